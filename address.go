@@ -0,0 +1,149 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Address types of an address (XAD-7), from HL7 table 0190.
+const (
+	AddressBad           = "BA"  // Bad address.
+	AddressBirth         = "N"   // Birth (nee), birth address not otherwise specified.
+	AddressBirthDelivery = "BDL" // Birth delivery location, where the birth occurred.
+	AddressBirthResident = "BR"  // Residence at birth, home address at time of birth.
+	AddressBusiness      = "B"   // Firm or business.
+	AddressCountryOrigin = "F"   // Country of origin.
+	AddressCurrent       = "C"   // Current or temporary.
+	AddressHome          = "H"   // Home.
+	AddressLegal         = "L"   // Legal address.
+	AddressMailing       = "M"   // Mailing.
+	AddressOffice        = "O"   // Office.
+	AddressPermanent     = "P"   // Permanent.
+	AddressRegistryHome  = "RH"  // Registry home, for use in public health registries.
+	AddressBilling       = "BI"  // Billing address, from v2.6.
+	AddressShipping      = "SH"  // Shipping address, from v2.6.
+	AddressVacation      = "V"   // Vacation.
+)
+
+// Address is the extended address data type (XAD) of any version, such as a PID-11 repetition.
+type Address struct {
+	Street     string // XAD-1, the street or mailing address.
+	Other      string // XAD-2 other designation, the second address line such as a suite or apartment.
+	City       string // XAD-3.
+	State      string // XAD-4 state or province.
+	PostalCode string // XAD-5 zip or postal code.
+	Country    string // XAD-6, the ISO 3166 three letter country code, such as USA.
+	Type       string // XAD-7 address type, such as AddressHome.
+	County     string // XAD-9 county or parish code.
+}
+
+// ParseAddress returns the address of an XAD or AD value of any version.
+func ParseAddress(xad any) (Address, error) {
+	rv := reflect.Indirect(reflect.ValueOf(xad))
+	if rv.Kind() != reflect.Struct {
+		return Address{}, fmt.Errorf("%T is not an address", xad)
+	}
+	return Address{
+		Street:     stringComponent(rv, 1),
+		Other:      stringComponent(rv, 2),
+		City:       stringComponent(rv, 3),
+		State:      stringComponent(rv, 4),
+		PostalCode: stringComponent(rv, 5),
+		Country:    stringComponent(rv, 6),
+		Type:       stringComponent(rv, 7),
+		County:     stringComponent(rv, 9),
+	}, nil
+}
+
+// Addresses returns the addresses of each repetition of an XAD field, such as PID-11.
+func Addresses(field any) ([]Address, error) {
+	var list []Address
+	err := repetitions(reflect.ValueOf(field), func(rv reflect.Value) error {
+		a, err := ParseAddress(rv.Interface())
+		if err != nil {
+			return err
+		}
+		list = append(list, a)
+		return nil
+	})
+	return list, err
+}
+
+// AddressesOfType returns the addresses with any of the address types, such as AddressHome, in order.
+func AddressesOfType(list []Address, addressType ...string) []Address {
+	var ret []Address
+	for _, a := range list {
+		for _, t := range addressType {
+			if a.Type == t {
+				ret = append(ret, a)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// Lines returns the address as the lines of a mailing label, leaving out empty lines:
+// the street, the other designation, "City, State PostalCode", and the country.
+func (a Address) Lines() []string {
+	var lines []string
+	add := func(s string) {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			lines = append(lines, s)
+		}
+	}
+	add(a.Street)
+	add(a.Other)
+	place := strings.TrimSpace(a.State + " " + a.PostalCode)
+	switch {
+	case len(a.City) == 0:
+		add(place)
+	case len(place) == 0:
+		add(a.City)
+	default:
+		add(a.City + ", " + place)
+	}
+	add(a.Country)
+	return lines
+}
+
+// MultiLine returns the address lines separated by new lines.
+func (a Address) MultiLine() string {
+	return strings.Join(a.Lines(), "\n")
+}
+
+// SingleLine returns the address lines on one line separated by commas,
+// such as "123 Main St, Apt 4, Springfield, IL 62701, USA".
+func (a Address) SingleLine() string {
+	return strings.Join(a.Lines(), ", ")
+}
+
+// Normalize returns the address with spaces trimmed and collapsed, and the state,
+// country, and address type in upper case.
+func (a Address) Normalize() Address {
+	clean := func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	}
+	return Address{
+		Street:     clean(a.Street),
+		Other:      clean(a.Other),
+		City:       clean(a.City),
+		State:      strings.ToUpper(clean(a.State)),
+		PostalCode: clean(a.PostalCode),
+		Country:    strings.ToUpper(clean(a.Country)),
+		Type:       strings.ToUpper(clean(a.Type)),
+		County:     clean(a.County),
+	}
+}
+
+// Set sets the components of an XAD value of any version, which must be a pointer.
+// The street is set in the first subcomponent of a street address (SAD) component.
+// Components the version does not define must be empty.
+func (a Address) Set(xad any) error {
+	rv := reflect.ValueOf(xad)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to an address", xad)
+	}
+	return setComponents(rv.Elem(), []string{a.Street, a.Other, a.City, a.State, a.PostalCode, a.Country, a.Type, "", a.County})
+}
@@ -0,0 +1,59 @@
+package hl7
+
+import (
+	"reflect"
+	"testing"
+
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestAddress(t *testing.T) {
+	pid := &v251.PID{PatientAddress: []v251.XAD{
+		{StreetAddress: &v251.SAD{StreetOrMailingAddress: "PO Box 9"}, City: "Springfield", StateOrProvince: "IL", ZipOrPostalCode: "62701", AddressType: AddressMailing},
+		{StreetAddress: &v251.SAD{StreetOrMailingAddress: "123 Main St"}, OtherDesignation: "Apt 4", City: "Springfield", StateOrProvince: "IL", ZipOrPostalCode: "62701", Country: "USA", AddressType: AddressHome},
+	}}
+	list, err := Addresses(pid.PatientAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	home := AddressesOfType(list, AddressHome, AddressPermanent)
+	if len(home) != 1 {
+		t.Fatalf("got %+v", home)
+	}
+	if got, want := home[0].SingleLine(), "123 Main St, Apt 4, Springfield, IL 62701, USA"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := list[0].MultiLine(), "PO Box 9\nSpringfield, IL 62701"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := (Address{State: "ON", Country: "CAN"}).Lines(); !reflect.DeepEqual(got, []string{"ON", "CAN"}) {
+		t.Errorf("got %q", got)
+	}
+
+	a := Address{Street: " 1  Elm   St ", City: "Toronto", State: "on", PostalCode: "M5V 2T6", Country: "can", Type: "h"}.Normalize()
+	if a != (Address{Street: "1 Elm St", City: "Toronto", State: "ON", PostalCode: "M5V 2T6", Country: "CAN", Type: AddressHome}) {
+		t.Errorf("got %+v", a)
+	}
+
+	var xad v251.XAD
+	err = a.Set(&xad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xad.StreetAddress == nil || xad.StreetAddress.StreetOrMailingAddress != "1 Elm St" || xad.Country != "CAN" || xad.AddressType != AddressHome {
+		t.Errorf("got %+v", xad)
+	}
+	if got, err := ParseAddress(&xad); err != nil || got != a {
+		t.Errorf("got %+v, %v", got, err)
+	}
+
+	var old v231.XAD
+	err = a.Set(&old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.StreetAddress != "1 Elm St" || old.StateOrProvince != "ON" {
+		t.Errorf("got %+v", old)
+	}
+}
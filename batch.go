@@ -0,0 +1,338 @@
+package hl7
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrCount is the FieldError reason for a trailer count that does not match the number decoded,
+// which may indicate a truncated transfer.
+var ErrCount = errors.New("trailer count does not match")
+
+// File is a set of batches sent with the HL7 batch protocol.
+// The header and trailer segments are of the registry version, such as h251.FHS and h251.FTS.
+type File struct {
+	Header  any // FHS segment, nil if not present.
+	Batch   []Batch
+	Trailer any // FTS segment, nil if not present.
+}
+
+// Batch is a set of messages within a file.
+type Batch struct {
+	Header  any   // BHS segment, nil if not present.
+	Message []any // Final trigger of each message, with all segments grouped.
+	Trailer any   // BTS segment, nil if not present.
+}
+
+// DecodeFile decodes data that uses the HL7 batch protocol into files, batches, and messages.
+// The FHS and BHS segments are optional, so a single batch or a list of messages may also be decoded.
+// Messages not within a batch are placed in a batch without a header.
+func (d *Decoder) DecodeFile(data []byte) (*File, error) {
+	list, err := d.DecodeList(data)
+	if err != nil {
+		return nil, fmt.Errorf("segment list: %w", err)
+	}
+	b := &batchBuilder{d: d, f: &File{}}
+	for i, seg := range list {
+		err := b.add(seg)
+		if err != nil {
+			return nil, fmt.Errorf("segment %d: %w", i+1, err)
+		}
+	}
+	err = b.flush()
+	if err != nil {
+		return nil, err
+	}
+	return b.f, nil
+}
+
+// batchBuilder assigns each segment to the file, the current batch, or the current message.
+type batchBuilder struct {
+	d       *Decoder
+	f       *File
+	open    bool  // Last batch is open to more messages.
+	message []any // Segments of the current message.
+	count   int   // Messages decoded.
+}
+
+func (b *batchBuilder) add(seg any) error {
+	name := segmentName(reflect.ValueOf(seg))
+	switch name {
+	default:
+		if b.message == nil {
+			return fmt.Errorf("%s segment outside of a message", name)
+		}
+		b.message = append(b.message, seg)
+		return nil
+	case "MSH":
+		err := b.flush()
+		if err != nil {
+			return err
+		}
+		b.batch()
+		b.message = []any{seg}
+		return nil
+	case "FHS":
+		if b.f.Header != nil || len(b.f.Batch) > 0 || b.message != nil {
+			return fmt.Errorf("unexpected FHS segment")
+		}
+		b.f.Header = seg
+		return nil
+	case "BHS":
+		err := b.flush()
+		if err != nil {
+			return err
+		}
+		b.f.Batch = append(b.f.Batch, Batch{Header: seg})
+		b.open = true
+		return nil
+	case "BTS":
+		err := b.flush()
+		if err != nil {
+			return err
+		}
+		b.batch().Trailer = seg
+		b.open = false
+		return nil
+	case "FTS":
+		err := b.flush()
+		if err != nil {
+			return err
+		}
+		if b.f.Trailer != nil {
+			return fmt.Errorf("unexpected FTS segment")
+		}
+		b.f.Trailer = seg
+		b.open = false
+		return nil
+	}
+}
+
+// batch returns the open batch, starting a batch without a header if none is open.
+func (b *batchBuilder) batch() *Batch {
+	if !b.open {
+		b.f.Batch = append(b.f.Batch, Batch{})
+		b.open = true
+	}
+	return &b.f.Batch[len(b.f.Batch)-1]
+}
+
+// flush groups the current message and adds it to the open batch.
+func (b *batchBuilder) flush() error {
+	if b.message == nil {
+		return nil
+	}
+	b.count++
+	g, err := b.d.DecodeGroup(b.message)
+	if err != nil {
+		return fmt.Errorf("message %d: trigger group: %w", b.count, err)
+	}
+	batch := b.batch()
+	batch.Message = append(batch.Message, g)
+	b.message = nil
+	return nil
+}
+
+// CheckCounts compares the message count of each batch trailer (BTS-1) and the batch count
+// of the file trailer (FTS-1) with the number of messages and batches decoded.
+// Mismatches are returned as a ValidationError. Missing trailers and empty counts are not checked.
+func (f *File) CheckCounts() error {
+	var ve ValidationError
+	check := func(seg any, n int, what string) {
+		if seg == nil {
+			return
+		}
+		rv := reflect.Indirect(reflect.ValueOf(seg))
+		ft, fv, _, ok := fieldByOrder(rv, 1)
+		if !ok {
+			return
+		}
+		count, set, err := intValue(fv)
+		switch {
+		case err != nil:
+		case !set:
+			return
+		case count == n:
+			return
+		default:
+			err = fmt.Errorf("%w: %d, decoded %d %s", ErrCount, count, n, what)
+		}
+		ve = append(ve, &FieldError{Segment: segmentName(rv), Position: []int{1}, Name: ft.Name, Err: err})
+	}
+	for i, b := range f.Batch {
+		check(b.Trailer, len(b.Message), fmt.Sprintf("messages in batch %d", i+1))
+	}
+	check(f.Trailer, len(f.Batch), "batches")
+	if len(ve) > 0 {
+		return ve
+	}
+	return nil
+}
+
+// intValue returns the integer in a count field, and false if the field is empty.
+func intValue(fv reflect.Value) (int, bool, error) {
+	switch {
+	case fv.Kind() == reflect.String:
+		v := strings.TrimSpace(fv.String())
+		if len(v) == 0 {
+			return 0, false, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, true, fmt.Errorf("invalid count %q", v)
+		}
+		return n, true, nil
+	case fv.CanInt():
+		return int(fv.Int()), true, nil
+	case fv.CanUint():
+		return int(fv.Uint()), true, nil
+	}
+	return 0, false, nil
+}
+
+// EncodeFile encodes the file header, each batch and message, and the file trailer.
+// Each message starts with the delimiters of the preceding header segment, unless its MSH sets them.
+func (e *Encoder) EncodeFile(f *File) ([]byte, error) {
+	e.init("", "")
+
+	list := []any{f.Header}
+	for _, b := range f.Batch {
+		list = append(list, b.Header)
+		list = append(list, b.Message...)
+		list = append(list, b.Trailer)
+	}
+	list = append(list, f.Trailer)
+	for _, v := range list {
+		err := e.walk(1, reflect.ValueOf(v))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.addenda(e.buf.Bytes())
+}
+
+// BatchPolicy selects how ProcessBatch handles a message that fails to decode.
+type BatchPolicy byte
+
+const (
+	BatchStop     BatchPolicy = iota // Return the error of the first message that fails to decode.
+	BatchContinue                    // Report the error to the Error function and continue with the next message.
+)
+
+// BatchOption represents options for ProcessBatch.
+type BatchOption struct {
+	Decode *DecodeOption // Options used to decode each message. May be nil.
+	Policy BatchPolicy
+
+	// Error is called with the message number, starting at 1, and the error of each message
+	// that fails to decode when the policy is BatchContinue. If nil, such messages are skipped.
+	Error func(message int, err error)
+}
+
+// ProcessBatch reads a batch file from r and calls fn with the final trigger of each message as it is decoded,
+// without reading the whole file into memory. An error from fn stops processing and is returned.
+// File and batch header and trailer segments are skipped. Option may be nil.
+func ProcessBatch(r io.Reader, registry Registry, opt *BatchOption, fn func(message any) error) error {
+	return ProcessBatchContext(context.Background(), r, registry, opt, func(ctx context.Context, message any) error {
+		return fn(message)
+	})
+}
+
+// ProcessBatchContext processes a batch file as ProcessBatch does, passing the context to fn.
+// Processing stops with the error of the context when it is done, such as when a service shuts down.
+func ProcessBatchContext(ctx context.Context, r io.Reader, registry Registry, opt *BatchOption, fn func(ctx context.Context, message any) error) error {
+	if opt == nil {
+		opt = &BatchOption{}
+	}
+	d := NewDecoder(registry, opt.Decode)
+	max := math.MaxInt
+	if d.opt.MaxLength > 0 {
+		max = d.opt.MaxLength
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, max)
+	sc.Split(scanSegment)
+
+	count := 0
+	var message []byte
+	flush := func() error {
+		if len(message) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		count++
+		v, err := d.Decode(message)
+		message = nil // Decoded values may refer to the message data.
+		if err != nil {
+			err = fmt.Errorf("message %d: %w", count, err)
+			if opt.Policy != BatchContinue {
+				return err
+			}
+			if opt.Error != nil {
+				opt.Error(count, err)
+			}
+			return nil
+		}
+		return fn(ctx, v)
+	}
+	for sc.Scan() {
+		line := sc.Bytes()
+		switch {
+		case isSegment(line, "MSH"):
+			err := flush()
+			if err != nil {
+				return err
+			}
+		case isSegment(line, "FHS"), isSegment(line, "BHS"), isSegment(line, "BTS"), isSegment(line, "FTS"):
+			err := flush()
+			if err != nil {
+				return err
+			}
+			continue
+		case len(message) == 0:
+			id := line
+			if len(id) > 3 {
+				id = id[:3]
+			}
+			return fmt.Errorf("message %d: %s segment outside of a message", count+1, id)
+		}
+		message = append(message, line...)
+		message = append(message, '\r')
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// scanSegment is a bufio.SplitFunc that returns each segment terminated by CR or LF, skipping empty lines.
+func scanSegment(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for {
+		i := bytes.IndexAny(data[advance:], "\r\n")
+		if i < 0 {
+			break
+		}
+		if i > 0 {
+			return advance + i + 1, data[advance : advance+i], nil
+		}
+		advance++
+	}
+	if atEOF && len(data) > advance {
+		return len(data), data[advance:], nil
+	}
+	return advance, nil, nil
+}
+
+func isSegment(line []byte, name string) bool {
+	return len(line) > len(name) && string(line[:len(name)]) == name
+}
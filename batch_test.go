@@ -0,0 +1,175 @@
+package hl7
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+const testBatchFile = "FHS|^~\\&|APP|FAC|||20240131120000||file1\r" +
+	"BHS|^~\\&|APP|FAC|||20240131120000||batch1\r" +
+	"MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1\rEVN|A01\rPID|1||123\rPV1|1|I\r" +
+	"MSH|^~\\&|||||||ADT^A01^ADT_A01|2|P|2.5.1\rEVN|A01\rPID|1||456\rPV1|1|O\r" +
+	"BTS|2\r" +
+	"FTS|1\r"
+
+func TestDecodeFile(t *testing.T) {
+	d := NewDecoder(v251.Registry, nil)
+	f, err := d.DecodeFile([]byte(testBatchFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fhs, ok := f.Header.(*v251.FHS)
+	if !ok || fhs.FileNameID != "file1" || fhs.FileEncodingCharacters != `^~\&` {
+		t.Fatalf("got header %#v", f.Header)
+	}
+	if _, ok := f.Trailer.(*v251.FTS); !ok {
+		t.Fatalf("got trailer %#v", f.Trailer)
+	}
+	if len(f.Batch) != 1 {
+		t.Fatalf("got %d batches", len(f.Batch))
+	}
+	b := f.Batch[0]
+	if bhs, ok := b.Header.(*v251.BHS); !ok || bhs.BatchNameIDType != "batch1" {
+		t.Fatalf("got batch header %#v", b.Header)
+	}
+	if bts, ok := b.Trailer.(*v251.BTS); !ok || bts.BatchMessageCount != "2" {
+		t.Fatalf("got batch trailer %#v", b.Trailer)
+	}
+	if len(b.Message) != 2 {
+		t.Fatalf("got %d messages", len(b.Message))
+	}
+	if id := b.Message[1].(v251.ADT_A01).PID.PatientIdentifierList[0].IDNumber; id != "456" {
+		t.Fatalf("got ID %q", id)
+	}
+
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).EncodeFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.TrimRight(string(bb), "\r"), strings.TrimRight(testBatchFile, "\r"); got != want {
+		t.Fatalf("round trip:\n%s", lineDiff([]byte(want), []byte(got)))
+	}
+}
+
+func TestDecodeFileWithoutHeaders(t *testing.T) {
+	d := NewDecoder(v251.Registry, nil)
+	raw := "MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1\rEVN|A01\rPID|1||123\rPV1|1|I\r"
+	f, err := d.DecodeFile([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Header != nil || len(f.Batch) != 1 || f.Batch[0].Header != nil || len(f.Batch[0].Message) != 1 {
+		t.Fatalf("got %+v", f)
+	}
+
+	_, err = d.DecodeFile([]byte("BHS|^~\\&\rPID|1||123\rBTS|0"))
+	if err == nil || !strings.Contains(err.Error(), "PID segment outside of a message") {
+		t.Fatalf("expected error, got %v", err)
+	}
+	_, err = d.DecodeFile([]byte("FHS|^~\\&\rFHS|^~\\&"))
+	if err == nil || !strings.Contains(err.Error(), "unexpected FHS") {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestProcessBatch(t *testing.T) {
+	var ids []string
+	err := ProcessBatch(strings.NewReader(testBatchFile), v251.Registry, nil, func(msg any) error {
+		ids = append(ids, msg.(v251.ADT_A01).PID.PatientIdentifierList[0].IDNumber)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, ",") != "123,456" {
+		t.Fatalf("got %v", ids)
+	}
+
+	bad := strings.Replace(testBatchFile, "PID|1||123", "PID|1||123\rQQQ|1", 1)
+	err = ProcessBatch(strings.NewReader(bad), v251.Registry, nil, func(msg any) error { return nil })
+	if err == nil || !strings.HasPrefix(err.Error(), "message 1: ") {
+		t.Fatalf("expected message 1 error, got %v", err)
+	}
+
+	ids = nil
+	var failed []int
+	opt := &BatchOption{
+		Policy: BatchContinue,
+		Error:  func(n int, err error) { failed = append(failed, n) },
+	}
+	err = ProcessBatch(strings.NewReader(bad), v251.Registry, opt, func(msg any) error {
+		ids = append(ids, msg.(v251.ADT_A01).PID.PatientIdentifierList[0].IDNumber)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, ",") != "456" || len(failed) != 1 || failed[0] != 1 {
+		t.Fatalf("got ids %v, failed %v", ids, failed)
+	}
+
+	stop := errors.New("stop")
+	err = ProcessBatch(strings.NewReader(testBatchFile), v251.Registry, nil, func(msg any) error { return stop })
+	if err != stop {
+		t.Fatalf("expected callback error, got %v", err)
+	}
+}
+
+func TestProcessBatchContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var ids []string
+	err := ProcessBatchContext(ctx, strings.NewReader(testBatchFile), v251.Registry, nil, func(ctx context.Context, msg any) error {
+		ids = append(ids, msg.(v251.ADT_A01).PID.PatientIdentifierList[0].IDNumber)
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("got %v", err)
+	}
+	if strings.Join(ids, ",") != "123" {
+		t.Fatalf("got %v", ids)
+	}
+}
+
+func TestFileCheckCounts(t *testing.T) {
+	d := NewDecoder(v251.Registry, nil)
+	f, err := d.DecodeFile([]byte(testBatchFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.CheckCounts(); err != nil {
+		t.Fatal(err)
+	}
+
+	truncated := strings.Replace(testBatchFile, "BTS|2", "BTS|3", 1)
+	truncated = strings.Replace(truncated, "FTS|1", "FTS|2", 1)
+	f, err = d.DecodeFile([]byte(truncated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.CheckCounts()
+	var ve ValidationError
+	if !errors.As(err, &ve) || len(ve) != 2 || !errors.Is(ve[0], ErrCount) {
+		t.Fatalf("expected count errors, got %v", err)
+	}
+	want := "BTS-1 BatchMessageCount: trailer count does not match: 3, decoded 2 messages in batch 1"
+	if got := ve[0].Error(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := ve[1].Location(); got != "FTS-1" {
+		t.Errorf("got %q", got)
+	}
+
+	f, err = d.DecodeFile([]byte(strings.Replace(testBatchFile, "BTS|2", "BTS|two", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.CheckCounts(); err == nil || !strings.Contains(err.Error(), `invalid count "two"`) {
+		t.Fatalf("expected invalid count, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MessageBuilder composes an outbound message from segments and groups, such as:
+//
+//	msg, err := hl7.NewMessage(h251.Registry, "ORU", "R01").
+//		Add(pid).
+//		AddGroup(h251.ORU_R01_OrderObservation{OBR: obr, Observation: obs}).
+//		Build()
+//
+// Segments are added in message order. Errors are reported by Build.
+type MessageBuilder struct {
+	registry Registry
+	header   MSHOption
+	segs     []any
+	err      error
+}
+
+// NewMessage returns a MessageBuilder of a message of the type and trigger event, such as ORU and R01,
+// in the version of the registry.
+func NewMessage(registry Registry, messageType, triggerEvent string) *MessageBuilder {
+	return &MessageBuilder{
+		registry: registry,
+		header:   MSHOption{MessageType: messageType, TriggerEvent: triggerEvent},
+	}
+}
+
+// Header sets the values of the MSH segment Build adds if the message has none.
+// The message type and trigger event of NewMessage are used if the option has none.
+// If the option has no message structure, the type and trigger event are used if the registry
+// has that trigger, such as ORU_R01.
+func (b *MessageBuilder) Header(opt *MSHOption) *MessageBuilder {
+	if opt == nil {
+		return b
+	}
+	h := *opt
+	if len(h.MessageType) == 0 {
+		h.MessageType = b.header.MessageType
+	}
+	if len(h.TriggerEvent) == 0 {
+		h.TriggerEvent = b.header.TriggerEvent
+	}
+	b.header = h
+	return b
+}
+
+// Add adds segments, such as a *h251.PID, to the end of the message.
+func (b *MessageBuilder) Add(segments ...any) *MessageBuilder {
+	for _, seg := range segments {
+		if len(SegmentName(seg)) == 0 {
+			b.fail(fmt.Errorf("%T is not a segment", seg))
+			continue
+		}
+		b.segs = append(b.segs, segmentPointer(seg))
+	}
+	return b
+}
+
+// AddGroup adds the segments of a group, such as a h251.ORU_R01_OrderObservation, to the end of the message
+// in the order of the group.
+func (b *MessageBuilder) AddGroup(group any) *MessageBuilder {
+	rv := reflect.Indirect(reflect.ValueOf(group))
+	if rv.Kind() != reflect.Struct {
+		b.fail(fmt.Errorf("%T is not a group", group))
+		return b
+	}
+	meta, err := (&Encoder{}).meta(rv.Type())
+	if err != nil || !meta.Present || meta.Type != structTriggerGroup {
+		b.fail(fmt.Errorf("%T is not a group", group))
+		return b
+	}
+	for _, seg := range Segments(group) {
+		b.segs = append(b.segs, segmentPointer(seg))
+	}
+	return b
+}
+
+func (b *MessageBuilder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// segmentPointer returns a pointer to a copy of a segment value, or the segment pointer.
+func segmentPointer(seg any) any {
+	rv := reflect.ValueOf(seg)
+	if rv.Kind() == reflect.Pointer {
+		return seg
+	}
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	return p.Interface()
+}
+
+// Build returns the message as a trigger, such as h251.ORU_R01. If no MSH segment was added, one is added
+// first with NewMSH. The segments are checked for the order of the message structure with ValidateOrder,
+// and the trigger for required values with Validate.
+func (b *MessageBuilder) Build() (any, error) {
+	if b.err != nil {
+		return nil, fmt.Errorf("build: %w", b.err)
+	}
+	list := b.segs
+	if len(list) == 0 || SegmentName(list[0]) != "MSH" {
+		h := b.header
+		if code := h.MessageType + "_" + h.TriggerEvent; len(h.MessageStructure) == 0 && b.registry.Trigger()[code] != nil {
+			h.MessageStructure = code
+		}
+		msh, err := NewMSH(b.registry, &h)
+		if err != nil {
+			return nil, fmt.Errorf("build: %w", err)
+		}
+		list = append([]any{msh}, list...)
+	}
+	if err := ValidateOrder(list, b.registry); err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	msg, err := group(list, b.registry)
+	if err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	if err := Validate(msg, nil); err != nil {
+		return nil, fmt.Errorf("build: %w", err)
+	}
+	return msg, nil
+}
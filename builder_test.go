@@ -0,0 +1,72 @@
+package hl7
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestMessageBuilder(t *testing.T) {
+	pid := &v251.PID{
+		PatientIdentifierList: []v251.CX{{IDNumber: "123"}},
+		PatientName:           []v251.XPN{{FamilyName: "Doe"}},
+	}
+	obr := &v251.OBR{UniversalServiceIdentifier: v251.CE{Identifier: "CBC"}}
+	obx := &v251.OBX{ValueType: "ST", ObservationIdentifier: v251.CE{Identifier: "WBC"}, ObservationResultStatus: "F"}
+
+	msg, err := NewMessage(v251.Registry, "ORU", "R01").
+		Header(&MSHOption{SendingApplication: "LAB"}).
+		Add(pid).
+		AddGroup(v251.ORU_R01_OrderObservation{
+			OBR:         obr,
+			Observation: []v251.ORU_R01_Observation{{OBX: obx}},
+		}).
+		Add(v251.NTE{Comment: []v251.FT{"done"}}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oru := msg.(v251.ORU_R01)
+	if oru.MSH.SendingApplication.NamespaceID != "LAB" || oru.MSH.MessageType.TriggerEvent != "R01" {
+		t.Errorf("got MSH %+v", oru.MSH)
+	}
+	pr := oru.PatientResult[0]
+	if pr.Patient.PID != pid || pr.OrderObservation[0].Observation[0].OBX != obx || len(pr.OrderObservation[0].Observation[0].NTE) != 1 {
+		t.Errorf("got %+v", pr)
+	}
+	if _, err := NewEncoder(nil).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// An MSH that is added is kept.
+	msh, err := NewMSH(v251.Registry, &MSHOption{MessageType: "ORU", TriggerEvent: "R01", MessageStructure: "ORU_R01", ProcessingID: "T"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err = NewMessage(v251.Registry, "ORU", "R01").Add(msh, pid, obr).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.(v251.ORU_R01).MSH != msh {
+		t.Errorf("MSH was replaced")
+	}
+
+	for name, b := range map[string]*MessageBuilder{
+		"order":    NewMessage(v251.Registry, "ORU", "R01").Add(obr, pid),
+		"required": NewMessage(v251.Registry, "ORU", "R01").Add(&v251.PID{}, obr),
+		"segment":  NewMessage(v251.Registry, "ORU", "R01").Add("PID"),
+		"group":    NewMessage(v251.Registry, "ORU", "R01").AddGroup(pid),
+		"trigger":  NewMessage(v251.Registry, "ORU", "X99").Add(pid, obr),
+	} {
+		_, err := b.Build()
+		if err == nil || !strings.HasPrefix(err.Error(), "build: ") {
+			t.Errorf("%s: got %v", name, err)
+		}
+		var ve ValidationError
+		if (name == "order" || name == "required") != errors.As(err, &ve) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+}
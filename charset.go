@@ -0,0 +1,220 @@
+package hl7
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// Charset converts text between a message character set and UTF-8.
+type Charset interface {
+	Decode(b []byte) ([]byte, error) // Decode to UTF-8.
+	Encode(b []byte) ([]byte, error) // Encode from UTF-8.
+}
+
+// CharsetLookup returns the Charset for an MSH-18 character set name (HL7 table 0211).
+// Return a nil Charset and nil error to fall back to the built-in character sets.
+type CharsetLookup func(name string) (Charset, error)
+
+type textCharset struct {
+	enc encoding.Encoding
+}
+
+func (c textCharset) Decode(b []byte) ([]byte, error) {
+	return c.enc.NewDecoder().Bytes(b)
+}
+func (c textCharset) Encode(b []byte) ([]byte, error) {
+	return c.enc.NewEncoder().Bytes(b)
+}
+
+// builtinCharset lists the MSH-18 character sets converted without a CharsetLookup.
+// ASCII and Unicode UTF-8 are passed through as is.
+var builtinCharset = map[string]Charset{
+	"8859/1":        textCharset{charmap.ISO8859_1},
+	"8859/2":        textCharset{charmap.ISO8859_2},
+	"8859/3":        textCharset{charmap.ISO8859_3},
+	"8859/4":        textCharset{charmap.ISO8859_4},
+	"8859/5":        textCharset{charmap.ISO8859_5},
+	"8859/6":        textCharset{charmap.ISO8859_6},
+	"8859/7":        textCharset{charmap.ISO8859_7},
+	"8859/8":        textCharset{charmap.ISO8859_8},
+	"8859/9":        textCharset{charmap.ISO8859_9},
+	"8859/15":       textCharset{charmap.ISO8859_15},
+	"GB 18030-2000": textCharset{simplifiedchinese.GB18030},
+	"KS X 1001":     textCharset{korean.EUCKR},
+	"BIG-5":         textCharset{traditionalchinese.Big5},
+}
+
+// lookupCharset returns the Charset for the MSH-18 name, or nil if the text
+// should be passed through as is.
+func lookupCharset(lookup CharsetLookup, name string) (Charset, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return nil, nil
+	}
+	if lookup != nil {
+		cs, err := lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		if cs != nil {
+			return cs, nil
+		}
+	}
+	return builtinCharset[name], nil
+}
+
+// Character set escape sequences (\Cxxyy\ and \Mxxyyzz\) carry the ISO 2022 designation
+// that follows the ESC byte, written in hex. A 94 or 96 character set may be designated
+// into G0 (used for bytes 0x21-0x7E) or G1 (used for bytes 0xA0-0xFF).
+
+// charset converts text encoded in a designated character set to UTF-8.
+type charset struct {
+	name   string
+	decode func(run []byte) ([]byte, error)
+}
+
+type designation struct {
+	g1      bool // Designate G1 when true, G0 when false.
+	charset *charset
+}
+
+// charsetState is the set of active character sets after a \C or \M escape.
+// When not active, text is converted from the message character set, if any, by the caller.
+type charsetState struct {
+	active bool
+	g0     *charset
+	g1     *charset
+}
+
+func decodeWith(enc encoding.Encoding) func(run []byte) ([]byte, error) {
+	return func(run []byte) ([]byte, error) {
+		return enc.NewDecoder().Bytes(run)
+	}
+}
+
+// jisSet converts 7-bit JIS X 0208 or JIS X 0212 byte pairs to EUC-JP and decodes them.
+func jisSet(prefix []byte) func(run []byte) ([]byte, error) {
+	return func(run []byte) ([]byte, error) {
+		euc := make([]byte, 0, len(run)+len(run)/2*len(prefix))
+		for i := 0; i+1 < len(run); i += 2 {
+			euc = append(euc, prefix...)
+			euc = append(euc, run[i]|0x80, run[i+1]|0x80)
+		}
+		return japanese.EUCJP.NewDecoder().Bytes(euc)
+	}
+}
+
+var (
+	charsetASCII = &charset{name: "ISO IR6", decode: func(run []byte) ([]byte, error) {
+		return run, nil
+	}}
+	charsetJISRoman = &charset{name: "ISO IR14", decode: func(run []byte) ([]byte, error) {
+		return run, nil
+	}}
+	charsetJISKatakana = &charset{name: "ISO IR13", decode: func(run []byte) ([]byte, error) {
+		out := make([]byte, 0, len(run)*3)
+		for _, b := range run {
+			if b < 0x21 || b > 0x5f {
+				out = append(out, b)
+				continue
+			}
+			out = append(out, string(rune(0xFF61+int(b)-0x21))...)
+		}
+		return out, nil
+	}}
+	charsetJISX0208 = &charset{name: "ISO IR87", decode: jisSet(nil)}
+	charsetJISX0212 = &charset{name: "ISO IR159", decode: jisSet([]byte{0x8f})}
+	charsetKSX1001  = &charset{name: "ISO IR149", decode: decodeWith(korean.EUCKR)}
+	charsetGB2312   = &charset{name: "ISO IR58", decode: decodeWith(simplifiedchinese.GBK)}
+)
+
+func latinSet(name string, cm *charmap.Charmap) *charset {
+	return &charset{name: name, decode: decodeWith(cm)}
+}
+
+// designationLookup maps the hex text of an escape sequence to the character set it designates.
+var designationLookup = map[string]designation{
+	// \Cxxyy\ single byte character sets.
+	"2842": {g1: false, charset: charsetASCII},
+	"284A": {g1: false, charset: charsetJISRoman},
+	"2849": {g1: false, charset: charsetJISKatakana},
+	"2D41": {g1: true, charset: latinSet("ISO IR100", charmap.ISO8859_1)},
+	"2D42": {g1: true, charset: latinSet("ISO IR101", charmap.ISO8859_2)},
+	"2D43": {g1: true, charset: latinSet("ISO IR109", charmap.ISO8859_3)},
+	"2D44": {g1: true, charset: latinSet("ISO IR110", charmap.ISO8859_4)},
+	"2D4C": {g1: true, charset: latinSet("ISO IR144", charmap.ISO8859_5)},
+	"2D47": {g1: true, charset: latinSet("ISO IR127", charmap.ISO8859_6)},
+	"2D46": {g1: true, charset: latinSet("ISO IR126", charmap.ISO8859_7)},
+	"2D48": {g1: true, charset: latinSet("ISO IR138", charmap.ISO8859_8)},
+	"2D4D": {g1: true, charset: latinSet("ISO IR148", charmap.ISO8859_9)},
+
+	// \Mxxyyzz\ multi-byte character sets.
+	"2440":   {g1: false, charset: charsetJISX0208},
+	"2442":   {g1: false, charset: charsetJISX0208},
+	"242844": {g1: false, charset: charsetJISX0212},
+	"242943": {g1: true, charset: charsetKSX1001},
+	"242941": {g1: true, charset: charsetGB2312},
+}
+
+// designate applies the character set escape sequence. It returns false if the
+// sequence is not known.
+func (cs *charsetState) designate(value []byte) bool {
+	des, ok := designationLookup[string(bytes.ToUpper(value))]
+	if !ok {
+		return false
+	}
+	cs.active = true
+	if des.g1 {
+		cs.g1 = des.charset
+	} else {
+		cs.g0 = des.charset
+	}
+	return true
+}
+
+// write converts text in the active character sets and writes it as UTF-8.
+func (cs *charsetState) write(buf *strings.Builder, text []byte) {
+	if !cs.active {
+		buf.Write(text)
+		return
+	}
+	for len(text) > 0 {
+		var set *charset
+		var n int
+		switch b := text[0]; {
+		case b >= 0x21 && b <= 0x7e:
+			set = cs.g0
+			for n < len(text) && text[n] >= 0x21 && text[n] <= 0x7e {
+				n++
+			}
+		case b >= 0xa0:
+			set = cs.g1
+			for n < len(text) && text[n] >= 0xa0 {
+				n++
+			}
+		default:
+			for n < len(text) && (text[n] < 0x21 || (text[n] > 0x7e && text[n] < 0xa0)) {
+				n++
+			}
+		}
+		run := text[:n]
+		text = text[n:]
+		if set == nil {
+			buf.Write(run)
+			continue
+		}
+		out, err := set.decode(run)
+		if err != nil {
+			buf.Write(run)
+			continue
+		}
+		buf.Write(out)
+	}
+}
@@ -0,0 +1,63 @@
+package hl7
+
+import (
+	"bytes"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+type upperCharset struct{}
+
+func (upperCharset) Decode(b []byte) ([]byte, error) { return bytes.ToUpper(b), nil }
+func (upperCharset) Encode(b []byte) ([]byte, error) { return bytes.ToLower(b), nil }
+
+func TestMessageCharset(t *testing.T) {
+	tests := []struct {
+		name    string
+		charset string
+		lookup  CharsetLookup
+		raw     string
+		want    string
+	}{
+		{"none", "", nil, "caf\xe9", "caf\xe9"},
+		{"utf-8", "UNICODE UTF-8", nil, "café", "café"},
+		{"latin1", "8859/1", nil, "caf\xe9", "café"},
+		{"latin1 escape", "8859/1", nil, "caf\xe9\\T\\", "café&"},
+		// Big5 trail bytes of 0x5C, 0x7C, and 0x5E are escaped as delimiters within the character.
+		{"big5 trail byte", "BIG-5", nil, "\xb3\\E\\\xa1\\F\\ \xa1\\S\\\\T\\", "許﹄ ）&"},
+		{"custom", "X-UPPER", func(name string) (Charset, error) {
+			if name == "X-UPPER" {
+				return upperCharset{}, nil
+			}
+			return nil, nil
+		}, "cafe", "CAFE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1||||||" + tt.charset + "|\rNTE|1||" + tt.raw + "|")
+			d := NewDecoder(v251.Registry, &DecodeOption{Charset: tt.lookup})
+			list, err := d.DecodeList(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nte := list[1].(*v251.NTE)
+			if got := nte.Comment[0]; got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+
+			e := NewEncoder(&EncodeOption{Charset: tt.lookup})
+			out := &bytes.Buffer{}
+			for _, seg := range list {
+				bb, err := e.Encode(seg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				out.Write(bb)
+			}
+			if got := out.Bytes(); !bytes.Contains(got, []byte("NTE|1||"+tt.raw+"|")) {
+				t.Fatalf("round trip got %q, want to contain %q", got, tt.raw)
+			}
+		})
+	}
+}
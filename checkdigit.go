@@ -0,0 +1,109 @@
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Check digit schemes of an extended composite ID (CX-3), from HL7 table 0061.
+const (
+	SchemeM10 = "M10" // Mod 10 algorithm.
+	SchemeM11 = "M11" // Mod 11 algorithm.
+)
+
+// ErrCheckDigit is the reason for an identifier whose check digit does not match.
+var ErrCheckDigit = errors.New("check digit does not match")
+
+// CheckDigit returns the check digit of the numeric identifier for the scheme.
+//
+// The M10 check digit doubles the digits in odd positions from the right, adds the digits of the result
+// to the other digits, and subtracts the sum from the next multiple of 10.
+// The M11 check digit weights each digit from the right by 2 to 7, repeating, and subtracts
+// the weighted sum modulo 11 from 11. Identifiers whose M11 check digit would be 10 have no check digit
+// and are not to be assigned.
+func CheckDigit(id, scheme string) (string, error) {
+	digits := make([]int, len(id))
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if c < '0' || c > '9' {
+			return "", fmt.Errorf("identifier %q is not numeric", id)
+		}
+		digits[len(id)-1-i] = int(c - '0') // Units digit first.
+	}
+	if len(digits) == 0 {
+		return "", fmt.Errorf("identifier is empty")
+	}
+	sum := 0
+	switch scheme {
+	default:
+		return "", fmt.Errorf("unknown check digit scheme %q", scheme)
+	case SchemeM10:
+		for i, d := range digits {
+			if i%2 == 0 {
+				d *= 2
+				d = d/10 + d%10
+			}
+			sum += d
+		}
+		return strconv.Itoa((10 - sum%10) % 10), nil
+	case SchemeM11:
+		for i, d := range digits {
+			sum += d * (i%6 + 2)
+		}
+		c := (11 - sum%11) % 11
+		if c == 10 {
+			return "", fmt.Errorf("identifier %q has no %s check digit", id, scheme)
+		}
+		return strconv.Itoa(c), nil
+	}
+}
+
+// VerifyCheckDigit checks the check digit of the identifier for the scheme.
+// If the check digit does not match, the error is ErrCheckDigit.
+func VerifyCheckDigit(id, digit, scheme string) error {
+	want, err := CheckDigit(id, scheme)
+	if err != nil {
+		return err
+	}
+	if digit != want {
+		return fmt.Errorf("%w: identifier %q %s check digit %q, want %q", ErrCheckDigit, id, scheme, digit, want)
+	}
+	return nil
+}
+
+// VerifyCX checks the check digit (CX-2) of an identifier (CX-1) with its scheme (CX-3), such as a PID-3 value.
+// The cx may be a CX or a CK value of any version. An identifier without a check digit scheme is not checked.
+func VerifyCX(cx any) error {
+	rv := reflect.Indirect(reflect.ValueOf(cx))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a data type", cx)
+	}
+	scheme := stringField(rv, 3)
+	if len(scheme) == 0 {
+		return nil
+	}
+	return VerifyCheckDigit(stringField(rv, 1), stringField(rv, 2), scheme)
+}
+
+// SetCheckDigit sets the check digit (CX-2) and scheme (CX-3) of the identifier (CX-1).
+// The cx must be a pointer to a CX or CK value.
+func SetCheckDigit(cx any, scheme string) error {
+	rv := reflect.ValueOf(cx)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to a data type", cx)
+	}
+	rv = rv.Elem()
+	digit, err := CheckDigit(stringField(rv, 1), scheme)
+	if err != nil {
+		return err
+	}
+	for order, v := range map[int]string{2: digit, 3: scheme} {
+		err := setStringField(rv, order, v)
+		if err != nil {
+			return fmt.Errorf("component %d: %w", order, err)
+		}
+	}
+	return nil
+}
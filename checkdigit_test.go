@@ -0,0 +1,60 @@
+package hl7
+
+import (
+	"errors"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestCheckDigit(t *testing.T) {
+	list := []struct {
+		id, scheme, digit string
+	}{
+		{"12345", SchemeM10, "5"},
+		{"401", SchemeM10, "0"},
+		{"9999", SchemeM10, "4"},
+		{"99999999", SchemeM10, "8"},
+		{"12345", SchemeM11, "5"},
+		{"401", SchemeM11, "4"},
+		{"1234567", SchemeM11, "4"},
+	}
+	for _, item := range list {
+		got, err := CheckDigit(item.id, item.scheme)
+		if err != nil {
+			t.Fatalf("%s %s: %v", item.id, item.scheme, err)
+		}
+		if got != item.digit {
+			t.Errorf("%s %s: got %q, want %q", item.id, item.scheme, got, item.digit)
+		}
+	}
+	for _, item := range [][2]string{{"6", SchemeM11}, {"12A", SchemeM10}, {"", SchemeM10}, {"123", "ISO"}} {
+		if _, err := CheckDigit(item[0], item[1]); err == nil {
+			t.Errorf("%q %s: expected error", item[0], item[1])
+		}
+	}
+	if err := VerifyCheckDigit("12345", "4", SchemeM10); !errors.Is(err, ErrCheckDigit) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestCX(t *testing.T) {
+	cx := &v251.CX{IDNumber: "12345"}
+	err := SetCheckDigit(cx, SchemeM10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cx.CheckDigit != "5" || cx.CheckDigitScheme != SchemeM10 {
+		t.Fatalf("got %+v", cx)
+	}
+	if err := VerifyCX(*cx); err != nil {
+		t.Fatal(err)
+	}
+	cx.IDNumber = "12346"
+	if err := VerifyCX(cx); !errors.Is(err, ErrCheckDigit) {
+		t.Fatalf("got %v", err)
+	}
+	if err := VerifyCX(v251.CX{IDNumber: "MRN-1"}); err != nil {
+		t.Fatalf("without a scheme: %v", err)
+	}
+}
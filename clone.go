@@ -0,0 +1,68 @@
+package hl7
+
+import "reflect"
+
+// Clone returns a deep copy of a decoded trigger, group, segment list, segment, or data type,
+// so a copy may be changed, such as for an outbound transformation, while the original is kept.
+// Slices, maps, pointers, and interface values are copied; nil values stay nil.
+// Unexported fields, such as the location of a time, are shared with the original.
+func Clone[T any](v T) T {
+	rv := reflect.ValueOf(&v).Elem()
+	cloneValue(rv, rv)
+	return v
+}
+
+// cloneValue sets dst, a copy of src, to a deep copy of src.
+func cloneValue(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		p.Elem().Set(src.Elem())
+		cloneValue(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		e := reflect.New(src.Elem().Type()).Elem()
+		e.Set(src.Elem())
+		cloneValue(e, src.Elem())
+		dst.Set(e)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		reflect.Copy(s, src)
+		for i := 0; i < s.Len(); i++ {
+			cloneValue(s.Index(i), src.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			e := reflect.New(iter.Value().Type()).Elem()
+			e.Set(iter.Value())
+			cloneValue(e, iter.Value())
+			m.SetMapIndex(iter.Key(), e)
+		}
+		dst.Set(m)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			cloneValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			if f := dst.Field(i); f.CanSet() {
+				cloneValue(f, src.Field(i))
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestClone(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||123^^^MRN^MR~456||Doe^Jane||19800101`,
+		`OBR|1||X|CBC`,
+		`OBX|1|CWE|WBC||A^^LN`,
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := msg.(v251.ORU_R01)
+	want, err := NewEncoder(nil).Encode(orig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Clone(orig)
+	if !reflect.DeepEqual(c, orig) {
+		t.Fatal("clone is not equal")
+	}
+
+	pr := &c.PatientResult[0]
+	pr.Patient.PID.PatientIdentifierList[1].IDNumber = "789"
+	pr.Patient.PID.DateTimeOfBirth = time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.MSH.SendingApplication.NamespaceID = "RIS"
+	obx := pr.OrderObservation[0].Observation[0].OBX
+	cwe := obx.ObservationValue[0].(v251.CWE)
+	cwe.Identifier = "B"
+	obx.ObservationValue[0] = cwe
+
+	got, err := NewEncoder(nil).Encode(orig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("original changed:\n%s", lineDiff(got, want))
+	}
+
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc := Clone(list)
+	lc[0].(*v251.MSH).SendingApplication.NamespaceID = "RIS"
+	if list[0].(*v251.MSH).SendingApplication.NamespaceID != "LAB" {
+		t.Errorf("segment list changed")
+	}
+
+	var nilSeg *v251.PID
+	if Clone(nilSeg) != nil {
+		t.Errorf("nil clone is not nil")
+	}
+}
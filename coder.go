@@ -30,6 +30,7 @@ const (
 
 type tag struct {
 	Order      int32
+	Len        int
 	Name       string
 	Format     string
 	Type       structType
@@ -40,10 +41,43 @@ type tag struct {
 	FieldSep   bool
 	FieldChars bool
 	Present    bool
+	Usage      usage
+	Table      string
+	DataType   string // HL7 data type, such as NM to check or CWE to resolve an interface field from the registry.
+	Display    string
+}
+
+// usage is the HL7 usage code of a field or component.
+type usage byte
+
+const (
+	usageOptional        usage = iota // O
+	usageRequired                     // R
+	usageRequiredOrEmpty              // RE
+	usageConditional                  // C
+	usageNotSupported                 // X
+)
+
+func (u usage) String() string {
+	switch u {
+	default:
+		return "O"
+	case usageRequired:
+		return "R"
+	case usageRequiredOrEmpty:
+		return "RE"
+	case usageConditional:
+		return "C"
+	case usageNotSupported:
+		return "X"
+	}
 }
 
 const hl7MetaName = "HL7"
 
+// presentName is the field within the meta field that records the number of components present.
+const presentName = "Present"
+
 func parseTag(fieldName, v string) (tag, error) {
 	t := tag{}
 	if len(v) == 0 {
@@ -86,6 +120,9 @@ func parseTag(fieldName, v string) (tag, error) {
 				t.Type = structDataType
 			}
 		case "format":
+			if _, _, err := formatLayout(v); err != nil {
+				return t, fmt.Errorf("field %q: %w", fieldName, err)
+			}
 			t.Format = v
 		case "noescape":
 			t.NoEscape = true
@@ -94,17 +131,38 @@ func parseTag(fieldName, v string) (tag, error) {
 		case "seq":
 			t.Sequence = true
 		case "required":
-			// TODO.
+			t.Usage = usageRequired
 		case "conditional":
-			// TODO.
+			t.Usage = usageConditional
+		case "usage":
+			switch v {
+			default:
+				return t, fmt.Errorf("field %q: unknown usage tag value %q", fieldName, vv)
+			case "O":
+				t.Usage = usageOptional
+			case "R":
+				t.Usage = usageRequired
+			case "RE":
+				t.Usage = usageRequiredOrEmpty
+			case "C":
+				t.Usage = usageConditional
+			case "X":
+				t.Usage = usageNotSupported
+			}
 		case "len":
-			// TODO.
+			i, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return t, fmt.Errorf("field %q: unable to parse tag len: %w", fieldName, err)
+			}
+			t.Len = int(i)
 		case "max":
 			// TODO.
 		case "display":
-			// TODO.
+			t.Display = v
 		case "table":
-			// TODO.
+			t.Table = v
+		case "datatype":
+			t.DataType = v
 		case "fieldsep":
 			t.FieldSep = true
 		case "fieldchars":
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	v25 "github.com/kardianos/hl7/h250"
 	v251 "github.com/kardianos/hl7/h251"
@@ -264,3 +265,223 @@ func TestError(t *testing.T) {
 		})
 	}
 }
+
+func TestKeepEmptyRepeat(t *testing.T) {
+	var raw = []byte(`MSH|^~\&||||||||||2.5.1^^|||||||||
+PID|1||A~~C||^Bob||||||||||||||||||||||||||||||||||`)
+
+	for _, keep := range []bool{false, true} {
+		d := NewDecoder(v251.Registry, &DecodeOption{KeepEmptyRepeat: keep})
+		v, err := d.DecodeList(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pid := v[1].(*v251.PID)
+		want := 2
+		if keep {
+			want = 3
+		}
+		if g := len(pid.PatientIdentifierList); g != want {
+			t.Fatalf("keep=%t: got %d repetitions, want %d", keep, g, want)
+		}
+		if !keep {
+			continue
+		}
+		if id := pid.PatientIdentifierList[2].IDNumber; id != "C" {
+			t.Fatalf("third repetition got %q, want %q", id, "C")
+		}
+
+		e := NewEncoder(nil)
+		bb, err := e.Encode(*pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(bb, []byte("|A~~C|")) {
+			t.Fatalf("empty repetition not preserved on encode: %q", bb)
+		}
+	}
+}
+
+func TestKeepTrailingComponents(t *testing.T) {
+	var raw = []byte(`MSH|^~\&||||||||||2.5.1^^|||||||||
+PID|1||||Smith^^Bob^||||||||||||||||||||||||||||||||||`)
+
+	for _, keep := range []bool{false, true} {
+		d := NewDecoder(v251.Registry, &DecodeOption{KeepTrailingComponents: keep})
+		v, err := d.DecodeList(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pid := v[1].(*v251.PID)
+
+		e := NewEncoder(nil)
+		bb, err := e.Encode(*pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []byte("|Smith^^Bob|")
+		if keep {
+			want = []byte("|Smith^^Bob^|")
+		}
+		if !bytes.Contains(bb, want) {
+			t.Fatalf("keep=%t: got %q, want to contain %q", keep, bb, want)
+		}
+	}
+}
+
+func TestEncodeEscape(t *testing.T) {
+	tests := []struct {
+		name  string
+		sep   string
+		chars string
+		value string
+		want  string
+	}{
+		{"default", "|", `^~\&`, `a|b^c~d\e&f`, `NTE|1||a\F\b\S\c\R\d\E\e\T\f`},
+		{"default empty", "", "", `a|b`, `NTE|1||a\F\b`},
+		{"custom", "#", `@*!%`, `a#b@c*d!e%f|^`, `NTE#1##a!F!b!S!c!R!d!E!e!T!f|^`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := v251.ORU_R01{
+				MSH: &v251.MSH{
+					FieldSeparator:     tt.sep,
+					EncodingCharacters: tt.chars,
+					MessageType:        v251.MSG{MessageCode: "ORU", TriggerEvent: "R01", MessageStructure: "ORU_R01"},
+					MessageControlID:   "1",
+				},
+				PatientResult: []v251.ORU_R01_PatientResult{
+					{
+						OrderObservation: []v251.ORU_R01_OrderObservation{
+							{
+								OBR: &v251.OBR{},
+								NTE: []v251.NTE{
+									{Comment: []v251.FT{tt.value}},
+								},
+							},
+						},
+					},
+				},
+			}
+			e := NewEncoder(&EncodeOption{TrimTrailingSeparator: true})
+			bb, err := e.Encode(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := bytes.Split(bb, []byte{'\r'})
+			var nte []byte
+			for _, l := range lines {
+				if bytes.HasPrefix(l, []byte("NTE")) {
+					nte = l
+				}
+			}
+			if !bytes.Equal(nte, []byte(tt.want)) {
+				t.Fatalf("got %q, want %q", nte, tt.want)
+			}
+
+			sep := tt.sep
+			if len(sep) == 0 {
+				sep = "|"
+			}
+			chars := tt.chars
+			if len(chars) == 0 {
+				chars = `^~\&`
+			}
+			if !bytes.HasPrefix(lines[0], []byte("MSH"+sep+chars+sep)) {
+				t.Fatalf("unexpected MSH delimiters: %q", lines[0])
+			}
+
+			d := NewDecoder(v251.Registry, nil)
+			v, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := v.(v251.ORU_R01).PatientResult[0].OrderObservation[0].NTE[0].Comment[0]
+			if got != tt.value {
+				t.Fatalf("round trip got %q, want %q", got, tt.value)
+			}
+		})
+	}
+}
+
+type testZNL struct {
+	HL7   struct{}   `hl7:",name=ZNL,type=s"`
+	Name  *string    `hl7:"1"`
+	Date  *Date      `hl7:"2"`
+	Code  *v251.CWE  `hl7:"3"`
+	Plain string     `hl7:"4"`
+	Empty *string    `hl7:"5"`
+	When  *time.Time `hl7:"6"`
+}
+
+func TestExplicitNull(t *testing.T) {
+	reg := newTestRegistry(testZNL{})
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZNL|\"\"|\"\"|\"\"|\"\"||\"\"")
+
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZNL)
+	if z.Name == nil || *z.Name != `""` {
+		t.Fatalf("name: got %v, want pointer to the null", z.Name)
+	}
+	if z.Date == nil || !z.Date.Null || !z.Date.IsZero() {
+		t.Fatalf("date: got %v, want the null", z.Date)
+	}
+	if z.Code == nil || *z.Code != (v251.CWE{Identifier: `""`}) {
+		t.Fatalf("code: got %v, want the null in the first component", z.Code)
+	}
+	if z.Plain != `""` {
+		t.Fatalf("plain: got %q", z.Plain)
+	}
+	if z.Empty != nil {
+		t.Fatalf("empty: got %q, want nil", *z.Empty)
+	}
+	if z.When != nil {
+		t.Fatalf("when: got %v, want nil as a time.Time cannot record the null", z.When)
+	}
+
+	bb, err := NewEncoder(nil).Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "ZNL|\"\"|\"\"|\"\"|\"\"||"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	// A pointer to a zero value is not a null.
+	empty := ""
+	bb, err = NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(testZNL{Name: &empty, Date: &Date{}, Code: &v251.CWE{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(bb), "\r"); got != "ZNL" {
+		t.Fatalf("got %q, want ZNL", got)
+	}
+}
+
+func TestEmptyComponentsRoundTrip(t *testing.T) {
+	// PV1-3 is a pointer field; empty components are not a null.
+	raw := "MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1\rPV1|1|I|^^"
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pv1 := list[1].(*v251.PV1)
+	if pv1.AssignedPatientLocation == nil {
+		t.Fatal("PV1-3 not decoded")
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bb), `""`) {
+		t.Fatalf("got %q, empty components encoded as a null", bb)
+	}
+	if !strings.Contains(string(bb), "PV1|1|I") {
+		t.Fatalf("got %q", bb)
+	}
+}
@@ -0,0 +1,207 @@
+package hl7
+
+// Typed values of common coded fields. The generated fields are strings, so convert the field
+// to compare it, such as hl7.Sex(pid.AdministrativeSex) == hl7.SexFemale.
+
+// Sex is an administrative sex (PID-8), from HL7 table 0001.
+type Sex string
+
+const (
+	SexFemale        Sex = "F"
+	SexMale          Sex = "M"
+	SexOther         Sex = "O"
+	SexUnknown       Sex = "U"
+	SexAmbiguous     Sex = "A"
+	SexNotApplicable Sex = "N"
+)
+
+var sexDescription = map[Sex]string{
+	SexFemale:        "Female",
+	SexMale:          "Male",
+	SexOther:         "Other",
+	SexUnknown:       "Unknown",
+	SexAmbiguous:     "Ambiguous",
+	SexNotApplicable: "Not applicable",
+}
+
+// Description returns the table description of the value, or empty if the value is not in the table.
+func (v Sex) Description() string { return sexDescription[v] }
+
+// PatientClass is a patient class (PV1-2), from HL7 table 0004.
+type PatientClass string
+
+const (
+	ClassEmergency         PatientClass = "E"
+	ClassInpatient         PatientClass = "I"
+	ClassOutpatient        PatientClass = "O"
+	ClassPreadmit          PatientClass = "P"
+	ClassRecurring         PatientClass = "R"
+	ClassObstetrics        PatientClass = "B"
+	ClassCommercialAccount PatientClass = "C"
+	ClassNotApplicable     PatientClass = "N"
+	ClassUnknown           PatientClass = "U"
+)
+
+var patientClassDescription = map[PatientClass]string{
+	ClassEmergency:         "Emergency",
+	ClassInpatient:         "Inpatient",
+	ClassOutpatient:        "Outpatient",
+	ClassPreadmit:          "Preadmit",
+	ClassRecurring:         "Recurring patient",
+	ClassObstetrics:        "Obstetrics",
+	ClassCommercialAccount: "Commercial account",
+	ClassNotApplicable:     "Not applicable",
+	ClassUnknown:           "Unknown",
+}
+
+// Description returns the table description of the value, or empty if the value is not in the table.
+func (v PatientClass) Description() string { return patientClassDescription[v] }
+
+// EventType is an event type code (MSH-9.2, EVN-1), from HL7 table 0003.
+type EventType string
+
+const (
+	EventA01 EventType = "A01" // ADT/ACK - Admit/visit notification.
+	EventA02 EventType = "A02" // ADT/ACK - Transfer a patient.
+	EventA03 EventType = "A03" // ADT/ACK - Discharge/end visit.
+	EventA04 EventType = "A04" // ADT/ACK - Register a patient.
+	EventA05 EventType = "A05" // ADT/ACK - Pre-admit a patient.
+	EventA06 EventType = "A06" // ADT/ACK - Change an outpatient to an inpatient.
+	EventA07 EventType = "A07" // ADT/ACK - Change an inpatient to an outpatient.
+	EventA08 EventType = "A08" // ADT/ACK - Update patient information.
+	EventA11 EventType = "A11" // ADT/ACK - Cancel admit/visit notification.
+	EventA12 EventType = "A12" // ADT/ACK - Cancel transfer.
+	EventA13 EventType = "A13" // ADT/ACK - Cancel discharge/end visit.
+	EventA28 EventType = "A28" // ADT/ACK - Add person information.
+	EventA31 EventType = "A31" // ADT/ACK - Update person information.
+	EventA34 EventType = "A34" // ADT/ACK - Merge patient information - patient ID only.
+	EventA40 EventType = "A40" // ADT/ACK - Merge patient - patient identifier list.
+	EventA60 EventType = "A60" // ADT/ACK - Update adverse reaction information.
+	EventO01 EventType = "O01" // ORM - Order message.
+	EventO21 EventType = "O21" // OML - Laboratory order.
+	EventQ22 EventType = "Q22" // QBP - Find candidates.
+	EventR01 EventType = "R01" // ORU/ACK - Unsolicited transmission of an observation message.
+	EventS12 EventType = "S12" // SIU/ACK - Notification of new appointment booking.
+	EventT02 EventType = "T02" // MDM/ACK - Original document notification and content.
+	EventV04 EventType = "V04" // VXU - Unsolicited vaccination record update.
+)
+
+// OrderControl is an order control code (ORC-1), from HL7 table 0119.
+type OrderControl string
+
+const (
+	OrderNew            OrderControl = "NW" // New order/service.
+	OrderOK             OrderControl = "OK" // Order/service accepted & OK.
+	OrderCancel         OrderControl = "CA" // Cancel order/service request.
+	OrderCanceled       OrderControl = "CR" // Canceled as requested.
+	OrderDiscontinue    OrderControl = "DC" // Discontinue order/service request.
+	OrderDiscontinued   OrderControl = "DR" // Discontinued as requested.
+	OrderHold           OrderControl = "HD" // Hold order request.
+	OrderRelease        OrderControl = "RL" // Release previous hold.
+	OrderReplace        OrderControl = "RP" // Order/service replace request.
+	OrderReplaced       OrderControl = "RO" // Replacement order.
+	OrderChange         OrderControl = "XO" // Change order/service request.
+	OrderChanged        OrderControl = "XR" // Changed as requested.
+	OrderStatusChanged  OrderControl = "SC" // Status changed.
+	OrderStatusRequest  OrderControl = "SR" // Status request.
+	OrderResults        OrderControl = "RE" // Observations/performed service to follow.
+	OrderParentOrder    OrderControl = "PA" // Parent order/service.
+	OrderChildOrder     OrderControl = "CH" // Child order/service.
+	OrderUnableToAccept OrderControl = "UA" // Unable to accept order/service.
+	OrderUnableToCancel OrderControl = "UC" // Unable to cancel.
+	OrderNumberAssigned OrderControl = "NA" // Number assigned.
+	OrderReleased       OrderControl = "OR" // Released as requested.
+)
+
+// ResultStatus is an order result status (OBR-25), from HL7 table 0123.
+type ResultStatus string
+
+const (
+	ResultOrderReceived ResultStatus = "O" // Order received; specimen not yet received.
+	ResultNoResults     ResultStatus = "I" // No results available; specimen received, procedure incomplete.
+	ResultScheduled     ResultStatus = "S" // No results available; procedure scheduled, but not done.
+	ResultPreliminary   ResultStatus = "P" // Preliminary.
+	ResultSomeAvailable ResultStatus = "A" // Some, but not all, results available.
+	ResultCorrection    ResultStatus = "C" // Correction to results.
+	ResultFinal         ResultStatus = "F" // Final results; results stored and verified.
+	ResultCanceled      ResultStatus = "X" // No results available; order canceled.
+	ResultNoOrder       ResultStatus = "Y" // No order on record for this test.
+	ResultNoPatient     ResultStatus = "Z" // No record of this patient.
+	ResultStored        ResultStatus = "R" // Results stored; not yet verified.
+)
+
+// Final reports if the results are final or a correction to final results.
+func (v ResultStatus) Final() bool {
+	return v == ResultFinal || v == ResultCorrection
+}
+
+// AllergenType is an allergen type (AL1-2, IAM-2), from HL7 table 0127.
+type AllergenType string
+
+const (
+	AllergenDrug                     AllergenType = "DA"
+	AllergenFood                     AllergenType = "FA"
+	AllergenMiscellaneous            AllergenType = "MA"
+	AllergenMiscContraindication     AllergenType = "MC"
+	AllergenEnvironmental            AllergenType = "EA"
+	AllergenAnimal                   AllergenType = "AA"
+	AllergenPlant                    AllergenType = "PA"
+	AllergenPollen                   AllergenType = "LA"
+	AllergenDrugClass                AllergenType = "DC"
+	AllergenEnvironmentalSensitivity AllergenType = "EI" // Not a true allergy, from v2.6.
+)
+
+var allergenTypeDescription = map[AllergenType]string{
+	AllergenDrug:                     "Drug allergy",
+	AllergenFood:                     "Food allergy",
+	AllergenMiscellaneous:            "Miscellaneous allergy",
+	AllergenMiscContraindication:     "Miscellaneous contraindication",
+	AllergenEnvironmental:            "Environmental allergy",
+	AllergenAnimal:                   "Animal allergy",
+	AllergenPlant:                    "Plant allergy",
+	AllergenPollen:                   "Pollen allergy",
+	AllergenDrugClass:                "Drug class allergy",
+	AllergenEnvironmentalSensitivity: "Environmental sensitivity",
+}
+
+// Description returns the table description of the value, or empty if the value is not in the table.
+func (v AllergenType) Description() string { return allergenTypeDescription[v] }
+
+// AllergySeverity is an allergy severity (AL1-4, IAM-4), from HL7 table 0128.
+type AllergySeverity string
+
+const (
+	SeveritySevere   AllergySeverity = "SV"
+	SeverityModerate AllergySeverity = "MO"
+	SeverityMild     AllergySeverity = "MI"
+	SeverityUnknown  AllergySeverity = "U"
+)
+
+var allergySeverityDescription = map[AllergySeverity]string{
+	SeveritySevere:   "Severe",
+	SeverityModerate: "Moderate",
+	SeverityMild:     "Mild",
+	SeverityUnknown:  "Unknown",
+}
+
+// Description returns the table description of the value, or empty if the value is not in the table.
+func (v AllergySeverity) Description() string { return allergySeverityDescription[v] }
+
+// AllergyAction is the action of an adverse reaction record (IAM-6), from HL7 table 0323.
+type AllergyAction string
+
+const (
+	AllergyAdd      AllergyAction = "A"
+	AllergyDelete   AllergyAction = "D"
+	AllergyUpdate   AllergyAction = "U"
+	AllergyNoChange AllergyAction = "X"
+)
+
+// DiagnosisType is a diagnosis type (DG1-6), from HL7 table 0052.
+type DiagnosisType string
+
+const (
+	DiagnosisAdmitting DiagnosisType = "A"
+	DiagnosisWorking   DiagnosisType = "W"
+	DiagnosisFinal     DiagnosisType = "F"
+)
@@ -0,0 +1,64 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestCodes(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|||||||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|1||123||Doe^Jane||19800101|F`,
+		`PV1|1|I`,
+	}, "\r")
+	v, err := NewDecoder(v251.Registry, nil).Decode([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.ADT_A01)
+	if EventType(msg.MSH.MessageType.TriggerEvent) != EventA01 {
+		t.Errorf("got %q", msg.MSH.MessageType.TriggerEvent)
+	}
+	if sex := Sex(msg.PID.AdministrativeSex); sex != SexFemale || sex.Description() != "Female" {
+		t.Errorf("got %q", sex)
+	}
+	if class := PatientClass(msg.PV1.PatientClass); class != ClassInpatient || class.Description() != "Inpatient" {
+		t.Errorf("got %q", class)
+	}
+	if Sex("Q").Description() != "" {
+		t.Error("expected no description")
+	}
+	if !ResultCorrection.Final() || ResultPreliminary.Final() {
+		t.Error("final results")
+	}
+}
+
+func TestClinicalCodes(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|||||||ADT^A60^ADT_A60|1|P|2.5.1`,
+		`EVN|A60`,
+		`PID|1||123||Doe^Jane||19800101|F`,
+		`IAM|1|DA^Drug allergy^HL70127|7980^Penicillin G^RXNORM|SV^Severe^HL70128|Hives|A^Add^HL70323|AL-100^EHR`,
+	}, "\r")
+	v, err := NewDecoder(v251.Registry, nil).Decode([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.ADT_A60)
+	if len(msg.IAM) != 1 {
+		t.Fatalf("got %d IAM segments", len(msg.IAM))
+	}
+	iam := msg.IAM[0]
+	if at := AllergenType(iam.AllergenTypeCode.Identifier); at != AllergenDrug || at.Description() != "Drug allergy" {
+		t.Errorf("got %q", at)
+	}
+	if sev := AllergySeverity(iam.AllergySeverityCode.Identifier); sev != SeveritySevere || sev.Description() != "Severe" {
+		t.Errorf("got %q", sev)
+	}
+	if AllergyAction(iam.AllergyActionCode.Identifier) != AllergyAdd {
+		t.Errorf("got %q", iam.AllergyActionCode.Identifier)
+	}
+}
@@ -0,0 +1,277 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// The continuation protocol splits a message that is too large into fragments.
+// Each fragment but the last ends with a DSC segment whose continuation pointer (DSC-1)
+// is repeated in the MSH continuation pointer (MSH-14) of the next fragment.
+const (
+	mshControlID           = 10
+	mshContinuationPointer = 14
+	dscContinuationPointer = 1
+)
+
+// Assembler joins message fragments sent with the continuation protocol into a single message
+// that may then be decoded. The zero value is ready to use.
+type Assembler struct {
+	// MaxPending is the number of messages that may wait for more fragments. When full, the message that
+	// has waited longest is discarded, as its remaining fragments may never be sent. If zero, 100.
+	MaxPending int
+
+	pending map[string][][]byte // Segments received so far, by the continuation pointer of the next fragment.
+	order   []string            // Continuation pointers of pending, oldest first.
+}
+
+// Add adds a message fragment. When the fragment completes a message, the whole message is returned.
+// If more fragments are expected, nil is returned. A message that is not fragmented is returned as is.
+func (a *Assembler) Add(fragment []byte) ([]byte, error) {
+	segs := splitSegments(fragment)
+	if len(segs) == 0 || !isSegment(segs[0], "MSH") {
+		return nil, fmt.Errorf("fragment must start with an MSH segment")
+	}
+	sep := segs[0][3]
+	ptr := rawField(segs[0], sep, mshContinuationPointer)
+
+	var next string
+	last := segs[len(segs)-1]
+	more := isSegment(last, "DSC")
+	if more {
+		next = rawField(last, last[3], dscContinuationPointer)
+		if len(next) == 0 {
+			return nil, fmt.Errorf("DSC segment missing continuation pointer")
+		}
+		segs = segs[:len(segs)-1]
+		// Copy the segments that are kept, as the caller may reuse the fragment buffer.
+		for i, seg := range segs {
+			segs[i] = append([]byte(nil), seg...)
+		}
+	}
+
+	if len(ptr) > 0 {
+		prev, ok := a.pending[ptr]
+		if !ok {
+			return nil, fmt.Errorf("no message fragment for continuation pointer %q", ptr)
+		}
+		a.remove(ptr)
+		segs = append(prev, segs[1:]...)
+	}
+	if !more {
+		return joinSegments(segs), nil
+	}
+	if _, ok := a.pending[next]; ok {
+		return nil, fmt.Errorf("duplicate continuation pointer %q", next)
+	}
+	if a.pending == nil {
+		a.pending = map[string][][]byte{}
+	}
+	max := a.MaxPending
+	if max <= 0 {
+		max = 100
+	}
+	for len(a.order) >= max {
+		a.remove(a.order[0])
+	}
+	a.pending[next] = segs
+	a.order = append(a.order, next)
+	return nil, nil
+}
+
+// remove removes the pending message waiting for the continuation pointer.
+func (a *Assembler) remove(ptr string) {
+	delete(a.pending, ptr)
+	for i, p := range a.order {
+		if p == ptr {
+			a.order = append(a.order[:i], a.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Pending returns the number of messages waiting for more fragments.
+func (a *Assembler) Pending() int {
+	return len(a.pending)
+}
+
+// FragmentOption represents options for Fragment.
+type FragmentOption struct {
+	// MaxLength is the maximum length in bytes of each fragment, including segment terminators.
+	MaxLength int
+
+	// Pointer returns the continuation pointer of fragment n, starting at 2, for a message with the control ID.
+	// If nil, the pointer is the control ID followed by a dash and n.
+	Pointer func(controlID string, n int) string
+
+	// ControlID returns the control ID (MSH-10) of fragment n, starting at 2, for a message with the control ID.
+	// If nil, the control ID of a fragment is its continuation pointer.
+	ControlID func(controlID string, n int) string
+}
+
+// Fragment splits an encoded message at segment boundaries into fragments no longer than the maximum length,
+// using the continuation protocol. Each fragment after the first starts with a copy of the MSH segment,
+// with its own control ID, so the ACK of each fragment may be matched by MSA-2, and the continuation pointer
+// in MSH-14 that links it to the DSC segment ending the fragment before it.
+// A message that fits is returned as the only fragment.
+func Fragment(message []byte, opt *FragmentOption) ([][]byte, error) {
+	if opt == nil || opt.MaxLength <= 0 {
+		return nil, fmt.Errorf("fragment max length must be set")
+	}
+	segs := splitSegments(message)
+	if len(segs) == 0 || !isSegment(segs[0], "MSH") {
+		return nil, fmt.Errorf("message must start with an MSH segment")
+	}
+	msh := segs[0]
+	sep := msh[3]
+	controlID := rawField(msh, sep, mshControlID)
+	pointer := opt.Pointer
+	if pointer == nil {
+		pointer = func(controlID string, n int) string {
+			return controlID + "-" + strconv.Itoa(n)
+		}
+	}
+	fragmentID := opt.ControlID
+	if fragmentID == nil {
+		fragmentID = pointer
+	}
+	// header returns the MSH segment of fragment n.
+	header := func(n int) []byte {
+		h := setRawField(msh, sep, mshControlID, fragmentID(controlID, n))
+		return setRawField(h, sep, mshContinuationPointer, pointer(controlID, n))
+	}
+
+	dsc := func(ptr string) []byte {
+		return []byte("DSC" + string(sep) + ptr + string(sep) + "F")
+	}
+
+	var ret [][]byte
+	n := 1
+	cur := [][]byte{msh}
+	size := len(msh) + 1
+	for i, seg := range segs[1:] {
+		need := len(seg) + 1
+		if i < len(segs)-2 {
+			// Leave room for the DSC segment that may end this fragment.
+			need += len(dsc(pointer(controlID, n+1))) + 1
+		}
+		// The segment must fit after the header of a continuation fragment.
+		if len(header(n+1))+1+need > opt.MaxLength {
+			return nil, fmt.Errorf("segment %d %s length %d does not fit in a fragment of %d", i+2, seg[:3], len(seg), opt.MaxLength)
+		}
+		if size+need <= opt.MaxLength {
+			cur = append(cur, seg)
+			size += len(seg) + 1
+			continue
+		}
+		n++
+		ptr := pointer(controlID, n)
+		ret = append(ret, joinSegments(append(cur, dsc(ptr))))
+
+		next := header(n)
+		cur = [][]byte{next, seg}
+		size = len(next) + 1 + len(seg) + 1
+	}
+	ret = append(ret, joinSegments(cur))
+	return ret, nil
+}
+
+// joinAddenda appends the data of each ADD segment to the segment it continues, and
+// replaces the ADD segment with an empty line to keep the line numbers.
+// An over-length segment may be continued in one or more ADD segments that directly follow it.
+func joinAddenda(lines [][]byte) {
+	prev := -1
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		if !isSegment(line, "ADD") || prev < 0 {
+			prev = i
+			continue
+		}
+		// Copy, as the line refers to the original data which is followed by this segment.
+		joined := make([]byte, 0, len(lines[prev])+len(line)-4)
+		joined = append(joined, lines[prev]...)
+		lines[prev] = append(joined, line[4:]...)
+		lines[i] = nil
+	}
+}
+
+// addenda splits segments longer than the MaxSegmentLength option into ADD segments.
+func (e *Encoder) addenda(data []byte) ([]byte, error) {
+	max := e.opt.MaxSegmentLength
+	if max <= 0 {
+		return data, nil
+	}
+	if max <= 4 {
+		return nil, fmt.Errorf("max segment length %d is too short for an ADD segment", max)
+	}
+	segs := bytes.SplitAfter(data, []byte{nextLine})
+	var sep byte = '|'
+	out := make([]byte, 0, len(data))
+	for _, seg := range segs {
+		line := bytes.TrimSuffix(seg, []byte{nextLine})
+		if isHeader(line) {
+			sep = line[3]
+		}
+		if len(line) <= max || isHeader(line) {
+			out = append(out, seg...)
+			continue
+		}
+		out = append(out, line[:max]...)
+		for rest := line[max:]; len(rest) > 0; {
+			n := len(rest)
+			if n > max-4 {
+				n = max - 4
+			}
+			out = append(out, nextLine, 'A', 'D', 'D', sep)
+			out = append(out, rest[:n]...)
+			rest = rest[n:]
+		}
+		out = append(out, seg[len(line):]...)
+	}
+	return out, nil
+}
+
+// splitSegments returns the non-empty segments in data.
+func splitSegments(data []byte) [][]byte {
+	return bytes.FieldsFunc(data, func(r rune) bool {
+		return r == '\r' || r == '\n'
+	})
+}
+
+// joinSegments returns the segments each terminated by a CR.
+func joinSegments(segs [][]byte) []byte {
+	return append(bytes.Join(segs, []byte{nextLine}), nextLine)
+}
+
+// rawField returns the unparsed field of an encoded segment, such as MSH-10.
+// Header segments are numbered from the field separator, as with MSH-1.
+func rawField(seg []byte, sep byte, n int) string {
+	parts := bytes.Split(seg, []byte{sep})
+	if isHeader(seg) {
+		n-- // The field separator is MSH-1.
+	}
+	if n <= 0 || n >= len(parts) {
+		return ""
+	}
+	return string(parts[n])
+}
+
+// setRawField returns a copy of the encoded segment with field n set to the value.
+func setRawField(seg []byte, sep byte, n int, value string) []byte {
+	parts := bytes.Split(seg, []byte{sep})
+	if isHeader(seg) {
+		n--
+	}
+	for len(parts) <= n {
+		parts = append(parts, nil)
+	}
+	parts[n] = []byte(value)
+	return bytes.Join(parts, []byte{sep})
+}
+
+func isHeader(seg []byte) bool {
+	return isSegment(seg, "MSH") || isSegment(seg, "BHS") || isSegment(seg, "FHS")
+}
@@ -0,0 +1,148 @@
+package hl7
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+const testContinuationMessage = "MSH|^~\\&|||||||ORU^R01^ORU_R01|CTRL1|P|2.5.1\r" +
+	"PID|1||123\r" +
+	"OBR|1\r" +
+	"OBX|1|ST|A||first value\r" +
+	"OBX|2|ST|B||second value\r" +
+	"OBX|3|ST|C||third value\r"
+
+func TestFragmentAssemble(t *testing.T) {
+	frags, err := Fragment([]byte(testContinuationMessage), &FragmentOption{MaxLength: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frags) < 2 {
+		t.Fatalf("got %d fragments", len(frags))
+	}
+	ids := map[string]bool{}
+	for i, f := range frags {
+		if len(f) > 100 {
+			t.Errorf("fragment %d length %d: %q", i+1, len(f), f)
+		}
+		ids[rawField(f, '|', mshControlID)] = true
+	}
+	if len(ids) != len(frags) || !ids["CTRL1"] {
+		t.Errorf("fragment control IDs are not unique: %v", ids)
+	}
+	if !bytes.Contains(frags[0], []byte("\rDSC|CTRL1-2|F\r")) {
+		t.Fatalf("first fragment missing DSC: %q", frags[0])
+	}
+	if !bytes.HasPrefix(frags[1], []byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|CTRL1-2|P|2.5.1||CTRL1-2\r")) {
+		t.Fatalf("second fragment missing its control ID or continuation pointer: %q", frags[1])
+	}
+
+	// The fragments are added from a reused buffer.
+	a := &Assembler{}
+	var msg []byte
+	buf := make([]byte, 0, 200)
+	for i, f := range frags {
+		for j := range buf {
+			buf[j] = 'X'
+		}
+		buf = append(buf[:0], f...)
+		msg, err = a.Add(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last := i == len(frags)-1; last != (msg != nil) {
+			t.Fatalf("fragment %d: got message %q", i+1, msg)
+		}
+	}
+	if a.Pending() != 0 {
+		t.Fatalf("got %d pending", a.Pending())
+	}
+	if string(msg) != testContinuationMessage {
+		t.Fatalf("got %q", msg)
+	}
+	if _, err := NewDecoder(v251.Registry, nil).Decode(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	single, err := Fragment([]byte(testContinuationMessage), &FragmentOption{MaxLength: 1000})
+	if err != nil || len(single) != 1 {
+		t.Fatalf("got %d fragments, %v", len(single), err)
+	}
+	if msg, err := a.Add(single[0]); err != nil || string(msg) != testContinuationMessage {
+		t.Fatalf("got %q, %v", msg, err)
+	}
+}
+
+func TestFragmentErrors(t *testing.T) {
+	if _, err := Fragment([]byte(testContinuationMessage), &FragmentOption{MaxLength: 60}); err == nil || !strings.Contains(err.Error(), "does not fit") {
+		t.Fatalf("expected fit error, got %v", err)
+	}
+	a := &Assembler{}
+	if _, err := a.Add([]byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|CTRL1|P|2.5.1||X\rOBX|1")); err == nil || !strings.Contains(err.Error(), `continuation pointer "X"`) {
+		t.Fatalf("expected unknown pointer error, got %v", err)
+	}
+	if _, err := a.Add([]byte("PID|1")); err == nil {
+		t.Fatal("expected MSH error")
+	}
+
+	// The message that has waited longest is discarded when too many are pending.
+	a = &Assembler{MaxPending: 1}
+	for _, id := range []string{"A", "B"} {
+		if msg, err := a.Add([]byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|" + id + "|P|2.5.1\rPID|1\rDSC|" + id + "-2|F")); err != nil || msg != nil {
+			t.Fatalf("got %q, %v", msg, err)
+		}
+	}
+	if a.Pending() != 1 {
+		t.Fatalf("got %d pending", a.Pending())
+	}
+	if _, err := a.Add([]byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|A-2|P|2.5.1||A-2\rOBR|1")); err == nil {
+		t.Fatal("expected the discarded message to be unknown")
+	}
+	if msg, err := a.Add([]byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|B-2|P|2.5.1||B-2\rOBR|1")); err != nil || !strings.Contains(string(msg), "|B|") {
+		t.Fatalf("got %q, %v", msg, err)
+	}
+}
+
+func TestAddSegment(t *testing.T) {
+	raw := "MSH|^~\\&|||||||ORU^R01^ORU_R01|CTRL1|P|2.5.1\r" +
+		"PID|1||123\r" +
+		"OBR|1\r" +
+		"OBX|1|ST|A||a long val\rADD|ue that continues\rADD||||N\r"
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 4 {
+		t.Fatalf("got %d segments", len(list))
+	}
+	obx := list[3].(*v251.OBX)
+	if got := obx.ObservationValue[0].(v251.ST); got != "a long value that continues" || obx.AbnormalFlags[0] != "N" {
+		t.Fatalf("got %q %q", got, obx.AbnormalFlags)
+	}
+
+	msg, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true, MaxSegmentLength: 16}).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|||||||ORU^R01^ORU_R01|CTRL1|P|2.5.1\r" +
+		"PID|1||123\r" +
+		"OBR|1\r" +
+		"OBX|1|ST|A||a lo\rADD|ng value tha\rADD|t continues|\rADD|||N"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q", got)
+	}
+	again, err := NewDecoder(v251.Registry, nil).DecodeList(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := again[3].(*v251.OBX).ObservationValue[0].(v251.ST); got != "a long value that continues" {
+		t.Fatalf("got %q", got)
+	}
+}
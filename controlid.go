@@ -0,0 +1,73 @@
+package hl7
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ControlIDGenerator returns unique message control IDs (MSH-10) for outbound messages.
+type ControlIDGenerator interface {
+	ControlID() (string, error)
+}
+
+// ControlIDFunc is a function that implements ControlIDGenerator.
+type ControlIDFunc func() (string, error)
+
+func (f ControlIDFunc) ControlID() (string, error) {
+	return f()
+}
+
+// SequenceControlID generates control IDs of the time to the second in UTC followed by a six digit sequence,
+// such as 20240102030405000001, which fit the 20 characters MSH-10 allows before version 2.7.
+// IDs increase and are unique for one generator, even if the clock moves back or more than a million
+// are generated in a second. Set a Prefix, such as the instance number, where many generators send
+// as the same application.
+type SequenceControlID struct {
+	// Prefix is written before each ID.
+	Prefix string
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	mu   sync.Mutex
+	last time.Time
+	seq  int
+}
+
+// ControlID returns the next control ID.
+func (g *SequenceControlID) ControlID() (string, error) {
+	now := time.Now
+	if g.Now != nil {
+		now = g.Now
+	}
+	t := now().UTC().Truncate(time.Second)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case t.After(g.last):
+		g.last = t
+		g.seq = 0
+	case g.seq == 999999:
+		g.last = g.last.Add(time.Second)
+		g.seq = 0
+	default:
+		g.seq++
+	}
+	return fmt.Sprintf("%s%s%06d", g.Prefix, g.last.Format("20060102150405"), g.seq), nil
+}
+
+// UUIDControlID generates random (version 4) UUIDs, such as 3b241101-e2bb-4255-8caf-4136c566a962.
+// A UUID is 36 characters, longer than the 20 characters MSH-10 allows before version 2.7.
+var UUIDControlID ControlIDGenerator = ControlIDFunc(func() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("control ID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40 // Version 4.
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant.
+	h := hex.EncodeToString(b[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:], nil
+})
@@ -0,0 +1,53 @@
+package hl7
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSequenceControlID(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	g := &SequenceControlID{Now: func() time.Time { return now }}
+	next := func() string {
+		t.Helper()
+		id, err := g.ControlID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	for _, want := range []string{"20240102030405000000", "20240102030405000001"} {
+		if got := next(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+	now = now.Add(-time.Minute)
+	if got := next(); got != "20240102030405000002" {
+		t.Errorf("clock moved back: got %s", got)
+	}
+	g.seq = 999999
+	if got := next(); got != "20240102030406000000" {
+		t.Errorf("sequence full: got %s", got)
+	}
+	now = time.Date(2024, 1, 2, 3, 5, 0, 0, time.FixedZone("", -5*3600))
+	g.Prefix = "A"
+	if got := next(); got != "A20240102080500000000" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestUUIDControlID(t *testing.T) {
+	re := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id, err := UUIDControlID.ControlID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !re.MatchString(id) || seen[id] {
+			t.Fatalf("got %s", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,157 @@
+package hl7
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Column is a CSV column and the HL7 path of its value, such as {Name: "MRN", Path: "PID-3.1"}.
+// Paths are written as for EncodeJSON; a path to a field or component with parts, such as PID-3,
+// is the value of its first component.
+type Column struct {
+	Name string
+	Path string
+}
+
+// CSVOption sets the rows written by a CSVWriter.
+type CSVOption struct {
+	// Row is a segment, such as OBX, to write a row for each of its segments in a message.
+	// A column path of the segment, such as OBX-5, is the value of the segment of that row;
+	// other paths are the values of the message, such as PID-3.1.
+	// If empty, a row is written for each message.
+	Row string
+
+	// Join separates the values of each repetition, "~" if empty.
+	Join string
+
+	// NoHeader does not write the column names as the first row.
+	NoHeader bool
+
+	// Encode sets how values, such as date times, are written. May be nil.
+	Encode *EncodeOption
+}
+
+// CSVWriter writes selected values of messages as CSV rows, for analytics extracts of large message archives.
+// Use with ProcessBatch to write each message of a batch file.
+type CSVWriter struct {
+	w       *csv.Writer
+	columns []Column
+	opt     CSVOption
+	e       *Encoder
+	header  bool
+}
+
+// NewCSVWriter returns a CSVWriter that writes the columns to w. Option may be nil.
+func NewCSVWriter(w io.Writer, columns []Column, opt *CSVOption) *CSVWriter {
+	c := &CSVWriter{
+		w:       csv.NewWriter(w),
+		columns: columns,
+	}
+	if opt != nil {
+		c.opt = *opt
+	}
+	if len(c.opt.Join) == 0 {
+		c.opt.Join = "~"
+	}
+	c.e = NewEncoder(c.opt.Encode)
+	return c
+}
+
+// Write writes the rows of the message, a trigger or segment list.
+// The column names are written before the first row.
+func (c *CSVWriter) Write(message any) error {
+	if !c.header {
+		if err := c.check(); err != nil {
+			return err
+		}
+		c.header = true
+		if !c.opt.NoHeader {
+			names := make([]string, len(c.columns))
+			for i, col := range c.columns {
+				names[i] = col.Name
+			}
+			if err := c.w.Write(names); err != nil {
+				return err
+			}
+		}
+	}
+	root, err := c.e.tree(message)
+	if err != nil {
+		return err
+	}
+	values := map[string][]string{}
+	for _, pv := range treePaths(root) {
+		values[pv.path] = pv.values
+	}
+	if len(c.opt.Row) == 0 {
+		return c.w.Write(c.row(values, 0))
+	}
+	for n := 1; n <= segmentCount(root, c.opt.Row); n++ {
+		if err := c.w.Write(c.row(values, n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered rows and returns any write error.
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// check returns an error for an invalid row segment or column path.
+func (c *CSVWriter) check() error {
+	if len(c.columns) == 0 {
+		return fmt.Errorf("csv: no columns")
+	}
+	if r := c.opt.Row; len(r) > 0 && len(r) != 3 {
+		return fmt.Errorf("csv: row %q is not a segment", r)
+	}
+	for _, col := range c.columns {
+		if _, _, err := parsePath(col.Path); err != nil {
+			return fmt.Errorf("csv: column %q: %w", col.Name, err)
+		}
+	}
+	return nil
+}
+
+// row returns the column values of the message, and of the nth row segment if n is set.
+func (c *CSVWriter) row(values map[string][]string, n int) []string {
+	row := make([]string, len(c.columns))
+	for i, col := range c.columns {
+		path := col.Path
+		if n > 1 && strings.HasPrefix(path, c.opt.Row+"-") {
+			path = c.opt.Row + "[" + strconv.Itoa(n) + "]" + path[len(c.opt.Row):]
+		}
+		// A field or component with parts has the value of its first part.
+		v, ok := values[path]
+		for part := 0; !ok && part < 2; part++ {
+			path += ".1"
+			v, ok = values[path]
+		}
+		row[i] = strings.Join(v, c.opt.Join)
+	}
+	return row
+}
+
+// segmentCount returns the number of segments with the name in the message tree.
+func segmentCount(root node, name string) int {
+	count := 0
+	var walk func(nodes []node)
+	walk = func(nodes []node) {
+		for _, n := range nodes {
+			switch {
+			case strings.Contains(n.name(), "."):
+				walk(n.Nodes)
+			case n.name() == name:
+				count++
+			}
+		}
+	}
+	walk(root.Nodes)
+	return count
+}
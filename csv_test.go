@@ -0,0 +1,96 @@
+package hl7
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestCSVWriter(t *testing.T) {
+	messages := []string{
+		strings.Join([]string{
+			`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+			`PID|||123^^^MRN^MR~456||Doe^Jane`,
+			`OBR|1|A1||CBC`,
+			`OBX|1|NM|WBC^White cells^L||7.2|10*3/uL|||||F`,
+			`OBX|2|NM|RBC^Red cells^L||4.5|10*6/uL|||||F`,
+		}, "\r"),
+		strings.Join([]string{
+			`MSH|^~\&|LAB|HOSP|||20240103030405||ORU^R01^ORU_R01|2|P|2.5.1`,
+			`PID|||789^^^MRN^MR||Roe, Jr^John`,
+			`OBR|1|A2||CBC`,
+			`OBX|1|ST|NOTE^Note^L||Hemolyzed||||||F`,
+		}, "\r"),
+	}
+	d := NewDecoder(v251.Registry, nil)
+	columns := []Column{
+		{Name: "control", Path: "MSH-10"},
+		{Name: "mrn", Path: "PID-3"},
+		{Name: "family", Path: "PID-5.1"},
+		{Name: "code", Path: "OBX-3.1"},
+		{Name: "value", Path: "OBX-5"},
+		{Name: "units", Path: "OBX-6"},
+	}
+
+	buf := &bytes.Buffer{}
+	w := NewCSVWriter(buf, columns, &CSVOption{Row: "OBX", Join: "|"})
+	for _, m := range messages {
+		msg, err := d.Decode([]byte(m))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`control,mrn,family,code,value,units`,
+		`1,123|456,Doe,WBC,7.2,10*3/uL`,
+		`1,123|456,Doe,RBC,4.5,10*6/uL`,
+		`2,789,"Roe, Jr",NOTE,Hemolyzed,`,
+		``,
+	}, "\n")
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf, want)
+	}
+
+	buf.Reset()
+	w = NewCSVWriter(buf, columns[:3], &CSVOption{NoHeader: true})
+	for _, m := range messages {
+		list, err := d.DecodeList([]byte(m))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(list); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "1,123~456,Doe\n2,789,\"Roe, Jr\"\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf, want)
+	}
+
+	msg, err := d.Decode([]byte(messages[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, bad := range []struct {
+		columns []Column
+		opt     *CSVOption
+	}{
+		{nil, nil},
+		{[]Column{{Name: "x", Path: "PID"}}, &CSVOption{Row: "OBXX"}},
+		{[]Column{{Name: "x", Path: "PID-0"}}, nil},
+	} {
+		if err := NewCSVWriter(&bytes.Buffer{}, bad.columns, bad.opt).Write(msg); err == nil {
+			t.Errorf("expected an error for %+v", bad)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package hl7
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// DateShiftOption sets the offsets of a DateShifter.
+type DateShiftOption struct {
+	// MaxDays is the largest offset in days, earlier or later, 365 if zero.
+	MaxDays int
+
+	// Patient returns the key of the patient of a message, whose offset is used for each date of the message.
+	// If nil, it is the first identifier of PID-3 with its assigning authority, such as 123^MRN.
+	Patient func(message any) (string, error)
+}
+
+// DateShifter de-identifies decoded messages by shifting each date and time by a number of days
+// set by the patient, so the intervals between the dates of a patient are kept across a message stream.
+// The offset of a patient is from a keyed hash (HMAC-SHA256) of the patient key and cannot be found without the key.
+// A DateShifter may be used by multiple goroutines.
+type DateShifter struct {
+	key []byte
+	opt DateShiftOption
+}
+
+// NewDateShifter returns a DateShifter with the secret key, which should be at least 32 random bytes.
+// Option may be nil.
+func NewDateShifter(key []byte, opt *DateShiftOption) *DateShifter {
+	s := &DateShifter{key: append([]byte{}, key...)}
+	if opt != nil {
+		s.opt = *opt
+	}
+	if s.opt.MaxDays <= 0 {
+		s.opt.MaxDays = 365
+	}
+	if s.opt.Patient == nil {
+		s.opt.Patient = patientKey
+	}
+	return s
+}
+
+// Offset returns the offset in days of the patient, from -MaxDays to MaxDays and never zero.
+func (s *DateShifter) Offset(patient string) int {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(patient))
+	n := binary.BigEndian.Uint64(mac.Sum(nil))
+	days := int(n>>1%uint64(s.opt.MaxDays)) + 1
+	if n&1 == 1 {
+		days = -days
+	}
+	return days
+}
+
+// Shift returns a Clone of the decoded trigger or segment list with each date and time shifted by the offset
+// of its patient. A Date or DateTime sent with only a year or month is kept, as is a time of day.
+// Dates sent in text, such as NTE-3, are not shifted.
+func (s *DateShifter) Shift(message any) (any, error) {
+	if message == nil {
+		return nil, fmt.Errorf("date shift: no message")
+	}
+	patient, err := s.opt.Patient(message)
+	if err != nil {
+		return nil, fmt.Errorf("date shift: %w", err)
+	}
+	days := s.Offset(patient)
+	// The copy is addressable so the fields of its segments may be set.
+	rv := reflect.New(reflect.TypeOf(message)).Elem()
+	rv.Set(reflect.ValueOf(Clone(message)))
+	shiftValue(rv, days)
+	return rv.Interface(), nil
+}
+
+// patientKey returns the first identifier of PID-3 with its assigning authority.
+func patientKey(message any) (string, error) {
+	pid, ok := findSegment(message, "PID")
+	if !ok {
+		return "", fmt.Errorf("missing PID segment")
+	}
+	var key string
+	_, fv, _, ok := fieldByOrder(pid, 3)
+	if ok {
+		repetitions(fv, func(rv reflect.Value) error {
+			if len(key) == 0 && rv.Kind() == reflect.Struct {
+				if id := stringComponent(rv, 1); len(id) > 0 {
+					key = id + "^" + stringComponent(rv, 4)
+				}
+			}
+			return nil
+		})
+	}
+	if len(key) == 0 {
+		return "", fmt.Errorf("missing patient identifier in PID-3")
+	}
+	return key, nil
+}
+
+var (
+	dateType     = reflect.TypeOf(Date{})
+	dateTimeType = reflect.TypeOf(DateTime{})
+	timeOfDay    = reflect.TypeOf(Time{})
+)
+
+// shiftValue shifts each date and time within the value by the days.
+func shiftValue(rv reflect.Value, days int) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			shiftValue(rv.Elem(), days)
+		}
+	case reflect.Interface:
+		if rv.IsNil() {
+			return
+		}
+		e := reflect.New(rv.Elem().Type()).Elem()
+		e.Set(rv.Elem())
+		shiftValue(e, days)
+		rv.Set(e)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			shiftValue(rv.Index(i), days)
+		}
+	case reflect.Struct:
+		switch rv.Type() {
+		case timeType:
+			if t := rv.Interface().(time.Time); !t.IsZero() {
+				rv.Set(reflect.ValueOf(t.AddDate(0, 0, days)))
+			}
+			return
+		case timeOfDay:
+			return
+		case dateType, dateTimeType:
+			p := Precision(rv.FieldByName("Precision").Uint())
+			if p == PrecisionYear || p == PrecisionMonth {
+				return
+			}
+			shiftValue(rv.FieldByName("Time"), days)
+			return
+		}
+		for i := 0; i < rv.NumField(); i++ {
+			if f := rv.Field(i); f.CanSet() {
+				shiftValue(f, days)
+			}
+		}
+	}
+}
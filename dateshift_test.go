@@ -0,0 +1,66 @@
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestDateShifter(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|A|B|||20240102030405||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01|20240102030405`,
+		`PID|1||123^^^MRN||Doe^Jane||19800101`,
+		"PV1||I" + strings.Repeat("|", 42) + "20240101120000|20240105120000",
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewDateShifter([]byte("0123456789abcdef0123456789abcdef"), &DateShiftOption{MaxDays: 30})
+	days := s.Offset("123^MRN")
+	if days == 0 || days < -30 || days > 30 {
+		t.Fatalf("got offset %d", days)
+	}
+	got, err := s.Shift(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adt, orig := got.(v251.ADT_A01), msg.(v251.ADT_A01)
+	if want := orig.PID.DateTimeOfBirth.AddDate(0, 0, days); !adt.PID.DateTimeOfBirth.Equal(want) {
+		t.Errorf("got birth %v, want %v", adt.PID.DateTimeOfBirth, want)
+	}
+	if want := orig.EVN.RecordedDateTime.AddDate(0, 0, days); !adt.EVN.RecordedDateTime.Equal(want) {
+		t.Errorf("got recorded %v, want %v", adt.EVN.RecordedDateTime, want)
+	}
+	if a, b := adt.PV1.AdmitDateTime, adt.PV1.DischargeDateTime; len(b) != 1 || b[0].Sub(a) != 96*time.Hour || a.Equal(orig.PV1.AdmitDateTime) {
+		t.Errorf("got stay from %v to %v", a, b)
+	}
+	if orig.PID.DateTimeOfBirth.Year() != 1980 || orig.PID.DateTimeOfBirth.YearDay() != 1 {
+		t.Errorf("original changed: %v", orig.PID.DateTimeOfBirth)
+	}
+
+	type partial struct {
+		Year Date
+		Day  *DateTime
+		TM   Time
+	}
+	base := time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC)
+	p := partial{
+		Year: Date{Time: base, Precision: PrecisionYear},
+		Day:  &DateTime{Time: base, Precision: PrecisionDay},
+		TM:   Time{Time: base},
+	}
+	shiftValue(reflect.ValueOf(&p), 10)
+	if !p.Year.Time.Equal(base) || !p.Day.Time.Equal(base.AddDate(0, 0, 10)) || !p.TM.Time.Equal(base) {
+		t.Errorf("got %+v %+v", p, p.Day)
+	}
+
+	if _, err := s.Shift([]any{orig.MSH}); err == nil {
+		t.Errorf("expected an error for a message without a patient")
+	}
+}
@@ -0,0 +1,566 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Precision is the degree of precision of a DT or DTM value.
+type Precision byte
+
+// Degrees of precision, from least to most precise.
+const (
+	PrecisionDefault  Precision = iota // Not recorded; a Date to the day, a DateTime to the second.
+	PrecisionYear                      // YYYY
+	PrecisionMonth                     // YYYYMM
+	PrecisionDay                       // YYYYMMDD
+	PrecisionHour                      // YYYYMMDDHH
+	PrecisionMinute                    // YYYYMMDDHHMM
+	PrecisionSecond                    // YYYYMMDDHHMMSS
+	PrecisionFraction                  // YYYYMMDDHHMMSS.S[S[S[S]]]
+)
+
+// Date is an HL7 DT value: YYYY[MM[DD]].
+type Date struct {
+	time.Time
+
+	// Precision is set when parsed to the precision supplied.
+	// An unknown month or day is encoded as it was received.
+	Precision Precision
+
+	Null bool // The explicit null "", which clears the value in the receiver.
+}
+
+// Time is an HL7 TM value: HH[MM[SS[.S[S[S[S]]]]]][+/-ZZZZ].
+// The date portion is always January 1, year 0.
+type Time struct {
+	time.Time
+
+	Null bool // The explicit null "", which clears the value in the receiver.
+}
+
+// DateTime is an HL7 DTM value: YYYY[MM[DD[HH[MM[SS[.S[S[S[S]]]]]]]]][+/-ZZZZ].
+//
+// When parsed, the precision and presence of an offset are recorded so the
+// value encodes as it was received: 202401 stays 202401.
+// A DateTime with PrecisionDefault encodes to the second and includes the
+// offset if the location is not UTC.
+type DateTime struct {
+	time.Time
+
+	Precision      Precision
+	FractionDigits int  // Number of fractional second digits for PrecisionFraction, 1 to 9.
+	Offset         bool // Encode the +/-ZZZZ offset.
+	Null           bool // The explicit null "", which clears the value in the receiver.
+}
+
+// valueParser is implemented by primitive types that decode from the field text.
+// The options may be nil.
+type valueParser interface {
+	parseHL7(v string, opt *DecodeOption) error
+}
+
+// valueFormatter is implemented by primitive types that encode to the field text.
+type valueFormatter interface {
+	IsZero() bool
+	formatHL7(t tag, opt *EncodeOption) string
+}
+
+// nullable is implemented by primitive types that record the explicit null.
+type nullable interface {
+	IsNull() bool
+}
+
+var (
+	_ valueParser    = &Date{}
+	_ valueParser    = &Time{}
+	_ valueParser    = &DateTime{}
+	_ valueFormatter = Date{}
+	_ valueFormatter = Time{}
+	_ valueFormatter = DateTime{}
+)
+
+// ParseDate parses an HL7 DT value.
+func ParseDate(v string) (Date, error) {
+	var d Date
+	err := d.parseHL7(v, nil)
+	return d, err
+}
+
+// ParseTime parses an HL7 TM value.
+func ParseTime(v string) (Time, error) {
+	var t Time
+	err := t.parseHL7(v, nil)
+	return t, err
+}
+
+// ParseDateTime parses an HL7 DTM or TS value.
+func ParseDateTime(v string) (DateTime, error) {
+	var dt DateTime
+	err := dt.parseHL7(v, nil)
+	return dt, err
+}
+
+func (d *Date) parseHL7(v string, opt *DecodeOption) error {
+	if v == nullValue {
+		*d = Date{Null: true}
+		return nil
+	}
+	v, _, _ = strings.Cut(v, "^")
+	if len(v) == 0 {
+		d.Time = time.Time{}
+		return nil
+	}
+	switch len(v) {
+	default:
+		return fmt.Errorf("invalid date %q: must be YYYY[MM[DD]]", v)
+	case 4, 6, 8:
+	}
+	if !isDigits(v) {
+		return fmt.Errorf("invalid characters in date: %q", v)
+	}
+	dt, err := parseDTM(v, opt)
+	if err != nil {
+		return err
+	}
+	d.Time = dt.Time
+	d.Precision = dt.Precision
+	return nil
+}
+
+func (d Date) formatHL7(t tag, opt *EncodeOption) string {
+	if v, ok := formatTag(d.Time, t); ok {
+		return v
+	}
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Format("2006")
+	case PrecisionMonth:
+		return d.Format("200601")
+	}
+	return d.Format("20060102")
+}
+
+// IsNull reports if the value is the explicit null.
+func (d Date) IsNull() bool {
+	return d.Null
+}
+
+// String returns the HL7 value.
+func (d Date) String() string {
+	if d.Null {
+		return nullValue
+	}
+	if d.IsZero() {
+		return ""
+	}
+	return d.formatHL7(tag{}, nil)
+}
+
+// timeLayouts are the TM layouts by length, without fraction or offset.
+var timeLayouts = []struct {
+	size   int
+	layout string
+}{
+	{6, "150405"},
+	{4, "1504"},
+	{2, "15"},
+}
+
+func (tm *Time) parseHL7(v string, opt *DecodeOption) error {
+	if v == nullValue {
+		*tm = Time{Null: true}
+		return nil
+	}
+	orig := v
+	v, _, _ = strings.Cut(v, "^")
+	strict := opt != nil && (opt.StrictTime || opt.CheckPrimitive)
+	if !strict {
+		v = strings.Replace(v, ":", "", -1)
+	}
+	if len(v) == 0 {
+		tm.Time = time.Time{}
+		return nil
+	}
+	loc := opt.location()
+	if zoneIndex := strings.IndexAny(v, "+-"); zoneIndex >= 0 {
+		var err error
+		loc, err = parseZone(v[zoneIndex:])
+		if err != nil {
+			return fmt.Errorf("time %q: %w", orig, err)
+		}
+		v = v[:zoneIndex]
+	}
+	v, frac, hasFrac := strings.Cut(v, ".")
+	if !isDigits(v) || !isDigits(frac) || (hasFrac && len(frac) == 0) {
+		return fmt.Errorf("invalid characters in time: %q", orig)
+	}
+	for _, l := range timeLayouts {
+		if len(v) != l.size {
+			continue
+		}
+		t, err := time.ParseInLocation(l.layout, v, loc)
+		if err != nil {
+			return fmt.Errorf("time %q: %w", orig, err)
+		}
+		if len(frac) > 0 && l.size != 6 && strict {
+			return fmt.Errorf("time %q: fractional seconds without seconds", orig)
+		}
+		if len(frac) > 0 && l.size == 6 {
+			t = t.Add(parseFraction(frac))
+		}
+		tm.Time = t
+		return nil
+	}
+	return fmt.Errorf("invalid time %q: must be HH[MM[SS[.S[S[S[S]]]]]][+/-ZZZZ]", orig)
+}
+
+func (tm Time) formatHL7(t tag, opt *EncodeOption) string {
+	if v, ok := formatTag(tm.Time, t); ok {
+		return v
+	}
+	return tm.Format("150405")
+}
+
+// IsNull reports if the value is the explicit null.
+func (tm Time) IsNull() bool {
+	return tm.Null
+}
+
+// String returns the HL7 value.
+func (tm Time) String() string {
+	if tm.Null {
+		return nullValue
+	}
+	if tm.IsZero() {
+		return ""
+	}
+	return tm.formatHL7(tag{}, nil)
+}
+
+func (dt *DateTime) parseHL7(v string, opt *DecodeOption) error {
+	if v == nullValue {
+		*dt = DateTime{Null: true}
+		return nil
+	}
+	p, err := parseDTM(v, opt)
+	if err != nil {
+		return err
+	}
+	*dt = p
+	return nil
+}
+
+func (dt DateTime) formatHL7(t tag, opt *EncodeOption) string {
+	offset := dt.Offset
+	if dt.Precision == PrecisionDefault {
+		offset = dt.Location() != time.UTC
+	}
+	return formatDTM(dt.Time, dt.Precision, dt.FractionDigits, offset, t, opt)
+}
+
+// IsNull reports if the value is the explicit null.
+func (dt DateTime) IsNull() bool {
+	return dt.Null
+}
+
+// String returns the HL7 value.
+func (dt DateTime) String() string {
+	if dt.Null {
+		return nullValue
+	}
+	if dt.IsZero() {
+		return ""
+	}
+	return dt.formatHL7(tag{}, nil)
+}
+
+// formatNames are the named precisions of the format tag.
+// Times of day have no date precision.
+var formatNames = map[string]struct {
+	layout    string
+	precision Precision
+}{
+	"Y":      {"2006", PrecisionYear},
+	"YM":     {"200601", PrecisionMonth},
+	"YMD":    {"20060102", PrecisionDay},
+	"YMDH":   {"2006010215", PrecisionHour},
+	"YMDHM":  {"200601021504", PrecisionMinute},
+	"YMDHMS": {"20060102150405", PrecisionSecond},
+	"HM":     {"1504", PrecisionDefault},
+	"HMS":    {"150405", PrecisionDefault},
+}
+
+// precisionLayouts are the DTM layouts of each precision, without fraction or offset.
+var precisionLayouts = [...]string{
+	PrecisionYear:     "2006",
+	PrecisionMonth:    "200601",
+	PrecisionDay:      "20060102",
+	PrecisionHour:     "2006010215",
+	PrecisionMinute:   "200601021504",
+	PrecisionSecond:   "20060102150405",
+	PrecisionFraction: "20060102150405",
+}
+
+// formatDTM formats a date time value with precision p, or the precision of a named format tag.
+// The encode options may convert the location, set a missing precision, and include or omit the offset.
+func formatDTM(tm time.Time, p Precision, digits int, offset bool, t tag, opt *EncodeOption) string {
+	if opt == nil {
+		opt = &EncodeOption{}
+	}
+	if opt.Location != nil {
+		tm = tm.In(opt.Location)
+	}
+	fromTag := false
+	if len(t.Format) > 0 {
+		f, ok := formatNames[t.Format]
+		if !ok || f.precision == PrecisionDefault {
+			layout, _, _ := formatLayout(t.Format)
+			return tm.Format(layout)
+		}
+		p, fromTag = f.precision, true
+	}
+	// The named timestamp precisions of generated fields are defaults, not requirements.
+	if opt.Precision != PrecisionDefault && (p == PrecisionDefault || (fromTag && p >= PrecisionHour)) {
+		p = opt.Precision
+	}
+	if p == PrecisionDefault || int(p) >= len(precisionLayouts) {
+		p = PrecisionSecond
+	}
+	v := tm.Format(precisionLayouts[p])
+	if p == PrecisionFraction {
+		if digits < 1 || digits > 9 {
+			digits = 4
+		}
+		v += "." + fmt.Sprintf("%09d", tm.Nanosecond())[:digits]
+	}
+	switch opt.Offset {
+	case OffsetInclude:
+		offset = offset || p >= PrecisionHour
+	case OffsetOmit:
+		offset = false
+	}
+	if offset {
+		v += tm.Format("-0700")
+	}
+	return v
+}
+
+// layoutCheckTime is formatted with a Go layout to check it; each element has a value unlike the reference time.
+var layoutCheckTime = time.Date(2009, 11, 12, 13, 14, 15, 123456789, time.FixedZone("", -5*3600))
+
+// formatLayout returns the time layout of a format tag value.
+// The value is either a named precision such as YMD, or a Go time layout
+// such as 20060102. A Go layout is strict: decoded values must match it exactly.
+// A Go layout must format a time without letters and parse back to the same value,
+// so a misspelled name such as YMD2 is an error.
+func formatLayout(format string) (layout string, strict bool, err error) {
+	if f, ok := formatNames[format]; ok {
+		return f.layout, false, nil
+	}
+	v := layoutCheckTime.Format(format)
+	if v == format || strings.IndexFunc(v, unicode.IsLetter) >= 0 {
+		return "", false, fmt.Errorf("unknown time format %q", format)
+	}
+	if tm, err := time.Parse(format, v); err != nil || tm.Format(format) != v {
+		return "", false, fmt.Errorf("unknown time format %q", format)
+	}
+	return format, true, nil
+}
+
+// formatTag formats tm with the format tag, if any.
+func formatTag(tm time.Time, t tag) (string, bool) {
+	if len(t.Format) == 0 {
+		return "", false
+	}
+	layout, _, err := formatLayout(t.Format)
+	if err != nil {
+		return "", false
+	}
+	return tm.Format(layout), true
+}
+
+// checkFormat returns an error if the format tag is a Go time layout and v does not match it.
+func checkFormat(v string, t tag) error {
+	v, _, _ = strings.Cut(v, "^")
+	if len(t.Format) == 0 || len(v) == 0 {
+		return nil
+	}
+	layout, strict, err := formatLayout(t.Format)
+	if err != nil || !strict {
+		return err
+	}
+	if _, err := time.Parse(layout, v); err != nil {
+		return fmt.Errorf("value %q does not match format %q", v, t.Format)
+	}
+	return nil
+}
+
+func isDigits(v string) bool {
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// dateTimeLayouts are the DTM layouts by length, without fraction or offset.
+var dateTimeLayouts = []struct {
+	size      int
+	layout    string
+	precision Precision
+}{
+	{14, "20060102150405", PrecisionSecond},
+	{12, "200601021504", PrecisionMinute},
+	{10, "2006010215", PrecisionHour},
+	{8, "20060102", PrecisionDay},
+	{6, "200601", PrecisionMonth},
+	{4, "2006", PrecisionYear},
+}
+
+func parseDateTime(dt string) (time.Time, error) {
+	v, err := parseDTM(dt, nil)
+	return v.Time, err
+}
+
+// parseDTM parses a DTM value, recording the precision and offset supplied.
+// The options may be nil.
+func parseDTM(dt string, opt *DecodeOption) (DateTime, error) {
+	// Format: YYYY[MM[DD[HH[MM[SS[.S[S[S[S]]]]]]]]][+/-ZZZZ]^<degree of precision>
+	// 20200522143859198-0700
+	// 20060102150405
+	orig := dt
+
+	// The degree of precision component (TS.2) is also a component separator. Ignore what is after it.
+	dt, _, _ = strings.Cut(dt, "^")
+
+	strict := opt != nil && (opt.StrictTime || opt.CheckPrimitive)
+	if !strict {
+		// Fix problems caused by bad formats.
+		// Fix dates with dashes in them: 2006-01-02.
+		if len(dt) >= 10 && dt[4] == '-' && dt[7] == '-' {
+			dt = dt[:4] + dt[5:7] + dt[8:]
+		}
+
+		// Remove spaces and colons
+		dt = strings.Replace(dt, " ", "", -1)
+		dt = strings.Replace(dt, ":", "", -1)
+	}
+
+	if len(dt) == 0 {
+		return DateTime{}, nil // No date supplied, use zero value
+	}
+
+	loc := opt.location()
+	hasZone := false
+	if zoneIndex := strings.IndexAny(dt, "+-"); zoneIndex >= 0 {
+		var err error
+		loc, err = parseZone(dt[zoneIndex:])
+		if err != nil {
+			return DateTime{}, fmt.Errorf("field %q: %w", orig, err)
+		}
+		dt = dt[:zoneIndex]
+		hasZone = true
+	}
+	dt, frac, hasFrac := strings.Cut(dt, ".")
+
+	for _, r := range dt + frac {
+		if r < '0' || r > '9' {
+			return DateTime{}, fmt.Errorf("invalid characters in date: %q", orig)
+		}
+	}
+	if hasFrac && len(frac) == 0 {
+		return DateTime{}, fmt.Errorf("field %q: missing fractional seconds", orig)
+	}
+	if strict {
+		if err := checkDTM(dt, frac); err != nil {
+			return DateTime{}, fmt.Errorf("field %q: %w", orig, err)
+		}
+	}
+
+	for _, l := range dateTimeLayouts {
+		if len(dt) < l.size {
+			continue
+		}
+		t, err := time.ParseInLocation(l.layout, dt[:l.size], loc)
+		if err != nil {
+			return DateTime{Time: t}, fmt.Errorf("field %q: %w", orig, err)
+		}
+		v := DateTime{Time: t, Precision: l.precision, Offset: hasZone}
+		// Fractional seconds are only meaningful with seconds.
+		if len(frac) > 0 && l.size == 14 {
+			v.Time = t.Add(parseFraction(frac))
+			v.Precision = PrecisionFraction
+			v.FractionDigits = len(frac)
+			if v.FractionDigits > 9 {
+				v.FractionDigits = 9
+			}
+		}
+		return v, nil
+	}
+	return DateTime{}, fmt.Errorf("field %q: date too short", orig)
+}
+
+// checkDTM returns an error if the digits of a DTM value are not a whole precision,
+// or if fractional seconds are not 1 to 4 digits after the seconds.
+func checkDTM(dt, frac string) error {
+	switch len(dt) {
+	default:
+		return fmt.Errorf("invalid length %d, must be YYYY[MM[DD[HH[MM[SS]]]]]", len(dt))
+	case 4, 6, 8, 10, 12, 14:
+	}
+	if len(frac) > 0 && len(dt) != 14 {
+		return fmt.Errorf("fractional seconds without seconds")
+	}
+	if len(frac) > 4 {
+		return fmt.Errorf("more than 4 digits of fractional seconds")
+	}
+	return nil
+}
+
+// parseFraction returns the duration of the digits after the decimal point of the seconds.
+// Digits beyond nanosecond precision are ignored.
+func parseFraction(frac string) time.Duration {
+	var ns time.Duration
+	scale := time.Duration(100_000_000)
+	for i := 0; i < len(frac) && scale > 0; i++ {
+		ns += time.Duration(frac[i]-'0') * scale
+		scale /= 10
+	}
+	return ns
+}
+
+// parseZone parses a +/-ZZZZ offset into a fixed location.
+func parseZone(z string) (*time.Location, error) {
+	if len(z) != 5 && len(z) != 3 {
+		return nil, fmt.Errorf("invalid time zone offset %q", z)
+	}
+	sign := 1
+	switch z[0] {
+	default:
+		return nil, fmt.Errorf("invalid time zone offset %q", z)
+	case '+':
+	case '-':
+		sign = -1
+	}
+	var hh, mm int
+	for i, r := range z[1:] {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid time zone offset %q", z)
+		}
+		v := int(r - '0')
+		switch i {
+		case 0, 1:
+			hh = hh*10 + v
+		case 2, 3:
+			mm = mm*10 + v
+		}
+	}
+	if hh > 14 || mm > 59 {
+		return nil, fmt.Errorf("invalid time zone offset %q", z)
+	}
+	return time.FixedZone("", sign*(hh*3600+mm*60)), nil
+}
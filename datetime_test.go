@@ -0,0 +1,312 @@
+package hl7
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+// testRegistry adds the given segments to the v2.5.1 registry.
+type testRegistry struct {
+	segment map[string]any
+}
+
+func newTestRegistry(segments ...any) testRegistry {
+	r := testRegistry{segment: map[string]any{}}
+	for k, v := range v251.SegmentRegistry {
+		r.segment[k] = v
+	}
+	for _, s := range segments {
+		meta, err := (&Encoder{}).meta(reflect.TypeOf(s))
+		if err != nil {
+			panic(err)
+		}
+		r.segment[meta.Name] = s
+	}
+	return r
+}
+
+func (r testRegistry) Version() string                { return v251.Registry.Version() }
+func (r testRegistry) ControlSegment() RegistryLookup { return v251.Registry.ControlSegment() }
+func (r testRegistry) Segment() RegistryLookup        { return r.segment }
+func (r testRegistry) Trigger() RegistryLookup        { return v251.Registry.Trigger() }
+func (r testRegistry) DataType() RegistryLookup       { return v251.Registry.DataType() }
+
+type testZDT struct {
+	HL7      struct{} `hl7:",name=ZDT,type=s"`
+	Birth    Date     `hl7:"1"`
+	At       Time     `hl7:"2"`
+	When     DateTime `hl7:"3"`
+	Optional *Date    `hl7:"4"`
+}
+
+func TestDateTimeTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) (time.Time, error)
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{"date", parseDateValue, "20240131", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), false},
+		{"date year", parseDateValue, "2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"date with time", parseDateValue, "202401311230", time.Time{}, true},
+		{"date letters", parseDateValue, "2024013X", time.Time{}, true},
+		{"time", parseTimeValue, "1230", time.Date(0, 1, 1, 12, 30, 0, 0, time.UTC), false},
+		{"time seconds", parseTimeValue, "123005.25", time.Date(0, 1, 1, 12, 30, 5, 250000000, time.UTC), false},
+		{"time offset", parseTimeValue, "1230-0500", time.Date(0, 1, 1, 12, 30, 0, 0, time.FixedZone("", -5*3600)), false},
+		{"time bad", parseTimeValue, "123", time.Time{}, true},
+		{"date time", parseDateTimeValue, "20240131123000-0500", time.Date(2024, 1, 31, 12, 30, 0, 0, time.FixedZone("", -5*3600)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("parse(%q) got %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func parseDateValue(v string) (time.Time, error) {
+	d, err := ParseDate(v)
+	return d.Time, err
+}
+func parseTimeValue(v string) (time.Time, error) {
+	d, err := ParseTime(v)
+	return d.Time, err
+}
+func parseDateTimeValue(v string) (time.Time, error) {
+	d, err := ParseDateTime(v)
+	return d.Time, err
+}
+
+func TestDateTimeCodec(t *testing.T) {
+	reg := newTestRegistry(testZDT{})
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZDT|20240131|0930|20240131093000-0500|")
+
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZDT)
+	if z.Birth.String() != "20240131" {
+		t.Fatalf("got date %q", z.Birth)
+	}
+	if z.At.String() != "093000" {
+		t.Fatalf("got time %q", z.At)
+	}
+	if z.When.String() != "20240131093000-0500" {
+		t.Fatalf("got date time %q", z.When)
+	}
+	if z.Optional != nil {
+		t.Fatalf("expected nil optional date")
+	}
+
+	e := NewEncoder(nil)
+	bb, err := e.Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte("ZDT|20240131|093000|20240131093000-0500|")
+	if !bytes.HasPrefix(bb, want) {
+		t.Fatalf("got %q, want prefix %q", bb, want)
+	}
+}
+
+func TestDateTimePrecision(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		p    Precision
+	}{
+		{"2024", "2024", PrecisionYear},
+		{"202401", "202401", PrecisionMonth},
+		{"20240131", "20240131", PrecisionDay},
+		{"2024013112", "2024013112", PrecisionHour},
+		{"202401311230", "202401311230", PrecisionMinute},
+		{"20240131123045", "20240131123045", PrecisionSecond},
+		{"20240131123045.12", "20240131123045.12", PrecisionFraction},
+		{"202401-0500", "202401-0500", PrecisionMonth},
+		{"20240131123045+0000", "20240131123045+0000", PrecisionSecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			dt, err := ParseDateTime(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dt.Precision != tt.p {
+				t.Fatalf("got precision %d, want %d", dt.Precision, tt.p)
+			}
+			if got := dt.String(); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	d, err := ParseDate("202401")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.String(); got != "202401" {
+		t.Fatalf("date got %q, want %q", got, "202401")
+	}
+
+	constructed := DateTime{Time: time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC)}
+	if got := constructed.String(); got != "20240131123000" {
+		t.Fatalf("constructed got %q", got)
+	}
+}
+
+type testZFT struct {
+	HL7     struct{}  `hl7:",name=ZFT,type=s"`
+	Date    time.Time `hl7:"1,format=20060102"`
+	Minute  DateTime  `hl7:"2,format=YMDHM"`
+	Ordered Date      `hl7:"3,format=2006"`
+}
+
+func TestDateTimeFormatTag(t *testing.T) {
+	reg := newTestRegistry(testZFT{})
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZFT|20240131|20240131093015|2024|")
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZFT)
+	bb, err := NewEncoder(nil).Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte("ZFT|20240131|202401310930|2024")
+	if !bytes.HasPrefix(bb, want) {
+		t.Fatalf("got %q, want prefix %q", bb, want)
+	}
+
+	raw = []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZFT|202401311200|")
+	if _, err := d.DecodeList(raw); err == nil || !strings.Contains(err.Error(), "does not match format") {
+		t.Fatalf("expected format error, got %v", err)
+	}
+
+	for _, f := range []string{"XYZ", "YMD2", "Jan 2006"} {
+		if _, err := parseTag("Bad", "1,format="+f); err == nil {
+			t.Fatalf("%s: expected unknown format error", f)
+		}
+	}
+}
+
+func TestDateTimeLocation(t *testing.T) {
+	loc := time.FixedZone("facility", -6*3600)
+	reg := newTestRegistry(testZDT{})
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true, Location: loc})
+
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZDT|20240131|0930|20240131093000|\rZDT||||\rZDT|||20240131093000+0100|")
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZDT)
+	if want := time.Date(2024, 1, 31, 9, 30, 0, 0, loc); !z.When.Equal(want) {
+		t.Fatalf("got %v, want %v", z.When.Time, want)
+	}
+	if z.When.String() != "20240131093000" {
+		t.Fatalf("got %q, want naive encoding", z.When)
+	}
+	if want := time.Date(2024, 1, 31, 0, 0, 0, 0, loc); !z.Birth.Equal(want) {
+		t.Fatalf("got date %v, want %v", z.Birth.Time, want)
+	}
+	if _, off := z.At.Zone(); off != -6*3600 {
+		t.Fatalf("got time offset %d", off)
+	}
+	z = list[3].(*testZDT)
+	if _, off := z.When.Zone(); off != 3600 {
+		t.Fatalf("explicit offset replaced, got %d", off)
+	}
+}
+
+func TestEncodeTimeOptions(t *testing.T) {
+	loc := time.FixedZone("", -5*3600)
+	at := time.Date(2024, 1, 31, 9, 30, 15, 0, loc)
+	parsed, err := ParseDateTime("202401311430+0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		opt  *EncodeOption
+		when DateTime
+		want string
+	}{
+		{"default", nil, DateTime{Time: at}, "ZDT|||20240131093015-0500"},
+		{"utc", &EncodeOption{Location: time.UTC}, DateTime{Time: at}, "ZDT|||20240131143015+0000"},
+		{"naive utc", &EncodeOption{Location: time.UTC}, DateTime{Time: at.UTC()}, "ZDT|||20240131143015"},
+		{"utc offset", &EncodeOption{Location: time.UTC, Offset: OffsetInclude}, DateTime{Time: at.UTC()}, "ZDT|||20240131143015+0000"},
+		{"omit", &EncodeOption{Offset: OffsetOmit}, DateTime{Time: at}, "ZDT|||20240131093015"},
+		{"precision", &EncodeOption{Precision: PrecisionMinute}, DateTime{Time: at}, "ZDT|||202401310930-0500"},
+		{"recorded precision", &EncodeOption{Precision: PrecisionSecond, Location: loc}, parsed, "ZDT|||202401310930-0500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bb, err := NewEncoder(tt.opt).Encode(testZDT{When: tt.when})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(bytes.TrimRight(bb, "|\r")); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	tag := tag{Format: "YMDHMS"}
+	if got := formatDTM(at, PrecisionDefault, 0, false, tag, &EncodeOption{Precision: PrecisionMinute}); got != "202401310930" {
+		t.Fatalf("tag precision got %q", got)
+	}
+	tag.Format = "YMD"
+	if got := formatDTM(at, PrecisionDefault, 0, false, tag, &EncodeOption{Precision: PrecisionMinute, Offset: OffsetInclude}); got != "20240131" {
+		t.Fatalf("date tag got %q", got)
+	}
+}
+
+func TestStrictTime(t *testing.T) {
+	strict := &DecodeOption{StrictTime: true}
+	tests := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"20240131", false},
+		{"20240131123045.1234-0500", false},
+		{"202401311230^M", false},
+		{"Invalid date", true},
+		{"202401311", true},
+		{"20240131123", true},
+		{"2024-01-31", true},
+		{"20240131 1230", true},
+		{"202401311230.5", true},
+		{"20240131123045.12345", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := parseDTM(tt.in, strict)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDTM(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+	if _, err := parseDTM("202401311", nil); err != nil {
+		t.Fatalf("lenient parse failed: %v", err)
+	}
+	var tm Time
+	if err := tm.parseHL7("12:30", strict); err == nil {
+		t.Fatal("expected strict time error")
+	}
+}
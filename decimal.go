@@ -0,0 +1,161 @@
+package hl7
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Decimal is an exact NM value, for amounts and doses that must not be rounded by a float.
+// The digits are kept as received, including trailing zeros after the decimal point.
+// The zero value is not set and encodes as an empty value.
+type Decimal struct {
+	v string
+}
+
+var (
+	_ valueParser    = &Decimal{}
+	_ valueFormatter = Decimal{}
+)
+
+// ParseDecimal parses an NM value: [+|-]digits[.digits].
+func ParseDecimal(v string) (Decimal, error) {
+	var d Decimal
+	err := d.parseHL7(v, nil)
+	return d, err
+}
+
+// MustDecimal returns the Decimal for v, or panics if v is not a valid NM value.
+func MustDecimal(v string) Decimal {
+	d, err := ParseDecimal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d *Decimal) parseHL7(v string, opt *DecodeOption) error {
+	v = strings.TrimSpace(v)
+	if len(v) == 0 || v == nullValue {
+		d.v = ""
+		return nil
+	}
+	if err := checkNumber(v); err != nil {
+		return err
+	}
+	u, scale := splitDecimal(v)
+	d.v = formatDecimal(u, scale)
+	return nil
+}
+
+func (d Decimal) formatHL7(t tag, opt *EncodeOption) string {
+	return d.v
+}
+
+// IsZero reports if the value is not set. A Decimal of 0 is set.
+func (d Decimal) IsZero() bool {
+	return len(d.v) == 0
+}
+
+// String returns the HL7 value.
+func (d Decimal) String() string {
+	return d.v
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	_, frac, _ := strings.Cut(d.v, ".")
+	return len(frac)
+}
+
+// Rat returns the value as a rational number.
+func (d Decimal) Rat() *big.Rat {
+	u, scale := d.split()
+	r := new(big.Rat).SetInt(u)
+	return r.Quo(r, new(big.Rat).SetInt(pow10(scale)))
+}
+
+// Float64 returns the nearest float64 value.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares d and o, returning -1, 0, or +1. An unset value is 0.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := align(d, o)
+	return a.Cmp(b)
+}
+
+// Add returns d + o, with the larger scale of the two.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{v: formatDecimal(a.Add(a, b), scale)}
+}
+
+// Sub returns d - o, with the larger scale of the two.
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{v: formatDecimal(a.Sub(a, b), scale)}
+}
+
+// Mul returns d * o, with the sum of the scales of the two.
+func (d Decimal) Mul(o Decimal) Decimal {
+	a, sa := d.split()
+	b, sb := o.split()
+	return Decimal{v: formatDecimal(a.Mul(a, b), sa+sb)}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	u, scale := d.split()
+	return Decimal{v: formatDecimal(u.Neg(u), scale)}
+}
+
+// split returns the unscaled integer and scale of d.
+func (d Decimal) split() (*big.Int, int) {
+	if len(d.v) == 0 {
+		return new(big.Int), 0
+	}
+	return splitDecimal(d.v)
+}
+
+// splitDecimal returns the unscaled integer and scale of a valid NM value.
+func splitDecimal(v string) (*big.Int, int) {
+	whole, frac, _ := strings.Cut(v, ".")
+	u, _ := new(big.Int).SetString(whole+frac, 10)
+	return u, len(frac)
+}
+
+// formatDecimal formats the unscaled integer u with scale digits after the decimal point.
+func formatDecimal(u *big.Int, scale int) string {
+	neg := u.Sign() < 0
+	digits := new(big.Int).Abs(u).String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// align returns the unscaled integers of a and b at the same scale.
+func align(a, b Decimal) (*big.Int, *big.Int, int) {
+	ua, sa := a.split()
+	ub, sb := b.split()
+	switch {
+	case sa < sb:
+		ua.Mul(ua, pow10(sb-sa))
+		sa = sb
+	case sb < sa:
+		ub.Mul(ub, pow10(sa-sb))
+	}
+	return ua, ub, sa
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,90 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"5.50", "5.50", false},
+		{"+007", "7", false},
+		{".5", "0.5", false},
+		{"-.25", "-0.25", false},
+		{"-0", "0", false},
+		{"5.", "5", false},
+		{"123456789012345678901234567890.000000001", "123456789012345678901234567890.000000001", false},
+		{"1e3", "", true},
+		{"abc", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDecimal(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got := d.String(); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	a, b := MustDecimal("0.1"), MustDecimal("0.20")
+	if got := a.Add(b).String(); got != "0.30" {
+		t.Fatalf("add got %q", got)
+	}
+	if got := a.Sub(b).String(); got != "-0.10" {
+		t.Fatalf("sub got %q", got)
+	}
+	if got := a.Mul(b).String(); got != "0.020" {
+		t.Fatalf("mul got %q", got)
+	}
+	if got := b.Neg().String(); got != "-0.20" {
+		t.Fatalf("neg got %q", got)
+	}
+	if a.Cmp(b) != -1 || b.Cmp(MustDecimal("0.2")) != 0 || (Decimal{}).Cmp(MustDecimal("0")) != 0 {
+		t.Fatal("unexpected compare")
+	}
+	if got := b.Float64(); got != 0.2 {
+		t.Fatalf("float got %v", got)
+	}
+	if got := b.Scale(); got != 2 {
+		t.Fatalf("scale got %d", got)
+	}
+}
+
+type testZMO struct {
+	HL7    struct{}  `hl7:",name=ZMO,type=s"`
+	Amount Decimal   `hl7:"1"`
+	Doses  []Decimal `hl7:"2"`
+	Zero   Decimal   `hl7:"3"`
+	Unset  Decimal   `hl7:"4"`
+	Last   string    `hl7:"5"`
+}
+
+func TestDecimalCodec(t *testing.T) {
+	reg := newTestRegistry(testZMO{})
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZMO|1234.10|0.125~2.50|0||x")
+
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZMO)
+	if z.Amount.String() != "1234.10" || len(z.Doses) != 2 || z.Zero.String() != "0" || !z.Unset.IsZero() {
+		t.Fatalf("got %+v", z)
+	}
+	bb, err := NewEncoder(nil).Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "ZMO|1234.10|0.125~2.50|0||x"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
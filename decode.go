@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
-	"strings"
 	"time"
 	"unicode"
 )
@@ -15,9 +14,16 @@ type lineDecoder struct {
 	dividers [3]byte // usually |, ^, &
 	chars    [4]byte // usually ^!\&
 	escape   byte    // usually a \
+	truncate byte    // usually a # when present in v2.7+
 	readSep  bool
 
-	unescaper *strings.Replacer
+	charset Charset // Character set from MSH-18, nil if passed through.
+
+	line int    // Current line number, for warnings.
+	path string // Current segment field, for warnings.
+
+	opt       DecodeOption
+	dataTypes RegistryLookup // Registry data types, for interface fields with a datatype tag.
 }
 
 // Decoder decodes bytes into HL7 structures.
@@ -28,7 +34,73 @@ type Decoder struct {
 
 // DecodeOption represents options for the HL7 decoder.
 type DecodeOption struct {
-	ErrorZSegment bool // Error on an unknown Zxx segment when true.
+	ErrorZSegment   bool // Error on an unknown Zxx segment when true.
+	KeepEmptyRepeat bool // Keep empty repetitions (A~~C) as zero value elements to preserve repetition positions.
+
+	// Length checks the length of primitive values against the len tag option.
+	Length LengthPolicy
+
+	// Warn is called with problems that are not treated as errors.
+	// If nil, warnings are ignored.
+	Warn func(err error)
+
+	// FormatText converts formatted text escape sequences when not FormatTextNone.
+	FormatText FormatText
+
+	// Charset returns the character set for the MSH-18 value.
+	// If nil or if it returns nil, the built-in character sets are used.
+	Charset CharsetLookup
+
+	// LocalEscape is called for each locally defined \Z...\ escape sequence with the text following the Z.
+	// The returned value replaces the escape sequence. If nil, the escape sequence is left as is.
+	LocalEscape func(code string) (string, error)
+
+	// Record the number of components present in the data type meta field (HL7Name.Present)
+	// so trailing empty components (X^^Z^) are reproduced on encode.
+	KeepTrailingComponents bool
+
+	// Location is the time zone of date and time values sent without an offset,
+	// usually the local time zone of the sending facility. If nil, UTC is used.
+	Location *time.Location
+
+	// StrictTime returns an error for date and time values that are not exactly
+	// in the HL7 format, rather than correcting common mistakes or ignoring extra digits.
+	StrictTime bool
+
+	// TableValue is how values of fields declared with an Enum type that are not in the table are reported.
+	// The default does not report them, so local codes decode. TableWarn reports them to the Warn function.
+	TableValue TablePolicy
+
+	// CheckPrimitive returns an error for primitive values that are not in the form of the
+	// data type: ID and IS codes, NM numbers, and SI positive integers. Date and time values are checked as with StrictTime.
+	CheckPrimitive bool
+
+	// Terminator checks that segments end with a CR as required, rather than a LF.
+	Terminator TerminatorPolicy
+
+	// Delimiters are used to decode segments that are not preceded by a header segment,
+	// such as segment fragments or stored segments. A header segment in the data replaces them.
+	// If nil, the data must start with a header segment.
+	Delimiters *Delimiters
+
+	// MaxLength is the maximum message length in bytes. Zero is no limit.
+	MaxLength int
+
+	// MaxSegments is the maximum number of segments in a message. Zero is no limit.
+	MaxSegments int
+
+	// Trace is called as Decode receives and decodes each message. If nil, decoding is not traced.
+	Trace *Trace
+
+	// Metrics receives a MetricDecode for each message decoded by Decode. May be nil.
+	Metrics Metrics
+}
+
+func (opt *DecodeOption) location() *time.Location {
+	if opt == nil || opt.Location == nil {
+		return time.UTC
+	}
+	return opt.Location
 }
 
 // NewDecoder creates a new Decoder. A registry must be provided. Option is optional.
@@ -43,17 +115,48 @@ func NewDecoder(registry Registry, opt *DecodeOption) *Decoder {
 	return d
 }
 
+// Registry returns the registry of the Decoder.
+func (d *Decoder) Registry() Registry {
+	return d.registry
+}
+
+// Unmarshal decodes an hl7 message with the registry and returns a final trigger with all segments grouped.
+// Option may be nil.
+func Unmarshal(data []byte, registry Registry, opt *DecodeOption) (any, error) {
+	return NewDecoder(registry, opt).Decode(data)
+}
+
 // Decode takes an hl7 message and returns a final trigger with all segments grouped.
 func (d *Decoder) Decode(data []byte) (any, error) {
-	list, err := d.DecodeList(data)
+	t, m := d.opt.Trace, d.opt.Metrics
+	if t == nil && m == nil {
+		v, _, err := d.decode(data)
+		return v, err
+	}
+	info := traceRaw(data, time.Now())
+	var decoded func(info TraceInfo, err error)
+	if t != nil {
+		if t.Received != nil {
+			t.Received(info)
+		}
+		decoded = t.Decoded
+	}
+	v, segment, err := d.decode(data)
+	info.done(decoded, m, MetricDecode, segment, err)
+	return v, err
+}
+
+// decode also returns the segment type being decoded if an error occurs.
+func (d *Decoder) decode(data []byte) (any, string, error) {
+	list, segment, err := d.decodeList(data)
 	if err != nil {
-		return nil, fmt.Errorf("segment list: %w", err)
+		return nil, segment, fmt.Errorf("segment list: %w", err)
 	}
 	g, err := d.DecodeGroup(list)
 	if err != nil {
-		return nil, fmt.Errorf("trigger group: %w", err)
+		return nil, "", fmt.Errorf("trigger group: %w", err)
 	}
-	return g, nil
+	return g, "", nil
 }
 
 // DecodeGroup decodes a list of elements into trigger groupings.
@@ -61,18 +164,113 @@ func (d *Decoder) DecodeGroup(list []any) (any, error) {
 	return group(list, d.registry)
 }
 
+// DecodeMessages decodes data that contains one or more messages and returns
+// a final trigger for each message, with all segments grouped.
+func (d *Decoder) DecodeMessages(data []byte) ([]any, error) {
+	list, err := d.DecodeList(data)
+	if err != nil {
+		return nil, fmt.Errorf("segment list: %w", err)
+	}
+	msgs := SplitMessages(list)
+	ret := make([]any, 0, len(msgs))
+	for i, msg := range msgs {
+		g, err := d.DecodeGroup(msg)
+		if err != nil {
+			return ret, fmt.Errorf("message %d: trigger group: %w", i+1, err)
+		}
+		ret = append(ret, g)
+	}
+	return ret, nil
+}
+
+// SplitMessages splits a segment list into one list per message, starting at each MSH segment.
+// Any segments before the first MSH segment are returned as the first list.
+func SplitMessages(list []any) [][]any {
+	var ret [][]any
+	start := 0
+	for i, seg := range list {
+		if i == start || segmentName(reflect.ValueOf(seg)) != "MSH" {
+			continue
+		}
+		ret = append(ret, list[start:i:i])
+		start = i
+	}
+	if start < len(list) {
+		ret = append(ret, list[start:])
+	}
+	return ret
+}
+
 // Varies should be implemented on a segment that knows how to
-// decode a child VARIES data type.
+// decode a child VARIES data type. A VARIES field of a segment that does not implement Varies
+// is decoded as Raw.
 type Varies interface {
 	ChildVaries(dtReg map[string]any) (reflect.Value, error)
 }
 
+// Raw is field data as sent, including any separators and escapes. It is encoded as is.
+// A VARIES field that is the last field of its segment, such as QPD-3, also holds the fields that follow it.
+type Raw string
+
+// isRawField reports if a field of type rt is decoded as Raw: an interface field without a datatype tag
+// of a segment that does not implement Varies.
+func isRawField(t tag, rt reflect.Type, vfc variesFunc) bool {
+	if rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	return rt.Kind() == reflect.Interface && len(t.DataType) == 0 && vfc == nil
+}
+
 type variesFunc func() (reflect.Value, error)
 
 var variesType = reflect.TypeOf((*Varies)(nil)).Elem()
 
-// DecodeList returns a list of segments without any grouping applied.
-func (d *Decoder) DecodeList(data []byte) ([]any, error) {
+// TerminatorPolicy selects how segments terminated by a LF rather than a CR are handled.
+type TerminatorPolicy byte
+
+const (
+	TerminatorAny   TerminatorPolicy = iota // Accept CR, LF, or CR LF.
+	TerminatorWarn                          // Report each LF to the Warn function and accept it.
+	TerminatorError                         // Return an error for a LF.
+)
+
+// checkTerminators applies the terminator policy to the segments in data.
+func (d *Decoder) checkTerminators(data []byte) error {
+	if d.opt.Terminator == TerminatorAny {
+		return nil
+	}
+	line := 0 // Line number of the last segment, as numbered in DecodeList.
+	start := 0
+	for i, b := range data {
+		if b != '\r' && b != '\n' {
+			continue
+		}
+		if i > start {
+			line++
+		}
+		start = i + 1
+		if b == '\r' || line == 0 {
+			continue
+		}
+		err := fmt.Errorf("line %d: segment terminated by LF, not CR", line)
+		if d.opt.Terminator == TerminatorError {
+			return err
+		}
+		if d.opt.Warn != nil {
+			d.opt.Warn(err)
+		}
+	}
+	return nil
+}
+
+// lines splits data into segment lines, with any continuation segments joined, after checking the size limits.
+func (d *Decoder) lines(data []byte) ([][]byte, error) {
+	if d.opt.MaxLength > 0 && len(data) > d.opt.MaxLength {
+		return nil, fmt.Errorf("message length %d exceeds the maximum of %d", len(data), d.opt.MaxLength)
+	}
+	if err := d.checkTerminators(data); err != nil {
+		return nil, err
+	}
 	// Explicitly accept both CR and LF as new lines. Some systems do use \n, despite the spec.
 	lines := bytes.FieldsFunc(data, func(r rune) bool {
 		switch r {
@@ -82,6 +280,25 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			return true
 		}
 	})
+	joinAddenda(lines)
+	if d.opt.MaxSegments > 0 && len(lines) > d.opt.MaxSegments {
+		return nil, fmt.Errorf("message segment count %d exceeds the maximum of %d", len(lines), d.opt.MaxSegments)
+	}
+	return lines, nil
+}
+
+// DecodeList returns a list of segments without any grouping applied.
+func (d *Decoder) DecodeList(data []byte) ([]any, error) {
+	list, _, err := d.decodeList(data)
+	return list, err
+}
+
+// decodeList also returns the segment type being decoded if an error occurs.
+func (d *Decoder) decodeList(data []byte) ([]any, string, error) {
+	lines, err := d.lines(data)
+	if err != nil {
+		return nil, "", err
+	}
 
 	type field struct {
 		name  string
@@ -92,7 +309,13 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 
 	ret := []any{}
 
-	ld := &lineDecoder{}
+	ld := &lineDecoder{
+		opt:       d.opt,
+		dataTypes: d.registry.DataType(),
+	}
+	if d.opt.Delimiters != nil {
+		ld.setDelimiters(*d.opt.Delimiters)
+	}
 	segmentRegistry := d.registry.Segment()
 	for index, line := range lines {
 		lineNumber := index + 1
@@ -103,7 +326,7 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 		segTypeName, n := ld.getID(line)
 		remain := line[n:]
 		if len(segTypeName) == 0 {
-			return nil, fmt.Errorf("line %d: missing segment type", lineNumber)
+			return nil, "", fmt.Errorf("line %d: missing segment type", lineNumber)
 		}
 
 		seg, ok := segmentRegistry[segTypeName]
@@ -112,7 +335,7 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			if isZ && !d.opt.ErrorZSegment {
 				continue
 			}
-			return nil, fmt.Errorf("line %d: unknown segment type %q", lineNumber, segTypeName)
+			return nil, segTypeName, fmt.Errorf("line %d: unknown segment type %q", lineNumber, segTypeName)
 		}
 
 		rt := reflect.TypeOf(seg)
@@ -134,7 +357,7 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			tagText := ft.Tag.Get(tagName)
 			tag, err := parseTag(ft.Name, tagText)
 			if err != nil {
-				return nil, err
+				return nil, segTypeName, err
 			}
 			if !tag.Present {
 				continue
@@ -161,7 +384,7 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			f.field = rvv.Field(i)
 
 			if !f.field.IsValid() {
-				return nil, fmt.Errorf("%s.%s invalid reflect value", SegmentName, f.name)
+				return nil, segTypeName, fmt.Errorf("%s.%s invalid reflect value", SegmentName, f.name)
 			}
 
 			fieldList = append(fieldList, f)
@@ -173,28 +396,29 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 
 		offset := 0
 		if hasInit {
-			if len(remain) < 5 {
-				return nil, fmt.Errorf("missing format delims")
+			dl, n, err := parseInitDelimiters(remain)
+			if err != nil {
+				return nil, segTypeName, fmt.Errorf("line %d: %w", lineNumber, err)
 			}
-			ld.sep = remain[0]
-			copy(ld.chars[:], remain[1:5])
+			ld.setDelimiters(dl)
 
-			ld.dividers = [3]byte{ld.sep, ld.chars[0], ld.chars[3]}
-			ld.repeat = ld.chars[1]
-			ld.escape = ld.chars[2]
-			ld.setupUnescaper()
-			ld.readSep = true
-
-			remain = remain[5:]
+			remain = remain[n:]
 			offset = 2
 		}
 
 		if ld.sep == 0 {
-			return nil, fmt.Errorf("missing sep prior to field")
+			return nil, segTypeName, fmt.Errorf("missing sep prior to field")
 		}
 
 		parts := bytes.Split(remain, []byte{ld.sep})
 
+		if hasInit && segTypeName == "MSH" {
+			err := ld.setCharset(parts, offset)
+			if err != nil {
+				return nil, segTypeName, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+		}
+
 		ff := make([]field, SegmentFieldLength)
 		for _, f := range fieldList {
 			if f.tag.FieldSep {
@@ -202,7 +426,7 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 				continue
 			}
 			if f.tag.FieldChars {
-				f.field.SetString(string(ld.chars[:]))
+				f.field.SetString(ld.encodingChars())
 				continue
 			}
 			index := int(f.tag.Order) - offset
@@ -231,36 +455,46 @@ func (d *Decoder) DecodeList(data []byte) ([]any, error) {
 			if f.tag.Omit {
 				continue
 			}
+			// The last VARIES field of a segment, such as QPD-3, holds the parameters in the fields after it.
+			if i == len(ff)-1 && isRawField(f.tag, f.field.Type(), vfc) {
+				p = bytes.Join(parts[i:], []byte{ld.sep})
+			}
+			ld.line = lineNumber
+			ld.path = SegmentName + "." + f.name
 			err := ld.decodeSegmentList(p, f.tag, f.field, vfc)
 			if err != nil {
-				return ret, fmt.Errorf("line %d, %s.%s: %w", lineNumber, SegmentName, f.name, err)
+				return ret, segTypeName, fmt.Errorf("line %d, %s.%s: %w", lineNumber, SegmentName, f.name, err)
 			}
 		}
 
 		ret = append(ret, rv.Interface())
 	}
-	return ret, nil
-}
-
-func (d *lineDecoder) setupUnescaper() {
-	d.unescaper = strings.NewReplacer(
-		string([]byte{d.escape, 'F', d.escape}), string(d.sep),
-		string([]byte{d.escape, 'S', d.escape}), string(d.chars[0]),
-		string([]byte{d.escape, 'R', d.escape}), string(d.chars[1]),
-		string([]byte{d.escape, 'E', d.escape}), string(d.chars[2]),
-		string([]byte{d.escape, 'T', d.escape}), string(d.chars[3]),
-	)
+	return ret, "", nil
 }
 
 var timeType reflect.Type = reflect.TypeOf(time.Time{})
 
+// nullValue is the explicit HL7 null, which clears the value in the receiver.
+// An empty value is not present and leaves any existing value unchanged.
+// The null is kept as its text in a string value, or in the first component of a composite, and encodes as it was
+// received. A Date, Time, or DateTime records it as Null. Other values, such as numbers and time.Time, cannot
+// record it and decode the null as not sent.
+const nullValue = `""`
+
 func (d *lineDecoder) decodeSegmentList(data []byte, t tag, rv reflect.Value, vfc variesFunc) error {
 	if len(data) == 0 {
 		return nil
 	}
+	if isRawField(t, rv.Type(), vfc) {
+		// Raw is kept as sent, including any repetitions.
+		return d.decodeSegment(data, t, rv, 1, false, vfc)
+	}
 	parts := bytes.Split(data, []byte{d.repeat})
 	for _, p := range parts {
 		if len(p) == 0 {
+			if d.opt.KeepEmptyRepeat && len(parts) > 1 && rv.Kind() == reflect.Slice {
+				rv.Set(reflect.Append(rv, reflect.Zero(rv.Type().Elem())))
+			}
 			continue
 		}
 		err := d.decodeSegment(p, t, rv, 1, len(parts) > 1, vfc)
@@ -270,6 +504,21 @@ func (d *lineDecoder) decodeSegmentList(data []byte, t tag, rv reflect.Value, vf
 	}
 	return nil
 }
+
+// namedDataType returns a new value of the registry data type name for an interface field of type it.
+// This allows a segment to refer to a data type by name and the registry to supply the version specific type.
+func (d *lineDecoder) namedDataType(name string, it reflect.Type) (reflect.Value, error) {
+	dt, ok := d.dataTypes[name]
+	if !ok || dt == nil {
+		return reflect.Value{}, fmt.Errorf("data type %q not found in registry", name)
+	}
+	rt := reflect.TypeOf(dt)
+	if !rt.AssignableTo(it) {
+		return reflect.Value{}, fmt.Errorf("data type %q %v not assignable to %v", name, rt, it)
+	}
+	return reflect.New(rt).Elem(), nil
+}
+
 func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level int, mustBeSlice bool, vfc variesFunc) error {
 	type field struct {
 		tag   tag
@@ -285,8 +534,23 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 	default:
 		return fmt.Errorf("unknown field kind %v value=%v(%v) tag=%v data=%q", rv.Kind(), rv, rv.Type(), t, data)
 	case reflect.Interface:
+		if len(t.DataType) > 0 {
+			nextRV, err := d.namedDataType(t.DataType, rv.Type())
+			if err != nil {
+				return err
+			}
+			err = d.decodeSegment(data, t, nextRV, level, mustBeSlice, vfc)
+			rv.Set(nextRV)
+			return err
+		}
 		if vfc == nil {
-			return fmt.Errorf("unsupported interface field kind %#v data=%q", t, data)
+			// Without a type, keep the field data as is.
+			raw := reflect.ValueOf(Raw(data))
+			if !raw.Type().AssignableTo(rv.Type()) {
+				return fmt.Errorf("unsupported interface field kind %#v data=%q", t, data)
+			}
+			rv.Set(raw)
+			return nil
 		}
 		nextRV, err := vfc()
 		if err != nil {
@@ -296,6 +560,12 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 		rv.Set(nextRV)
 		return err
 	case reflect.Pointer:
+		if len(data) == 0 {
+			return nil
+		}
+		if string(data) == nullValue && (isNumber(rv.Type().Elem().Kind()) || rv.Type().Elem() == timeType) {
+			return nil // The value cannot record the null.
+		}
 		next := reflect.New(rv.Type().Elem())
 		rv.Set(next)
 		return d.decodeSegment(data, t, next.Elem(), level, false, vfc)
@@ -317,7 +587,37 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 
 		rv.Set(reflect.Append(rv, ivv))
 		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		v, err := d.decodeByte(data, t)
+		if err != nil {
+			return err
+		}
+		err = setNumber(rv, v)
+		if err != nil {
+			return err
+		}
+		if t.Sequence && rv.CanInt() && rv.Int() < 0 {
+			return fmt.Errorf("invalid set ID %q: must not be negative", v)
+		}
+		return nil
 	case reflect.Struct:
+		if rv.CanAddr() {
+			if p, ok := rv.Addr().Interface().(valueParser); ok {
+				if string(data) == nullValue {
+					return p.parseHL7(nullValue, &d.opt)
+				}
+				v, err := d.decodeByte(data, t)
+				if err != nil {
+					return err
+				}
+				if err := checkFormat(v, t); err != nil {
+					return err
+				}
+				return p.parseHL7(v, &d.opt)
+			}
+		}
 		switch rv.Type() {
 		default:
 			sep := d.dividers[level]
@@ -330,6 +630,7 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 			var SegmentName string
 			var SegmentSize int32
 			var maxOrd int32
+			var metaValue reflect.Value
 
 			for i := 0; i < ct; i++ {
 				ft := rt.Field(i)
@@ -341,8 +642,9 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 				if fTag.Meta {
 					SegmentName = fTag.Name
 					SegmentSize = fTag.Order
+					metaValue = rv.Field(i)
 					if ft.Type.Kind() == reflect.String {
-						rv.Field(i).SetString(SegmentName)
+						metaValue.SetString(SegmentName)
 					}
 					continue
 				}
@@ -380,11 +682,17 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 
 			// TODO: Make more robust. Watch for repeats, etc, other stuff.
 			parts := bytes.Split(data, []byte{sep})
+			if d.opt.KeepTrailingComponents && len(data) > 0 {
+				setPresent(metaValue, len(parts))
+			}
 			for i, p := range parts {
 				if i >= len(ff) {
 					continue
 				}
 				f := ff[i]
+				if !f.tag.Present {
+					continue
+				}
 				err := d.decodeSegment(p, f.tag, f.field, level+1, false, vfc)
 				if err != nil {
 					return fmt.Errorf("%s-%s.%d: %w", SegmentName, f.field.Type().String(), f.tag.Order, err)
@@ -392,12 +700,21 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 			}
 			return nil
 		case timeType:
-			v := d.decodeByte(data, t)
-			t, err := parseDateTime(v)
+			if string(data) == nullValue {
+				return nil
+			}
+			v, err := d.decodeByte(data, t)
 			if err != nil {
 				return err
 			}
-			rv.Set(reflect.ValueOf(t))
+			if err := checkFormat(v, t); err != nil {
+				return err
+			}
+			t, err := parseDTM(v, &d.opt)
+			if err != nil {
+				return err
+			}
+			rv.Set(reflect.ValueOf(t.Time))
 			return nil
 		}
 	case reflect.String:
@@ -408,16 +725,69 @@ func (d *lineDecoder) decodeSegment(data []byte, t tag, rv reflect.Value, level
 				return fmt.Errorf("%s contains an escape character %s; data may be malformed, invalid type, or contain a bug", t.Name, data)
 			}
 		}
-		rv.SetString(d.decodeByte(data, t))
+		v, err := d.decodeByte(data, t)
+		if err != nil {
+			return err
+		}
+		err = d.checkLength(v, t)
+		if err != nil {
+			return err
+		}
+		if d.opt.CheckPrimitive {
+			err = checkPrimitive(v, t)
+			if err != nil {
+				return err
+			}
+		}
+		rv.SetString(v)
+		if d.opt.TableValue != TableDefault && d.opt.TableValue != TableIgnore {
+			if err := checkEnum(rv); err != nil {
+				if d.opt.TableValue == TableError {
+					return err
+				}
+				d.warn(err)
+			}
+		}
 		return nil
 	}
 }
 
-func (d *lineDecoder) decodeByte(v []byte, t tag) string {
+// mshCharacterSet is the MSH field that contains the message character set.
+const mshCharacterSet = 18
+
+// setCharset sets the message character set from the first repetition of MSH-18.
+func (d *lineDecoder) setCharset(parts [][]byte, offset int) error {
+	d.charset = nil
+	index := mshCharacterSet - offset
+	if index >= len(parts) {
+		return nil
+	}
+	name, _, _ := bytes.Cut(parts[index], []byte{d.repeat})
+	cs, err := lookupCharset(d.opt.Charset, string(name))
+	if err != nil {
+		return fmt.Errorf("MSH.%d character set %q: %w", mshCharacterSet, name, err)
+	}
+	d.charset = cs
+	return nil
+}
+
+// setPresent records the number of components present on the meta field, if supported.
+func setPresent(meta reflect.Value, n int) {
+	if !meta.IsValid() || meta.Kind() != reflect.Struct {
+		return
+	}
+	pv := meta.FieldByName(presentName)
+	if !pv.IsValid() || !pv.CanSet() || pv.Kind() != reflect.Int {
+		return
+	}
+	pv.SetInt(int64(n))
+}
+
+func (d *lineDecoder) decodeByte(v []byte, t tag) (string, error) {
 	if t.NoEscape {
-		return string(v)
+		return string(v), nil
 	}
-	return d.unescaper.Replace(string(v))
+	return d.unescape(v)
 }
 
 func (d *lineDecoder) getID(data []byte) (string, int) {
@@ -433,84 +803,3 @@ func (d *lineDecoder) getID(data []byte) (string, int) {
 	}
 	return string(data), len(data)
 }
-
-func parseDateTime(dt string) (time.Time, error) {
-	var zoneIndex int
-
-	// Fix problems caused by bad formats
-	if len(dt) >= 8 {
-		// Fix dates with dashes in them
-		parts := strings.Split(dt[:8], "-")
-		dt = strings.Join(parts, "") + dt[8:]
-	}
-
-	// Remove spaces and colons
-	dt = strings.Replace(dt, " ", "", -1)
-	dt = strings.Replace(dt, ":", "", -1)
-
-	precisionOffset := -1
-	for i, r := range dt {
-		switch {
-		default:
-			return time.Time{}, fmt.Errorf("invalid characters in date: %q", dt)
-		case unicode.IsNumber(r):
-		case r == '.': //ignore
-		case r == '-':
-			fallthrough
-		case r == '+':
-			zoneIndex = i
-		case r == '^': // This is problematic, as its also a field separator. We are going to ignore whatever is after.
-			precisionOffset = i
-		}
-	}
-
-	if precisionOffset >= 0 {
-		dt = dt[:precisionOffset]
-	}
-
-	// Format: YYYY[MM[DD[HH[MM[SS[.S[S[S[S]]]]]]]]][+/-ZZZZ]^<degree of precision>
-	// 20200522143859198-0700
-	// 20060102150405
-	in := dt
-	var t time.Time
-	var err error
-	if zoneIndex > 0 {
-		tp := dt[:zoneIndex]
-		zp := dt[zoneIndex:]
-
-		switch len(dt) {
-		default:
-			in = tp[:12] + zp
-			t, err = time.Parse("200601021504-0700", in)
-		case 8 + 5: // To the day.
-			t, err = time.Parse("20060102-0700", in)
-		case 12 + 5: // To the minute.
-			t, err = time.Parse("200601021504-0700", in)
-		case 14 + 5, 16 + 5: // To the second.
-			in = tp[:14] + zp
-			t, err = time.Parse("20060102150405-0700", in)
-		}
-		if err != nil {
-			err = fmt.Errorf("field %q: %w", dt, err)
-		}
-		return t, err
-	}
-	switch len(dt) {
-	default:
-		in = dt[:12]
-		t, err = time.Parse("200601021504", in)
-	case 0:
-		t, err = time.Time{}, nil // No date supplied, use zero value
-	case 8: // To the day.
-		t, err = time.Parse("20060102", in)
-	case 12: // To the minute.
-		t, err = time.Parse("200601021504", in)
-	case 14, 16: // To the second.
-		in = dt[:14]
-		t, err = time.Parse("20060102150405", in)
-	}
-	if err != nil {
-		err = fmt.Errorf("field %q : %w", dt, err)
-	}
-	return t, err
-}
@@ -281,11 +281,27 @@ func (w *walker) found(line, index int, si *structItem, v any, rv reflect.Value,
 			pv.Set(reflect.Append(pv, set))
 			c.set(pv.Index(pv.Len() - 1))
 		}
+		if !c.Leaf {
+			w.reset(c)
+		}
 	}
 
 	return nil
 }
 
+// reset clears the active values under a newly created group, which still
+// refer to the values of the previous group.
+func (w *walker) reset(group *structItem) {
+	for _, si := range w.list {
+		for p := si.Parent; p != nil; p = p.Parent {
+			if p == group {
+				si.ActiveValue = reflect.Value{}
+				break
+			}
+		}
+	}
+}
+
 // Returns true if there is no place to put another value by setting an existing value
 // or by adding an item (either selv or parent).
 func (w *walker) fullInArray(si *structItem) bool {
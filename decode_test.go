@@ -1,8 +1,13 @@
 package hl7
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
 )
 
 func Test_lineDecoder_parseDateTime(t *testing.T) {
@@ -14,6 +19,23 @@ func Test_lineDecoder_parseDateTime(t *testing.T) {
 	}{
 		{"year only", "2006", time.Date(2006, 1, 1, 0, 0, 0, 0, time.Local), false},
 		{"date only", "20060203", time.Date(2006, 2, 3, 0, 0, 0, 0, time.Local), false},
+		{"year month", "200602", time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC), false},
+		{"to the second", "20240131123000", time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC), false},
+		{"negative offset", "20240131123000-0500", time.Date(2024, 1, 31, 12, 30, 0, 0, time.FixedZone("", -5*3600)), false},
+		{"positive offset", "202401311230+0130", time.Date(2024, 1, 31, 12, 30, 0, 0, time.FixedZone("", 90*60)), false},
+		{"day offset", "20240131-0700", time.Date(2024, 1, 31, 0, 0, 0, 0, time.FixedZone("", -7*3600)), false},
+		{"hour offset", "2024013112+0000", time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC), false},
+		{"fraction", "20240131123000.1234", time.Date(2024, 1, 31, 12, 30, 0, 123400000, time.UTC), false},
+		{"fraction one digit", "20240131123000.5", time.Date(2024, 1, 31, 12, 30, 0, 500000000, time.UTC), false},
+		{"fraction offset", "20240131123000.1234-0500", time.Date(2024, 1, 31, 12, 30, 0, 123400000, time.FixedZone("", -5*3600)), false},
+		{"fraction empty", "20240131123000.", time.Time{}, true},
+		{"dashes", "2024-01-31", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), false},
+		{"precision", "20190306^^^default", time.Date(2019, 3, 6, 0, 0, 0, 0, time.UTC), false},
+		{"empty", "", time.Time{}, false},
+		{"short offset", "20240131-05", time.Date(2024, 1, 31, 0, 0, 0, 0, time.FixedZone("", -5*3600)), false},
+		{"bad offset", "20240131-5", time.Time{}, true},
+		{"bad offset minutes", "20240131-0575", time.Time{}, true},
+		{"letters", "Invalid date", time.Time{}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -28,3 +50,218 @@ func Test_lineDecoder_parseDateTime(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalLimits(t *testing.T) {
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rEVN|A01\rPID|1||123\rPV1|1|I")
+
+	v, err := Unmarshal(raw, v251.Registry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.(v251.ADT_A01); !ok {
+		t.Fatalf("got %T", v)
+	}
+
+	_, err = Unmarshal(raw, v251.Registry, &DecodeOption{MaxSegments: 3})
+	if err == nil || !strings.Contains(err.Error(), "segment count 4 exceeds the maximum of 3") {
+		t.Fatalf("expected segment limit error, got %v", err)
+	}
+	_, err = Unmarshal(raw, v251.Registry, &DecodeOption{MaxLength: 20})
+	if err == nil || !strings.Contains(err.Error(), "exceeds the maximum of 20") {
+		t.Fatalf("expected length limit error, got %v", err)
+	}
+	if _, err = Unmarshal(raw, v251.Registry, &DecodeOption{MaxLength: len(raw), MaxSegments: 4}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTerminator(t *testing.T) {
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rEVN|A01\r\nPID|1||123\nPV1|1|I\r")
+
+	if _, err := Unmarshal(raw, v251.Registry, nil); err != nil {
+		t.Fatal(err)
+	}
+	var warn []string
+	_, err := Unmarshal(raw, v251.Registry, &DecodeOption{Terminator: TerminatorWarn, Warn: func(err error) { warn = append(warn, err.Error()) }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"line 2: segment terminated by LF, not CR", "line 3: segment terminated by LF, not CR"}
+	if strings.Join(warn, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("got warnings %q", warn)
+	}
+	_, err = Unmarshal(raw, v251.Registry, &DecodeOption{Terminator: TerminatorError})
+	if err == nil || !strings.HasSuffix(err.Error(), want[0]) {
+		t.Fatalf("expected terminator error, got %v", err)
+	}
+	if _, err = Unmarshal([]byte(strings.ReplaceAll(string(raw), "\n", "")), v251.Registry, &DecodeOption{Terminator: TerminatorError}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeMessages(t *testing.T) {
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rEVN|A01\rPID|1||123\rPV1|1|I\r" +
+		"MSH|^~\\&|||||||ADT^A01^ADT_A01|2|P|2.5.1|\rEVN|A01\rPID|1||456\rPV1|1|O\r")
+
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	split := SplitMessages(list)
+	if len(split) != 2 || len(split[0]) != 4 || len(split[1]) != 4 {
+		t.Fatalf("got split %v", split)
+	}
+	if len(SplitMessages(nil)) != 0 {
+		t.Fatal("expected no messages")
+	}
+
+	msgs, err := d.DecodeMessages(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("got %d messages", len(msgs))
+	}
+	for i, id := range []string{"123", "456"} {
+		adt := msgs[i].(v251.ADT_A01)
+		if got := adt.PID.PatientIdentifierList[0].IDNumber; got != id {
+			t.Errorf("message %d: got ID %q, want %q", i+1, got, id)
+		}
+	}
+}
+
+func TestDecodeOrderGroups(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "oml_o21.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.OML_O21)
+	if len(msg.Order) != 1 {
+		t.Fatalf("got %d orders", len(msg.Order))
+	}
+	o := msg.Order[0]
+	if len(o.Tiiming) != 1 || o.Tiiming[0].TQ1 == nil || o.ObservationRequest == nil {
+		t.Fatalf("got %+v", o)
+	}
+	req := o.ObservationRequest
+	if len(req.Specimen) != 1 || req.Specimen[0].SPM.SpecimenType.Identifier != "119297000" {
+		t.Fatalf("got %+v", req.Specimen)
+	}
+	if len(req.Specimen[0].OBX) != 1 || len(req.NTE) != 1 {
+		t.Errorf("got %+v", req)
+	}
+}
+
+func TestDecodeFinancialGroups(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "dft_p03.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.DFT_P03)
+	if len(msg.Financial) != 1 {
+		t.Fatalf("got %d financial transactions", len(msg.Financial))
+	}
+	f := msg.Financial[0]
+	if f.FT1.TransactionCode.Identifier != "99213" {
+		t.Errorf("got transaction code %+v", f.FT1.TransactionCode)
+	}
+	if len(f.FinancialProcedure) != 1 || f.FinancialProcedure[0].PR1.ProcedureCode.Identifier != "99213" {
+		t.Errorf("got procedures %+v", f.FinancialProcedure)
+	}
+	if len(msg.DG1) != 1 || len(msg.GT1) != 1 || len(msg.Insurance) != 1 {
+		t.Errorf("got DG1 %d, GT1 %d, insurance %d", len(msg.DG1), len(msg.GT1), len(msg.Insurance))
+	}
+}
+
+func TestDecodeVisitGroups(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "bar_p01.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.BAR_P01)
+	if len(msg.Visit) != 2 {
+		t.Fatalf("got %d visits", len(msg.Visit))
+	}
+	for i, want := range []string{"V200", "V201"} {
+		visit := msg.Visit[i]
+		if got := visit.PV1.VisitNumber.IDNumber; got != want {
+			t.Errorf("visit %d: got visit number %q, want %q", i, got, want)
+		}
+		if len(visit.DG1) != 1 {
+			t.Errorf("visit %d: got %d diagnoses", i, len(visit.DG1))
+		}
+	}
+	if v := msg.Visit[0]; len(v.GT1) != 1 || len(v.Insurance) != 1 {
+		t.Errorf("got GT1 %d, insurance %d", len(v.GT1), len(v.Insurance))
+	}
+}
+
+func TestDecodeMasterFileGroups(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "mfn_m02.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.MFN_M02)
+	if got := msg.MFI.MasterFileIdentifier.Identifier; got != "PRA" {
+		t.Errorf("got master file %q", got)
+	}
+	if len(msg.MfStaff) != 2 {
+		t.Fatalf("got %d staff entries", len(msg.MfStaff))
+	}
+	for i, want := range []string{"601-1", "601-2"} {
+		if got := msg.MfStaff[i].MFE.MFNControlID; got != want {
+			t.Errorf("entry %d: got control ID %q, want %q", i, got, want)
+		}
+	}
+	if len(msg.MfStaff[0].PRA) != 1 || len(msg.MfStaff[1].PRA) != 0 {
+		t.Errorf("got PRA %d and %d", len(msg.MfStaff[0].PRA), len(msg.MfStaff[1].PRA))
+	}
+}
+
+func TestDecodeInsuranceGroups(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "adt_a01.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := v.(v251.ADT_A01)
+	if len(msg.GT1) != 1 || msg.GT1[0].GuarantorRelationship == nil || msg.GT1[0].GuarantorRelationship.Identifier != "SEL" {
+		t.Errorf("got guarantor %+v", msg.GT1)
+	}
+	if len(msg.Insurance) != 2 {
+		t.Fatalf("got %d insurance groups", len(msg.Insurance))
+	}
+	first, second := msg.Insurance[0], msg.Insurance[1]
+	if got := first.IN1.InsuranceCompanyName[0].OrganizationName; got != "Blue Cross Blue Shield" {
+		t.Errorf("got company name %q", got)
+	}
+	if got := first.IN1.InsuredsRelationshipToPatient.Identifier; got != "SEL" {
+		t.Errorf("got relationship %q", got)
+	}
+	if first.IN2 == nil || first.IN2.InsuredsSocialSecurityNumber != "123-45-6789" || len(first.IN3) != 1 {
+		t.Errorf("got first insurance %+v %+v", first.IN2, first.IN3)
+	}
+	if second.IN2 == nil || second.IN2.InsuredsSocialSecurityNumber != "987-65-4321" || len(second.IN3) != 0 {
+		t.Errorf("got second insurance %+v %+v", second.IN2, second.IN3)
+	}
+}
@@ -0,0 +1,193 @@
+package hl7
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDuplicate is returned by a Dedup middleware for a message already seen, if there is no Duplicate handler.
+var ErrDuplicate = errors.New("duplicate message")
+
+// DedupStore records the keys of messages seen. A store shared between processes, such as one in a database,
+// lets a set of receivers detect the retransmissions of each other.
+type DedupStore interface {
+	// Record records the key and reports if it was already recorded within the window.
+	Record(key string, window time.Duration) (seen bool, err error)
+
+	// Forget removes a recorded key, such as for a message that could not be handled, so its retransmission
+	// is not a duplicate.
+	Forget(key string) error
+}
+
+// DedupOption sets how Dedup detects duplicate messages.
+type DedupOption struct {
+	// Window is how long a message is remembered. If zero, 24 hours.
+	Window time.Duration
+
+	// Store records the keys of messages seen. If nil, a MemoryDedupStore of 10000 keys is used.
+	Store DedupStore
+
+	// Key returns the key of a message. If nil, the key is the sending application (MSH-3), sending facility (MSH-4),
+	// and control ID (MSH-10), as control IDs are only unique for each sender. A message with an empty key
+	// is never a duplicate.
+	Key func(message any) (string, error)
+
+	// Duplicate handles duplicate messages for the Dedup middleware, such as by returning the ACK sent
+	// for the first message. If nil, the middleware returns ErrDuplicate.
+	Duplicate Handler
+}
+
+// Dedup detects messages that were already received, as sending systems often retransmit messages
+// they did not see acknowledged.
+type Dedup struct {
+	opt DedupOption
+}
+
+// NewDedup returns a Dedup. Option may be nil.
+func NewDedup(opt *DedupOption) *Dedup {
+	d := &Dedup{}
+	if opt != nil {
+		d.opt = *opt
+	}
+	if d.opt.Window <= 0 {
+		d.opt.Window = 24 * time.Hour
+	}
+	if d.opt.Store == nil {
+		d.opt.Store = NewMemoryDedupStore(10000)
+	}
+	if d.opt.Key == nil {
+		d.opt.Key = dedupKey
+	}
+	return d
+}
+
+// dedupKey returns the sending application, sending facility, and control ID of the message.
+func dedupKey(message any) (string, error) {
+	msh, ok := findSegment(message, "MSH")
+	if !ok {
+		return "", fmt.Errorf("missing MSH segment")
+	}
+	id := stringField(msh, 10)
+	if len(id) == 0 {
+		return "", nil
+	}
+	return stringComponent(msh, 3) + "^" + stringComponent(msh, 4) + "^" + id, nil
+}
+
+// Check records the message and reports if it is a duplicate of a message seen within the window.
+func (d *Dedup) Check(message any) (bool, error) {
+	_, seen, err := d.check(message)
+	return seen, err
+}
+
+// check records the message and returns its key, which is empty if it has none.
+func (d *Dedup) check(message any) (string, bool, error) {
+	key, err := d.opt.Key(message)
+	if err != nil {
+		return "", false, fmt.Errorf("dedup: %w", err)
+	}
+	if len(key) == 0 {
+		return "", false, nil
+	}
+	seen, err := d.opt.Store.Record(key, d.opt.Window)
+	if err != nil {
+		return "", false, fmt.Errorf("dedup: %w", err)
+	}
+	return key, seen, nil
+}
+
+// Middleware is a Middleware that passes duplicate messages to the Duplicate handler, rather than the next handler.
+// If the next handler returns an error, the message is forgotten, as the sender gets no ACK and sends it again.
+func (d *Dedup) Middleware(next Handler) Handler {
+	return ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+		key, dup, err := d.check(message)
+		if err != nil {
+			return nil, err
+		}
+		if !dup {
+			resp, err := ServeContext(ctx, next, message)
+			if err != nil && len(key) > 0 {
+				if ferr := d.opt.Store.Forget(key); ferr != nil {
+					return nil, fmt.Errorf("%w; dedup: %v", err, ferr)
+				}
+			}
+			return resp, err
+		}
+		if d.opt.Duplicate == nil {
+			return nil, ErrDuplicate
+		}
+		return ServeContext(ctx, d.opt.Duplicate, message)
+	})
+}
+
+// MemoryDedupStore is a DedupStore in memory that keeps the most recently used keys.
+type MemoryDedupStore struct {
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	mu    sync.Mutex
+	size  int
+	order *list.List // Of *dedupEntry, most recently used first.
+	index map[string]*list.Element
+}
+
+type dedupEntry struct {
+	key  string
+	seen time.Time
+}
+
+// NewMemoryDedupStore returns a MemoryDedupStore of up to size keys. When full, the least recently used key is removed.
+func NewMemoryDedupStore(size int) *MemoryDedupStore {
+	if size < 1 {
+		size = 1
+	}
+	return &MemoryDedupStore{
+		size:  size,
+		order: list.New(),
+		index: map[string]*list.Element{},
+	}
+}
+
+// Record records the key and reports if it was already recorded within the window.
+// A key is remembered from the first time it is recorded, so repeated retransmissions do not extend the window.
+func (s *MemoryDedupStore) Record(key string, window time.Duration) (bool, error) {
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	t := now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.index[key]; ok {
+		e := el.Value.(*dedupEntry)
+		if t.Sub(e.seen) < window {
+			s.order.MoveToFront(el)
+			return true, nil
+		}
+		e.seen = t
+		s.order.MoveToFront(el)
+		return false, nil
+	}
+	s.index[key] = s.order.PushFront(&dedupEntry{key: key, seen: t})
+	for s.order.Len() > s.size {
+		el := s.order.Back()
+		s.order.Remove(el)
+		delete(s.index, el.Value.(*dedupEntry).key)
+	}
+	return false, nil
+}
+
+// Forget removes the key.
+func (s *MemoryDedupStore) Forget(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.index[key]; ok {
+		s.order.Remove(el)
+		delete(s.index, key)
+	}
+	return nil
+}
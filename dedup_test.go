@@ -0,0 +1,97 @@
+package hl7
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestDedup(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := NewMemoryDedupStore(2)
+	store.Now = func() time.Time { return now }
+	d := NewDedup(&DedupOption{Window: time.Hour, Store: store})
+
+	d251 := NewDecoder(v251.Registry, nil)
+	decode := func(sender, id string) any {
+		list, err := d251.DecodeList([]byte("MSH|^~\\&|" + sender + "|HOSP|||20240102||ADT^A01|" + id + "|P|2.5.1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+	check := func(msg any, want bool) {
+		t.Helper()
+		got, err := d.Check(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got duplicate %t, want %t", got, want)
+		}
+	}
+	check(decode("LAB", "1"), false)
+	check(decode("LAB", "1"), true)
+	check(decode("RIS", "1"), false) // Control IDs are unique for each sender.
+	check(decode("LAB", "2"), false) // The store is full, so LAB^1, the least recently used, is removed.
+	check(decode("RIS", "1"), true)
+	check(decode("LAB", "1"), false)
+	check(decode("LAB", ""), false)
+	check(decode("LAB", ""), false)
+
+	now = now.Add(2 * time.Hour)
+	check(decode("RIS", "1"), false)
+
+	var handled, dups int
+	h := Chain(HandlerFunc(func(message any) (any, error) {
+		handled++
+		return "AA", nil
+	}), d.Middleware)
+	if resp, err := h.ServeHL7(decode("LAB", "3")); err != nil || resp != "AA" {
+		t.Fatalf("got %v, %v", resp, err)
+	}
+	if _, err := h.ServeHL7(decode("LAB", "3")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("got %v, want ErrDuplicate", err)
+	}
+	d = NewDedup(&DedupOption{Duplicate: HandlerFunc(func(message any) (any, error) {
+		dups++
+		return "AA", nil
+	})})
+	h = Chain(HandlerFunc(func(message any) (any, error) {
+		handled++
+		return "AA", nil
+	}), d.Middleware)
+	for i := 0; i < 3; i++ {
+		if resp, err := h.ServeHL7(decode("LAB", "3")); err != nil || resp != "AA" {
+			t.Fatalf("got %v, %v", resp, err)
+		}
+	}
+	if handled != 2 || dups != 2 {
+		t.Errorf("got %d handled, %d duplicates", handled, dups)
+	}
+
+	// A message the handler fails is not a duplicate when it is sent again.
+	fail := true
+	h = Chain(HandlerFunc(func(message any) (any, error) {
+		if fail {
+			fail = false
+			return nil, errors.New("database down")
+		}
+		return "AA", nil
+	}), NewDedup(nil).Middleware)
+	if _, err := h.ServeHL7(decode("LAB", "4")); err == nil || errors.Is(err, ErrDuplicate) {
+		t.Errorf("got %v, want the handler error", err)
+	}
+	if resp, err := h.ServeHL7(decode("LAB", "4")); err != nil || resp != "AA" {
+		t.Errorf("retransmission: got %v, %v", resp, err)
+	}
+	if _, err := h.ServeHL7(decode("LAB", "4")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("got %v, want ErrDuplicate after success", err)
+	}
+
+	if _, err := d.Check([]any{}); err == nil {
+		t.Errorf("expected an error without MSH")
+	}
+}
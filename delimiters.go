@@ -0,0 +1,88 @@
+package hl7
+
+import "fmt"
+
+// Delimiters of a message, as set in MSH-1 and MSH-2 (or BHS and FHS).
+type Delimiters struct {
+	Field        byte // Usually a |
+	Component    byte // Usually a ^
+	Repeat       byte // Usually a ~
+	Escape       byte // Usually a \
+	Subcomponent byte // Usually a &
+	Truncation   byte // Usually a # in v2.7 and later, zero if not used.
+}
+
+// DefaultDelimiters are the standard delimiters without a truncation character.
+var DefaultDelimiters = Delimiters{
+	Field:        '|',
+	Component:    '^',
+	Repeat:       '~',
+	Escape:       '\\',
+	Subcomponent: '&',
+}
+
+// ParseDelimiters returns the delimiters from the field separator (MSH-1) and
+// the encoding characters (MSH-2). The encoding characters may be four characters,
+// or five characters when the v2.7 truncation character is present.
+func ParseDelimiters(fieldSep, encodingChars string) (Delimiters, error) {
+	if len(fieldSep) != 1 {
+		return Delimiters{}, fmt.Errorf("field separator %q must be a single character", fieldSep)
+	}
+	if len(encodingChars) != 4 && len(encodingChars) != 5 {
+		return Delimiters{}, fmt.Errorf("encoding characters %q must be four or five characters", encodingChars)
+	}
+	d := Delimiters{
+		Field:        fieldSep[0],
+		Component:    encodingChars[0],
+		Repeat:       encodingChars[1],
+		Escape:       encodingChars[2],
+		Subcomponent: encodingChars[3],
+	}
+	if len(encodingChars) == 5 {
+		d.Truncation = encodingChars[4]
+	}
+	return d, nil
+}
+
+// EncodingCharacters returns the value of MSH-2.
+func (d Delimiters) EncodingCharacters() string {
+	b := []byte{d.Component, d.Repeat, d.Escape, d.Subcomponent}
+	if d.Truncation != 0 {
+		b = append(b, d.Truncation)
+	}
+	return string(b)
+}
+
+// parseInitDelimiters reads the delimiters at the start of a header segment,
+// directly after the segment ID, and returns the number of bytes read.
+func parseInitDelimiters(data []byte) (Delimiters, int, error) {
+	if len(data) < 5 {
+		return Delimiters{}, 0, fmt.Errorf("missing format delims")
+	}
+	n := 5
+	// The truncation character is present if MSH-2 is five characters.
+	if len(data) > 5 && data[5] != data[0] && data[5] != '\r' && data[5] != '\n' {
+		n = 6
+	}
+	d, err := ParseDelimiters(string(data[:1]), string(data[1:n]))
+	return d, n, err
+}
+
+func (d *lineDecoder) setDelimiters(dl Delimiters) {
+	d.sep = dl.Field
+	d.chars = [4]byte{dl.Component, dl.Repeat, dl.Escape, dl.Subcomponent}
+	d.truncate = dl.Truncation
+
+	d.dividers = [3]byte{d.sep, d.chars[0], d.chars[3]}
+	d.repeat = d.chars[1]
+	d.escape = d.chars[2]
+	d.readSep = true
+}
+
+// encodingChars returns the value of MSH-2 for the active delimiters.
+func (d *lineDecoder) encodingChars() string {
+	if d.truncate == 0 {
+		return string(d.chars[:])
+	}
+	return string(d.chars[:]) + string(d.truncate)
+}
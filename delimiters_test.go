@@ -0,0 +1,100 @@
+package hl7
+
+import (
+	"bytes"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestParseDelimiters(t *testing.T) {
+	tests := []struct {
+		name    string
+		sep     string
+		chars   string
+		want    Delimiters
+		wantErr bool
+	}{
+		{"default", "|", `^~\&`, DefaultDelimiters, false},
+		{"truncation", "|", `^~\&#`, Delimiters{'|', '^', '~', '\\', '&', '#'}, false},
+		{"short", "|", `^~\`, Delimiters{}, true},
+		{"long sep", "||", `^~\&`, Delimiters{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDelimiters(tt.sep, tt.chars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDelimiters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseDelimiters() got %+v, want %+v", got, tt.want)
+			}
+			if err == nil && got.EncodingCharacters() != tt.chars {
+				t.Fatalf("EncodingCharacters() got %q, want %q", got.EncodingCharacters(), tt.chars)
+			}
+		})
+	}
+}
+
+func TestTruncationCharacter(t *testing.T) {
+	var raw = []byte("MSH|^~\\&#||||||||||2.5.1^^|||||||||\rNTE|1||Room \\P\\4|")
+
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msh := list[0].(*v251.MSH)
+	if msh.EncodingCharacters != `^~\&#` {
+		t.Fatalf("got encoding characters %q", msh.EncodingCharacters)
+	}
+	nte := list[1].(*v251.NTE)
+	if got := nte.Comment[0]; got != "Room #4" {
+		t.Fatalf("got comment %q", got)
+	}
+
+	e := NewEncoder(nil)
+	var out []byte
+	for _, seg := range list {
+		bb, err := e.Encode(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, bb...)
+	}
+	if !bytes.HasPrefix(out, []byte(`MSH|^~\&#|`)) {
+		t.Fatalf("missing truncation character in MSH-2: %q", out)
+	}
+	if !bytes.Contains(out, []byte(`NTE|1||Room \P\4|`)) {
+		t.Fatalf("truncation character not escaped: %q", out)
+	}
+}
+
+func TestDecodeFragmentDelimiters(t *testing.T) {
+	raw := []byte("PID|1||123^^^HOSP~456^^^LAB||DOE^JOHN\rPV1|1|I")
+	if _, err := NewDecoder(v251.Registry, nil).DecodeList(raw); err == nil {
+		t.Fatal("expected error decoding without delimiters")
+	}
+
+	list, err := NewDecoder(v251.Registry, &DecodeOption{Delimiters: &DefaultDelimiters}).DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("got %d segments", len(list))
+	}
+	pid := list[0].(*v251.PID)
+	if len(pid.PatientIdentifierList) != 2 || pid.PatientIdentifierList[1].IDNumber != "456" || pid.PatientName[0].GivenName != "JOHN" {
+		t.Fatalf("got %+v", pid)
+	}
+
+	custom := Delimiters{Field: '!', Component: '*', Repeat: '+', Escape: '\\', Subcomponent: '@'}
+	list, err = NewDecoder(v251.Registry, &DecodeOption{Delimiters: &custom}).DecodeList([]byte("PID!1!!123***HOSP@1.2.3@ISO+456"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := list[0].(*v251.PID).PatientIdentifierList
+	if len(ids) != 2 || ids[0].IDNumber != "123" || ids[0].AssigningAuthority.UniversalID != "1.2.3" {
+		t.Fatalf("got %+v", ids)
+	}
+}
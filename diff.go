@@ -0,0 +1,350 @@
+package hl7
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DiffOption sets how messages are compared by Diff.
+type DiffOption struct {
+	// Ignore lists paths whose values are not compared, such as MSH-7 or OBX-14.
+	// A path also ignores the components within it, and a segment without a number, such as OBX-14,
+	// ignores the path in each of its segments, while OBX[2]-14 ignores it in the second only.
+	// A segment name alone ignores the whole segment.
+	Ignore []string
+
+	// Decode sets how raw messages are read. May be nil.
+	Decode *DecodeOption
+}
+
+// Difference is a value that differs between two messages.
+type Difference struct {
+	Path string   // HL7 path, such as PID-5.1 or OBX[2]-5.
+	Old  []string // Value of each repetition in the first message, or nil if not sent.
+	New  []string // Value of each repetition in the second message, or nil if not sent.
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %q -> %q", d.Path, strings.Join(d.Old, "~"), strings.Join(d.New, "~"))
+}
+
+// Diff compares two messages and returns each value that differs, in message order.
+// A message is raw HL7 as a []byte or string, or a decoded trigger or segment list.
+// Values are compared as unescaped text by position, so a decoded message may be compared with a raw message,
+// and messages of different versions or with unknown segments may be compared.
+// Option may be nil.
+func Diff(a, b any, opt *DiffOption) ([]Difference, error) {
+	var o DiffOption
+	if opt != nil {
+		o = *opt
+	}
+	ignore, err := parsePathPatterns(o.Ignore)
+	if err != nil {
+		return nil, fmt.Errorf("diff: ignore: %w", err)
+	}
+	av, err := diffValues(a, o.Decode)
+	if err != nil {
+		return nil, fmt.Errorf("diff: first message: %w", err)
+	}
+	bv, err := diffValues(b, o.Decode)
+	if err != nil {
+		return nil, fmt.Errorf("diff: second message: %w", err)
+	}
+
+	// Paths are written with a component or subcomponent only if either message has one after the first.
+	comps := map[diffKey]int{}
+	for _, v := range []*diffSet{av, bv} {
+		for _, k := range v.keys {
+			f := diffKey{seg: k.seg, pos: [3]int{k.pos[0]}}
+			c := diffKey{seg: k.seg, pos: [3]int{k.pos[0], k.pos[1]}}
+			if k.pos[1] > comps[f] {
+				comps[f] = k.pos[1]
+			}
+			if k.pos[2] > comps[c] {
+				comps[c] = k.pos[2]
+			}
+		}
+	}
+	path := func(k diffKey) string {
+		p := k.seg + "-" + strconv.Itoa(k.pos[0])
+		f := diffKey{seg: k.seg, pos: [3]int{k.pos[0]}}
+		c := diffKey{seg: k.seg, pos: [3]int{k.pos[0], k.pos[1]}}
+		sub := comps[c] > 1
+		if sub || comps[f] > 1 {
+			p += "." + strconv.Itoa(k.pos[1])
+		}
+		if sub {
+			p += "." + strconv.Itoa(k.pos[2])
+		}
+		return p
+	}
+
+	var list []Difference
+	keys := append(av.keys, bv.keys...)
+	seen := map[diffKey]bool{}
+	for _, k := range keys {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if k.matches(ignore) {
+			continue
+		}
+		ov, nv := av.values[k], bv.values[k]
+		if diffEqual(ov, nv) {
+			continue
+		}
+		list = append(list, Difference{Path: path(k), Old: ov, New: nv})
+	}
+	return list, nil
+}
+
+// diffKey is the segment, numbered if after the first such as OBX[2], and the field, component, and subcomponent of a value.
+type diffKey struct {
+	seg string
+	pos [3]int
+}
+
+type diffSet struct {
+	keys   []diffKey
+	values map[diffKey][]string // Value of each repetition.
+}
+
+// diffSegments returns the segments of a raw or decoded message, with the name of each numbered if after the first, such as OBX[2].
+func diffSegments(message any, opt *DecodeOption) ([]GenericSegment, error) {
+	var data []byte
+	switch m := message.(type) {
+	case []byte:
+		data = m
+	case string:
+		data = []byte(m)
+	default:
+		var err error
+		data, err = NewEncoder(nil).Encode(message)
+		if err != nil {
+			return nil, err
+		}
+		opt = nil
+	}
+	segs, err := UnmarshalGeneric(data, opt)
+	if err != nil {
+		return nil, err
+	}
+	count := map[string]int{}
+	for i, s := range segs {
+		count[s.Name]++
+		if c := count[s.Name]; c > 1 {
+			segs[i].Name += "[" + strconv.Itoa(c) + "]"
+		}
+	}
+	return segs, nil
+}
+
+// diffValues returns the values of a message by position.
+func diffValues(message any, opt *DecodeOption) (*diffSet, error) {
+	segs, err := diffSegments(message, opt)
+	if err != nil {
+		return nil, err
+	}
+	set := &diffSet{values: map[diffKey][]string{}}
+	for _, s := range segs {
+		seg := s.Name
+		for f, reps := range s.Fields {
+			for r, comps := range reps {
+				for c, subs := range comps {
+					for sub, v := range subs {
+						if len(v) == 0 {
+							continue
+						}
+						k := diffKey{seg: seg, pos: [3]int{f + 1, c + 1, sub + 1}}
+						values, ok := set.values[k]
+						if !ok {
+							set.keys = append(set.keys, k)
+						}
+						for len(values) <= r {
+							values = append(values, "")
+						}
+						values[r] = v
+						set.values[k] = values
+					}
+				}
+			}
+		}
+	}
+	return set, nil
+}
+
+func diffEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathPattern is a parsed path that matches the values within it. A zero position matches any.
+type pathPattern struct {
+	name string
+	num  int // Segment number, or zero for each segment.
+	pos  [3]int
+}
+
+func parsePathPatterns(paths []string) ([]pathPattern, error) {
+	list := make([]pathPattern, len(paths))
+	for i, p := range paths {
+		pp, err := parsePathPattern(p)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = pp
+	}
+	return list, nil
+}
+
+func parsePathPattern(path string) (pathPattern, error) {
+	seg, pos, err := parsePath(path)
+	if err != nil {
+		return pathPattern{}, err
+	}
+	pp := pathPattern{pos: pos}
+	name, num, numbered := strings.Cut(seg, "[")
+	pp.name = name
+	if numbered {
+		pp.num, _ = strconv.Atoi(strings.TrimSuffix(num, "]"))
+	} else if strings.Contains(path, "[") {
+		// A first segment written as OBX[1] has no number after parsePath.
+		pp.num = 1
+	}
+	return pp, nil
+}
+
+func (k diffKey) matches(list []pathPattern) bool {
+	name, num, numbered := strings.Cut(k.seg, "[")
+	n := 1
+	if numbered {
+		n, _ = strconv.Atoi(strings.TrimSuffix(num, "]"))
+	}
+	for _, pp := range list {
+		if pp.name != name || (pp.num != 0 && pp.num != n) {
+			continue
+		}
+		match := true
+		for i, p := range pp.pos {
+			if p != 0 && p != k.pos[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualOption sets how messages are compared by Equal.
+type EqualOption struct {
+	// Ignore lists paths whose values are not compared, as for DiffOption.
+	Ignore []string
+
+	// NullEmpty compares an explicit null, "", as a value not sent.
+	NullEmpty bool
+
+	// Unordered compares the repetitions of each field in any order, so A~B equals B~A.
+	Unordered bool
+
+	// Decode sets how raw messages are read. May be nil.
+	Decode *DecodeOption
+}
+
+// Equal reports whether two messages have the same values, compared as by Diff: a message is raw HL7 as
+// a []byte or string, or a decoded trigger or segment list, and values are compared as unescaped text by position,
+// so the delimiters, such as MSH-1 and MSH-2, and trailing empty fields or components are not significant.
+// Option may be nil.
+func Equal(a, b any, opt *EqualOption) (bool, error) {
+	var o EqualOption
+	if opt != nil {
+		o = *opt
+	}
+	ignore, err := parsePathPatterns(o.Ignore)
+	if err != nil {
+		return false, fmt.Errorf("equal: ignore: %w", err)
+	}
+	af, err := equalFields(a, &o, ignore)
+	if err != nil {
+		return false, fmt.Errorf("equal: first message: %w", err)
+	}
+	bf, err := equalFields(b, &o, ignore)
+	if err != nil {
+		return false, fmt.Errorf("equal: second message: %w", err)
+	}
+	if len(af) != len(bf) {
+		return false, nil
+	}
+	for k, ra := range af {
+		rb, ok := bf[k]
+		if !ok {
+			return false, nil
+		}
+		if o.Unordered {
+			sort.Strings(ra)
+			sort.Strings(rb)
+		}
+		if !diffEqual(ra, rb) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// equalFields returns the repetitions of each field sent, by segment and field position.
+// Each repetition is written as the position and value of each of its components and subcomponents.
+func equalFields(message any, o *EqualOption, ignore []pathPattern) (map[diffKey][]string, error) {
+	segs, err := diffSegments(message, o.Decode)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[diffKey][]string{}
+	for _, s := range segs {
+		name, _, _ := strings.Cut(s.Name, "[")
+		header := name == "MSH" || name == "BHS" || name == "FHS"
+		for f, reps := range s.Fields {
+			if header && f < 2 {
+				// The delimiters are not values.
+				continue
+			}
+			var list []string
+			for _, comps := range reps {
+				buf := &strings.Builder{}
+				for c, subs := range comps {
+					for sub, v := range subs {
+						if len(v) == 0 || (o.NullEmpty && v == nullValue) {
+							continue
+						}
+						if (diffKey{seg: s.Name, pos: [3]int{f + 1, c + 1, sub + 1}}).matches(ignore) {
+							continue
+						}
+						fmt.Fprintf(buf, "%d.%d=%s\x00", c+1, sub+1, v)
+					}
+				}
+				if buf.Len() == 0 && o.Unordered {
+					continue
+				}
+				list = append(list, buf.String())
+			}
+			for len(list) > 0 && len(list[len(list)-1]) == 0 {
+				list = list[:len(list)-1]
+			}
+			if len(list) > 0 {
+				fields[diffKey{seg: s.Name, pos: [3]int{f + 1}}] = list
+			}
+		}
+	}
+	return fields, nil
+}
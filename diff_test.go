@@ -2,7 +2,11 @@ package hl7
 
 import (
 	"bytes"
+	"reflect"
+	"strings"
+	"testing"
 
+	v251 "github.com/kardianos/hl7/h251"
 	"github.com/mb0/diff"
 )
 
@@ -94,3 +98,103 @@ func red(b []byte) []byte {
 	buf.WriteString("\x1b[0m")
 	return buf.Bytes()
 }
+
+func TestDiff(t *testing.T) {
+	a := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||123^^^MRN^MR~456||Doe^Jane||19800101|F`,
+		`OBR|1||X|CBC`,
+		`OBX|1|NM|WBC||7.1|||N`,
+		`OBX|2|NM|RBC||4.5|||N`,
+	}, "\r")
+	b := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240202030405||ORU^R01^ORU_R01|2|P|2.5.1`,
+		`PID|||123^^^MRN^MR~789||Doe^Janet||19800101`,
+		`OBR|1||X|CBC`,
+		`OBX|1|NM|WBC||7.1|||N`,
+		`OBX|2|NM|RBC||4.9|||H`,
+		`NTE|1||Rechecked`,
+	}, "\r")
+	got, err := Diff(a, []byte(b), &DiffOption{Ignore: []string{"MSH-7", "MSH-10", "OBX-8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Difference{
+		{Path: "PID-3.1", Old: []string{"123", "456"}, New: []string{"123", "789"}},
+		{Path: "PID-5.2", Old: []string{"Jane"}, New: []string{"Janet"}},
+		{Path: "PID-8", Old: []string{"F"}},
+		{Path: "OBX[2]-5", Old: []string{"4.5"}, New: []string{"4.9"}},
+		{Path: "NTE-1", New: []string{"1"}},
+		{Path: "NTE-3", New: []string{"Rechecked"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v\nwant %v", got, want)
+	}
+
+	got, err = Diff(a, b, &DiffOption{Ignore: []string{"MSH", "PID", "OBX[2]", "NTE"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("got %v", got)
+	}
+
+	// A decoded message is compared as encoded, with the set ID and the date time of birth to the second.
+	msg, err := NewDecoder(v251.Registry, nil).Decode([]byte(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = Diff(msg, a, &DiffOption{Ignore: []string{"PID-1", "PID-7"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("got %v", got)
+	}
+
+	if _, err := Diff(a, b, &DiffOption{Ignore: []string{"PID-X"}}); err == nil {
+		t.Errorf("expected an error for an invalid ignore path")
+	}
+	if _, err := Diff(a, "PID|1", nil); err == nil {
+		t.Errorf("expected an error for a message without a header")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	base := "MSH|^~\\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1\rPID|||123^^^MRN~456||Doe^Jane\rOBX|1|ST|X||A"
+	list := []struct {
+		name  string
+		b     string
+		opt   *EqualOption
+		equal bool
+	}{
+		{name: "same", b: base, equal: true},
+		{
+			name:  "delimiters and trailing",
+			b:     "MSH*#~!@*LAB*HOSP***20240102030405**ORU#R01#ORU_R01*1*P*2.5.1***\nPID***123###MRN~456**Doe#Jane##\nOBX*1*ST*X**A\n",
+			equal: true,
+		},
+		{name: "value", b: strings.Replace(base, "Jane", "Janet", 1)},
+		{name: "order", b: strings.Replace(base, "123^^^MRN~456", "456~123^^^MRN", 1)},
+		{name: "unordered", b: strings.Replace(base, "123^^^MRN~456", "456~123^^^MRN", 1), opt: &EqualOption{Unordered: true}, equal: true},
+		{name: "null", b: base + "|||\"\""},
+		{name: "null empty", b: base + "|||\"\"", opt: &EqualOption{NullEmpty: true}, equal: true},
+		{name: "empty repetition", b: strings.Replace(base, "MRN~456", "MRN~~456", 1)},
+		{name: "ignore", b: strings.Replace(base, "|1|P|", "|2|P|", 1), opt: &EqualOption{Ignore: []string{"MSH-10"}}, equal: true},
+		{name: "extra segment", b: base + "\rNTE|1||note"},
+	}
+	for _, item := range list {
+		t.Run(item.name, func(t *testing.T) {
+			got, err := Equal(base, item.b, item.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != item.equal {
+				t.Errorf("got %t, want %t", got, item.equal)
+			}
+		})
+	}
+	if _, err := Equal(base, base, &EqualOption{Ignore: []string{"PID-"}}); err == nil {
+		t.Errorf("expected an error for an invalid ignore path")
+	}
+}
@@ -0,0 +1,219 @@
+package hl7
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Encodings of encapsulated data (ED-4), from HL7 table 0299.
+const (
+	EncodingASCII  = "A"      // Text without any encoding, escaped as other text.
+	EncodingHex    = "Hex"    // Hexadecimal digits.
+	EncodingBase64 = "Base64" // Base64 encoding, as in RFC 4648.
+)
+
+// EncodeData encodes binary data as encapsulated data text with the encoding.
+func EncodeData(data []byte, encoding string) (string, error) {
+	switch encoding {
+	default:
+		return "", fmt.Errorf("unknown encapsulated data encoding %q", encoding)
+	case EncodingASCII:
+		return string(data), nil
+	case EncodingHex:
+		return strings.ToUpper(hex.EncodeToString(data)), nil
+	case EncodingBase64:
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+}
+
+// DecodeData decodes encapsulated data text with the encoding to binary data.
+// Base64 data may contain line breaks or be missing its padding, which some senders do.
+func DecodeData(text, encoding string) ([]byte, error) {
+	switch encoding {
+	default:
+		return nil, fmt.Errorf("unknown encapsulated data encoding %q", encoding)
+	case EncodingASCII:
+		return []byte(text), nil
+	case EncodingHex:
+		return hex.DecodeString(text)
+	case EncodingBase64:
+		text = strings.Map(func(r rune) rune {
+			switch r {
+			case '\r', '\n', ' ':
+				return -1
+			}
+			return r
+		}, text)
+		return base64.RawStdEncoding.DecodeString(strings.TrimRight(text, "="))
+	}
+}
+
+// Document is a document sent as encapsulated data, such as in OBX-5 of an MDM^T02 message.
+type Document struct {
+	TypeOfData  string // ED-2, such as AP for application data or TEXT.
+	DataSubtype string // ED-3, such as PDF or RTF.
+	Encoding    string // ED-4, such as Base64.
+	Data        []byte // The decoded document.
+}
+
+// AttachDocument sets the OBX segment value type (OBX-2) to ED and adds the document to the observation value (OBX-5)
+// as the registry ED data type. The obx must be a pointer to an OBX segment of the same registry version.
+// If the document encoding is empty, Base64 is used.
+func AttachDocument(registry Registry, obx any, doc Document) error {
+	rv := reflect.ValueOf(obx)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct || segmentName(rv.Elem()) != "OBX" {
+		return fmt.Errorf("%T is not a pointer to an OBX segment", obx)
+	}
+	rv = rv.Elem()
+	dt, ok := registry.DataType()["ED"]
+	if !ok || reflect.TypeOf(dt).Kind() != reflect.Struct {
+		return fmt.Errorf("ED data type not found in registry")
+	}
+	if len(doc.Encoding) == 0 {
+		doc.Encoding = EncodingBase64
+	}
+	text, err := EncodeData(doc.Data, doc.Encoding)
+	if err != nil {
+		return err
+	}
+	ed := reflect.New(reflect.TypeOf(dt)).Elem()
+	for order, v := range map[int]string{2: doc.TypeOfData, 3: doc.DataSubtype, 4: doc.Encoding, 5: text} {
+		err := setStringField(ed, order, v)
+		if err != nil {
+			return fmt.Errorf("ED-%d: %w", order, err)
+		}
+	}
+
+	err = setStringField(rv, 2, "ED")
+	if err != nil {
+		return fmt.Errorf("OBX-2: %w", err)
+	}
+	_, fv, _, ok := fieldByOrder(rv, 5)
+	if !ok {
+		return fmt.Errorf("OBX-5 not found in %T", obx)
+	}
+	switch {
+	case fv.Kind() == reflect.Slice && ed.Type().AssignableTo(fv.Type().Elem()):
+		fv.Set(reflect.Append(fv, ed))
+	case fv.Kind() == reflect.Interface && ed.Type().AssignableTo(fv.Type()):
+		fv.Set(ed)
+	default:
+		return fmt.Errorf("OBX-5 %v cannot hold the %v data type", fv.Type(), ed.Type())
+	}
+	return nil
+}
+
+// ExtractDocuments returns the encapsulated data documents in the observation value (OBX-5) of the OBX segment.
+func ExtractDocuments(obx any) ([]Document, error) {
+	rv := reflect.Indirect(reflect.ValueOf(obx))
+	if rv.Kind() != reflect.Struct || segmentName(rv) != "OBX" {
+		return nil, fmt.Errorf("%T is not an OBX segment", obx)
+	}
+	_, fv, _, ok := fieldByOrder(rv, 5)
+	if !ok {
+		return nil, fmt.Errorf("OBX-5 not found in %T", obx)
+	}
+	values := []reflect.Value{fv}
+	if fv.Kind() == reflect.Slice {
+		values = values[:0]
+		for i := 0; i < fv.Len(); i++ {
+			values = append(values, fv.Index(i))
+		}
+	}
+	var list []Document
+	for i, v := range values {
+		for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct || segmentName(v) != "ED" {
+			continue
+		}
+		doc := Document{
+			TypeOfData:  stringField(v, 2),
+			DataSubtype: stringField(v, 3),
+			Encoding:    stringField(v, 4),
+		}
+		var err error
+		doc.Data, err = DecodeData(stringField(v, 5), doc.Encoding)
+		if err != nil {
+			return nil, fmt.Errorf("OBX-5 repetition %d: %w", i+1, err)
+		}
+		list = append(list, doc)
+	}
+	return list, nil
+}
+
+// setStringField sets a string field of the struct by field number.
+func setStringField(rv reflect.Value, order int, v string) error {
+	_, fv, _, ok := fieldByOrder(rv, order)
+	if !ok {
+		return fmt.Errorf("field not found in %v", rv.Type())
+	}
+	if fv.Kind() != reflect.String {
+		return fmt.Errorf("field %v is not a string", fv.Type())
+	}
+	fv.SetString(v)
+	return nil
+}
+
+// HL7Name is the meta field of the data types of this package, like the HL7Name of each version package.
+type HL7Name struct {
+	// Present is the number of components present when decoded with KeepTrailingComponents.
+	// When set, the encoder will write at least this many components, preserving trailing empty components.
+	Present int
+}
+
+// ED is the encapsulated data type. Add it to a registry with MapRegistry.AddDataType to decode
+// OBX-5 values of type ED as this type, including in versions before 2.5 that do not define it.
+type ED struct {
+	HL7               HL7Name `hl7:",name=ED,type=d"`
+	SourceApplication *HD     `hl7:"1"`
+	TypeOfData        string  `hl7:"2,required"`
+	DataSubtype       string  `hl7:"3"`
+	Encoding          string  `hl7:"4,required"`
+	Data              string  `hl7:"5,required"`
+}
+
+// Bytes returns the data decoded with its encoding.
+func (ed ED) Bytes() ([]byte, error) {
+	return DecodeData(ed.Data, ed.Encoding)
+}
+
+// SetBytes sets the data and the encoding. If the encoding is empty, Base64 is used.
+func (ed *ED) SetBytes(data []byte, encoding string) error {
+	if len(encoding) == 0 {
+		encoding = EncodingBase64
+	}
+	text, err := EncodeData(data, encoding)
+	if err != nil {
+		return err
+	}
+	ed.Encoding = encoding
+	ed.Data = text
+	return nil
+}
+
+// RP is the reference pointer data type, which refers to data stored on another system,
+// such as a document URL. No version registers it, so it must always be added to a registry with
+// MapRegistry.AddDataType to decode OBX-5 values of type RP.
+type RP struct {
+	HL7           HL7Name `hl7:",name=RP,type=d"`
+	Pointer       string  `hl7:"1"` // Key or URI of the data on the application system.
+	ApplicationID *HD     `hl7:"2"` // System that stores the data.
+	TypeOfData    string  `hl7:"3"` // Same values as ED-2.
+	Subtype       string  `hl7:"4"` // Same values as ED-3.
+}
+
+// HD is the hierarchic designator data type, which identifies the application of an ED or RP value.
+type HD struct {
+	HL7             HL7Name `hl7:",name=HD,type=d"`
+	NamespaceID     string  `hl7:"1"`
+	UniversalID     string  `hl7:"2"`
+	UniversalIDType string  `hl7:"3"`
+}
@@ -0,0 +1,119 @@
+package hl7
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestEncodeData(t *testing.T) {
+	data := []byte("%PDF-1.4\x00\xff|^~\\&")
+	for _, enc := range []string{EncodingASCII, EncodingHex, EncodingBase64} {
+		text, err := EncodeData(data, enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := DecodeData(text, enc)
+		if err != nil {
+			t.Fatalf("%s: %v", enc, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: got %q", enc, got)
+		}
+	}
+	if got, err := DecodeData("aGVs\r\nbG8", EncodingBase64); err != nil || string(got) != "hello" {
+		t.Errorf("got %q, %v", got, err)
+	}
+	if _, err := EncodeData(data, "UU"); err == nil {
+		t.Error("expected unknown encoding error")
+	}
+}
+
+func TestDocument(t *testing.T) {
+	pdf := []byte("%PDF-1.4 document \x00\x01\x02")
+	obx := &v251.OBX{ObservationIdentifier: v251.CE{Identifier: "18842-5"}}
+	err := AttachDocument(v251.Registry, obx, Document{TypeOfData: "AP", DataSubtype: "PDF", Data: pdf})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obx.ValueType != "ED" || len(obx.ObservationValue) != 1 {
+		t.Fatalf("got %+v", obx)
+	}
+
+	msg := []any{
+		&v251.MSH{MessageType: v251.MSG{MessageCode: "MDM", TriggerEvent: "T02", MessageStructure: "MDM_T02"}, MessageControlID: "1", ProcessingID: v251.PT{ProcessingID: "P"}, VersionID: v251.VID{VersionID: "2.5.1"}},
+		&v251.EVN{EventTypeCode: "T02"},
+		&v251.PID{PatientIdentifierList: []v251.CX{{IDNumber: "123"}}},
+		&v251.PV1{PatientClass: "O"},
+		&v251.TXA{DocumentType: "DS", DocumentContentPresentation: "AP", DocumentCompletionStatus: "AU"},
+		obx,
+	}
+	bb, err := NewEncoder(nil).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(bb), "\rOBX|1|ED|18842-5||^AP^PDF^Base64^JVBERi0xLjQgZG9jdW1lbnQgAAEC|") {
+		t.Fatalf("got %q", bb)
+	}
+
+	v, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdm := v.(v251.MDM_T02)
+	docs, err := ExtractDocuments(mdm.Obxnte[0].OBX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 1 || docs[0].DataSubtype != "PDF" || docs[0].Encoding != EncodingBase64 || !bytes.Equal(docs[0].Data, pdf) {
+		t.Fatalf("got %+v", docs)
+	}
+
+	if err := AttachDocument(v251.Registry, &v251.PID{}, Document{}); err == nil {
+		t.Fatal("expected error for non-OBX segment")
+	}
+}
+
+func TestEDRP(t *testing.T) {
+	reg := CloneRegistry(v251.Registry)
+	if err := reg.AddDataType(MergeReplace, ED{}, RP{}); err != nil {
+		t.Fatal(err)
+	}
+	raw := "MSH|^~\\&|||||||ORU^R01^ORU_R01|1|P|2.5.1\r" +
+		"OBX|1|ED|PDF||LAB&1.2.3&ISO^AP^PDF^Base64^aGVsbG8=\r" +
+		"OBX|2|RP|PDF||https://example.com/doc/1^DOCS&1.2.4&ISO^AP^PDF\r"
+	list, err := NewDecoder(reg, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed, ok := list[1].(*v251.OBX).ObservationValue[0].(ED)
+	if !ok {
+		t.Fatalf("got %T", list[1].(*v251.OBX).ObservationValue[0])
+	}
+	data, err := ed.Bytes()
+	if err != nil || string(data) != "hello" || ed.SourceApplication.UniversalID != "1.2.3" {
+		t.Fatalf("got %q, %v from %+v", data, err, ed)
+	}
+	rp, ok := list[2].(*v251.OBX).ObservationValue[0].(RP)
+	if !ok || rp.Pointer != "https://example.com/doc/1" || rp.ApplicationID.NamespaceID != "DOCS" || rp.Subtype != "PDF" {
+		t.Fatalf("got %#v", list[2].(*v251.OBX).ObservationValue[0])
+	}
+
+	docs, err := ExtractDocuments(list[1])
+	if err != nil || len(docs) != 1 || string(docs[0].Data) != "hello" {
+		t.Fatalf("got %+v, %v", docs, err)
+	}
+
+	if err := ed.SetBytes([]byte{0xde, 0xad}, EncodingHex); err != nil || ed.Data != "DEAD" {
+		t.Fatalf("got %q, %v", ed.Data, err)
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(bb), "OBX|2|RP|PDF||https://example.com/doc/1^DOCS&1.2.4&ISO^AP^PDF") {
+		t.Fatalf("got %q", bb)
+	}
+}
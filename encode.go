@@ -24,54 +24,101 @@ func NewEncoder(opt *EncodeOption) *Encoder {
 // Encoding options.
 type EncodeOption struct {
 	TrimTrailingSeparator bool
+
+	// Length checks the length of primitive values against the len tag option.
+	// When truncated, the truncation character is written after the value if present.
+	Length LengthPolicy
+
+	// Warn is called with problems that are not treated as errors.
+	// If nil, warnings are ignored.
+	Warn func(err error)
+
+	// Charset returns the character set for the MSH-18 value.
+	// If nil or if it returns nil, the built-in character sets are used.
+	Charset CharsetLookup
+
+	// Location converts date time values to the location before encoding, such as time.UTC.
+	// If nil, values are encoded in their own location.
+	Location *time.Location
+
+	// Precision of date time values without a recorded precision.
+	// It replaces the default timestamp precision of the format tag, but not a time layout.
+	// If PrecisionDefault, timestamps are encoded to the second.
+	Precision Precision
+
+	// Offset includes or omits the time zone offset of date time values.
+	Offset OffsetPolicy
+
+	// MaxSegmentLength splits segments longer than this many bytes, continuing them in ADD segments.
+	// Header segments are not split. Zero does not split segments.
+	MaxSegmentLength int
+
+	// Trace is called as Encode encodes each message. If nil, encoding is not traced.
+	Trace *Trace
+
+	// Metrics receives a MetricEncode for each message encoded by Encode. May be nil.
+	Metrics Metrics
 }
 
+// OffsetPolicy controls the time zone offset written for date time values.
+type OffsetPolicy byte
+
+const (
+	OffsetDefault OffsetPolicy = iota // Write the offset of a DateTime that had one or is not in UTC.
+	OffsetInclude                     // Write the offset of all values to the hour or more precise.
+	OffsetOmit                        // Never write the offset.
+)
+
 type Encoder struct {
 	// Set only from init.
 	initSep   string
 	initChars string
 
-	sep      byte // usually a |
-	repeat   byte // usually a ~
-	dividers []byte
-	esc      map[byte][]byte
+	sep          byte // usually a |
+	repeat       byte // usually a ~
+	dividers     []byte
+	escape       byte // usually a \
+	truncateChar byte // usually a # when present in v2.7+
+	esc          map[byte][]byte
 
 	deferred [3]*bytes.Buffer
 	buf      *bytes.Buffer
 
+	path    string  // Current segment field, for warnings.
+	charset Charset // Character set from MSH-18, nil if passed through.
+
 	opt EncodeOption
 }
 
 func (e *Encoder) Encode(message any) ([]byte, error) {
+	var encoded func(info TraceInfo, err error)
+	if t := e.opt.Trace; t != nil {
+		encoded = t.Encoded
+	}
+	if encoded == nil && e.opt.Metrics == nil {
+		return e.encode(message)
+	}
+	info := traceMessage(message, time.Now())
+	b, err := e.encode(message)
+	info.done(encoded, e.opt.Metrics, MetricEncode, "", err)
+	return b, err
+}
+
+func (e *Encoder) encode(message any) ([]byte, error) {
 	e.init("", "")
 
 	err := e.walk(1, reflect.ValueOf(message))
 	if err != nil {
 		return nil, err
 	}
-	return e.buf.Bytes(), nil
+	return e.addenda(e.buf.Bytes())
 }
 
 // Init separators and reset buffers.
 // If sep or chars are empty, then the previous value or the default will be used.
 func (e *Encoder) init(sep, chars string) {
-	if len(sep) > 0 {
-		sep = e.initSep
-	}
-	if len(sep) == 0 {
-		sep = defaultSep
-	}
+	e.delimiters(sep, chars)
 
-	if len(chars) == 0 {
-		chars = e.initSep
-	}
-	if len(chars) == 0 {
-		chars = defaultChars
-	}
-
-	e.sep = byte(sep[0])
-	e.repeat = chars[1]
-	e.dividers = []byte{sep[0], chars[0], chars[3]}
 	if e.deferred[0] == nil {
 		e.deferred[0] = &bytes.Buffer{}
 	}
@@ -88,8 +135,33 @@ func (e *Encoder) init(sep, chars string) {
 	for _, d := range e.deferred {
 		d.Reset()
 	}
+}
+
+// delimiters sets the active separators and the escape sequences for them.
+// If sep or chars are empty, then the previous value or the default will be used.
+func (e *Encoder) delimiters(sep, chars string) {
+	if len(sep) == 0 {
+		sep = e.initSep
+	}
+	if len(sep) == 0 {
+		sep = defaultSep
+	}
+
+	if len(chars) == 0 {
+		chars = e.initChars
+	}
+	if len(chars) == 0 {
+		chars = defaultChars
+	}
+	e.initSep = sep
+	e.initChars = chars
+
+	e.sep = byte(sep[0])
+	e.repeat = chars[1]
+	e.dividers = []byte{sep[0], chars[0], chars[3]}
 
 	esc := chars[2]
+	e.escape = esc
 	e.esc = map[byte][]byte{
 		e.sep:    {esc, 'F', esc},
 		chars[0]: {esc, 'S', esc},
@@ -97,12 +169,33 @@ func (e *Encoder) init(sep, chars string) {
 		chars[2]: {esc, 'E', esc},
 		chars[3]: {esc, 'T', esc},
 	}
+	e.truncateChar = 0
+	if len(chars) > 4 {
+		e.truncateChar = chars[4]
+		e.esc[e.truncateChar] = []byte{esc, 'P', esc}
+	}
 }
 
 func (e *Encoder) walk(seq int, wv reflect.Value) error {
 	if !wv.IsValid() {
 		return nil
 	}
+	// A list of segments, such as from DecodeList.
+	switch {
+	case wv.Kind() == reflect.Interface:
+		if wv.IsNil() {
+			return nil
+		}
+		return e.walk(seq, wv.Elem())
+	case wv.Kind() == reflect.Slice && wv.Type().Elem().Kind() == reflect.Interface:
+		for i := 0; i < wv.Len(); i++ {
+			err := e.walk(seq, wv.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	metaTag, err := e.meta(wv.Type())
 	if err != nil {
 		return err
@@ -205,9 +298,15 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 		switch {
 		case tag.FieldSep:
 			msgSep = f.String()
+			if len(msgSep) > 1 {
+				return fmt.Errorf("%s: field separator %q must be a single character", fld.Name, msgSep)
+			}
 		case tag.FieldChars:
 			chars := f.String()
-			e.init(msgSep, chars)
+			if len(chars) != 0 && len(chars) != 4 && len(chars) != 5 {
+				return fmt.Errorf("%s: encoding characters %q must be four or five characters", fld.Name, chars)
+			}
+			e.delimiters(msgSep, chars)
 		}
 
 		if tag.Meta {
@@ -236,6 +335,19 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 		})
 	}
 
+	if SegmentName == "MSH" {
+		e.charset = nil
+		for _, f := range fieldList {
+			if f.tag.Order != mshCharacterSet {
+				continue
+			}
+			err := e.setCharset(f.value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if SegmentSize == 0 {
 		SegmentSize = maxOrd
 	}
@@ -255,6 +367,11 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 		}
 		v := f.value
 		switch {
+		// Write the active encoding characters if not set.
+		case f.tag.FieldChars:
+			if s, ok := v.(string); ok && len(s) == 0 {
+				v = e.initChars
+			}
 		// Auto populate SetID sequences.
 		case f.tag.Sequence:
 			if seq == 0 {
@@ -263,12 +380,17 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 			if s, ok := v.(string); ok && len(s) == 0 {
 				v = strconv.FormatInt(int64(seq), 10)
 			}
+			// A Set ID may also be declared as an integer.
+			if rv := reflect.ValueOf(v); isNumber(rv.Kind()) && rv.IsZero() {
+				v = seq
+			}
 		}
 		direct := !e.opt.TrimTrailingSeparator
 		e.writeSep(0, 0, direct)
+		e.path = SegmentName + "." + f.name
 		err := e.encodeDataType(f.tag, v, 0)
 		if err != nil {
-			return err
+			return fmt.Errorf("%s: %w", e.path, err)
 		}
 	}
 	e.resetAllDeferred()
@@ -276,6 +398,37 @@ func (e *Encoder) encodeSegment(seq int, st reflect.Value) error {
 	return nil
 }
 
+// setCharset sets the message character set from the first repetition of MSH-18.
+func (e *Encoder) setCharset(v any) error {
+	var name string
+	switch v := v.(type) {
+	case string:
+		name = v
+	case []string:
+		if len(v) > 0 {
+			name = v[0]
+		}
+	}
+	cs, err := lookupCharset(e.opt.Charset, name)
+	if err != nil {
+		return fmt.Errorf("MSH.%d character set %q: %w", mshCharacterSet, name, err)
+	}
+	e.charset = cs
+	return nil
+}
+
+// getPresent returns the number of components recorded on the meta field, if any.
+func getPresent(meta reflect.Value) int {
+	if meta.Kind() != reflect.Struct {
+		return 0
+	}
+	pv := meta.FieldByName(presentName)
+	if !pv.IsValid() || pv.Kind() != reflect.Int {
+		return 0
+	}
+	return int(pv.Int())
+}
+
 func (e *Encoder) flushDeferred(level int) {
 	// If level 0"|", then write level 0, remove 1, 2.
 	// If level 1"^", then write level 0 and 1, remove 2.
@@ -291,22 +444,7 @@ func (e *Encoder) flushDeferred(level int) {
 
 // write escapes individual values.
 func (e *Encoder) write(val string, level int, noEscape bool) {
-	if len(val) > 0 {
-		e.flushDeferred(level)
-	}
-	buf := e.buf
-	if noEscape {
-		buf.WriteString(val)
-		return
-	}
-	for i := 0; i < len(val); i++ {
-		c := val[i]
-		if esc, is := e.esc[c]; is {
-			buf.Write(esc)
-			continue
-		}
-		buf.WriteByte(c)
-	}
+	e.writeByte([]byte(val), level, noEscape)
 }
 func (e *Encoder) writeByte(val []byte, level int, noEscape bool) {
 	if len(val) > 0 {
@@ -323,6 +461,15 @@ func (e *Encoder) writeByte(val []byte, level int, noEscape bool) {
 			buf.Write(esc)
 			continue
 		}
+		if isHexEscaped(c) {
+			end := i + 1
+			for end < len(val) && isHexEscaped(val[end]) {
+				end++
+			}
+			e.writeHex(val[i:end])
+			i = end - 1
+			continue
+		}
 		buf.WriteByte(c)
 	}
 }
@@ -354,23 +501,41 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 	if o == nil || !t.Present {
 		return nil
 	}
+	// A pointer to a number is sent even if it is 0.
+	isPointer := false
+	if rv := reflect.ValueOf(o); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		o = rv.Elem().Interface()
+		isPointer = true
+	}
 
 	switch v := o.(type) {
 	default:
 		rv := reflect.ValueOf(o)
+		if isNumber(rv.Kind()) {
+			if rv.IsZero() && !isPointer {
+				return nil
+			}
+			sv, err := formatNumber(rv)
+			if err != nil {
+				return err
+			}
+			e.write(sv, level, t.NoEscape)
+			return nil
+		}
 		if rv.IsZero() {
 			return nil
 		}
 		switch rv.Kind() {
 		default:
 			return fmt.Errorf("unknown value kind: %v", rv.Kind())
-		case reflect.Pointer:
-			rv = rv.Elem()
-			fallthrough
 		case reflect.Struct:
 			var SegmentName string
 			var SegmentSize int32
 			var maxOrd int32
+			var present int
 
 			type field struct {
 				name    string
@@ -396,6 +561,7 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 				if tag.Meta {
 					SegmentName = tag.Name
 					SegmentSize = tag.Order
+					present = getPresent(rv.Field(i))
 				} else {
 					if tag.Order > maxOrd {
 						maxOrd = tag.Order
@@ -431,6 +597,10 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 				if err != nil {
 					return fmt.Errorf("%s (%+v): %w", SegmentName, f.value, err)
 				}
+				// Write out any trailing empty components that were present when decoded.
+				if i+1 == present {
+					e.flushDeferred(level + 1)
+				}
 			}
 		case reflect.Slice:
 			if rv.Type().Elem().Kind() == reflect.Uint8 {
@@ -444,6 +614,10 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 				}
 				x := rv.Index(i)
 				value := x.Interface()
+				// A repetition keeps its position, so a 0 in it is sent.
+				if isNumber(x.Kind()) {
+					value = x.Addr().Interface()
+				}
 
 				err := e.encodeDataType(t, value, level)
 				if err != nil {
@@ -455,25 +629,39 @@ func (e *Encoder) encodeDataType(t tag, o interface{}, level int) error {
 	case []byte:
 		e.writeByte(v, level, true)
 	case string:
+		v, truncated, err := e.truncate(v, t)
+		if err != nil {
+			return err
+		}
+		// Escape after converting, so a delimiter byte within a multi-byte character, such as a Big5 trail byte,
+		// does not split the value. The decoder converts the text after replacing the escapes.
+		if e.charset != nil && !t.NoEscape {
+			b, err := e.charset.Encode([]byte(v))
+			if err != nil {
+				return fmt.Errorf("character set: %w", err)
+			}
+			v = string(b)
+		}
 		e.write(v, level, t.NoEscape)
-	case time.Time:
+		if truncated && e.truncateChar != 0 {
+			e.writeByte([]byte{e.truncateChar}, level, true)
+		}
+	case Raw:
+		e.write(string(v), level, true)
+	case valueFormatter:
+		if n, ok := v.(nullable); ok && n.IsNull() {
+			e.write(nullValue, level, true)
+			return nil
+		}
 		if v.IsZero() {
 			return nil
 		}
-		var sv string
-		switch t.Format {
-		default:
-			sv = v.Format("20060102150405")
-		case "YMDHMS":
-			sv = v.Format("20060102150405")
-		case "YMDHM":
-			sv = v.Format("200601021504")
-		case "YMD":
-			sv = v.Format("20060102")
-		case "HM":
-			sv = v.Format("1504")
+		e.write(v.formatHL7(t, &e.opt), level, t.NoEscape)
+	case time.Time:
+		if v.IsZero() {
+			return nil
 		}
-		e.write(sv, level, t.NoEscape)
+		e.write(formatDTM(v, PrecisionDefault, 0, false, t, &e.opt), level, t.NoEscape)
 	}
 	return nil
 }
@@ -0,0 +1,204 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// unescapeState is the state of the escape sequences within a single value.
+type unescapeState struct {
+	charset   charsetState
+	highlight bool   // Within a \H\ highlight.
+	pending   []byte // Text in the message character set not yet converted.
+}
+
+// unescape replaces escape sequences in v with the characters they represent.
+// Unknown or malformed escape sequences are left in place.
+// Text in the message character set is converted after the escaped delimiters within it are replaced,
+// as the encoder escapes a delimiter byte of a multi-byte character, such as a Big5 trail byte of 0x5C.
+func (d *lineDecoder) unescape(v []byte) (string, error) {
+	esc := d.escape
+	if d.charset == nil && (esc == 0 || bytes.IndexByte(v, esc) < 0) {
+		if d.opt.FormatText == FormatTextHTML {
+			return html.EscapeString(string(v)), nil
+		}
+		return string(v), nil
+	}
+	buf := &strings.Builder{}
+	buf.Grow(len(v))
+
+	// Character set and highlight escapes apply until the end of the value.
+	st := &unescapeState{}
+	for len(v) > 0 {
+		start := bytes.IndexByte(v, esc)
+		if start < 0 {
+			d.writeText(buf, st, v)
+			break
+		}
+		d.writeText(buf, st, v[:start])
+		v = v[start+1:]
+
+		end := bytes.IndexByte(v, esc)
+		if end < 0 {
+			// Unterminated escape sequence, write as is.
+			d.writeEscaped(buf, st, append([]byte{esc}, v...))
+			break
+		}
+		seq := v[:end]
+		v = v[end+1:]
+
+		ok, err := d.unescapeSequence(buf, st, seq)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			d.writeEscaped(buf, st, append(append([]byte{esc}, seq...), esc))
+		}
+	}
+	d.flush(buf, st)
+	d.endFormat(buf, st)
+	return buf.String(), nil
+}
+
+// writeText writes text from the message, converting it from the active character set.
+func (d *lineDecoder) writeText(buf *strings.Builder, st *unescapeState, text []byte) {
+	if d.charset != nil && !st.charset.active {
+		st.pending = append(st.pending, text...)
+		return
+	}
+	if d.opt.FormatText != FormatTextHTML {
+		st.charset.write(buf, text)
+		return
+	}
+	tmp := &strings.Builder{}
+	st.charset.write(tmp, text)
+	buf.WriteString(html.EscapeString(tmp.String()))
+}
+
+// writeEscaped writes the characters of an escape sequence, such as an escaped delimiter, which are
+// converted with the text around them in the message character set.
+func (d *lineDecoder) writeEscaped(buf *strings.Builder, st *unescapeState, v []byte) {
+	if d.charset != nil && !st.charset.active {
+		st.pending = append(st.pending, v...)
+		return
+	}
+	d.writeLiteral(buf, string(v))
+}
+
+// flush converts the pending text from the message character set and writes it.
+func (d *lineDecoder) flush(buf *strings.Builder, st *unescapeState) {
+	if len(st.pending) == 0 {
+		return
+	}
+	text, err := d.charset.Decode(st.pending)
+	if err != nil {
+		text = st.pending
+	}
+	d.writeLiteral(buf, string(text))
+	st.pending = st.pending[:0]
+}
+
+// writeLiteral writes the value of an escape sequence as is.
+func (d *lineDecoder) writeLiteral(buf *strings.Builder, v string) {
+	if d.opt.FormatText == FormatTextHTML {
+		v = html.EscapeString(v)
+	}
+	buf.WriteString(v)
+}
+
+// unescapeSequence writes the value of a single escape sequence, without the
+// surrounding escape characters, to buf. It returns false if the sequence is not known.
+func (d *lineDecoder) unescapeSequence(buf *strings.Builder, st *unescapeState, seq []byte) (bool, error) {
+	if len(seq) == 0 {
+		return false, nil
+	}
+	code, value := seq[0], seq[1:]
+	switch code {
+	default:
+		return false, nil
+	case 'F', 'S', 'R', 'E', 'T':
+		if len(value) != 0 {
+			return false, nil
+		}
+		var c byte
+		switch code {
+		case 'F':
+			c = d.sep
+		case 'S':
+			c = d.chars[0]
+		case 'R':
+			c = d.chars[1]
+		case 'E':
+			c = d.chars[2]
+		case 'T':
+			c = d.chars[3]
+		}
+		d.writeEscaped(buf, st, []byte{c})
+		return true, nil
+	case 'P':
+		if len(value) != 0 || d.truncate == 0 {
+			return false, nil
+		}
+		d.writeEscaped(buf, st, []byte{d.truncate})
+		return true, nil
+	case 'H', 'N', '.':
+		d.flush(buf, st)
+		return d.formatSequence(buf, st, seq), nil
+	case 'X':
+		if len(value) == 0 {
+			return false, nil
+		}
+		b, err := hex.DecodeString(string(value))
+		if err != nil {
+			return false, nil
+		}
+		d.writeEscaped(buf, st, b)
+		return true, nil
+	case 'C':
+		if len(value) != 4 {
+			return false, nil
+		}
+		d.flush(buf, st)
+		return st.charset.designate(value), nil
+	case 'M':
+		if len(value) != 4 && len(value) != 6 {
+			return false, nil
+		}
+		d.flush(buf, st)
+		return st.charset.designate(value), nil
+	case 'Z':
+		if d.opt.LocalEscape == nil {
+			return false, nil
+		}
+		v, err := d.opt.LocalEscape(string(value))
+		if err != nil {
+			return false, fmt.Errorf("local escape %q: %w", seq, err)
+		}
+		d.flush(buf, st)
+		d.writeLiteral(buf, v)
+		return true, nil
+	}
+}
+
+// isHexEscaped reports if c must be written as a hexadecimal escape sequence.
+// Control characters such as CR and LF would otherwise break the message structure.
+func isHexEscaped(c byte) bool {
+	return c < 0x20 || c == 0x7f
+}
+
+const hexDigits = "0123456789ABCDEF"
+
+// writeHex writes the run of bytes as a single \Xdd..\ escape sequence.
+func (e *Encoder) writeHex(run []byte) {
+	buf := e.buf
+	buf.WriteByte(e.escape)
+	buf.WriteByte('X')
+	for _, c := range run {
+		buf.WriteByte(hexDigits[c>>4])
+		buf.WriteByte(hexDigits[c&0x0f])
+	}
+	buf.WriteByte(e.escape)
+}
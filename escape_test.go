@@ -0,0 +1,136 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func newTestLineDecoder() *lineDecoder {
+	return &lineDecoder{
+		sep:      '|',
+		repeat:   '~',
+		dividers: [3]byte{'|', '^', '&'},
+		chars:    [4]byte{'^', '~', '\\', '&'},
+		escape:   '\\',
+		readSep:  true,
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"none", `plain text`, `plain text`},
+		{"delimiters", `a\F\b\S\c\R\d\E\e\T\f`, `a|b^c~d\e&f`},
+		{"hex", `line1\X0D0A\line2`, "line1\r\nline2"},
+		{"hex lower", `\X0d\`, "\r"},
+		{"hex odd", `\X0D0\`, `\X0D0\`},
+		{"unknown", `\Q\`, `\Q\`},
+		{"unterminated", `a\Fb`, `a\Fb`},
+		{"empty", `\\`, `\\`},
+	}
+	d := newTestLineDecoder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.unescape([]byte(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("unescape(%q) got %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeHexEscape(t *testing.T) {
+	seg := v251.NTE{
+		Comment: []v251.FT{"line1\r\nline2|x"},
+	}
+	e := NewEncoder(nil)
+	bb, err := e.Encode(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte(`NTE|1||line1\X0D0A\line2\F\x|`)
+	if !bytes.HasPrefix(bb, want) {
+		t.Fatalf("got %q, want prefix %q", bb, want)
+	}
+
+	raw := append([]byte("MSH|^~\\&||||||||||2.5.1^^|||||||||\r"), bb...)
+	d := NewDecoder(v251.Registry, nil)
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := list[1].(*v251.NTE).Comment[0]
+	if got != seg.Comment[0] {
+		t.Fatalf("round trip got %q, want %q", got, seg.Comment[0])
+	}
+}
+
+func TestUnescapeCharset(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"jis x 0208", `Name \M2442\;3ED\C2842\ end`, "Name 山田 end"},
+		{"katakana", `\C2849\1\C2842\A`, "ｱA"},
+		{"latin1", "caf\\C2D41\\\xe9", "café"},
+		{"latin1 lower", "caf\\C2d41\\\xe9", "café"},
+		{"unknown", `\C2D5A\x`, `\C2D5A\x`},
+		{"utf-8 passthrough", "café", "café"},
+	}
+	d := newTestLineDecoder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.unescape([]byte(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("unescape(%q) got %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeLocal(t *testing.T) {
+	d := newTestLineDecoder()
+	d.opt.LocalEscape = func(code string) (string, error) {
+		switch code {
+		default:
+			return "", fmt.Errorf("unknown code")
+		case "BOLD":
+			return "<b>", nil
+		case "END":
+			return "</b>", nil
+		}
+	}
+	got, err := d.unescape([]byte(`a \ZBOLD\word\ZEND\ b`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a <b>word</b> b"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	_, err = d.unescape([]byte(`\ZOTHER\`))
+	if err == nil {
+		t.Fatal("expected error for unknown local escape")
+	}
+
+	d.opt.LocalEscape = nil
+	got, err = d.unescape([]byte(`\ZBOLD\`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `\ZBOLD\`; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,356 @@
+package fhir
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kardianos/hl7"
+)
+
+// ADT converts an ADT message of any version, such as h251.ADT_A01, to a bundle with a Patient resource
+// from the PID segment, an Encounter resource from the PV1 segment if sent, and a Coverage resource
+// from each IN1 segment. The message may also be a segment list from DecodeList.
+func ADT(message any, opt *Option) (*Bundle, error) {
+	var (
+		msh, pid, pv1 any
+		in1           []any
+	)
+	for _, seg := range hl7.Segments(message) {
+		switch hl7.SegmentName(seg) {
+		case "MSH":
+			msh = seg
+		case "PID":
+			if pid != nil {
+				return nil, fmt.Errorf("message has more than one PID segment")
+			}
+			pid = seg
+		case "PV1":
+			pv1 = seg
+		case "IN1":
+			in1 = append(in1, seg)
+		}
+	}
+	if pid == nil {
+		return nil, fmt.Errorf("message has no PID segment")
+	}
+	b := newBuilder(opt)
+	p, err := b.patient(pid)
+	if err != nil {
+		return nil, err
+	}
+	subject := b.add("Patient", p)
+	if pv1 != nil {
+		b.add("Encounter", b.encounter(pv1, str(msh, 9, 2), subject))
+	}
+	for i, seg := range in1 {
+		b.add("Coverage", b.coverage(seg, i+1, subject))
+	}
+	return b.bundle, nil
+}
+
+// patientGender maps administrative sex (table 0001) to the FHIR administrative gender.
+var patientGender = map[string]string{
+	"F": "female",
+	"M": "male",
+	"O": "other",
+	"A": "other",
+	"U": "unknown",
+	"N": "unknown",
+}
+
+// nameUse maps name types (table 0200) to the FHIR name use.
+var nameUse = map[string]string{
+	hl7.NameLegal:     "official",
+	hl7.NameAdopted:   "official",
+	hl7.NameAlias:     "usual",
+	hl7.NameDisplay:   "usual",
+	hl7.NameNickname:  "nickname",
+	hl7.NameMaiden:    "maiden",
+	hl7.NameBirth:     "old",
+	hl7.NamePseudonym: "anonymous",
+}
+
+// addressUse maps address types (table 0190) to the FHIR address use and type.
+var addressUse = map[string][2]string{
+	hl7.AddressHome:      {"home", ""},
+	hl7.AddressPermanent: {"home", ""},
+	hl7.AddressBusiness:  {"work", ""},
+	hl7.AddressOffice:    {"work", ""},
+	hl7.AddressCurrent:   {"temp", ""},
+	hl7.AddressVacation:  {"temp", ""},
+	hl7.AddressBad:       {"old", ""},
+	hl7.AddressMailing:   {"", "postal"},
+}
+
+func (b *builder) patient(pid any) (*Patient, error) {
+	p := &Patient{ResourceType: "Patient"}
+	for _, cx := range append(reps(hl7.Field(pid, 2)), reps(hl7.Field(pid, 3))...) {
+		if id := identifier(cx); len(id.Value) > 0 {
+			p.Identifier = append(p.Identifier, id)
+		}
+	}
+	if ssn := str(pid, 19); len(ssn) > 0 {
+		p.Identifier = append(p.Identifier, Identifier{Type: v2Code("0203", "SS", ""), System: "http://hl7.org/fhir/sid/us-ssn", Value: ssn})
+	}
+
+	names, err := hl7.PersonNames(hl7.Field(pid, 5))
+	if err != nil {
+		return nil, fmt.Errorf("PID-5: %w", err)
+	}
+	for _, n := range names {
+		p.Name = append(p.Name, humanName(n))
+	}
+	p.Gender = patientGender[str(pid, 8)]
+	p.BirthDate = date(timeValue(pid, 7))
+
+	addrs, err := hl7.Addresses(hl7.Field(pid, 11))
+	if err != nil {
+		return nil, fmt.Errorf("PID-11: %w", err)
+	}
+	for _, a := range addrs {
+		p.Address = append(p.Address, address(a))
+	}
+	for _, f := range []struct {
+		order int
+		use   string
+	}{{13, "home"}, {14, "work"}} {
+		list, err := hl7.Telephones(hl7.Field(pid, f.order))
+		if err != nil {
+			return nil, fmt.Errorf("PID-%d: %w", f.order, err)
+		}
+		for _, t := range list {
+			if cp, ok := contactPoint(t, f.use); ok {
+				p.Telecom = append(p.Telecom, cp)
+			}
+		}
+	}
+	p.MaritalStatus = b.concept(hl7.Field(pid, 16), "0002")
+
+	// Deceased is a choice; the date is more specific than the indicator.
+	if death := dateTime(timeValue(pid, 29)); len(death) > 0 {
+		p.DeceasedDateTime = death
+	} else if ind := str(pid, 30); len(ind) > 0 {
+		deceased := ind == "Y"
+		p.DeceasedBoolean = &deceased
+	}
+	return p, nil
+}
+
+func humanName(n hl7.PersonName) HumanName {
+	h := HumanName{Use: nameUse[n.Type], Family: n.Family}
+	if len(n.Given) > 0 {
+		h.Given = append(h.Given, n.Given)
+	}
+	h.Given = append(h.Given, strings.Fields(n.Middle)...)
+	if len(n.Prefix) > 0 {
+		h.Prefix = []string{n.Prefix}
+	}
+	for _, s := range []string{n.Suffix, n.Degree} {
+		if len(s) > 0 {
+			h.Suffix = append(h.Suffix, s)
+		}
+	}
+	return h
+}
+
+func address(a hl7.Address) Address {
+	use := addressUse[a.Type]
+	fa := Address{
+		Use:        use[0],
+		Type:       use[1],
+		City:       a.City,
+		District:   a.County,
+		State:      a.State,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+	for _, s := range []string{a.Street, a.Other} {
+		if len(s) > 0 {
+			fa.Line = append(fa.Line, s)
+		}
+	}
+	return fa
+}
+
+// contactPoint returns the contact point of a telephone number, with the use of the field
+// it was sent in unless the number sets one.
+func contactPoint(t hl7.Telephone, use string) (ContactPoint, bool) {
+	cp := ContactPoint{System: "phone", Use: use}
+	switch t.Use {
+	case hl7.UsePrimaryResidence, hl7.UseOtherResidence, hl7.UseVacationHome:
+		cp.Use = "home"
+	case hl7.UseWork:
+		cp.Use = "work"
+	}
+	switch t.Equipment {
+	case hl7.EquipmentFax:
+		cp.System = "fax"
+	case hl7.EquipmentBeeper:
+		cp.System = "pager"
+	case hl7.EquipmentCellular:
+		cp.Use = "mobile"
+	case hl7.EquipmentInternet, hl7.EquipmentX400:
+		cp.System = "email"
+	}
+	if t.Use == hl7.UseNetwork || len(t.Email) > 0 {
+		cp.System = "email"
+		cp.Value = t.Email
+		return cp, len(cp.Value) > 0
+	}
+	if n, ok := t.E164(""); ok {
+		cp.Value = n
+	} else if len(t.LocalNumber) > 0 {
+		cp.Value = strings.TrimSpace(t.AreaCode + " " + t.LocalNumber)
+	} else if len(t.Formatted) > 0 {
+		cp.Value = t.Formatted
+	} else {
+		cp.Value = t.Unformatted
+	}
+	if ext := t.Ext(); len(ext) > 0 && len(cp.Value) > 0 {
+		cp.Value += " ext. " + ext
+	}
+	return cp, len(cp.Value) > 0
+}
+
+// encounterClass maps patient classes (table 0004) to v3 ActCode encounter classes.
+var encounterClass = map[string][2]string{
+	string(hl7.ClassEmergency):  {"EMER", "emergency"},
+	string(hl7.ClassInpatient):  {"IMP", "inpatient encounter"},
+	string(hl7.ClassObstetrics): {"IMP", "inpatient encounter"},
+	string(hl7.ClassOutpatient): {"AMB", "ambulatory"},
+	string(hl7.ClassRecurring):  {"AMB", "ambulatory"},
+	string(hl7.ClassPreadmit):   {"PRENC", "pre-admission"},
+}
+
+// encounterParticipants are the PV1 doctor fields and their v3 ParticipationType codes.
+var encounterParticipants = []struct {
+	order   int
+	code    string
+	display string
+}{
+	{7, "ATND", "attender"},
+	{8, "REF", "referrer"},
+	{9, "CON", "consultant"},
+	{17, "ADM", "admitter"},
+}
+
+func (b *builder) encounter(pv1 any, event string, subject *Reference) *Encounter {
+	e := &Encounter{ResourceType: "Encounter", Subject: subject}
+	class := str(pv1, 2)
+	if c, ok := encounterClass[class]; ok {
+		e.Class = Coding{System: "http://terminology.hl7.org/CodeSystem/v3-ActCode", Code: c[0], Display: c[1]}
+	} else {
+		e.Class = Coding{System: codeSystem("HL70004"), Code: class}
+	}
+	if id := identifier(value(pv1, 19)); len(id.Value) > 0 {
+		if id.Type == nil {
+			id.Type = v2Code("0203", "VN", "Visit number")
+		}
+		e.Identifier = append(e.Identifier, id)
+	}
+	discharge := timeValue(pv1, 45)
+	e.Period = period(timeValue(pv1, 44), discharge)
+	switch {
+	case event == "A11" || event == "A38":
+		e.Status = "cancelled"
+	case event == "A05" || event == "A14":
+		e.Status = "planned"
+	case event == "A03" || !discharge.IsZero():
+		e.Status = "finished"
+	default:
+		e.Status = "in-progress"
+	}
+	for _, f := range []struct {
+		order  int
+		status string
+	}{{3, "active"}, {6, "completed"}} {
+		l, err := hl7.ParseLocation(hl7.Field(pv1, f.order))
+		if err != nil || l.IsZero() {
+			continue
+		}
+		e.Location = append(e.Location, EncounterLocation{Location: Reference{Display: l.String()}, Status: f.status})
+	}
+	for _, p := range encounterParticipants {
+		for _, xcn := range reps(hl7.Field(pv1, p.order)) {
+			ref := practitioner(xcn)
+			if ref == nil {
+				continue
+			}
+			e.Participant = append(e.Participant, EncounterParticipant{
+				Type: []CodeableConcept{{Coding: []Coding{{
+					System: "http://terminology.hl7.org/CodeSystem/v3-ParticipationType", Code: p.code, Display: p.display,
+				}}}},
+				Individual: ref,
+			})
+		}
+	}
+	return e
+}
+
+// practitioner returns a reference to the person of an XCN value, or nil if not set.
+func practitioner(xcn any) *Reference {
+	name := hl7.PersonName{Family: str(xcn, 2), Given: str(xcn, 3), Middle: str(xcn, 4), Suffix: str(xcn, 5)}
+	ref := &Reference{Display: name.DisplayName()}
+	if id := identifier(xcn); len(id.Value) > 0 {
+		ref.Identifier = &id
+	}
+	if ref.Identifier == nil && len(ref.Display) == 0 {
+		return nil
+	}
+	return ref
+}
+
+// subscriberRelationship maps relationships (table 0063) to the FHIR subscriber relationship.
+var subscriberRelationship = map[string]string{
+	"SEL": "self",
+	"SPO": "spouse",
+	"DOM": "common",
+	"CHD": "child",
+	"PAR": "parent",
+	"EME": "other",
+}
+
+func (b *builder) coverage(in1 any, order int, beneficiary *Reference) *Coverage {
+	c := &Coverage{
+		ResourceType: "Coverage",
+		Status:       "active",
+		Beneficiary:  *beneficiary,
+		Order:        order,
+		Type:         b.concept(hl7.Field(in1, 15), "0086"),
+		Period:       period(timeValue(in1, 12), timeValue(in1, 13)),
+	}
+	if policy := str(in1, 36); len(policy) > 0 {
+		c.Identifier = append(c.Identifier, Identifier{Value: policy})
+	}
+	c.SubscriberID = str(in1, 49)
+	if len(c.SubscriberID) == 0 {
+		c.SubscriberID = str(in1, 36)
+	}
+	payor := Reference{Display: str(in1, 4)}
+	if id := identifier(value(in1, 3)); len(id.Value) > 0 {
+		payor.Identifier = &id
+	}
+	c.Payor = []Reference{payor}
+	if rel := str(in1, 17); len(rel) > 0 {
+		code, ok := subscriberRelationship[rel]
+		if !ok {
+			code = "other"
+		}
+		c.Relationship = &CodeableConcept{Coding: []Coding{{System: "http://terminology.hl7.org/CodeSystem/subscriber-relationship", Code: code}}}
+	}
+	if plan := str(in1, 2); len(plan) > 0 {
+		c.Class = append(c.Class, coverageClass("plan", plan, str(in1, 2, 2)))
+	}
+	if group := str(in1, 8); len(group) > 0 {
+		c.Class = append(c.Class, coverageClass("group", group, str(in1, 9)))
+	}
+	return c
+}
+
+func coverageClass(classType, value, name string) CoverageClass {
+	return CoverageClass{
+		Type:  CodeableConcept{Coding: []Coding{{System: "http://terminology.hl7.org/CodeSystem/coverage-class", Code: classType}}},
+		Value: value,
+		Name:  name,
+	}
+}
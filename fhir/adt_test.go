@@ -0,0 +1,175 @@
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kardianos/hl7"
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func testOption() *Option {
+	n := 0
+	return &Option{NewID: func() string {
+		n++
+		return fmt.Sprintf("id-%d", n)
+	}}
+}
+
+func TestADT(t *testing.T) {
+	bb, err := os.ReadFile(filepath.Join("..", "testdata", "roundtrip", "adt_a01.hl7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := hl7.NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ADT(msg, testOption())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Entry) != 4 {
+		t.Fatalf("got %d entries", len(b.Entry))
+	}
+	p := b.Entry[0].Resource.(*Patient)
+	if b.Entry[0].FullURL != "urn:uuid:id-1" || p.Gender != "female" || p.BirthDate != "1980-01-01" {
+		t.Errorf("got %+v", p)
+	}
+	if len(p.Identifier) != 1 || p.Identifier[0].Value != "123" || p.Identifier[0].Type.Coding[0].Code != "MR" || p.Identifier[0].Assigner.Display != "MRN" {
+		t.Errorf("got identifiers %+v", p.Identifier)
+	}
+	if !reflect.DeepEqual(p.Name, []HumanName{{Family: "Doe", Given: []string{"Jane"}}}) {
+		t.Errorf("got names %+v", p.Name)
+	}
+	if !reflect.DeepEqual(p.Address, []Address{{Use: "home", Line: []string{"123 Main St"}, City: "Springfield", State: "IL", PostalCode: "62701", Country: "USA"}}) {
+		t.Errorf("got addresses %+v", p.Address)
+	}
+
+	e := b.Entry[1].Resource.(*Encounter)
+	if e.Status != "in-progress" || e.Class.Code != "IMP" || e.Subject.Reference != "urn:uuid:id-1" {
+		t.Errorf("got %+v", e)
+	}
+	if len(e.Identifier) != 1 || e.Identifier[0].Value != "V300" {
+		t.Errorf("got identifiers %+v", e.Identifier)
+	}
+	if len(e.Location) != 1 || e.Location[0].Location.Display != "WARD-201-A" {
+		t.Errorf("got locations %+v", e.Location)
+	}
+	if len(e.Participant) != 1 || e.Participant[0].Individual.Display != "Smith, John" || e.Participant[0].Type[0].Coding[0].Code != "ATND" {
+		t.Errorf("got participants %+v", e.Participant)
+	}
+
+	c := b.Entry[2].Resource.(*Coverage)
+	if c.Payor[0].Display != "Blue Cross Blue Shield" || c.Relationship.Coding[0].Code != "self" || c.Order != 1 {
+		t.Errorf("got %+v", c)
+	}
+	if c.Period == nil || c.Period.Start != "2024-01-01" || c.Period.End != "2024-12-31" {
+		t.Errorf("got period %+v", c.Period)
+	}
+	if len(c.Class) != 2 || c.Class[0].Value != "PPO" || c.Class[1].Value != "G500" || c.Class[1].Name != "Acme Corp" {
+		t.Errorf("got classes %+v", c.Class)
+	}
+	if c := b.Entry[3].Resource.(*Coverage); c.Payor[0].Display != "Medicare" || c.Order != 2 {
+		t.Errorf("got %+v", c)
+	}
+
+	out, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"resourceType":"Bundle","type":"collection"`, `"resourceType":"Patient"`, `"beneficiary":{"reference":"urn:uuid:id-1"}`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+}
+
+func TestADTVersion(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|||||||ADT^A03|1|P|2.3.1`,
+		`EVN|A03`,
+		`PID|1||123^^^HOSP&1.2.3&ISO^MR||Doe^Jane^Q^Jr||19800101|M|||||^PRN^PH^^1^555^5551234~^NET^Internet^jane@example.com||||||||||||||||200401010830|Y`,
+		`PV1|1|E|||||||||||||||||V1|||||||||||||||||||||||||200312312300|200401010830`,
+	}, "\r")
+	msg, err := hl7.NewDecoder(v231.Registry, nil).Decode([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ADT(msg, &Option{BundleType: BundleTransaction, NewID: testOption().NewID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Entry) != 2 || b.Entry[0].Request == nil || b.Entry[0].Request.URL != "Patient" {
+		t.Fatalf("got %+v", b.Entry)
+	}
+	p := b.Entry[0].Resource.(*Patient)
+	if p.Identifier[0].System != "urn:oid:1.2.3" || p.Identifier[0].Assigner != nil {
+		t.Errorf("got identifiers %+v", p.Identifier)
+	}
+	if !reflect.DeepEqual(p.Name[0], HumanName{Family: "Doe", Given: []string{"Jane", "Q"}, Suffix: []string{"Jr"}}) {
+		t.Errorf("got names %+v", p.Name)
+	}
+	if !reflect.DeepEqual(p.Telecom, []ContactPoint{{System: "phone", Value: "+15555551234", Use: "home"}, {System: "email", Value: "jane@example.com", Use: "home"}}) {
+		t.Errorf("got telecom %+v", p.Telecom)
+	}
+	if p.DeceasedDateTime == "" || p.DeceasedBoolean != nil {
+		t.Errorf("got deceased %q %v", p.DeceasedDateTime, p.DeceasedBoolean)
+	}
+	e := b.Entry[1].Resource.(*Encounter)
+	if e.Status != "finished" || e.Class.Code != "EMER" || e.Period == nil || e.Period.End == "" {
+		t.Errorf("got %+v", e)
+	}
+
+	if _, err := ADT([]any{&v251.MSH{}}, nil); err == nil {
+		t.Error("expected an error without a PID segment")
+	}
+}
+
+type testZDT struct {
+	HL7   struct{}     `hl7:",name=ZDT,type=s"`
+	When  hl7.DateTime `hl7:"1"`
+	Stamp time.Time    `hl7:"2,format=YMDHMS"`
+	Day   time.Time    `hl7:"3,format=YMD"`
+}
+
+func TestDateTimePrecision(t *testing.T) {
+	reg := hl7.CloneRegistry(v251.Registry)
+	if err := reg.AddSegment(hl7.MergeReplace, testZDT{}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		raw      string
+		location *time.Location
+		when     string
+		stamp    string
+		day      string
+	}{
+		{"202401|20240102000000|20240102", nil, "2024-01", "2024-01-02T00:00:00Z", "2024-01-02"},
+		{"20240102", nil, "2024-01-02", "", ""},
+		{"202401020000", nil, "2024-01-02T00:00:00Z", "", ""},
+		{"20240102083000.12-0500", nil, "2024-01-02T08:30:00.12-05:00", "", ""},
+		{"202401020830|20240102083000", time.FixedZone("", -5*3600), "2024-01-02T08:30:00-05:00", "2024-01-02T08:30:00-05:00", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			data := "MSH|^~\\&|||||||ADT^A01|1|P|2.5.1\rZDT|" + tt.raw
+			list, err := hl7.NewDecoder(reg, &hl7.DecodeOption{Location: tt.location}).DecodeList([]byte(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			zdt := list[1]
+			got := []string{dateTime(timeValue(zdt, 1)), dateTime(timeValue(zdt, 2)), dateTime(timeValue(zdt, 3))}
+			if want := []string{tt.when, tt.stamp, tt.day}; !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Package fhir converts decoded HL7 v2 messages to FHIR R4 resources, following the
+// HL7 v2-to-FHIR mappings. Messages of any version are read by field order, so the
+// same conversion applies to h231.ADT_A01 and h251.ADT_A01.
+//
+// Encode the returned Bundle with encoding/json to send it to a FHIR server.
+package fhir
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+)
+
+// Bundle types.
+const (
+	BundleCollection  = "collection"
+	BundleTransaction = "transaction"
+)
+
+// Option sets how messages are converted. A nil Option uses the defaults.
+type Option struct {
+	// BundleType of the returned bundle, BundleCollection if empty.
+	// The entries of a BundleTransaction bundle create each resource with a POST request.
+	BundleType string
+
+	// NewID returns the ID of each resource, used in the urn:uuid: full URL of its bundle entry.
+	// If nil, a random UUID is used.
+	NewID func() string
+
+	// Codes maps the codings of coded values, such as local lab codes to LOINC. If nil, codings are not mapped.
+	Codes CodeMapper
+}
+
+// CodeMapper maps the codings of coded values, such as the local codes of a lab to LOINC.
+// The mapper is supplied by the user, as this package does not include a terminology service.
+type CodeMapper interface {
+	// MapCode returns the codings of a code in the HL7 coding system, such as "LN", "HL70078", or a local system
+	// name, whose FHIR system is set if known. Return the coding to keep it, with a translation to add one,
+	// or nothing to leave it out.
+	MapCode(system string, c Coding) []Coding
+}
+
+// CodeMapperFunc is a function that implements CodeMapper.
+type CodeMapperFunc func(system string, c Coding) []Coding
+
+func (f CodeMapperFunc) MapCode(system string, c Coding) []Coding {
+	return f(system, c)
+}
+
+func (opt *Option) bundleType() string {
+	if opt == nil || len(opt.BundleType) == 0 {
+		return BundleCollection
+	}
+	return opt.BundleType
+}
+
+func (opt *Option) newID() string {
+	if opt != nil && opt.NewID != nil {
+		return opt.NewID()
+	}
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40 // Version 4.
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant.
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Bundle is a FHIR Bundle resource.
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+// BundleEntry is an entry of a Bundle.
+type BundleEntry struct {
+	FullURL  string         `json:"fullUrl,omitempty"`
+	Resource any            `json:"resource"`
+	Request  *BundleRequest `json:"request,omitempty"`
+}
+
+// BundleRequest is the request of a transaction bundle entry.
+type BundleRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// Patient is a FHIR Patient resource.
+type Patient struct {
+	ResourceType     string           `json:"resourceType"`
+	ID               string           `json:"id,omitempty"`
+	Identifier       []Identifier     `json:"identifier,omitempty"`
+	Name             []HumanName      `json:"name,omitempty"`
+	Telecom          []ContactPoint   `json:"telecom,omitempty"`
+	Gender           string           `json:"gender,omitempty"`
+	BirthDate        string           `json:"birthDate,omitempty"`
+	DeceasedBoolean  *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedDateTime string           `json:"deceasedDateTime,omitempty"`
+	Address          []Address        `json:"address,omitempty"`
+	MaritalStatus    *CodeableConcept `json:"maritalStatus,omitempty"`
+}
+
+// Encounter is a FHIR Encounter resource.
+type Encounter struct {
+	ResourceType string                 `json:"resourceType"`
+	ID           string                 `json:"id,omitempty"`
+	Identifier   []Identifier           `json:"identifier,omitempty"`
+	Status       string                 `json:"status"`
+	Class        Coding                 `json:"class"`
+	Subject      *Reference             `json:"subject,omitempty"`
+	Participant  []EncounterParticipant `json:"participant,omitempty"`
+	Period       *Period                `json:"period,omitempty"`
+	Location     []EncounterLocation    `json:"location,omitempty"`
+}
+
+// EncounterParticipant is a participant of an Encounter, such as the attending doctor.
+type EncounterParticipant struct {
+	Type       []CodeableConcept `json:"type,omitempty"`
+	Individual *Reference        `json:"individual,omitempty"`
+}
+
+// EncounterLocation is a location of an Encounter.
+type EncounterLocation struct {
+	Location Reference `json:"location"`
+	Status   string    `json:"status,omitempty"`
+}
+
+// Coverage is a FHIR Coverage resource.
+type Coverage struct {
+	ResourceType string           `json:"resourceType"`
+	ID           string           `json:"id,omitempty"`
+	Identifier   []Identifier     `json:"identifier,omitempty"`
+	Status       string           `json:"status"`
+	Type         *CodeableConcept `json:"type,omitempty"`
+	SubscriberID string           `json:"subscriberId,omitempty"`
+	Beneficiary  Reference        `json:"beneficiary"`
+	Relationship *CodeableConcept `json:"relationship,omitempty"`
+	Period       *Period          `json:"period,omitempty"`
+	Payor        []Reference      `json:"payor"`
+	Class        []CoverageClass  `json:"class,omitempty"`
+	Order        int              `json:"order,omitempty"`
+}
+
+// CoverageClass is a plan or group of a Coverage.
+type CoverageClass struct {
+	Type  CodeableConcept `json:"type"`
+	Value string          `json:"value"`
+	Name  string          `json:"name,omitempty"`
+}
+
+// Identifier is a FHIR Identifier.
+type Identifier struct {
+	Use      string           `json:"use,omitempty"`
+	Type     *CodeableConcept `json:"type,omitempty"`
+	System   string           `json:"system,omitempty"`
+	Value    string           `json:"value,omitempty"`
+	Assigner *Reference       `json:"assigner,omitempty"`
+}
+
+// HumanName is a FHIR HumanName.
+type HumanName struct {
+	Use    string   `json:"use,omitempty"`
+	Text   string   `json:"text,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+	Prefix []string `json:"prefix,omitempty"`
+	Suffix []string `json:"suffix,omitempty"`
+}
+
+// Address is a FHIR Address.
+type Address struct {
+	Use        string   `json:"use,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Line       []string `json:"line,omitempty"`
+	City       string   `json:"city,omitempty"`
+	District   string   `json:"district,omitempty"`
+	State      string   `json:"state,omitempty"`
+	PostalCode string   `json:"postalCode,omitempty"`
+	Country    string   `json:"country,omitempty"`
+}
+
+// ContactPoint is a FHIR ContactPoint.
+type ContactPoint struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Use    string `json:"use,omitempty"`
+}
+
+// CodeableConcept is a FHIR CodeableConcept.
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Coding is a FHIR Coding.
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// Period is a FHIR Period.
+type Period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+// Reference is a FHIR Reference.
+type Reference struct {
+	Reference  string      `json:"reference,omitempty"`
+	Identifier *Identifier `json:"identifier,omitempty"`
+	Display    string      `json:"display,omitempty"`
+}
+
+// builder adds resources to a bundle.
+type builder struct {
+	opt    *Option
+	bundle *Bundle
+}
+
+func newBuilder(opt *Option) *builder {
+	return &builder{
+		opt:    opt,
+		bundle: &Bundle{ResourceType: "Bundle", Type: opt.bundleType()},
+	}
+}
+
+// add adds the resource to the bundle and returns a reference to it.
+func (b *builder) add(resourceType string, resource any) *Reference {
+	url := "urn:uuid:" + b.opt.newID()
+	e := BundleEntry{FullURL: url, Resource: resource}
+	if b.bundle.Type == BundleTransaction {
+		e.Request = &BundleRequest{Method: "POST", URL: resourceType}
+	}
+	b.bundle.Entry = append(b.bundle.Entry, e)
+	return &Reference{Reference: url}
+}
+
+// DiagnosticReport is a FHIR DiagnosticReport resource.
+type DiagnosticReport struct {
+	ResourceType      string            `json:"resourceType"`
+	ID                string            `json:"id,omitempty"`
+	Identifier        []Identifier      `json:"identifier,omitempty"`
+	Status            string            `json:"status"`
+	Category          []CodeableConcept `json:"category,omitempty"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           *Reference        `json:"subject,omitempty"`
+	EffectiveDateTime string            `json:"effectiveDateTime,omitempty"`
+	Issued            string            `json:"issued,omitempty"`
+	Specimen          []Reference       `json:"specimen,omitempty"`
+	Result            []Reference       `json:"result,omitempty"`
+}
+
+// Observation is a FHIR Observation resource.
+type Observation struct {
+	ResourceType         string                      `json:"resourceType"`
+	ID                   string                      `json:"id,omitempty"`
+	Identifier           []Identifier                `json:"identifier,omitempty"`
+	Status               string                      `json:"status"`
+	Code                 CodeableConcept             `json:"code"`
+	Subject              *Reference                  `json:"subject,omitempty"`
+	EffectiveDateTime    string                      `json:"effectiveDateTime,omitempty"`
+	ValueQuantity        *Quantity                   `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept            `json:"valueCodeableConcept,omitempty"`
+	ValueString          string                      `json:"valueString,omitempty"`
+	ValueDateTime        string                      `json:"valueDateTime,omitempty"`
+	Interpretation       []CodeableConcept           `json:"interpretation,omitempty"`
+	Note                 []Annotation                `json:"note,omitempty"`
+	Method               *CodeableConcept            `json:"method,omitempty"`
+	Specimen             *Reference                  `json:"specimen,omitempty"`
+	ReferenceRange       []ObservationReferenceRange `json:"referenceRange,omitempty"`
+}
+
+// ObservationReferenceRange is a reference range of an Observation.
+type ObservationReferenceRange struct {
+	Low  *Quantity `json:"low,omitempty"`
+	High *Quantity `json:"high,omitempty"`
+	Text string    `json:"text,omitempty"`
+}
+
+// Specimen is a FHIR Specimen resource.
+type Specimen struct {
+	ResourceType        string              `json:"resourceType"`
+	ID                  string              `json:"id,omitempty"`
+	Identifier          []Identifier        `json:"identifier,omitempty"`
+	AccessionIdentifier *Identifier         `json:"accessionIdentifier,omitempty"`
+	Type                *CodeableConcept    `json:"type,omitempty"`
+	Subject             *Reference          `json:"subject,omitempty"`
+	ReceivedTime        string              `json:"receivedTime,omitempty"`
+	Collection          *SpecimenCollection `json:"collection,omitempty"`
+}
+
+// SpecimenCollection is the collection of a Specimen.
+type SpecimenCollection struct {
+	CollectedDateTime string           `json:"collectedDateTime,omitempty"`
+	Method            *CodeableConcept `json:"method,omitempty"`
+	BodySite          *CodeableConcept `json:"bodySite,omitempty"`
+}
+
+// Quantity is a FHIR Quantity. The value is a JSON number.
+type Quantity struct {
+	Value      json.Number `json:"value,omitempty"`
+	Comparator string      `json:"comparator,omitempty"`
+	Unit       string      `json:"unit,omitempty"`
+	System     string      `json:"system,omitempty"`
+	Code       string      `json:"code,omitempty"`
+}
+
+// Annotation is a FHIR Annotation, such as a note of an Observation.
+type Annotation struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,299 @@
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kardianos/hl7"
+)
+
+// ORU converts an observation result message of any version, such as h251.ORU_R01, to a bundle with
+// a Patient resource from the PID segment if sent, and for each order a DiagnosticReport resource from the OBR
+// segment, an Observation resource from each OBX segment, and a Specimen resource from each SPM segment,
+// or from OBR-15 in versions before 2.5. OBX segments that describe a specimen are not converted.
+//
+// Pass the segment list from DecodeList to keep the segments of each order as received; see hl7.GroupResults.
+func ORU(message any, opt *Option) (*Bundle, error) {
+	orders, err := hl7.GroupResults(message)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("message has no OBR segment")
+	}
+	b := newBuilder(opt)
+	patients := map[any]*Reference{}
+	for i, o := range orders {
+		var subject *Reference
+		if o.Patient != nil {
+			subject = patients[o.Patient]
+			if subject == nil {
+				p, err := b.patient(o.Patient)
+				if err != nil {
+					return nil, fmt.Errorf("order %d: %w", i+1, err)
+				}
+				subject = b.add("Patient", p)
+				patients[o.Patient] = subject
+			}
+		}
+		err := b.order(o, subject)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i+1, err)
+		}
+	}
+	return b.bundle, nil
+}
+
+// reportStatus maps result statuses (table 0123) to the FHIR diagnostic report status.
+var reportStatus = map[string]string{
+	string(hl7.ResultOrderReceived): "registered",
+	string(hl7.ResultNoResults):     "registered",
+	string(hl7.ResultScheduled):     "registered",
+	string(hl7.ResultSomeAvailable): "partial",
+	string(hl7.ResultStored):        "partial",
+	string(hl7.ResultPreliminary):   "preliminary",
+	string(hl7.ResultCorrection):    "corrected",
+	string(hl7.ResultFinal):         "final",
+	string(hl7.ResultCanceled):      "cancelled",
+}
+
+// observationStatus maps observation result statuses (table 0085) to the FHIR observation status.
+var observationStatus = map[string]string{
+	"C": "corrected",
+	"D": "entered-in-error",
+	"F": "final",
+	"I": "registered",
+	"N": "cancelled",
+	"O": "registered",
+	"P": "preliminary",
+	"R": "preliminary",
+	"S": "preliminary",
+	"U": "final",
+	"W": "entered-in-error",
+	"X": "cancelled",
+}
+
+func status(m map[string]string, code string) string {
+	if s, ok := m[code]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+func (b *builder) order(o hl7.Order, subject *Reference) error {
+	obr := o.OBR
+	r := &DiagnosticReport{
+		ResourceType:      "DiagnosticReport",
+		Status:            status(reportStatus, str(obr, 25)),
+		Subject:           subject,
+		EffectiveDateTime: dateTime(timeValue(obr, 7)),
+		Issued:            instant(timeValue(obr, 22)),
+	}
+	if code := b.concept(hl7.Field(obr, 4), ""); code != nil {
+		r.Code = *code
+	}
+	if c := b.concept(hl7.Field(obr, 24), "0074"); c != nil {
+		r.Category = append(r.Category, *c)
+	}
+	for _, f := range []struct {
+		order int
+		code  string
+	}{{2, "PLAC"}, {3, "FILL"}} {
+		if id := identifier(value(obr, f.order)); len(id.Value) > 0 {
+			id.Type = v2Code("0203", f.code, "")
+			r.Identifier = append(r.Identifier, id)
+		}
+	}
+
+	var specimens []*Reference
+	for _, seg := range o.Segment {
+		if hl7.SegmentName(seg) == "SPM" {
+			specimens = append(specimens, b.add("Specimen", b.specimen(seg, subject)))
+		}
+	}
+	if len(specimens) == 0 {
+		if s := b.sourceSpecimen(obr, subject); s != nil {
+			specimens = append(specimens, b.add("Specimen", s))
+		}
+	}
+	for _, s := range specimens {
+		r.Specimen = append(r.Specimen, *s)
+	}
+
+	for i, ob := range o.Observation {
+		obs, err := b.observation(ob, subject)
+		if err != nil {
+			return fmt.Errorf("observation %d: %w", i+1, err)
+		}
+		if len(obs.EffectiveDateTime) == 0 {
+			obs.EffectiveDateTime = r.EffectiveDateTime
+		}
+		if len(specimens) == 1 {
+			obs.Specimen = specimens[0]
+		}
+		r.Result = append(r.Result, *b.add("Observation", obs))
+	}
+	b.add("DiagnosticReport", r)
+	return nil
+}
+
+func (b *builder) observation(ob hl7.Observation, subject *Reference) (*Observation, error) {
+	obx := ob.OBX
+	obs := &Observation{
+		ResourceType:      "Observation",
+		Status:            status(observationStatus, str(obx, 11)),
+		Subject:           subject,
+		EffectiveDateTime: dateTime(timeValue(obx, 14)),
+		Method:            b.concept(hl7.Field(obx, 17), ""),
+	}
+	if code := b.concept(hl7.Field(obx, 3), ""); code != nil {
+		obs.Code = *code
+	}
+	units, err := hl7.ObservationUnits(obx)
+	if err != nil {
+		return nil, err
+	}
+	err = b.observationValue(obs, obx, units)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := hl7.AbnormalFlags(obx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range flags {
+		obs.Interpretation = append(obs.Interpretation, CodeableConcept{Coding: []Coding{{
+			System: "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation", Code: f,
+		}}})
+	}
+	if text := str(obx, 7); len(text) > 0 {
+		rr := ObservationReferenceRange{Text: text}
+		if r, err := hl7.ObservationRange(obx); err == nil {
+			rr.Low = quantity(r.Low, units)
+			rr.High = quantity(r.High, units)
+		}
+		obs.ReferenceRange = append(obs.ReferenceRange, rr)
+	}
+	for _, nte := range ob.Notes {
+		if text := noteText(nte); len(text) > 0 {
+			obs.Note = append(obs.Note, Annotation{Text: text})
+		}
+	}
+	return obs, nil
+}
+
+// observationValue sets the value of the observation from OBX-5 by its value type (OBX-2).
+func (b *builder) observationValue(obs *Observation, obx any, units hl7.Units) error {
+	if hl7.Field(obx, 5) == nil || len(reps(hl7.Field(obx, 5))) == 0 {
+		return nil
+	}
+	switch valueType := str(obx, 2); valueType {
+	case "NM":
+		d, err := hl7.NumericValue(obx)
+		if err != nil {
+			return err
+		}
+		obs.ValueQuantity = quantity(d, units)
+	case "SN":
+		// A structured numeric value with a comparator, such as <^0.5, is a quantity.
+		sn := value(obx, 5)
+		comparator, num, sep := str(sn, 1), str(sn, 2), str(sn, 3)
+		d, err := hl7.ParseDecimal(num)
+		if err == nil && len(sep) == 0 && len(str(sn, 4)) == 0 {
+			obs.ValueQuantity = quantity(d, units)
+			if comparator != "=" {
+				obs.ValueQuantity.Comparator = comparator
+			}
+			return nil
+		}
+		obs.ValueString = strings.TrimSpace(comparator + num + sep + str(sn, 4))
+	case "CE", "CWE", "CNE", "CF":
+		obs.ValueCodeableConcept = b.concept(hl7.Field(obx, 5), "")
+	case "DT":
+		obs.ValueDateTime = date(timeValue(obx, 5))
+	case "TS", "DTM":
+		obs.ValueDateTime = dateTime(timeValue(obx, 5))
+	default:
+		var lines []string
+		for _, v := range reps(hl7.Field(obx, 5)) {
+			lines = append(lines, str(v))
+		}
+		obs.ValueString = strings.Join(lines, "\n")
+	}
+	return nil
+}
+
+// quantity returns the quantity of the value in the units, or nil if the value is not set.
+func quantity(d hl7.Decimal, u hl7.Units) *Quantity {
+	if d.IsZero() {
+		return nil
+	}
+	q := &Quantity{Value: json.Number(strings.TrimPrefix(d.String(), "+")), Unit: u.Text}
+	if len(q.Unit) == 0 {
+		q.Unit = u.Identifier
+	}
+	if system := codeSystem(u.CodingSystem); len(system) > 0 {
+		q.System = system
+		q.Code = u.Identifier
+	}
+	return q
+}
+
+// noteText returns the comment (NTE-3) of an NTE segment, with repetitions on separate lines.
+func noteText(nte any) string {
+	var lines []string
+	for _, v := range reps(hl7.Field(nte, 3)) {
+		lines = append(lines, str(v))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func (b *builder) specimen(spm any, subject *Reference) *Specimen {
+	s := &Specimen{
+		ResourceType: "Specimen",
+		Type:         b.concept(hl7.Field(spm, 4), "0487"),
+		Subject:      subject,
+		ReceivedTime: dateTime(timeValue(spm, 18)),
+	}
+	for _, c := range []int{1, 2} { // Placer and filler assigned identifiers.
+		if id := identifier(value(spm, 2, c)); len(id.Value) > 0 {
+			s.Identifier = append(s.Identifier, id)
+		}
+	}
+	col := &SpecimenCollection{
+		CollectedDateTime: dateTime(timeValue(spm, 17, 1)),
+		Method:            b.concept(hl7.Field(spm, 7), "0488"),
+		BodySite:          b.concept(hl7.Field(spm, 8), ""),
+	}
+	if *col != (SpecimenCollection{}) {
+		s.Collection = col
+	}
+	return s
+}
+
+// sourceSpecimen returns the specimen of the specimen source (OBR-15) and collection time (OBR-7)
+// of versions before 2.5, or nil if no source is sent.
+func (b *builder) sourceSpecimen(obr any, subject *Reference) *Specimen {
+	source := value(obr, 15)
+	if source == nil {
+		return nil
+	}
+	s := &Specimen{
+		ResourceType: "Specimen",
+		Type:         b.concept(value(source, 1), "0070"),
+		Subject:      subject,
+	}
+	if s.Type == nil {
+		return nil
+	}
+	col := &SpecimenCollection{
+		CollectedDateTime: dateTime(timeValue(obr, 7)),
+		BodySite:          b.concept(value(source, 4), "0163"),
+	}
+	if *col != (SpecimenCollection{}) {
+		s.Collection = col
+	}
+	return s
+}
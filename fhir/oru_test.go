@@ -0,0 +1,127 @@
+package fhir
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kardianos/hl7"
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestORU(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|LAB||EHR||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|1||123^^^MRN^MR||Doe^Jane||19800101|F`,
+		`ORC|RE|A1|F1`,
+		`OBR|1|A1^EHR|F1^LAB|24323-8^Comprehensive metabolic panel^LN|||20240102080000|||||||||||||||20240102090000-0500||CH|F`,
+		`OBX|1|NM|GLU^Glucose^L||95|mg/dL^^UCUM|70-99|N|||F|||20240102081500`,
+		`NTE|1||Fasting`,
+		`OBX|2|SN|1751-7^Albumin^LN||<^2.5|g/dL^^UCUM|3.5-5.0|L|||F`,
+		`OBX|3|CWE|882-1^ABO and Rh group^LN||A^Group A^HL70005||||||P`,
+		`OBX|4|TX|8251-1^Service comment^LN||Line one~Line two||||||F`,
+		`SPM|1|S100&EHR^L100&LAB||119297000^Blood specimen^SCT|||||||||||||20240102075500|20240102080500`,
+	}, "\r")
+	list, err := hl7.NewDecoder(v251.Registry, nil).DecodeList([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := testOption()
+	opt.Codes = CodeMapperFunc(func(system string, c Coding) []Coding {
+		if system == "L" && c.Code == "GLU" {
+			return []Coding{{System: "http://lab.example.com/codes", Code: c.Code, Display: c.Display}, {System: "http://loinc.org", Code: "2345-7"}}
+		}
+		return []Coding{c}
+	})
+	b, err := ORU(list, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, e := range b.Entry {
+		types = append(types, reflect.Indirect(reflect.ValueOf(e.Resource)).FieldByName("ResourceType").String())
+	}
+	if got, want := strings.Join(types, " "), "Patient Specimen Observation Observation Observation Observation DiagnosticReport"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	s := b.Entry[1].Resource.(*Specimen)
+	if s.Type.Coding[0].System != "http://snomed.info/sct" || len(s.Identifier) != 2 || s.Identifier[1].Value != "L100" {
+		t.Errorf("got specimen %+v", s)
+	}
+	if s.Collection == nil || s.Collection.CollectedDateTime == "" || s.ReceivedTime == "" {
+		t.Errorf("got collection %+v", s.Collection)
+	}
+
+	glu := b.Entry[2].Resource.(*Observation)
+	if len(glu.Code.Coding) != 2 || glu.Code.Coding[1].Code != "2345-7" || glu.Code.Coding[0].System != "http://lab.example.com/codes" {
+		t.Errorf("got code %+v", glu.Code)
+	}
+	if glu.ValueQuantity == nil || glu.ValueQuantity.Value != "95" || glu.ValueQuantity.System != "http://unitsofmeasure.org" || glu.ValueQuantity.Code != "mg/dL" {
+		t.Errorf("got value %+v", glu.ValueQuantity)
+	}
+	if len(glu.ReferenceRange) != 1 || glu.ReferenceRange[0].Low.Value != "70" || glu.ReferenceRange[0].High.Value != "99" {
+		t.Errorf("got reference range %+v", glu.ReferenceRange)
+	}
+	if glu.Status != "final" || glu.Interpretation[0].Coding[0].Code != "N" || glu.Note[0].Text != "Fasting" {
+		t.Errorf("got %+v", glu)
+	}
+	if glu.Specimen == nil || glu.Specimen.Reference != b.Entry[1].FullURL {
+		t.Errorf("got specimen %+v", glu.Specimen)
+	}
+
+	alb := b.Entry[3].Resource.(*Observation)
+	if alb.ValueQuantity == nil || alb.ValueQuantity.Comparator != "<" || alb.ValueQuantity.Value != "2.5" {
+		t.Errorf("got value %+v", alb.ValueQuantity)
+	}
+	abo := b.Entry[4].Resource.(*Observation)
+	if abo.Status != "preliminary" || abo.ValueCodeableConcept == nil || abo.ValueCodeableConcept.Coding[0].Code != "A" {
+		t.Errorf("got %+v", abo)
+	}
+	if txt := b.Entry[5].Resource.(*Observation); txt.ValueString != "Line one\nLine two" {
+		t.Errorf("got %q", txt.ValueString)
+	}
+
+	r := b.Entry[6].Resource.(*DiagnosticReport)
+	if r.Status != "final" || r.Code.Coding[0].Code != "24323-8" || len(r.Result) != 4 || len(r.Specimen) != 1 {
+		t.Errorf("got %+v", r)
+	}
+	if r.Issued != "2024-01-02T09:00:00-05:00" || r.Subject.Reference != b.Entry[0].FullURL {
+		t.Errorf("got issued %q, subject %+v", r.Issued, r.Subject)
+	}
+	if len(r.Identifier) != 2 || r.Identifier[0].Type.Coding[0].Code != "PLAC" || r.Identifier[1].Value != "F1" {
+		t.Errorf("got identifiers %+v", r.Identifier)
+	}
+}
+
+func TestORUSpecimenSource(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|LAB||EHR||20240102030405||ORU^R01|1|P|2.3.1`,
+		`PID|1||123||Doe^Jane`,
+		`OBR|1|A1||CBC^Complete blood count^L|||20240102080000||||||||BLD^Blood^HL70070||||||||||C`,
+		`OBX|1|NM|WBC^White cells^L||7.2|10*3/uL|4.0-11.0||||C`,
+	}, "\r")
+	msg, err := hl7.NewDecoder(v231.Registry, nil).Decode([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ORU(msg, testOption())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Entry) != 4 {
+		t.Fatalf("got %d entries", len(b.Entry))
+	}
+	s := b.Entry[1].Resource.(*Specimen)
+	if s.Type == nil || s.Type.Coding[0].Code != "BLD" || s.Type.Coding[0].System != "http://terminology.hl7.org/CodeSystem/v2-0070" {
+		t.Errorf("got specimen %+v", s)
+	}
+	obs := b.Entry[2].Resource.(*Observation)
+	if obs.Status != "corrected" || obs.EffectiveDateTime != "2024-01-02T08:00:00Z" || obs.ValueQuantity.Unit != "10*3/uL" || obs.ValueQuantity.System != "" {
+		t.Errorf("got %+v %+v", obs, obs.ValueQuantity)
+	}
+	if r := b.Entry[3].Resource.(*DiagnosticReport); r.Status != "corrected" {
+		t.Errorf("got %+v", r)
+	}
+}
@@ -0,0 +1,299 @@
+package fhir
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/kardianos/hl7"
+)
+
+// value returns the field or component at the orders, taking the first repetition of repeating values.
+func value(v any, orders ...int) any {
+	for _, o := range orders {
+		v = hl7.Field(first(v), o)
+	}
+	return first(v)
+}
+
+// first returns the first repetition of a repeating value, or the value.
+func first(v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return v
+	}
+	if rv.Len() == 0 {
+		return nil
+	}
+	return rv.Index(0).Interface()
+}
+
+// str returns the string of the field or component at the orders. If the value is a data type,
+// its first component is used, such as the identifier of a CE value.
+func str(v any, orders ...int) string {
+	v = value(v, orders...)
+	for i := 0; i < 4; i++ {
+		rv := reflect.Indirect(reflect.ValueOf(v))
+		switch rv.Kind() {
+		default:
+			return ""
+		case reflect.String:
+			return strings.TrimSpace(rv.String())
+		case reflect.Struct:
+			if t, ok := rv.Interface().(time.Time); ok {
+				return dateTime(hl7.DateTime{Time: t})
+			}
+			v = value(v, 1)
+		}
+	}
+	return ""
+}
+
+// reps returns each repetition of a repeating value. A value that does not repeat is one repetition.
+func reps(v any) []any {
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return []any{v}
+	}
+	list := make([]any, rv.Len())
+	for i := range list {
+		if e := rv.Index(i); e.Kind() == reflect.Interface {
+			list[i] = e.Interface()
+		} else {
+			list[i] = e.Addr().Interface()
+		}
+	}
+	return list
+}
+
+// timeValue returns the date or time of the field or component at the orders. A DateTime or Date value keeps
+// the precision it was received with. A time.Time value, such as of a registry field, records no precision,
+// so the precision of the format tag of its field is used, such as a date for a DT field.
+func timeValue(v any, orders ...int) hl7.DateTime {
+	var parent any
+	var order int
+	for _, o := range orders {
+		parent, order = first(v), o
+		v = hl7.Field(parent, o)
+	}
+	v = first(v)
+	for i := 0; i < 2; i++ {
+		switch x := reflect.Indirect(reflect.ValueOf(v)); {
+		case !x.IsValid():
+			return hl7.DateTime{}
+		case x.Type() == reflect.TypeOf(hl7.DateTime{}):
+			return x.Interface().(hl7.DateTime)
+		case x.Type() == reflect.TypeOf(hl7.Date{}):
+			d := x.Interface().(hl7.Date)
+			p := d.Precision
+			if p == hl7.PrecisionDefault {
+				p = hl7.PrecisionDay
+			}
+			return hl7.DateTime{Time: d.Time, Precision: p, Null: d.Null}
+		case x.Type() == reflect.TypeOf(time.Time{}):
+			return hl7.DateTime{Time: x.Interface().(time.Time), Precision: formatPrecision(parent, order)}
+		case x.Kind() == reflect.Struct:
+			parent, order = v, 1
+			v = value(v, 1) // The time of a TS data type value.
+		}
+	}
+	return hl7.DateTime{}
+}
+
+// formatPrecision returns the date precision of the format tag of the field at the order, or PrecisionDefault.
+func formatPrecision(parent any, order int) hl7.Precision {
+	if parent == nil {
+		return hl7.PrecisionDefault
+	}
+	info, err := hl7.Describe(parent)
+	if err != nil {
+		return hl7.PrecisionDefault
+	}
+	for _, f := range info.Field {
+		if f.Position != order {
+			continue
+		}
+		switch f.Format {
+		case "Y":
+			return hl7.PrecisionYear
+		case "YM":
+			return hl7.PrecisionMonth
+		case "YMD":
+			return hl7.PrecisionDay
+		}
+	}
+	return hl7.PrecisionDefault
+}
+
+// date returns the FHIR date of the time, to the year or month if it was received so, or empty if not set.
+func date(t hl7.DateTime) string {
+	if t.IsZero() || t.Null {
+		return ""
+	}
+	switch t.Precision {
+	case hl7.PrecisionYear:
+		return t.Format("2006")
+	case hl7.PrecisionMonth:
+		return t.Format("2006-01")
+	}
+	return t.Format("2006-01-02")
+}
+
+// dateTime returns the FHIR dateTime of the time to its precision, or empty if not set.
+// FHIR requires an offset with a time of day, so a time received without one is given the offset of its
+// location, the DecodeOption Location of the message.
+func dateTime(t hl7.DateTime) string {
+	if t.IsZero() || t.Null {
+		return ""
+	}
+	switch t.Precision {
+	case hl7.PrecisionYear, hl7.PrecisionMonth, hl7.PrecisionDay:
+		return date(t)
+	case hl7.PrecisionFraction:
+		digits := t.FractionDigits
+		if digits < 1 || digits > 9 {
+			digits = 4
+		}
+		return t.Format("2006-01-02T15:04:05." + strings.Repeat("0", digits) + "Z07:00")
+	}
+	return t.Format(time.RFC3339)
+}
+
+// period returns the period of the times, or nil if neither is set.
+func period(start, end hl7.DateTime) *Period {
+	p := &Period{Start: dateTime(start), End: dateTime(end)}
+	if *p == (Period{}) {
+		return nil
+	}
+	return p
+}
+
+// codeSystems are the FHIR URIs of HL7 table 0396 coding systems.
+var codeSystems = map[string]string{
+	"LN":     "http://loinc.org",
+	"SCT":    "http://snomed.info/sct",
+	"SNM":    "http://snomed.info/sct",
+	"I9C":    "http://hl7.org/fhir/sid/icd-9-cm",
+	"I10":    "http://hl7.org/fhir/sid/icd-10-cm",
+	"I10C":   "http://hl7.org/fhir/sid/icd-10-cm",
+	"CPT":    "http://www.ama-assn.org/go/cpt",
+	"C4":     "http://www.ama-assn.org/go/cpt",
+	"UCUM":   "http://unitsofmeasure.org",
+	"RXNORM": "http://www.nlm.nih.gov/research/umls/rxnorm",
+	"NDC":    "http://hl7.org/fhir/sid/ndc",
+	"CVX":    "http://hl7.org/fhir/sid/cvx",
+	"MVX":    "http://terminology.hl7.org/CodeSystem/MVX",
+}
+
+// codeSystem returns the FHIR URI of a coding system, such as LN or HL70078, or empty if not known.
+func codeSystem(name string) string {
+	if uri, ok := codeSystems[strings.ToUpper(name)]; ok {
+		return uri
+	}
+	if len(name) == 7 && strings.HasPrefix(strings.ToUpper(name), "HL7") {
+		return "http://terminology.hl7.org/CodeSystem/v2-" + name[3:]
+	}
+	return ""
+}
+
+// concept returns the codeable concept of a CE, CWE, or CNE value, or of a string value in the table, or nil if not set.
+// Each coding is mapped by the CodeMapper of the option.
+func (b *builder) concept(ce any, table string) *CodeableConcept {
+	ce = first(ce)
+	c := &CodeableConcept{}
+	add := func(code, text, system string) {
+		if len(system) == 0 && len(table) > 0 {
+			system = "HL7" + table
+		}
+		coding := Coding{System: codeSystem(system), Code: code, Display: text}
+		if b.opt != nil && b.opt.Codes != nil {
+			c.Coding = append(c.Coding, b.opt.Codes.MapCode(system, coding)...)
+			return
+		}
+		c.Coding = append(c.Coding, coding)
+	}
+	if s, ok := ce.(string); ok {
+		if s = strings.TrimSpace(s); len(s) == 0 {
+			return nil
+		}
+		add(s, "", "")
+		return c
+	}
+	for _, o := range [][3]int{{1, 2, 3}, {4, 5, 6}} {
+		code, text, system := str(ce, o[0]), str(ce, o[1]), str(ce, o[2])
+		switch {
+		case len(code) > 0:
+			add(code, text, system)
+		case len(text) > 0:
+			c.Text = text
+		}
+	}
+	if len(c.Coding) == 0 && len(c.Text) == 0 {
+		return nil
+	}
+	return c
+}
+
+// v2Code returns a codeable concept in an HL7 v2 table, such as 0203.
+func v2Code(table, code, display string) *CodeableConcept {
+	if len(code) == 0 {
+		return nil
+	}
+	return &CodeableConcept{Coding: []Coding{{System: codeSystem("HL7" + table), Code: code, Display: display}}}
+}
+
+// identifier returns the identifier of a CX, EI, or XCN value.
+func identifier(cx any) Identifier {
+	id := Identifier{Value: str(cx, 1)}
+	rv := reflect.Indirect(reflect.ValueOf(cx))
+	if !rv.IsValid() {
+		return id
+	}
+	switch rv.Type().Name() {
+	case "CX":
+		id.System, id.Assigner = authority(value(cx, 4))
+		id.Type = v2Code("0203", str(cx, 5), "")
+	case "EI":
+		id.System, id.Assigner = authority(cx, 2, 3, 4)
+	case "XCN":
+		id.System, id.Assigner = authority(value(cx, 9))
+		id.Type = v2Code("0203", str(cx, 13), "")
+	}
+	return id
+}
+
+// authority returns the identifier system and assigner of an HD or IS value, or of the namespace ID,
+// universal ID, and universal ID type components of a data type. An ISO OID or a UUID universal ID
+// is the system; otherwise the namespace ID is the assigner display.
+func authority(hd any, orders ...int) (string, *Reference) {
+	if s, ok := hd.(string); ok {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			return "", &Reference{Display: s}
+		}
+		return "", nil
+	}
+	if len(orders) == 0 {
+		orders = []int{1, 2, 3}
+	}
+	namespace, id, idType := str(hd, orders[0]), str(hd, orders[1]), str(hd, orders[2])
+	switch {
+	case len(id) > 0 && strings.EqualFold(idType, "ISO"):
+		return "urn:oid:" + id, nil
+	case len(id) > 0 && strings.EqualFold(idType, "UUID"):
+		return "urn:uuid:" + id, nil
+	case len(namespace) > 0:
+		return "", &Reference{Display: namespace}
+	}
+	return "", nil
+}
+
+// instant returns the FHIR instant of the time, which includes the time zone, or empty if not set.
+func instant(t hl7.DateTime) string {
+	if t.IsZero() || t.Null {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
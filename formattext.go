@@ -0,0 +1,98 @@
+package hl7
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FormatText selects how formatted text escape sequences used in FT and TX
+// fields (\.br\, \.sp\, \.in\, \H\, \N\, etc) are converted when decoded.
+type FormatText byte
+
+const (
+	FormatTextNone  FormatText = iota // Leave formatting escape sequences as is.
+	FormatTextPlain                   // Convert to plain text with newlines and spaces.
+	FormatTextHTML                    // Convert to HTML, escaping the text.
+)
+
+// formatSequence writes the converted formatting escape sequence to buf.
+// It returns false if the sequence is not known or formatting is not converted.
+func (d *lineDecoder) formatSequence(buf *strings.Builder, st *unescapeState, seq []byte) bool {
+	mode := d.opt.FormatText
+	if mode == FormatTextNone {
+		return false
+	}
+	isHTML := mode == FormatTextHTML
+
+	switch seq[0] {
+	case 'H':
+		if len(seq) != 1 {
+			return false
+		}
+		if isHTML && !st.highlight {
+			buf.WriteString("<b>")
+		}
+		st.highlight = true
+		return true
+	case 'N':
+		if len(seq) != 1 {
+			return false
+		}
+		if isHTML && st.highlight {
+			buf.WriteString("</b>")
+		}
+		st.highlight = false
+		return true
+	}
+
+	// Commands are a two letter name after the dot with an optional number: .sp 2
+	if len(seq) < 3 {
+		return false
+	}
+	cmd := string(seq[1:3])
+	arg := strings.TrimSpace(string(seq[3:]))
+	n := 1
+	if len(arg) > 0 {
+		i, err := strconv.Atoi(arg)
+		if err != nil {
+			return false
+		}
+		n = i
+	}
+
+	newline := "\n"
+	space := " "
+	if isHTML {
+		newline = "<br>"
+		space = "&nbsp;"
+	}
+	switch cmd {
+	default:
+		return false
+	case "br", "ce":
+		if len(arg) > 0 {
+			return false
+		}
+		buf.WriteString(newline)
+	case "sp":
+		if n < 0 {
+			return false
+		}
+		buf.WriteString(strings.Repeat(newline, n))
+	case "sk", "ti":
+		if n > 0 {
+			buf.WriteString(strings.Repeat(space, n))
+		}
+	case "in", "fi", "nf":
+		// Indent and fill mode do not have a simple representation; drop them.
+	}
+	return true
+}
+
+// endFormat closes any formatting still open at the end of a value.
+func (d *lineDecoder) endFormat(buf *strings.Builder, st *unescapeState) {
+	if st.highlight && d.opt.FormatText == FormatTextHTML {
+		buf.WriteString("</b>")
+	}
+	st.highlight = false
+}
@@ -0,0 +1,33 @@
+package hl7
+
+import "testing"
+
+func TestFormatText(t *testing.T) {
+	const in = `Result:\.br\\H\High\N\ & low\.sp 2\\.sk 2\end\.in 4\`
+	tests := []struct {
+		name string
+		mode FormatText
+		in   string
+		want string
+	}{
+		{"none", FormatTextNone, in, in},
+		{"plain", FormatTextPlain, in, "Result:\nHigh & low\n\n  end"},
+		{"html", FormatTextHTML, in, "Result:<br><b>High</b> &amp; low<br><br>&nbsp;&nbsp;end"},
+		{"html unclosed", FormatTextHTML, `\H\x<y`, "<b>x&lt;y</b>"},
+		{"html no escape", FormatTextHTML, `a<b`, "a&lt;b"},
+		{"plain unknown", FormatTextPlain, `\.zz\`, `\.zz\`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestLineDecoder()
+			d.opt.FormatText = tt.mode
+			got, err := d.unescape([]byte(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("unescape(%q) got %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// GenericSegment is a segment decoded without a registry, as strings by position.
+type GenericSegment struct {
+	Name string
+
+	// Fields starting at field 1, with the repetitions of each field, the components of each repetition,
+	// and the subcomponents of each component. A field that is not sent has no repetitions.
+	// The field separator and encoding characters of a header segment, such as MSH-1 and MSH-2, are sent as is.
+	Fields [][][][]string
+}
+
+// Value returns the value at the field, repetition, component, and subcomponent, each starting at 1,
+// such as Value(5, 1, 1, 1) for the family name of PID-5. If the value is not sent, it is empty.
+func (s GenericSegment) Value(field, rep, comp, sub int) string {
+	if field < 1 || field > len(s.Fields) {
+		return ""
+	}
+	reps := s.Fields[field-1]
+	if rep < 1 || rep > len(reps) {
+		return ""
+	}
+	comps := reps[rep-1]
+	if comp < 1 || comp > len(comps) {
+		return ""
+	}
+	subs := comps[comp-1]
+	if sub < 1 || sub > len(subs) {
+		return ""
+	}
+	return subs[sub-1]
+}
+
+// UnmarshalGeneric decodes each segment of the data by position, without a registry, for tools that
+// handle messages of any version or with unknown segments. Values are unescaped. Option may be nil.
+func UnmarshalGeneric(data []byte, opt *DecodeOption) ([]GenericSegment, error) {
+	d := &Decoder{}
+	if opt != nil {
+		d.opt = *opt
+	}
+	lines, err := d.lines(data)
+	if err != nil {
+		return nil, err
+	}
+	ld := &lineDecoder{opt: d.opt}
+	if d.opt.Delimiters != nil {
+		ld.setDelimiters(*d.opt.Delimiters)
+	}
+	var ret []GenericSegment
+	for index, line := range lines {
+		lineNumber := index + 1
+		if len(line) == 0 {
+			continue
+		}
+		name, n := ld.getID(line)
+		if len(name) == 0 {
+			return nil, fmt.Errorf("line %d: missing segment type", lineNumber)
+		}
+		remain := line[n:]
+		seg := GenericSegment{Name: name}
+		switch name {
+		case "MSH", "BHS", "FHS":
+			dl, n, err := parseInitDelimiters(remain)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			ld.setDelimiters(dl)
+			remain = remain[n:]
+			seg.Fields = [][][][]string{{{{string(ld.sep)}}}, {{{ld.encodingChars()}}}}
+		}
+		if ld.sep == 0 {
+			return nil, fmt.Errorf("line %d: missing sep prior to field", lineNumber)
+		}
+		if len(remain) == 0 {
+			ret = append(ret, seg)
+			continue
+		}
+		// The segment ID or encoding characters are followed by a field separator.
+		parts := bytes.Split(remain[1:], []byte{ld.sep})
+		if name == "MSH" {
+			if err := ld.setCharset(append([][]byte{nil}, parts...), 2); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+		}
+		ld.line = lineNumber
+		for _, p := range parts {
+			ld.path = fmt.Sprintf("%s.%d", name, len(seg.Fields)+1)
+			f, err := ld.genericField(p)
+			if err != nil {
+				return nil, fmt.Errorf("line %d, %s: %w", lineNumber, ld.path, err)
+			}
+			seg.Fields = append(seg.Fields, f)
+		}
+		ret = append(ret, seg)
+	}
+	return ret, nil
+}
+
+// genericField splits a field into repetitions, components, and unescaped subcomponents.
+func (d *lineDecoder) genericField(data []byte) ([][][]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var reps [][][]string
+	for _, rep := range bytes.Split(data, []byte{d.repeat}) {
+		var comps [][]string
+		for _, comp := range bytes.Split(rep, []byte{d.dividers[1]}) {
+			var subs []string
+			for _, sub := range bytes.Split(comp, []byte{d.dividers[2]}) {
+				v, err := d.unescape(sub)
+				if err != nil {
+					return nil, err
+				}
+				subs = append(subs, v)
+			}
+			comps = append(comps, subs)
+		}
+		reps = append(reps, comps)
+	}
+	return reps, nil
+}
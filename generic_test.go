@@ -0,0 +1,49 @@
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalGeneric(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01|1|P|2.9`,
+		`PID|||123^^^MRN&1.2.3&ISO^MR~456||Smith \T\ Sons^Jane`,
+		`ZXY|1||a~~b`,
+		`EVN`,
+	}, "\r")
+	list, err := UnmarshalGeneric([]byte(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 4 {
+		t.Fatalf("got %d segments", len(list))
+	}
+	msh, pid, z, evn := list[0], list[1], list[2], list[3]
+	if msh.Name != "MSH" || msh.Value(1, 1, 1, 1) != "|" || msh.Value(2, 1, 1, 1) != `^~\&` || msh.Value(9, 1, 2, 1) != "R01" || msh.Value(12, 1, 1, 1) != "2.9" {
+		t.Errorf("got %+v", msh)
+	}
+	if len(msh.Fields) != 12 || msh.Fields[7] != nil {
+		t.Errorf("got MSH fields %#v", msh.Fields)
+	}
+	if pid.Value(3, 1, 4, 2) != "1.2.3" || pid.Value(3, 2, 1, 1) != "456" || pid.Value(5, 1, 1, 1) != "Smith & Sons" || pid.Value(5, 1, 2, 1) != "Jane" {
+		t.Errorf("got %+v", pid)
+	}
+	if pid.Value(3, 3, 1, 1) != "" || pid.Value(0, 1, 1, 1) != "" || pid.Value(99, 1, 1, 1) != "" {
+		t.Error("expected empty values out of range")
+	}
+	if want := [][][]string{{{"a"}}, {{""}}, {{"b"}}}; z.Name != "ZXY" || !reflect.DeepEqual(z.Fields[2], want) {
+		t.Errorf("got %#v", z.Fields)
+	}
+	if evn.Name != "EVN" || len(evn.Fields) != 0 {
+		t.Errorf("got %+v", evn)
+	}
+
+	if _, err := UnmarshalGeneric([]byte(raw), &DecodeOption{MaxSegments: 2}); err == nil {
+		t.Error("expected an error for too many segments")
+	}
+	if _, err := UnmarshalGeneric([]byte("MSH|^~"), nil); err == nil {
+		t.Error("expected an error for missing encoding characters")
+	}
+}
@@ -7,3 +7,5 @@ require (
 	github.com/mb0/diff v0.0.0-20131118162322-d8d9a906c24d
 	github.com/sanity-io/litter v1.5.1
 )
+
+require golang.org/x/text v0.14.0
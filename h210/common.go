@@ -2,4 +2,9 @@
 
 package h210
 
-type HL7Name struct{}
+// HL7Name is the meta field of each trigger, segment, and data type.
+type HL7Name struct {
+	// Present is the number of components present when decoded with KeepTrailingComponents.
+	// When set, the encoder will write at least this many components, preserving trailing empty components.
+	Present int
+}
@@ -10,7 +10,7 @@ type AD = string
 // Coded Element
 type CE struct {
 	HL7                         HL7Name `hl7:",name=CE,len=0,type=d"`
-	Identifier                  ID      `hl7:"1,display=Identifier"`
+	Identifier                  ID      `hl7:"1,datatype=ID,display=Identifier"`
 	Text                        ST      `hl7:"2,display=Text"`
 	NameOfCodingSystem          ST      `hl7:"3,display=Name Of Coding System"`
 	AlternateIdentifier         ST      `hl7:"4,display=Alternate Identifier"`
@@ -0,0 +1,1519 @@
+// Code generated by "hl7fetch -pkgdir h210 -root ./genjson -version 2.1"; DO NOT EDIT.
+
+package h210
+
+// Each table has an enum type, such as Table0001, with a constant for each value.
+// Declare a field with the enum type to check the value against the table when decoded,
+// as with the hl7.Enum interface.
+
+// Table0001 is a value of table 0001, SEX.
+type Table0001 string
+
+const (
+	Table0001_F Table0001 = `F` // Female
+	Table0001_M Table0001 = `M` // Male
+	Table0001_O Table0001 = `O` // Other
+	Table0001_U Table0001 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0001) TableID() string { return `0001` }
+
+// Valid reports if the value is in the table.
+func (v Table0001) Valid() bool { return TableValueLookup[`0001`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0001) Description() string { return tableDescription(`0001`, string(v)) }
+
+// Table0002 is a value of table 0002, MARITAL STATUS.
+type Table0002 string
+
+const (
+	Table0002_A Table0002 = `A` // Separated
+	Table0002_D Table0002 = `D` // Divorced
+	Table0002_M Table0002 = `M` // Married
+	Table0002_S Table0002 = `S` // Single
+	Table0002_W Table0002 = `W` // Widowed
+)
+
+// TableID returns the table ID.
+func (Table0002) TableID() string { return `0002` }
+
+// Valid reports if the value is in the table.
+func (v Table0002) Valid() bool { return TableValueLookup[`0002`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0002) Description() string { return tableDescription(`0002`, string(v)) }
+
+// Table0003 is a value of table 0003, EVENT TYPE CODE.
+type Table0003 string
+
+const (
+	Table0003_A01 Table0003 = `A01` // Admit a patient
+	Table0003_A02 Table0003 = `A02` // Transfer a Patient
+	Table0003_A03 Table0003 = `A03` // Discharge a Patient
+	Table0003_A04 Table0003 = `A04` // Register a Patient
+	Table0003_A05 Table0003 = `A05` // Pre-admit a Patient
+	Table0003_A06 Table0003 = `A06` // Transfer an outpatient to inpatient
+	Table0003_A07 Table0003 = `A07` // Transfer an Inpatient to Outpatient
+	Table0003_A08 Table0003 = `A08` // Update patient information
+	Table0003_A09 Table0003 = `A09` // Patient departing
+	Table0003_A10 Table0003 = `A10` // Patient arriving
+	Table0003_A11 Table0003 = `A11` // Cancel admit
+	Table0003_A12 Table0003 = `A12` // Cancel transfer
+	Table0003_A13 Table0003 = `A13` // Cancel discharge
+	Table0003_A14 Table0003 = `A14` // Pending admit
+	Table0003_A15 Table0003 = `A15` // Pending transfer
+	Table0003_A16 Table0003 = `A16` // Pending discharge
+	Table0003_A17 Table0003 = `A17` // Swap Patients
+	Table0003_A18 Table0003 = `A18` // Merge patient information
+	Table0003_A19 Table0003 = `A19` // Patient query
+	Table0003_A20 Table0003 = `A20` // Bed status updates
+	Table0003_A21 Table0003 = `A21` // Leave of Absence - Out (leaving)
+	Table0003_A22 Table0003 = `A22` // Leave of Absence - In (returning)
+	Table0003_A23 Table0003 = `A23` // Delete a Patient Record
+	Table0003_A24 Table0003 = `A24` // Link Patient Records
+	Table0003_O01 Table0003 = `O01` // Order message
+	Table0003_O02 Table0003 = `O02` // Order response
+	Table0003_P01 Table0003 = `P01` // Add and update patient account
+	Table0003_P02 Table0003 = `P02` // Purge Patient Accounts
+	Table0003_P03 Table0003 = `P03` // Post detail financial transaction
+	Table0003_P04 Table0003 = `P04` // Generate bills and A/R statements
+	Table0003_Q01 Table0003 = `Q01` // Immediate access
+	Table0003_Q02 Table0003 = `Q02` // Deferred Access
+	Table0003_R01 Table0003 = `R01` // Unsolicited transmission of requested Observ.
+	Table0003_R03 Table0003 = `R03` // Display oriented results, query/unsol. update
+)
+
+// TableID returns the table ID.
+func (Table0003) TableID() string { return `0003` }
+
+// Valid reports if the value is in the table.
+func (v Table0003) Valid() bool { return TableValueLookup[`0003`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0003) Description() string { return tableDescription(`0003`, string(v)) }
+
+// Table0004 is a value of table 0004, PATIENT CLASS.
+type Table0004 string
+
+const (
+	Table0004_E Table0004 = `E` // Emergency
+	Table0004_I Table0004 = `I` // Inpatient
+	Table0004_O Table0004 = `O` // Outpatient
+	Table0004_P Table0004 = `P` // Preadmit
+)
+
+// TableID returns the table ID.
+func (Table0004) TableID() string { return `0004` }
+
+// Valid reports if the value is in the table.
+func (v Table0004) Valid() bool { return TableValueLookup[`0004`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0004) Description() string { return tableDescription(`0004`, string(v)) }
+
+// Table0005 is a value of table 0005, ETHNIC GROUP.
+type Table0005 string
+
+const (
+	Table0005_B Table0005 = `B` // Black
+	Table0005_C Table0005 = `C` // Caucasian
+	Table0005_H Table0005 = `H` // Hispanic
+	Table0005_R Table0005 = `R` // Oriental
+)
+
+// TableID returns the table ID.
+func (Table0005) TableID() string { return `0005` }
+
+// Valid reports if the value is in the table.
+func (v Table0005) Valid() bool { return TableValueLookup[`0005`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0005) Description() string { return tableDescription(`0005`, string(v)) }
+
+// Table0006 is a value of table 0006, RELIGION.
+type Table0006 string
+
+const (
+	Table0006_A Table0006 = `A` // Atheist
+	Table0006_B Table0006 = `B` // Baptist
+	Table0006_C Table0006 = `C` // Catholic
+	Table0006_E Table0006 = `E` // Episcopalian
+	Table0006_J Table0006 = `J` // Judaism
+	Table0006_L Table0006 = `L` // Lutheran
+	Table0006_M Table0006 = `M` // Church of Latter Day Saints (Mormon)
+	Table0006_N Table0006 = `N` // Hindu
+	Table0006_P Table0006 = `P` // Protestant
+)
+
+// TableID returns the table ID.
+func (Table0006) TableID() string { return `0006` }
+
+// Valid reports if the value is in the table.
+func (v Table0006) Valid() bool { return TableValueLookup[`0006`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0006) Description() string { return tableDescription(`0006`, string(v)) }
+
+// Table0007 is a value of table 0007, ADMISSION TYPE.
+type Table0007 string
+
+const (
+	Table0007_A Table0007 = `A` // Accident
+	Table0007_E Table0007 = `E` // Emergency
+	Table0007_L Table0007 = `L` // Labor and Delivery
+	Table0007_R Table0007 = `R` // Routine
+)
+
+// TableID returns the table ID.
+func (Table0007) TableID() string { return `0007` }
+
+// Valid reports if the value is in the table.
+func (v Table0007) Valid() bool { return TableValueLookup[`0007`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0007) Description() string { return tableDescription(`0007`, string(v)) }
+
+// Table0008 is a value of table 0008, ACKNOWLEDGMENT CODE.
+type Table0008 string
+
+const (
+	Table0008_AA Table0008 = `AA` // Application Accept
+	Table0008_AE Table0008 = `AE` // Application Error
+	Table0008_AR Table0008 = `AR` // Application Reject
+)
+
+// TableID returns the table ID.
+func (Table0008) TableID() string { return `0008` }
+
+// Valid reports if the value is in the table.
+func (v Table0008) Valid() bool { return TableValueLookup[`0008`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0008) Description() string { return tableDescription(`0008`, string(v)) }
+
+// Table0009 is a value of table 0009, AMBULATORY STATUS.
+type Table0009 string
+
+const (
+	Table0009_A0 Table0009 = `A0` // No functional limitations
+	Table0009_A1 Table0009 = `A1` // Ambulates with assistive device
+	Table0009_A2 Table0009 = `A2` // Wheelchair/stretcher bound
+	Table0009_A3 Table0009 = `A3` // Comatose; non-responsive
+	Table0009_A4 Table0009 = `A4` // Disoriented
+	Table0009_A5 Table0009 = `A5` // Vision impaired
+	Table0009_A7 Table0009 = `A7` // Speech impaired
+	Table0009_A8 Table0009 = `A8` // Non-English Speaking
+	Table0009_A9 Table0009 = `A9` // Functional level unknown
+	Table0009_B1 Table0009 = `B1` // Oxygen Therapy
+	Table0009_B2 Table0009 = `B2` // Special Equipment (tunes, IV's, Catheters)
+	Table0009_B3 Table0009 = `B3` // Amputee
+	Table0009_B4 Table0009 = `B4` // Mastectomy
+	Table0009_B5 Table0009 = `B5` // Paraplegic
+	Table0009_B6 Table0009 = `B6` // Pregnant
+)
+
+// TableID returns the table ID.
+func (Table0009) TableID() string { return `0009` }
+
+// Valid reports if the value is in the table.
+func (v Table0009) Valid() bool { return TableValueLookup[`0009`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0009) Description() string { return tableDescription(`0009`, string(v)) }
+
+// Table0010 is a value of table 0010, PHYSICIAN ID.
+type Table0010 string
+
+// TableID returns the table ID.
+func (Table0010) TableID() string { return `0010` }
+
+// Valid reports if the value is in the table.
+func (v Table0010) Valid() bool { return TableValueLookup[`0010`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0010) Description() string { return tableDescription(`0010`, string(v)) }
+
+// Table0017 is a value of table 0017, TRANSACTION TYPE.
+type Table0017 string
+
+// TableID returns the table ID.
+func (Table0017) TableID() string { return `0017` }
+
+// Valid reports if the value is in the table.
+func (v Table0017) Valid() bool { return TableValueLookup[`0017`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0017) Description() string { return tableDescription(`0017`, string(v)) }
+
+// Table0018 is a value of table 0018, PATIENT TYPE.
+type Table0018 string
+
+// TableID returns the table ID.
+func (Table0018) TableID() string { return `0018` }
+
+// Valid reports if the value is in the table.
+func (v Table0018) Valid() bool { return TableValueLookup[`0018`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0018) Description() string { return tableDescription(`0018`, string(v)) }
+
+// Table0019 is a value of table 0019, ANESTHESIA CODE.
+type Table0019 string
+
+// TableID returns the table ID.
+func (Table0019) TableID() string { return `0019` }
+
+// Valid reports if the value is in the table.
+func (v Table0019) Valid() bool { return TableValueLookup[`0019`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0019) Description() string { return tableDescription(`0019`, string(v)) }
+
+// Table0021 is a value of table 0021, BAD DEBT AGENCY CODE.
+type Table0021 string
+
+// TableID returns the table ID.
+func (Table0021) TableID() string { return `0021` }
+
+// Valid reports if the value is in the table.
+func (v Table0021) Valid() bool { return TableValueLookup[`0021`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0021) Description() string { return tableDescription(`0021`, string(v)) }
+
+// Table0022 is a value of table 0022, BILLING STATUS.
+type Table0022 string
+
+// TableID returns the table ID.
+func (Table0022) TableID() string { return `0022` }
+
+// Valid reports if the value is in the table.
+func (v Table0022) Valid() bool { return TableValueLookup[`0022`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0022) Description() string { return tableDescription(`0022`, string(v)) }
+
+// Table0023 is a value of table 0023, ADMIT SOURCE.
+type Table0023 string
+
+// TableID returns the table ID.
+func (Table0023) TableID() string { return `0023` }
+
+// Valid reports if the value is in the table.
+func (v Table0023) Valid() bool { return TableValueLookup[`0023`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0023) Description() string { return tableDescription(`0023`, string(v)) }
+
+// Table0024 is a value of table 0024, FEE SCHEDULE.
+type Table0024 string
+
+// TableID returns the table ID.
+func (Table0024) TableID() string { return `0024` }
+
+// Valid reports if the value is in the table.
+func (v Table0024) Valid() bool { return TableValueLookup[`0024`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0024) Description() string { return tableDescription(`0024`, string(v)) }
+
+// Table0032 is a value of table 0032, CHARGE/PRICE INDICATOR.
+type Table0032 string
+
+// TableID returns the table ID.
+func (Table0032) TableID() string { return `0032` }
+
+// Valid reports if the value is in the table.
+func (v Table0032) Valid() bool { return TableValueLookup[`0032`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0032) Description() string { return tableDescription(`0032`, string(v)) }
+
+// Table0036 is a value of table 0036, UNITS OF MEASURE - ISO528,1977.
+type Table0036 string
+
+const (
+	Table0036_BT  Table0036 = `BT`  // Bottle
+	Table0036_EA  Table0036 = `EA`  // Each
+	Table0036_GM  Table0036 = `GM`  // Grams
+	Table0036_KG  Table0036 = `KG`  // Kilograms
+	Table0036_MEQ Table0036 = `MEQ` // Milliequivalent
+	Table0036_MG  Table0036 = `MG`  // Milligrams
+	Table0036_OZ  Table0036 = `OZ`  // Ounces
+	Table0036_SC  Table0036 = `SC`  // Square centimeters
+	Table0036_TB  Table0036 = `TB`  // Tablet
+	Table0036_VL  Table0036 = `VL`  // Vial
+)
+
+// TableID returns the table ID.
+func (Table0036) TableID() string { return `0036` }
+
+// Valid reports if the value is in the table.
+func (v Table0036) Valid() bool { return TableValueLookup[`0036`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0036) Description() string { return tableDescription(`0036`, string(v)) }
+
+// Table0038 is a value of table 0038, ORDER STATUS.
+type Table0038 string
+
+const (
+	Table0038_CA Table0038 = `CA` // Order was canceled
+	Table0038_CM Table0038 = `CM` // Order is completed
+	Table0038_DC Table0038 = `DC` // Order was discontinued
+	Table0038_ER Table0038 = `ER` // Error, order not found
+	Table0038_HD Table0038 = `HD` // Order is on hold
+	Table0038_IP Table0038 = `IP` // In process, unspecified
+	Table0038_SC Table0038 = `SC` // In process, scheduled
+)
+
+// TableID returns the table ID.
+func (Table0038) TableID() string { return `0038` }
+
+// Valid reports if the value is in the table.
+func (v Table0038) Valid() bool { return TableValueLookup[`0038`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0038) Description() string { return tableDescription(`0038`, string(v)) }
+
+// Table0042 is a value of table 0042, INS. COMPANY PLAN CODE.
+type Table0042 string
+
+// TableID returns the table ID.
+func (Table0042) TableID() string { return `0042` }
+
+// Valid reports if the value is in the table.
+func (v Table0042) Valid() bool { return TableValueLookup[`0042`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0042) Description() string { return tableDescription(`0042`, string(v)) }
+
+// Table0043 is a value of table 0043, CONDITION.
+type Table0043 string
+
+// TableID returns the table ID.
+func (Table0043) TableID() string { return `0043` }
+
+// Valid reports if the value is in the table.
+func (v Table0043) Valid() bool { return TableValueLookup[`0043`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0043) Description() string { return tableDescription(`0043`, string(v)) }
+
+// Table0044 is a value of table 0044, CONTRACT CODE.
+type Table0044 string
+
+// TableID returns the table ID.
+func (Table0044) TableID() string { return `0044` }
+
+// Valid reports if the value is in the table.
+func (v Table0044) Valid() bool { return TableValueLookup[`0044`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0044) Description() string { return tableDescription(`0044`, string(v)) }
+
+// Table0045 is a value of table 0045, COURTESY CODE.
+type Table0045 string
+
+// TableID returns the table ID.
+func (Table0045) TableID() string { return `0045` }
+
+// Valid reports if the value is in the table.
+func (v Table0045) Valid() bool { return TableValueLookup[`0045`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0045) Description() string { return tableDescription(`0045`, string(v)) }
+
+// Table0046 is a value of table 0046, CREDIT RATING.
+type Table0046 string
+
+// TableID returns the table ID.
+func (Table0046) TableID() string { return `0046` }
+
+// Valid reports if the value is in the table.
+func (v Table0046) Valid() bool { return TableValueLookup[`0046`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0046) Description() string { return tableDescription(`0046`, string(v)) }
+
+// Table0047 is a value of table 0047, DANGER CODE.
+type Table0047 string
+
+// TableID returns the table ID.
+func (Table0047) TableID() string { return `0047` }
+
+// Valid reports if the value is in the table.
+func (v Table0047) Valid() bool { return TableValueLookup[`0047`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0047) Description() string { return tableDescription(`0047`, string(v)) }
+
+// Table0048 is a value of table 0048, WHAT SUBJECT FILTER.
+type Table0048 string
+
+const (
+	Table0048_ADV Table0048 = `ADV` // Advice/Diagnosis
+	Table0048_ANU Table0048 = `ANU` // Nursing Unit Look up
+	Table0048_APN Table0048 = `APN` // Patient name look up
+	Table0048_CAN Table0048 = `CAN` // Cancel. Used to cancel a query
+	Table0048_DEM Table0048 = `DEM` // Demographics
+	Table0048_MRI Table0048 = `MRI` // Most recent inpatient
+	Table0048_MRO Table0048 = `MRO` // Most recent outpatient
+	Table0048_OTH Table0048 = `OTH` // Other
+	Table0048_PRO Table0048 = `PRO` // Procedure
+	Table0048_RES Table0048 = `RES` // Result
+	Table0048_STA Table0048 = `STA` // Status
+)
+
+// TableID returns the table ID.
+func (Table0048) TableID() string { return `0048` }
+
+// Valid reports if the value is in the table.
+func (v Table0048) Valid() bool { return TableValueLookup[`0048`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0048) Description() string { return tableDescription(`0048`, string(v)) }
+
+// Table0049 is a value of table 0049, DEPARTMENT CODE.
+type Table0049 string
+
+// TableID returns the table ID.
+func (Table0049) TableID() string { return `0049` }
+
+// Valid reports if the value is in the table.
+func (v Table0049) Valid() bool { return TableValueLookup[`0049`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0049) Description() string { return tableDescription(`0049`, string(v)) }
+
+// Table0050 is a value of table 0050, ACCIDENT CODE.
+type Table0050 string
+
+// TableID returns the table ID.
+func (Table0050) TableID() string { return `0050` }
+
+// Valid reports if the value is in the table.
+func (v Table0050) Valid() bool { return TableValueLookup[`0050`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0050) Description() string { return tableDescription(`0050`, string(v)) }
+
+// Table0051 is a value of table 0051, DIAGNOSIS CODE.
+type Table0051 string
+
+// TableID returns the table ID.
+func (Table0051) TableID() string { return `0051` }
+
+// Valid reports if the value is in the table.
+func (v Table0051) Valid() bool { return TableValueLookup[`0051`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0051) Description() string { return tableDescription(`0051`, string(v)) }
+
+// Table0052 is a value of table 0052, DIAGNOSIS TYPE.
+type Table0052 string
+
+// TableID returns the table ID.
+func (Table0052) TableID() string { return `0052` }
+
+// Valid reports if the value is in the table.
+func (v Table0052) Valid() bool { return TableValueLookup[`0052`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0052) Description() string { return tableDescription(`0052`, string(v)) }
+
+// Table0053 is a value of table 0053, DIAGNOSIS CODING METHOD.
+type Table0053 string
+
+const (
+	Table0053_I9 Table0053 = `I9` // ICD9
+)
+
+// TableID returns the table ID.
+func (Table0053) TableID() string { return `0053` }
+
+// Valid reports if the value is in the table.
+func (v Table0053) Valid() bool { return TableValueLookup[`0053`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0053) Description() string { return tableDescription(`0053`, string(v)) }
+
+// Table0055 is a value of table 0055, DRG CODE.
+type Table0055 string
+
+// TableID returns the table ID.
+func (Table0055) TableID() string { return `0055` }
+
+// Valid reports if the value is in the table.
+func (v Table0055) Valid() bool { return TableValueLookup[`0055`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0055) Description() string { return tableDescription(`0055`, string(v)) }
+
+// Table0056 is a value of table 0056, DRG GROUPER REVIEW CODE.
+type Table0056 string
+
+// TableID returns the table ID.
+func (Table0056) TableID() string { return `0056` }
+
+// Valid reports if the value is in the table.
+func (v Table0056) Valid() bool { return TableValueLookup[`0056`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0056) Description() string { return tableDescription(`0056`, string(v)) }
+
+// Table0059 is a value of table 0059, CONSENT CODE.
+type Table0059 string
+
+// TableID returns the table ID.
+func (Table0059) TableID() string { return `0059` }
+
+// Valid reports if the value is in the table.
+func (v Table0059) Valid() bool { return TableValueLookup[`0059`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0059) Description() string { return tableDescription(`0059`, string(v)) }
+
+// Table0062 is a value of table 0062, EVENT REASON.
+type Table0062 string
+
+const (
+	Table0062_01 Table0062 = `01` // Patient Request
+	Table0062_02 Table0062 = `02` // Physician Order
+)
+
+// TableID returns the table ID.
+func (Table0062) TableID() string { return `0062` }
+
+// Valid reports if the value is in the table.
+func (v Table0062) Valid() bool { return TableValueLookup[`0062`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0062) Description() string { return tableDescription(`0062`, string(v)) }
+
+// Table0063 is a value of table 0063, RELATIONSHIP.
+type Table0063 string
+
+// TableID returns the table ID.
+func (Table0063) TableID() string { return `0063` }
+
+// Valid reports if the value is in the table.
+func (v Table0063) Valid() bool { return TableValueLookup[`0063`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0063) Description() string { return tableDescription(`0063`, string(v)) }
+
+// Table0064 is a value of table 0064, FINANCIAL CLASS.
+type Table0064 string
+
+// TableID returns the table ID.
+func (Table0064) TableID() string { return `0064` }
+
+// Valid reports if the value is in the table.
+func (v Table0064) Valid() bool { return TableValueLookup[`0064`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0064) Description() string { return tableDescription(`0064`, string(v)) }
+
+// Table0065 is a value of table 0065, ACTION CODE.
+type Table0065 string
+
+const (
+	Table0065_A Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065_C Table0065 = `C` // Cancel order for battery or tests named
+	Table0065_G Table0065 = `G` // Generated order
+	Table0065_L Table0065 = `L` // Lab to obtain specimen from patient.
+	Table0065_O Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065_P Table0065 = `P` // Pending specimen-Order sent prior to delivery
+	Table0065_S Table0065 = `S` // Schedule the tests specified below
+)
+
+// TableID returns the table ID.
+func (Table0065) TableID() string { return `0065` }
+
+// Valid reports if the value is in the table.
+func (v Table0065) Valid() bool { return TableValueLookup[`0065`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0065) Description() string { return tableDescription(`0065`, string(v)) }
+
+// Table0066 is a value of table 0066, EMPLOYMENT STATUS.
+type Table0066 string
+
+// TableID returns the table ID.
+func (Table0066) TableID() string { return `0066` }
+
+// Valid reports if the value is in the table.
+func (v Table0066) Valid() bool { return TableValueLookup[`0066`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0066) Description() string { return tableDescription(`0066`, string(v)) }
+
+// Table0068 is a value of table 0068, GUARANTOR TYPE.
+type Table0068 string
+
+// TableID returns the table ID.
+func (Table0068) TableID() string { return `0068` }
+
+// Valid reports if the value is in the table.
+func (v Table0068) Valid() bool { return TableValueLookup[`0068`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0068) Description() string { return tableDescription(`0068`, string(v)) }
+
+// Table0069 is a value of table 0069, HOSPITAL SERVICE.
+type Table0069 string
+
+// TableID returns the table ID.
+func (Table0069) TableID() string { return `0069` }
+
+// Valid reports if the value is in the table.
+func (v Table0069) Valid() bool { return TableValueLookup[`0069`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0069) Description() string { return tableDescription(`0069`, string(v)) }
+
+// Table0070 is a value of table 0070, SOURCE OF SPECIMEN.
+type Table0070 string
+
+const (
+	Table0070_BLD  Table0070 = `BLD`  // Blood
+	Table0070_BON  Table0070 = `BON`  // Bone
+	Table0070_BRN  Table0070 = `BRN`  // Burn
+	Table0070_CNJT Table0070 = `CNJT` // Conjunctiva
+	Table0070_CSF  Table0070 = `CSF`  // Cerebral spinal fluid
+	Table0070_CVX  Table0070 = `CVX`  // Cervix
+	Table0070_EAR  Table0070 = `EAR`  // Ear
+	Table0070_FIB  Table0070 = `FIB`  // Fibroblood
+	Table0070_HAR  Table0070 = `HAR`  // Hair
+	Table0070_MN   Table0070 = `MN`   // Amniotic Fluid
+	Table0070_NOS  Table0070 = `NOS`  // Nose
+	Table0070_OTH  Table0070 = `OTH`  // Other
+	Table0070_PLAS Table0070 = `PLAS` // Plasma
+	Table0070_PRT  Table0070 = `PRT`  // Peritoneal Fluid
+	Table0070_RBC  Table0070 = `RBC`  // Erythrocytes
+	Table0070_SAL  Table0070 = `SAL`  // Saliva
+	Table0070_SEM  Table0070 = `SEM`  // Seminal Fluid
+	Table0070_SER  Table0070 = `SER`  // Serum
+	Table0070_SKN  Table0070 = `SKN`  // Skin
+	Table0070_SNV  Table0070 = `SNV`  // Synovial Fluid
+	Table0070_STL  Table0070 = `STL`  // Stool
+	Table0070_SWT  Table0070 = `SWT`  // Sweat
+	Table0070_THRT Table0070 = `THRT` // Throat
+	Table0070_TIS  Table0070 = `TIS`  // Tissue
+	Table0070_UMB  Table0070 = `UMB`  // Umbilical Blood
+	Table0070_UR   Table0070 = `UR`   // Urine
+	Table0070_URTH Table0070 = `URTH` // Urethra
+	Table0070_WBC  Table0070 = `WBC`  // Leukocytes
+	Table0070_WND  Table0070 = `WND`  // Wound
+)
+
+// TableID returns the table ID.
+func (Table0070) TableID() string { return `0070` }
+
+// Valid reports if the value is in the table.
+func (v Table0070) Valid() bool { return TableValueLookup[`0070`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0070) Description() string { return tableDescription(`0070`, string(v)) }
+
+// Table0072 is a value of table 0072, INS. PLAN ID.
+type Table0072 string
+
+// TableID returns the table ID.
+func (Table0072) TableID() string { return `0072` }
+
+// Valid reports if the value is in the table.
+func (v Table0072) Valid() bool { return TableValueLookup[`0072`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0072) Description() string { return tableDescription(`0072`, string(v)) }
+
+// Table0073 is a value of table 0073, INTEREST RATE CODE.
+type Table0073 string
+
+// TableID returns the table ID.
+func (Table0073) TableID() string { return `0073` }
+
+// Valid reports if the value is in the table.
+func (v Table0073) Valid() bool { return TableValueLookup[`0073`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0073) Description() string { return tableDescription(`0073`, string(v)) }
+
+// Table0074 is a value of table 0074, DIAGNOSTIC SERVICE SECTION ID.
+type Table0074 string
+
+const (
+	Table0074_BG  Table0074 = `BG`  // Blood gases
+	Table0074_CH  Table0074 = `CH`  // Chemistry
+	Table0074_CP  Table0074 = `CP`  // Cytopathology
+	Table0074_CT  Table0074 = `CT`  // CAT scan
+	Table0074_CUS Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074_EC  Table0074 = `EC`  // Electrocardiac (e.g., EKG, EEC, Holter)
+	Table0074_HM  Table0074 = `HM`  // Hematology
+	Table0074_IMM Table0074 = `IMM` // Immunology
+	Table0074_MB  Table0074 = `MB`  // Microbiology
+	Table0074_MCB Table0074 = `MCB` // Mycobacteriology
+	Table0074_MYC Table0074 = `MYC` // Mycology
+	Table0074_NMR Table0074 = `NMR` // Nuclear magnetic resonance
+	Table0074_NMS Table0074 = `NMS` // Nuclear medicine scan
+	Table0074_NRS Table0074 = `NRS` // Nursing service measures
+	Table0074_OT  Table0074 = `OT`  // Occupational Therapy
+	Table0074_OTH Table0074 = `OTH` // Other
+	Table0074_OUS Table0074 = `OUS` // OB Ultrasound
+	Table0074_PHR Table0074 = `PHR` // Pharmacy
+	Table0074_PT  Table0074 = `PT`  // Physical Therapy
+	Table0074_RC  Table0074 = `RC`  // Respiratory Care
+	Table0074_RT  Table0074 = `RT`  // Radiation Therapy
+	Table0074_RUS Table0074 = `RUS` // Radiology ultrasound
+	Table0074_SP  Table0074 = `SP`  // Surgical Pathology
+	Table0074_SR  Table0074 = `SR`  // Serology
+	Table0074_TX  Table0074 = `TX`  // Toxicology
+	Table0074_VUS Table0074 = `VUS` // Vascular Ultrasound
+	Table0074_XRC Table0074 = `XRC` // Cineradiography
+)
+
+// TableID returns the table ID.
+func (Table0074) TableID() string { return `0074` }
+
+// Valid reports if the value is in the table.
+func (v Table0074) Valid() bool { return TableValueLookup[`0074`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0074) Description() string { return tableDescription(`0074`, string(v)) }
+
+// Table0076 is a value of table 0076, MESSAGE TYPE.
+type Table0076 string
+
+const (
+	Table0076_ACK Table0076 = `ACK` // General Acknowledgment CNT II
+	Table0076_ARD Table0076 = `ARD` // Ancillary RPT (display) ANR VII
+	Table0076_BAR Table0076 = `BAR` // Add/change billing account BLN VI
+	Table0076_DSR Table0076 = `DSR` // Display response QRY V
+	Table0076_MCF Table0076 = `MCF` // Delayed acknowledgment CNT II
+	Table0076_ORF Table0076 = `ORF` // Observ. Result/record resp. ANR VII
+	Table0076_ORM Table0076 = `ORM` // Order ORD IV
+	Table0076_ORR Table0076 = `ORR` // Order response message ORD IV
+	Table0076_ORU Table0076 = `ORU` // Observ. result/unsolicited ANR VII
+	Table0076_OSQ Table0076 = `OSQ` // Order status query ORD IV
+	Table0076_UDM Table0076 = `UDM` // Unsolicited display QRY V
+)
+
+// TableID returns the table ID.
+func (Table0076) TableID() string { return `0076` }
+
+// Valid reports if the value is in the table.
+func (v Table0076) Valid() bool { return TableValueLookup[`0076`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0076) Description() string { return tableDescription(`0076`, string(v)) }
+
+// Table0078 is a value of table 0078, ABNORMAL FLAGS.
+type Table0078 string
+
+const (
+	Table0078_A  Table0078 = `A`  // Abnormal (applies to non-numeric results)
+	Table0078_AA Table0078 = `AA` // Very abnormal
+	Table0078_D  Table0078 = `D`  // Significant change down
+	Table0078_H  Table0078 = `H`  // Above high normal
+	Table0078_HH Table0078 = `HH` // Above upper panic limits
+	Table0078_I  Table0078 = `I`  // Interval
+	Table0078_LL Table0078 = `LL` // Below lower panic limits
+	Table0078_MS Table0078 = `MS` // Moderately sensitive
+	Table0078_R  Table0078 = `R`  // Resists
+	Table0078_S  Table0078 = `S`  // Sensitive
+	Table0078_U  Table0078 = `U`  // Significant change up
+	Table0078_VS Table0078 = `VS` // Very sensitive
+)
+
+// TableID returns the table ID.
+func (Table0078) TableID() string { return `0078` }
+
+// Valid reports if the value is in the table.
+func (v Table0078) Valid() bool { return TableValueLookup[`0078`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0078) Description() string { return tableDescription(`0078`, string(v)) }
+
+// Table0079 is a value of table 0079, LOCATION.
+type Table0079 string
+
+// TableID returns the table ID.
+func (Table0079) TableID() string { return `0079` }
+
+// Valid reports if the value is in the table.
+func (v Table0079) Valid() bool { return TableValueLookup[`0079`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0079) Description() string { return tableDescription(`0079`, string(v)) }
+
+// Table0080 is a value of table 0080, NATURE OF ABNORMAL TESTING.
+type Table0080 string
+
+const (
+	Table0080_A Table0080 = `A` // An aged based population
+	Table0080_N Table0080 = `N` // None - generic normal range
+	Table0080_R Table0080 = `R` // A race based population
+	Table0080_S Table0080 = `S` // A sexed based population
+)
+
+// TableID returns the table ID.
+func (Table0080) TableID() string { return `0080` }
+
+// Valid reports if the value is in the table.
+func (v Table0080) Valid() bool { return TableValueLookup[`0080`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0080) Description() string { return tableDescription(`0080`, string(v)) }
+
+// Table0081 is a value of table 0081, NOTICE OF ADMISSION.
+type Table0081 string
+
+// TableID returns the table ID.
+func (Table0081) TableID() string { return `0081` }
+
+// Valid reports if the value is in the table.
+func (v Table0081) Valid() bool { return TableValueLookup[`0081`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0081) Description() string { return tableDescription(`0081`, string(v)) }
+
+// Table0083 is a value of table 0083, OUTLIER TYPE.
+type Table0083 string
+
+// TableID returns the table ID.
+func (Table0083) TableID() string { return `0083` }
+
+// Valid reports if the value is in the table.
+func (v Table0083) Valid() bool { return TableValueLookup[`0083`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0083) Description() string { return tableDescription(`0083`, string(v)) }
+
+// Table0084 is a value of table 0084, PERFORMED BY CODE.
+type Table0084 string
+
+// TableID returns the table ID.
+func (Table0084) TableID() string { return `0084` }
+
+// Valid reports if the value is in the table.
+func (v Table0084) Valid() bool { return TableValueLookup[`0084`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0084) Description() string { return tableDescription(`0084`, string(v)) }
+
+// Table0085 is a value of table 0085, OBSERVATION RESULT STATUS.
+type Table0085 string
+
+const (
+	Table0085_D Table0085 = `D` // Delete previously transmitted observation
+	Table0085_F Table0085 = `F` // Complete/final results (entered and verified)
+	Table0085_I Table0085 = `I` // Specimen in lab--results pending
+	Table0085_R Table0085 = `R` // Results entered - not verified
+	Table0085_S Table0085 = `S` // Partial results
+)
+
+// TableID returns the table ID.
+func (Table0085) TableID() string { return `0085` }
+
+// Valid reports if the value is in the table.
+func (v Table0085) Valid() bool { return TableValueLookup[`0085`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0085) Description() string { return tableDescription(`0085`, string(v)) }
+
+// Table0086 is a value of table 0086, INS. PLAN TYPE.
+type Table0086 string
+
+// TableID returns the table ID.
+func (Table0086) TableID() string { return `0086` }
+
+// Valid reports if the value is in the table.
+func (v Table0086) Valid() bool { return TableValueLookup[`0086`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0086) Description() string { return tableDescription(`0086`, string(v)) }
+
+// Table0087 is a value of table 0087, PRE-ADMIT TESTING.
+type Table0087 string
+
+// TableID returns the table ID.
+func (Table0087) TableID() string { return `0087` }
+
+// Valid reports if the value is in the table.
+func (v Table0087) Valid() bool { return TableValueLookup[`0087`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0087) Description() string { return tableDescription(`0087`, string(v)) }
+
+// Table0088 is a value of table 0088, PROCEDURE CODE.
+type Table0088 string
+
+// TableID returns the table ID.
+func (Table0088) TableID() string { return `0088` }
+
+// Valid reports if the value is in the table.
+func (v Table0088) Valid() bool { return TableValueLookup[`0088`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0088) Description() string { return tableDescription(`0088`, string(v)) }
+
+// Table0089 is a value of table 0089, PROCEDURE CODING METHOD.
+type Table0089 string
+
+// TableID returns the table ID.
+func (Table0089) TableID() string { return `0089` }
+
+// Valid reports if the value is in the table.
+func (v Table0089) Valid() bool { return TableValueLookup[`0089`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0089) Description() string { return tableDescription(`0089`, string(v)) }
+
+// Table0090 is a value of table 0090, PROCEDURE TYPE.
+type Table0090 string
+
+// TableID returns the table ID.
+func (Table0090) TableID() string { return `0090` }
+
+// Valid reports if the value is in the table.
+func (v Table0090) Valid() bool { return TableValueLookup[`0090`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0090) Description() string { return tableDescription(`0090`, string(v)) }
+
+// Table0091 is a value of table 0091, QUERY PRIORITY.
+type Table0091 string
+
+const (
+	Table0091_D Table0091 = `D` // Deferred
+	Table0091_I Table0091 = `I` // Immediate
+)
+
+// TableID returns the table ID.
+func (Table0091) TableID() string { return `0091` }
+
+// Valid reports if the value is in the table.
+func (v Table0091) Valid() bool { return TableValueLookup[`0091`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0091) Description() string { return tableDescription(`0091`, string(v)) }
+
+// Table0092 is a value of table 0092, RE-ADMISSION INDICATOR.
+type Table0092 string
+
+// TableID returns the table ID.
+func (Table0092) TableID() string { return `0092` }
+
+// Valid reports if the value is in the table.
+func (v Table0092) Valid() bool { return TableValueLookup[`0092`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0092) Description() string { return tableDescription(`0092`, string(v)) }
+
+// Table0093 is a value of table 0093, RELEASE OF INFORMATION.
+type Table0093 string
+
+// TableID returns the table ID.
+func (Table0093) TableID() string { return `0093` }
+
+// Valid reports if the value is in the table.
+func (v Table0093) Valid() bool { return TableValueLookup[`0093`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0093) Description() string { return tableDescription(`0093`, string(v)) }
+
+// Table0094 is a value of table 0094, REPORT OF ELIGIBILITY.
+type Table0094 string
+
+// TableID returns the table ID.
+func (Table0094) TableID() string { return `0094` }
+
+// Valid reports if the value is in the table.
+func (v Table0094) Valid() bool { return TableValueLookup[`0094`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0094) Description() string { return tableDescription(`0094`, string(v)) }
+
+// Table0096 is a value of table 0096, FINANCIAL TRANSACTION CODE.
+type Table0096 string
+
+// TableID returns the table ID.
+func (Table0096) TableID() string { return `0096` }
+
+// Valid reports if the value is in the table.
+func (v Table0096) Valid() bool { return TableValueLookup[`0096`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0096) Description() string { return tableDescription(`0096`, string(v)) }
+
+// Table0098 is a value of table 0098, TYPE OF AGREEMENT CODE.
+type Table0098 string
+
+// TableID returns the table ID.
+func (Table0098) TableID() string { return `0098` }
+
+// Valid reports if the value is in the table.
+func (v Table0098) Valid() bool { return TableValueLookup[`0098`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0098) Description() string { return tableDescription(`0098`, string(v)) }
+
+// Table0099 is a value of table 0099, VIP INDICATOR.
+type Table0099 string
+
+// TableID returns the table ID.
+func (Table0099) TableID() string { return `0099` }
+
+// Valid reports if the value is in the table.
+func (v Table0099) Valid() bool { return TableValueLookup[`0099`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0099) Description() string { return tableDescription(`0099`, string(v)) }
+
+// Table0100 is a value of table 0100, WHEN TO CHARGE.
+type Table0100 string
+
+const (
+	Table0100_D Table0100 = `D` // On discharge
+	Table0100_O Table0100 = `O` // On receipt of order
+	Table0100_R Table0100 = `R` // At time service is completed
+	Table0100_S Table0100 = `S` // At time service is started
+)
+
+// TableID returns the table ID.
+func (Table0100) TableID() string { return `0100` }
+
+// Valid reports if the value is in the table.
+func (v Table0100) Valid() bool { return TableValueLookup[`0100`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0100) Description() string { return tableDescription(`0100`, string(v)) }
+
+// Table0102 is a value of table 0102, DELAYED ACKNOWLEDGMENT TYPE.
+type Table0102 string
+
+const (
+	Table0102_D Table0102 = `D` // Message Received, stored for later processing
+)
+
+// TableID returns the table ID.
+func (Table0102) TableID() string { return `0102` }
+
+// Valid reports if the value is in the table.
+func (v Table0102) Valid() bool { return TableValueLookup[`0102`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0102) Description() string { return tableDescription(`0102`, string(v)) }
+
+// Table0103 is a value of table 0103, PROCESSING ID.
+type Table0103 string
+
+const (
+	Table0103_D Table0103 = `D` // Debugging
+	Table0103_P Table0103 = `P` // Production
+	Table0103_T Table0103 = `T` // Training
+)
+
+// TableID returns the table ID.
+func (Table0103) TableID() string { return `0103` }
+
+// Valid reports if the value is in the table.
+func (v Table0103) Valid() bool { return TableValueLookup[`0103`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0103) Description() string { return tableDescription(`0103`, string(v)) }
+
+// Table0104 is a value of table 0104, VERSION CONTROL TABLE.
+type Table0104 string
+
+const (
+	Table0104_2_0  Table0104 = `2.0`  // Release 2.0 September 1988
+	Table0104_2_0D Table0104 = `2.0D` // Demo 2.0 October 1988
+	Table0104_2_1  Table0104 = `2.1`  // Release 2.1 March 1990
+)
+
+// TableID returns the table ID.
+func (Table0104) TableID() string { return `0104` }
+
+// Valid reports if the value is in the table.
+func (v Table0104) Valid() bool { return TableValueLookup[`0104`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0104) Description() string { return tableDescription(`0104`, string(v)) }
+
+// Table0105 is a value of table 0105, SOURCE OF COMMENT.
+type Table0105 string
+
+const (
+	Table0105_L Table0105 = `L` // Ancillary department is source of comment
+	Table0105_P Table0105 = `P` // Orderer is source of comment
+)
+
+// TableID returns the table ID.
+func (Table0105) TableID() string { return `0105` }
+
+// Valid reports if the value is in the table.
+func (v Table0105) Valid() bool { return TableValueLookup[`0105`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0105) Description() string { return tableDescription(`0105`, string(v)) }
+
+// Table0106 is a value of table 0106, QUERY FORMAT CODE.
+type Table0106 string
+
+const (
+	Table0106_R Table0106 = `R` // Response in Record-oriented format
+)
+
+// TableID returns the table ID.
+func (Table0106) TableID() string { return `0106` }
+
+// Valid reports if the value is in the table.
+func (v Table0106) Valid() bool { return TableValueLookup[`0106`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0106) Description() string { return tableDescription(`0106`, string(v)) }
+
+// Table0107 is a value of table 0107, DEFERRED RESPONSE TYPE.
+type Table0107 string
+
+const (
+	Table0107_L Table0107 = `L` // Later than the DATE/TIME specified
+)
+
+// TableID returns the table ID.
+func (Table0107) TableID() string { return `0107` }
+
+// Valid reports if the value is in the table.
+func (v Table0107) Valid() bool { return TableValueLookup[`0107`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0107) Description() string { return tableDescription(`0107`, string(v)) }
+
+// Table0108 is a value of table 0108, QUERY RESULTS LEVEL.
+type Table0108 string
+
+const (
+	Table0108_O Table0108 = `O` // Order plus order status
+	Table0108_S Table0108 = `S` // Status only
+	Table0108_T Table0108 = `T` // Full Results
+)
+
+// TableID returns the table ID.
+func (Table0108) TableID() string { return `0108` }
+
+// Valid reports if the value is in the table.
+func (v Table0108) Valid() bool { return TableValueLookup[`0108`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0108) Description() string { return tableDescription(`0108`, string(v)) }
+
+// Table0109 is a value of table 0109, REPORT PRIORITY.
+type Table0109 string
+
+const (
+	Table0109_R Table0109 = `R` // Routine
+	Table0109_S Table0109 = `S` // Stat
+)
+
+// TableID returns the table ID.
+func (Table0109) TableID() string { return `0109` }
+
+// Valid reports if the value is in the table.
+func (v Table0109) Valid() bool { return TableValueLookup[`0109`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0109) Description() string { return tableDescription(`0109`, string(v)) }
+
+// Table0110 is a value of table 0110, TRANSFER TO BAD DEBT CODE.
+type Table0110 string
+
+// TableID returns the table ID.
+func (Table0110) TableID() string { return `0110` }
+
+// Valid reports if the value is in the table.
+func (v Table0110) Valid() bool { return TableValueLookup[`0110`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0110) Description() string { return tableDescription(`0110`, string(v)) }
+
+// Table0111 is a value of table 0111, DELETE ACCOUNT CODE.
+type Table0111 string
+
+// TableID returns the table ID.
+func (Table0111) TableID() string { return `0111` }
+
+// Valid reports if the value is in the table.
+func (v Table0111) Valid() bool { return TableValueLookup[`0111`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0111) Description() string { return tableDescription(`0111`, string(v)) }
+
+// Table0112 is a value of table 0112, DISCHARGED DISPOSITION.
+type Table0112 string
+
+// TableID returns the table ID.
+func (Table0112) TableID() string { return `0112` }
+
+// Valid reports if the value is in the table.
+func (v Table0112) Valid() bool { return TableValueLookup[`0112`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0112) Description() string { return tableDescription(`0112`, string(v)) }
+
+// Table0113 is a value of table 0113, DISCHARGED TO LOCATION.
+type Table0113 string
+
+// TableID returns the table ID.
+func (Table0113) TableID() string { return `0113` }
+
+// Valid reports if the value is in the table.
+func (v Table0113) Valid() bool { return TableValueLookup[`0113`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0113) Description() string { return tableDescription(`0113`, string(v)) }
+
+// Table0114 is a value of table 0114, DIET TYPE.
+type Table0114 string
+
+// TableID returns the table ID.
+func (Table0114) TableID() string { return `0114` }
+
+// Valid reports if the value is in the table.
+func (v Table0114) Valid() bool { return TableValueLookup[`0114`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0114) Description() string { return tableDescription(`0114`, string(v)) }
+
+// Table0115 is a value of table 0115, SERVICING FACILITY.
+type Table0115 string
+
+// TableID returns the table ID.
+func (Table0115) TableID() string { return `0115` }
+
+// Valid reports if the value is in the table.
+func (v Table0115) Valid() bool { return TableValueLookup[`0115`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0115) Description() string { return tableDescription(`0115`, string(v)) }
+
+// Table0116 is a value of table 0116, BED STATUS.
+type Table0116 string
+
+const (
+	Table0116_C Table0116 = `C` // Closed
+	Table0116_H Table0116 = `H` // Housekeeping
+	Table0116_O Table0116 = `O` // Occupied
+)
+
+// TableID returns the table ID.
+func (Table0116) TableID() string { return `0116` }
+
+// Valid reports if the value is in the table.
+func (v Table0116) Valid() bool { return TableValueLookup[`0116`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0116) Description() string { return tableDescription(`0116`, string(v)) }
+
+// Table0117 is a value of table 0117, ACCOUNT STATUS.
+type Table0117 string
+
+// TableID returns the table ID.
+func (Table0117) TableID() string { return `0117` }
+
+// Valid reports if the value is in the table.
+func (v Table0117) Valid() bool { return TableValueLookup[`0117`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0117) Description() string { return tableDescription(`0117`, string(v)) }
+
+// Table0118 is a value of table 0118, MAJOR DIAGNOSTIC CATEGORY.
+type Table0118 string
+
+// TableID returns the table ID.
+func (Table0118) TableID() string { return `0118` }
+
+// Valid reports if the value is in the table.
+func (v Table0118) Valid() bool { return TableValueLookup[`0118`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0118) Description() string { return tableDescription(`0118`, string(v)) }
+
+// Table0119 is a value of table 0119, ORDER CONTROL.
+type Table0119 string
+
+const (
+	Table0119_CA Table0119 = `CA` // Cancel order request
+	Table0119_CH Table0119 = `CH` // Child order
+	Table0119_CN Table0119 = `CN` // Combined result
+	Table0119_DC Table0119 = `DC` // Discontinue order request
+	Table0119_DE Table0119 = `DE` // Data Errors
+	Table0119_DR Table0119 = `DR` // Discontinued as requested
+	Table0119_HD Table0119 = `HD` // Hold order request
+	Table0119_HR Table0119 = `HR` // On hold as requested
+	Table0119_NA Table0119 = `NA` // Number assigned T
+	Table0119_NW Table0119 = `NW` // New order T
+	Table0119_OD Table0119 = `OD` // Order discontinued
+	Table0119_OK Table0119 = `OK` // Order accepted and OK
+	Table0119_OR Table0119 = `OR` // Released as requested
+	Table0119_PA Table0119 = `PA` // Parent order
+	Table0119_RE Table0119 = `RE` // Observations to follow
+	Table0119_RO Table0119 = `RO` // Replacement order
+	Table0119_RP Table0119 = `RP` // Order replace request
+	Table0119_RR Table0119 = `RR` // Request received
+	Table0119_RU Table0119 = `RU` // Replaced unsolicited
+	Table0119_SN Table0119 = `SN` // Send filler number F I
+	Table0119_SS Table0119 = `SS` // Send order status request
+	Table0119_UD Table0119 = `UD` // Unable to discontinue
+	Table0119_UH Table0119 = `UH` // Unable to put on hold
+	Table0119_UR Table0119 = `UR` // Unable to release
+	Table0119_UX Table0119 = `UX` // Unable to change
+	Table0119_XR Table0119 = `XR` // Changed as requested
+	Table0119_XX Table0119 = `XX` // Order changed, unsolicited
+)
+
+// TableID returns the table ID.
+func (Table0119) TableID() string { return `0119` }
+
+// Valid reports if the value is in the table.
+func (v Table0119) Valid() bool { return TableValueLookup[`0119`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0119) Description() string { return tableDescription(`0119`, string(v)) }
+
+// Table0121 is a value of table 0121, RESPONSE FLAG.
+type Table0121 string
+
+const (
+	Table0121_E Table0121 = `E` // Report exceptions only.
+	Table0121_F Table0121 = `F` // Same as D, plus confirmations explicitly.
+	Table0121_N Table0121 = `N` // Only the MSA segment is returned.
+)
+
+// TableID returns the table ID.
+func (Table0121) TableID() string { return `0121` }
+
+// Valid reports if the value is in the table.
+func (v Table0121) Valid() bool { return TableValueLookup[`0121`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0121) Description() string { return tableDescription(`0121`, string(v)) }
+
+// Table0122 is a value of table 0122, CHARGE TYPE.
+type Table0122 string
+
+const (
+	Table0122_CH Table0122 = `CH` // Charge
+	Table0122_CO Table0122 = `CO` // Contract
+	Table0122_CR Table0122 = `CR` // Credit
+	Table0122_DP Table0122 = `DP` // Department
+	Table0122_GR Table0122 = `GR` // Grant
+	Table0122_NC Table0122 = `NC` // No Charge
+	Table0122_PC Table0122 = `PC` // Professional
+	Table0122_RS Table0122 = `RS` // Research
+)
+
+// TableID returns the table ID.
+func (Table0122) TableID() string { return `0122` }
+
+// Valid reports if the value is in the table.
+func (v Table0122) Valid() bool { return TableValueLookup[`0122`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0122) Description() string { return tableDescription(`0122`, string(v)) }
+
+// Table0123 is a value of table 0123, RESULT STATUS - OBR.
+type Table0123 string
+
+const (
+	Table0123_C Table0123 = `C` // Correction of previously transmitted results
+	Table0123_F Table0123 = `F` // Final results - results stored & verified
+	Table0123_I Table0123 = `I` // Specimen in lab, not yet processed.
+	Table0123_P Table0123 = `P` // Preliminary results
+	Table0123_R Table0123 = `R` // Results stored - not yet verified
+	Table0123_S Table0123 = `S` // Procedure scheduled, not done
+	Table0123_Y Table0123 = `Y` // No order on record for this test
+	Table0123_Z Table0123 = `Z` // No record of this patient
+)
+
+// TableID returns the table ID.
+func (Table0123) TableID() string { return `0123` }
+
+// Valid reports if the value is in the table.
+func (v Table0123) Valid() bool { return TableValueLookup[`0123`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0123) Description() string { return tableDescription(`0123`, string(v)) }
+
+// Table0124 is a value of table 0124, TRANSPORTATION MODE.
+type Table0124 string
+
+const (
+	Table0124_PORT Table0124 = `PORT` // The examining device goes to Patient's Loc.
+	Table0124_WALK Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124_WHLC Table0124 = `WHLC` // Wheelchair
+)
+
+// TableID returns the table ID.
+func (Table0124) TableID() string { return `0124` }
+
+// Valid reports if the value is in the table.
+func (v Table0124) Valid() bool { return TableValueLookup[`0124`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0124) Description() string { return tableDescription(`0124`, string(v)) }
+
+// Table0125 is a value of table 0125, VALUE TYPE.
+type Table0125 string
+
+const (
+	Table0125_AD Table0125 = `AD` // Address
+	Table0125_CK Table0125 = `CK` // Composite ID with check digit
+	Table0125_FT Table0125 = `FT` // Formatted Text
+	Table0125_PN Table0125 = `PN` // Person name
+	Table0125_ST Table0125 = `ST` // String data. Used to transmit numerics.
+	Table0125_TM Table0125 = `TM` // Time
+	Table0125_TS Table0125 = `TS` // Time stamp
+	Table0125_TX Table0125 = `TX` // Text
+)
+
+// TableID returns the table ID.
+func (Table0125) TableID() string { return `0125` }
+
+// Valid reports if the value is in the table.
+func (v Table0125) Valid() bool { return TableValueLookup[`0125`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0125) Description() string { return tableDescription(`0125`, string(v)) }
+
+// Table0126 is a value of table 0126, QUANTITY LIMITED REQUEST.
+type Table0126 string
+
+const (
+	Table0126_CH Table0126 = `CH` // Characters
+	Table0126_LI Table0126 = `LI` // Lines
+	Table0126_PG Table0126 = `PG` // Pages
+	Table0126_ZO Table0126 = `ZO` // Locally defined
+)
+
+// TableID returns the table ID.
+func (Table0126) TableID() string { return `0126` }
+
+// Valid reports if the value is in the table.
+func (v Table0126) Valid() bool { return TableValueLookup[`0126`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0126) Description() string { return tableDescription(`0126`, string(v)) }
+
+func tableDescription(table, value string) string {
+	for _, row := range TableLookup[table].Row {
+		if row.ID == value {
+			return row.Description
+		}
+	}
+	return ""
+}
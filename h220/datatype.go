@@ -13,9 +13,9 @@ type AD struct {
 	OtherDesignation           ST      `hl7:"2,display=Other Designation"`
 	City                       ST      `hl7:"3,display=City"`
 	StateOrProvince            ST      `hl7:"4,display=State Or Province"`
-	ZipOrPostalCode            ID      `hl7:"5,display=Zip Or Postal Code"`
-	Country                    ID      `hl7:"6,table=ISO3166,display=Country"`
-	Type                       ID      `hl7:"7,table=0190,display=Type"`
+	ZipOrPostalCode            ID      `hl7:"5,datatype=ID,display=Zip Or Postal Code"`
+	Country                    ID      `hl7:"6,table=ISO3166,datatype=ID,display=Country"`
+	Type                       ID      `hl7:"7,table=0190,datatype=ID,display=Type"`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other Geographic Designation"`
 }
 
@@ -27,7 +27,7 @@ type AD struct {
 // |54.21^Laparoscopy^I9^42112^^AS4|
 type CE struct {
 	HL7                         HL7Name `hl7:",name=CE,len=0,type=d"`
-	Identifier                  ID      `hl7:"1,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the <text>.  Different coding schemes will have different elements here."`
+	Identifier                  ID      `hl7:"1,datatype=ID,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the <text>.  Different coding schemes will have different elements here."`
 	Text                        ST      `hl7:"2,display=Name or description of the item in question.  E.g.- myocardial infarction or x-ray impression.  Its data type is string (ST)."`
 	NameOfCodingSystem          ST      `hl7:"3,display=Each coding system will be assigned a unique identifier.  This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item.  For backward compatibility- if this component is absent- it will be taken to mean the CPT-4 with ASTM extensions- i.e.- AS4.  Other coding systems that might appear here are ICD-9- ICD-10- SNOMED- etc.  Each system will be given a unique identifying string.  The current ASTM 1238-88  diagnostic/procedure/observation/drug ID/health outcomes coding systems are identified in the tables below.  Others may be added as needed."`
 	AlternateIdentifier         ST      `hl7:"4,display=These three components are defined analogously to the above for the alternate or local coding system.  If the Alternate Text component is absent- and the Alternate Identifier is present- the Alternate Text will be taken to be the same as the Text component.  If the Alternate Coding System component is absent- it will be taken to mean the locally defined system"`
@@ -44,9 +44,9 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=0,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=ID Number"`
-	CheckDigit                                 NM      `hl7:"2,display=Check Digit"`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in table 0061 - check digit scheme. "`
+	IDNumber                                   NM      `hl7:"1,datatype=NM,display=ID Number"`
+	CheckDigit                                 NM      `hl7:"2,datatype=NM,display=Check Digit"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=The check digit scheme codes are defined in table 0061 - check digit scheme. "`
 	AssigningFacilityID                        ST      `hl7:"4,display=The assigning facility ID is a unique name (up to six characters in length) of the system that stores the data.  It is an ST data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning facility ID's are unique across a given HL7 implementation."`
 }
 
@@ -64,15 +64,15 @@ type CM_AUI struct {
 // data type
 type CM_BATCH_TOTAL struct {
 	HL7         HL7Name `hl7:",name=CM_BATCH_TOTAL,len=0,type=d"`
-	BatchTotal  NM      `hl7:"1,display=Batch Total 1"`
-	BatchTotal2 NM      `hl7:"2,display=Batch Total 2"`
-	Value       NM      `hl7:"3,display=..."`
+	BatchTotal  NM      `hl7:"1,datatype=NM,display=Batch Total 1"`
+	BatchTotal2 NM      `hl7:"2,datatype=NM,display=Batch Total 2"`
+	Value       NM      `hl7:"3,datatype=NM,display=..."`
 }
 
 // Charge Time
 type CM_CCD struct {
 	HL7          HL7Name `hl7:",name=CM_CCD,len=0,type=d"`
-	WhenToCharge ID      `hl7:"1,table=0100,display=When To Charge"`
+	WhenToCharge ID      `hl7:"1,table=0100,datatype=ID,display=When To Charge"`
 	DateTime     TS      `hl7:"2,format=YMDHMS,display=Date/Time"`
 }
 
@@ -80,22 +80,22 @@ type CM_CCD struct {
 type CM_DDI struct {
 	HL7          HL7Name `hl7:",name=CM_DDI,len=0,type=d"`
 	DelayDays    ST      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	Amount       NM      `hl7:"2,datatype=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,datatype=NM,display=Number Of Days"`
 }
 
 // Discharge Location
 type CM_DLD struct {
 	HL7         HL7Name `hl7:",name=CM_DLD,len=0,type=d"`
-	Code        ID      `hl7:"1,table=0113,display=Code"`
+	Code        ID      `hl7:"1,table=0113,datatype=ID,display=Code"`
 	Description ST      `hl7:"2,display=Description"`
 }
 
 // Day Type And Number
 type CM_DTN struct {
 	HL7          HL7Name `hl7:",name=CM_DTN,len=0,type=d"`
-	DayType      ID      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	DayType      ID      `hl7:"1,table=0149,datatype=ID,display=Day Type"`
+	NumberOfDays NM      `hl7:"2,datatype=NM,display=Number Of Days"`
 }
 
 // Parent Order
@@ -109,47 +109,47 @@ type CM_EIP struct {
 type CM_ELD struct {
 	HL7                  HL7Name `hl7:",name=CM_ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment-id"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field-position"`
+	Sequence             NM      `hl7:"2,datatype=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,datatype=NM,display=Field-position"`
 	CodeIdentifyingError *CE     `hl7:"4,table=0060,display=Code Identifying Error"`
 }
 
 // Number Of Processing Power Point
 type CM_FILLER struct {
 	HL7                 HL7Name `hl7:",name=CM_FILLER,len=0,type=d"`
-	UniqueFillerID      ID      `hl7:"1,display=Its first component is a string of up to 15 characters that identifies an order detail segment (e.g.- OBR). It is assigned by the order filler (receiving) application. This string must uniquely identify the order (as specified in the order detail segment) from other orders in a particular filling application (e.g.- clinical laboratory).  This uniqueness must persist over time. "`
-	FillerApplicationID ID      `hl7:"2,display=The second component contains the filler application ID.  The filler application ID is a string of up to six characters that uniquely defines the application from other applications on the network.  The second component of the filler order number always identifies the actual filler of an order. "`
+	UniqueFillerID      ID      `hl7:"1,datatype=ID,display=Its first component is a string of up to 15 characters that identifies an order detail segment (e.g.- OBR). It is assigned by the order filler (receiving) application. This string must uniquely identify the order (as specified in the order detail segment) from other orders in a particular filling application (e.g.- clinical laboratory).  This uniqueness must persist over time. "`
+	FillerApplicationID ID      `hl7:"2,datatype=ID,display=The second component contains the filler application ID.  The filler application ID is a string of up to six characters that uniquely defines the application from other applications on the network.  The second component of the filler order number always identifies the actual filler of an order. "`
 }
 
 // Cm Of Finance
 type CM_FINANCE struct {
 	HL7              HL7Name `hl7:",name=CM_FINANCE,len=0,type=d"`
-	FinancialClassID ID      `hl7:"1,table=0064,display=Financial Class Id"`
+	FinancialClassID ID      `hl7:"1,table=0064,datatype=ID,display=Financial Class Id"`
 	EffectiveDate    TS      `hl7:"2,format=YMDHMS,display=Effective Date"`
 }
 
 // Order Group Number
 type CM_GROUP_ID struct {
 	HL7                 HL7Name `hl7:",name=CM_GROUP_ID,len=0,type=d"`
-	UniqueGroupID       ID      `hl7:"1,display=The first component is a string of up to 15 characters that uniquely identifies all order groups from the given placer application.  It is assigned by the placer application and may come from the same series as the placer order number of the ORC- but this is not required. "`
-	PlacerApplicationID ID      `hl7:"2,display=The second component is a placer application ID identical to the second component of ORC-2-placer order number.  Order groups and how to use them are described in detail at the end of the ORC section under 'Use Notes' and in the Examples."`
+	UniqueGroupID       ID      `hl7:"1,datatype=ID,display=The first component is a string of up to 15 characters that uniquely identifies all order groups from the given placer application.  It is assigned by the placer application and may come from the same series as the placer order number of the ORC- but this is not required. "`
+	PlacerApplicationID ID      `hl7:"2,datatype=ID,display=The second component is a placer application ID identical to the second component of ORC-2-placer order number.  Order groups and how to use them are described in detail at the end of the ORC section under 'Use Notes' and in the Examples."`
 }
 
 // Cm For Location Information In Hospital
 type CM_INTERNAL_LOCATION struct {
 	HL7              HL7Name `hl7:",name=CM_INTERNAL_LOCATION,len=0,type=d"`
-	NurseUnitStation ID      `hl7:"1,display=Nurse Unit (station)"`
-	Room             ID      `hl7:"2,display=Room"`
-	Bed              ID      `hl7:"3,display=Bed"`
-	FacilityID       ID      `hl7:"4,display=Facility Id"`
-	BedStatus        ID      `hl7:"5,display=Bed Status"`
+	NurseUnitStation ID      `hl7:"1,datatype=ID,display=Nurse Unit (station)"`
+	Room             ID      `hl7:"2,datatype=ID,display=Room"`
+	Bed              ID      `hl7:"3,datatype=ID,display=Bed"`
+	FacilityID       ID      `hl7:"4,datatype=ID,display=Facility Id"`
+	BedStatus        ID      `hl7:"5,datatype=ID,display=Bed Status"`
 }
 
 // Job Title
 type CM_JOB_CODE struct {
 	HL7                    HL7Name `hl7:",name=CM_JOB_CODE,len=0,type=d"`
-	JobCode                ID      `hl7:"1,display=Job Code"`
-	EmployeeClassification ID      `hl7:"2,display=Employee Classification"`
+	JobCode                ID      `hl7:"1,datatype=ID,display=Job Code"`
+	EmployeeClassification ID      `hl7:"2,datatype=ID,display=Employee Classification"`
 }
 
 // Location With Address Information
@@ -176,8 +176,8 @@ type CM_MOC struct {
 // Message Type
 type CM_MSG struct {
 	HL7          HL7Name `hl7:",name=CM_MSG,len=0,type=d"`
-	MessageType  ID      `hl7:"1,table=0076,display=Message Type"`
-	TriggerEvent ID      `hl7:"2,table=0003,display=Trigger Event"`
+	MessageType  ID      `hl7:"1,table=0076,datatype=ID,display=Message Type"`
+	TriggerEvent ID      `hl7:"2,table=0003,datatype=ID,display=Trigger Event"`
 }
 
 func (d CM_MSG) MessageStructureID() string {
@@ -199,26 +199,26 @@ type CM_NDL struct {
 // Occurence
 type CM_OCD struct {
 	HL7            HL7Name `hl7:",name=CM_OCD,len=0,type=d"`
-	OccurrenceCode ID      `hl7:"1,display=Occurrence Code"`
+	OccurrenceCode ID      `hl7:"1,datatype=ID,display=Occurrence Code"`
 	OccurrenceDate DT      `hl7:"2,format=YMD,display=Occurrence Date"`
 }
 
 // Order Sequence
 type CM_OSD struct {
 	HL7                               HL7Name `hl7:",name=CM_OSD,len=0,type=d"`
-	SequenceResultsFlag               ID      `hl7:"1,display=S for sequence conditions; R is reserved for possible future use. "`
+	SequenceResultsFlag               ID      `hl7:"1,datatype=ID,display=S for sequence conditions; R is reserved for possible future use. "`
 	PlacerOrderNumberEntityIdentifier ST      `hl7:"2,required,display=Uses two subcomponents since the placer order number has two components"`
-	PlacerOrderNumberNamespaceID      IS      `hl7:"3,display=Uses two subcomponents since the placer order number has two components"`
+	PlacerOrderNumberNamespaceID      IS      `hl7:"3,datatype=IS,display=Uses two subcomponents since the placer order number has two components"`
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Uses two subcomponents since the filler order number has two components"`
-	FillerOrderNumberNamespaceID      IS      `hl7:"5,display=Uses two subcomponents since the filler order number has two components"`
+	FillerOrderNumberNamespaceID      IS      `hl7:"5,datatype=IS,display=Uses two subcomponents since the filler order number has two components"`
 	SequenceConditionValue            ST      `hl7:"6,display=The acceptable condition values have the form commonly used in project planning methodologies  <one of 'SS'- 'EE'- 'SE'- or 'ES'> +/- <time>  The first letter stands for start (S) or end (E) of predecessor order- where the predecessor is defined by the placer or filler order number in subcomponents 1-2 or subcomponents 3-4.    The second letter stands for the start (S) or end (E) of the successor order- where the successor order is the order containing this quantity/timing specification.    The time specifies the interval between the predecessor and successor starts or ends "`
-	MaximumNumberOfRepeats            NM      `hl7:"7,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,datatype=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 }
 
 // Occurence Span
 type CM_OSP struct {
 	HL7                     HL7Name `hl7:",name=CM_OSP,len=0,type=d"`
-	OccurrenceSpanCode      ID      `hl7:"1,display=Occurrence Span Code"`
+	OccurrenceSpanCode      ID      `hl7:"1,datatype=ID,display=Occurrence Span Code"`
 	OccurrenceSpanStartDate DT      `hl7:"2,format=YMD,display=Occurrence Span Start Date"`
 	OccurrenceSpanStopDate  DT      `hl7:"3,format=YMD,display=Occurrence Span Stop Date"`
 }
@@ -227,49 +227,49 @@ type CM_OSP struct {
 type CM_PAT_ID struct {
 	HL7              HL7Name `hl7:",name=CM_PAT_ID,len=0,type=d"`
 	PatientID        ST      `hl7:"1,display=Patient Id"`
-	CheckDigit       NM      `hl7:"2,display=Check Digit"`
-	CheckDigitScheme ID      `hl7:"3,table=0061,display=Check Digit Scheme"`
-	FacilityID       ID      `hl7:"4,display=Facility Id"`
-	Type             ID      `hl7:"5,display=Type"`
+	CheckDigit       NM      `hl7:"2,datatype=NM,display=Check Digit"`
+	CheckDigitScheme ID      `hl7:"3,table=0061,datatype=ID,display=Check Digit Scheme"`
+	FacilityID       ID      `hl7:"4,datatype=ID,display=Facility Id"`
+	Type             ID      `hl7:"5,datatype=ID,display=Type"`
 }
 
 // Patient Id With Table 0192
 type CM_PAT_ID_0192 struct {
 	HL7              HL7Name `hl7:",name=CM_PAT_ID_0192,len=0,type=d"`
 	PatientID        ST      `hl7:"1,display=Patient Id"`
-	CheckDigit       NM      `hl7:"2,display=Check Digit"`
-	CheckDigitScheme ID      `hl7:"3,table=0061,display=Check Digit Scheme"`
-	FacilityID       ID      `hl7:"4,display=Facility Id"`
-	Type             ID      `hl7:"5,table=0192,display=Type"`
+	CheckDigit       NM      `hl7:"2,datatype=NM,display=Check Digit"`
+	CheckDigitScheme ID      `hl7:"3,table=0061,datatype=ID,display=Check Digit Scheme"`
+	FacilityID       ID      `hl7:"4,datatype=ID,display=Facility Id"`
+	Type             ID      `hl7:"5,table=0192,datatype=ID,display=Type"`
 }
 
 // Pre-certification Required
 type CM_PCF struct {
 	HL7                         HL7Name `hl7:",name=CM_PCF,len=0,type=d"`
-	PreCertificationPatientType ID      `hl7:"1,table=0150,display=Pre-certification Patient Type"`
-	PreCerticationRequired      ID      `hl7:"2,table=0136,display=Pre-certication Required"`
+	PreCertificationPatientType ID      `hl7:"1,table=0150,datatype=ID,display=Pre-certification Patient Type"`
+	PreCerticationRequired      ID      `hl7:"2,table=0136,datatype=ID,display=Pre-certication Required"`
 	PreCertificationWindow      TS      `hl7:"3,format=YMDHMS,display=Pre-certification Window"`
 }
 
 // Penalty
 type CM_PEN struct {
 	HL7           HL7Name `hl7:",name=CM_PEN,len=0,type=d"`
-	PenaltyID     ID      `hl7:"1,table=0148,display=Penalty Id"`
-	PenaltyAmount NM      `hl7:"2,display=Penalty Amount"`
+	PenaltyID     ID      `hl7:"1,table=0148,datatype=ID,display=Penalty Id"`
+	PenaltyAmount NM      `hl7:"2,datatype=NM,display=Penalty Amount"`
 }
 
 // Order Number Of The Client / The Contracting Authority
 type CM_PLACER struct {
 	HL7               HL7Name `hl7:",name=CM_PLACER,len=0,type=d"`
 	UniquePlacerID    ST      `hl7:"1,len=15,display=The first component is a string of up to 15 characters that identifies an individual order (e.g.- OBR).  It is assigned by the placer (ordering application).  It identifies an order uniquely among all orders from a particular ordering application."`
-	PlacerApplication ID      `hl7:"2,display=The second component contains the application ID of the placing application.  The application ID is a string of up to six (6) characters that will be uniquely associated with an application.  A given institution or group of intercommunicating institutions should establish a unique list of applications that may be potential placers and fillers and assign unique application ID's."`
+	PlacerApplication ID      `hl7:"2,datatype=ID,display=The second component contains the application ID of the placing application.  The application ID is a string of up to six (6) characters that will be uniquely associated with an application.  A given institution or group of intercommunicating institutions should establish a unique list of applications that may be potential placers and fillers and assign unique application ID's."`
 }
 
 // Action Carried Out By
 type CM_PRACTITIONER struct {
 	HL7                       HL7Name    `hl7:",name=CM_PRACTITIONER,len=0,type=d"`
 	ProcedurePractitionerID   *CN_PERSON `hl7:"1,display=Procedure Practitioner  Id"`
-	ProcedurePractitionerType ID         `hl7:"2,display=Procedure Practitioner Type"`
+	ProcedurePractitionerType ID         `hl7:"2,datatype=ID,display=Procedure Practitioner Type"`
 }
 
 // Parent Result Link
@@ -286,9 +286,9 @@ type CM_PRL struct {
 // Policy Type
 type CM_PTA struct {
 	HL7         HL7Name `hl7:",name=CM_PTA,len=0,type=d"`
-	PolicyType  ID      `hl7:"1,table=0147,display=Policy Type"`
-	AmountClass ID      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	PolicyType  ID      `hl7:"1,table=0147,datatype=ID,display=Policy Type"`
+	AmountClass ID      `hl7:"2,table=0193,datatype=ID,display=Amount Class"`
+	Amount      NM      `hl7:"3,datatype=NM,display=Amount"`
 }
 
 // Interval
@@ -301,9 +301,9 @@ type CM_RI struct {
 // Room Coverage
 type CM_RMC struct {
 	HL7            HL7Name `hl7:",name=CM_RMC,len=0,type=d"`
-	RoomType       ID      `hl7:"1,table=0145,display=Room Type"`
-	AmountType     ID      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	RoomType       ID      `hl7:"1,table=0145,datatype=ID,display=Room Type"`
+	AmountType     ID      `hl7:"2,table=0146,datatype=ID,display=Amount Type"`
+	CoverageAmount NM      `hl7:"3,datatype=NM,display=Coverage Amount"`
 }
 
 // Specimen Source
@@ -319,8 +319,8 @@ type CM_SPS struct {
 // Value Code And Amount
 type CM_UVC struct {
 	HL7         HL7Name `hl7:",name=CM_UVC,len=0,type=d"`
-	ValueCode   ID      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueCode   ID      `hl7:"1,table=0153,datatype=ID,display=Value Code"`
+	ValueAmount NM      `hl7:"2,datatype=NM,display=Value Amount"`
 }
 
 // Value Qualifier
@@ -340,27 +340,27 @@ type CM_VR struct {
 // |12372^RIGGINS^JOHN^""^""^""^MD^ADT1| |12372| |^RIGGINS^JOHN^""^""^""^MD|
 type CN_PERSON struct {
 	HL7                 HL7Name `hl7:",name=CN_PERSON,len=0,type=d"`
-	IDNumber            ID      `hl7:"1,display=Id Number"`
+	IDNumber            ID      `hl7:"1,datatype=ID,display=Id Number"`
 	FamiliyName         ST      `hl7:"2,display=Familiy Name"`
 	GivenName           ST      `hl7:"3,display=Given Name"`
 	MiddleInitialOrName ST      `hl7:"4,display=Middle Initial Or Name"`
 	Suffix              ST      `hl7:"5,display=Suffix (e.g. Jr Or Iii)"`
 	Prefix              ST      `hl7:"6,display=Prefix (e.g. Dr)"`
 	Degree              ST      `hl7:"7,display=Degree (e.g. Md)"`
-	SourceTableID       ID      `hl7:"8,display=Source Table Id"`
+	SourceTableID       ID      `hl7:"8,datatype=ID,display=Source Table Id"`
 }
 
 // Cn For Physicians
 type CN_PHYSICIAN struct {
 	HL7                 HL7Name `hl7:",name=CN_PHYSICIAN,len=0,type=d"`
-	PhysicianID         ID      `hl7:"1,display=Physician Id"`
+	PhysicianID         ID      `hl7:"1,datatype=ID,display=Physician Id"`
 	FamiliyName         ST      `hl7:"2,display=Familiy Name"`
 	GivenName           ST      `hl7:"3,display=Given Name"`
 	MiddleInitialOrName ST      `hl7:"4,display=Middle Initial Or Name"`
 	Suffix              ST      `hl7:"5,display=Suffix (e.g. Jr Or Iii)"`
 	Prefix              ST      `hl7:"6,display=Prefix (e.g. Dr)"`
 	Degree              ST      `hl7:"7,display=Degree (e.g. Md)"`
-	SourceTableID       ID      `hl7:"8,display=Source Table Id"`
+	SourceTableID       ID      `hl7:"8,datatype=ID,display=Source Table Id"`
 }
 
 // Composite Id W/chk Digit
@@ -381,7 +381,7 @@ type COMP_ID_DIGIT = string
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,datatype=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed.  Field-by-field- default units may be defined within the specifications.  When the observation is measured in the default units- the units need not be transmitted.  If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component.  If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7.  If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7.  But in these cases the component separator should be replaced by the subcomponent delimiter "`
 }
 
@@ -415,9 +415,9 @@ type FT = string
 // must be unique within the series of ID’s defined by that component.
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=0,type=d"`
-	NamespaceID     IS      `hl7:"1,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
+	NamespaceID     IS      `hl7:"1,datatype=IS,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
 	UniversalID     ST      `hl7:"2,display=The HD’s second component- Universal ID (UID)- is a string formatted according to the scheme defined by the third component- Universal ID type (UID type).  The UID is intended to be unique over time within the UID type.  It is rigorously defined.  Each UID must belong  to one of the specifically enumerated schemes for constructing UID’s (defined by the UID type).  The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). "`
-	UniversalIDType ID      `hl7:"3,display=The third component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
+	UniversalIDType ID      `hl7:"3,datatype=ID,display=The third component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
 }
 
 // Coded Value
@@ -450,13 +450,13 @@ type NM = string
 // within a healthcare setting.
 type PL struct {
 	HL7                HL7Name `hl7:",name=PL,len=0,type=d"`
-	PointOfCare        ID      `hl7:"1,display=Conditional on person location type (e.g.- nursing unit or department or clinic).  After floor- most general patient location designation.  Refer to user-defined table 0302 - Point of care for suggested values."`
-	Room               IS      `hl7:"2,display=Patient room.  After nursing unit- most general person location designation.  Refer to user-defined table 0303 - Room for suggested values"`
-	Bed                IS      `hl7:"3,display=Patient bed.  After room- most general person location designation.  Refer to user-defined table 0304 - Bed for suggested values"`
+	PointOfCare        ID      `hl7:"1,datatype=ID,display=Conditional on person location type (e.g.- nursing unit or department or clinic).  After floor- most general patient location designation.  Refer to user-defined table 0302 - Point of care for suggested values."`
+	Room               IS      `hl7:"2,datatype=IS,display=Patient room.  After nursing unit- most general person location designation.  Refer to user-defined table 0303 - Room for suggested values"`
+	Bed                IS      `hl7:"3,datatype=IS,display=Patient bed.  After room- most general person location designation.  Refer to user-defined table 0304 - Bed for suggested values"`
 	Facility           *HD     `hl7:"4,display=Most general person location designation. (See Section 2.8.18- “HD - hierarchic designator”). "`
-	LocationStatus     IS      `hl7:"5,display=Location (e.g.- Bed) status.  Refer to user-defined table 0306 - Location status for suggested values"`
-	PersonLocationType IS      `hl7:"6,display=Usually includes values such as nursing unit- department- clinic- SNF- physician’s office.  Refer to userdefined table 0305 - Person location type for suggested values"`
-	Building           IS      `hl7:"7,display=After facility- most general person location designation.  Refer to user-defined table 0307 - Building for suggested values"`
+	LocationStatus     IS      `hl7:"5,datatype=IS,display=Location (e.g.- Bed) status.  Refer to user-defined table 0306 - Location status for suggested values"`
+	PersonLocationType IS      `hl7:"6,datatype=IS,display=Usually includes values such as nursing unit- department- clinic- SNF- physician’s office.  Refer to userdefined table 0305 - Person location type for suggested values"`
+	Building           IS      `hl7:"7,datatype=IS,display=After facility- most general person location designation.  Refer to user-defined table 0307 - Building for suggested values"`
 	Floor              ST      `hl7:"8,display=After building- most general person location designation.  Refer to user-defined table 0308 - Floor for suggested values"`
 	LocationType       ST      `hl7:"9,display=A free text description of the location"`
 }
@@ -515,10 +515,10 @@ type TQ struct {
 	Duration        ST      `hl7:"3,display=Indicates how long the service should continue after it is started.  The default is INDEF (do indefinitely)."`
 	StartDateTime   TS      `hl7:"4,format=YMDHMS,display=May be specified by the orderer- in which case it indicates the earliest date/time at which the services should be started.  In many cases- however- the start date time will be implied or will be defined by other fields in the order record (e.g.- urgency - STAT).  In such a case- this field will be empty"`
 	EndDateTime     TS      `hl7:"5,format=YMDHMS,display=when filled in by the requester of the service- this field should be the latest date-time that the service should be performed.  If it has not been performed by the specified time- it should not be performed at all.  The requester may not always fill in this value- yet the filling service may fill it in on the basis of the instruction it receives and the actual start time."`
-	Priority        ID      `hl7:"6,display=describes the urgency of the request.  The following values are suggested (the default for Priority is R)"`
+	Priority        ID      `hl7:"6,datatype=ID,display=describes the urgency of the request.  The following values are suggested (the default for Priority is R)"`
 	Condition       ST      `hl7:"7,display=This is a free text field that describes the conditions under which the drug is to be given.  For example- PRN pain- or to keep blood pressure below 110.  The presence of text in this field should be taken to mean that human review is needed to determine the how and/or when this drug should be given"`
 	Text            TX      `hl7:"8,display=full text version of the instruction (optional)."`
-	Conjunction     ID      `hl7:"9,display= non-null component indicates that a second timing specification is to follow using the repeat delimiter.  This field can take three values:   S :  Synchronous  A :  Asynchronous  C :  This is an actuation time "`
+	Conjunction     ID      `hl7:"9,datatype=ID,display= non-null component indicates that a second timing specification is to follow using the repeat delimiter.  This field can take three values:   S :  Synchronous  A :  Asynchronous  C :  This is an actuation time "`
 	OrderSequencing *CM_OSD `hl7:"10,display=there are many situations- such as the creation of an order for a group of intervenous (IV) solutions- where the sequence of the individual intervenous solutions (each an order in itself) needs to be specified.  There are other situations- where part of the order's instructions contains a results condition of some type- such as 'PRN pain.'  There is currently a free text 'condition' component of ORC-4-quantity/timing which allows any condition to be specified.  However- to support a fully encoded version of order sequencing- or results condition- we have defined in the following paragraphs a 10th component of ORC-4quantity/timing"`
 }
 
@@ -0,0 +1,3092 @@
+// Code generated by "hl7fetch -pkgdir h220 -root ./genjson -version 2.2"; DO NOT EDIT.
+
+package h220
+
+// Each table has an enum type, such as Table0001, with a constant for each value.
+// Declare a field with the enum type to check the value against the table when decoded,
+// as with the hl7.Enum interface.
+
+// Table0001 is a value of table 0001, SEX.
+type Table0001 string
+
+const (
+	Table0001_F Table0001 = `F` // Female
+	Table0001_M Table0001 = `M` // Male
+	Table0001_O Table0001 = `O` // Other
+	Table0001_U Table0001 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0001) TableID() string { return `0001` }
+
+// Valid reports if the value is in the table.
+func (v Table0001) Valid() bool { return TableValueLookup[`0001`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0001) Description() string { return tableDescription(`0001`, string(v)) }
+
+// Table0002 is a value of table 0002, MARITAL STATUS.
+type Table0002 string
+
+const (
+	Table0002_A Table0002 = `A` // Separated
+	Table0002_D Table0002 = `D` // Divorced
+	Table0002_M Table0002 = `M` // Married
+	Table0002_S Table0002 = `S` // Single
+	Table0002_W Table0002 = `W` // Widowed
+)
+
+// TableID returns the table ID.
+func (Table0002) TableID() string { return `0002` }
+
+// Valid reports if the value is in the table.
+func (v Table0002) Valid() bool { return TableValueLookup[`0002`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0002) Description() string { return tableDescription(`0002`, string(v)) }
+
+// Table0003 is a value of table 0003, EVENT TYPE CODE.
+type Table0003 string
+
+const (
+	Table0003_A01 Table0003 = `A01` // Admit a patient
+	Table0003_A02 Table0003 = `A02` // Transfer a patient
+	Table0003_A03 Table0003 = `A03` // Discharge a patient
+	Table0003_A04 Table0003 = `A04` // Register a patient
+	Table0003_A05 Table0003 = `A05` // Pre-admit a Patient
+	Table0003_A06 Table0003 = `A06` // Transfer an outpatient to inpatient
+	Table0003_A07 Table0003 = `A07` // Transfer an inpatient to outpatient
+	Table0003_A08 Table0003 = `A08` // Update patient information
+	Table0003_A09 Table0003 = `A09` // Patient departing
+	Table0003_A10 Table0003 = `A10` // Patient arriving
+	Table0003_A11 Table0003 = `A11` // Cancel admit
+	Table0003_A12 Table0003 = `A12` // Cancel transfer
+	Table0003_A13 Table0003 = `A13` // Cancel discharge
+	Table0003_A14 Table0003 = `A14` // Pending admit
+	Table0003_A15 Table0003 = `A15` // Pending transfer
+	Table0003_A16 Table0003 = `A16` // Pending discharge
+	Table0003_A17 Table0003 = `A17` // Swap patients
+	Table0003_A18 Table0003 = `A18` // Merge patient information
+	Table0003_A19 Table0003 = `A19` // Patient query
+	Table0003_A20 Table0003 = `A20` // Bed Status Update
+	Table0003_A21 Table0003 = `A21` // Leave of absence - out (leaving)
+	Table0003_A22 Table0003 = `A22` // Leave of absence - in (returning)
+	Table0003_A23 Table0003 = `A23` // Delete a patient record
+	Table0003_A24 Table0003 = `A24` // Link patient information
+	Table0003_A25 Table0003 = `A25` // Cancel pending discharge
+	Table0003_A26 Table0003 = `A26` // Cancel pending transfer
+	Table0003_A27 Table0003 = `A27` // Cancel pending admit
+	Table0003_A28 Table0003 = `A28` // Add person information
+	Table0003_A29 Table0003 = `A29` // Delete person information
+	Table0003_A30 Table0003 = `A30` // Merge Patient information
+	Table0003_A31 Table0003 = `A31` // Update person information
+	Table0003_A32 Table0003 = `A32` // Cancel patient arriving
+	Table0003_A33 Table0003 = `A33` // Cancel patient departing
+	Table0003_A34 Table0003 = `A34` // Merge patient information - patient ID only
+	Table0003_A35 Table0003 = `A35` // Merge patient information - account number only
+	Table0003_A36 Table0003 = `A36` // Merge patient information - patient ID and account number
+	Table0003_A37 Table0003 = `A37` // Unlink patient information
+	Table0003_M01 Table0003 = `M01` // Master file not otherwise specified (for backwards compatibility only)
+	Table0003_M02 Table0003 = `M02` // Master file - Staff Practioner
+	Table0003_M03 Table0003 = `M03` // Master file - test / observation
+	Table0003_O01 Table0003 = `O01` // Order message
+	Table0003_O02 Table0003 = `O02` // Order response
+	Table0003_P01 Table0003 = `P01` // Add and Update Patient Accounts
+	Table0003_P02 Table0003 = `P02` // Purge Patient Accounts
+	Table0003_P03 Table0003 = `P03` // Post detail financial transaction
+	Table0003_P04 Table0003 = `P04` // Generate bill and accounts receivable statements
+	Table0003_Q01 Table0003 = `Q01` // Immediate access
+	Table0003_Q02 Table0003 = `Q02` // Deferred access
+	Table0003_Q03 Table0003 = `Q03` // Deferred response to query
+	Table0003_Q05 Table0003 = `Q05` // Unsolicited display update
+	Table0003_R01 Table0003 = `R01` // Unsolicited transmission of requested observation
+	Table0003_R02 Table0003 = `R02` // Query for results of observation
+	Table0003_R03 Table0003 = `R03` // Display-oriented results (query / unsolicited update)
+	Table0003_R04 Table0003 = `R04` // Response to query / transmission of requested observation
+)
+
+// TableID returns the table ID.
+func (Table0003) TableID() string { return `0003` }
+
+// Valid reports if the value is in the table.
+func (v Table0003) Valid() bool { return TableValueLookup[`0003`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0003) Description() string { return tableDescription(`0003`, string(v)) }
+
+// Table0004 is a value of table 0004, PATIENT CLASS.
+type Table0004 string
+
+const (
+	Table0004_B Table0004 = `B` // Obstetrics
+	Table0004_E Table0004 = `E` // Emergency
+	Table0004_I Table0004 = `I` // Inpatient
+	Table0004_O Table0004 = `O` // Outpatient
+	Table0004_P Table0004 = `P` // Preadmit
+	Table0004_R Table0004 = `R` // Recurring Patient
+)
+
+// TableID returns the table ID.
+func (Table0004) TableID() string { return `0004` }
+
+// Valid reports if the value is in the table.
+func (v Table0004) Valid() bool { return TableValueLookup[`0004`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0004) Description() string { return tableDescription(`0004`, string(v)) }
+
+// Table0005 is a value of table 0005, RACE.
+type Table0005 string
+
+// TableID returns the table ID.
+func (Table0005) TableID() string { return `0005` }
+
+// Valid reports if the value is in the table.
+func (v Table0005) Valid() bool { return TableValueLookup[`0005`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0005) Description() string { return tableDescription(`0005`, string(v)) }
+
+// Table0006 is a value of table 0006, RELIGION.
+type Table0006 string
+
+// TableID returns the table ID.
+func (Table0006) TableID() string { return `0006` }
+
+// Valid reports if the value is in the table.
+func (v Table0006) Valid() bool { return TableValueLookup[`0006`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0006) Description() string { return tableDescription(`0006`, string(v)) }
+
+// Table0007 is a value of table 0007, ADMISSION TYPE.
+type Table0007 string
+
+const (
+	Table0007_A Table0007 = `A` // Accident
+	Table0007_E Table0007 = `E` // Emergency
+	Table0007_L Table0007 = `L` // Labor and Delivery
+	Table0007_R Table0007 = `R` // Routine
+)
+
+// TableID returns the table ID.
+func (Table0007) TableID() string { return `0007` }
+
+// Valid reports if the value is in the table.
+func (v Table0007) Valid() bool { return TableValueLookup[`0007`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0007) Description() string { return tableDescription(`0007`, string(v)) }
+
+// Table0008 is a value of table 0008, ACKNOWLEDGMENT CODE.
+type Table0008 string
+
+const (
+	Table0008_AA Table0008 = `AA` // Application accept (original mode) / Application acknowledgement: accept (enhanced mode)
+	Table0008_AE Table0008 = `AE` // Application error (original mode) / Application acknowledgement: error (enhanced mode)
+	Table0008_AR Table0008 = `AR` // Application reject (original mode) / Application acknowledgement: reject (enhanced mode)
+	Table0008_CA Table0008 = `CA` // Enhanced mode: Application acknowledgement: Commit Accept
+	Table0008_CE Table0008 = `CE` // Enhanced mode: Application acknowledgement: Commit Error
+	Table0008_CR Table0008 = `CR` // Enhanced mode: Application acknowledgement: Commit Reject
+)
+
+// TableID returns the table ID.
+func (Table0008) TableID() string { return `0008` }
+
+// Valid reports if the value is in the table.
+func (v Table0008) Valid() bool { return TableValueLookup[`0008`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0008) Description() string { return tableDescription(`0008`, string(v)) }
+
+// Table0009 is a value of table 0009, AMBULATORY STATUS.
+type Table0009 string
+
+const (
+	Table0009_A0 Table0009 = `A0` // No functional limitations
+	Table0009_A1 Table0009 = `A1` // Ambulates with assistive device
+	Table0009_A2 Table0009 = `A2` // Wheelchair / stretcher bound
+	Table0009_A3 Table0009 = `A3` // Comatose; non-responsive
+	Table0009_A4 Table0009 = `A4` // Disoriented
+	Table0009_A5 Table0009 = `A5` // Vision impaired
+	Table0009_A6 Table0009 = `A6` // Hearing impaired
+	Table0009_A7 Table0009 = `A7` // Speech impaired
+	Table0009_A8 Table0009 = `A8` // Nonenglish speaking
+	Table0009_A9 Table0009 = `A9` // Functional level unknown
+	Table0009_B1 Table0009 = `B1` // Oxygen Therapy
+	Table0009_B2 Table0009 = `B2` // Special equipment (tubes, Ivs, catheters)
+	Table0009_B3 Table0009 = `B3` // Amputee
+	Table0009_B4 Table0009 = `B4` // Mastectomy
+	Table0009_B5 Table0009 = `B5` // Paraplegic
+	Table0009_B6 Table0009 = `B6` // Pregnant
+)
+
+// TableID returns the table ID.
+func (Table0009) TableID() string { return `0009` }
+
+// Valid reports if the value is in the table.
+func (v Table0009) Valid() bool { return TableValueLookup[`0009`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0009) Description() string { return tableDescription(`0009`, string(v)) }
+
+// Table0010 is a value of table 0010, PHYSICIAN ID.
+type Table0010 string
+
+// TableID returns the table ID.
+func (Table0010) TableID() string { return `0010` }
+
+// Valid reports if the value is in the table.
+func (v Table0010) Valid() bool { return TableValueLookup[`0010`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0010) Description() string { return tableDescription(`0010`, string(v)) }
+
+// Table0017 is a value of table 0017, TRANSACTION TYPE.
+type Table0017 string
+
+// TableID returns the table ID.
+func (Table0017) TableID() string { return `0017` }
+
+// Valid reports if the value is in the table.
+func (v Table0017) Valid() bool { return TableValueLookup[`0017`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0017) Description() string { return tableDescription(`0017`, string(v)) }
+
+// Table0018 is a value of table 0018, PATIENT TYPE.
+type Table0018 string
+
+const (
+	Table0018_B Table0018 = `B`
+	Table0018_E Table0018 = `E`
+	Table0018_F Table0018 = `F`
+	Table0018_G Table0018 = `G`
+	Table0018_J Table0018 = `J`
+	Table0018_K Table0018 = `K`
+	Table0018_N Table0018 = `N`
+	Table0018_P Table0018 = `P`
+	Table0018_S Table0018 = `S`
+)
+
+// TableID returns the table ID.
+func (Table0018) TableID() string { return `0018` }
+
+// Valid reports if the value is in the table.
+func (v Table0018) Valid() bool { return TableValueLookup[`0018`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0018) Description() string { return tableDescription(`0018`, string(v)) }
+
+// Table0019 is a value of table 0019, ANESTHESIA CODE.
+type Table0019 string
+
+// TableID returns the table ID.
+func (Table0019) TableID() string { return `0019` }
+
+// Valid reports if the value is in the table.
+func (v Table0019) Valid() bool { return TableValueLookup[`0019`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0019) Description() string { return tableDescription(`0019`, string(v)) }
+
+// Table0021 is a value of table 0021, BAD DEBT AGENCY CODE.
+type Table0021 string
+
+// TableID returns the table ID.
+func (Table0021) TableID() string { return `0021` }
+
+// Valid reports if the value is in the table.
+func (v Table0021) Valid() bool { return TableValueLookup[`0021`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0021) Description() string { return tableDescription(`0021`, string(v)) }
+
+// Table0022 is a value of table 0022, BILLING STATUS.
+type Table0022 string
+
+// TableID returns the table ID.
+func (Table0022) TableID() string { return `0022` }
+
+// Valid reports if the value is in the table.
+func (v Table0022) Valid() bool { return TableValueLookup[`0022`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0022) Description() string { return tableDescription(`0022`, string(v)) }
+
+// Table0023 is a value of table 0023, ADMIT SOURCE.
+type Table0023 string
+
+// TableID returns the table ID.
+func (Table0023) TableID() string { return `0023` }
+
+// Valid reports if the value is in the table.
+func (v Table0023) Valid() bool { return TableValueLookup[`0023`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0023) Description() string { return tableDescription(`0023`, string(v)) }
+
+// Table0024 is a value of table 0024, FEE SCHEDULE.
+type Table0024 string
+
+// TableID returns the table ID.
+func (Table0024) TableID() string { return `0024` }
+
+// Valid reports if the value is in the table.
+func (v Table0024) Valid() bool { return TableValueLookup[`0024`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0024) Description() string { return tableDescription(`0024`, string(v)) }
+
+// Table0032 is a value of table 0032, CHARGE/PRICE INDICATOR.
+type Table0032 string
+
+// TableID returns the table ID.
+func (Table0032) TableID() string { return `0032` }
+
+// Valid reports if the value is in the table.
+func (v Table0032) Valid() bool { return TableValueLookup[`0032`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0032) Description() string { return tableDescription(`0032`, string(v)) }
+
+// Table0038 is a value of table 0038, ORDER STATUS.
+type Table0038 string
+
+const (
+	Table0038_CA Table0038 = `CA` // Order was canceled
+	Table0038_CM Table0038 = `CM` // Order is completed
+	Table0038_DC Table0038 = `DC` // Order was discontinued
+	Table0038_ER Table0038 = `ER` // Error - order not found
+	Table0038_HD Table0038 = `HD` // Order is on hold
+	Table0038_IP Table0038 = `IP` // In process - unspecified
+	Table0038_RP Table0038 = `RP` // Order has been replaced
+	Table0038_SC Table0038 = `SC` // In process - scheduled
+)
+
+// TableID returns the table ID.
+func (Table0038) TableID() string { return `0038` }
+
+// Valid reports if the value is in the table.
+func (v Table0038) Valid() bool { return TableValueLookup[`0038`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0038) Description() string { return tableDescription(`0038`, string(v)) }
+
+// Table0042 is a value of table 0042, INS. COMPANY PLAN CODE.
+type Table0042 string
+
+// TableID returns the table ID.
+func (Table0042) TableID() string { return `0042` }
+
+// Valid reports if the value is in the table.
+func (v Table0042) Valid() bool { return TableValueLookup[`0042`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0042) Description() string { return tableDescription(`0042`, string(v)) }
+
+// Table0043 is a value of table 0043, CONDITION CODE.
+type Table0043 string
+
+// TableID returns the table ID.
+func (Table0043) TableID() string { return `0043` }
+
+// Valid reports if the value is in the table.
+func (v Table0043) Valid() bool { return TableValueLookup[`0043`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0043) Description() string { return tableDescription(`0043`, string(v)) }
+
+// Table0044 is a value of table 0044, CONTRACT CODE.
+type Table0044 string
+
+// TableID returns the table ID.
+func (Table0044) TableID() string { return `0044` }
+
+// Valid reports if the value is in the table.
+func (v Table0044) Valid() bool { return TableValueLookup[`0044`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0044) Description() string { return tableDescription(`0044`, string(v)) }
+
+// Table0045 is a value of table 0045, COURTESY CODE.
+type Table0045 string
+
+// TableID returns the table ID.
+func (Table0045) TableID() string { return `0045` }
+
+// Valid reports if the value is in the table.
+func (v Table0045) Valid() bool { return TableValueLookup[`0045`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0045) Description() string { return tableDescription(`0045`, string(v)) }
+
+// Table0046 is a value of table 0046, CREDIT RATING.
+type Table0046 string
+
+// TableID returns the table ID.
+func (Table0046) TableID() string { return `0046` }
+
+// Valid reports if the value is in the table.
+func (v Table0046) Valid() bool { return TableValueLookup[`0046`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0046) Description() string { return tableDescription(`0046`, string(v)) }
+
+// Table0048 is a value of table 0048, WHAT SUBJECT FILTER.
+type Table0048 string
+
+const (
+	Table0048_ADV Table0048 = `ADV` // Advice / diagnosis
+	Table0048_ANU Table0048 = `ANU` // Nursing unit lookup (returns patients in beds, excluding empty beds)
+	Table0048_APA Table0048 = `APA` // Account number query, return matching visit
+	Table0048_APM Table0048 = `APM` // Medical record number query, returns visits for a medical record number
+	Table0048_APN Table0048 = `APN` // Patient name lookup
+	Table0048_APP Table0048 = `APP` // Physician lookup
+	Table0048_ARN Table0048 = `ARN` // Nursing unit lookup (returns patients in beds, including empty beds)
+	Table0048_CAN Table0048 = `CAN` // Cancel (used to cancel a query)
+	Table0048_DEM Table0048 = `DEM` // Demographics
+	Table0048_FIN Table0048 = `FIN` // Financial
+	Table0048_MFQ Table0048 = `MFQ` // Master file query
+	Table0048_MRI Table0048 = `MRI` // Most recent inpatient
+	Table0048_MRO Table0048 = `MRO` // Most recent outpatient
+	Table0048_NCK Table0048 = `NCK` // Network clock
+	Table0048_NSC Table0048 = `NSC` // Network status change
+	Table0048_NST Table0048 = `NST` // Network statistic
+	Table0048_ORD Table0048 = `ORD` // Order
+	Table0048_OTH Table0048 = `OTH` // Other
+	Table0048_PRO Table0048 = `PRO` // Procedure
+	Table0048_RAR Table0048 = `RAR` // Pharmacy administration information
+	Table0048_RDR Table0048 = `RDR` // Pharmacy dispense information
+	Table0048_RER Table0048 = `RER` // Pharmacy encoded order information
+	Table0048_RES Table0048 = `RES` // Result
+	Table0048_RGR Table0048 = `RGR` // Pharmacy give information
+	Table0048_ROR Table0048 = `ROR` // Pharmacy prescription information
+	Table0048_STA Table0048 = `STA` // Status
+)
+
+// TableID returns the table ID.
+func (Table0048) TableID() string { return `0048` }
+
+// Valid reports if the value is in the table.
+func (v Table0048) Valid() bool { return TableValueLookup[`0048`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0048) Description() string { return tableDescription(`0048`, string(v)) }
+
+// Table0049 is a value of table 0049, DEPARTMENT CODE.
+type Table0049 string
+
+// TableID returns the table ID.
+func (Table0049) TableID() string { return `0049` }
+
+// Valid reports if the value is in the table.
+func (v Table0049) Valid() bool { return TableValueLookup[`0049`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0049) Description() string { return tableDescription(`0049`, string(v)) }
+
+// Table0050 is a value of table 0050, ACCIDENT CODE.
+type Table0050 string
+
+// TableID returns the table ID.
+func (Table0050) TableID() string { return `0050` }
+
+// Valid reports if the value is in the table.
+func (v Table0050) Valid() bool { return TableValueLookup[`0050`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0050) Description() string { return tableDescription(`0050`, string(v)) }
+
+// Table0051 is a value of table 0051, DIAGNOSIS CODE.
+type Table0051 string
+
+// TableID returns the table ID.
+func (Table0051) TableID() string { return `0051` }
+
+// Valid reports if the value is in the table.
+func (v Table0051) Valid() bool { return TableValueLookup[`0051`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0051) Description() string { return tableDescription(`0051`, string(v)) }
+
+// Table0052 is a value of table 0052, DIAGNOSIS TYPE.
+type Table0052 string
+
+// TableID returns the table ID.
+func (Table0052) TableID() string { return `0052` }
+
+// Valid reports if the value is in the table.
+func (v Table0052) Valid() bool { return TableValueLookup[`0052`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0052) Description() string { return tableDescription(`0052`, string(v)) }
+
+// Table0053 is a value of table 0053, DIAGNOSIS CODING METHOD.
+type Table0053 string
+
+const (
+	Table0053_I9 Table0053 = `I9` // ICD9
+)
+
+// TableID returns the table ID.
+func (Table0053) TableID() string { return `0053` }
+
+// Valid reports if the value is in the table.
+func (v Table0053) Valid() bool { return TableValueLookup[`0053`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0053) Description() string { return tableDescription(`0053`, string(v)) }
+
+// Table0055 is a value of table 0055, DRG CODE.
+type Table0055 string
+
+// TableID returns the table ID.
+func (Table0055) TableID() string { return `0055` }
+
+// Valid reports if the value is in the table.
+func (v Table0055) Valid() bool { return TableValueLookup[`0055`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0055) Description() string { return tableDescription(`0055`, string(v)) }
+
+// Table0056 is a value of table 0056, DRG GROUPER REVIEW CODE.
+type Table0056 string
+
+// TableID returns the table ID.
+func (Table0056) TableID() string { return `0056` }
+
+// Valid reports if the value is in the table.
+func (v Table0056) Valid() bool { return TableValueLookup[`0056`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0056) Description() string { return tableDescription(`0056`, string(v)) }
+
+// Table0059 is a value of table 0059, CONSENT CODE.
+type Table0059 string
+
+// TableID returns the table ID.
+func (Table0059) TableID() string { return `0059` }
+
+// Valid reports if the value is in the table.
+func (v Table0059) Valid() bool { return TableValueLookup[`0059`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0059) Description() string { return tableDescription(`0059`, string(v)) }
+
+// Table0060 is a value of table 0060, ERROR CODE.
+type Table0060 string
+
+// TableID returns the table ID.
+func (Table0060) TableID() string { return `0060` }
+
+// Valid reports if the value is in the table.
+func (v Table0060) Valid() bool { return TableValueLookup[`0060`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0060) Description() string { return tableDescription(`0060`, string(v)) }
+
+// Table0061 is a value of table 0061, CHECK DIGIT SCHEME.
+type Table0061 string
+
+const (
+	Table0061_M10 Table0061 = `M10` // Mod 10 algorithm
+	Table0061_M11 Table0061 = `M11` // Mod 11 algorithm
+)
+
+// TableID returns the table ID.
+func (Table0061) TableID() string { return `0061` }
+
+// Valid reports if the value is in the table.
+func (v Table0061) Valid() bool { return TableValueLookup[`0061`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0061) Description() string { return tableDescription(`0061`, string(v)) }
+
+// Table0062 is a value of table 0062, EVENT REASON.
+type Table0062 string
+
+const (
+	Table0062_01 Table0062 = `01` // Patient request
+	Table0062_02 Table0062 = `02` // Physician order
+	Table0062_03 Table0062 = `03` // Census management
+)
+
+// TableID returns the table ID.
+func (Table0062) TableID() string { return `0062` }
+
+// Valid reports if the value is in the table.
+func (v Table0062) Valid() bool { return TableValueLookup[`0062`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0062) Description() string { return tableDescription(`0062`, string(v)) }
+
+// Table0063 is a value of table 0063, RELATIONSHIP.
+type Table0063 string
+
+// TableID returns the table ID.
+func (Table0063) TableID() string { return `0063` }
+
+// Valid reports if the value is in the table.
+func (v Table0063) Valid() bool { return TableValueLookup[`0063`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0063) Description() string { return tableDescription(`0063`, string(v)) }
+
+// Table0064 is a value of table 0064, FINANCIAL CLASS.
+type Table0064 string
+
+// TableID returns the table ID.
+func (Table0064) TableID() string { return `0064` }
+
+// Valid reports if the value is in the table.
+func (v Table0064) Valid() bool { return TableValueLookup[`0064`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0064) Description() string { return tableDescription(`0064`, string(v)) }
+
+// Table0065 is a value of table 0065, ACTION CODE.
+type Table0065 string
+
+const (
+	Table0065_A Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065_G Table0065 = `G` // Generated order / reflex order
+	Table0065_L Table0065 = `L` // Lab to obtain specimen from patient
+	Table0065_O Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065_P Table0065 = `P` // Pending specimen - order sent prior to delivery
+	Table0065_R Table0065 = `R` // Revised order
+	Table0065_S Table0065 = `S` // Schedule the tests specified below
+)
+
+// TableID returns the table ID.
+func (Table0065) TableID() string { return `0065` }
+
+// Valid reports if the value is in the table.
+func (v Table0065) Valid() bool { return TableValueLookup[`0065`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0065) Description() string { return tableDescription(`0065`, string(v)) }
+
+// Table0066 is a value of table 0066, EMPLOYMENT STATUS.
+type Table0066 string
+
+// TableID returns the table ID.
+func (Table0066) TableID() string { return `0066` }
+
+// Valid reports if the value is in the table.
+func (v Table0066) Valid() bool { return TableValueLookup[`0066`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0066) Description() string { return tableDescription(`0066`, string(v)) }
+
+// Table0068 is a value of table 0068, GUARANTOR TYPE.
+type Table0068 string
+
+// TableID returns the table ID.
+func (Table0068) TableID() string { return `0068` }
+
+// Valid reports if the value is in the table.
+func (v Table0068) Valid() bool { return TableValueLookup[`0068`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0068) Description() string { return tableDescription(`0068`, string(v)) }
+
+// Table0069 is a value of table 0069, HOSPITAL SERVICE.
+type Table0069 string
+
+// TableID returns the table ID.
+func (Table0069) TableID() string { return `0069` }
+
+// Valid reports if the value is in the table.
+func (v Table0069) Valid() bool { return TableValueLookup[`0069`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0069) Description() string { return tableDescription(`0069`, string(v)) }
+
+// Table0070 is a value of table 0070, SOURCE OF SPECIMEN.
+type Table0070 string
+
+const (
+	Table0070_ABLD  Table0070 = `ABLD`  // Arterial blood
+	Table0070_ABS   Table0070 = `ABS`   // Abcess
+	Table0070_AMN   Table0070 = `AMN`   // Amniotic fluid
+	Table0070_ASP   Table0070 = `ASP`   // Aspirate
+	Table0070_BBL   Table0070 = `BBL`   // Blood bag
+	Table0070_BDY   Table0070 = `BDY`   // Whole body
+	Table0070_BLD   Table0070 = `BLD`   // Whole blood
+	Table0070_BON   Table0070 = `BON`   // Bone
+	Table0070_BPH   Table0070 = `BPH`   // Basophils
+	Table0070_BRN   Table0070 = `BRN`   // Burn
+	Table0070_BRO   Table0070 = `BRO`   // Bronchial
+	Table0070_BRTH  Table0070 = `BRTH`  // Breath (use EXHLD)
+	Table0070_CALC  Table0070 = `CALC`  // Calculus (=Stone)
+	Table0070_CBLD  Table0070 = `CBLD`  // Cord blood
+	Table0070_CDM   Table0070 = `CDM`   // Cardiac muscle
+	Table0070_CNJT  Table0070 = `CNJT`  // Conjunctiva
+	Table0070_CNL   Table0070 = `CNL`   // Cannula
+	Table0070_COL   Table0070 = `COL`   // Colostrum
+	Table0070_CSF   Table0070 = `CSF`   // Cerebral spinal fluid
+	Table0070_CTP   Table0070 = `CTP`   // Catheter tip
+	Table0070_CUR   Table0070 = `CUR`   // Curettage
+	Table0070_CVM   Table0070 = `CVM`   // Cervical mucus
+	Table0070_CVX   Table0070 = `CVX`   // Cervix
+	Table0070_CYST  Table0070 = `CYST`  // Cyst
+	Table0070_DRN   Table0070 = `DRN`   // Drain
+	Table0070_EAR   Table0070 = `EAR`   // Ear
+	Table0070_ELT   Table0070 = `ELT`   // Electrode
+	Table0070_ENDC  Table0070 = `ENDC`  // Endocardium
+	Table0070_ENDM  Table0070 = `ENDM`  // Endometrium
+	Table0070_EOS   Table0070 = `EOS`   // Eosinophils
+	Table0070_FIB   Table0070 = `FIB`   // Fibroblasts
+	Table0070_FIST  Table0070 = `FIST`  // Fistula
+	Table0070_FLT   Table0070 = `FLT`   // Filter
+	Table0070_FLU   Table0070 = `FLU`   // Body fluid, unsp
+	Table0070_GAST  Table0070 = `GAST`  // Gastric fluid/contents
+	Table0070_GEN   Table0070 = `GEN`   // Genital
+	Table0070_GENC  Table0070 = `GENC`  // Genital cervix
+	Table0070_GENL  Table0070 = `GENL`  // Genital lochia
+	Table0070_GENV  Table0070 = `GENV`  // Genital vaginal
+	Table0070_HAR   Table0070 = `HAR`   // Hair
+	Table0070_IT    Table0070 = `IT`    // Intubation tube
+	Table0070_LAM   Table0070 = `LAM`   // Lamella
+	Table0070_LN    Table0070 = `LN`    // Line
+	Table0070_LNA   Table0070 = `LNA`   // Line arterial
+	Table0070_LNV   Table0070 = `LNV`   // Line venous
+	Table0070_LYM   Table0070 = `LYM`   // Lymphocytes
+	Table0070_MAC   Table0070 = `MAC`   // Macrophages
+	Table0070_MAR   Table0070 = `MAR`   // Marrow
+	Table0070_MBLD  Table0070 = `MBLD`  // Menstrual blood
+	Table0070_MEC   Table0070 = `MEC`   // Meconium
+	Table0070_MILK  Table0070 = `MILK`  // Breast milk
+	Table0070_MLK   Table0070 = `MLK`   // Milk
+	Table0070_NAIL  Table0070 = `NAIL`  // Nail
+	Table0070_NOS   Table0070 = `NOS`   // Nose (nasal passage)
+	Table0070_ORH   Table0070 = `ORH`   // Other
+	Table0070_PER   Table0070 = `PER`   // Peritoneum
+	Table0070_PLAS  Table0070 = `PLAS`  // Plasma
+	Table0070_PLB   Table0070 = `PLB`   // Plasma bag
+	Table0070_PLC   Table0070 = `PLC`   // Placenta
+	Table0070_PLR   Table0070 = `PLR`   // Pleural fluid (thoracentesis fld)
+	Table0070_PMN   Table0070 = `PMN`   // Polymorphonuclear neutrophils
+	Table0070_PRT   Table0070 = `PRT`   // Peritoneal fluid / ascites
+	Table0070_PUS   Table0070 = `PUS`   // Pus
+	Table0070_RBC   Table0070 = `RBC`   // Erythrocytes
+	Table0070_SAL   Table0070 = `SAL`   // Saliva
+	Table0070_SEM   Table0070 = `SEM`   // Seminal fluid
+	Table0070_SER   Table0070 = `SER`   // Serum
+	Table0070_SKM   Table0070 = `SKM`   // Skeletal muscle
+	Table0070_SKN   Table0070 = `SKN`   // Skin
+	Table0070_SNV   Table0070 = `SNV`   // Synovial fluid (Joint fluid)
+	Table0070_SPRM  Table0070 = `SPRM`  // Spermatozoa
+	Table0070_SPT   Table0070 = `SPT`   // Sputum
+	Table0070_SPTC  Table0070 = `SPTC`  // Sputum - coughed
+	Table0070_SPTT  Table0070 = `SPTT`  // Sputum - tracheal aspirate
+	Table0070_STL   Table0070 = `STL`   // Stool
+	Table0070_STON  Table0070 = `STON`  // Stone (use CALC)
+	Table0070_SWT   Table0070 = `SWT`   // Sweat
+	Table0070_TEAR  Table0070 = `TEAR`  // Tears
+	Table0070_THRB  Table0070 = `THRB`  // Thrombocyte (platelet)
+	Table0070_THRT  Table0070 = `THRT`  // Throat
+	Table0070_TISB  Table0070 = `TISB`  // Tissue bone marrow
+	Table0070_TISC  Table0070 = `TISC`  // Tissue curettage
+	Table0070_TISG  Table0070 = `TISG`  // Tissue gall bladder
+	Table0070_TISL  Table0070 = `TISL`  // Tissue lung
+	Table0070_TISP  Table0070 = `TISP`  // Tissue peritoneum
+	Table0070_TISPL Table0070 = `TISPL` // Tissue placenta
+	Table0070_TISS  Table0070 = `TISS`  // Tissue
+	Table0070_TISU  Table0070 = `TISU`  // Tissue ulcer
+	Table0070_ULC   Table0070 = `ULC`   // Ulcer
+	Table0070_UMB   Table0070 = `UMB`   // Umbilical Blood
+	Table0070_UR    Table0070 = `UR`    // Urine
+	Table0070_URC   Table0070 = `URC`   // Urine clean catch
+	Table0070_URT   Table0070 = `URT`   // Urine catheter
+	Table0070_URTH  Table0070 = `URTH`  // Urethra
+	Table0070_VOM   Table0070 = `VOM`   // Vomitus
+	Table0070_WBC   Table0070 = `WBC`   // Leukocytes
+	Table0070_WICK  Table0070 = `WICK`  // Wick
+	Table0070_WND   Table0070 = `WND`   // Wound
+	Table0070_WNDA  Table0070 = `WNDA`  // Wound abscess
+	Table0070_WNDD  Table0070 = `WNDD`  // Wound drainage
+	Table0070_WNDE  Table0070 = `WNDE`  // Wound exudate
+)
+
+// TableID returns the table ID.
+func (Table0070) TableID() string { return `0070` }
+
+// Valid reports if the value is in the table.
+func (v Table0070) Valid() bool { return TableValueLookup[`0070`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0070) Description() string { return tableDescription(`0070`, string(v)) }
+
+// Table0072 is a value of table 0072, INS. PLAN ID.
+type Table0072 string
+
+// TableID returns the table ID.
+func (Table0072) TableID() string { return `0072` }
+
+// Valid reports if the value is in the table.
+func (v Table0072) Valid() bool { return TableValueLookup[`0072`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0072) Description() string { return tableDescription(`0072`, string(v)) }
+
+// Table0073 is a value of table 0073, INTEREST RATE CODE.
+type Table0073 string
+
+// TableID returns the table ID.
+func (Table0073) TableID() string { return `0073` }
+
+// Valid reports if the value is in the table.
+func (v Table0073) Valid() bool { return TableValueLookup[`0073`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0073) Description() string { return tableDescription(`0073`, string(v)) }
+
+// Table0074 is a value of table 0074, DIAGNOSTIC SERVICE SECTION ID.
+type Table0074 string
+
+const (
+	Table0074_AU  Table0074 = `AU`  // Audiology
+	Table0074_BG  Table0074 = `BG`  // Blood gases
+	Table0074_BLB Table0074 = `BLB` // Blood bank
+	Table0074_CH  Table0074 = `CH`  // Chemistry
+	Table0074_CP  Table0074 = `CP`  // Cytopathology
+	Table0074_CT  Table0074 = `CT`  // CAT scan
+	Table0074_CTH Table0074 = `CTH` // Cardiac catheterization
+	Table0074_CUS Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074_EC  Table0074 = `EC`  // Electrocardiac (e.g., EKG, EEC, Holter)
+	Table0074_EN  Table0074 = `EN`  // Electroneuro (EEG, EMG)
+	Table0074_HM  Table0074 = `HM`  // Hematology
+	Table0074_IMM Table0074 = `IMM` // Immunology
+	Table0074_MB  Table0074 = `MB`  // Microbiology
+	Table0074_MCB Table0074 = `MCB` // Mycobacteriology
+	Table0074_MYC Table0074 = `MYC` // Mycology
+	Table0074_NMR Table0074 = `NMR` // Nuclear magnetic resonance
+	Table0074_NMS Table0074 = `NMS` // Nuclear medicine scan
+	Table0074_NRS Table0074 = `NRS` // Nursing service measures
+	Table0074_OSL Table0074 = `OSL` // Outside Lab
+	Table0074_OT  Table0074 = `OT`  // Occupational therapy
+	Table0074_OTH Table0074 = `OTH` // Other
+	Table0074_OUS Table0074 = `OUS` // OB Ultrasound
+	Table0074_PF  Table0074 = `PF`  // Pulmonary function
+	Table0074_PHR Table0074 = `PHR` // Pharmacy
+	Table0074_PHY Table0074 = `PHY` // Physician (Hx, Dx, admission note, etc.)
+	Table0074_PT  Table0074 = `PT`  // Physical therapy
+	Table0074_RC  Table0074 = `RC`  // Respiratory care (therapy)
+	Table0074_RT  Table0074 = `RT`  // Radiation therapy
+	Table0074_RUS Table0074 = `RUS` // Radiology ultrasound
+	Table0074_RX  Table0074 = `RX`  // Radiograph
+	Table0074_SP  Table0074 = `SP`  // Surgical Pathology
+	Table0074_SR  Table0074 = `SR`  // Serology
+	Table0074_TX  Table0074 = `TX`  // Toxicology
+	Table0074_VR  Table0074 = `VR`  // Virology
+	Table0074_VUS Table0074 = `VUS` // Vascular Ultrasound
+	Table0074_XRC Table0074 = `XRC` // Cineradiograph
+)
+
+// TableID returns the table ID.
+func (Table0074) TableID() string { return `0074` }
+
+// Valid reports if the value is in the table.
+func (v Table0074) Valid() bool { return TableValueLookup[`0074`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0074) Description() string { return tableDescription(`0074`, string(v)) }
+
+// Table0076 is a value of table 0076, MESSAGE TYPE.
+type Table0076 string
+
+const (
+	Table0076_ACK Table0076 = `ACK` // General acknowledgement message
+	Table0076_ADR Table0076 = `ADR` // ADT response
+	Table0076_ADT Table0076 = `ADT` // ADT message
+	Table0076_ARD Table0076 = `ARD` // Ancillary report (display)
+	Table0076_BAR Table0076 = `BAR` // Add / change billing account
+	Table0076_DFT Table0076 = `DFT` // Detail financial transaction
+	Table0076_DSR Table0076 = `DSR` // Display response
+	Table0076_MCF Table0076 = `MCF` // Delayed acknowledgement
+	Table0076_MFD Table0076 = `MFD` // Master files delayed application acknowledgement
+	Table0076_MFK Table0076 = `MFK` // Master file application acknowledgement
+	Table0076_MFN Table0076 = `MFN` // Master files notification
+	Table0076_MFR Table0076 = `MFR` // Master files response
+	Table0076_NMD Table0076 = `NMD` // Network management data
+	Table0076_NMQ Table0076 = `NMQ` // Network management query
+	Table0076_NMR Table0076 = `NMR` // Network management response
+	Table0076_ORF Table0076 = `ORF` // Observational result (record response)
+	Table0076_ORM Table0076 = `ORM` // Order message
+	Table0076_ORR Table0076 = `ORR` // Order acknowledgement message
+	Table0076_ORU Table0076 = `ORU` // Observational result (unsolicited)
+	Table0076_OSQ Table0076 = `OSQ` // Order status query
+	Table0076_QRY Table0076 = `QRY` // Query
+	Table0076_RAR Table0076 = `RAR` // Pharmacy administration information
+	Table0076_RAS Table0076 = `RAS` // Pharmacy administration message
+	Table0076_RDE Table0076 = `RDE` // Pharmacy encoded order message
+	Table0076_RDR Table0076 = `RDR` // Pharmacy dispense information
+	Table0076_RDS Table0076 = `RDS` // Pharmacy dispense message
+	Table0076_RER Table0076 = `RER` // Pharmacy encoded order information
+	Table0076_RGR Table0076 = `RGR` // Pharmacy dose information
+	Table0076_RGV Table0076 = `RGV` // Pharmacy give message
+	Table0076_ROR Table0076 = `ROR` // Pharmacy prescription order response
+	Table0076_RRA Table0076 = `RRA` // Pharmacy administration acknowledgment
+	Table0076_RRD Table0076 = `RRD` // Pharmacy dispense acknowledgment
+	Table0076_RRE Table0076 = `RRE` // Pharmacy encoded order acknowledgment
+	Table0076_RRG Table0076 = `RRG` // Pharmacy give acknowledgment
+	Table0076_UDM Table0076 = `UDM` // Unsolicited display message
+)
+
+// TableID returns the table ID.
+func (Table0076) TableID() string { return `0076` }
+
+// Valid reports if the value is in the table.
+func (v Table0076) Valid() bool { return TableValueLookup[`0076`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0076) Description() string { return tableDescription(`0076`, string(v)) }
+
+// Table0078 is a value of table 0078, ABNORMAL FLAGS.
+type Table0078 string
+
+const (
+	Table0078_A    Table0078 = `A`    // Abnormal (applies to non-numeric results)
+	Table0078_AA   Table0078 = `AA`   // Very abnormal (applies to non-numeric units, analogous to panic limits for numerics limits)
+	Table0078_B    Table0078 = `B`    // Better (use when direction not relevant)
+	Table0078_D    Table0078 = `D`    // Significant change down
+	Table0078_H    Table0078 = `H`    // Above high normal
+	Table0078_HH   Table0078 = `HH`   // Above upper panic limits
+	Table0078_I    Table0078 = `I`    // Intermediate
+	Table0078_L    Table0078 = `L`    // Below low normal
+	Table0078_LL   Table0078 = `LL`   // Below lower panic limits
+	Table0078_MS   Table0078 = `MS`   // Moderately sensitive
+	Table0078_N    Table0078 = `N`    // Normal (applies to non-numeric results)
+	Table0078_null Table0078 = `null` // No range defined, or normal ranges don't apply
+	Table0078_R    Table0078 = `R`    // Resistant
+	Table0078_S    Table0078 = `S`    // Sensitive
+	Table0078_U    Table0078 = `U`    // Significant change up
+	Table0078_VS   Table0078 = `VS`   // Very sensitive
+	Table0078_W    Table0078 = `W`    // Worse (use when direction not relevant)
+)
+
+// TableID returns the table ID.
+func (Table0078) TableID() string { return `0078` }
+
+// Valid reports if the value is in the table.
+func (v Table0078) Valid() bool { return TableValueLookup[`0078`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0078) Description() string { return tableDescription(`0078`, string(v)) }
+
+// Table0079 is a value of table 0079, LOCATION.
+type Table0079 string
+
+// TableID returns the table ID.
+func (Table0079) TableID() string { return `0079` }
+
+// Valid reports if the value is in the table.
+func (v Table0079) Valid() bool { return TableValueLookup[`0079`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0079) Description() string { return tableDescription(`0079`, string(v)) }
+
+// Table0080 is a value of table 0080, NATURE OF ABNORMAL TESTING.
+type Table0080 string
+
+const (
+	Table0080_A Table0080 = `A` // An age-based population
+	Table0080_N Table0080 = `N` // None - generic normal range
+	Table0080_R Table0080 = `R` // A race-based population
+	Table0080_S Table0080 = `S` // A sex-based population
+)
+
+// TableID returns the table ID.
+func (Table0080) TableID() string { return `0080` }
+
+// Valid reports if the value is in the table.
+func (v Table0080) Valid() bool { return TableValueLookup[`0080`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0080) Description() string { return tableDescription(`0080`, string(v)) }
+
+// Table0083 is a value of table 0083, OUTLIER TYPE.
+type Table0083 string
+
+// TableID returns the table ID.
+func (Table0083) TableID() string { return `0083` }
+
+// Valid reports if the value is in the table.
+func (v Table0083) Valid() bool { return TableValueLookup[`0083`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0083) Description() string { return tableDescription(`0083`, string(v)) }
+
+// Table0084 is a value of table 0084, PERFORMED BY CODE.
+type Table0084 string
+
+// TableID returns the table ID.
+func (Table0084) TableID() string { return `0084` }
+
+// Valid reports if the value is in the table.
+func (v Table0084) Valid() bool { return TableValueLookup[`0084`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0084) Description() string { return tableDescription(`0084`, string(v)) }
+
+// Table0085 is a value of table 0085, OBSERVATION RESULT STATUS CODES INTERPRETATION.
+type Table0085 string
+
+const (
+	Table0085_C Table0085 = `C` // Record coming over is a correction and thus replaces a result
+	Table0085_D Table0085 = `D` // Deletes the OBX record
+	Table0085_F Table0085 = `F` // Final results (can only be changed with a corrected result)
+	Table0085_I Table0085 = `I` // Specimen in lab - results pending
+	Table0085_P Table0085 = `P` // Preliminary results
+	Table0085_R Table0085 = `R` // Results entered - not verified
+	Table0085_S Table0085 = `S` // Partial results
+	Table0085_U Table0085 = `U` // Results status change to Final - results did not change ( don't transmit test)
+	Table0085_X Table0085 = `X` // Results cannot be obtained for this observation
+)
+
+// TableID returns the table ID.
+func (Table0085) TableID() string { return `0085` }
+
+// Valid reports if the value is in the table.
+func (v Table0085) Valid() bool { return TableValueLookup[`0085`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0085) Description() string { return tableDescription(`0085`, string(v)) }
+
+// Table0086 is a value of table 0086, INS. PLAN TYPE.
+type Table0086 string
+
+// TableID returns the table ID.
+func (Table0086) TableID() string { return `0086` }
+
+// Valid reports if the value is in the table.
+func (v Table0086) Valid() bool { return TableValueLookup[`0086`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0086) Description() string { return tableDescription(`0086`, string(v)) }
+
+// Table0087 is a value of table 0087, PRE-ADMIT TESTING.
+type Table0087 string
+
+// TableID returns the table ID.
+func (Table0087) TableID() string { return `0087` }
+
+// Valid reports if the value is in the table.
+func (v Table0087) Valid() bool { return TableValueLookup[`0087`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0087) Description() string { return tableDescription(`0087`, string(v)) }
+
+// Table0088 is a value of table 0088, PROCEDURE CODE.
+type Table0088 string
+
+// TableID returns the table ID.
+func (Table0088) TableID() string { return `0088` }
+
+// Valid reports if the value is in the table.
+func (v Table0088) Valid() bool { return TableValueLookup[`0088`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0088) Description() string { return tableDescription(`0088`, string(v)) }
+
+// Table0089 is a value of table 0089, PROCEDURE CODING METHOD.
+type Table0089 string
+
+// TableID returns the table ID.
+func (Table0089) TableID() string { return `0089` }
+
+// Valid reports if the value is in the table.
+func (v Table0089) Valid() bool { return TableValueLookup[`0089`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0089) Description() string { return tableDescription(`0089`, string(v)) }
+
+// Table0090 is a value of table 0090, PROCEDURE TYPE.
+type Table0090 string
+
+// TableID returns the table ID.
+func (Table0090) TableID() string { return `0090` }
+
+// Valid reports if the value is in the table.
+func (v Table0090) Valid() bool { return TableValueLookup[`0090`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0090) Description() string { return tableDescription(`0090`, string(v)) }
+
+// Table0091 is a value of table 0091, QUERY PRIORITY.
+type Table0091 string
+
+const (
+	Table0091_D Table0091 = `D` // Deferred
+	Table0091_I Table0091 = `I` // Immediate
+)
+
+// TableID returns the table ID.
+func (Table0091) TableID() string { return `0091` }
+
+// Valid reports if the value is in the table.
+func (v Table0091) Valid() bool { return TableValueLookup[`0091`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0091) Description() string { return tableDescription(`0091`, string(v)) }
+
+// Table0092 is a value of table 0092, RE-ADMISSION INDICATOR.
+type Table0092 string
+
+const (
+	Table0092_R Table0092 = `R` // Readmission
+)
+
+// TableID returns the table ID.
+func (Table0092) TableID() string { return `0092` }
+
+// Valid reports if the value is in the table.
+func (v Table0092) Valid() bool { return TableValueLookup[`0092`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0092) Description() string { return tableDescription(`0092`, string(v)) }
+
+// Table0093 is a value of table 0093, RELEASE OF INFORMATION.
+type Table0093 string
+
+const (
+	Table0093_N Table0093 = `N` // No
+	Table0093_Y Table0093 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0093) TableID() string { return `0093` }
+
+// Valid reports if the value is in the table.
+func (v Table0093) Valid() bool { return TableValueLookup[`0093`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0093) Description() string { return tableDescription(`0093`, string(v)) }
+
+// Table0098 is a value of table 0098, TYPE OF AGREEMENT CODE.
+type Table0098 string
+
+// TableID returns the table ID.
+func (Table0098) TableID() string { return `0098` }
+
+// Valid reports if the value is in the table.
+func (v Table0098) Valid() bool { return TableValueLookup[`0098`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0098) Description() string { return tableDescription(`0098`, string(v)) }
+
+// Table0099 is a value of table 0099, VIP INDICATOR.
+type Table0099 string
+
+// TableID returns the table ID.
+func (Table0099) TableID() string { return `0099` }
+
+// Valid reports if the value is in the table.
+func (v Table0099) Valid() bool { return TableValueLookup[`0099`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0099) Description() string { return tableDescription(`0099`, string(v)) }
+
+// Table0100 is a value of table 0100, WHEN TO CHARGE.
+type Table0100 string
+
+const (
+	Table0100_D Table0100 = `D` // On discharge
+	Table0100_O Table0100 = `O` // On receipt of order
+	Table0100_R Table0100 = `R` // At time service is completed
+	Table0100_S Table0100 = `S` // At time service is started
+	Table0100_T Table0100 = `T` // At a designated date / time
+)
+
+// TableID returns the table ID.
+func (Table0100) TableID() string { return `0100` }
+
+// Valid reports if the value is in the table.
+func (v Table0100) Valid() bool { return TableValueLookup[`0100`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0100) Description() string { return tableDescription(`0100`, string(v)) }
+
+// Table0102 is a value of table 0102, DELAYED ACKNOWLEDGMENT TYPE.
+type Table0102 string
+
+const (
+	Table0102_D Table0102 = `D` // Message Received, stored for later processing
+	Table0102_F Table0102 = `F` // Acknowledgement after processing
+)
+
+// TableID returns the table ID.
+func (Table0102) TableID() string { return `0102` }
+
+// Valid reports if the value is in the table.
+func (v Table0102) Valid() bool { return TableValueLookup[`0102`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0102) Description() string { return tableDescription(`0102`, string(v)) }
+
+// Table0103 is a value of table 0103, PROCESSING ID.
+type Table0103 string
+
+const (
+	Table0103_D Table0103 = `D` // Debugging
+	Table0103_P Table0103 = `P` // Production
+	Table0103_T Table0103 = `T` // Training
+)
+
+// TableID returns the table ID.
+func (Table0103) TableID() string { return `0103` }
+
+// Valid reports if the value is in the table.
+func (v Table0103) Valid() bool { return TableValueLookup[`0103`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0103) Description() string { return tableDescription(`0103`, string(v)) }
+
+// Table0104 is a value of table 0104, VERSION ID.
+type Table0104 string
+
+const (
+	Table0104_2_0  Table0104 = `2.0`  // Version 2.0, September 1988
+	Table0104_2_0D Table0104 = `2.0D` // Demo 2.0 October 1988
+	Table0104_2_1  Table0104 = `2.1`  // Release 2.1 March 1990
+	Table0104_2_2  Table0104 = `2.2`  // Release 2.2 December 1994
+)
+
+// TableID returns the table ID.
+func (Table0104) TableID() string { return `0104` }
+
+// Valid reports if the value is in the table.
+func (v Table0104) Valid() bool { return TableValueLookup[`0104`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0104) Description() string { return tableDescription(`0104`, string(v)) }
+
+// Table0105 is a value of table 0105, SOURCE OF COMMENT.
+type Table0105 string
+
+const (
+	Table0105_L Table0105 = `L` // Ancillary (filler) department is source of comment
+	Table0105_O Table0105 = `O` // Other system is source of comment
+	Table0105_P Table0105 = `P` // Orderer (placer) is source of comment
+)
+
+// TableID returns the table ID.
+func (Table0105) TableID() string { return `0105` }
+
+// Valid reports if the value is in the table.
+func (v Table0105) Valid() bool { return TableValueLookup[`0105`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0105) Description() string { return tableDescription(`0105`, string(v)) }
+
+// Table0106 is a value of table 0106, QUERY FORMAT CODE.
+type Table0106 string
+
+const (
+	Table0106_D Table0106 = `D` // Response is in display format
+	Table0106_R Table0106 = `R` // Response is in record-oriented format
+)
+
+// TableID returns the table ID.
+func (Table0106) TableID() string { return `0106` }
+
+// Valid reports if the value is in the table.
+func (v Table0106) Valid() bool { return TableValueLookup[`0106`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0106) Description() string { return tableDescription(`0106`, string(v)) }
+
+// Table0107 is a value of table 0107, DEFERRED RESPONSE TYPE.
+type Table0107 string
+
+const (
+	Table0107_B Table0107 = `B` // Before the date / time specified
+	Table0107_L Table0107 = `L` // Later than the date / time specified
+)
+
+// TableID returns the table ID.
+func (Table0107) TableID() string { return `0107` }
+
+// Valid reports if the value is in the table.
+func (v Table0107) Valid() bool { return TableValueLookup[`0107`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0107) Description() string { return tableDescription(`0107`, string(v)) }
+
+// Table0108 is a value of table 0108, QUERY RESULTS LEVEL.
+type Table0108 string
+
+const (
+	Table0108_O Table0108 = `O` // Order plus order status
+	Table0108_R Table0108 = `R` // Results without bulk text
+	Table0108_S Table0108 = `S` // Status only
+	Table0108_T Table0108 = `T` // Full results
+)
+
+// TableID returns the table ID.
+func (Table0108) TableID() string { return `0108` }
+
+// Valid reports if the value is in the table.
+func (v Table0108) Valid() bool { return TableValueLookup[`0108`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0108) Description() string { return tableDescription(`0108`, string(v)) }
+
+// Table0109 is a value of table 0109, REPORT PRIORITY.
+type Table0109 string
+
+const (
+	Table0109_R Table0109 = `R` // Routine
+	Table0109_S Table0109 = `S` // Stat
+)
+
+// TableID returns the table ID.
+func (Table0109) TableID() string { return `0109` }
+
+// Valid reports if the value is in the table.
+func (v Table0109) Valid() bool { return TableValueLookup[`0109`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0109) Description() string { return tableDescription(`0109`, string(v)) }
+
+// Table0110 is a value of table 0110, TRANSFER TO BAD DEBT CODE.
+type Table0110 string
+
+// TableID returns the table ID.
+func (Table0110) TableID() string { return `0110` }
+
+// Valid reports if the value is in the table.
+func (v Table0110) Valid() bool { return TableValueLookup[`0110`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0110) Description() string { return tableDescription(`0110`, string(v)) }
+
+// Table0111 is a value of table 0111, DELETE ACCOUNT CODE.
+type Table0111 string
+
+// TableID returns the table ID.
+func (Table0111) TableID() string { return `0111` }
+
+// Valid reports if the value is in the table.
+func (v Table0111) Valid() bool { return TableValueLookup[`0111`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0111) Description() string { return tableDescription(`0111`, string(v)) }
+
+// Table0112 is a value of table 0112, DISCHARGE DISPOSITION.
+type Table0112 string
+
+// TableID returns the table ID.
+func (Table0112) TableID() string { return `0112` }
+
+// Valid reports if the value is in the table.
+func (v Table0112) Valid() bool { return TableValueLookup[`0112`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0112) Description() string { return tableDescription(`0112`, string(v)) }
+
+// Table0113 is a value of table 0113, DISCHARGED TO LOCATION.
+type Table0113 string
+
+// TableID returns the table ID.
+func (Table0113) TableID() string { return `0113` }
+
+// Valid reports if the value is in the table.
+func (v Table0113) Valid() bool { return TableValueLookup[`0113`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0113) Description() string { return tableDescription(`0113`, string(v)) }
+
+// Table0114 is a value of table 0114, DIET TYPE.
+type Table0114 string
+
+// TableID returns the table ID.
+func (Table0114) TableID() string { return `0114` }
+
+// Valid reports if the value is in the table.
+func (v Table0114) Valid() bool { return TableValueLookup[`0114`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0114) Description() string { return tableDescription(`0114`, string(v)) }
+
+// Table0115 is a value of table 0115, SERVICING FACILITY.
+type Table0115 string
+
+// TableID returns the table ID.
+func (Table0115) TableID() string { return `0115` }
+
+// Valid reports if the value is in the table.
+func (v Table0115) Valid() bool { return TableValueLookup[`0115`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0115) Description() string { return tableDescription(`0115`, string(v)) }
+
+// Table0116 is a value of table 0116, BED STATUS.
+type Table0116 string
+
+const (
+	Table0116_C Table0116 = `C` // Closed
+	Table0116_H Table0116 = `H` // Housekeeping
+	Table0116_I Table0116 = `I` // Isolated
+	Table0116_K Table0116 = `K` // Contaminated
+	Table0116_O Table0116 = `O` // Occupied
+	Table0116_U Table0116 = `U` // Unoccupied
+)
+
+// TableID returns the table ID.
+func (Table0116) TableID() string { return `0116` }
+
+// Valid reports if the value is in the table.
+func (v Table0116) Valid() bool { return TableValueLookup[`0116`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0116) Description() string { return tableDescription(`0116`, string(v)) }
+
+// Table0117 is a value of table 0117, ACCOUNT STATUS.
+type Table0117 string
+
+// TableID returns the table ID.
+func (Table0117) TableID() string { return `0117` }
+
+// Valid reports if the value is in the table.
+func (v Table0117) Valid() bool { return TableValueLookup[`0117`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0117) Description() string { return tableDescription(`0117`, string(v)) }
+
+// Table0118 is a value of table 0118, MAJOR DIAGNOSTIC CATEGORY.
+type Table0118 string
+
+// TableID returns the table ID.
+func (Table0118) TableID() string { return `0118` }
+
+// Valid reports if the value is in the table.
+func (v Table0118) Valid() bool { return TableValueLookup[`0118`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0118) Description() string { return tableDescription(`0118`, string(v)) }
+
+// Table0119 is a value of table 0119, ORDER CONTROL.
+type Table0119 string
+
+const (
+	Table0119_CA Table0119 = `CA` // Cancel order request
+	Table0119_CH Table0119 = `CH` // Child order
+	Table0119_CN Table0119 = `CN` // Combined result
+	Table0119_CR Table0119 = `CR` // Canceled as requested
+	Table0119_DC Table0119 = `DC` // Discontinue order request
+	Table0119_DE Table0119 = `DE` // Data Errors
+	Table0119_DR Table0119 = `DR` // Discontinued as requested
+	Table0119_HD Table0119 = `HD` // Hold order request
+	Table0119_HR Table0119 = `HR` // On hold as requested
+	Table0119_NA Table0119 = `NA` // Number assigned
+	Table0119_NW Table0119 = `NW` // New Order
+	Table0119_OC Table0119 = `OC` // Order canceled
+	Table0119_OD Table0119 = `OD` // Order discontinued
+	Table0119_OH Table0119 = `OH` // Order held
+	Table0119_OK Table0119 = `OK` // Order accepted and OK
+	Table0119_OR Table0119 = `OR` // Released as requested
+	Table0119_PA Table0119 = `PA` // Parent order
+	Table0119_RE Table0119 = `RE` // Observations to follow
+	Table0119_RL Table0119 = `RL` // Release previous hold
+	Table0119_RO Table0119 = `RO` // Replacement order
+	Table0119_RP Table0119 = `RP` // Order replace request
+	Table0119_RQ Table0119 = `RQ` // Replaced as requested
+	Table0119_RR Table0119 = `RR` // Request received
+	Table0119_RU Table0119 = `RU` // Replaced unsolicited
+	Table0119_SC Table0119 = `SC` // Status changed
+	Table0119_SN Table0119 = `SN` // Send order number
+	Table0119_SR Table0119 = `SR` // Response to send order status request
+	Table0119_SS Table0119 = `SS` // Send order status request
+	Table0119_UC Table0119 = `UC` // Unable to cancel
+	Table0119_UD Table0119 = `UD` // Unable to discontinue
+	Table0119_UH Table0119 = `UH` // Unable to put on hold
+	Table0119_UM Table0119 = `UM` // Unable to replace
+	Table0119_UR Table0119 = `UR` // Unable to release
+	Table0119_UX Table0119 = `UX` // Unable to change
+	Table0119_XO Table0119 = `XO` // Change order request
+	Table0119_XR Table0119 = `XR` // Changed as requested
+	Table0119_XX Table0119 = `XX` // Order changed, unsolicited
+)
+
+// TableID returns the table ID.
+func (Table0119) TableID() string { return `0119` }
+
+// Valid reports if the value is in the table.
+func (v Table0119) Valid() bool { return TableValueLookup[`0119`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0119) Description() string { return tableDescription(`0119`, string(v)) }
+
+// Table0121 is a value of table 0121, RESPONSE FLAG.
+type Table0121 string
+
+const (
+	Table0121_D Table0121 = `D` // Same as R, also other associated segments
+	Table0121_E Table0121 = `E` // Report exceptions only
+	Table0121_F Table0121 = `F` // Same as D, plus confirmations explicitly
+	Table0121_N Table0121 = `N` // Only the MSA segment is returned
+	Table0121_R Table0121 = `R` // Same as E, also Replacement and Parent-Child
+)
+
+// TableID returns the table ID.
+func (Table0121) TableID() string { return `0121` }
+
+// Valid reports if the value is in the table.
+func (v Table0121) Valid() bool { return TableValueLookup[`0121`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0121) Description() string { return tableDescription(`0121`, string(v)) }
+
+// Table0122 is a value of table 0122, CHARGE TYPE.
+type Table0122 string
+
+const (
+	Table0122_CH Table0122 = `CH` // Charge
+	Table0122_CO Table0122 = `CO` // Contract
+	Table0122_CR Table0122 = `CR` // Credit
+	Table0122_DP Table0122 = `DP` // Department
+	Table0122_GR Table0122 = `GR` // Grant
+	Table0122_NC Table0122 = `NC` // No Charge
+	Table0122_PC Table0122 = `PC` // Professional
+	Table0122_RS Table0122 = `RS` // Research
+)
+
+// TableID returns the table ID.
+func (Table0122) TableID() string { return `0122` }
+
+// Valid reports if the value is in the table.
+func (v Table0122) Valid() bool { return TableValueLookup[`0122`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0122) Description() string { return tableDescription(`0122`, string(v)) }
+
+// Table0123 is a value of table 0123, RESULT STATUS - OBR.
+type Table0123 string
+
+const (
+	Table0123_C Table0123 = `C` // Correction to results
+	Table0123_F Table0123 = `F` // Final results - results stored & verified
+	Table0123_I Table0123 = `I` // Specimen in lab, not yet processed.
+	Table0123_O Table0123 = `O` // Order received; specimen not yet received
+	Table0123_P Table0123 = `P` // Preliminary: A verified early result is available, final results not yet obtained
+	Table0123_R Table0123 = `R` // Results stored; not yet verified
+	Table0123_S Table0123 = `S` // No results available; procedure scheduled, but not done
+	Table0123_X Table0123 = `X` // No results available; Order canceled.
+	Table0123_Y Table0123 = `Y` // No order on record for this test. (Used only on queries)
+	Table0123_Z Table0123 = `Z` // No record of this patient. (Used only on queries)
+)
+
+// TableID returns the table ID.
+func (Table0123) TableID() string { return `0123` }
+
+// Valid reports if the value is in the table.
+func (v Table0123) Valid() bool { return TableValueLookup[`0123`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0123) Description() string { return tableDescription(`0123`, string(v)) }
+
+// Table0124 is a value of table 0124, TRANSPORTATION MODE.
+type Table0124 string
+
+const (
+	Table0124_CART Table0124 = `CART` // Cart - patient travels on cart or gurney
+	Table0124_PORT Table0124 = `PORT` // The examining device goes to patient's location
+	Table0124_WALK Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124_WHLC Table0124 = `WHLC` // Wheelchair
+)
+
+// TableID returns the table ID.
+func (Table0124) TableID() string { return `0124` }
+
+// Valid reports if the value is in the table.
+func (v Table0124) Valid() bool { return TableValueLookup[`0124`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0124) Description() string { return tableDescription(`0124`, string(v)) }
+
+// Table0125 is a value of table 0125, VALUE TYPE.
+type Table0125 string
+
+const (
+	Table0125_AD Table0125 = `AD` // Address
+	Table0125_CE Table0125 = `CE` // Coded element
+	Table0125_CF Table0125 = `CF` // Coded element with formatted values
+	Table0125_CK Table0125 = `CK` // Composite ID with check digit
+	Table0125_CM Table0125 = `CM` // Composite
+	Table0125_CN Table0125 = `CN` // Composite ID and name
+	Table0125_CQ Table0125 = `CQ` // Composite quantity with units
+	Table0125_DT Table0125 = `DT` // Date
+	Table0125_FT Table0125 = `FT` // Formatted text (display)
+	Table0125_ID Table0125 = `ID` // Coded value
+	Table0125_MO Table0125 = `MO` // Money
+	Table0125_NM Table0125 = `NM` // Numeric
+	Table0125_PN Table0125 = `PN` // Person name
+	Table0125_RP Table0125 = `RP` // Reference pointer
+	Table0125_SI Table0125 = `SI` // Sequence ID
+	Table0125_ST Table0125 = `ST` // String data
+	Table0125_TM Table0125 = `TM` // Time
+	Table0125_TN Table0125 = `TN` // Telephone number
+	Table0125_TQ Table0125 = `TQ` // Timing / quantity
+	Table0125_TS Table0125 = `TS` // Time stamp ( date & time)
+	Table0125_TX Table0125 = `TX` // Text data (display)
+)
+
+// TableID returns the table ID.
+func (Table0125) TableID() string { return `0125` }
+
+// Valid reports if the value is in the table.
+func (v Table0125) Valid() bool { return TableValueLookup[`0125`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0125) Description() string { return tableDescription(`0125`, string(v)) }
+
+// Table0127 is a value of table 0127, ALLERGY TYPE.
+type Table0127 string
+
+const (
+	Table0127_DA Table0127 = `DA` // Drug Allergy
+	Table0127_FA Table0127 = `FA` // Food Allergy
+	Table0127_MA Table0127 = `MA` // Miscellaneous Allergy
+	Table0127_MC Table0127 = `MC` // Miscellaneous Contraindication
+)
+
+// TableID returns the table ID.
+func (Table0127) TableID() string { return `0127` }
+
+// Valid reports if the value is in the table.
+func (v Table0127) Valid() bool { return TableValueLookup[`0127`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0127) Description() string { return tableDescription(`0127`, string(v)) }
+
+// Table0128 is a value of table 0128, ALLERGY SEVERITY.
+type Table0128 string
+
+const (
+	Table0128_MI Table0128 = `MI` // Mild
+	Table0128_MO Table0128 = `MO` // Moderate
+	Table0128_SV Table0128 = `SV` // Severe
+)
+
+// TableID returns the table ID.
+func (Table0128) TableID() string { return `0128` }
+
+// Valid reports if the value is in the table.
+func (v Table0128) Valid() bool { return TableValueLookup[`0128`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0128) Description() string { return tableDescription(`0128`, string(v)) }
+
+// Table0129 is a value of table 0129, ACCOMODATION CODE.
+type Table0129 string
+
+// TableID returns the table ID.
+func (Table0129) TableID() string { return `0129` }
+
+// Valid reports if the value is in the table.
+func (v Table0129) Valid() bool { return TableValueLookup[`0129`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0129) Description() string { return tableDescription(`0129`, string(v)) }
+
+// Table0130 is a value of table 0130, VISIT USER CODE.
+type Table0130 string
+
+// TableID returns the table ID.
+func (Table0130) TableID() string { return `0130` }
+
+// Valid reports if the value is in the table.
+func (v Table0130) Valid() bool { return TableValueLookup[`0130`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0130) Description() string { return tableDescription(`0130`, string(v)) }
+
+// Table0131 is a value of table 0131, CONTRACT ROLE.
+type Table0131 string
+
+// TableID returns the table ID.
+func (Table0131) TableID() string { return `0131` }
+
+// Valid reports if the value is in the table.
+func (v Table0131) Valid() bool { return TableValueLookup[`0131`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0131) Description() string { return tableDescription(`0131`, string(v)) }
+
+// Table0132 is a value of table 0132, TRANSACTION CODE.
+type Table0132 string
+
+// TableID returns the table ID.
+func (Table0132) TableID() string { return `0132` }
+
+// Valid reports if the value is in the table.
+func (v Table0132) Valid() bool { return TableValueLookup[`0132`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0132) Description() string { return tableDescription(`0132`, string(v)) }
+
+// Table0135 is a value of table 0135, ASSIGNMENT OF BENEFITS.
+type Table0135 string
+
+const (
+	Table0135_M Table0135 = `M` // Modified assignment
+	Table0135_N Table0135 = `N` // No
+	Table0135_Y Table0135 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0135) TableID() string { return `0135` }
+
+// Valid reports if the value is in the table.
+func (v Table0135) Valid() bool { return TableValueLookup[`0135`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0135) Description() string { return tableDescription(`0135`, string(v)) }
+
+// Table0136 is a value of table 0136, Y/N INDICATOR.
+type Table0136 string
+
+const (
+	Table0136_N Table0136 = `N` // No
+	Table0136_Y Table0136 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0136) TableID() string { return `0136` }
+
+// Valid reports if the value is in the table.
+func (v Table0136) Valid() bool { return TableValueLookup[`0136`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0136) Description() string { return tableDescription(`0136`, string(v)) }
+
+// Table0137 is a value of table 0137, MAIL CLAIM PARTY.
+type Table0137 string
+
+const (
+	Table0137_E Table0137 = `E` // Employer
+	Table0137_G Table0137 = `G` // Guarantor
+	Table0137_I Table0137 = `I` // Insurance Company
+	Table0137_O Table0137 = `O` // Other
+	Table0137_P Table0137 = `P` // Patient
+)
+
+// TableID returns the table ID.
+func (Table0137) TableID() string { return `0137` }
+
+// Valid reports if the value is in the table.
+func (v Table0137) Valid() bool { return TableValueLookup[`0137`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0137) Description() string { return tableDescription(`0137`, string(v)) }
+
+// Table0139 is a value of table 0139, EMPLOYER INFORMATION DATA.
+type Table0139 string
+
+// TableID returns the table ID.
+func (Table0139) TableID() string { return `0139` }
+
+// Valid reports if the value is in the table.
+func (v Table0139) Valid() bool { return TableValueLookup[`0139`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0139) Description() string { return tableDescription(`0139`, string(v)) }
+
+// Table0140 is a value of table 0140, CHAMPUS SERVICE.
+type Table0140 string
+
+// TableID returns the table ID.
+func (Table0140) TableID() string { return `0140` }
+
+// Valid reports if the value is in the table.
+func (v Table0140) Valid() bool { return TableValueLookup[`0140`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0140) Description() string { return tableDescription(`0140`, string(v)) }
+
+// Table0141 is a value of table 0141, CHAMPUS RANK/GRADE.
+type Table0141 string
+
+// TableID returns the table ID.
+func (Table0141) TableID() string { return `0141` }
+
+// Valid reports if the value is in the table.
+func (v Table0141) Valid() bool { return TableValueLookup[`0141`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0141) Description() string { return tableDescription(`0141`, string(v)) }
+
+// Table0142 is a value of table 0142, CHAMPUS STATE.
+type Table0142 string
+
+// TableID returns the table ID.
+func (Table0142) TableID() string { return `0142` }
+
+// Valid reports if the value is in the table.
+func (v Table0142) Valid() bool { return TableValueLookup[`0142`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0142) Description() string { return tableDescription(`0142`, string(v)) }
+
+// Table0143 is a value of table 0143, NON-COVEREDINSURANCE CODE.
+type Table0143 string
+
+// TableID returns the table ID.
+func (Table0143) TableID() string { return `0143` }
+
+// Valid reports if the value is in the table.
+func (v Table0143) Valid() bool { return TableValueLookup[`0143`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0143) Description() string { return tableDescription(`0143`, string(v)) }
+
+// Table0144 is a value of table 0144, ELIGIBILITY SOURCE.
+type Table0144 string
+
+const (
+	Table0144_1 Table0144 = `1` // Insurance Company
+	Table0144_2 Table0144 = `2` // Employer
+	Table0144_3 Table0144 = `3` // Insured Presented Policy
+	Table0144_4 Table0144 = `4` // Insured Presented Card
+	Table0144_5 Table0144 = `5` // Signed Statement on File
+	Table0144_6 Table0144 = `6` // Verbal Information
+	Table0144_7 Table0144 = `7` // None
+)
+
+// TableID returns the table ID.
+func (Table0144) TableID() string { return `0144` }
+
+// Valid reports if the value is in the table.
+func (v Table0144) Valid() bool { return TableValueLookup[`0144`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0144) Description() string { return tableDescription(`0144`, string(v)) }
+
+// Table0145 is a value of table 0145, ROOM TYPE.
+type Table0145 string
+
+const (
+	Table0145_2ICU Table0145 = `2ICU` // Second Intensive Care Unit
+	Table0145_2PRI Table0145 = `2PRI` // Second Private Room
+	Table0145_2SPR Table0145 = `2SPR` // Second Semi-private Room
+	Table0145_ICU  Table0145 = `ICU`  // Intensive Care Unit
+	Table0145_PRI  Table0145 = `PRI`  // Private Room
+	Table0145_SPR  Table0145 = `SPR`  // Semi-private Room
+)
+
+// TableID returns the table ID.
+func (Table0145) TableID() string { return `0145` }
+
+// Valid reports if the value is in the table.
+func (v Table0145) Valid() bool { return TableValueLookup[`0145`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0145) Description() string { return tableDescription(`0145`, string(v)) }
+
+// Table0146 is a value of table 0146, AMOUNT TYPE.
+type Table0146 string
+
+const (
+	Table0146_DF Table0146 = `DF` // Differential
+	Table0146_LM Table0146 = `LM` // Limit
+	Table0146_PC Table0146 = `PC` // Percentage
+	Table0146_RT Table0146 = `RT` // Rate
+	Table0146_UL Table0146 = `UL` // Unlimited
+)
+
+// TableID returns the table ID.
+func (Table0146) TableID() string { return `0146` }
+
+// Valid reports if the value is in the table.
+func (v Table0146) Valid() bool { return TableValueLookup[`0146`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0146) Description() string { return tableDescription(`0146`, string(v)) }
+
+// Table0147 is a value of table 0147, POLICY TYPE.
+type Table0147 string
+
+const (
+	Table0147_2ANC Table0147 = `2ANC` // Second Ancillary
+	Table0147_2MMD Table0147 = `2MMD` // Second Major Medical
+	Table0147_3MMD Table0147 = `3MMD` // Third Major Medical
+	Table0147_ANC  Table0147 = `ANC`  // Ancillary
+	Table0147_MMD  Table0147 = `MMD`  // Major Medical
+)
+
+// TableID returns the table ID.
+func (Table0147) TableID() string { return `0147` }
+
+// Valid reports if the value is in the table.
+func (v Table0147) Valid() bool { return TableValueLookup[`0147`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0147) Description() string { return tableDescription(`0147`, string(v)) }
+
+// Table0148 is a value of table 0148, PENALTY TYPE.
+type Table0148 string
+
+const (
+	Table0148_AT Table0148 = `AT` // Currency Amount
+	Table0148_PC Table0148 = `PC` // Percentage
+)
+
+// TableID returns the table ID.
+func (Table0148) TableID() string { return `0148` }
+
+// Valid reports if the value is in the table.
+func (v Table0148) Valid() bool { return TableValueLookup[`0148`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0148) Description() string { return tableDescription(`0148`, string(v)) }
+
+// Table0149 is a value of table 0149, DAY TYPE.
+type Table0149 string
+
+const (
+	Table0149_AP Table0149 = `AP` // Approved
+	Table0149_DE Table0149 = `DE` // Denied
+	Table0149_PE Table0149 = `PE` // Pending
+)
+
+// TableID returns the table ID.
+func (Table0149) TableID() string { return `0149` }
+
+// Valid reports if the value is in the table.
+func (v Table0149) Valid() bool { return TableValueLookup[`0149`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0149) Description() string { return tableDescription(`0149`, string(v)) }
+
+// Table0150 is a value of table 0150, PRECERTIFICATION PATIENT TYPE.
+type Table0150 string
+
+const (
+	Table0150_ER  Table0150 = `ER`  // Emergency
+	Table0150_IPE Table0150 = `IPE` // Inpatient elective
+	Table0150_OPE Table0150 = `OPE` // Outpatient elective
+	Table0150_UR  Table0150 = `UR`  // Urgent
+)
+
+// TableID returns the table ID.
+func (Table0150) TableID() string { return `0150` }
+
+// Valid reports if the value is in the table.
+func (v Table0150) Valid() bool { return TableValueLookup[`0150`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0150) Description() string { return tableDescription(`0150`, string(v)) }
+
+// Table0151 is a value of table 0151, SECOND OPINION STATUS.
+type Table0151 string
+
+// TableID returns the table ID.
+func (Table0151) TableID() string { return `0151` }
+
+// Valid reports if the value is in the table.
+func (v Table0151) Valid() bool { return TableValueLookup[`0151`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0151) Description() string { return tableDescription(`0151`, string(v)) }
+
+// Table0152 is a value of table 0152, SECOND OPINION DOCUMENTATION RECEIVED.
+type Table0152 string
+
+// TableID returns the table ID.
+func (Table0152) TableID() string { return `0152` }
+
+// Valid reports if the value is in the table.
+func (v Table0152) Valid() bool { return TableValueLookup[`0152`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0152) Description() string { return tableDescription(`0152`, string(v)) }
+
+// Table0153 is a value of table 0153, VALUE CODE.
+type Table0153 string
+
+// TableID returns the table ID.
+func (Table0153) TableID() string { return `0153` }
+
+// Valid reports if the value is in the table.
+func (v Table0153) Valid() bool { return TableValueLookup[`0153`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0153) Description() string { return tableDescription(`0153`, string(v)) }
+
+// Table0155 is a value of table 0155, ACCEPT/APPLICATION ACKNOWLEDGEMENT CONDITIONS.
+type Table0155 string
+
+const (
+	Table0155_AL Table0155 = `AL` // Always
+	Table0155_ER Table0155 = `ER` // Error / reject conditions only
+	Table0155_NE Table0155 = `NE` // Never
+	Table0155_SU Table0155 = `SU` // Successful completion only
+)
+
+// TableID returns the table ID.
+func (Table0155) TableID() string { return `0155` }
+
+// Valid reports if the value is in the table.
+func (v Table0155) Valid() bool { return TableValueLookup[`0155`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0155) Description() string { return tableDescription(`0155`, string(v)) }
+
+// Table0156 is a value of table 0156, DATE/TIME QUALIFIER.
+type Table0156 string
+
+const (
+	Table0156_ANY   Table0156 = `ANY`   // Any date / time within a range
+	Table0156_CAN   Table0156 = `CAN`   // Cancellation date / time
+	Table0156_COL   Table0156 = `COL`   // Collection date / time (equivalent to film or sample collection date / time)
+	Table0156_ORD   Table0156 = `ORD`   // Order date / time
+	Table0156_RCT   Table0156 = `RCT`   // Specimen receipt date / time (receipt of specimen in filling ancillary (lab))
+	Table0156_REP   Table0156 = `REP`   // Report date / time (report date / time at filling ancillary (i.e., lab))
+	Table0156_SCHED Table0156 = `SCHED` // Schedule date / time
+)
+
+// TableID returns the table ID.
+func (Table0156) TableID() string { return `0156` }
+
+// Valid reports if the value is in the table.
+func (v Table0156) Valid() bool { return TableValueLookup[`0156`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0156) Description() string { return tableDescription(`0156`, string(v)) }
+
+// Table0157 is a value of table 0157, WHICH DATE/TIME STATUS QUALIFIER.
+type Table0157 string
+
+const (
+	Table0157_ANY Table0157 = `ANY` // Any status
+	Table0157_CFN Table0157 = `CFN` // Current final value (whether final or corrected)
+	Table0157_COR Table0157 = `COR` // Corrected only (no final with corrections)
+	Table0157_FIN Table0157 = `FIN` // Final only (no corrections)
+	Table0157_PRE Table0157 = `PRE` // Preliminary
+	Table0157_REP Table0157 = `REP` // Report completion date / time
+)
+
+// TableID returns the table ID.
+func (Table0157) TableID() string { return `0157` }
+
+// Valid reports if the value is in the table.
+func (v Table0157) Valid() bool { return TableValueLookup[`0157`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0157) Description() string { return tableDescription(`0157`, string(v)) }
+
+// Table0158 is a value of table 0158, DATE/TIME SELECTION QUALIFIER.
+type Table0158 string
+
+const (
+	Table0158_1ST Table0158 = `1ST` // First value within range
+	Table0158_ALL Table0158 = `ALL` // All values within the range
+	Table0158_LST Table0158 = `LST` // Last value within the range
+	Table0158_REV Table0158 = `REV` // All values within the range returned in reverse chronological order
+)
+
+// TableID returns the table ID.
+func (Table0158) TableID() string { return `0158` }
+
+// Valid reports if the value is in the table.
+func (v Table0158) Valid() bool { return TableValueLookup[`0158`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0158) Description() string { return tableDescription(`0158`, string(v)) }
+
+// Table0159 is a value of table 0159, DIET TYPE.
+type Table0159 string
+
+const (
+	Table0159_D Table0159 = `D` // Diet
+	Table0159_P Table0159 = `P` // Preference
+	Table0159_S Table0159 = `S` // Supplement
+)
+
+// TableID returns the table ID.
+func (Table0159) TableID() string { return `0159` }
+
+// Valid reports if the value is in the table.
+func (v Table0159) Valid() bool { return TableValueLookup[`0159`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0159) Description() string { return tableDescription(`0159`, string(v)) }
+
+// Table0160 is a value of table 0160, TRAY TYPE.
+type Table0160 string
+
+const (
+	Table0160_EARLY Table0160 = `EARLY` // Early tray
+	Table0160_GUEST Table0160 = `GUEST` // Guest tray
+	Table0160_LATE  Table0160 = `LATE`  // Late tray
+	Table0160_MSG   Table0160 = `MSG`   // Tray message only
+	Table0160_NO    Table0160 = `NO`    // No tray
+)
+
+// TableID returns the table ID.
+func (Table0160) TableID() string { return `0160` }
+
+// Valid reports if the value is in the table.
+func (v Table0160) Valid() bool { return TableValueLookup[`0160`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0160) Description() string { return tableDescription(`0160`, string(v)) }
+
+// Table0161 is a value of table 0161, ALLOW SUBSTITUTION.
+type Table0161 string
+
+const (
+	Table0161_G Table0161 = `G` // Allow generic substitutions
+	Table0161_N Table0161 = `N` // Substitutions are not authorized
+	Table0161_T Table0161 = `T` // Allow therapeutic substitutions
+)
+
+// TableID returns the table ID.
+func (Table0161) TableID() string { return `0161` }
+
+// Valid reports if the value is in the table.
+func (v Table0161) Valid() bool { return TableValueLookup[`0161`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0161) Description() string { return tableDescription(`0161`, string(v)) }
+
+// Table0162 is a value of table 0162, ROUTE OF ADMINISTRATION.
+type Table0162 string
+
+const (
+	Table0162_AP  Table0162 = `AP`  // Apply Externally
+	Table0162_B   Table0162 = `B`   // Buccal
+	Table0162_DT  Table0162 = `DT`  // Dental
+	Table0162_GTT Table0162 = `GTT` // Gastronomy Tube
+	Table0162_GU  Table0162 = `GU`  // Magenspülung
+	Table0162_IA  Table0162 = `IA`  // Intra-arterial
+	Table0162_IC  Table0162 = `IC`  // Intracardiac
+	Table0162_ID  Table0162 = `ID`  // Intradermal
+	Table0162_IH  Table0162 = `IH`  // Inhalation
+	Table0162_IM  Table0162 = `IM`  // Intramuscular
+	Table0162_IN  Table0162 = `IN`  // Intranasal
+	Table0162_IO  Table0162 = `IO`  // Intraocular
+	Table0162_IP  Table0162 = `IP`  // Intraperitoneal
+	Table0162_IS  Table0162 = `IS`  // Intrasynovial
+	Table0162_IT  Table0162 = `IT`  // Intrathecal
+	Table0162_IV  Table0162 = `IV`  // Intravenous
+	Table0162_NG  Table0162 = `NG`  // Nasogastric
+	Table0162_NS  Table0162 = `NS`  // Nasal
+	Table0162_OP  Table0162 = `OP`  // Ophthalmic
+	Table0162_OT  Table0162 = `OT`  // Otic
+	Table0162_PO  Table0162 = `PO`  // Oral
+	Table0162_PR  Table0162 = `PR`  // Rectal
+	Table0162_SC  Table0162 = `SC`  // Subcutaneous
+	Table0162_SL  Table0162 = `SL`  // Sublingual
+	Table0162_TD  Table0162 = `TD`  // Transdermal
+	Table0162_TL  Table0162 = `TL`  // Translingual
+	Table0162_TP  Table0162 = `TP`  // Topical
+	Table0162_UR  Table0162 = `UR`  // Urethral
+	Table0162_VG  Table0162 = `VG`  // Vaginal
+)
+
+// TableID returns the table ID.
+func (Table0162) TableID() string { return `0162` }
+
+// Valid reports if the value is in the table.
+func (v Table0162) Valid() bool { return TableValueLookup[`0162`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0162) Description() string { return tableDescription(`0162`, string(v)) }
+
+// Table0163 is a value of table 0163, ADMINISTRIVE SITE.
+type Table0163 string
+
+const (
+	Table0163_BE    Table0163 = `BE`    // Bilateral Ears
+	Table0163_BN    Table0163 = `BN`    // Bilateral Nares
+	Table0163_BU    Table0163 = `BU`    // Buttock
+	Table0163_CT    Table0163 = `CT`    // Tubus
+	Table0163_LA    Table0163 = `LA`    // Left arm
+	Table0163_LAC   Table0163 = `LAC`   // Left Anterior Chest
+	Table0163_LACF  Table0163 = `LACF`  // Left Antecubital Fossa
+	Table0163_LD    Table0163 = `LD`    // Left Deltoid
+	Table0163_LE    Table0163 = `LE`    // Left Ear
+	Table0163_LEJ   Table0163 = `LEJ`   // Left External Jugular
+	Table0163_LF    Table0163 = `LF`    // Left Foot
+	Table0163_LG    Table0163 = `LG`    // Left Gluteus Medius
+	Table0163_LH    Table0163 = `LH`    // Left Hand
+	Table0163_LIJ   Table0163 = `LIJ`   // Left Internal Jugular
+	Table0163_LLAQ  Table0163 = `LLAQ`  // Left Lower Abd Quadrant
+	Table0163_LLFA  Table0163 = `LLFA`  // Left Lower Forearm
+	Table0163_LMFA  Table0163 = `LMFA`  // Left Mid Forearm
+	Table0163_LN    Table0163 = `LN`    // Left Naris
+	Table0163_LPC   Table0163 = `LPC`   // Left Posterior Chest
+	Table0163_LSC   Table0163 = `LSC`   // Left Subclavian
+	Table0163_LT    Table0163 = `LT`    // Left Thigh
+	Table0163_LUA   Table0163 = `LUA`   // Left Upper Arm
+	Table0163_LUAQ  Table0163 = `LUAQ`  // Left Upper Abd Quadrant
+	Table0163_LUFA  Table0163 = `LUFA`  // Left Upper Forearm
+	Table0163_LVG   Table0163 = `LVG`   // Left Ventragluteal
+	Table0163_LVL   Table0163 = `LVL`   // Left Vastus Lateralis
+	Table0163_NB    Table0163 = `NB`    // Nebulized
+	Table0163_OD    Table0163 = `OD`    // Right Eye
+	Table0163_OS    Table0163 = `OS`    // Left Eye
+	Table0163_OU    Table0163 = `OU`    // Bilateral Eyes
+	Table0163_PA    Table0163 = `PA`    // Perianal
+	Table0163_PERIN Table0163 = `PERIN` // Perineal
+	Table0163_RA    Table0163 = `RA`    // Right Arm
+	Table0163_RAC   Table0163 = `RAC`   // Right Anterior Chest
+	Table0163_RACF  Table0163 = `RACF`  // Right Antecubital Fossa
+	Table0163_RD    Table0163 = `RD`    // Right Deltoid
+	Table0163_RE    Table0163 = `RE`    // Right Ear
+	Table0163_REJ   Table0163 = `REJ`   // Right External Jugular
+	Table0163_RF    Table0163 = `RF`    // Right Foot
+	Table0163_RG    Table0163 = `RG`    // Right Gluteus Medius
+	Table0163_RH    Table0163 = `RH`    // Right Hand
+	Table0163_RIJ   Table0163 = `RIJ`   // Right Internal Jugular
+	Table0163_RLAQ  Table0163 = `RLAQ`  // Rt Lower Abd Quadrant
+	Table0163_RLFA  Table0163 = `RLFA`  // Right Lower Forearm
+	Table0163_RMFA  Table0163 = `RMFA`  // Right Mid Forearm
+	Table0163_RN    Table0163 = `RN`    // Right Naris
+	Table0163_RPC   Table0163 = `RPC`   // Right Posterior Chest
+	Table0163_RSC   Table0163 = `RSC`   // Right Subclavian
+	Table0163_RT    Table0163 = `RT`    // Right Thigh
+	Table0163_RUA   Table0163 = `RUA`   // Right Upper Arm
+	Table0163_RUAQ  Table0163 = `RUAQ`  // Right Upper Abd Quadrant
+	Table0163_RUFA  Table0163 = `RUFA`  // Right Upper Forearm
+	Table0163_RVG   Table0163 = `RVG`   // Right Ventragluteal
+	Table0163_RVL   Table0163 = `RVL`   // Right Vastus Lateralis
+)
+
+// TableID returns the table ID.
+func (Table0163) TableID() string { return `0163` }
+
+// Valid reports if the value is in the table.
+func (v Table0163) Valid() bool { return TableValueLookup[`0163`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0163) Description() string { return tableDescription(`0163`, string(v)) }
+
+// Table0164 is a value of table 0164, ADMINISTRATION DEVICE.
+type Table0164 string
+
+const (
+	Table0164_AP   Table0164 = `AP`   // Applicator
+	Table0164_BT   Table0164 = `BT`   // Buretrol
+	Table0164_HL   Table0164 = `HL`   // Heparin Lock
+	Table0164_IPPB Table0164 = `IPPB` // IPPB
+	Table0164_IVP  Table0164 = `IVP`  // IV Pump
+	Table0164_IVS  Table0164 = `IVS`  // IV Soluset
+	Table0164_MI   Table0164 = `MI`   // Metered Inhaler
+	Table0164_NEB  Table0164 = `NEB`  // Nebulizer
+	Table0164_PCA  Table0164 = `PCA`  // PCA Pump
+)
+
+// TableID returns the table ID.
+func (Table0164) TableID() string { return `0164` }
+
+// Valid reports if the value is in the table.
+func (v Table0164) Valid() bool { return TableValueLookup[`0164`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0164) Description() string { return tableDescription(`0164`, string(v)) }
+
+// Table0165 is a value of table 0165, ADMINISTRATION METHOD.
+type Table0165 string
+
+const (
+	Table0165_CH   Table0165 = `CH`   // Chew
+	Table0165_DI   Table0165 = `DI`   // Dissolve
+	Table0165_DU   Table0165 = `DU`   // Dust
+	Table0165_IF   Table0165 = `IF`   // Inflitrate
+	Table0165_IR   Table0165 = `IR`   // Irrigate
+	Table0165_IS   Table0165 = `IS`   // Insert
+	Table0165_IVP  Table0165 = `IVP`  // IV Push
+	Table0165_IVPB Table0165 = `IVPB` // IV Piggyback
+	Table0165_NB   Table0165 = `NB`   // Nebulized
+	Table0165_PF   Table0165 = `PF`   // Perfuse
+	Table0165_PT   Table0165 = `PT`   // Pain
+	Table0165_SH   Table0165 = `SH`   // Shampoo
+	Table0165_SO   Table0165 = `SO`   // Soak
+	Table0165_WA   Table0165 = `WA`   // Wash
+	Table0165_WI   Table0165 = `WI`   // Wipe
+)
+
+// TableID returns the table ID.
+func (Table0165) TableID() string { return `0165` }
+
+// Valid reports if the value is in the table.
+func (v Table0165) Valid() bool { return TableValueLookup[`0165`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0165) Description() string { return tableDescription(`0165`, string(v)) }
+
+// Table0166 is a value of table 0166, RX COMPONENT TYPE.
+type Table0166 string
+
+const (
+	Table0166_A Table0166 = `A` // Additive
+	Table0166_B Table0166 = `B` // Base
+)
+
+// TableID returns the table ID.
+func (Table0166) TableID() string { return `0166` }
+
+// Valid reports if the value is in the table.
+func (v Table0166) Valid() bool { return TableValueLookup[`0166`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0166) Description() string { return tableDescription(`0166`, string(v)) }
+
+// Table0167 is a value of table 0167, SUBSTITUTION STATUS.
+type Table0167 string
+
+const (
+	Table0167_G Table0167 = `G` // A generic substitution was dispensed
+	Table0167_N Table0167 = `N` // No substitute was dispensed
+	Table0167_T Table0167 = `T` // A therapeutic substitution was dispensed
+)
+
+// TableID returns the table ID.
+func (Table0167) TableID() string { return `0167` }
+
+// Valid reports if the value is in the table.
+func (v Table0167) Valid() bool { return TableValueLookup[`0167`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0167) Description() string { return tableDescription(`0167`, string(v)) }
+
+// Table0171 is a value of table 0171, COUNTRY CODE.
+type Table0171 string
+
+const (
+	Table0171__1  Table0171 = `_1`  // Mongolia
+	Table0171__10 Table0171 = `_10` // Comoros
+	Table0171__11 Table0171 = `_11` // Equatorial Guinea
+	Table0171__12 Table0171 = `_12` // Marshall Islands
+	Table0171__2  Table0171 = `_2`  // Armenia
+	Table0171__3  Table0171 = `_3`  // Azerbaijan
+	Table0171__4  Table0171 = `_4`  // Vanuatu
+	Table0171__5  Table0171 = `_5`  // Guinea-Bissau
+	Table0171__6  Table0171 = `_6`  // Tuvalu
+	Table0171__7  Table0171 = `_7`  // Kiribati
+	Table0171__8  Table0171 = `_8`  // Tonga
+	Table0171__9  Table0171 = `_9`  // Djibouti
+	Table0171_A   Table0171 = `A`   // Austria
+	Table0171_ADN Table0171 = `ADN` // Yemen
+	Table0171_AFG Table0171 = `AFG` // Afghanistan
+	Table0171_AGO Table0171 = `AGO` // Angola
+	Table0171_AL  Table0171 = `AL`  // Albania
+	Table0171_AND Table0171 = `AND` // Andorra
+	Table0171_ANT Table0171 = `ANT` // Antigua and Barbuda
+	Table0171_AUS Table0171 = `AUS` // Australia
+	Table0171_B   Table0171 = `B`   // Belgium
+	Table0171_BD  Table0171 = `BD`  // Bangladesh
+	Table0171_BDS Table0171 = `BDS` // Barbados
+	Table0171_BG  Table0171 = `BG`  // Bulgaria
+	Table0171_BH  Table0171 = `BH`  // Belize
+	Table0171_BHT Table0171 = `BHT` // Bhutan
+	Table0171_BIO Table0171 = `BIO` // Maldives
+	Table0171_BOL Table0171 = `BOL` // Bolivia
+	Table0171_BOS Table0171 = `BOS` // Bosnia and Herzegovina
+	Table0171_BR  Table0171 = `BR`  // Brazil
+	Table0171_BRN Table0171 = `BRN` // Bahrain
+	Table0171_BRU Table0171 = `BRU` // Brunei
+	Table0171_BS  Table0171 = `BS`  // Bahamas
+	Table0171_BUR Table0171 = `BUR` // Myanmar
+	Table0171_BY  Table0171 = `BY`  // Belarus
+	Table0171_C   Table0171 = `C`   // Cuba
+	Table0171_CAM Table0171 = `CAM` // Cameroon
+	Table0171_CDN Table0171 = `CDN` // Canada
+	Table0171_CH  Table0171 = `CH`  // Switzerland
+	Table0171_CHD Table0171 = `CHD` // Chad
+	Table0171_CI  Table0171 = `CI`  // Ivory Coast
+	Table0171_CL  Table0171 = `CL`  // Sri Lanka
+	Table0171_CO  Table0171 = `CO`  // Colombia
+	Table0171_CR  Table0171 = `CR`  // Costa Rica
+	Table0171_CV  Table0171 = `CV`  // Cape Verde
+	Table0171_CY  Table0171 = `CY`  // Cyprus
+	Table0171_CZ  Table0171 = `CZ`  // Czech Republic
+	Table0171_D   Table0171 = `D`   // Germany
+	Table0171_DCM Table0171 = `DCM` // Dominican Republic
+	Table0171_DK  Table0171 = `DK`  // Denmark
+	Table0171_DY  Table0171 = `DY`  // Benin
+	Table0171_DZ  Table0171 = `DZ`  // Algeria
+	Table0171_E   Table0171 = `E`   // Spain
+	Table0171_EAK Table0171 = `EAK` // Kenya
+	Table0171_EAT Table0171 = `EAT` // Tanzania
+	Table0171_EAU Table0171 = `EAU` // Uganda
+	Table0171_EAZ Table0171 = `EAZ` // Zanzibar
+	Table0171_EC  Table0171 = `EC`  // Ecuador
+	Table0171_ES  Table0171 = `ES`  // El Salvador
+	Table0171_ET  Table0171 = `ET`  // Egypt
+	Table0171_ETH Table0171 = `ETH` // Ethiopia
+	Table0171_EW  Table0171 = `EW`  // Estonia
+	Table0171_F   Table0171 = `F`   // France
+	Table0171_FAL Table0171 = `FAL` // Falkland Islands
+	Table0171_FJL Table0171 = `FJL` // Fiji
+	Table0171_FL  Table0171 = `FL`  // Liechtenstein
+	Table0171_FR  Table0171 = `FR`  // Faeroe Islands
+	Table0171_FRJ Table0171 = `FRJ` // Yugoslavia
+	Table0171_FSM Table0171 = `FSM` // Micronesia
+	Table0171_GAB Table0171 = `GAB` // Gabon
+	Table0171_GB  Table0171 = `GB`  // United Kingdom
+	Table0171_GBA Table0171 = `GBA` // Channel Islands - Alderney
+	Table0171_GBG Table0171 = `GBG` // Channel Islands - Guernsey
+	Table0171_GBJ Table0171 = `GBJ` // Channel Islands - Jersey
+	Table0171_GBM Table0171 = `GBM` // Isle of Man
+	Table0171_GBZ Table0171 = `GBZ` // Gibraltar
+	Table0171_GCA Table0171 = `GCA` // Guatemala
+	Table0171_GEO Table0171 = `GEO` // Georgia
+	Table0171_GH  Table0171 = `GH`  // Ghana
+	Table0171_GR  Table0171 = `GR`  // Greece
+	Table0171_GUY Table0171 = `GUY` // Guyana
+	Table0171_H   Table0171 = `H`   // Hungary
+	Table0171_HCA Table0171 = `HCA` // Honduras
+	Table0171_HK  Table0171 = `HK`  // Hongkong
+	Table0171_HR  Table0171 = `HR`  // Croatia
+	Table0171_HV  Table0171 = `HV`  // Burkina Faso
+	Table0171_I   Table0171 = `I`   // Italy
+	Table0171_IL  Table0171 = `IL`  // Israel
+	Table0171_IND Table0171 = `IND` // India
+	Table0171_IR  Table0171 = `IR`  // Iran
+	Table0171_IRL Table0171 = `IRL` // Ireland
+	Table0171_IRQ Table0171 = `IRQ` // Iraq
+	Table0171_IS  Table0171 = `IS`  // Iceland
+	Table0171_J   Table0171 = `J`   // Japan
+	Table0171_JA  Table0171 = `JA`  // Jamaica
+	Table0171_JOR Table0171 = `JOR` // Jordan
+	Table0171_K   Table0171 = `K`   // Cambodia
+	Table0171_KAS Table0171 = `KAS` // Kazakhstan
+	Table0171_KIS Table0171 = `KIS` // Kyrgyzstan
+	Table0171_KWT Table0171 = `KWT` // Kuwait
+	Table0171_L   Table0171 = `L`   // Luxembourg
+	Table0171_LAO Table0171 = `LAO` // Laos
+	Table0171_LAR Table0171 = `LAR` // Libya
+	Table0171_LB  Table0171 = `LB`  // Liberia
+	Table0171_LS  Table0171 = `LS`  // Lesotho
+	Table0171_LT  Table0171 = `LT`  // Lithuania
+	Table0171_LV  Table0171 = `LV`  // Latvia
+	Table0171_M   Table0171 = `M`   // Malta
+	Table0171_MA  Table0171 = `MA`  // Morocco
+	Table0171_MAK Table0171 = `MAK` // Macedonia
+	Table0171_MAL Table0171 = `MAL` // Malaysia
+	Table0171_MAO Table0171 = `MAO` // Oman
+	Table0171_MC  Table0171 = `MC`  // Monaco
+	Table0171_MEX Table0171 = `MEX` // Mexico
+	Table0171_MOL Table0171 = `MOL` // Moldova
+	Table0171_MOZ Table0171 = `MOZ` // Mozambique
+	Table0171_MS  Table0171 = `MS`  // Mauritius
+	Table0171_MW  Table0171 = `MW`  // Malawi
+	Table0171_N   Table0171 = `N`   // Norway
+	Table0171_NA  Table0171 = `NA`  // Netherlands Antilles
+	Table0171_NAU Table0171 = `NAU` // Nauru
+	Table0171_NEP Table0171 = `NEP` // Nepal
+	Table0171_NIC Table0171 = `NIC` // Nicaragua
+	Table0171_NL  Table0171 = `NL`  // Netherlands
+	Table0171_NZ  Table0171 = `NZ`  // New Zealand
+	Table0171_P   Table0171 = `P`   // Portugal
+	Table0171_PA  Table0171 = `PA`  // Panama
+	Table0171_PAK Table0171 = `PAK` // Pakistan
+	Table0171_PE  Table0171 = `PE`  // Peru
+	Table0171_PL  Table0171 = `PL`  // Poland
+	Table0171_PNG Table0171 = `PNG` // Papua New Guinea
+	Table0171_PY  Table0171 = `PY`  // Paraguay
+	Table0171_QAT Table0171 = `QAT` // Qatar
+	Table0171_RA  Table0171 = `RA`  // Argentina
+	Table0171_RB  Table0171 = `RB`  // Botswana
+	Table0171_RC  Table0171 = `RC`  // Taiwan
+	Table0171_RCA Table0171 = `RCA` // Central African Republic
+	Table0171_RCB Table0171 = `RCB` // Congo
+	Table0171_RCH Table0171 = `RCH` // Chile
+	Table0171_RG  Table0171 = `RG`  // Guinea
+	Table0171_RH  Table0171 = `RH`  // Haiti
+	Table0171_RI  Table0171 = `RI`  // Indonesia
+	Table0171_RIM Table0171 = `RIM` // Mauritania
+	Table0171_RL  Table0171 = `RL`  // Lebanon
+	Table0171_RM  Table0171 = `RM`  // Madagascar
+	Table0171_RMM Table0171 = `RMM` // Mali
+	Table0171_RN  Table0171 = `RN`  // Niger
+	Table0171_RO  Table0171 = `RO`  // Romania
+	Table0171_ROK Table0171 = `ROK` // Korea
+	Table0171_ROU Table0171 = `ROU` // Uruguay
+	Table0171_RP  Table0171 = `RP`  // Philippines
+	Table0171_RSM Table0171 = `RSM` // San Marino
+	Table0171_RU  Table0171 = `RU`  // Burundi
+	Table0171_RUS Table0171 = `RUS` // Russia
+	Table0171_RWA Table0171 = `RWA` // Rwanda
+	Table0171_S   Table0171 = `S`   // Sweden
+	Table0171_SAU Table0171 = `SAU` // Saudi Arabia
+	Table0171_SCN Table0171 = `SCN` // St. Kitts and Nevis
+	Table0171_SD  Table0171 = `SD`  // Swaziland
+	Table0171_SF  Table0171 = `SF`  // Finland
+	Table0171_SGP Table0171 = `SGP` // Singapore
+	Table0171_SK  Table0171 = `SK`  // Slovakia
+	Table0171_SLO Table0171 = `SLO` // Slovenia
+	Table0171_SME Table0171 = `SME` // Suriname
+	Table0171_SN  Table0171 = `SN`  // Senegal
+	Table0171_SOL Table0171 = `SOL` // Solomon Islands
+	Table0171_SP  Table0171 = `SP`  // Somalia
+	Table0171_STP Table0171 = `STP` // Sao Tome and Principe
+	Table0171_SUD Table0171 = `SUD` // Sudan
+	Table0171_SWA Table0171 = `SWA` // Namibia
+	Table0171_SY  Table0171 = `SY`  // Seychelles
+	Table0171_SYR Table0171 = `SYR` // Syria
+	Table0171_T   Table0171 = `T`   // Thailand
+	Table0171_TAD Table0171 = `TAD` // Tajikistan
+	Table0171_TG  Table0171 = `TG`  // Togo
+	Table0171_TJ  Table0171 = `TJ`  // China
+	Table0171_TMN Table0171 = `TMN` // Turkmenistan
+	Table0171_TN  Table0171 = `TN`  // Tunisia
+	Table0171_TR  Table0171 = `TR`  // Turkey
+	Table0171_TT  Table0171 = `TT`  // Trinidad and Tobago
+	Table0171_UA  Table0171 = `UA`  // Ukraine
+	Table0171_UAE Table0171 = `UAE` // United Arab Emirates
+	Table0171_USA Table0171 = `USA` // United States of America
+	Table0171_USB Table0171 = `USB` // Uzbekistan
+	Table0171_V   Table0171 = `V`   // Vatican City State
+	Table0171_VN  Table0171 = `VN`  // Vietnam
+	Table0171_WAG Table0171 = `WAG` // Gambia
+	Table0171_WAL Table0171 = `WAL` // Sierra Leone
+	Table0171_WAN Table0171 = `WAN` // Nigeria
+	Table0171_WD  Table0171 = `WD`  // Dominica
+	Table0171_WG  Table0171 = `WG`  // Grenada
+	Table0171_WL  Table0171 = `WL`  // St. Lucia
+	Table0171_WS  Table0171 = `WS`  // Western Samoa
+	Table0171_WV  Table0171 = `WV`  // St. Vincent and the Grenadines
+	Table0171_YV  Table0171 = `YV`  // Venezuela
+	Table0171_Z   Table0171 = `Z`   // Zambia
+	Table0171_ZA  Table0171 = `ZA`  // South Africa
+	Table0171_ZRE Table0171 = `ZRE` // Zaire
+	Table0171_ZW  Table0171 = `ZW`  // Zimbabwe
+)
+
+// TableID returns the table ID.
+func (Table0171) TableID() string { return `0171` }
+
+// Valid reports if the value is in the table.
+func (v Table0171) Valid() bool { return TableValueLookup[`0171`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0171) Description() string { return tableDescription(`0171`, string(v)) }
+
+// Table0172 is a value of table 0172, VETERANS MILITARY STATUS.
+type Table0172 string
+
+// TableID returns the table ID.
+func (Table0172) TableID() string { return `0172` }
+
+// Valid reports if the value is in the table.
+func (v Table0172) Valid() bool { return TableValueLookup[`0172`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0172) Description() string { return tableDescription(`0172`, string(v)) }
+
+// Table0173 is a value of table 0173, COORDINATION OF BENEFITS.
+type Table0173 string
+
+const (
+	Table0173_CO Table0173 = `CO` // Coordination
+	Table0173_IN Table0173 = `IN` // Independent
+)
+
+// TableID returns the table ID.
+func (Table0173) TableID() string { return `0173` }
+
+// Valid reports if the value is in the table.
+func (v Table0173) Valid() bool { return TableValueLookup[`0173`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0173) Description() string { return tableDescription(`0173`, string(v)) }
+
+// Table0175 is a value of table 0175, MASTER FILE IDENTIFIER CODE.
+type Table0175 string
+
+const (
+	Table0175_CDM Table0175 = `CDM` // Charge description master file (see chapter 6, appendix)
+	Table0175_OM1 Table0175 = `OM1` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_OM2 Table0175 = `OM2` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_OM3 Table0175 = `OM3` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_OM4 Table0175 = `OM4` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_OM5 Table0175 = `OM5` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_OM6 Table0175 = `OM6` // Observation text master file (i.e., Lab) (see Chapter 7, Appendix)
+	Table0175_PRA Table0175 = `PRA` // Practitioner master file (see chapter 8, appendix)
+	Table0175_STF Table0175 = `STF` // Staff master file (see chapter 8, Appendix)
+)
+
+// TableID returns the table ID.
+func (Table0175) TableID() string { return `0175` }
+
+// Valid reports if the value is in the table.
+func (v Table0175) Valid() bool { return TableValueLookup[`0175`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0175) Description() string { return tableDescription(`0175`, string(v)) }
+
+// Table0176 is a value of table 0176, MASTER FILE APPLICATION IDENTIFIER.
+type Table0176 string
+
+// TableID returns the table ID.
+func (Table0176) TableID() string { return `0176` }
+
+// Valid reports if the value is in the table.
+func (v Table0176) Valid() bool { return TableValueLookup[`0176`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0176) Description() string { return tableDescription(`0176`, string(v)) }
+
+// Table0178 is a value of table 0178, FILE-LEVEL EVENT CODE.
+type Table0178 string
+
+const (
+	Table0178_REP Table0178 = `REP` // Replace current version of this master file with the version contained in this message
+	Table0178_UPD Table0178 = `UPD` // Change file records as defined in the record level event codes for each record that follows
+)
+
+// TableID returns the table ID.
+func (Table0178) TableID() string { return `0178` }
+
+// Valid reports if the value is in the table.
+func (v Table0178) Valid() bool { return TableValueLookup[`0178`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0178) Description() string { return tableDescription(`0178`, string(v)) }
+
+// Table0179 is a value of table 0179, RESPONSE LEVEL.
+type Table0179 string
+
+const (
+	Table0179_AL Table0179 = `AL` // Always
+	Table0179_ER Table0179 = `ER` // Error / reject conditions only
+	Table0179_NE Table0179 = `NE` // Never - no application level response needed
+	Table0179_SU Table0179 = `SU` // Success
+)
+
+// TableID returns the table ID.
+func (Table0179) TableID() string { return `0179` }
+
+// Valid reports if the value is in the table.
+func (v Table0179) Valid() bool { return TableValueLookup[`0179`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0179) Description() string { return tableDescription(`0179`, string(v)) }
+
+// Table0180 is a value of table 0180, RECORD LEVEL EVENT CODE.
+type Table0180 string
+
+const (
+	Table0180_MAC Table0180 = `MAC` // Reactivate deactivated record
+	Table0180_MAD Table0180 = `MAD` // Add record to master file
+	Table0180_MDC Table0180 = `MDC` // Deactivate - discontinue using record in master file, but do not delete from database
+	Table0180_MDL Table0180 = `MDL` // Delete record from master file
+	Table0180_MUP Table0180 = `MUP` // Update record for master file
+)
+
+// TableID returns the table ID.
+func (Table0180) TableID() string { return `0180` }
+
+// Valid reports if the value is in the table.
+func (v Table0180) Valid() bool { return TableValueLookup[`0180`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0180) Description() string { return tableDescription(`0180`, string(v)) }
+
+// Table0181 is a value of table 0181, MFN RECORD-LEVEL ERROR RETURN.
+type Table0181 string
+
+const (
+	Table0181_S Table0181 = `S` // Successful posting of the record defined by the MFE segment
+	Table0181_U Table0181 = `U` // Unsuccessful posting of the record defined by the MFE segment
+)
+
+// TableID returns the table ID.
+func (Table0181) TableID() string { return `0181` }
+
+// Valid reports if the value is in the table.
+func (v Table0181) Valid() bool { return TableValueLookup[`0181`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0181) Description() string { return tableDescription(`0181`, string(v)) }
+
+// Table0188 is a value of table 0188, OPERATOR ID.
+type Table0188 string
+
+// TableID returns the table ID.
+func (Table0188) TableID() string { return `0188` }
+
+// Valid reports if the value is in the table.
+func (v Table0188) Valid() bool { return TableValueLookup[`0188`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0188) Description() string { return tableDescription(`0188`, string(v)) }
+
+// Table0189 is a value of table 0189, ETHNIC GROUP.
+type Table0189 string
+
+// TableID returns the table ID.
+func (Table0189) TableID() string { return `0189` }
+
+// Valid reports if the value is in the table.
+func (v Table0189) Valid() bool { return TableValueLookup[`0189`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0189) Description() string { return tableDescription(`0189`, string(v)) }
+
+// Table0190 is a value of table 0190, ADDRESS TYPE.
+type Table0190 string
+
+const (
+	Table0190_B Table0190 = `B` // Business
+	Table0190_C Table0190 = `C` // Current or Temporary
+	Table0190_H Table0190 = `H` // Home
+	Table0190_M Table0190 = `M` // Mailing
+	Table0190_O Table0190 = `O` // Office
+	Table0190_P Table0190 = `P` // Permanent
+)
+
+// TableID returns the table ID.
+func (Table0190) TableID() string { return `0190` }
+
+// Valid reports if the value is in the table.
+func (v Table0190) Valid() bool { return TableValueLookup[`0190`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0190) Description() string { return tableDescription(`0190`, string(v)) }
+
+// Table0192 is a value of table 0192, VISIT ID TYPE.
+type Table0192 string
+
+// TableID returns the table ID.
+func (Table0192) TableID() string { return `0192` }
+
+// Valid reports if the value is in the table.
+func (v Table0192) Valid() bool { return TableValueLookup[`0192`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0192) Description() string { return tableDescription(`0192`, string(v)) }
+
+// Table0193 is a value of table 0193, AMOUNT CLASS.
+type Table0193 string
+
+const (
+	Table0193_AT Table0193 = `AT` // Amount
+	Table0193_LM Table0193 = `LM` // Limit
+	Table0193_PC Table0193 = `PC` // Percentage
+	Table0193_UL Table0193 = `UL` // Unlimited
+)
+
+// TableID returns the table ID.
+func (Table0193) TableID() string { return `0193` }
+
+// Valid reports if the value is in the table.
+func (v Table0193) Valid() bool { return TableValueLookup[`0193`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0193) Description() string { return tableDescription(`0193`, string(v)) }
+
+// TableISO3166 is a value of table ISO3166, Country Codes.
+type TableISO3166 string
+
+const (
+	TableISO3166_ABW TableISO3166 = `ABW` // Aruba
+	TableISO3166_AFG TableISO3166 = `AFG` // Afghanistan
+	TableISO3166_AGO TableISO3166 = `AGO` // Angola
+	TableISO3166_AIA TableISO3166 = `AIA` // Anguilla
+	TableISO3166_ALA TableISO3166 = `ALA` // Aland Islands
+	TableISO3166_ALB TableISO3166 = `ALB` // Albania
+	TableISO3166_AND TableISO3166 = `AND` // Andorra
+	TableISO3166_ARE TableISO3166 = `ARE` // United Arab Emirates
+	TableISO3166_ARG TableISO3166 = `ARG` // Argentina
+	TableISO3166_ARM TableISO3166 = `ARM` // Armenia
+	TableISO3166_ASM TableISO3166 = `ASM` // American Samoa
+	TableISO3166_ATA TableISO3166 = `ATA` // Antarctica
+	TableISO3166_ATF TableISO3166 = `ATF` // French Southern Territories
+	TableISO3166_ATG TableISO3166 = `ATG` // Antigua and Barbuda
+	TableISO3166_AUS TableISO3166 = `AUS` // Australia
+	TableISO3166_AUT TableISO3166 = `AUT` // Austria
+	TableISO3166_AZE TableISO3166 = `AZE` // Azerbaijan
+	TableISO3166_BDI TableISO3166 = `BDI` // Burundi
+	TableISO3166_BEL TableISO3166 = `BEL` // Belgium
+	TableISO3166_BEN TableISO3166 = `BEN` // Benin
+	TableISO3166_BES TableISO3166 = `BES` // Bonaire, Sint Eustatius and Saba
+	TableISO3166_BFA TableISO3166 = `BFA` // Burkina Faso
+	TableISO3166_BGD TableISO3166 = `BGD` // Bangladesh
+	TableISO3166_BGR TableISO3166 = `BGR` // Bulgaria
+	TableISO3166_BHR TableISO3166 = `BHR` // Bahrain
+	TableISO3166_BHS TableISO3166 = `BHS` // Bahamas
+	TableISO3166_BIH TableISO3166 = `BIH` // Bosnia and Herzegovina
+	TableISO3166_BLM TableISO3166 = `BLM` // Saint BarthÃ©lemy
+	TableISO3166_BLR TableISO3166 = `BLR` // Belarus
+	TableISO3166_BLZ TableISO3166 = `BLZ` // Belize
+	TableISO3166_BMU TableISO3166 = `BMU` // Bermuda
+	TableISO3166_BOL TableISO3166 = `BOL` // Bolivia (Plurinational State of)
+	TableISO3166_BRA TableISO3166 = `BRA` // Brazil
+	TableISO3166_BRB TableISO3166 = `BRB` // Barbados
+	TableISO3166_BRN TableISO3166 = `BRN` // Brunei Darussalam
+	TableISO3166_BTN TableISO3166 = `BTN` // Bhutan
+	TableISO3166_BVT TableISO3166 = `BVT` // Bouvet Island
+	TableISO3166_BWA TableISO3166 = `BWA` // Botswana
+	TableISO3166_CAF TableISO3166 = `CAF` // Central African Republic
+	TableISO3166_CAN TableISO3166 = `CAN` // Canada
+	TableISO3166_CCK TableISO3166 = `CCK` // Cocos (Keeling) Islands
+	TableISO3166_CHE TableISO3166 = `CHE` // Switzerland
+	TableISO3166_CHL TableISO3166 = `CHL` // Chile
+	TableISO3166_CHN TableISO3166 = `CHN` // China
+	TableISO3166_CIV TableISO3166 = `CIV` // CÃ´te d'Ivoire
+	TableISO3166_CMR TableISO3166 = `CMR` // Cameroon
+	TableISO3166_COD TableISO3166 = `COD` // Congo, Democratic Republic of the
+	TableISO3166_COG TableISO3166 = `COG` // Congo
+	TableISO3166_COK TableISO3166 = `COK` // Cook Islands
+	TableISO3166_COL TableISO3166 = `COL` // Colombia
+	TableISO3166_COM TableISO3166 = `COM` // Comoros
+	TableISO3166_CPV TableISO3166 = `CPV` // Cabo Verde
+	TableISO3166_CRI TableISO3166 = `CRI` // Costa Rica
+	TableISO3166_CUB TableISO3166 = `CUB` // Cuba
+	TableISO3166_CUW TableISO3166 = `CUW` // CuraÃ§ao
+	TableISO3166_CXR TableISO3166 = `CXR` // Christmas Island
+	TableISO3166_CYM TableISO3166 = `CYM` // Cayman Islands
+	TableISO3166_CYP TableISO3166 = `CYP` // Cyprus
+	TableISO3166_CZE TableISO3166 = `CZE` // Czechia
+	TableISO3166_DEU TableISO3166 = `DEU` // Germany
+	TableISO3166_DJI TableISO3166 = `DJI` // Djibouti
+	TableISO3166_DMA TableISO3166 = `DMA` // Dominica
+	TableISO3166_DNK TableISO3166 = `DNK` // Denmark
+	TableISO3166_DOM TableISO3166 = `DOM` // Dominican Republic
+	TableISO3166_DZA TableISO3166 = `DZA` // Algeria
+	TableISO3166_ECU TableISO3166 = `ECU` // Ecuador
+	TableISO3166_EGY TableISO3166 = `EGY` // Egypt
+	TableISO3166_ERI TableISO3166 = `ERI` // Eritrea
+	TableISO3166_ESH TableISO3166 = `ESH` // Western Sahara
+	TableISO3166_ESP TableISO3166 = `ESP` // Spain
+	TableISO3166_EST TableISO3166 = `EST` // Estonia
+	TableISO3166_ETH TableISO3166 = `ETH` // Ethiopia
+	TableISO3166_FIN TableISO3166 = `FIN` // Finland
+	TableISO3166_FJI TableISO3166 = `FJI` // Fiji
+	TableISO3166_FLK TableISO3166 = `FLK` // Falkland Islands (Malvinas)
+	TableISO3166_FRA TableISO3166 = `FRA` // France
+	TableISO3166_FRO TableISO3166 = `FRO` // Faroe Islands
+	TableISO3166_FSM TableISO3166 = `FSM` // Micronesia (Federated States of)
+	TableISO3166_GAB TableISO3166 = `GAB` // Gabon
+	TableISO3166_GBR TableISO3166 = `GBR` // United Kingdom of Great Britain and Northern Ireland
+	TableISO3166_GEO TableISO3166 = `GEO` // Georgia
+	TableISO3166_GGY TableISO3166 = `GGY` // Guernsey
+	TableISO3166_GHA TableISO3166 = `GHA` // Ghana
+	TableISO3166_GIB TableISO3166 = `GIB` // Gibraltar
+	TableISO3166_GIN TableISO3166 = `GIN` // Guinea
+	TableISO3166_GLP TableISO3166 = `GLP` // Guadeloupe
+	TableISO3166_GMB TableISO3166 = `GMB` // Gambia
+	TableISO3166_GNB TableISO3166 = `GNB` // Guinea-Bissau
+	TableISO3166_GNQ TableISO3166 = `GNQ` // Equatorial Guinea
+	TableISO3166_GRC TableISO3166 = `GRC` // Greece
+	TableISO3166_GRD TableISO3166 = `GRD` // Grenada
+	TableISO3166_GRL TableISO3166 = `GRL` // Greenland
+	TableISO3166_GTM TableISO3166 = `GTM` // Guatemala
+	TableISO3166_GUF TableISO3166 = `GUF` // French Guiana
+	TableISO3166_GUM TableISO3166 = `GUM` // Guam
+	TableISO3166_GUY TableISO3166 = `GUY` // Guyana
+	TableISO3166_HKG TableISO3166 = `HKG` // Hong Kong
+	TableISO3166_HMD TableISO3166 = `HMD` // Heard Island and McDonald Islands
+	TableISO3166_HND TableISO3166 = `HND` // Honduras
+	TableISO3166_HRV TableISO3166 = `HRV` // Croatia
+	TableISO3166_HTI TableISO3166 = `HTI` // Haiti
+	TableISO3166_HUN TableISO3166 = `HUN` // Hungary
+	TableISO3166_IDN TableISO3166 = `IDN` // Indonesia
+	TableISO3166_IMN TableISO3166 = `IMN` // Isle of Man
+	TableISO3166_IND TableISO3166 = `IND` // India
+	TableISO3166_IOT TableISO3166 = `IOT` // British Indian Ocean Territory
+	TableISO3166_IRL TableISO3166 = `IRL` // Ireland
+	TableISO3166_IRN TableISO3166 = `IRN` // Iran (Islamic Republic of)
+	TableISO3166_IRQ TableISO3166 = `IRQ` // Iraq
+	TableISO3166_ISL TableISO3166 = `ISL` // Iceland
+	TableISO3166_ISR TableISO3166 = `ISR` // Israel
+	TableISO3166_ITA TableISO3166 = `ITA` // Italy
+	TableISO3166_JAM TableISO3166 = `JAM` // Jamaica
+	TableISO3166_JEY TableISO3166 = `JEY` // Jersey
+	TableISO3166_JOR TableISO3166 = `JOR` // Jordan
+	TableISO3166_JPN TableISO3166 = `JPN` // Japan
+	TableISO3166_KAZ TableISO3166 = `KAZ` // Kazakhstan
+	TableISO3166_KEN TableISO3166 = `KEN` // Kenya
+	TableISO3166_KGZ TableISO3166 = `KGZ` // Kyrgyzstan
+	TableISO3166_KHM TableISO3166 = `KHM` // Cambodia
+	TableISO3166_KIR TableISO3166 = `KIR` // Kiribati
+	TableISO3166_KNA TableISO3166 = `KNA` // Saint Kitts and Nevis
+	TableISO3166_KOR TableISO3166 = `KOR` // Korea, Republic of
+	TableISO3166_KWT TableISO3166 = `KWT` // Kuwait
+	TableISO3166_LAO TableISO3166 = `LAO` // Lao People's Democratic Republic
+	TableISO3166_LBN TableISO3166 = `LBN` // Lebanon
+	TableISO3166_LBR TableISO3166 = `LBR` // Liberia
+	TableISO3166_LBY TableISO3166 = `LBY` // Libya
+	TableISO3166_LCA TableISO3166 = `LCA` // Saint Lucia
+	TableISO3166_LIE TableISO3166 = `LIE` // Liechtenstein
+	TableISO3166_LKA TableISO3166 = `LKA` // Sri Lanka
+	TableISO3166_LSO TableISO3166 = `LSO` // Lesotho
+	TableISO3166_LTU TableISO3166 = `LTU` // Lithuania
+	TableISO3166_LUX TableISO3166 = `LUX` // Luxembourg
+	TableISO3166_LVA TableISO3166 = `LVA` // Latvia
+	TableISO3166_MAC TableISO3166 = `MAC` // Macao
+	TableISO3166_MAF TableISO3166 = `MAF` // Saint Martin (French part)
+	TableISO3166_MAR TableISO3166 = `MAR` // Morocco
+	TableISO3166_MCO TableISO3166 = `MCO` // Monaco
+	TableISO3166_MDA TableISO3166 = `MDA` // Moldova, Republic of
+	TableISO3166_MDG TableISO3166 = `MDG` // Madagascar
+	TableISO3166_MDV TableISO3166 = `MDV` // Maldives
+	TableISO3166_MEX TableISO3166 = `MEX` // Mexico
+	TableISO3166_MHL TableISO3166 = `MHL` // Marshall Islands
+	TableISO3166_MKD TableISO3166 = `MKD` // North Macedonia
+	TableISO3166_MLI TableISO3166 = `MLI` // Mali
+	TableISO3166_MLT TableISO3166 = `MLT` // Malta
+	TableISO3166_MMR TableISO3166 = `MMR` // Myanmar
+	TableISO3166_MNE TableISO3166 = `MNE` // Montenegro
+	TableISO3166_MNG TableISO3166 = `MNG` // Mongolia
+	TableISO3166_MNP TableISO3166 = `MNP` // Northern Mariana Islands
+	TableISO3166_MOZ TableISO3166 = `MOZ` // Mozambique
+	TableISO3166_MRT TableISO3166 = `MRT` // Mauritania
+	TableISO3166_MSR TableISO3166 = `MSR` // Montserrat
+	TableISO3166_MTQ TableISO3166 = `MTQ` // Martinique
+	TableISO3166_MUS TableISO3166 = `MUS` // Mauritius
+	TableISO3166_MWI TableISO3166 = `MWI` // Malawi
+	TableISO3166_MYS TableISO3166 = `MYS` // Malaysia
+	TableISO3166_MYT TableISO3166 = `MYT` // Mayotte
+	TableISO3166_NAM TableISO3166 = `NAM` // Namibia
+	TableISO3166_NCL TableISO3166 = `NCL` // New Caledonia
+	TableISO3166_NER TableISO3166 = `NER` // Niger
+	TableISO3166_NFK TableISO3166 = `NFK` // Norfolk Island
+	TableISO3166_NGA TableISO3166 = `NGA` // Nigeria
+	TableISO3166_NIC TableISO3166 = `NIC` // Nicaragua
+	TableISO3166_NIU TableISO3166 = `NIU` // Niue
+	TableISO3166_NLD TableISO3166 = `NLD` // Netherlands
+	TableISO3166_NOR TableISO3166 = `NOR` // Norway
+	TableISO3166_NPL TableISO3166 = `NPL` // Nepal
+	TableISO3166_NRU TableISO3166 = `NRU` // Nauru
+	TableISO3166_NZL TableISO3166 = `NZL` // New Zealand
+	TableISO3166_OMN TableISO3166 = `OMN` // Oman
+	TableISO3166_PAK TableISO3166 = `PAK` // Pakistan
+	TableISO3166_PAN TableISO3166 = `PAN` // Panama
+	TableISO3166_PCN TableISO3166 = `PCN` // Pitcairn
+	TableISO3166_PER TableISO3166 = `PER` // Peru
+	TableISO3166_PHL TableISO3166 = `PHL` // Philippines
+	TableISO3166_PLW TableISO3166 = `PLW` // Palau
+	TableISO3166_PNG TableISO3166 = `PNG` // Papua New Guinea
+	TableISO3166_POL TableISO3166 = `POL` // Poland
+	TableISO3166_PRI TableISO3166 = `PRI` // Puerto Rico
+	TableISO3166_PRK TableISO3166 = `PRK` // Korea (Democratic People's Republic of)
+	TableISO3166_PRT TableISO3166 = `PRT` // Portugal
+	TableISO3166_PRY TableISO3166 = `PRY` // Paraguay
+	TableISO3166_PSE TableISO3166 = `PSE` // Palestine, State of
+	TableISO3166_PYF TableISO3166 = `PYF` // French Polynesia
+	TableISO3166_QAT TableISO3166 = `QAT` // Qatar
+	TableISO3166_REU TableISO3166 = `REU` // RÃ©union
+	TableISO3166_ROU TableISO3166 = `ROU` // Romania
+	TableISO3166_RUS TableISO3166 = `RUS` // Russian Federation
+	TableISO3166_RWA TableISO3166 = `RWA` // Rwanda
+	TableISO3166_SAU TableISO3166 = `SAU` // Saudi Arabia
+	TableISO3166_SDN TableISO3166 = `SDN` // Sudan
+	TableISO3166_SEN TableISO3166 = `SEN` // Senegal
+	TableISO3166_SGP TableISO3166 = `SGP` // Singapore
+	TableISO3166_SGS TableISO3166 = `SGS` // South Georgia and the South Sandwich Islands
+	TableISO3166_SHN TableISO3166 = `SHN` // Saint Helena, Ascension and Tristan da Cunha
+	TableISO3166_SJM TableISO3166 = `SJM` // Svalbard and Jan Mayen
+	TableISO3166_SLB TableISO3166 = `SLB` // Solomon Islands
+	TableISO3166_SLE TableISO3166 = `SLE` // Sierra Leone
+	TableISO3166_SLV TableISO3166 = `SLV` // El Salvador
+	TableISO3166_SMR TableISO3166 = `SMR` // San Marino
+	TableISO3166_SOM TableISO3166 = `SOM` // Somalia
+	TableISO3166_SPM TableISO3166 = `SPM` // Saint Pierre and Miquelon
+	TableISO3166_SRB TableISO3166 = `SRB` // Serbia
+	TableISO3166_SSD TableISO3166 = `SSD` // South Sudan
+	TableISO3166_STP TableISO3166 = `STP` // Sao Tome and Principe
+	TableISO3166_SUR TableISO3166 = `SUR` // Suriname
+	TableISO3166_SVK TableISO3166 = `SVK` // Slovakia
+	TableISO3166_SVN TableISO3166 = `SVN` // Slovenia
+	TableISO3166_SWE TableISO3166 = `SWE` // Sweden
+	TableISO3166_SWZ TableISO3166 = `SWZ` // Eswatini
+	TableISO3166_SXM TableISO3166 = `SXM` // Sint Maarten (Dutch part)
+	TableISO3166_SYC TableISO3166 = `SYC` // Seychelles
+	TableISO3166_SYR TableISO3166 = `SYR` // Syrian Arab Republic
+	TableISO3166_TCA TableISO3166 = `TCA` // Turks and Caicos Islands
+	TableISO3166_TCD TableISO3166 = `TCD` // Chad
+	TableISO3166_TGO TableISO3166 = `TGO` // Togo
+	TableISO3166_THA TableISO3166 = `THA` // Thailand
+	TableISO3166_TJK TableISO3166 = `TJK` // Tajikistan
+	TableISO3166_TKL TableISO3166 = `TKL` // Tokelau
+	TableISO3166_TKM TableISO3166 = `TKM` // Turkmenistan
+	TableISO3166_TLS TableISO3166 = `TLS` // Timor-Leste
+	TableISO3166_TON TableISO3166 = `TON` // Tonga
+	TableISO3166_TTO TableISO3166 = `TTO` // Trinidad and Tobago
+	TableISO3166_TUN TableISO3166 = `TUN` // Tunisia
+	TableISO3166_TUR TableISO3166 = `TUR` // Turkey
+	TableISO3166_TUV TableISO3166 = `TUV` // Tuvalu
+	TableISO3166_TWN TableISO3166 = `TWN` // Taiwan, Province of China
+	TableISO3166_TZA TableISO3166 = `TZA` // Tanzania, United Republic of
+	TableISO3166_UGA TableISO3166 = `UGA` // Uganda
+	TableISO3166_UKR TableISO3166 = `UKR` // Ukraine
+	TableISO3166_UMI TableISO3166 = `UMI` // United States Minor Outlying Islands
+	TableISO3166_URY TableISO3166 = `URY` // Uruguay
+	TableISO3166_USA TableISO3166 = `USA` // United States of America
+	TableISO3166_UZB TableISO3166 = `UZB` // Uzbekistan
+	TableISO3166_VAT TableISO3166 = `VAT` // Holy See
+	TableISO3166_VCT TableISO3166 = `VCT` // Saint Vincent and the Grenadines
+	TableISO3166_VEN TableISO3166 = `VEN` // Venezuela (Bolivarian Republic of)
+	TableISO3166_VGB TableISO3166 = `VGB` // Virgin Islands (British)
+	TableISO3166_VIR TableISO3166 = `VIR` // Virgin Islands (U.S.)
+	TableISO3166_VNM TableISO3166 = `VNM` // Viet Nam
+	TableISO3166_VUT TableISO3166 = `VUT` // Vanuatu
+	TableISO3166_WLF TableISO3166 = `WLF` // Wallis and Futuna
+	TableISO3166_WSM TableISO3166 = `WSM` // Samoa
+	TableISO3166_YEM TableISO3166 = `YEM` // Yemen
+	TableISO3166_ZAF TableISO3166 = `ZAF` // South Africa
+	TableISO3166_ZMB TableISO3166 = `ZMB` // Zambia
+	TableISO3166_ZWE TableISO3166 = `ZWE` // Zimbabwe
+)
+
+// TableID returns the table ID.
+func (TableISO3166) TableID() string { return `ISO3166` }
+
+// Valid reports if the value is in the table.
+func (v TableISO3166) Valid() bool { return TableValueLookup[`ISO3166`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableISO3166) Description() string { return tableDescription(`ISO3166`, string(v)) }
+
+// TableNSC1 is a value of table NSC1, Network Change Type.
+type TableNSC1 string
+
+const (
+	TableNSC1_M  TableNSC1 = `M`  // Migrates to different CPU
+	TableNSC1_SD TableNSC1 = `SD` // Shut down
+	TableNSC1_SU TableNSC1 = `SU` // Start up
+)
+
+// TableID returns the table ID.
+func (TableNSC1) TableID() string { return `NSC1` }
+
+// Valid reports if the value is in the table.
+func (v TableNSC1) Valid() bool { return TableValueLookup[`NSC1`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableNSC1) Description() string { return tableDescription(`NSC1`, string(v)) }
+
+// TableNST3 is a value of table NST3, Network Source Type.
+type TableNST3 string
+
+const (
+	TableNST3_A TableNST3 = `A` // Accept
+	TableNST3_I TableNST3 = `I` // Initiate
+)
+
+// TableID returns the table ID.
+func (TableNST3) TableID() string { return `NST3` }
+
+// Valid reports if the value is in the table.
+func (v TableNST3) Valid() bool { return TableValueLookup[`NST3`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableNST3) Description() string { return tableDescription(`NST3`, string(v)) }
+
+func tableDescription(table, value string) string {
+	for _, row := range TableLookup[table].Row {
+		if row.ID == value {
+			return row.Description
+		}
+	}
+	return ""
+}
@@ -15,8 +15,8 @@ type AD struct {
 	City                       ST      `hl7:"3,display=City"`
 	StateOrProvince            ST      `hl7:"4,display=State or province should be represented by the official postal service codes for that country"`
 	ZipOrPostalCode            ST      `hl7:"5,display=Zip or postal codes should be represented by the official codes for that country.  In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A-9A9"`
-	Country                    ID      `hl7:"6,table=ISO3166,display=Defines  the country of the address.   ISO 3166 provides a list of country codes that may be used"`
-	AddressType                ID      `hl7:"7,table=0190,display=Type is optional and defined by HL7 table 0190 - Address type"`
+	Country                    ID      `hl7:"6,table=ISO3166,datatype=ID,display=Defines  the country of the address.   ISO 3166 provides a list of country codes that may be used"`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Type is optional and defined by HL7 table 0190 - Address type"`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes county- bioregion- SMSA- etc"`
 }
 
@@ -46,9 +46,9 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=0,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=ID Number"`
+	IDNumber                                   NM      `hl7:"1,datatype=NM,display=ID Number"`
 	CheckDigit                                 ST      `hl7:"2,display=The check digit in this data type is not an add-on produced by the message processor.  It is the check digit that is part of the identifying number used in the sending application.  If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null"`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique name of the system that creates the data.  It is an HD data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning authorities are unique across a given HL7 implementation"`
 }
 
@@ -56,9 +56,9 @@ type CK struct {
 type CM_ABS_RANGE struct {
 	HL7              HL7Name   `hl7:",name=CM_ABS_RANGE,len=0,type=d"`
 	Range            *CM_RANGE `hl7:"1,display=Range"`
-	NumericChange    NM        `hl7:"2,display=Numeric Change"`
-	PercentPerChange NM        `hl7:"3,display=Percent Per Change"`
-	Days             NM        `hl7:"4,display=Days"`
+	NumericChange    NM        `hl7:"2,datatype=NM,display=Numeric Change"`
+	PercentPerChange NM        `hl7:"3,datatype=NM,display=Percent Per Change"`
+	Days             NM        `hl7:"4,datatype=NM,display=Days"`
 }
 
 // Authorization Information
@@ -72,16 +72,16 @@ type CM_AUI struct {
 // Charge Time
 type CM_CCD struct {
 	HL7              HL7Name `hl7:",name=CM_CCD,len=0,type=d"`
-	WhenToChargeCode ID      `hl7:"1,table=0100,display=When To Charge Code"`
+	WhenToChargeCode ID      `hl7:"1,table=0100,datatype=ID,display=When To Charge Code"`
 	DateTime         TS      `hl7:"2,format=YMDHMS,display=Date/time"`
 }
 
 // Daily Deductible
 type CM_DDI struct {
 	HL7          HL7Name `hl7:",name=CM_DDI,len=0,type=d"`
-	DelayDays    NM      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	DelayDays    NM      `hl7:"1,datatype=NM,display=Delay Days"`
+	Amount       NM      `hl7:"2,datatype=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,datatype=NM,display=Number Of Days"`
 }
 
 // Activation Date
@@ -94,7 +94,7 @@ type CM_DIN struct {
 // Discharge Location
 type CM_DLD struct {
 	HL7               HL7Name `hl7:",name=CM_DLD,len=0,type=d"`
-	DischargeLocation ID      `hl7:"1,table=0113,display=Discharge Location"`
+	DischargeLocation ID      `hl7:"1,table=0113,datatype=ID,display=Discharge Location"`
 	EffectiveDate     TS      `hl7:"2,format=YMDHMS,display=Effective Date"`
 }
 
@@ -102,16 +102,16 @@ type CM_DLD struct {
 type CM_DLT struct {
 	HL7              HL7Name   `hl7:",name=CM_DLT,len=0,type=d"`
 	Range            *CM_RANGE `hl7:"1,display=The range to which the following applies: <low & high>.  All the ranges are defined in terms of the customary reporting units given in OM2-3-units of measure.  If no value range is given- the check applies to all values"`
-	NumericThreshold NM        `hl7:"2,display=The numeric threshold of the change that is detected- e.g.- 10. "`
+	NumericThreshold NM        `hl7:"2,datatype=NM,display=The numeric threshold of the change that is detected- e.g.- 10. "`
 	Change           ST        `hl7:"3,display=Whether the change is computed as a percent change or an absolute change.  This component can have two possible values:      % Indicates a percent change      a  Absolute change "`
-	LengthOfTimeDays NM        `hl7:"4,display=The length of time that the service retains a value for computing delta checks.  This is recorded in number of days"`
+	LengthOfTimeDays NM        `hl7:"4,datatype=NM,display=The length of time that the service retains a value for computing delta checks.  This is recorded in number of days"`
 }
 
 // Day Type And Number
 type CM_DTN struct {
 	HL7          HL7Name `hl7:",name=CM_DTN,len=0,type=d"`
-	DayType      IS      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	DayType      IS      `hl7:"1,table=0149,datatype=IS,display=Day Type"`
+	NumberOfDays NM      `hl7:"2,datatype=NM,display=Number Of Days"`
 }
 
 // Parent Order
@@ -125,8 +125,8 @@ type CM_EIP struct {
 type CM_ELD struct {
 	HL7                  HL7Name `hl7:",name=CM_ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment ID"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field Position"`
+	Sequence             NM      `hl7:"2,datatype=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,datatype=NM,display=Field Position"`
 	CodeIdentifyingError *CE     `hl7:"4,display=Code Identifying Error"`
 }
 
@@ -142,8 +142,8 @@ type CM_MOC struct {
 // This field contains the message type and trigger event for the message.
 type CM_MSG struct {
 	HL7          HL7Name `hl7:",name=CM_MSG,len=0,type=d"`
-	MessageType  ID      `hl7:"1,table=0076,display=The first component is the message type edited by HL7 table 0076 - Message type"`
-	TriggerEvent ID      `hl7:"2,table=0003,display=The second is the trigger event code edited by HL7 table 0003 - Event type."`
+	MessageType  ID      `hl7:"1,table=0076,datatype=ID,display=The first component is the message type edited by HL7 table 0076 - Message type"`
+	TriggerEvent ID      `hl7:"2,table=0003,datatype=ID,display=The second is the trigger event code edited by HL7 table 0003 - Event type."`
 }
 
 func (d CM_MSG) MessageStructureID() string {
@@ -159,13 +159,13 @@ type CM_NDL struct {
 	OPName             *CN     `hl7:"1,display=OP Name"`
 	StartDateTime      TS      `hl7:"2,format=YMDHMS,display=Start Date/time"`
 	EndDateTime        TS      `hl7:"3,format=YMDHMS,display=End Date/time"`
-	PointOfCare        IS      `hl7:"4,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"5,table=0303,display=Room"`
-	Bed                IS      `hl7:"6,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"4,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"5,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"6,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"7,display=Facility"`
-	LocationStatus     IS      `hl7:"8,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"9,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"10,table=0307,display=Building"`
+	LocationStatus     IS      `hl7:"8,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"9,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"10,table=0307,datatype=IS,display=Building"`
 	Floor              ST      `hl7:"11,display=Floor"`
 }
 
@@ -190,17 +190,17 @@ type CM_OCD struct {
 // The sequencing conditions supported by this 10th component are based on the completion of a  predecessor service.
 type CM_OSD struct {
 	HL7                               HL7Name `hl7:",name=CM_OSD,len=0,type=d"`
-	SequenceResultsFlag               ID      `hl7:"1,table=OSD1,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencin"`
+	SequenceResultsFlag               ID      `hl7:"1,table=OSD1,datatype=ID,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencin"`
 	PlacerOrderNumberEntityIdentifier ST      `hl7:"2,required,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
-	PlacerOrderNumberNamespaceID      IS      `hl7:"3,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
+	PlacerOrderNumberNamespaceID      IS      `hl7:"3,datatype=IS,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
-	FillerOrderNumberNamespaceID      IS      `hl7:"5,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
+	FillerOrderNumberNamespaceID      IS      `hl7:"5,datatype=IS,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	SequenceConditionValue            ST      `hl7:"6,display=The acceptable condition values have the form commonly used in project planning methodologies: <one of “SS”- “EE”- “SE”- or “ES”> +/- <time>   The first letter stands for start (S) or end (E) of predecessor order- where the predecessor is defined by the placer or filler order number in subcomponents 1-2 or subcomponents 3-4.   The second letter stands for the start (S) or end (E) of the successor order- where the successor order is the order containing this quantity/timing specification.   The time specifies the interval between the predecessor and successor starts or ends (see following examples). Where <time> is defined as:     - S<integer> do for <integer> seconds    - M<integer> do for <integer> minutes    - H<integer> do for <integer> hours    - D<integer> do for <integer> days    - W<integer> do for <integer> weeks    - L<integer> do for <integer> months"`
-	MaximumNumberOfRepeats            NM      `hl7:"7,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,datatype=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
-	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
+	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,datatype=ID,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
-	FillerOrderNumberUniversalIDType  ID      `hl7:"11,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
+	FillerOrderNumberUniversalIDType  ID      `hl7:"11,datatype=ID,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
 }
 
 // Occurence Span
@@ -217,23 +217,23 @@ type CM_OSP struct {
 // the certification.
 type CM_PCF struct {
 	HL7                         HL7Name `hl7:",name=CM_PCF,len=0,type=d"`
-	PreCertificationPatientType IS      `hl7:"1,table=0150,display=pre-certification patient type refers to user-defined table 0150 - Pre-certification patient type for suggested values "`
-	PreCertificationRequired    ID      `hl7:"2,table=0136,display=pre-certification required refers to HL7 table 0136 - Yes/no indicator for valid values "`
+	PreCertificationPatientType IS      `hl7:"1,table=0150,datatype=IS,display=pre-certification patient type refers to user-defined table 0150 - Pre-certification patient type for suggested values "`
+	PreCertificationRequired    ID      `hl7:"2,table=0136,datatype=ID,display=pre-certification required refers to HL7 table 0136 - Yes/no indicator for valid values "`
 	PreCertificationWindwow     TS      `hl7:"3,format=YMDHMS,display=Pre-certification Windwow"`
 }
 
 // Penalty
 type CM_PEN struct {
 	HL7           HL7Name `hl7:",name=CM_PEN,len=0,type=d"`
-	PenaltyType   IS      `hl7:"1,table=0148,display=Penalty Type"`
-	PenaltyAmount NM      `hl7:"2,display=Penalty Amount"`
+	PenaltyType   IS      `hl7:"1,table=0148,datatype=IS,display=Penalty Type"`
+	PenaltyAmount NM      `hl7:"2,datatype=NM,display=Penalty Amount"`
 }
 
 // Person Identifier
 type CM_PI struct {
 	HL7                 HL7Name `hl7:",name=CM_PI,len=0,type=d"`
 	IDNumber            ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber      IS      `hl7:"2,display=Type Of ID Number"`
+	TypeOfIDNumber      IS      `hl7:"2,datatype=IS,display=Type Of ID Number"`
 	OtherQualifyingInfo ST      `hl7:"3,display=Other Qualifying Info"`
 }
 
@@ -250,7 +250,7 @@ type CM_PIP struct {
 type CM_PLN struct {
 	HL7                      HL7Name `hl7:",name=CM_PLN,len=0,type=d"`
 	IDNumber                 ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber           IS      `hl7:"2,table=0338,display=Type Of ID Number"`
+	TypeOfIDNumber           IS      `hl7:"2,table=0338,datatype=IS,display=Type Of ID Number"`
 	StateOtherQualifyingInfo ST      `hl7:"3,display=State/other Qualifying Info"`
 	ExpirationDate           DT      `hl7:"4,format=YMD,display=Expiration Date"`
 }
@@ -266,9 +266,9 @@ type CM_PRL struct {
 // Policy Type
 type CM_PTA struct {
 	HL7         HL7Name `hl7:",name=CM_PTA,len=0,type=d"`
-	PolicyType  IS      `hl7:"1,table=0147,display=Policy Type"`
-	AmountClass IS      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	PolicyType  IS      `hl7:"1,table=0147,datatype=IS,display=Policy Type"`
+	AmountClass IS      `hl7:"2,table=0193,datatype=IS,display=Amount Class"`
+	Amount      NM      `hl7:"3,datatype=NM,display=Amount"`
 }
 
 // Wertebereich
@@ -282,7 +282,7 @@ type CM_RANGE struct {
 type CM_RFR struct {
 	HL7            HL7Name   `hl7:",name=CM_RFR,len=0,type=d"`
 	ReferenceRange *CM_RANGE `hl7:"1,display=This subcomponent contains the reference (:normal) range.  The format of this field is where the range is taken to be inclusive (i.e.- the range includes the end points).  In this specification- the units are assumed to be identical to the reporting units given in OM2-3-units of measure)"`
-	Sex            IS        `hl7:"2,table=0001,display=This subcomponent contains the sex of the patient.  Refer to user-defined table 0001 - Sex for suggested values"`
+	Sex            IS        `hl7:"2,table=0001,datatype=IS,display=This subcomponent contains the sex of the patient.  Refer to user-defined table 0001 - Sex for suggested values"`
 	AgeRange       *CM_RANGE `hl7:"3,display=This component contains the age range (in years or fractions thereof) specified as two values separated by a subcomponent delimiter (in order to allow a simple and consistent machine interpretation of this component).  Ages of less than one year should be specified as a fraction (e.g.- 1 month : 0.0830- 1 week : 0.01920- 1 day : 0.0027300).  However- for most purposes involving infants- the gestational age (measured in weeks) is preferred.  The lower end of the range is not indicated; the upper end is- assuring that series of ranges do not overlap"`
 	AgeGestation   *CM_RANGE `hl7:"4,display=This component contains the gestational age and is relevant only when the reference range is influenced by the stage of pregnancy.  A range of values is required.  The gestational age is measured in weeks from conception.  For example- <1&10> implies that the normals apply to gestational ages from 1 week to 4 weeks inclusive (1&4).  The lower end of the range is not included; the upper end is- assuring  that series of age ranges do not overlap"`
 	Species        TX        `hl7:"5,display=This component is assumed to be human unless otherwise stated.  The species should be represented as text (e.g.- rabbit- mouse- rat). "`
@@ -293,9 +293,9 @@ type CM_RFR struct {
 // Room Coverage
 type CM_RMC struct {
 	HL7            HL7Name `hl7:",name=CM_RMC,len=0,type=d"`
-	RoomType       IS      `hl7:"1,table=0145,display=Room Type"`
-	AmountType     IS      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	RoomType       IS      `hl7:"1,table=0145,datatype=IS,display=Room Type"`
+	AmountType     IS      `hl7:"2,table=0146,datatype=IS,display=Amount Type"`
+	CoverageAmount NM      `hl7:"3,datatype=NM,display=Coverage Amount"`
 }
 
 // Specialty
@@ -303,7 +303,7 @@ type CM_SPD struct {
 	HL7                 HL7Name `hl7:",name=CM_SPD,len=0,type=d"`
 	SpecialtyName       ST      `hl7:"1,display=Specialty Name"`
 	GoverningBoard      ST      `hl7:"2,display=Governing Board"`
-	EligibleOrCertified ID      `hl7:"3,table=0337,display=Eligible Or Certified"`
+	EligibleOrCertified ID      `hl7:"3,table=0337,datatype=ID,display=Eligible Or Certified"`
 	DateOfCertification DT      `hl7:"4,format=YMD,display=Date Of Certification"`
 }
 
@@ -321,8 +321,8 @@ type CM_SPS struct {
 // Value Code And Amount
 type CM_UVC struct {
 	HL7         HL7Name `hl7:",name=CM_UVC,len=0,type=d"`
-	ValueCode   IS      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueCode   IS      `hl7:"1,table=0153,datatype=IS,display=Value Code"`
+	ValueAmount NM      `hl7:"2,datatype=NM,display=Value Amount"`
 }
 
 // Value Qualifier
@@ -350,7 +350,7 @@ type CN struct {
 	Suffix              ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III). "`
 	Prefix              ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.). "`
 	Degree              ST      `hl7:"7,display=Used to specify an educational degree (e.g.- MD). "`
-	SourceTable         ID      `hl7:"8,table=0297,display=Refer to user-defined table 0297 - CN ID source  for suggested values.  Used to delineate the first component"`
+	SourceTable         ID      `hl7:"8,table=0297,datatype=ID,display=Refer to user-defined table 0297 - CN ID source  for suggested values.  Used to delineate the first component"`
 	AssigningAuthority  ST      `hl7:"9,display=Assigning Authority"`
 }
 
@@ -361,11 +361,11 @@ type CN struct {
 type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      *MO     `hl7:"1,display=The only required component; usually containing a decimal point. "`
-	PriceType  ID      `hl7:"2,table=0205,display=A coded value- data type ID.  Refer to HL7 table 0205 - Price type for valid values"`
-	FromValue  NM      `hl7:"3,display=Each is an NM data type; together they specify the “range.”  The range can be defined as either time or quantity.  For example- the range can indicate that the first 10 minutes of the procedure has one price.  Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price"`
-	ToValue    NM      `hl7:"4,display=See From value"`
+	PriceType  ID      `hl7:"2,table=0205,datatype=ID,display=A coded value- data type ID.  Refer to HL7 table 0205 - Price type for valid values"`
+	FromValue  NM      `hl7:"3,datatype=NM,display=Each is an NM data type; together they specify the “range.”  The range can be defined as either time or quantity.  For example- the range can indicate that the first 10 minutes of the procedure has one price.  Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price"`
+	ToValue    NM      `hl7:"4,datatype=NM,display=See From value"`
 	RangeUnits *CE     `hl7:"5,display=A coded value- data type CE- defined by the standard table of units for either time or quantity  (see for example- the tables in Section 7.1.4- “Coding schemes”).  This describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (e.g.- count); it is required if <from value> and  <to value> are present"`
-	RangeType  ID      `hl7:"6,table=0298,display=Refers to HL7 table 0298 - CP range type for valid values"`
+	RangeType  ID      `hl7:"6,table=0298,datatype=ID,display=Refers to HL7 table 0298 - CP range type for valid values"`
 }
 
 // Composite Quantity With Units
@@ -379,7 +379,7 @@ type CP struct {
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,datatype=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed.  Field-by-field- default units may be defined within the specifications.  When the observation is measured in the default units- the units need not be transmitted.  If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component.  If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7.  If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7.  But in these cases the component separator should be replaced by the subcomponent delimiter "`
 }
 
@@ -391,9 +391,9 @@ type CX struct {
 	HL7                                        HL7Name `hl7:",name=CX,len=0,type=d"`
 	ID                                         ST      `hl7:"1,display=Defined as in the CK data type (see Section 2.8.5- “CK - composite ID with check digit”) except that an ST data type is allowed instead of an NM data type"`
 	CheckDigit                                 ST      `hl7:"2,display=Defined as in the CK data type (see Section 2.8.5- “CK - composite ID with check digit”) except that an ST data type is allowed instead of an NM data type.  The check digit in this data type is not an add-on produced by the message processor.  It is the check digit that is part of the identifying number used in the sending application.  If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null. "`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique name of the system that creates the data.  It is an HD data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning authorities are unique across a given HL7 implementation. "`
-	IdentifierTypeCode                         IS      `hl7:"5,table=0203,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
+	IdentifierTypeCode                         IS      `hl7:"5,table=0203,datatype=IS,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
 	AssigningFacility                          *HD     `hl7:"6,display=The place or location identifier where the identifier was first assigned to the patient.  This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems"`
 }
 
@@ -404,7 +404,7 @@ type CX struct {
 type DLN struct {
 	HL7                         HL7Name `hl7:",name=DLN,len=0,type=d"`
 	DriverSLicenseNumber        ST      `hl7:"1,display=This field contains the driver’s license number"`
-	IssuingStateProvinceCountry IS      `hl7:"2,display=Issuing authority for driver’s license.  For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes.  Refer to user-defined table 0333 - Driver’s license issuing authority"`
+	IssuingStateProvinceCountry IS      `hl7:"2,datatype=IS,display=Issuing authority for driver’s license.  For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes.  Refer to user-defined table 0333 - Driver’s license issuing authority"`
 	ExpirationDate              DT      `hl7:"3,format=YMD,display=Expiration date (DT) for driver’s license"`
 }
 
@@ -442,15 +442,15 @@ type DT = time.Time
 type EI struct {
 	HL7              HL7Name `hl7:",name=EI,len=0,type=d"`
 	EntityIdentifier ST      `hl7:"1,display=The first component- entity identifier- is usually defined to be unique within the series of identifiers created by the assigning authority- defined by a hierarchic designator- represented by components 2 through 4.  (See Section 2.8.18- “HD - hierarchic designator”.) "`
-	NamespaceID      IS      `hl7:"2,table=0300,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
+	NamespaceID      IS      `hl7:"2,table=0300,datatype=IS,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
 	UniversalID      ST      `hl7:"3,display=The HD’s second component- universal ID (UID)- is a string formatted according to the scheme defined by the third component- universal ID type (UID type).  The UID is intended to be unique over time within the UID type.  It is rigorously defined.  Each UID must belong to one of the specifically enumerated schemes for constructing UID’s (defined by the UID type).  The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). "`
-	UniversalIDType  ID      `hl7:"4,table=0301,display=Refer to HL7 table 0301 - Universal ID type for valid values."`
+	UniversalIDType  ID      `hl7:"4,table=0301,datatype=ID,display=Refer to HL7 table 0301 - Universal ID type for valid values."`
 }
 
 // Financial Class
 type FC struct {
 	HL7            HL7Name `hl7:",name=FC,len=0,type=d"`
-	FinancialClass IS      `hl7:"1,table=0064,display=This component contains the financial class assigned to a person. Refer to user-defined table 0064 - Financial class for suggested values"`
+	FinancialClass IS      `hl7:"1,table=0064,datatype=IS,display=This component contains the financial class assigned to a person. Refer to user-defined table 0064 - Financial class for suggested values"`
 	EffectiveDate  TS      `hl7:"2,format=YMDHMS,display=This component contains the effective date/time of the person’s assignment to the financial class specified in the first component"`
 }
 
@@ -476,9 +476,9 @@ type FT = string
 // must be unique within the series of ID’s defined by that component.
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=0,type=d"`
-	NamespaceID     IS      `hl7:"1,table=0300,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
+	NamespaceID     IS      `hl7:"1,table=0300,datatype=IS,display=Refer to user-defined table 0300 - Namespace ID for suggested values"`
 	UniversalID     ST      `hl7:"2,display=The HD’s second component- Universal ID (UID)- is a string formatted according to the scheme defined by the third component- Universal ID type (UID type).  The UID is intended to be unique over time within the UID type.  It is rigorously defined.  Each UID must belong  to one of the specifically enumerated schemes for constructing UID’s (defined by the UID type).  The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). "`
-	UniversalIDType ID      `hl7:"3,table=0301,display=The third component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
+	UniversalIDType ID      `hl7:"3,table=0301,datatype=ID,display=The third component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
 }
 
 // Coded values for HL7 tables
@@ -501,50 +501,50 @@ type IS = string
 // Job Code Class
 type JCC struct {
 	HL7      HL7Name `hl7:",name=JCC,len=0,type=d"`
-	JobCode  IS      `hl7:"1,table=0327,display=This component contains the person’s  job code.  Refer to user-defined table 0327 - job code"`
-	JobClass IS      `hl7:"2,table=0328,display=This component contains the person’s employee classification."`
+	JobCode  IS      `hl7:"1,table=0327,datatype=IS,display=This component contains the person’s  job code.  Refer to user-defined table 0327 - job code"`
+	JobClass IS      `hl7:"2,table=0328,datatype=IS,display=This component contains the person’s employee classification."`
 }
 
 // Location With Address Information (variant 1)
 type LA1 struct {
 	HL7                HL7Name `hl7:",name=LA1,len=0,type=d"`
-	PointOfCare        IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"2,table=0303,display=Room"`
-	Bed                IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"4,display=Facility"`
-	LocationStatus     IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"7,table=0307,display=Building"`
-	Floor              IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus     IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor              IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	Address            *AD     `hl7:"9,display=Address"`
 }
 
 // Location With Address Information (variant 2)
 type LA2 struct {
 	HL7                        HL7Name `hl7:",name=LA2,len=0,type=d"`
-	PointOfCare                IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room                       IS      `hl7:"2,table=0303,display=Room"`
-	Bed                        IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare                IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room                       IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                        IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility                   *HD     `hl7:"4,display=Facility"`
-	LocationStatus             IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType         IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building                   IS      `hl7:"7,table=0307,display=Building"`
-	Floor                      IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus             IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType         IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building                   IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor                      IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	StreetAddress              ST      `hl7:"9,display=Street Address"`
 	OtherDesignation           ST      `hl7:"10,display=Other Designation"`
 	City                       ST      `hl7:"11,display=City"`
 	StateOrProvince            ST      `hl7:"12,display=State Or Province"`
 	ZipOrPostalCode            ST      `hl7:"13,display=Zip Or Postal Code"`
-	Country                    ID      `hl7:"14,table=ISO3166,display=Country"`
-	AddressType                ID      `hl7:"15,table=0190,display=Address Type"`
+	Country                    ID      `hl7:"14,table=ISO3166,datatype=ID,display=Country"`
+	AddressType                ID      `hl7:"15,table=0190,datatype=ID,display=Address Type"`
 	OtherGeographicDesignation ST      `hl7:"16,display=Other Geographic Designation"`
 }
 
 // Money
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity"`
-	Denomination ID      `hl7:"2,table=ISO4217,display=The second component is the denomination in which the quantity is expressed.  The values for the denomination component are those specified in ISO-4217.  If the denomination is not specified- MSH-17country code is used to determine the default.   Example:  |99.50^USD|  where USD is the ISO 4217 code for the U.S. American dollar. "`
+	Quantity     NM      `hl7:"1,datatype=NM,display=The first component is a quantity"`
+	Denomination ID      `hl7:"2,table=ISO4217,datatype=ID,display=The second component is the denomination in which the quantity is expressed.  The values for the denomination component are those specified in ISO-4217.  If the denomination is not specified- MSH-17country code is used to determine the default.   Example:  |99.50^USD|  where USD is the ISO 4217 code for the U.S. American dollar. "`
 }
 
 // Numeric
@@ -561,14 +561,14 @@ type NM = string
 // within a healthcare setting.
 type PL struct {
 	HL7                HL7Name `hl7:",name=PL,len=0,type=d"`
-	PointOfCare        IS      `hl7:"1,table=0302,display=Conditional on person location type (e.g.- nursing unit or department or clinic).  After floor- most general patient location designation.  Refer to user-defined table 0302 - Point of care for suggested values."`
-	Room               IS      `hl7:"2,table=0303,display=Patient room.  After nursing unit- most general person location designation.  Refer to user-defined table 0303 - Room for suggested values"`
-	Bed                IS      `hl7:"3,table=0304,display=Patient bed.  After room- most general person location designation.  Refer to user-defined table 0304 - Bed for suggested values"`
+	PointOfCare        IS      `hl7:"1,table=0302,datatype=IS,display=Conditional on person location type (e.g.- nursing unit or department or clinic).  After floor- most general patient location designation.  Refer to user-defined table 0302 - Point of care for suggested values."`
+	Room               IS      `hl7:"2,table=0303,datatype=IS,display=Patient room.  After nursing unit- most general person location designation.  Refer to user-defined table 0303 - Room for suggested values"`
+	Bed                IS      `hl7:"3,table=0304,datatype=IS,display=Patient bed.  After room- most general person location designation.  Refer to user-defined table 0304 - Bed for suggested values"`
 	Facility           *HD     `hl7:"4,display=Most general person location designation. (See Section 2.8.18- “HD - hierarchic designator”). "`
-	LocationStatus     IS      `hl7:"5,table=0306,display=Location (e.g.- Bed) status.  Refer to user-defined table 0306 - Location status for suggested values"`
-	PersonLocationType IS      `hl7:"6,table=0305,display=Usually includes values such as nursing unit- department- clinic- SNF- physician’s office.  Refer to userdefined table 0305 - Person location type for suggested values"`
-	Building           IS      `hl7:"7,table=0307,display=After facility- most general person location designation.  Refer to user-defined table 0307 - Building for suggested values"`
-	Floor              IS      `hl7:"8,table=0308,display=After building- most general person location designation.  Refer to user-defined table 0308 - Floor for suggested values"`
+	LocationStatus     IS      `hl7:"5,table=0306,datatype=IS,display=Location (e.g.- Bed) status.  Refer to user-defined table 0306 - Location status for suggested values"`
+	PersonLocationType IS      `hl7:"6,table=0305,datatype=IS,display=Usually includes values such as nursing unit- department- clinic- SNF- physician’s office.  Refer to userdefined table 0305 - Person location type for suggested values"`
+	Building           IS      `hl7:"7,table=0307,datatype=IS,display=After facility- most general person location designation.  Refer to user-defined table 0307 - Building for suggested values"`
+	Floor              IS      `hl7:"8,table=0308,datatype=IS,display=After building- most general person location designation.  Refer to user-defined table 0308 - Floor for suggested values"`
 	LocationType       ST      `hl7:"9,display=A free text description of the location"`
 }
 
@@ -585,12 +585,12 @@ type PPN struct {
 	Suffix                                     ST      `hl7:"5,display=Suffix"`
 	Prefix                                     ST      `hl7:"6,display=Prefix"`
 	Degree                                     ST      `hl7:"7,display=Degree"`
-	SourceTable                                ID      `hl7:"8,table=0297,display=Source Table"`
+	SourceTable                                ID      `hl7:"8,table=0297,datatype=ID,display=Source Table"`
 	AssigningAuthority                         *HD     `hl7:"9,display=In this version- an optional 9th component- the assigning authority (HD)- has been added.  It is an HD data type (see Section 2.8.18- “HD - hierarchic designator”)"`
-	NameTypeCode                               ID      `hl7:"10,table=0200,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.48- “XPN - extended person name”)."`
+	NameTypeCode                               ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.48- “XPN - extended person name”)."`
 	IdentifierCheckDigit                       ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor.  It is the check digit that is part of the identifying number used in the sending application.  If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null"`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,display=Refer to HL7 table 0061 - Check digit scheme for valid values"`
-	IdentifierTypeCode                         IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 table 0061 - Check digit scheme for valid values"`
+	IdentifierTypeCode                         IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
 	AssigningFacility                          *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the patient.  This component is not an inherent part of the identifier but rather part of the history of the identifier:  as part of this data type- its existence is a convenience for certain intercommunicating systems. "`
 	DateTimeActionPerformed                    TS      `hl7:"15,format=YMDHMS,display=This component describes when the activity was performed  Note: If this field is not null- both the performing person and the time stamp must be valued."`
 }
@@ -621,9 +621,9 @@ type QIP struct {
 type QSC struct {
 	HL7                   HL7Name `hl7:",name=QSC,len=0,type=d"`
 	NameOfField           ST      `hl7:"1,display=The name of the field that is participating as a qualifier (usually the “key”).  Refer to Section 2.8.30- “QIP - query input parameter list-” for field naming conventions"`
-	RelationalOperator    ID      `hl7:"2,table=0209,display=Relational Operator"`
+	RelationalOperator    ID      `hl7:"2,table=0209,datatype=ID,display=Relational Operator"`
 	Value                 ST      `hl7:"3,display=The value to which the field will be compared"`
-	RelationalConjunction ID      `hl7:"4,table=0210,display=Refer to HL7 table 0102 - Relation conjunction for valid values.  The relational conjunction- defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next.  - When applied to strings- the relational operators LT- GT- LE- and GE imply an alphabetic comparison.  - A “generic” comparison selects a record for inclusion in the response when the beginning of the designated field matches the select string.   - Where a repeating field is specified as an operand- a match on any instance of that field qualifies the row for inclusion in the response message.  - AND takes precedence over OR.  More sophisticated precedence rules require that the query be expressed as an embedded query language message or a stored procedure query message (see Section 2.19- “ENHANCED MODE QUERY MESSAGES-” and also Sections 2.24.16- “EQL - embedded query language segment-” and 2.24.20- “SPR - stored procedure request definition segment.”"`
+	RelationalConjunction ID      `hl7:"4,table=0210,datatype=ID,display=Refer to HL7 table 0102 - Relation conjunction for valid values.  The relational conjunction- defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next.  - When applied to strings- the relational operators LT- GT- LE- and GE imply an alphabetic comparison.  - A “generic” comparison selects a record for inclusion in the response when the beginning of the designated field matches the select string.   - Where a repeating field is specified as an operand- a match on any instance of that field qualifies the row for inclusion in the response message.  - AND takes precedence over OR.  More sophisticated precedence rules require that the query be expressed as an embedded query language message or a stored procedure query message (see Section 2.19- “ENHANCED MODE QUERY MESSAGES-” and also Sections 2.24.16- “EQL - embedded query language segment-” and 2.24.20- “SPR - stored procedure request definition segment.”"`
 }
 
 // Row Column Definition
@@ -633,7 +633,7 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	HL7ItemNumber      ST      `hl7:"1,display=The HL7 item number- which identifies the field occupying the column.  (Refer to Section 2.8.30- “QIP - query input parameter list-” for item numbering conventions.) "`
 	HL7DateType        ST      `hl7:"2,display=The two or three character HL7 data type- as defined in Section 2.8- “Data types'"`
-	MaximumColumnWidth NM      `hl7:"3,display=The maximum width of the column- as dictated by the responding system.  (This may vary from the HL7defined maximum field length.) "`
+	MaximumColumnWidth NM      `hl7:"3,datatype=NM,display=The maximum width of the column- as dictated by the responding system.  (This may vary from the HL7defined maximum field length.) "`
 }
 
 // Repeat Interval
@@ -643,7 +643,7 @@ type RCD struct {
 // Interval component of the Quantity/Timing field given in Chapter 4, Section 4.4.2 “Interval component (CM).”
 type RI struct {
 	HL7                  HL7Name `hl7:",name=RI,len=0,type=d"`
-	RepeatPattern        IS      `hl7:"1,table=0335,display=The first component is defined by user-defined table 0335 - Repeat pattern. See Section  4.4.2.1 “Repeat pattern-”  for further details"`
+	RepeatPattern        IS      `hl7:"1,table=0335,datatype=IS,display=The first component is defined by user-defined table 0335 - Repeat pattern. See Section  4.4.2.1 “Repeat pattern-”  for further details"`
 	ExplicitTimeInterval ST      `hl7:"2,display=The second component explicitly lists the actual times referenced by the code in the first subcomponent- in the following format: HHMM-HHMM-HHMM-.…  This second subcomponent will be used to clarify the first subcomponent in cases where the actual administration times vary within an institution. See Section 4.4.2.2- “Explicit time interval subcomponent-” for further details."`
 }
 
@@ -655,7 +655,7 @@ type RI struct {
 // time slot, resource, location, or filler override criterion  for an appointment
 type SCV struct {
 	HL7            HL7Name `hl7:",name=SCV,len=0,type=d"`
-	ParameterClass IS      `hl7:"1,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application"`
+	ParameterClass IS      `hl7:"1,datatype=IS,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application"`
 	ParameterValue ST      `hl7:"2,display=The second component is the actual data value for that parameter.  For example- if a filler application allows preference parameters to be passed to specify a preferred start time- a preferred end time- and preferred days of the week for the appointment- it may define the following parameter class codes and valid data sets"`
 }
 
@@ -782,8 +782,8 @@ type VARIES = CE
 // valid values for the first two components
 type VH struct {
 	HL7            HL7Name `hl7:",name=VH,len=0,type=d"`
-	StartDayRange  ID      `hl7:"1,table=0267,display=Starting day of visiting hours range.  See HL7 table 0267 - Days of the week for values"`
-	EndDayRange    ID      `hl7:"2,table=0267,display=Ending day of visiting hours range.  Starting day of visiting hours range.  See HL7 table 0267 - Days of the week for values "`
+	StartDayRange  ID      `hl7:"1,table=0267,datatype=ID,display=Starting day of visiting hours range.  See HL7 table 0267 - Days of the week for values"`
+	EndDayRange    ID      `hl7:"2,table=0267,datatype=ID,display=Ending day of visiting hours range.  Starting day of visiting hours range.  See HL7 table 0267 - Days of the week for values "`
 	StartHourRange TM      `hl7:"3,format=HM,display=Starting hour on starting day of visiting hours range (see first component- 2.8.44.1- “Start day range”). "`
 	EndHourRange   TM      `hl7:"4,format=HM,display=Ending hour on ending day of visiting hours range (see second component- 2.8.44.2- “End day range”). "`
 }
@@ -799,11 +799,11 @@ type XAD struct {
 	City                       ST      `hl7:"3,table=City,display=City"`
 	StateOrProvince            ST      `hl7:"4,table=State,display=tate or province should be represented by the official postal service codes for that country"`
 	ZipOrPostalCode            ST      `hl7:"5,table=ZipCode,display=Zip or postal codes should be represented by the official codes for that country.  In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A-9A9"`
-	Country                    ID      `hl7:"6,table=ISO3166,display=Defines the country of the address.  ISO 3166 provides a list of country codes that may be used"`
-	AddressType                ID      `hl7:"7,table=0190,display=Address type is optional and defined by HL7 table 0190 - Address type"`
+	Country                    ID      `hl7:"6,table=ISO3166,datatype=ID,display=Defines the country of the address.  ISO 3166 provides a list of country codes that may be used"`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Address type is optional and defined by HL7 table 0190 - Address type"`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes country- bioregion- SMSA- etc"`
-	CountyParishCode           IS      `hl7:"9,table=0289,display=A code that represents the county in which the specified address resides. Refer to user-defined table 0289 - County/parish.  When this component is used to represent the county (or parish)- component 8 “other geographic designation” should not duplicate it (i.e.- the use of “other geographic designation” to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7).  Allowable values:  codes defined by government"`
-	CensusTract                IS      `hl7:"10,table=0288,display=A code that represents the census track in which the specified address resides. Refer to user-defined table 0288 - Census tract.  Allowable Values:  codes defined by government"`
+	CountyParishCode           IS      `hl7:"9,table=0289,datatype=IS,display=A code that represents the county in which the specified address resides. Refer to user-defined table 0289 - County/parish.  When this component is used to represent the county (or parish)- component 8 “other geographic designation” should not duplicate it (i.e.- the use of “other geographic designation” to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7).  Allowable values:  codes defined by government"`
+	CensusTract                IS      `hl7:"10,table=0288,datatype=IS,display=A code that represents the census track in which the specified address resides. Refer to user-defined table 0288 - Census tract.  Allowable Values:  codes defined by government"`
 }
 
 // Extended Composite ID Number And Name
@@ -819,12 +819,12 @@ type XCN struct {
 	Suffix                                     ST      `hl7:"5,display=Suffix"`
 	Prefix                                     ST      `hl7:"6,display=Prefix"`
 	Degree                                     ST      `hl7:"7,display=Degree"`
-	SourceTable                                IS      `hl7:"8,table=0297,display=Refer to user-defined table 0207 - CN ID source for suggested values.  Used to delineate the first component. "`
+	SourceTable                                IS      `hl7:"8,table=0297,datatype=IS,display=Refer to user-defined table 0207 - CN ID source for suggested values.  Used to delineate the first component. "`
 	AssigningAuthority                         *HD     `hl7:"9,display= In this version an optional 9th component- the assigning authority (HD)- has been added.  It is an HD data type (see Section 2.8.18- “HD - hierarchic designator”). "`
-	NameType                                   ID      `hl7:"10,table=0200,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.48- “XPN - extended person name”). "`
+	NameType                                   ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.48- “XPN - extended person name”). "`
 	IdentifierCheckDigit                       ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor.  It is the check digit that is part of the identifying number used in the sending application.  If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null"`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,display=Refer to HL7 table 0060 - Check digit scheme for valid values"`
-	IdentifierTypeCode                         IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 table 0060 - Check digit scheme for valid values"`
+	IdentifierTypeCode                         IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
 	AssigningFacilityID                        *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the patient.  This component is not an inherent part of the identifier but rather part of the history of the identifier:  as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 }
 
@@ -835,12 +835,12 @@ type XCN struct {
 type XON struct {
 	HL7                                        HL7Name `hl7:",name=XON,len=0,type=d"`
 	OrganizationName                           ST      `hl7:"1,display=The name of the specified organization"`
-	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name.  Refer to user-defined table 0204 - Organizational name type for suggested values. "`
-	IDNumber                                   NM      `hl7:"3,display=ID Number"`
+	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,datatype=IS,display=A code that represents the type of name i.e.- legal name- display name.  Refer to user-defined table 0204 - Organizational name type for suggested values. "`
+	IDNumber                                   NM      `hl7:"3,datatype=NM,display=ID Number"`
 	CheckDigit                                 ST      `hl7:"4,display=The check digit in this data type is not an add-on produced by the message processor.  It is the check digit that is part of the identifying number used in the sending application.  If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null"`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme"`
 	AssigningAuthority                         *HD     `hl7:"6,display=The assigning authority is a unique name of the system that creates the data.  It is an HD data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning authorities are unique across a given HL7 implementation"`
-	IdentifierTypeCode                         IS      `hl7:"7,table=0203,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
+	IdentifierTypeCode                         IS      `hl7:"7,table=0203,datatype=IS,display=A code corresponding to the type of identifier.  In some cases- this code may be used as a qualifier to the “Assigning authority” component.  Refer to user-defined table 0203 - Identifier type for suggested values"`
 	AssigningFacilityID                        *HD     `hl7:"8,display=The assigning facility is a unique identifier of the system that creates the data.  It is an HD data type.  It is equivalent to the application ID of the placer or filler order number (see Chapter 4).  Assigning authorities are unique across a given HL7 implementation"`
 }
 
@@ -856,8 +856,8 @@ type XPN struct {
 	Suffix                 ST      `hl7:"4,display=Suffix"`
 	Prefix                 ST      `hl7:"5,display=Prefix"`
 	Degree                 ST      `hl7:"6,display=Degree"`
-	NameTypeCode           ID      `hl7:"7,table=0200,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values"`
-	NameRepresentationCode ID      `hl7:"8,table=4000,display=In general this component provides an indication of the representation provided by the data item.  It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired.  This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying"`
+	NameTypeCode           ID      `hl7:"7,table=0200,datatype=ID,display=A code that represents the type of name.  Refer to HL7 table 0200 - Name type for valid values"`
+	NameRepresentationCode ID      `hl7:"8,table=4000,datatype=ID,display=In general this component provides an indication of the representation provided by the data item.  It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired.  This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying"`
 }
 
 // Extended Telecommunication Number
@@ -867,12 +867,12 @@ type XPN struct {
 type XTN struct {
 	HL7                            HL7Name `hl7:",name=XTN,len=0,type=d"`
 	TelephoneNumber                TN      `hl7:"1,table=PhoneNumber,display=Defined as the TN data type ( see Section 2.8.40- “TN - telephone number”)- except that the length of the country access code has been increased to three"`
-	TelecommunicationUseCode       ID      `hl7:"2,table=0201,display=A code that represents a specific use of a telecommunication number.  Refer to HL7 table 0201 - Telecommunication use code for valid values"`
-	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,display=A code that represents the type of telecommunication equipment.  Refer to HL7 table 0202 - Telecommunication equipment type for valid values"`
+	TelecommunicationUseCode       ID      `hl7:"2,table=0201,datatype=ID,display=A code that represents a specific use of a telecommunication number.  Refer to HL7 table 0201 - Telecommunication use code for valid values"`
+	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,datatype=ID,display=A code that represents the type of telecommunication equipment.  Refer to HL7 table 0202 - Telecommunication equipment type for valid values"`
 	EmailAddress                   ST      `hl7:"4,display=Note: Components five through nine reiterate the basic function of the first component in a delimited form that allows the expression of both local and international telephone numbers.  In Version 2.3- the recommended form for the telephone number is to use the delimited form rather than the unstructured form supported by the first component (which is left in for backward compatibility only). "`
-	CountryCode                    NM      `hl7:"5,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,display=Area/city Code"`
-	PhoneNumber                    NM      `hl7:"7,display=Phone Number"`
-	Extension                      NM      `hl7:"8,display=Extension"`
+	CountryCode                    NM      `hl7:"5,datatype=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,datatype=NM,display=Area/city Code"`
+	PhoneNumber                    NM      `hl7:"7,datatype=NM,display=Phone Number"`
+	Extension                      NM      `hl7:"8,datatype=NM,display=Extension"`
 	AnyText                        ST      `hl7:"9,display=Any Text"`
 }
@@ -11,8 +11,8 @@ type ACC struct {
 	AccidentCode                *CE     `hl7:"2,len=60,table=0050,display=Accident Code"`
 	AccidentLocation            ST      `hl7:"3,len=25,display=Accident Location"`
 	AutoAccidentState           *CE     `hl7:"4,len=60,display=Auto Accident State"`
-	AccidentJobRelatedIndicator ID      `hl7:"5,len=2,table=0136,display=Accident Job Related Indicator"`
-	AccidentDeathIndicator      ID      `hl7:"6,len=2,table=0136,display=Accident Death Indicator"`
+	AccidentJobRelatedIndicator ID      `hl7:"5,len=2,table=0136,datatype=ID,display=Accident Job Related Indicator"`
+	AccidentDeathIndicator      ID      `hl7:"6,len=2,table=0136,datatype=ID,display=Accident Death Indicator"`
 }
 
 // Addendum
@@ -33,19 +33,19 @@ type ADD struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIG"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIG"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceID               *CE     `hl7:"3,conditional,len=200,display=Resource ID"`
 	ResourceType             CE      `hl7:"4,required,len=200,display=Resource Type"`
 	ResourceGroup            []CE    `hl7:"5,len=200,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,len=5,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,len=5,datatype=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CE     `hl7:"7,len=200,display=Resource Quantity Units"`
 	StartDateTime            TS      `hl7:"8,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"10,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,len=20,display=Duration"`
+	Duration                 NM      `hl7:"11,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"12,len=200,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"14,conditional,len=200,table=0278,display=Filler Status Code"`
 }
 
@@ -58,17 +58,17 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIL"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIL"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	LocationResourceID       *PL     `hl7:"3,conditional,len=80,display=Location Resource ID"`
 	LocationType             CE      `hl7:"4,required,len=200,display=Location Type"`
 	LocationGroup            *CE     `hl7:"5,len=200,display=Location Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=200,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=200,table=0278,display=Filler Status Code"`
 }
 
@@ -81,17 +81,17 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIP"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIP"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	PersonnelResourceID      *XCN    `hl7:"3,conditional,len=80,display=Personnel Resource ID"`
 	ResourceRole             CE      `hl7:"4,required,len=200,display=Resource Role"`
 	ResourceGroup            *CE     `hl7:"5,len=200,display=Resource Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=200,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=200,table=0278,display=Filler Status Code"`
 }
 
@@ -102,15 +102,15 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                        HL7Name `hl7:",name=AIS,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - AIS"`
-	SegmentActionCode          ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIS"`
+	SegmentActionCode          ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	UniversalServiceIdentifier CE      `hl7:"3,required,len=200,display=Universal Service Identifier"`
 	StartDateTime              TS      `hl7:"4,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset        NM      `hl7:"5,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset        NM      `hl7:"5,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits   *CE     `hl7:"6,conditional,len=200,display=Start Date/Time Offset Units"`
-	Duration                   NM      `hl7:"7,len=20,display=Duration"`
+	Duration                   NM      `hl7:"7,len=20,datatype=NM,display=Duration"`
 	DurationUnits              *CE     `hl7:"8,len=200,display=Duration Units"`
-	AllowSubstitutionCode      IS      `hl7:"9,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode      IS      `hl7:"9,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode           *CE     `hl7:"10,conditional,len=200,table=0278,display=Filler Status Code"`
 }
 
@@ -120,10 +120,10 @@ type AIS struct {
 // tables.  Each AL1 segment describes a single patient allergy.
 type AL1 struct {
 	HL7                            HL7Name `hl7:",name=AL1,type=s"`
-	SetID                          SI      `hl7:"1,seq,required,len=4,display=Set ID - AL1"`
-	AllergyType                    IS      `hl7:"2,len=2,table=0127,display=Allergy Type"`
+	SetID                          SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AL1"`
+	AllergyType                    IS      `hl7:"2,len=2,table=0127,datatype=IS,display=Allergy Type"`
 	AllergyCodeMnemonicDescription CE      `hl7:"3,required,len=60,display=Allergy Code/Mnemonic/ Description"`
-	AllergySeverity                IS      `hl7:"4,len=2,table=0128,display=Allergy Severity"`
+	AllergySeverity                IS      `hl7:"4,len=2,table=0128,datatype=IS,display=Allergy Severity"`
 	AllergyReaction                ST      `hl7:"5,len=15,display=Allergy Reaction"`
 	IdentificationDate             DT      `hl7:"6,len=8,format=YMD,display=Identification Date"`
 }
@@ -142,7 +142,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,len=80,table=0294,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,len=80,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,len=80,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,len=5,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,len=5,datatype=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,len=80,display=Filler Override Criteria"`
 }
 
@@ -154,13 +154,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,len=75,display=Placer Group Number"`
 	ScheduleID                  *CE     `hl7:"5,len=200,display=Schedule ID"`
 	RequestEventReason          *CE     `hl7:"6,len=200,display=Request Event Reason"`
 	AppointmentReason           *CE     `hl7:"7,len=200,table=0276,display=Appointment Reason"`
 	AppointmentType             *CE     `hl7:"8,len=200,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CE     `hl7:"10,len=200,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,len=53,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,len=5,display=Priority"`
@@ -190,8 +190,8 @@ type AUT struct {
 	AuthorizationExpirationDate  TS      `hl7:"5,len=26,format=YMDHMS,display=Authorization Expiration Date"`
 	AuthorizationIdentifier      *EI     `hl7:"6,conditional,len=30,display=Authorization Identifier"`
 	ReimbursementLimit           *CP     `hl7:"7,len=25,display=Reimbursement Limit"`
-	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,display=Requested Number of Treatments"`
-	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,display=Authorized Number of Treatments"`
+	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,datatype=NM,display=Requested Number of Treatments"`
+	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,datatype=NM,display=Authorized Number of Treatments"`
 	ProcessDate                  TS      `hl7:"10,len=26,format=YMDHMS,display=Process Date"`
 }
 
@@ -200,8 +200,8 @@ type AUT struct {
 // The BHS segment defines the start of a batch.
 type BHS struct {
 	HL7                       HL7Name `hl7:",name=BHS,type=s"`
-	BatchFieldSeparator       ST      `hl7:"1,required,len=1,display=Batch Field Separator"`
-	BatchEncodingCharacters   ST      `hl7:"2,required,len=3,display=Batch Encoding Characters"`
+	BatchFieldSeparator       ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=Batch Field Separator"`
+	BatchEncodingCharacters   ST      `hl7:"2,noescape,fieldchars,required,len=3,display=Batch Encoding Characters"`
 	BatchSendingApplication   ST      `hl7:"3,len=15,display=Batch Sending Application"`
 	BatchSendingFacility      ST      `hl7:"4,len=20,display=Batch Sending Facility"`
 	BatchReceivingApplication ST      `hl7:"5,len=15,display=Batch Receiving Application"`
@@ -220,7 +220,7 @@ type BHS struct {
 type BLG struct {
 	HL7          HL7Name `hl7:",name=BLG,type=s"`
 	WhenToCharge *CM_CCD `hl7:"1,len=40,display=When to Charge"`
-	ChargeType   ID      `hl7:"2,len=50,table=0122,display=Charge Type"`
+	ChargeType   ID      `hl7:"2,len=50,table=0122,datatype=ID,display=Charge Type"`
 	AccountID    *CK     `hl7:"3,len=100,display=Account ID"`
 }
 
@@ -231,7 +231,7 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,len=10,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,len=80,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,len=100,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,len=100,datatype=NM,display=Batch Totals"`
 }
 
 // Charge Description Master
@@ -254,15 +254,15 @@ type CDM struct {
 	ChargeCodeAlias              []CE    `hl7:"2,len=200,display=Charge Code Alias"`
 	ChargeDescriptionShort       ST      `hl7:"3,required,len=20,display=Charge Description Short"`
 	ChargeDescriptionLong        ST      `hl7:"4,len=250,display=Charge Description Long"`
-	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,display=Description Override Indicator"`
+	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,datatype=IS,display=Description Override Indicator"`
 	ExplodingCharges             []CE    `hl7:"6,len=60,display=Exploding Charges"`
 	ProcedureCode                []CE    `hl7:"7,len=200,display=Procedure Code"`
-	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	InventoryNumber              []CE    `hl7:"9,len=60,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,len=12,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,len=12,datatype=NM,display=Resource Load"`
 	ContractNumber               []CK    `hl7:"11,len=200,display=Contract Number"`
 	ContractOrganization         *XON    `hl7:"12,len=200,display=Contract Organization"`
-	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
+	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Room Fee Indicator"`
 }
 
 // Clinical Study Master
@@ -273,13 +273,13 @@ type CDM struct {
 // unless otherwise agreed upon
 type CM0 struct {
 	HL7                 HL7Name `hl7:",name=CM0,type=s"`
-	SetID               SI      `hl7:"1,seq,len=4,display=CM0 - Set ID"`
+	SetID               SI      `hl7:"1,seq,len=4,datatype=SI,display=CM0 - Set ID"`
 	SponsorStudyID      EI      `hl7:"2,required,len=60,display=Sponsor Study ID"`
 	AlternateStudyID    []CE    `hl7:"3,max=3,len=60,display=Alternate Study ID"`
 	TitleOfStudy        ST      `hl7:"4,required,len=300,display=Title of Study"`
 	ChairmanOfStudy     *XCN    `hl7:"5,len=60,display=Chairman of Study"`
 	LastIRBApprovalDate DT      `hl7:"6,len=8,format=YMD,display=Last IRB Approval Date"`
-	TotalAccrualToDate  NM      `hl7:"7,len=8,display=Total Accrual to Date"`
+	TotalAccrualToDate  NM      `hl7:"7,len=8,datatype=NM,display=Total Accrual to Date"`
 	LastAccrualDate     DT      `hl7:"8,len=8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy     *XCN    `hl7:"9,len=60,display=Contact for Study"`
 	ContactsTelNumber   *XTN    `hl7:"10,len=40,display=Contact's Tel. Number"`
@@ -294,7 +294,7 @@ type CM0 struct {
 // for the receiving system.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=CM1 - Set ID"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=CM1 - Set ID"`
 	StudyPhaseIdentifier    CE      `hl7:"2,required,len=60,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,len=300,display=Description of Study Phase"`
 }
@@ -306,7 +306,7 @@ type CM1 struct {
 // sequence 2.  The CM2 segment describes the scheduled time points in general.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=CM2 - Set ID"`
+	SetID                        SI      `hl7:"1,seq,len=4,datatype=SI,display=CM2 - Set ID"`
 	ScheduledTimePoint           CE      `hl7:"2,required,len=60,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,len=300,display=Description of Time Point"`
 	EventsScheduledThisTimePoint []CE    `hl7:"4,required,max=200,len=60,display=Events Scheduled This Time Point"`
@@ -408,10 +408,10 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - DB1"`
-	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,display=Disabled person code"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - DB1"`
+	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,datatype=IS,display=Disabled person code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,len=32,display=Disabled person identifier"`
-	DisabledIndicator          ID      `hl7:"4,len=1,table=0136,display=Disabled Indicator"`
+	DisabledIndicator          ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Disabled Indicator"`
 	DisabilityStartDate        DT      `hl7:"5,len=8,format=YMD,display=Disability start date"`
 	DisabilityEndDate          DT      `hl7:"6,len=8,format=YMD,display=Disability end date"`
 	DisabilityReturnToWorkDate DT      `hl7:"7,len=8,format=YMD,display=Disability return to work date"`
@@ -427,24 +427,24 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                     HL7Name `hl7:",name=DG1,type=s"`
-	SetIDDiagnosis          SI      `hl7:"1,required,len=4,display=Set ID - Diagnosis"`
-	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,display=Diagnosis Coding Method"`
+	SetIDDiagnosis          SI      `hl7:"1,required,len=4,datatype=SI,display=Set ID - Diagnosis"`
+	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,datatype=ID,display=Diagnosis Coding Method"`
 	DiagnosisCode           *CE     `hl7:"3,len=60,table=0051,display=Diagnosis Code"`
 	DiagnosisDescription    ST      `hl7:"4,len=40,display=Diagnosis Description"`
 	DiagnosisDateTime       TS      `hl7:"5,len=26,format=YMDHMS,display=Diagnosis Date/Time"`
-	DiagnosisType           IS      `hl7:"6,required,len=2,table=0052,display=Diagnosis Type"`
+	DiagnosisType           IS      `hl7:"6,required,len=2,table=0052,datatype=IS,display=Diagnosis Type"`
 	MajorDiagnosticCategory CE      `hl7:"7,len=60,table=0118,display=Major Diagnostic Category"`
 	DiagnosticRelatedGroup  CE      `hl7:"8,len=60,table=0055,display=Diagnostic Related Group"`
-	DRGApprovalIndicator    ID      `hl7:"9,len=2,table=0136,display=DRG Approval Indicator"`
-	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,display=DRG Grouper Review Code"`
+	DRGApprovalIndicator    ID      `hl7:"9,len=2,table=0136,datatype=ID,display=DRG Approval Indicator"`
+	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,datatype=IS,display=DRG Grouper Review Code"`
 	OutlierType             CE      `hl7:"11,len=60,table=0083,display=Outlier Type"`
-	OutlierDays             NM      `hl7:"12,len=3,display=Outlier Days"`
+	OutlierDays             NM      `hl7:"12,len=3,datatype=NM,display=Outlier Days"`
 	OutlierCost             CP      `hl7:"13,len=12,display=Outlier Cost"`
 	GrouperVersionAndType   ST      `hl7:"14,len=4,display=Grouper Version and Type"`
-	DiagnosisPriority       NM      `hl7:"15,len=2,display=Diagnosis Priority"`
+	DiagnosisPriority       NM      `hl7:"15,len=2,datatype=NM,display=Diagnosis Priority"`
 	DiagnosingClinician     []XCN   `hl7:"16,len=60,display=Diagnosing Clinician"`
-	DiagnosisClassification IS      `hl7:"17,len=3,table=0228,display=Diagnosis Classification"`
-	ConfidentialIndicator   ID      `hl7:"18,len=1,table=0136,display=Confidential Indicator"`
+	DiagnosisClassification IS      `hl7:"17,len=3,table=0228,datatype=IS,display=Diagnosis Classification"`
+	ConfidentialIndicator   ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 	AttestationDateTime     TS      `hl7:"19,len=26,format=YMDHMS,display=Attestation Date/Time"`
 }
 
@@ -456,14 +456,14 @@ type DRG struct {
 	HL7                    HL7Name `hl7:",name=DRG,type=s"`
 	DiagnosticRelatedGroup *CE     `hl7:"1,len=60,table=0055,display=Diagnostic Related Group"`
 	AssignedDateTime       TS      `hl7:"2,len=26,format=YMDHMS,display=DRG Assigned Date/Time"`
-	ApprovalIndicator      ID      `hl7:"3,len=2,table=0136,display=DRG Approval Indicator"`
-	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,display=DRG Grouper Review Code"`
+	ApprovalIndicator      ID      `hl7:"3,len=2,table=0136,datatype=ID,display=DRG Approval Indicator"`
+	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,datatype=IS,display=DRG Grouper Review Code"`
 	OutlierType            *CE     `hl7:"5,len=60,table=0083,display=Outlier Type"`
-	OutlierDays            NM      `hl7:"6,len=3,display=Outlier Days"`
+	OutlierDays            NM      `hl7:"6,len=3,datatype=NM,display=Outlier Days"`
 	OutlierCost            *CP     `hl7:"7,len=12,display=Outlier Cost"`
-	Payor                  IS      `hl7:"8,len=1,table=0229,display=DRG Payor"`
+	Payor                  IS      `hl7:"8,len=1,table=0229,datatype=IS,display=DRG Payor"`
 	OutlierReimbursement   *CP     `hl7:"9,len=9,display=Outlier Reimbursement"`
-	ConfidentialIndicator  ID      `hl7:"10,len=1,table=0136,display=Confidential Indicator"`
+	ConfidentialIndicator  ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 }
 
 // Continuation pointer segment
@@ -480,8 +480,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetIDDisplayData  SI      `hl7:"1,len=4,display=Set ID - Display Data"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetIDDisplayData  SI      `hl7:"1,len=4,datatype=SI,display=Set ID - Display Data"`
+	DisplayLevel      SI      `hl7:"2,len=4,datatype=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result ID"`
@@ -494,7 +494,7 @@ type DSP struct {
 type EQL struct {
 	HL7                     HL7Name `hl7:",name=EQL,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/ Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/ Response Format Code"`
 	QueryName               CE      `hl7:"3,required,len=60,display=EQL Query Name"`
 	QueryStatement          ST      `hl7:"4,required,len=4096,display=EQL Query Statement"`
 }
@@ -526,10 +526,10 @@ type ERR struct {
 // for all chapters are contained in HL7 table 0003 - Event type.
 type EVN struct {
 	HL7                  HL7Name `hl7:",name=EVN,type=s"`
-	EventTypeCode        ID      `hl7:"1,len=3,table=0003,display=Event Type Code"`
+	EventTypeCode        ID      `hl7:"1,len=3,table=0003,datatype=ID,display=Event Type Code"`
 	RecordedDateTime     TS      `hl7:"2,required,len=26,format=YMDHMS,display=Recorded Date/Time"`
 	DateTimePlannedEvent TS      `hl7:"3,len=26,format=YMDHMS,display=Date/Time Planned Event"`
-	EventReasonCode      IS      `hl7:"4,len=3,table=0062,display=Event Reason Code"`
+	EventReasonCode      IS      `hl7:"4,len=3,table=0062,datatype=IS,display=Event Reason Code"`
 	OperatorID           *XCN    `hl7:"5,len=60,table=0188,display=Operator ID"`
 	EventOccurred        TS      `hl7:"6,len=26,format=YMDHMS,display=Event Occurred"`
 }
@@ -538,7 +538,7 @@ type EVN struct {
 type FAC struct {
 	HL7                                 HL7Name `hl7:",name=FAC,type=s"`
 	FacilityID                          EI      `hl7:"1,required,len=20,display=Facility ID"`
-	FacilityType                        ID      `hl7:"2,len=1,table=0331,display=Facility Type"`
+	FacilityType                        ID      `hl7:"2,len=1,table=0331,datatype=ID,display=Facility Type"`
 	FacilityAddress                     XAD     `hl7:"3,required,len=200,display=Facility Address"`
 	FacilityTelecommunication           XTN     `hl7:"4,required,len=44,display=Facility Telecommunication"`
 	ContactPerson                       []XCN   `hl7:"5,len=60,display=Contact Person"`
@@ -556,8 +556,8 @@ type FAC struct {
 // The FHS segment is used to head a file (group of batches) as defined in Section 2.23.3, “HL7 batch protocol.”
 type FHS struct {
 	HL7                      HL7Name `hl7:",name=FHS,type=s"`
-	FileFieldSeparator       ST      `hl7:"1,required,len=1,display=File Field Separator"`
-	FileEncodingCharacters   ST      `hl7:"2,required,len=4,display=File Encoding Characters"`
+	FileFieldSeparator       ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=File Field Separator"`
+	FileEncodingCharacters   ST      `hl7:"2,noescape,fieldchars,required,len=4,display=File Encoding Characters"`
 	FileSendingApplication   ST      `hl7:"3,len=15,display=File Sending Application"`
 	FileSendingFacility      ST      `hl7:"4,len=20,display=File Sending Facility"`
 	FileReceivingApplication ST      `hl7:"5,len=15,display=File Receiving Application"`
@@ -576,24 +576,24 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                             HL7Name `hl7:",name=FT1,type=s"`
-	SetIDFinancialTransaction       SI      `hl7:"1,len=4,display=Set ID - Financial Transaction"`
+	SetIDFinancialTransaction       SI      `hl7:"1,len=4,datatype=SI,display=Set ID - Financial Transaction"`
 	TransactionID                   ST      `hl7:"2,len=12,display=Transaction ID"`
 	TransactionBatchID              ST      `hl7:"3,len=10,display=Transaction Batch ID"`
 	TransactionDate                 TS      `hl7:"4,required,len=26,format=YMDHMS,display=Transaction Date"`
 	TransactionPostingDate          TS      `hl7:"5,len=26,format=YMDHMS,display=Transaction Posting Date"`
-	TransactionType                 IS      `hl7:"6,required,len=8,table=0017,display=Transaction Type"`
+	TransactionType                 IS      `hl7:"6,required,len=8,table=0017,datatype=IS,display=Transaction Type"`
 	TransactionCode                 CE      `hl7:"7,required,len=80,table=0132,display=Transaction Code"`
 	TransactionDescription          ST      `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlternate ST      `hl7:"9,len=40,display=Transaction Description - alternate"`
-	TransactionQuantity             NM      `hl7:"10,len=6,display=Transaction Quantity"`
+	TransactionQuantity             NM      `hl7:"10,len=6,datatype=NM,display=Transaction Quantity"`
 	TransactionAmountExtended       *CP     `hl7:"11,len=12,display=Transaction Amount - Extended"`
 	TransactionAmountUnit           *CP     `hl7:"12,len=12,display=Transaction Amount - Unit"`
 	DepartmentCode                  *CE     `hl7:"13,len=60,table=0049,display=Department Code"`
 	InsurancePlanID                 *CE     `hl7:"14,len=60,table=0072,display=Insurance Plan ID"`
 	InsuranceAmount                 *CP     `hl7:"15,len=12,display=Insurance Amount"`
 	AssignedPatientLocation         *PL     `hl7:"16,len=80,display=Assigned Patient Location"`
-	FeeSchedule                     IS      `hl7:"17,len=1,table=0024,display=Fee Schedule"`
-	PatientType                     IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	FeeSchedule                     IS      `hl7:"17,len=1,table=0024,datatype=IS,display=Fee Schedule"`
+	PatientType                     IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	DiagnosisCode                   []CE    `hl7:"19,len=60,table=0051,display=Diagnosis Code"`
 	PerformedByCode                 *XCN    `hl7:"20,len=120,table=0084,display=Performed By Code"`
 	OrderedByCode                   *XCN    `hl7:"21,len=120,display=Ordered By Code"`
@@ -608,7 +608,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,datatype=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -621,12 +621,12 @@ type FTS struct {
 // version of that data.
 type GOL struct {
 	HL7                             HL7Name `hl7:",name=GOL,type=s"`
-	ActionCode                      ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                      ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime                  TS      `hl7:"2,required,len=26,format=YMDHMS,display=Action Date/Time"`
 	GoalID                          CE      `hl7:"3,required,len=80,display=Goal ID"`
 	GoalInstanceID                  EI      `hl7:"4,required,len=60,display=Goal Instance ID"`
 	EpisodeOfCareID                 *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	GoalListPriority                NM      `hl7:"6,len=60,display=Goal List Priority"`
+	GoalListPriority                NM      `hl7:"6,len=60,datatype=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime         TS      `hl7:"7,len=26,format=YMDHMS,display=Goal Established Date/Time"`
 	ExpectedGoalAchievementDateTime TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Goal Achievement Date/Time"`
 	GoalClassification              *CE     `hl7:"9,len=80,display=Goal Classification"`
@@ -650,7 +650,7 @@ type GOL struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetIDGuarantor                     SI      `hl7:"1,required,len=4,display=Set ID - Guarantor"`
+	SetIDGuarantor                     SI      `hl7:"1,required,len=4,datatype=SI,display=Set ID - Guarantor"`
 	GuarantorNumber                    []CX    `hl7:"2,len=59,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,len=48,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,len=48,display=Guarantor Spouse Name"`
@@ -658,53 +658,53 @@ type GT1 struct {
 	GuarantorPhNumHome                 []XTN   `hl7:"6,len=40,display=Guarantor Ph Num- Home"`
 	GuarantorPhNumBusiness             []XTN   `hl7:"7,len=40,display=Guarantor Ph Num-Business"`
 	GuarantorDateTimeOfBirth           TS      `hl7:"8,len=26,format=YMDHMS,display=Guarantor Date/Time of Birth"`
-	GuarantorSex                       IS      `hl7:"9,len=1,table=0001,display=Guarantor Sex"`
-	GuarantorType                      IS      `hl7:"10,len=2,table=0068,display=Guarantor Type"`
-	GuarantorRelationship              IS      `hl7:"11,len=2,table=0063,display=Guarantor Relationship"`
+	GuarantorSex                       IS      `hl7:"9,len=1,table=0001,datatype=IS,display=Guarantor Sex"`
+	GuarantorType                      IS      `hl7:"10,len=2,table=0068,datatype=IS,display=Guarantor Type"`
+	GuarantorRelationship              IS      `hl7:"11,len=2,table=0063,datatype=IS,display=Guarantor Relationship"`
 	GuarantorSSN                       ST      `hl7:"12,len=11,display=Guarantor SSN"`
 	GuarantorDateBegin                 DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,datatype=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,len=130,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,len=106,display=Guarantor Employer Address"`
 	GuarantorEmployPhoneNumber         []XTN   `hl7:"18,len=40,display=Guarantor Employ Phone Number"`
 	GuarantorEmployeeIDNumber          []CX    `hl7:"19,len=20,display=Guarantor Employee ID Number"`
-	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,display=Guarantor Employment Status"`
+	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,datatype=IS,display=Guarantor Employment Status"`
 	GuarantorOrganization              []XON   `hl7:"21,len=130,display=Guarantor Organization"`
-	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,display=Guarantor Billing Hold Flag"`
+	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Guarantor Billing Hold Flag"`
 	GuarantorCreditRatingCode          *CE     `hl7:"23,len=80,display=Guarantor Credit Rating Code"`
 	GuarantorDeathDateAndTime          TS      `hl7:"24,len=26,format=YMDHMS,display=Guarantor Death Date And Time"`
-	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
+	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CE     `hl7:"26,len=80,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,len=10,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,len=3,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,len=3,datatype=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,len=20,display=Guarantor Employer ID Number"`
-	GuarantorMaritalStatusCode         IS      `hl7:"30,len=1,table=0002,display=Guarantor Marital Status Code"`
+	GuarantorMaritalStatusCode         IS      `hl7:"30,len=1,table=0002,datatype=IS,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,len=8,format=YMD,display=Guarantor Hire Effective Date"`
 	EmploymentStopDate                 DT      `hl7:"32,len=8,format=YMD,display=Employment Stop Date"`
-	LivingDependency                   IS      `hl7:"33,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatusCode               IS      `hl7:"34,len=2,table=0009,display=Ambulatory Status Code"`
-	Citizenship                        IS      `hl7:"35,len=4,table=0171,display=Citizenship"`
+	LivingDependency                   IS      `hl7:"33,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatusCode               IS      `hl7:"34,len=2,table=0009,datatype=IS,display=Ambulatory Status Code"`
+	Citizenship                        IS      `hl7:"35,len=4,table=0171,datatype=IS,display=Citizenship"`
 	PrimaryLanguage                    *CE     `hl7:"36,len=60,table=0296,display=Primary Language"`
-	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityIndicator                 *CE     `hl7:"38,len=80,table=0215,display=Publicity Indicator"`
-	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,display=Student Indicator"`
-	Religion                           IS      `hl7:"41,len=3,table=0006,display=Religion"`
+	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Religion                           IS      `hl7:"41,len=3,table=0006,datatype=IS,display=Religion"`
 	MotherSMaidenName                  *XPN    `hl7:"42,len=48,display=Mother s Maiden Name"`
 	NationalityCode                    *CE     `hl7:"43,len=80,table=0212,display=Nationality Code"`
-	EthnicGroup                        IS      `hl7:"44,len=3,table=0189,display=Ethnic Group"`
+	EthnicGroup                        IS      `hl7:"44,len=3,table=0189,datatype=IS,display=Ethnic Group"`
 	ContactPersonsName                 []XPN   `hl7:"45,len=48,display=Contact Person's Name"`
 	ContactPersonSTelephoneNumber      []XTN   `hl7:"46,len=40,display=Contact Person s Telephone Number"`
 	ContactReason                      *CE     `hl7:"47,len=80,table=0222,display=Contact Reason"`
-	ContactRelationshipCode            IS      `hl7:"48,len=2,table=0063,display=Contact Relationship Code"`
+	ContactRelationshipCode            IS      `hl7:"48,len=2,table=0063,datatype=IS,display=Contact Relationship Code"`
 	JobTitle                           ST      `hl7:"49,len=20,display=Job Title"`
 	JobCodeClass                       *JCC    `hl7:"50,len=20,display=Job Code/Class"`
 	GuarantorEmployersOrganizationName []XON   `hl7:"51,len=130,display=Guarantor Employer's Organization Name"`
-	Handicap                           IS      `hl7:"52,len=2,table=0310,display=Handicap"`
-	JobStatus                          IS      `hl7:"53,len=2,table=0311,display=Job Status"`
+	Handicap                           IS      `hl7:"52,len=2,table=0310,datatype=IS,display=Handicap"`
+	JobStatus                          IS      `hl7:"53,len=2,table=0311,datatype=IS,display=Job Status"`
 	GuarantorFinancialClass            *FC     `hl7:"54,len=50,display=Guarantor Financial Class"`
-	GuarantorRace                      IS      `hl7:"55,len=1,table=0005,display=Guarantor Race"`
+	GuarantorRace                      IS      `hl7:"55,len=1,table=0005,datatype=IS,display=Guarantor Race"`
 }
 
 // Insurance
@@ -713,7 +713,7 @@ type GT1 struct {
 // and insurance bills.
 type IN1 struct {
 	HL7                            HL7Name `hl7:",name=IN1,type=s"`
-	SetIDInsurance                 SI      `hl7:"1,required,len=4,display=Set ID - Insurance"`
+	SetIDInsurance                 SI      `hl7:"1,required,len=4,datatype=SI,display=Set ID - Insurance"`
 	InsurancePlanID                CE      `hl7:"2,required,len=60,table=0072,display=Insurance Plan ID"`
 	InsuranceCompanyID             []CX    `hl7:"3,required,len=59,display=Insurance Company ID"`
 	InsuranceCompanyName           []XON   `hl7:"4,len=130,display=Insurance Company Name"`
@@ -727,40 +727,40 @@ type IN1 struct {
 	PlanEffectiveDate              DT      `hl7:"12,len=8,format=YMD,display=Plan Effective Date"`
 	PlanExpirationDate             DT      `hl7:"13,len=8,format=YMD,display=Plan Expiration Date"`
 	AuthorizationInformation       *CM_AUI `hl7:"14,len=55,display=Authorization Information"`
-	PlanType                       IS      `hl7:"15,len=3,table=0086,display=Plan Type"`
+	PlanType                       IS      `hl7:"15,len=3,table=0086,datatype=IS,display=Plan Type"`
 	NameOfInsured                  []XPN   `hl7:"16,len=48,display=Name of Insured"`
-	InsuredsRelationshipToPatient  IS      `hl7:"17,len=2,table=0063,display=Insured's Relationship to Patient"`
+	InsuredsRelationshipToPatient  IS      `hl7:"17,len=2,table=0063,datatype=IS,display=Insured's Relationship to Patient"`
 	InsuredsDateOfBirth            TS      `hl7:"18,len=26,format=YMDHMS,display=Insured's Date of Birth"`
 	InsuredsAddress                []XAD   `hl7:"19,len=106,display=Insured's Address"`
-	AssignmentOfBenefits           IS      `hl7:"20,len=2,table=0135,display=Assignment of Benefits"`
-	CoordinationOfBenefits         IS      `hl7:"21,len=2,table=0173,display=Coordination of Benefits"`
+	AssignmentOfBenefits           IS      `hl7:"20,len=2,table=0135,datatype=IS,display=Assignment of Benefits"`
+	CoordinationOfBenefits         IS      `hl7:"21,len=2,table=0173,datatype=IS,display=Coordination of Benefits"`
 	CoordOfBenPriority             ST      `hl7:"22,len=2,display=Coord of Ben. Priority"`
-	NoticeOfAdmissionCode          ID      `hl7:"23,len=2,table=0136,display=Notice of Admission Code"`
+	NoticeOfAdmissionCode          ID      `hl7:"23,len=2,table=0136,datatype=ID,display=Notice of Admission Code"`
 	NoticeOfAdmissionDate          DT      `hl7:"24,len=8,format=YMD,display=Notice of Admission Date"`
-	ReportOfEigibilityCode         ID      `hl7:"25,len=2,table=0136,display=Report of Eigibility Code"`
+	ReportOfEigibilityCode         ID      `hl7:"25,len=2,table=0136,datatype=ID,display=Report of Eigibility Code"`
 	ReportOfEligibilityDate        DT      `hl7:"26,len=8,format=YMD,display=Report of Eligibility Date"`
-	ReleaseInformationCode         IS      `hl7:"27,len=2,table=0093,display=Release Information Code"`
+	ReleaseInformationCode         IS      `hl7:"27,len=2,table=0093,datatype=IS,display=Release Information Code"`
 	PreAdmitCert                   ST      `hl7:"28,len=15,display=Pre-Admit Cert"`
 	VerificationDateTime           TS      `hl7:"29,len=26,format=YMDHMS,display=Verification Date/Time"`
 	VerificationBy                 *XCN    `hl7:"30,len=60,display=Verification By"`
-	TypeOfAgreementCode            IS      `hl7:"31,len=2,table=0098,display=Type of Agreement Code"`
-	BillingStatus                  IS      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays            NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLifetimeReserveDays NM      `hl7:"34,len=4,display=Delay before lifetime reserve days"`
-	CompanyPlanCode                IS      `hl7:"35,len=8,table=0042,display=Company Plan Code"`
+	TypeOfAgreementCode            IS      `hl7:"31,len=2,table=0098,datatype=IS,display=Type of Agreement Code"`
+	BillingStatus                  IS      `hl7:"32,len=2,table=0022,datatype=IS,display=Billing Status"`
+	LifetimeReserveDays            NM      `hl7:"33,len=4,datatype=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLifetimeReserveDays NM      `hl7:"34,len=4,datatype=NM,display=Delay before lifetime reserve days"`
+	CompanyPlanCode                IS      `hl7:"35,len=8,table=0042,datatype=IS,display=Company Plan Code"`
 	PolicyNumber                   ST      `hl7:"36,len=15,display=Policy Number"`
 	PolicyDeductible               *CP     `hl7:"37,len=12,display=Policy Deductible"`
 	PolicyLimitAmount              CP      `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays                NM      `hl7:"39,len=4,display=Policy Limit - Days"`
+	PolicyLimitDays                NM      `hl7:"39,len=4,datatype=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate            CP      `hl7:"40,len=12,display=Room Rate - Semi-Private"`
 	RoomRatePrivate                CP      `hl7:"41,len=12,display=Room Rate - Private"`
 	InsuredsEmploymentStatus       *CE     `hl7:"42,len=60,table=0066,display=Insured's Employment Status"`
-	InsuredsSex                    IS      `hl7:"43,len=1,table=0001,display=Insured's Sex"`
+	InsuredsSex                    IS      `hl7:"43,len=1,table=0001,datatype=IS,display=Insured's Sex"`
 	InsuredsEmployerAddress        []XAD   `hl7:"44,len=106,display=Insured's Employer Address"`
 	VerificationStatus             ST      `hl7:"45,len=2,display=Verification Status"`
-	PriorInsurancePlanID           IS      `hl7:"46,len=8,table=0072,display=Prior Insurance Plan ID"`
-	CoverageType                   IS      `hl7:"47,len=3,table=0309,display=Coverage Type"`
-	Handicap                       IS      `hl7:"48,len=2,table=0310,display=Handicap"`
+	PriorInsurancePlanID           IS      `hl7:"46,len=8,table=0072,datatype=IS,display=Prior Insurance Plan ID"`
+	CoverageType                   IS      `hl7:"47,len=3,table=0309,datatype=IS,display=Coverage Type"`
+	Handicap                       IS      `hl7:"48,len=2,table=0310,datatype=IS,display=Handicap"`
 	InsuredsIDNumber               []CX    `hl7:"49,len=12,display=Insured's ID Number"`
 }
 
@@ -773,8 +773,8 @@ type IN2 struct {
 	InsuredsEmployeeID                   []CX     `hl7:"1,len=59,display=Insured's Employee ID"`
 	InsuredsSocialSecurityNumber         ST       `hl7:"2,len=11,display=Insured's Social Security Number"`
 	InsuredsEmployerName                 []XCN    `hl7:"3,len=130,display=Insured's Employer Name"`
-	EmployerInformationData              IS       `hl7:"4,len=1,table=0139,display=Employer Information Data"`
-	MailClaimParty                       []IS     `hl7:"5,len=1,table=0137,display=Mail Claim Party"`
+	EmployerInformationData              IS       `hl7:"4,len=1,table=0139,datatype=IS,display=Employer Information Data"`
+	MailClaimParty                       []IS     `hl7:"5,len=1,table=0137,datatype=IS,display=Mail Claim Party"`
 	MedicareHealthInsCardNumber          ST       `hl7:"6,len=15,display=Medicare Health Ins Card Number"`
 	MedicaidCaseName                     []XPN    `hl7:"7,len=48,display=Medicaid Case Name"`
 	MedicaidCaseNumber                   ST       `hl7:"8,len=15,display=Medicaid Case Number"`
@@ -783,64 +783,64 @@ type IN2 struct {
 	DependentOfChampusRecipient          *CE      `hl7:"11,len=80,display=Dependent of Champus Recipient"`
 	ChampusOrganization                  ST       `hl7:"12,len=25,display=Champus Organization"`
 	ChampusStation                       ST       `hl7:"13,len=25,display=Champus Station"`
-	ChampusService                       IS       `hl7:"14,len=14,table=0140,display=Champus Service"`
-	ChampusRankGrade                     IS       `hl7:"15,len=2,table=0141,display=Champus Rank/Grade"`
-	ChampusStatus                        IS       `hl7:"16,len=3,table=0142,display=Champus Status"`
+	ChampusService                       IS       `hl7:"14,len=14,table=0140,datatype=IS,display=Champus Service"`
+	ChampusRankGrade                     IS       `hl7:"15,len=2,table=0141,datatype=IS,display=Champus Rank/Grade"`
+	ChampusStatus                        IS       `hl7:"16,len=3,table=0142,datatype=IS,display=Champus Status"`
 	ChampusRetireDate                    DT       `hl7:"17,len=8,format=YMD,display=Champus Retire Date"`
-	ChampusNonAvailCertOnFile            ID       `hl7:"18,len=1,table=0136,display=Champus Non-Avail Cert on File"`
-	BabyCoverage                         ID       `hl7:"19,len=1,table=0136,display=Baby Coverage"`
-	CombineBabyBill                      ID       `hl7:"20,len=1,table=0136,display=Combine Baby Bill"`
+	ChampusNonAvailCertOnFile            ID       `hl7:"18,len=1,table=0136,datatype=ID,display=Champus Non-Avail Cert on File"`
+	BabyCoverage                         ID       `hl7:"19,len=1,table=0136,datatype=ID,display=Baby Coverage"`
+	CombineBabyBill                      ID       `hl7:"20,len=1,table=0136,datatype=ID,display=Combine Baby Bill"`
 	BloodDeductible                      ST       `hl7:"21,len=1,display=Blood Deductible"`
 	SpecialCoverageApprovalName          []XPN    `hl7:"22,len=48,display=Special Coverage Approval Name"`
 	SpecialCoverageApprovalTitle         ST       `hl7:"23,len=30,display=Special Coverage Approval Title"`
-	NonCoveredInsuranceCode              []IS     `hl7:"24,len=8,table=0143,display=Non-Covered Insurance Code"`
+	NonCoveredInsuranceCode              []IS     `hl7:"24,len=8,table=0143,datatype=IS,display=Non-Covered Insurance Code"`
 	PayorID                              []CX     `hl7:"25,len=59,display=Payor ID"`
 	PayorSubscriberID                    []CX     `hl7:"26,len=59,display=Payor Subscriber ID"`
-	EligibilitySource                    IS       `hl7:"27,len=1,table=0144,display=Eligibility Source"`
+	EligibilitySource                    IS       `hl7:"27,len=1,table=0144,datatype=IS,display=Eligibility Source"`
 	RoomCoverageTypeAmount               []CM_RMC `hl7:"28,len=25,display=Room Coverage Type/Amount"`
 	PolicyTypeAmount                     []CM_PTA `hl7:"29,len=25,display=Policy Type/Amount"`
 	DailyDeductible                      *CM_DDI  `hl7:"30,len=25,display=Daily Deductible"`
-	LivingDependency                     IS       `hl7:"31,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                     IS       `hl7:"32,len=2,table=0009,display=Ambulatory Status"`
-	Citizenship                          IS       `hl7:"33,len=4,table=0171,display=Citizenship"`
+	LivingDependency                     IS       `hl7:"31,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                     IS       `hl7:"32,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
+	Citizenship                          IS       `hl7:"33,len=4,table=0171,datatype=IS,display=Citizenship"`
 	PrimaryLanguage                      *CE      `hl7:"34,len=60,table=0296,display=Primary Language"`
-	LivingArrangement                    IS       `hl7:"35,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                    IS       `hl7:"35,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityIndicator                   *CE      `hl7:"36,len=80,table=0215,display=Publicity Indicator"`
-	ProtectionIndicator                  ID       `hl7:"37,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                     IS       `hl7:"38,len=2,table=0231,display=Student Indicator"`
-	Religion                             IS       `hl7:"39,len=3,table=0006,display=Religion"`
+	ProtectionIndicator                  ID       `hl7:"37,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                     IS       `hl7:"38,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Religion                             IS       `hl7:"39,len=3,table=0006,datatype=IS,display=Religion"`
 	MotherSMaidenName                    *XPN     `hl7:"40,len=48,display=Mother s Maiden Name"`
 	NationalityCode                      *CE      `hl7:"41,len=80,table=0212,display=Nationality Code"`
-	EthnicGroup                          IS       `hl7:"42,len=3,table=0189,display=Ethnic Group"`
-	MaritalStatus                        []IS     `hl7:"43,len=1,table=0002,display=Marital Status"`
+	EthnicGroup                          IS       `hl7:"42,len=3,table=0189,datatype=IS,display=Ethnic Group"`
+	MaritalStatus                        []IS     `hl7:"43,len=1,table=0002,datatype=IS,display=Marital Status"`
 	EmploymentStartDate                  DT       `hl7:"44,len=8,format=YMD,display=Employment Start Date"`
 	EmploymentStopDate                   DT       `hl7:"45,len=8,format=YMD,display=Employment Stop Date"`
 	JobTitle                             ST       `hl7:"46,len=20,display=Job Title"`
 	JobCodeClass                         *JCC     `hl7:"47,len=20,display=Job Code/Class"`
-	JobStatus                            IS       `hl7:"48,len=2,table=0311,display=Job Status"`
+	JobStatus                            IS       `hl7:"48,len=2,table=0311,datatype=IS,display=Job Status"`
 	EmployerContactPersonName            []XPN    `hl7:"49,len=48,display=Employer Contact Person Name"`
 	EmployerContactPersonPhoneNumber     []XTN    `hl7:"50,len=40,display=Employer Contact Person Phone Number"`
-	EmployerContactReason                IS       `hl7:"51,len=2,table=0222,display=Employer Contact Reason"`
+	EmployerContactReason                IS       `hl7:"51,len=2,table=0222,datatype=IS,display=Employer Contact Reason"`
 	InsuredsContactPersonName            []XPN    `hl7:"52,len=48,display=Insured's Contact Person Name"`
 	InsuredSContactPersonTelephoneNumber []XTN    `hl7:"53,len=40,display=Insured s Contact Person Telephone Number"`
-	InsuredSContactPersonReason          []IS     `hl7:"54,len=2,table=0222,display=Insured s Contact Person Reason"`
+	InsuredSContactPersonReason          []IS     `hl7:"54,len=2,table=0222,datatype=IS,display=Insured s Contact Person Reason"`
 	RelationshipToThePatientStartDate    DT       `hl7:"55,len=8,format=YMD,display=Relationship To The Patient Start Date"`
 	RelationshipToThePatientStopDate     []DT     `hl7:"56,len=8,format=YMD,display=Relationship To The Patient Stop Date"`
-	InsuranceCompanyContactReason        IS       `hl7:"57,len=2,table=0232,display=Insurance Company Contact Reason"`
+	InsuranceCompanyContactReason        IS       `hl7:"57,len=2,table=0232,datatype=IS,display=Insurance Company Contact Reason"`
 	InsuranceCompanyContactPhoneNumber   *XTN     `hl7:"58,len=40,display=Insurance Company Contact Phone Number"`
-	PolicyScope                          IS       `hl7:"59,len=2,table=0312,display=Policy Scope"`
-	PolicySource                         IS       `hl7:"60,len=2,table=0313,display=Policy Source"`
+	PolicyScope                          IS       `hl7:"59,len=2,table=0312,datatype=IS,display=Policy Scope"`
+	PolicySource                         IS       `hl7:"60,len=2,table=0313,datatype=IS,display=Policy Source"`
 	PatientMemberNumber                  *CX      `hl7:"61,len=60,display=Patient Member Number"`
-	GuarantorsRelationshipToInsured      IS       `hl7:"62,len=2,table=0063,display=Guarantor's Relationship To Insured"`
+	GuarantorsRelationshipToInsured      IS       `hl7:"62,len=2,table=0063,datatype=IS,display=Guarantor's Relationship To Insured"`
 	InsuredsTelephoneNumberHome          []XTN    `hl7:"63,len=40,display=Insured's Telephone Number - Home"`
 	InsuredsEmployerTelephoneNumber      []XTN    `hl7:"64,len=40,display=Insured's Employer Telephone Number"`
 	MilitaryHandicappedProgram           *CE      `hl7:"65,len=60,display=Military Handicapped Program"`
-	SuspendFlag                          ID       `hl7:"66,len=2,table=0136,display=Suspend Flag"`
-	CoPayLimitFlag                       ID       `hl7:"67,len=2,table=0136,display=Co-pay Limit Flag"`
-	StoplossLimitFlag                    ID       `hl7:"68,len=2,table=0136,display=Stoploss Limit Flag"`
+	SuspendFlag                          ID       `hl7:"66,len=2,table=0136,datatype=ID,display=Suspend Flag"`
+	CoPayLimitFlag                       ID       `hl7:"67,len=2,table=0136,datatype=ID,display=Co-pay Limit Flag"`
+	StoplossLimitFlag                    ID       `hl7:"68,len=2,table=0136,datatype=ID,display=Stoploss Limit Flag"`
 	InsuredOrganizationNameAndID         []XON    `hl7:"69,len=130,display=Insured Organization Name And ID"`
 	InsuredEmployerOrganizationNameAndID []XON    `hl7:"70,len=130,display=Insured Employer Organization Name And ID"`
-	Race                                 IS       `hl7:"71,len=1,table=0005,display=Race"`
+	Race                                 IS       `hl7:"71,len=1,table=0005,datatype=IS,display=Race"`
 	PatientRelationshipToInsured         *CE      `hl7:"72,len=60,display=Patient Relationship to Insured"`
 }
 
@@ -850,10 +850,10 @@ type IN2 struct {
 // segment are defined by HCFA, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name  `hl7:",name=IN3,type=s"`
-	SetIDInsuranceCertification        SI       `hl7:"1,required,len=4,display=Set ID - Insurance Certification"`
+	SetIDInsuranceCertification        SI       `hl7:"1,required,len=4,datatype=SI,display=Set ID - Insurance Certification"`
 	CertificationNumber                *CX      `hl7:"2,len=59,display=Certification Number"`
 	CertifiedBy                        []XCN    `hl7:"3,len=60,display=Certified By"`
-	CertificationRequired              ID       `hl7:"4,len=1,table=0136,display=Certification Required"`
+	CertificationRequired              ID       `hl7:"4,len=1,table=0136,datatype=ID,display=Certification Required"`
 	Penalty                            *CM_PEN  `hl7:"5,len=10,display=Penalty"`
 	CertificationDateTime              TS       `hl7:"6,len=26,format=YMDHMS,display=Certification Date/Time"`
 	CertificationModifyDateTime        TS       `hl7:"7,len=26,format=YMDHMS,display=Certification Modify Date/Time"`
@@ -872,8 +872,8 @@ type IN3 struct {
 	PreCertificationRequiredWindow     []CM_PCF `hl7:"20,len=40,display=Pre-Certification required/Window"`
 	CaseManager                        ST       `hl7:"21,len=48,display=Case Manager"`
 	SecondOpinionDate                  DT       `hl7:"22,len=8,format=YMD,display=Second Opinion Date"`
-	SecondOpinionStatus                IS       `hl7:"23,len=1,table=0151,display=Second Opinion Status"`
-	SecondOpinionDocumentationReceived []IS     `hl7:"24,len=1,table=0152,display=Second Opinion Documentation Received"`
+	SecondOpinionStatus                IS       `hl7:"23,len=1,table=0151,datatype=IS,display=Second Opinion Status"`
+	SecondOpinionDocumentationReceived []IS     `hl7:"24,len=1,table=0152,datatype=IS,display=Second Opinion Documentation Received"`
 	SecondOpinionPhysician             []XCN    `hl7:"25,len=60,table=0010,display=Second Opinion Physician"`
 }
 
@@ -884,7 +884,7 @@ type IN3 struct {
 type LCC struct {
 	HL7                HL7Name `hl7:",name=LCC,type=s"`
 	PrimaryKeyValue    PL      `hl7:"1,required,len=200,display=Primary Key Value"`
-	LocationDepartment IS      `hl7:"2,required,len=10,table=0264,display=Location Department"`
+	LocationDepartment IS      `hl7:"2,required,len=10,table=0264,datatype=IS,display=Location Department"`
 	AccommodationType  []CE    `hl7:"3,len=60,display=Accommodation Type"`
 	ChargeCode         []CE    `hl7:"4,required,len=60,table=0132,display=Charge Code"`
 }
@@ -903,7 +903,7 @@ type LCC struct {
 type LCH struct {
 	HL7                         HL7Name `hl7:",name=LCH,type=s"`
 	PrimaryKeyValue             PL      `hl7:"1,required,len=200,display=Primary Key Value"`
-	SegmentActionCode           ID      `hl7:"2,len=1,table=0206,display=Segment Action Code"`
+	SegmentActionCode           ID      `hl7:"2,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey            *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationCharacteristicID    CE      `hl7:"4,required,len=80,table=0324,display=Location Characteristic ID"`
 	LocationCharacteristicValue CE      `hl7:"5,required,len=80,display=Location Characteristic Value"`
@@ -917,11 +917,11 @@ type LCH struct {
 type LDP struct {
 	HL7                 HL7Name `hl7:",name=LDP,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=LDP Primary Key Value"`
-	LocationDepartment  IS      `hl7:"2,required,len=10,table=0264,display=Location Department"`
-	LocationService     []IS    `hl7:"3,len=3,table=0069,display=Location Service"`
+	LocationDepartment  IS      `hl7:"2,required,len=10,table=0264,datatype=IS,display=Location Department"`
+	LocationService     []IS    `hl7:"3,len=3,table=0069,datatype=IS,display=Location Service"`
 	SpecialityType      []CE    `hl7:"4,len=60,table=0265,display=Speciality Type"`
-	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,display=Valid Patient Classes"`
-	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,display=Active/Inactive Flag"`
+	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
+	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	ActivationDate      TS      `hl7:"7,len=26,format=YMDHMS,display=Activation Date"`
 	InactivationDate    TS      `hl7:"8,len=26,format=YMDHMS,display=Inactivation Date - LDP"`
 	InactivatedReason   ST      `hl7:"9,len=80,display=Inactivated Reason"`
@@ -939,12 +939,12 @@ type LOC struct {
 	HL7                 HL7Name `hl7:",name=LOC,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=Primary Key Value"`
 	LocationDescription ST      `hl7:"2,len=48,display=Location Description"`
-	LocationType        []IS    `hl7:"3,required,len=2,table=0260,display=Location Type"`
+	LocationType        []IS    `hl7:"3,required,len=2,table=0260,datatype=IS,display=Location Type"`
 	OrganizationName    *XON    `hl7:"4,len=90,display=Organization Name"`
 	LocationAddress     *XAD    `hl7:"5,len=106,display=Location Address"`
 	LocationPhone       []XTN   `hl7:"6,len=40,display=Location Phone"`
 	LicenseNumber       []CE    `hl7:"7,len=60,display=License Number"`
-	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,display=Location Equipment"`
+	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,datatype=IS,display=Location Equipment"`
 }
 
 // Location Relationship
@@ -953,7 +953,7 @@ type LOC struct {
 type LRL struct {
 	HL7                                     HL7Name `hl7:",name=LRL,type=s"`
 	PrimaryKeyValue                         PL      `hl7:"1,required,len=200,display=Primary Key Value"`
-	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,display=Segment Action Code"`
+	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey                        *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationRelationshipID                  CE      `hl7:"4,required,len=80,table=0325,display=Location Relationship ID"`
 	OrganizationalLocationRelationshipValue *XON    `hl7:"5,conditional,len=80,display=Organizational Location Relationship Value"`
@@ -965,7 +965,7 @@ type LRL struct {
 // The MFA segment contains the following fields as defined in Figure 8-3 - MFA attributes.
 type MFA struct {
 	HL7                      HL7Name `hl7:",name=MFA,type=s"`
-	RecordLevelEventCode     ID      `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode     ID      `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID             ST      `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EventCompletionDateTime  TS      `hl7:"3,len=26,format=YMDHMS,display=Event Completion Date/Time"`
 	ErrorReturnCodeAndOrText CE      `hl7:"4,required,len=60,table=0181,display=Error Return Code and/or Text"`
@@ -975,7 +975,7 @@ type MFA struct {
 // Master file entry segment
 type MFE struct {
 	HL7                  HL7Name  `hl7:",name=MFE,type=s"`
-	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID         ST       `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EffectiveDateTime    TS       `hl7:"3,len=26,format=YMDHMS,display=Effective Date/Time"`
 	PrimaryKeyValue      []VARIES `hl7:"4,required,len=200,display=Primary Key Value"`
@@ -986,10 +986,10 @@ type MFI struct {
 	HL7                             HL7Name `hl7:",name=MFI,type=s"`
 	MasterFileIdentifier            CE      `hl7:"1,required,len=60,table=0175,display=Master File Identifier"`
 	MasterFileApplicationIdentifier *HD     `hl7:"2,len=180,display=Master File Application Identifier"`
-	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,display=File-Level Event Code"`
+	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,datatype=ID,display=File-Level Event Code"`
 	EnteredDateTime                 TS      `hl7:"4,len=26,format=YMDHMS,display=Entered Date/Time"`
 	EffectiveDateTime               TS      `hl7:"5,len=26,format=YMDHMS,display=Effective Date/Time"`
-	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,display=Response Level Code"`
+	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,datatype=ID,display=Response Level Code"`
 }
 
 // Merge patient information
@@ -1024,11 +1024,11 @@ type MRG struct {
 // The MSA segment contains information sent while acknowledging another message.
 type MSA struct {
 	HL7                        HL7Name `hl7:",name=MSA,type=s"`
-	AcknowledgementCode        ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgement code"`
+	AcknowledgementCode        ID      `hl7:"1,required,len=2,table=0008,datatype=ID,display=Acknowledgement code"`
 	MessageControlID           ST      `hl7:"2,required,len=20,display=Message Control ID"`
 	TextMessage                ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber     NM      `hl7:"4,len=15,display=Expected Sequence Number"`
-	DelayedAcknowledgementType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgement Type"`
+	ExpectedSequenceNumber     NM      `hl7:"4,len=15,datatype=NM,display=Expected Sequence Number"`
+	DelayedAcknowledgementType ID      `hl7:"5,len=1,table=0102,datatype=ID,display=Delayed Acknowledgement Type"`
 	ErrorCondition             *CE     `hl7:"6,len=100,display=Error Condition"`
 }
 
@@ -1048,13 +1048,13 @@ type MSH struct {
 	MessageType                    CM_MSG  `hl7:"9,required,len=7,display=Message Type"`
 	MessageControlID               ST      `hl7:"10,required,len=20,display=Message Control ID"`
 	ProcessingID                   PT      `hl7:"11,required,len=3,display=Processing ID"`
-	VersionID                      ID      `hl7:"12,required,len=8,table=0104,display=Version ID"`
-	SequenceNumber                 NM      `hl7:"13,len=15,display=Sequence Number"`
+	VersionID                      ID      `hl7:"12,required,len=8,table=0104,datatype=ID,display=Version ID"`
+	SequenceNumber                 NM      `hl7:"13,len=15,datatype=NM,display=Sequence Number"`
 	ContinuationPointer            ST      `hl7:"14,len=180,display=Continuation Pointer"`
-	AcceptAcknowledgementType      ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgement Type"`
-	ApplicationAcknowledgementType ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgement Type"`
-	CountryCode                    ID      `hl7:"17,len=2,table=ISO3166,display=Country Code"`
-	CharacterSet                   []ID    `hl7:"18,max=3,len=6,table=0211,display=Character Set"`
+	AcceptAcknowledgementType      ID      `hl7:"15,len=2,table=0155,datatype=ID,display=Accept Acknowledgement Type"`
+	ApplicationAcknowledgementType ID      `hl7:"16,len=2,table=0155,datatype=ID,display=Application Acknowledgement Type"`
+	CountryCode                    ID      `hl7:"17,len=2,table=ISO3166,datatype=ID,display=Country Code"`
+	CharacterSet                   []ID    `hl7:"18,max=3,len=6,table=0211,datatype=ID,display=Character Set"`
 	PrincipalLanguageOfMessage     *CE     `hl7:"19,len=60,display=Principal Language of Message"`
 }
 
@@ -1078,7 +1078,7 @@ type NCK struct {
 // Utilizing NK1-1-set ID, multiple NK1 segments can be sent to patient accounts
 type NK1 struct {
 	HL7                                      HL7Name `hl7:",name=NK1,type=s"`
-	SetIDNextOfKin                           SI      `hl7:"1,required,len=4,display=Set ID - Next of Kin"`
+	SetIDNextOfKin                           SI      `hl7:"1,required,len=4,datatype=SI,display=Set ID - Next of Kin"`
 	NKName                                   []XPN   `hl7:"2,len=48,display=NK Name"`
 	Relationship                             *CE     `hl7:"3,len=60,table=0063,display=Relationship"`
 	Address                                  []XAD   `hl7:"4,len=106,display=Address"`
@@ -1091,29 +1091,29 @@ type NK1 struct {
 	NextOfKinJobAssociatedPartiesCodeClass   *JCC    `hl7:"11,len=20,display=Next of Kin Job/Associated Parties Code/Class"`
 	NextOfKinAssociatedPartiesEmployeeNumber *CX     `hl7:"12,len=20,display=Next of Kin/Associated Parties Employee Number"`
 	OrganizationName                         []XON   `hl7:"13,len=60,display=Organization Name"`
-	MaritalStatus                            IS      `hl7:"14,len=2,table=0002,display=Marital Status"`
-	Sex                                      IS      `hl7:"15,len=1,table=0001,display=Sex"`
+	MaritalStatus                            IS      `hl7:"14,len=2,table=0002,datatype=IS,display=Marital Status"`
+	Sex                                      IS      `hl7:"15,len=1,table=0001,datatype=IS,display=Sex"`
 	DateOfBirth                              TS      `hl7:"16,len=26,format=YMDHMS,display=Date of Birth"`
-	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,display=Ambulatory Status"`
-	Citizenship                              []IS    `hl7:"19,len=4,table=0171,display=Citizenship"`
+	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
+	Citizenship                              []IS    `hl7:"19,len=4,table=0171,datatype=IS,display=Citizenship"`
 	PrimaryLanguage                          *CE     `hl7:"20,len=60,table=0296,display=Primary Language"`
-	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityIndicator                       *CE     `hl7:"22,len=1,table=0215,display=Publicity Indicator"`
-	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,display=Student Indicator"`
-	Religion                                 IS      `hl7:"25,len=3,table=0006,display=Religion"`
+	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Religion                                 IS      `hl7:"25,len=3,table=0006,datatype=IS,display=Religion"`
 	MotherSMaidenName                        *XPN    `hl7:"26,len=48,display=Mother s Maiden Name"`
 	NationalityCode                          *CE     `hl7:"27,len=80,table=0212,display=Nationality Code"`
-	EthnicGroup                              IS      `hl7:"28,len=3,table=0189,display=Ethnic Group"`
+	EthnicGroup                              IS      `hl7:"28,len=3,table=0189,datatype=IS,display=Ethnic Group"`
 	ContactReason                            []CE    `hl7:"29,len=80,table=0222,display=Contact Reason"`
 	ContactPersonsName                       []XPN   `hl7:"30,len=48,display=Contact Person's Name"`
 	ContactPersonSTelephoneNumber            []XTN   `hl7:"31,len=40,display=Contact Person s Telephone Number"`
 	ContactPersonSAddress                    []XAD   `hl7:"32,len=106,display=Contact Person s Address"`
 	NextOfKinAssociatedPartyIdentifiers      []CX    `hl7:"33,len=32,display=Next of kin/associated party identifiers"`
-	JobStatus                                IS      `hl7:"34,len=2,table=0311,display=Job Status"`
-	Race                                     IS      `hl7:"35,len=1,table=0005,display=Race"`
-	Handicap                                 IS      `hl7:"36,len=2,table=0295,display=Handicap"`
+	JobStatus                                IS      `hl7:"34,len=2,table=0311,datatype=IS,display=Job Status"`
+	Race                                     IS      `hl7:"35,len=1,table=0005,datatype=IS,display=Race"`
+	Handicap                                 IS      `hl7:"36,len=2,table=0295,datatype=IS,display=Handicap"`
 	ContactPersonSocialSecurityNumber        ST      `hl7:"37,len=16,display=Contact Person Social Security Number"`
 }
 
@@ -1124,7 +1124,7 @@ type NK1 struct {
 type NPU struct {
 	HL7         HL7Name `hl7:",name=NPU,type=s"`
 	BedLocation PL      `hl7:"1,required,len=80,display=Bed Location"`
-	BedStatus   IS      `hl7:"2,len=1,table=0116,display=Bed Status"`
+	BedStatus   IS      `hl7:"2,len=1,table=0116,datatype=IS,display=Bed Status"`
 }
 
 // Status change
@@ -1134,7 +1134,7 @@ type NPU struct {
 // shut-down, or migration of an application.
 type NSC struct {
 	HL7                HL7Name `hl7:",name=NSC,type=s"`
-	NetworkChangeType  IS      `hl7:"1,required,len=4,table=0333,display=Network Change Type"`
+	NetworkChangeType  IS      `hl7:"1,required,len=4,table=0333,datatype=IS,display=Network Change Type"`
 	CurrentCPU         ST      `hl7:"2,len=30,display=Current CPU"`
 	CurrentFileserver  ST      `hl7:"3,len=30,display=Current Fileserver"`
 	CurrentApplication ST      `hl7:"4,len=30,display=Current Application"`
@@ -1152,21 +1152,21 @@ type NSC struct {
 // applications monitoring the state of various network links
 type NST struct {
 	HL7                    HL7Name `hl7:",name=NST,type=s"`
-	StatisticsAvailable    ID      `hl7:"1,required,len=1,table=0136,display=Statistics Available"`
+	StatisticsAvailable    ID      `hl7:"1,required,len=1,table=0136,datatype=ID,display=Statistics Available"`
 	SourceIdentifier       ST      `hl7:"2,len=30,display=Source Identifier"`
-	SourceType             ID      `hl7:"3,len=3,table=0332,display=Source Type"`
+	SourceType             ID      `hl7:"3,len=3,table=0332,datatype=ID,display=Source Type"`
 	StatisticsStart        TS      `hl7:"4,len=26,format=YMDHMS,display=Statistics Start"`
 	StatisticsEnd          TS      `hl7:"5,len=26,format=YMDHMS,display=Statistics End"`
-	ReceiveCharacterCount  NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount     NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessagesReceived       NM      `hl7:"8,len=10,display=Messages Received"`
-	MessagesSent           NM      `hl7:"9,len=10,display=Messages Sent"`
-	ChecksumErrorsReceived NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived   NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived    NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts        NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts        NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	NetworkErrors          NM      `hl7:"15,len=10,display=Network Errors"`
+	ReceiveCharacterCount  NM      `hl7:"6,len=10,datatype=NM,display=Receive Character Count"`
+	SendCharacterCount     NM      `hl7:"7,len=10,datatype=NM,display=Send Character Count"`
+	MessagesReceived       NM      `hl7:"8,len=10,datatype=NM,display=Messages Received"`
+	MessagesSent           NM      `hl7:"9,len=10,datatype=NM,display=Messages Sent"`
+	ChecksumErrorsReceived NM      `hl7:"10,len=10,datatype=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived   NM      `hl7:"11,len=10,datatype=NM,display=Length Errors Received"`
+	OtherErrorsReceived    NM      `hl7:"12,len=10,datatype=NM,display=Other Errors Received"`
+	ConnectTimeouts        NM      `hl7:"13,len=10,datatype=NM,display=Connect Timeouts"`
+	ReceiveTimeouts        NM      `hl7:"14,len=10,datatype=NM,display=Receive Timeouts"`
+	NetworkErrors          NM      `hl7:"15,len=10,datatype=NM,display=Network Errors"`
 }
 
 // Notes and comments segment
@@ -1175,8 +1175,8 @@ type NST struct {
 // and comments
 type NTE struct {
 	HL7                   HL7Name `hl7:",name=NTE,type=s"`
-	SetIDNotesAndComments SI      `hl7:"1,len=4,display=Set ID - Notes and Comments"`
-	SourceOfComment       ID      `hl7:"2,len=8,table=0105,display=Source of Comment"`
+	SetIDNotesAndComments SI      `hl7:"1,len=4,datatype=SI,display=Set ID - Notes and Comments"`
+	SourceOfComment       ID      `hl7:"2,len=8,table=0105,datatype=ID,display=Source of Comment"`
 	Comment               []FT    `hl7:"3,len=65536,display=Comment"`
 }
 
@@ -1194,17 +1194,17 @@ type NTE struct {
 // a separate order segment will usually be generated for each diagnostic study
 type OBR struct {
 	HL7                                 HL7Name  `hl7:",name=OBR,type=s"`
-	SetIDObservationRequest             SI       `hl7:"1,conditional,len=4,display=Set ID - Observation Request"`
+	SetIDObservationRequest             SI       `hl7:"1,conditional,len=4,datatype=SI,display=Set ID - Observation Request"`
 	PlacerOrderNumber                   *EI      `hl7:"2,conditional,len=75,display=Placer Order Number"`
 	FillerOrderNumber                   *EI      `hl7:"3,conditional,len=75,display=Filler Order Number"`
 	UniversalServiceIdentifier          CE       `hl7:"4,required,len=200,display=Universal Service Identifier"`
-	Priority                            ID       `hl7:"5,len=2,display=Priority"`
+	Priority                            ID       `hl7:"5,len=2,datatype=ID,display=Priority"`
 	RequestedDateTime                   TS       `hl7:"6,len=26,format=YMDHMS,display=Requested Date/Time"`
 	ObservationDateTime                 TS       `hl7:"7,conditional,len=26,format=YMDHMS,display=Observation Date/Time"`
 	ObservationEndDateTime              TS       `hl7:"8,len=26,format=YMDHMS,display=Observation End Date/Time"`
 	CollectionVolume                    *CQ      `hl7:"9,len=20,display=Collection Volume"`
 	CollectorIdentifier                 []XCN    `hl7:"10,len=60,display=Collector Identifier"`
-	SpecimenActionCode                  ID       `hl7:"11,len=1,table=0065,display=Specimen Action Code"`
+	SpecimenActionCode                  ID       `hl7:"11,len=1,table=0065,datatype=ID,display=Specimen Action Code"`
 	DangerCode                          *CE      `hl7:"12,len=60,display=Danger Code"`
 	RelevantClinicalInformation         ST       `hl7:"13,len=300,display=Relevant Clinical Information"`
 	SpecimenReceivedDateTime            TS       `hl7:"14,conditional,len=26,format=YMDHMS,display=Specimen Received Date/Time"`
@@ -1217,25 +1217,25 @@ type OBR struct {
 	FillerField2                        ST       `hl7:"21,len=60,display=Filler Field 2"`
 	ResultsRptStatusChngDateTime        TS       `hl7:"22,conditional,len=26,format=YMDHMS,display=Results Rpt/Status Chng - Date/Time"`
 	ChargeToPractice                    *CM_MOC  `hl7:"23,len=40,display=Charge To Practice"`
-	DiagnosticServiceSectionID          ID       `hl7:"24,len=10,table=0074,display=Diagnostic Service Section ID"`
-	ResultStatus                        ID       `hl7:"25,conditional,len=1,table=0123,display=Result Status"`
+	DiagnosticServiceSectionID          ID       `hl7:"24,len=10,table=0074,datatype=ID,display=Diagnostic Service Section ID"`
+	ResultStatus                        ID       `hl7:"25,conditional,len=1,table=0123,datatype=ID,display=Result Status"`
 	ParentResult                        *CM_PRL  `hl7:"26,len=400,display=Parent Result"`
 	QuantityTiming                      []TQ     `hl7:"27,len=200,display=Quantity/Timing"`
 	ResultCopiesTo                      []XCN    `hl7:"28,max=5,len=150,display=Result Copies To"`
 	ParentNumber                        *CM_EIP  `hl7:"29,len=150,display=Parent Number"`
-	TransportationMode                  ID       `hl7:"30,len=20,table=0124,display=Transportation Mode"`
+	TransportationMode                  ID       `hl7:"30,len=20,table=0124,datatype=ID,display=Transportation Mode"`
 	ReasonForStudy                      []CE     `hl7:"31,len=300,display=Reason For Study"`
 	PrincipalResultInterpreter          *CM_NDL  `hl7:"32,len=200,display=Principal Result Interpreter"`
 	AssistantResultInterpreter          []CM_NDL `hl7:"33,len=200,display=Assistant Result Interpreter"`
 	Technician                          []CM_NDL `hl7:"34,len=200,display=Technician"`
 	Transcriptionist                    []CM_NDL `hl7:"35,len=200,display=Transcriptionist"`
 	ScheduledDateTime                   TS       `hl7:"36,len=26,format=YMDHMS,display=Scheduled Date/Time"`
-	NumberOfSampleContainers            NM       `hl7:"37,len=4,display=Number Of Sample Containers"`
+	NumberOfSampleContainers            NM       `hl7:"37,len=4,datatype=NM,display=Number Of Sample Containers"`
 	TransportLogisticsOfCollectedSample []CE     `hl7:"38,len=60,display=Transport Logistics Of Collected Sample"`
 	CollectorSComment                   []CE     `hl7:"39,len=200,display=Collector s Comment"`
 	TransportArrangementResponsibility  *CE      `hl7:"40,len=60,display=Transport Arrangement Responsibility"`
-	TransportArranged                   ID       `hl7:"41,len=30,table=0224,display=Transport Arranged"`
-	EscortRequired                      ID       `hl7:"42,len=1,table=0225,display=Escort Required"`
+	TransportArranged                   ID       `hl7:"41,len=30,table=0224,datatype=ID,display=Transport Arranged"`
+	EscortRequired                      ID       `hl7:"42,len=1,table=0225,datatype=ID,display=Escort Required"`
 	PlannedPatientTransportComment      []CE     `hl7:"43,len=200,display=Planned Patient Transport Comment"`
 }
 
@@ -1253,17 +1253,17 @@ type OBR struct {
 // clinical information.
 type OBX struct {
 	HL7                      HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                    SI       `hl7:"1,seq,len=10,display=Set ID - OBX"`
-	ValueType                ID       `hl7:"2,conditional,len=2,table=0125,display=Value Type"`
+	SetID                    SI       `hl7:"1,seq,len=10,datatype=SI,display=Set ID - OBX"`
+	ValueType                ID       `hl7:"2,conditional,len=2,table=0125,datatype=ID,display=Value Type"`
 	ObservationIdentifier    CE       `hl7:"3,required,len=590,display=Observation Identifier"`
 	ObservationSubID         ST       `hl7:"4,conditional,len=20,display=Observation Sub-ID"`
 	ObservationValue         []VARIES `hl7:"5,conditional,len=65536,display=Observation Value"`
 	Units                    *CE      `hl7:"6,len=60,display=Units"`
 	ReferencesRange          ST       `hl7:"7,len=10,display=References Range"`
-	AbnormalFlags            []ID     `hl7:"8,max=5,len=5,table=0078,display=Abnormal Flags"`
-	Probability              NM       `hl7:"9,len=5,display=Probability"`
-	NatureOfAbnormalTest     []ID     `hl7:"10,len=2,table=0080,display=Nature of Abnormal Test"`
-	ObservResultStatus       ID       `hl7:"11,required,len=1,table=0085,display=Observ Result Status"`
+	AbnormalFlags            []ID     `hl7:"8,max=5,len=5,table=0078,datatype=ID,display=Abnormal Flags"`
+	Probability              NM       `hl7:"9,len=5,datatype=NM,display=Probability"`
+	NatureOfAbnormalTest     []ID     `hl7:"10,len=2,table=0080,datatype=ID,display=Nature of Abnormal Test"`
+	ObservResultStatus       ID       `hl7:"11,required,len=1,table=0085,datatype=ID,display=Observ Result Status"`
 	DateLastObsNormalValues  TS       `hl7:"12,len=26,format=YMDHMS,display=Date Last Obs Normal Values"`
 	UserDefinedAccessChecks  ST       `hl7:"13,len=20,display=User Defined Access Checks"`
 	DateTimeOfTheObservation TS       `hl7:"14,len=26,format=YMDHMS,display=Date/Time of the Observation"`
@@ -1282,7 +1282,7 @@ type OBX struct {
 // which are part of a diet but only delivered, say, every day at night.
 type ODS struct {
 	HL7                            HL7Name `hl7:",name=ODS,type=s"`
-	Type                           ID      `hl7:"1,required,len=1,table=0159,display=Type"`
+	Type                           ID      `hl7:"1,required,len=1,table=0159,datatype=ID,display=Type"`
 	ServicePeriod                  []CE    `hl7:"2,max=10,len=60,display=Service Period"`
 	DietSupplementOrPreferenceCode []CE    `hl7:"3,required,max=20,len=60,display=Diet- Supplement- or Preference Code"`
 	TextInstruction                []ST    `hl7:"4,max=2,len=80,display=Text Instruction"`
@@ -1305,10 +1305,10 @@ type ODT struct {
 // the field attributes that specify what additional segments might also be defined for this observation
 type OM1 struct {
 	HL7                                                             HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumber                                                  NM      `hl7:"1,required,len=4,display=Sequence Number"`
+	SequenceNumber                                                  NM      `hl7:"1,required,len=4,datatype=NM,display=Sequence Number"`
 	ProducersTestObservationID                                      CE      `hl7:"2,required,len=200,display=Producer's Test/Observation ID"`
-	PermittedDataTypes                                              []ID    `hl7:"3,len=12,table=0125,display=Permitted Data Types"`
-	SpecimenRequired                                                ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
+	PermittedDataTypes                                              []ID    `hl7:"3,len=12,table=0125,datatype=ID,display=Permitted Data Types"`
+	SpecimenRequired                                                ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Specimen Required"`
 	ProducerID                                                      CE      `hl7:"5,required,len=200,display=Producer ID"`
 	ObservationDescription                                          TX      `hl7:"6,len=200,display=Observation Description"`
 	OtherTestObservationIDsForTheObservation                        *CE     `hl7:"7,len=200,display=Other Test/Observation IDs for the Observation"`
@@ -1316,25 +1316,25 @@ type OM1 struct {
 	PreferredReportNameForTheObservation                            ST      `hl7:"9,len=30,display=Preferred Report Name for the Observation"`
 	PreferredShortNameOrMnemonicForObservation                      ST      `hl7:"10,len=8,display=Preferred Short Name or Mnemonic for Observation"`
 	PreferredLongNameForTheObservation                              ST      `hl7:"11,len=200,display=Preferred Long Name for the Observation"`
-	Orderability                                                    ID      `hl7:"12,len=1,table=0136,display=Orderability"`
+	Orderability                                                    ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Orderability"`
 	IdentityOfInstrumentUsedToPerfromThisStudy                      []CE    `hl7:"13,len=60,display=Identity of Instrument Used to Perfrom this Study"`
 	CodedRepresentationOfMethod                                     []CE    `hl7:"14,len=200,display=Coded Representation of Method"`
-	Portable                                                        ID      `hl7:"15,len=1,table=0136,display=Portable"`
+	Portable                                                        ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Portable"`
 	ObservationProducingDepartmentSection                           []CE    `hl7:"16,len=1,display=Observation Producing Department/Section"`
 	TelephoneNumberOfSection                                        *XTN    `hl7:"17,len=40,display=Telephone Number of Section"`
-	NatureOfTestObservation                                         IS      `hl7:"18,required,len=1,table=0174,display=Nature of Test/Observation"`
+	NatureOfTestObservation                                         IS      `hl7:"18,required,len=1,table=0174,datatype=IS,display=Nature of Test/Observation"`
 	ReportSubheader                                                 *CE     `hl7:"19,len=200,display=Report Subheader"`
 	ReportDisplayOrder                                              ST      `hl7:"20,len=20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefAttriForObs                       TS      `hl7:"21,len=26,format=YMDHMS,display=Date/Time Stamp for any change in Def Attri for Obs"`
 	EffectiveDateTimeOfChangeInTestProcThatMakeResultsNonComparable TS      `hl7:"22,len=26,format=YMDHMS,display=Effective Date/Time of Change in Test Proc. that make Results Non-Comparable"`
-	TypicalTurnAroundTime                                           NM      `hl7:"23,len=20,display=Typical Turn-Around Time"`
-	ProcessingTime                                                  NM      `hl7:"24,len=20,display=Processing Time"`
-	ProcessingPriority                                              []ID    `hl7:"25,len=40,table=0168,display=Processing Priority"`
-	ReportingPriority                                               ID      `hl7:"26,len=5,table=0169,display=Reporting Priority"`
+	TypicalTurnAroundTime                                           NM      `hl7:"23,len=20,datatype=NM,display=Typical Turn-Around Time"`
+	ProcessingTime                                                  NM      `hl7:"24,len=20,datatype=NM,display=Processing Time"`
+	ProcessingPriority                                              []ID    `hl7:"25,len=40,table=0168,datatype=ID,display=Processing Priority"`
+	ReportingPriority                                               ID      `hl7:"26,len=5,table=0169,datatype=ID,display=Reporting Priority"`
 	OutsideSite                                                     []CE    `hl7:"27,len=200,display=Outside Site"`
 	AddressOfOutsideSite                                            *XAD    `hl7:"28,len=1000,display=Address of Outside Site"`
 	PhoneNumberOfOutsideSite                                        *XTN    `hl7:"29,len=400,display=Phone Number of Outside Site"`
-	ConfidentialityCode                                             IS      `hl7:"30,len=1,table=0177,display=Confidentiality Code"`
+	ConfidentialityCode                                             IS      `hl7:"30,len=1,table=0177,datatype=IS,display=Confidentiality Code"`
 	ObservationsRequiredToInterpretTheObservation                   *CE     `hl7:"31,len=200,display=Observations Required to Interpret the Observation"`
 	InterpretationOfObservations                                    TX      `hl7:"32,len=65536,display=Interpretation of Observations"`
 	ContraindicationsToObservations                                 *CE     `hl7:"33,len=65536,display=Contraindications to Observations"`
@@ -1360,16 +1360,16 @@ type OM1 struct {
 // date, or time stamp).  It can be applied to observation batteries of type A and C (see OM119-nature of test/observation).
 type OM2 struct {
 	HL7                                  HL7Name       `hl7:",name=OM2,type=s"`
-	SequenceNumber                       NM            `hl7:"1,len=4,display=Sequence Number"`
+	SequenceNumber                       NM            `hl7:"1,len=4,datatype=NM,display=Sequence Number"`
 	UnitsOfMeasure                       *CE           `hl7:"2,len=60,display=Units of Measure"`
-	RangeOfDecimalPrecision              []NM          `hl7:"3,len=10,display=Range of Decimal Precision"`
+	RangeOfDecimalPrecision              []NM          `hl7:"3,len=10,datatype=NM,display=Range of Decimal Precision"`
 	CorrespondingSIUnitsOfMeasure        *CE           `hl7:"4,len=60,display=Corresponding SI Units of Measure"`
 	SIConversionFactor                   TX            `hl7:"5,len=60,display=SI Conversion Factor"`
 	Reference                            *CM_RFR       `hl7:"6,len=200,display=Reference"`
 	CriticalRangeForOrdinalContinuousObs *CM_RANGE     `hl7:"7,len=200,display=Critical Range for Ordinal & Continuous Obs"`
 	AbsoluteRangeForOrdinalContinuousObs *CM_ABS_RANGE `hl7:"8,len=200,display=Absolute Range for Ordinal & Continuous Obs"`
 	DeltaCheckCriteria                   []CM_DLT      `hl7:"9,len=200,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements          NM            `hl7:"10,len=20,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements          NM            `hl7:"10,len=20,datatype=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical test/observation
@@ -1377,13 +1377,13 @@ type OM2 struct {
 // This segment applies to free text and other non-numeric data t
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumber                              NM      `hl7:"1,len=4,display=Sequence Number"`
+	SequenceNumber                              NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number"`
 	PreferredCodingSystem                       *CE     `hl7:"2,len=60,display=Preferred Coding System"`
 	ValidCodedAnswers                           *CE     `hl7:"3,len=60,display=Valid Coded 'Answers'"`
 	NormalTextCodesForCategoricalObservations   []CE    `hl7:"4,len=200,display=Normal Text/Codes for Categorical Observations"`
 	AbnormalTextCodesForCategoricalObservations *CE     `hl7:"5,len=200,display=Abnormal Text/Codes for Categorical Observations"`
 	CriticalTextCodesForCategoricalObservations *CE     `hl7:"6,len=200,display=Critical Text Codes for Categorical Observations"`
-	ValueType                                   ID      `hl7:"7,len=3,table=0125,display=Value Type"`
+	ValueType                                   ID      `hl7:"7,len=3,table=0125,datatype=ID,display=Value Type"`
 }
 
 // Observations that require specimens
@@ -1393,10 +1393,10 @@ type OM3 struct {
 // and a serum specimen), multiple segments may be included, one for each specimen type.
 type OM4 struct {
 	HL7                         HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumber              NM      `hl7:"1,len=4,display=Sequence Number"`
-	DerivedSpecimen             ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
+	SequenceNumber              NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number"`
+	DerivedSpecimen             ID      `hl7:"2,len=1,table=0170,datatype=ID,display=Derived Specimen"`
 	ContainerDescription        TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume             NM      `hl7:"4,len=20,display=Container Volume"`
+	ContainerVolume             NM      `hl7:"4,len=20,datatype=NM,display=Container Volume"`
 	ContainerUnits              *CE     `hl7:"5,len=60,display=Container Units"`
 	Specimen                    *CE     `hl7:"6,len=60,display=Specimen"`
 	Additive                    *CE     `hl7:"7,len=60,display=Additive"`
@@ -1405,7 +1405,7 @@ type OM4 struct {
 	NormalCollectionVolume      *CQ     `hl7:"10,len=20,display=Normal Collection Volume"`
 	MinimumCollectionVolume     *CQ     `hl7:"11,len=20,display=Minimum Collection Volume"`
 	SpecimenRequirements        TX      `hl7:"12,len=10240,display=Specimen Requirements"`
-	SpecimenPriorities          []ID    `hl7:"13,len=1,table=0027,display=Specimen Priorities"`
+	SpecimenPriorities          []ID    `hl7:"13,len=1,table=0027,datatype=ID,display=Specimen Priorities"`
 	SpecimenRetentionTime       *CQ     `hl7:"14,len=20,display=Specimen Retention Time"`
 }
 
@@ -1415,7 +1415,7 @@ type OM4 struct {
 // of test/observation).
 type OM5 struct {
 	HL7                                           HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumber                                NM      `hl7:"1,len=4,display=Sequence Number"`
+	SequenceNumber                                NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number"`
 	TestObservationsIncludedWAnOrderedTestBattery []CE    `hl7:"2,len=200,display=Test/Observations Included w/an Ordered Test Battery"`
 	ObservationIDSuffixes                         ST      `hl7:"3,len=200,display=Observation ID Suffixes"`
 }
@@ -1426,7 +1426,7 @@ type OM5 struct {
 // by mathematical or logical means
 type OM6 struct {
 	HL7            HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumber NM      `hl7:"1,len=4,display=Sequence Number"`
+	SequenceNumber NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number"`
 	DerivationRule TX      `hl7:"2,len=10240,display=Derivation Rule"`
 }
 
@@ -1445,12 +1445,12 @@ type OM6 struct {
 // number, and ORC-3-filler order number
 type ORC struct {
 	HL7                    HL7Name `hl7:",name=ORC,type=s"`
-	OrderControl           ID      `hl7:"1,required,len=2,table=0119,display=Order Control"`
+	OrderControl           ID      `hl7:"1,required,len=2,table=0119,datatype=ID,display=Order Control"`
 	PlacerOrderNumber      *EI     `hl7:"2,conditional,len=22,display=Placer Order Number"`
 	FillerOrderNumber      *EI     `hl7:"3,conditional,len=22,display=Filler Order Number"`
 	PlacerGroupNumber      *EI     `hl7:"4,len=22,display=Placer Group Number"`
-	OrderStatus            ID      `hl7:"5,len=2,table=0038,display=Order Status"`
-	ResponseFlag           ID      `hl7:"6,len=1,table=0121,display=Response Flag"`
+	OrderStatus            ID      `hl7:"5,len=2,table=0038,datatype=ID,display=Order Status"`
+	ResponseFlag           ID      `hl7:"6,len=1,table=0121,datatype=ID,display=Response Flag"`
 	QuantityTiming         *TQ     `hl7:"7,len=200,display=Quantity/Timing"`
 	ParentOrder            *CM_EIP `hl7:"8,len=200,display=Parent Order"`
 	DateTimeOfTransaction  TS      `hl7:"9,len=26,format=YMDHMS,display=Date/Time of Transaction"`
@@ -1477,28 +1477,28 @@ type ORC struct {
 type PCR struct {
 	HL7                               HL7Name `hl7:",name=PCR,type=s"`
 	ImplicatedProduct                 CE      `hl7:"1,required,len=60,display=Implicated Product"`
-	GenericProduct                    IS      `hl7:"2,len=1,table=0249,display=Generic Product"`
+	GenericProduct                    IS      `hl7:"2,len=1,table=0249,datatype=IS,display=Generic Product"`
 	ProductClass                      *CE     `hl7:"3,len=60,display=Product Class"`
 	TotalDurationOfTherapy            *CQ     `hl7:"4,len=8,display=Total Duration Of Therapy"`
 	ProductManufactureDate            TS      `hl7:"5,len=26,format=YMDHMS,display=Product Manufacture Date"`
 	ProductExpirationDate             TS      `hl7:"6,len=26,format=YMDHMS,display=Product Expiration Date"`
 	ProductImplantationDate           TS      `hl7:"7,len=26,format=YMDHMS,display=Product Implantation Date"`
 	ProductExplantationDate           TS      `hl7:"8,len=26,format=YMDHMS,display=Product Explantation Date"`
-	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,display=Single Use Device"`
+	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,datatype=IS,display=Single Use Device"`
 	IndicationForProductUse           *CE     `hl7:"10,len=60,display=Indication For Product Use"`
-	ProductProblem                    IS      `hl7:"11,len=8,table=0245,display=Product Problem"`
+	ProductProblem                    IS      `hl7:"11,len=8,table=0245,datatype=IS,display=Product Problem"`
 	ProductSerialLotNumber            []ST    `hl7:"12,max=3,len=30,display=Product Serial/Lot Number"`
-	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,display=Product Available For Inspection"`
+	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,datatype=IS,display=Product Available For Inspection"`
 	ProductEvaluationPerformed        *CE     `hl7:"14,len=60,display=Product Evaluation Performed"`
 	ProductEvaluationStatus           *CE     `hl7:"15,len=60,table=0247,display=Product Evaluation Status"`
 	ProductEvaluationResults          *CE     `hl7:"16,len=60,display=Product Evaluation Results"`
-	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,display=Evaluated Product Source"`
+	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,datatype=ID,display=Evaluated Product Source"`
 	DateProductReturnedToManufacturer TS      `hl7:"18,len=26,format=YMDHMS,display=Date Product Returned To Manufacturer"`
-	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,display=Device Operator Qualifications"`
-	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,display=Relatedness Assessment"`
-	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,display=Action Taken In Response To The Event"`
-	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,display=Event Causality Observations"`
-	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,display=Indirect Exposure Mechanism"`
+	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,datatype=ID,display=Device Operator Qualifications"`
+	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,datatype=ID,display=Relatedness Assessment"`
+	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,datatype=ID,display=Action Taken In Response To The Event"`
+	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,datatype=ID,display=Event Causality Observations"`
+	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,datatype=ID,display=Indirect Exposure Mechanism"`
 }
 
 // Patient Demographic
@@ -1506,18 +1506,18 @@ type PCR struct {
 // The patient additional demographic segment contains demographic information that is likely to change about the patient.
 type PD1 struct {
 	HL7                                HL7Name `hl7:",name=PD1,type=s"`
-	LivingDependency                   []IS    `hl7:"1,len=2,table=0223,display=Living Dependency"`
-	LivingArrangement                  IS      `hl7:"2,len=2,table=0220,display=Living Arrangement"`
+	LivingDependency                   []IS    `hl7:"1,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	LivingArrangement                  IS      `hl7:"2,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PatientPrimaryFacility             []XON   `hl7:"3,len=90,display=Patient Primary Facility"`
 	PatientPrimaryCareProviderNameIDNo []XCN   `hl7:"4,len=90,display=Patient Primary Care Provider Name & ID No."`
-	StudentIndicator                   IS      `hl7:"5,len=2,table=0231,display=Student Indicator"`
-	Handicap                           IS      `hl7:"6,len=2,table=0295,display=Handicap"`
-	LivingWill                         IS      `hl7:"7,len=2,table=0315,display=Living Will"`
-	OrganDonor                         IS      `hl7:"8,len=2,table=0316,display=Organ Donor"`
-	SeparateBill                       ID      `hl7:"9,len=2,table=0136,display=Separate Bill"`
+	StudentIndicator                   IS      `hl7:"5,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Handicap                           IS      `hl7:"6,len=2,table=0295,datatype=IS,display=Handicap"`
+	LivingWill                         IS      `hl7:"7,len=2,table=0315,datatype=IS,display=Living Will"`
+	OrganDonor                         IS      `hl7:"8,len=2,table=0316,datatype=IS,display=Organ Donor"`
+	SeparateBill                       ID      `hl7:"9,len=2,table=0136,datatype=ID,display=Separate Bill"`
 	DuplicatePatient                   []CX    `hl7:"10,len=2,display=Duplicate Patient"`
 	PublicityIndicator                 *CE     `hl7:"11,len=1,table=0215,display=Publicity Indicator"`
-	ProtectionIndicator                ID      `hl7:"12,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 }
 
 // Product Detail Country
@@ -1532,7 +1532,7 @@ type PDC struct {
 	CatalogueIdentifier         ST      `hl7:"7,len=60,display=Catalogue Identifier"`
 	OtherIdentifier             []ST    `hl7:"8,len=60,display=Other Identifier"`
 	ProductCode                 *CE     `hl7:"9,len=60,display=Product Code"`
-	MarketingBasis              ID      `hl7:"10,len=4,table=0330,display=Marketing Basis"`
+	MarketingBasis              ID      `hl7:"10,len=4,table=0330,datatype=ID,display=Marketing Basis"`
 	MarketingApprovalIdentifier ST      `hl7:"11,len=60,display=Marketing Approval Identifier"`
 	LabeledShelfLife            *CQ     `hl7:"12,len=12,display=Labeled Shelf Life"`
 	ExpectedShelfLife           *CQ     `hl7:"13,len=12,display=Expected Shelf Life"`
@@ -1559,11 +1559,11 @@ type PEO struct {
 	EventImprovedDateTime                 TS      `hl7:"5,len=26,format=YMDHMS,display=Event Improved Date/Time"`
 	EventEndedDataTime                    TS      `hl7:"6,len=26,format=YMDHMS,display=Event Ended Data/Time"`
 	EventLocationOccurredAddress          *XAD    `hl7:"7,len=106,display=Event Location Occurred Address"`
-	EventQualification                    []ID    `hl7:"8,len=1,table=0237,display=Event Qualification"`
-	EventSerious                          ID      `hl7:"9,len=1,table=0238,display=Event Serious"`
-	EventExpected                         ID      `hl7:"10,len=1,table=0239,display=Event Expected"`
-	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,display=Event Outcome"`
-	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,display=Patient Outcome"`
+	EventQualification                    []ID    `hl7:"8,len=1,table=0237,datatype=ID,display=Event Qualification"`
+	EventSerious                          ID      `hl7:"9,len=1,table=0238,datatype=ID,display=Event Serious"`
+	EventExpected                         ID      `hl7:"10,len=1,table=0239,datatype=ID,display=Event Expected"`
+	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,datatype=ID,display=Event Outcome"`
+	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,datatype=ID,display=Patient Outcome"`
 	EventDescriptionFromOthers            []FT    `hl7:"13,len=600,display=Event Description From Others"`
 	EventFromOriginalReporter             []FT    `hl7:"14,len=600,display=Event From Original Reporter"`
 	EventDescriptionFromPatient           []FT    `hl7:"15,len=600,display=Event Description From Patient"`
@@ -1573,10 +1573,10 @@ type PEO struct {
 	PrimaryObserverName                   *XPN    `hl7:"19,len=46,display=Primary Observer Name"`
 	PrimaryObserverAddress                []XAD   `hl7:"20,len=106,display=Primary Observer Address"`
 	PrimaryObserverTelephone              []XTN   `hl7:"21,len=40,display=Primary Observer Telephone"`
-	PrimaryObserverSQualification         ID      `hl7:"22,len=1,table=0242,display=Primary Observer s Qualification"`
-	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,display=Confirmation Provided By"`
+	PrimaryObserverSQualification         ID      `hl7:"22,len=1,table=0242,datatype=ID,display=Primary Observer s Qualification"`
+	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,datatype=ID,display=Confirmation Provided By"`
 	PrimaryObserverAwareDateTime          TS      `hl7:"24,len=26,format=YMDHMS,display=Primary Observer Aware Date/Time"`
-	PrimaryObserverSIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,display=Primary Observer s Identity May Be Divulged"`
+	PrimaryObserverSIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,datatype=ID,display=Primary Observer s Identity May Be Divulged"`
 }
 
 // Product Experience Sender
@@ -1587,14 +1587,14 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,len=200,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,len=44,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,len=30,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,len=2,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,len=2,datatype=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,len=600,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,len=600,display=Sender Comment"`
 	SenderAwareDateTime    TS      `hl7:"9,len=26,format=YMDHMS,display=Sender Aware Date/Time"`
 	EventReportDate        TS      `hl7:"10,required,len=26,format=YMDHMS,display=Event Report Date"`
-	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,display=Event Report Timing/Type"`
-	EventReportSource      ID      `hl7:"12,len=1,table=0235,display=Event Report Source"`
-	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,display=Event Reported To"`
+	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,datatype=ID,display=Event Report Timing/Type"`
+	EventReportSource      ID      `hl7:"12,len=1,table=0235,datatype=ID,display=Event Report Source"`
+	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,datatype=ID,display=Event Reported To"`
 }
 
 // Patient Identification
@@ -1615,36 +1615,36 @@ type PES struct {
 //
 type PID struct {
 	HL7                     HL7Name `hl7:",name=PID,type=s"`
-	SetIDPatientID          SI      `hl7:"1,len=4,display=Set ID - Patient ID"`
+	SetIDPatientID          SI      `hl7:"1,len=4,datatype=SI,display=Set ID - Patient ID"`
 	PatientIDExternalID     *CX     `hl7:"2,len=20,display=Patient ID (External ID)"`
 	PatientIDInternalID     []CX    `hl7:"3,required,len=20,display=Patient ID (Internal ID)"`
 	AlternatePatientID      []CX    `hl7:"4,len=20,display=Alternate Patient ID"`
 	PatientName             []XPN   `hl7:"5,required,len=48,display=Patient Name"`
 	MothersMaidenName       *XPN    `hl7:"6,len=48,display=Mother's Maiden Name"`
 	DateOfBirth             TS      `hl7:"7,len=26,format=YMDHMS,display=Date of Birth"`
-	Sex                     IS      `hl7:"8,len=1,table=0001,display=Sex"`
+	Sex                     IS      `hl7:"8,len=1,table=0001,datatype=IS,display=Sex"`
 	PatientAlias            []XPN   `hl7:"9,len=48,display=Patient Alias"`
-	Race                    IS      `hl7:"10,len=1,table=0005,display=Race"`
+	Race                    IS      `hl7:"10,len=1,table=0005,datatype=IS,display=Race"`
 	PatientAddress          []XAD   `hl7:"11,len=106,display=Patient Address"`
-	CountyCode              IS      `hl7:"12,len=4,table=0289,display=County Code"`
+	CountyCode              IS      `hl7:"12,len=4,table=0289,datatype=IS,display=County Code"`
 	PhoneNumberHome         []XTN   `hl7:"13,len=40,display=Phone Number - Home"`
 	PhoneNumberBusiness     []XTN   `hl7:"14,len=40,display=Phone Number - Business"`
 	PrimaryLanguage         *CE     `hl7:"15,len=60,table=0296,display=Primary Language"`
-	MaritalStatus           IS      `hl7:"16,len=1,table=0002,display=Marital Status"`
-	Religion                IS      `hl7:"17,len=3,table=0006,display=Religion"`
+	MaritalStatus           IS      `hl7:"16,len=1,table=0002,datatype=IS,display=Marital Status"`
+	Religion                IS      `hl7:"17,len=3,table=0006,datatype=IS,display=Religion"`
 	PatientAccountNumber    *CX     `hl7:"18,len=20,display=Patient Account Number"`
 	SSNNumberPatient        ST      `hl7:"19,len=16,display=SSN Number - Patient"`
 	DriversLicenseNumber    *DLN    `hl7:"20,len=25,display=Driver's License Number"`
 	MothersIdentifier       []CX    `hl7:"21,len=20,display=Mother's Identifier"`
-	EthnicGroup             IS      `hl7:"22,len=3,table=0189,display=Ethnic Group"`
+	EthnicGroup             IS      `hl7:"22,len=3,table=0189,datatype=IS,display=Ethnic Group"`
 	BirthPlace              ST      `hl7:"23,len=60,display=Birth Place"`
-	MultipleBirthIndicator  ID      `hl7:"24,len=2,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder              NM      `hl7:"25,len=2,display=Birth Order"`
-	Citizenship             []IS    `hl7:"26,len=4,table=0171,display=Citizenship"`
+	MultipleBirthIndicator  ID      `hl7:"24,len=2,table=0136,datatype=ID,display=Multiple Birth Indicator"`
+	BirthOrder              NM      `hl7:"25,len=2,datatype=NM,display=Birth Order"`
+	Citizenship             []IS    `hl7:"26,len=4,table=0171,datatype=IS,display=Citizenship"`
 	VeteransMilitaryStatus  *CE     `hl7:"27,len=60,table=0172,display=Veterans Military Status"`
 	NationalityCode         *CE     `hl7:"28,len=80,table=0212,display=Nationality Code"`
 	PatientDeathDateAndTime TS      `hl7:"29,len=26,format=YMDHMS,display=Patient Death Date and Time"`
-	PatientDeathIndicator   ID      `hl7:"30,len=1,table=0136,display=Patient Death Indicator"`
+	PatientDeathIndicator   ID      `hl7:"30,len=1,table=0136,datatype=ID,display=Patient Death Indicator"`
 }
 
 // Procedures
@@ -1654,20 +1654,20 @@ type PID struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                     HL7Name `hl7:",name=PR1,type=s"`
-	SetIDProcedure          SI      `hl7:"1,required,len=4,display=Set ID - Procedure"`
-	ProcedureCodingMethod   IS      `hl7:"2,len=2,table=0089,display=Procedure Coding Method"`
+	SetIDProcedure          SI      `hl7:"1,required,len=4,datatype=SI,display=Set ID - Procedure"`
+	ProcedureCodingMethod   IS      `hl7:"2,len=2,table=0089,datatype=IS,display=Procedure Coding Method"`
 	ProcedureCode           CE      `hl7:"3,required,len=80,table=0088,display=Procedure Code"`
 	ProcedureDescription    ST      `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime       TS      `hl7:"5,required,len=26,format=YMDHMS,display=Procedure Date/Time"`
-	ProcedureType           IS      `hl7:"6,required,len=2,table=0230,display=Procedure Type"`
-	ProcedureMinutes        NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureType           IS      `hl7:"6,required,len=2,table=0230,datatype=IS,display=Procedure Type"`
+	ProcedureMinutes        NM      `hl7:"7,len=4,datatype=NM,display=Procedure Minutes"`
 	Anesthesiologist        []XCN   `hl7:"8,len=120,table=0010,display=Anesthesiologist"`
-	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes       NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,datatype=IS,display=Anesthesia Code"`
+	AnesthesiaMinutes       NM      `hl7:"10,len=4,datatype=NM,display=Anesthesia Minutes"`
 	Surgeon                 []XCN   `hl7:"11,len=120,table=0010,display=Surgeon"`
 	ProcedurePractitioner   []XCN   `hl7:"12,len=230,table=0010,display=Procedure Practitioner"`
 	ConsentCode             *CE     `hl7:"13,len=60,table=0059,display=Consent Code"`
-	ProcedurePriority       NM      `hl7:"14,len=2,display=Procedure Priority"`
+	ProcedurePriority       NM      `hl7:"14,len=2,datatype=NM,display=Procedure Priority"`
 	AssociatedDiagnosisCode *CE     `hl7:"15,len=80,display=Associated Diagnosis Code"`
 }
 
@@ -1681,8 +1681,8 @@ type PRA struct {
 	HL7                   HL7Name  `hl7:",name=PRA,type=s"`
 	PrimaryKeyValue       ST       `hl7:"1,required,len=20,display=PRA - Primary Key Value"`
 	PractionerGroup       []CE     `hl7:"2,len=60,display=Practioner Group"`
-	PractionerCategory    []IS     `hl7:"3,len=3,table=0186,display=Practioner Category"`
-	ProviderBilling       ID       `hl7:"4,len=1,table=0187,display=Provider Billing"`
+	PractionerCategory    []IS     `hl7:"3,len=3,table=0186,datatype=IS,display=Practioner Category"`
+	ProviderBilling       ID       `hl7:"4,len=1,table=0187,datatype=ID,display=Provider Billing"`
 	Specialty             []CM_SPD `hl7:"5,len=100,display=Specialty"`
 	PractitionerIDNumbers []CM_PLN `hl7:"6,len=100,display=Practitioner ID Numbers"`
 	Privileges            []CM_PIP `hl7:"7,len=200,display=Privileges"`
@@ -1698,12 +1698,12 @@ type PRA struct {
 // version of that data.
 type PRB struct {
 	HL7                                               HL7Name `hl7:",name=PRB,type=s"`
-	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime                                    TS      `hl7:"2,required,len=26,format=YMDHMS,display=Action Date/Time"`
 	ProblemID                                         CE      `hl7:"3,required,len=80,display=Problem ID"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,len=60,display=Problem Instance ID"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	ProblemListPriority                               NM      `hl7:"6,len=60,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,len=60,datatype=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        TS      `hl7:"7,len=26,format=YMDHMS,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              TS      `hl7:"8,len=26,format=YMDHMS,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   TS      `hl7:"9,len=26,format=YMDHMS,display=Actual Problem Resolution Date/Time"`
@@ -1717,7 +1717,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,len=80,display=Problem Onset Text"`
 	ProblemRanking                                    *CE     `hl7:"18,len=80,display=Problem Ranking"`
 	CertaintyOfProblem                                *CE     `hl7:"19,len=60,display=Certainty of Problem"`
-	ProbabilityOfProblem                              NM      `hl7:"20,len=5,display=Probability of Problem"`
+	ProbabilityOfProblem                              NM      `hl7:"20,len=5,datatype=NM,display=Probability of Problem"`
 	IndividualAwarenessOfProblem                      *CE     `hl7:"21,len=80,display=Individual Awareness of Problem"`
 	ProblemPrognosis                                  *CE     `hl7:"22,len=80,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CE     `hl7:"23,len=80,display=Individual Awareness of Prognosis"`
@@ -1736,21 +1736,21 @@ type PRC struct {
 	PrimaryKeyValue     CE      `hl7:"1,required,len=200,table=0132,display=Primary Key Value"`
 	FacilityID          []CE    `hl7:"2,len=60,display=Facility ID"`
 	Department          []CE    `hl7:"3,len=30,display=Department"`
-	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,display=Valid Patient Classes"`
+	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,len=12,display=Price"`
 	Formula             []ST    `hl7:"6,len=200,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,len=4,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,len=4,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,len=4,datatype=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,len=4,datatype=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,len=12,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,len=12,display=Maximum Price"`
 	EffectiveStartDate  TS      `hl7:"11,len=26,format=YMDHMS,display=Effective Start Date"`
 	EffectiveEndDate    TS      `hl7:"12,len=26,format=YMDHMS,display=Effective End Date"`
-	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,display=Price Override Flag"`
+	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,datatype=IS,display=Price Override Flag"`
 	BillingCategory     []CE    `hl7:"14,len=60,table=0293,display=Billing Category"`
-	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,display=Chargeable Flag"`
-	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,display=Active/Inactive Flag"`
+	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Chargeable Flag"`
+	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Cost                *MO     `hl7:"17,len=12,display=Cost"`
-	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,display=Charge On Indicator"`
+	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,datatype=IS,display=Charge On Indicator"`
 }
 
 // Provider Data
@@ -1786,13 +1786,13 @@ type PSH struct {
 	ReportIntervalEndDate                              TS      `hl7:"5,len=26,format=YMDHMS,display=Report Interval End Date"`
 	QuantityManufactured                               *CQ     `hl7:"6,len=12,display=Quantity Manufactured"`
 	QuantityDistributed                                *CQ     `hl7:"7,len=12,display=Quantity Distributed"`
-	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,display=Quantity Distributed Method"`
+	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,datatype=ID,display=Quantity Distributed Method"`
 	QuantityDistributedComment                         FT      `hl7:"9,len=600,display=Quantity Distributed Comment"`
 	QuantityInUse                                      *CQ     `hl7:"10,len=12,display=Quantity in Use"`
-	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,display=Quantity in Use Method"`
+	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,datatype=ID,display=Quantity in Use Method"`
 	QuantityInUseComment                               FT      `hl7:"12,len=600,display=Quantity in Use Comment"`
-	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,display=Number of Product Experience Reports Filed by Facility"`
-	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,display=Number of Product Experience Reports Filed by Distributor"`
+	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,datatype=NM,display=Number of Product Experience Reports Filed by Facility"`
+	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,datatype=NM,display=Number of Product Experience Reports Filed by Distributor"`
 }
 
 // Pathway
@@ -1801,7 +1801,7 @@ type PSH struct {
 // to address an individual’s health care
 type PTH struct {
 	HL7                                  HL7Name `hl7:",name=PTH,type=s"`
-	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	PathwayID                            CE      `hl7:"2,required,len=80,display=Pathway ID"`
 	PathwayInstanceID                    EI      `hl7:"3,required,len=60,display=Pathway Instance ID"`
 	PathwayEstablishedDateTime           TS      `hl7:"4,required,len=26,format=YMDHMS,display=Pathway Established Date/Time"`
@@ -1826,57 +1826,57 @@ type PTH struct {
 // since the same <nurse unit> ^ <room> ^ <bed> combination may exist at more than one facility.
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetIDPatientVisit       SI      `hl7:"1,len=4,display=Set ID - Patient Visit"`
-	PatientClass            ID      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
+	SetIDPatientVisit       SI      `hl7:"1,len=4,datatype=SI,display=Set ID - Patient Visit"`
+	PatientClass            ID      `hl7:"2,required,len=1,table=0004,datatype=ID,display=Patient Class"`
 	AssignedPatientLocation *PL     `hl7:"3,len=80,display=Assigned Patient Location"`
-	AdmissionType           ID      `hl7:"4,len=2,table=0007,display=Admission Type"`
+	AdmissionType           ID      `hl7:"4,len=2,table=0007,datatype=ID,display=Admission Type"`
 	PreadmitNumber          *CX     `hl7:"5,len=20,display=Preadmit Number"`
 	PriorPatientLocation    *PL     `hl7:"6,len=12,display=Prior Patient Location"`
 	AttendingDoctor         []XCN   `hl7:"7,len=60,table=0010,display=Attending Doctor"`
 	ReferringDoctor         []XCN   `hl7:"8,len=60,table=0010,display=Referring Doctor"`
 	ConsultingDoctor        []XCN   `hl7:"9,len=60,table=0010,display=Consulting Doctor"`
-	HospitalService         IS      `hl7:"10,len=3,table=0069,display=Hospital Service"`
+	HospitalService         IS      `hl7:"10,len=3,table=0069,datatype=IS,display=Hospital Service"`
 	TemporaryLocation       *PL     `hl7:"11,len=80,display=Temporary Location"`
-	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,display=Preadmit Test Indicator"`
-	ReadmissionIndicator    IS      `hl7:"13,len=2,table=0092,display=Readmission Indicator"`
-	AdmitSource             IS      `hl7:"14,len=3,table=0023,display=Admit Source"`
-	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,display=Ambulatory Status"`
-	VIPIndicator            IS      `hl7:"16,len=2,table=0099,display=VIP Indicator"`
+	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,datatype=IS,display=Preadmit Test Indicator"`
+	ReadmissionIndicator    IS      `hl7:"13,len=2,table=0092,datatype=IS,display=Readmission Indicator"`
+	AdmitSource             IS      `hl7:"14,len=3,table=0023,datatype=IS,display=Admit Source"`
+	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
+	VIPIndicator            IS      `hl7:"16,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 	AdmittingDoctor         []XCN   `hl7:"17,len=60,display=Admitting Doctor"`
-	PatientType             IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	PatientType             IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	VisitNumber             *CX     `hl7:"19,len=20,display=Visit Number"`
 	FinancialClass          []FC    `hl7:"20,len=50,display=Financial Class"`
-	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,display=Charge Price Indicator"`
-	CourtesyCode            IS      `hl7:"22,len=2,table=0045,display=Courtesy Code"`
-	CreditRating            IS      `hl7:"23,len=2,table=0046,display=Credit Rating"`
-	ContractCode            []IS    `hl7:"24,len=2,table=0044,display=Contract Code"`
+	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,datatype=IS,display=Charge Price Indicator"`
+	CourtesyCode            IS      `hl7:"22,len=2,table=0045,datatype=IS,display=Courtesy Code"`
+	CreditRating            IS      `hl7:"23,len=2,table=0046,datatype=IS,display=Credit Rating"`
+	ContractCode            []IS    `hl7:"24,len=2,table=0044,datatype=IS,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
-	InterestCode            IS      `hl7:"28,len=2,table=0073,display=Interest Code"`
-	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,display=Transfer to Bad Debt Code"`
+	ContractAmount          []NM    `hl7:"26,len=12,datatype=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,datatype=NM,display=Contract Period"`
+	InterestCode            IS      `hl7:"28,len=2,table=0073,datatype=IS,display=Interest Code"`
+	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,datatype=IS,display=Transfer to Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer to Bad Debt Date"`
-	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
-	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
+	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,datatype=IS,display=Bad Debt Agency Code"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,datatype=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,datatype=NM,display=Bad Debt Recovery Amount"`
+	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,datatype=IS,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
-	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
+	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,datatype=IS,display=Discharge Disposition"`
 	DischargedToLocation    *CM_DLD `hl7:"37,len=25,display=Discharged to Location"`
-	DietType                IS      `hl7:"38,len=2,table=0114,display=Diet Type"`
-	ServicingFacility       IS      `hl7:"39,len=2,table=0115,display=Servicing Facility"`
-	BedStatus               IS      `hl7:"40,len=1,table=0116,display=Bed Status"`
-	AccountStatus           IS      `hl7:"41,len=2,table=0117,display=Account Status"`
+	DietType                IS      `hl7:"38,len=2,table=0114,datatype=IS,display=Diet Type"`
+	ServicingFacility       IS      `hl7:"39,len=2,table=0115,datatype=IS,display=Servicing Facility"`
+	BedStatus               IS      `hl7:"40,len=1,table=0116,datatype=IS,display=Bed Status"`
+	AccountStatus           IS      `hl7:"41,len=2,table=0117,datatype=IS,display=Account Status"`
 	PendingLocation         *PL     `hl7:"42,len=80,display=Pending Location"`
 	PriorTemporaryLocation  *PL     `hl7:"43,len=80,display=Prior Temporary Location"`
 	AdmitDateTime           TS      `hl7:"44,len=26,format=YMDHMS,display=Admit Date/Time"`
 	DischargeDateTime       TS      `hl7:"45,len=26,format=YMDHMS,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,datatype=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,datatype=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,datatype=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,datatype=NM,display=Total Payments"`
 	AlternateVisitID        *CX     `hl7:"50,len=20,display=Alternate Visit ID"`
-	VisitIndicator          IS      `hl7:"51,len=1,table=0326,display=Visit Indicator"`
+	VisitIndicator          IS      `hl7:"51,len=1,table=0326,datatype=IS,display=Visit Indicator"`
 	OtherHealthcareProvider []XCN   `hl7:"52,len=60,table=0010,display=Other Healthcare Provider"`
 }
 
@@ -1891,37 +1891,37 @@ type PV2 struct {
 	TransferReason                    *CE     `hl7:"4,len=60,display=Transfer Reason"`
 	PatientValuables                  []ST    `hl7:"5,len=25,display=Patient Valuables"`
 	PatientValuablesLocation          ST      `hl7:"6,len=25,display=Patient Valuables Location"`
-	VisitUserCode                     IS      `hl7:"7,len=2,table=0130,display=Visit User Code"`
+	VisitUserCode                     IS      `hl7:"7,len=2,table=0130,datatype=IS,display=Visit User Code"`
 	ExpectedAdmitDate                 TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Admit Date"`
 	ExpectedDischargeDate             TS      `hl7:"9,len=26,format=YMDHMS,display=Expected Discharge Date"`
-	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,display=Estimated Length of Inpatient Stay"`
-	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,display=Actual Length of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,datatype=NM,display=Estimated Length of Inpatient Stay"`
+	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,datatype=NM,display=Actual Length of Inpatient Stay"`
 	VisitDescription                  ST      `hl7:"12,len=50,display=Visit Description"`
 	ReferralSourceCode                *XCN    `hl7:"13,len=90,display=Referral Source Code"`
 	PreviousServiceDate               DT      `hl7:"14,len=8,format=YMD,display=Previous Service Date"`
-	EmploymentIllnessRelatedIndicator ID      `hl7:"15,len=1,table=0136,display=Employment Illness Related Indicator"`
-	PurgeStatusCode                   IS      `hl7:"16,len=1,table=0213,display=Purge Status Code"`
+	EmploymentIllnessRelatedIndicator ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Employment Illness Related Indicator"`
+	PurgeStatusCode                   IS      `hl7:"16,len=1,table=0213,datatype=IS,display=Purge Status Code"`
 	PurgeStatusDate                   DT      `hl7:"17,len=8,format=YMD,display=Purge Status Date"`
-	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,display=Special Program Code"`
-	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,display=Expected Number of Insurance Plans"`
-	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,display=Visit Publicity Code"`
-	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
+	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,datatype=IS,display=Special Program Code"`
+	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Retention Indicator"`
+	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,datatype=NM,display=Expected Number of Insurance Plans"`
+	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,datatype=IS,display=Visit Publicity Code"`
+	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Visit Protection Indicator"`
 	ClinicOrganizationName            []XON   `hl7:"23,len=90,display=Clinic Organization Name"`
-	PatientStatusCode                 IS      `hl7:"24,len=2,table=0216,display=Patient Status Code"`
-	VisitPriorityCode                 IS      `hl7:"25,len=1,table=0217,display=Visit Priority Code"`
+	PatientStatusCode                 IS      `hl7:"24,len=2,table=0216,datatype=IS,display=Patient Status Code"`
+	VisitPriorityCode                 IS      `hl7:"25,len=1,table=0217,datatype=IS,display=Visit Priority Code"`
 	PreviousTreatmentDate             DT      `hl7:"26,len=8,format=YMD,display=Previous Treatment Date"`
-	ExpectedDischargeDisposition      IS      `hl7:"27,len=2,table=0112,display=Expected Discharge Disposition"`
+	ExpectedDischargeDisposition      IS      `hl7:"27,len=2,table=0112,datatype=IS,display=Expected Discharge Disposition"`
 	SignatureOnFileDate               DT      `hl7:"28,len=8,format=YMD,display=Signature on File Date"`
 	FirstSimilarIllnessDate           DT      `hl7:"29,len=8,format=YMD,display=First Similar Illness Date"`
-	PatientChargeAdjustmentCode       IS      `hl7:"30,len=3,table=0218,display=Patient Charge Adjustment Code"`
-	RecurringServiceCode              IS      `hl7:"31,len=2,table=0219,display=Recurring Service Code"`
-	BillingMediaCode                  ID      `hl7:"32,len=1,table=0136,display=Billing Media Code"`
+	PatientChargeAdjustmentCode       IS      `hl7:"30,len=3,table=0218,datatype=IS,display=Patient Charge Adjustment Code"`
+	RecurringServiceCode              IS      `hl7:"31,len=2,table=0219,datatype=IS,display=Recurring Service Code"`
+	BillingMediaCode                  ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Billing Media Code"`
 	ExpectedSurgeryDateTime           TS      `hl7:"33,len=26,format=YMDHMS,display=Expected Surgery Date & Time"`
-	MilitaryPartnershipCode           ID      `hl7:"34,len=2,table=0136,display=Military Partnership Code"`
-	MilitaryNonAvailabiltiyCode       ID      `hl7:"35,len=2,table=0136,display=Military Non-Availabiltiy Code"`
-	NewbornBabyIndicator              ID      `hl7:"36,len=1,table=0136,display=Newborn Baby Indicator"`
-	BabyDetainedIndicator             ID      `hl7:"37,len=1,table=0136,display=Baby Detained Indicator"`
+	MilitaryPartnershipCode           ID      `hl7:"34,len=2,table=0136,datatype=ID,display=Military Partnership Code"`
+	MilitaryNonAvailabiltiyCode       ID      `hl7:"35,len=2,table=0136,datatype=ID,display=Military Non-Availabiltiy Code"`
+	NewbornBabyIndicator              ID      `hl7:"36,len=1,table=0136,datatype=ID,display=Newborn Baby Indicator"`
+	BabyDetainedIndicator             ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Baby Detained Indicator"`
 }
 
 // Query Acknowledgement
@@ -1930,7 +1930,7 @@ type PV2 struct {
 type QAK struct {
 	HL7                 HL7Name `hl7:",name=QAK,type=s"`
 	QueryTag            ST      `hl7:"1,conditional,len=32,display=Query tag"`
-	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,display=Query response status"`
+	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,datatype=ID,display=Query response status"`
 }
 
 // Query definition segment
@@ -1939,17 +1939,17 @@ type QAK struct {
 type QRD struct {
 	HL7                        HL7Name `hl7:",name=QRD,type=s"`
 	QueryDateTime              TS      `hl7:"1,required,len=26,format=YMDHMS,display=Query Date/Time"`
-	QueryFormatCode            ID      `hl7:"2,required,len=1,table=0106,display=Query Format Code"`
-	QueryPriority              ID      `hl7:"3,required,len=1,table=0091,display=Query Priority"`
+	QueryFormatCode            ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query Format Code"`
+	QueryPriority              ID      `hl7:"3,required,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QueryID                    ST      `hl7:"4,required,len=10,display=Query ID"`
-	DeferredResponseType       ID      `hl7:"5,len=1,table=0107,display=Deferred Response Type"`
+	DeferredResponseType       ID      `hl7:"5,len=1,table=0107,datatype=ID,display=Deferred Response Type"`
 	DeferredResponseDateTime   TS      `hl7:"6,len=26,format=YMDHMS,display=Deferred Response Date/Time"`
 	QuantityLimitedRequest     CQ      `hl7:"7,required,len=10,display=Quantity Limited Request"`
 	WhoSubjectFilter           []XCN   `hl7:"8,required,len=60,display=Who Subject Filter"`
 	WhatSubjectFilter          []CE    `hl7:"9,required,len=60,table=0048,display=What Subject Filter"`
 	WhatDepartmentDataCode     []CE    `hl7:"10,required,len=60,display=What Department Data Code"`
 	WhatDataCodeValueQualifier []CM_VR `hl7:"11,len=20,display=What Data Code Value Qualifier"`
-	QueryResultsLevel          ID      `hl7:"12,len=1,table=0108,display=Query Results Level"`
+	QueryResultsLevel          ID      `hl7:"12,len=1,table=0108,datatype=ID,display=Query Results Level"`
 }
 
 // Query filter segment
@@ -1962,9 +1962,9 @@ type QRF struct {
 	WhenDataEndDateTime          TS      `hl7:"3,len=26,format=YMDHMS,display=When Data End Date/Time"`
 	WhatUserQualifier            []ST    `hl7:"4,len=60,display=What User Qualifier"`
 	OtherQRYSubjectFilter        []ST    `hl7:"5,len=60,display=Other QRY Subject Filter"`
-	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,display=Which Date/Time Qualifier"`
-	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,display=Which Date/Time Status Qualifier"`
-	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,display=Date/Time Selection Qualifier"`
+	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=Which Date/Time Qualifier"`
+	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=Which Date/Time Status Qualifier"`
+	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=Date/Time Selection Qualifier"`
 	WhenQuantityTimingQualifier  *TQ     `hl7:"9,len=60,display=When Quantity/Timing Qualifier"`
 }
 
@@ -1981,7 +1981,7 @@ type QRF struct {
 // (RDT) segments that follow.
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,display=Number of Columns per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,datatype=NM,display=Number of Columns per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,len=40,display=Column Description"`
 }
 
@@ -2024,8 +2024,8 @@ type RF1 struct {
 // if no grouping of resources is required — to allow parsers to properly understand the message.)
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set ID - RGS"`
-	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - RGS"`
+	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceGroupID   *CE     `hl7:"3,len=200,display=Resource Group ID"`
 }
 
@@ -2036,7 +2036,7 @@ type RGS struct {
 type ROL struct {
 	HL7               HL7Name `hl7:",name=ROL,type=s"`
 	RoleInstanceID    EI      `hl7:"1,required,len=60,display=Role Instance ID"`
-	ActionCode        ID      `hl7:"2,required,len=2,table=0287,display=Action Code"`
+	ActionCode        ID      `hl7:"2,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	Role              CE      `hl7:"3,required,len=80,display=Role"`
 	RolePerson        XCN     `hl7:"4,required,len=80,display=Role Person"`
 	RoleBeginDateTime TS      `hl7:"5,len=26,format=YMDHMS,display=Role Begin Date/Time"`
@@ -2056,8 +2056,8 @@ type RQ1 struct {
 	ManufacturersCatalog ST      `hl7:"3,conditional,len=16,display=Manufacturer's Catalog"`
 	VendorID             *CE     `hl7:"4,conditional,len=60,display=Vendor ID"`
 	VendorCatalog        ST      `hl7:"5,conditional,len=16,display=Vendor Catalog"`
-	Taxable              ID      `hl7:"6,len=1,table=0136,display=Taxable"`
-	SubstituteAllowed    ID      `hl7:"7,len=1,table=0136,display=Substitute Allowed"`
+	Taxable              ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Taxable"`
+	SubstituteAllowed    ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Substitute Allowed"`
 }
 
 // Requisition detail
@@ -2065,14 +2065,14 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,datatype=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CE     `hl7:"2,conditional,len=60,display=Item Code - Internal"`
 	ItemCodeExternal         *CE     `hl7:"3,conditional,len=60,display=Item Code - External"`
 	HospitalItemCode         *CE     `hl7:"4,conditional,len=60,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,datatype=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CE     `hl7:"6,len=60,display=Requisition Unit of Measure"`
-	DepartmentCostCenter     ID      `hl7:"7,len=30,table=0319,display=Department Cost Center"`
-	ItemNaturalAccountCode   ID      `hl7:"8,len=30,table=0320,display=Item Natural Account Code"`
+	DepartmentCostCenter     ID      `hl7:"7,len=30,table=0319,datatype=ID,display=Department Cost Center"`
+	ItemNaturalAccountCode   ID      `hl7:"8,len=30,table=0320,datatype=ID,display=Item Natural Account Code"`
 	DeliverToID              *CE     `hl7:"9,len=60,display=Deliver To ID"`
 	DateNeeded               DT      `hl7:"10,len=8,format=YMD,display=Date Needed"`
 }
@@ -2084,27 +2084,27 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                           HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter              NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	AdministrationSubIDCounter    NM      `hl7:"2,required,len=4,display=Administration Sub-ID Counter"`
+	GiveSubIDCounter              NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	AdministrationSubIDCounter    NM      `hl7:"2,required,len=4,datatype=NM,display=Administration Sub-ID Counter"`
 	DateTimeStartOfAdministration TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Start of Administration"`
 	DateTimeEndOfAdministration   TS      `hl7:"4,required,len=26,format=YMDHMS,display=Date/Time End of Administration"`
 	AdministeredCode              CE      `hl7:"5,required,len=100,table=0292,display=Administered Code"`
-	AdministeredAmount            NM      `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount            NM      `hl7:"6,required,len=20,datatype=NM,display=Administered Amount"`
 	AdministeredUnits             *CE     `hl7:"7,conditional,len=60,display=Administered Units"`
 	AdministeredDosageForm        *CE     `hl7:"8,len=60,display=Administered Dosage Form"`
 	AdministrationNotes           []CE    `hl7:"9,len=200,display=Administration Notes"`
 	AdministeringProvider         *XCN    `hl7:"10,len=200,display=Administering Provider"`
 	AdministeredAtLocation        *LA2    `hl7:"11,conditional,len=200,display=Administered-at Location"`
 	AdministeredPer               ST      `hl7:"12,conditional,len=20,display=Administered Per"`
-	AdministeredStrength          NM      `hl7:"13,len=20,display=Administered Strength"`
+	AdministeredStrength          NM      `hl7:"13,len=20,datatype=NM,display=Administered Strength"`
 	AdministeredStrengthUnits     *CE     `hl7:"14,len=60,display=Administered Strength Units"`
 	SubstanceLotNumber            []ST    `hl7:"15,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate       []TS    `hl7:"16,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName     []CE    `hl7:"17,len=60,table=0227,display=Substance Manufacturer Name"`
 	SubstanceRefusalReason        []CE    `hl7:"18,len=60,display=Substance Refusal Reason"`
 	Indication                    []CE    `hl7:"19,len=200,display=Indication"`
-	CompletionStatus              ID      `hl7:"20,len=2,table=0322,display=Completion Status"`
-	ActionCode                    ID      `hl7:"21,len=2,table=0323,display=Action Code-RXA"`
+	CompletionStatus              ID      `hl7:"20,len=2,table=0322,datatype=ID,display=Completion Status"`
+	ActionCode                    ID      `hl7:"21,len=2,table=0323,datatype=ID,display=Action Code-RXA"`
 	SystemEntryDateTime           TS      `hl7:"22,len=26,format=YMDHMS,display=System Entry Date/Time"`
 }
 
@@ -2116,41 +2116,41 @@ type RXA struct {
 // level is identical to that for the RXO level.
 type RXC struct {
 	HL7                    HL7Name `hl7:",name=RXC,type=s"`
-	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,display=RX Component Type"`
+	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,datatype=ID,display=RX Component Type"`
 	ComponentCode          CE      `hl7:"2,required,len=100,display=Component Code"`
-	ComponentAmount        NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount        NM      `hl7:"3,required,len=20,datatype=NM,display=Component Amount"`
 	ComponentUnits         CE      `hl7:"4,required,len=60,display=Component Units"`
-	ComponentStrength      NM      `hl7:"5,len=20,display=Component Strength"`
+	ComponentStrength      NM      `hl7:"5,len=20,datatype=NM,display=Component Strength"`
 	ComponentStrengthUnits *CE     `hl7:"6,len=60,display=Component Strength Units"`
 }
 
 // Pharmacy dispense segment
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,display=Dispense Sub-ID Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	DispenseGiveCode                                        CE      `hl7:"2,required,len=100,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,datatype=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CE     `hl7:"5,conditional,len=60,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CE     `hl7:"6,len=60,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,display=Number of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,len=200,display=Dispense Notes"`
 	DispensingProvider                                      *XCN    `hl7:"10,len=200,display=Dispensing Provider"`
-	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
-	TotalDailyDose                                          NM      `hl7:"12,len=10,display=Total Daily Dose"`
+	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,datatype=ID,display=Substitution Status"`
+	TotalDailyDose                                          NM      `hl7:"12,len=10,datatype=NM,display=Total Daily Dose"`
 	DispenseToLocation                                      *LA2    `hl7:"13,conditional,len=200,display=Dispense-To Location"`
-	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CE    `hl7:"15,len=200,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,len=20,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,len=20,datatype=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CE     `hl7:"17,len=60,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []TS    `hl7:"19,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CE    `hl7:"20,len=60,display=Substance Manufacturer Name"`
 	Indication                                              []CE    `hl7:"21,len=200,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"23,len=60,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 }
 
 // Pharmacy encoded order segment
@@ -2168,56 +2168,56 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          TQ      `hl7:"1,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                CE      `hl7:"2,required,len=100,table=0292,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CE      `hl7:"5,required,len=60,display=Give Units"`
 	GiveDosageForm                                          *CE     `hl7:"6,len=60,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions                     []CE    `hl7:"7,len=200,display=Provider's Administration Instructions"`
 	DeliverToLocation                                       *LA2    `hl7:"8,conditional,len=200,display=Deliver To Location"`
-	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,datatype=ID,display=Substitution Status"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,datatype=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CE     `hl7:"11,conditional,len=60,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,len=3,display=Number of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,len=3,datatype=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber                              *XCN    `hl7:"13,conditional,len=60,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID                  *XCN    `hl7:"14,len=60,display=Pharmacist/Treatment Supplier's Verifier ID"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,display=Number of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,display=Number of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,datatype=NM,display=Number of Refills/Doses Dispensed"`
 	DateTimeOfMostRecentRefillOrDoseDispensed               TS      `hl7:"18,conditional,len=26,format=YMDHMS,display=Date / time of most recent refill or dose dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,len=10,display=Total Daily Dose"`
-	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CE    `hl7:"21,len=200,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
 	GivePer                                                 ST      `hl7:"22,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                          ST      `hl7:"23,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                           *CE     `hl7:"24,len=60,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,len=20,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CE     `hl7:"26,len=60,display=Give Strength Units"`
 	GiveIndication                                          []CE    `hl7:"27,len=200,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"29,len=60,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 }
 
 // Pharmacy give segment
 type RXG struct {
 	HL7                                       HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                          NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	DispenseSubIDCounter                      NM      `hl7:"2,len=4,display=Dispense Sub-ID Counter"`
+	GiveSubIDCounter                          NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	DispenseSubIDCounter                      NM      `hl7:"2,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	QuantityTiming                            TQ      `hl7:"3,required,len=200,display=Quantity/Timing"`
 	GiveCode                                  CE      `hl7:"4,required,len=100,table=0292,display=Give Code"`
-	GiveAmountMinimum                         NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                         NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                         NM      `hl7:"5,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                         NM      `hl7:"6,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                 CE      `hl7:"7,required,len=60,display=Give Units"`
 	GiveDosageForm                            *CE     `hl7:"8,len=60,display=Give Dosage Form"`
 	AdministrationNotes                       []CE    `hl7:"9,len=200,display=Administration Notes"`
-	SubstitutionStatus                        ID      `hl7:"10,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                        ID      `hl7:"10,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	DispenseToLocation                        *LA2    `hl7:"11,len=200,display=Dispense-To Location"`
-	NeedsHumanReview                          ID      `hl7:"12,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                          ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacySpecialAdministrationInstructions []CE    `hl7:"13,len=200,display=Pharmacy Special Administration Instructions"`
 	GivePer                                   ST      `hl7:"14,conditional,len=20,display=Give Per"`
 	GiveRateAmount                            ST      `hl7:"15,len=6,display=Give Rate Amount"`
 	GiveRateUnits                             *CE     `hl7:"16,len=60,display=Give Rate Units"`
-	GiveStrength                              NM      `hl7:"17,len=20,display=Give Strength"`
+	GiveStrength                              NM      `hl7:"17,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                         *CE     `hl7:"18,len=60,display=Give Strength Units"`
 	SubstanceLotNumber                        []ST    `hl7:"19,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                   []TS    `hl7:"20,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2242,23 +2242,23 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      CE      `hl7:"1,required,len=100,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,required,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,required,len=20,datatype=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,datatype=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     CE      `hl7:"4,required,len=60,display=Requested Give Units"`
 	RequestedDosageForm                    *CE     `hl7:"5,len=60,display=Requested Dosage Form"`
 	ProvidersPharmacyInstructions          []CE    `hl7:"6,len=200,display=Provider's Pharmacy Instructions"`
 	ProvidersAdministrationInstructions    []CE    `hl7:"7,len=200,display=Provider's Administration Instructions"`
 	DeliverToLocation                      *LA1    `hl7:"8,len=200,display=Deliver To Location"`
-	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
+	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,datatype=ID,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CE     `hl7:"10,len=100,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,len=20,datatype=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CE     `hl7:"12,len=60,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,len=3,display=Number of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,len=3,datatype=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber             *XCN    `hl7:"14,conditional,len=60,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID *XCN    `hl7:"15,conditional,len=60,display=Pharmacist/Treatment Supplier's Verifier ID"`
-	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	RequestedGivePer                       ST      `hl7:"17,conditional,len=20,display=Requested Give Per"`
-	RequestedGiveStrength                  NM      `hl7:"18,len=20,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,len=20,datatype=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CE     `hl7:"19,len=60,display=Requested Give Strength Units"`
 	Indication                             *CE     `hl7:"20,len=200,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,len=6,display=Requested Give Rate Amount"`
@@ -2285,13 +2285,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,len=75,display=Placer Group Number"`
 	ScheduleID                *CE     `hl7:"5,len=200,display=Schedule ID"`
 	EventReason               CE      `hl7:"6,required,len=200,display=Event Reason"`
 	AppointmentReason         *CE     `hl7:"7,len=200,table=0276,display=Appointment Reason"`
 	AppointmentType           *CE     `hl7:"8,len=200,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  *CE     `hl7:"10,len=200,display=Appointment Duration Units"`
 	AppointmentTimingQuantity []TQ    `hl7:"11,required,len=200,display=Appointment Timing Quantity"`
 	PlacerContactPerson       *XCN    `hl7:"12,len=48,display=Placer Contact Person"`
@@ -2317,7 +2317,7 @@ type SCH struct {
 type SPR struct {
 	HL7                     HL7Name `hl7:",name=SPR,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/ Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/ Response Format Code"`
 	StoredProcedureName     CE      `hl7:"3,required,len=60,display=Stored procedure name"`
 	InputParameterList      []QIP   `hl7:"4,len=256,display=Input parameter list"`
 }
@@ -2336,10 +2336,10 @@ type STF struct {
 	PrimaryKeyValue          CE       `hl7:"1,required,len=60,display=STF - Primary Key Value"`
 	StaffIDCode              []CX     `hl7:"2,len=60,display=Staff ID Code"`
 	StaffName                *XPN     `hl7:"3,len=48,display=Staff Name"`
-	StaffType                []ID     `hl7:"4,len=2,table=0182,display=Staff Type"`
-	Sex                      IS       `hl7:"5,len=1,table=0001,display=Sex"`
+	StaffType                []ID     `hl7:"4,len=2,table=0182,datatype=ID,display=Staff Type"`
+	Sex                      IS       `hl7:"5,len=1,table=0001,datatype=IS,display=Sex"`
 	DateOfBirth              TS       `hl7:"6,len=26,format=YMDHMS,display=Date of Birth"`
-	ActiveInactiveFlag       ID       `hl7:"7,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag       ID       `hl7:"7,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Department               []CE     `hl7:"8,len=200,table=0184,display=Department"`
 	Service                  []CE     `hl7:"9,len=200,table=0069,display=Service"`
 	Phone                    []XTN    `hl7:"10,len=40,display=Phone"`
@@ -2348,14 +2348,14 @@ type STF struct {
 	InactivationDate         []CM_DIN `hl7:"13,len=26,display=Inactivation Date"`
 	BackupPersonID           []CE     `hl7:"14,len=60,display=Backup Person ID"`
 	EMailAddress             []ST     `hl7:"15,len=40,display=E-mail Address"`
-	PreferredMethodOfContact ID       `hl7:"16,len=1,table=0185,display=Preferred Method of Contact"`
-	MaritalStatus            IS       `hl7:"17,len=1,table=0002,display=Marital Status"`
+	PreferredMethodOfContact ID       `hl7:"16,len=1,table=0185,datatype=ID,display=Preferred Method of Contact"`
+	MaritalStatus            IS       `hl7:"17,len=1,table=0002,datatype=IS,display=Marital Status"`
 	JobTitle                 ST       `hl7:"18,len=20,display=Job Title"`
 	JobCodeClass             *JCC     `hl7:"19,len=20,display=Job Code/Class"`
-	EmploymentStatus         IS       `hl7:"20,len=2,table=0066,display=Employment Status"`
-	AdditionalInsuredOnAuto  ID       `hl7:"21,len=1,table=0136,display=Additional Insured on Auto"`
+	EmploymentStatus         IS       `hl7:"20,len=2,table=0066,datatype=IS,display=Employment Status"`
+	AdditionalInsuredOnAuto  ID       `hl7:"21,len=1,table=0136,datatype=ID,display=Additional Insured on Auto"`
 	DriversLicenseNumber     *DLN     `hl7:"22,len=25,display=Driver's License Number"`
-	CopyAutoIns              ID       `hl7:"23,len=1,table=0136,display=Copy Auto Ins"`
+	CopyAutoIns              ID       `hl7:"23,len=1,table=0136,datatype=ID,display=Copy Auto Ins"`
 	AutoInsExpires           DT       `hl7:"24,len=8,format=YMD,display=Auto Ins. Expires"`
 	DateLastDMVReview        DT       `hl7:"25,len=8,format=YMD,display=Date Last DMV Review"`
 	DateNextDMVReview        DT       `hl7:"26,len=8,format=YMD,display=Date Next DMV Review"`
@@ -2370,9 +2370,9 @@ type STF struct {
 // text.
 type TXA struct {
 	HL7                             HL7Name `hl7:",name=TXA,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID- TXA"`
-	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,display=Document Type"`
-	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,display=Document Content Presentation"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID- TXA"`
+	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,datatype=IS,display=Document Type"`
+	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,datatype=ID,display=Document Content Presentation"`
 	ActivityDateTime                TS      `hl7:"4,len=26,format=YMDHMS,display=Activity Date/Time"`
 	PrimaryActivityProviderCodeName *XCN    `hl7:"5,conditional,len=60,display=Primary Activity Provider Code/Name"`
 	OriginationDateTime             TS      `hl7:"6,len=26,format=YMDHMS,display=Origination Date/Time"`
@@ -2386,10 +2386,10 @@ type TXA struct {
 	PlacerOrderNumber               []EI    `hl7:"14,len=22,display=Placer Order Number"`
 	FillerOrderNumber               *EI     `hl7:"15,len=22,display=Filler Order Number"`
 	UniqueDocumentFileName          ST      `hl7:"16,len=30,display=Unique Document File Name"`
-	DocumentCompletionStatus        ID      `hl7:"17,required,len=2,table=0271,display=Document Completion Status"`
-	DocumentConfidentialityStatus   ID      `hl7:"18,len=2,table=0272,display=Document Confidentiality Status"`
-	DocumentAvailabilityStatus      ID      `hl7:"19,len=2,table=0273,display=Document Availability Status"`
-	DocumentStorageStatus           ID      `hl7:"20,len=2,table=0275,display=Document Storage Status"`
+	DocumentCompletionStatus        ID      `hl7:"17,required,len=2,table=0271,datatype=ID,display=Document Completion Status"`
+	DocumentConfidentialityStatus   ID      `hl7:"18,len=2,table=0272,datatype=ID,display=Document Confidentiality Status"`
+	DocumentAvailabilityStatus      ID      `hl7:"19,len=2,table=0273,datatype=ID,display=Document Availability Status"`
+	DocumentStorageStatus           ID      `hl7:"20,len=2,table=0275,datatype=ID,display=Document Storage Status"`
 	DocumentChangeReason            ST      `hl7:"21,conditional,len=30,display=Document Change Reason"`
 	AuthenticationPersonTimeStamp   []PPN   `hl7:"22,conditional,len=60,display=Authentication Person- Time Stamp"`
 	DistributedCopies               []XCN   `hl7:"23,len=60,display=Distributed Copies"`
@@ -2402,17 +2402,17 @@ type TXA struct {
 // in the PID segment and therefore do not appear here.
 type UB1 struct {
 	HL7                     HL7Name  `hl7:",name=UB1,type=s"`
-	SetID                   SI       `hl7:"1,seq,len=4,display=Set ID - UB1"`
-	BloodDeductible         NM       `hl7:"2,len=1,display=Blood Deductible"`
-	BloodFurnishedPintsOf   NM       `hl7:"3,len=2,display=Blood Furnished Pints Of"`
-	BloodReplacedPints      NM       `hl7:"4,len=2,display=Blood Replaced Pints"`
-	BloodNotReplacedPints   NM       `hl7:"5,len=2,display=Blood Not Replaced Pints"`
-	CoInsuranceDays         NM       `hl7:"6,len=2,display=Co Insurance Days"`
-	ConditionCode           []IS     `hl7:"7,max=5,len=14,table=0043,display=Condition Code"`
-	CoveredDays             NM       `hl7:"8,len=3,display=Covered Days"`
-	NonCoveredDays          NM       `hl7:"9,len=3,display=Non Covered Days"`
+	SetID                   SI       `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB1"`
+	BloodDeductible         NM       `hl7:"2,len=1,datatype=NM,display=Blood Deductible"`
+	BloodFurnishedPintsOf   NM       `hl7:"3,len=2,datatype=NM,display=Blood Furnished Pints Of"`
+	BloodReplacedPints      NM       `hl7:"4,len=2,datatype=NM,display=Blood Replaced Pints"`
+	BloodNotReplacedPints   NM       `hl7:"5,len=2,datatype=NM,display=Blood Not Replaced Pints"`
+	CoInsuranceDays         NM       `hl7:"6,len=2,datatype=NM,display=Co Insurance Days"`
+	ConditionCode           []IS     `hl7:"7,max=5,len=14,table=0043,datatype=IS,display=Condition Code"`
+	CoveredDays             NM       `hl7:"8,len=3,datatype=NM,display=Covered Days"`
+	NonCoveredDays          NM       `hl7:"9,len=3,datatype=NM,display=Non Covered Days"`
 	ValueAmountCode         []CM_UVC `hl7:"10,max=8,len=12,display=Value Amount & Code"`
-	NumberOfGraceDays       NM       `hl7:"11,len=2,display=Number Of Grace Days"`
+	NumberOfGraceDays       NM       `hl7:"11,len=2,datatype=NM,display=Number Of Grace Days"`
 	SpecProgramIndicator    *CE      `hl7:"12,len=60,display=Spec Program Indicator"`
 	PSROURApprovalIndicator *CE      `hl7:"13,len=60,display=PSRO/UR Approval Indicator"`
 	PSROURApprovedStayFm    DT       `hl7:"14,len=8,format=YMD,display=PSRO/UR Approved Stay Fm"`
@@ -2435,9 +2435,9 @@ type UB1 struct {
 // the element is listed with its new location in parentheses  (  ).
 type UB2 struct {
 	HL7                     HL7Name  `hl7:",name=UB2,type=s"`
-	SetID                   SI       `hl7:"1,seq,len=4,display=Set ID - UB2"`
+	SetID                   SI       `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB2"`
 	CoInsuranceDays         ST       `hl7:"2,len=3,display=Co-Insurance Days"`
-	ConditionCode           []IS     `hl7:"3,max=7,len=2,table=0043,display=Condition Code"`
+	ConditionCode           []IS     `hl7:"3,max=7,len=2,table=0043,datatype=IS,display=Condition Code"`
 	CoveredDays             ST       `hl7:"4,len=3,display=Covered Days"`
 	NonCoveredDays          ST       `hl7:"5,len=4,display=Non-Covered Days"`
 	ValueAmountCode         []CM_UVC `hl7:"6,max=12,len=11,display=Value Amount & Code"`
@@ -2451,7 +2451,7 @@ type UB2 struct {
 	UB92Locator56           []ST     `hl7:"14,max=5,len=14,display=UB92 Locator 56"`
 	UB92Locator57           ST       `hl7:"15,len=27,display=UB92 Locator 57"`
 	UB92Locator78           []ST     `hl7:"16,max=2,len=2,display=UB92 Locator 78"`
-	SpecialVisitCount       NM       `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount       NM       `hl7:"17,len=3,datatype=NM,display=Special Visit Count"`
 }
 
 // Results/update definition segment
@@ -2461,12 +2461,12 @@ type UB2 struct {
 type URD struct {
 	HL7                     HL7Name `hl7:",name=URD,type=s"`
 	RUDateTime              TS      `hl7:"1,len=26,format=YMDHMS,display=R/U Date/Time"`
-	ReportPriority          ID      `hl7:"2,len=1,table=0109,display=Report Priority"`
+	ReportPriority          ID      `hl7:"2,len=1,table=0109,datatype=ID,display=Report Priority"`
 	RUWhoSubjectDefinition  []XCN   `hl7:"3,required,len=60,display=R/U Who Subject Definition"`
 	RUWhatSubjectDefinition []CE    `hl7:"4,len=60,table=0048,display=R/U What Subject Definition"`
 	RUWhatDepartmentCode    []CE    `hl7:"5,len=60,display=R/U What Department Code"`
 	RUDisplayPrintLocations []ST    `hl7:"6,len=20,display=R/U Display/Print Locations"`
-	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,display=R/U Results Level"`
+	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,datatype=ID,display=R/U Results Level"`
 }
 
 // Unsolicited selection segment
@@ -2480,9 +2480,9 @@ type URS struct {
 	RUWhenDataEndDateTime           TS      `hl7:"3,len=26,format=YMDHMS,display=R/U When Data End Date/Time"`
 	RUWhatUserQualifier             []ST    `hl7:"4,len=20,display=R/U What User Qualifier"`
 	RUOtherResultsSubjectDefinition []ST    `hl7:"5,len=20,display=R/U Other Results Subject Definition"`
-	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,display=R/U Which Date/Time Qualifier"`
-	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,display=R/U Which Date/Time Status Qualifier"`
-	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,display=R/U Date/Time Selection Qualifier"`
+	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=R/U Which Date/Time Qualifier"`
+	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=R/U Which Date/Time Status Qualifier"`
+	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=R/U Date/Time Selection Qualifier"`
 	RUQuantityTimingQualifier       *TQ     `hl7:"9,len=60,display=R/U Quantity/Timing Qualifier"`
 }
 
@@ -2512,7 +2512,7 @@ type VAR struct {
 type VTQ struct {
 	HL7                     HL7Name `hl7:",name=VTQ,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/ Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/ Response Format Code"`
 	VTQueryName             CE      `hl7:"3,required,len=60,display=VT Query Name"`
 	VirtualTableName        CE      `hl7:"4,required,len=60,display=Virtual Table Name"`
 	SelectionCriteria       []QSC   `hl7:"5,len=256,display=Selection Criteria"`
@@ -15,8 +15,8 @@ type AD struct {
 	City                       ST      `hl7:"3,display=City"`
 	StateOrProvince            ST      `hl7:"4,display=State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,display=Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A-9A9."`
-	Country                    ID      `hl7:"6,table=ISO3166,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used.[2] This ISO table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
-	AddressType                ID      `hl7:"7,table=0190,display=Type is optional and defined by HL7 table 0190 - Address type ."`
+	Country                    ID      `hl7:"6,table=ISO3166,datatype=ID,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used.[2] This ISO table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Type is optional and defined by HL7 table 0190 - Address type ."`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes county- bioregion- SMSA- etc."`
 }
 
@@ -31,7 +31,7 @@ type AUI struct {
 // Charge Time
 type CCD struct {
 	HL7              HL7Name `hl7:",name=CCD,len=0,type=d"`
-	WhenToChargeCode ID      `hl7:"1,table=0100,display=When To Charge Code"`
+	WhenToChargeCode ID      `hl7:"1,table=0100,datatype=ID,display=When To Charge Code"`
 	DateTime         TS      `hl7:"2,format=YMDHMS,display=Date/time"`
 }
 
@@ -61,9 +61,9 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=0,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=Id Number"`
-	CheckDigit                                 NM      `hl7:"2,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
+	IDNumber                                   NM      `hl7:"1,datatype=NM,display=Id Number"`
+	CheckDigit                                 NM      `hl7:"2,datatype=NM,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component- namespace ID."`
 }
 
@@ -71,9 +71,9 @@ type CK struct {
 type CM_ABS_RANGE struct {
 	HL7              HL7Name   `hl7:",name=CM_ABS_RANGE,len=0,type=d"`
 	Range            *CM_RANGE `hl7:"1,display=Range"`
-	NumericChange    NM        `hl7:"2,display=Numeric Change"`
-	PercentPerChange NM        `hl7:"3,display=Percent Per Change"`
-	Days             NM        `hl7:"4,display=Days"`
+	NumericChange    NM        `hl7:"2,datatype=NM,display=Numeric Change"`
+	PercentPerChange NM        `hl7:"3,datatype=NM,display=Percent Per Change"`
+	Days             NM        `hl7:"4,datatype=NM,display=Days"`
 }
 
 // Order Sequence
@@ -90,24 +90,24 @@ type CM_ABS_RANGE struct {
 // The sequencing conditions supported by this 10th component are based on the completion of a  predecessor service.
 type CM_OSD struct {
 	HL7                               HL7Name `hl7:",name=CM_OSD,len=0,type=d"`
-	SequenceResultsFlag               ID      `hl7:"1,table=OSD1,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencin"`
+	SequenceResultsFlag               ID      `hl7:"1,table=OSD1,datatype=ID,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencin"`
 	PlacerOrderNumberEntityIdentifier ST      `hl7:"2,required,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
-	PlacerOrderNumberNamespaceID      IS      `hl7:"3,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
+	PlacerOrderNumberNamespaceID      IS      `hl7:"3,datatype=IS,display=Contains the first two components of the placer order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL"`
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
-	FillerOrderNumberNamespaceID      IS      `hl7:"5,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
+	FillerOrderNumberNamespaceID      IS      `hl7:"5,datatype=IS,display=Contains the first two components of the filler order number: entity identifier (ST) and namespace ID (IS) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	SequenceConditionValue            ST      `hl7:"6,display=The acceptable condition values have the form commonly used in project planning methodologies: <one of “SS”- “EE”- “SE”- or “ES”> +/- <time>   The first letter stands for start (S) or end (E) of predecessor order- where the predecessor is defined by the placer or filler order number in subcomponents 1-2 or subcomponents 3-4.   The second letter stands for the start (S) or end (E) of the successor order- where the successor order is the order containing this quantity/timing specification.   The time specifies the interval between the predecessor and successor starts or ends (see following examples). Where <time> is defined as:     - S<integer> do for <integer> seconds    - M<integer> do for <integer> minutes    - H<integer> do for <integer> hours    - D<integer> do for <integer> days    - W<integer> do for <integer> weeks    - L<integer> do for <integer> months"`
-	MaximumNumberOfRepeats            NM      `hl7:"7,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,datatype=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
-	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
+	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,datatype=ID,display=Contains the last two components of the placer order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the placer order number is an EI data type.  We have not defined subsubcomponents in HL7."`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
-	FillerOrderNumberUniversalIDType  ID      `hl7:"11,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
+	FillerOrderNumberUniversalIDType  ID      `hl7:"11,datatype=ID,display=Contains the last two components of the filler order number: universal ID (ST) and universal ID type (ID) (respectively).  Uses two subcomponents since the filler order number is an EI data type.  We have not defined subsubcomponents in HL7"`
 }
 
 // Penalty
 type CM_PEN struct {
 	HL7           HL7Name `hl7:",name=CM_PEN,len=0,type=d"`
-	PenaltyType   IS      `hl7:"1,table=0148,display=Penalty Type"`
-	PenaltyAmount NM      `hl7:"2,display=Penalty Amount"`
+	PenaltyType   IS      `hl7:"1,table=0148,datatype=IS,display=Penalty Type"`
+	PenaltyAmount NM      `hl7:"2,datatype=NM,display=Penalty Amount"`
 }
 
 // Wertebereich
@@ -134,8 +134,8 @@ type CN struct {
 	MiddleInitialOrName ST      `hl7:"4,display=Middle Initial Or Name"`
 	Suffix              ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix              ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree              IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
-	SourceTable         IS      `hl7:"8,table=0297,display=User-defined table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree              IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
+	SourceTable         IS      `hl7:"8,table=0297,datatype=IS,display=User-defined table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority  *HD     `hl7:"9,display=The assigning authority is a unique identifier of the system (organization or agency or department) that creates the data. It is a HD data type. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD data type- namespace ID."`
 }
 
@@ -148,11 +148,11 @@ type CN struct {
 type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      *MO     `hl7:"1,display=Subcomponents of price: <quantity> & <denomination>"`
-	PriceType  ID      `hl7:"2,table=0205,display=A coded value- data type ID. Refer to HL7 table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,display=See <from value> above."`
+	PriceType  ID      `hl7:"2,table=0205,datatype=ID,display=A coded value- data type ID. Refer to HL7 table 0205 - Price type for valid values."`
+	FromValue  NM      `hl7:"3,datatype=NM,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,datatype=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,display=Subcomponents of range units: <identifier (ID)> & <text (ST)> & <name of coding system (ST)> & <alternate identifier (ID)> & <alternate text (ST)> & <name of alternate coding system (ST)>"`
-	RangeType  ID      `hl7:"6,table=0298,display=Refers to HL7 table 0298 - CP range type for valid values."`
+	RangeType  ID      `hl7:"6,table=0298,datatype=ID,display=Refers to HL7 table 0298 - CP range type for valid values."`
 }
 
 // Composite Quantity With Units
@@ -165,7 +165,7 @@ type CP struct {
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,datatype=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the observation is measured in the default units- the units need not be transmitted. If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component. If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7. If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7. But in these cases the component separator should be replaced by the subcomponent delimiter"`
 }
 
@@ -177,18 +177,18 @@ type CX struct {
 	HL7                                        HL7Name `hl7:",name=CX,len=0,type=d"`
 	ID                                         ST      `hl7:"1,display=Defined as in the CK data type (see Section 2.8.5- CK - composite ID with check digit) except that a ST data type is allowed instead of a NM data type."`
 	CheckDigit                                 ST      `hl7:"2,display=Defined as in the CK data type (see Section 2.8.5- CK - composite ID with check digit) except that an ST data type is allowed instead of an NM data type. The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=Defined as in the CK data type (see Section 2.8.5- CK - composite ID with check digit). Refer to HL7 table 0061- Check digit scheme for valid values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=Defined as in the CK data type (see Section 2.8.5- CK - composite ID with check digit). Refer to HL7 table 0061- Check digit scheme for valid values."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique name of the system (or organization or agency or department) that creates the data. It is a HD data type. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	IdentifierTypeCode                         IS      `hl7:"5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
+	IdentifierTypeCode                         IS      `hl7:"5,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
 	AssigningFacility                          *HD     `hl7:"6,display=Subcomponents: <namespace ID (IS)> & < universal ID (ST)> & <universal ID type (ID)>"`
 }
 
 // Daily Deductible
 type DDI struct {
 	HL7          HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays    NM      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	DelayDays    NM      `hl7:"1,datatype=NM,display=Delay Days"`
+	Amount       NM      `hl7:"2,datatype=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,datatype=NM,display=Number Of Days"`
 }
 
 // Activation Date
@@ -201,7 +201,7 @@ type DIN struct {
 // Discharge Location
 type DLD struct {
 	HL7               HL7Name `hl7:",name=DLD,len=0,type=d"`
-	DischargeLocation IS      `hl7:"1,table=0113,display=Discharge Location"`
+	DischargeLocation IS      `hl7:"1,table=0113,datatype=IS,display=Discharge Location"`
 	EffectiveDate     TS      `hl7:"2,format=YMDHMS,display=Effective Date"`
 }
 
@@ -212,7 +212,7 @@ type DLD struct {
 type DLN struct {
 	HL7                         HL7Name `hl7:",name=DLN,len=0,type=d"`
 	DriversLicenseNumber        ST      `hl7:"1,display=This field contains the drivers license number."`
-	IssuingStateProvinceCountry IS      `hl7:"2,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. (The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code.) User-defined table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
+	IssuingStateProvinceCountry IS      `hl7:"2,datatype=IS,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. (The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code.) User-defined table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
 	ExpirationDate              DT      `hl7:"3,format=YMD,display=Expiration date (DT) for drivers license."`
 }
 
@@ -220,9 +220,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	Range             *NR     `hl7:"1,display=Range"`
-	NumericThreshold  NM      `hl7:"2,display=Numeric Threshold"`
+	NumericThreshold  NM      `hl7:"2,datatype=NM,display=Numeric Threshold"`
 	ChangeComputation ST      `hl7:"3,display=Change Computation"`
-	LengthOfTimeDays  NM      `hl7:"4,display=Length Of Time-days"`
+	LengthOfTimeDays  NM      `hl7:"4,datatype=NM,display=Length Of Time-days"`
 }
 
 // Date/time Range
@@ -249,8 +249,8 @@ type DT = time.Time
 // Day Type And Number
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
-	DayType      IS      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	DayType      IS      `hl7:"1,table=0149,datatype=IS,display=Day Type"`
+	NumberOfDays NM      `hl7:"2,datatype=NM,display=Number Of Days"`
 }
 
 // Entity Identifier
@@ -259,9 +259,9 @@ type DTN struct {
 type EI struct {
 	HL7              HL7Name `hl7:",name=EI,len=0,type=d"`
 	EntityIdentifier ST      `hl7:"1,display=The first component- <entity identifier>- is usually defined to be unique within the series of identifiers created by the <assigning authority>- defined by a hierarchic designator- represented by components 2 through 4. (See Section 2.8.20- HD - hierarchic designator.)"`
-	NamespaceID      IS      `hl7:"2,table=0300,display=See Section 2.8.20.1- Namespace ID (IS) for definition."`
+	NamespaceID      IS      `hl7:"2,table=0300,datatype=IS,display=See Section 2.8.20.1- Namespace ID (IS) for definition."`
 	UniversalID      ST      `hl7:"3,display=See Section 2.8.20.2- Universal ID (ST) for definition.."`
-	UniversalIDType  ID      `hl7:"4,table=0301,display=Refer to HL7 table 0301 - Universal ID type for valid values. See Section 2.8.20.2 Universal ID (ST)- for definition."`
+	UniversalIDType  ID      `hl7:"4,table=0301,datatype=ID,display=Refer to HL7 table 0301 - Universal ID type for valid values. See Section 2.8.20.2 Universal ID (ST)- for definition."`
 }
 
 // Parent Order
@@ -275,15 +275,15 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment ID"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field Position"`
+	Sequence             NM      `hl7:"2,datatype=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,datatype=NM,display=Field Position"`
 	CodeIdentifyingError *CE     `hl7:"4,table=0357,display=The fourth component (which references HL7 Table 0357 - Message error condition codes-  (as a CE data type)) is restricted from having any subcomponents as the subcomponent separator is now the CE’s component separator"`
 }
 
 // Financial Class
 type FC struct {
 	HL7            HL7Name `hl7:",name=FC,len=0,type=d"`
-	FinancialClass IS      `hl7:"1,table=0064,display=This component contains the financial class assigned to a person. User-defined table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
+	FinancialClass IS      `hl7:"1,table=0064,datatype=IS,display=This component contains the financial class assigned to a person. User-defined table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
 	EffectiveDate  TS      `hl7:"2,format=YMDHMS,display=This component contains the effective date/time of the persons assignment to the financial class specified in the first component."`
 }
 
@@ -302,9 +302,9 @@ type FT = string
 // is renamed more generically to be the namespace ID)
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=0,type=d"`
-	NamespaceID     IS      `hl7:"1,table=0300,display=User-defined table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
+	NamespaceID     IS      `hl7:"1,table=0300,datatype=IS,display=User-defined table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
 	UniversalID     ST      `hl7:"2,display=The HDs second component- <universal ID (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type). The UID is intended to be unique over time within the UID type. It is rigorously defined. Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type). The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType ID      `hl7:"3,table=0301,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
+	UniversalIDType ID      `hl7:"3,table=0301,datatype=ID,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
 }
 
 // Coded values for HL7 tables
@@ -327,50 +327,50 @@ type IS = string
 // Job Code/class
 type JCC struct {
 	HL7      HL7Name `hl7:",name=JCC,len=0,type=d"`
-	JobCode  IS      `hl7:"1,table=0327,display=This component contains the persons job code. User-defined table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
-	JobClass IS      `hl7:"2,table=0328,display=This component contains the persons employee classification. User-defined table 0328 - Employee classification is used as the HL7 identifier for the user-defined table of values for this component."`
+	JobCode  IS      `hl7:"1,table=0327,datatype=IS,display=This component contains the persons job code. User-defined table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
+	JobClass IS      `hl7:"2,table=0328,datatype=IS,display=This component contains the persons employee classification. User-defined table 0328 - Employee classification is used as the HL7 identifier for the user-defined table of values for this component."`
 }
 
 // Location With Address Information (variant 1)
 type LA1 struct {
 	HL7                HL7Name `hl7:",name=LA1,len=0,type=d"`
-	PointOfCare        IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"2,table=0303,display=Room"`
-	Bed                IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"4,display=Facility"`
-	LocationStatus     IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"7,table=0307,display=Building"`
-	Floor              IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus     IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor              IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	Address            *AD     `hl7:"9,display=Address"`
 }
 
 // Location With Address Information (variant 2)
 type LA2 struct {
 	HL7                        HL7Name `hl7:",name=LA2,len=0,type=d"`
-	PointOfCare                IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room                       IS      `hl7:"2,table=0303,display=Room"`
-	Bed                        IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare                IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room                       IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                        IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility                   *HD     `hl7:"4,display=Facility"`
-	LocationStatus             IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType         IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building                   IS      `hl7:"7,table=0307,display=Building"`
-	Floor                      IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus             IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType         IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building                   IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor                      IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	StreetAddress              ST      `hl7:"9,display=Street Address"`
 	OtherDesignation           ST      `hl7:"10,display=Other Designation"`
 	City                       ST      `hl7:"11,display=City"`
 	StateOrProvince            ST      `hl7:"12,display=State Or Province"`
 	ZipOrPostalCode            ST      `hl7:"13,display=Zip Or Postal Code"`
-	Country                    ID      `hl7:"14,table=ISO3166,display=Country"`
-	AddressType                ID      `hl7:"15,table=0190,display=Address Type"`
+	Country                    ID      `hl7:"14,table=ISO3166,datatype=ID,display=Country"`
+	AddressType                ID      `hl7:"15,table=0190,datatype=ID,display=Address Type"`
 	OtherGeographicDesignation ST      `hl7:"16,display=Other Geographic Designation"`
 }
 
 // Money
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
-	Denomination ID      `hl7:"2,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default. Example:"`
+	Quantity     NM      `hl7:"1,datatype=NM,display=The first component is a quantity."`
+	Denomination ID      `hl7:"2,table=ISO4217,datatype=ID,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default. Example:"`
 }
 
 // Charge To Practise
@@ -385,9 +385,9 @@ type MOC struct {
 // This field contains the message type, trigger event, and the message structure ID for the message.
 type MSG struct {
 	HL7              HL7Name `hl7:",name=MSG,len=0,type=d"`
-	MessageType      ID      `hl7:"1,table=0076,display=The first component is the message type code defined by HL7 table 0076 - Message type"`
-	TriggerEvent     ID      `hl7:"2,table=0003,display=The second component is the trigger event code defined by HL7 table 0003 - Event type"`
-	MessageStructure ID      `hl7:"3,table=0354,display=The third component is the abstract message structure code defined by HL7 Table 0354 - Message structure.  This table has two columns.  The first column contains the value of this code- which describes a particular HL7 “abstract message structure definition” in terms of segments- as defined in sections 2.11 and 2.11.1.  The second column of table 0354 lists the various HL7 trigger events that use the particular abstract message definition.  For example- the message structure code ADT_A01 describes the single abstract message structure used by the trigger events A01- A04- A05- A08- A13- A14- A28 and A31"`
+	MessageType      ID      `hl7:"1,table=0076,datatype=ID,display=The first component is the message type code defined by HL7 table 0076 - Message type"`
+	TriggerEvent     ID      `hl7:"2,table=0003,datatype=ID,display=The second component is the trigger event code defined by HL7 table 0003 - Event type"`
+	MessageStructure ID      `hl7:"3,table=0354,datatype=ID,display=The third component is the abstract message structure code defined by HL7 Table 0354 - Message structure.  This table has two columns.  The first column contains the value of this code- which describes a particular HL7 “abstract message structure definition” in terms of segments- as defined in sections 2.11 and 2.11.1.  The second column of table 0354 lists the various HL7 trigger events that use the particular abstract message definition.  For example- the message structure code ADT_A01 describes the single abstract message structure used by the trigger events A01- A04- A05- A08- A13- A14- A28 and A31"`
 }
 
 func (d MSG) MessageStructureID() string {
@@ -406,14 +406,14 @@ type NDL struct {
 	OPName             *CN     `hl7:"1,display=OP Name"`
 	StartDateTime      TS      `hl7:"2,format=YMDHMS,display=Start Date/time"`
 	EndDateTime        TS      `hl7:"3,format=YMDHMS,display=End Date/time"`
-	PointOfCare        IS      `hl7:"4,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"5,table=0303,display=Room"`
-	Bed                IS      `hl7:"6,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"4,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"5,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"6,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"7,display=Facility"`
-	LocationStatus     IS      `hl7:"8,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"9,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"10,table=0307,display=Building"`
-	Floor              IS      `hl7:"11,table=0308,display=Floor"`
+	LocationStatus     IS      `hl7:"8,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"9,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"10,table=0307,datatype=IS,display=Building"`
+	Floor              IS      `hl7:"11,table=0308,datatype=IS,display=Floor"`
 }
 
 // Numeric
@@ -426,14 +426,14 @@ type NM = string
 // Wertebereich
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=Low Value"`
-	HighValue NM      `hl7:"2,display=High Value"`
+	LowValue  NM      `hl7:"1,datatype=NM,display=Low Value"`
+	HighValue NM      `hl7:"2,datatype=NM,display=High Value"`
 }
 
 // Occurence
 type OCD struct {
 	HL7            HL7Name `hl7:",name=OCD,len=0,type=d"`
-	OccurrenceCode ID      `hl7:"1,table=0350,display=Occurrence Code"`
+	OccurrenceCode ID      `hl7:"1,table=0350,datatype=ID,display=Occurrence Code"`
 	OccurrenceDate DT      `hl7:"2,format=YMD,display=Occurrence Date"`
 }
 
@@ -448,8 +448,8 @@ type OSP struct {
 // Pre-certification Required
 type PCF struct {
 	HL7                         HL7Name `hl7:",name=PCF,len=0,type=d"`
-	PreCertificationPatientType IS      `hl7:"1,table=0150,display=Pre-certification Patient Type"`
-	PreCertificationRequired    ID      `hl7:"2,table=0136,display=Pre-certification Required"`
+	PreCertificationPatientType IS      `hl7:"1,table=0150,datatype=IS,display=Pre-certification Patient Type"`
+	PreCertificationRequired    ID      `hl7:"2,table=0136,datatype=ID,display=Pre-certification Required"`
 	PreCertificationWindow      TS      `hl7:"3,format=YMDHMS,display=Pre-certification Window"`
 }
 
@@ -457,7 +457,7 @@ type PCF struct {
 type PI struct {
 	HL7                 HL7Name `hl7:",name=PI,len=0,type=d"`
 	IDNumber            ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber      IS      `hl7:"2,display=Type Of ID Number"`
+	TypeOfIDNumber      IS      `hl7:"2,datatype=IS,display=Type Of ID Number"`
 	OtherQualifyingInfo ST      `hl7:"3,display=Other Qualifying Info"`
 }
 
@@ -478,14 +478,14 @@ type PIP struct {
 // within a healthcare setting.
 type PL struct {
 	HL7                 HL7Name `hl7:",name=PL,len=0,type=d"`
-	PointOfCare         IS      `hl7:"1,table=0302,display=Conditional on person location type (e.g.- nursing unit or department or clinic). After floor- most general patient location designation. User-defined table 0302 - Point of care is used as the HL7 identifier for the user-defined table of values for this component."`
-	Room                IS      `hl7:"2,table=0303,display=Patient room. After nursing unit- most general person location designation. User-defined table 0303 - Room is used as the HL7 identifier for the user-defined table of values for this component."`
-	Bed                 IS      `hl7:"3,table=0304,display=Patient bed. After room- most general person location designation. User-defined table 0304 - Bed is used as the HL7 identifier for the user-defined table of values for this component."`
+	PointOfCare         IS      `hl7:"1,table=0302,datatype=IS,display=Conditional on person location type (e.g.- nursing unit or department or clinic). After floor- most general patient location designation. User-defined table 0302 - Point of care is used as the HL7 identifier for the user-defined table of values for this component."`
+	Room                IS      `hl7:"2,table=0303,datatype=IS,display=Patient room. After nursing unit- most general person location designation. User-defined table 0303 - Room is used as the HL7 identifier for the user-defined table of values for this component."`
+	Bed                 IS      `hl7:"3,table=0304,datatype=IS,display=Patient bed. After room- most general person location designation. User-defined table 0304 - Bed is used as the HL7 identifier for the user-defined table of values for this component."`
 	Facility            *HD     `hl7:"4,display=Most general person location designation. (See Section 2.8.20- HD - hierarchic designator)."`
-	LocationStatus      IS      `hl7:"5,table=0306,display=Location (e.g.- Bed) status. User-defined table 0306 - Location status is used as the HL7 identifier for the user-defined table of values for this component."`
-	PersonLocationType  IS      `hl7:"6,table=0305,display=Usually includes values such as nursing unit- department- clinic- SNF- physicians office. User-defined table 0305 - Person location type is used as the HL7 identifier for the user-defined table of values for this component."`
-	Building            IS      `hl7:"7,table=0307,display=After facility- most general person location designation. User-defined table 0307 - Building is used as the HL7 identifier for the user-defined table of values for this component."`
-	Floor               IS      `hl7:"8,table=0308,display=After building- most general person location designation. User-defined table 0308 - Floor is used as the HL7 identifier for the user-defined table of values for this component."`
+	LocationStatus      IS      `hl7:"5,table=0306,datatype=IS,display=Location (e.g.- Bed) status. User-defined table 0306 - Location status is used as the HL7 identifier for the user-defined table of values for this component."`
+	PersonLocationType  IS      `hl7:"6,table=0305,datatype=IS,display=Usually includes values such as nursing unit- department- clinic- SNF- physicians office. User-defined table 0305 - Person location type is used as the HL7 identifier for the user-defined table of values for this component."`
+	Building            IS      `hl7:"7,table=0307,datatype=IS,display=After facility- most general person location designation. User-defined table 0307 - Building is used as the HL7 identifier for the user-defined table of values for this component."`
+	Floor               IS      `hl7:"8,table=0308,datatype=IS,display=After building- most general person location designation. User-defined table 0308 - Floor is used as the HL7 identifier for the user-defined table of values for this component."`
 	LocationDescription ST      `hl7:"9,display=A free text description of the location."`
 }
 
@@ -493,7 +493,7 @@ type PL struct {
 type PLN struct {
 	HL7                      HL7Name `hl7:",name=PLN,len=0,type=d"`
 	IDNumber                 ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber           IS      `hl7:"2,table=0338,display=Type Of ID Number"`
+	TypeOfIDNumber           IS      `hl7:"2,table=0338,datatype=IS,display=Type Of ID Number"`
 	StateOtherQualifyingInfo ST      `hl7:"3,display=State/other Qualifying Info"`
 	ExpirationDate           DT      `hl7:"4,format=YMD,display=Expiration Date"`
 }
@@ -510,16 +510,16 @@ type PPN struct {
 	MiddleInitialOrName                        ST      `hl7:"4,display=Middle Initial Or Name"`
 	Suffix                                     ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                     ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                     IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
-	SourceTable                                IS      `hl7:"8,table=0297,display=User-defined table 0297 - CN IDsource is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                     IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
+	SourceTable                                IS      `hl7:"8,table=0297,datatype=IS,display=User-defined table 0297 - CN IDsource is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                         *HD     `hl7:"9,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. It is a HD data type. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                               ID      `hl7:"10,table=0200,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.51- XPN - extended person name)."`
+	NameTypeCode                               ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.51- XPN - extended person name)."`
 	IdentifierCheckDigit                       ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,display=Refer to HL7 table 0061 - Check digit scheme for valid values."`
-	IdentifierTypeCode                         IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 table 0061 - Check digit scheme for valid values."`
+	IdentifierTypeCode                         IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
 	AssigningFacility                          *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 	DateTimeActionPerformed                    TS      `hl7:"15,format=YMDHMS,display=This component describes when the activity was performed."`
-	NameRepresentationCode                     ID      `hl7:"16,table=4000,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component."`
+	NameRepresentationCode                     ID      `hl7:"16,table=4000,datatype=ID,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component."`
 }
 
 // Parent Result Link
@@ -535,16 +535,16 @@ type PRL struct {
 // This data type indicates whether to process a message as defined in HL7 Application (level 7) Processing rules.
 type PT struct {
 	HL7            HL7Name `hl7:",name=PT,len=0,type=d"`
-	ProcessingID   ID      `hl7:"1,table=0103,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 table 0103 - Processing ID for valid values."`
-	ProcessingMode ID      `hl7:"2,table=0207,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 table 0207 - Processing mode for valid values."`
+	ProcessingID   ID      `hl7:"1,table=0103,datatype=ID,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 table 0103 - Processing ID for valid values."`
+	ProcessingMode ID      `hl7:"2,table=0207,datatype=ID,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 table 0207 - Processing mode for valid values."`
 }
 
 // Policy Type
 type PTA struct {
 	HL7         HL7Name `hl7:",name=PTA,len=0,type=d"`
-	PolicyType  IS      `hl7:"1,table=0147,display=Policy Type"`
-	AmountClass IS      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	PolicyType  IS      `hl7:"1,table=0147,datatype=IS,display=Policy Type"`
+	AmountClass IS      `hl7:"2,table=0193,datatype=IS,display=Amount Class"`
+	Amount      NM      `hl7:"3,datatype=NM,display=Amount"`
 }
 
 // Query Input Parameter List
@@ -563,9 +563,9 @@ type QIP struct {
 type QSC struct {
 	HL7                   HL7Name `hl7:",name=QSC,len=0,type=d"`
 	SegmentFieldName      ST      `hl7:"1,display=The name of the field that is participating as a qualifier (usually the key). Refer to Section 2.8.32- QIP - query input parameter list- for segment field name conventions."`
-	RelationalOperator    ID      `hl7:"2,table=0209,display=Refer to HL7 table 0209 - Relational operator for valid values."`
+	RelationalOperator    ID      `hl7:"2,table=0209,datatype=ID,display=Refer to HL7 table 0209 - Relational operator for valid values."`
 	Value                 ST      `hl7:"3,display=The value to which the field will be compared."`
-	RelationalConjunction ID      `hl7:"4,table=0210,display=Refer to HL7 table 0210 - Relational conjunction for valid values. The relational conjunction is defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next."`
+	RelationalConjunction ID      `hl7:"4,table=0210,datatype=ID,display=Refer to HL7 table 0210 - Relational conjunction for valid values. The relational conjunction is defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next."`
 }
 
 // Row Column Definition
@@ -576,14 +576,14 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,display=The HL7 segment field name- which identifies the field occupying the column. (Refer to Section 2.8.32- QIP - query input parameter list- for segment field name definition conventions.)"`
 	Hl7DataType        ST      `hl7:"2,display=The two or three character HL7 data type- as defined in Section 2.8- Data types."`
-	MaximumColumnWidth NM      `hl7:"3,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
+	MaximumColumnWidth NM      `hl7:"3,datatype=NM,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
 }
 
 // Reference Range
 type RFR struct {
 	HL7                 HL7Name `hl7:",name=RFR,len=0,type=d"`
 	NumericRange        *NR     `hl7:"1,display=Numeric Range"`
-	AdministrativeSex   IS      `hl7:"2,table=0001,display=Administrative Sex"`
+	AdministrativeSex   IS      `hl7:"2,table=0001,datatype=IS,display=Administrative Sex"`
 	AgeRange            *NR     `hl7:"3,display=Age Range"`
 	GestationalAgeRange *NR     `hl7:"4,display=Gestational Age Range"`
 	Species             TX      `hl7:"5,display=Species"`
@@ -598,16 +598,16 @@ type RFR struct {
 // of the Interval component of the Quantity/Timing field given in Chapter 4, Section 4.4.2 Interval component (CM).
 type RI struct {
 	HL7                  HL7Name `hl7:",name=RI,len=0,type=d"`
-	RepeatPattern        IS      `hl7:"1,table=0335,display=The repeating frequency with which the treatment is to be administered.  It is similar to the frequency and SIG code tables used in order entry systems"`
+	RepeatPattern        IS      `hl7:"1,table=0335,datatype=IS,display=The repeating frequency with which the treatment is to be administered.  It is similar to the frequency and SIG code tables used in order entry systems"`
 	ExplicitTimeInterval ST      `hl7:"2,display=This field explicitly lists the actual times referenced by the code in the first subcomponent- in the following format: HHMM-HHMM-HHMM-.…  This second subcomponent will be used to clarify the first subcomponent in cases where the actual administration times vary within an institution.  If the time of the order spans more than a single day- this new subcomponent is only practical if the same times of administration occur for each day of the order.  If the actual start time of the order (as given by the fourth subcomponent of the quantity/timing field) is after the first explicit time- the first administration is taken to be the first explicit time after the start time.  In the case where the patient moves to a location having a different set of explicit times- the existing order may be updated with a new quantity/timing field showing the changed explicit times."`
 }
 
 // Room Coverage
 type RMC struct {
 	HL7            HL7Name `hl7:",name=RMC,len=0,type=d"`
-	RoomType       IS      `hl7:"1,table=0145,display=Room Type"`
-	AmountType     IS      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	RoomType       IS      `hl7:"1,table=0145,datatype=IS,display=Room Type"`
+	AmountType     IS      `hl7:"2,table=0146,datatype=IS,display=Amount Type"`
+	CoverageAmount NM      `hl7:"3,datatype=NM,display=Coverage Amount"`
 }
 
 // Scheduling Class Value Pair
@@ -615,7 +615,7 @@ type RMC struct {
 // For use only with the scheduling chapter.
 type SCV struct {
 	HL7            HL7Name `hl7:",name=SCV,len=0,type=d"`
-	ParameterClass IS      `hl7:"1,table=0294,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application."`
+	ParameterClass IS      `hl7:"1,table=0294,datatype=IS,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application."`
 	ParameterValue ST      `hl7:"2,display=The second component is the actual data value for that parameter."`
 }
 
@@ -630,7 +630,7 @@ type SPD struct {
 	HL7                 HL7Name `hl7:",name=SPD,len=0,type=d"`
 	SpecialtyName       ST      `hl7:"1,display=Specialty Name"`
 	GoverningBoard      ST      `hl7:"2,display=Governing Board"`
-	EligibleOrCertified ID      `hl7:"3,table=0337,display=Eligible Or Certified"`
+	EligibleOrCertified ID      `hl7:"3,table=0337,datatype=ID,display=Eligible Or Certified"`
 	DateOfCertification DT      `hl7:"4,format=YMD,display=Date Of Certification"`
 }
 
@@ -686,7 +686,7 @@ type TQ struct {
 	Conjunction        ST      `hl7:"9,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter"`
 	OrderSequencing    *CM_OSD `hl7:"10,display=There are many situations- such as the creation of an order for a group of intravenous (IV) solutions- where the sequence of the individual intravenous solutions (each a service in itself) needs to be specified- e.g.- hyperalimentation with multi-vitamins in every third bottle."`
 	OccurrenceDuration *CE     `hl7:"11,display=This field contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat."`
-	TotalOccurences    NM      `hl7:"12,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
+	TotalOccurences    NM      `hl7:"12,datatype=NM,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -708,8 +708,8 @@ type TX = string
 // Value Code And Amount
 type UVC struct {
 	HL7         HL7Name `hl7:",name=UVC,len=0,type=d"`
-	ValueCode   IS      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueCode   IS      `hl7:"1,table=0153,datatype=IS,display=Value Code"`
+	ValueAmount NM      `hl7:"2,datatype=NM,display=Value Amount"`
 }
 
 // Variable Datatype
@@ -721,8 +721,8 @@ type VARIES any
 // of the week for valid values for the first two components.
 type VH struct {
 	HL7            HL7Name `hl7:",name=VH,len=0,type=d"`
-	StartDayRange  ID      `hl7:"1,table=0267,display=Starting day of visiting hours range. See HL7 table 0267 - Days of the week for valid values."`
-	EndDayRange    ID      `hl7:"2,table=0267,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 table 0267 - Days of the week for valid values"`
+	StartDayRange  ID      `hl7:"1,table=0267,datatype=ID,display=Starting day of visiting hours range. See HL7 table 0267 - Days of the week for valid values."`
+	EndDayRange    ID      `hl7:"2,table=0267,datatype=ID,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 table 0267 - Days of the week for valid values"`
 	StartHourRange TM      `hl7:"3,format=HM,display=Starting hour on starting day of visiting hours range (see first component- 2.8.46.0- Start day range)."`
 	EndHourRange   TM      `hl7:"4,format=HM,display=Ending hour on ending day of visiting hours range (see second component- 2.8.46.2- End day range)."`
 }
@@ -730,7 +730,7 @@ type VH struct {
 // Version Identifier
 type VID struct {
 	HL7                      HL7Name `hl7:",name=VID,len=0,type=d"`
-	VersionID                ID      `hl7:"1,table=0104,display=Used to identify the HL7 version. Refer to HL7 table 0104 Version ID for valid values."`
+	VersionID                ID      `hl7:"1,table=0104,datatype=ID,display=Used to identify the HL7 version. Refer to HL7 table 0104 Version ID for valid values."`
 	InternationalizationCode *CE     `hl7:"2,display=Used to identify the international affiliate country code. Refer to ISO 3166-1:1977 for the country code. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
 	InternationalVersionID   *CE     `hl7:"3,display=This field component identifies international affiliates version; it is especially important when the international affiliate has more than a single local version associated with a single US version."`
 }
@@ -753,12 +753,12 @@ type XAD struct {
 	City                       ST      `hl7:"3,table=City,display=City"`
 	StateOrProvince            ST      `hl7:"4,table=State,display=State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,table=ZipCode,display=Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A-9A9."`
-	Country                    ID      `hl7:"6,table=ISO3166,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
-	AddressType                ID      `hl7:"7,table=0190,display=Address type is optional and defined by HL7 table 0190 - Address type ."`
+	Country                    ID      `hl7:"6,table=ISO3166,datatype=ID,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Address type is optional and defined by HL7 table 0190 - Address type ."`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes county- bioregion- SMSA- etc."`
-	CountyParishCode           IS      `hl7:"9,table=0289,display=A code that represents the county in which the specified address resides. User-defined table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
-	CensusTract                IS      `hl7:"10,table=0288,display=A code that represents the census tract in which the specified address resides. User-defined table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
-	AddressRepresentationCode  ID      `hl7:"11,table=4000,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	CountyParishCode           IS      `hl7:"9,table=0289,datatype=IS,display=A code that represents the county in which the specified address resides. User-defined table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
+	CensusTract                IS      `hl7:"10,table=0288,datatype=IS,display=A code that represents the census tract in which the specified address resides. User-defined table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
+	AddressRepresentationCode  ID      `hl7:"11,table=4000,datatype=ID,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 }
 
 // Extended Composite ID Number And Name For Persons
@@ -773,15 +773,15 @@ type XCN struct {
 	MiddleInitialOrName                        ST      `hl7:"4,display=Middle Initial Or Name"`
 	Suffix                                     ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                     ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                     IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
-	SourceTable                                IS      `hl7:"8,table=0297,display=User-defined table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                     IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
+	SourceTable                                IS      `hl7:"8,table=0297,datatype=IS,display=User-defined table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                         *HD     `hl7:"9,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                               ID      `hl7:"10,table=0200,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.51- XPN - extended person name)."`
+	NameTypeCode                               ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values (see Section 2.8.51- XPN - extended person name)."`
 	IdentifierCheckDigit                       ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,display=Refer to HL7 table 0061 - Check digit scheme for valid values."`
-	IdentifierTypeCode                         IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to user-defined table 0203 - Identifier type for suggested values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 table 0061 - Check digit scheme for valid values."`
+	IdentifierTypeCode                         IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to user-defined table 0203 - Identifier type for suggested values."`
 	AssigningFacility                          *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode                     ID      `hl7:"15,table=4000,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode                     ID      `hl7:"15,table=4000,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 }
 
 // Extended Composite Name And Identification Number For Organizations
@@ -790,14 +790,14 @@ type XCN struct {
 type XON struct {
 	HL7                                        HL7Name `hl7:",name=XON,len=0,type=d"`
 	OrganizationName                           ST      `hl7:"1,display=The name of the specified organization."`
-	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to user-defined table 0204 - Organizational name type for suggested values."`
-	IDNumber                                   NM      `hl7:"3,display=Id Number"`
+	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,datatype=IS,display=A code that represents the type of name i.e.- legal name- display name. Refer to user-defined table 0204 - Organizational name type for suggested values."`
+	IDNumber                                   NM      `hl7:"3,datatype=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"4,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 table 0061 - Check digit scheme ."`
 	AssigningAuthority                         *HD     `hl7:"6,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined table 0363 Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component <namespace ID>."`
-	IdentifierTypeCode                         IS      `hl7:"7,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
+	IdentifierTypeCode                         IS      `hl7:"7,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to user-defined table 0203 - Identifier type for suggested values."`
 	AssigningFacilityID                        *HD     `hl7:"8,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode                     ID      `hl7:"9,table=4000,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode                     ID      `hl7:"9,table=4000,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 }
 
 // Extended Person Name
@@ -811,9 +811,9 @@ type XPN struct {
 	MiddleInitialOrName      ST      `hl7:"3,display=Middle Initial Or Name"`
 	Suffix                   ST      `hl7:"4,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                   ST      `hl7:"5,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                   IS      `hl7:"6,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
-	NameTypeCode             ID      `hl7:"7,table=0200,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values."`
-	NameRepresentationCode   ID      `hl7:"8,table=4000,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	Degree                   IS      `hl7:"6,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to user-defined table 0360 Degree for suggested values."`
+	NameTypeCode             ID      `hl7:"7,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 table 0200 - Name type for valid values."`
+	NameRepresentationCode   ID      `hl7:"8,table=4000,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 }
 
 // Extended Telecommunication Number
@@ -823,12 +823,12 @@ type XPN struct {
 type XTN struct {
 	HL7                            HL7Name `hl7:",name=XTN,len=0,type=d"`
 	TelephoneNumber                TN      `hl7:"1,table=PhoneNumber,display=Defined as the TN data type (see Section 2.8.42- TN - telephone number)- except that the length of the country access code has been increased to three."`
-	TelecommunicationUseCode       ID      `hl7:"2,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 table 0201 - Telecommunication use code for valid values."`
-	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 table 0202 - Telecommunication equipment type for valid values."`
+	TelecommunicationUseCode       ID      `hl7:"2,table=0201,datatype=ID,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 table 0201 - Telecommunication use code for valid values."`
+	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,datatype=ID,display=A code that represents the type of telecommunicationequipment. Refer to HL7 table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,display=Area/City Code"`
-	PhoneNumber                    NM      `hl7:"7,display=Phone Number"`
-	Extension                      NM      `hl7:"8,display=Extension"`
+	CountryCode                    NM      `hl7:"5,datatype=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,datatype=NM,display=Area/City Code"`
+	PhoneNumber                    NM      `hl7:"7,datatype=NM,display=Phone Number"`
+	Extension                      NM      `hl7:"8,datatype=NM,display=Extension"`
 	AnyText                        ST      `hl7:"9,display=Any Text"`
 }
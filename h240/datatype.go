@@ -17,8 +17,8 @@ type AD struct {
 	City                       ST      `hl7:"3,display=City"`
 	StateOrProvince            ST      `hl7:"4,display=State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,display=Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9."`
-	Country                    ID      `hl7:"6,table=0399,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used.[1] This ISO table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code. Refer to HL7 Table 0399 - Country code for valid values."`
-	AddressType                ID      `hl7:"7,table=0190,display=Type is optional and defined by HL7 Table 0190 - Address type ."`
+	Country                    ID      `hl7:"6,table=0399,datatype=ID,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used.[1] This ISO table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code. Refer to HL7 Table 0399 - Country code for valid values."`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Type is optional and defined by HL7 Table 0190 - Address type ."`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes county- bioregion- SMSA- etc."`
 }
 
@@ -33,7 +33,7 @@ type AUI struct {
 // Charge Time
 type CCD struct {
 	HL7              HL7Name `hl7:",name=CCD,len=0,type=d"`
-	WhenToChargeCode ID      `hl7:"1,table=0100,display=When To Charge Code"`
+	WhenToChargeCode ID      `hl7:"1,table=0100,datatype=ID,display=When To Charge Code"`
 	DateTime         TS      `hl7:"2,format=YMDHMS,display=Date/time"`
 }
 
@@ -47,10 +47,10 @@ type CE struct {
 	HL7                         HL7Name `hl7:",name=CE,len=250,type=d"`
 	Identifier                  ST      `hl7:"1,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the <text>. Different coding schemes will have different elements here."`
 	Text                        ST      `hl7:"2,display=Name or description of the item in question. E.g.- myocardial infarction or X-ray impression. Its data type is string (ST)."`
-	NameOfCodingSystem          IS      `hl7:"3,table=0396,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier.  Refer to User-defined table 0396 Coding Systems for valid values. When an HL7 table is used for a CE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. "`
+	NameOfCodingSystem          IS      `hl7:"3,table=0396,datatype=IS,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier.  Refer to User-defined table 0396 Coding Systems for valid values. When an HL7 table is used for a CE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. "`
 	AlternateIdentifier         ST      `hl7:"4,display=These three components are defined analogously to the above for the alternate or local coding system.  If the alternate text component is absent- and the alternate identifier is present- the alternate text will be taken to be the same as the text component.  If the alternate coding system component is absent- it will be taken to mean the locally-defined system."`
 	AlternateText               ST      `hl7:"5,display=These three components are defined analogously to the above for the alternate or local coding system.  If the alternate text component is absent- and the alternate identifier is present- the alternate text will be taken to be the same as the text component.  If the alternate coding system component is absent- it will be taken to mean the locally-defined system."`
-	NameOfAlternateCodingSystem IS      `hl7:"6,table=0396,display=These three components are defined analogously to the above for the alternate or local coding system.  If the alternate text component is absent- and the alternate identifier is present- the alternate text will be taken to be the same as the text component.  If the alternate coding system component is absent- it will be taken to mean the locally-defined system.  Refer to User-defined table 0396 Coding Systems for valid values. When an HL7 table is used for a CE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. "`
+	NameOfAlternateCodingSystem IS      `hl7:"6,table=0396,datatype=IS,display=These three components are defined analogously to the above for the alternate or local coding system.  If the alternate text component is absent- and the alternate identifier is present- the alternate text will be taken to be the same as the text component.  If the alternate coding system component is absent- it will be taken to mean the locally-defined system.  Refer to User-defined table 0396 Coding Systems for valid values. When an HL7 table is used for a CE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. "`
 }
 
 // Composite ID With Check Digit
@@ -62,9 +62,9 @@ type CE struct {
 // |128952^6^M11^ADT01|
 type CK struct {
 	HL7                                        HL7Name `hl7:",name=CK,len=250,type=d"`
-	IDNumber                                   NM      `hl7:"1,display=Id Number"`
+	IDNumber                                   NM      `hl7:"1,datatype=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"2,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. It is a HD data type. Assigning authorities are unique across a given HL7 implementation. User-defined Table 0363 - Assigning authorit y is used as the HL7 identifier for the user-defined table of values for the first sub-component- namespace ID."`
 }
 
@@ -73,10 +73,10 @@ type CNE struct {
 	HL7                            HL7Name `hl7:",name=CNE,len=250,type=d"`
 	Identifier                     ST      `hl7:"1,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the <text>. Different coding schemes will have different elements here."`
 	Text                           ST      `hl7:"2,display=Name or description of the item in question. E.g.- myocardial infarction or X-ray impression. Its data type is string (ST). This is the corresponding text assigned by the coding system to the identifier."`
-	NameOfCodingSystem             IS      `hl7:"3,table=0396,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
+	NameOfCodingSystem             IS      `hl7:"3,table=0396,datatype=IS,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
 	AlternateIdentifier            ST      `hl7:"4,display=Alternate Identifier"`
 	AlternateText                  ST      `hl7:"5,display=Alternate Text"`
-	NameOfAlternateCodingSystem    IS      `hl7:"6,table=0396,display=This is the version ID for the coding system identified by components 4-6. It belongs conceptually to the group of Alternate components (see note 2.9.3.6) and appears here only for reasons of backward compatibility."`
+	NameOfAlternateCodingSystem    IS      `hl7:"6,table=0396,datatype=IS,display=This is the version ID for the coding system identified by components 4-6. It belongs conceptually to the group of Alternate components (see note 2.9.3.6) and appears here only for reasons of backward compatibility."`
 	CodingSystemVersionID          ST      `hl7:"7,display=This is the version ID for the coding system identified by component 1-3. It belongs conceptually to components 1-3 and appears here only for reasons of backward compatibility."`
 	AlternateCodingSystemVersionID ST      `hl7:"8,display=This is the version ID for the coding system identified by components 4-6. It belongs conceptually to the group of Alternate components (see note 2.9.3.6) and appears here only for reasons of backward compatibility."`
 	OriginalText                   ST      `hl7:"9,display=The original text that was available to an automated process or a human before a specific code was assigned. This component is optional."`
@@ -91,11 +91,11 @@ type CNN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,display=Second And Further Given Names Or Initials Thereof"`
 	Suffix                                      ST      `hl7:"5,display=Suffix"`
 	Prefix                                      ST      `hl7:"6,display=Prefix"`
-	Degree                                      IS      `hl7:"7,table=0360,display=Degree"`
-	SourceTable                                 IS      `hl7:"8,table=0297,display=Source Table"`
-	AssigningAuthorityNamespaceID               IS      `hl7:"9,table=0363,display=Assigning Authority Namespace ID"`
+	Degree                                      IS      `hl7:"7,table=0360,datatype=IS,display=Degree"`
+	SourceTable                                 IS      `hl7:"8,table=0297,datatype=IS,display=Source Table"`
+	AssigningAuthorityNamespaceID               IS      `hl7:"9,table=0363,datatype=IS,display=Assigning Authority Namespace ID"`
 	AssigningAuthorityUniversalID               ST      `hl7:"10,display=Assigning Authority Universal ID"`
-	AssigningAuthorityUniversalIDType           ID      `hl7:"11,table=0301,display=Assigning Authority Universal ID Type"`
+	AssigningAuthorityUniversalIDType           ID      `hl7:"11,table=0301,datatype=ID,display=Assigning Authority Universal ID Type"`
 }
 
 // Composite Price
@@ -109,11 +109,11 @@ type CNN struct {
 type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      *MO     `hl7:"1,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.9.26- MO - money) is a subcomponent here."`
-	PriceType  ID      `hl7:"2,table=0205,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,display=See <from value> above."`
+	PriceType  ID      `hl7:"2,table=0205,datatype=ID,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
+	FromValue  NM      `hl7:"3,datatype=NM,display=Each is a NM data type; together they specify the range. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,datatype=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,display=A coded value- data type CE- defined by the standard table of units for either time or quantity  (see for example- the tables in Section 7.1.4- “Coding schemes”).  This describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count); it is required if <from value> and  <to value> are present.  Subcomponents of range units: <identifier (ST)> & <text (ST)> & <name of coding system (IS)> & <alternate identifier (ST)> & <alternate text (ST)> & <name of alternate coding system (IS)>"`
-	RangeType  ID      `hl7:"6,table=0298,display=Refers to HL7 Table 0298 - CP range type for valid values."`
+	RangeType  ID      `hl7:"6,table=0298,datatype=ID,display=Refers to HL7 Table 0298 - CP range type for valid values."`
 }
 
 // Composite Quantity With Units
@@ -126,7 +126,7 @@ type CP struct {
 // |150^lb&&ANSI+| weight in pounds is a customary US unit defined within ANSI+.
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=Quantity"`
+	Quantity NM      `hl7:"1,datatype=NM,display=Quantity"`
 	Units    ST      `hl7:"2,display=The units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the observation is measured in the default units- the units need not be transmitted. If the measure is recorded in units different from the default- the measurement units must be transmitted as the second component. If the units are ISO+ units- then units should be recorded as lowercase abbreviations as specified in Chapter 7. If the units are ANSI or local- the units and the source table must be recorded as specified in Chapter 7. But in these cases the component separator should be replaced by the subcomponent delimiter"`
 }
 
@@ -135,10 +135,10 @@ type CWE struct {
 	HL7                            HL7Name `hl7:",name=CWE,len=250,type=d"`
 	Identifier                     ST      `hl7:"1,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the <text>. Different coding schemes will have different elements here."`
 	Text                           ST      `hl7:"2,display=Name or description of the item in question. E.g.- myocardial infarction or X-ray impression."`
-	NameOfCodingSystem             IS      `hl7:"3,table=0396,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
+	NameOfCodingSystem             IS      `hl7:"3,table=0396,datatype=IS,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
 	AlternateIdentifier            ST      `hl7:"4,display=Alternate Identifier"`
 	AlternateText                  ST      `hl7:"5,display=Alternate Text"`
-	NameOfAlternateCodingSystem    IS      `hl7:"6,table=0396,display=Name Of Alternate Coding System"`
+	NameOfAlternateCodingSystem    IS      `hl7:"6,table=0396,datatype=IS,display=Name Of Alternate Coding System"`
 	CodingSystemVersionID          ST      `hl7:"7,display=This is the version ID for the coding system identified by components 1-3. It belongs conceptually to the group of component 1-3 and appears here only for reasons of backward compatibility."`
 	AlternateCodingSystemVersionID ST      `hl7:"8,display=This is the version ID for the coding system identified by components 4-6. It belongs conceptually to the group of alternate components (see note 0- Analogous to Text above. See 2.9.11.10- Usage notes: for further description."`
 	OriginalText                   ST      `hl7:"9,display=The original text that was available to an automated process or a human before a specific code was assigned"`
@@ -154,9 +154,9 @@ type CX struct {
 	HL7                                        HL7Name `hl7:",name=CX,len=250,type=d"`
 	ID                                         ST      `hl7:"1,display=Definition: The value of the identifier itself. It is similar to the CK data type (see Section 2.9.5- CK - composite ID with check digit) except that a ST data type is used instead of a NM data type."`
 	CheckDigit                                 ST      `hl7:"2,display=Defined as in the CK data type (see Section 2.9.5- CK - composite ID with check digit) except that an ST data type is allowed instead of an NM data type. The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,display=Defined as in the CK data type (see Section 2.9.5- CK - composite ID with check digit). Refer to HL7 Table 0061- Check digit scheme for valid values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"3,table=0061,datatype=ID,display=Defined as in the CK data type (see Section 2.9.5- CK - composite ID with check digit). Refer to HL7 Table 0061- Check digit scheme for valid values."`
 	AssigningAuthority                         *HD     `hl7:"4,display=The assigning authority is a unique name of the system (or organization or agency or department) that creates the data. It is a HD data type . User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	IdentifierTypeCode                         ID      `hl7:"5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer toHL7 Table 0203 - Identifier type for suggested values."`
+	IdentifierTypeCode                         ID      `hl7:"5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer toHL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility                          *HD     `hl7:"6,display=The place or location identifier where the identifier was first assigned to the patient.  This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 	EffectiveDate                              DT      `hl7:"7,format=YMD,display=The first date- if known- on which the identifier is valid and active."`
 	ExpirationDate                             DT      `hl7:"8,format=YMD,display=The last date- if known- on which the identifier is valid and active."`
@@ -165,9 +165,9 @@ type CX struct {
 // Daily Deductible
 type DDI struct {
 	HL7          HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays    NM      `hl7:"1,display=Delay Days"`
-	Amount       NM      `hl7:"2,display=Amount"`
-	NumberOfDays NM      `hl7:"3,display=Number Of Days"`
+	DelayDays    NM      `hl7:"1,datatype=NM,display=Delay Days"`
+	Amount       NM      `hl7:"2,datatype=NM,display=Amount"`
+	NumberOfDays NM      `hl7:"3,datatype=NM,display=Number Of Days"`
 }
 
 // Activation Date
@@ -180,7 +180,7 @@ type DIN struct {
 // Discharge Location
 type DLD struct {
 	HL7               HL7Name `hl7:",name=DLD,len=0,type=d"`
-	DischargeLocation ID      `hl7:"1,table=0113,display=Discharge Location"`
+	DischargeLocation ID      `hl7:"1,table=0113,datatype=ID,display=Discharge Location"`
 	EffectiveDate     TS      `hl7:"2,format=YMDHMS,display=Effective Date"`
 }
 
@@ -191,7 +191,7 @@ type DLD struct {
 type DLN struct {
 	HL7                         HL7Name `hl7:",name=DLN,len=0,type=d"`
 	DriversLicenseNumber        ST      `hl7:"1,display=This field contains the drivers license number."`
-	IssuingStateProvinceCountry IS      `hl7:"2,table=0333,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. (The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code .) User-defined Table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
+	IssuingStateProvinceCountry IS      `hl7:"2,table=0333,datatype=IS,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. (The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code .) User-defined Table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
 	ExpirationDate              DT      `hl7:"3,format=YMD,display=Expiration date (DT) for drivers license."`
 }
 
@@ -199,9 +199,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	Range             *NR     `hl7:"1,display=Range"`
-	NumericThreshold  NM      `hl7:"2,display=Numeric Threshold"`
+	NumericThreshold  NM      `hl7:"2,datatype=NM,display=Numeric Threshold"`
 	ChangeComputation ST      `hl7:"3,display=Change Computation"`
-	LengthOfTimeDays  NM      `hl7:"4,display=Length Of Time-days"`
+	LengthOfTimeDays  NM      `hl7:"4,datatype=NM,display=Length Of Time-days"`
 }
 
 // Date/time Range
@@ -228,8 +228,8 @@ type DT = time.Time
 // Day Type And Number
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
-	DayType      IS      `hl7:"1,table=0149,display=Day Type"`
-	NumberOfDays NM      `hl7:"2,display=Number Of Days"`
+	DayType      IS      `hl7:"1,table=0149,datatype=IS,display=Day Type"`
+	NumberOfDays NM      `hl7:"2,datatype=NM,display=Number Of Days"`
 }
 
 // Entity Identifier
@@ -238,9 +238,9 @@ type DTN struct {
 type EI struct {
 	HL7              HL7Name `hl7:",name=EI,len=0,type=d"`
 	EntityIdentifier ST      `hl7:"1,display=The first component- <entity identifier>- is usually defined to be unique within the series of identifiers created by the <assigning authority>- defined by a hierarchic designator- represented by components 2 through 4. (See Section 2.9.21- HD - hierarchic designator.)"`
-	NamespaceID      IS      `hl7:"2,table=0300,display=User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component"`
+	NamespaceID      IS      `hl7:"2,table=0300,datatype=IS,display=User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component"`
 	UniversalID      ST      `hl7:"3,display=<universal ID> (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type).  The UID is intended to be unique over time within the UID type.  It is rigorously defined.  Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type).  The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component).  Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType  ID      `hl7:"4,table=0301,display=This component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
+	UniversalIDType  ID      `hl7:"4,table=0301,datatype=ID,display=This component governs the interpretation of the second component of the HD.  If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type"`
 }
 
 // Parent Order
@@ -254,15 +254,15 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=0,type=d"`
 	SegmentID            ST      `hl7:"1,display=Segment ID"`
-	Sequence             NM      `hl7:"2,display=Sequence"`
-	FieldPosition        NM      `hl7:"3,display=Field Position"`
+	Sequence             NM      `hl7:"2,datatype=NM,display=Sequence"`
+	FieldPosition        NM      `hl7:"3,datatype=NM,display=Field Position"`
 	CodeIdentifyingError *CE     `hl7:"4,display=Code Identifying Error"`
 }
 
 // Financial Class
 type FC struct {
 	HL7            HL7Name `hl7:",name=FC,len=0,type=d"`
-	FinancialClass IS      `hl7:"1,table=0064,display=This component contains the financial class assigned to a person. User-defined Table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
+	FinancialClass IS      `hl7:"1,table=0064,datatype=IS,display=This component contains the financial class assigned to a person. User-defined Table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
 	EffectiveDate  TS      `hl7:"2,format=YMDHMS,display=This component contains the effective date/time of the persons assignment to the financial class specified in the first component."`
 }
 
@@ -290,9 +290,9 @@ type FT = string
 // is renamed more generically to be the namespace ID)
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=0,type=d"`
-	NamespaceID     IS      `hl7:"1,table=0300,display=User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
+	NamespaceID     IS      `hl7:"1,table=0300,datatype=IS,display=User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
 	UniversalID     ST      `hl7:"2,display=The HDs second component- <universal ID> (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type). The UID is intended to be unique over time within the UID type. It is rigorously defined. Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type). The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType ID      `hl7:"3,table=0301,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
+	UniversalIDType ID      `hl7:"3,table=0301,datatype=ID,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
 }
 
 // Coded values for HL7 tables
@@ -315,42 +315,42 @@ type IS = string
 // Job Code/class
 type JCC struct {
 	HL7      HL7Name `hl7:",name=JCC,len=0,type=d"`
-	JobCode  IS      `hl7:"1,table=0327,display=This component contains the persons job code. User-defined Table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
-	JobClass IS      `hl7:"2,table=0328,display=This component contains the persons employee classification . User-defined Table 0328 - Employee classificationis used as the HL7 identifier for the user-defined table of values for this component."`
+	JobCode  IS      `hl7:"1,table=0327,datatype=IS,display=This component contains the persons job code. User-defined Table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
+	JobClass IS      `hl7:"2,table=0328,datatype=IS,display=This component contains the persons employee classification . User-defined Table 0328 - Employee classificationis used as the HL7 identifier for the user-defined table of values for this component."`
 }
 
 // Location With Address Information (variant 1
 type LA1 struct {
 	HL7                HL7Name `hl7:",name=LA1,len=0,type=d"`
-	PointOfCare        IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"2,table=0303,display=Room"`
-	Bed                IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"4,display=Facility"`
-	LocationStatus     IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"7,table=0307,display=Building"`
-	Floor              IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus     IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor              IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	Address            *AD     `hl7:"9,display=Address"`
 }
 
 // Location With Address Information (variant 2
 type LA2 struct {
 	HL7                        HL7Name `hl7:",name=LA2,len=0,type=d"`
-	PointOfCare                IS      `hl7:"1,table=0302,display=Point Of Care"`
-	Room                       IS      `hl7:"2,table=0303,display=Room"`
-	Bed                        IS      `hl7:"3,table=0304,display=Bed"`
+	PointOfCare                IS      `hl7:"1,table=0302,datatype=IS,display=Point Of Care"`
+	Room                       IS      `hl7:"2,table=0303,datatype=IS,display=Room"`
+	Bed                        IS      `hl7:"3,table=0304,datatype=IS,display=Bed"`
 	Facility                   *HD     `hl7:"4,display=Facility"`
-	LocationStatus             IS      `hl7:"5,table=0306,display=Location Status"`
-	PersonLocationType         IS      `hl7:"6,table=0305,display=Person Location Type"`
-	Building                   IS      `hl7:"7,table=0307,display=Building"`
-	Floor                      IS      `hl7:"8,table=0308,display=Floor"`
+	LocationStatus             IS      `hl7:"5,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType         IS      `hl7:"6,table=0305,datatype=IS,display=Person Location Type"`
+	Building                   IS      `hl7:"7,table=0307,datatype=IS,display=Building"`
+	Floor                      IS      `hl7:"8,table=0308,datatype=IS,display=Floor"`
 	StreetAddress              ST      `hl7:"9,display=Street Address"`
 	OtherDesignation           ST      `hl7:"10,display=Other Designation"`
 	City                       ST      `hl7:"11,display=City"`
 	StateOrProvince            ST      `hl7:"12,display=State Or Province"`
 	ZipOrPostalCode            ST      `hl7:"13,display=Zip Or Postal Code"`
-	Country                    ID      `hl7:"14,table=0399,display=Country"`
-	AddressType                ID      `hl7:"15,table=0190,display=Address Type"`
+	Country                    ID      `hl7:"14,table=0399,datatype=ID,display=Country"`
+	AddressType                ID      `hl7:"15,table=0190,datatype=ID,display=Address Type"`
 	OtherGeographicDesignation ST      `hl7:"16,display=Other Geographic Designation"`
 }
 
@@ -359,8 +359,8 @@ type LA2 struct {
 // Note: Intent is that it appear only as a component of data type CP.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
-	Denomination ID      `hl7:"2,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default.   Example: |99.50^USD|  where USD is the ISO 4217 code for the U.S. American dollar."`
+	Quantity     NM      `hl7:"1,datatype=NM,display=The first component is a quantity."`
+	Denomination ID      `hl7:"2,table=ISO4217,datatype=ID,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default.   Example: |99.50^USD|  where USD is the ISO 4217 code for the U.S. American dollar."`
 }
 
 // Charge To Practise
@@ -373,16 +373,16 @@ type MOC struct {
 // Money Or Percentage
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=0,type=d"`
-	MoneyOrPercentageIndicator IS      `hl7:"1,table=0148,display=Money Or Percentage Indicator"`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,display=Money Or Percentage Quantity"`
+	MoneyOrPercentageIndicator IS      `hl7:"1,table=0148,datatype=IS,display=Money Or Percentage Indicator"`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,datatype=NM,display=Money Or Percentage Quantity"`
 }
 
 // Message Type
 type MSG struct {
 	HL7              HL7Name `hl7:",name=MSG,len=0,type=d"`
-	MessageType      ID      `hl7:"1,table=0076,display=Message Type"`
-	TriggerEvent     ID      `hl7:"2,table=0003,display=Trigger Event"`
-	MessageStructure ID      `hl7:"3,table=0354,display=Message Structure"`
+	MessageType      ID      `hl7:"1,table=0076,datatype=ID,display=Message Type"`
+	TriggerEvent     ID      `hl7:"2,table=0003,datatype=ID,display=Trigger Event"`
+	MessageStructure ID      `hl7:"3,table=0354,datatype=ID,display=Message Structure"`
 }
 
 func (d MSG) MessageStructureID() string {
@@ -407,10 +407,10 @@ func (d MSG) MessageStructureID() string {
 // is determined by the maximum field length
 type NA struct {
 	HL7    HL7Name `hl7:",name=NA,len=0,type=d"`
-	Value1 NM      `hl7:"1,display=Value1"`
-	Value2 NM      `hl7:"2,display=Value2"`
-	Value3 NM      `hl7:"3,display=Value3"`
-	Value4 NM      `hl7:"4,display=Value4"`
+	Value1 NM      `hl7:"1,datatype=NM,display=Value1"`
+	Value2 NM      `hl7:"2,datatype=NM,display=Value2"`
+	Value3 NM      `hl7:"3,datatype=NM,display=Value3"`
+	Value4 NM      `hl7:"4,datatype=NM,display=Value4"`
 }
 
 // Observing Practitioner
@@ -419,14 +419,14 @@ type NDL struct {
 	OPName             *CNN    `hl7:"1,display=OP Name"`
 	StartDateTime      TS      `hl7:"2,format=YMDHMS,display=Start Date/time"`
 	EndDateTime        TS      `hl7:"3,format=YMDHMS,display=End Date/time"`
-	PointOfCare        IS      `hl7:"4,table=0302,display=Point Of Care"`
-	Room               IS      `hl7:"5,table=0303,display=Room"`
-	Bed                IS      `hl7:"6,table=0304,display=Bed"`
+	PointOfCare        IS      `hl7:"4,table=0302,datatype=IS,display=Point Of Care"`
+	Room               IS      `hl7:"5,table=0303,datatype=IS,display=Room"`
+	Bed                IS      `hl7:"6,table=0304,datatype=IS,display=Bed"`
 	Facility           *HD     `hl7:"7,display=Facility"`
-	LocationStatus     IS      `hl7:"8,table=0306,display=Location Status"`
-	PersonLocationType IS      `hl7:"9,table=0305,display=Person Location Type"`
-	Building           IS      `hl7:"10,table=0307,display=Building"`
-	Floor              IS      `hl7:"11,table=0308,display=Floor"`
+	LocationStatus     IS      `hl7:"8,table=0306,datatype=IS,display=Location Status"`
+	PersonLocationType IS      `hl7:"9,table=0305,datatype=IS,display=Person Location Type"`
+	Building           IS      `hl7:"10,table=0307,datatype=IS,display=Building"`
+	Floor              IS      `hl7:"11,table=0308,datatype=IS,display=Floor"`
 }
 
 // Numeric
@@ -443,31 +443,31 @@ type NM = string
 // Wertebereich
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=Low Value"`
-	HighValue NM      `hl7:"2,display=High Value"`
+	LowValue  NM      `hl7:"1,datatype=NM,display=Low Value"`
+	HighValue NM      `hl7:"2,datatype=NM,display=High Value"`
 }
 
 // Occurence
 type OCD struct {
 	HL7            HL7Name `hl7:",name=OCD,len=0,type=d"`
-	OccurrenceCode IS      `hl7:"1,table=0350,display=Occurrence Code"`
+	OccurrenceCode IS      `hl7:"1,table=0350,datatype=IS,display=Occurrence Code"`
 	OccurrenceDate DT      `hl7:"2,format=YMD,display=Occurrence Date"`
 }
 
 // Order Sequence
 type OSD struct {
 	HL7                               HL7Name `hl7:",name=OSD,len=0,type=d"`
-	SequenceResultsFlag               ID      `hl7:"1,required,table=OSD1,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencing "`
+	SequenceResultsFlag               ID      `hl7:"1,required,table=OSD1,datatype=ID,display=S for sequence conditions; C for cyclical; R is reserved for possible future use. The C will be used for indicating a repeating cycle of orders; for example- individual intravenous solutions used in a cyclical sequence (a.k.a. “Alternating IVs”).  This value would be compatible with linking separate orders or with having all cyclical order components in a single order.  Likewise- the value would be compatible with either Parent-Child messages or a single order message to communicate the orders’ sequencing "`
 	PlacerOrderNumberEntityIdentifier ST      `hl7:"2,required,display=Placer Order Number: Entity Identifier"`
-	PlacerOrderNumberNamespaceID      IS      `hl7:"3,table=0363,display=Placer Order Number: Namespace ID"`
+	PlacerOrderNumberNamespaceID      IS      `hl7:"3,table=0363,datatype=IS,display=Placer Order Number: Namespace ID"`
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,display=Filler Order Number: Entity Identifier"`
-	FillerOrderNumberNamespaceID      IS      `hl7:"5,table=0363,display=Filler Order Number: Namespace ID"`
+	FillerOrderNumberNamespaceID      IS      `hl7:"5,table=0363,datatype=IS,display=Filler Order Number: Namespace ID"`
 	SequenceConditionValue            ST      `hl7:"6,required,display=Sequence Condition Value"`
-	MaximumNumberOfRepeats            NM      `hl7:"7,required,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,required,datatype=NM,display=The maximum number of repeats to be used only on cyclic groups.  The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,display=Placer Order Number: Universal ID"`
-	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,table=0301,display=Placer Order Number; Universal ID Type"`
+	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,table=0301,datatype=ID,display=Placer Order Number; Universal ID Type"`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,display=Filler Order Number: Universal ID"`
-	FillerOrderNumberUniversalIDType  ID      `hl7:"11,table=0301,display=Filler Order Number: Universal ID Type"`
+	FillerOrderNumberUniversalIDType  ID      `hl7:"11,table=0301,datatype=ID,display=Filler Order Number: Universal ID Type"`
 }
 
 // Occurence Span
@@ -481,8 +481,8 @@ type OSP struct {
 // Pre-certification Required
 type PCF struct {
 	HL7                         HL7Name `hl7:",name=PCF,len=0,type=d"`
-	PreCertificationPatientType IS      `hl7:"1,table=0150,display=pre-certification patient type refers to User-defined Table 0150 - Pre-certification patient type for suggested values "`
-	PreCertificationRequired    ID      `hl7:"2,table=0136,display=pre-certification required refers to HL7 table 0136 - Yes/no indicator for valid values "`
+	PreCertificationPatientType IS      `hl7:"1,table=0150,datatype=IS,display=pre-certification patient type refers to User-defined Table 0150 - Pre-certification patient type for suggested values "`
+	PreCertificationRequired    ID      `hl7:"2,table=0136,datatype=ID,display=pre-certification required refers to HL7 table 0136 - Yes/no indicator for valid values "`
 	PreCertificationWindow      TS      `hl7:"3,format=YMDHMS,display=pre-certification window is the amount of time required to attain certification from arrival at the institution. Its format follows the time stamp (TS) data type rules."`
 }
 
@@ -490,7 +490,7 @@ type PCF struct {
 type PI struct {
 	HL7                 HL7Name `hl7:",name=PI,len=0,type=d"`
 	IDNumber            ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber      IS      `hl7:"2,display=Type Of ID Number"`
+	TypeOfIDNumber      IS      `hl7:"2,datatype=IS,display=Type Of ID Number"`
 	OtherQualifyingInfo ST      `hl7:"3,display=Other Qualifying Info"`
 }
 
@@ -516,14 +516,14 @@ type PIP struct {
 // location status.
 type PL struct {
 	HL7                 HL7Name `hl7:",name=PL,len=0,type=d"`
-	PointOfCare         IS      `hl7:"1,table=0302,display=Conditional on person location type (e.g.- nursing unit or department or clinic). After floor- most general patient location designation. User-defined Table 0302 - Point of care is used as the HL7 identifier for the user-defined table of values for this component."`
-	Room                IS      `hl7:"2,table=0303,display=Patient room. After point of care- most general person location designation. User-defined Table 0303 - Room is used as the HL7 identifier for the user-defined table of values for this component."`
-	Bed                 IS      `hl7:"3,table=0304,display=Patient bed. After room- most general person location designation. User-defined Table 0304 - Bed is used as the HL7 identifier for the user-defined table of values for this component."`
+	PointOfCare         IS      `hl7:"1,table=0302,datatype=IS,display=Conditional on person location type (e.g.- nursing unit or department or clinic). After floor- most general patient location designation. User-defined Table 0302 - Point of care is used as the HL7 identifier for the user-defined table of values for this component."`
+	Room                IS      `hl7:"2,table=0303,datatype=IS,display=Patient room. After point of care- most general person location designation. User-defined Table 0303 - Room is used as the HL7 identifier for the user-defined table of values for this component."`
+	Bed                 IS      `hl7:"3,table=0304,datatype=IS,display=Patient bed. After room- most general person location designation. User-defined Table 0304 - Bed is used as the HL7 identifier for the user-defined table of values for this component."`
 	Facility            *HD     `hl7:"4,display=Subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. Most general person location designation."`
-	LocationStatus      IS      `hl7:"5,table=0306,display=Location (e.g.- Bed) status. User-defined Table 0306 - Location status is used as the HL7 identifier for the user-defined table of values for this component."`
-	PersonLocationType  IS      `hl7:"6,table=0305,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physicians office . User-defined Table 0305 - Person location type is used as the HL7 identifier for the user-defined table of values for this component."`
-	Building            IS      `hl7:"7,table=0307,display=After facility- most general person location designation. User-defined Table 0307 - Building is used as the HL7 identifier for the user-defined table of values for this component."`
-	Floor               IS      `hl7:"8,table=0308,display=After building- most general person location designation. User-defined Table 0308 - Floor is used as the HL7 identifier for the user-defined table of values for this component."`
+	LocationStatus      IS      `hl7:"5,table=0306,datatype=IS,display=Location (e.g.- Bed) status. User-defined Table 0306 - Location status is used as the HL7 identifier for the user-defined table of values for this component."`
+	PersonLocationType  IS      `hl7:"6,table=0305,datatype=IS,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physicians office . User-defined Table 0305 - Person location type is used as the HL7 identifier for the user-defined table of values for this component."`
+	Building            IS      `hl7:"7,table=0307,datatype=IS,display=After facility- most general person location designation. User-defined Table 0307 - Building is used as the HL7 identifier for the user-defined table of values for this component."`
+	Floor               IS      `hl7:"8,table=0308,datatype=IS,display=After building- most general person location designation. User-defined Table 0308 - Floor is used as the HL7 identifier for the user-defined table of values for this component."`
 	LocationDescription ST      `hl7:"9,display=A free text description of the location."`
 }
 
@@ -531,7 +531,7 @@ type PL struct {
 type PLN struct {
 	HL7                      HL7Name `hl7:",name=PLN,len=0,type=d"`
 	IDNumber                 ST      `hl7:"1,display=ID Number"`
-	TypeOfIDNumber           IS      `hl7:"2,table=0338,display=Type Of ID Number"`
+	TypeOfIDNumber           IS      `hl7:"2,table=0338,datatype=IS,display=Type Of ID Number"`
 	StateOtherQualifyingInfo ST      `hl7:"3,display=State/other Qualifying Info"`
 	ExpirationDate           DT      `hl7:"4,format=YMD,display=Expiration Date"`
 }
@@ -548,19 +548,19 @@ type PPN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
-	SourceTable                                 IS      `hl7:"8,table=0297,display=User-defined Table 0297 - CN IDsource is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                      IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
+	SourceTable                                 IS      `hl7:"8,table=0297,datatype=IS,display=User-defined Table 0297 - CN IDsource is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. It is a HD data type. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                                ID      `hl7:"10,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.9.55- XPN - extended person name)."`
+	NameTypeCode                                ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.9.55- XPN - extended person name)."`
 	IdentifierCheckDigit                        ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed  ID      `hl7:"12,table=0061,display=Refer to HL7 Table 0061 - Check digit scheme for valid values."`
-	IdentifierTypeCode                          IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier typefor suggested values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed  ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 Table 0061 - Check digit scheme for valid values."`
+	IdentifierTypeCode                          IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier typefor suggested values."`
 	AssigningFacility                           *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 	DateTimeActionPerformed                     TS      `hl7:"15,format=YMDHMS,display=This component describes when the activity was performed."`
-	NameRepresentationCode                      ID      `hl7:"16,table=0465,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component."`
+	NameRepresentationCode                      ID      `hl7:"16,table=0465,datatype=ID,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component."`
 	NameContext                                 *CE     `hl7:"17,display=Subcomponents of name context: <identifier (ST)> & <text (ST)> & <name of coding system (IS)> & <alternate identifier (ID)> & <alternate text (ST)> & <name of alternate coding system (IS)>"`
 	NameValidityRange                           *DR     `hl7:"18,display=This component contains the start and end date/times which define the period during which this name was valid. See section 2.9.14- DR - date/time range for description of subcomponents."`
-	NameAssemblyOrder                           ID      `hl7:"19,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"19,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly order for valid values."`
 }
 
 // Parent Result Link
@@ -576,16 +576,16 @@ type PRL struct {
 // This data type indicates whether to process a message as defined in HL7 Application (level 7) Processing rules.
 type PT struct {
 	HL7            HL7Name `hl7:",name=PT,len=0,type=d"`
-	ProcessingID   ID      `hl7:"1,table=0103,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
-	ProcessingMode ID      `hl7:"2,table=0207,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing mode for valid values."`
+	ProcessingID   ID      `hl7:"1,table=0103,datatype=ID,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
+	ProcessingMode ID      `hl7:"2,table=0207,datatype=ID,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing mode for valid values."`
 }
 
 // Policy Type
 type PTA struct {
 	HL7         HL7Name `hl7:",name=PTA,len=0,type=d"`
-	PolicyType  IS      `hl7:"1,table=0147,display=Policy Type"`
-	AmountClass IS      `hl7:"2,table=0193,display=Amount Class"`
-	Amount      NM      `hl7:"3,display=Amount"`
+	PolicyType  IS      `hl7:"1,table=0147,datatype=IS,display=Policy Type"`
+	AmountClass IS      `hl7:"2,table=0193,datatype=IS,display=Amount Class"`
+	Amount      NM      `hl7:"3,datatype=NM,display=Amount"`
 }
 
 // Query Input Parameter List
@@ -610,9 +610,9 @@ type QIP struct {
 type QSC struct {
 	HL7                   HL7Name `hl7:",name=QSC,len=0,type=d"`
 	SegmentFieldName      ST      `hl7:"1,display=The name of the field that is participating as a qualifier (usually the key). Refer to Section 2.9.33.1- Segment field name (ST)- for segment field name conventions."`
-	RelationalOperator    ID      `hl7:"2,table=0209,display=Refer to HL7 Table 0209 - Relational operator for valid values."`
+	RelationalOperator    ID      `hl7:"2,table=0209,datatype=ID,display=Refer to HL7 Table 0209 - Relational operator for valid values."`
 	Value                 ST      `hl7:"3,display=The value to which the field will be compared."`
-	RelationalConjunction ID      `hl7:"4,table=0210,display=Refer to HL7 Table 0210 - Relational conjunction for valid values. The relational conjunction is defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next."`
+	RelationalConjunction ID      `hl7:"4,table=0210,datatype=ID,display=Refer to HL7 Table 0210 - Relational conjunction for valid values. The relational conjunction is defined as follows: If more than one comparison is to be made to select qualifying rows- a conjunction relates this repetition of the field to the next."`
 }
 
 // Row Column Definition
@@ -628,14 +628,14 @@ type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,display=The HL7 segment field name- which identifies the field occupying the column. (Refer to Section 2.9.33.1- Segment field name (ST)- for segment field name definition conventions.)"`
 	Hl7DataType        ST      `hl7:"2,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 - Data Types for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
+	MaximumColumnWidth NM      `hl7:"3,datatype=NM,display=The maximum width of the column- as dictated by the responding system. (This may vary from the HL7-defined maximum field length.)"`
 }
 
 // Reference Range
 type RFR struct {
 	HL7               HL7Name `hl7:",name=RFR,len=0,type=d"`
 	NumericRange      *NR     `hl7:"1,display=Numeric Range"`
-	AdministrativeSex IS      `hl7:"2,table=0007,display=Administrative Sex"`
+	AdministrativeSex IS      `hl7:"2,table=0007,datatype=IS,display=Administrative Sex"`
 	AgeRange          *NR     `hl7:"3,display=Age Range"`
 	GestationalRange  *NR     `hl7:"4,display=Gestational Range"`
 	Species           TX      `hl7:"5,display=Species"`
@@ -650,16 +650,16 @@ type RFR struct {
 // Interval component of the Quantity/Timing field given in Chapter 4, Section 4.4.2 Interval component (CM).
 type RI struct {
 	HL7                  HL7Name `hl7:",name=RI,len=0,type=d"`
-	RepeatPattern        IS      `hl7:"1,table=0335,display=The repeating frequency with which the treatment is to be administered.  It is similar to the frequency and SIG code tables used in order entry systems. "`
+	RepeatPattern        IS      `hl7:"1,table=0335,datatype=IS,display=The repeating frequency with which the treatment is to be administered.  It is similar to the frequency and SIG code tables used in order entry systems. "`
 	ExplicitTimeInterval ST      `hl7:"2,display=This field explicitly lists the actual times referenced by the code in the first subcomponent- in the following format: HHMM-HHMM-HHMM-.…  This second subcomponent will be used to clarify the first subcomponent in cases where the actual administration times vary within an institution.  If the time of the order spans more than a single day- this new subcomponent is only practical if the same times of administration occur for each day of the order.  If the actual start time of the order (as given by the fourth subcomponent of the quantity/timing field) is after the first explicit time- the first administration is taken to be the first explicit time after the start time.  In the case where the patient moves to a location having a different set of explicit times- the existing order may be updated with a new quantity/timing field showing the changed explicit times."`
 }
 
 // Room Coverage
 type RMC struct {
 	HL7            HL7Name `hl7:",name=RMC,len=0,type=d"`
-	RoomType       IS      `hl7:"1,table=0145,display=Room Type"`
-	AmountType     IS      `hl7:"2,table=0146,display=Amount Type"`
-	CoverageAmount NM      `hl7:"3,display=Coverage Amount"`
+	RoomType       IS      `hl7:"1,table=0145,datatype=IS,display=Room Type"`
+	AmountType     IS      `hl7:"2,table=0146,datatype=IS,display=Amount Type"`
+	CoverageAmount NM      `hl7:"3,datatype=NM,display=Coverage Amount"`
 }
 
 // Street Address
@@ -678,7 +678,7 @@ type SAD struct {
 // an appropriate time slot, resource, location, or filler override criterion for an appointment.
 type SCV struct {
 	HL7            HL7Name `hl7:",name=SCV,len=0,type=d"`
-	ParameterClass IS      `hl7:"1,table=0294,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application. Refer to User-defined table 0294Time selection criteria parameter class codes for suggested values."`
+	ParameterClass IS      `hl7:"1,table=0294,datatype=IS,display=The first component of this field is a code identifying the parameter or preference being passed to the filler application. Refer to User-defined table 0294Time selection criteria parameter class codes for suggested values."`
 	ParameterValue ST      `hl7:"2,display=The second component is the actual data value for that parameter."`
 }
 
@@ -701,9 +701,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=0,type=d"`
 	Comparator      ST      `hl7:"1,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (: > or < or >: or <: or : or <>"`
-	Num1            NM      `hl7:"2,display=A number."`
+	Num1            NM      `hl7:"2,datatype=NM,display=A number."`
 	SeparatorSuffix ST      `hl7:"3,display=- or + or / or . or :"`
-	Num2            NM      `hl7:"4,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,datatype=NM,display=A number or null depending on the measurement."`
 }
 
 // Specialty
@@ -711,7 +711,7 @@ type SPD struct {
 	HL7                 HL7Name `hl7:",name=SPD,len=0,type=d"`
 	SpecialtyName       ST      `hl7:"1,display=Specialty Name"`
 	GoverningBoard      ST      `hl7:"2,display=Governing Board"`
-	EligibleOrCertified ID      `hl7:"3,table=0337,display=Eligible Or Certified"`
+	EligibleOrCertified ID      `hl7:"3,table=0337,datatype=ID,display=Eligible Or Certified"`
 	DateOfCertification DT      `hl7:"4,format=YMD,display=Date Of Certification"`
 }
 
@@ -733,7 +733,7 @@ type SPS struct {
 type SRT struct {
 	HL7         HL7Name `hl7:",name=SRT,len=0,type=d"`
 	SortByField ST      `hl7:"1,display=Identifies the field by which the response will be sorted. In a tabular response - this will be the column name to sort by. In the Segment Pattern and the Display Response- this will be the segment field name to sort by. (see QIP in Section 2.9.33.1- Segment field name (ST) for segment field name definition.)"`
-	Sequencing  ID      `hl7:"2,table=0397,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 - Sequencingfor valid values"`
+	Sequencing  ID      `hl7:"2,table=0397,datatype=ID,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 - Sequencingfor valid values"`
 }
 
 // String Data
@@ -785,10 +785,10 @@ type TQ struct {
 	Priority             ST      `hl7:"6,display= This field describes the urgency of the request."`
 	Condition            ST      `hl7:"7,display=This is a free text field that describes the conditions under which the drug is to be given.  For example- PRN pain- or to keep blood pressure below 110.  The presence of text in this field should be taken to mean that human review is needed to determine the how and/or when this drug should be given."`
 	Text                 TX      `hl7:"8,display=This field is a full text version of the instruction (optional)."`
-	ConjunctionComponent ID      `hl7:"9,table=0472,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter.  This field can take three values as shown in HL7 table 0472 - TQ Conjunction ID."`
+	ConjunctionComponent ID      `hl7:"9,table=0472,datatype=ID,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter.  This field can take three values as shown in HL7 table 0472 - TQ Conjunction ID."`
 	OrderSequencing      *OSD    `hl7:"10,display=There are many situations- such as the creation of an order for a group of intravenous (IV) solutions- where the sequence of the individual intravenous solutions (each a service in itself) needs to be specified- e.g.- hyperalimentation with multi-vitamins in every third bottle."`
 	OccurrenceDuration   *CE     `hl7:"11,display=This field contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat.  Note: The component delimiter in this CQ is demoted to a subcomponent delimiter."`
-	TotalOccurences      NM      `hl7:"12,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
+	TotalOccurences      NM      `hl7:"12,datatype=NM,display=This field contains the total number of occurrences of a service that should result from this order.  It is optional within TQ and does not repeat.  If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence.  Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -826,8 +826,8 @@ type TX = string
 // Value Code And Amount
 type UVC struct {
 	HL7         HL7Name `hl7:",name=UVC,len=0,type=d"`
-	ValueCode   IS      `hl7:"1,table=0153,display=Value Code"`
-	ValueAmount NM      `hl7:"2,display=Value Amount"`
+	ValueCode   IS      `hl7:"1,table=0153,datatype=IS,display=Value Code"`
+	ValueAmount NM      `hl7:"2,datatype=NM,display=Value Amount"`
 }
 
 // Variable Datatype
@@ -839,8 +839,8 @@ type VARIES any
 // valid values for the first two components.
 type VH struct {
 	HL7            HL7Name `hl7:",name=VH,len=0,type=d"`
-	StartDayRange  ID      `hl7:"1,table=0267,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values."`
-	EndDayRange    ID      `hl7:"2,table=0267,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values"`
+	StartDayRange  ID      `hl7:"1,table=0267,datatype=ID,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values."`
+	EndDayRange    ID      `hl7:"2,table=0267,datatype=ID,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values"`
 	StartHourRange TM      `hl7:"3,format=HM,display=Starting hour on starting day of visiting hours range (see first component- 2.9.49.1- Start day range (ID))."`
 	EndHourRange   TM      `hl7:"4,format=HM,display=Ending hour on ending day of visiting hours range (see second component- 2.9.49.2- End day range (ID))."`
 }
@@ -848,7 +848,7 @@ type VH struct {
 // Version Identifier
 type VID struct {
 	HL7                      HL7Name `hl7:",name=VID,len=0,type=d"`
-	VersionID                ID      `hl7:"1,table=0104,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID for valid values."`
+	VersionID                ID      `hl7:"1,table=0104,datatype=ID,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID for valid values."`
 	InternationalizationCode *CE     `hl7:"2,table=0399,display=Used to identify the international affiliate country code. The values to be used are those of ISO 3166 -1:1977. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
 	InternationalVersionID   *CE     `hl7:"3,display=This field component identifies international affiliates version; it is especially important when the international affiliate has more than a single local version associated with a single US version."`
 }
@@ -875,12 +875,12 @@ type XAD struct {
 	City                       ST      `hl7:"3,table=City,display=This may be the name of the city- or district or place depending upon the national convention for formatting addresses for postal usage."`
 	StateOrProvince            ST      `hl7:"4,table=State,display=State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,table=ZipCode,display=Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9- and the Australian Postcode takes the form 9999"`
-	Country                    ID      `hl7:"6,table=0399,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code . HL7 Table 0399 - Country code is defined to contain these 3-character codes."`
-	AddressType                ID      `hl7:"7,table=0190,display=Address type is optional and defined by HL7 Table 0190 - Address type."`
+	Country                    ID      `hl7:"6,table=0399,datatype=ID,display=Defines the country of the address. ISO 3166 provides a list of country codes that may be used. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code . HL7 Table 0399 - Country code is defined to contain these 3-character codes."`
+	AddressType                ID      `hl7:"7,table=0190,datatype=ID,display=Address type is optional and defined by HL7 Table 0190 - Address type."`
 	OtherGeographicDesignation ST      `hl7:"8,display=Other geographic designation includes county- bioregion- SMSA- etc."`
-	CountyParishCode           IS      `hl7:"9,table=0289,display=A code that represents the county in which the specified address resides . User-defined Table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
-	CensusTract                IS      `hl7:"10,table=0288,display=A code that represents the census tract in which the specified address resides . User-defined Table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
-	AddressRepresentationCode  ID      `hl7:"11,table=0465,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	CountyParishCode           IS      `hl7:"9,table=0289,datatype=IS,display=A code that represents the county in which the specified address resides . User-defined Table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
+	CensusTract                IS      `hl7:"10,table=0288,datatype=IS,display=A code that represents the census tract in which the specified address resides . User-defined Table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
+	AddressRepresentationCode  ID      `hl7:"11,table=0465,datatype=ID,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	AddressValidityRange       *DR     `hl7:"12,display=This component contains the start and end date/times which define the period in which this address was valid"`
 }
 
@@ -898,18 +898,18 @@ type XCN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
-	SourceTable                                 IS      `hl7:"8,table=0297,display=User-defined Table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                      IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
+	SourceTable                                 IS      `hl7:"8,table=0297,datatype=IS,display=User-defined Table 0297 - CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                                ID      `hl7:"10,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.9.54.7- Name type code (ID))."`
+	NameTypeCode                                ID      `hl7:"10,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.9.54.7- Name type code (ID))."`
 	IdentifierCheckDigit                        ST      `hl7:"11,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed  ID      `hl7:"12,table=0061,display=Refer to HL7 Table 0061 - Check digit scheme for valid values."`
-	IdentifierTypeCode                          IS      `hl7:"13,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed  ID      `hl7:"12,table=0061,datatype=ID,display=Refer to HL7 Table 0061 - Check digit scheme for valid values."`
+	IdentifierTypeCode                          IS      `hl7:"13,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility                           *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode                      ID      `hl7:"15,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode                      ID      `hl7:"15,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	NameContext                                 *CE     `hl7:"16,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare for indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to User-defined Table 0448 - Name context for suggested values."`
 	NameValidityRange                           *DR     `hl7:"17,display=This component contains the start and end date/times that define the period during which this name was valid. See section 2.9.14- DR - date/time range for description of subcomponents."`
-	NameAssemblyOrder                           ID      `hl7:"18,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly orde r for valid values"`
+	NameAssemblyOrder                           ID      `hl7:"18,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly orde r for valid values"`
 }
 
 // Extended Composite Name And Identification Number For Organizations
@@ -918,14 +918,14 @@ type XCN struct {
 type XON struct {
 	HL7                                        HL7Name `hl7:",name=XON,len=250,type=d"`
 	OrganizationName                           ST      `hl7:"1,display=The name of the specified organization."`
-	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational name type for suggested values."`
-	IDNumber                                   NM      `hl7:"3,display=Id Number"`
+	OrganizationNameTypeCode                   IS      `hl7:"2,table=0204,datatype=IS,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational name type for suggested values."`
+	IDNumber                                   NM      `hl7:"3,datatype=NM,display=Id Number"`
 	CheckDigit                                 ST      `hl7:"4,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
+	CodeIdentifyingTheCheckDigitSchemeEmployed ID      `hl7:"5,table=0061,datatype=ID,display=The check digit scheme codes are defined in HL7 Table 0061 - Check digit scheme."`
 	AssigningAuthority                         *HD     `hl7:"6,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component <namespace ID>."`
-	IdentifierTypeCode                         IS      `hl7:"7,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier typefor suggested values."`
+	IdentifierTypeCode                         IS      `hl7:"7,table=0203,datatype=IS,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier typefor suggested values."`
 	AssigningFacilityID                        *HD     `hl7:"8,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode                     ID      `hl7:"9,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode                     ID      `hl7:"9,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 }
 
 // Extended Person Name
@@ -941,12 +941,12 @@ type XPN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"3,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"4,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"5,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"6,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
-	NameTypeCode                                ID      `hl7:"7,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values."`
-	NameRepresentationCode                      ID      `hl7:"8,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	Degree                                      IS      `hl7:"6,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
+	NameTypeCode                                ID      `hl7:"7,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values."`
+	NameRepresentationCode                      ID      `hl7:"8,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	NameContext                                 *CE     `hl7:"9,table=0448,display=Subcomponents of name context: <identifier (ID)> & <text (ST)> & <name of coding system (IS)> & <alternate identifier (ID)> & <alternate text (ST)> & <name of alternate coding system (IS)>"`
 	NameValidityRange                           *DR     `hl7:"10,display=This component contains the start and end date/times which define the period during which this name was valid. See section 2.9.14- DR - date/time range for description of subcomponents."`
-	NameAssemblyOrder                           ID      `hl7:"11,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 - Name assembly order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"11,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 - Name assembly order for valid values."`
 }
 
 // Extended Telecommunication Number
@@ -958,12 +958,12 @@ type XPN struct {
 type XTN struct {
 	HL7                            HL7Name `hl7:",name=XTN,len=250,type=d"`
 	TelephoneNumber                TN      `hl7:"1,table=PhoneNumber,display=Defined as the TN data type (see Section 2.9.45- TN - telephone number)- except that the length of the country access code has been increased to three."`
-	TelecommunicationUseCode       ID      `hl7:"2,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
-	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
+	TelecommunicationUseCode       ID      `hl7:"2,table=0201,datatype=ID,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
+	TelecommunicationEquipmentType ID      `hl7:"3,table=0202,datatype=ID,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,display=Area/City Code"`
-	PhoneNumber                    NM      `hl7:"7,display=Phone Number"`
-	Extension                      NM      `hl7:"8,display=Extension"`
+	CountryCode                    NM      `hl7:"5,datatype=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,datatype=NM,display=Area/City Code"`
+	PhoneNumber                    NM      `hl7:"7,datatype=NM,display=Phone Number"`
+	Extension                      NM      `hl7:"8,datatype=NM,display=Extension"`
 	AnyText                        ST      `hl7:"9,display=Any Text"`
 }
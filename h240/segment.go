@@ -22,11 +22,11 @@ type ABS struct {
 	AbstractCompletionDateTime TS      `hl7:"7,len=26,format=YMDHMS,display=Abstract Completion Date/Time"`
 	AbstractedBy               *XCN    `hl7:"8,len=250,display=Abstracted By"`
 	CaseCategoryCode           *CE     `hl7:"9,len=250,table=0423,display=Case Category Code"`
-	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,display=Caesarian Section Indicator"`
+	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Caesarian Section Indicator"`
 	GestationCategoryCode      *CE     `hl7:"11,len=250,table=0424,display=Gestation Category Code"`
-	GestationPeriodWeeks       NM      `hl7:"12,len=3,display=Gestation Period - Weeks"`
+	GestationPeriodWeeks       NM      `hl7:"12,len=3,datatype=NM,display=Gestation Period - Weeks"`
 	NewbornCode                *CE     `hl7:"13,len=250,table=0425,display=Newborn Code"`
-	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,display=Stillborn Indicator"`
+	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Stillborn Indicator"`
 }
 
 // Accident
@@ -38,12 +38,12 @@ type ACC struct {
 	AccidentCode                *CE     `hl7:"2,len=250,table=0050,display=Accident Code"`
 	AccidentLocation            ST      `hl7:"3,len=25,display=Accident Location"`
 	AutoAccidentState           *CE     `hl7:"4,len=250,table=0347,display=Auto Accident State"`
-	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,display=Accident Job Related Indicator"`
-	AccidentDeathIndicator      ID      `hl7:"6,len=12,table=0136,display=Accident Death Indicator"`
+	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,datatype=ID,display=Accident Job Related Indicator"`
+	AccidentDeathIndicator      ID      `hl7:"6,len=12,table=0136,datatype=ID,display=Accident Death Indicator"`
 	EnteredBy                   *XCN    `hl7:"7,len=250,display=Entered By"`
 	AccidentDescription         ST      `hl7:"8,len=25,display=Accident Description"`
 	BroughtInBy                 ST      `hl7:"9,len=80,display=Brought In By"`
-	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,display=Police Notified Indicator"`
+	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Police Notified Indicator"`
 }
 
 // Addendum
@@ -61,7 +61,7 @@ type ADD struct {
 // by the STF segment is/was associated
 type AFF struct {
 	HL7                                          HL7Name `hl7:",name=AFF,type=s"`
-	SetID                                        SI      `hl7:"1,seq,required,len=60,display=Set ID - AFF"`
+	SetID                                        SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID - AFF"`
 	ProfessionalOrganization                     XON     `hl7:"2,required,len=250,display=Professional Organization"`
 	ProfessionalOrganizationAddress              *XAD    `hl7:"3,len=250,display=Professional Organization Address"`
 	ProfessionalOrganizationAffiliationDateRange []DR    `hl7:"4,len=52,display=Professional Organization Affiliation Date Range"`
@@ -77,19 +77,19 @@ type AFF struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIG"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIG"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceID               *CE     `hl7:"3,conditional,len=250,display=Resource ID"`
 	ResourceType             CE      `hl7:"4,required,len=250,display=Resource Type"`
 	ResourceGroup            []CE    `hl7:"5,len=250,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,len=5,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,len=5,datatype=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CE     `hl7:"7,len=250,display=Resource Quantity Units"`
 	StartDateTime            TS      `hl7:"8,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"10,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,len=20,display=Duration"`
+	Duration                 NM      `hl7:"11,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"12,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"14,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -102,17 +102,17 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIL"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIL"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	LocationResourceID       *PL     `hl7:"3,conditional,len=80,display=Location Resource ID"`
 	LocationType             CE      `hl7:"4,required,len=250,display=Location Type-AIL"`
 	LocationGroup            *CE     `hl7:"5,len=250,display=Location Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -125,17 +125,17 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIP"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIP"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	PersonnelResourceID      []XCN   `hl7:"3,conditional,len=250,display=Personnel Resource ID"`
 	ResourceRole             CE      `hl7:"4,required,len=250,display=Resource Role"`
 	ResourceGroup            *CE     `hl7:"5,len=250,display=Resource Group"`
 	StartDateTime            TS      `hl7:"6,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CE     `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CE     `hl7:"10,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CE     `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -146,15 +146,15 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                                  HL7Name `hl7:",name=AIS,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - AIS"`
-	SegmentActionCode                    ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIS"`
+	SegmentActionCode                    ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	UniversalServiceIdentifier           CE      `hl7:"3,required,len=250,display=Universal Service Identifier"`
 	StartDateTime                        TS      `hl7:"4,conditional,len=26,format=YMDHMS,display=Start Date/Time"`
-	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits             *CE     `hl7:"6,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                             NM      `hl7:"7,len=20,display=Duration"`
+	Duration                             NM      `hl7:"7,len=20,datatype=NM,display=Duration"`
 	DurationUnits                        *CE     `hl7:"8,len=250,display=Duration Units"`
-	AllowSubstitutionCode                IS      `hl7:"9,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode                IS      `hl7:"9,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode                     *CE     `hl7:"10,conditional,len=250,table=0278,display=Filler Status Code"`
 	PlacerSupplementalServiceInformation []CE    `hl7:"11,len=250,table=0411,display=Placer Supplemental Service Information"`
 	FillerSupplementalServiceInformation []CE    `hl7:"12,len=250,table=0411,display=Filler Supplemental Service Information"`
@@ -188,7 +188,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,len=80,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,len=80,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,len=80,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,len=5,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,len=5,datatype=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,len=80,display=Filler Override Criteria"`
 }
 
@@ -200,13 +200,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                  *CE     `hl7:"5,len=250,display=Schedule ID"`
 	RequestEventReason          *CE     `hl7:"6,len=250,display=Request Event Reason"`
 	AppointmentReason           *CE     `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType             *CE     `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CE     `hl7:"10,len=250,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,len=53,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,len=5,display=Priority-ARQ"`
@@ -238,8 +238,8 @@ type AUT struct {
 	AuthorizationExpirationDate  TS      `hl7:"5,len=26,format=YMDHMS,display=Authorization Expiration Date"`
 	AuthorizationIdentifier      *EI     `hl7:"6,conditional,len=30,display=Authorization Identifier"`
 	ReimbursementLimit           *CP     `hl7:"7,len=25,display=Reimbursement Limit"`
-	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,display=Requested Number of Treatments"`
-	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,display=Authorized Number of Treatments"`
+	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,datatype=NM,display=Requested Number of Treatments"`
+	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,datatype=NM,display=Authorized Number of Treatments"`
 	ProcessDate                  TS      `hl7:"10,len=26,format=YMDHMS,display=Process Date"`
 }
 
@@ -248,8 +248,8 @@ type AUT struct {
 // The BHS segment defines the start of a batch.
 type BHS struct {
 	HL7                       HL7Name `hl7:",name=BHS,type=s"`
-	BatchFieldSeparator       ST      `hl7:"1,required,len=1,display=Batch Field Separator"`
-	BatchEncodingCharacters   ST      `hl7:"2,required,len=3,display=Batch Encoding Characters"`
+	BatchFieldSeparator       ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=Batch Field Separator"`
+	BatchEncodingCharacters   ST      `hl7:"2,noescape,fieldchars,required,len=3,display=Batch Encoding Characters"`
 	BatchSendingApplication   ST      `hl7:"3,len=15,display=Batch Sending Application"`
 	BatchSendingFacility      ST      `hl7:"4,len=20,display=Batch Sending Facility"`
 	BatchReceivingApplication ST      `hl7:"5,len=15,display=Batch Receiving Application"`
@@ -278,7 +278,7 @@ type BLC struct {
 type BLG struct {
 	HL7          HL7Name `hl7:",name=BLG,type=s"`
 	WhenToCharge *CCD    `hl7:"1,len=40,display=When to Charge"`
-	ChargeType   ID      `hl7:"2,len=50,table=0122,display=Charge Type"`
+	ChargeType   ID      `hl7:"2,len=50,table=0122,datatype=ID,display=Charge Type"`
 	AccountID    *CX     `hl7:"3,len=100,display=Account ID"`
 }
 
@@ -289,7 +289,7 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,len=10,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,len=80,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,len=100,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,len=100,datatype=NM,display=Batch Totals"`
 }
 
 // Charge Description Master
@@ -310,15 +310,15 @@ type CDM struct {
 	ChargeCodeAlias              []CE    `hl7:"2,len=250,display=Charge Code Alias"`
 	ChargeDescriptionShort       ST      `hl7:"3,required,len=20,display=Charge Description Short"`
 	ChargeDescriptionLong        ST      `hl7:"4,len=250,display=Charge Description Long"`
-	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,display=Description Override Indicator"`
+	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,datatype=IS,display=Description Override Indicator"`
 	ExplodingCharges             []CE    `hl7:"6,len=250,display=Exploding Charges"`
 	ProcedureCode                []CE    `hl7:"7,len=250,table=0088,display=Procedure Code"`
-	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	InventoryNumber              []CE    `hl7:"9,len=250,table=0463,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,len=12,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,len=12,datatype=NM,display=Resource Load"`
 	ContractNumber               []CK    `hl7:"11,len=250,display=Contract Number"`
 	ContractOrganization         []XON   `hl7:"12,len=250,display=Contract Organization"`
-	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
+	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Room Fee Indicator"`
 }
 
 // Clinical Study Master
@@ -331,13 +331,13 @@ type CDM struct {
 // The Technical Steward for the CM0 segment is ORDERS.
 type CM0 struct {
 	HL7                     HL7Name `hl7:",name=CM0,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - CM0"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - CM0"`
 	SponsorStudyID          EI      `hl7:"2,required,len=60,display=Sponsor Study ID"`
 	AlternateStudyID        []EI    `hl7:"3,max=3,len=60,display=Alternate Study ID"`
 	TitleOfStudy            ST      `hl7:"4,required,len=300,display=Title of Study"`
 	ChairmanOfStudy         []XCN   `hl7:"5,len=250,display=Chairman of Study"`
 	LastIRBApprovalDate     DT      `hl7:"6,len=8,format=YMD,display=Last IRB Approval Date"`
-	TotalAccrualToDate      NM      `hl7:"7,len=8,display=Total Accrual to Date"`
+	TotalAccrualToDate      NM      `hl7:"7,len=8,datatype=NM,display=Total Accrual to Date"`
 	LastAccrualDate         DT      `hl7:"8,len=8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy         []XCN   `hl7:"9,len=250,display=Contact for Study"`
 	ContactsTelephoneNumber *XTN    `hl7:"10,len=250,display=Contact's Telephone Number"`
@@ -354,7 +354,7 @@ type CM0 struct {
 // The Technical Steward for the CM1 segment is ORDERS.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - CM1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - CM1"`
 	StudyPhaseIdentifier    CE      `hl7:"2,required,len=250,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,len=300,display=Description of Study Phase"`
 }
@@ -368,7 +368,7 @@ type CM1 struct {
 // The Technical Steward for the CM2 segment is ORDERS.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=Set ID- CM2"`
+	SetID                        SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID- CM2"`
 	ScheduledTimePoint           CE      `hl7:"2,required,len=250,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,len=300,display=Description of Time Point"`
 	EventsScheduledThisTimePoint []CE    `hl7:"4,required,max=200,len=250,display=Events Scheduled This Time Point"`
@@ -380,8 +380,8 @@ type CM2 struct {
 // notifications.
 type CNS struct {
 	HL7                                 HL7Name `hl7:",name=CNS,type=s"`
-	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,display=Starting Notification Reference Number"`
-	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,display=Ending Notification Reference Number"`
+	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,datatype=NM,display=Starting Notification Reference Number"`
+	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,datatype=NM,display=Ending Notification Reference Number"`
 	StartingNotificationDateTime        TS      `hl7:"3,len=26,format=YMDHMS,display=Starting Notification Date/Time"`
 	EndingNotificationDateTime          TS      `hl7:"4,len=26,format=YMDHMS,display=Ending Notification Date/Time"`
 	StartingNotificationCode            *CE     `hl7:"5,len=250,display=Starting Notification Code"`
@@ -484,10 +484,10 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - DB1"`
-	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,display=Disabled Person Code"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - DB1"`
+	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,datatype=IS,display=Disabled Person Code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,len=250,display=Disabled Person Identifier"`
-	DisabilityIndicator        ID      `hl7:"4,len=1,table=0136,display=Disability Indicator"`
+	DisabilityIndicator        ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Disability Indicator"`
 	DisabilityStartDate        DT      `hl7:"5,len=8,format=YMD,display=Disability Start Date"`
 	DisabilityEndDate          DT      `hl7:"6,len=8,format=YMD,display=Disability End Date"`
 	DisabilityReturnToWorkDate DT      `hl7:"7,len=8,format=YMD,display=Disability Return to Work Date"`
@@ -503,24 +503,24 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                     HL7Name `hl7:",name=DG1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - DG1"`
-	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,display=Diagnosis Coding Method"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - DG1"`
+	DiagnosisCodingMethod   ID      `hl7:"2,len=2,table=0053,datatype=ID,display=Diagnosis Coding Method"`
 	DiagnosisCode           *CE     `hl7:"3,len=250,table=0051,display=Diagnosis Code - DG1"`
 	DiagnosisDescription    ST      `hl7:"4,len=40,display=Diagnosis Description"`
 	DiagnosisDateTime       TS      `hl7:"5,len=26,format=YMDHMS,display=Diagnosis Date/Time"`
-	DiagnosisType           IS      `hl7:"6,required,len=2,table=0052,display=Diagnosis Type"`
+	DiagnosisType           IS      `hl7:"6,required,len=2,table=0052,datatype=IS,display=Diagnosis Type"`
 	MajorDiagnosticCategory CE      `hl7:"7,len=250,table=0118,display=Major Diagnostic Category"`
 	DiagnosticRelatedGroup  CE      `hl7:"8,len=250,table=0055,display=Diagnostic Related Group"`
-	DRGApprovalIndicator    ID      `hl7:"9,len=1,table=0136,display=DRG Approval Indicator"`
-	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,display=DRG Grouper Review Code"`
+	DRGApprovalIndicator    ID      `hl7:"9,len=1,table=0136,datatype=ID,display=DRG Approval Indicator"`
+	DRGGrouperReviewCode    IS      `hl7:"10,len=2,table=0056,datatype=IS,display=DRG Grouper Review Code"`
 	OutlierType             CE      `hl7:"11,len=250,table=0083,display=Outlier Type"`
-	OutlierDays             NM      `hl7:"12,len=3,display=Outlier Days"`
+	OutlierDays             NM      `hl7:"12,len=3,datatype=NM,display=Outlier Days"`
 	OutlierCost             CP      `hl7:"13,len=12,display=Outlier Cost"`
 	GrouperVersionAndType   ST      `hl7:"14,len=4,display=Grouper Version And Type"`
-	DiagnosisPriority       ID      `hl7:"15,len=2,table=0359,display=Diagnosis Priority"`
+	DiagnosisPriority       ID      `hl7:"15,len=2,table=0359,datatype=ID,display=Diagnosis Priority"`
 	DiagnosingClinician     []XCN   `hl7:"16,len=250,display=Diagnosing Clinician"`
-	DiagnosisClassification IS      `hl7:"17,len=3,table=0228,display=Diagnosis Classification"`
-	ConfidentialIndicator   ID      `hl7:"18,len=1,table=0136,display=Confidential Indicator"`
+	DiagnosisClassification IS      `hl7:"17,len=3,table=0228,datatype=IS,display=Diagnosis Classification"`
+	ConfidentialIndicator   ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 	AttestationDateTime     TS      `hl7:"19,len=26,format=YMDHMS,display=Attestation Date/Time"`
 }
 
@@ -532,15 +532,15 @@ type DRG struct {
 	HL7                    HL7Name `hl7:",name=DRG,type=s"`
 	DiagnosticRelatedGroup *CE     `hl7:"1,len=250,table=0055,display=Diagnostic Related Group"`
 	AssignedDateTime       TS      `hl7:"2,len=26,format=YMDHMS,display=DRG Assigned Date/Time"`
-	ApprovalIndicator      ID      `hl7:"3,len=1,table=0136,display=DRG Approval Indicator"`
-	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,display=DRG Grouper Review Code"`
+	ApprovalIndicator      ID      `hl7:"3,len=1,table=0136,datatype=ID,display=DRG Approval Indicator"`
+	GrouperReviewCode      IS      `hl7:"4,len=2,table=0056,datatype=IS,display=DRG Grouper Review Code"`
 	OutlierType            *CE     `hl7:"5,len=250,table=0083,display=Outlier Type"`
-	OutlierDays            NM      `hl7:"6,len=3,display=Outlier Days"`
+	OutlierDays            NM      `hl7:"6,len=3,datatype=NM,display=Outlier Days"`
 	OutlierCost            *CP     `hl7:"7,len=12,display=Outlier Cost"`
-	Payor                  IS      `hl7:"8,len=1,table=0229,display=DRG Payor"`
+	Payor                  IS      `hl7:"8,len=1,table=0229,datatype=IS,display=DRG Payor"`
 	OutlierReimbursement   *CP     `hl7:"9,len=9,display=Outlier Reimbursement"`
-	ConfidentialIndicator  ID      `hl7:"10,len=1,table=0136,display=Confidential Indicator"`
-	TransferType           IS      `hl7:"11,len=21,table=0415,display=DRG Transfer Type"`
+	ConfidentialIndicator  ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
+	TransferType           IS      `hl7:"11,len=21,table=0415,datatype=IS,display=DRG Transfer Type"`
 }
 
 // Continuation Pointer
@@ -549,7 +549,7 @@ type DRG struct {
 type DSC struct {
 	HL7                 HL7Name `hl7:",name=DSC,type=s"`
 	ContinuationPointer ST      `hl7:"1,len=180,display=Continuation Pointer"`
-	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,display=Continuation Style"`
+	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,datatype=ID,display=Continuation Style"`
 }
 
 // Display Data
@@ -558,8 +558,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetID             SI      `hl7:"1,seq,len=4,display=Set ID - DSP"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetID             SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - DSP"`
+	DisplayLevel      SI      `hl7:"2,len=4,datatype=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result ID"`
@@ -573,9 +573,9 @@ type DSP struct {
 // such as centrifuges, aliquoters, sorters, uncappers, recappers, automated storage units, etc.
 type ECD struct {
 	HL7                     HL7Name `hl7:",name=ECD,type=s"`
-	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,display=Reference Command Number"`
+	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,datatype=NM,display=Reference Command Number"`
 	RemoteControlCommand    CE      `hl7:"2,required,len=250,table=0368,display=Remote Control Command"`
-	ResponseRequired        ID      `hl7:"3,len=80,table=0136,display=Response Required"`
+	ResponseRequired        ID      `hl7:"3,len=80,table=0136,datatype=ID,display=Response Required"`
 	RequestedCompletionTime *TQ     `hl7:"4,len=200,display=Requested Completion Time"`
 	Parameters              []ST    `hl7:"5,len=65536,display=Parameters"`
 }
@@ -596,8 +596,8 @@ type ECR struct {
 // may optionally follow an STF segment.  An EDU segment must always have been preceded by a corresponding STF segment
 type EDU struct {
 	HL7                                         HL7Name `hl7:",name=EDU,type=s"`
-	SetID                                       SI      `hl7:"1,seq,required,len=60,display=Set ID - EDU"`
-	AcademicDegree                              IS      `hl7:"2,len=10,table=0360,display=Academic Degree"`
+	SetID                                       SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID - EDU"`
+	AcademicDegree                              IS      `hl7:"2,len=10,table=0360,datatype=IS,display=Academic Degree"`
 	AcademicDegreeProgramDateRange              *DR     `hl7:"3,len=52,display=Academic Degree Program Date Range"`
 	AcademicDegreeProgramParticipationDateRange *DR     `hl7:"4,len=52,display=Academic Degree Program Participation Date Range"`
 	AcademicDegreeGrantedDate                   DT      `hl7:"5,len=8,format=YMD,display=Academic Degree Granted Date"`
@@ -613,7 +613,7 @@ type EDU struct {
 type EQL struct {
 	HL7                     HL7Name `hl7:",name=EQL,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	QueryName               CE      `hl7:"3,required,len=250,display=EQL Query Name"`
 	QueryStatement          ST      `hl7:"4,required,len=4096,display=EQL Query Statement"`
 }
@@ -670,10 +670,10 @@ type ERR struct {
 // for all chapters are contained in HL7 Table 0003 - Event type.
 type EVN struct {
 	HL7                  HL7Name `hl7:",name=EVN,type=s"`
-	EventTypeCode        ID      `hl7:"1,len=3,table=0003,display=Event Type Code"`
+	EventTypeCode        ID      `hl7:"1,len=3,table=0003,datatype=ID,display=Event Type Code"`
 	RecordedDateTime     TS      `hl7:"2,required,len=26,format=YMDHMS,display=Recorded Date/Time"`
 	DateTimePlannedEvent TS      `hl7:"3,len=26,format=YMDHMS,display=Date/Time Planned Event"`
-	EventReasonCode      IS      `hl7:"4,len=3,table=0062,display=Event Reason Code"`
+	EventReasonCode      IS      `hl7:"4,len=3,table=0062,datatype=IS,display=Event Reason Code"`
 	OperatorID           []XCN   `hl7:"5,len=250,table=0188,display=Operator ID"`
 	EventOccurred        TS      `hl7:"6,len=26,format=YMDHMS,display=Event Occurred"`
 	EventFacility        *HD     `hl7:"7,len=180,display=Event Facility"`
@@ -683,7 +683,7 @@ type EVN struct {
 type FAC struct {
 	HL7                                 HL7Name `hl7:",name=FAC,type=s"`
 	FacilityID                          EI      `hl7:"1,required,len=20,display=Facility ID-FAC"`
-	FacilityType                        ID      `hl7:"2,len=1,table=0331,display=Facility Type"`
+	FacilityType                        ID      `hl7:"2,len=1,table=0331,datatype=ID,display=Facility Type"`
 	FacilityAddress                     []XAD   `hl7:"3,required,len=250,display=Facility Address"`
 	FacilityTelecommunication           XTN     `hl7:"4,required,len=250,display=Facility Telecommunication"`
 	ContactPerson                       []XCN   `hl7:"5,len=250,display=Contact Person"`
@@ -701,8 +701,8 @@ type FAC struct {
 // The FHS segment is used to head a file (group of batches) as defined in Section 2.15.3, “HL7 batch protocol.”
 type FHS struct {
 	HL7                      HL7Name `hl7:",name=FHS,type=s"`
-	FileFieldSeparator       ST      `hl7:"1,required,len=1,display=File Field Separator"`
-	FileEncodingCharacters   ST      `hl7:"2,required,len=4,display=File Encoding Characters"`
+	FileFieldSeparator       ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=File Field Separator"`
+	FileEncodingCharacters   ST      `hl7:"2,noescape,fieldchars,required,len=4,display=File Encoding Characters"`
 	FileSendingApplication   ST      `hl7:"3,len=15,display=File Sending Application"`
 	FileSendingFacility      ST      `hl7:"4,len=20,display=File Sending Facility"`
 	FileReceivingApplication ST      `hl7:"5,len=15,display=File Receiving Application"`
@@ -721,24 +721,24 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                       HL7Name `hl7:",name=FT1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - FT1"`
+	SetID                     SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - FT1"`
 	TransactionID             ST      `hl7:"2,len=12,display=Transaction ID"`
 	TransactionBatchID        ST      `hl7:"3,len=10,display=Transaction Batch ID"`
 	TransactionDate           TS      `hl7:"4,required,len=26,format=YMDHMS,display=Transaction Date"`
 	TransactionPostingDate    TS      `hl7:"5,len=26,format=YMDHMS,display=Transaction Posting Date"`
-	TransactionType           IS      `hl7:"6,required,len=8,table=0017,display=Transaction Type"`
+	TransactionType           IS      `hl7:"6,required,len=8,table=0017,datatype=IS,display=Transaction Type"`
 	TransactionCode           CE      `hl7:"7,required,len=250,table=0132,display=Transaction Code"`
 	TransactionDescription    ST      `hl7:"8,len=40,display=Transaction Description"`
 	TransactionDescriptionAlt ST      `hl7:"9,len=40,display=Transaction Description - Alt"`
-	TransactionQuantity       NM      `hl7:"10,len=6,display=Transaction Quantity"`
+	TransactionQuantity       NM      `hl7:"10,len=6,datatype=NM,display=Transaction Quantity"`
 	TransactionAmountExtended *CP     `hl7:"11,len=12,display=Transaction Amount - Extended"`
 	TransactionAmountUnit     *CP     `hl7:"12,len=12,display=Transaction Amount - Unit"`
 	DepartmentCode            *CE     `hl7:"13,len=250,table=0049,display=Department Code"`
 	InsurancePlanID           *CE     `hl7:"14,len=250,table=0072,display=Insurance Plan ID"`
 	InsuranceAmount           *CP     `hl7:"15,len=12,display=Insurance Amount"`
 	AssignedPatientLocation   *PL     `hl7:"16,len=80,display=Assigned Patient Location"`
-	FeeSchedule               IS      `hl7:"17,len=1,table=0024,display=Fee Schedule"`
-	PatientType               IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	FeeSchedule               IS      `hl7:"17,len=1,table=0024,datatype=IS,display=Fee Schedule"`
+	PatientType               IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	DiagnosisCode             []CE    `hl7:"19,len=250,table=0051,display=Diagnosis Code - FT1"`
 	PerformedByCode           []XCN   `hl7:"20,len=250,table=0084,display=Performed By Code"`
 	OrderedByCode             []XCN   `hl7:"21,len=250,display=Ordered By Code"`
@@ -754,7 +754,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,datatype=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -763,12 +763,12 @@ type FTS struct {
 // The goal detail segment contains the data necessary to add, update, correct, and delete the goals for an individual.
 type GOL struct {
 	HL7                         HL7Name `hl7:",name=GOL,type=s"`
-	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime              TS      `hl7:"2,required,len=26,format=YMDHMS,display=Action Date/Time"`
 	GoalID                      CE      `hl7:"3,required,len=250,display=Goal ID"`
 	GoalInstanceID              EI      `hl7:"4,required,len=60,display=Goal Instance ID"`
 	EpisodeOfCareID             *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	GoalListPriority            NM      `hl7:"6,len=60,display=Goal List Priority"`
+	GoalListPriority            NM      `hl7:"6,len=60,datatype=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime     TS      `hl7:"7,len=26,format=YMDHMS,display=Goal Established Date/Time"`
 	ExpectedGoalAchieveDateTime TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Goal Achieve Date/Time"`
 	GoalClassification          *CE     `hl7:"9,len=250,display=Goal Classification"`
@@ -794,10 +794,10 @@ type GOL struct {
 // The GP1 segment is specific to the US and may not be implemented in non-US systems.
 type GP1 struct {
 	HL7                         HL7Name `hl7:",name=GP1,type=s"`
-	TypeOfBillCode              IS      `hl7:"1,required,len=3,table=0455,display=Type of Bill Code"`
-	RevenueCode                 []IS    `hl7:"2,len=3,table=0456,display=Revenue Code"`
-	OverallClaimDispositionCode IS      `hl7:"3,len=1,table=0457,display=Overall Claim Disposition Code"`
-	OCEEditsPerVisitCode        []IS    `hl7:"4,len=2,table=0458,display=OCE Edits per Visit Code"`
+	TypeOfBillCode              IS      `hl7:"1,required,len=3,table=0455,datatype=IS,display=Type of Bill Code"`
+	RevenueCode                 []IS    `hl7:"2,len=3,table=0456,datatype=IS,display=Revenue Code"`
+	OverallClaimDispositionCode IS      `hl7:"3,len=1,table=0457,datatype=IS,display=Overall Claim Disposition Code"`
+	OCEEditsPerVisitCode        []IS    `hl7:"4,len=2,table=0458,datatype=IS,display=OCE Edits per Visit Code"`
 	OutlierCost                 *CP     `hl7:"5,len=12,display=Outlier Cost"`
 }
 
@@ -808,20 +808,20 @@ type GP1 struct {
 // The GP2 segment is specific to the US and may not be implemented in non-US systems.
 type GP2 struct {
 	HL7                                 HL7Name `hl7:",name=GP2,type=s"`
-	RevenueCode                         IS      `hl7:"1,len=3,table=0456,display=Revenue Code"`
-	NumberOfServiceUnits                NM      `hl7:"2,len=7,display=Number of Service Units"`
+	RevenueCode                         IS      `hl7:"1,len=3,table=0456,datatype=IS,display=Revenue Code"`
+	NumberOfServiceUnits                NM      `hl7:"2,len=7,datatype=NM,display=Number of Service Units"`
 	Charge                              *CP     `hl7:"3,len=12,display=Charge"`
-	ReimbursementActionCode             IS      `hl7:"4,len=1,table=0459,display=Reimbursement Action Code"`
-	DenialOrRejectionCode               IS      `hl7:"5,len=1,table=0460,display=Denial or Rejection Code"`
-	OCEEditCode                         []IS    `hl7:"6,len=3,table=0458,display=OCE Edit Code"`
+	ReimbursementActionCode             IS      `hl7:"4,len=1,table=0459,datatype=IS,display=Reimbursement Action Code"`
+	DenialOrRejectionCode               IS      `hl7:"5,len=1,table=0460,datatype=IS,display=Denial or Rejection Code"`
+	OCEEditCode                         []IS    `hl7:"6,len=3,table=0458,datatype=IS,display=OCE Edit Code"`
 	AmbulatoryPaymentClassificationCode *CE     `hl7:"7,len=250,table=0466,display=Ambulatory Payment Classification Code"`
-	ModifierEditCode                    []IS    `hl7:"8,len=1,table=0467,display=Modifier Edit Code"`
-	PaymentAdjustmentCode               IS      `hl7:"9,len=1,table=0468,display=Payment Adjustment Code"`
-	PackagingStatusCode                 IS      `hl7:"10,len=1,table=0469,display=Packaging Status Code"`
+	ModifierEditCode                    []IS    `hl7:"8,len=1,table=0467,datatype=IS,display=Modifier Edit Code"`
+	PaymentAdjustmentCode               IS      `hl7:"9,len=1,table=0468,datatype=IS,display=Payment Adjustment Code"`
+	PackagingStatusCode                 IS      `hl7:"10,len=1,table=0469,datatype=IS,display=Packaging Status Code"`
 	ExpectedHCFAPaymentAmount           *CP     `hl7:"11,len=12,display=Expected HCFA Payment Amount"`
-	ReimbursementTypeCode               IS      `hl7:"12,len=2,table=0470,display=Reimbursement Type Code"`
+	ReimbursementTypeCode               IS      `hl7:"12,len=2,table=0470,datatype=IS,display=Reimbursement Type Code"`
 	CoPayAmount                         *CP     `hl7:"13,len=12,display=Co-Pay Amount"`
-	PayRatePerUnit                      NM      `hl7:"14,len=4,display=Pay Rate per Unit"`
+	PayRatePerUnit                      NM      `hl7:"14,len=4,datatype=NM,display=Pay Rate per Unit"`
 }
 
 // Guarantor
@@ -830,7 +830,7 @@ type GP2 struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - GT1"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - GT1"`
 	GuarantorNumber                    []CX    `hl7:"2,len=250,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,len=250,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,len=250,display=Guarantor Spouse Name"`
@@ -838,38 +838,38 @@ type GT1 struct {
 	GuarantorPhNumHome                 []XTN   `hl7:"6,len=250,display=Guarantor Ph Num - Home"`
 	GuarantorPhNumBusiness             []XTN   `hl7:"7,len=250,display=Guarantor Ph Num - Business"`
 	GuarantorDateTimeOfBirth           TS      `hl7:"8,len=26,format=YMDHMS,display=Guarantor Date/Time Of Birth"`
-	GuarantorAdministrativeSex         IS      `hl7:"9,len=1,table=0001,display=Guarantor Administrative Sex"`
-	GuarantorType                      IS      `hl7:"10,len=2,table=0068,display=Guarantor Type"`
+	GuarantorAdministrativeSex         IS      `hl7:"9,len=1,table=0001,datatype=IS,display=Guarantor Administrative Sex"`
+	GuarantorType                      IS      `hl7:"10,len=2,table=0068,datatype=IS,display=Guarantor Type"`
 	GuarantorRelationship              *CE     `hl7:"11,len=250,table=0063,display=Guarantor Relationship"`
 	GuarantorSSN                       ST      `hl7:"12,len=11,display=Guarantor SSN"`
 	GuarantorDateBegin                 DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,datatype=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,len=250,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,len=250,display=Guarantor Employer Address"`
 	GuarantorEmployerPhoneNumber       []XTN   `hl7:"18,len=250,display=Guarantor Employer Phone Number"`
 	GuarantorEmployeeIDNumber          []CX    `hl7:"19,len=250,display=Guarantor Employee ID Number"`
-	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,display=Guarantor Employment Status"`
+	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,datatype=IS,display=Guarantor Employment Status"`
 	GuarantorOrganizationName          []XON   `hl7:"21,len=250,display=Guarantor Organization Name"`
-	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,display=Guarantor Billing Hold Flag"`
+	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Guarantor Billing Hold Flag"`
 	GuarantorCreditRatingCode          *CE     `hl7:"23,len=250,table=0341,display=Guarantor Credit Rating Code"`
 	GuarantorDeathDateAndTime          TS      `hl7:"24,len=26,format=YMDHMS,display=Guarantor Death Date And Time"`
-	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
+	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CE     `hl7:"26,len=250,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,len=10,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,len=3,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,len=3,datatype=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,len=250,display=Guarantor Employer ID Number"`
 	GuarantorMaritalStatusCode         *CE     `hl7:"30,len=250,table=0002,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,len=8,format=YMD,display=Guarantor Hire Effective Date"`
 	EmploymentStopDate                 DT      `hl7:"32,len=8,format=YMD,display=Employment Stop Date"`
-	LivingDependency                   IS      `hl7:"33,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                   []IS    `hl7:"34,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                   IS      `hl7:"33,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                   []IS    `hl7:"34,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                        []CE    `hl7:"35,len=250,table=0171,display=Citizenship"`
 	PrimaryLanguage                    *CE     `hl7:"36,len=250,table=0296,display=Primary Language"`
-	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                      *CE     `hl7:"38,len=250,table=0215,display=Publicity Code"`
-	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                           *CE     `hl7:"41,len=250,table=0006,display=Religion"`
 	MothersMaidenName                  []XPN   `hl7:"42,len=250,display=Mother's Maiden Name"`
 	Nationality                        *CE     `hl7:"43,len=250,table=0212,display=Nationality"`
@@ -877,12 +877,12 @@ type GT1 struct {
 	ContactPersonsName                 []XPN   `hl7:"45,len=250,display=Contact Person's Name"`
 	ContactPersonsTelephoneNumber      []XTN   `hl7:"46,len=250,display=Contact Person's Telephone Number"`
 	ContactReason                      *CE     `hl7:"47,len=250,table=0222,display=Contact Reason"`
-	ContactRelationship                IS      `hl7:"48,len=2,table=0063,display=Contact Relationship"`
+	ContactRelationship                IS      `hl7:"48,len=2,table=0063,datatype=IS,display=Contact Relationship"`
 	JobTitle                           ST      `hl7:"49,len=20,display=Job Title"`
 	JobCodeClass                       *JCC    `hl7:"50,len=20,display=Job Code/Class"`
 	GuarantorEmployersOrganizationName []XON   `hl7:"51,len=250,display=Guarantor Employer's Organization Name"`
-	Handicap                           IS      `hl7:"52,len=2,table=0295,display=Handicap"`
-	JobStatus                          IS      `hl7:"53,len=2,table=0311,display=Job Status"`
+	Handicap                           IS      `hl7:"52,len=2,table=0295,datatype=IS,display=Handicap"`
+	JobStatus                          IS      `hl7:"53,len=2,table=0311,datatype=IS,display=Job Status"`
 	GuarantorFinancialClass            *FC     `hl7:"54,len=50,display=Guarantor Financial Class"`
 	GuarantorRace                      []CE    `hl7:"55,len=250,table=0005,display=Guarantor Race"`
 }
@@ -896,7 +896,7 @@ type GT1 struct {
 // in 2.14.4.1.
 type IAM struct {
 	HL7                                  HL7Name `hl7:",name=IAM,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - IAM"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IAM"`
 	AllergenTypeCode                     *CE     `hl7:"2,len=250,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription      CE      `hl7:"3,required,len=250,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode                  *CE     `hl7:"4,len=250,table=0128,display=Allergy Severity Code"`
@@ -924,7 +924,7 @@ type IAM struct {
 // insurance bills.
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set ID - IN1"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IN1"`
 	InsurancePlanID               CE      `hl7:"2,required,len=250,table=0072,display=Insurance Plan ID"`
 	InsuranceCompanyID            []CX    `hl7:"3,required,len=250,display=Insurance Company ID"`
 	InsuranceCompanyName          []XON   `hl7:"4,len=250,display=Insurance Company Name"`
@@ -938,40 +938,40 @@ type IN1 struct {
 	PlanEffectiveDate             DT      `hl7:"12,len=8,format=YMD,display=Plan Effective Date"`
 	PlanExpirationDate            DT      `hl7:"13,len=8,format=YMD,display=Plan Expiration Date"`
 	AuthorizationInformation      *AUI    `hl7:"14,len=250,display=Authorization Information"`
-	PlanType                      IS      `hl7:"15,len=3,table=0086,display=Plan Type"`
+	PlanType                      IS      `hl7:"15,len=3,table=0086,datatype=IS,display=Plan Type"`
 	NameOfInsured                 []XPN   `hl7:"16,len=250,display=Name Of Insured"`
 	InsuredsRelationshipToPatient *CE     `hl7:"17,len=250,table=0063,display=Insured's Relationship To Patient"`
 	InsuredsDateOfBirth           TS      `hl7:"18,len=26,format=YMDHMS,display=Insured's Date Of Birth"`
 	InsuredsAddress               []XAD   `hl7:"19,len=250,display=Insured's Address"`
-	AssignmentOfBenefits          IS      `hl7:"20,len=2,table=0135,display=Assignment Of Benefits"`
-	CoordinationOfBenefits        IS      `hl7:"21,len=2,table=0173,display=Coordination Of Benefits"`
+	AssignmentOfBenefits          IS      `hl7:"20,len=2,table=0135,datatype=IS,display=Assignment Of Benefits"`
+	CoordinationOfBenefits        IS      `hl7:"21,len=2,table=0173,datatype=IS,display=Coordination Of Benefits"`
 	CoordOfBenPriority            ST      `hl7:"22,len=2,display=Coord Of Ben. Priority"`
-	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,display=Notice Of Admission Flag"`
+	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Notice Of Admission Flag"`
 	NoticeOfAdmissionDate         DT      `hl7:"24,len=8,format=YMD,display=Notice Of Admission Date"`
-	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,display=Report Of Eligibility Flag"`
+	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Report Of Eligibility Flag"`
 	ReportOfEligibilityDate       DT      `hl7:"26,len=8,format=YMD,display=Report Of Eligibility Date"`
-	ReleaseInformationCode        IS      `hl7:"27,len=2,table=0093,display=Release Information Code"`
+	ReleaseInformationCode        IS      `hl7:"27,len=2,table=0093,datatype=IS,display=Release Information Code"`
 	PreAdmitCert                  ST      `hl7:"28,len=15,display=Pre-Admit Cert"`
 	VerificationDateTime          TS      `hl7:"29,len=26,format=YMDHMS,display=Verification Date/Time"`
 	VerificationBy                []XCN   `hl7:"30,len=250,display=Verification By"`
-	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
-	BillingStatus                 IS      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,len=4,display=Delay Before L.R. Day"`
-	CompanyPlanCode               IS      `hl7:"35,len=8,table=0042,display=Company Plan Code"`
+	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,datatype=IS,display=Type Of Agreement Code"`
+	BillingStatus                 IS      `hl7:"32,len=2,table=0022,datatype=IS,display=Billing Status"`
+	LifetimeReserveDays           NM      `hl7:"33,len=4,datatype=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,len=4,datatype=NM,display=Delay Before L.R. Day"`
+	CompanyPlanCode               IS      `hl7:"35,len=8,table=0042,datatype=IS,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,len=15,display=Policy Number"`
 	PolicyDeductible              *CP     `hl7:"37,len=12,display=Policy Deductible"`
 	PolicyLimitAmount             CP      `hl7:"38,len=12,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,len=4,display=Policy Limit - Days"`
+	PolicyLimitDays               NM      `hl7:"39,len=4,datatype=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate           CP      `hl7:"40,len=12,display=Room Rate - Semi-Private"`
 	RoomRatePrivate               CP      `hl7:"41,len=12,display=Room Rate - Private"`
 	InsuredsEmploymentStatus      *CE     `hl7:"42,len=250,table=0066,display=Insured's Employment Status"`
-	InsuredsAdministrativeSex     IS      `hl7:"43,len=1,table=0001,display=Insured's Administrative Sex"`
+	InsuredsAdministrativeSex     IS      `hl7:"43,len=1,table=0001,datatype=IS,display=Insured's Administrative Sex"`
 	InsuredsEmployersAddress      []XAD   `hl7:"44,len=250,display=Insured's Employer's Address"`
 	VerificationStatus            ST      `hl7:"45,len=2,display=Verification Status"`
-	PriorInsurancePlanID          IS      `hl7:"46,len=8,table=0072,display=Prior Insurance Plan ID"`
-	CoverageType                  IS      `hl7:"47,len=3,table=0309,display=Coverage Type"`
-	Handicap                      IS      `hl7:"48,len=2,table=0295,display=Handicap"`
+	PriorInsurancePlanID          IS      `hl7:"46,len=8,table=0072,datatype=IS,display=Prior Insurance Plan ID"`
+	CoverageType                  IS      `hl7:"47,len=3,table=0309,datatype=IS,display=Coverage Type"`
+	Handicap                      IS      `hl7:"48,len=2,table=0295,datatype=IS,display=Handicap"`
 	InsuredsIDNumber              []CX    `hl7:"49,len=250,display=Insured's ID Number"`
 }
 
@@ -984,8 +984,8 @@ type IN2 struct {
 	InsuredsEmployeeID                   []CX    `hl7:"1,len=250,display=Insured's Employee ID"`
 	InsuredsSocialSecurityNumber         ST      `hl7:"2,len=11,display=Insured's Social Security Number"`
 	InsuredsEmployersNameAndID           []XCN   `hl7:"3,len=250,display=Insured's Employer's Name and ID"`
-	EmployerInformationData              IS      `hl7:"4,len=1,table=0139,display=Employer Information Data"`
-	MailClaimParty                       []IS    `hl7:"5,len=1,table=0137,display=Mail Claim Party"`
+	EmployerInformationData              IS      `hl7:"4,len=1,table=0139,datatype=IS,display=Employer Information Data"`
+	MailClaimParty                       []IS    `hl7:"5,len=1,table=0137,datatype=IS,display=Mail Claim Party"`
 	MedicareHealthInsCardNumber          ST      `hl7:"6,len=15,display=Medicare Health Ins Card Number"`
 	MedicaidCaseName                     []XPN   `hl7:"7,len=250,display=Medicaid Case Name"`
 	MedicaidCaseNumber                   ST      `hl7:"8,len=15,display=Medicaid Case Number"`
@@ -994,31 +994,31 @@ type IN2 struct {
 	DependentOfMilitaryRecipient         *CE     `hl7:"11,len=250,table=0342,display=Dependent Of Military Recipient"`
 	MilitaryOrganization                 ST      `hl7:"12,len=25,display=Military Organization"`
 	MilitaryStation                      ST      `hl7:"13,len=25,display=Military Station"`
-	MilitaryService                      IS      `hl7:"14,len=14,table=0140,display=Military Service"`
-	MilitaryRankGrade                    IS      `hl7:"15,len=2,table=0141,display=Military Rank/Grade"`
-	MilitaryStatus                       IS      `hl7:"16,len=3,table=0142,display=Military Status"`
+	MilitaryService                      IS      `hl7:"14,len=14,table=0140,datatype=IS,display=Military Service"`
+	MilitaryRankGrade                    IS      `hl7:"15,len=2,table=0141,datatype=IS,display=Military Rank/Grade"`
+	MilitaryStatus                       IS      `hl7:"16,len=3,table=0142,datatype=IS,display=Military Status"`
 	MilitaryRetireDate                   DT      `hl7:"17,len=8,format=YMD,display=Military Retire Date"`
-	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,display=Military Non-Avail Cert On File"`
-	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,display=Baby Coverage"`
-	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,display=Combine Baby Bill"`
+	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Military Non-Avail Cert On File"`
+	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Baby Coverage"`
+	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Combine Baby Bill"`
 	BloodDeductible                      ST      `hl7:"21,len=1,display=Blood Deductible"`
 	SpecialCoverageApprovalName          []XPN   `hl7:"22,len=250,display=Special Coverage Approval Name"`
 	SpecialCoverageApprovalTitle         ST      `hl7:"23,len=30,display=Special Coverage Approval Title"`
-	NonCoveredInsuranceCode              []IS    `hl7:"24,len=8,table=0143,display=Non-Covered Insurance Code"`
+	NonCoveredInsuranceCode              []IS    `hl7:"24,len=8,table=0143,datatype=IS,display=Non-Covered Insurance Code"`
 	PayorID                              []CX    `hl7:"25,len=250,display=Payor ID"`
 	PayorSubscriberID                    []CX    `hl7:"26,len=250,display=Payor Subscriber ID"`
-	EligibilitySource                    IS      `hl7:"27,len=1,table=0144,display=Eligibility Source"`
+	EligibilitySource                    IS      `hl7:"27,len=1,table=0144,datatype=IS,display=Eligibility Source"`
 	RoomCoverageTypeAmount               []RMC   `hl7:"28,len=250,display=Room Coverage Type/Amount"`
 	PolicyTypeAmount                     []PTA   `hl7:"29,len=250,display=Policy Type/Amount"`
 	DailyDeductible                      *DDI    `hl7:"30,len=250,display=Daily Deductible"`
-	LivingDependency                     IS      `hl7:"31,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                     []IS    `hl7:"32,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                     IS      `hl7:"31,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                     []IS    `hl7:"32,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                          []CE    `hl7:"33,len=250,table=0171,display=Citizenship"`
 	PrimaryLanguage                      *CE     `hl7:"34,len=250,table=0296,display=Primary Language"`
-	LivingArrangement                    IS      `hl7:"35,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                    IS      `hl7:"35,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                        *CE     `hl7:"36,len=250,table=0215,display=Publicity Code"`
-	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                     IS      `hl7:"38,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                     IS      `hl7:"38,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                             *CE     `hl7:"39,len=250,table=0006,display=Religion"`
 	MothersMaidenName                    []XPN   `hl7:"40,len=250,display=Mother's Maiden Name"`
 	Nationality                          *CE     `hl7:"41,len=250,table=0212,display=Nationality"`
@@ -1028,27 +1028,27 @@ type IN2 struct {
 	EmploymentStopDate                   DT      `hl7:"45,len=8,format=YMD,display=Employment Stop Date"`
 	JobTitle                             ST      `hl7:"46,len=20,display=Job Title"`
 	JobCodeClass                         *JCC    `hl7:"47,len=20,display=Job Code/Class"`
-	JobStatus                            IS      `hl7:"48,len=2,table=0311,display=Job Status"`
+	JobStatus                            IS      `hl7:"48,len=2,table=0311,datatype=IS,display=Job Status"`
 	EmployerContactPersonName            []XPN   `hl7:"49,len=250,display=Employer Contact Person Name"`
 	EmployerContactPersonPhoneNumber     []XTN   `hl7:"50,len=250,display=Employer Contact Person Phone Number"`
-	EmployerContactReason                IS      `hl7:"51,len=2,table=0222,display=Employer Contact Reason"`
+	EmployerContactReason                IS      `hl7:"51,len=2,table=0222,datatype=IS,display=Employer Contact Reason"`
 	InsuredsContactPersonsName           []XPN   `hl7:"52,len=250,display=Insured's Contact Person's Name"`
 	InsuredsContactPersonPhoneNumber     []XTN   `hl7:"53,len=250,display=Insured's Contact Person Phone Number"`
-	InsuredsContactPersonReason          []IS    `hl7:"54,len=2,table=0222,display=Insured's Contact Person Reason"`
+	InsuredsContactPersonReason          []IS    `hl7:"54,len=2,table=0222,datatype=IS,display=Insured's Contact Person Reason"`
 	RelationshipToThePatientStartDate    DT      `hl7:"55,len=8,format=YMD,display=Relationship To The Patient Start Date"`
 	RelationshipToThePatientStopDate     []DT    `hl7:"56,len=8,format=YMD,display=Relationship To The Patient Stop Date"`
-	InsuranceCoContactReason             IS      `hl7:"57,len=2,table=0232,display=Insurance Co. Contact Reason"`
+	InsuranceCoContactReason             IS      `hl7:"57,len=2,table=0232,datatype=IS,display=Insurance Co. Contact Reason"`
 	InsuranceCoContactPhoneNumber        *XTN    `hl7:"58,len=250,display=Insurance Co Contact Phone Number"`
-	PolicyScope                          IS      `hl7:"59,len=2,table=0312,display=Policy Scope"`
-	PolicySource                         IS      `hl7:"60,len=2,table=0313,display=Policy Source"`
+	PolicyScope                          IS      `hl7:"59,len=2,table=0312,datatype=IS,display=Policy Scope"`
+	PolicySource                         IS      `hl7:"60,len=2,table=0313,datatype=IS,display=Policy Source"`
 	PatientMemberNumber                  *CX     `hl7:"61,len=250,display=Patient Member Number"`
 	GuarantorsRelationshipToInsured      *CE     `hl7:"62,len=250,table=0063,display=Guarantor's Relationship To Insured"`
 	InsuredsPhoneNumberHome              []XTN   `hl7:"63,len=250,display=Insured's Phone Number - Home"`
 	InsuredsEmployerPhoneNumber          []XTN   `hl7:"64,len=250,display=Insured's Employer Phone Number"`
 	MilitaryHandicappedProgram           *CE     `hl7:"65,len=250,table=0343,display=Military Handicapped Program"`
-	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,display=Suspend Flag"`
-	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,display=Copay Limit Flag"`
-	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,display=Stoploss Limit Flag"`
+	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,datatype=ID,display=Suspend Flag"`
+	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,datatype=ID,display=Copay Limit Flag"`
+	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,datatype=ID,display=Stoploss Limit Flag"`
 	InsuredOrganizationNameAndID         []XON   `hl7:"69,len=250,display=Insured Organization Name And ID"`
 	InsuredEmployerOrganizationNameAndID []XON   `hl7:"70,len=250,display=Insured Employer Organization Name And ID"`
 	Race                                 []CE    `hl7:"71,len=250,table=0005,display=Race"`
@@ -1061,10 +1061,10 @@ type IN2 struct {
 // segment are defined by HCFA, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name `hl7:",name=IN3,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - IN3"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IN3"`
 	CertificationNumber                *CX     `hl7:"2,len=250,display=Certification Number"`
 	CertifiedBy                        []XCN   `hl7:"3,len=250,display=Certified By"`
-	CertificationRequired              ID      `hl7:"4,len=1,table=0136,display=Certification Required"`
+	CertificationRequired              ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Certification Required"`
 	Penalty                            *MOP    `hl7:"5,len=10,display=Penalty"`
 	CertificationDateTime              TS      `hl7:"6,len=26,format=YMDHMS,display=Certification Date/Time"`
 	CertificationModifyDateTime        TS      `hl7:"7,len=26,format=YMDHMS,display=Certification Modify Date/Time"`
@@ -1083,8 +1083,8 @@ type IN3 struct {
 	PreCertificationReqWindow          []PCF   `hl7:"20,len=40,display=Pre-Certification Req/Window"`
 	CaseManager                        ST      `hl7:"21,len=48,display=Case Manager"`
 	SecondOpinionDate                  DT      `hl7:"22,len=8,format=YMD,display=Second Opinion Date"`
-	SecondOpinionStatus                IS      `hl7:"23,len=1,table=0151,display=Second Opinion Status"`
-	SecondOpinionDocumentationReceived []IS    `hl7:"24,len=1,table=0152,display=Second Opinion Documentation Received"`
+	SecondOpinionStatus                IS      `hl7:"23,len=1,table=0151,datatype=IS,display=Second Opinion Status"`
+	SecondOpinionDocumentationReceived []IS    `hl7:"24,len=1,table=0152,datatype=IS,display=Second Opinion Documentation Received"`
 	SecondOpinionPhysician             []XCN   `hl7:"25,len=250,table=0010,display=Second Opinion Physician"`
 }
 
@@ -1100,10 +1100,10 @@ type INV struct {
 	InventoryContainerIdentifier *CE     `hl7:"4,len=250,display=Inventory Container Identifier"`
 	ContainerCarrierIdentifier   *CE     `hl7:"5,len=250,display=Container Carrier Identifier"`
 	PositionOnCarrier            *CE     `hl7:"6,len=250,display=Position on Carrier"`
-	InitialQuantity              NM      `hl7:"7,len=20,display=Initial Quantity"`
-	CurrentQuantity              NM      `hl7:"8,len=20,display=Current Quantity"`
-	AvailableQuantity            NM      `hl7:"9,len=20,display=Available Quantity"`
-	ConsumptionQuantity          NM      `hl7:"10,len=20,display=Consumption Quantity"`
+	InitialQuantity              NM      `hl7:"7,len=20,datatype=NM,display=Initial Quantity"`
+	CurrentQuantity              NM      `hl7:"8,len=20,datatype=NM,display=Current Quantity"`
+	AvailableQuantity            NM      `hl7:"9,len=20,datatype=NM,display=Available Quantity"`
+	ConsumptionQuantity          NM      `hl7:"10,len=20,datatype=NM,display=Consumption Quantity"`
 	QuantityUnits                *CE     `hl7:"11,len=250,display=Quantity Units"`
 	ExpirationDateTime           TS      `hl7:"12,len=26,format=YMDHMS,display=Expiration Date/Time"`
 	FirstUsedDateTime            TS      `hl7:"13,len=26,format=YMDHMS,display=First Used Date/Time"`
@@ -1120,7 +1120,7 @@ type INV struct {
 // section Glossary) on the specific equipment.
 type ISD struct {
 	HL7                        HL7Name `hl7:",name=ISD,type=s"`
-	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,display=Reference Interaction Number"`
+	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,datatype=NM,display=Reference Interaction Number"`
 	InteractionTypeIdentifier  *CE     `hl7:"2,len=250,table=0368,display=Interaction Type Identifier"`
 	InteractionActiveState     CE      `hl7:"3,required,len=250,table=0387,display=Interaction Active State"`
 }
@@ -1131,7 +1131,7 @@ type ISD struct {
 // optionally follow an STF segment.  An LAN segment must always have been preceded by a corresponding STF segment
 type LAN struct {
 	HL7                     HL7Name `hl7:",name=LAN,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=60,display=Set ID - LAN"`
+	SetID                   SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID - LAN"`
 	LanguageCode            CE      `hl7:"2,required,len=250,table=0296,display=Language Code"`
 	LanguageAbilityCode     []CE    `hl7:"3,len=250,table=0403,display=Language Ability Code"`
 	LanguageProficiencyCode *CE     `hl7:"4,len=250,table=0404,display=Language Proficiency Code"`
@@ -1161,7 +1161,7 @@ type LCC struct {
 type LCH struct {
 	HL7                         HL7Name `hl7:",name=LCH,type=s"`
 	PrimaryKeyValue             PL      `hl7:"1,required,len=200,display=Primary Key Value - LCH"`
-	SegmentActionCode           ID      `hl7:"2,len=3,table=0206,display=Segment Action Code"`
+	SegmentActionCode           ID      `hl7:"2,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey            *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationCharacteristicID    CE      `hl7:"4,required,len=250,table=0324,display=Location Characteristic ID"`
 	LocationCharacteristicValue CE      `hl7:"5,required,len=250,display=Location Characteristic Value-LCH"`
@@ -1178,10 +1178,10 @@ type LDP struct {
 	HL7                 HL7Name `hl7:",name=LDP,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=Primary Key Value - LDP"`
 	LocationDepartment  CE      `hl7:"2,required,len=250,table=0264,display=Location Department"`
-	LocationService     []IS    `hl7:"3,len=3,table=0069,display=Location Service"`
+	LocationService     []IS    `hl7:"3,len=3,table=0069,datatype=IS,display=Location Service"`
 	SpecialtyType       []CE    `hl7:"4,len=250,table=0265,display=Specialty Type"`
-	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,display=Valid Patient Classes"`
-	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,display=Active/Inactive Flag"`
+	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
+	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	ActivationDate      TS      `hl7:"7,len=26,format=YMDHMS,display=Activation Date  LDP"`
 	InactivationDate    TS      `hl7:"8,len=26,format=YMDHMS,display=Inactivation Date - LDP"`
 	InactivatedReason   ST      `hl7:"9,len=80,display=Inactivated Reason"`
@@ -1202,13 +1202,13 @@ type LOC struct {
 	HL7                 HL7Name `hl7:",name=LOC,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=Primary Key Value - LOC"`
 	LocationDescription ST      `hl7:"2,len=48,display=Location Description"`
-	LocationType        []IS    `hl7:"3,required,len=2,table=0260,display=Location Type - LOC"`
+	LocationType        []IS    `hl7:"3,required,len=2,table=0260,datatype=IS,display=Location Type - LOC"`
 	OrganizationName    []XON   `hl7:"4,len=250,display=Organization Name - LOC"`
 	LocationAddress     []XAD   `hl7:"5,len=250,display=Location Address"`
 	LocationPhone       []XTN   `hl7:"6,len=250,display=Location Phone"`
 	LicenseNumber       []CE    `hl7:"7,len=250,table=0461,display=License Number"`
-	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,display=Location Equipment"`
-	LocationServiceCode IS      `hl7:"9,len=1,table=0442,display=Location Service Code"`
+	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,datatype=IS,display=Location Equipment"`
+	LocationServiceCode IS      `hl7:"9,len=1,table=0442,datatype=IS,display=Location Service Code"`
 }
 
 // Location Relationship
@@ -1219,7 +1219,7 @@ type LOC struct {
 type LRL struct {
 	HL7                                     HL7Name `hl7:",name=LRL,type=s"`
 	PrimaryKeyValue                         PL      `hl7:"1,required,len=200,display=Primary Key Value - LRL"`
-	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,display=Segment Action Code"`
+	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey                        *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationRelationshipID                  CE      `hl7:"4,required,len=250,table=0325,display=Location Relationship ID"`
 	OrganizationalLocationRelationshipValue []XON   `hl7:"5,conditional,len=250,display=Organizational Location Relationship Value"`
@@ -1231,12 +1231,12 @@ type LRL struct {
 // The Technical Steward for the MFA segment is CQ.
 type MFA struct {
 	HL7                       HL7Name `hl7:",name=MFA,type=s"`
-	RecordLevelEventCode      ID      `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode      ID      `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID              ST      `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EventCompletionDateTime   TS      `hl7:"3,len=26,format=YMDHMS,display=Event Completion Date/Time"`
 	MFNRecordLevelErrorReturn CE      `hl7:"4,required,len=250,table=0181,display=MFN Record Level Error Return"`
 	PrimaryKeyValue           []CE    `hl7:"5,required,len=250,display=Primary Key Value - MFA"`
-	PrimaryKeyValueType       []ID    `hl7:"6,required,len=3,table=0355,display=Primary Key Value Type - MFA"`
+	PrimaryKeyValueType       []ID    `hl7:"6,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type - MFA"`
 }
 
 // Master File Entry
@@ -1244,11 +1244,11 @@ type MFA struct {
 // The Technical Steward for the MFE segment is CQ.
 type MFE struct {
 	HL7                  HL7Name  `hl7:",name=MFE,type=s"`
-	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID         ST       `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EffectiveDateTime    TS       `hl7:"3,len=26,format=YMDHMS,display=Effective Date/Time"`
 	PrimaryKeyValue      []VARIES `hl7:"4,required,len=200,display=Primary Key Value - MFE"`
-	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,display=Primary Key Value Type"`
+	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type"`
 }
 
 // Master File Identification
@@ -1258,10 +1258,10 @@ type MFI struct {
 	HL7                             HL7Name `hl7:",name=MFI,type=s"`
 	MasterFileIdentifier            CE      `hl7:"1,required,len=250,table=0175,display=Master File Identifier"`
 	MasterFileApplicationIdentifier *HD     `hl7:"2,len=180,display=Master File Application Identifier"`
-	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,display=File-Level Event Code"`
+	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,datatype=ID,display=File-Level Event Code"`
 	EnteredDateTime                 TS      `hl7:"4,len=26,format=YMDHMS,display=Entered Date/Time"`
 	EffectiveDateTime               TS      `hl7:"5,len=26,format=YMDHMS,display=Effective Date/Time"`
-	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,display=Response Level Code"`
+	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,datatype=ID,display=Response Level Code"`
 }
 
 // Merge patient information
@@ -1285,11 +1285,11 @@ type MRG struct {
 // The MSA segment contains information sent while acknowledging another message.
 type MSA struct {
 	HL7                       HL7Name `hl7:",name=MSA,type=s"`
-	AcknowledgementCode       ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgement Code"`
+	AcknowledgementCode       ID      `hl7:"1,required,len=2,table=0008,datatype=ID,display=Acknowledgement Code"`
 	MessageControlID          ST      `hl7:"2,required,len=20,display=Message Control ID"`
 	TextMessage               ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,len=15,display=Expected Sequence Number"`
-	DelayedAcknowledgmentType ID      `hl7:"5,len=1,table=0102,display=Delayed Acknowledgment Type"`
+	ExpectedSequenceNumber    NM      `hl7:"4,len=15,datatype=NM,display=Expected Sequence Number"`
+	DelayedAcknowledgmentType ID      `hl7:"5,len=1,table=0102,datatype=ID,display=Delayed Acknowledgment Type"`
 	ErrorCondition            *CE     `hl7:"6,len=250,table=0357,display=Error Condition"`
 }
 
@@ -1310,15 +1310,15 @@ type MSH struct {
 	MessageControlID                    ST      `hl7:"10,required,len=20,display=Message Control ID"`
 	ProcessingID                        PT      `hl7:"11,required,len=3,display=Processing ID"`
 	VersionID                           VID     `hl7:"12,required,len=60,display=Version ID"`
-	SequenceNumber                      NM      `hl7:"13,len=15,display=Sequence Number"`
+	SequenceNumber                      NM      `hl7:"13,len=15,datatype=NM,display=Sequence Number"`
 	ContinuationPointer                 ST      `hl7:"14,len=180,display=Continuation Pointer"`
-	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgment Type"`
-	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgment Type"`
-	CountryCode                         ID      `hl7:"17,len=3,table=0399,display=Country Code"`
-	CharacterSet                        []ID    `hl7:"18,len=16,table=0211,display=Character Set"`
+	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,datatype=ID,display=Accept Acknowledgment Type"`
+	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,datatype=ID,display=Application Acknowledgment Type"`
+	CountryCode                         ID      `hl7:"17,len=3,table=0399,datatype=ID,display=Country Code"`
+	CharacterSet                        []ID    `hl7:"18,len=16,table=0211,datatype=ID,display=Character Set"`
 	PrincipalLanguageOfMessage          *CE     `hl7:"19,len=250,display=Principal Language Of Message"`
-	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=20,table=0356,display=Alternate Character Set Handling Scheme"`
-	ConformanceStatementID              []ID    `hl7:"21,len=10,table=0449,display=Conformance Statement ID"`
+	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=20,table=0356,datatype=ID,display=Alternate Character Set Handling Scheme"`
+	ConformanceStatementID              []ID    `hl7:"21,len=10,table=0449,datatype=ID,display=Conformance Statement ID"`
 }
 
 // MessageStructureID returns the code for the message structure.
@@ -1342,7 +1342,7 @@ type NCK struct {
 // of events, (e.g., alarms that have occurred on a particular piece of equipment.
 type NDS struct {
 	HL7                         HL7Name `hl7:",name=NDS,type=s"`
-	NotificationReferenceNumber NM      `hl7:"1,required,len=20,display=Notification Reference Number"`
+	NotificationReferenceNumber NM      `hl7:"1,required,len=20,datatype=NM,display=Notification Reference Number"`
 	NotificationDateTime        TS      `hl7:"2,required,len=26,format=YMDHMS,display=Notification Date/Time"`
 	NotificationAlertSeverity   CE      `hl7:"3,required,len=250,table=0367,display=Notification Alert Severity"`
 	NotificationCode            CE      `hl7:"4,required,len=250,display=Notification Code"`
@@ -1354,7 +1354,7 @@ type NDS struct {
 // Utilizing NK1-1 - set ID , multiple NK1 segments can be sent to patient accounts.
 type NK1 struct {
 	HL7                                      HL7Name `hl7:",name=NK1,type=s"`
-	SetID                                    SI      `hl7:"1,seq,required,len=4,display=Set ID - NK1"`
+	SetID                                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - NK1"`
 	NKName                                   []XPN   `hl7:"2,len=250,display=NK Name"`
 	Relationship                             *CE     `hl7:"3,len=250,table=0063,display=Relationship"`
 	Address                                  []XAD   `hl7:"4,len=250,display=Address"`
@@ -1368,16 +1368,16 @@ type NK1 struct {
 	NextOfKinAssociatedPartiesEmployeeNumber *CX     `hl7:"12,len=250,display=Next of Kin / Associated Parties Employee Number"`
 	OrganizationName                         []XON   `hl7:"13,len=250,display=Organization Name - NK1"`
 	MaritalStatus                            *CE     `hl7:"14,len=250,table=0002,display=Marital Status"`
-	AdministrativeSex                        IS      `hl7:"15,len=1,table=0001,display=Administrative Sex"`
+	AdministrativeSex                        IS      `hl7:"15,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	DateTimeOfBirth                          TS      `hl7:"16,len=26,format=YMDHMS,display=Date/Time Of Birth"`
-	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                              []CE    `hl7:"19,len=250,table=0171,display=Citizenship"`
 	PrimaryLanguage                          *CE     `hl7:"20,len=250,table=0296,display=Primary Language"`
-	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                            *CE     `hl7:"22,len=250,table=0215,display=Publicity Code"`
-	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                                 *CE     `hl7:"25,len=250,table=0006,display=Religion"`
 	MothersMaidenName                        []XPN   `hl7:"26,len=250,display=Mother's Maiden Name"`
 	Nationality                              *CE     `hl7:"27,len=250,table=0212,display=Nationality"`
@@ -1387,9 +1387,9 @@ type NK1 struct {
 	ContactPersonsTelephoneNumber            []XTN   `hl7:"31,len=250,display=Contact Person's Telephone Number"`
 	ContactPersonsAddress                    []XAD   `hl7:"32,len=250,display=Contact Person's Address"`
 	NextOfKinAssociatedPartysIdentifiers     []CX    `hl7:"33,len=250,display=Next of Kin/Associated Party's Identifiers"`
-	JobStatus                                IS      `hl7:"34,len=2,table=0311,display=Job Status"`
+	JobStatus                                IS      `hl7:"34,len=2,table=0311,datatype=IS,display=Job Status"`
 	Race                                     []CE    `hl7:"35,len=250,table=0005,display=Race"`
-	Handicap                                 IS      `hl7:"36,len=2,table=0295,display=Handicap"`
+	Handicap                                 IS      `hl7:"36,len=2,table=0295,datatype=IS,display=Handicap"`
 	ContactPersonSocialSecurityNumber        ST      `hl7:"37,len=16,display=Contact Person Social Security Number"`
 }
 
@@ -1400,7 +1400,7 @@ type NK1 struct {
 type NPU struct {
 	HL7         HL7Name `hl7:",name=NPU,type=s"`
 	BedLocation PL      `hl7:"1,required,len=80,display=Bed Location"`
-	BedStatus   IS      `hl7:"2,len=1,table=0116,display=Bed Status"`
+	BedStatus   IS      `hl7:"2,len=1,table=0116,datatype=IS,display=Bed Status"`
 }
 
 // Application status change
@@ -1409,7 +1409,7 @@ type NPU struct {
 // migration (to a different cpu or file-server/file-system) of a particular application.
 type NSC struct {
 	HL7                   HL7Name `hl7:",name=NSC,type=s"`
-	ApplicationChangeType IS      `hl7:"1,required,len=4,table=0409,display=Application Change Type"`
+	ApplicationChangeType IS      `hl7:"1,required,len=4,table=0409,datatype=IS,display=Application Change Type"`
 	CurrentCPU            ST      `hl7:"2,len=30,display=Current CPU"`
 	CurrentFileserver     ST      `hl7:"3,len=30,display=Current Fileserver"`
 	CurrentApplication    *HD     `hl7:"4,len=30,display=Current Application"`
@@ -1427,21 +1427,21 @@ type NSC struct {
 // be used by application management applications monitoring the state of various network links.
 type NST struct {
 	HL7                           HL7Name `hl7:",name=NST,type=s"`
-	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,display=Statistics Available"`
+	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,datatype=ID,display=Statistics Available"`
 	SourceIdentifier              ST      `hl7:"2,len=30,display=Source Identifier"`
-	SourceType                    ID      `hl7:"3,len=3,table=0332,display=Source Type"`
+	SourceType                    ID      `hl7:"3,len=3,table=0332,datatype=ID,display=Source Type"`
 	StatisticsStart               TS      `hl7:"4,len=26,format=YMDHMS,display=Statistics Start"`
 	StatisticsEnd                 TS      `hl7:"5,len=26,format=YMDHMS,display=Statistics End"`
-	ReceiveCharacterCount         NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount            NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessagesReceived              NM      `hl7:"8,len=10,display=Messages Received"`
-	MessagesSent                  NM      `hl7:"9,len=10,display=Messages Sent"`
-	ChecksumErrorsReceived        NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived          NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived           NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts               NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts               NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	ApplicationControlLevelErrors NM      `hl7:"15,len=10,display=Application control-level Errors"`
+	ReceiveCharacterCount         NM      `hl7:"6,len=10,datatype=NM,display=Receive Character Count"`
+	SendCharacterCount            NM      `hl7:"7,len=10,datatype=NM,display=Send Character Count"`
+	MessagesReceived              NM      `hl7:"8,len=10,datatype=NM,display=Messages Received"`
+	MessagesSent                  NM      `hl7:"9,len=10,datatype=NM,display=Messages Sent"`
+	ChecksumErrorsReceived        NM      `hl7:"10,len=10,datatype=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived          NM      `hl7:"11,len=10,datatype=NM,display=Length Errors Received"`
+	OtherErrorsReceived           NM      `hl7:"12,len=10,datatype=NM,display=Other Errors Received"`
+	ConnectTimeouts               NM      `hl7:"13,len=10,datatype=NM,display=Connect Timeouts"`
+	ReceiveTimeouts               NM      `hl7:"14,len=10,datatype=NM,display=Receive Timeouts"`
+	ApplicationControlLevelErrors NM      `hl7:"15,len=10,datatype=NM,display=Application control-level Errors"`
 }
 
 // Notes and Comments
@@ -1450,8 +1450,8 @@ type NST struct {
 // and comments.
 type NTE struct {
 	HL7             HL7Name `hl7:",name=NTE,type=s"`
-	SetID           SI      `hl7:"1,seq,len=4,display=Set ID - NTE"`
-	SourceOfComment ID      `hl7:"2,len=8,table=0105,display=Source of Comment"`
+	SetID           SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - NTE"`
+	SourceOfComment ID      `hl7:"2,len=8,table=0105,datatype=ID,display=Source of Comment"`
 	Comment         []FT    `hl7:"3,len=65536,display=Comment"`
 	CommentType     *CE     `hl7:"4,len=250,table=0364,display=Comment Type"`
 }
@@ -1472,17 +1472,17 @@ type NTE struct {
 // obtained directly from a subject (e.g., BP, Chest X-ray), they represent the start and end time of the observation.
 type OBR struct {
 	HL7                                  HL7Name `hl7:",name=OBR,type=s"`
-	SetID                                SI      `hl7:"1,seq,len=4,display=Set ID - OBR"`
+	SetID                                SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - OBR"`
 	PlacerOrderNumber                    *EI     `hl7:"2,conditional,len=22,display=Placer Order Number"`
 	FillerOrderNumber                    *EI     `hl7:"3,conditional,len=22,display=Filler Order Number"`
 	UniversalServiceIdentifier           CE      `hl7:"4,required,len=250,display=Universal Service Identifier"`
-	Priority                             ID      `hl7:"5,len=2,display=Priority"`
+	Priority                             ID      `hl7:"5,len=2,datatype=ID,display=Priority"`
 	RequestedDateTime                    TS      `hl7:"6,len=26,format=YMDHMS,display=Requested Date/Time"`
 	ObservationDateTime                  TS      `hl7:"7,conditional,len=26,format=YMDHMS,display=Observation Date/Time  "`
 	ObservationEndDateTime               TS      `hl7:"8,len=26,format=YMDHMS,display=Observation End Date/Time  "`
 	CollectionVolume                     *CQ     `hl7:"9,len=20,display=Collection Volume *"`
 	CollectorIdentifier                  []XCN   `hl7:"10,len=250,display=Collector Identifier *"`
-	SpecimenActionCode                   ID      `hl7:"11,len=1,table=0065,display=Specimen Action Code *"`
+	SpecimenActionCode                   ID      `hl7:"11,len=1,table=0065,datatype=ID,display=Specimen Action Code *"`
 	DangerCode                           *CE     `hl7:"12,len=250,display=Danger Code"`
 	RelevantClinicalInfo                 ST      `hl7:"13,len=300,display=Relevant Clinical Info."`
 	SpecimenReceivedDateTime             TS      `hl7:"14,conditional,len=26,format=YMDHMS,display=Specimen Received Date/Time *"`
@@ -1495,25 +1495,25 @@ type OBR struct {
 	FillerField2                         ST      `hl7:"21,len=60,display=Filler Field 2 +"`
 	ResultsRptStatusChngDateTime         TS      `hl7:"22,conditional,len=26,format=YMDHMS,display=Results Rpt/Status Chng - Date/Time +"`
 	ChargeToPractice                     *MOC    `hl7:"23,len=40,display=Charge to Practice +"`
-	DiagnosticServSectID                 ID      `hl7:"24,len=10,table=0074,display=Diagnostic Serv Sect ID"`
-	ResultStatus                         ID      `hl7:"25,conditional,len=1,table=0123,display=Result Status +"`
+	DiagnosticServSectID                 ID      `hl7:"24,len=10,table=0074,datatype=ID,display=Diagnostic Serv Sect ID"`
+	ResultStatus                         ID      `hl7:"25,conditional,len=1,table=0123,datatype=ID,display=Result Status +"`
 	ParentResult                         *PRL    `hl7:"26,len=400,display=Parent Result +"`
 	QuantityTiming                       []TQ    `hl7:"27,len=200,display=Quantity/Timing"`
 	ResultCopiesTo                       []XCN   `hl7:"28,max=5,len=250,display=Result Copies To"`
 	ParentNumber                         *EIP    `hl7:"29,len=200,display=Parent Number"`
-	TransportationMode                   ID      `hl7:"30,len=20,table=0124,display=Transportation Mode"`
+	TransportationMode                   ID      `hl7:"30,len=20,table=0124,datatype=ID,display=Transportation Mode"`
 	ReasonForStudy                       []CE    `hl7:"31,len=250,display=Reason for Study"`
 	PrincipalResultInterpreter           *NDL    `hl7:"32,len=200,display=Principal Result Interpreter +"`
 	AssistantResultInterpreter           []NDL   `hl7:"33,len=200,display=Assistant Result Interpreter +"`
 	Technician                           []NDL   `hl7:"34,len=200,display=Technician +"`
 	Transcriptionist                     []NDL   `hl7:"35,len=200,display=Transcriptionist +"`
 	ScheduledDateTime                    TS      `hl7:"36,len=26,format=YMDHMS,display=Scheduled Date/Time +"`
-	NumberOfSampleContainers             NM      `hl7:"37,len=4,display=Number of Sample Containers *"`
+	NumberOfSampleContainers             NM      `hl7:"37,len=4,datatype=NM,display=Number of Sample Containers *"`
 	TransportLogisticsOfCollectedSample  []CE    `hl7:"38,len=250,display=Transport Logistics of Collected Sample *"`
 	CollectorsComment                    []CE    `hl7:"39,len=250,display=Collector's Comment *"`
 	TransportArrangementResponsibility   *CE     `hl7:"40,len=250,display=Transport Arrangement Responsibility"`
-	TransportArranged                    ID      `hl7:"41,len=30,table=0224,display=Transport Arranged"`
-	EscortRequired                       ID      `hl7:"42,len=1,table=0225,display=Escort Required"`
+	TransportArranged                    ID      `hl7:"41,len=30,table=0224,datatype=ID,display=Transport Arranged"`
+	EscortRequired                       ID      `hl7:"42,len=1,table=0225,datatype=ID,display=Escort Required"`
 	PlannedPatientTransportComment       []CE    `hl7:"43,len=250,display=Planned Patient Transport Comment"`
 	ProcedureCode                        *CE     `hl7:"44,len=250,table=0088,display=Procedure Code"`
 	ProcedureCodeModifier                []CE    `hl7:"45,len=250,table=0340,display=Procedure Code Modifier"`
@@ -1527,17 +1527,17 @@ type OBR struct {
 // unit of a report. Its structure is summarized in Figure 7-5.
 type OBX struct {
 	HL7                            HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                          SI       `hl7:"1,seq,len=4,display=Set ID - OBX"`
-	ValueType                      ID       `hl7:"2,conditional,len=2,table=0125,display=Value Type"`
+	SetID                          SI       `hl7:"1,seq,len=4,datatype=SI,display=Set ID - OBX"`
+	ValueType                      ID       `hl7:"2,conditional,len=2,table=0125,datatype=ID,display=Value Type"`
 	ObservationIdentifier          CE       `hl7:"3,required,len=250,display=Observation Identifier"`
 	ObservationSubID               ST       `hl7:"4,conditional,len=20,display=Observation Sub-Id"`
 	ObservationValue               []VARIES `hl7:"5,conditional,len=65536,display=Observation Value"`
 	Units                          *CE      `hl7:"6,len=250,display=Units"`
 	ReferencesRange                ST       `hl7:"7,len=60,display=References Range"`
-	AbnormalFlags                  []IS     `hl7:"8,max=5,len=5,table=0078,display=Abnormal Flags"`
-	Probability                    NM       `hl7:"9,len=5,display=Probability"`
-	NatureOfAbnormalTest           []ID     `hl7:"10,len=2,table=0080,display=Nature of Abnormal Test"`
-	ObservationResultStatus        ID       `hl7:"11,required,len=1,table=0085,display=Observation Result Status"`
+	AbnormalFlags                  []IS     `hl7:"8,max=5,len=5,table=0078,datatype=IS,display=Abnormal Flags"`
+	Probability                    NM       `hl7:"9,len=5,datatype=NM,display=Probability"`
+	NatureOfAbnormalTest           []ID     `hl7:"10,len=2,table=0080,datatype=ID,display=Nature of Abnormal Test"`
+	ObservationResultStatus        ID       `hl7:"11,required,len=1,table=0085,datatype=ID,display=Observation Result Status"`
 	DateLastObservationNormalValue TS       `hl7:"12,len=26,format=YMDHMS,display=Date Last Observation Normal Value"`
 	UserDefinedAccessChecks        ST       `hl7:"13,len=20,display=User Defined Access Checks"`
 	DateTimeOfTheObservation       TS       `hl7:"14,len=26,format=YMDHMS,display=Date/Time of the Observation"`
@@ -1569,7 +1569,7 @@ func (v OBX) ChildVaries(dtReg map[string]any) (reflect.Value, error) {
 // which are part of a diet but only delivered, say, every day at night.
 type ODS struct {
 	HL7                            HL7Name `hl7:",name=ODS,type=s"`
-	Type                           ID      `hl7:"1,required,len=1,table=0159,display=Type"`
+	Type                           ID      `hl7:"1,required,len=1,table=0159,datatype=ID,display=Type"`
 	ServicePeriod                  []CE    `hl7:"2,max=10,len=250,display=Service Period"`
 	DietSupplementOrPreferenceCode []CE    `hl7:"3,required,max=20,len=250,display=Diet- Supplement- or Preference Code"`
 	TextInstruction                []ST    `hl7:"4,max=2,len=80,display=Text Instruction"`
@@ -1594,10 +1594,10 @@ type ODT struct {
 // The Technical Steward for the OM1 segment is ORDERS.
 type OM1 struct {
 	HL7                                                    HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	ProducersServiceTestObservationID                      CE      `hl7:"2,required,len=250,display=Producer's Service/Test/Observation ID"`
-	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,display=Permitted Data Types"`
-	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
+	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,datatype=ID,display=Permitted Data Types"`
+	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Specimen Required"`
 	ProducerID                                             CE      `hl7:"5,required,len=250,display=Producer ID"`
 	ObservationDescription                                 TX      `hl7:"6,len=200,display=Observation Description"`
 	OtherServiceTestObservationIDsForTheObservation        *CE     `hl7:"7,len=250,display=Other Service/Test/Observation IDs for the Observation"`
@@ -1605,25 +1605,25 @@ type OM1 struct {
 	PreferredReportNameForTheObservation                   ST      `hl7:"9,len=30,display=Preferred Report Name for the Observation"`
 	PreferredShortNameOrMnemonicForObservation             ST      `hl7:"10,len=8,display=Preferred Short Name or Mnemonic for Observation"`
 	PreferredLongNameForTheObservation                     ST      `hl7:"11,len=200,display=Preferred Long Name for the Observation"`
-	Orderability                                           ID      `hl7:"12,len=1,table=0136,display=Orderability"`
+	Orderability                                           ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Orderability"`
 	IdentityOfInstrumentUsedToPerformThisStudy             []CE    `hl7:"13,len=250,display=Identity of Instrument Used to Perform this Study"`
 	CodedRepresentationOfMethod                            []CE    `hl7:"14,len=250,display=Coded Representation of Method"`
-	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,display=Portable Device Indicator"`
+	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Portable Device Indicator"`
 	ObservationProducingDepartmentSection                  []CE    `hl7:"16,len=250,display=Observation Producing Department/Section"`
 	TelephoneNumberOfSection                               *XTN    `hl7:"17,len=250,display=Telephone Number of Section"`
-	NatureOfServiceTestObservation                         IS      `hl7:"18,required,len=1,table=0174,display=Nature of Service/Test/Observation"`
+	NatureOfServiceTestObservation                         IS      `hl7:"18,required,len=1,table=0174,datatype=IS,display=Nature of Service/Test/Observation"`
 	ReportSubheader                                        *CE     `hl7:"19,len=250,display=Report Subheader"`
 	ReportDisplayOrder                                     ST      `hl7:"20,len=20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefinitionForTheObservation TS      `hl7:"21,len=26,format=YMDHMS,display=Date/Time Stamp for any change in Definition for the Observation"`
 	EffectiveDateTimeOfChange                              TS      `hl7:"22,len=26,format=YMDHMS,display=Effective Date/Time of Change"`
-	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,display=Typical Turn-Around Time"`
-	ProcessingTime                                         NM      `hl7:"24,len=20,display=Processing Time"`
-	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,display=Processing Priority"`
-	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,display=Reporting Priority"`
+	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,datatype=NM,display=Typical Turn-Around Time"`
+	ProcessingTime                                         NM      `hl7:"24,len=20,datatype=NM,display=Processing Time"`
+	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,datatype=ID,display=Processing Priority"`
+	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,datatype=ID,display=Reporting Priority"`
 	OutsideSite                                            []CE    `hl7:"27,len=250,display=Outside Site"`
 	AddressOfOutsideSite                                   []XAD   `hl7:"28,len=1000,display=Address of Outside Site"`
 	PhoneNumberOfOutsideSite                               *XTN    `hl7:"29,len=400,display=Phone Number of Outside Site"`
-	ConfidentialityCode                                    IS      `hl7:"30,len=1,table=0177,display=Confidentiality Code"`
+	ConfidentialityCode                                    IS      `hl7:"30,len=1,table=0177,datatype=IS,display=Confidentiality Code"`
 	ObservationsRequiredToInterpretTheObservation          *CE     `hl7:"31,len=250,display=Observations Required to Interpret the Observation"`
 	InterpretationOfObservations                           TX      `hl7:"32,len=65536,display=Interpretation of Observations"`
 	ContraindicationsToObservations                        *CE     `hl7:"33,len=65536,display=Contraindications to Observations"`
@@ -1651,16 +1651,16 @@ type OM1 struct {
 // The Technical Steward for the OM2 segment is ORDERS.
 type OM2 struct {
 	HL7                                              HL7Name `hl7:",name=OM2,type=s"`
-	SequenceNumberTestObservationMasterFile          NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile          NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	UnitsOfMeasure                                   *CE     `hl7:"2,len=250,display=Units of Measure"`
-	RangeOfDecimalPrecision                          []NM    `hl7:"3,len=10,display=Range of Decimal Precision"`
+	RangeOfDecimalPrecision                          []NM    `hl7:"3,len=10,datatype=NM,display=Range of Decimal Precision"`
 	CorrespondingSIUnitsOfMeasure                    *CE     `hl7:"4,len=250,display=Corresponding SI Units of Measure"`
 	SIConversionFactor                               TX      `hl7:"5,len=60,display=SI Conversion Factor"`
 	Reference                                        *RFR    `hl7:"6,len=250,display=Reference"`
 	CriticalRangeForOrdinalAndContinuousObservations *NR     `hl7:"7,len=205,display=Critical Range for Ordinal and Continuous Observations"`
 	AbsoluteRangeForOrdinalAndContinuousObservations *RFR    `hl7:"8,len=250,display=Absolute Range for Ordinal and Continuous Observations"`
 	DeltaCheckCriteria                               []DLT   `hl7:"9,len=250,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements                      NM      `hl7:"10,len=20,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements                      NM      `hl7:"10,len=20,datatype=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical Service/Test/Observation
@@ -1670,13 +1670,13 @@ type OM2 struct {
 // The Technical Steward for the OM3 segment is ORDERS.
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	PreferredCodingSystem                       *CE     `hl7:"2,len=250,display=Preferred Coding System"`
 	ValidCodedAnswers                           *CE     `hl7:"3,len=250,display=Valid Coded 'Answers'"`
 	NormalTextCodesForCategoricalObservations   []CE    `hl7:"4,len=250,display=Normal Text/Codes for Categorical Observations"`
 	AbnormalTextCodesForCategoricalObservations *CE     `hl7:"5,len=250,display=Abnormal Text/Codes for Categorical Observations"`
 	CriticalTextCodesForCategoricalObservations *CE     `hl7:"6,len=250,display=Critical Text/Codes for Categorical Observations"`
-	ValueType                                   ID      `hl7:"7,len=2,table=0125,display=Value Type"`
+	ValueType                                   ID      `hl7:"7,len=2,table=0125,datatype=ID,display=Value Type"`
 }
 
 // Observations that Require Specimens
@@ -1688,10 +1688,10 @@ type OM3 struct {
 // The Technical Steward for the OM4 segment is ORDERS.
 type OM4 struct {
 	HL7                                     HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
-	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
+	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,datatype=ID,display=Derived Specimen"`
 	ContainerDescription                    TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume                         NM      `hl7:"4,len=20,display=Container Volume"`
+	ContainerVolume                         NM      `hl7:"4,len=20,datatype=NM,display=Container Volume"`
 	ContainerUnits                          *CE     `hl7:"5,len=250,display=Container Units"`
 	Specimen                                *CE     `hl7:"6,len=250,display=Specimen"`
 	Additive                                *CE     `hl7:"7,len=250,table=0371,display=Additive"`
@@ -1700,7 +1700,7 @@ type OM4 struct {
 	NormalCollectionVolume                  *CQ     `hl7:"10,len=20,display=Normal Collection Volume"`
 	MinimumCollectionVolume                 *CQ     `hl7:"11,len=20,display=Minimum Collection Volume"`
 	SpecimenRequirements                    TX      `hl7:"12,len=10240,display=Specimen Requirements"`
-	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,display=Specimen Priorities"`
+	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,datatype=ID,display=Specimen Priorities"`
 	SpecimenRetentionTime                   *CQ     `hl7:"14,len=20,display=Specimen Retention Time"`
 }
 
@@ -1712,7 +1712,7 @@ type OM4 struct {
 // The Technical Steward for the OM5 segment is ORDERS.
 type OM5 struct {
 	HL7                                                HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	TestObservationsIncludedWithinAnOrderedTestBattery []CE    `hl7:"2,len=250,display=Test/Observations Included within an Ordered Test Battery"`
 	ObservationIDSuffixes                              ST      `hl7:"3,len=250,display=Observation ID Suffixes"`
 }
@@ -1725,7 +1725,7 @@ type OM5 struct {
 // The Technical Steward for the OM6 segment is ORDERS.
 type OM6 struct {
 	HL7                                     HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	DerivationRule                          TX      `hl7:"2,len=10240,display=Derivation Rule"`
 }
 
@@ -1734,29 +1734,29 @@ type OM6 struct {
 // The OM7 segment contains additional basic attributes that apply to the definition of most observations/services.
 type OM7 struct {
 	HL7                                     HL7Name `hl7:",name=OM7,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,display=Sequence Number - Test/ Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,datatype=NM,display=Sequence Number - Test/ Observation Master File"`
 	UniversalServiceIdentifier              CE      `hl7:"2,required,len=250,display=Universal Service Identifier"`
 	CategoryIdentifier                      []CE    `hl7:"3,len=250,table=0412,display=Category Identifier"`
 	CategoryDescription                     TX      `hl7:"4,len=200,display=Category Description"`
 	CategorySynonym                         []ST    `hl7:"5,len=200,display=Category Synonym"`
 	EffectiveTestServiceStartDateTime       TS      `hl7:"6,len=26,format=YMDHMS,display=Effective Test/Service Start Date/Time"`
 	EffectiveTestServiceEndDateTime         TS      `hl7:"7,len=26,format=YMDHMS,display=Effective Test/Service End Date/Time"`
-	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,display=Test/Service Default Duration Quantity"`
+	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,datatype=NM,display=Test/Service Default Duration Quantity"`
 	TestServiceDefaultDurationUnits         *CE     `hl7:"9,len=250,display=Test/Service Default Duration Units"`
-	TestServiceDefaultFrequency             IS      `hl7:"10,len=60,table=0335,display=Test/Service Default Frequency"`
-	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,display=Consent Indicator"`
+	TestServiceDefaultFrequency             IS      `hl7:"10,len=60,table=0335,datatype=IS,display=Test/Service Default Frequency"`
+	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Consent Indicator"`
 	ConsentIdentifier                       *CE     `hl7:"12,len=250,table=0413,display=Consent Identifier"`
 	ConsentEffectiveStartDateTime           TS      `hl7:"13,len=26,format=YMDHMS,display=Consent Effective Start Date/Time"`
 	ConsentEffectiveEndDateTime             TS      `hl7:"14,len=26,format=YMDHMS,display=Consent Effective End Date/Time"`
-	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,display=Consent Interval Quantity"`
+	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,datatype=NM,display=Consent Interval Quantity"`
 	ConsentIntervalUnits                    *CE     `hl7:"16,conditional,len=250,table=0414,display=Consent Interval Units"`
-	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,display=Consent Waiting Period Quantity"`
+	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,datatype=NM,display=Consent Waiting Period Quantity"`
 	ConsentWaitingPeriodUnits               *CE     `hl7:"18,conditional,len=250,table=0414,display=Consent Waiting Period Units"`
 	EffectiveDateTimeOfChange               TS      `hl7:"19,len=26,format=YMDHMS,display=Effective Date/Time of Change"`
 	EnteredBy                               *XCN    `hl7:"20,len=250,display=Entered By"`
 	OrderableAtLocation                     []PL    `hl7:"21,len=200,display=Orderable-at Location"`
-	FormularyStatus                         IS      `hl7:"22,len=1,table=0473,display=Formulary Status"`
-	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,display=Special Order Indicator"`
+	FormularyStatus                         IS      `hl7:"22,len=1,table=0473,datatype=IS,display=Formulary Status"`
+	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Special Order Indicator"`
 	PrimaryKeyValueCDM                      []CE    `hl7:"24,len=250,table=0132,display=Primary Key Value - CDM"`
 }
 
@@ -1767,12 +1767,12 @@ type OM7 struct {
 // detail segment is present, but is not required otherwise.
 type ORC struct {
 	HL7                           HL7Name `hl7:",name=ORC,type=s"`
-	OrderControl                  ID      `hl7:"1,required,len=2,table=0119,display=Order Control"`
+	OrderControl                  ID      `hl7:"1,required,len=2,table=0119,datatype=ID,display=Order Control"`
 	PlacerOrderNumber             *EI     `hl7:"2,conditional,len=22,display=Placer Order Number"`
 	FillerOrderNumber             *EI     `hl7:"3,conditional,len=22,display=Filler Order Number"`
 	PlacerGroupNumber             *EI     `hl7:"4,len=22,display=Placer Group Number"`
-	OrderStatus                   ID      `hl7:"5,len=2,table=0038,display=Order Status"`
-	ResponseFlag                  ID      `hl7:"6,len=1,table=0121,display=Response Flag"`
+	OrderStatus                   ID      `hl7:"5,len=2,table=0038,datatype=ID,display=Order Status"`
+	ResponseFlag                  ID      `hl7:"6,len=1,table=0121,datatype=ID,display=Response Flag"`
 	QuantityTiming                []TQ    `hl7:"7,len=200,display=Quantity/Timing"`
 	ParentOrder                   *EIP    `hl7:"8,len=200,display=Parent Order"`
 	DateTimeOfTransaction         TS      `hl7:"9,len=26,format=YMDHMS,display=Date/Time of Transaction"`
@@ -1804,18 +1804,18 @@ type ORC struct {
 // Category.
 type ORG struct {
 	HL7                                        HL7Name `hl7:",name=ORG,type=s"`
-	SetID                                      SI      `hl7:"1,seq,required,len=60,display=Set ID - ORG"`
+	SetID                                      SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID - ORG"`
 	OrganizationUnitCode                       *CE     `hl7:"2,len=250,table=0405,display=Organization Unit Code"`
 	OrganizationUnitTypeCode                   *CE     `hl7:"3,len=250,table=0474,display=Organization Unit Type Code - ORG"`
-	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,display=Primary Org Unit Indicator"`
+	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Primary Org Unit Indicator"`
 	PractitionerUnitIdentifier                 *CX     `hl7:"5,len=60,display=Practitioner Org Unit Identifier"`
 	HealthCareProviderTypeCode                 *CE     `hl7:"6,len=250,table=0452,display=Health Care Provider Type Code"`
 	HealthCareProviderClassificationCode       *CE     `hl7:"7,len=250,table=0453,display=Health Care Provider Classification Code"`
 	HealthCareProviderAreaOfSpecializationCode *CE     `hl7:"8,len=250,table=0454,display=Health Care Provider Area of Specialization Code"`
 	EffectiveDateRange                         *DR     `hl7:"9,len=52,display=Effective Date Range"`
 	EmploymentStatusCode                       *CE     `hl7:"10,len=2,table=0066,display=Employment Status Code"`
-	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,display=Board Approval Indicator"`
-	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,display=Primary Care Physician Indicator"`
+	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Board Approval Indicator"`
+	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Primary Care Physician Indicator"`
 }
 
 // Possible Causal Relationship
@@ -1826,28 +1826,28 @@ type ORG struct {
 type PCR struct {
 	HL7                               HL7Name `hl7:",name=PCR,type=s"`
 	ImplicatedProduct                 CE      `hl7:"1,required,len=250,display=Implicated Product"`
-	GenericProduct                    IS      `hl7:"2,len=1,table=0249,display=Generic Product"`
+	GenericProduct                    IS      `hl7:"2,len=1,table=0249,datatype=IS,display=Generic Product"`
 	ProductClass                      *CE     `hl7:"3,len=250,display=Product Class"`
 	TotalDurationOfTherapy            *CQ     `hl7:"4,len=8,display=Total Duration Of Therapy"`
 	ProductManufactureDate            TS      `hl7:"5,len=26,format=YMDHMS,display=Product Manufacture Date"`
 	ProductExpirationDate             TS      `hl7:"6,len=26,format=YMDHMS,display=Product Expiration Date"`
 	ProductImplantationDate           TS      `hl7:"7,len=26,format=YMDHMS,display=Product Implantation Date"`
 	ProductExplantationDate           TS      `hl7:"8,len=26,format=YMDHMS,display=Product Explantation Date"`
-	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,display=Single Use Device"`
+	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,datatype=IS,display=Single Use Device"`
 	IndicationForProductUse           *CE     `hl7:"10,len=250,display=Indication For Product Use"`
-	ProductProblem                    IS      `hl7:"11,len=8,table=0245,display=Product Problem"`
+	ProductProblem                    IS      `hl7:"11,len=8,table=0245,datatype=IS,display=Product Problem"`
 	ProductSerialLotNumber            []ST    `hl7:"12,max=3,len=30,display=Product Serial/Lot Number"`
-	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,display=Product Available For Inspection"`
+	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,datatype=IS,display=Product Available For Inspection"`
 	ProductEvaluationPerformed        *CE     `hl7:"14,len=250,display=Product Evaluation Performed"`
 	ProductEvaluationStatus           *CE     `hl7:"15,len=250,table=0247,display=Product Evaluation Status"`
 	ProductEvaluationResults          *CE     `hl7:"16,len=250,display=Product Evaluation Results"`
-	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,display=Evaluated Product Source"`
+	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,datatype=ID,display=Evaluated Product Source"`
 	DateProductReturnedToManufacturer TS      `hl7:"18,len=26,format=YMDHMS,display=Date Product Returned To Manufacturer"`
-	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,display=Device Operator Qualifications"`
-	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,display=Relatedness Assessment"`
-	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,display=Action Taken In Response To The Event"`
-	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,display=Event Causality Observations"`
-	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,display=Indirect Exposure Mechanism"`
+	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,datatype=ID,display=Device Operator Qualifications"`
+	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,datatype=ID,display=Relatedness Assessment"`
+	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,datatype=ID,display=Action Taken In Response To The Event"`
+	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,datatype=ID,display=Event Causality Observations"`
+	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,datatype=ID,display=Indirect Exposure Mechanism"`
 }
 
 // Patient Additional Demographic
@@ -1855,27 +1855,27 @@ type PCR struct {
 // The patient additional demographic segment contains demographic information that is likely to change about the patient.
 type PD1 struct {
 	HL7                                     HL7Name `hl7:",name=PD1,type=s"`
-	LivingDependency                        []IS    `hl7:"1,len=2,table=0223,display=Living Dependency"`
-	LivingArrangement                       IS      `hl7:"2,len=2,table=0220,display=Living Arrangement"`
+	LivingDependency                        []IS    `hl7:"1,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	LivingArrangement                       IS      `hl7:"2,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PatientPrimaryFacility                  []XON   `hl7:"3,len=250,display=Patient Primary Facility"`
 	PatientPrimaryCareProviderNameIDNo      []XCN   `hl7:"4,len=250,display=Patient Primary Care Provider Name & ID No."`
-	StudentIndicator                        IS      `hl7:"5,len=2,table=0231,display=Student Indicator"`
-	Handicap                                IS      `hl7:"6,len=2,table=0295,display=Handicap"`
-	LivingWillCode                          IS      `hl7:"7,len=2,table=0315,display=Living Will Code"`
-	OrganDonorCode                          IS      `hl7:"8,len=2,table=0316,display=Organ Donor Code"`
-	SeparateBill                            ID      `hl7:"9,len=1,table=0136,display=Separate Bill"`
+	StudentIndicator                        IS      `hl7:"5,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Handicap                                IS      `hl7:"6,len=2,table=0295,datatype=IS,display=Handicap"`
+	LivingWillCode                          IS      `hl7:"7,len=2,table=0315,datatype=IS,display=Living Will Code"`
+	OrganDonorCode                          IS      `hl7:"8,len=2,table=0316,datatype=IS,display=Organ Donor Code"`
+	SeparateBill                            ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Separate Bill"`
 	DuplicatePatient                        []CX    `hl7:"10,len=250,display=Duplicate Patient"`
 	PublicityCode                           *CE     `hl7:"11,len=250,table=0215,display=Publicity Code"`
-	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	ProtectionIndicatorEffectiveDate        DT      `hl7:"13,len=8,format=YMD,display=Protection Indicator Effective Date"`
 	PlaceOfWorship                          []XON   `hl7:"14,len=250,display=Place of Worship"`
 	AdvanceDirectiveCode                    []CE    `hl7:"15,len=250,table=0435,display=Advance Directive Code"`
-	ImmunizationRegistryStatus              IS      `hl7:"16,len=1,table=0441,display=Immunization Registry Status"`
+	ImmunizationRegistryStatus              IS      `hl7:"16,len=1,table=0441,datatype=IS,display=Immunization Registry Status"`
 	ImmunizationRegistryStatusEffectiveDate DT      `hl7:"17,len=8,format=YMD,display=Immunization Registry Status Effective Date"`
 	PublicityCodeEffectiveDate              DT      `hl7:"18,len=8,format=YMD,display=Publicity Code Effective Date"`
-	MilitaryBranch                          IS      `hl7:"19,len=5,table=0140,display=Military Branch"`
-	MilitaryRankGrade                       IS      `hl7:"20,len=2,table=0141,display=Military Rank/Grade"`
-	MilitaryStatus                          IS      `hl7:"21,len=3,table=0142,display=Military Status"`
+	MilitaryBranch                          IS      `hl7:"19,len=5,table=0140,datatype=IS,display=Military Branch"`
+	MilitaryRankGrade                       IS      `hl7:"20,len=2,table=0141,datatype=IS,display=Military Rank/Grade"`
+	MilitaryStatus                          IS      `hl7:"21,len=3,table=0142,datatype=IS,display=Military Status"`
 }
 
 // Patient death and autopsy
@@ -1885,13 +1885,13 @@ type PDA struct {
 	HL7                            HL7Name `hl7:",name=PDA,type=s"`
 	DeathCauseCode                 []CE    `hl7:"1,len=250,display=Death Cause Code"`
 	DeathLocation                  *PL     `hl7:"2,len=80,display=Death Location"`
-	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,display=Death Certified Indicator"`
+	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Death Certified Indicator"`
 	DeathCertificateSignedDateTime TS      `hl7:"4,len=26,format=YMDHMS,display=Death Certificate Signed Date/Time"`
 	DeathCertifiedBy               *XCN    `hl7:"5,len=250,display=Death Certified By"`
-	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,display=Autopsy Indicator"`
+	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Autopsy Indicator"`
 	AutopsyStartAndEndDateTime     *DR     `hl7:"7,len=53,display=Autopsy Start and End Date/Time"`
 	AutopsyPerformedBy             *XCN    `hl7:"8,len=250,display=Autopsy Performed By"`
-	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,display=Coroner Indicator"`
+	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Coroner Indicator"`
 }
 
 // Product Detail Country
@@ -1906,7 +1906,7 @@ type PDC struct {
 	CatalogueIdentifier     ST      `hl7:"7,len=60,display=Catalogue Identifier"`
 	OtherIdentifier         []ST    `hl7:"8,len=60,display=Other Identifier"`
 	ProductCode             *CE     `hl7:"9,len=250,display=Product Code"`
-	MarketingBasis          ID      `hl7:"10,len=4,table=0330,display=Marketing Basis"`
+	MarketingBasis          ID      `hl7:"10,len=4,table=0330,datatype=ID,display=Marketing Basis"`
 	MarketingApprovalID     ST      `hl7:"11,len=60,display=Marketing Approval ID"`
 	LabeledShelfLife        *CQ     `hl7:"12,len=12,display=Labeled Shelf Life"`
 	ExpectedShelfLife       *CQ     `hl7:"13,len=12,display=Expected Shelf Life"`
@@ -1930,11 +1930,11 @@ type PEO struct {
 	EventImprovedDateTime                 TS      `hl7:"5,len=26,format=YMDHMS,display=Event Improved Date/Time"`
 	EventEndedDataTime                    TS      `hl7:"6,len=26,format=YMDHMS,display=Event Ended Data/Time"`
 	EventLocationOccurredAddress          []XAD   `hl7:"7,len=250,display=Event Location Occurred Address"`
-	EventQualification                    []ID    `hl7:"8,len=1,table=0237,display=Event Qualification"`
-	EventSerious                          ID      `hl7:"9,len=1,table=0238,display=Event Serious"`
-	EventExpected                         ID      `hl7:"10,len=1,table=0239,display=Event Expected"`
-	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,display=Event Outcome"`
-	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,display=Patient Outcome"`
+	EventQualification                    []ID    `hl7:"8,len=1,table=0237,datatype=ID,display=Event Qualification"`
+	EventSerious                          ID      `hl7:"9,len=1,table=0238,datatype=ID,display=Event Serious"`
+	EventExpected                         ID      `hl7:"10,len=1,table=0239,datatype=ID,display=Event Expected"`
+	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,datatype=ID,display=Event Outcome"`
+	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,datatype=ID,display=Patient Outcome"`
 	EventDescriptionFromOthers            []FT    `hl7:"13,len=600,display=Event Description From Others"`
 	EventFromOriginalReporter             []FT    `hl7:"14,len=600,display=Event From Original Reporter"`
 	EventDescriptionFromPatient           []FT    `hl7:"15,len=600,display=Event Description From Patient"`
@@ -1944,10 +1944,10 @@ type PEO struct {
 	PrimaryObserverName                   []XPN   `hl7:"19,len=250,display=Primary Observer Name"`
 	PrimaryObserverAddress                []XAD   `hl7:"20,len=250,display=Primary Observer Address"`
 	PrimaryObserverTelephone              []XTN   `hl7:"21,len=250,display=Primary Observer Telephone"`
-	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,display=Primary Observer's Qualification"`
-	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,display=Confirmation Provided By"`
+	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,datatype=ID,display=Primary Observer's Qualification"`
+	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,datatype=ID,display=Confirmation Provided By"`
 	PrimaryObserverAwareDateTime          TS      `hl7:"24,len=26,format=YMDHMS,display=Primary Observer Aware Date/Time"`
-	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,display=Primary Observer's identity May Be Divulged"`
+	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,datatype=ID,display=Primary Observer's identity May Be Divulged"`
 }
 
 // Product Experience Sender
@@ -1960,14 +1960,14 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,len=250,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,len=250,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,len=75,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,len=2,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,len=2,datatype=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,len=600,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,len=600,display=Sender Comment"`
 	SenderAwareDateTime    TS      `hl7:"9,len=26,format=YMDHMS,display=Sender Aware Date/Time"`
 	EventReportDate        TS      `hl7:"10,required,len=26,format=YMDHMS,display=Event Report Date"`
-	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,display=Event Report Timing/Type"`
-	EventReportSource      ID      `hl7:"12,len=1,table=0235,display=Event Report Source"`
-	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,display=Event Reported To"`
+	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,datatype=ID,display=Event Report Timing/Type"`
+	EventReportSource      ID      `hl7:"12,len=1,table=0235,datatype=ID,display=Event Report Source"`
+	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,datatype=ID,display=Event Reported To"`
 }
 
 // Patient identification
@@ -1977,18 +1977,18 @@ type PES struct {
 // to change frequently.
 type PID struct {
 	HL7                         HL7Name `hl7:",name=PID,type=s"`
-	SetID                       SI      `hl7:"1,seq,len=4,display=Set ID - PID"`
+	SetID                       SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - PID"`
 	PatientID                   CX      `hl7:"2,len=20,display=Patient ID"`
 	PatientIdentifierList       []CX    `hl7:"3,required,len=250,display=Patient Identifier List"`
 	AlternatePatientID          []CX    `hl7:"4,len=20,display=Alternate Patient ID - PID"`
 	PatientName                 []XPN   `hl7:"5,required,len=250,display=Patient Name"`
 	MothersMaidenName           []XPN   `hl7:"6,len=250,display=Mother's Maiden Name"`
 	DateTimeOfBirth             TS      `hl7:"7,len=26,format=YMDHMS,display=Date/Time Of Birth"`
-	AdministrativeSex           IS      `hl7:"8,len=1,table=0001,display=Administrative Sex"`
+	AdministrativeSex           IS      `hl7:"8,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	PatientAlias                []XPN   `hl7:"9,len=250,display=Patient Alias"`
 	Race                        []CE    `hl7:"10,len=250,table=0005,display=Race"`
 	PatientAddress              []XAD   `hl7:"11,len=250,display=Patient Address"`
-	CountyCode                  IS      `hl7:"12,len=4,table=0289,display=County Code"`
+	CountyCode                  IS      `hl7:"12,len=4,table=0289,datatype=IS,display=County Code"`
 	PhoneNumberHome             []XTN   `hl7:"13,len=250,display=Phone Number - Home"`
 	PhoneNumberBusiness         []XTN   `hl7:"14,len=250,display=Phone Number - Business"`
 	PrimaryLanguage             *CE     `hl7:"15,len=250,table=0296,display=Primary Language"`
@@ -2000,15 +2000,15 @@ type PID struct {
 	MothersIdentifier           []CX    `hl7:"21,len=250,display=Mother's Identifier"`
 	EthnicGroup                 []CE    `hl7:"22,len=250,table=0189,display=Ethnic Group"`
 	BirthPlace                  ST      `hl7:"23,len=250,display=Birth Place"`
-	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                  NM      `hl7:"25,len=2,display=Birth Order"`
+	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,datatype=ID,display=Multiple Birth Indicator"`
+	BirthOrder                  NM      `hl7:"25,len=2,datatype=NM,display=Birth Order"`
 	Citizenship                 []CE    `hl7:"26,len=250,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus      *CE     `hl7:"27,len=250,table=0172,display=Veterans Military Status"`
 	Nationality                 CE      `hl7:"28,len=250,table=0212,display=Nationality"`
 	PatientDeathDateAndTime     TS      `hl7:"29,len=26,format=YMDHMS,display=Patient Death Date and Time"`
-	PatientDeathIndicator       ID      `hl7:"30,len=1,table=0136,display=Patient Death Indicator"`
-	IdentityUnknownIndicator    ID      `hl7:"31,len=1,table=0136,display=Identity Unknown Indicator"`
-	IdentityReliabilityCode     []IS    `hl7:"32,len=20,table=0445,display=Identity Reliability Code"`
+	PatientDeathIndicator       ID      `hl7:"30,len=1,table=0136,datatype=ID,display=Patient Death Indicator"`
+	IdentityUnknownIndicator    ID      `hl7:"31,len=1,table=0136,datatype=ID,display=Identity Unknown Indicator"`
+	IdentityReliabilityCode     []IS    `hl7:"32,len=20,table=0445,datatype=IS,display=Identity Reliability Code"`
 	LastUpdateDateTime          TS      `hl7:"33,len=26,format=YMDHMS,display=Last Update Date/Time"`
 	LastUpdateFacility          *HD     `hl7:"34,len=40,display=Last Update Facility"`
 	SpeciesCode                 *CE     `hl7:"35,conditional,len=250,table=0446,display=Species Code"`
@@ -2024,23 +2024,23 @@ type PID struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                     HL7Name `hl7:",name=PR1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - PR1"`
-	ProcedureCodingMethod   IS      `hl7:"2,len=3,table=0089,display=Procedure Coding Method"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - PR1"`
+	ProcedureCodingMethod   IS      `hl7:"2,len=3,table=0089,datatype=IS,display=Procedure Coding Method"`
 	ProcedureCode           CE      `hl7:"3,required,len=250,table=0088,display=Procedure Code"`
 	ProcedureDescription    ST      `hl7:"4,len=40,display=Procedure Description"`
 	ProcedureDateTime       TS      `hl7:"5,required,len=26,format=YMDHMS,display=Procedure Date/Time"`
-	ProcedureFunctionalType IS      `hl7:"6,len=2,table=0230,display=Procedure Functional Type"`
-	ProcedureMinutes        NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureFunctionalType IS      `hl7:"6,len=2,table=0230,datatype=IS,display=Procedure Functional Type"`
+	ProcedureMinutes        NM      `hl7:"7,len=4,datatype=NM,display=Procedure Minutes"`
 	Anesthesiologist        []XCN   `hl7:"8,len=250,table=0010,display=Anesthesiologist"`
-	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes       NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaCode          IS      `hl7:"9,len=2,table=0019,datatype=IS,display=Anesthesia Code"`
+	AnesthesiaMinutes       NM      `hl7:"10,len=4,datatype=NM,display=Anesthesia Minutes"`
 	Surgeon                 []XCN   `hl7:"11,len=250,table=0010,display=Surgeon"`
 	ProcedurePractitioner   []XCN   `hl7:"12,len=250,table=0010,display=Procedure Practitioner"`
 	ConsentCode             *CE     `hl7:"13,len=250,table=0059,display=Consent Code"`
-	ProcedurePriority       ID      `hl7:"14,len=2,table=0418,display=Procedure Priority"`
+	ProcedurePriority       ID      `hl7:"14,len=2,table=0418,datatype=ID,display=Procedure Priority"`
 	AssociatedDiagnosisCode *CE     `hl7:"15,len=250,table=0051,display=Associated Diagnosis Code"`
 	ProcedureCodeModifier   []CE    `hl7:"16,len=250,table=0340,display=Procedure Code Modifier"`
-	ProcedureDRGType        IS      `hl7:"17,len=20,table=0416,display=Procedure DRG Type"`
+	ProcedureDRGType        IS      `hl7:"17,len=20,table=0416,datatype=IS,display=Procedure DRG Type"`
 	TissueTypeCode          []CE    `hl7:"18,len=250,table=0417,display=Tissue Type Code"`
 }
 
@@ -2056,8 +2056,8 @@ type PRA struct {
 	HL7                                       HL7Name `hl7:",name=PRA,type=s"`
 	PrimaryKeyValue                           *CE     `hl7:"1,conditional,len=250,display=Primary Key Value - PRA"`
 	PractitionerGroup                         []CE    `hl7:"2,len=250,table=0358,display=Practitioner Group"`
-	PractitionerCategory                      []IS    `hl7:"3,len=3,table=0186,display=Practitioner Category"`
-	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,display=Provider Billing"`
+	PractitionerCategory                      []IS    `hl7:"3,len=3,table=0186,datatype=IS,display=Practitioner Category"`
+	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,datatype=ID,display=Provider Billing"`
 	Specialty                                 []SPD   `hl7:"5,len=100,display=Specialty"`
 	PractitionerIDNumbers                     []PLN   `hl7:"6,len=100,display=Practitioner ID Numbers"`
 	Privileges                                []PIP   `hl7:"7,len=200,display=Privileges"`
@@ -2065,7 +2065,7 @@ type PRA struct {
 	Institution                               *CE     `hl7:"9,len=250,display=Institution"`
 	DateLeftPractice                          DT      `hl7:"10,len=8,format=YMD,display=Date Left Practice"`
 	GovernmentReimbursementBillingEligibility []CE    `hl7:"11,len=250,table=0401,display=Government Reimbursement Billing Eligibility"`
-	SetID                                     SI      `hl7:"12,seq,conditional,len=60,display=Set ID - PRA"`
+	SetID                                     SI      `hl7:"12,seq,conditional,len=60,datatype=SI,display=Set ID - PRA"`
 }
 
 // Problem Details
@@ -2073,12 +2073,12 @@ type PRA struct {
 // The problem detail segment contains the data necessary to add, update, correct, and delete the problems of a given individual.
 type PRB struct {
 	HL7                                               HL7Name `hl7:",name=PRB,type=s"`
-	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime                                    TS      `hl7:"2,required,len=26,format=YMDHMS,display=Action Date/Time"`
 	ProblemID                                         CE      `hl7:"3,required,len=250,display=Problem ID"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,len=60,display=Problem Instance ID"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	ProblemListPriority                               NM      `hl7:"6,len=60,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,len=60,datatype=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        TS      `hl7:"7,len=26,format=YMDHMS,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              TS      `hl7:"8,len=26,format=YMDHMS,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   TS      `hl7:"9,len=26,format=YMDHMS,display=Actual Problem Resolution Date/Time"`
@@ -2092,7 +2092,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,len=80,display=Problem Onset Text"`
 	ProblemRanking                                    *CE     `hl7:"18,len=250,display=Problem Ranking"`
 	CertaintyOfProblem                                *CE     `hl7:"19,len=250,display=Certainty of Problem"`
-	ProbabilityOfProblem                              NM      `hl7:"20,len=5,display=Probability of Problem"`
+	ProbabilityOfProblem                              NM      `hl7:"20,len=5,datatype=NM,display=Probability of Problem"`
 	IndividualAwarenessOfProblem                      *CE     `hl7:"21,len=250,display=Individual Awareness of Problem"`
 	ProblemPrognosis                                  *CE     `hl7:"22,len=250,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CE     `hl7:"23,len=250,display=Individual Awareness of Prognosis"`
@@ -2113,21 +2113,21 @@ type PRC struct {
 	PrimaryKeyValue     CE      `hl7:"1,required,len=250,table=0132,display=Primary Key Value - PRC"`
 	FacilityID          []CE    `hl7:"2,len=250,table=0464,display=Facility ID - PRC"`
 	Department          []CE    `hl7:"3,len=250,table=0184,display=Department"`
-	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,display=Valid Patient Classes"`
+	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,len=12,display=Price"`
 	Formula             []ST    `hl7:"6,len=200,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,len=4,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,len=4,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,len=4,datatype=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,len=4,datatype=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,len=12,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,len=12,display=Maximum Price"`
 	EffectiveStartDate  TS      `hl7:"11,len=26,format=YMDHMS,display=Effective Start Date"`
 	EffectiveEndDate    TS      `hl7:"12,len=26,format=YMDHMS,display=Effective End Date"`
-	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,display=Price Override Flag"`
+	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,datatype=IS,display=Price Override Flag"`
 	BillingCategory     []CE    `hl7:"14,len=250,table=0293,display=Billing Category"`
-	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,display=Chargeable Flag"`
-	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,display=Active/Inactive Flag"`
+	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Chargeable Flag"`
+	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Cost                *MO     `hl7:"17,len=12,display=Cost"`
-	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,display=Charge On Indicator"`
+	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,datatype=IS,display=Charge On Indicator"`
 }
 
 // Provider Data
@@ -2163,13 +2163,13 @@ type PSH struct {
 	ReportIntervalEndDate                              TS      `hl7:"5,len=26,format=YMDHMS,display=Report Interval End Date"`
 	QuantityManufactured                               *CQ     `hl7:"6,len=12,display=Quantity Manufactured"`
 	QuantityDistributed                                *CQ     `hl7:"7,len=12,display=Quantity Distributed"`
-	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,display=Quantity Distributed Method"`
+	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,datatype=ID,display=Quantity Distributed Method"`
 	QuantityDistributedComment                         FT      `hl7:"9,len=600,display=Quantity Distributed Comment"`
 	QuantityInUse                                      *CQ     `hl7:"10,len=12,display=Quantity in Use"`
-	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,display=Quantity in Use Method"`
+	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,datatype=ID,display=Quantity in Use Method"`
 	QuantityInUseComment                               FT      `hl7:"12,len=600,display=Quantity in Use Comment"`
-	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,display=Number of Product Experience Reports Filed by Facility"`
-	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,display=Number of Product Experience Reports Filed by Distributor"`
+	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=2,datatype=NM,display=Number of Product Experience Reports Filed by Facility"`
+	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=2,datatype=NM,display=Number of Product Experience Reports Filed by Distributor"`
 }
 
 // Pathway
@@ -2178,7 +2178,7 @@ type PSH struct {
 // to address an individuals health care.
 type PTH struct {
 	HL7                                  HL7Name `hl7:",name=PTH,type=s"`
-	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	PathwayID                            CE      `hl7:"2,required,len=250,display=Pathway ID"`
 	PathwayInstanceID                    EI      `hl7:"3,required,len=60,display=Pathway Instance ID"`
 	PathwayEstablishedDateTime           TS      `hl7:"4,required,len=26,format=YMDHMS,display=Pathway Established Date/Time"`
@@ -2194,57 +2194,57 @@ type PTH struct {
 // that are part of the associated PV1 hierarchy (e.g. ROL, DG1, or OBX).
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - PV1"`
-	PatientClass            IS      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - PV1"`
+	PatientClass            IS      `hl7:"2,required,len=1,table=0004,datatype=IS,display=Patient Class"`
 	AssignedPatientLocation *PL     `hl7:"3,len=80,display=Assigned Patient Location"`
-	AdmissionType           IS      `hl7:"4,len=2,table=0007,display=Admission Type"`
+	AdmissionType           IS      `hl7:"4,len=2,table=0007,datatype=IS,display=Admission Type"`
 	PreadmitNumber          *CX     `hl7:"5,len=250,display=Preadmit Number"`
 	PriorPatientLocation    *PL     `hl7:"6,len=80,display=Prior Patient Location"`
 	AttendingDoctor         []XCN   `hl7:"7,len=250,table=0010,display=Attending Doctor"`
 	ReferringDoctor         []XCN   `hl7:"8,len=250,table=0010,display=Referring Doctor"`
 	ConsultingDoctor        []XCN   `hl7:"9,len=250,table=0010,display=Consulting Doctor"`
-	HospitalService         IS      `hl7:"10,len=3,table=0069,display=Hospital Service"`
+	HospitalService         IS      `hl7:"10,len=3,table=0069,datatype=IS,display=Hospital Service"`
 	TemporaryLocation       *PL     `hl7:"11,len=80,display=Temporary Location"`
-	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,display=Preadmit Test Indicator"`
-	ReAdmissionIndicator    IS      `hl7:"13,len=2,table=0092,display=Re-admission Indicator"`
-	AdmitSource             IS      `hl7:"14,len=6,table=0023,display=Admit Source"`
-	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,display=Ambulatory Status"`
-	VIPIndicator            IS      `hl7:"16,len=2,table=0099,display=VIP Indicator"`
+	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,datatype=IS,display=Preadmit Test Indicator"`
+	ReAdmissionIndicator    IS      `hl7:"13,len=2,table=0092,datatype=IS,display=Re-admission Indicator"`
+	AdmitSource             IS      `hl7:"14,len=6,table=0023,datatype=IS,display=Admit Source"`
+	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
+	VIPIndicator            IS      `hl7:"16,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 	AdmittingDoctor         []XCN   `hl7:"17,len=250,table=0010,display=Admitting Doctor"`
-	PatientType             IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	PatientType             IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	VisitNumber             *CX     `hl7:"19,len=250,display=Visit Number"`
 	FinancialClass          []FC    `hl7:"20,len=50,display=Financial Class"`
-	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,display=Charge Price Indicator"`
-	CourtesyCode            IS      `hl7:"22,len=2,table=0045,display=Courtesy Code"`
-	CreditRating            IS      `hl7:"23,len=2,table=0046,display=Credit Rating"`
-	ContractCode            []IS    `hl7:"24,len=2,table=0044,display=Contract Code"`
+	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,datatype=IS,display=Charge Price Indicator"`
+	CourtesyCode            IS      `hl7:"22,len=2,table=0045,datatype=IS,display=Courtesy Code"`
+	CreditRating            IS      `hl7:"23,len=2,table=0046,datatype=IS,display=Credit Rating"`
+	ContractCode            []IS    `hl7:"24,len=2,table=0044,datatype=IS,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
-	InterestCode            IS      `hl7:"28,len=2,table=0073,display=Interest Code"`
-	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,display=Transfer to Bad Debt Code"`
+	ContractAmount          []NM    `hl7:"26,len=12,datatype=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,datatype=NM,display=Contract Period"`
+	InterestCode            IS      `hl7:"28,len=2,table=0073,datatype=IS,display=Interest Code"`
+	TransferToBadDebtCode   IS      `hl7:"29,len=1,table=0110,datatype=IS,display=Transfer to Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer to Bad Debt Date"`
-	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
-	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
+	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,datatype=IS,display=Bad Debt Agency Code"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,datatype=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,datatype=NM,display=Bad Debt Recovery Amount"`
+	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,datatype=IS,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
-	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
+	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,datatype=IS,display=Discharge Disposition"`
 	DischargedToLocation    *DLD    `hl7:"37,len=25,display=Discharged to Location"`
 	DietType                *CE     `hl7:"38,len=250,table=0114,display=Diet Type"`
-	ServicingFacility       IS      `hl7:"39,len=2,table=0115,display=Servicing Facility"`
-	BedStatus               IS      `hl7:"40,len=1,table=0116,display=Bed Status"`
-	AccountStatus           IS      `hl7:"41,len=2,table=0117,display=Account Status"`
+	ServicingFacility       IS      `hl7:"39,len=2,table=0115,datatype=IS,display=Servicing Facility"`
+	BedStatus               IS      `hl7:"40,len=1,table=0116,datatype=IS,display=Bed Status"`
+	AccountStatus           IS      `hl7:"41,len=2,table=0117,datatype=IS,display=Account Status"`
 	PendingLocation         *PL     `hl7:"42,len=80,display=Pending Location"`
 	PriorTemporaryLocation  *PL     `hl7:"43,len=80,display=Prior Temporary Location"`
 	AdmitDateTime           TS      `hl7:"44,len=26,format=YMDHMS,display=Admit Date/Time"`
 	DischargeDateTime       []TS    `hl7:"45,len=26,format=YMDHMS,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,datatype=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,datatype=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,datatype=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,datatype=NM,display=Total Payments"`
 	AlternateVisitID        *CX     `hl7:"50,len=250,display=Alternate Visit ID"`
-	VisitIndicator          IS      `hl7:"51,len=1,table=0326,display=Visit Indicator"`
+	VisitIndicator          IS      `hl7:"51,len=1,table=0326,datatype=IS,display=Visit Indicator"`
 	OtherHealthcareProvider []XCN   `hl7:"52,len=250,table=0010,display=Other Healthcare Provider"`
 }
 
@@ -2259,44 +2259,44 @@ type PV2 struct {
 	TransferReason                    *CE     `hl7:"4,len=250,display=Transfer Reason"`
 	PatientValuables                  []ST    `hl7:"5,len=25,display=Patient Valuables"`
 	PatientValuablesLocation          ST      `hl7:"6,len=25,display=Patient Valuables Location"`
-	VisitUserCode                     []IS    `hl7:"7,len=2,table=0130,display=Visit User Code"`
+	VisitUserCode                     []IS    `hl7:"7,len=2,table=0130,datatype=IS,display=Visit User Code"`
 	ExpectedAdmitDateTime             TS      `hl7:"8,len=26,format=YMDHMS,display=Expected Admit Date/Time"`
 	ExpectedDischargeDateTime         TS      `hl7:"9,len=26,format=YMDHMS,display=Expected Discharge Date/Time"`
-	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,display=Estimated Length of Inpatient Stay"`
-	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,display=Actual Length of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay    NM      `hl7:"10,len=3,datatype=NM,display=Estimated Length of Inpatient Stay"`
+	ActualLengthOfInpatientStay       NM      `hl7:"11,len=3,datatype=NM,display=Actual Length of Inpatient Stay"`
 	VisitDescription                  ST      `hl7:"12,len=50,display=Visit Description"`
 	ReferralSourceCode                []XCN   `hl7:"13,len=250,display=Referral Source Code"`
 	PreviousServiceDate               DT      `hl7:"14,len=8,format=YMD,display=Previous Service Date"`
-	EmploymentIllnessRelatedIndicator ID      `hl7:"15,len=1,table=0136,display=Employment Illness Related Indicator"`
-	PurgeStatusCode                   IS      `hl7:"16,len=1,table=0213,display=Purge Status Code"`
+	EmploymentIllnessRelatedIndicator ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Employment Illness Related Indicator"`
+	PurgeStatusCode                   IS      `hl7:"16,len=1,table=0213,datatype=IS,display=Purge Status Code"`
 	PurgeStatusDate                   DT      `hl7:"17,len=8,format=YMD,display=Purge Status Date"`
-	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,display=Special Program Code"`
-	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,display=Expected Number of Insurance Plans"`
-	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,display=Visit Publicity Code"`
-	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
+	SpecialProgramCode                IS      `hl7:"18,len=2,table=0214,datatype=IS,display=Special Program Code"`
+	RetentionIndicator                ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Retention Indicator"`
+	ExpectedNumberOfInsurancePlans    NM      `hl7:"20,len=1,datatype=NM,display=Expected Number of Insurance Plans"`
+	VisitPublicityCode                IS      `hl7:"21,len=1,table=0215,datatype=IS,display=Visit Publicity Code"`
+	VisitProtectionIndicator          ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Visit Protection Indicator"`
 	ClinicOrganizationName            []XON   `hl7:"23,len=250,display=Clinic Organization Name"`
-	PatientStatusCode                 IS      `hl7:"24,len=2,table=0216,display=Patient Status Code"`
-	VisitPriorityCode                 IS      `hl7:"25,len=1,table=0217,display=Visit Priority Code"`
+	PatientStatusCode                 IS      `hl7:"24,len=2,table=0216,datatype=IS,display=Patient Status Code"`
+	VisitPriorityCode                 IS      `hl7:"25,len=1,table=0217,datatype=IS,display=Visit Priority Code"`
 	PreviousTreatmentDate             DT      `hl7:"26,len=8,format=YMD,display=Previous Treatment Date"`
-	ExpectedDischargeDisposition      IS      `hl7:"27,len=2,table=0112,display=Expected Discharge Disposition"`
+	ExpectedDischargeDisposition      IS      `hl7:"27,len=2,table=0112,datatype=IS,display=Expected Discharge Disposition"`
 	SignatureOnFileDate               DT      `hl7:"28,len=8,format=YMD,display=Signature on File Date"`
 	FirstSimilarIllnessDate           DT      `hl7:"29,len=8,format=YMD,display=First Similar Illness Date"`
 	PatientChargeAdjustmentCode       *CE     `hl7:"30,len=250,table=0218,display=Patient Charge Adjustment Code"`
-	RecurringServiceCode              IS      `hl7:"31,len=2,table=0219,display=Recurring Service Code"`
-	BillingMediaCode                  ID      `hl7:"32,len=1,table=0136,display=Billing Media Code"`
+	RecurringServiceCode              IS      `hl7:"31,len=2,table=0219,datatype=IS,display=Recurring Service Code"`
+	BillingMediaCode                  ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Billing Media Code"`
 	ExpectedSurgeryDateAndTime        TS      `hl7:"33,len=26,format=YMDHMS,display=Expected Surgery Date and Time"`
-	MilitaryPartnershipCode           ID      `hl7:"34,len=1,table=0136,display=Military Partnership Code"`
-	MilitaryNonAvailabilityCode       ID      `hl7:"35,len=1,table=0136,display=Military Non-Availability Code"`
-	NewbornBabyIndicator              ID      `hl7:"36,len=1,table=0136,display=Newborn Baby Indicator"`
-	BabyDetainedIndicator             ID      `hl7:"37,len=1,table=0136,display=Baby Detained Indicator"`
+	MilitaryPartnershipCode           ID      `hl7:"34,len=1,table=0136,datatype=ID,display=Military Partnership Code"`
+	MilitaryNonAvailabilityCode       ID      `hl7:"35,len=1,table=0136,datatype=ID,display=Military Non-Availability Code"`
+	NewbornBabyIndicator              ID      `hl7:"36,len=1,table=0136,datatype=ID,display=Newborn Baby Indicator"`
+	BabyDetainedIndicator             ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Baby Detained Indicator"`
 	ModeOfArrivalCode                 *CE     `hl7:"38,len=250,table=0430,display=Mode of Arrival Code"`
 	RecreationalDrugUseCode           []CE    `hl7:"39,len=250,table=0431,display=Recreational Drug Use Code"`
 	AdmissionLevelOfCareCode          *CE     `hl7:"40,len=250,table=0432,display=Admission Level of Care Code"`
 	PrecautionCode                    []CE    `hl7:"41,len=250,table=0433,display=Precaution Code"`
 	PatientConditionCode              *CE     `hl7:"42,len=250,table=0434,display=Patient Condition Code"`
-	LivingWillCode                    IS      `hl7:"43,len=2,table=0315,display=Living Will Code"`
-	OrganDonorCode                    IS      `hl7:"44,len=2,table=0316,display=Organ Donor Code"`
+	LivingWillCode                    IS      `hl7:"43,len=2,table=0315,datatype=IS,display=Living Will Code"`
+	OrganDonorCode                    IS      `hl7:"44,len=2,table=0316,datatype=IS,display=Organ Donor Code"`
 	AdvanceDirectiveCode              []CE    `hl7:"45,len=250,table=0435,display=Advance Directive Code"`
 	PatientStatusEffectiveDate        DT      `hl7:"46,len=8,format=YMD,display=Patient Status Effective Date"`
 	ExpectedLOAReturnDateTime         TS      `hl7:"47,conditional,len=26,format=YMDHMS,display=Expected LOA Return Date/Time"`
@@ -2310,11 +2310,11 @@ type PV2 struct {
 type QAK struct {
 	HL7                 HL7Name `hl7:",name=QAK,type=s"`
 	QueryTag            ST      `hl7:"1,conditional,len=32,display=Query Tag"`
-	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,display=Query Response Status"`
+	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,datatype=ID,display=Query Response Status"`
 	MessageQueryName    *CE     `hl7:"3,len=250,display=Message Query Name"`
-	HitCountTotal       NM      `hl7:"4,len=10,display=Hit Count Total"`
-	ThisPayload         NM      `hl7:"5,len=10,display=This payload"`
-	HitsRemaining       NM      `hl7:"6,len=10,display=Hits remaining"`
+	HitCountTotal       NM      `hl7:"4,len=10,datatype=NM,display=Hit Count Total"`
+	ThisPayload         NM      `hl7:"5,len=10,datatype=NM,display=This payload"`
+	HitsRemaining       NM      `hl7:"6,len=10,datatype=NM,display=Hits remaining"`
 }
 
 // Query Identification
@@ -2341,17 +2341,17 @@ type QPD struct {
 type QRD struct {
 	HL7                      HL7Name `hl7:",name=QRD,type=s"`
 	QueryDateTime            TS      `hl7:"1,required,len=26,format=YMDHMS,display=Query Date/Time"`
-	QueryFormatCode          ID      `hl7:"2,required,len=1,table=0106,display=Query Format Code"`
-	QueryPriority            ID      `hl7:"3,required,len=1,table=0091,display=Query Priority"`
+	QueryFormatCode          ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query Format Code"`
+	QueryPriority            ID      `hl7:"3,required,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QueryID                  ST      `hl7:"4,required,len=10,display=Query ID"`
-	DeferredResponseType     ID      `hl7:"5,len=1,table=0107,display=Deferred Response Type"`
+	DeferredResponseType     ID      `hl7:"5,len=1,table=0107,datatype=ID,display=Deferred Response Type"`
 	DeferredResponseDateTime TS      `hl7:"6,len=26,format=YMDHMS,display=Deferred Response Date/Time"`
 	QuantityLimitedRequest   CQ      `hl7:"7,required,len=10,display=Quantity Limited Request"`
 	WhoSubjectFilter         []XCN   `hl7:"8,required,len=250,display=Who Subject Filter"`
 	WhatSubjectFilter        []CE    `hl7:"9,required,len=250,table=0048,display=What Subject Filter"`
 	WhatDepartmentDataCode   []CE    `hl7:"10,required,len=250,display=What Department Data Code"`
 	WhatDataCodeValueQual    []VR    `hl7:"11,len=20,display=What Data Code Value Qual."`
-	QueryResultsLevel        ID      `hl7:"12,len=1,table=0108,display=Query Results Level"`
+	QueryResultsLevel        ID      `hl7:"12,len=1,table=0108,datatype=ID,display=Query Results Level"`
 }
 
 // Original Style Query Filter
@@ -2364,11 +2364,11 @@ type QRF struct {
 	WhenDataEndDateTime          TS      `hl7:"3,len=26,format=YMDHMS,display=When Data End Date/Time"`
 	WhatUserQualifier            []ST    `hl7:"4,len=60,display=What User Qualifier"`
 	OtherQRYSubjectFilter        []ST    `hl7:"5,len=60,display=Other QRY Subject Filter"`
-	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,display=Which Date/Time Qualifier"`
-	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,display=Which Date/Time Status Qualifier"`
-	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,display=Date/Time Selection Qualifier"`
+	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=Which Date/Time Qualifier"`
+	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=Which Date/Time Status Qualifier"`
+	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=Date/Time Selection Qualifier"`
 	WhenQuantityTimingQualifier  *TQ     `hl7:"9,len=60,display=When Quantity/Timing Qualifier"`
-	SearchConfidenceThreshold    NM      `hl7:"10,len=10,display=Search Confidence Threshold"`
+	SearchConfidenceThreshold    NM      `hl7:"10,len=10,datatype=NM,display=Search Confidence Threshold"`
 }
 
 // Query Response Instance
@@ -2377,8 +2377,8 @@ type QRF struct {
 // algorithm) and/or the match reason code (where the responding system uses rules or other match options).
 type QRI struct {
 	HL7                 HL7Name `hl7:",name=QRI,type=s"`
-	CandidateConfidence NM      `hl7:"1,len=10,display=Candidate Confidence"`
-	MatchReasonCode     []IS    `hl7:"2,len=2,table=0392,display=Match Reason Code"`
+	CandidateConfidence NM      `hl7:"1,len=10,datatype=NM,display=Candidate Confidence"`
+	MatchReasonCode     []IS    `hl7:"2,len=2,table=0392,datatype=IS,display=Match Reason Code"`
 	AlgorithmDescriptor *CE     `hl7:"3,len=250,table=0393,display=Algorithm Descriptor"`
 }
 
@@ -2387,13 +2387,13 @@ type QRI struct {
 // The RCP segment is used to restrict the amount of data that should be returned in response to query.
 type RCP struct {
 	HL7                      HL7Name `hl7:",name=RCP,type=s"`
-	QueryPriority            ID      `hl7:"1,len=1,table=0091,display=Query Priority"`
+	QueryPriority            ID      `hl7:"1,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QuantityLimitedRequest   *CQ     `hl7:"2,len=10,display=Quantity Limited Request"`
 	ResponseModality         *CE     `hl7:"3,len=250,table=0394,display=Response Modality"`
 	ExecutionAndDeliveryTime TS      `hl7:"4,len=26,format=YMDHMS,display=Execution and Delivery Time"`
-	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,display=Modify Indicator"`
+	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,datatype=ID,display=Modify Indicator"`
 	SortByField              []SRT   `hl7:"6,len=512,display=Sort-by Field"`
-	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,display=Segment group inclusion"`
+	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,datatype=ID,display=Segment group inclusion"`
 }
 
 // Table Row Definition
@@ -2401,7 +2401,7 @@ type RCP struct {
 // The RDF segment defines the content of the row data segments (RDT) in the tabular response (RTB).
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,display=Number of Columns per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,datatype=NM,display=Number of Columns per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,len=40,display=Column Description"`
 }
 
@@ -2441,8 +2441,8 @@ type RF1 struct {
 // or AIP).
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set ID - RGS"`
-	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - RGS"`
+	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceGroupID   *CE     `hl7:"3,len=250,display=Resource Group ID"`
 }
 
@@ -2463,7 +2463,7 @@ type RMI struct {
 type ROL struct {
 	HL7                  HL7Name `hl7:",name=ROL,type=s"`
 	RoleInstanceID       *EI     `hl7:"1,conditional,len=60,display=Role Instance ID"`
-	ActionCode           ID      `hl7:"2,required,len=2,table=0287,display=Action Code"`
+	ActionCode           ID      `hl7:"2,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	Role                 CE      `hl7:"3,required,len=250,table=0443,display=Role-ROL"`
 	RolePerson           []XCN   `hl7:"4,required,len=250,display=Role Person"`
 	RoleBeginDateTime    TS      `hl7:"5,len=26,format=YMDHMS,display=Role Begin Date/Time"`
@@ -2487,8 +2487,8 @@ type RQ1 struct {
 	ManufacturersCatalog   ST      `hl7:"3,conditional,len=16,display=Manufacturer's Catalog"`
 	VendorID               *CE     `hl7:"4,conditional,len=250,display=Vendor ID"`
 	VendorCatalog          ST      `hl7:"5,conditional,len=16,display=Vendor Catalog"`
-	Taxable                ID      `hl7:"6,len=1,table=0136,display=Taxable"`
-	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,display=Substitute Allowed"`
+	Taxable                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Taxable"`
+	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Substitute Allowed"`
 }
 
 // Requisition Detail
@@ -2496,14 +2496,14 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item. See assumptions above.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,datatype=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CE     `hl7:"2,conditional,len=250,display=Item Code - Internal"`
 	ItemCodeExternal         *CE     `hl7:"3,conditional,len=250,display=Item Code - External"`
 	HospitalItemCode         *CE     `hl7:"4,conditional,len=250,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,datatype=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CE     `hl7:"6,len=250,display=Requisition Unit of Measure"`
-	DeptCostCenter           IS      `hl7:"7,len=30,table=0319,display=Dept. Cost Center"`
-	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,display=Item Natural Account Code"`
+	DeptCostCenter           IS      `hl7:"7,len=30,table=0319,datatype=IS,display=Dept. Cost Center"`
+	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,datatype=IS,display=Item Natural Account Code"`
 	DeliverToID              *CE     `hl7:"9,len=250,display=Deliver To ID"`
 	DateNeeded               DT      `hl7:"10,len=8,format=YMD,display=Date Needed"`
 }
@@ -2515,27 +2515,27 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                             HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter                NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	AdministrationSubIDCounter      NM      `hl7:"2,required,len=4,display=Administration Sub-ID Counter"`
+	GiveSubIDCounter                NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	AdministrationSubIDCounter      NM      `hl7:"2,required,len=4,datatype=NM,display=Administration Sub-ID Counter"`
 	DateTimeStartOfAdministration   TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Start of Administration"`
 	DateTimeEndOfAdministration     TS      `hl7:"4,required,len=26,format=YMDHMS,display=Date/Time End of Administration"`
 	AdministeredCode                CE      `hl7:"5,required,len=250,table=0292,display=Administered Code"`
-	AdministeredAmount              NM      `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount              NM      `hl7:"6,required,len=20,datatype=NM,display=Administered Amount"`
 	AdministeredUnits               *CE     `hl7:"7,conditional,len=250,display=Administered Units"`
 	AdministeredDosageForm          *CE     `hl7:"8,len=250,display=Administered Dosage Form"`
 	AdministrationNotes             []CE    `hl7:"9,len=250,display=Administration Notes"`
 	AdministeringProvider           []XCN   `hl7:"10,len=250,display=Administering Provider"`
 	AdministeredAtLocation          *LA2    `hl7:"11,conditional,len=200,display=Administered-at Location"`
 	AdministeredPer                 ST      `hl7:"12,conditional,len=20,display=Administered Per"`
-	AdministeredStrength            NM      `hl7:"13,len=20,display=Administered Strength"`
+	AdministeredStrength            NM      `hl7:"13,len=20,datatype=NM,display=Administered Strength"`
 	AdministeredStrengthUnits       *CE     `hl7:"14,len=250,display=Administered Strength Units"`
 	SubstanceLotNumber              []ST    `hl7:"15,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate         []TS    `hl7:"16,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName       []CE    `hl7:"17,len=250,table=0227,display=Substance Manufacturer Name"`
 	SubstanceTreatmentRefusalReason []CE    `hl7:"18,len=250,display=Substance/Treatment Refusal Reason"`
 	Indication                      []CE    `hl7:"19,len=250,display=Indication"`
-	CompletionStatus                ID      `hl7:"20,len=2,table=0322,display=Completion Status"`
-	ActionCode                      ID      `hl7:"21,len=2,table=0323,display=Action Code-RXA"`
+	CompletionStatus                ID      `hl7:"20,len=2,table=0322,datatype=ID,display=Completion Status"`
+	ActionCode                      ID      `hl7:"21,len=2,table=0323,datatype=ID,display=Action Code-RXA"`
 	SystemEntryDateTime             TS      `hl7:"22,len=26,format=YMDHMS,display=System Entry Date/Time"`
 }
 
@@ -2547,11 +2547,11 @@ type RXA struct {
 // at the RXC level is identical to that for the RXO level.
 type RXC struct {
 	HL7                    HL7Name `hl7:",name=RXC,type=s"`
-	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,display=RX Component Type"`
+	RXComponentType        ID      `hl7:"1,required,len=1,table=0166,datatype=ID,display=RX Component Type"`
 	ComponentCode          CE      `hl7:"2,required,len=250,display=Component Code"`
-	ComponentAmount        NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount        NM      `hl7:"3,required,len=20,datatype=NM,display=Component Amount"`
 	ComponentUnits         CE      `hl7:"4,required,len=250,display=Component Units"`
-	ComponentStrength      NM      `hl7:"5,len=20,display=Component Strength"`
+	ComponentStrength      NM      `hl7:"5,len=20,datatype=NM,display=Component Strength"`
 	ComponentStrengthUnits *CE     `hl7:"6,len=250,display=Component Strength Units"`
 	SupplementaryCode      []CE    `hl7:"7,len=250,display=Supplementary Code"`
 }
@@ -2561,30 +2561,30 @@ type RXC struct {
 // HL7 Attribute Table - RXD - Pharmacy/Treatment Dispense
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,display=Dispense Sub-ID Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	DispenseGiveCode                                        CE      `hl7:"2,required,len=250,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       TS      `hl7:"3,required,len=26,format=YMDHMS,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,datatype=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CE     `hl7:"5,conditional,len=250,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CE     `hl7:"6,len=250,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,display=Number of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,len=200,display=Dispense Notes"`
 	DispensingProvider                                      []XCN   `hl7:"10,len=200,display=Dispensing Provider"`
-	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	TotalDailyDose                                          *CQ     `hl7:"12,len=10,display=Total Daily Dose"`
 	DispenseToLocation                                      *LA2    `hl7:"13,conditional,len=200,display=Dispense-To Location"`
-	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CE    `hl7:"15,len=250,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,len=20,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,len=20,datatype=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CE     `hl7:"17,len=250,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []TS    `hl7:"19,len=26,format=YMDHMS,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CE    `hl7:"20,len=250,table=0227,display=Substance Manufacturer Name"`
 	Indication                                              []CE    `hl7:"21,len=250,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"23,len=250,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CE    `hl7:"25,len=250,display=Supplementary Code"`
 	InitiatingLocation                                      *CE     `hl7:"26,len=250,display=Initiating Location"`
 	PackagingAssemblyLocation                               *CE     `hl7:"27,len=250,display=Packaging/Assembly Location"`
@@ -2599,57 +2599,57 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          TQ      `hl7:"1,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                CE      `hl7:"2,required,len=250,table=0292,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CE      `hl7:"5,required,len=250,display=Give Units"`
 	GiveDosageForm                                          *CE     `hl7:"6,len=250,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions                     []CE    `hl7:"7,len=250,display=Provider's Administration Instructions"`
 	DeliverToLocation                                       *LA1    `hl7:"8,conditional,len=200,display=Deliver-To Location"`
-	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,datatype=ID,display=Substitution Status"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,datatype=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CE     `hl7:"11,conditional,len=250,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,len=3,display=Number of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,len=3,datatype=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber                              []XCN   `hl7:"13,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID                  []XCN   `hl7:"14,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,display=Number of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,display=Number of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,datatype=NM,display=Number of Refills/Doses Dispensed"`
 	DTOfMostRecentRefillOrDoseDispensed                     TS      `hl7:"18,conditional,len=26,format=YMDHMS,display=D/T of Most Recent Refill or Dose Dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,len=10,display=Total Daily Dose"`
-	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CE    `hl7:"21,len=250,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
 	GivePer                                                 ST      `hl7:"22,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                          ST      `hl7:"23,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                           *CE     `hl7:"24,len=250,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,len=20,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CE     `hl7:"26,len=250,display=Give Strength Units"`
 	GiveIndication                                          []CE    `hl7:"27,len=250,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CE     `hl7:"29,len=250,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CE    `hl7:"31,len=250,display=Supplementary Code"`
 }
 
 // Pharmacy/Treatment Give
 type RXG struct {
 	HL7                                                         HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,display=Dispense Sub-ID Counter"`
+	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	QuantityTiming                                              TQ      `hl7:"3,required,len=200,display=Quantity/Timing"`
 	GiveCode                                                    CE      `hl7:"4,required,len=250,table=0292,display=Give Code"`
-	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                           NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                           NM      `hl7:"6,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                                   CE      `hl7:"7,required,len=250,display=Give Units"`
 	GiveDosageForm                                              *CE     `hl7:"8,len=250,display=Give Dosage Form"`
 	AdministrationNotes                                         []CE    `hl7:"9,len=250,display=Administration Notes"`
-	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	DispenseToLocation                                          *LA2    `hl7:"11,len=200,display=Dispense-To Location"`
-	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialAdministrationInstructions []CE    `hl7:"13,len=250,display=Pharmacy/Treatment Supplier's Special Administration Instructions"`
 	GivePer                                                     ST      `hl7:"14,conditional,len=20,display=Give Per"`
 	GiveRateAmount                                              ST      `hl7:"15,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                               *CE     `hl7:"16,len=250,display=Give Rate Units"`
-	GiveStrength                                                NM      `hl7:"17,len=20,display=Give Strength"`
+	GiveStrength                                                NM      `hl7:"17,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                           *CE     `hl7:"18,len=250,display=Give Strength Units"`
 	SubstanceLotNumber                                          []ST    `hl7:"19,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                     []TS    `hl7:"20,len=26,format=YMDHMS,display=Substance Expiration Date"`
@@ -2664,23 +2664,23 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      *CE     `hl7:"1,conditional,len=250,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,datatype=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,datatype=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     *CE     `hl7:"4,conditional,len=250,display=Requested Give Units"`
 	RequestedDosageForm                    *CE     `hl7:"5,conditional,len=250,display=Requested Dosage Form"`
 	ProvidersPharmacyTreatmentInstructions []CE    `hl7:"6,len=250,display=Provider's Pharmacy/Treatment Instructions"`
 	ProvidersAdministrationInstructions    []CE    `hl7:"7,len=250,display=Provider's Administration Instructions"`
 	DeliverToLocation                      *LA1    `hl7:"8,len=200,display=Deliver-To Location"`
-	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
+	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,datatype=ID,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CE     `hl7:"10,len=250,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,len=20,datatype=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CE     `hl7:"12,len=250,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,len=3,display=Number of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,len=3,datatype=NM,display=Number of Refills"`
 	OrderingProvidersDEANumber             []XCN   `hl7:"14,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID []XCN   `hl7:"15,conditional,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
-	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	RequestedGivePer                       ST      `hl7:"17,conditional,len=20,display=Requested Give Per"`
-	RequestedGiveStrength                  NM      `hl7:"18,len=20,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,len=20,datatype=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CE     `hl7:"19,len=250,display=Requested Give Strength Units"`
 	Indication                             []CE    `hl7:"20,len=250,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,len=6,display=Requested Give Rate Amount"`
@@ -2725,14 +2725,14 @@ type SAC struct {
 	TrayIdentifier                    *EI     `hl7:"13,len=80,display=Tray Identifier"`
 	PositionInTray                    *NA     `hl7:"14,len=80,display=Position in Tray"`
 	Location                          []CE    `hl7:"15,len=250,display=Location"`
-	ContainerHeight                   NM      `hl7:"16,len=20,display=Container Height"`
-	ContainerDiameter                 NM      `hl7:"17,len=20,display=Container Diameter"`
-	BarrierDelta                      NM      `hl7:"18,len=20,display=Barrier Delta"`
-	BottomDelta                       NM      `hl7:"19,len=20,display=Bottom Delta"`
+	ContainerHeight                   NM      `hl7:"16,len=20,datatype=NM,display=Container Height"`
+	ContainerDiameter                 NM      `hl7:"17,len=20,datatype=NM,display=Container Diameter"`
+	BarrierDelta                      NM      `hl7:"18,len=20,datatype=NM,display=Barrier Delta"`
+	BottomDelta                       NM      `hl7:"19,len=20,datatype=NM,display=Bottom Delta"`
 	ContainerHeightDiameterDeltaUnits *CE     `hl7:"20,len=250,display=Container Height/Diameter/Delta Units"`
-	ContainerVolume                   NM      `hl7:"21,len=20,display=Container Volume"`
-	AvailableVolume                   NM      `hl7:"22,len=20,display=Available Volume"`
-	InitialSpecimenVolume             NM      `hl7:"23,len=20,display=Initial Specimen Volume"`
+	ContainerVolume                   NM      `hl7:"21,len=20,datatype=NM,display=Container Volume"`
+	AvailableVolume                   NM      `hl7:"22,len=20,datatype=NM,display=Available Volume"`
+	InitialSpecimenVolume             NM      `hl7:"23,len=20,datatype=NM,display=Initial Specimen Volume"`
 	VolumeUnits                       *CE     `hl7:"24,len=250,display=Volume  Units"`
 	SeparatorType                     *CE     `hl7:"25,len=250,table=0380,display=Separator Type"`
 	CapType                           *CE     `hl7:"26,len=250,table=0381,display=Cap Type"`
@@ -2741,13 +2741,13 @@ type SAC struct {
 	DilutionFactor                    *SN     `hl7:"29,len=20,display=Dilution Factor"`
 	Treatment                         *CE     `hl7:"30,len=250,table=0373,display=Treatment"`
 	Temperature                       *SN     `hl7:"31,len=20,display=Temperature"`
-	HemolysisIndex                    NM      `hl7:"32,len=20,display=Hemolysis Index"`
+	HemolysisIndex                    NM      `hl7:"32,len=20,datatype=NM,display=Hemolysis Index"`
 	HemolysisIndexUnits               *CE     `hl7:"33,len=250,display=Hemolysis Index Units"`
-	LipemiaIndex                      NM      `hl7:"34,len=20,display=Lipemia Index"`
+	LipemiaIndex                      NM      `hl7:"34,len=20,datatype=NM,display=Lipemia Index"`
 	LipemiaIndexUnits                 *CE     `hl7:"35,len=250,display=Lipemia Index Units"`
-	IcterusIndex                      NM      `hl7:"36,len=20,display=Icterus Index"`
+	IcterusIndex                      NM      `hl7:"36,len=20,datatype=NM,display=Icterus Index"`
 	IcterusIndexUnits                 *CE     `hl7:"37,len=250,display=Icterus Index Units"`
-	FibrinIndex                       NM      `hl7:"38,len=20,display=Fibrin Index"`
+	FibrinIndex                       NM      `hl7:"38,len=20,datatype=NM,display=Fibrin Index"`
 	FibrinIndexUnits                  *CE     `hl7:"39,len=250,display=Fibrin Index Units"`
 	SystemInducedContaminants         []CE    `hl7:"40,len=250,table=0374,display=System Induced Contaminants"`
 	DrugInterference                  []CE    `hl7:"41,len=250,table=0382,display=Drug Interference"`
@@ -2763,13 +2763,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       *EI     `hl7:"1,conditional,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                *CE     `hl7:"5,len=250,display=Schedule ID"`
 	EventReason               CE      `hl7:"6,required,len=250,display=Event Reason"`
 	AppointmentReason         *CE     `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType           *CE     `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  *CE     `hl7:"10,len=250,display=Appointment Duration Units"`
 	AppointmentTimingQuantity []TQ    `hl7:"11,required,len=200,display=Appointment Timing Quantity"`
 	PlacerContactPerson       []XCN   `hl7:"12,len=250,display=Placer Contact Person"`
@@ -2812,7 +2812,7 @@ type SID struct {
 type SPR struct {
 	HL7                     HL7Name `hl7:",name=SPR,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	StoredProcedureName     CE      `hl7:"3,required,len=250,display=Stored Procedure Name"`
 	InputParameterList      []QIP   `hl7:"4,len=256,display=Input Parameter List"`
 }
@@ -2835,10 +2835,10 @@ type STF struct {
 	PrimaryKeyValue               *CE     `hl7:"1,conditional,len=250,display=Primary Key Value - STF"`
 	StaffIDCode                   []CX    `hl7:"2,len=60,display=Staff ID Code"`
 	StaffName                     []XPN   `hl7:"3,len=250,display=Staff Name"`
-	StaffType                     []IS    `hl7:"4,len=2,table=0182,display=Staff Type"`
-	AdministrativeSex             IS      `hl7:"5,len=1,table=0001,display=Administrative Sex"`
+	StaffType                     []IS    `hl7:"4,len=2,table=0182,datatype=IS,display=Staff Type"`
+	AdministrativeSex             IS      `hl7:"5,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	DateTimeOfBirth               TS      `hl7:"6,len=26,format=YMDHMS,display=Date/Time Of Birth"`
-	ActiveInactiveFlag            ID      `hl7:"7,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag            ID      `hl7:"7,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Department                    []CE    `hl7:"8,len=250,table=0184,display=Department"`
 	HospitalService               []CE    `hl7:"9,len=250,table=0069,display=Hospital Service"`
 	Phone                         []XTN   `hl7:"10,len=250,display=Phone"`
@@ -2852,15 +2852,15 @@ type STF struct {
 	JobTitle                      ST      `hl7:"18,len=20,display=Job Title"`
 	JobCodeClass                  *JCC    `hl7:"19,len=20,display=Job Code/Class"`
 	EmploymentStatusCode          *CE     `hl7:"20,len=2,table=0066,display=Employment Status Code"`
-	AdditionalInsuredOnAuto       ID      `hl7:"21,len=1,table=0136,display=Additional Insured on  Auto"`
+	AdditionalInsuredOnAuto       ID      `hl7:"21,len=1,table=0136,datatype=ID,display=Additional Insured on  Auto"`
 	DriversLicenseNumberStaff     *DLN    `hl7:"22,len=25,display=Driver's License Number - Staff"`
-	CopyAutoIns                   ID      `hl7:"23,len=1,table=0136,display=Copy  Auto Ins"`
+	CopyAutoIns                   ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Copy  Auto Ins"`
 	AutoInsExpires                DT      `hl7:"24,len=8,format=YMD,display=Auto Ins. Expires"`
 	DateLastDMVReview             DT      `hl7:"25,len=8,format=YMD,display=Date Last DMV Review"`
 	DateNextDMVReview             DT      `hl7:"26,len=8,format=YMD,display=Date Next DMV Review"`
 	Race                          *CE     `hl7:"27,len=250,table=0005,display=Race"`
 	EthnicGroup                   *CE     `hl7:"28,len=250,table=0189,display=Ethnic Group"`
-	ReActivationApprovalIndicator ID      `hl7:"29,len=1,table=0136,display=Re-activation Approval Indicator"`
+	ReActivationApprovalIndicator ID      `hl7:"29,len=1,table=0136,datatype=ID,display=Re-activation Approval Indicator"`
 }
 
 // Test Code Configuration
@@ -2876,10 +2876,10 @@ type TCC struct {
 	RerunDilutionFactorDefault            *SN     `hl7:"5,len=20,display=Rerun Dilution Factor Default"`
 	PreDilutionFactorDefault              *SN     `hl7:"6,len=20,display=Pre-Dilution Factor Default"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"7,len=20,display=Endogenous Content of Pre-Dilution Diluent"`
-	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,display=Inventory Limits Warning Level"`
-	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,display=Automatic Rerun Allowed"`
-	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,display=Automatic Repeat Allowed"`
-	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,display=Automatic Reflex Allowed"`
+	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,datatype=NM,display=Inventory Limits Warning Level"`
+	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Automatic Rerun Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Automatic Reflex Allowed"`
 	EquipmentDynamicRange                 *SN     `hl7:"12,len=20,display=Equipment Dynamic Range"`
 	Units                                 *CE     `hl7:"13,len=250,display=Units"`
 	ProcessingType                        *CE     `hl7:"14,len=250,table=0388,display=Processing Type"`
@@ -2897,8 +2897,8 @@ type TCD struct {
 	RerunDilutionFactor                   *SN     `hl7:"3,len=20,display=Rerun Dilution Factor"`
 	PreDilutionFactor                     *SN     `hl7:"4,len=20,display=Pre-Dilution Factor"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"5,len=20,display=Endogenous Content of Pre-Dilution Diluent"`
-	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,display=Automatic Repeat Allowed"`
-	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,display=Reflex Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Reflex Allowed"`
 	AnalyteRepeatStatus                   *CE     `hl7:"8,len=250,table=0389,display=Analyte Repeat Status"`
 }
 
@@ -2911,9 +2911,9 @@ type TCD struct {
 // text.
 type TXA struct {
 	HL7                             HL7Name `hl7:",name=TXA,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID- TXA"`
-	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,display=Document Type"`
-	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,display=Document Content Presentation"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID- TXA"`
+	DocumentType                    IS      `hl7:"2,required,len=30,table=0270,datatype=IS,display=Document Type"`
+	DocumentContentPresentation     ID      `hl7:"3,conditional,len=2,table=0191,datatype=ID,display=Document Content Presentation"`
 	ActivityDateTime                TS      `hl7:"4,len=26,format=YMDHMS,display=Activity Date/Time"`
 	PrimaryActivityProviderCodeName []XCN   `hl7:"5,conditional,len=250,display=Primary Activity Provider Code/Name"`
 	OriginationDateTime             TS      `hl7:"6,len=26,format=YMDHMS,display=Origination Date/Time"`
@@ -2927,10 +2927,10 @@ type TXA struct {
 	PlacerOrderNumber               []EI    `hl7:"14,len=22,display=Placer Order Number"`
 	FillerOrderNumber               *EI     `hl7:"15,len=22,display=Filler Order Number"`
 	UniqueDocumentFileName          ST      `hl7:"16,len=30,display=Unique Document File Name"`
-	DocumentCompletionStatus        ID      `hl7:"17,required,len=2,table=0271,display=Document Completion Status"`
-	DocumentConfidentialityStatus   ID      `hl7:"18,len=2,table=0272,display=Document Confidentiality Status"`
-	DocumentAvailabilityStatus      ID      `hl7:"19,len=2,table=0273,display=Document Availability Status"`
-	DocumentStorageStatus           ID      `hl7:"20,len=2,table=0275,display=Document Storage Status"`
+	DocumentCompletionStatus        ID      `hl7:"17,required,len=2,table=0271,datatype=ID,display=Document Completion Status"`
+	DocumentConfidentialityStatus   ID      `hl7:"18,len=2,table=0272,datatype=ID,display=Document Confidentiality Status"`
+	DocumentAvailabilityStatus      ID      `hl7:"19,len=2,table=0273,datatype=ID,display=Document Availability Status"`
+	DocumentStorageStatus           ID      `hl7:"20,len=2,table=0275,datatype=ID,display=Document Storage Status"`
 	DocumentChangeReason            ST      `hl7:"21,conditional,len=30,display=Document Change Reason"`
 	AuthenticationPersonTimeStamp   []PPN   `hl7:"22,conditional,len=250,display=Authentication Person- Time Stamp"`
 	DistributedCopies               []XCN   `hl7:"23,len=250,display=Distributed Copies"`
@@ -2944,17 +2944,17 @@ type TXA struct {
 // Refer to a UB specification for additional information.
 type UB1 struct {
 	HL7                       HL7Name `hl7:",name=UB1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - UB1"`
-	BloodDeductible43         NM      `hl7:"2,len=1,display=Blood Deductible (43)"`
-	BloodFurnishedPintsOf40   NM      `hl7:"3,len=2,display=Blood Furnished-Pints Of (40)"`
-	BloodReplacedPints41      NM      `hl7:"4,len=2,display=Blood Replaced-Pints (41)"`
-	BloodNotReplacedPints42   NM      `hl7:"5,len=2,display=Blood Not Replaced-Pints (42)"`
-	CoInsuranceDays25         NM      `hl7:"6,len=2,display=Co-Insurance Days (25)"`
-	ConditionCode3539         []IS    `hl7:"7,max=5,len=14,table=0043,display=Condition Code (35-39)"`
-	CoveredDays23             NM      `hl7:"8,len=3,display=Covered Days (23)"`
-	NonCoveredDays24          NM      `hl7:"9,len=3,display=Non Covered Days (24)"`
+	SetID                     SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB1"`
+	BloodDeductible43         NM      `hl7:"2,len=1,datatype=NM,display=Blood Deductible (43)"`
+	BloodFurnishedPintsOf40   NM      `hl7:"3,len=2,datatype=NM,display=Blood Furnished-Pints Of (40)"`
+	BloodReplacedPints41      NM      `hl7:"4,len=2,datatype=NM,display=Blood Replaced-Pints (41)"`
+	BloodNotReplacedPints42   NM      `hl7:"5,len=2,datatype=NM,display=Blood Not Replaced-Pints (42)"`
+	CoInsuranceDays25         NM      `hl7:"6,len=2,datatype=NM,display=Co-Insurance Days (25)"`
+	ConditionCode3539         []IS    `hl7:"7,max=5,len=14,table=0043,datatype=IS,display=Condition Code (35-39)"`
+	CoveredDays23             NM      `hl7:"8,len=3,datatype=NM,display=Covered Days (23)"`
+	NonCoveredDays24          NM      `hl7:"9,len=3,datatype=NM,display=Non Covered Days (24)"`
 	ValueAmountCode4649       []UVC   `hl7:"10,max=8,len=12,display=Value Amount & Code (46-49)"`
-	NumberOfGraceDays90       NM      `hl7:"11,len=2,display=Number Of Grace Days (90)"`
+	NumberOfGraceDays90       NM      `hl7:"11,len=2,datatype=NM,display=Number Of Grace Days (90)"`
 	SpecialProgramIndicator44 *CE     `hl7:"12,len=250,table=0348,display=Special Program Indicator (44)"`
 	PSROURApprovalIndicator87 *CE     `hl7:"13,len=250,table=0349,display=PSRO/UR Approval Indicator (87)"`
 	PSROURApprovedStayFm88    DT      `hl7:"14,len=8,format=YMD,display=PSRO/UR Approved Stay-Fm (88)"`
@@ -2980,9 +2980,9 @@ type UB1 struct {
 // The Uniform Billing segments are specific to the US and may not be implemented in non-US systems.
 type UB2 struct {
 	HL7                       HL7Name `hl7:",name=UB2,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set ID - UB2"`
+	SetID                     SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB2"`
 	CoInsuranceDays9          ST      `hl7:"2,len=3,display=Co-Insurance Days (9)"`
-	ConditionCode2430         []IS    `hl7:"3,max=7,len=2,table=0043,display=Condition Code (24-30)"`
+	ConditionCode2430         []IS    `hl7:"3,max=7,len=2,table=0043,datatype=IS,display=Condition Code (24-30)"`
 	CoveredDays7              ST      `hl7:"4,len=3,display=Covered Days (7)"`
 	NonCoveredDays8           ST      `hl7:"5,len=4,display=Non-Covered Days (8)"`
 	ValueAmountCode3941       []UVC   `hl7:"6,max=12,len=11,display=Value Amount & Code (39-41)"`
@@ -2996,7 +2996,7 @@ type UB2 struct {
 	UB92Locator56State        []ST    `hl7:"14,max=5,len=14,display=UB92 Locator 56 (state)"`
 	UB92Locator57National     ST      `hl7:"15,len=27,display=UB92 Locator 57 (national)"`
 	UB92Locator78State        []ST    `hl7:"16,max=2,len=2,display=UB92 Locator 78 (state)"`
-	SpecialVisitCount         NM      `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount         NM      `hl7:"17,len=3,datatype=NM,display=Special Visit Count"`
 }
 
 // Results/update Definition
@@ -3006,12 +3006,12 @@ type UB2 struct {
 type URD struct {
 	HL7                     HL7Name `hl7:",name=URD,type=s"`
 	RUDateTime              TS      `hl7:"1,len=26,format=YMDHMS,display=R/U Date/Time"`
-	ReportPriority          ID      `hl7:"2,len=1,table=0109,display=Report Priority"`
+	ReportPriority          ID      `hl7:"2,len=1,table=0109,datatype=ID,display=Report Priority"`
 	RUWhoSubjectDefinition  []XCN   `hl7:"3,required,len=250,display=R/U Who Subject Definition"`
 	RUWhatSubjectDefinition []CE    `hl7:"4,len=250,table=0048,display=R/U What Subject Definition"`
 	RUWhatDepartmentCode    []CE    `hl7:"5,len=250,display=R/U What Department Code"`
 	RUDisplayPrintLocations []ST    `hl7:"6,len=20,display=R/U Display/Print Locations"`
-	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,display=R/U Results Level"`
+	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,datatype=ID,display=R/U Results Level"`
 }
 
 // Unsolicited Selection
@@ -3025,9 +3025,9 @@ type URS struct {
 	RUWhenDataEndDateTime           TS      `hl7:"3,len=26,format=YMDHMS,display=R/U When Data End Date/Time"`
 	RUWhatUserQualifier             []ST    `hl7:"4,len=20,display=R/U What User Qualifier"`
 	RUOtherResultsSubjectDefinition []ST    `hl7:"5,len=20,display=R/U Other Results Subject Definition"`
-	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,display=R/U Which Date/Time Qualifier"`
-	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,display=R/U Which Date/Time Status Qualifier"`
-	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,display=R/U Date/Time Selection Qualifier"`
+	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=R/U Which Date/Time Qualifier"`
+	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=R/U Which Date/Time Status Qualifier"`
+	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=R/U Date/Time Selection Qualifier"`
 	RUQuantityTimingQualifier       *TQ     `hl7:"9,len=60,display=R/U Quantity/Timing Qualifier"`
 }
 
@@ -3054,7 +3054,7 @@ type VAR struct {
 type VTQ struct {
 	HL7                     HL7Name `hl7:",name=VTQ,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	VTQueryName             CE      `hl7:"3,required,len=250,display=VT Query Name"`
 	VirtualTableName        CE      `hl7:"4,required,len=250,display=Virtual Table Name"`
 	SelectionCriteria       []QSC   `hl7:"5,len=256,display=Selection Criteria"`
@@ -14,8 +14,8 @@ type AD struct {
 	City                       ST      `hl7:"3,len=50,display=This component specifies the city- district or place where the addressee is located depending upon the national convention for formatting addresses for postal usage."`
 	StateOrProvince            ST      `hl7:"4,len=50,display=This component specifies the state or province where the addressee is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,len=12,display=This component specifies the zip or postal code where the addressee is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9 and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"6,len=3,table=0399,display=This component specifies the country where the addressee is located. HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country Code in section 2.15.9.17 for valid values."`
-	AddressType                ID      `hl7:"7,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
+	Country                    ID      `hl7:"6,len=3,table=0399,datatype=ID,display=This component specifies the country where the addressee is located. HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country Code in section 2.15.9.17 for valid values."`
+	AddressType                ID      `hl7:"7,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
 	OtherGeographicDesignation ST      `hl7:"8,len=50,display=This component specifies any other geographic designation that may be necessary. It includes county- bioregion- SMSA- etc."`
 }
 
@@ -34,7 +34,7 @@ type AUI struct {
 // Specifies whether a charge action is based on an invocation event or is time-based.
 type CCD struct {
 	HL7             HL7Name `hl7:",name=CCD,len=28,type=d"`
-	InvocationEvent ID      `hl7:"1,required,len=1,table=0100,display=Specifies the code for the event precipitating/triggering the charge activity. Refer to HL7 Table 0100 - Invocation event for valid values."`
+	InvocationEvent ID      `hl7:"1,required,len=1,table=0100,datatype=ID,display=Specifies the code for the event precipitating/triggering the charge activity. Refer to HL7 Table 0100 - Invocation event for valid values."`
 	DateTime        TS      `hl7:"2,len=26,format=YMDHMS,display=The second component is used to express the exact time to charge for the ordered service; it is used only when the CCD.1 value is T. When used- it is expressed as a TS data type."`
 }
 
@@ -45,10 +45,10 @@ type CE struct {
 	HL7                         HL7Name `hl7:",name=CE,len=483,type=d"`
 	Identifier                  ST      `hl7:"1,len=20,display=Sequence of characters (the code) that uniquely identifies the item being referenced. Different coding schemes will have different elements here."`
 	Text                        ST      `hl7:"2,len=199,display=The descriptive or textual name of the identifier- e.g.- myocardial infarction or X-ray impression."`
-	NameOfCodingSystem          ID      `hl7:"3,len=20,table=0396,display=Identifies the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
+	NameOfCodingSystem          ID      `hl7:"3,len=20,table=0396,datatype=ID,display=Identifies the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
 	AlternateIdentifier         ST      `hl7:"4,len=20,display=An alternate sequence of characters (the code) that uniquely identifies the item being referenced. See usage note in section introduction."`
 	AlternateText               ST      `hl7:"5,len=199,display=The descriptive or textual name of the alternate identifier. See usage note in section introduction."`
-	NameOfAlternateCodingSystem ID      `hl7:"6,len=20,table=0396,display=Identifies the coding scheme being used in the alternate identifier component."`
+	NameOfAlternateCodingSystem ID      `hl7:"6,len=20,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component."`
 }
 
 // Coded with No Exceptions
@@ -60,10 +60,10 @@ type CNE struct {
 	HL7                            HL7Name `hl7:",name=CNE,len=705,type=d"`
 	Identifier                     ST      `hl7:"1,required,len=20,display=Sequence of characters (the code) that uniquely identifies the item being referenced by the CNE.2. Different coding schemes will have different elements here."`
 	Text                           ST      `hl7:"2,len=199,display=The descriptive or textual name of the identifier- e.g.- myocardial infarction or X-ray impression. Its data type is string (ST). This is the corresponding text assigned by the coding system to the identifier."`
-	NameOfCodingSystem             ID      `hl7:"3,len=20,table=0396,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
+	NameOfCodingSystem             ID      `hl7:"3,len=20,table=0396,datatype=ID,display=Each coding system is assigned a unique identifier. This component will serve to identify the coding scheme being used in the identifier component. The combination of the identifier and name of coding system components will be a unique code for a data item. Each system has a unique identifier."`
 	AlternateIdentifier            ST      `hl7:"4,len=20,display=Analogous to Identifier in component 1."`
 	AlternateText                  ST      `hl7:"5,len=199,display=The descriptive or textual name of the alternate identifier. Analogous to 'Text' in component 2. See usage notes in section introduction for further description."`
-	NameOfAlternateCodingSystem    ID      `hl7:"6,len=20,table=0396,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to Name of Coding System in component 3. Refer to HL7 Table 0396 in section 2.17.5 for valid values."`
+	NameOfAlternateCodingSystem    ID      `hl7:"6,len=20,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to Name of Coding System in component 3. Refer to HL7 Table 0396 in section 2.17.5 for valid values."`
 	CodingSystemVersionID          ST      `hl7:"7,conditional,len=10,display=the version ID for the coding system identified by component 3. It belongs conceptually to components 1-3 and appears here only for reasons of backward compatibility."`
 	AlternateCodingSystemVersionID ST      `hl7:"8,len=10,display=the version ID for the coding system identified by component -6. It belongs conceptually to the group of Alternate components (see note 2.A.6.6) and appears here only for reasons of backward compatibility."`
 	OriginalText                   ST      `hl7:"9,len=199,display=The original text that was available to an automated process or a human before a specific code was assigned."`
@@ -80,22 +80,22 @@ type CNN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,len=30,display=Second And Further Given Names Or Initials Thereof"`
 	Suffix                                      ST      `hl7:"5,len=20,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,len=20,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,len=5,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
-	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,display=Refer to User-defined Table 0297 - CN ID source for suggested values. Used to delineate the first component. If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued. "`
-	AssigningAuthorityNamespaceID               IS      `hl7:"9,conditional,len=20,table=0363,display= Assigning Authority is normally expressed as an HD data type- but has been flattened to 3 components here (CNS.9- CNS.10 and CNS.11) in this data type so that it may be fully expressed. Also note that if additional components are added to the HD data type in the future- adjustment will need to be made accordingly to this data type.   If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued."`
+	Degree                                      IS      `hl7:"7,len=5,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 - Degree for suggested values."`
+	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,datatype=IS,display=Refer to User-defined Table 0297 - CN ID source for suggested values. Used to delineate the first component. If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued. "`
+	AssigningAuthorityNamespaceID               IS      `hl7:"9,conditional,len=20,table=0363,datatype=IS,display= Assigning Authority is normally expressed as an HD data type- but has been flattened to 3 components here (CNS.9- CNS.10 and CNS.11) in this data type so that it may be fully expressed. Also note that if additional components are added to the HD data type in the future- adjustment will need to be made accordingly to this data type.   If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued."`
 	AssigningAuthorityUniversalID               ST      `hl7:"10,conditional,len=199,display=If CNN.11 is valued- this component must be valued. If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued. "`
-	AssigningAuthorityUniversalIDType           ID      `hl7:"11,conditional,len=6,table=0301,display=If this component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values.  If CNN.10 is valued- this component must be valued. If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued. "`
+	AssigningAuthorityUniversalIDType           ID      `hl7:"11,conditional,len=6,table=0301,datatype=ID,display=If this component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values.  If CNN.10 is valued- this component must be valued. If component 1 is valued- either component 8- or 9- or both 10 and 11- must be valued. "`
 }
 
 // Composite Price
 type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=543,type=d"`
 	Price      MO      `hl7:"1,required,len=20,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.A.41- 'MO - money') is a subcomponent here."`
-	PriceType  ID      `hl7:"2,len=2,table=0205,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
-	FromValue  NM      `hl7:"3,len=16,display=Each is a NM data type; together they specify the 'range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
-	ToValue    NM      `hl7:"4,len=16,display=See <from value> above."`
+	PriceType  ID      `hl7:"2,len=2,table=0205,datatype=ID,display=A coded value- data type ID. Refer to HL7 Table 0205 - Price type for valid values."`
+	FromValue  NM      `hl7:"3,len=16,datatype=NM,display=Each is a NM data type; together they specify the 'range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price."`
+	ToValue    NM      `hl7:"4,len=16,datatype=NM,display=See <from value> above."`
 	RangeUnits *CE     `hl7:"5,len=483,display=A coded value- data type CE- defined by the standard table of units for either time or quantity (see for example- the tables in Section 7.1.4- 'Coding schemes'). This describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count); it is required if <from value> and <to value> are present."`
-	RangeType  ID      `hl7:"6,len=1,table=0298,display=Range Type"`
+	RangeType  ID      `hl7:"6,len=1,table=0298,datatype=ID,display=Range Type"`
 }
 
 // Composite Quantity with Units
@@ -103,7 +103,7 @@ type CP struct {
 // Note: CQ cannot be legally expressed when embedded within another data type. Its use is constrained to a segment field.
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=500,type=d"`
-	Quantity NM      `hl7:"1,len=16,display=This component specifies the numeric quantity or amount of an entity."`
+	Quantity NM      `hl7:"1,len=16,datatype=NM,display=This component specifies the numeric quantity or amount of an entity."`
 	Units    *CE     `hl7:"2,len=483,display=This component species the units in which the quantity is expressed. Field-by-field- default units may be defined within the specifications. When the quantity is measured in the default units- the units need not be transmitted. If the quantity is recorded in units different from the default- the units must be transmitted."`
 }
 
@@ -116,10 +116,10 @@ type CWE struct {
 	HL7                            HL7Name `hl7:",name=CWE,len=705,type=d"`
 	Identifier                     ST      `hl7:"1,len=20,display=Sequence of characters (the code) that uniquely identifies the item being referenced. Different coding schemes will have different elements here."`
 	Text                           ST      `hl7:"2,len=199,display=The descriptive or textual name of the identifier- e.g.- myocardial infarction or X-ray impression."`
-	NameOfCodingSystem             ID      `hl7:"3,len=20,table=0396,display=Identifies the coding scheme being used in the identifier component."`
+	NameOfCodingSystem             ID      `hl7:"3,len=20,table=0396,datatype=ID,display=Identifies the coding scheme being used in the identifier component."`
 	AlternateIdentifier            ST      `hl7:"4,len=20,display=An alternate sequence of characters (the code) that uniquely identifies the item being referenced. Analogous to 'Identifier' in component 1. See usage note in section introduction."`
 	AlternateText                  ST      `hl7:"5,len=199,display=The descriptive or textual name of the alternate identifier. Analogous to 'Text' in component 2. See usage note in section introduction."`
-	NameOfAlternateCodingSystem    ID      `hl7:"6,len=20,table=0396,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to Name of Coding System above. See usage note in section introduction."`
+	NameOfAlternateCodingSystem    ID      `hl7:"6,len=20,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to Name of Coding System above. See usage note in section introduction."`
 	CodingSystemVersionID          ST      `hl7:"7,conditional,len=10,display=This is the version ID for the coding system identified by components 1-3. It belongs conceptually to the group of component 1-3 and appears here only for reasons of backward compatibility."`
 	AlternateCodingSystemVersionID ST      `hl7:"8,len=10,display=This is the version ID for the coding system identified by components 4-6. It belongs conceptually to the group of alternate components (See usage note in section introduction) and appears here only for reasons of backward compatibility."`
 	OriginalText                   ST      `hl7:"9,len=199,display=The original text that was available to an automated process or a human before a specific code was assigned."`
@@ -132,9 +132,9 @@ type CX struct {
 	HL7                         HL7Name `hl7:",name=CX,len=1913,type=d"`
 	IDNumber                    ST      `hl7:"1,required,len=15,display=The value of the identifier itself."`
 	CheckDigit                  ST      `hl7:"2,len=1,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CheckDigitScheme            ID      `hl7:"3,len=3,table=0061,display=Contains the code identifying the check digit scheme employed."`
+	CheckDigitScheme            ID      `hl7:"3,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed."`
 	AssigningAuthority          *HD     `hl7:"4,len=227,table=0363,display=The assigning authority is a unique name of the system (or organization or agency or department) that creates the data. . Refer to User-defined Table 0363 - Assigning authority for suggested values."`
-	IdentifierTypeCode          ID      `hl7:"5,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
+	IdentifierTypeCode          ID      `hl7:"5,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the Assigning authority component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility           *HD     `hl7:"6,len=227,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 	EffectiveDate               DT      `hl7:"7,len=8,format=YMD,display=The first date- if known- on which the identifier is valid and active."`
 	ExpirationDate              DT      `hl7:"8,len=8,format=YMD,display=The last date- if known- on which the identifier is valid and active."`
@@ -147,9 +147,9 @@ type CX struct {
 // This data type specifies the detail information for the daily deductible.
 type DDI struct {
 	HL7            HL7Name `hl7:",name=DDI,len=25,type=d"`
-	DelayDays      NM      `hl7:"1,len=3,display=The number of days after which the daily deductible begins"`
+	DelayDays      NM      `hl7:"1,len=3,datatype=NM,display=The number of days after which the daily deductible begins"`
 	MonetaryAmount MO      `hl7:"2,required,len=16,display=The monetary amount of the deductible"`
-	NumberOfDays   NM      `hl7:"3,len=4,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
+	NumberOfDays   NM      `hl7:"3,len=4,datatype=NM,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
 }
 
 // Date and Institution Name
@@ -166,7 +166,7 @@ type DIN struct {
 // Specifies the healthcare facility to which the patient was discharged and the date.
 type DLD struct {
 	HL7               HL7Name `hl7:",name=DLD,len=47,type=d"`
-	DischargeLocation IS      `hl7:"1,required,len=20,table=0113,display=Specifies the healthcare facility to which the patient was discharged. Refer to User-defined Table 0113 - Discharged to location for suggested values."`
+	DischargeLocation IS      `hl7:"1,required,len=20,table=0113,datatype=IS,display=Specifies the healthcare facility to which the patient was discharged. Refer to User-defined Table 0113 - Discharged to location for suggested values."`
 	EffectiveDate     TS      `hl7:"2,len=26,format=YMDHMS,display=Specifies the date on which the patient was discharged to a healthcare facility."`
 }
 
@@ -177,7 +177,7 @@ type DLD struct {
 type DLN struct {
 	HL7                         HL7Name `hl7:",name=DLN,len=66,type=d"`
 	LicenseNumber               ST      `hl7:"1,required,len=20,display=This field contains the drivers license number."`
-	IssuingStateProvinceCountry IS      `hl7:"2,len=20,table=0333,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code. User-defined Table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
+	IssuingStateProvinceCountry IS      `hl7:"2,len=20,table=0333,datatype=IS,display=Issuing authority for drivers license. For state or province refer to official postal codes for that country; for country refer to ISO 3166 for codes. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code. User-defined Table 0333 - Drivers license issuing authority is used as the HL7 identifier for the user-defined table of values for this component."`
 	ExpirationDate              DT      `hl7:"3,len=24,format=YMD,display=Expiration date (DT) for drivers license."`
 }
 
@@ -187,9 +187,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	NormalRange       *NR     `hl7:"1,len=33,display=Specifies the normal interval of the reference data"`
-	NumericThreshold  NM      `hl7:"2,len=4,display=The numeric threshold of the change that is detected."`
-	ChangeComputation ID      `hl7:"3,len=1,table=0523,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation type for valid values."`
-	DaysRetained      NM      `hl7:"4,len=4,display=The length of time in days that the value is retained for computing delta checks."`
+	NumericThreshold  NM      `hl7:"2,len=4,datatype=NM,display=The numeric threshold of the change that is detected."`
+	ChangeComputation ID      `hl7:"3,len=1,table=0523,datatype=ID,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation type for valid values."`
+	DaysRetained      NM      `hl7:"4,len=4,datatype=NM,display=The length of time in days that the value is retained for computing delta checks."`
 }
 
 // Date/Time Range
@@ -221,8 +221,8 @@ type DTM = time.Time
 // This data type specifies the type and number of days for which a certification is valid.
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=6,type=d"`
-	DayType      IS      `hl7:"1,required,len=2,table=0149,display=Specifies whether the days are denied- pending- or approved."`
-	NumberOfDays NM      `hl7:"2,required,len=3,display=Specifies the number of days for which the certification is valid."`
+	DayType      IS      `hl7:"1,required,len=2,table=0149,datatype=IS,display=Specifies whether the days are denied- pending- or approved."`
+	NumberOfDays NM      `hl7:"2,required,len=3,datatype=NM,display=Specifies the number of days for which the certification is valid."`
 }
 
 // Encapsulated Data
@@ -234,9 +234,9 @@ type DTN struct {
 type ED struct {
 	HL7               HL7Name `hl7:",name=ED,len=65536,type=d"`
 	SourceApplication *HD     `hl7:"1,len=227,display=A unique name that identifies the system which was the source of the data. Identical format and restrictions as in reference pointer (see Section 2.A.65.2- 'Application ID (HD)')."`
-	TypeOfData        ID      `hl7:"2,required,len=9,table=0191,display=Identical to type of data component in the reference pointer (RP) data type. See Section 2.A.65.3- 'Type of Data (ID)'."`
-	DataSubtype       ID      `hl7:"3,len=18,table=0291,display=Identical to subtype component in the reference pointer (RP) data type. See Section 2.A.65.4- 'Subtype (ID)'."`
-	Encoding          ID      `hl7:"4,required,len=6,table=0299,display=The type of encoding used to represent successive octets of binary data as displayable ASCII characters. Refer to HL7 Table 0299 - Encoding for valid values."`
+	TypeOfData        ID      `hl7:"2,required,len=9,table=0191,datatype=ID,display=Identical to type of data component in the reference pointer (RP) data type. See Section 2.A.65.3- 'Type of Data (ID)'."`
+	DataSubtype       ID      `hl7:"3,len=18,table=0291,datatype=ID,display=Identical to subtype component in the reference pointer (RP) data type. See Section 2.A.65.4- 'Subtype (ID)'."`
+	Encoding          ID      `hl7:"4,required,len=6,table=0299,datatype=ID,display=The type of encoding used to represent successive octets of binary data as displayable ASCII characters. Refer to HL7 Table 0299 - Encoding for valid values."`
 	Data              TX      `hl7:"5,required,len=65536,display=Displayable ASCII characters which constitute the data to be sent from source application to destination application. The characters are limited to the legal characters of the ST data type- as defined in Section 2.A.74- ' ST - string data -' and- if encoded binary- are encoded according to the method of Section 2.A.24.2- 'Type of Data (ID)'."`
 }
 
@@ -246,9 +246,9 @@ type ED struct {
 type EI struct {
 	HL7              HL7Name `hl7:",name=EI,len=427,type=d"`
 	EntityIdentifier ST      `hl7:"1,len=199,display=The first component- <entity identifier>- is usually defined to be unique within the series of identifiers created by the <assigning authority>- defined by a hierarchic designator- represented by components 2 through 4. See Section 2.A.33- 'HD - hierarchic designator'."`
-	NamespaceID      IS      `hl7:"2,len=20,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Refer to User-defined Table 0363 - Assigning authority for suggested values."`
+	NamespaceID      IS      `hl7:"2,len=20,table=0363,datatype=IS,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Refer to User-defined Table 0363 - Assigning authority for suggested values."`
 	UniversalID      ST      `hl7:"3,conditional,len=199,display=Universal Id"`
-	UniversalIDType  ID      `hl7:"4,conditional,len=6,table=0301,display=Universal Id Type"`
+	UniversalIDType  ID      `hl7:"4,conditional,len=6,table=0301,datatype=ID,display=Universal Id Type"`
 }
 
 // Entity Identifier Pair
@@ -267,8 +267,8 @@ type EIP struct {
 type ELD struct {
 	HL7                  HL7Name `hl7:",name=ELD,len=493,type=d"`
 	SegmentID            ST      `hl7:"1,len=3,display=The segment containing the error in another message"`
-	SegmentSequence      NM      `hl7:"2,len=2,display=Specifies the specific occurrence if the segment specified in component 1 occurs more than once in the message."`
-	FieldPosition        NM      `hl7:"3,len=2,display=Ordinal position of the data field within the segment. For systems that do not use the HL7 Encoding Rules- the data item number may be used for the third component."`
+	SegmentSequence      NM      `hl7:"2,len=2,datatype=NM,display=Specifies the specific occurrence if the segment specified in component 1 occurs more than once in the message."`
+	FieldPosition        NM      `hl7:"3,len=2,datatype=NM,display=Ordinal position of the data field within the segment. For systems that do not use the HL7 Encoding Rules- the data item number may be used for the third component."`
 	CodeIdentifyingError *CE     `hl7:"4,len=483,table=0357,display=A code that describes the nature of the error. Refer to HL7 Table 0357 - Message error condition codes for valid values."`
 }
 
@@ -278,17 +278,17 @@ type ELD struct {
 type ERL struct {
 	HL7                HL7Name `hl7:",name=ERL,len=18,type=d"`
 	SegmentID          ST      `hl7:"1,required,len=3,display=Specifies the 3-letter name for the segment."`
-	SegmentSequence    NM      `hl7:"2,required,len=2,display=Identifies the segment occurrence within the message."`
-	FieldPosition      NM      `hl7:"3,len=2,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
-	FieldRepetition    NM      `hl7:"4,len=2,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
-	ComponentNumber    NM      `hl7:"5,len=2,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
-	SubComponentNumber NM      `hl7:"6,len=2,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
+	SegmentSequence    NM      `hl7:"2,required,len=2,datatype=NM,display=Identifies the segment occurrence within the message."`
+	FieldPosition      NM      `hl7:"3,len=2,datatype=NM,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
+	FieldRepetition    NM      `hl7:"4,len=2,datatype=NM,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
+	ComponentNumber    NM      `hl7:"5,len=2,datatype=NM,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
+	SubComponentNumber NM      `hl7:"6,len=2,datatype=NM,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
 }
 
 // Financial Class
 type FC struct {
 	HL7                HL7Name `hl7:",name=FC,len=47,type=d"`
-	FinancialClassCode IS      `hl7:"1,required,len=20,table=0064,display=This component contains the financial class assigned to a person. User-defined Table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
+	FinancialClassCode IS      `hl7:"1,required,len=20,table=0064,datatype=IS,display=This component contains the financial class assigned to a person. User-defined Table 0064 - Financial class is used as the HL7 identifier for the user-defined table of values for this component."`
 	EffectiveDate      TS      `hl7:"2,len=26,format=YMDHMS,display=This component contains the effective date/time of the persons assignment to the financial class specified in the first component."`
 }
 
@@ -324,9 +324,9 @@ type GTS = string
 // identifiers or drivers license numbers, or a facility where such identifiers are assigned.
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=227,type=d"`
-	NamespaceID     IS      `hl7:"1,len=20,table=0300,display=Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
+	NamespaceID     IS      `hl7:"1,len=20,table=0300,datatype=IS,display=Namespace ID is used as the HL7 identifier for the user-defined table of values for this component."`
 	UniversalID     ST      `hl7:"2,conditional,len=199,display=The HDs second component- <universal ID> (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type). The UID is intended to be unique over time within the UID type. It is rigorously defined. Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type). The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType ID      `hl7:"3,conditional,len=6,table=0301,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
+	UniversalIDType ID      `hl7:"3,conditional,len=6,table=0301,datatype=ID,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
 }
 
 // Insurance Certification Definition
@@ -335,8 +335,8 @@ type HD struct {
 // for obtaining the certification.
 type ICD struct {
 	HL7                           HL7Name `hl7:",name=ICD,len=40,type=d"`
-	CertificationPatientType      IS      `hl7:"1,len=11,table=0150,display=Specifies the category or type of patient for which this certification is requested. Refer to User-defined Table 0150 - Certification patient type for suggested values."`
-	CertificationRequired         ID      `hl7:"2,required,len=1,table=0136,display=Specifies whether or not a certification is required. Refer to HL7 table 0136 - Yes/no indicator for valid values."`
+	CertificationPatientType      IS      `hl7:"1,len=11,table=0150,datatype=IS,display=Specifies the category or type of patient for which this certification is requested. Refer to User-defined Table 0150 - Certification patient type for suggested values."`
+	CertificationRequired         ID      `hl7:"2,required,len=1,table=0136,datatype=ID,display=Specifies whether or not a certification is required. Refer to HL7 table 0136 - Yes/no indicator for valid values."`
 	DateTimeCertificationRequired TS      `hl7:"3,len=26,format=YMDHMS,display=The date/time by which the certification must be obtained."`
 }
 
@@ -360,8 +360,8 @@ type IS = string
 // Job Code/Class
 type JCC struct {
 	HL7                HL7Name `hl7:",name=JCC,len=292,type=d"`
-	JobCode            IS      `hl7:"1,len=20,table=0327,display=This component contains the persons job code. User-defined Table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
-	JobClass           IS      `hl7:"2,len=20,table=0328,display=This component contains the persons employee classification. Refer to User-defined Table 0328 - Employee classification for suggested values."`
+	JobCode            IS      `hl7:"1,len=20,table=0327,datatype=IS,display=This component contains the persons job code. User-defined Table 0327 - Job code is used as the HL7 identifier for the user-defined table of values for this component."`
+	JobClass           IS      `hl7:"2,len=20,table=0328,datatype=IS,display=This component contains the persons employee classification. Refer to User-defined Table 0328 - Employee classification for suggested values."`
 	JobDescriptionText TX      `hl7:"3,len=250,display=This component contains the text of the job description. This will accommodate systems where job descriptions are not codified."`
 }
 
@@ -370,14 +370,14 @@ type JCC struct {
 // Specifies a location and its address.
 type LA1 struct {
 	HL7                 HL7Name `hl7:",name=LA1,len=790,type=d"`
-	PointOfCare         IS      `hl7:"1,len=20,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on person location type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
-	Room                IS      `hl7:"2,len=20,table=0303,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                 IS      `hl7:"3,len=20,table=0304,display=This component specifies the code for the patient bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare         IS      `hl7:"1,len=20,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on person location type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
+	Room                IS      `hl7:"2,len=20,table=0303,datatype=IS,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                 IS      `hl7:"3,len=20,table=0304,datatype=IS,display=This component specifies the code for the patient bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility            *HD     `hl7:"4,len=227,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation."`
-	LocationStatus      IS      `hl7:"5,len=20,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
-	PatientLocationType IS      `hl7:"6,len=20,table=0305,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
-	Building            IS      `hl7:"7,len=20,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor               IS      `hl7:"8,len=20,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus      IS      `hl7:"5,len=20,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
+	PatientLocationType IS      `hl7:"6,len=20,table=0305,datatype=IS,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
+	Building            IS      `hl7:"7,len=20,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor               IS      `hl7:"8,len=20,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	Address             *AD     `hl7:"9,len=415,display=This component describes the location in free text."`
 }
 
@@ -386,21 +386,21 @@ type LA1 struct {
 // Specifies a location and its address.
 type LA2 struct {
 	HL7                        HL7Name `hl7:",name=LA2,len=790,type=d"`
-	PointOfCare                IS      `hl7:"1,len=20,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on LA2. 6 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
-	Room                       IS      `hl7:"2,len=20,table=0303,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                        IS      `hl7:"3,len=20,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare                IS      `hl7:"1,len=20,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on LA2. 6 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
+	Room                       IS      `hl7:"2,len=20,table=0303,datatype=IS,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                        IS      `hl7:"3,len=20,table=0304,datatype=IS,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility                   *HD     `hl7:"4,len=227,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation."`
-	LocationStatus             IS      `hl7:"5,len=20,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
-	PatientLocationType        IS      `hl7:"6,len=20,table=0305,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
-	Building                   IS      `hl7:"7,len=20,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor                      IS      `hl7:"8,len=20,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus             IS      `hl7:"5,len=20,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
+	PatientLocationType        IS      `hl7:"6,len=20,table=0305,datatype=IS,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
+	Building                   IS      `hl7:"7,len=20,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor                      IS      `hl7:"8,len=20,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	StreetAddress              ST      `hl7:"9,len=120,display=This component specifies the street or mailing address of a person or institution. When referencing an institution- it is used to specify the institution name. When used in connection with a person- it specifies the first line of the address."`
 	OtherDesignation           ST      `hl7:"10,len=120,display=This component specifies the second line of an address. In general- it qualifies address. Examples: Suite 555 or Fourth Floor. When referencing an institution- this component specifies the street address."`
 	City                       ST      `hl7:"11,len=50,display=This component specifies the city- or district or place where the person or institution is located depending upon the national convention for formatting addresses for postal usage. City should be represented by the official postal service codes for that state."`
 	StateOrProvince            ST      `hl7:"12,len=50,display=This component specifies the state or province where the person or institution is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"13,len=12,display=This component specifies the zip or postal code where the person or institution is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9 and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"14,len=3,table=0399,display=This component specifies the country where the person or institution is located. ISO 3166 provides a list of country codes that may be used.[1] HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country code in section 2.15.9.17 for valid values."`
-	AddressType                ID      `hl7:"15,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address type for valid values."`
+	Country                    ID      `hl7:"14,len=3,table=0399,datatype=ID,display=This component specifies the country where the person or institution is located. ISO 3166 provides a list of country codes that may be used.[1] HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country code in section 2.15.9.17 for valid values."`
+	AddressType                ID      `hl7:"15,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address type for valid values."`
 	OtherGeographicDesignation ST      `hl7:"16,len=50,display=This component specifies any other geographic designation that may be necessary. It includes county- bioregion- SMSA- etc."`
 }
 
@@ -409,8 +409,8 @@ type LA2 struct {
 // This data type specifies an amount of money and the denomination in which it is expressed.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=20,type=d"`
-	Quantity     NM      `hl7:"1,len=16,display=The first component is a quantity."`
-	Denomination ID      `hl7:"2,len=3,table=ISO4217,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default."`
+	Quantity     NM      `hl7:"1,len=16,datatype=NM,display=The first component is a quantity."`
+	Denomination ID      `hl7:"2,len=3,table=ISO4217,datatype=ID,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- MSH-17-country code is used to determine the default."`
 }
 
 // Money and Code
@@ -428,9 +428,9 @@ type MOC struct {
 // limited to currency.
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=23,type=d"`
-	MoneyOrPercentageIndicator ID      `hl7:"1,required,len=2,table=0148,display=Specifies whether the amount is currency or a percentage."`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,required,len=16,display=Specifies the currency or percentage quantity."`
-	CurrencyDenomination       ID      `hl7:"3,len=3,table=ISO4217,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- the context of the message or MSH-17-country code is used to determine the default."`
+	MoneyOrPercentageIndicator ID      `hl7:"1,required,len=2,table=0148,datatype=ID,display=Specifies whether the amount is currency or a percentage."`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,required,len=16,datatype=NM,display=Specifies the currency or percentage quantity."`
+	CurrencyDenomination       ID      `hl7:"3,len=3,table=ISO4217,datatype=ID,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are those specified in ISO-4217. If the denomination is not specified- the context of the message or MSH-17-country code is used to determine the default."`
 }
 
 // Message Type
@@ -438,9 +438,9 @@ type MOP struct {
 // This field contains the message type, trigger event, and the message structure ID for the message.
 type MSG struct {
 	HL7              HL7Name `hl7:",name=MSG,len=0,type=d"`
-	MessageCode      ID      `hl7:"1,required,len=3,table=0076,display=Specifies the message type code. Refer to HL7 Table - Message Type in section 2.17.1 for valid values."`
-	TriggerEvent     ID      `hl7:"2,required,len=3,table=0003,display=Specifies the trigger event code. Refer to HL7 Table - Event Type in section 2.17.2 for valid values."`
-	MessageStructure ID      `hl7:"3,required,len=7,table=0354,display=Specifies the abstract message structure code. Refer to HL7 Table 0354 - Message Structure in section 2.17.3 for valid values."`
+	MessageCode      ID      `hl7:"1,required,len=3,table=0076,datatype=ID,display=Specifies the message type code. Refer to HL7 Table - Message Type in section 2.17.1 for valid values."`
+	TriggerEvent     ID      `hl7:"2,required,len=3,table=0003,datatype=ID,display=Specifies the trigger event code. Refer to HL7 Table - Event Type in section 2.17.2 for valid values."`
+	MessageStructure ID      `hl7:"3,required,len=7,table=0354,datatype=ID,display=Specifies the abstract message structure code. Refer to HL7 Table 0354 - Message Structure in section 2.17.3 for valid values."`
 }
 
 func (d MSG) MessageStructureID() string {
@@ -464,16 +464,16 @@ func (d MSG) MessageStructureID() string {
 // repetition delimiters).
 type NA struct {
 	HL7     HL7Name `hl7:",name=NA,len=65536,type=d"`
-	Value1  NM      `hl7:"1,required,len=16,display=Value1"`
-	Value2  NM      `hl7:"2,len=16,display=Value2"`
-	Value3  NM      `hl7:"3,len=16,display=Value3"`
-	Value4  NM      `hl7:"4,len=16,display=Value4"`
-	Value5  NM      `hl7:"5,display=Value5"`
-	Value6  NM      `hl7:"6,display=Value6"`
-	Value7  NM      `hl7:"7,display=Value7"`
-	Value8  NM      `hl7:"8,display=Value8"`
-	Value9  NM      `hl7:"9,display=Value9"`
-	Value10 NM      `hl7:"10,display=Value10"`
+	Value1  NM      `hl7:"1,required,len=16,datatype=NM,display=Value1"`
+	Value2  NM      `hl7:"2,len=16,datatype=NM,display=Value2"`
+	Value3  NM      `hl7:"3,len=16,datatype=NM,display=Value3"`
+	Value4  NM      `hl7:"4,len=16,datatype=NM,display=Value4"`
+	Value5  NM      `hl7:"5,datatype=NM,display=Value5"`
+	Value6  NM      `hl7:"6,datatype=NM,display=Value6"`
+	Value7  NM      `hl7:"7,datatype=NM,display=Value7"`
+	Value8  NM      `hl7:"8,datatype=NM,display=Value8"`
+	Value9  NM      `hl7:"9,datatype=NM,display=Value9"`
+	Value10 NM      `hl7:"10,datatype=NM,display=Value10"`
 }
 
 // Name with Date and Location
@@ -485,14 +485,14 @@ type NDL struct {
 	Name                *CNN    `hl7:"1,len=406,display=This component specifies the name of the person performing a service."`
 	StartDateTime       TS      `hl7:"2,len=26,format=YMDHMS,display=This component specifies the starting date and time for when the person is performing the service."`
 	EndDateTime         TS      `hl7:"3,len=26,format=YMDHMS,display=This component specifies the ending date and time for when the person is performing the service."`
-	PointOfCare         IS      `hl7:"4,len=20,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on NDL. 9 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values"`
-	Room                IS      `hl7:"5,len=20,table=0303,display=Patient room. After point of care- it is the most general location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                 IS      `hl7:"6,len=20,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare         IS      `hl7:"4,len=20,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on NDL. 9 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values"`
+	Room                IS      `hl7:"5,len=20,table=0303,datatype=IS,display=Patient room. After point of care- it is the most general location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                 IS      `hl7:"6,len=20,table=0304,datatype=IS,display=This component specifies the code for the patient's bed. After room- it is the most general location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility            *HD     `hl7:"7,len=227,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general location designation."`
-	LocationStatus      IS      `hl7:"8,len=20,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
-	PatientLocationType IS      `hl7:"9,len=20,table=0305,display=Location type is the categorization of the location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
-	Building            IS      `hl7:"10,len=20,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor               IS      `hl7:"11,len=20,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus      IS      `hl7:"8,len=20,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
+	PatientLocationType IS      `hl7:"9,len=20,table=0305,datatype=IS,display=Location type is the categorization of the location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
+	Building            IS      `hl7:"10,len=20,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor               IS      `hl7:"11,len=20,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 }
 
 // Numeric
@@ -509,8 +509,8 @@ type NM = string
 // in the usage note for the field.
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=33,type=d"`
-	LowValue  NM      `hl7:"1,len=16,display=The number specifying the lower limit or boundary of the range."`
-	HighValue NM      `hl7:"2,len=16,display=The number specifying the high limit or boundary of the range."`
+	LowValue  NM      `hl7:"1,len=16,datatype=NM,display=The number specifying the lower limit or boundary of the range."`
+	HighValue NM      `hl7:"2,len=16,datatype=NM,display=The number specifying the high limit or boundary of the range."`
 }
 
 // Occurrence Code and Date
@@ -528,17 +528,17 @@ type OCD struct {
 // The relationship may be sequential or a cyclical pattern.
 type OSD struct {
 	HL7                               HL7Name `hl7:",name=OSD,len=110,type=d"`
-	SequenceResultsFlag               ID      `hl7:"1,required,len=1,table=0524,display=Identifies whether sequence conditions or a repeating cycle of orders is defined. Refer to HL7-defined Table 0524 - Sequence condition for valid values."`
+	SequenceResultsFlag               ID      `hl7:"1,required,len=1,table=0524,datatype=ID,display=Identifies whether sequence conditions or a repeating cycle of orders is defined. Refer to HL7-defined Table 0524 - Sequence condition for valid values."`
 	PlacerOrderNumberEntityIdentifier ST      `hl7:"2,required,len=15,display=Contains the first component of the placer order number- entity identifier ."`
-	PlacerOrderNumberNamespaceID      IS      `hl7:"3,len=6,table=0363,display=Contains the second component of the placer order number- namespace ID. Refer to user-defined table 0363 - Assigning Authority for suggested values."`
+	PlacerOrderNumberNamespaceID      IS      `hl7:"3,len=6,table=0363,datatype=IS,display=Contains the second component of the placer order number- namespace ID. Refer to user-defined table 0363 - Assigning Authority for suggested values."`
 	FillerOrderNumberEntityIdentifier ST      `hl7:"4,required,len=15,display=Contains the first component of the filler order number- entity identifier."`
-	FillerOrderNumberNamespaceID      IS      `hl7:"5,len=6,table=0363,display=Contains the second component of the filler order number- namespace ID. Refer to user-defined table 0363 - Assigning Authority for suggested values"`
+	FillerOrderNumberNamespaceID      IS      `hl7:"5,len=6,table=0363,datatype=IS,display=Contains the second component of the filler order number- namespace ID. Refer to user-defined table 0363 - Assigning Authority for suggested values"`
 	SequenceConditionValue            ST      `hl7:"6,len=12,display=Defines the relationship between the start/end of the related predecessor or successor order and the current order from ORC-2- 3 or 4."`
-	MaximumNumberOfRepeats            NM      `hl7:"7,len=3,display=The maximum number of repeats to be used only on cyclic groups. The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
+	MaximumNumberOfRepeats            NM      `hl7:"7,len=3,datatype=NM,display=The maximum number of repeats to be used only on cyclic groups. The total number of repeats is constrained by the end date/time of the last repeat or the end date/time of the parent- whichever is first."`
 	PlacerOrderNumberUniversalID      ST      `hl7:"8,required,len=15,display=Contains the next to the last component of the placer order number- universal ID."`
-	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,len=6,table=0301,display=Contains the last component of the placer order number. Refer to HL7 table 0301 - Universal ID Type for valid values."`
+	PlacerOrderNumberUniversalIDType  ID      `hl7:"9,len=6,table=0301,datatype=ID,display=Contains the last component of the placer order number. Refer to HL7 table 0301 - Universal ID Type for valid values."`
 	FillerOrderNumberUniversalID      ST      `hl7:"10,required,len=15,display=Contains the next to the last component of the filler order number- universal ID."`
-	FillerOrderNumberUniversalIDType  ID      `hl7:"11,len=6,table=0301,display=Contains the last component of the placer order number. Refer to HL7 table 0301 - Universal ID Type for valid values."`
+	FillerOrderNumberUniversalIDType  ID      `hl7:"11,len=6,table=0301,datatype=ID,display=Contains the last component of the placer order number. Refer to HL7 table 0301 - Universal ID Type for valid values."`
 }
 
 // Occurrence Span Code and Date
@@ -572,14 +572,14 @@ type PIP struct {
 // used for specifying patient locations, but may refer to other types of persons within a healthcare setting.
 type PL struct {
 	HL7                             HL7Name `hl7:",name=PL,len=1230,type=d"`
-	PointOfCare                     IS      `hl7:"1,len=20,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on PL.6 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
-	Room                            IS      `hl7:"2,len=20,table=0303,display=This component specifies the code for the patient's room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                             IS      `hl7:"3,len=20,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare                     IS      `hl7:"1,len=20,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on PL.6 Person Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
+	Room                            IS      `hl7:"2,len=20,table=0303,datatype=IS,display=This component specifies the code for the patient's room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                             IS      `hl7:"3,len=20,table=0304,datatype=IS,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility                        *HD     `hl7:"4,len=227,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation."`
-	LocationStatus                  IS      `hl7:"5,len=20,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
-	PersonLocationType              IS      `hl7:"6,conditional,len=20,table=0305,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
-	Building                        IS      `hl7:"7,len=20,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor                           IS      `hl7:"8,len=20,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus                  IS      `hl7:"5,len=20,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location status for suggested values."`
+	PersonLocationType              IS      `hl7:"6,conditional,len=20,table=0305,datatype=IS,display=Person location type is the categorization of the persons location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physicians office. Refer to User-defined Table 0305 - Person location type for suggested values."`
+	Building                        IS      `hl7:"7,len=20,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor                           IS      `hl7:"8,len=20,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	LocationDescription             ST      `hl7:"9,len=199,display=This component describes the location in free text."`
 	ComprehensiveLocationIdentifier *EI     `hl7:"10,len=427,display=The unique identifier that represents the physical location as a whole without regard for the individual components. This accommodates sites that may have a different method of defining physical units or who may code at a less granular level. For example- point of care- room- and bed may be 1 indivisible code."`
 	AssigningAuthorityForLocation   *HD     `hl7:"11,len=227,display=The entity that creates the data for the individual physical location components. If populated- it should be the authority for all components populated. Refer to User-defined Table 0363 - Assigning authority for suggested values for the first sub-component of the HD component- <namespace ID>."`
@@ -592,7 +592,7 @@ type PL struct {
 type PLN struct {
 	HL7                             HL7Name `hl7:",name=PLN,len=101,type=d"`
 	IDNumber                        ST      `hl7:"1,required,len=20,display=Specifies the license number or other ID number such as UPIN- Medicare and Medicaid number."`
-	TypeOfIDNumber                  IS      `hl7:"2,required,len=8,table=0338,display=Specifies the type of number."`
+	TypeOfIDNumber                  IS      `hl7:"2,required,len=8,table=0338,datatype=IS,display=Specifies the type of number."`
 	StateOtherQualifyingInformation ST      `hl7:"3,len=62,display=Specifies the state or province in which the license or ID is valid- if relevant- or other qualifying information. It is recommended that state qualifications use the abbreviations from the postal service of the country."`
 	ExpirationDate                  DT      `hl7:"4,len=8,format=YMD,display=Specifies the date when the license or ID is no longer valid."`
 }
@@ -609,19 +609,19 @@ type PPN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,len=30,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"5,len=20,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,len=20,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,len=5,table=0360,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
-	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,display=CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                      IS      `hl7:"7,len=5,table=0360,datatype=IS,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
+	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,datatype=IS,display=CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,len=227,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. It is a HD data type. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                                ID      `hl7:"10,len=1,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.A.88-  XPN - extended person name )."`
+	NameTypeCode                                ID      `hl7:"10,len=1,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values (see Section 2.A.88-  XPN - extended person name )."`
 	IdentifierCheckDigit                        ST      `hl7:"11,len=1,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,display=Contains the code identifying the check digit scheme employed."`
-	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
+	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed."`
+	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility                           *HD     `hl7:"14,len=227,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
 	DateTimeActionPerformed                     TS      `hl7:"15,len=26,format=YMDHMS,display=This component describes when the activity was performed."`
-	NameRepresentationCode                      ID      `hl7:"16,len=1,table=0465,display=Name Representation Code"`
+	NameRepresentationCode                      ID      `hl7:"16,len=1,table=0465,datatype=ID,display=Name Representation Code"`
 	NameContext                                 *CE     `hl7:"17,len=483,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare: indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to chapter 3- section 3.4.2.6 for more detailed information on how to use this table. Refer to User-defined table 0448 - Name context for suggested values."`
 	NameValidityRange                           DR      `hl7:"18,len=17,display=This component contains the start and end date/times- which define the period during which this name was valid. See section 2.A.20- ' DR - date/time range ' for description of subcomponents.  Retained for backward compatibility only as of v 2.5. Refer to PPN.20 Effective Date and PPN.21Expiration Date. This component cannot be fully expressed and was identified as v 2.4 erratum"`
-	NameAssemblyOrder                           ID      `hl7:"19,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"19,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name assembly order for valid values."`
 	EffectiveDate                               TS      `hl7:"20,len=26,format=YMDHMS,display=The first date- if known- on which the address is valid and active."`
 	ExpirationDate                              TS      `hl7:"21,len=26,format=YMDHMS,display=The last date- if known- on which the address is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"22,len=199,display=Used to specify an abbreviation- or a string of abbreviations- denoting qualifications that support the persons profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- Personnel Management."`
@@ -644,8 +644,8 @@ type PRL struct {
 // This data type indicates whether to process a message as defined in HL7 Application (level 7) Processing rules.
 type PT struct {
 	HL7            HL7Name `hl7:",name=PT,len=3,type=d"`
-	ProcessingID   ID      `hl7:"1,len=1,table=0103,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
-	ProcessingMode ID      `hl7:"2,len=1,table=0207,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing mode for valid values."`
+	ProcessingID   ID      `hl7:"1,len=1,table=0103,datatype=ID,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
+	ProcessingMode ID      `hl7:"2,len=1,table=0207,datatype=ID,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing mode for valid values."`
 }
 
 // Policy Type and Amount
@@ -653,9 +653,9 @@ type PT struct {
 // This data type specifies the policy type and amount covered by the insurance.
 type PTA struct {
 	HL7                       HL7Name `hl7:",name=PTA,len=56,type=d"`
-	PolicyType                IS      `hl7:"1,required,len=5,table=0147,display=Specifies the policy type."`
-	AmountClass               IS      `hl7:"2,len=9,table=0193,display=Specifies the amount quantity class."`
-	MoneyOrPercentageQuantity NM      `hl7:"3,len=16,display=Specifies the currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to PTA.4 instead. "`
+	PolicyType                IS      `hl7:"1,required,len=5,table=0147,datatype=IS,display=Specifies the policy type."`
+	AmountClass               IS      `hl7:"2,len=9,table=0193,datatype=IS,display=Specifies the amount quantity class."`
+	MoneyOrPercentageQuantity NM      `hl7:"3,len=16,datatype=NM,display=Specifies the currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to PTA.4 instead. "`
 	MoneyOrPercentage         MOP     `hl7:"4,required,len=23,display=specifies an amount that may be either currency or a percentage."`
 }
 
@@ -675,9 +675,9 @@ type QIP struct {
 type QSC struct {
 	HL7                   HL7Name `hl7:",name=QSC,len=219,type=d"`
 	SegmentFieldName      ST      `hl7:"1,required,len=12,display=The name of the field that is participating as a qualifier (usually the 'key'). Refer to Section 2.A.59.1-  Segment Field Name (ST) - for segment field name conventions."`
-	RelationalOperator    ID      `hl7:"2,len=2,table=0209,display=Relational Operator"`
+	RelationalOperator    ID      `hl7:"2,len=2,table=0209,datatype=ID,display=Relational Operator"`
 	Value                 ST      `hl7:"3,len=199,display=The value to which the field will be compared."`
-	RelationalConjunction ID      `hl7:"4,len=3,table=0210,display=Relational Conjunction"`
+	RelationalConjunction ID      `hl7:"4,len=3,table=0210,datatype=ID,display=Relational Conjunction"`
 }
 
 // Row Column Definition
@@ -686,8 +686,8 @@ type QSC struct {
 type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=19,type=d"`
 	SegmentFieldName   ST      `hl7:"1,len=12,display=The HL7 segment field name- which identifies the field occupying the column. Refer to Section 2.A.59.1- 'Segment Field Name (ST)-' for segment field name definition conventions."`
-	Hl7DataType        ID      `hl7:"2,len=3,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 - Data Types in section 2.16 for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,len=2,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
+	Hl7DataType        ID      `hl7:"2,len=3,table=0440,datatype=ID,display=The two or three character HL7 data type. Refer to HL7 Table 0440 - Data Types in section 2.16 for valid values."`
+	MaximumColumnWidth NM      `hl7:"3,len=2,datatype=NM,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
 }
 
 // Reference Range
@@ -696,7 +696,7 @@ type RCD struct {
 type RFR struct {
 	HL7                 HL7Name `hl7:",name=RFR,len=352,type=d"`
 	NumericRange        NR      `hl7:"1,required,len=33,display=This component specifies the numeric interval of the reference data. Range is taken to be inclusive (i.e.- the range includes the end points). Units are context sensitive and are defined in the usage note for the field where this data type is used."`
-	AdministrativeSex   IS      `hl7:"2,len=8,table=0001,display=This component specifies which gender for which the reference range is valid. Refer to User-defined Table 0001 - Administrative Sex for suggested values."`
+	AdministrativeSex   IS      `hl7:"2,len=8,table=0001,datatype=IS,display=This component specifies which gender for which the reference range is valid. Refer to User-defined Table 0001 - Administrative Sex for suggested values."`
 	AgeRange            *NR     `hl7:"3,len=33,display=This component specifies the age range for which the reference range is valid. Ages of less than one year should be specified as a fraction (e.g.- 1 month : 0.0830- 1 week : 0.01920- 1 day : 0.0027300). However- for most purposes involving infants- the gestational age (measured in weeks) is preferred. The lower end of the range is not indicated; the upper end is- assuring that series of ranges do not overlap."`
 	GestationalAgeRange *NR     `hl7:"4,len=33,display=This component specifies the gestational age range for which the reference range is valid. Gestational age is relevant only when the reference range is influenced by the stage of pregnancy. The gestational age is measured in weeks from conception. For example- |1&4| implies that the normals apply to gestational ages from 1 week to 4 weeks inclusive. The lower end of the range is not included; the upper end is- assuring that series of age ranges do not overlap."`
 	Species             ST      `hl7:"5,len=20,display=This component specifies the species for which the reference range is valid. Species is assumed to be human unless otherwise stated. Example values are rabbit- mouse- and rat."`
@@ -709,7 +709,7 @@ type RFR struct {
 // contains the interval between repeated services.
 type RI struct {
 	HL7                  HL7Name `hl7:",name=RI,len=206,type=d"`
-	RepeatPattern        IS      `hl7:"1,len=6,table=0335,display=The repeating frequency with which the treatment is to be administered. It is similar to the frequency and SIG code tables used in order entry systems."`
+	RepeatPattern        IS      `hl7:"1,len=6,table=0335,datatype=IS,display=The repeating frequency with which the treatment is to be administered. It is similar to the frequency and SIG code tables used in order entry systems."`
 	ExplicitTimeInterval ST      `hl7:"2,len=199,display=This component explicitly lists the actual times referenced by the code in the first component- in the following format: HHMM-HHMM-HHMM-. This second component will be used to clarify the first component in cases where the actual times vary within an institution. If the time of the order spans more than a single day- this new component is only practical if the same times of administration occur for each day of the order. If the actual start time of the order (as given by the fourth component of the quantity/timing field) is after the first explicit time- the first administration is taken to be the first explicit time after the start time. In the case where the patient moves to a location having a different set of explicit times- the existing order may be updated with a new quantity/timing field showing the changed explicit times."`
 }
 
@@ -718,9 +718,9 @@ type RI struct {
 // This data type specifies insurance coverage detail for a room.
 type RMC struct {
 	HL7               HL7Name `hl7:",name=RMC,len=82,type=d"`
-	RoomType          IS      `hl7:"1,required,len=20,table=0145,display=Specifies the room type."`
-	AmountType        IS      `hl7:"2,len=20,table=0146,display=Specifies amount quantity type"`
-	CoverageAmount    NM      `hl7:"3,len=16,display=Specifies amount covered by the insurance as a currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to Money or Percentage. "`
+	RoomType          IS      `hl7:"1,required,len=20,table=0145,datatype=IS,display=Specifies the room type."`
+	AmountType        IS      `hl7:"2,len=20,table=0146,datatype=IS,display=Specifies amount quantity type"`
+	CoverageAmount    NM      `hl7:"3,len=16,datatype=NM,display=Specifies amount covered by the insurance as a currency or percentage quantity.  Retained for backward compatibility only as of v 2.5. Refer to Money or Percentage. "`
 	MoneyOrPercentage MOP     `hl7:"4,required,len=23,display=specifies an amount that may be either currency or a percentage."`
 }
 
@@ -738,15 +738,15 @@ type RMC struct {
 type RPT struct {
 	HL7                        HL7Name `hl7:",name=RPT,len=984,type=d"`
 	RepeatPatternCode          CWE     `hl7:"1,required,len=705,table=0335,display=A code representing the repeat pattern defined by the other components of this data type. Refer to User-defined Table 335 - Repeat Pattern for suggested values."`
-	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from the 5th of every month). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
-	PhaseRangeBeginValue       NM      `hl7:"3,len=10,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week."`
-	PhaseRangeEndValue         NM      `hl7:"4,len=10,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the end of the phase."`
-	PeriodQuantity             NM      `hl7:"5,len=10,display=A time duration specifying the frequency at which the periodic interval repeats. RPT-6 (Period Units) defines the units of time for this component."`
-	PeriodUnits                IS      `hl7:"6,conditional,len=10,display=Defines the units used for RPT-5 (Period Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
-	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish every 8 hours from 3 times a day.) Refer to HL7 Table 0136 - Yes/No Indicator for valid values."`
-	Event                      ID      `hl7:"8,len=6,table=0528,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528- Event-Related Period for valid values."`
-	EventOffsetQuantity        NM      `hl7:"9,len=10,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT-8. A negative numeric value indicates the amount of time prior to the event in RPT-8. RPT-10 (Event Offset Units) defines the units of time for this component."`
-	EventOffsetUnits           IS      `hl7:"10,conditional,len=10,display=Defines the units used for RPT-9 (Event Offset Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
+	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,datatype=ID,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from the 5th of every month). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
+	PhaseRangeBeginValue       NM      `hl7:"3,len=10,datatype=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week."`
+	PhaseRangeEndValue         NM      `hl7:"4,len=10,datatype=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT-2 (Calendar Alignment) to the end of the phase."`
+	PeriodQuantity             NM      `hl7:"5,len=10,datatype=NM,display=A time duration specifying the frequency at which the periodic interval repeats. RPT-6 (Period Units) defines the units of time for this component."`
+	PeriodUnits                IS      `hl7:"6,conditional,len=10,datatype=IS,display=Defines the units used for RPT-5 (Period Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
+	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,datatype=ID,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish every 8 hours from 3 times a day.) Refer to HL7 Table 0136 - Yes/No Indicator for valid values."`
+	Event                      ID      `hl7:"8,len=6,table=0528,datatype=ID,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528- Event-Related Period for valid values."`
+	EventOffsetQuantity        NM      `hl7:"9,len=10,datatype=NM,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT-8. A negative numeric value indicates the amount of time prior to the event in RPT-8. RPT-10 (Event Offset Units) defines the units of time for this component."`
+	EventOffsetUnits           IS      `hl7:"10,conditional,len=10,datatype=IS,display=Defines the units used for RPT-9 (Event Offset Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM]."`
 	GeneralTimingSpecification GTS     `hl7:"11,len=200,display=The General Timing Specification as defined by the Version 3 Data Types document."`
 }
 
@@ -786,9 +786,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=36,type=d"`
 	Comparator      ST      `hl7:"1,len=2,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (: '>' or '<' or '>:' or '<:' or ':' or '<>'"`
-	Num1            NM      `hl7:"2,len=15,display=A number."`
+	Num1            NM      `hl7:"2,len=15,datatype=NM,display=A number."`
 	SeparatorSuffix ST      `hl7:"3,len=1,display='-' or '+' or '/' or '.' or ':'"`
-	Num2            NM      `hl7:"4,len=15,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,len=15,datatype=NM,display=A number or null depending on the measurement."`
 }
 
 // Specialty Description
@@ -798,7 +798,7 @@ type SPD struct {
 	HL7                 HL7Name `hl7:",name=SPD,len=112,type=d"`
 	SpecialtyName       ST      `hl7:"1,required,len=50,display=Identifies the providers specialty."`
 	GoverningBoard      ST      `hl7:"2,len=50,display=Identifies the governing body providing for the specialty."`
-	EligibleOrCertified ID      `hl7:"3,len=1,table=0337,display=Specifies the certification status. Refer to HL7 Table 0337 - Certification status for valid values."`
+	EligibleOrCertified ID      `hl7:"3,len=1,table=0337,datatype=ID,display=Specifies the certification status. Refer to HL7 Table 0337 - Certification status for valid values."`
 	DateOfCertification DT      `hl7:"4,len=8,format=YMD,display=Specifies when certification occurred."`
 }
 
@@ -822,7 +822,7 @@ type SPS struct {
 type SRT struct {
 	HL7         HL7Name `hl7:",name=SRT,len=15,type=d"`
 	SortByField ST      `hl7:"1,required,len=12,display=Identifies the field by which the response will be sorted. In a tabular response- this will be the column name to sort by. In the Segment Pattern and the Display Response- this will be the segment field name to sort by. See QIP in Section 2.A.59.1- 'Segment Field Name (ST)' for segment field name definition."`
-	Sequencing  ID      `hl7:"2,len=2,table=0397,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 - Sequencing for valid values"`
+	Sequencing  ID      `hl7:"2,len=2,table=0397,datatype=ID,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 - Sequencing for valid values"`
 }
 
 // String Data
@@ -854,10 +854,10 @@ type TQ struct {
 	Priority           ST      `hl7:"6,len=6,display=This component describes the urgency of the request. The following values are suggested (the default for Priority is R):"`
 	Condition          ST      `hl7:"7,len=199,display=This is a free text component that describes the conditions under which the drug is to be given. For example- PRN pain - or to keep blood pressure below 110. The presence of text in this field should be taken to mean that human review is needed to determine the how and/or when this drug should be given."`
 	Text               TX      `hl7:"8,len=200,display=This component is a full text version of the instruction (optional)."`
-	Conjunction        ID      `hl7:"9,len=1,table=0472,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter. Refer to HL7 table 0472 - TQ Conjunction ID for valid values"`
+	Conjunction        ID      `hl7:"9,len=1,table=0472,datatype=ID,display=This non-null component indicates that a second timing specification is to follow using the repeat delimiter. Refer to HL7 table 0472 - TQ Conjunction ID for valid values"`
 	OrderSequencing    *OSD    `hl7:"10,len=110,display=Order Sequencing"`
 	OccurrenceDuration *CE     `hl7:"11,len=483,display=This component contains the duration for a single performance of a service- e.g.- whirlpool twenty minutes three times per day for three days. It is optional within TQ and does not repeat."`
-	TotalOccurrences   NM      `hl7:"12,len=4,display=This component contains the total number of occurrences of a service that should result from this order. It is optional within TQ and does not repeat. If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence. Otherwise the number of occurrences takes precedence."`
+	TotalOccurrences   NM      `hl7:"12,len=4,datatype=NM,display=This component contains the total number of occurrences of a service that should result from this order. It is optional within TQ and does not repeat. If both the end date/time and the total occurrences are valued and the occurrences would extend beyond the end date/time- then the end date/time takes precedence. Otherwise the number of occurrences takes precedence."`
 }
 
 // Time Stamp
@@ -894,8 +894,8 @@ type VARIES any
 // valid values for the first two components.
 type VH struct {
 	HL7            HL7Name `hl7:",name=VH,len=41,type=d"`
-	StartDayRange  ID      `hl7:"1,len=3,table=0267,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values."`
-	EndDayRange    ID      `hl7:"2,len=3,table=0267,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values"`
+	StartDayRange  ID      `hl7:"1,len=3,table=0267,datatype=ID,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values."`
+	EndDayRange    ID      `hl7:"2,len=3,table=0267,datatype=ID,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the week for valid values"`
 	StartHourRange TM      `hl7:"3,len=16,format=HM,display=Starting hour on starting day of visiting hours range. See first component- 2.A.80.1- 'Start Day Range (ID)'."`
 	EndHourRange   TM      `hl7:"4,len=16,format=HM,display=Ending hour on ending day of visiting hours range See second component- 2.A.80.2- 'End Day Range (ID)'."`
 }
@@ -903,7 +903,7 @@ type VH struct {
 // Version Identifier
 type VID struct {
 	HL7                      HL7Name `hl7:",name=VID,len=973,type=d"`
-	VersionID                ID      `hl7:"1,len=5,table=0104,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID in section 2.15.9.12 for valid values."`
+	VersionID                ID      `hl7:"1,len=5,table=0104,datatype=ID,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID in section 2.15.9.12 for valid values."`
 	InternationalizationCode *CE     `hl7:"2,len=483,table=0399,display=Used to identify the international affiliate country code. The values to be used are those of ISO 3166 -1:1977. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code."`
 	InternationalVersionID   *CE     `hl7:"3,len=483,display=This field component identifies international affiliates version; it is especially important when the international affiliate has more than a single local version associated with a single US version."`
 }
@@ -928,12 +928,12 @@ type XAD struct {
 	City                       ST      `hl7:"3,len=50,table=City,display=This component specifies the city- or district or place where the addressee is located depending upon the national convention for formatting addresses for postal usage."`
 	StateOrProvince            ST      `hl7:"4,len=50,table=State,display=This component specifies the state or province where the addressee is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,len=12,table=ZipCode,display=This component specifies the zip or postal code where the addressee is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9- and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"6,len=3,table=0399,display=This component specifies the country where the addressee is locatedHL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country code in section 2.15.9.17 for valid values."`
-	AddressType                ID      `hl7:"7,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address type for valid values."`
+	Country                    ID      `hl7:"6,len=3,table=0399,datatype=ID,display=This component specifies the country where the addressee is locatedHL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country code in section 2.15.9.17 for valid values."`
+	AddressType                ID      `hl7:"7,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address type for valid values."`
 	OtherGeographicDesignation ST      `hl7:"8,len=50,display=This component specifies any other geographic designation. It includes county- bioregion- SMSA- etc."`
-	CountyParishCode           IS      `hl7:"9,len=20,table=0289,display=A code that represents the county in which the specified address resides. User-defined Table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
-	CensusTract                IS      `hl7:"10,len=20,table=0288,display=A code that represents the census tract in which the specified address resides. User-defined Table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
-	AddressRepresentationCode  ID      `hl7:"11,len=1,table=0465,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	CountyParishCode           IS      `hl7:"9,len=20,table=0289,datatype=IS,display=A code that represents the county in which the specified address resides. User-defined Table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7)."`
+	CensusTract                IS      `hl7:"10,len=20,table=0288,datatype=IS,display=A code that represents the census tract in which the specified address resides. User-defined Table 0288 - Census tract is used as the HL7 identifier for the user-defined table of values for this component."`
+	AddressRepresentationCode  ID      `hl7:"11,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	AddressValidityRange       DR      `hl7:"12,len=53,display=This component cannot be fully expressed. Identified as v 2.4 erratum. Retained for backward compatibility only as of v 2.5. Refer to Effective Date and Expiration Date components."`
 	EffectiveDate              TS      `hl7:"13,len=26,format=YMDHMS,display=The first date- if known- on which the address is valid and active."`
 	ExpirationDate             TS      `hl7:"14,len=26,format=YMDHMS,display=The last date- if known- on which the address is valid and active."`
@@ -948,18 +948,18 @@ type XCN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,len=30,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"5,len=20,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,len=20,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,len=5,table=0360,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
-	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,display=CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
+	Degree                                      IS      `hl7:"7,len=5,table=0360,datatype=IS,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
+	SourceTable                                 IS      `hl7:"8,conditional,len=4,table=0297,datatype=IS,display=CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,len=227,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. User-defined Table 0363 - Assigning authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>."`
-	NameTypeCode                                ID      `hl7:"10,len=1,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values. See Section 2.A.88.7- ' Name Type Code (ID) '."`
+	NameTypeCode                                ID      `hl7:"10,len=1,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values. See Section 2.A.88.7- ' Name Type Code (ID) '."`
 	IdentifierCheckDigit                        ST      `hl7:"11,len=1,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,display=Contains the code identifying the check digit scheme employed."`
-	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
+	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed."`
+	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility                           *HD     `hl7:"14,len=227,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode                      ID      `hl7:"15,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode                      ID      `hl7:"15,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	NameContext                                 *CE     `hl7:"16,len=483,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare for indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to User-defined Table 0448 - Name context for suggested values."`
 	NameValidityRange                           DR      `hl7:"17,len=53,display=Retained for backward compatibility only as of v 2.5. Refer to XCN.19 Effective Date and XCN.20 Expiration Date instead. This component cannot be fully expressed and has been identified as v 2.4 erratum.  This component contains the start and end date/times that define the period during which this name was valid. See section 2.A.20- ' DR - date/time range ' for description of subcomponents."`
-	NameAssemblyOrder                           ID      `hl7:"18,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name Assembly Order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"18,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name Assembly Order for valid values."`
 	EffectiveDate                               TS      `hl7:"19,len=26,format=YMDHMS,display=The first date- if known- on which the address is valid and active."`
 	ExpirationDate                              TS      `hl7:"20,len=26,format=YMDHMS,display=The last date- if known- on which the address is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"21,len=199,display=Used to specify an abbreviation- or a string of abbreviations denoting qualifications that support the persons profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- Personnel Management."`
@@ -973,14 +973,14 @@ type XCN struct {
 type XON struct {
 	HL7                      HL7Name `hl7:",name=XON,len=567,type=d"`
 	OrganizationName         ST      `hl7:"1,len=50,display=The name of the specified organization."`
-	OrganizationNameTypeCode IS      `hl7:"2,len=20,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational Name Type for suggested values."`
-	IDNumber                 NM      `hl7:"3,len=4,display=This component has been retained for backward compatibility only as of v 2.5. It is recommended to use component 10 Organization identifier that accommodates alphanumeric identifiers."`
-	CheckDigit               NM      `hl7:"4,len=1,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
-	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,display=Contains the code identifying the check digit scheme employed."`
+	OrganizationNameTypeCode IS      `hl7:"2,len=20,table=0204,datatype=IS,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational Name Type for suggested values."`
+	IDNumber                 NM      `hl7:"3,len=4,datatype=NM,display=This component has been retained for backward compatibility only as of v 2.5. It is recommended to use component 10 Organization identifier that accommodates alphanumeric identifiers."`
+	CheckDigit               NM      `hl7:"4,len=1,datatype=NM,display=The check digit in this data type is not an add-on produced by the message processor. It is the check digit that is part of the identifying number used in the sending application. If the sending application does not include a self-generated check digit in the identifying number- this component should be valued null."`
+	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed."`
 	AssigningAuthority       *HD     `hl7:"6,len=227,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. Refer to User-defined Table 0363 - Assigning Authority for suggested values."`
-	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
+	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier type for suggested values."`
 	AssigningFacility        *HD     `hl7:"8,len=227,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems."`
-	NameRepresentationCode   ID      `hl7:"9,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	NameRepresentationCode   ID      `hl7:"9,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	OrganizationIdentifier   ST      `hl7:"10,len=20,display=This component contains the sequence of characters (the code) that uniquely identifies the item being referenced by XON.1 Organization Name. This component replaces XON.3 ID Number as of v 2.5."`
 }
 
@@ -992,12 +992,12 @@ type XPN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"3,len=30,display=Multiple middle names may be included by separating them with spaces."`
 	Suffix                                      ST      `hl7:"4,len=20,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"5,len=20,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"6,len=6,table=0360,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
-	NameTypeCode                                ID      `hl7:"7,len=1,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values."`
-	NameRepresentationCode                      ID      `hl7:"8,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
+	Degree                                      IS      `hl7:"6,len=6,table=0360,datatype=IS,display=Retained for backward compatibility only as of v 2.5. See Professional Suffix component."`
+	NameTypeCode                                ID      `hl7:"7,len=1,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name type for valid values."`
+	NameRepresentationCode                      ID      `hl7:"8,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component."`
 	NameContext                                 *CE     `hl7:"9,len=483,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare for indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to User-defined Table 0448 - Name context for suggested values."`
 	NameValidityRange                           DR      `hl7:"10,len=53,display=This component cannot be fully expressed. Identified as v 2.4 erratum. Retained for backward compatibility only as of v 2.5. Refer to Effective Date and Expiration Date components."`
-	NameAssemblyOrder                           ID      `hl7:"11,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 - Name assembly order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"11,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 - Name assembly order for valid values."`
 	EffectiveDate                               TS      `hl7:"12,len=26,format=YMDHMS,display=The first date- if known- on which the person name is valid and active."`
 	ExpirationDate                              TS      `hl7:"13,len=26,format=YMDHMS,display=The last date- if known- on which the person name is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"14,len=199,display=Used to specify an abbreviation- or a string of abbreviations denoting qualifications that support the persons profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- Personnel Management."`
@@ -1007,13 +1007,13 @@ type XPN struct {
 type XTN struct {
 	HL7                            HL7Name `hl7:",name=XTN,len=850,type=d"`
 	TelephoneNumber                ST      `hl7:"1,len=199,table=PhoneNumber,display=This component has been retained for backward compatibility only as of version 2.3.   Specifies the telephone number in a predetermined format that includes an optional extension- beeper number and comment."`
-	TelecommunicationUseCode       ID      `hl7:"2,len=3,table=0201,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
-	TelecommunicationEquipmentType ID      `hl7:"3,len=8,table=0202,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
+	TelecommunicationUseCode       ID      `hl7:"2,len=3,table=0201,datatype=ID,display=A code that represents a specific use of a telecommunicationnumber. Refer to HL7 Table 0201 - Telecommunication use code for valid values."`
+	TelecommunicationEquipmentType ID      `hl7:"3,len=8,table=0202,datatype=ID,display=A code that represents the type of telecommunicationequipment. Refer to HL7 Table 0202 - Telecommunication equipment type for valid values."`
 	EmailAddress                   ST      `hl7:"4,len=199,display=Email Address"`
-	CountryCode                    NM      `hl7:"5,len=3,display=Country Code"`
-	AreaCityCode                   NM      `hl7:"6,len=5,display=Area/City Code"`
-	LocalNumber                    NM      `hl7:"7,len=9,display=Local Number"`
-	Extension                      NM      `hl7:"8,len=5,display=Contains comments with respect to the telephone number."`
+	CountryCode                    NM      `hl7:"5,len=3,datatype=NM,display=Country Code"`
+	AreaCityCode                   NM      `hl7:"6,len=5,datatype=NM,display=Area/City Code"`
+	LocalNumber                    NM      `hl7:"7,len=9,datatype=NM,display=Local Number"`
+	Extension                      NM      `hl7:"8,len=5,datatype=NM,display=Contains comments with respect to the telephone number."`
 	AnyText                        ST      `hl7:"9,len=199,display=The characters established within a companys internal telephone system network used as a prefix to the Extension component for internal dialing. Note that the use of Extension Prefix requires that the Extension component be valued and that digits- as well as special characters (e.g.- *- #) may be used."`
 	ExtensionPrefix                ST      `hl7:"10,len=4,display=The characters established within a companys internal telephone system used in place of the (external) telephone number to facilitate calling because its length is shorter than that of the telephone number. Note that digits- as well as special characters (e.g.- *- #) may be used."`
 	SpeedDialCode                  ST      `hl7:"11,len=6,display=An expression of the telephone number as an unparsible string."`
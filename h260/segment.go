@@ -17,11 +17,11 @@ type ABS struct {
 	AbstractCompletionDateTime DTM     `hl7:"7,len=24,format=YMDHM,display=Abstract Completion Date/Time"`
 	AbstractedBy               *XCN    `hl7:"8,len=250,display=Abstracted By"`
 	CaseCategoryCode           *CWE    `hl7:"9,len=250,table=0423,display=Case Category Code"`
-	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,display=Caesarian Section Indicator"`
+	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Caesarian Section Indicator"`
 	GestationCategoryCode      *CWE    `hl7:"11,len=250,table=0424,display=Gestation Category Code"`
-	GestationPeriodWeeks       NM      `hl7:"12,len=3,display=Gestation Period - Weeks"`
+	GestationPeriodWeeks       NM      `hl7:"12,len=3,datatype=NM,display=Gestation Period - Weeks"`
 	NewbornCode                *CWE    `hl7:"13,len=250,table=0425,display=Newborn Code"`
-	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,display=Stillborn Indicator"`
+	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Stillborn Indicator"`
 }
 
 // Accident
@@ -33,12 +33,12 @@ type ACC struct {
 	AccidentCode                *CWE    `hl7:"2,len=250,table=0050,display=Accident Code"`
 	AccidentLocation            ST      `hl7:"3,len=25,display=Accident Location"`
 	AutoAccidentState           CWE     `hl7:"4,len=250,table=0347,display=Auto Accident State"`
-	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,display=Accident Job Related Indicator"`
-	AccidentDeathIndicator      ID      `hl7:"6,len=12,table=0136,display=Accident Death Indicator"`
+	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,datatype=ID,display=Accident Job Related Indicator"`
+	AccidentDeathIndicator      ID      `hl7:"6,len=12,table=0136,datatype=ID,display=Accident Death Indicator"`
 	EnteredBy                   *XCN    `hl7:"7,len=3220,display=Entered By"`
 	AccidentDescription         ST      `hl7:"8,len=25,display=Accident Description"`
 	BroughtInBy                 ST      `hl7:"9,len=80,display=Brought In By"`
-	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,display=Police Notified Indicator"`
+	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Police Notified Indicator"`
 	AccidentAddress             *XAD    `hl7:"11,len=250,display=Accident Address"`
 }
 
@@ -59,15 +59,15 @@ type ADJ struct {
 	HL7                                                HL7Name `hl7:",name=ADJ,type=s"`
 	ProviderAdjustmentNumber                           EI      `hl7:"1,required,len=73,display=Provider Adjustment Number"`
 	PayerAdjustmentNumber                              EI      `hl7:"2,required,len=73,display=Payer Adjustment Number"`
-	AdjustmentSequenceNumber                           SI      `hl7:"3,required,len=4,display=Adjustment Sequence Number"`
-	AdjustmentCategory                                 IS      `hl7:"4,required,len=4,table=0564,display=Adjustment Category"`
+	AdjustmentSequenceNumber                           SI      `hl7:"3,required,len=4,datatype=SI,display=Adjustment Sequence Number"`
+	AdjustmentCategory                                 IS      `hl7:"4,required,len=4,table=0564,datatype=IS,display=Adjustment Category"`
 	AdjustmentAmount                                   []CP    `hl7:"5,max=5,len=254,display=Adjustment Amount"`
 	AdjustmentQuantity                                 *CQ     `hl7:"6,len=222,display=Adjustment Quantity"`
 	AdjustmentReasonPA                                 *CWE    `hl7:"7,conditional,len=211,table=0565,display=Adjustment Reason PA"`
 	AdjustmentDescription                              ST      `hl7:"8,len=250,display=Adjustment Description"`
-	OriginalValue                                      NM      `hl7:"9,len=250,display=Original Value"`
-	SubstituteValue                                    NM      `hl7:"10,len=250,display=Substitute Value"`
-	AdjustmentAction                                   IS      `hl7:"11,len=4,table=0569,display=Adjustment Action"`
+	OriginalValue                                      NM      `hl7:"9,len=250,datatype=NM,display=Original Value"`
+	SubstituteValue                                    NM      `hl7:"10,len=250,datatype=NM,display=Substitute Value"`
+	AdjustmentAction                                   IS      `hl7:"11,len=4,table=0569,datatype=IS,display=Adjustment Action"`
 	ProviderAdjustmentNumberCrossReference             *EI     `hl7:"12,len=73,display=Provider Adjustment Number Cross Reference"`
 	ProviderProductServiceLineItemNumberCrossReference *EI     `hl7:"13,len=73,display=Provider Product/Service Line Item Number Cross Reference"`
 	AdjustmentDate                                     DTM     `hl7:"14,required,len=26,format=YMDHM,display=Adjustment Date"`
@@ -80,7 +80,7 @@ type ADJ struct {
 // by the STF segment is/was associated.
 type AFF struct {
 	HL7                                          HL7Name `hl7:",name=AFF,type=s"`
-	SetID                                        SI      `hl7:"1,seq,required,len=60,display=Set ID - AFF"`
+	SetID                                        SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID - AFF"`
 	ProfessionalOrganization                     XON     `hl7:"2,required,len=250,display=Professional Organization"`
 	ProfessionalOrganizationAddress              *XAD    `hl7:"3,len=250,display=Professional Organization Address"`
 	ProfessionalOrganizationAffiliationDateRange []DR    `hl7:"4,len=52,display=Professional Organization Affiliation Date Range"`
@@ -96,19 +96,19 @@ type AFF struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIG"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIG"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceID               *CWE    `hl7:"3,conditional,len=250,display=Resource ID"`
 	ResourceType             CWE     `hl7:"4,required,len=250,display=Resource Type"`
 	ResourceGroup            []CWE   `hl7:"5,len=250,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,len=5,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,len=5,datatype=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CNE    `hl7:"7,len=250,display=Resource Quantity Units"`
 	StartDateTime            DTM     `hl7:"8,conditional,len=24,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"10,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,len=20,display=Duration"`
+	Duration                 NM      `hl7:"11,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"12,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"13,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"14,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -121,17 +121,17 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIL"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIL"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	LocationResourceID       []PL    `hl7:"3,conditional,len=80,display=Location Resource ID"`
 	LocationType             *CWE    `hl7:"4,conditional,len=250,table=0305,display=Location Type-AIL"`
 	LocationGroup            *CWE    `hl7:"5,len=250,display=Location Group"`
 	StartDateTime            DTM     `hl7:"6,conditional,len=24,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"10,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -144,17 +144,17 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set ID - AIP"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIP"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	PersonnelResourceID      []XCN   `hl7:"3,conditional,len=250,display=Personnel Resource ID"`
 	ResourceType             *CWE    `hl7:"4,conditional,len=250,table=0182,display=Resource Type"`
 	ResourceGroup            *CWE    `hl7:"5,len=250,display=Resource Group"`
 	StartDateTime            DTM     `hl7:"6,conditional,len=24,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"8,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,len=20,display=Duration"`
+	Duration                 NM      `hl7:"9,len=20,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"10,len=250,display=Duration Units"`
-	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode    IS      `hl7:"11,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"12,conditional,len=250,table=0278,display=Filler Status Code"`
 }
 
@@ -165,15 +165,15 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                                  HL7Name `hl7:",name=AIS,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - AIS"`
-	SegmentActionCode                    ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AIS"`
+	SegmentActionCode                    ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	UniversalServiceIdentifier           CWE     `hl7:"3,required,len=705,display=Universal Service Identifier"`
 	StartDateTime                        DTM     `hl7:"4,conditional,len=24,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,display=Start Date/Time Offset"`
+	StartDateTimeOffset                  NM      `hl7:"5,conditional,len=20,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits             *CNE    `hl7:"6,conditional,len=250,display=Start Date/Time Offset Units"`
-	Duration                             NM      `hl7:"7,len=20,display=Duration"`
+	Duration                             NM      `hl7:"7,len=20,datatype=NM,display=Duration"`
 	DurationUnits                        *CNE    `hl7:"8,len=250,display=Duration Units"`
-	AllowSubstitutionCode                IS      `hl7:"9,conditional,len=10,table=0279,display=Allow Substitution Code"`
+	AllowSubstitutionCode                IS      `hl7:"9,conditional,len=10,table=0279,datatype=IS,display=Allow Substitution Code"`
 	FillerStatusCode                     *CWE    `hl7:"10,conditional,len=250,table=0278,display=Filler Status Code"`
 	PlacerSupplementalServiceInformation []CWE   `hl7:"11,len=705,table=0411,display=Placer Supplemental Service Information"`
 	FillerSupplementalServiceInformation []CWE   `hl7:"12,len=705,table=0411,display=Filler Supplemental Service Information"`
@@ -185,7 +185,7 @@ type AIS struct {
 // tables. Each AL1 segment describes a single patient allergy.
 type AL1 struct {
 	HL7                             HL7Name `hl7:",name=AL1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID - AL1"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - AL1"`
 	AllergenTypeCode                *CWE    `hl7:"2,len=705,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription CWE     `hl7:"3,required,len=705,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode             *CWE    `hl7:"4,len=705,table=0128,display=Allergy Severity Code"`
@@ -207,7 +207,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,len=80,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,len=80,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,len=80,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,len=5,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,len=5,datatype=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,len=80,display=Filler Override Criteria"`
 }
 
@@ -219,13 +219,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                  *CWE    `hl7:"5,len=250,display=Schedule ID"`
 	RequestEventReason          *CWE    `hl7:"6,len=250,display=Request Event Reason"`
 	AppointmentReason           *CWE    `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType             *CWE    `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CNE    `hl7:"10,len=250,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,len=49,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,len=5,display=Priority-ARQ"`
@@ -250,7 +250,7 @@ type ARQ struct {
 // the person/patient and the encounter/visit level.
 type ARV struct {
 	HL7                                  HL7Name `hl7:",name=ARV,type=s"`
-	SetID                                SI      `hl7:"1,seq,len=4,display=Set ID"`
+	SetID                                SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID"`
 	AccessRestrictionActionCode          CNE     `hl7:"2,required,len=705,table=0206,display=Access Restriction Action Code"`
 	AccessRestrictionValue               CWE     `hl7:"3,required,len=705,table=0717,display=Access Restriction Value"`
 	AccessRestrictionReason              []CWE   `hl7:"4,len=705,table=0719,display=Access Restriction Reason"`
@@ -271,8 +271,8 @@ type AUT struct {
 	AuthorizationExpirationDate  DTM     `hl7:"5,len=24,format=YMDHM,display=Authorization Expiration Date"`
 	AuthorizationIdentifier      *EI     `hl7:"6,conditional,len=30,display=Authorization Identifier"`
 	ReimbursementLimit           *CP     `hl7:"7,len=25,display=Reimbursement Limit"`
-	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,display=Requested Number of Treatments"`
-	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,display=Authorized Number of Treatments"`
+	RequestedNumberOfTreatments  NM      `hl7:"8,len=2,datatype=NM,display=Requested Number of Treatments"`
+	AuthorizedNumberOfTreatments NM      `hl7:"9,len=2,datatype=NM,display=Authorized Number of Treatments"`
 	ProcessDate                  DTM     `hl7:"10,len=24,format=YMDHM,display=Process Date"`
 }
 
@@ -281,8 +281,8 @@ type AUT struct {
 // The BHS segment defines the start of a batch.
 type BHS struct {
 	HL7                          HL7Name `hl7:",name=BHS,type=s"`
-	BatchFieldSeparator          ST      `hl7:"1,required,len=1,display=Batch Field Separator"`
-	BatchEncodingCharacters      ST      `hl7:"2,required,len=4,display=Batch Encoding Characters"`
+	BatchFieldSeparator          ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=Batch Field Separator"`
+	BatchEncodingCharacters      ST      `hl7:"2,noescape,fieldchars,required,len=4,display=Batch Encoding Characters"`
 	BatchSendingApplication      *HD     `hl7:"3,len=227,display=Batch Sending Application"`
 	BatchSendingFacility         *HD     `hl7:"4,len=227,display=Batch Sending Facility"`
 	BatchReceivingApplication    *HD     `hl7:"5,len=227,display=Batch Receiving Application"`
@@ -313,7 +313,7 @@ type BLC struct {
 type BLG struct {
 	HL7              HL7Name `hl7:",name=BLG,type=s"`
 	WhenToCharge     *CCD    `hl7:"1,len=40,display=When to Charge"`
-	ChargeType       ID      `hl7:"2,len=50,table=0122,display=Charge Type"`
+	ChargeType       ID      `hl7:"2,len=50,table=0122,datatype=ID,display=Charge Type"`
 	AccountID        *CX     `hl7:"3,len=100,display=Account ID"`
 	ChargeTypeReason *CWE    `hl7:"4,len=60,table=0475,display=Charge Type Reason"`
 }
@@ -325,11 +325,11 @@ type BLG struct {
 // processing requirements (e.g., irradiation and leukoreduction) and the amount of the blood product to be administered.
 type BPO struct {
 	HL7                            HL7Name `hl7:",name=BPO,type=s"`
-	SetID                          SI      `hl7:"1,seq,required,len=4,display=Set ID – BPO"`
+	SetID                          SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID – BPO"`
 	BPUniversalServiceIdentifier   CWE     `hl7:"2,required,len=250,display=BP Universal Service Identifier"`
 	BPProcessingRequirements       []CWE   `hl7:"3,len=250,table=0508,display=BP  Processing Requirements"`
-	BPQuantity                     NM      `hl7:"4,required,len=5,display=BP Quantity"`
-	BPAmount                       NM      `hl7:"5,len=5,display=BP Amount"`
+	BPQuantity                     NM      `hl7:"4,required,len=5,datatype=NM,display=BP Quantity"`
+	BPAmount                       NM      `hl7:"5,len=5,datatype=NM,display=BP Amount"`
 	BPUnits                        *CWE    `hl7:"6,len=250,display=BP Units"`
 	BPIntendedUseDateTime          DTM     `hl7:"7,len=24,format=YMDHM,display=BP Intended Use Date/Time"`
 	BPIntendedDispenseFromLocation *PL     `hl7:"8,len=80,display=BP Intended Dispense From Location"`
@@ -338,7 +338,7 @@ type BPO struct {
 	BPRequestedDispenseToLocation  *PL     `hl7:"11,len=80,display=BP Requested Dispense To Location"`
 	BPRequestedDispenseToAddress   *XAD    `hl7:"12,len=250,display=BP Requested Dispense To Address"`
 	BPIndicationForUse             []CWE   `hl7:"13,len=250,table=0509,display=BP Indication for Use"`
-	BPInformedConsentIndicator     ID      `hl7:"14,len=1,table=0136,display=BP Informed Consent Indicator"`
+	BPInformedConsentIndicator     ID      `hl7:"14,len=1,table=0136,datatype=ID,display=BP Informed Consent Indicator"`
 }
 
 // Blood product dispense status
@@ -349,9 +349,9 @@ type BPO struct {
 // segment is similar to an OBX segment, but contains additional attributes.
 type BPX struct {
 	HL7                         HL7Name `hl7:",name=BPX,type=s"`
-	SetID                       SI      `hl7:"1,seq,required,len=4,display=Set ID – BPX"`
+	SetID                       SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID – BPX"`
 	BPDispenseStatus            CWE     `hl7:"2,required,len=250,table=0510,display=BP Dispense Status"`
-	BPStatus                    ID      `hl7:"3,required,len=1,table=0511,display=BP Status"`
+	BPStatus                    ID      `hl7:"3,required,len=1,table=0511,datatype=ID,display=BP Status"`
 	BPDateTimeOfStatus          DTM     `hl7:"4,required,len=24,format=YMDHM,display=BP Date/Time of Status"`
 	BCDonationID                *EI     `hl7:"5,conditional,len=22,display=BC Donation ID"`
 	BCComponent                 *CNE    `hl7:"6,conditional,len=250,display=BC Component"`
@@ -362,8 +362,8 @@ type BPX struct {
 	BPBloodGroup                *CNE    `hl7:"11,len=250,display=BP Blood Group"`
 	BCSpecialTesting            []CNE   `hl7:"12,len=250,display=BC Special Testing"`
 	BPExpirationDateTime        DTM     `hl7:"13,len=24,format=YMDHM,display=BP Expiration Date/Time"`
-	BPQuantity                  NM      `hl7:"14,required,len=5,display=BP Quantity"`
-	BPAmount                    NM      `hl7:"15,len=5,display=BP Amount"`
+	BPQuantity                  NM      `hl7:"14,required,len=5,datatype=NM,display=BP Quantity"`
+	BPAmount                    NM      `hl7:"15,len=5,datatype=NM,display=BP Amount"`
 	BPUnits                     *CWE    `hl7:"16,len=250,display=BP Units"`
 	BPUniqueID                  *EI     `hl7:"17,len=22,display=BP Unique ID"`
 	BPActualDispensedToLocation *PL     `hl7:"18,len=80,display=BP Actual Dispensed To Location"`
@@ -379,24 +379,24 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,len=10,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,len=80,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,len=100,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,len=100,datatype=NM,display=Batch Totals"`
 }
 
 // Blood Product Transfusion/Disposition
 type BTX struct {
 	HL7                                HL7Name `hl7:",name=BTX,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID – BTX"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID – BTX"`
 	BCDonationID                       *EI     `hl7:"2,conditional,len=22,display=BC Donation ID"`
 	BCComponent                        *CNE    `hl7:"3,conditional,len=250,display=BC Component"`
 	BCBloodGroup                       *CNE    `hl7:"4,conditional,len=250,display=BC Blood Group"`
 	CPCommercialProduct                *CWE    `hl7:"5,conditional,len=250,table=0512,display=CP Commercial Product"`
 	CPManufacturer                     *XON    `hl7:"6,conditional,len=250,display=CP Manufacturer"`
 	CPLotNumber                        *EI     `hl7:"7,conditional,len=22,display=CP Lot Number"`
-	BPQuantity                         NM      `hl7:"8,required,len=5,display=BP Quantity"`
-	BPAmount                           NM      `hl7:"9,len=5,display=BP Amount"`
+	BPQuantity                         NM      `hl7:"8,required,len=5,datatype=NM,display=BP Quantity"`
+	BPAmount                           NM      `hl7:"9,len=5,datatype=NM,display=BP Amount"`
 	BPUnits                            *CWE    `hl7:"10,len=250,display=BP Units"`
 	BPTransfusionDispositionStatus     CWE     `hl7:"11,required,len=250,table=0513,display=BP Transfusion/Disposition Status"`
-	BPMessageStatus                    ID      `hl7:"12,required,len=1,table=0511,display=BP Message Status"`
+	BPMessageStatus                    ID      `hl7:"12,required,len=1,table=0511,datatype=ID,display=BP Message Status"`
 	BPDateTimeOfStatus                 DTM     `hl7:"13,required,len=24,format=YMDHM,display=BP Date/Time of Status"`
 	BPAdministrator                    *XCN    `hl7:"14,len=250,display=BP Administrator"`
 	BPVerifier                         *XCN    `hl7:"15,len=250,display=BP Verifier"`
@@ -424,15 +424,15 @@ type CDM struct {
 	ChargeCodeAlias              []CWE   `hl7:"2,len=250,display=Charge Code Alias"`
 	ChargeDescriptionShort       ST      `hl7:"3,required,len=20,display=Charge Description Short"`
 	ChargeDescriptionLong        ST      `hl7:"4,len=250,display=Charge Description Long"`
-	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,display=Description Override Indicator"`
+	DescriptionOverrideIndicator IS      `hl7:"5,len=1,table=0268,datatype=IS,display=Description Override Indicator"`
 	ExplodingCharges             []CWE   `hl7:"6,len=250,table=0132,display=Exploding Charges"`
 	ProcedureCode                []CWE   `hl7:"7,len=705,table=0088,display=Procedure Code"`
-	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	InventoryNumber              []CWE   `hl7:"9,len=250,table=0463,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,len=12,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,len=12,datatype=NM,display=Resource Load"`
 	ContractNumber               []CX    `hl7:"11,len=250,display=Contract Number"`
 	ContractOrganization         []XON   `hl7:"12,len=250,display=Contract Organization"`
-	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
+	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Room Fee Indicator"`
 }
 
 // Certificate Detail
@@ -441,25 +441,25 @@ type CDM struct {
 // held by the health professional identified by the STF segment.
 type CER struct {
 	HL7                                HL7Name `hl7:",name=CER,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID – CER"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID – CER"`
 	SerialNumber                       ST      `hl7:"2,len=80,display=Serial Number"`
 	Version                            ST      `hl7:"3,len=80,display=Version"`
 	GrantingAuthority                  *XON    `hl7:"4,len=250,display=Granting Authority"`
 	IssuingAuthority                   *XCN    `hl7:"5,len=250,display=Issuing Authority"`
 	SignatureOfIssuingAuthority        *ED     `hl7:"6,len=65536,display=Signature of Issuing Authority"`
-	GrantingCountry                    ID      `hl7:"7,len=3,table=0399,display=Granting Country"`
+	GrantingCountry                    ID      `hl7:"7,len=3,table=0399,datatype=ID,display=Granting Country"`
 	GrantingStateProvince              *CWE    `hl7:"8,len=250,table=0347,display=Granting State/Province"`
 	GrantingCountyParish               *CWE    `hl7:"9,len=250,table=0289,display=Granting County/Parish"`
 	CertificateType                    *CWE    `hl7:"10,len=250,display=Certificate Type"`
 	CertificateDomain                  *CWE    `hl7:"11,len=250,display=Certificate Domain"`
-	SubjectID                          ID      `hl7:"12,conditional,len=250,display=Subject ID"`
+	SubjectID                          ID      `hl7:"12,conditional,len=250,datatype=ID,display=Subject ID"`
 	SubjectName                        ST      `hl7:"13,required,len=250,display=Subject Name"`
 	SubjectDirectoryAttributeExtension []CWE   `hl7:"14,len=250,display=Subject Directory Attribute Extension"`
 	SubjectPublicKeyInfo               *CWE    `hl7:"15,len=250,display=Subject Public Key Info"`
 	AuthorityKeyIdentifier             *CWE    `hl7:"16,len=250,display=Authority Key Identifier"`
-	BasicConstraint                    ID      `hl7:"17,len=250,table=0136,display=Basic Constraint"`
+	BasicConstraint                    ID      `hl7:"17,len=250,table=0136,datatype=ID,display=Basic Constraint"`
 	CRLDistributionPoint               []CWE   `hl7:"18,len=250,display=CRL Distribution Point"`
-	JurisdictionCountry                ID      `hl7:"19,len=3,table=0399,display=Jurisdiction Country"`
+	JurisdictionCountry                ID      `hl7:"19,len=3,table=0399,datatype=ID,display=Jurisdiction Country"`
 	JurisdictionStateProvince          *CWE    `hl7:"20,len=250,table=0347,display=Jurisdiction State/Province"`
 	JurisdictionCountyParish           *CWE    `hl7:"21,len=250,table=0289,display=Jurisdiction County/Parish"`
 	JurisdictionBreadth                []CWE   `hl7:"22,len=250,table=0547,display=Jurisdiction Breadth"`
@@ -484,13 +484,13 @@ type CER struct {
 // unless otherwise agreed upon.
 type CM0 struct {
 	HL7                     HL7Name `hl7:",name=CM0,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - CM0"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - CM0"`
 	SponsorStudyID          EI      `hl7:"2,required,len=427,display=Sponsor Study ID"`
 	AlternateStudyID        []EI    `hl7:"3,max=3,len=427,display=Alternate Study ID"`
 	TitleOfStudy            ST      `hl7:"4,required,len=300,display=Title of Study"`
 	ChairmanOfStudy         []XCN   `hl7:"5,len=250,display=Chairman of Study"`
 	LastIRBApprovalDate     DT      `hl7:"6,len=8,format=YMD,display=Last IRB Approval Date"`
-	TotalAccrualToDate      NM      `hl7:"7,len=8,display=Total Accrual to Date"`
+	TotalAccrualToDate      NM      `hl7:"7,len=8,datatype=NM,display=Total Accrual to Date"`
 	LastAccrualDate         DT      `hl7:"8,len=8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy         []XCN   `hl7:"9,len=250,display=Contact for Study"`
 	ContactsTelephoneNumber *XTN    `hl7:"10,len=250,display=Contact's Telephone Number"`
@@ -507,7 +507,7 @@ type CM0 struct {
 // for the receiving system.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set ID - CM1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - CM1"`
 	StudyPhaseIdentifier    CWE     `hl7:"2,required,len=705,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,len=300,display=Description of Study Phase"`
 }
@@ -521,7 +521,7 @@ type CM1 struct {
 // sequence 2.  The CM2 segment describes the scheduled time points in general.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=Set ID - CM2"`
+	SetID                        SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - CM2"`
 	ScheduledTimePoint           CWE     `hl7:"2,required,len=250,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,len=300,display=Description of Time Point"`
 	EventsScheduledThisTimePoint []CWE   `hl7:"4,required,max=200,len=250,display=Events Scheduled This Time Point"`
@@ -533,8 +533,8 @@ type CM2 struct {
 // notifications.
 type CNS struct {
 	HL7                                 HL7Name `hl7:",name=CNS,type=s"`
-	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,display=Starting Notification Reference Number"`
-	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,display=Ending Notification Reference Number"`
+	StartingNotificationReferenceNumber NM      `hl7:"1,len=20,datatype=NM,display=Starting Notification Reference Number"`
+	EndingNotificationReferenceNumber   NM      `hl7:"2,len=20,datatype=NM,display=Ending Notification Reference Number"`
 	StartingNotificationDateTime        DTM     `hl7:"3,len=24,format=YMDHM,display=Starting Notification Date/Time"`
 	EndingNotificationDateTime          DTM     `hl7:"4,len=24,format=YMDHM,display=Ending Notification Date/Time"`
 	StartingNotificationCode            *CWE    `hl7:"5,len=705,display=Starting Notification Code"`
@@ -638,10 +638,10 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set ID - DB1"`
-	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,display=Disabled Person Code"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - DB1"`
+	DisabledPersonCode         IS      `hl7:"2,len=2,table=0334,datatype=IS,display=Disabled Person Code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,len=250,display=Disabled Person Identifier"`
-	DisabledIndicator          ID      `hl7:"4,len=1,table=0136,display=Disabled Indicator"`
+	DisabledIndicator          ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Disabled Indicator"`
 	DisabilityStartDate        DT      `hl7:"5,len=8,format=YMD,display=Disability Start Date"`
 	DisabilityEndDate          DT      `hl7:"6,len=8,format=YMD,display=Disability End Date"`
 	DisabilityReturnToWorkDate DT      `hl7:"7,len=8,format=YMD,display=Disability Return to Work Date"`
@@ -657,12 +657,12 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                             HL7Name `hl7:",name=DG1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID - DG1"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - DG1"`
 	DiagnosisCodingMethod           ST      `hl7:"2,display=Diagnosis Coding Method"`
 	DiagnosisCode                   CWE     `hl7:"3,required,len=250,table=0051,display=Diagnosis Code - DG1"`
 	DiagnosisDescription            ST      `hl7:"4,display=Diagnosis Description"`
 	DiagnosisDateTime               DTM     `hl7:"5,len=24,format=YMDHM,display=Diagnosis Date/Time"`
-	DiagnosisType                   IS      `hl7:"6,required,len=2,table=0052,display=Diagnosis Type"`
+	DiagnosisType                   IS      `hl7:"6,required,len=2,table=0052,datatype=IS,display=Diagnosis Type"`
 	MajorDiagnosticCategory         ST      `hl7:"7,display=Major Diagnostic Category"`
 	DiagnosticRelatedGroup          ST      `hl7:"8,display=Diagnostic Related Group"`
 	DRGApprovalIndicator            ST      `hl7:"9,display=DRG Approval Indicator"`
@@ -671,18 +671,18 @@ type DG1 struct {
 	OutlierDays                     ST      `hl7:"12,display=Outlier Days"`
 	OutlierCost                     ST      `hl7:"13,display=Outlier Cost"`
 	GrouperVersionAndType           ST      `hl7:"14,display=Grouper Version And Type"`
-	DiagnosisPriority               ID      `hl7:"15,len=2,table=0359,display=Diagnosis Priority"`
+	DiagnosisPriority               ID      `hl7:"15,len=2,table=0359,datatype=ID,display=Diagnosis Priority"`
 	DiagnosingClinician             []XCN   `hl7:"16,len=250,display=Diagnosing Clinician"`
-	DiagnosisClassification         IS      `hl7:"17,len=3,table=0228,display=Diagnosis Classification"`
-	ConfidentialIndicator           ID      `hl7:"18,len=1,table=0136,display=Confidential Indicator"`
+	DiagnosisClassification         IS      `hl7:"17,len=3,table=0228,datatype=IS,display=Diagnosis Classification"`
+	ConfidentialIndicator           ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 	AttestationDateTime             DTM     `hl7:"19,len=24,format=YMDHM,display=Attestation Date/Time"`
 	DiagnosisIdentifier             *EI     `hl7:"20,conditional,len=427,display=Diagnosis Identifier"`
-	DiagnosisActionCode             ID      `hl7:"21,conditional,len=1,table=0206,display=Diagnosis Action Code"`
+	DiagnosisActionCode             ID      `hl7:"21,conditional,len=1,table=0206,datatype=ID,display=Diagnosis Action Code"`
 	ParentDiagnosis                 *EI     `hl7:"22,conditional,len=427,display=Parent Diagnosis"`
 	DRGCCLValueCode                 *CWE    `hl7:"23,len=705,table=0728,display=DRG CCL Value Code"`
-	DRGGroupingUsage                ID      `hl7:"24,len=20,table=0136,display=DRG Grouping Usage"`
-	DRGDiagnosisDeterminationStatus IS      `hl7:"25,len=20,table=0731,display=DRG Diagnosis Determination Status"`
-	PresentOnAdmissionPOAIndicator  IS      `hl7:"26,len=1,table=0895,display=Present On Admission (POA) Indicator"`
+	DRGGroupingUsage                ID      `hl7:"24,len=20,table=0136,datatype=ID,display=DRG Grouping Usage"`
+	DRGDiagnosisDeterminationStatus IS      `hl7:"25,len=20,table=0731,datatype=IS,display=DRG Diagnosis Determination Status"`
+	PresentOnAdmissionPOAIndicator  IS      `hl7:"26,len=1,table=0895,datatype=IS,display=Present On Admission (POA) Indicator"`
 }
 
 // DRG Master File Information
@@ -696,8 +696,8 @@ type DMI struct {
 	DiagnosticRelatedGroup  *CNE    `hl7:"1,len=250,table=0055,display=Diagnostic Related Group"`
 	MajorDiagnosticCategory *CNE    `hl7:"2,conditional,len=1,table=0118,display=Major Diagnostic Category"`
 	LowerAndUpperTrimPoints *NR     `hl7:"3,conditional,len=7,display=Lower and Upper Trim Points"`
-	AverageLengthOfStay     NM      `hl7:"4,conditional,len=5,display=Average Length of Stay"`
-	RelativeWeight          NM      `hl7:"5,conditional,len=7,display=Relative Weight"`
+	AverageLengthOfStay     NM      `hl7:"4,conditional,len=5,datatype=NM,display=Average Length of Stay"`
+	RelativeWeight          NM      `hl7:"5,conditional,len=7,datatype=NM,display=Relative Weight"`
 }
 
 // Diagnosis Related Group
@@ -708,37 +708,37 @@ type DRG struct {
 	HL7                        HL7Name `hl7:",name=DRG,type=s"`
 	DiagnosticRelatedGroup     *CNE    `hl7:"1,len=250,table=0055,display=Diagnostic Related Group"`
 	AssignedDateTime           DTM     `hl7:"2,len=24,format=YMDHM,display=DRG Assigned Date/Time"`
-	ApprovalIndicator          ID      `hl7:"3,len=1,table=0136,display=DRG Approval Indicator"`
-	GrouperReviewCode          IS      `hl7:"4,len=2,table=0056,display=DRG Grouper Review Code"`
+	ApprovalIndicator          ID      `hl7:"3,len=1,table=0136,datatype=ID,display=DRG Approval Indicator"`
+	GrouperReviewCode          IS      `hl7:"4,len=2,table=0056,datatype=IS,display=DRG Grouper Review Code"`
 	OutlierType                *CWE    `hl7:"5,len=250,table=0083,display=Outlier Type"`
-	OutlierDays                NM      `hl7:"6,len=3,display=Outlier Days"`
+	OutlierDays                NM      `hl7:"6,len=3,datatype=NM,display=Outlier Days"`
 	OutlierCost                *CP     `hl7:"7,len=12,display=Outlier Cost"`
-	Payor                      IS      `hl7:"8,len=1,table=0229,display=DRG Payor"`
+	Payor                      IS      `hl7:"8,len=1,table=0229,datatype=IS,display=DRG Payor"`
 	OutlierReimbursement       *CP     `hl7:"9,len=9,display=Outlier Reimbursement"`
-	ConfidentialIndicator      ID      `hl7:"10,len=1,table=0136,display=Confidential Indicator"`
-	TransferType               IS      `hl7:"11,len=21,table=0415,display=DRG Transfer Type"`
+	ConfidentialIndicator      ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
+	TransferType               IS      `hl7:"11,len=21,table=0415,datatype=IS,display=DRG Transfer Type"`
 	NameOfCoder                *XPN    `hl7:"12,len=1103,display=Name of Coder"`
 	GrouperStatus              *CWE    `hl7:"13,len=705,table=0734,display=Grouper Status"`
 	PCCLValueCode              *CWE    `hl7:"14,len=20,table=0136,display=PCCL Value Code"`
-	EffectiveWeight            NM      `hl7:"15,len=5,display=Effective Weight"`
+	EffectiveWeight            NM      `hl7:"15,len=5,datatype=NM,display=Effective Weight"`
 	MonetaryAmount             *MO     `hl7:"16,len=20,display=Monetary Amount"`
-	StatusPatient              IS      `hl7:"17,len=20,table=0739,display=Status Patient"`
+	StatusPatient              IS      `hl7:"17,len=20,table=0739,datatype=IS,display=Status Patient"`
 	GrouperSoftwareName        ST      `hl7:"18,len=100,display=Grouper Software Name"`
 	GrouperSoftwareVersion     ST      `hl7:"19,len=100,display=Grouper Software Version"`
-	StatusFinancialCalculation IS      `hl7:"20,len=20,table=0742,display=Status Financial Calculation"`
+	StatusFinancialCalculation IS      `hl7:"20,len=20,table=0742,datatype=IS,display=Status Financial Calculation"`
 	RelativeDiscountSurcharge  *MO     `hl7:"21,len=20,display=Relative Discount/Surcharge"`
 	BasicCharge                *MO     `hl7:"22,len=20,display=Basic Charge"`
 	TotalCharge                *MO     `hl7:"23,len=20,display=Total Charge"`
 	DiscountSurcharge          *MO     `hl7:"24,len=20,display=Discount/Surcharge"`
-	CalculatedDays             NM      `hl7:"25,len=5,display=Calculated Days"`
-	StatusGender               IS      `hl7:"26,len=20,table=0749,display=Status Gender"`
-	StatusAge                  IS      `hl7:"27,len=20,table=0749,display=Status Age"`
-	StatusLengthOfStay         IS      `hl7:"28,len=20,table=0749,display=Status Length of Stay"`
-	StatusSameDayFlag          IS      `hl7:"29,len=20,table=0749,display=Status Same Day Flag"`
-	StatusSeparationMode       IS      `hl7:"30,len=20,table=0749,display=Status Separation Mode"`
-	StatusWeightAtBirth        IS      `hl7:"31,len=20,table=0755,display=Status Weight at Birth"`
-	StatusRespirationMinutes   IS      `hl7:"32,len=20,table=0757,display=Status Respiration Minutes"`
-	StatusAdmission            IS      `hl7:"33,len=20,table=0759,display=Status Admission"`
+	CalculatedDays             NM      `hl7:"25,len=5,datatype=NM,display=Calculated Days"`
+	StatusGender               IS      `hl7:"26,len=20,table=0749,datatype=IS,display=Status Gender"`
+	StatusAge                  IS      `hl7:"27,len=20,table=0749,datatype=IS,display=Status Age"`
+	StatusLengthOfStay         IS      `hl7:"28,len=20,table=0749,datatype=IS,display=Status Length of Stay"`
+	StatusSameDayFlag          IS      `hl7:"29,len=20,table=0749,datatype=IS,display=Status Same Day Flag"`
+	StatusSeparationMode       IS      `hl7:"30,len=20,table=0749,datatype=IS,display=Status Separation Mode"`
+	StatusWeightAtBirth        IS      `hl7:"31,len=20,table=0755,datatype=IS,display=Status Weight at Birth"`
+	StatusRespirationMinutes   IS      `hl7:"32,len=20,table=0757,datatype=IS,display=Status Respiration Minutes"`
+	StatusAdmission            IS      `hl7:"33,len=20,table=0759,datatype=IS,display=Status Admission"`
 }
 
 // Continuation Pointer
@@ -747,7 +747,7 @@ type DRG struct {
 type DSC struct {
 	HL7                 HL7Name `hl7:",name=DSC,type=s"`
 	ContinuationPointer ST      `hl7:"1,len=180,display=Continuation Pointer"`
-	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,display=Continuation Style"`
+	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,datatype=ID,display=Continuation Style"`
 }
 
 // Display Data
@@ -756,8 +756,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetID             SI      `hl7:"1,seq,len=4,display=Set ID - DSP"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetID             SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - DSP"`
+	DisplayLevel      SI      `hl7:"2,len=4,datatype=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,len=300,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,len=2,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,len=20,display=Result ID"`
@@ -768,9 +768,9 @@ type DSP struct {
 // The equipment command segment contains the information required to notify the receiving component what is to happen.
 type ECD struct {
 	HL7                     HL7Name `hl7:",name=ECD,type=s"`
-	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,display=Reference Command Number"`
+	ReferenceCommandNumber  NM      `hl7:"1,required,len=20,datatype=NM,display=Reference Command Number"`
 	RemoteControlCommand    CWE     `hl7:"2,required,len=250,table=0368,display=Remote Control Command"`
-	ResponseRequired        ID      `hl7:"3,len=80,table=0136,display=Response Required"`
+	ResponseRequired        ID      `hl7:"3,len=80,table=0136,datatype=ID,display=Response Required"`
 	RequestedCompletionTime TQ      `hl7:"4,len=200,display=Requested Completion Time"`
 	Parameters              []TX    `hl7:"5,len=65536,display=Parameters"`
 }
@@ -791,8 +791,8 @@ type ECR struct {
 // may optionally follow an STF segment. An EDU segment must always have been preceded by a corresponding STF segment.
 type EDU struct {
 	HL7                                         HL7Name `hl7:",name=EDU,type=s"`
-	SetID                                       SI      `hl7:"1,seq,required,len=60,display=Set ID – EDU"`
-	AcademicDegree                              IS      `hl7:"2,len=10,table=0360,display=Academic Degree"`
+	SetID                                       SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID – EDU"`
+	AcademicDegree                              IS      `hl7:"2,len=10,table=0360,datatype=IS,display=Academic Degree"`
 	AcademicDegreeProgramDateRange              *DR     `hl7:"3,len=52,display=Academic Degree Program Date Range"`
 	AcademicDegreeProgramParticipationDateRange *DR     `hl7:"4,len=52,display=Academic Degree Program Participation Date Range"`
 	AcademicDegreeGrantedDate                   DT      `hl7:"5,len=8,format=YMD,display=Academic Degree Granted Date"`
@@ -809,7 +809,7 @@ type EDU struct {
 type EQL struct {
 	HL7                     HL7Name `hl7:",name=EQL,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	QueryName               CE      `hl7:"3,required,len=250,display=EQL Query Name"`
 	QueryStatement          ST      `hl7:"4,required,len=4096,display=EQL Query Statement"`
 }
@@ -861,12 +861,12 @@ type ERR struct {
 	ErrorCodeAndLocation      []ELD   `hl7:"1,len=493,display=Error Code and Location"`
 	ErrorLocation             []ERL   `hl7:"2,len=18,display=Error Location"`
 	HL7ErrorCode              CWE     `hl7:"3,required,len=705,table=0357,display=HL7 Error Code"`
-	Severity                  ID      `hl7:"4,required,len=2,table=0516,display=Severity"`
+	Severity                  ID      `hl7:"4,required,len=2,table=0516,datatype=ID,display=Severity"`
 	ApplicationErrorCode      *CWE    `hl7:"5,len=705,table=0533,display=Application Error Code"`
 	ApplicationErrorParameter []ST    `hl7:"6,max=10,len=80,display=Application Error Parameter"`
 	DiagnosticInformation     TX      `hl7:"7,len=2048,display=Diagnostic Information"`
 	UserMessage               TX      `hl7:"8,len=250,display=User Message"`
-	InformPersonIndicator     []IS    `hl7:"9,len=20,table=0517,display=Inform Person Indicator"`
+	InformPersonIndicator     []IS    `hl7:"9,len=20,table=0517,datatype=IS,display=Inform Person Indicator"`
 	OverrideType              *CWE    `hl7:"10,len=705,table=0518,display=Override Type"`
 	OverrideReasonCode        []CWE   `hl7:"11,len=705,table=0519,display=Override Reason Code"`
 	HelpDeskContactPoint      []XTN   `hl7:"12,len=652,display=Help Desk Contact Point"`
@@ -878,10 +878,10 @@ type ERR struct {
 // for all chapters are contained in HL7 Table 0003 - Event Type.
 type EVN struct {
 	HL7                  HL7Name `hl7:",name=EVN,type=s"`
-	EventTypeCode        ID      `hl7:"1,len=3,table=0003,display=Event Type Code"`
+	EventTypeCode        ID      `hl7:"1,len=3,table=0003,datatype=ID,display=Event Type Code"`
 	RecordedDateTime     DTM     `hl7:"2,required,len=24,format=YMDHM,display=Recorded Date/Time"`
 	DateTimePlannedEvent DTM     `hl7:"3,len=24,format=YMDHM,display=Date/Time Planned Event"`
-	EventReasonCode      IS      `hl7:"4,len=3,table=0062,display=Event Reason Code"`
+	EventReasonCode      IS      `hl7:"4,len=3,table=0062,datatype=IS,display=Event Reason Code"`
 	OperatorID           []XCN   `hl7:"5,len=250,table=0188,display=Operator ID"`
 	EventOccurred        DTM     `hl7:"6,len=24,format=YMDHM,display=Event Occurred"`
 	EventFacility        *HD     `hl7:"7,len=241,display=Event Facility"`
@@ -891,7 +891,7 @@ type EVN struct {
 type FAC struct {
 	HL7                                 HL7Name `hl7:",name=FAC,type=s"`
 	FacilityID                          EI      `hl7:"1,required,len=427,display=Facility ID-FAC"`
-	FacilityType                        ID      `hl7:"2,len=1,table=0331,display=Facility Type"`
+	FacilityType                        ID      `hl7:"2,len=1,table=0331,datatype=ID,display=Facility Type"`
 	FacilityAddress                     []XAD   `hl7:"3,required,len=2915,display=Facility Address"`
 	FacilityTelecommunication           XTN     `hl7:"4,required,len=2743,display=Facility Telecommunication"`
 	ContactPerson                       []XCN   `hl7:"5,len=3220,display=Contact Person"`
@@ -909,8 +909,8 @@ type FAC struct {
 // The FHS segment is used to head a file (group of batches) as defined in Section 2.10.3, "HL7 batch protocol".
 type FHS struct {
 	HL7                         HL7Name `hl7:",name=FHS,type=s"`
-	FileFieldSeparator          ST      `hl7:"1,required,len=1,display=File Field Separator"`
-	FileEncodingCharacters      ST      `hl7:"2,required,len=4,display=File Encoding Characters"`
+	FileFieldSeparator          ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=File Field Separator"`
+	FileEncodingCharacters      ST      `hl7:"2,noescape,fieldchars,required,len=4,display=File Encoding Characters"`
 	FileSendingApplication      *HD     `hl7:"3,len=227,display=File Sending Application"`
 	FileSendingFacility         *HD     `hl7:"4,len=227,display=File Sending Facility"`
 	FileReceivingApplication    *HD     `hl7:"5,len=227,display=File Receiving Application"`
@@ -931,24 +931,24 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                                        HL7Name `hl7:",name=FT1,type=s"`
-	SetID                                      SI      `hl7:"1,seq,len=4,display=Set ID - FT1"`
+	SetID                                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - FT1"`
 	TransactionID                              ST      `hl7:"2,len=12,display=Transaction ID"`
 	TransactionBatchID                         ST      `hl7:"3,len=10,display=Transaction Batch ID"`
 	TransactionDate                            DR      `hl7:"4,required,len=53,display=Transaction Date"`
 	TransactionPostingDate                     DTM     `hl7:"5,len=24,format=YMDHM,display=Transaction Posting Date"`
-	TransactionType                            IS      `hl7:"6,required,len=8,table=0017,display=Transaction Type"`
+	TransactionType                            IS      `hl7:"6,required,len=8,table=0017,datatype=IS,display=Transaction Type"`
 	TransactionCode                            CWE     `hl7:"7,required,len=250,table=0132,display=Transaction Code"`
 	TransactionDescription                     ST      `hl7:"8,display=Transaction Description"`
 	TransactionDescriptionAlt                  ST      `hl7:"9,display=Transaction Description - Alt"`
-	TransactionQuantity                        NM      `hl7:"10,len=6,display=Transaction Quantity"`
+	TransactionQuantity                        NM      `hl7:"10,len=6,datatype=NM,display=Transaction Quantity"`
 	TransactionAmountExtended                  *CP     `hl7:"11,len=12,display=Transaction Amount - Extended"`
 	TransactionAmountUnit                      *CP     `hl7:"12,len=12,display=Transaction Amount - Unit"`
 	DepartmentCode                             *CWE    `hl7:"13,len=250,table=0049,display=Department Code"`
 	InsurancePlanID                            *CWE    `hl7:"14,len=250,table=0072,display=Insurance Plan ID"`
 	InsuranceAmount                            *CP     `hl7:"15,len=12,display=Insurance Amount"`
 	AssignedPatientLocation                    *PL     `hl7:"16,len=80,display=Assigned Patient Location"`
-	FeeSchedule                                IS      `hl7:"17,len=1,table=0024,display=Fee Schedule"`
-	PatientType                                IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	FeeSchedule                                IS      `hl7:"17,len=1,table=0024,datatype=IS,display=Fee Schedule"`
+	PatientType                                IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	DiagnosisCode                              []CWE   `hl7:"19,len=250,table=0051,display=Diagnosis Code - FT1"`
 	PerformedByCode                            []XCN   `hl7:"20,len=250,table=0084,display=Performed By Code"`
 	OrderedByCode                              []XCN   `hl7:"21,len=250,display=Ordered By Code"`
@@ -961,7 +961,7 @@ type FT1 struct {
 	MedicallyNecessaryDuplicateProcedureReason *CWE    `hl7:"28,len=705,table=0476,display=Medically Necessary Duplicate Procedure Reason."`
 	NDCCode                                    *CWE    `hl7:"29,len=250,table=0549,display=NDC Code"`
 	PaymentReferenceID                         *CX     `hl7:"30,len=250,display=Payment Reference ID"`
-	TransactionReferenceKey                    []SI    `hl7:"31,len=4,display=Transaction Reference Key"`
+	TransactionReferenceKey                    []SI    `hl7:"31,len=4,datatype=SI,display=Transaction Reference Key"`
 }
 
 // File Trailer
@@ -969,7 +969,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,len=10,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,len=10,datatype=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,len=80,display=File Trailer Comment"`
 }
 
@@ -978,12 +978,12 @@ type FTS struct {
 // The goal detail segment contains the data necessary to add, update, correct, and delete the goals for an individual.
 type GOL struct {
 	HL7                         HL7Name `hl7:",name=GOL,type=s"`
-	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime              DTM     `hl7:"2,required,len=24,format=YMDHM,display=Action Date/Time"`
 	GoalID                      CWE     `hl7:"3,required,len=705,display=Goal ID"`
 	GoalInstanceID              EI      `hl7:"4,required,len=60,display=Goal Instance ID"`
 	EpisodeOfCareID             *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	GoalListPriority            NM      `hl7:"6,len=60,display=Goal List Priority"`
+	GoalListPriority            NM      `hl7:"6,len=60,datatype=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime     DTM     `hl7:"7,len=24,format=YMDHM,display=Goal Established Date/Time"`
 	ExpectedGoalAchieveDateTime DTM     `hl7:"8,len=24,format=YMDHM,display=Expected Goal Achieve Date/Time"`
 	GoalClassification          *CWE    `hl7:"9,len=705,display=Goal Classification"`
@@ -1010,10 +1010,10 @@ type GOL struct {
 // The GP1 segment is specific to the US and may not be implemented in non-US systems.
 type GP1 struct {
 	HL7                         HL7Name `hl7:",name=GP1,type=s"`
-	TypeOfBillCode              IS      `hl7:"1,required,len=3,table=0455,display=Type of Bill Code"`
-	RevenueCode                 []IS    `hl7:"2,len=3,table=0456,display=Revenue Code"`
-	OverallClaimDispositionCode IS      `hl7:"3,len=1,table=0457,display=Overall Claim Disposition Code"`
-	OCEEditsPerVisitCode        []IS    `hl7:"4,len=2,table=0458,display=OCE Edits per Visit Code"`
+	TypeOfBillCode              IS      `hl7:"1,required,len=3,table=0455,datatype=IS,display=Type of Bill Code"`
+	RevenueCode                 []IS    `hl7:"2,len=3,table=0456,datatype=IS,display=Revenue Code"`
+	OverallClaimDispositionCode IS      `hl7:"3,len=1,table=0457,datatype=IS,display=Overall Claim Disposition Code"`
+	OCEEditsPerVisitCode        []IS    `hl7:"4,len=2,table=0458,datatype=IS,display=OCE Edits per Visit Code"`
 	OutlierCost                 *CP     `hl7:"5,len=12,display=Outlier Cost"`
 }
 
@@ -1024,20 +1024,20 @@ type GP1 struct {
 // The GP2 segment is specific to the US and may not be implemented in non-US systems.
 type GP2 struct {
 	HL7                                 HL7Name `hl7:",name=GP2,type=s"`
-	RevenueCode                         IS      `hl7:"1,len=3,table=0456,display=Revenue Code"`
-	NumberOfServiceUnits                NM      `hl7:"2,len=7,display=Number of Service Units"`
+	RevenueCode                         IS      `hl7:"1,len=3,table=0456,datatype=IS,display=Revenue Code"`
+	NumberOfServiceUnits                NM      `hl7:"2,len=7,datatype=NM,display=Number of Service Units"`
 	Charge                              *CP     `hl7:"3,len=12,display=Charge"`
-	ReimbursementActionCode             IS      `hl7:"4,len=1,table=0459,display=Reimbursement Action Code"`
-	DenialOrRejectionCode               IS      `hl7:"5,len=1,table=0460,display=Denial or Rejection Code"`
-	OCEEditCode                         []IS    `hl7:"6,len=3,table=0458,display=OCE Edit Code"`
+	ReimbursementActionCode             IS      `hl7:"4,len=1,table=0459,datatype=IS,display=Reimbursement Action Code"`
+	DenialOrRejectionCode               IS      `hl7:"5,len=1,table=0460,datatype=IS,display=Denial or Rejection Code"`
+	OCEEditCode                         []IS    `hl7:"6,len=3,table=0458,datatype=IS,display=OCE Edit Code"`
 	AmbulatoryPaymentClassificationCode *CWE    `hl7:"7,len=250,table=0466,display=Ambulatory Payment Classification Code"`
-	ModifierEditCode                    []IS    `hl7:"8,len=1,table=0467,display=Modifier Edit Code"`
-	PaymentAdjustmentCode               IS      `hl7:"9,len=1,table=0468,display=Payment Adjustment Code"`
-	PackagingStatusCode                 IS      `hl7:"10,len=1,table=0469,display=Packaging Status Code"`
+	ModifierEditCode                    []IS    `hl7:"8,len=1,table=0467,datatype=IS,display=Modifier Edit Code"`
+	PaymentAdjustmentCode               IS      `hl7:"9,len=1,table=0468,datatype=IS,display=Payment Adjustment Code"`
+	PackagingStatusCode                 IS      `hl7:"10,len=1,table=0469,datatype=IS,display=Packaging Status Code"`
 	ExpectedCMSPaymentAmount            *CP     `hl7:"11,len=12,display=Expected CMS Payment Amount"`
-	ReimbursementTypeCode               IS      `hl7:"12,len=2,table=0470,display=Reimbursement Type Code"`
+	ReimbursementTypeCode               IS      `hl7:"12,len=2,table=0470,datatype=IS,display=Reimbursement Type Code"`
 	CoPayAmount                         *CP     `hl7:"13,len=12,display=Co-Pay Amount"`
-	PayRatePerServiceUnit               NM      `hl7:"14,len=4,display=Pay Rate per Service Unit"`
+	PayRatePerServiceUnit               NM      `hl7:"14,len=4,datatype=NM,display=Pay Rate per Service Unit"`
 }
 
 // Guarantor
@@ -1046,7 +1046,7 @@ type GP2 struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - GT1"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - GT1"`
 	GuarantorNumber                    []CX    `hl7:"2,len=250,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,len=250,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,len=250,display=Guarantor Spouse Name"`
@@ -1054,38 +1054,38 @@ type GT1 struct {
 	GuarantorPhNumHome                 []XTN   `hl7:"6,len=250,display=Guarantor Ph Num - Home"`
 	GuarantorPhNumBusiness             []XTN   `hl7:"7,len=250,display=Guarantor Ph Num - Business"`
 	GuarantorDateTimeOfBirth           DTM     `hl7:"8,len=24,format=YMDHM,display=Guarantor Date/Time Of Birth"`
-	GuarantorAdministrativeSex         IS      `hl7:"9,len=1,table=0001,display=Guarantor Administrative Sex"`
-	GuarantorType                      IS      `hl7:"10,len=2,table=0068,display=Guarantor Type"`
+	GuarantorAdministrativeSex         IS      `hl7:"9,len=1,table=0001,datatype=IS,display=Guarantor Administrative Sex"`
+	GuarantorType                      IS      `hl7:"10,len=2,table=0068,datatype=IS,display=Guarantor Type"`
 	GuarantorRelationship              *CWE    `hl7:"11,len=250,table=0063,display=Guarantor Relationship"`
 	GuarantorSSN                       ST      `hl7:"12,len=11,display=Guarantor SSN"`
 	GuarantorDateBegin                 DT      `hl7:"13,len=8,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,len=8,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,datatype=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,len=250,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,len=250,display=Guarantor Employer Address"`
 	GuarantorEmployerPhoneNumber       []XTN   `hl7:"18,len=250,display=Guarantor Employer Phone Number"`
 	GuarantorEmployeeIDNumber          []CX    `hl7:"19,len=250,display=Guarantor Employee ID Number"`
-	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,display=Guarantor Employment Status"`
+	GuarantorEmploymentStatus          IS      `hl7:"20,len=2,table=0066,datatype=IS,display=Guarantor Employment Status"`
 	GuarantorOrganizationName          []XON   `hl7:"21,len=250,display=Guarantor Organization Name"`
-	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,display=Guarantor Billing Hold Flag"`
+	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Guarantor Billing Hold Flag"`
 	GuarantorCreditRatingCode          *CWE    `hl7:"23,len=250,table=0341,display=Guarantor Credit Rating Code"`
 	GuarantorDeathDateAndTime          DTM     `hl7:"24,len=24,format=YMDHM,display=Guarantor Death Date And Time"`
-	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
+	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CWE    `hl7:"26,len=250,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,len=10,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,len=3,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,len=3,datatype=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,len=250,display=Guarantor Employer ID Number"`
 	GuarantorMaritalStatusCode         *CWE    `hl7:"30,len=250,table=0002,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,len=8,format=YMD,display=Guarantor Hire Effective Date"`
 	EmploymentStopDate                 DT      `hl7:"32,len=8,format=YMD,display=Employment Stop Date"`
-	LivingDependency                   IS      `hl7:"33,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                   []IS    `hl7:"34,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                   IS      `hl7:"33,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                   []IS    `hl7:"34,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                        []CWE   `hl7:"35,len=705,table=0171,display=Citizenship"`
 	PrimaryLanguage                    *CWE    `hl7:"36,len=705,table=0296,display=Primary Language"`
-	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                  IS      `hl7:"37,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                      *CWE    `hl7:"38,len=705,table=0215,display=Publicity Code"`
-	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                   IS      `hl7:"40,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                           *CWE    `hl7:"41,len=705,table=0006,display=Religion"`
 	MothersMaidenName                  []XPN   `hl7:"42,len=250,display=Mother's Maiden Name"`
 	Nationality                        *CWE    `hl7:"43,len=705,table=0212,display=Nationality"`
@@ -1093,16 +1093,16 @@ type GT1 struct {
 	ContactPersonsName                 []XPN   `hl7:"45,len=250,display=Contact Person's Name"`
 	ContactPersonsTelephoneNumber      []XTN   `hl7:"46,len=250,display=Contact Person's Telephone Number"`
 	ContactReason                      *CWE    `hl7:"47,len=705,table=0212,display=Contact Reason"`
-	ContactRelationship                IS      `hl7:"48,len=3,table=0063,display=Contact Relationship"`
+	ContactRelationship                IS      `hl7:"48,len=3,table=0063,datatype=IS,display=Contact Relationship"`
 	JobTitle                           ST      `hl7:"49,len=20,display=Job Title"`
 	JobCodeClass                       *JCC    `hl7:"50,len=20,display=Job Code/Class"`
 	GuarantorEmployersOrganizationName []XON   `hl7:"51,len=250,display=Guarantor Employer's Organization Name"`
-	Handicap                           IS      `hl7:"52,len=2,table=0295,display=Handicap"`
-	JobStatus                          IS      `hl7:"53,len=2,table=0311,display=Job Status"`
+	Handicap                           IS      `hl7:"52,len=2,table=0295,datatype=IS,display=Handicap"`
+	JobStatus                          IS      `hl7:"53,len=2,table=0311,datatype=IS,display=Job Status"`
 	GuarantorFinancialClass            *FC     `hl7:"54,len=50,display=Guarantor Financial Class"`
 	GuarantorRace                      []CWE   `hl7:"55,len=250,table=0005,display=Guarantor Race"`
 	GuarantorBirthPlace                ST      `hl7:"56,len=250,display=Guarantor Birth Place"`
-	VIPIndicator                       IS      `hl7:"57,len=2,table=0099,display=VIP Indicator"`
+	VIPIndicator                       IS      `hl7:"57,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 }
 
 // Patient Adverse Reaction Information
@@ -1115,7 +1115,7 @@ type GT1 struct {
 // 2.10.4.1.
 type IAM struct {
 	HL7                                  HL7Name `hl7:",name=IAM,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set ID - IAM"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IAM"`
 	AllergenTypeCode                     *CWE    `hl7:"2,len=705,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription      CWE     `hl7:"3,required,len=705,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode                  *CWE    `hl7:"4,len=705,table=0128,display=Allergy Severity Code"`
@@ -1152,11 +1152,11 @@ type IIM struct {
 	InventoryManufacturerName     *CWE    `hl7:"5,len=250,display=Inventory Manufacturer Name"`
 	InventoryLocation             *CWE    `hl7:"6,len=250,display=Inventory Location"`
 	InventoryReceivedDate         DTM     `hl7:"7,len=24,format=YMDHM,display=Inventory Received Date"`
-	InventoryReceivedQuantity     NM      `hl7:"8,len=12,display=Inventory Received Quantity"`
+	InventoryReceivedQuantity     NM      `hl7:"8,len=12,datatype=NM,display=Inventory Received Quantity"`
 	InventoryReceivedQuantityUnit *CWE    `hl7:"9,len=250,display=Inventory Received Quantity Unit"`
 	InventoryReceivedItemCost     *MO     `hl7:"10,len=12,display=Inventory Received Item Cost"`
 	InventoryOnHandDate           DTM     `hl7:"11,len=24,format=YMDHM,display=Inventory On Hand Date"`
-	InventoryOnHandQuantity       NM      `hl7:"12,len=12,display=Inventory On Hand Quantity"`
+	InventoryOnHandQuantity       NM      `hl7:"12,len=12,datatype=NM,display=Inventory On Hand Quantity"`
 	InventoryOnHandQuantityUnit   *CWE    `hl7:"13,len=250,display=Inventory On Hand Quantity Unit"`
 	ProcedureCode                 *CNE    `hl7:"14,len=705,table=0088,display=Procedure Code"`
 	ProcedureCodeModifier         []CNE   `hl7:"15,len=705,table=0340,display=Procedure Code Modifier"`
@@ -1168,15 +1168,15 @@ type IIM struct {
 // with the item in the IVT segment. This segment is similar to the IIM segment used with the limited inventory item master message.
 type ILT struct {
 	HL7                           HL7Name `hl7:",name=ILT,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set Id - ILT"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - ILT"`
 	InventoryLotNumber            ST      `hl7:"2,required,len=250,display=Inventory Lot Number"`
 	InventoryExpirationDate       DTM     `hl7:"3,len=24,format=YMDHM,display=Inventory Expiration Date"`
 	InventoryReceivedDate         DTM     `hl7:"4,len=24,format=YMDHM,display=Inventory Received Date"`
-	InventoryReceivedQuantity     NM      `hl7:"5,len=12,display=Inventory Received Quantity"`
+	InventoryReceivedQuantity     NM      `hl7:"5,len=12,datatype=NM,display=Inventory Received Quantity"`
 	InventoryReceivedQuantityUnit *CWE    `hl7:"6,len=250,display=Inventory Received Quantity Unit"`
 	InventoryReceivedItemCost     *MO     `hl7:"7,len=12,display=Inventory Received Item Cost"`
 	InventoryOnHandDate           DTM     `hl7:"8,len=24,format=YMDHM,display=Inventory On Hand Date"`
-	InventoryOnHandQuantity       NM      `hl7:"9,len=12,display=Inventory On Hand Quantity"`
+	InventoryOnHandQuantity       NM      `hl7:"9,len=12,datatype=NM,display=Inventory On Hand Quantity"`
 	InventoryOnHandQuantityUnit   *CWE    `hl7:"10,len=250,display=Inventory On Hand Quantity Unit"`
 }
 
@@ -1186,7 +1186,7 @@ type ILT struct {
 // and insurance bills.
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set ID - IN1"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IN1"`
 	InsurancePlanID               CWE     `hl7:"2,required,len=250,table=0072,display=Insurance Plan ID"`
 	InsuranceCompanyID            []CX    `hl7:"3,required,len=250,display=Insurance Company ID"`
 	InsuranceCompanyName          []XON   `hl7:"4,len=250,display=Insurance Company Name"`
@@ -1200,45 +1200,45 @@ type IN1 struct {
 	PlanEffectiveDate             DT      `hl7:"12,len=8,format=YMD,display=Plan Effective Date"`
 	PlanExpirationDate            DT      `hl7:"13,len=8,format=YMD,display=Plan Expiration Date"`
 	AuthorizationInformation      *AUI    `hl7:"14,len=239,display=Authorization Information"`
-	PlanType                      IS      `hl7:"15,len=3,table=0086,display=Plan Type"`
+	PlanType                      IS      `hl7:"15,len=3,table=0086,datatype=IS,display=Plan Type"`
 	NameOfInsured                 []XPN   `hl7:"16,len=250,display=Name Of Insured"`
 	InsuredsRelationshipToPatient *CWE    `hl7:"17,len=250,table=0063,display=Insured's Relationship To Patient"`
 	InsuredsDateOfBirth           DTM     `hl7:"18,len=24,format=YMDHM,display=Insured's Date Of Birth"`
 	InsuredsAddress               []XAD   `hl7:"19,len=250,display=Insured's Address"`
-	AssignmentOfBenefits          IS      `hl7:"20,len=2,table=0135,display=Assignment Of Benefits"`
-	CoordinationOfBenefits        IS      `hl7:"21,len=2,table=0173,display=Coordination Of Benefits"`
+	AssignmentOfBenefits          IS      `hl7:"20,len=2,table=0135,datatype=IS,display=Assignment Of Benefits"`
+	CoordinationOfBenefits        IS      `hl7:"21,len=2,table=0173,datatype=IS,display=Coordination Of Benefits"`
 	CoordOfBenPriority            ST      `hl7:"22,len=2,display=Coord Of Ben. Priority"`
-	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,display=Notice Of Admission Flag"`
+	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Notice Of Admission Flag"`
 	NoticeOfAdmissionDate         DT      `hl7:"24,len=8,format=YMD,display=Notice Of Admission Date"`
-	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,display=Report Of Eligibility Flag"`
+	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Report Of Eligibility Flag"`
 	ReportOfEligibilityDate       DT      `hl7:"26,len=8,format=YMD,display=Report Of Eligibility Date"`
-	ReleaseInformationCode        IS      `hl7:"27,len=2,table=0093,display=Release Information Code"`
+	ReleaseInformationCode        IS      `hl7:"27,len=2,table=0093,datatype=IS,display=Release Information Code"`
 	PreAdmitCertPAC               ST      `hl7:"28,len=15,display=Pre-Admit Cert (PAC)"`
 	VerificationDateTime          DTM     `hl7:"29,len=24,format=YMDHM,display=Verification Date/Time"`
 	VerificationBy                []XCN   `hl7:"30,len=250,display=Verification By"`
-	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,display=Type Of Agreement Code"`
-	BillingStatus                 IS      `hl7:"32,len=2,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,len=4,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,len=4,display=Delay Before L.R. Day"`
-	CompanyPlanCode               IS      `hl7:"35,len=20,table=0042,display=Company Plan Code"`
+	TypeOfAgreementCode           IS      `hl7:"31,len=2,table=0098,datatype=IS,display=Type Of Agreement Code"`
+	BillingStatus                 IS      `hl7:"32,len=2,table=0022,datatype=IS,display=Billing Status"`
+	LifetimeReserveDays           NM      `hl7:"33,len=4,datatype=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,len=4,datatype=NM,display=Delay Before L.R. Day"`
+	CompanyPlanCode               IS      `hl7:"35,len=20,table=0042,datatype=IS,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,len=15,display=Policy Number"`
 	PolicyDeductible              *CP     `hl7:"37,len=12,display=Policy Deductible"`
 	PolicyLimitAmount             ST      `hl7:"38,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,len=4,display=Policy Limit - Days"`
+	PolicyLimitDays               NM      `hl7:"39,len=4,datatype=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate           ST      `hl7:"40,display=Room Rate - Semi-Private"`
 	RoomRatePrivate               ST      `hl7:"41,display=Room Rate - Private"`
 	InsuredsEmploymentStatus      *CWE    `hl7:"42,len=250,table=0066,display=Insured's Employment Status"`
-	InsuredsAdministrativeSex     IS      `hl7:"43,len=1,table=0001,display=Insured's Administrative Sex"`
+	InsuredsAdministrativeSex     IS      `hl7:"43,len=1,table=0001,datatype=IS,display=Insured's Administrative Sex"`
 	InsuredsEmployersAddress      []XAD   `hl7:"44,len=250,display=Insured's Employer's Address"`
 	VerificationStatus            ST      `hl7:"45,len=2,display=Verification Status"`
-	PriorInsurancePlanID          IS      `hl7:"46,len=8,table=0072,display=Prior Insurance Plan ID"`
-	CoverageType                  IS      `hl7:"47,len=3,table=0309,display=Coverage Type"`
-	Handicap                      IS      `hl7:"48,len=2,table=0295,display=Handicap"`
+	PriorInsurancePlanID          IS      `hl7:"46,len=8,table=0072,datatype=IS,display=Prior Insurance Plan ID"`
+	CoverageType                  IS      `hl7:"47,len=3,table=0309,datatype=IS,display=Coverage Type"`
+	Handicap                      IS      `hl7:"48,len=2,table=0295,datatype=IS,display=Handicap"`
 	InsuredsIDNumber              []CX    `hl7:"49,len=250,display=Insured's ID Number"`
-	SignatureCode                 IS      `hl7:"50,len=1,table=0535,display=Signature Code"`
+	SignatureCode                 IS      `hl7:"50,len=1,table=0535,datatype=IS,display=Signature Code"`
 	SignatureCodeDate             DT      `hl7:"51,len=8,format=YMD,display=Signature Code Date"`
 	InsuredsBirthPlace            ST      `hl7:"52,len=250,display=Insured's Birth Place"`
-	VIPIndicator                  IS      `hl7:"53,len=2,table=0099,display=VIP Indicator"`
+	VIPIndicator                  IS      `hl7:"53,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 }
 
 // Insurance Additional Information
@@ -1250,8 +1250,8 @@ type IN2 struct {
 	InsuredsEmployeeID                   []CX    `hl7:"1,len=250,display=Insured's Employee ID"`
 	InsuredsSocialSecurityNumber         ST      `hl7:"2,len=11,display=Insured's Social Security Number"`
 	InsuredsEmployersNameAndID           []XCN   `hl7:"3,len=250,display=Insured's Employer's Name and ID"`
-	EmployerInformationData              IS      `hl7:"4,len=1,table=0139,display=Employer Information Data"`
-	MailClaimParty                       []IS    `hl7:"5,len=1,table=0137,display=Mail Claim Party"`
+	EmployerInformationData              IS      `hl7:"4,len=1,table=0139,datatype=IS,display=Employer Information Data"`
+	MailClaimParty                       []IS    `hl7:"5,len=1,table=0137,datatype=IS,display=Mail Claim Party"`
 	MedicareHealthInsCardNumber          ST      `hl7:"6,len=15,display=Medicare Health Ins Card Number"`
 	MedicaidCaseName                     []XPN   `hl7:"7,len=250,display=Medicaid Case Name"`
 	MedicaidCaseNumber                   ST      `hl7:"8,len=15,display=Medicaid Case Number"`
@@ -1260,31 +1260,31 @@ type IN2 struct {
 	DependentOfMilitaryRecipient         *CWE    `hl7:"11,len=250,table=0342,display=Dependent Of Military Recipient"`
 	MilitaryOrganization                 ST      `hl7:"12,len=25,display=Military Organization"`
 	MilitaryStation                      ST      `hl7:"13,len=25,display=Military Station"`
-	MilitaryService                      IS      `hl7:"14,len=14,table=0140,display=Military Service"`
-	MilitaryRankGrade                    IS      `hl7:"15,len=2,table=0141,display=Military Rank/Grade"`
-	MilitaryStatus                       IS      `hl7:"16,len=3,table=0142,display=Military Status"`
+	MilitaryService                      IS      `hl7:"14,len=14,table=0140,datatype=IS,display=Military Service"`
+	MilitaryRankGrade                    IS      `hl7:"15,len=2,table=0141,datatype=IS,display=Military Rank/Grade"`
+	MilitaryStatus                       IS      `hl7:"16,len=3,table=0142,datatype=IS,display=Military Status"`
 	MilitaryRetireDate                   DT      `hl7:"17,len=8,format=YMD,display=Military Retire Date"`
-	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,display=Military Non-Avail Cert On File"`
-	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,display=Baby Coverage"`
-	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,display=Combine Baby Bill"`
+	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Military Non-Avail Cert On File"`
+	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Baby Coverage"`
+	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Combine Baby Bill"`
 	BloodDeductible                      ST      `hl7:"21,len=1,display=Blood Deductible"`
 	SpecialCoverageApprovalName          []XPN   `hl7:"22,len=250,display=Special Coverage Approval Name"`
 	SpecialCoverageApprovalTitle         ST      `hl7:"23,len=30,display=Special Coverage Approval Title"`
-	NonCoveredInsuranceCode              []IS    `hl7:"24,len=8,table=0143,display=Non-Covered Insurance Code"`
+	NonCoveredInsuranceCode              []IS    `hl7:"24,len=8,table=0143,datatype=IS,display=Non-Covered Insurance Code"`
 	PayorID                              []CX    `hl7:"25,len=250,display=Payor ID"`
 	PayorSubscriberID                    []CX    `hl7:"26,len=250,display=Payor Subscriber ID"`
-	EligibilitySource                    IS      `hl7:"27,len=1,table=0144,display=Eligibility Source"`
+	EligibilitySource                    IS      `hl7:"27,len=1,table=0144,datatype=IS,display=Eligibility Source"`
 	RoomCoverageTypeAmount               []RMC   `hl7:"28,len=82,display=Room Coverage Type/Amount"`
 	PolicyTypeAmount                     []PTA   `hl7:"29,len=56,display=Policy Type/Amount"`
 	DailyDeductible                      *DDI    `hl7:"30,len=25,display=Daily Deductible"`
-	LivingDependency                     IS      `hl7:"31,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                     []IS    `hl7:"32,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                     IS      `hl7:"31,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                     []IS    `hl7:"32,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                          []CWE   `hl7:"33,len=705,table=0171,display=Citizenship"`
 	PrimaryLanguage                      *CWE    `hl7:"34,len=705,table=0296,display=Primary Language"`
-	LivingArrangement                    IS      `hl7:"35,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                    IS      `hl7:"35,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                        *CWE    `hl7:"36,len=705,table=0215,display=Publicity Code"`
-	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                     IS      `hl7:"38,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                     IS      `hl7:"38,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                             *CWE    `hl7:"39,len=705,table=0006,display=Religion"`
 	MothersMaidenName                    []XPN   `hl7:"40,len=250,display=Mother's Maiden Name"`
 	Nationality                          *CWE    `hl7:"41,len=705,table=0212,display=Nationality"`
@@ -1294,27 +1294,27 @@ type IN2 struct {
 	EmploymentStopDate                   DT      `hl7:"45,len=8,format=YMD,display=Employment Stop Date"`
 	JobTitle                             ST      `hl7:"46,len=20,display=Job Title"`
 	JobCodeClass                         *JCC    `hl7:"47,len=20,display=Job Code/Class"`
-	JobStatus                            IS      `hl7:"48,len=2,table=0311,display=Job Status"`
+	JobStatus                            IS      `hl7:"48,len=2,table=0311,datatype=IS,display=Job Status"`
 	EmployerContactPersonName            []XPN   `hl7:"49,len=250,display=Employer Contact Person Name"`
 	EmployerContactPersonPhoneNumber     []XTN   `hl7:"50,len=250,display=Employer Contact Person Phone Number"`
-	EmployerContactReason                IS      `hl7:"51,len=2,table=0222,display=Employer Contact Reason"`
+	EmployerContactReason                IS      `hl7:"51,len=2,table=0222,datatype=IS,display=Employer Contact Reason"`
 	InsuredsContactPersonsName           []XPN   `hl7:"52,len=250,display=Insured's Contact Person's Name"`
 	InsuredsContactPersonPhoneNumber     []XTN   `hl7:"53,len=250,display=Insured's Contact Person Phone Number"`
-	InsuredsContactPersonReason          []IS    `hl7:"54,len=2,table=0222,display=Insured's Contact Person Reason"`
+	InsuredsContactPersonReason          []IS    `hl7:"54,len=2,table=0222,datatype=IS,display=Insured's Contact Person Reason"`
 	RelationshipToThePatientStartDate    DT      `hl7:"55,len=8,format=YMD,display=Relationship to the Patient Start Date"`
 	RelationshipToThePatientStopDate     []DT    `hl7:"56,len=8,format=YMD,display=Relationship to the Patient Stop Date"`
-	InsuranceCoContactReason             IS      `hl7:"57,len=2,table=0232,display=Insurance Co. Contact Reason"`
+	InsuranceCoContactReason             IS      `hl7:"57,len=2,table=0232,datatype=IS,display=Insurance Co. Contact Reason"`
 	InsuranceCoContactPhoneNumber        []XTN   `hl7:"58,len=250,display=Insurance Co Contact Phone Number"`
-	PolicyScope                          IS      `hl7:"59,len=2,table=0312,display=Policy Scope"`
-	PolicySource                         IS      `hl7:"60,len=2,table=0313,display=Policy Source"`
+	PolicyScope                          IS      `hl7:"59,len=2,table=0312,datatype=IS,display=Policy Scope"`
+	PolicySource                         IS      `hl7:"60,len=2,table=0313,datatype=IS,display=Policy Source"`
 	PatientMemberNumber                  *CX     `hl7:"61,len=250,display=Patient Member Number"`
 	GuarantorsRelationshipToInsured      *CWE    `hl7:"62,len=250,table=0063,display=Guarantor's Relationship To Insured"`
 	InsuredsPhoneNumberHome              []XTN   `hl7:"63,len=250,display=Insured's Phone Number - Home"`
 	InsuredsEmployerPhoneNumber          []XTN   `hl7:"64,len=250,display=Insured's Employer Phone Number"`
 	MilitaryHandicappedProgram           *CWE    `hl7:"65,len=250,table=0343,display=Military Handicapped Program"`
-	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,display=Suspend Flag"`
-	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,display=Copay Limit Flag"`
-	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,display=Stoploss Limit Flag"`
+	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,datatype=ID,display=Suspend Flag"`
+	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,datatype=ID,display=Copay Limit Flag"`
+	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,datatype=ID,display=Stoploss Limit Flag"`
 	InsuredOrganizationNameAndID         []XON   `hl7:"69,len=250,display=Insured Organization Name and ID"`
 	InsuredEmployerOrganizationNameAndID []XON   `hl7:"70,len=250,display=Insured Employer Organization Name and ID"`
 	Race                                 []CWE   `hl7:"71,len=705,table=0005,display=Race"`
@@ -1327,10 +1327,10 @@ type IN2 struct {
 // segment are defined by CMS, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name `hl7:",name=IN3,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set ID - IN3"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - IN3"`
 	CertificationNumber                *CX     `hl7:"2,len=250,display=Certification Number"`
 	CertifiedBy                        []XCN   `hl7:"3,len=250,display=Certified By"`
-	CertificationRequired              ID      `hl7:"4,len=1,table=0136,display=Certification Required"`
+	CertificationRequired              ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Certification Required"`
 	Penalty                            *MOP    `hl7:"5,len=23,display=Penalty"`
 	CertificationDateTime              DTM     `hl7:"6,len=24,format=YMDHM,display=Certification Date/Time"`
 	CertificationModifyDateTime        DTM     `hl7:"7,len=24,format=YMDHM,display=Certification Modify Date/Time"`
@@ -1349,8 +1349,8 @@ type IN3 struct {
 	PreCertificationRequirement        []ICD   `hl7:"20,len=40,display=Pre-Certification Requirement"`
 	CaseManager                        ST      `hl7:"21,len=48,display=Case Manager"`
 	SecondOpinionDate                  DT      `hl7:"22,len=8,format=YMD,display=Second Opinion Date"`
-	SecondOpinionStatus                IS      `hl7:"23,len=1,table=0151,display=Second Opinion Status"`
-	SecondOpinionDocumentationReceived []IS    `hl7:"24,len=1,table=0152,display=Second Opinion Documentation Received"`
+	SecondOpinionStatus                IS      `hl7:"23,len=1,table=0151,datatype=IS,display=Second Opinion Status"`
+	SecondOpinionDocumentationReceived []IS    `hl7:"24,len=1,table=0152,datatype=IS,display=Second Opinion Documentation Received"`
 	SecondOpinionPhysician             []XCN   `hl7:"25,len=250,table=0010,display=Second Opinion Physician"`
 }
 
@@ -1366,10 +1366,10 @@ type INV struct {
 	InventoryContainerIdentifier *CWE    `hl7:"4,len=705,display=Inventory Container Identifier"`
 	ContainerCarrierIdentifier   *CWE    `hl7:"5,len=705,display=Container Carrier Identifier"`
 	PositionOnCarrier            *CWE    `hl7:"6,len=705,display=Position on Carrier"`
-	InitialQuantity              NM      `hl7:"7,len=20,display=Initial Quantity"`
-	CurrentQuantity              NM      `hl7:"8,len=20,display=Current Quantity"`
-	AvailableQuantity            NM      `hl7:"9,len=20,display=Available Quantity"`
-	ConsumptionQuantity          NM      `hl7:"10,len=20,display=Consumption Quantity"`
+	InitialQuantity              NM      `hl7:"7,len=20,datatype=NM,display=Initial Quantity"`
+	CurrentQuantity              NM      `hl7:"8,len=20,datatype=NM,display=Current Quantity"`
+	AvailableQuantity            NM      `hl7:"9,len=20,datatype=NM,display=Available Quantity"`
+	ConsumptionQuantity          NM      `hl7:"10,len=20,datatype=NM,display=Consumption Quantity"`
 	QuantityUnits                *CWE    `hl7:"11,len=705,display=Quantity Units"`
 	ExpirationDateTime           DTM     `hl7:"12,len=24,format=YMDHM,display=Expiration Date/Time"`
 	FirstUsedDateTime            DTM     `hl7:"13,len=24,format=YMDHM,display=First Used Date/Time"`
@@ -1422,7 +1422,7 @@ type IPR struct {
 // section Glossary) on the specific equipment.
 type ISD struct {
 	HL7                        HL7Name `hl7:",name=ISD,type=s"`
-	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,display=Reference Interaction Number"`
+	ReferenceInteractionNumber NM      `hl7:"1,required,len=20,datatype=NM,display=Reference Interaction Number"`
 	InteractionTypeIdentifier  *CWE    `hl7:"2,len=705,table=0368,display=Interaction Type Identifier"`
 	InteractionActiveState     CWE     `hl7:"3,required,len=705,table=0387,display=Interaction Active State"`
 }
@@ -1450,8 +1450,8 @@ type ITM struct {
 	ApprovingRegulatoryAgency             []XON   `hl7:"16,len=250,table=0790,display=Approving Regulatory Agency"`
 	LatexIndicator                        *CNE    `hl7:"17,len=4,table=0532,display=Latex Indicator"`
 	RulingAct                             []CWE   `hl7:"18,len=250,table=0793,display=Ruling Act"`
-	ItemNaturalAccountCode                IS      `hl7:"19,len=30,table=0320,display=Item Natural Account Code"`
-	ApprovedToBuyQuantity                 NM      `hl7:"20,len=6,display=Approved To Buy Quantity"`
+	ItemNaturalAccountCode                IS      `hl7:"19,len=30,table=0320,datatype=IS,display=Item Natural Account Code"`
+	ApprovedToBuyQuantity                 NM      `hl7:"20,len=6,datatype=NM,display=Approved To Buy Quantity"`
 	ApprovedToBuyPrice                    *MO     `hl7:"21,len=10,display=Approved To Buy Price"`
 	TaxableItemIndicator                  *CNE    `hl7:"22,len=4,table=0532,display=Taxable Item Indicator"`
 	FreightChargeIndicator                *CNE    `hl7:"23,len=4,table=0532,display=Freight Charge Indicator"`
@@ -1472,16 +1472,16 @@ type IVC struct {
 	ProviderInvoiceNumber                  EI      `hl7:"1,required,len=74,display=Provider Invoice Number"`
 	PayerInvoiceNumber                     *EI     `hl7:"2,len=74,display=Payer Invoice Number"`
 	ContractAgreementNumber                *EI     `hl7:"3,len=74,display=Contract/Agreement Number"`
-	InvoiceControl                         IS      `hl7:"4,required,len=2,table=0553,display=Invoice Control"`
-	InvoiceReason                          IS      `hl7:"5,required,len=4,table=0554,display=Invoice Reason"`
-	InvoiceType                            IS      `hl7:"6,required,len=2,table=0555,display=Invoice Type"`
+	InvoiceControl                         IS      `hl7:"4,required,len=2,table=0553,datatype=IS,display=Invoice Control"`
+	InvoiceReason                          IS      `hl7:"5,required,len=4,table=0554,datatype=IS,display=Invoice Reason"`
+	InvoiceType                            IS      `hl7:"6,required,len=2,table=0555,datatype=IS,display=Invoice Type"`
 	InvoiceDateTime                        DTM     `hl7:"7,required,len=24,format=YMDHM,display=Invoice Date/Time"`
 	InvoiceAmount                          CP      `hl7:"8,required,len=254,display=Invoice Amount"`
 	PaymentTerms                           ST      `hl7:"9,len=80,display=Payment Terms"`
 	ProviderOrganization                   XON     `hl7:"10,required,len=183,display=Provider Organization"`
 	PayerOrganization                      XON     `hl7:"11,required,len=183,display=Payer Organization"`
 	Attention                              *XCN    `hl7:"12,len=637,display=Attention"`
-	LastInvoiceIndicator                   ID      `hl7:"13,len=1,table=0136,display=Last Invoice Indicator"`
+	LastInvoiceIndicator                   ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Last Invoice Indicator"`
 	InvoiceBookingPeriod                   DTM     `hl7:"14,len=24,format=YMDHM,display=Invoice Booking Period"`
 	Origin                                 ST      `hl7:"15,len=250,display=Origin"`
 	InvoiceFixedAmount                     *CP     `hl7:"16,len=254,display=Invoice Fixed Amount"`
@@ -1492,12 +1492,12 @@ type IVC struct {
 	InvoicePrepaidAmount                   *CP     `hl7:"21,len=254,display=Invoice Prepaid Amount"`
 	TotalInvoiceAmountWithoutPrepaidAmount *CP     `hl7:"22,len=254,display=Total Invoice Amount without Prepaid Amount"`
 	TotalAmountOfVAT                       *CP     `hl7:"23,conditional,len=254,display=Total-Amount of VAT"`
-	VATRatesApplied                        []NM    `hl7:"24,len=1024,display=VAT-Rates applied"`
-	BenefitGroup                           IS      `hl7:"25,required,len=4,table=0556,display=Benefit Group"`
+	VATRatesApplied                        []NM    `hl7:"24,len=1024,datatype=NM,display=VAT-Rates applied"`
+	BenefitGroup                           IS      `hl7:"25,required,len=4,table=0556,datatype=IS,display=Benefit Group"`
 	ProviderTaxID                          ST      `hl7:"26,len=20,display=Provider Tax ID"`
 	PayerTaxID                             ST      `hl7:"27,len=20,display=Payer Tax ID"`
-	ProviderTaxStatus                      IS      `hl7:"28,len=4,table=0572,display=Provider Tax status"`
-	PayerTaxStatus                         IS      `hl7:"29,len=4,table=0572,display=Payer Tax status"`
+	ProviderTaxStatus                      IS      `hl7:"28,len=4,table=0572,datatype=IS,display=Provider Tax status"`
+	PayerTaxStatus                         IS      `hl7:"29,len=4,table=0572,datatype=IS,display=Payer Tax status"`
 	SalesTaxID                             ST      `hl7:"30,len=20,display=Sales Tax ID"`
 }
 
@@ -1507,7 +1507,7 @@ type IVC struct {
 // item in the Material Item (ITM) segment.
 type IVT struct {
 	HL7                               HL7Name `hl7:",name=IVT,type=s"`
-	SetID                             SI      `hl7:"1,seq,required,len=4,display=Set Id – IVT"`
+	SetID                             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id – IVT"`
 	InventoryLocationIdentifier       EI      `hl7:"2,required,len=250,display=Inventory Location Identifier"`
 	InventoryLocationName             ST      `hl7:"3,len=999,display=Inventory Location Name"`
 	SourceLocationIdentifier          *EI     `hl7:"4,len=250,display=Source Location Identifier"`
@@ -1528,10 +1528,10 @@ type IVT struct {
 	SubstituteItemIdentifier          []EI    `hl7:"19,len=250,display=Substitute Item Identifier"`
 	LatexFreeSubstituteItemIdentifier *EI     `hl7:"20,len=250,display=Latex-Free Substitute Item Identifier"`
 	RecommendedReorderTheory          *CWE    `hl7:"21,len=250,table=0642,display=Recommended Reorder Theory"`
-	RecommendedSafetyStockDays        NM      `hl7:"22,len=4,display=Recommended Safety Stock Days"`
-	RecommendedMaximumDaysInventory   NM      `hl7:"23,len=4,display=Recommended Maximum Days Inventory"`
-	RecommendedOrderPoint             NM      `hl7:"24,len=8,display=Recommended Order Point"`
-	RecommendedOrderAmount            NM      `hl7:"25,len=8,display=Recommended Order Amount"`
+	RecommendedSafetyStockDays        NM      `hl7:"22,len=4,datatype=NM,display=Recommended Safety Stock Days"`
+	RecommendedMaximumDaysInventory   NM      `hl7:"23,len=4,datatype=NM,display=Recommended Maximum Days Inventory"`
+	RecommendedOrderPoint             NM      `hl7:"24,len=8,datatype=NM,display=Recommended Order Point"`
+	RecommendedOrderAmount            NM      `hl7:"25,len=8,datatype=NM,display=Recommended Order Amount"`
 	OperatingRoomParLevelIndicator    *CNE    `hl7:"26,len=4,table=0532,display=Operating Room Par Level Indicator"`
 }
 
@@ -1541,7 +1541,7 @@ type IVT struct {
 // optionally follow an STF segment. An LAN segment must always have been preceded by a corresponding STF segment.
 type LAN struct {
 	HL7                     HL7Name `hl7:",name=LAN,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=60,display=Set ID – LAN"`
+	SetID                   SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID – LAN"`
 	LanguageCode            CWE     `hl7:"2,required,len=250,table=0296,display=Language Code"`
 	LanguageAbilityCode     []CWE   `hl7:"3,len=250,table=0403,display=Language Ability Code"`
 	LanguageProficiencyCode *CWE    `hl7:"4,len=250,table=0404,display=Language Proficiency Code"`
@@ -1571,7 +1571,7 @@ type LCC struct {
 type LCH struct {
 	HL7                         HL7Name `hl7:",name=LCH,type=s"`
 	PrimaryKeyValue             PL      `hl7:"1,required,len=200,display=Primary Key Value - LCH"`
-	SegmentActionCode           ID      `hl7:"2,len=3,table=0206,display=Segment Action Code"`
+	SegmentActionCode           ID      `hl7:"2,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey            *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationCharacteristicID    CWE     `hl7:"4,required,len=250,table=0324,display=Location Characteristic ID"`
 	LocationCharacteristicValue CWE     `hl7:"5,required,len=250,display=Location Characteristic Value-LCH"`
@@ -1588,10 +1588,10 @@ type LDP struct {
 	HL7                 HL7Name `hl7:",name=LDP,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=Primary Key Value - LDP"`
 	LocationDepartment  CWE     `hl7:"2,required,len=250,table=0264,display=Location Department"`
-	LocationService     []IS    `hl7:"3,len=3,table=0069,display=Location Service"`
+	LocationService     []IS    `hl7:"3,len=3,table=0069,datatype=IS,display=Location Service"`
 	SpecialtyType       []CWE   `hl7:"4,len=250,table=0265,display=Specialty Type"`
-	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,display=Valid Patient Classes"`
-	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,display=Active/Inactive Flag"`
+	ValidPatientClasses []IS    `hl7:"5,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
+	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	ActivationDate      DTM     `hl7:"7,len=24,format=YMDHM,display=Activation Date  LDP"`
 	InactivationDate    DTM     `hl7:"8,len=24,format=YMDHM,display=Inactivation Date - LDP"`
 	InactivatedReason   ST      `hl7:"9,len=80,display=Inactivated Reason"`
@@ -1612,13 +1612,13 @@ type LOC struct {
 	HL7                 HL7Name `hl7:",name=LOC,type=s"`
 	PrimaryKeyValue     PL      `hl7:"1,required,len=200,display=Primary Key Value - LOC"`
 	LocationDescription ST      `hl7:"2,len=48,display=Location Description"`
-	LocationType        []IS    `hl7:"3,required,len=2,table=0260,display=Location Type - LOC"`
+	LocationType        []IS    `hl7:"3,required,len=2,table=0260,datatype=IS,display=Location Type - LOC"`
 	OrganizationName    []XON   `hl7:"4,len=250,display=Organization Name - LOC"`
 	LocationAddress     []XAD   `hl7:"5,len=250,display=Location Address"`
 	LocationPhone       []XTN   `hl7:"6,len=250,display=Location Phone"`
 	LicenseNumber       []CWE   `hl7:"7,len=250,table=0461,display=License Number"`
-	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,display=Location Equipment"`
-	LocationServiceCode IS      `hl7:"9,len=1,table=0442,display=Location Service Code"`
+	LocationEquipment   []IS    `hl7:"8,len=3,table=0261,datatype=IS,display=Location Equipment"`
+	LocationServiceCode IS      `hl7:"9,len=1,table=0442,datatype=IS,display=Location Service Code"`
 }
 
 // Location Relationship
@@ -1629,7 +1629,7 @@ type LOC struct {
 type LRL struct {
 	HL7                                     HL7Name `hl7:",name=LRL,type=s"`
 	PrimaryKeyValue                         PL      `hl7:"1,required,len=200,display=Primary Key Value - LRL"`
-	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,display=Segment Action Code"`
+	SegmentActionCode                       ID      `hl7:"2,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey                        *EI     `hl7:"3,len=80,display=Segment Unique Key"`
 	LocationRelationshipID                  CWE     `hl7:"4,required,len=250,table=0325,display=Location Relationship ID"`
 	OrganizationalLocationRelationshipValue []XON   `hl7:"5,conditional,len=250,display=Organizational Location Relationship Value"`
@@ -1641,12 +1641,12 @@ type LRL struct {
 // The Technical Steward for the MFA segment is Infrastructure and Messaging.
 type MFA struct {
 	HL7                       HL7Name  `hl7:",name=MFA,type=s"`
-	RecordLevelEventCode      ID       `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode      ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID              ST       `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EventCompletionDateTime   DTM      `hl7:"3,len=24,format=YMDHM,display=Event Completion Date/Time"`
 	MFNRecordLevelErrorReturn CWE      `hl7:"4,required,len=250,table=0181,display=MFN Record Level Error Return"`
 	PrimaryKeyValue           []VARIES `hl7:"5,required,len=250,display=Primary Key Value - MFA"`
-	PrimaryKeyValueType       []ID     `hl7:"6,required,len=3,table=0355,display=Primary Key Value Type - MFA"`
+	PrimaryKeyValueType       []ID     `hl7:"6,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type - MFA"`
 }
 
 // Master File Entry
@@ -1654,11 +1654,11 @@ type MFA struct {
 // The Technical Steward for the MFE segment is Infrastructure and Messaging.
 type MFE struct {
 	HL7                  HL7Name  `hl7:",name=MFE,type=s"`
-	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,display=Record-Level Event Code"`
+	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-Level Event Code"`
 	MFNControlID         ST       `hl7:"2,conditional,len=20,display=MFN Control ID"`
 	EffectiveDateTime    DTM      `hl7:"3,len=26,format=YMDHM,display=Effective Date/Time"`
 	PrimaryKeyValue      []VARIES `hl7:"4,required,len=200,display=Primary Key Value - MFE"`
-	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,display=Primary Key Value Type"`
+	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type"`
 	EnteredDateTime      DTM      `hl7:"6,len=24,format=YMDHM,display=Entered Date/Time"`
 	EnteredBy            *XCN     `hl7:"7,len=3220,display=Entered By"`
 }
@@ -1670,10 +1670,10 @@ type MFI struct {
 	HL7                             HL7Name `hl7:",name=MFI,type=s"`
 	MasterFileIdentifier            CWE     `hl7:"1,required,len=250,table=0175,display=Master File Identifier"`
 	MasterFileApplicationIdentifier []HD    `hl7:"2,len=180,table=0361,display=Master File Application Identifier"`
-	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,display=File-Level Event Code"`
+	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,datatype=ID,display=File-Level Event Code"`
 	EnteredDateTime                 DTM     `hl7:"4,len=24,format=YMDHM,display=Entered Date/Time"`
 	EffectiveDateTime               DTM     `hl7:"5,len=24,format=YMDHM,display=Effective Date/Time"`
-	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,display=Response Level Code"`
+	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,datatype=ID,display=Response Level Code"`
 }
 
 // Merge Patient Information
@@ -1697,14 +1697,14 @@ type MRG struct {
 // The MSA segment contains information sent while acknowledging another message.
 type MSA struct {
 	HL7                       HL7Name `hl7:",name=MSA,type=s"`
-	AcknowledgmentCode        ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgment Code"`
+	AcknowledgmentCode        ID      `hl7:"1,required,len=2,table=0008,datatype=ID,display=Acknowledgment Code"`
 	MessageControlID          ST      `hl7:"2,required,len=199,display=Message Control ID"`
 	TextMessage               ST      `hl7:"3,len=80,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,len=15,display=Expected Sequence Number"`
+	ExpectedSequenceNumber    NM      `hl7:"4,len=15,datatype=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgmentType ST      `hl7:"5,display=Delayed Acknowledgment Type"`
 	ErrorCondition            CE      `hl7:"6,len=250,table=0357,display=Error Condition"`
-	MessageWaitingNumber      NM      `hl7:"7,len=5,display=Message Waiting Number"`
-	MessageWaitingPriority    ID      `hl7:"8,len=1,table=0520,display=Message Waiting Priority"`
+	MessageWaitingNumber      NM      `hl7:"7,len=5,datatype=NM,display=Message Waiting Number"`
+	MessageWaitingPriority    ID      `hl7:"8,len=1,table=0520,datatype=ID,display=Message Waiting Priority"`
 }
 
 // Message Header
@@ -1724,14 +1724,14 @@ type MSH struct {
 	MessageControlID                    ST      `hl7:"10,required,len=199,display=Message Control ID"`
 	ProcessingID                        PT      `hl7:"11,required,len=3,display=Processing ID"`
 	VersionID                           VID     `hl7:"12,required,len=60,display=Version ID"`
-	SequenceNumber                      NM      `hl7:"13,len=15,display=Sequence Number"`
+	SequenceNumber                      NM      `hl7:"13,len=15,datatype=NM,display=Sequence Number"`
 	ContinuationPointer                 ST      `hl7:"14,len=180,display=Continuation Pointer"`
-	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgment Type"`
-	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgment Type"`
-	CountryCode                         ID      `hl7:"17,len=3,table=0399,display=Country Code"`
-	CharacterSet                        []ID    `hl7:"18,len=16,table=0211,display=Character Set"`
+	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,datatype=ID,display=Accept Acknowledgment Type"`
+	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,datatype=ID,display=Application Acknowledgment Type"`
+	CountryCode                         ID      `hl7:"17,len=3,table=0399,datatype=ID,display=Country Code"`
+	CharacterSet                        []ID    `hl7:"18,len=16,table=0211,datatype=ID,display=Character Set"`
 	PrincipalLanguageOfMessage          *CWE    `hl7:"19,len=250,display=Principal Language Of Message"`
-	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=20,table=0356,display=Alternate Character Set Handling Scheme"`
+	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=20,table=0356,datatype=ID,display=Alternate Character Set Handling Scheme"`
 	MessageProfileIdentifier            []EI    `hl7:"21,len=427,display=Message Profile Identifier"`
 	SendingResponsibleOrganization      *XON    `hl7:"22,len=567,display=Sending Responsible Organization"`
 	ReceivingResponsibleOrganization    *XON    `hl7:"23,len=567,display=Receiving Responsible Organization"`
@@ -1760,7 +1760,7 @@ type NCK struct {
 // of events, (e.g., alarms that have occurred on a particular piece of equipment.
 type NDS struct {
 	HL7                         HL7Name `hl7:",name=NDS,type=s"`
-	NotificationReferenceNumber NM      `hl7:"1,required,len=20,display=Notification Reference Number"`
+	NotificationReferenceNumber NM      `hl7:"1,required,len=20,datatype=NM,display=Notification Reference Number"`
 	NotificationDateTime        DTM     `hl7:"2,required,len=24,format=YMDHM,display=Notification Date/Time"`
 	NotificationAlertSeverity   CWE     `hl7:"3,required,len=705,table=0367,display=Notification Alert Severity"`
 	NotificationCode            CWE     `hl7:"4,required,len=705,display=Notification Code"`
@@ -1772,7 +1772,7 @@ type NDS struct {
 // Utilizing NK1-1 - set ID, multiple NK1 segments can be sent to patient accounts.
 type NK1 struct {
 	HL7                                      HL7Name `hl7:",name=NK1,type=s"`
-	SetID                                    SI      `hl7:"1,seq,required,len=4,display=Set ID - NK1"`
+	SetID                                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - NK1"`
 	Name                                     []XPN   `hl7:"2,len=250,display=Name"`
 	Relationship                             *CWE    `hl7:"3,len=705,table=0063,display=Relationship"`
 	Address                                  []XAD   `hl7:"4,len=250,display=Address"`
@@ -1786,16 +1786,16 @@ type NK1 struct {
 	NextOfKinAssociatedPartiesEmployeeNumber *CX     `hl7:"12,len=250,display=Next of Kin / Associated Parties Employee Number"`
 	OrganizationName                         []XON   `hl7:"13,len=250,display=Organization Name - NK1"`
 	MaritalStatus                            *CWE    `hl7:"14,len=705,table=0002,display=Marital Status"`
-	AdministrativeSex                        IS      `hl7:"15,len=1,table=0001,display=Administrative Sex"`
+	AdministrativeSex                        IS      `hl7:"15,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	DateTimeOfBirth                          DTM     `hl7:"16,len=24,format=YMDHM,display=Date/Time of Birth"`
-	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,display=Living Dependency"`
-	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,display=Ambulatory Status"`
+	LivingDependency                         []IS    `hl7:"17,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	AmbulatoryStatus                         []IS    `hl7:"18,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
 	Citizenship                              []CWE   `hl7:"19,len=705,table=0171,display=Citizenship"`
 	PrimaryLanguage                          *CWE    `hl7:"20,len=705,table=0296,display=Primary Language"`
-	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,display=Living Arrangement"`
+	LivingArrangement                        IS      `hl7:"21,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PublicityCode                            *CWE    `hl7:"22,len=705,table=0215,display=Publicity Code"`
-	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,display=Protection Indicator"`
-	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,display=Student Indicator"`
+	ProtectionIndicator                      ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Protection Indicator"`
+	StudentIndicator                         IS      `hl7:"24,len=2,table=0231,datatype=IS,display=Student Indicator"`
 	Religion                                 *CWE    `hl7:"25,len=705,table=0006,display=Religion"`
 	MothersMaidenName                        []XPN   `hl7:"26,len=250,display=Mother's Maiden Name"`
 	Nationality                              *CWE    `hl7:"27,len=705,table=0212,display=Nationality"`
@@ -1805,12 +1805,12 @@ type NK1 struct {
 	ContactPersonsTelephoneNumber            []XTN   `hl7:"31,len=250,display=Contact Person's Telephone Number"`
 	ContactPersonsAddress                    []XAD   `hl7:"32,len=250,display=Contact Person's Address"`
 	NextOfKinAssociatedPartysIdentifiers     []CX    `hl7:"33,len=250,display=Next of Kin/Associated Party's Identifiers"`
-	JobStatus                                IS      `hl7:"34,len=2,table=0311,display=Job Status"`
+	JobStatus                                IS      `hl7:"34,len=2,table=0311,datatype=IS,display=Job Status"`
 	Race                                     []CWE   `hl7:"35,len=705,table=0005,display=Race"`
-	Handicap                                 IS      `hl7:"36,len=2,table=0295,display=Handicap"`
+	Handicap                                 IS      `hl7:"36,len=2,table=0295,datatype=IS,display=Handicap"`
 	ContactPersonSocialSecurityNumber        ST      `hl7:"37,len=16,display=Contact Person Social Security Number"`
 	NextOfKinBirthPlace                      ST      `hl7:"38,len=250,display=Next of Kin Birth Place"`
-	VIPIndicator                             IS      `hl7:"39,len=2,table=0099,display=VIP Indicator"`
+	VIPIndicator                             IS      `hl7:"39,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 }
 
 // Bed Status Update
@@ -1820,7 +1820,7 @@ type NK1 struct {
 type NPU struct {
 	HL7         HL7Name `hl7:",name=NPU,type=s"`
 	BedLocation PL      `hl7:"1,required,len=80,display=Bed Location"`
-	BedStatus   IS      `hl7:"2,len=1,table=0116,display=Bed Status"`
+	BedStatus   IS      `hl7:"2,len=1,table=0116,datatype=IS,display=Bed Status"`
 }
 
 // Application Status Change
@@ -1829,7 +1829,7 @@ type NPU struct {
 // migration (to a different CPU or file-server/file-system) of a particular application.
 type NSC struct {
 	HL7                   HL7Name `hl7:",name=NSC,type=s"`
-	ApplicationChangeType IS      `hl7:"1,required,len=4,table=0409,display=Application Change Type"`
+	ApplicationChangeType IS      `hl7:"1,required,len=4,table=0409,datatype=IS,display=Application Change Type"`
 	CurrentCPU            ST      `hl7:"2,len=30,display=Current CPU"`
 	CurrentFileserver     ST      `hl7:"3,len=30,display=Current Fileserver"`
 	CurrentApplication    *HD     `hl7:"4,len=30,display=Current Application"`
@@ -1847,21 +1847,21 @@ type NSC struct {
 // be used by application management applications monitoring the state of various network links.
 type NST struct {
 	HL7                           HL7Name `hl7:",name=NST,type=s"`
-	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,display=Statistics Available"`
+	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,datatype=ID,display=Statistics Available"`
 	SourceIdentifier              ST      `hl7:"2,len=30,display=Source Identifier"`
-	SourceType                    ID      `hl7:"3,len=3,table=0332,display=Source Type"`
+	SourceType                    ID      `hl7:"3,len=3,table=0332,datatype=ID,display=Source Type"`
 	StatisticsStart               DTM     `hl7:"4,conditional,len=24,format=YMDHM,display=Statistics Start"`
 	StatisticsEnd                 DTM     `hl7:"5,conditional,len=24,format=YMDHM,display=Statistics End"`
-	ReceiveCharacterCount         NM      `hl7:"6,len=10,display=Receive Character Count"`
-	SendCharacterCount            NM      `hl7:"7,len=10,display=Send Character Count"`
-	MessagesReceived              NM      `hl7:"8,len=10,display=Messages Received"`
-	MessagesSent                  NM      `hl7:"9,len=10,display=Messages Sent"`
-	ChecksumErrorsReceived        NM      `hl7:"10,len=10,display=Checksum Errors Received"`
-	LengthErrorsReceived          NM      `hl7:"11,len=10,display=Length Errors Received"`
-	OtherErrorsReceived           NM      `hl7:"12,len=10,display=Other Errors Received"`
-	ConnectTimeouts               NM      `hl7:"13,len=10,display=Connect Timeouts"`
-	ReceiveTimeouts               NM      `hl7:"14,len=10,display=Receive Timeouts"`
-	ApplicationControlLevelErrors NM      `hl7:"15,len=10,display=Application control-level Errors"`
+	ReceiveCharacterCount         NM      `hl7:"6,len=10,datatype=NM,display=Receive Character Count"`
+	SendCharacterCount            NM      `hl7:"7,len=10,datatype=NM,display=Send Character Count"`
+	MessagesReceived              NM      `hl7:"8,len=10,datatype=NM,display=Messages Received"`
+	MessagesSent                  NM      `hl7:"9,len=10,datatype=NM,display=Messages Sent"`
+	ChecksumErrorsReceived        NM      `hl7:"10,len=10,datatype=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived          NM      `hl7:"11,len=10,datatype=NM,display=Length Errors Received"`
+	OtherErrorsReceived           NM      `hl7:"12,len=10,datatype=NM,display=Other Errors Received"`
+	ConnectTimeouts               NM      `hl7:"13,len=10,datatype=NM,display=Connect Timeouts"`
+	ReceiveTimeouts               NM      `hl7:"14,len=10,datatype=NM,display=Receive Timeouts"`
+	ApplicationControlLevelErrors NM      `hl7:"15,len=10,datatype=NM,display=Application control-level Errors"`
 }
 
 // Notes and Comments
@@ -1870,8 +1870,8 @@ type NST struct {
 // and comments.
 type NTE struct {
 	HL7                HL7Name `hl7:",name=NTE,type=s"`
-	SetID              SI      `hl7:"1,seq,len=4,display=Set ID - NTE"`
-	SourceOfComment    ID      `hl7:"2,len=8,table=0105,display=Source of Comment"`
+	SetID              SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - NTE"`
+	SourceOfComment    ID      `hl7:"2,len=8,table=0105,datatype=ID,display=Source of Comment"`
 	Comment            []FT    `hl7:"3,len=65536,display=Comment"`
 	CommentType        *CWE    `hl7:"4,len=250,table=0364,display=Comment Type"`
 	EnteredBy          *XCN    `hl7:"5,len=3220,display=Entered By"`
@@ -1888,17 +1888,17 @@ type NTE struct {
 // physical exam, or assessment.
 type OBR struct {
 	HL7                                        HL7Name `hl7:",name=OBR,type=s"`
-	SetID                                      SI      `hl7:"1,seq,len=4,display=Set ID - OBR"`
+	SetID                                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - OBR"`
 	PlacerOrderNumber                          *EI     `hl7:"2,conditional,len=427,display=Placer Order Number"`
 	FillerOrderNumber                          *EI     `hl7:"3,conditional,len=427,display=Filler Order Number"`
 	UniversalServiceIdentifier                 CWE     `hl7:"4,required,len=705,display=Universal Service Identifier"`
-	Priority                                   ID      `hl7:"5,len=2,display=Priority"`
+	Priority                                   ID      `hl7:"5,len=2,datatype=ID,display=Priority"`
 	RequestedDateTime                          DTM     `hl7:"6,len=24,format=YMDHM,display=Requested Date/Time"`
 	ObservationDateTime                        DTM     `hl7:"7,conditional,len=24,format=YMDHM,display=Observation Date/Time"`
 	ObservationEndDateTime                     DTM     `hl7:"8,len=24,format=YMDHM,display=Observation End Date/Time"`
 	CollectionVolume                           *CQ     `hl7:"9,len=722,display=Collection Volume"`
 	CollectorIdentifier                        []XCN   `hl7:"10,len=3220,display=Collector Identifier"`
-	SpecimenActionCode                         ID      `hl7:"11,len=1,table=0065,display=Specimen Action Code"`
+	SpecimenActionCode                         ID      `hl7:"11,len=1,table=0065,datatype=ID,display=Specimen Action Code"`
 	DangerCode                                 *CWE    `hl7:"12,len=705,display=Danger Code"`
 	RelevantClinicalInformation                ST      `hl7:"13,len=300,display=Relevant Clinical Information"`
 	SpecimenReceivedDateTime                   DTM     `hl7:"14,len=24,format=YMDHM,display=Specimen Received Date/Time"`
@@ -1911,32 +1911,32 @@ type OBR struct {
 	FillerField2                               ST      `hl7:"21,len=199,display=Filler Field 2"`
 	ResultsRptStatusChngDateTime               DTM     `hl7:"22,conditional,len=24,format=YMDHM,display=Results Rpt/Status Chng - Date/Time"`
 	ChargeToPractice                           *MOC    `hl7:"23,len=504,display=Charge to Practice"`
-	DiagnosticServSectID                       ID      `hl7:"24,len=10,table=0074,display=Diagnostic Serv Sect ID"`
-	ResultStatus                               ID      `hl7:"25,conditional,len=1,table=0123,display=Result Status"`
+	DiagnosticServSectID                       ID      `hl7:"24,len=10,table=0074,datatype=ID,display=Diagnostic Serv Sect ID"`
+	ResultStatus                               ID      `hl7:"25,conditional,len=1,table=0123,datatype=ID,display=Result Status"`
 	ParentResult                               *PRL    `hl7:"26,len=977,display=Parent Result"`
 	QuantityTiming                             []TQ    `hl7:"27,len=705,display=Quantity/Timing"`
 	ResultCopiesTo                             []XCN   `hl7:"28,len=3220,display=Result Copies To"`
 	Parent                                     *EIP    `hl7:"29,len=855,display=Parent"`
-	TransportationMode                         ID      `hl7:"30,len=20,table=0124,display=Transportation Mode"`
+	TransportationMode                         ID      `hl7:"30,len=20,table=0124,datatype=ID,display=Transportation Mode"`
 	ReasonForStudy                             []CWE   `hl7:"31,len=705,display=Reason for Study"`
 	PrincipalResultInterpreter                 NDL     `hl7:"32,len=831,display=Principal Result Interpreter"`
 	AssistantResultInterpreter                 []NDL   `hl7:"33,len=831,display=Assistant Result Interpreter"`
 	Technician                                 []NDL   `hl7:"34,len=831,display=Technician"`
 	Transcriptionist                           []NDL   `hl7:"35,len=831,display=Transcriptionist"`
 	ScheduledDateTime                          DTM     `hl7:"36,len=24,format=YMDHM,display=Scheduled Date/Time"`
-	NumberOfSampleContainers                   NM      `hl7:"37,len=16,display=Number of Sample Containers"`
+	NumberOfSampleContainers                   NM      `hl7:"37,len=16,datatype=NM,display=Number of Sample Containers"`
 	TransportLogisticsOfCollectedSample        []CWE   `hl7:"38,len=705,display=Transport Logistics of Collected Sample"`
 	CollectorsComment                          []CWE   `hl7:"39,len=705,display=Collector's Comment"`
 	TransportArrangementResponsibility         *CWE    `hl7:"40,len=705,display=Transport Arrangement Responsibility"`
-	TransportArranged                          ID      `hl7:"41,len=30,table=0224,display=Transport Arranged"`
-	EscortRequired                             ID      `hl7:"42,len=1,table=0225,display=Escort Required"`
+	TransportArranged                          ID      `hl7:"41,len=30,table=0224,datatype=ID,display=Transport Arranged"`
+	EscortRequired                             ID      `hl7:"42,len=1,table=0225,datatype=ID,display=Escort Required"`
 	PlannedPatientTransportComment             []CWE   `hl7:"43,len=705,display=Planned Patient Transport Comment"`
 	ProcedureCode                              *CNE    `hl7:"44,len=705,table=0088,display=Procedure Code"`
 	ProcedureCodeModifier                      []CNE   `hl7:"45,len=705,table=0340,display=Procedure Code Modifier"`
 	PlacerSupplementalServiceInformation       []CWE   `hl7:"46,len=705,table=0441,display=Placer Supplemental Service Information"`
 	FillerSupplementalServiceInformation       []CWE   `hl7:"47,len=705,table=0441,display=Filler Supplemental Service Information"`
 	MedicallyNecessaryDuplicateProcedureReason *CWE    `hl7:"48,conditional,len=705,table=0476,display=Medically Necessary Duplicate Procedure Reason"`
-	ResultHandling                             IS      `hl7:"49,len=2,table=0507,display=Result Handling"`
+	ResultHandling                             IS      `hl7:"49,len=2,table=0507,datatype=IS,display=Result Handling"`
 	ParentUniversalServiceIdentifier           *CWE    `hl7:"50,len=705,display=Parent Universal Service Identifier"`
 }
 
@@ -1946,17 +1946,17 @@ type OBR struct {
 // unit of a report. The OBX segment can also contain encapsulated data, e.g., a CDA document or a DICOM image.
 type OBX struct {
 	HL7                                   HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                                 SI       `hl7:"1,seq,len=4,display=Set ID – OBX"`
-	ValueType                             ID       `hl7:"2,conditional,len=3,table=0125,display=Value Type"`
+	SetID                                 SI       `hl7:"1,seq,len=4,datatype=SI,display=Set ID – OBX"`
+	ValueType                             ID       `hl7:"2,conditional,len=3,table=0125,datatype=ID,display=Value Type"`
 	ObservationIdentifier                 CWE      `hl7:"3,required,len=705,display=Observation Identifier"`
 	ObservationSubID                      ST       `hl7:"4,conditional,len=20,display=Observation Sub-ID"`
 	ObservationValue                      []VARIES `hl7:"5,conditional,len=99999,display=Observation Value"`
 	Units                                 *CWE     `hl7:"6,len=705,display=Units"`
 	ReferencesRange                       ST       `hl7:"7,len=60,display=References Range"`
-	AbnormalFlags                         []IS     `hl7:"8,len=5,table=0078,display=Abnormal Flags"`
-	Probability                           NM       `hl7:"9,len=5,display=Probability"`
-	NatureOfAbnormalTest                  []ID     `hl7:"10,len=2,table=0080,display=Nature of Abnormal Test"`
-	ObservationResultStatus               ID       `hl7:"11,required,len=1,table=0085,display=Observation Result Status"`
+	AbnormalFlags                         []IS     `hl7:"8,len=5,table=0078,datatype=IS,display=Abnormal Flags"`
+	Probability                           NM       `hl7:"9,len=5,datatype=NM,display=Probability"`
+	NatureOfAbnormalTest                  []ID     `hl7:"10,len=2,table=0080,datatype=ID,display=Nature of Abnormal Test"`
+	ObservationResultStatus               ID       `hl7:"11,required,len=1,table=0085,datatype=ID,display=Observation Result Status"`
 	EffectiveDateOfReferenceRange         DTM      `hl7:"12,len=24,format=YMDHM,display=Effective Date of Reference Range"`
 	UserDefinedAccessChecks               ST       `hl7:"13,len=20,display=User Defined Access Checks"`
 	DateTimeOfTheObservation              DTM      `hl7:"14,len=24,format=YMDHM,display=Date/Time of the Observation"`
@@ -1983,7 +1983,7 @@ type OBX struct {
 // which are part of a diet but only delivered, say, every day at night.
 type ODS struct {
 	HL7                            HL7Name `hl7:",name=ODS,type=s"`
-	Type                           ID      `hl7:"1,required,len=1,table=0159,display=Type"`
+	Type                           ID      `hl7:"1,required,len=1,table=0159,datatype=ID,display=Type"`
 	ServicePeriod                  []CWE   `hl7:"2,max=10,len=250,display=Service Period"`
 	DietSupplementOrPreferenceCode []CWE   `hl7:"3,required,max=20,len=250,display=Diet- Supplement- or Preference Code"`
 	TextInstruction                []ST    `hl7:"4,max=2,len=80,display=Text Instruction"`
@@ -2008,10 +2008,10 @@ type ODT struct {
 // the field attributes that specify what additional segments might also be defined for this observation.
 type OM1 struct {
 	HL7                                                    HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	ProducersServiceTestObservationID                      CWE     `hl7:"2,required,len=250,display=Producer's Service/Test/Observation ID"`
-	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,display=Permitted Data Types"`
-	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
+	PermittedDataTypes                                     []ID    `hl7:"3,len=12,table=0125,datatype=ID,display=Permitted Data Types"`
+	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Specimen Required"`
 	ProducerID                                             CWE     `hl7:"5,required,len=250,display=Producer ID"`
 	ObservationDescription                                 TX      `hl7:"6,len=200,display=Observation Description"`
 	OtherServiceTestObservationIDsForTheObservation        *CWE    `hl7:"7,len=250,display=Other Service/Test/Observation IDs for the Observation"`
@@ -2019,21 +2019,21 @@ type OM1 struct {
 	PreferredReportNameForTheObservation                   ST      `hl7:"9,len=30,display=Preferred Report Name for the Observation"`
 	PreferredShortNameOrMnemonicForObservation             ST      `hl7:"10,len=8,display=Preferred Short Name or Mnemonic for Observation"`
 	PreferredLongNameForTheObservation                     ST      `hl7:"11,len=200,display=Preferred Long Name for the Observation"`
-	Orderability                                           ID      `hl7:"12,len=1,table=0136,display=Orderability"`
+	Orderability                                           ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Orderability"`
 	IdentityOfInstrumentUsedToPerformThisStudy             []CWE   `hl7:"13,len=250,display=Identity of Instrument Used to Perform this Study"`
 	CodedRepresentationOfMethod                            []CWE   `hl7:"14,len=250,display=Coded Representation of Method"`
-	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,display=Portable Device Indicator"`
+	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Portable Device Indicator"`
 	ObservationProducingDepartmentSection                  []CWE   `hl7:"16,len=250,display=Observation Producing Department/Section"`
 	TelephoneNumberOfSection                               *XTN    `hl7:"17,len=250,display=Telephone Number of Section"`
-	NatureOfServiceTestObservation                         IS      `hl7:"18,required,len=1,table=0174,display=Nature of Service/Test/Observation"`
+	NatureOfServiceTestObservation                         IS      `hl7:"18,required,len=1,table=0174,datatype=IS,display=Nature of Service/Test/Observation"`
 	ReportSubheader                                        *CWE    `hl7:"19,len=250,display=Report Subheader"`
 	ReportDisplayOrder                                     ST      `hl7:"20,len=20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefinitionForTheObservation DTM     `hl7:"21,len=24,format=YMDHM,display=Date/Time Stamp for any change in Definition for the Observation"`
 	EffectiveDateTimeOfChange                              DTM     `hl7:"22,len=24,format=YMDHM,display=Effective Date/Time of Change"`
-	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,display=Typical Turn-Around Time"`
-	ProcessingTime                                         NM      `hl7:"24,len=20,display=Processing Time"`
-	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,display=Processing Priority"`
-	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,display=Reporting Priority"`
+	TypicalTurnAroundTime                                  NM      `hl7:"23,len=20,datatype=NM,display=Typical Turn-Around Time"`
+	ProcessingTime                                         NM      `hl7:"24,len=20,datatype=NM,display=Processing Time"`
+	ProcessingPriority                                     []ID    `hl7:"25,len=40,table=0168,datatype=ID,display=Processing Priority"`
+	ReportingPriority                                      ID      `hl7:"26,len=5,table=0169,datatype=ID,display=Reporting Priority"`
 	OutsideSiteSWhereObservationMayBePerformed             []CWE   `hl7:"27,len=250,display=Outside Site(s) Where Observation may be Performed"`
 	AddressOfOutsideSiteS                                  []XAD   `hl7:"28,len=250,display=Address of Outside Site(s)"`
 	PhoneNumberOfOutsideSite                               *XTN    `hl7:"29,len=250,display=Phone Number of Outside Site"`
@@ -2065,16 +2065,16 @@ type OM1 struct {
 // date, or time stamp).  It can be applied to observation batteries of type A and C (see OM1-18 - Nature of Service/Test/Observation).
 type OM2 struct {
 	HL7                                                     HL7Name `hl7:",name=OM2,type=s"`
-	SequenceNumberTestObservationMasterFile                 NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile                 NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	UnitsOfMeasure                                          *CWE    `hl7:"2,len=250,display=Units of Measure"`
-	RangeOfDecimalPrecision                                 []NM    `hl7:"3,len=10,display=Range of Decimal Precision"`
+	RangeOfDecimalPrecision                                 []NM    `hl7:"3,len=10,datatype=NM,display=Range of Decimal Precision"`
 	CorrespondingSIUnitsOfMeasure                           *CWE    `hl7:"4,len=250,display=Corresponding SI Units of Measure"`
 	SIConversionFactor                                      TX      `hl7:"5,len=60,display=SI Conversion Factor"`
 	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR   `hl7:"6,len=250,display=Reference (Normal) Range for Ordinal and Continuous Observations"`
 	CriticalRangeForOrdinalAndContinuousObservations        []RFR   `hl7:"7,len=205,display=Critical Range for Ordinal and Continuous Observations"`
 	AbsoluteRangeForOrdinalAndContinuousObservations        *RFR    `hl7:"8,len=250,display=Absolute Range for Ordinal and Continuous Observations"`
 	DeltaCheckCriteria                                      []DLT   `hl7:"9,len=250,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements                             NM      `hl7:"10,len=20,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements                             NM      `hl7:"10,len=20,datatype=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical Service/Test/Observation
@@ -2084,13 +2084,13 @@ type OM2 struct {
 // This segment applies to free text and other non-numeric data types.
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	PreferredCodingSystem                       *CWE    `hl7:"2,len=250,display=Preferred Coding System"`
 	ValidCodedAnswers                           []CWE   `hl7:"3,len=250,display=Valid Coded 'Answers'"`
 	NormalTextCodesForCategoricalObservations   []CWE   `hl7:"4,len=250,display=Normal Text/Codes for Categorical Observations"`
 	AbnormalTextCodesForCategoricalObservations []CWE   `hl7:"5,len=250,display=Abnormal Text/Codes for Categorical Observations"`
 	CriticalTextCodesForCategoricalObservations []CWE   `hl7:"6,len=250,display=Critical Text/Codes for Categorical Observations"`
-	ValueType                                   ID      `hl7:"7,len=3,table=0125,display=Value Type"`
+	ValueType                                   ID      `hl7:"7,len=3,table=0125,datatype=ID,display=Value Type"`
 }
 
 // Observations That Require Specimens
@@ -2102,10 +2102,10 @@ type OM3 struct {
 // and a serum specimen), multiple segments may be included, one for each specimen type.
 type OM4 struct {
 	HL7                                     HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
-	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
+	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,datatype=ID,display=Derived Specimen"`
 	ContainerDescription                    TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume                         NM      `hl7:"4,len=20,display=Container Volume"`
+	ContainerVolume                         NM      `hl7:"4,len=20,datatype=NM,display=Container Volume"`
 	ContainerUnits                          *CWE    `hl7:"5,len=250,display=Container Units"`
 	Specimen                                *CWE    `hl7:"6,len=250,display=Specimen"`
 	Additive                                *CWE    `hl7:"7,len=705,table=0371,display=Additive"`
@@ -2114,7 +2114,7 @@ type OM4 struct {
 	NormalCollectionVolume                  *CQ     `hl7:"10,len=20,display=Normal Collection Volume"`
 	MinimumCollectionVolume                 *CQ     `hl7:"11,len=20,display=Minimum Collection Volume"`
 	SpecimenRequirements                    TX      `hl7:"12,len=10240,display=Specimen Requirements"`
-	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,display=Specimen Priorities"`
+	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,datatype=ID,display=Specimen Priorities"`
 	SpecimenRetentionTime                   *CQ     `hl7:"14,len=20,display=Specimen Retention Time"`
 }
 
@@ -2126,7 +2126,7 @@ type OM4 struct {
 // Nature of Service/Test/Observation).
 type OM5 struct {
 	HL7                                                HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	TestObservationsIncludedWithinAnOrderedTestBattery []CWE   `hl7:"2,len=250,display=Test/Observations Included Within an Ordered Test Battery"`
 	ObservationIDSuffixes                              ST      `hl7:"3,len=250,display=Observation ID Suffixes"`
 }
@@ -2139,7 +2139,7 @@ type OM5 struct {
 // by mathematical or logical means.
 type OM6 struct {
 	HL7                                     HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	DerivationRule                          TX      `hl7:"2,len=10240,display=Derivation Rule"`
 }
 
@@ -2150,29 +2150,29 @@ type OM6 struct {
 // The OM7 segment contains additional basic attributes that apply to the definition of most observations/services.
 type OM7 struct {
 	HL7                                     HL7Name `hl7:",name=OM7,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,len=4,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	UniversalServiceIdentifier              CWE     `hl7:"2,required,len=705,display=Universal Service Identifier"`
 	CategoryIdentifier                      []CWE   `hl7:"3,len=250,table=0412,display=Category Identifier"`
 	CategoryDescription                     TX      `hl7:"4,len=200,display=Category Description"`
 	CategorySynonym                         []ST    `hl7:"5,len=200,display=Category Synonym"`
 	EffectiveTestServiceStartDateTime       DTM     `hl7:"6,len=24,format=YMDHM,display=Effective Test/Service Start Date/Time"`
 	EffectiveTestServiceEndDateTime         DTM     `hl7:"7,len=24,format=YMDHM,display=Effective Test/Service End Date/Time"`
-	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,display=Test/Service Default Duration Quantity"`
+	TestServiceDefaultDurationQuantity      NM      `hl7:"8,len=5,datatype=NM,display=Test/Service Default Duration Quantity"`
 	TestServiceDefaultDurationUnits         *CWE    `hl7:"9,len=250,display=Test/Service Default Duration Units"`
-	TestServiceDefaultFrequency             IS      `hl7:"10,len=60,display=Test/Service Default Frequency"`
-	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,display=Consent Indicator"`
+	TestServiceDefaultFrequency             IS      `hl7:"10,len=60,datatype=IS,display=Test/Service Default Frequency"`
+	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Consent Indicator"`
 	ConsentIdentifier                       *CWE    `hl7:"12,len=250,table=0413,display=Consent Identifier"`
 	ConsentEffectiveStartDateTime           DTM     `hl7:"13,len=24,format=YMDHM,display=Consent Effective Start Date/Time"`
 	ConsentEffectiveEndDateTime             DTM     `hl7:"14,len=24,format=YMDHM,display=Consent Effective End Date/Time"`
-	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,display=Consent Interval Quantity"`
+	ConsentIntervalQuantity                 NM      `hl7:"15,len=5,datatype=NM,display=Consent Interval Quantity"`
 	ConsentIntervalUnits                    *CWE    `hl7:"16,conditional,len=250,table=0414,display=Consent Interval Units"`
-	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,display=Consent Waiting Period Quantity"`
+	ConsentWaitingPeriodQuantity            NM      `hl7:"17,len=5,datatype=NM,display=Consent Waiting Period Quantity"`
 	ConsentWaitingPeriodUnits               *CWE    `hl7:"18,conditional,len=250,table=0414,display=Consent Waiting Period Units"`
 	EffectiveDateTimeOfChange               DTM     `hl7:"19,len=24,format=YMDHM,display=Effective Date/Time of Change"`
 	EnteredBy                               *XCN    `hl7:"20,len=3220,display=Entered By"`
 	OrderableAtLocation                     []PL    `hl7:"21,len=200,display=Orderable-at Location"`
-	FormularyStatus                         IS      `hl7:"22,len=1,table=0473,display=Formulary Status"`
-	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,display=Special Order Indicator"`
+	FormularyStatus                         IS      `hl7:"22,len=1,table=0473,datatype=IS,display=Formulary Status"`
+	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Special Order Indicator"`
 	PrimaryKeyValueCDM                      []CWE   `hl7:"24,len=250,table=0132,display=Primary Key Value - CDM"`
 }
 
@@ -2181,12 +2181,12 @@ type OM7 struct {
 // The Common Order segment (ORC) is used to transmit fields that are common to all orders (all types of services that are requested).
 type ORC struct {
 	HL7                                     HL7Name `hl7:",name=ORC,type=s"`
-	OrderControl                            ID      `hl7:"1,required,len=2,table=0119,display=Order Control"`
+	OrderControl                            ID      `hl7:"1,required,len=2,table=0119,datatype=ID,display=Order Control"`
 	PlacerOrderNumber                       *EI     `hl7:"2,conditional,len=427,display=Placer Order Number"`
 	FillerOrderNumber                       *EI     `hl7:"3,conditional,len=427,display=Filler Order Number"`
 	PlacerGroupNumber                       *EI     `hl7:"4,len=22,display=Placer Group Number"`
-	OrderStatus                             ID      `hl7:"5,len=2,table=0038,display=Order Status"`
-	ResponseFlag                            ID      `hl7:"6,len=1,table=0121,display=Response Flag"`
+	OrderStatus                             ID      `hl7:"5,len=2,table=0038,datatype=ID,display=Order Status"`
+	ResponseFlag                            ID      `hl7:"6,len=1,table=0121,datatype=ID,display=Response Flag"`
 	QuantityTiming                          []TQ    `hl7:"7,len=705,display=Quantity/Timing"`
 	Parent                                  *EIP    `hl7:"8,len=200,display=Parent"`
 	DateTimeOfTransaction                   DTM     `hl7:"9,len=24,format=YMDHM,display=Date/Time of Transaction"`
@@ -2224,18 +2224,18 @@ type ORC struct {
 // Category.
 type ORG struct {
 	HL7                                        HL7Name `hl7:",name=ORG,type=s"`
-	SetID                                      SI      `hl7:"1,seq,required,len=60,display=Set ID – ORG"`
+	SetID                                      SI      `hl7:"1,seq,required,len=60,datatype=SI,display=Set ID – ORG"`
 	OrganizationUnitCode                       *CWE    `hl7:"2,len=250,table=0405,display=Organization Unit Code"`
 	OrganizationUnitTypeCode                   *CWE    `hl7:"3,len=250,table=0474,display=Organization Unit Type Code"`
-	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,display=Primary Org Unit Indicator"`
+	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Primary Org Unit Indicator"`
 	PractitionerUnitIdentifier                 *CX     `hl7:"5,len=60,display=Practitioner Org Unit Identifier"`
 	HealthCareProviderTypeCode                 *CWE    `hl7:"6,len=250,table=0452,display=Health Care Provider Type Code"`
 	HealthCareProviderClassificationCode       *CWE    `hl7:"7,len=250,table=0453,display=Health Care Provider Classification Code"`
 	HealthCareProviderAreaOfSpecializationCode *CWE    `hl7:"8,len=250,table=0454,display=Health Care Provider Area of Specialization Code"`
 	EffectiveDateRange                         *DR     `hl7:"9,len=52,display=Effective Date Range"`
 	EmploymentStatusCode                       *CWE    `hl7:"10,len=250,table=0066,display=Employment Status Code"`
-	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,display=Board Approval Indicator"`
-	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,display=Primary Care Physician Indicator"`
+	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Board Approval Indicator"`
+	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Primary Care Physician Indicator"`
 }
 
 // Override Segment
@@ -2260,8 +2260,8 @@ type OVR struct {
 // Center accounts, Patient Charge Identifier, and Patient Price is unique.
 type PCE struct {
 	HL7                     HL7Name `hl7:",name=PCE,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=2,display=Set ID – PCE"`
-	CostCenterAccountNumber IS      `hl7:"2,len=30,table=0319,display=Cost Center Account Number"`
+	SetID                   SI      `hl7:"1,seq,required,len=2,datatype=SI,display=Set ID – PCE"`
+	CostCenterAccountNumber IS      `hl7:"2,len=30,table=0319,datatype=IS,display=Cost Center Account Number"`
 	TransactionCode         *CWE    `hl7:"3,len=250,table=0132,display=Transaction Code"`
 	TransactionAmountUnit   *CP     `hl7:"4,len=12,display=Transaction Amount - Unit"`
 }
@@ -2274,28 +2274,28 @@ type PCE struct {
 type PCR struct {
 	HL7                               HL7Name `hl7:",name=PCR,type=s"`
 	ImplicatedProduct                 CWE     `hl7:"1,required,len=705,display=Implicated Product"`
-	GenericProduct                    IS      `hl7:"2,len=1,table=0249,display=Generic Product"`
+	GenericProduct                    IS      `hl7:"2,len=1,table=0249,datatype=IS,display=Generic Product"`
 	ProductClass                      *CWE    `hl7:"3,len=705,display=Product Class"`
 	TotalDurationOfTherapy            *CQ     `hl7:"4,len=500,display=Total Duration Of Therapy"`
 	ProductManufactureDate            DTM     `hl7:"5,len=24,format=YMDHM,display=Product Manufacture Date"`
 	ProductExpirationDate             DTM     `hl7:"6,len=24,format=YMDHM,display=Product Expiration Date"`
 	ProductImplantationDate           DTM     `hl7:"7,len=24,format=YMDHM,display=Product Implantation Date"`
 	ProductExplantationDate           DTM     `hl7:"8,len=24,format=YMDHM,display=Product Explantation Date"`
-	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,display=Single Use Device"`
+	SingleUseDevice                   IS      `hl7:"9,len=8,table=0244,datatype=IS,display=Single Use Device"`
 	IndicationForProductUse           *CWE    `hl7:"10,len=705,display=Indication For Product Use"`
-	ProductProblem                    IS      `hl7:"11,len=8,table=0245,display=Product Problem"`
+	ProductProblem                    IS      `hl7:"11,len=8,table=0245,datatype=IS,display=Product Problem"`
 	ProductSerialLotNumber            []ST    `hl7:"12,max=3,len=199,display=Product Serial/Lot Number"`
-	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,display=Product Available For Inspection"`
+	ProductAvailableForInspection     IS      `hl7:"13,len=1,table=0246,datatype=IS,display=Product Available For Inspection"`
 	ProductEvaluationPerformed        *CWE    `hl7:"14,len=705,display=Product Evaluation Performed"`
 	ProductEvaluationStatus           *CWE    `hl7:"15,len=705,table=0247,display=Product Evaluation Status"`
 	ProductEvaluationResults          *CWE    `hl7:"16,len=705,display=Product Evaluation Results"`
-	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,display=Evaluated Product Source"`
+	EvaluatedProductSource            ID      `hl7:"17,len=8,table=0248,datatype=ID,display=Evaluated Product Source"`
 	DateProductReturnedToManufacturer DTM     `hl7:"18,len=24,format=YMDHM,display=Date Product Returned To Manufacturer"`
-	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,display=Device Operator Qualifications"`
-	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,display=Relatedness Assessment"`
-	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,display=Action Taken In Response To The Event"`
-	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,display=Event Causality Observations"`
-	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,display=Indirect Exposure Mechanism"`
+	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,datatype=ID,display=Device Operator Qualifications"`
+	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,datatype=ID,display=Relatedness Assessment"`
+	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,datatype=ID,display=Action Taken In Response To The Event"`
+	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,datatype=ID,display=Event Causality Observations"`
+	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,datatype=ID,display=Indirect Exposure Mechanism"`
 }
 
 // Patient Additional Demographic
@@ -2303,27 +2303,27 @@ type PCR struct {
 // The patient additional demographic segment contains demographic information that is likely to change about the patient.
 type PD1 struct {
 	HL7                                     HL7Name `hl7:",name=PD1,type=s"`
-	LivingDependency                        []IS    `hl7:"1,len=2,table=0223,display=Living Dependency"`
-	LivingArrangement                       IS      `hl7:"2,len=2,table=0220,display=Living Arrangement"`
+	LivingDependency                        []IS    `hl7:"1,len=2,table=0223,datatype=IS,display=Living Dependency"`
+	LivingArrangement                       IS      `hl7:"2,len=2,table=0220,datatype=IS,display=Living Arrangement"`
 	PatientPrimaryFacility                  []XON   `hl7:"3,len=250,table=0204,display=Patient Primary Facility"`
 	PatientPrimaryCareProviderNameAndIDNo   []XCN   `hl7:"4,len=250,display=Patient Primary Care Provider Name and ID No."`
-	StudentIndicator                        IS      `hl7:"5,len=2,table=0231,display=Student Indicator"`
-	Handicap                                IS      `hl7:"6,len=2,table=0295,display=Handicap"`
-	LivingWillCode                          IS      `hl7:"7,len=2,table=0315,display=Living Will Code"`
-	OrganDonorCode                          IS      `hl7:"8,len=2,table=0316,display=Organ Donor Code"`
-	SeparateBill                            ID      `hl7:"9,len=1,table=0136,display=Separate Bill"`
+	StudentIndicator                        IS      `hl7:"5,len=2,table=0231,datatype=IS,display=Student Indicator"`
+	Handicap                                IS      `hl7:"6,len=2,table=0295,datatype=IS,display=Handicap"`
+	LivingWillCode                          IS      `hl7:"7,len=2,table=0315,datatype=IS,display=Living Will Code"`
+	OrganDonorCode                          IS      `hl7:"8,len=2,table=0316,datatype=IS,display=Organ Donor Code"`
+	SeparateBill                            ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Separate Bill"`
 	DuplicatePatient                        []CX    `hl7:"10,len=250,display=Duplicate Patient"`
 	PublicityCode                           *CWE    `hl7:"11,len=705,table=0215,display=Publicity Code"`
-	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	ProtectionIndicatorEffectiveDate        DT      `hl7:"13,len=8,format=YMD,display=Protection Indicator Effective Date"`
 	PlaceOfWorship                          []XON   `hl7:"14,len=250,display=Place of Worship"`
 	AdvanceDirectiveCode                    []CWE   `hl7:"15,conditional,len=705,table=0435,display=Advance Directive Code"`
-	ImmunizationRegistryStatus              IS      `hl7:"16,len=1,table=0441,display=Immunization Registry Status"`
+	ImmunizationRegistryStatus              IS      `hl7:"16,len=1,table=0441,datatype=IS,display=Immunization Registry Status"`
 	ImmunizationRegistryStatusEffectiveDate DT      `hl7:"17,len=8,format=YMD,display=Immunization Registry Status Effective Date"`
 	PublicityCodeEffectiveDate              DT      `hl7:"18,len=8,format=YMD,display=Publicity Code Effective Date"`
-	MilitaryBranch                          IS      `hl7:"19,len=5,table=0140,display=Military Branch"`
-	MilitaryRankGrade                       IS      `hl7:"20,len=2,table=0141,display=Military Rank/Grade"`
-	MilitaryStatus                          IS      `hl7:"21,len=3,table=0142,display=Military Status"`
+	MilitaryBranch                          IS      `hl7:"19,len=5,table=0140,datatype=IS,display=Military Branch"`
+	MilitaryRankGrade                       IS      `hl7:"20,len=2,table=0141,datatype=IS,display=Military Rank/Grade"`
+	MilitaryStatus                          IS      `hl7:"21,len=3,table=0142,datatype=IS,display=Military Status"`
 	AdvanceDirectiveLastVerifiedDate        DT      `hl7:"22,len=8,format=YMD,display=Advance Directive Last Verified Date"`
 }
 
@@ -2334,13 +2334,13 @@ type PDA struct {
 	HL7                            HL7Name `hl7:",name=PDA,type=s"`
 	DeathCauseCode                 []CWE   `hl7:"1,len=705,display=Death Cause Code"`
 	DeathLocation                  *PL     `hl7:"2,len=80,display=Death Location"`
-	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,display=Death Certified Indicator"`
+	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Death Certified Indicator"`
 	DeathCertificateSignedDateTime DTM     `hl7:"4,len=24,format=YMDHM,display=Death Certificate Signed Date/Time"`
 	DeathCertifiedBy               *XCN    `hl7:"5,len=250,display=Death Certified By"`
-	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,display=Autopsy Indicator"`
+	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Autopsy Indicator"`
 	AutopsyStartAndEndDateTime     *DR     `hl7:"7,len=53,display=Autopsy Start and End Date/Time"`
 	AutopsyPerformedBy             *XCN    `hl7:"8,len=250,display=Autopsy Performed By"`
-	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,display=Coroner Indicator"`
+	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Coroner Indicator"`
 }
 
 // Product Detail Country
@@ -2355,7 +2355,7 @@ type PDC struct {
 	CatalogueIdentifier     ST      `hl7:"7,len=60,display=Catalogue Identifier"`
 	OtherIdentifier         []ST    `hl7:"8,len=60,display=Other Identifier"`
 	ProductCode             *CWE    `hl7:"9,len=705,display=Product Code"`
-	MarketingBasis          ID      `hl7:"10,len=4,table=0330,display=Marketing Basis"`
+	MarketingBasis          ID      `hl7:"10,len=4,table=0330,datatype=ID,display=Marketing Basis"`
 	MarketingApprovalID     ST      `hl7:"11,len=60,display=Marketing Approval ID"`
 	LabeledShelfLife        *CQ     `hl7:"12,len=722,display=Labeled Shelf Life"`
 	ExpectedShelfLife       *CQ     `hl7:"13,len=722,display=Expected Shelf Life"`
@@ -2379,11 +2379,11 @@ type PEO struct {
 	EventImprovedDateTime                 DTM     `hl7:"5,len=24,format=YMDHM,display=Event Improved Date/Time"`
 	EventEndedDataTime                    DTM     `hl7:"6,len=24,format=YMDHM,display=Event Ended Data/Time"`
 	EventLocationOccurredAddress          []XAD   `hl7:"7,len=2915,display=Event Location Occurred Address"`
-	EventQualification                    []ID    `hl7:"8,len=1,table=0237,display=Event Qualification"`
-	EventSerious                          ID      `hl7:"9,len=1,table=0238,display=Event Serious"`
-	EventExpected                         ID      `hl7:"10,len=1,table=0239,display=Event Expected"`
-	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,display=Event Outcome"`
-	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,display=Patient Outcome"`
+	EventQualification                    []ID    `hl7:"8,len=1,table=0237,datatype=ID,display=Event Qualification"`
+	EventSerious                          ID      `hl7:"9,len=1,table=0238,datatype=ID,display=Event Serious"`
+	EventExpected                         ID      `hl7:"10,len=1,table=0239,datatype=ID,display=Event Expected"`
+	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,datatype=ID,display=Event Outcome"`
+	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,datatype=ID,display=Patient Outcome"`
 	EventDescriptionFromOthers            []FT    `hl7:"13,len=600,display=Event Description From Others"`
 	EventDescriptionFromOriginalReporter  []FT    `hl7:"14,len=600,display=Event Description From Original Reporter"`
 	EventDescriptionFromPatient           []FT    `hl7:"15,len=600,display=Event Description From Patient"`
@@ -2393,10 +2393,10 @@ type PEO struct {
 	PrimaryObserverName                   []XPN   `hl7:"19,len=1317,display=Primary Observer Name"`
 	PrimaryObserverAddress                []XAD   `hl7:"20,len=2915,display=Primary Observer Address"`
 	PrimaryObserverTelephone              []XTN   `hl7:"21,len=2743,display=Primary Observer Telephone"`
-	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,display=Primary Observer's Qualification"`
-	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,display=Confirmation Provided By"`
+	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,datatype=ID,display=Primary Observer's Qualification"`
+	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,datatype=ID,display=Confirmation Provided By"`
 	PrimaryObserverAwareDateTime          DTM     `hl7:"24,len=24,format=YMDHM,display=Primary Observer Aware Date/Time"`
-	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,display=Primary Observer's Identity May Be Divulged"`
+	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=1,table=0243,datatype=ID,display=Primary Observer's Identity May Be Divulged"`
 }
 
 // Product Experience Sender
@@ -2407,14 +2407,14 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,len=2915,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,len=2743,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,len=427,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,len=16,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,len=16,datatype=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,len=600,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,len=600,display=Sender Comment"`
 	SenderAwareDateTime    DTM     `hl7:"9,len=24,format=YMDHM,display=Sender Aware Date/Time"`
 	EventReportDate        DTM     `hl7:"10,required,len=24,format=YMDHM,display=Event Report Date"`
-	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,display=Event Report Timing/Type"`
-	EventReportSource      ID      `hl7:"12,len=1,table=0235,display=Event Report Source"`
-	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,display=Event Reported To"`
+	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,datatype=ID,display=Event Report Timing/Type"`
+	EventReportSource      ID      `hl7:"12,len=1,table=0235,datatype=ID,display=Event Report Source"`
+	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,datatype=ID,display=Event Reported To"`
 }
 
 // Patient Identification
@@ -2424,18 +2424,18 @@ type PES struct {
 // to change frequently.
 type PID struct {
 	HL7                         HL7Name `hl7:",name=PID,type=s"`
-	SetID                       SI      `hl7:"1,seq,len=4,display=Set ID - PID"`
+	SetID                       SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - PID"`
 	PatientID                   CX      `hl7:"2,len=20,display=Patient ID"`
 	PatientIdentifierList       []CX    `hl7:"3,required,len=250,display=Patient Identifier List"`
 	AlternatePatientID          []CX    `hl7:"4,len=20,display=Alternate Patient ID - PID"`
 	PatientName                 []XPN   `hl7:"5,required,len=250,display=Patient Name"`
 	MothersMaidenName           []XPN   `hl7:"6,len=250,display=Mother's Maiden Name"`
 	DateTimeOfBirth             DTM     `hl7:"7,len=24,format=YMDHM,display=Date/Time of Birth"`
-	AdministrativeSex           IS      `hl7:"8,len=1,table=0001,display=Administrative Sex"`
+	AdministrativeSex           IS      `hl7:"8,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	PatientAlias                []XPN   `hl7:"9,len=250,display=Patient Alias"`
 	Race                        []CWE   `hl7:"10,len=705,table=0005,display=Race"`
 	PatientAddress              []XAD   `hl7:"11,len=250,display=Patient Address"`
-	CountyCode                  IS      `hl7:"12,len=4,table=0289,display=County Code"`
+	CountyCode                  IS      `hl7:"12,len=4,table=0289,datatype=IS,display=County Code"`
 	PhoneNumberHome             []XTN   `hl7:"13,len=250,display=Phone Number - Home"`
 	PhoneNumberBusiness         []XTN   `hl7:"14,len=250,display=Phone Number - Business"`
 	PrimaryLanguage             *CWE    `hl7:"15,len=705,table=0296,display=Primary Language"`
@@ -2447,15 +2447,15 @@ type PID struct {
 	MothersIdentifier           []CX    `hl7:"21,len=250,display=Mother's Identifier"`
 	EthnicGroup                 []CWE   `hl7:"22,len=705,table=0189,display=Ethnic Group"`
 	BirthPlace                  ST      `hl7:"23,len=250,display=Birth Place"`
-	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                  NM      `hl7:"25,len=2,display=Birth Order"`
+	MultipleBirthIndicator      ID      `hl7:"24,len=1,table=0136,datatype=ID,display=Multiple Birth Indicator"`
+	BirthOrder                  NM      `hl7:"25,len=2,datatype=NM,display=Birth Order"`
 	Citizenship                 []CWE   `hl7:"26,len=705,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus      *CWE    `hl7:"27,len=705,table=0172,display=Veterans Military Status"`
 	Nationality                 CWE     `hl7:"28,len=705,table=0212,display=Nationality"`
 	PatientDeathDateAndTime     DTM     `hl7:"29,len=24,format=YMDHM,display=Patient Death Date and Time"`
-	PatientDeathIndicator       ID      `hl7:"30,len=1,table=0136,display=Patient Death Indicator"`
-	IdentityUnknownIndicator    ID      `hl7:"31,len=1,table=0136,display=Identity Unknown Indicator"`
-	IdentityReliabilityCode     []IS    `hl7:"32,len=20,table=0445,display=Identity Reliability Code"`
+	PatientDeathIndicator       ID      `hl7:"30,len=1,table=0136,datatype=ID,display=Patient Death Indicator"`
+	IdentityUnknownIndicator    ID      `hl7:"31,len=1,table=0136,datatype=ID,display=Identity Unknown Indicator"`
+	IdentityReliabilityCode     []IS    `hl7:"32,len=20,table=0445,datatype=IS,display=Identity Reliability Code"`
 	LastUpdateDateTime          DTM     `hl7:"33,len=24,format=YMDHM,display=Last Update Date/Time"`
 	LastUpdateFacility          *HD     `hl7:"34,len=241,display=Last Update Facility"`
 	SpeciesCode                 *CWE    `hl7:"35,conditional,len=705,table=0446,display=Species Code"`
@@ -2472,10 +2472,10 @@ type PID struct {
 // unit of measure order corresponding with the ascending Set ID.
 type PKG struct {
 	HL7                                HL7Name `hl7:",name=PKG,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=2,display=Set Id - PKG"`
+	SetID                              SI      `hl7:"1,seq,required,len=2,datatype=SI,display=Set Id - PKG"`
 	PackagingUnits                     *CWE    `hl7:"2,len=250,table=0818,display=Packaging Units"`
 	DefaultOrderUnitOfMeasureIndicator *CNE    `hl7:"3,len=1,table=0532,display=Default Order Unit Of Measure Indicator"`
-	PackageQuantity                    NM      `hl7:"4,len=12,display=Package Quantity"`
+	PackageQuantity                    NM      `hl7:"4,len=12,datatype=NM,display=Package Quantity"`
 	Price                              *CP     `hl7:"5,len=12,display=Price"`
 	FutureItemPrice                    *CP     `hl7:"6,len=12,display=Future Item Price"`
 	FutureItemPriceEffectiveDate       DTM     `hl7:"7,len=24,format=YMDHM,display=Future Item Price Effective Date"`
@@ -2507,28 +2507,28 @@ type PMT struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                             HL7Name `hl7:",name=PR1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set ID - PR1"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - PR1"`
 	ProcedureCodingMethod           ST      `hl7:"2,display=Procedure Coding Method"`
 	ProcedureCode                   CNE     `hl7:"3,required,len=705,table=0088,display=Procedure Code"`
 	ProcedureDescription            ST      `hl7:"4,display=Procedure Description"`
 	ProcedureDateTime               DTM     `hl7:"5,required,len=24,format=YMDHM,display=Procedure Date/Time"`
-	ProcedureFunctionalType         IS      `hl7:"6,len=2,table=0230,display=Procedure Functional Type"`
-	ProcedureMinutes                NM      `hl7:"7,len=4,display=Procedure Minutes"`
+	ProcedureFunctionalType         IS      `hl7:"6,len=2,table=0230,datatype=IS,display=Procedure Functional Type"`
+	ProcedureMinutes                NM      `hl7:"7,len=4,datatype=NM,display=Procedure Minutes"`
 	Anesthesiologist                ST      `hl7:"8,display=Anesthesiologist"`
-	AnesthesiaCode                  IS      `hl7:"9,len=2,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes               NM      `hl7:"10,len=4,display=Anesthesia Minutes"`
+	AnesthesiaCode                  IS      `hl7:"9,len=2,table=0019,datatype=IS,display=Anesthesia Code"`
+	AnesthesiaMinutes               NM      `hl7:"10,len=4,datatype=NM,display=Anesthesia Minutes"`
 	Surgeon                         ST      `hl7:"11,display=Surgeon"`
 	ProcedurePractitioner           ST      `hl7:"12,display=Procedure Practitioner"`
 	ConsentCode                     *CWE    `hl7:"13,len=250,table=0059,display=Consent Code"`
-	ProcedurePriority               ID      `hl7:"14,len=2,table=0418,display=Procedure Priority"`
+	ProcedurePriority               ID      `hl7:"14,len=2,table=0418,datatype=ID,display=Procedure Priority"`
 	AssociatedDiagnosisCode         *CWE    `hl7:"15,len=250,table=0051,display=Associated Diagnosis Code"`
 	ProcedureCodeModifier           []CNE   `hl7:"16,len=705,table=0340,display=Procedure Code Modifier"`
-	ProcedureDRGType                IS      `hl7:"17,len=20,table=0416,display=Procedure DRG Type"`
+	ProcedureDRGType                IS      `hl7:"17,len=20,table=0416,datatype=IS,display=Procedure DRG Type"`
 	TissueTypeCode                  []CWE   `hl7:"18,len=250,table=0417,display=Tissue Type Code"`
 	ProcedureIdentifier             *EI     `hl7:"19,conditional,len=427,display=Procedure Identifier"`
-	ProcedureActionCode             ID      `hl7:"20,conditional,len=1,table=0206,display=Procedure Action Code"`
-	DRGProcedureDeterminationStatus IS      `hl7:"21,len=20,table=0761,display=DRG Procedure Determination Status"`
-	DRGProcedureRelevance           IS      `hl7:"22,len=20,table=0763,display=DRG Procedure Relevance"`
+	ProcedureActionCode             ID      `hl7:"20,conditional,len=1,table=0206,datatype=ID,display=Procedure Action Code"`
+	DRGProcedureDeterminationStatus IS      `hl7:"21,len=20,table=0761,datatype=IS,display=DRG Procedure Determination Status"`
+	DRGProcedureRelevance           IS      `hl7:"22,len=20,table=0763,datatype=IS,display=DRG Procedure Relevance"`
 }
 
 // Practitioner Detail
@@ -2543,8 +2543,8 @@ type PRA struct {
 	HL7                                       HL7Name `hl7:",name=PRA,type=s"`
 	PrimaryKeyValue                           *CWE    `hl7:"1,conditional,len=250,display=Primary Key Value - PRA"`
 	PractitionerGroup                         []CWE   `hl7:"2,len=250,table=0358,display=Practitioner Group"`
-	PractitionerCategory                      []IS    `hl7:"3,len=3,table=0186,display=Practitioner Category"`
-	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,display=Provider Billing"`
+	PractitionerCategory                      []IS    `hl7:"3,len=3,table=0186,datatype=IS,display=Practitioner Category"`
+	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,datatype=ID,display=Provider Billing"`
 	Specialty                                 []SPD   `hl7:"5,len=112,display=Specialty"`
 	PractitionerIDNumbers                     []PLN   `hl7:"6,len=99,display=Practitioner ID Numbers"`
 	Privileges                                []PIP   `hl7:"7,len=770,display=Privileges"`
@@ -2552,7 +2552,7 @@ type PRA struct {
 	Institution                               *CWE    `hl7:"9,len=250,table=0537,display=Institution"`
 	DateLeftPractice                          DT      `hl7:"10,len=8,format=YMD,display=Date Left Practice"`
 	GovernmentReimbursementBillingEligibility []CWE   `hl7:"11,len=250,table=0401,display=Government Reimbursement Billing Eligibility"`
-	SetID                                     SI      `hl7:"12,seq,conditional,len=60,display=Set ID - PRA"`
+	SetID                                     SI      `hl7:"12,seq,conditional,len=60,datatype=SI,display=Set ID - PRA"`
 }
 
 // Problem Details
@@ -2560,12 +2560,12 @@ type PRA struct {
 // The problem detail segment contains the data necessary to add, update, correct, and delete the problems of a given individual.
 type PRB struct {
 	HL7                                               HL7Name `hl7:",name=PRB,type=s"`
-	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime                                    DTM     `hl7:"2,required,len=24,format=YMDHM,display=Action Date/Time"`
 	ProblemID                                         CWE     `hl7:"3,required,len=705,display=Problem ID"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,len=60,display=Problem Instance ID"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,len=60,display=Episode of Care ID"`
-	ProblemListPriority                               NM      `hl7:"6,len=60,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,len=60,datatype=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        DTM     `hl7:"7,len=24,format=YMDHM,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              DTM     `hl7:"8,len=24,format=YMDHM,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   DTM     `hl7:"9,len=24,format=YMDHM,display=Actual Problem Resolution Date/Time"`
@@ -2579,7 +2579,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,len=80,display=Problem Onset Text"`
 	ProblemRanking                                    *CWE    `hl7:"18,len=705,display=Problem Ranking"`
 	CertaintyOfProblem                                *CWE    `hl7:"19,len=705,display=Certainty of Problem"`
-	ProbabilityOfProblem                              NM      `hl7:"20,len=5,display=Probability of Problem"`
+	ProbabilityOfProblem                              NM      `hl7:"20,len=5,datatype=NM,display=Probability of Problem"`
 	IndividualAwarenessOfProblem                      *CWE    `hl7:"21,len=705,display=Individual Awareness of Problem"`
 	ProblemPrognosis                                  *CWE    `hl7:"22,len=705,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CWE    `hl7:"23,len=705,display=Individual Awareness of Prognosis"`
@@ -2603,21 +2603,21 @@ type PRC struct {
 	PrimaryKeyValue     CWE     `hl7:"1,required,len=250,table=0132,display=Primary Key Value - PRC"`
 	FacilityID          []CWE   `hl7:"2,len=250,table=0464,display=Facility ID - PRC"`
 	Department          []CWE   `hl7:"3,len=250,table=0184,display=Department"`
-	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,display=Valid Patient Classes"`
+	ValidPatientClasses []IS    `hl7:"4,len=1,table=0004,datatype=IS,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,len=12,display=Price"`
 	Formula             []ST    `hl7:"6,len=200,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,len=4,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,len=4,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,len=4,datatype=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,len=4,datatype=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,len=12,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,len=12,display=Maximum Price"`
 	EffectiveStartDate  DTM     `hl7:"11,len=24,format=YMDHM,display=Effective Start Date"`
 	EffectiveEndDate    DTM     `hl7:"12,len=24,format=YMDHM,display=Effective End Date"`
-	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,display=Price Override Flag"`
+	PriceOverrideFlag   IS      `hl7:"13,len=1,table=0268,datatype=IS,display=Price Override Flag"`
 	BillingCategory     []CWE   `hl7:"14,len=250,table=0293,display=Billing Category"`
-	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,display=Chargeable Flag"`
-	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,display=Active/Inactive Flag"`
+	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Chargeable Flag"`
+	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Cost                *MO     `hl7:"17,len=12,display=Cost"`
-	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,display=Charge On Indicator"`
+	ChargeOnIndicator   IS      `hl7:"18,len=1,table=0269,datatype=IS,display=Charge On Indicator"`
 }
 
 // Provider Data
@@ -2657,8 +2657,8 @@ type PSG struct {
 	HL7                               HL7Name `hl7:",name=PSG,type=s"`
 	ProviderProductServiceGroupNumber EI      `hl7:"1,required,len=73,display=Provider Product/Service Group Number"`
 	PayerProductServiceGroupNumber    *EI     `hl7:"2,len=73,display=Payer Product/Service Group Number"`
-	ProductServiceGroupSequenceNumber SI      `hl7:"3,required,len=4,display=Product/Service Group Sequence Number"`
-	AdjudicateAsGroup                 ID      `hl7:"4,required,len=1,table=0136,display=Adjudicate as Group"`
+	ProductServiceGroupSequenceNumber SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Group Sequence Number"`
+	AdjudicateAsGroup                 ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Adjudicate as Group"`
 	ProductServiceGroupBilledAmount   CP      `hl7:"5,required,len=254,display=Product/Service Group Billed Amount"`
 	ProductServiceGroupDescription    ST      `hl7:"6,required,len=254,display=Product/Service Group Description"`
 }
@@ -2673,13 +2673,13 @@ type PSH struct {
 	ReportIntervalEndDate                              DTM     `hl7:"5,len=24,format=YMDHM,display=Report Interval End Date"`
 	QuantityManufactured                               *CQ     `hl7:"6,len=722,display=Quantity Manufactured"`
 	QuantityDistributed                                *CQ     `hl7:"7,len=722,display=Quantity Distributed"`
-	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,display=Quantity Distributed Method"`
+	QuantityDistributedMethod                          ID      `hl7:"8,len=1,table=0329,datatype=ID,display=Quantity Distributed Method"`
 	QuantityDistributedComment                         FT      `hl7:"9,len=600,display=Quantity Distributed Comment"`
 	QuantityInUse                                      *CQ     `hl7:"10,len=722,display=Quantity in Use"`
-	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,display=Quantity in Use Method"`
+	QuantityInUseMethod                                ID      `hl7:"11,len=1,table=0329,datatype=ID,display=Quantity in Use Method"`
 	QuantityInUseComment                               FT      `hl7:"12,len=600,display=Quantity in Use Comment"`
-	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=16,display=Number of Product Experience Reports Filed by Facility"`
-	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=16,display=Number of Product Experience Reports Filed by Distributor"`
+	NumberOfProductExperienceReportsFiledByFacility    []NM    `hl7:"13,max=8,len=16,datatype=NM,display=Number of Product Experience Reports Filed by Facility"`
+	NumberOfProductExperienceReportsFiledByDistributor []NM    `hl7:"14,max=8,len=16,datatype=NM,display=Number of Product Experience Reports Filed by Distributor"`
 }
 
 // Product/Service Line Item
@@ -2690,7 +2690,7 @@ type PSL struct {
 	HL7                                      HL7Name `hl7:",name=PSL,type=s"`
 	ProviderProductServiceLineItemNumber     EI      `hl7:"1,required,len=73,display=Provider Product/Service Line Item Number"`
 	PayerProductServiceLineItemNumber        *EI     `hl7:"2,len=73,display=Payer Product/Service Line Item Number"`
-	ProductServiceLineItemSequenceNumber     SI      `hl7:"3,required,len=4,display=Product/Service Line Item Sequence Number"`
+	ProductServiceLineItemSequenceNumber     SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Line Item Sequence Number"`
 	ProviderTrackingID                       *EI     `hl7:"4,len=20,display=Provider Tracking ID"`
 	PayerTrackingID                          *EI     `hl7:"5,len=20,display=Payer Tracking ID"`
 	ProductServiceLineItemStatus             CWE     `hl7:"6,required,len=2,table=0559,display=Product/Service Line Item Status"`
@@ -2701,40 +2701,40 @@ type PSL struct {
 	ProductServiceExpirationDate             DTM     `hl7:"11,len=24,format=YMDHM,display=Product/Service Expiration Date"`
 	ProductServiceQuantity                   *CQ     `hl7:"12,conditional,len=222,display=Product/Service Quantity"`
 	ProductServiceUnitCost                   *CP     `hl7:"13,conditional,len=254,display=Product/Service Unit Cost"`
-	NumberOfItemsPerUnit                     NM      `hl7:"14,conditional,len=10,display=Number of Items per Unit"`
+	NumberOfItemsPerUnit                     NM      `hl7:"14,conditional,len=10,datatype=NM,display=Number of Items per Unit"`
 	ProductServiceGrossAmount                *CP     `hl7:"15,conditional,len=254,display=Product/Service Gross Amount"`
 	ProductServiceBilledAmount               *CP     `hl7:"16,conditional,len=254,display=Product/Service Billed Amount"`
-	ProductServiceClarificationCodeType      []IS    `hl7:"17,max=20,len=10,table=0561,display=Product/Service Clarification Code Type"`
+	ProductServiceClarificationCodeType      []IS    `hl7:"17,max=20,len=10,table=0561,datatype=IS,display=Product/Service Clarification Code Type"`
 	ProductServiceClarificationCodeValue     []ST    `hl7:"18,max=20,len=40,display=Product/Service Clarification Code Value"`
 	HealthDocumentReferenceIdentifier        []EI    `hl7:"19,max=5,len=73,display=Health Document Reference Identifier"`
-	ProcessingConsiderationCode              []IS    `hl7:"20,max=20,len=10,table=0562,display=Processing Consideration Code"`
-	RestrictedDisclosureIndicator            ID      `hl7:"21,required,len=2,table=0532,display=Restricted Disclosure Indicator"`
+	ProcessingConsiderationCode              []IS    `hl7:"20,max=20,len=10,table=0562,datatype=IS,display=Processing Consideration Code"`
+	RestrictedDisclosureIndicator            ID      `hl7:"21,required,len=2,table=0532,datatype=ID,display=Restricted Disclosure Indicator"`
 	RelatedProductServiceCodeIndicator       *CWE    `hl7:"22,len=177,table=0879,display=Related Product/Service Code Indicator"`
 	ProductServiceAmountForPhysician         *CP     `hl7:"23,len=254,display=Product/Service Amount for Physician"`
-	ProductServiceCostFactor                 NM      `hl7:"24,len=5,display=Product/Service Cost Factor"`
+	ProductServiceCostFactor                 NM      `hl7:"24,len=5,datatype=NM,display=Product/Service Cost Factor"`
 	CostCenter                               *CX     `hl7:"25,len=250,display=Cost Center"`
 	BillingPeriod                            *DR     `hl7:"26,len=49,display=Billing Period"`
-	DaysWithoutBilling                       NM      `hl7:"27,len=5,display=Days without Billing"`
-	SessionNo                                NM      `hl7:"28,len=4,display=Session-No"`
+	DaysWithoutBilling                       NM      `hl7:"27,len=5,datatype=NM,display=Days without Billing"`
+	SessionNo                                NM      `hl7:"28,len=4,datatype=NM,display=Session-No"`
 	ExecutingPhysicianID                     *XCN    `hl7:"29,len=20,display=Executing Physician ID"`
 	ResponsiblePhysicianID                   *XCN    `hl7:"30,len=20,display=Responsible Physician ID"`
 	RoleExecutingPhysician                   *CWE    `hl7:"31,len=10,table=0881,display=Role Executing Physician"`
 	MedicalRoleExecutingPhysician            *CWE    `hl7:"32,len=10,table=0882,display=Medical Role Executing Physician"`
 	SideOfBody                               *CWE    `hl7:"33,len=3,table=0894,display=Side of body"`
-	NumberOfTPsPP                            NM      `hl7:"34,len=6,display=Number of TP's PP"`
+	NumberOfTPsPP                            NM      `hl7:"34,len=6,datatype=NM,display=Number of TP's PP"`
 	TPValuePP                                *CP     `hl7:"35,len=6,display=TP-Value PP"`
-	InternalScalingFactorPP                  NM      `hl7:"36,len=4,display=Internal Scaling Factor PP"`
-	ExternalScalingFactorPP                  NM      `hl7:"37,len=4,display=External Scaling Factor PP"`
+	InternalScalingFactorPP                  NM      `hl7:"36,len=4,datatype=NM,display=Internal Scaling Factor PP"`
+	ExternalScalingFactorPP                  NM      `hl7:"37,len=4,datatype=NM,display=External Scaling Factor PP"`
 	AmountPP                                 *CP     `hl7:"38,len=7,display=Amount PP"`
-	NumberOfTPsTechnicalPart                 NM      `hl7:"39,len=6,display=Number of TP's Technical Part"`
+	NumberOfTPsTechnicalPart                 NM      `hl7:"39,len=6,datatype=NM,display=Number of TP's Technical Part"`
 	TPValueTechnicalPart                     *CP     `hl7:"40,len=6,display=TP-Value Technical Part"`
-	InternalScalingFactorTechnicalPart       NM      `hl7:"41,len=4,display=Internal Scaling Factor Technical Part"`
-	ExternalScalingFactorTechnicalPart       NM      `hl7:"42,len=4,display=External Scaling Factor Technical Part"`
+	InternalScalingFactorTechnicalPart       NM      `hl7:"41,len=4,datatype=NM,display=Internal Scaling Factor Technical Part"`
+	ExternalScalingFactorTechnicalPart       NM      `hl7:"42,len=4,datatype=NM,display=External Scaling Factor Technical Part"`
 	AmountTechnicalPart                      *CP     `hl7:"43,len=7,display=Amount Technical Part"`
 	TotalAmountProfessionalPartTechnicalPart *CP     `hl7:"44,len=8,display=Total Amount Professional Part + Technical Part"`
-	VATRate                                  NM      `hl7:"45,len=3,display=VAT-Rate"`
-	MainService                              ID      `hl7:"46,len=20,display=Main-Service"`
-	Validation                               ID      `hl7:"47,len=1,table=0136,display=Validation"`
+	VATRate                                  NM      `hl7:"45,len=3,datatype=NM,display=VAT-Rate"`
+	MainService                              ID      `hl7:"46,len=20,datatype=ID,display=Main-Service"`
+	Validation                               ID      `hl7:"47,len=1,table=0136,datatype=ID,display=Validation"`
 	Comment                                  ST      `hl7:"48,len=255,display=Comment"`
 }
 
@@ -2746,7 +2746,7 @@ type PSS struct {
 	HL7                                 HL7Name `hl7:",name=PSS,type=s"`
 	ProviderProductServiceSectionNumber EI      `hl7:"1,required,len=73,display=Provider Product/Service Section Number"`
 	PayerProductServiceSectionNumber    *EI     `hl7:"2,len=73,display=Payer Product/Service Section Number"`
-	ProductServiceSectionSequenceNumber SI      `hl7:"3,required,len=4,display=Product/Service Section Sequence Number"`
+	ProductServiceSectionSequenceNumber SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Section Sequence Number"`
 	BilledAmount                        CP      `hl7:"4,required,len=254,display=Billed Amount"`
 	SectionDescriptionOrHeading         ST      `hl7:"5,required,len=254,display=Section Description or Heading"`
 }
@@ -2757,7 +2757,7 @@ type PSS struct {
 // to address an individual's health care.
 type PTH struct {
 	HL7                                  HL7Name `hl7:",name=PTH,type=s"`
-	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	PathwayID                            CWE     `hl7:"2,required,len=705,display=Pathway ID"`
 	PathwayInstanceID                    EI      `hl7:"3,required,len=60,display=Pathway Instance ID"`
 	PathwayEstablishedDateTime           DTM     `hl7:"4,required,len=24,format=YMDHM,display=Pathway Established Date/Time"`
@@ -2774,57 +2774,57 @@ type PTH struct {
 // that are part of the associated PV1 hierarchy (e.g., ROL, DG1, or OBX).
 type PV1 struct {
 	HL7                     HL7Name `hl7:",name=PV1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - PV1"`
-	PatientClass            IS      `hl7:"2,required,len=1,table=0004,display=Patient Class"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - PV1"`
+	PatientClass            IS      `hl7:"2,required,len=1,table=0004,datatype=IS,display=Patient Class"`
 	AssignedPatientLocation *PL     `hl7:"3,len=80,display=Assigned Patient Location"`
-	AdmissionType           IS      `hl7:"4,len=2,table=0007,display=Admission Type"`
+	AdmissionType           IS      `hl7:"4,len=2,table=0007,datatype=IS,display=Admission Type"`
 	PreadmitNumber          *CX     `hl7:"5,len=250,display=Preadmit Number"`
 	PriorPatientLocation    *PL     `hl7:"6,len=80,display=Prior Patient Location"`
 	AttendingDoctor         []XCN   `hl7:"7,len=250,table=0010,display=Attending Doctor"`
 	ReferringDoctor         []XCN   `hl7:"8,len=250,table=0010,display=Referring Doctor"`
 	ConsultingDoctor        []XCN   `hl7:"9,len=250,table=0010,display=Consulting Doctor"`
-	HospitalService         IS      `hl7:"10,len=3,table=0069,display=Hospital Service"`
+	HospitalService         IS      `hl7:"10,len=3,table=0069,datatype=IS,display=Hospital Service"`
 	TemporaryLocation       *PL     `hl7:"11,len=80,display=Temporary Location"`
-	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,display=Preadmit Test Indicator"`
-	ReAdmissionIndicator    IS      `hl7:"13,len=2,table=0092,display=Re-admission Indicator"`
-	AdmitSource             IS      `hl7:"14,len=6,table=0023,display=Admit Source"`
-	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,display=Ambulatory Status"`
-	VIPIndicator            IS      `hl7:"16,len=2,table=0099,display=VIP Indicator"`
+	PreadmitTestIndicator   IS      `hl7:"12,len=2,table=0087,datatype=IS,display=Preadmit Test Indicator"`
+	ReAdmissionIndicator    IS      `hl7:"13,len=2,table=0092,datatype=IS,display=Re-admission Indicator"`
+	AdmitSource             IS      `hl7:"14,len=6,table=0023,datatype=IS,display=Admit Source"`
+	AmbulatoryStatus        []IS    `hl7:"15,len=2,table=0009,datatype=IS,display=Ambulatory Status"`
+	VIPIndicator            IS      `hl7:"16,len=2,table=0099,datatype=IS,display=VIP Indicator"`
 	AdmittingDoctor         []XCN   `hl7:"17,len=250,table=0010,display=Admitting Doctor"`
-	PatientType             IS      `hl7:"18,len=2,table=0018,display=Patient Type"`
+	PatientType             IS      `hl7:"18,len=2,table=0018,datatype=IS,display=Patient Type"`
 	VisitNumber             *CX     `hl7:"19,len=250,display=Visit Number"`
 	FinancialClass          []FC    `hl7:"20,len=50,display=Financial Class"`
-	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,display=Charge Price Indicator"`
-	CourtesyCode            IS      `hl7:"22,len=2,table=0045,display=Courtesy Code"`
-	CreditRating            IS      `hl7:"23,len=2,table=0046,display=Credit Rating"`
-	ContractCode            []IS    `hl7:"24,len=2,table=0044,display=Contract Code"`
+	ChargePriceIndicator    IS      `hl7:"21,len=2,table=0032,datatype=IS,display=Charge Price Indicator"`
+	CourtesyCode            IS      `hl7:"22,len=2,table=0045,datatype=IS,display=Courtesy Code"`
+	CreditRating            IS      `hl7:"23,len=2,table=0046,datatype=IS,display=Credit Rating"`
+	ContractCode            []IS    `hl7:"24,len=2,table=0044,datatype=IS,display=Contract Code"`
 	ContractEffectiveDate   []DT    `hl7:"25,len=8,format=YMD,display=Contract Effective Date"`
-	ContractAmount          []NM    `hl7:"26,len=12,display=Contract Amount"`
-	ContractPeriod          []NM    `hl7:"27,len=3,display=Contract Period"`
-	InterestCode            IS      `hl7:"28,len=2,table=0073,display=Interest Code"`
-	TransferToBadDebtCode   IS      `hl7:"29,len=4,table=0110,display=Transfer to Bad Debt Code"`
+	ContractAmount          []NM    `hl7:"26,len=12,datatype=NM,display=Contract Amount"`
+	ContractPeriod          []NM    `hl7:"27,len=3,datatype=NM,display=Contract Period"`
+	InterestCode            IS      `hl7:"28,len=2,table=0073,datatype=IS,display=Interest Code"`
+	TransferToBadDebtCode   IS      `hl7:"29,len=4,table=0110,datatype=IS,display=Transfer to Bad Debt Code"`
 	TransferToBadDebtDate   DT      `hl7:"30,len=8,format=YMD,display=Transfer to Bad Debt Date"`
-	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount   NM      `hl7:"32,len=12,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,display=Bad Debt Recovery Amount"`
-	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,display=Delete Account Indicator"`
+	BadDebtAgencyCode       IS      `hl7:"31,len=10,table=0021,datatype=IS,display=Bad Debt Agency Code"`
+	BadDebtTransferAmount   NM      `hl7:"32,len=12,datatype=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount   NM      `hl7:"33,len=12,datatype=NM,display=Bad Debt Recovery Amount"`
+	DeleteAccountIndicator  IS      `hl7:"34,len=1,table=0111,datatype=IS,display=Delete Account Indicator"`
 	DeleteAccountDate       DT      `hl7:"35,len=8,format=YMD,display=Delete Account Date"`
-	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,display=Discharge Disposition"`
+	DischargeDisposition    IS      `hl7:"36,len=3,table=0112,datatype=IS,display=Discharge Disposition"`
 	DischargedToLocation    *DLD    `hl7:"37,len=47,display=Discharged to Location"`
 	DietType                *CWE    `hl7:"38,len=705,table=0114,display=Diet Type"`
-	ServicingFacility       IS      `hl7:"39,len=2,table=0115,display=Servicing Facility"`
-	BedStatus               IS      `hl7:"40,len=1,table=0116,display=Bed Status"`
-	AccountStatus           IS      `hl7:"41,len=2,table=0117,display=Account Status"`
+	ServicingFacility       IS      `hl7:"39,len=2,table=0115,datatype=IS,display=Servicing Facility"`
+	BedStatus               IS      `hl7:"40,len=1,table=0116,datatype=IS,display=Bed Status"`
+	AccountStatus           IS      `hl7:"41,len=2,table=0117,datatype=IS,display=Account Status"`
 	PendingLocation         *PL     `hl7:"42,len=80,display=Pending Location"`
 	PriorTemporaryLocation  *PL     `hl7:"43,len=80,display=Prior Temporary Location"`
 	AdmitDateTime           DTM     `hl7:"44,len=24,format=YMDHM,display=Admit Date/Time"`
 	DischargeDateTime       DTM     `hl7:"45,len=24,format=YMDHM,display=Discharge Date/Time"`
-	CurrentPatientBalance   NM      `hl7:"46,len=12,display=Current Patient Balance"`
-	TotalCharges            NM      `hl7:"47,len=12,display=Total Charges"`
-	TotalAdjustments        NM      `hl7:"48,len=12,display=Total Adjustments"`
-	TotalPayments           NM      `hl7:"49,len=12,display=Total Payments"`
+	CurrentPatientBalance   NM      `hl7:"46,len=12,datatype=NM,display=Current Patient Balance"`
+	TotalCharges            NM      `hl7:"47,len=12,datatype=NM,display=Total Charges"`
+	TotalAdjustments        NM      `hl7:"48,len=12,datatype=NM,display=Total Adjustments"`
+	TotalPayments           NM      `hl7:"49,len=12,datatype=NM,display=Total Payments"`
 	AlternateVisitID        *CX     `hl7:"50,len=250,display=Alternate Visit ID"`
-	VisitIndicator          IS      `hl7:"51,len=1,table=0326,display=Visit Indicator"`
+	VisitIndicator          IS      `hl7:"51,len=1,table=0326,datatype=IS,display=Visit Indicator"`
 	OtherHealthcareProvider []XCN   `hl7:"52,len=250,table=0010,display=Other Healthcare Provider"`
 }
 
@@ -2839,49 +2839,49 @@ type PV2 struct {
 	TransferReason                      *CWE    `hl7:"4,len=705,display=Transfer Reason"`
 	PatientValuables                    []ST    `hl7:"5,len=25,display=Patient Valuables"`
 	PatientValuablesLocation            ST      `hl7:"6,len=25,display=Patient Valuables Location"`
-	VisitUserCode                       []IS    `hl7:"7,len=2,table=0130,display=Visit User Code"`
+	VisitUserCode                       []IS    `hl7:"7,len=2,table=0130,datatype=IS,display=Visit User Code"`
 	ExpectedAdmitDateTime               DTM     `hl7:"8,len=24,format=YMDHM,display=Expected Admit Date/Time"`
 	ExpectedDischargeDateTime           DTM     `hl7:"9,len=24,format=YMDHM,display=Expected Discharge Date/Time"`
-	EstimatedLengthOfInpatientStay      NM      `hl7:"10,len=3,display=Estimated Length of Inpatient Stay"`
-	ActualLengthOfInpatientStay         NM      `hl7:"11,len=3,display=Actual Length of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay      NM      `hl7:"10,len=3,datatype=NM,display=Estimated Length of Inpatient Stay"`
+	ActualLengthOfInpatientStay         NM      `hl7:"11,len=3,datatype=NM,display=Actual Length of Inpatient Stay"`
 	VisitDescription                    ST      `hl7:"12,len=50,display=Visit Description"`
 	ReferralSourceCode                  []XCN   `hl7:"13,len=250,display=Referral Source Code"`
 	PreviousServiceDate                 DT      `hl7:"14,len=8,format=YMD,display=Previous Service Date"`
-	EmploymentIllnessRelatedIndicator   ID      `hl7:"15,len=1,table=0136,display=Employment Illness Related Indicator"`
-	PurgeStatusCode                     IS      `hl7:"16,len=1,table=0213,display=Purge Status Code"`
+	EmploymentIllnessRelatedIndicator   ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Employment Illness Related Indicator"`
+	PurgeStatusCode                     IS      `hl7:"16,len=1,table=0213,datatype=IS,display=Purge Status Code"`
 	PurgeStatusDate                     DT      `hl7:"17,len=8,format=YMD,display=Purge Status Date"`
-	SpecialProgramCode                  IS      `hl7:"18,len=2,table=0214,display=Special Program Code"`
-	RetentionIndicator                  ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans      NM      `hl7:"20,len=1,display=Expected Number of Insurance Plans"`
-	VisitPublicityCode                  IS      `hl7:"21,len=1,table=0215,display=Visit Publicity Code"`
-	VisitProtectionIndicator            ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
+	SpecialProgramCode                  IS      `hl7:"18,len=2,table=0214,datatype=IS,display=Special Program Code"`
+	RetentionIndicator                  ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Retention Indicator"`
+	ExpectedNumberOfInsurancePlans      NM      `hl7:"20,len=1,datatype=NM,display=Expected Number of Insurance Plans"`
+	VisitPublicityCode                  IS      `hl7:"21,len=1,table=0215,datatype=IS,display=Visit Publicity Code"`
+	VisitProtectionIndicator            ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Visit Protection Indicator"`
 	ClinicOrganizationName              []XON   `hl7:"23,len=250,display=Clinic Organization Name"`
-	PatientStatusCode                   IS      `hl7:"24,len=2,table=0216,display=Patient Status Code"`
-	VisitPriorityCode                   IS      `hl7:"25,len=1,table=0217,display=Visit Priority Code"`
+	PatientStatusCode                   IS      `hl7:"24,len=2,table=0216,datatype=IS,display=Patient Status Code"`
+	VisitPriorityCode                   IS      `hl7:"25,len=1,table=0217,datatype=IS,display=Visit Priority Code"`
 	PreviousTreatmentDate               DT      `hl7:"26,len=8,format=YMD,display=Previous Treatment Date"`
-	ExpectedDischargeDisposition        IS      `hl7:"27,len=2,table=0112,display=Expected Discharge Disposition"`
+	ExpectedDischargeDisposition        IS      `hl7:"27,len=2,table=0112,datatype=IS,display=Expected Discharge Disposition"`
 	SignatureOnFileDate                 DT      `hl7:"28,len=8,format=YMD,display=Signature on File Date"`
 	FirstSimilarIllnessDate             DT      `hl7:"29,len=8,format=YMD,display=First Similar Illness Date"`
 	PatientChargeAdjustmentCode         *CWE    `hl7:"30,len=705,table=0218,display=Patient Charge Adjustment Code"`
-	RecurringServiceCode                IS      `hl7:"31,len=2,table=0219,display=Recurring Service Code"`
-	BillingMediaCode                    ID      `hl7:"32,len=1,table=0136,display=Billing Media Code"`
+	RecurringServiceCode                IS      `hl7:"31,len=2,table=0219,datatype=IS,display=Recurring Service Code"`
+	BillingMediaCode                    ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Billing Media Code"`
 	ExpectedSurgeryDateAndTime          DTM     `hl7:"33,len=24,format=YMDHM,display=Expected Surgery Date and Time"`
-	MilitaryPartnershipCode             ID      `hl7:"34,len=1,table=0136,display=Military Partnership Code"`
-	MilitaryNonAvailabilityCode         ID      `hl7:"35,len=1,table=0136,display=Military Non-Availability Code"`
-	NewbornBabyIndicator                ID      `hl7:"36,len=1,table=0136,display=Newborn Baby Indicator"`
-	BabyDetainedIndicator               ID      `hl7:"37,len=1,table=0136,display=Baby Detained Indicator"`
+	MilitaryPartnershipCode             ID      `hl7:"34,len=1,table=0136,datatype=ID,display=Military Partnership Code"`
+	MilitaryNonAvailabilityCode         ID      `hl7:"35,len=1,table=0136,datatype=ID,display=Military Non-Availability Code"`
+	NewbornBabyIndicator                ID      `hl7:"36,len=1,table=0136,datatype=ID,display=Newborn Baby Indicator"`
+	BabyDetainedIndicator               ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Baby Detained Indicator"`
 	ModeOfArrivalCode                   *CWE    `hl7:"38,len=705,table=0430,display=Mode of Arrival Code"`
 	RecreationalDrugUseCode             []CWE   `hl7:"39,len=705,table=0431,display=Recreational Drug Use Code"`
 	AdmissionLevelOfCareCode            *CWE    `hl7:"40,len=705,table=0432,display=Admission Level of Care Code"`
 	PrecautionCode                      []CWE   `hl7:"41,len=705,table=0433,display=Precaution Code"`
 	PatientConditionCode                *CWE    `hl7:"42,len=705,table=0434,display=Patient Condition Code"`
-	LivingWillCode                      IS      `hl7:"43,len=2,table=0315,display=Living Will Code"`
-	OrganDonorCode                      IS      `hl7:"44,len=2,table=0316,display=Organ Donor Code"`
+	LivingWillCode                      IS      `hl7:"43,len=2,table=0315,datatype=IS,display=Living Will Code"`
+	OrganDonorCode                      IS      `hl7:"44,len=2,table=0316,datatype=IS,display=Organ Donor Code"`
 	AdvanceDirectiveCode                []CWE   `hl7:"45,conditional,len=705,table=0435,display=Advance Directive Code"`
 	PatientStatusEffectiveDate          DT      `hl7:"46,len=8,format=YMD,display=Patient Status Effective Date"`
 	ExpectedLOAReturnDateTime           DTM     `hl7:"47,conditional,len=24,format=YMDHM,display=Expected LOA Return Date/Time"`
 	ExpectedPreAdmissionTestingDateTime DTM     `hl7:"48,len=24,format=YMDHM,display=Expected Pre-admission Testing Date/Time"`
-	NotifyClergyCode                    []IS    `hl7:"49,len=20,table=0534,display=Notify Clergy Code"`
+	NotifyClergyCode                    []IS    `hl7:"49,len=20,table=0534,datatype=IS,display=Notify Clergy Code"`
 	AdvanceDirectiveLastVerifiedDate    DT      `hl7:"50,len=8,format=YMD,display=Advance Directive Last Verified Date"`
 }
 
@@ -2890,13 +2890,13 @@ type PV2 struct {
 // This segment is used to define payee information.
 type PYE struct {
 	HL7                               HL7Name `hl7:",name=PYE,type=s"`
-	SetID                             SI      `hl7:"1,seq,required,len=4,display=Set ID – PYE"`
-	PayeeType                         IS      `hl7:"2,required,len=6,table=0557,display=Payee Type"`
-	PayeeRelationshipToInvoicePatient IS      `hl7:"3,conditional,len=2,table=0558,display=Payee Relationship to Invoice (Patient)"`
+	SetID                             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID – PYE"`
+	PayeeType                         IS      `hl7:"2,required,len=6,table=0557,datatype=IS,display=Payee Type"`
+	PayeeRelationshipToInvoicePatient IS      `hl7:"3,conditional,len=2,table=0558,datatype=IS,display=Payee Relationship to Invoice (Patient)"`
 	PayeeIdentificationList           []XON   `hl7:"4,conditional,max=5,len=183,display=Payee Identification List"`
 	PayeePersonName                   []XPN   `hl7:"5,conditional,max=4,len=466,display=Payee Person Name"`
 	PayeeAddress                      []XAD   `hl7:"6,conditional,max=4,len=235,display=Payee Address"`
-	PaymentMethod                     IS      `hl7:"7,len=80,table=0570,display=Payment Method"`
+	PaymentMethod                     IS      `hl7:"7,len=80,table=0570,datatype=IS,display=Payment Method"`
 }
 
 // Query Acknowledgment
@@ -2908,11 +2908,11 @@ type PYE struct {
 type QAK struct {
 	HL7                 HL7Name `hl7:",name=QAK,type=s"`
 	QueryTag            ST      `hl7:"1,conditional,len=32,display=Query Tag"`
-	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,display=Query Response Status"`
+	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,datatype=ID,display=Query Response Status"`
 	MessageQueryName    *CWE    `hl7:"3,len=250,table=0471,display=Message Query Name"`
-	HitCount            NM      `hl7:"4,len=10,display=Hit Count"`
-	ThisPayload         NM      `hl7:"5,len=10,display=This payload"`
-	HitsRemaining       NM      `hl7:"6,len=10,display=Hits remaining"`
+	HitCount            NM      `hl7:"4,len=10,datatype=NM,display=Hit Count"`
+	ThisPayload         NM      `hl7:"5,len=10,datatype=NM,display=This payload"`
+	HitsRemaining       NM      `hl7:"6,len=10,datatype=NM,display=Hits remaining"`
 }
 
 // Query Identification
@@ -2943,17 +2943,17 @@ type QPD struct {
 type QRD struct {
 	HL7                      HL7Name `hl7:",name=QRD,type=s"`
 	QueryDateTime            DTM     `hl7:"1,required,len=24,format=YMDHM,display=Query Date/Time"`
-	QueryFormatCode          ID      `hl7:"2,required,len=1,table=0106,display=Query Format Code"`
-	QueryPriority            ID      `hl7:"3,required,len=1,table=0091,display=Query Priority"`
+	QueryFormatCode          ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query Format Code"`
+	QueryPriority            ID      `hl7:"3,required,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QueryID                  ST      `hl7:"4,required,len=10,display=Query ID"`
-	DeferredResponseType     ID      `hl7:"5,len=1,table=0107,display=Deferred Response Type"`
+	DeferredResponseType     ID      `hl7:"5,len=1,table=0107,datatype=ID,display=Deferred Response Type"`
 	DeferredResponseDateTime DTM     `hl7:"6,len=24,format=YMDHM,display=Deferred Response Date/Time"`
 	QuantityLimitedRequest   CQ      `hl7:"7,required,len=10,display=Quantity Limited Request"`
 	WhoSubjectFilter         []XCN   `hl7:"8,required,len=250,display=Who Subject Filter"`
 	WhatSubjectFilter        []CWE   `hl7:"9,required,len=250,table=0048,display=What Subject Filter"`
 	WhatDepartmentDataCode   []CWE   `hl7:"10,required,len=250,display=What Department Data Code"`
 	WhatDataCodeValueQual    []VR    `hl7:"11,len=20,display=What Data Code Value Qual."`
-	QueryResultsLevel        ID      `hl7:"12,len=1,table=0108,display=Query Results Level"`
+	QueryResultsLevel        ID      `hl7:"12,len=1,table=0108,datatype=ID,display=Query Results Level"`
 }
 
 // Original style query filter
@@ -2968,11 +2968,11 @@ type QRF struct {
 	WhenDataEndDateTime          DTM     `hl7:"3,len=24,format=YMDHM,display=When Data End Date/Time"`
 	WhatUserQualifier            []ST    `hl7:"4,len=60,display=What User Qualifier"`
 	OtherQRYSubjectFilter        []ST    `hl7:"5,len=60,display=Other QRY Subject Filter"`
-	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,display=Which Date/Time Qualifier"`
-	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,display=Which Date/Time Status Qualifier"`
-	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,display=Date/Time Selection Qualifier"`
+	WhichDateTimeQualifier       []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=Which Date/Time Qualifier"`
+	WhichDateTimeStatusQualifier []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=Which Date/Time Status Qualifier"`
+	DateTimeSelectionQualifier   []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=Date/Time Selection Qualifier"`
 	WhenQuantityTimingQualifier  TQ      `hl7:"9,len=60,display=When Quantity/Timing Qualifier"`
-	SearchConfidenceThreshold    NM      `hl7:"10,len=10,display=Search Confidence Threshold"`
+	SearchConfidenceThreshold    NM      `hl7:"10,len=10,datatype=NM,display=Search Confidence Threshold"`
 }
 
 // Query Response Instance
@@ -2981,8 +2981,8 @@ type QRF struct {
 // algorithm) and/or the match reason code (where the responding system uses rules or other match options).
 type QRI struct {
 	HL7                 HL7Name `hl7:",name=QRI,type=s"`
-	CandidateConfidence NM      `hl7:"1,len=10,display=Candidate Confidence"`
-	MatchReasonCode     []IS    `hl7:"2,len=2,table=0392,display=Match Reason Code"`
+	CandidateConfidence NM      `hl7:"1,len=10,datatype=NM,display=Candidate Confidence"`
+	MatchReasonCode     []IS    `hl7:"2,len=2,table=0392,datatype=IS,display=Match Reason Code"`
 	AlgorithmDescriptor *CWE    `hl7:"3,len=250,table=0393,display=Algorithm Descriptor"`
 }
 
@@ -2991,13 +2991,13 @@ type QRI struct {
 // The RCP segment is used to restrict the amount of data that should be returned in response to query.
 type RCP struct {
 	HL7                      HL7Name `hl7:",name=RCP,type=s"`
-	QueryPriority            ID      `hl7:"1,len=1,table=0091,display=Query Priority"`
+	QueryPriority            ID      `hl7:"1,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QuantityLimitedRequest   *CQ     `hl7:"2,len=10,display=Quantity Limited Request"`
 	ResponseModality         *CNE    `hl7:"3,len=250,table=0394,display=Response Modality"`
 	ExecutionAndDeliveryTime DTM     `hl7:"4,conditional,len=24,format=YMDHM,display=Execution and Delivery Time"`
-	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,display=Modify Indicator"`
+	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,datatype=ID,display=Modify Indicator"`
 	SortByField              []SRT   `hl7:"6,len=512,display=Sort-by Field"`
-	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,display=Segment group inclusion"`
+	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,datatype=ID,display=Segment group inclusion"`
 }
 
 // Table Row Definition
@@ -3005,7 +3005,7 @@ type RCP struct {
 // The RDF segment defines the content of the row data segments (RDT) in the tabular response (RTB).
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,display=Number of Columns per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,len=3,datatype=NM,display=Number of Columns per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,len=40,display=Column Description"`
 }
 
@@ -3042,7 +3042,7 @@ type RFI struct {
 	HL7                                   HL7Name `hl7:",name=RFI,type=s"`
 	RequestDate                           DTM     `hl7:"1,required,len=24,format=YMDHM,display=Request Date"`
 	ResponseDueDate                       DTM     `hl7:"2,required,len=24,format=YMDHM,display=Response Due Date"`
-	PatientConsent                        ID      `hl7:"3,len=1,table=0136,display=Patient Consent"`
+	PatientConsent                        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Patient Consent"`
 	DateAdditionalInformationWasSubmitted DTM     `hl7:"4,len=24,format=YMDHM,display=Date Additional Information was submitted"`
 }
 
@@ -3055,8 +3055,8 @@ type RFI struct {
 // or AIP).
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set ID - RGS"`
-	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,display=Segment Action Code"`
+	SetID             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID - RGS"`
+	SegmentActionCode ID      `hl7:"2,conditional,len=3,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceGroupID   *CWE    `hl7:"3,len=250,display=Resource Group ID"`
 }
 
@@ -3077,7 +3077,7 @@ type RMI struct {
 type ROL struct {
 	HL7                         HL7Name `hl7:",name=ROL,type=s"`
 	RoleInstanceID              *EI     `hl7:"1,conditional,len=60,display=Role Instance ID"`
-	ActionCode                  ID      `hl7:"2,required,len=2,table=0287,display=Action Code"`
+	ActionCode                  ID      `hl7:"2,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	Role                        CWE     `hl7:"3,required,len=250,table=0443,display=Role-ROL"`
 	RolePerson                  []XCN   `hl7:"4,required,len=250,display=Role Person"`
 	RoleBeginDateTime           DTM     `hl7:"5,len=24,format=YMDHM,display=Role Begin Date/Time"`
@@ -3102,8 +3102,8 @@ type RQ1 struct {
 	ManufacturersCatalog   ST      `hl7:"3,conditional,len=16,display=Manufacturer's Catalog"`
 	VendorID               *CWE    `hl7:"4,conditional,len=250,display=Vendor ID"`
 	VendorCatalog          ST      `hl7:"5,conditional,len=16,display=Vendor Catalog"`
-	Taxable                ID      `hl7:"6,len=1,table=0136,display=Taxable"`
-	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,display=Substitute Allowed"`
+	Taxable                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Taxable"`
+	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Substitute Allowed"`
 }
 
 // Requisition Detail
@@ -3111,14 +3111,14 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,datatype=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CWE    `hl7:"2,conditional,len=250,display=Item Code - Internal"`
 	ItemCodeExternal         *CWE    `hl7:"3,conditional,len=250,display=Item Code - External"`
 	HospitalItemCode         *CWE    `hl7:"4,conditional,len=250,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,len=6,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,len=6,datatype=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CWE    `hl7:"6,len=250,display=Requisition Unit of Measure"`
-	CostCenterAccountNumber  IS      `hl7:"7,len=30,table=0319,display=Cost Center Account Number"`
-	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,display=Item Natural Account Code"`
+	CostCenterAccountNumber  IS      `hl7:"7,len=30,table=0319,datatype=IS,display=Cost Center Account Number"`
+	ItemNaturalAccountCode   IS      `hl7:"8,len=30,table=0320,datatype=IS,display=Item Natural Account Code"`
 	DeliverToID              *CWE    `hl7:"9,len=250,display=Deliver To ID"`
 	DateNeeded               DT      `hl7:"10,len=8,format=YMD,display=Date Needed"`
 }
@@ -3130,32 +3130,32 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                                 HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter                    NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	AdministrationSubIDCounter          NM      `hl7:"2,required,len=4,display=Administration Sub-ID Counter"`
+	GiveSubIDCounter                    NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	AdministrationSubIDCounter          NM      `hl7:"2,required,len=4,datatype=NM,display=Administration Sub-ID Counter"`
 	DateTimeStartOfAdministration       DTM     `hl7:"3,required,len=24,format=YMDHM,display=Date/Time Start of Administration"`
 	DateTimeEndOfAdministration         DTM     `hl7:"4,required,len=24,format=YMDHM,display=Date/Time End of Administration"`
 	AdministeredCode                    CWE     `hl7:"5,required,len=250,table=0292,display=Administered Code"`
-	AdministeredAmount                  NM      `hl7:"6,required,len=20,display=Administered Amount"`
+	AdministeredAmount                  NM      `hl7:"6,required,len=20,datatype=NM,display=Administered Amount"`
 	AdministeredUnits                   *CWE    `hl7:"7,conditional,len=250,display=Administered Units"`
 	AdministeredDosageForm              *CWE    `hl7:"8,len=250,display=Administered Dosage Form"`
 	AdministrationNotes                 []CWE   `hl7:"9,len=250,display=Administration Notes"`
 	AdministeringProvider               []XCN   `hl7:"10,len=250,display=Administering Provider"`
 	AdministeredAtLocation              LA2     `hl7:"11,len=200,display=Administered-at Location"`
 	AdministeredPerTimeUnit             ST      `hl7:"12,conditional,len=20,display=Administered Per (Time Unit)"`
-	AdministeredStrength                NM      `hl7:"13,len=20,display=Administered Strength"`
+	AdministeredStrength                NM      `hl7:"13,len=20,datatype=NM,display=Administered Strength"`
 	AdministeredStrengthUnits           *CWE    `hl7:"14,len=250,display=Administered Strength Units"`
 	SubstanceLotNumber                  []ST    `hl7:"15,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate             []DTM   `hl7:"16,len=24,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName           []CWE   `hl7:"17,len=250,table=0227,display=Substance Manufacturer Name"`
 	SubstanceTreatmentRefusalReason     []CWE   `hl7:"18,len=250,display=Substance/Treatment Refusal Reason"`
 	Indication                          []CWE   `hl7:"19,len=250,display=Indication"`
-	CompletionStatus                    ID      `hl7:"20,len=2,table=0322,display=Completion Status"`
-	ActionCode                          ID      `hl7:"21,len=2,table=0206,display=Action Code - RXA"`
+	CompletionStatus                    ID      `hl7:"20,len=2,table=0322,datatype=ID,display=Completion Status"`
+	ActionCode                          ID      `hl7:"21,len=2,table=0206,datatype=ID,display=Action Code - RXA"`
 	SystemEntryDateTime                 DTM     `hl7:"22,len=24,format=YMDHM,display=System Entry Date/Time"`
-	AdministeredDrugStrengthVolume      NM      `hl7:"23,len=5,display=Administered Drug Strength Volume"`
+	AdministeredDrugStrengthVolume      NM      `hl7:"23,len=5,datatype=NM,display=Administered Drug Strength Volume"`
 	AdministeredDrugStrengthVolumeUnits *CWE    `hl7:"24,len=250,display=Administered Drug Strength Volume Units"`
 	AdministeredBarcodeIdentifier       *CWE    `hl7:"25,len=60,display=Administered Barcode Identifier"`
-	PharmacyOrderType                   ID      `hl7:"26,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                   ID      `hl7:"26,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	AdministerAt                        *PL     `hl7:"27,len=180,display=Administer-at"`
 	AdministeredAtAddress               *XAD    `hl7:"28,len=106,display=Administered-at Address"`
 }
@@ -3168,52 +3168,52 @@ type RXA struct {
 // at the RXC level is identical to that for the RXO level.
 type RXC struct {
 	HL7                              HL7Name `hl7:",name=RXC,type=s"`
-	RXComponentType                  ID      `hl7:"1,required,len=1,table=0166,display=RX Component Type"`
+	RXComponentType                  ID      `hl7:"1,required,len=1,table=0166,datatype=ID,display=RX Component Type"`
 	ComponentCode                    CWE     `hl7:"2,required,len=250,display=Component Code"`
-	ComponentAmount                  NM      `hl7:"3,required,len=20,display=Component Amount"`
+	ComponentAmount                  NM      `hl7:"3,required,len=20,datatype=NM,display=Component Amount"`
 	ComponentUnits                   CWE     `hl7:"4,required,len=250,display=Component Units"`
-	ComponentStrength                NM      `hl7:"5,len=20,display=Component Strength"`
+	ComponentStrength                NM      `hl7:"5,len=20,datatype=NM,display=Component Strength"`
 	ComponentStrengthUnits           *CWE    `hl7:"6,len=250,display=Component Strength Units"`
 	SupplementaryCode                []CWE   `hl7:"7,len=250,display=Supplementary Code"`
-	ComponentDrugStrengthVolume      NM      `hl7:"8,len=5,display=Component Drug Strength Volume"`
+	ComponentDrugStrengthVolume      NM      `hl7:"8,len=5,datatype=NM,display=Component Drug Strength Volume"`
 	ComponentDrugStrengthVolumeUnits *CWE    `hl7:"9,len=250,display=Component Drug Strength Volume Units"`
 }
 
 // Pharmacy/Treatment Dispense
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,display=Dispense Sub-ID Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	DispenseGiveCode                                        CWE     `hl7:"2,required,len=250,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       DTM     `hl7:"3,required,len=24,format=YMDHM,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,len=20,datatype=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CWE    `hl7:"5,conditional,len=250,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CWE    `hl7:"6,len=250,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,display=Number of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,len=200,display=Dispense Notes"`
 	DispensingProvider                                      []XCN   `hl7:"10,len=200,display=Dispensing Provider"`
-	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	TotalDailyDose                                          *CQ     `hl7:"12,len=10,display=Total Daily Dose"`
 	DispenseToLocation                                      LA2     `hl7:"13,len=200,display=Dispense-to Location"`
-	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CWE   `hl7:"15,len=250,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,len=20,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,len=20,datatype=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CWE    `hl7:"17,len=250,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []DTM   `hl7:"19,len=24,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CWE   `hl7:"20,len=250,table=0227,display=Substance Manufacturer Name"`
 	Indication                                              []CWE   `hl7:"21,len=250,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CWE    `hl7:"23,len=250,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CWE   `hl7:"25,len=250,display=Supplementary Code"`
 	InitiatingLocation                                      *CWE    `hl7:"26,len=250,display=Initiating Location"`
 	PackagingAssemblyLocation                               *CWE    `hl7:"27,len=250,display=Packaging/Assembly Location"`
-	ActualDrugStrengthVolume                                NM      `hl7:"28,len=5,display=Actual Drug Strength Volume"`
+	ActualDrugStrengthVolume                                NM      `hl7:"28,len=5,datatype=NM,display=Actual Drug Strength Volume"`
 	ActualDrugStrengthVolumeUnits                           *CWE    `hl7:"29,len=250,display=Actual Drug Strength Volume Units"`
 	DispenseToPharmacy                                      *CWE    `hl7:"30,len=180,display=Dispense to Pharmacy"`
 	DispenseToPharmacyAddress                               *XAD    `hl7:"31,len=106,display=Dispense to Pharmacy Address"`
-	PharmacyOrderType                                       ID      `hl7:"32,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                       ID      `hl7:"32,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	DispenseType                                            *CWE    `hl7:"33,len=250,table=0484,display=Dispense Type"`
 }
 
@@ -3226,79 +3226,79 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          TQ      `hl7:"1,len=705,display=Quantity/Timing"`
 	GiveCode                                                CWE     `hl7:"2,required,len=250,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CWE     `hl7:"5,required,len=250,display=Give Units"`
 	GiveDosageForm                                          *CWE    `hl7:"6,len=250,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions                     []CWE   `hl7:"7,len=250,display=Provider's Administration Instructions"`
 	DeliverToLocation                                       LA1     `hl7:"8,len=200,display=Deliver-To Location"`
-	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,display=Dispense Amount"`
+	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,datatype=ID,display=Substitution Status"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,len=20,datatype=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CWE    `hl7:"11,conditional,len=250,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,len=3,display=Number Of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,len=3,datatype=NM,display=Number Of Refills"`
 	OrderingProvidersDEANumber                              []XCN   `hl7:"13,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID                  []XCN   `hl7:"14,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,len=20,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,display=Number of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,display=Number of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,len=20,datatype=NM,display=Number of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,len=20,datatype=NM,display=Number of Refills/Doses Dispensed"`
 	DTOfMostRecentRefillOrDoseDispensed                     DTM     `hl7:"18,conditional,len=24,format=YMDHM,display=D/T of Most Recent Refill or Dose Dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,len=10,display=Total Daily Dose"`
-	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CWE   `hl7:"21,len=250,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
 	GivePerTimeUnit                                         ST      `hl7:"22,conditional,len=20,display=Give Per (Time Unit)"`
 	GiveRateAmount                                          ST      `hl7:"23,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                           *CWE    `hl7:"24,len=250,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,len=20,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CWE    `hl7:"26,len=250,display=Give Strength Units"`
 	GiveIndication                                          []CWE   `hl7:"27,len=250,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,len=20,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,len=20,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CWE    `hl7:"29,len=250,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CWE   `hl7:"31,len=250,display=Supplementary Code"`
 	OriginalOrderDateTime                                   DTM     `hl7:"32,len=24,format=YMDHM,display=Original Order Date/Time"`
-	GiveDrugStrengthVolume                                  NM      `hl7:"33,len=5,display=Give Drug Strength Volume"`
+	GiveDrugStrengthVolume                                  NM      `hl7:"33,len=5,datatype=NM,display=Give Drug Strength Volume"`
 	GiveDrugStrengthVolumeUnits                             *CWE    `hl7:"34,len=250,display=Give Drug Strength Volume Units"`
 	ControlledSubstanceSchedule                             *CWE    `hl7:"35,len=60,table=0477,display=Controlled Substance Schedule"`
-	FormularyStatus                                         ID      `hl7:"36,len=1,table=0478,display=Formulary Status"`
+	FormularyStatus                                         ID      `hl7:"36,len=1,table=0478,datatype=ID,display=Formulary Status"`
 	PharmaceuticalSubstanceAlternative                      []CWE   `hl7:"37,len=60,display=Pharmaceutical Substance Alternative"`
 	PharmacyOfMostRecentFill                                *CWE    `hl7:"38,len=250,display=Pharmacy of Most Recent Fill"`
-	InitialDispenseAmount                                   NM      `hl7:"39,len=250,display=Initial Dispense Amount"`
+	InitialDispenseAmount                                   NM      `hl7:"39,len=250,datatype=NM,display=Initial Dispense Amount"`
 	DispensingPharmacy                                      *CWE    `hl7:"40,len=250,display=Dispensing Pharmacy"`
 	DispensingPharmacyAddress                               *XAD    `hl7:"41,len=250,display=Dispensing Pharmacy Address"`
 	DeliverToPatientLocation                                *PL     `hl7:"42,len=80,display=Deliver-to Patient Location"`
 	DeliverToAddress                                        *XAD    `hl7:"43,len=250,display=Deliver-to Address"`
-	PharmacyOrderType                                       ID      `hl7:"44,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                       ID      `hl7:"44,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 }
 
 // Pharmacy/Treatment Give
 type RXG struct {
 	HL7                                                         HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,display=Give Sub-ID Counter"`
-	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,display=Dispense Sub-ID Counter"`
+	GiveSubIDCounter                                            NM      `hl7:"1,required,len=4,datatype=NM,display=Give Sub-ID Counter"`
+	DispenseSubIDCounter                                        NM      `hl7:"2,len=4,datatype=NM,display=Dispense Sub-ID Counter"`
 	QuantityTiming                                              TQ      `hl7:"3,len=705,display=Quantity/Timing"`
 	GiveCode                                                    CWE     `hl7:"4,required,len=250,table=0292,display=Give Code"`
-	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                           NM      `hl7:"6,len=20,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                           NM      `hl7:"5,required,len=20,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                           NM      `hl7:"6,len=20,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                                   CWE     `hl7:"7,required,len=250,display=Give Units"`
 	GiveDosageForm                                              *CWE    `hl7:"8,len=250,display=Give Dosage Form"`
 	AdministrationNotes                                         []CWE   `hl7:"9,len=250,display=Administration Notes"`
-	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	DispenseToLocation                                          LA2     `hl7:"11,len=200,display=Dispense-to Location"`
-	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialAdministrationInstructions []CWE   `hl7:"13,len=250,display=Pharmacy/Treatment Supplier's Special Administration Instructions"`
 	GivePerTImeUnit                                             ST      `hl7:"14,conditional,len=20,display=Give Per (TIme Unit)"`
 	GiveRateAmount                                              ST      `hl7:"15,len=6,display=Give Rate Amount"`
 	GiveRateUnits                                               *CWE    `hl7:"16,len=250,display=Give Rate Units"`
-	GiveStrength                                                NM      `hl7:"17,len=20,display=Give Strength"`
+	GiveStrength                                                NM      `hl7:"17,len=20,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                           *CWE    `hl7:"18,len=250,display=Give Strength Units"`
 	SubstanceLotNumber                                          []ST    `hl7:"19,len=20,display=Substance Lot Number"`
 	SubstanceExpirationDate                                     []DTM   `hl7:"20,len=24,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName                                   []CWE   `hl7:"21,len=250,table=0227,display=Substance Manufacturer Name"`
 	Indication                                                  []CWE   `hl7:"22,len=250,display=Indication"`
-	GiveDrugStrengthVolume                                      NM      `hl7:"23,len=5,display=Give Drug Strength Volume"`
+	GiveDrugStrengthVolume                                      NM      `hl7:"23,len=5,datatype=NM,display=Give Drug Strength Volume"`
 	GiveDrugStrengthVolumeUnits                                 *CWE    `hl7:"24,len=250,display=Give Drug Strength Volume Units"`
 	GiveBarcodeIdentifier                                       *CWE    `hl7:"25,len=60,display=Give Barcode Identifier"`
-	PharmacyOrderType                                           ID      `hl7:"26,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                           ID      `hl7:"26,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	DispenseToPharmacy                                          *CWE    `hl7:"27,len=180,display=Dispense to Pharmacy"`
 	DispenseToPharmacyAddress                                   *XAD    `hl7:"28,len=106,display=Dispense to Pharmacy Address"`
 	DeliverToPatientLocation                                    *PL     `hl7:"29,len=80,display=Deliver-to Patient Location"`
@@ -3312,33 +3312,33 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      *CWE    `hl7:"1,conditional,len=250,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,len=20,datatype=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,len=20,datatype=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     *CWE    `hl7:"4,conditional,len=250,display=Requested Give Units"`
 	RequestedDosageForm                    *CWE    `hl7:"5,conditional,len=250,display=Requested Dosage Form"`
 	ProvidersPharmacyTreatmentInstructions []CWE   `hl7:"6,len=250,display=Provider's Pharmacy/Treatment Instructions"`
 	ProvidersAdministrationInstructions    []CWE   `hl7:"7,len=250,display=Provider's Administration Instructions"`
 	DeliverToLocation                      LA1     `hl7:"8,len=200,display=Deliver-To Location"`
-	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
+	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,datatype=ID,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CWE    `hl7:"10,len=250,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,len=20,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,len=20,datatype=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CWE    `hl7:"12,len=250,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,len=3,display=Number Of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,len=3,datatype=NM,display=Number Of Refills"`
 	OrderingProvidersDEANumber             []XCN   `hl7:"14,conditional,len=250,display=Ordering Provider's DEA Number"`
 	PharmacistTreatmentSuppliersVerifierID []XCN   `hl7:"15,conditional,len=250,display=Pharmacist/Treatment Supplier's Verifier ID"`
-	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	RequestedGivePerTimeUnit               ST      `hl7:"17,conditional,len=20,display=Requested Give Per (Time Unit)"`
-	RequestedGiveStrength                  NM      `hl7:"18,len=20,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,len=20,datatype=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CWE    `hl7:"19,len=250,display=Requested Give Strength Units"`
 	Indication                             []CWE   `hl7:"20,len=250,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,len=6,display=Requested Give Rate Amount"`
 	RequestedGiveRateUnits                 *CWE    `hl7:"22,len=250,display=Requested Give Rate Units"`
 	TotalDailyDose                         *CQ     `hl7:"23,len=10,display=Total Daily Dose"`
 	SupplementaryCode                      []CWE   `hl7:"24,len=250,display=Supplementary Code"`
-	RequestedDrugStrengthVolume            NM      `hl7:"25,len=5,display=Requested Drug Strength Volume"`
+	RequestedDrugStrengthVolume            NM      `hl7:"25,len=5,datatype=NM,display=Requested Drug Strength Volume"`
 	RequestedDrugStrengthVolumeUnits       *CWE    `hl7:"26,len=250,display=Requested Drug Strength Volume Units"`
-	PharmacyOrderType                      ID      `hl7:"27,len=1,table=0480,display=Pharmacy Order Type"`
-	DispensingInterval                     NM      `hl7:"28,len=20,display=Dispensing Interval"`
+	PharmacyOrderType                      ID      `hl7:"27,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
+	DispensingInterval                     NM      `hl7:"28,len=20,datatype=NM,display=Dispensing Interval"`
 	MedicationInstanceIdentifier           *EI     `hl7:"29,len=60,display=Medication Instance Identifier"`
 	SegmentInstanceIdentifier              *EI     `hl7:"30,len=60,display=Segment Instance Identifier"`
 	MoodCode                               *CNE    `hl7:"31,conditional,len=2,table=0725,display=Mood Code"`
@@ -3389,14 +3389,14 @@ type SAC struct {
 	TrayIdentifier                    *EI     `hl7:"13,len=427,display=Tray Identifier"`
 	PositionInTray                    *NA     `hl7:"14,len=80,display=Position in Tray"`
 	Location                          []CWE   `hl7:"15,len=705,display=Location"`
-	ContainerHeight                   NM      `hl7:"16,len=20,display=Container Height"`
-	ContainerDiameter                 NM      `hl7:"17,len=20,display=Container Diameter"`
-	BarrierDelta                      NM      `hl7:"18,len=20,display=Barrier Delta"`
-	BottomDelta                       NM      `hl7:"19,len=20,display=Bottom Delta"`
+	ContainerHeight                   NM      `hl7:"16,len=20,datatype=NM,display=Container Height"`
+	ContainerDiameter                 NM      `hl7:"17,len=20,datatype=NM,display=Container Diameter"`
+	BarrierDelta                      NM      `hl7:"18,len=20,datatype=NM,display=Barrier Delta"`
+	BottomDelta                       NM      `hl7:"19,len=20,datatype=NM,display=Bottom Delta"`
 	ContainerHeightDiameterDeltaUnits *CWE    `hl7:"20,len=705,display=Container Height/Diameter/Delta Units"`
-	ContainerVolume                   NM      `hl7:"21,len=20,display=Container Volume"`
-	AvailableSpecimenVolume           NM      `hl7:"22,len=20,display=Available Specimen Volume"`
-	InitialSpecimenVolume             NM      `hl7:"23,len=20,display=Initial Specimen Volume"`
+	ContainerVolume                   NM      `hl7:"21,len=20,datatype=NM,display=Container Volume"`
+	AvailableSpecimenVolume           NM      `hl7:"22,len=20,datatype=NM,display=Available Specimen Volume"`
+	InitialSpecimenVolume             NM      `hl7:"23,len=20,datatype=NM,display=Initial Specimen Volume"`
 	VolumeUnits                       *CWE    `hl7:"24,len=705,display=Volume Units"`
 	SeparatorType                     *CWE    `hl7:"25,len=705,table=0380,display=Separator Type"`
 	CapType                           *CWE    `hl7:"26,len=705,table=0381,display=Cap Type"`
@@ -3405,13 +3405,13 @@ type SAC struct {
 	DilutionFactor                    *SN     `hl7:"29,len=36,display=Dilution Factor"`
 	Treatment                         *CWE    `hl7:"30,len=705,table=0373,display=Treatment"`
 	Temperature                       *SN     `hl7:"31,len=36,display=Temperature"`
-	HemolysisIndex                    NM      `hl7:"32,len=20,display=Hemolysis Index"`
+	HemolysisIndex                    NM      `hl7:"32,len=20,datatype=NM,display=Hemolysis Index"`
 	HemolysisIndexUnits               *CWE    `hl7:"33,len=705,display=Hemolysis Index Units"`
-	LipemiaIndex                      NM      `hl7:"34,len=20,display=Lipemia Index"`
+	LipemiaIndex                      NM      `hl7:"34,len=20,datatype=NM,display=Lipemia Index"`
 	LipemiaIndexUnits                 *CWE    `hl7:"35,len=705,display=Lipemia Index Units"`
-	IcterusIndex                      NM      `hl7:"36,len=20,display=Icterus Index"`
+	IcterusIndex                      NM      `hl7:"36,len=20,datatype=NM,display=Icterus Index"`
 	IcterusIndexUnits                 *CWE    `hl7:"37,len=705,display=Icterus Index Units"`
-	FibrinIndex                       NM      `hl7:"38,len=20,display=Fibrin Index"`
+	FibrinIndex                       NM      `hl7:"38,len=20,datatype=NM,display=Fibrin Index"`
 	FibrinIndexUnits                  *CWE    `hl7:"39,len=705,display=Fibrin Index Units"`
 	SystemInducedContaminants         []CWE   `hl7:"40,len=705,table=0374,display=System Induced Contaminants"`
 	DrugInterference                  []CWE   `hl7:"41,len=705,table=0382,display=Drug Interference"`
@@ -3426,10 +3426,10 @@ type SAC struct {
 type SCD struct {
 	HL7                    HL7Name `hl7:",name=SCD,type=s"`
 	CycleStartTime         TM      `hl7:"1,len=16,format=HM,display=Cycle Start Time"`
-	CycleCount             NM      `hl7:"2,len=16,display=Cycle Count"`
+	CycleCount             NM      `hl7:"2,len=16,datatype=NM,display=Cycle Count"`
 	TempMax                *CQ     `hl7:"3,len=36,display=Temp Max"`
 	TempMin                *CQ     `hl7:"4,len=36,display=Temp Min"`
-	LoadNumber             NM      `hl7:"5,len=16,display=Load Number"`
+	LoadNumber             NM      `hl7:"5,len=16,datatype=NM,display=Load Number"`
 	ConditionTime          *CQ     `hl7:"6,len=16,display=Condition Time"`
 	SterilizeTime          *CQ     `hl7:"7,len=16,display=Sterilize Time"`
 	ExhaustTime            *CQ     `hl7:"8,len=16,display=Exhaust Time"`
@@ -3471,13 +3471,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       *EI     `hl7:"1,conditional,len=75,display=Placer Appointment ID"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,len=75,display=Filler Appointment ID"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,len=5,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,len=22,display=Placer Group Number"`
 	ScheduleID                *CWE    `hl7:"5,len=250,display=Schedule ID"`
 	EventReason               CWE     `hl7:"6,required,len=250,display=Event Reason"`
 	AppointmentReason         *CWE    `hl7:"7,len=250,table=0276,display=Appointment Reason"`
 	AppointmentType           *CWE    `hl7:"8,len=250,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,len=20,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,len=20,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  CNE     `hl7:"10,len=250,display=Appointment Duration Units"`
 	AppointmentTimingQuantity []TQ    `hl7:"11,len=200,display=Appointment Timing Quantity"`
 	PlacerContactPerson       []XCN   `hl7:"12,len=250,display=Placer Contact Person"`
@@ -3504,7 +3504,7 @@ type SCH struct {
 // processing sterilization or decontamination loads.
 type SCP struct {
 	HL7                                         HL7Name `hl7:",name=SCP,type=s"`
-	NumberOfDecontaminationSterilizationDevices NM      `hl7:"1,len=2,display=Number Of Decontamination/Sterilization Devices"`
+	NumberOfDecontaminationSterilizationDevices NM      `hl7:"1,len=2,datatype=NM,display=Number Of Decontamination/Sterilization Devices"`
 	LaborCalculationType                        *CWE    `hl7:"2,len=250,table=0651,display=Labor Calculation Type"`
 	DateFormat                                  *CWE    `hl7:"3,len=250,table=0653,display=Date Format"`
 	DeviceNumber                                *EI     `hl7:"4,len=8,display=Device Number"`
@@ -3523,9 +3523,9 @@ type SDD struct {
 	LotNumber       *EI     `hl7:"1,len=11,display=Lot Number"`
 	DeviceNumber    *EI     `hl7:"2,len=8,display=Device Number"`
 	DeviceName      ST      `hl7:"3,len=999,display=Device Name"`
-	DeviceDataState IS      `hl7:"4,len=1,table=0667,display=Device Data State"`
-	LoadStatus      IS      `hl7:"5,len=3,table=0669,display=Load Status"`
-	ControlCode     NM      `hl7:"6,len=3,display=Control Code"`
+	DeviceDataState IS      `hl7:"4,len=1,table=0667,datatype=IS,display=Device Data State"`
+	LoadStatus      IS      `hl7:"5,len=3,table=0669,datatype=IS,display=Load Status"`
+	ControlCode     NM      `hl7:"6,len=3,datatype=NM,display=Control Code"`
 	OperatorName    ST      `hl7:"7,len=15,display=Operator Name"`
 }
 
@@ -3584,7 +3584,7 @@ type SLT struct {
 // order(s), results, specimen(s) and specimen container(s).
 type SPM struct {
 	HL7                        HL7Name `hl7:",name=SPM,type=s"`
-	SetID                      SI      `hl7:"1,seq,len=4,display=Set ID - SPM"`
+	SetID                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - SPM"`
 	SpecimenID                 *EIP    `hl7:"2,len=855,display=Specimen ID"`
 	SpecimenParentIDs          []EIP   `hl7:"3,len=855,display=Specimen Parent IDs"`
 	SpecimenType               CWE     `hl7:"4,required,len=705,table=0487,display=Specimen Type"`
@@ -3596,20 +3596,20 @@ type SPM struct {
 	SpecimenCollectionSite     *CWE    `hl7:"10,len=705,table=0543,display=Specimen Collection Site"`
 	SpecimenRole               []CWE   `hl7:"11,len=705,table=0369,display=Specimen Role"`
 	SpecimenCollectionAmount   *CQ     `hl7:"12,len=20,display=Specimen Collection Amount"`
-	GroupedSpecimenCount       NM      `hl7:"13,conditional,len=6,display=Grouped Specimen Count"`
+	GroupedSpecimenCount       NM      `hl7:"13,conditional,len=6,datatype=NM,display=Grouped Specimen Count"`
 	SpecimenDescription        []ST    `hl7:"14,len=250,display=Specimen Description"`
 	SpecimenHandlingCode       []CWE   `hl7:"15,len=705,table=0376,display=Specimen Handling Code"`
 	SpecimenRiskCode           []CWE   `hl7:"16,len=705,table=0489,display=Specimen Risk Code"`
 	SpecimenCollectionDateTime *DR     `hl7:"17,len=49,display=Specimen Collection Date/Time"`
 	SpecimenReceivedDateTime   DTM     `hl7:"18,len=24,format=YMDHM,display=Specimen Received Date/Time"`
 	SpecimenExpirationDateTime DTM     `hl7:"19,len=24,format=YMDHM,display=Specimen Expiration Date/Time"`
-	SpecimenAvailability       ID      `hl7:"20,len=1,table=0136,display=Specimen Availability"`
+	SpecimenAvailability       ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Specimen Availability"`
 	SpecimenRejectReason       []CWE   `hl7:"21,len=705,table=0490,display=Specimen Reject Reason"`
 	SpecimenQuality            *CWE    `hl7:"22,len=705,table=0491,display=Specimen Quality"`
 	SpecimenAppropriateness    *CWE    `hl7:"23,len=705,table=0492,display=Specimen Appropriateness"`
 	SpecimenCondition          []CWE   `hl7:"24,len=705,table=0493,display=Specimen Condition"`
 	SpecimenCurrentQuantity    *CQ     `hl7:"25,len=722,display=Specimen Current Quantity"`
-	NumberOfSpecimenContainers NM      `hl7:"26,len=4,display=Number of Specimen Containers"`
+	NumberOfSpecimenContainers NM      `hl7:"26,len=4,datatype=NM,display=Number of Specimen Containers"`
 	ContainerType              *CWE    `hl7:"27,len=705,display=Container Type"`
 	ContainerCondition         *CWE    `hl7:"28,len=705,table=0544,display=Container Condition"`
 	SpecimenChildRole          *CWE    `hl7:"29,len=705,table=0494,display=Specimen Child Role"`
@@ -3622,7 +3622,7 @@ type SPM struct {
 type SPR struct {
 	HL7                     HL7Name `hl7:",name=SPR,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	StoredProcedureName     CE      `hl7:"3,required,len=250,display=Stored Procedure Name"`
 	InputParameterList      []QIP   `hl7:"4,len=256,display=Input Parameter List"`
 }
@@ -3645,10 +3645,10 @@ type STF struct {
 	PrimaryKeyValue                 *CWE    `hl7:"1,conditional,len=250,display=Primary Key Value - STF"`
 	StaffIdentifierList             []CX    `hl7:"2,len=250,display=Staff Identifier List"`
 	StaffName                       []XPN   `hl7:"3,len=250,display=Staff Name"`
-	StaffType                       []IS    `hl7:"4,len=2,table=0182,display=Staff Type"`
-	AdministrativeSex               IS      `hl7:"5,len=1,table=0001,display=Administrative Sex"`
+	StaffType                       []IS    `hl7:"4,len=2,table=0182,datatype=IS,display=Staff Type"`
+	AdministrativeSex               IS      `hl7:"5,len=1,table=0001,datatype=IS,display=Administrative Sex"`
 	DateTimeOfBirth                 DTM     `hl7:"6,len=24,format=YMDHM,display=Date/Time of Birth"`
-	ActiveInactiveFlag              ID      `hl7:"7,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag              ID      `hl7:"7,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Department                      []CWE   `hl7:"8,len=250,table=0184,display=Department"`
 	HospitalService                 []CWE   `hl7:"9,len=250,table=0069,display=Hospital Service - STF"`
 	Phone                           []XTN   `hl7:"10,len=250,display=Phone"`
@@ -3662,23 +3662,23 @@ type STF struct {
 	JobTitle                        ST      `hl7:"18,len=20,display=Job Title"`
 	JobCodeClass                    *JCC    `hl7:"19,len=20,display=Job Code/Class"`
 	EmploymentStatusCode            *CWE    `hl7:"20,len=250,table=0066,display=Employment Status Code"`
-	AdditionalInsuredOnAuto         ID      `hl7:"21,len=1,table=0136,display=Additional Insured on Auto"`
+	AdditionalInsuredOnAuto         ID      `hl7:"21,len=1,table=0136,datatype=ID,display=Additional Insured on Auto"`
 	DriversLicenseNumberStaff       *DLN    `hl7:"22,len=25,display=Driver's License Number - Staff"`
-	CopyAutoIns                     ID      `hl7:"23,len=1,table=0136,display=Copy Auto Ins"`
+	CopyAutoIns                     ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Copy Auto Ins"`
 	AutoInsExpires                  DT      `hl7:"24,len=8,format=YMD,display=Auto Ins. Expires"`
 	DateLastDMVReview               DT      `hl7:"25,len=8,format=YMD,display=Date Last DMV Review"`
 	DateNextDMVReview               DT      `hl7:"26,len=8,format=YMD,display=Date Next DMV Review"`
 	Race                            *CWE    `hl7:"27,len=705,table=0005,display=Race"`
 	EthnicGroup                     *CWE    `hl7:"28,len=705,table=0189,display=Ethnic Group"`
-	ReActivationApprovalIndicator   ID      `hl7:"29,len=1,table=0136,display=Re-activation Approval Indicator"`
+	ReActivationApprovalIndicator   ID      `hl7:"29,len=1,table=0136,datatype=ID,display=Re-activation Approval Indicator"`
 	Citizenship                     []CWE   `hl7:"30,len=705,table=0171,display=Citizenship"`
 	DateTimeOfDeath                 DTM     `hl7:"31,len=8,format=YMDHM,display=Date/Time of Death"`
-	DeathIndicator                  ID      `hl7:"32,len=1,table=0136,display=Death Indicator"`
+	DeathIndicator                  ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Death Indicator"`
 	InstitutionRelationshipTypeCode *CWE    `hl7:"33,len=250,table=0538,display=Institution Relationship Type Code"`
 	InstitutionRelationshipPeriod   *DR     `hl7:"34,len=52,display=Institution Relationship Period"`
 	ExpectedReturnDate              DT      `hl7:"35,len=8,format=YMD,display=Expected Return Date"`
 	CostCenterCode                  []CWE   `hl7:"36,len=250,table=0539,display=Cost Center Code"`
-	GenericClassificationIndicator  ID      `hl7:"37,len=1,table=0136,display=Generic Classification Indicator"`
+	GenericClassificationIndicator  ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Generic Classification Indicator"`
 	InactiveReasonCode              *CWE    `hl7:"38,len=250,table=0540,display=Inactive Reason Code"`
 	GenericResourceTypeOrCategory   []CWE   `hl7:"39,len=1,table=0771,display=Generic resource type or category"`
 }
@@ -3707,10 +3707,10 @@ type TCC struct {
 	RerunDilutionFactorDefault            *SN     `hl7:"5,len=36,display=Rerun Dilution Factor Default"`
 	PreDilutionFactorDefault              *SN     `hl7:"6,len=36,display=Pre-Dilution Factor Default"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"7,len=36,display=Endogenous Content of Pre-Dilution Diluent"`
-	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,display=Inventory Limits Warning Level"`
-	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,display=Automatic Rerun Allowed"`
-	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,display=Automatic Repeat Allowed"`
-	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,display=Automatic Reflex Allowed"`
+	InventoryLimitsWarningLevel           NM      `hl7:"8,len=10,datatype=NM,display=Inventory Limits Warning Level"`
+	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Automatic Rerun Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Automatic Reflex Allowed"`
 	EquipmentDynamicRange                 *SN     `hl7:"12,len=36,display=Equipment Dynamic Range"`
 	Units                                 *CWE    `hl7:"13,len=705,display=Units"`
 	ProcessingType                        *CWE    `hl7:"14,len=705,table=0388,display=Processing Type"`
@@ -3728,8 +3728,8 @@ type TCD struct {
 	RerunDilutionFactor                   *SN     `hl7:"3,len=36,display=Rerun Dilution Factor"`
 	PreDilutionFactor                     *SN     `hl7:"4,len=36,display=Pre-Dilution Factor"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"5,len=36,display=Endogenous Content of Pre-Dilution Diluent"`
-	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,display=Automatic Repeat Allowed"`
-	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,display=Reflex Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Reflex Allowed"`
 	AnalyteRepeatStatus                   *CWE    `hl7:"8,len=705,table=0389,display=Analyte Repeat Status"`
 }
 
@@ -3741,7 +3741,7 @@ type TCD struct {
 // over time.
 type TQ1 struct {
 	HL7                  HL7Name `hl7:",name=TQ1,type=s"`
-	SetID                SI      `hl7:"1,seq,len=4,display=Set ID - TQ1"`
+	SetID                SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - TQ1"`
 	Quantity             *CQ     `hl7:"2,len=20,display=Quantity"`
 	RepeatPattern        []RPT   `hl7:"3,len=540,display=Repeat Pattern"`
 	ExplicitTime         []TM    `hl7:"4,len=20,format=HM,display=Explicit Time"`
@@ -3752,9 +3752,9 @@ type TQ1 struct {
 	Priority             []CWE   `hl7:"9,len=250,table=0485,display=Priority"`
 	ConditionText        TX      `hl7:"10,len=250,display=Condition text"`
 	TextInstruction      TX      `hl7:"11,len=250,display=Text instruction"`
-	Conjunction          ID      `hl7:"12,conditional,len=10,table=0472,display=Conjunction"`
+	Conjunction          ID      `hl7:"12,conditional,len=10,table=0472,datatype=ID,display=Conjunction"`
 	OccurrenceDuration   *CQ     `hl7:"13,len=20,display=Occurrence duration"`
-	TotalOccurrences     NM      `hl7:"14,len=10,display=Total occurrences"`
+	TotalOccurrences     NM      `hl7:"14,len=10,datatype=NM,display=Total occurrences"`
 }
 
 // Timing/Quantity Relationship
@@ -3763,16 +3763,16 @@ type TQ1 struct {
 // other service requests. The TQ2 segment will link the current service request with one or more other service requests.
 type TQ2 struct {
 	HL7                               HL7Name `hl7:",name=TQ2,type=s"`
-	SetID                             SI      `hl7:"1,seq,len=4,display=Set ID - TQ2"`
-	SequenceResultsFlag               ID      `hl7:"2,len=1,table=0503,display=Sequence/Results Flag"`
+	SetID                             SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - TQ2"`
+	SequenceResultsFlag               ID      `hl7:"2,len=1,table=0503,datatype=ID,display=Sequence/Results Flag"`
 	RelatedPlacerNumber               []EI    `hl7:"3,conditional,len=22,display=Related Placer Number"`
 	RelatedFillerNumber               []EI    `hl7:"4,conditional,len=22,display=Related Filler Number"`
 	RelatedPlacerGroupNumber          []EI    `hl7:"5,conditional,len=22,display=Related Placer Group Number"`
-	SequenceConditionCode             ID      `hl7:"6,conditional,len=2,table=0504,display=Sequence Condition Code"`
-	CyclicEntryExitIndicator          ID      `hl7:"7,conditional,len=1,table=0505,display=Cyclic Entry/Exit Indicator"`
+	SequenceConditionCode             ID      `hl7:"6,conditional,len=2,table=0504,datatype=ID,display=Sequence Condition Code"`
+	CyclicEntryExitIndicator          ID      `hl7:"7,conditional,len=1,table=0505,datatype=ID,display=Cyclic Entry/Exit Indicator"`
 	SequenceConditionTimeInterval     *CQ     `hl7:"8,len=20,display=Sequence Condition Time Interval"`
-	CyclicGroupMaximumNumberOfRepeats NM      `hl7:"9,len=10,display=Cyclic Group Maximum Number of Repeats"`
-	SpecialServiceRequestRelationship ID      `hl7:"10,conditional,len=1,table=0506,display=Special Service Request Relationship"`
+	CyclicGroupMaximumNumberOfRepeats NM      `hl7:"9,len=10,datatype=NM,display=Cyclic Group Maximum Number of Repeats"`
+	SpecialServiceRequestRelationship ID      `hl7:"10,conditional,len=1,table=0506,datatype=ID,display=Special Service Request Relationship"`
 }
 
 // Transcription Document Header
@@ -3784,9 +3784,9 @@ type TQ2 struct {
 // document text.
 type TXA struct {
 	HL7                                       HL7Name `hl7:",name=TXA,type=s"`
-	SetID                                     SI      `hl7:"1,seq,required,len=4,display=Set ID- TXA"`
-	DocumentType                              IS      `hl7:"2,required,len=30,table=0270,display=Document Type"`
-	DocumentContentPresentation               ID      `hl7:"3,conditional,len=2,table=0191,display=Document Content Presentation"`
+	SetID                                     SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set ID- TXA"`
+	DocumentType                              IS      `hl7:"2,required,len=30,table=0270,datatype=IS,display=Document Type"`
+	DocumentContentPresentation               ID      `hl7:"3,conditional,len=2,table=0191,datatype=ID,display=Document Content Presentation"`
 	ActivityDateTime                          DTM     `hl7:"4,len=24,format=YMDHM,display=Activity Date/Time"`
 	PrimaryActivityProviderCodeName           []XCN   `hl7:"5,conditional,len=250,display=Primary Activity Provider Code/Name"`
 	OriginationDateTime                       DTM     `hl7:"6,len=24,format=YMDHM,display=Origination Date/Time"`
@@ -3800,10 +3800,10 @@ type TXA struct {
 	PlacerOrderNumber                         []EI    `hl7:"14,len=427,display=Placer Order Number"`
 	FillerOrderNumber                         *EI     `hl7:"15,len=427,display=Filler Order Number"`
 	UniqueDocumentFileName                    ST      `hl7:"16,len=30,display=Unique Document File Name"`
-	DocumentCompletionStatus                  ID      `hl7:"17,required,len=2,table=0271,display=Document Completion Status"`
-	DocumentConfidentialityStatus             ID      `hl7:"18,len=2,table=0272,display=Document Confidentiality Status"`
-	DocumentAvailabilityStatus                ID      `hl7:"19,len=2,table=0273,display=Document Availability Status"`
-	DocumentStorageStatus                     ID      `hl7:"20,len=2,table=0275,display=Document Storage Status"`
+	DocumentCompletionStatus                  ID      `hl7:"17,required,len=2,table=0271,datatype=ID,display=Document Completion Status"`
+	DocumentConfidentialityStatus             ID      `hl7:"18,len=2,table=0272,datatype=ID,display=Document Confidentiality Status"`
+	DocumentAvailabilityStatus                ID      `hl7:"19,len=2,table=0273,datatype=ID,display=Document Availability Status"`
+	DocumentStorageStatus                     ID      `hl7:"20,len=2,table=0275,datatype=ID,display=Document Storage Status"`
 	DocumentChangeReason                      ST      `hl7:"21,conditional,len=30,display=Document Change Reason"`
 	AuthenticationPersonTimeStampSet          []PPN   `hl7:"22,conditional,len=250,display=Authentication Person- Time Stamp (set)"`
 	DistributedCopiesCodeAndNameOfRecipientsS []XCN   `hl7:"23,len=250,display=Distributed Copies (Code and Name of Recipients(s) )"`
@@ -3840,17 +3840,17 @@ type UAC struct {
 // applicability.
 type UB1 struct {
 	HL7                     HL7Name `hl7:",name=UB1,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - UB1"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB1"`
 	BloodDeductible         ST      `hl7:"2,display=Blood Deductible"`
-	BloodFurnishedPints     NM      `hl7:"3,len=2,display=Blood Furnished-Pints"`
-	BloodReplacedPints      NM      `hl7:"4,len=2,display=Blood Replaced-Pints"`
-	BloodNotReplacedPints   NM      `hl7:"5,len=2,display=Blood Not Replaced-Pints"`
-	CoInsuranceDays         NM      `hl7:"6,len=2,display=Co-Insurance Days"`
-	ConditionCode           []IS    `hl7:"7,max=5,len=14,table=0043,display=Condition Code"`
-	CoveredDays             NM      `hl7:"8,len=3,display=Covered Days"`
-	NonCoveredDays          NM      `hl7:"9,len=3,display=Non Covered Days"`
+	BloodFurnishedPints     NM      `hl7:"3,len=2,datatype=NM,display=Blood Furnished-Pints"`
+	BloodReplacedPints      NM      `hl7:"4,len=2,datatype=NM,display=Blood Replaced-Pints"`
+	BloodNotReplacedPints   NM      `hl7:"5,len=2,datatype=NM,display=Blood Not Replaced-Pints"`
+	CoInsuranceDays         NM      `hl7:"6,len=2,datatype=NM,display=Co-Insurance Days"`
+	ConditionCode           []IS    `hl7:"7,max=5,len=14,table=0043,datatype=IS,display=Condition Code"`
+	CoveredDays             NM      `hl7:"8,len=3,datatype=NM,display=Covered Days"`
+	NonCoveredDays          NM      `hl7:"9,len=3,datatype=NM,display=Non Covered Days"`
 	ValueAmountCode         []UVC   `hl7:"10,max=8,len=41,display=Value Amount & Code"`
-	NumberOfGraceDays       NM      `hl7:"11,len=2,display=Number Of Grace Days"`
+	NumberOfGraceDays       NM      `hl7:"11,len=2,datatype=NM,display=Number Of Grace Days"`
 	SpecialProgramIndicator *CWE    `hl7:"12,len=250,table=0348,display=Special Program Indicator"`
 	PSROURApprovalIndicator *CWE    `hl7:"13,len=250,table=0349,display=PSRO/UR Approval Indicator"`
 	PSROURApprovedStayFm    DT      `hl7:"14,len=8,format=YMD,display=PSRO/UR Approved Stay-Fm"`
@@ -3874,9 +3874,9 @@ type UB1 struct {
 // list; refer to a UB specification for additional information.
 type UB2 struct {
 	HL7                     HL7Name `hl7:",name=UB2,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set ID - UB2"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set ID - UB2"`
 	CoInsuranceDays         ST      `hl7:"2,len=3,display=Co-Insurance Days"`
-	ConditionCode           []IS    `hl7:"3,max=7,len=2,table=0043,display=Condition Code"`
+	ConditionCode           []IS    `hl7:"3,max=7,len=2,table=0043,datatype=IS,display=Condition Code"`
 	CoveredDays             ST      `hl7:"4,len=3,display=Covered Days"`
 	NonCoveredDays          ST      `hl7:"5,len=4,display=Non-Covered Days"`
 	ValueAmountAndCode      []UVC   `hl7:"6,max=12,len=41,display=Value Amount and Code"`
@@ -3890,7 +3890,7 @@ type UB2 struct {
 	UB92Locator56State      []ST    `hl7:"14,max=5,len=14,display=UB92 Locator 56 (State)"`
 	UB92Locator57National   ST      `hl7:"15,len=27,display=UB92 Locator 57 (National)"`
 	UB92Locator78State      []ST    `hl7:"16,max=2,len=2,display=UB92 Locator 78 (State)"`
-	SpecialVisitCount       NM      `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount       NM      `hl7:"17,len=3,datatype=NM,display=Special Visit Count"`
 }
 
 // Results/update Definition
@@ -3902,12 +3902,12 @@ type UB2 struct {
 type URD struct {
 	HL7                     HL7Name `hl7:",name=URD,type=s"`
 	RUDateTime              DTM     `hl7:"1,len=24,format=YMDHM,display=R/U Date/Time"`
-	ReportPriority          ID      `hl7:"2,len=1,table=0109,display=Report Priority"`
+	ReportPriority          ID      `hl7:"2,len=1,table=0109,datatype=ID,display=Report Priority"`
 	RUWhoSubjectDefinition  []XCN   `hl7:"3,required,len=250,display=R/U Who Subject Definition"`
 	RUWhatSubjectDefinition []CWE   `hl7:"4,len=250,table=0048,display=R/U What Subject Definition"`
 	RUWhatDepartmentCode    []CWE   `hl7:"5,len=250,display=R/U What Department Code"`
 	RUDisplayPrintLocations []ST    `hl7:"6,len=20,display=R/U Display/Print Locations"`
-	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,display=R/U Results Level"`
+	RUResultsLevel          ID      `hl7:"7,len=1,table=0108,datatype=ID,display=R/U Results Level"`
 }
 
 // Unsolicited Selection
@@ -3923,9 +3923,9 @@ type URS struct {
 	RUWhenDataEndDateTime           DTM     `hl7:"3,len=24,format=YMDHM,display=R/U When Data End Date/Time"`
 	RUWhatUserQualifier             []ST    `hl7:"4,len=20,display=R/U What User Qualifier"`
 	RUOtherResultsSubjectDefinition []ST    `hl7:"5,len=20,display=R/U Other Results Subject Definition"`
-	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,display=R/U Which Date/Time Qualifier"`
-	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,display=R/U Which Date/Time Status Qualifier"`
-	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,display=R/U Date/Time Selection Qualifier"`
+	RUWhichDateTimeQualifier        []ID    `hl7:"6,len=12,table=0156,datatype=ID,display=R/U Which Date/Time Qualifier"`
+	RUWhichDateTimeStatusQualifier  []ID    `hl7:"7,len=12,table=0157,datatype=ID,display=R/U Which Date/Time Status Qualifier"`
+	RUDateTimeSelectionQualifier    []ID    `hl7:"8,len=12,table=0158,datatype=ID,display=R/U Date/Time Selection Qualifier"`
 	RUQuantityTimingQualifier       TQ      `hl7:"9,len=60,display=R/U Quantity/Timing Qualifier"`
 }
 
@@ -3948,7 +3948,7 @@ type VAR struct {
 // This segment contains purchasing vendors that supply the inventory supply item specified in the ITM segment.
 type VND struct {
 	HL7                    HL7Name `hl7:",name=VND,type=s"`
-	SetID                  SI      `hl7:"1,seq,required,len=2,display=Set Id – VND"`
+	SetID                  SI      `hl7:"1,seq,required,len=2,datatype=SI,display=Set Id – VND"`
 	VendorIdentifier       EI      `hl7:"2,required,len=250,display=Vendor Identifier"`
 	VendorName             ST      `hl7:"3,len=999,display=Vendor Name"`
 	VendorCatalogNumber    *EI     `hl7:"4,len=20,display=Vendor Catalog Number"`
@@ -3967,7 +3967,7 @@ type VND struct {
 type VTQ struct {
 	HL7                     HL7Name `hl7:",name=VTQ,type=s"`
 	QueryTag                ST      `hl7:"1,len=32,display=Query Tag"`
-	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,display=Query/Response Format Code"`
+	QueryResponseFormatCode ID      `hl7:"2,required,len=1,table=0106,datatype=ID,display=Query/Response Format Code"`
 	VTQueryName             CE      `hl7:"3,required,len=250,display=VT Query Name"`
 	VirtualTableName        CE      `hl7:"4,required,len=250,display=Virtual Table Name"`
 	SelectionCriteria       []QSC   `hl7:"5,len=256,display=Selection Criteria"`
@@ -20,8 +20,8 @@ type AD struct {
 	City                       ST      `hl7:"3,display=This component specifies the city- district or place where the addressee is located depending upon the national convention for formatting addresses for postal usage."`
 	StateOrProvince            ST      `hl7:"4,display=This component specifies the state or province where the addressee is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,display=This component specifies the zip or postal code where the addressee is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9 and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"6,len=3,table=0399,display=This component specifies the country where the addressee is located. HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country Code for valid values."`
-	AddressType                ID      `hl7:"7,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
+	Country                    ID      `hl7:"6,len=3,table=0399,datatype=ID,display=This component specifies the country where the addressee is located. HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 - Country Code for valid values."`
+	AddressType                ID      `hl7:"7,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
 	OtherGeographicDesignation ST      `hl7:"8,display=This component specifies any other geographic designation that may be necessary. It includes county- bioregion- SMSA- etc."`
 }
 
@@ -44,7 +44,7 @@ type AUI struct {
 // Note: Replaces the CM data type used in section 4.5.2.1 BLG-1, as of v2.5.
 type CCD struct {
 	HL7             HL7Name `hl7:",name=CCD,len=0,type=d"`
-	InvocationEvent ID      `hl7:"1,required,len=1,table=0100,display=Specifies the code for the event precipitating/triggering the charge activity. Refer to HL7 Table 0100 - Invocation event for valid values."`
+	InvocationEvent ID      `hl7:"1,required,len=1,table=0100,datatype=ID,display=Specifies the code for the event precipitating/triggering the charge activity. Refer to HL7 Table 0100 - Invocation event for valid values."`
 	DateTime        DTM     `hl7:"2,format=YMDHM,display=The second component is used to express the exact time to charge for the ordered service; it is used only when the CCD.1 value is T. When used- it is expressed as a DTM data type."`
 }
 
@@ -75,16 +75,16 @@ type CNE struct {
 	HL7                                  HL7Name `hl7:",name=CNE,len=0,type=d"`
 	Identifier                           ST      `hl7:"1,required,display=The first component contains the sequence of characters (the code) that uniquely identifies the item being referenced by the CNE.2. Different coding schemes will have different elements here.  Usage Note: The identifier is required and must be a valid code."`
 	Text                                 ST      `hl7:"2,display=The second component contains the descriptive or textual name of the identifier- e.g.- myocardial infarction or X-ray impression. This is the corresponding text assigned by the coding system to the identifier.  Usage Note: Text description of code is optional but its use should be encouraged since it makes messages easier to review for accuracy- especially during interface testing and debugging."`
-	NameOfCodingSystem                   ID      `hl7:"3,len=12,table=0396,display=The third component contains the code for the name of the coding system from which the value in CNE.1 is obtained. Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table' for valid values. Each coding system is assigned a unique identifier.   As of v2.7 this component is required when CNE.1 is populated and CNE.14 is not populated. Both CNE.3 and CNE.14 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.3 and/or CNE.14 the Coding System component or the Coding System OID for the tuple.The combination of the identifier and name of coding system represent a unique concept for a data item.  Some organizations that publish code sets author more than one. The coding system- then- to be unique- is a concatenation of the name of the coding authority organization and the name of its code set or table. When an HL7 table is used for a CNE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. Similarly- ISO tables will be named ISOnnnn- where nnnn is the ISO table number.  Usage Note: The following statement is retained for backward compatibility as of v2.7. Best practice would recommend that this component always be populated. However- if the field narrative explicitly states 'Refer to HL7 Table nnnn for valid values”- and- if the sending and receiving systems are HL7 compliant- the coding system would be known from the standard.  This would be similar to a field with an ID data type- except that there is a second triplet in which to express an alternate code."`
+	NameOfCodingSystem                   ID      `hl7:"3,len=12,table=0396,datatype=ID,display=The third component contains the code for the name of the coding system from which the value in CNE.1 is obtained. Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table' for valid values. Each coding system is assigned a unique identifier.   As of v2.7 this component is required when CNE.1 is populated and CNE.14 is not populated. Both CNE.3 and CNE.14 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.3 and/or CNE.14 the Coding System component or the Coding System OID for the tuple.The combination of the identifier and name of coding system represent a unique concept for a data item.  Some organizations that publish code sets author more than one. The coding system- then- to be unique- is a concatenation of the name of the coding authority organization and the name of its code set or table. When an HL7 table is used for a CNE data type- the name of coding system component is defined as HL7nnnn where nnnn is the HL7 table number. Similarly- ISO tables will be named ISOnnnn- where nnnn is the ISO table number.  Usage Note: The following statement is retained for backward compatibility as of v2.7. Best practice would recommend that this component always be populated. However- if the field narrative explicitly states 'Refer to HL7 Table nnnn for valid values”- and- if the sending and receiving systems are HL7 compliant- the coding system would be known from the standard.  This would be similar to a field with an ID data type- except that there is a second triplet in which to express an alternate code."`
 	AlternateIdentifier                  ST      `hl7:"4,display=A sequence of characters that uniquely identifies an alternate code. Analogous to CNE.1 Identifier.   Usage Notes: The Alternate Identifier is used to represent the local or user seen code as described. If present- it obeys the same rules of use and interpretation as described for component 1. If both are present- the identifiers in component 4 and component 1 should have exactly the same meaning- i.e.- they should be exact synonyms."`
 	AlternateText                        ST      `hl7:"5,display=The descriptive or textual name of the alternate identifier. Analogous to CNE.2 Text.   Usage Notes: If present- CNE.5 obeys the same rules of use and interpretation as described for CNE.2. "`
-	NameOfAlternateCodingSystem          ID      `hl7:"6,len=12,table=0396,display=Identifies the coding scheme being used in the alternate identifier component.  Analogous to  CNE.3 Name of Coding System.  Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table'- for valid values.  As of v2.7 this component is required when CNE.4 is populated and CNE.17 is not populated. Both CNE.6 and CNE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.6 and/or CNE.17- the 'Coding System' component or the 'Coding System OID'- for the tuple.  Usage Notes: If present- CNE.6 obeys the same rules of use and interpretation as described for CNE.3."`
+	NameOfAlternateCodingSystem          ID      `hl7:"6,len=12,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component.  Analogous to  CNE.3 Name of Coding System.  Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table'- for valid values.  As of v2.7 this component is required when CNE.4 is populated and CNE.17 is not populated. Both CNE.6 and CNE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.6 and/or CNE.17- the 'Coding System' component or the 'Coding System OID'- for the tuple.  Usage Notes: If present- CNE.6 obeys the same rules of use and interpretation as described for CNE.3."`
 	CodingSystemVersionID                ST      `hl7:"7,conditional,display=the version ID for the coding system identified by CNE.3. It belongs conceptually to components 1-3 and appears here only for reasons of backward compatibility.  Usage Note: If the coding system is any system other than an 'HL7 coding system-' version ID must be valued with an actual version ID. If the coding system is 'HL7 coding system-' version ID may have an actual value or it may be absent. If version ID is absent- it will be interpreted to have the same value as the HL7 version number in the message header. Text description of code is optional but its use should be encouraged since it makes messages easier to review for accuracy- especially during interface testing and debugging."`
 	AlternateCodingSystemVersionID       ST      `hl7:"8,display=the version ID for the coding system identified by CNE.6.  It belongs conceptually to the group of Alternate components (see note 2.A.1) and appears here only for reasons of backward compatibility. Analogous to CNE.7 Coding System Version ID.  Usage Notes: If present- CNE.8 obeys the same rules of use and interpretation as described for CNE.7."`
 	OriginalText                         ST      `hl7:"9,display=The text as seen and/or selected by the user who entered the data. Original text can be used in a structured user interface to capture what the user saw as a representation of the code on the data input screen- or in a situation where the user dictates or directly enters text- it is the text entered or uttered by the user. In a situation where the code is assigned sometime after the text was entered- original text is the text or phrase used as the basis for assigning the code."`
 	SecondAlternateIdentifier            ST      `hl7:"10,display=A sequence of characters that uniquely identifies a second alternate code. Analogous to CN-1 Identifier."`
 	SecondAlternateText                  ST      `hl7:"11,display=The descriptive or textual name of the Second Alternate Identifier. Analogous to CNE.2 Text."`
-	NameOfSecondAlternateCodingSystem    ID      `hl7:"12,len=12,table=0396,display=Identifies the coding scheme being used in the Second Alternate Identifier component. Analogous to CNE.3 Name of Coding System.  Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table'- for valid values.  As of v2.7 this component is required when CNE.10 is populated and CNE.20 is not populated. Both CNE.12 and CNE.20 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.12 and/or CNE.20- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
+	NameOfSecondAlternateCodingSystem    ID      `hl7:"12,len=12,table=0396,datatype=ID,display=Identifies the coding scheme being used in the Second Alternate Identifier component. Analogous to CNE.3 Name of Coding System.  Refer to HL7 Table 0396 - Coding Systems in Chapter 2C- section 'Coding System Table'- for valid values.  As of v2.7 this component is required when CNE.10 is populated and CNE.20 is not populated. Both CNE.12 and CNE.20 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CNE.12 and/or CNE.20- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
 	SecondAlternateCodingSystemVersionID ST      `hl7:"13,conditional,display=This component carries the version for the coding system identified by components 10-12. Analogous to CNE.7 Coding System Version ID."`
 	CodingSystemOid                      ST      `hl7:"14,conditional,display=This component contains the ISO Object Identifier (OID) for the coding system or value set named in CNE.3. The value for this component is 2.16.840.1.113883.12.#### where  '####' is to be replaced by the HL7 table number in the case of an HL7 defined or user defined table. For externally defined code systems the OID registered in the HL7 OID registry SHALL be used.  This component is required when CNE.1 is populated and CNE.3 is not populated. Both CNE.3 and CNE.14 may be populated."`
 	ValueSetOid                          ST      `hl7:"15,display=This component contains the ISO Object Identifier (OID) to allow identification of the value set from which the value in CNE.1 is obtained. The value for this component is 2.16.840.1.113883.12.#### where  '####' is to be replaced by the HL7 table number in the case of an HL7 defined or user defined table. For externally defined value sets- the OID registered in the HL7 OID registry SHALL be used.   A value set may or need not be present irrespective of other fields. Note that if a code is provided- the meaning of the code must come from the definition of the code in the code system. The meaning of the code SHALL NOT depend on the value set.  Applications SHALL NOT be required to interpret the code in light of the valueSet- and they SHALL NOT reject an instance because of the presence or absence of any or a particular value set/ value set version ID."`
@@ -115,11 +115,11 @@ type CNN struct {
 	SecondAndFurtherGivenNamesOrInitialsThereof ST      `hl7:"4,display=Second And Further Given Names Or Initials Thereof"`
 	Suffix                                      ST      `hl7:"5,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"6,display=Used to specify a name prefix (e.g.- Dr.)."`
-	Degree                                      IS      `hl7:"7,table=0360,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 – Degree/license/certificate for suggested values."`
-	SourceTable                                 IS      `hl7:"8,conditional,table=0297,display=Refer to User-defined Table 0297 - CN ID source for suggested values. Used to delineate the first component. If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
-	AssigningAuthorityNamespaceID               IS      `hl7:"9,conditional,table=0363,display=See section- 2.A.14.4- 'Assigning Authority (HD)' for definition. Refer to User-defined Table 0363 – Assigning Authority for suggested values. Assigning Authority is normally expressed as an HD data type- but has been flattened to 3 components here (CNN.9- CNN.10 and CNN.11) in this data type so that it may be fully expressed. Also note that if additional components are added to the HD data type in the future- adjustment will need to be made accordingly to this data type.  If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
+	Degree                                      IS      `hl7:"7,table=0360,datatype=IS,display=Used to specify an educational degree (e.g.- MD). Refer to User-defined Table 0360 – Degree/license/certificate for suggested values."`
+	SourceTable                                 IS      `hl7:"8,conditional,table=0297,datatype=IS,display=Refer to User-defined Table 0297 - CN ID source for suggested values. Used to delineate the first component. If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
+	AssigningAuthorityNamespaceID               IS      `hl7:"9,conditional,table=0363,datatype=IS,display=See section- 2.A.14.4- 'Assigning Authority (HD)' for definition. Refer to User-defined Table 0363 – Assigning Authority for suggested values. Assigning Authority is normally expressed as an HD data type- but has been flattened to 3 components here (CNN.9- CNN.10 and CNN.11) in this data type so that it may be fully expressed. Also note that if additional components are added to the HD data type in the future- adjustment will need to be made accordingly to this data type.  If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
 	AssigningAuthorityUniversalID               ST      `hl7:"10,conditional,display=If CNN.11 is valued- this component must be valued. If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
-	AssigningAuthorityUniversalIDType           ID      `hl7:"11,conditional,len=6,table=0301,display=If this component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values.  If CNN.10 is valued- this component must be valued. If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
+	AssigningAuthorityUniversalIDType           ID      `hl7:"11,conditional,len=6,table=0301,datatype=ID,display=If this component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values.  If CNN.10 is valued- this component must be valued. If component 1 is valued- either CNN.8 or CNN.9- or both CNN.10 and CNN.11- must be valued."`
 }
 
 // Composite Price
@@ -131,11 +131,11 @@ type CNN struct {
 type CP struct {
 	HL7        HL7Name `hl7:",name=CP,len=0,type=d"`
 	Price      MO      `hl7:"1,required,display=The only required component; usually containing a decimal point. Note that each component of the MO data type (Section 2.A.41- 'MO - money') is a subcomponent here."`
-	PriceType  ID      `hl7:"2,len=2,table=0205,display=A coded value- data type ID.  Refer to HL7 Table 0205 – Price Type for valid values."`
-	FromValue  NM      `hl7:"3,display=The number specifying the lower limit or boundary of the range. This component- together with the CP.4 component- specifies the 'price range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price.  Note that- if the CP.2 Price Type component is TP- both CP.3 and CP.4 may be null."`
-	ToValue    NM      `hl7:"4,display=The number specifying the high limit or boundary of the range."`
+	PriceType  ID      `hl7:"2,len=2,table=0205,datatype=ID,display=A coded value- data type ID.  Refer to HL7 Table 0205 – Price Type for valid values."`
+	FromValue  NM      `hl7:"3,datatype=NM,display=The number specifying the lower limit or boundary of the range. This component- together with the CP.4 component- specifies the 'price range'. The range can be defined as either time or quantity. For example- the range can indicate that the first 10 minutes of the procedure has one price. Another repetition of the data type can use the range to specify that the following 10 to 60 minutes of the procedure is charged at another price per; a final repetition can specify that the final 60 to N minutes of the procedure at a third price.  Note that- if the CP.2 Price Type component is TP- both CP.3 and CP.4 may be null."`
+	ToValue    NM      `hl7:"4,datatype=NM,display=The number specifying the high limit or boundary of the range."`
 	RangeUnits *CWE    `hl7:"5,conditional,display=This component describes the units associated with the range- e.g.- seconds- minutes- hours- days- quantity (i.e.- count).  As of v2.7 the Externally-defined Unified Code for Units of Measure (UCUM) case sensitive code is the required code for units of measure.  Refer to the externally-defined table 'Unified Code for Units of Measure' (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values. Local codes may be transmitted in addition to UCUM codes.   This component is required if CP.3 From Value and/or CP.4 To Value are present."`
-	RangeType  ID      `hl7:"6,len=1,table=0298,display=Refer to HL7 Table 0298 - CP Range Type for valid values."`
+	RangeType  ID      `hl7:"6,len=1,table=0298,datatype=ID,display=Refer to HL7 Table 0298 - CP Range Type for valid values."`
 }
 
 // Composite Quantity With Units
@@ -150,7 +150,7 @@ type CP struct {
 // |150^lb&&ANSI+|
 type CQ struct {
 	HL7      HL7Name `hl7:",name=CQ,len=0,type=d"`
-	Quantity NM      `hl7:"1,display=This component specifies the numeric quantity or amount of an entity."`
+	Quantity NM      `hl7:"1,datatype=NM,display=This component specifies the numeric quantity or amount of an entity."`
 	Units    *CWE    `hl7:"2,display=This component species the units in which the quantity is expressed.  As of v2.7 the externally-defined Unified Code for Units of Measure (UCUM) case sensitive code is the required code for units of measure. Refer to the external table 'Unified Code for Units of Measure' (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values. Local codes may be transmitted in addition to UCUM codes."`
 }
 
@@ -178,16 +178,16 @@ type CWE struct {
 	HL7                                  HL7Name `hl7:",name=CWE,len=0,type=d"`
 	Identifier                           ST      `hl7:"1,display=The first component contains the sequence of characters (the code) that uniquely identifies the item being referenced by the CWE.2. Different coding schemes will have different elements here."`
 	Text                                 ST      `hl7:"2,display=The second component contains the descriptive or textual name of the identifier- e.g.- 'myocardial infarction' or 'X-ray impression'."`
-	NameOfCodingSystem                   ID      `hl7:"3,conditional,len=12,table=0396,display=The third component contains the code for the name of the coding system from which the value in CWE.1 is obtained. Each coding system is assigned a unique identifier. As of v2.7 this component is required when CWE.1 is populated and CWE.14 is not populated. Both CWE.3 and CWE.14 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CWE.3 and/or CWE.14- the 'Coding System' component or the 'Coding System OID' for the tuple.  Refer to HL7 Table 0396 – Coding System in Chapter 2C- section 'Coding System Table' for valid values.   Refer to section 2.A.8.3- 'Name of Coding System (ID)' for a discussion of coding system conventions."`
+	NameOfCodingSystem                   ID      `hl7:"3,conditional,len=12,table=0396,datatype=ID,display=The third component contains the code for the name of the coding system from which the value in CWE.1 is obtained. Each coding system is assigned a unique identifier. As of v2.7 this component is required when CWE.1 is populated and CWE.14 is not populated. Both CWE.3 and CWE.14 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the codingSystem as specified in CWE.3 and/or CWE.14- the 'Coding System' component or the 'Coding System OID' for the tuple.  Refer to HL7 Table 0396 – Coding System in Chapter 2C- section 'Coding System Table' for valid values.   Refer to section 2.A.8.3- 'Name of Coding System (ID)' for a discussion of coding system conventions."`
 	AlternateIdentifier                  ST      `hl7:"4,display=A sequence of characters that uniquely identifies an alternate code. Analogous to CWE.1- Identifier.   Usage Notes: The Alternate Identifier is used to represent the local or user seen code as described. If present- it obeys the same rules of use and interpretation as described for component 1. If both are present- the identifiers in component 4 and component 1 should have exactly the same meaning- i.e.- they should be exact synonyms."`
 	AlternateText                        ST      `hl7:"5,display=The descriptive or textual name of the alternate identifier. Analogous to CWE.2 - Text.  See usage note in section introduction."`
-	NameOfAlternateCodingSystem          ID      `hl7:"6,conditional,len=12,table=0396,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to CWE.3 - Name of Coding System. See usage note in section introduction.  As of v2.7 this component is required when CWE.4 is populated and CWE.17 is not populated. Both CWE.6 and CWE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the coding System as specified in CWE.6 and/or CWE.17- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
+	NameOfAlternateCodingSystem          ID      `hl7:"6,conditional,len=12,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to CWE.3 - Name of Coding System. See usage note in section introduction.  As of v2.7 this component is required when CWE.4 is populated and CWE.17 is not populated. Both CWE.6 and CWE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the coding System as specified in CWE.6 and/or CWE.17- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
 	CodingSystemVersionID                ST      `hl7:"7,conditional,display=This component carries the version for the coding system identified by components 1-3. If CWE.3 is populated with a value other than HL7nnnn or is of table type user-defined- version ID must be valued with an actual version ID.  If CWE.3 is populated with a value of HL7nnnn and nnnn is of table type HL7- version ID may have an actual value or it may be absent. If version ID is absent- it will be interpreted to have the same value as the HL7 version number in the message header. "`
 	AlternateCodingSystemVersionID       ST      `hl7:"8,display=This component carries the version for the coding system identified by components 4-6. Analogous To CWE.7 - Coding System Version ID."`
 	OriginalText                         ST      `hl7:"9,display=The text as seen and/or selected by the user who entered the data. Original text can be used in a structured user interface to capture what the user saw as a representation of the code on the data input screen- or in a situation where the user dictates or directly enters text- it is the text entered or uttered by the user. In a situation where the code is assigned sometime after the text was entered- original text is the text or phrase used as the basis for assigning the code."`
 	SecondAlternateIdentifier            ST      `hl7:"10,display=A sequence of characters that uniquely identifies a second alternate code. Analogous to CWE.1- Identifier. "`
 	SecondAlternateText                  ST      `hl7:"11,display=The descriptive or textual name of the alternate identifier. Analogous to CWE.2 - Text. See usage note in section introduction."`
-	NameOfSecondAlternateCodingSystem    ID      `hl7:"12,conditional,len=12,table=0396,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to CWE.3 Name of Coding System. See usage note in section introduction.  As of v2.7 this component is required when CWE.10 is populated and CWE.20 is not populated. Both CWE.6 and CWE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the coding System as specified in CWE.12 and/or CWE.20- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
+	NameOfSecondAlternateCodingSystem    ID      `hl7:"12,conditional,len=12,table=0396,datatype=ID,display=Identifies the coding scheme being used in the alternate identifier component. Analogous to CWE.3 Name of Coding System. See usage note in section introduction.  As of v2.7 this component is required when CWE.10 is populated and CWE.20 is not populated. Both CWE.6 and CWE.17 may be populated. Receivers should not identify a code based on its position within the tuples (Identifier- Alternate Identifier- or Second Alternate Identifier) or position within a repeating field. Instead- the receiver should always examine the coding System as specified in CWE.12 and/or CWE.20- the 'Coding System' component or the 'Coding System OID'- for the tuple."`
 	SecondAlternateCodingSystemVersionID ST      `hl7:"13,display=This component carries the version for the coding system identified by components 10-12. Analogous to CWE.7 - Coding System Version ID."`
 	CodingSystemOid                      ST      `hl7:"14,conditional,display=This component contains the ISO Object Identifier (OID) for the coding system or value set named in CWE.3. The value for this component is 2.16.840.1.113883.12.#### where  '####' is to be replaced by the HL7 table number in the case of an HL7 defined or user defined table. For externally defined code systems the OID registered in the HL7 OID registry SHALL be used.   This component is required when CWE.1 is populated and CWE.3 is not populated. Both CWE.3 and CWE.14 may be populated."`
 	ValueSetOid                          ST      `hl7:"15,display=This component contains the ISO Object Identifier (OID) to allow identification of the value set from which the value in CWE.1 is obtained. The value for this component is 2.16.840.1.113883.12.#### where  '####' is to be replaced by the HL7 table number in the case of an HL7 defined or user defined table. For externally defined value sets- the OID registered in the HL7 OID registry SHALL be used.   A value set may or need not be present irrespective of other fields. Note that if a code is provided- the meaning of the code must come from the definition of the code in the code system. The meaning of the code SHALL NOT depend on the value set.  Applications SHALL NOT be required to interpret the code in light of the valueSet- and they SHALL NOT reject an instance because of the presence or absence of any or a particular value set/ value set version ID."`
@@ -212,16 +212,16 @@ type CX struct {
 	HL7                         HL7Name `hl7:",name=CX,len=0,type=d"`
 	IDNumber                    ST      `hl7:"1,required,display=The value of the identifier itself."`
 	IdentifierCheckDigit        ST      `hl7:"2,display=A digit- or digits- exclusive of the identifier in CX.1- calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier."`
-	CheckDigitScheme            ID      `hl7:"3,len=3,table=0061,display=Contains the code identifying the check digit scheme employed.  Refer to HL7 Table 0061 - Check Digit Scheme for valid values.  Note: The check digit and code identifying check digit scheme are null if ID is alphanumeric."`
+	CheckDigitScheme            ID      `hl7:"3,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed.  Refer to HL7 Table 0061 - Check Digit Scheme for valid values.  Note: The check digit and code identifying check digit scheme are null if ID is alphanumeric."`
 	AssigningAuthority          *HD     `hl7:"4,conditional,table=0363,display=The assigning authority is a unique name of the system (or organization or agency or department) that creates the data. As of v2.7- CX.4 Assigning Authority is required if neither CX.9 nor CX.10 are populated. Best practice is to always send an OID in the Assigning Authority component  Refer to User-defined Table 0363 - Assigning Authority for suggested values.  The reader is referred to the CX.9 and the CX.10 if there is a need to transmit values with semantic meaning for an assigning jurisdiction or assigning department or agency in addition to- or instead of- an assigning authority. However- all 3 components may be valued. If- in so doing- it is discovered that the values in CX.9 and/or CX.10 conflict with CX.4- the user would look to the Message Profile or other implementation agreement for a statement as to which takes precedence.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.	  By site agreement- implementers may continue to use User-defined Table 0300 – Namespace ID for the first sub-component."`
-	IdentifierTypeCode          ID      `hl7:"5,required,len=5,table=0203,display=A code corresponding to the type of identifier. As of v2.7- CX.5 Identifier Type Code is required. Refer to HL7 Table 0203 – Identifier Type for suggested values."`
+	IdentifierTypeCode          ID      `hl7:"5,required,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. As of v2.7- CX.5 Identifier Type Code is required. Refer to HL7 Table 0203 – Identifier Type for suggested values."`
 	AssigningFacility           *HD     `hl7:"6,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component)- may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
 	EffectiveDate               DT      `hl7:"7,format=YMD,display=The first date- if known- on which the identifier is valid and active."`
 	ExpirationDate              DT      `hl7:"8,format=YMD,display=The last date- if known- on which the identifier is valid and active."`
 	AssigningJurisdiction       *CWE    `hl7:"9,conditional,display=The geo-political body that assigned the identifier in component 1. As of v2.7- Assigning Jurisdiction is required if neither CX.4 nor CX.10 are populated.    - Refer to HL7 Table 0399 – Country Code for valid values if the administrative unit under whose jurisdiction the identifier was issued is a country.  - Refer to User-Defined Table 0347 – State/Province for suggested values if the administrative unit under whose jurisdiction the identifier was issued is a state or province. This table is country specific. In the US- postal codes may be used.  - Refer to User-defined Table –0289 County/Parish for suggested values if the administrative unit under whose jurisdiction the identifier was issued is a county or parish.  The reader is referred to the CX.4 if there is a need to transmit this information as an OID."`
 	AssigningAgencyOrDepartment *CWE    `hl7:"10,conditional,display=The agency or department that assigned the identifier in component 1. As of v2.7- Assigning Agency or Department is required if neither CX.4 nor CX.9 are populated.  Refer to User-defined Table 0530 – Organizations- agency- department for suggested values if the administrative unit under whose jurisdiction the identifier was issued is an organization- agency or department. This is populated with site-specific assigning authorities. It also should contain national or international codes when CX.5 Identifier Type Code may be assigned by more than one authority within a governmental or organizational unit. For example- a federal government may have 2 departments that assign a military identifier- its Veterans Affairs department and its Department of Defense. It is not recommended to include values for entities such as Social Security Administration (SSA)- Immigration and Naturalization Service (INS)- Center for Medicare and Medicaid Services (CMS) because they are included in the identifier type table. In these cases the name of the country plus the identifier type yields the correct interpretation of the identifier in component 1. Likewise- entries like department of motor vehicles (DMV) and licensing boards are not recommended for inclusion because the combination of state and identifier type yields the correct interpretation of the identifier in component 1. This approach is not to be confused with the detailed information provided in the Chapter 15 segments that have provision for specifying the precise granting body and issuing body information needed in personnel management messages."`
 	SecurityCheck               ST      `hl7:"11,display=This component is used to communicate a version code that may be assigned to the value given in CX.1 ID."`
-	SecurityCheckScheme         ID      `hl7:"12,len=3,table=0904,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values."`
+	SecurityCheckScheme         ID      `hl7:"12,len=3,table=0904,datatype=ID,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values."`
 }
 
 // Daily Deductible Information
@@ -231,9 +231,9 @@ type CX struct {
 // Note: Replaces the CM data type used in section 6.5.7.30 IN2-30, as of v 2.5.
 type DDI struct {
 	HL7            HL7Name `hl7:",name=DDI,len=0,type=d"`
-	DelayDays      NM      `hl7:"1,display=The number of days after which the daily deductible begins."`
+	DelayDays      NM      `hl7:"1,datatype=NM,display=The number of days after which the daily deductible begins."`
 	MonetaryAmount MO      `hl7:"2,required,display=The monetary amount of the deductible."`
-	NumberOfDays   NM      `hl7:"3,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
+	NumberOfDays   NM      `hl7:"3,datatype=NM,display=The number of days to apply the deductible. If this component is not populated- it means that the number of days is indefinite."`
 }
 
 // Date And Institution Name
@@ -277,9 +277,9 @@ type DLN struct {
 type DLT struct {
 	HL7               HL7Name `hl7:",name=DLT,len=0,type=d"`
 	NormalRange       *NR     `hl7:"1,display=Specifies the normal interval of the reference data."`
-	NumericThreshold  NM      `hl7:"2,display=The numeric threshold of the change that is detected.   For example the threshold may be set to 10. "`
-	ChangeComputation ID      `hl7:"3,table=0523,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation Type for valid values."`
-	DaysRetained      NM      `hl7:"4,display=The length of time in days that the value is retained for computing delta checks."`
+	NumericThreshold  NM      `hl7:"2,datatype=NM,display=The numeric threshold of the change that is detected.   For example the threshold may be set to 10. "`
+	ChangeComputation ID      `hl7:"3,table=0523,datatype=ID,display=Specifies if the change is computed as a percent change or as an absolute change. Refer to HL7 Table 0523 - Computation Type for valid values."`
+	DaysRetained      NM      `hl7:"4,datatype=NM,display=The length of time in days that the value is retained for computing delta checks."`
 }
 
 // Date/time Range
@@ -334,7 +334,7 @@ type DTM = time.Time
 type DTN struct {
 	HL7          HL7Name `hl7:",name=DTN,len=0,type=d"`
 	DayType      CWE     `hl7:"1,required,table=0149,display=Specifies whether the days are denied- pending- or approved.  Refer to User-defined Table 0149 - Day Type for suggested values."`
-	NumberOfDays NM      `hl7:"2,required,display=Specifies the number of days for which the certification is valid."`
+	NumberOfDays NM      `hl7:"2,required,datatype=NM,display=Specifies the number of days for which the certification is valid."`
 }
 
 // Encapsulated Data
@@ -346,9 +346,9 @@ type DTN struct {
 type ED struct {
 	HL7               HL7Name `hl7:",name=ED,len=0,type=d"`
 	SourceApplication *HD     `hl7:"1,display=A unique name that identifies the system which was the source of the data. Identical format and restrictions as in reference pointer (see Section 2.A.65.2- 'Application ID (HD)')."`
-	TypeOfData        ID      `hl7:"2,required,len=11,table=0834,display=Identical to “type of data” component in the reference pointer (RP) data type. See Section 2.A.65.3- 'Type of Data (ID)'.  Refer to Imported Table 0834 – MIME Types for valid values."`
-	DataSubtype       ID      `hl7:"3,table=0291,display=Identical to “subtype” component in the reference pointer (RP) data type. See Section 2.A.65.4- 'Subtype (ID)'.  Refer to External Table 0291 - Subtype of Referenced Data for valid values."`
-	Encoding          ID      `hl7:"4,required,len=6,table=0299,display=The type of encoding used to represent successive octets of binary data as displayable ASCII characters. Refer to HL7 Table 0299 - Encoding for valid values."`
+	TypeOfData        ID      `hl7:"2,required,len=11,table=0834,datatype=ID,display=Identical to “type of data” component in the reference pointer (RP) data type. See Section 2.A.65.3- 'Type of Data (ID)'.  Refer to Imported Table 0834 – MIME Types for valid values."`
+	DataSubtype       ID      `hl7:"3,table=0291,datatype=ID,display=Identical to “subtype” component in the reference pointer (RP) data type. See Section 2.A.65.4- 'Subtype (ID)'.  Refer to External Table 0291 - Subtype of Referenced Data for valid values."`
+	Encoding          ID      `hl7:"4,required,len=6,table=0299,datatype=ID,display=The type of encoding used to represent successive octets of binary data as displayable ASCII characters. Refer to HL7 Table 0299 - Encoding for valid values."`
 	Data              TX      `hl7:"5,required,display=Displayable ASCII characters which constitute the data to be sent from source application to destination application. The characters are limited to the legal characters of the ST data type- as defined in Section 2.A.75- 'ST - string data-' and- if encoded binary- are encoded according to the method of Section 2.A.24.2- 'Type of Data (ID)'.  If the encoding component (see Section 2.A.24.4- 'Encoding (ID)') : 'A' (none)- then the data component must be scanned before transmission for HL7 delimiter characters- and any found must be escaped by using the HL7 escape sequences defined in Section 2.7 – 'Use of escape sequences in text fields.' On the receiving application- the data field must be de-escaped after being parsed.  If the encoding component ED.4 does not equal 'A'- then- after encoding- the (encoded) data must be scanned for HL7 delimiter characters- and any found must be escaped by using the HL7 escape sequences. Only then can the component be added to the HL7 segment/message. On the receiving application- the data field must be de-escaped after being parsed out of the message before being decoded. This can be expressed as 'encode'- 'escape'- 'parse'- 'de-escape' or 'decode'."`
 }
 
@@ -368,9 +368,9 @@ type ED struct {
 type EI struct {
 	HL7              HL7Name `hl7:",name=EI,len=0,type=d"`
 	EntityIdentifier ST      `hl7:"1,display=The first component- <entity identifier>- is usually defined to be unique within the series of identifiers created by the <assigning authority>- defined by a hierarchic designator- represented by components 2 through 4. See Section 2.A.33- 'HD - hierarchic designator'."`
-	NamespaceID      IS      `hl7:"2,table=0363,display=The component intentionally remains associated with the IS data type in v2.7.  The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Refer to User-defined Table 0363 – Assigning Authority for suggested values.  Note: When the HD is used as a part of another data type- in this case as part of the EI data type- this table may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.   By site agreement- implementers may continue to use User-defined Table 0300 – Namespace ID for the first component"`
+	NamespaceID      IS      `hl7:"2,table=0363,datatype=IS,display=The component intentionally remains associated with the IS data type in v2.7.  The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Refer to User-defined Table 0363 – Assigning Authority for suggested values.  Note: When the HD is used as a part of another data type- in this case as part of the EI data type- this table may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.   By site agreement- implementers may continue to use User-defined Table 0300 – Namespace ID for the first component"`
 	UniversalID      ST      `hl7:"3,conditional,display=The <universal ID> (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type). The UID is intended to be unique over time within the UID type. It is rigorously defined. Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type). The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType  ID      `hl7:"4,conditional,len=6,table=0301,display=The component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type.  Refer to HL7 Table 0301 - Universal ID Type for valid values."`
+	UniversalIDType  ID      `hl7:"4,conditional,len=6,table=0301,datatype=ID,display=The component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type.  Refer to HL7 Table 0301 - Universal ID Type for valid values."`
 }
 
 // Entity Identifier Pair
@@ -391,11 +391,11 @@ type EIP struct {
 type ERL struct {
 	HL7                HL7Name `hl7:",name=ERL,len=0,type=d"`
 	SegmentID          ST      `hl7:"1,required,len=3,display=Specifies the 3-letter name for the segment."`
-	SegmentSequence    NM      `hl7:"2,required,display=Identifies the segment occurrence within the message."`
-	FieldPosition      NM      `hl7:"3,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
-	FieldRepetition    NM      `hl7:"4,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
-	ComponentNumber    NM      `hl7:"5,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
-	SubComponentNumber NM      `hl7:"6,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
+	SegmentSequence    NM      `hl7:"2,required,datatype=NM,display=Identifies the segment occurrence within the message."`
+	FieldPosition      NM      `hl7:"3,datatype=NM,display=Identifies the number of the field within the segment. The first field is assigned a number of 1. Field number should not be specified when referring to the entire segment."`
+	FieldRepetition    NM      `hl7:"4,datatype=NM,display=Identifies the repetition number of the field. The first repetition is counted as 1. If a Field Position is specified- but Field Repetition is not- Field Repetition should be assumed to be 1. If Field Position is not specified- Field Repetition should not be specified."`
+	ComponentNumber    NM      `hl7:"5,datatype=NM,display=Identifies the number of the component within the field. The first component is assigned a number of 1. Component number should not be specified when referring to the entire field."`
+	SubComponentNumber NM      `hl7:"6,datatype=NM,display=Identifies the number of the sub-component within the component. The first sub-component is assigned a number of 1. Sub-component number should not be specified when referring to the entire component."`
 }
 
 // Financial Class
@@ -505,9 +505,9 @@ type GTS = string
 // |^falcon.iupui.edu^DNS|
 type HD struct {
 	HL7             HL7Name `hl7:",name=HD,len=0,type=d"`
-	NamespaceID     IS      `hl7:"1,table=0300,display=The local coded item for the entity. The component intentionally remains associated with the IS data type in v2.7.  User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component.   Note: When the HD is used in a given segment (either as a field or as a component of another data type) this table may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
+	NamespaceID     IS      `hl7:"1,table=0300,datatype=IS,display=The local coded item for the entity. The component intentionally remains associated with the IS data type in v2.7.  User-defined Table 0300 - Namespace ID is used as the HL7 identifier for the user-defined table of values for this component.   Note: When the HD is used in a given segment (either as a field or as a component of another data type) this table may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
 	UniversalID     ST      `hl7:"2,conditional,display=The HD’s second component- <universal ID> (UID)- is a string formatted according to the scheme defined by the third component- <universal ID type> (UID type). The UID is intended to be unique over time within the UID type. It is rigorously defined. Each UID must belong to one of the specifically enumerated schemes for constructing UIDs (defined by the UID type). The UID (second component) must follow the syntactic rules of the particular universal identifier scheme (defined by the third component). Note that these syntactic rules are not defined within HL7 but are defined by the rules of the particular universal identifier scheme (defined by the third component)."`
-	UniversalIDType ID      `hl7:"3,conditional,len=6,table=0301,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
+	UniversalIDType ID      `hl7:"3,conditional,len=6,table=0301,datatype=ID,display=The third component governs the interpretation of the second component of the HD. If the third component is a known UID refer to HL7 Table 0301 - Universal ID type for valid values- then the second component is a universal ID of that type."`
 }
 
 // Insurance Certification Definition
@@ -519,7 +519,7 @@ type HD struct {
 type ICD struct {
 	HL7                           HL7Name `hl7:",name=ICD,len=0,type=d"`
 	CertificationPatientType      *CWE    `hl7:"1,table=0150,display=Specifies the category or type of patient for which this certification is requested. Refer to User-defined Table 0150 - Certification patient type for suggested values."`
-	CertificationRequired         ID      `hl7:"2,required,len=1,table=0136,display=Specifies whether or not a certification is required. Refer to HL7 Table 0136 - Yes/no Indicator for valid values."`
+	CertificationRequired         ID      `hl7:"2,required,len=1,table=0136,datatype=ID,display=Specifies whether or not a certification is required. Refer to HL7 Table 0136 - Yes/no Indicator for valid values."`
 	DateTimeCertificationRequired DTM     `hl7:"3,format=YMDHM,display=The date/time by which the certification must be obtained. "`
 }
 
@@ -577,14 +577,14 @@ type JCC struct {
 // by 4 new fields in the RXO and RXE segments.
 type LA1 struct {
 	HL7                 HL7Name `hl7:",name=LA1,len=0,type=d"`
-	PointOfCare         IS      `hl7:"1,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on person location type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of Care for suggested values."`
-	Room                IS      `hl7:"2,table=0303,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                 IS      `hl7:"3,table=0304,display=This component specifies the code for the patient bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare         IS      `hl7:"1,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on person location type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of Care for suggested values."`
+	Room                IS      `hl7:"2,table=0303,datatype=IS,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                 IS      `hl7:"3,table=0304,datatype=IS,display=This component specifies the code for the patient bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility            *HD     `hl7:"4,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation."`
-	LocationStatus      IS      `hl7:"5,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
-	PatientLocationType IS      `hl7:"6,table=0305,display=Person location type is the categorization of the person’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person Location Type for suggested values."`
-	Building            IS      `hl7:"7,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor               IS      `hl7:"8,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus      IS      `hl7:"5,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
+	PatientLocationType IS      `hl7:"6,table=0305,datatype=IS,display=Person location type is the categorization of the person’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person Location Type for suggested values."`
+	Building            IS      `hl7:"7,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor               IS      `hl7:"8,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	Address             *AD     `hl7:"9,display=This component specifies the address of a location."`
 }
 
@@ -599,21 +599,21 @@ type LA1 struct {
 // by 2 new fields in the RXD, RXG and RXA segments.
 type LA2 struct {
 	HL7                        HL7Name `hl7:",name=LA2,len=0,type=d"`
-	PointOfCare                IS      `hl7:"1,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on Patient Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of Care for suggested values."`
-	Room                       IS      `hl7:"2,table=0303,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                        IS      `hl7:"3,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare                IS      `hl7:"1,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on Patient Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of Care for suggested values."`
+	Room                       IS      `hl7:"2,table=0303,datatype=IS,display=This component specifies the code for the patient room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                        IS      `hl7:"3,table=0304,datatype=IS,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility                   *HD     `hl7:"4,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation."`
-	LocationStatus             IS      `hl7:"5,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
-	PatientLocationType        IS      `hl7:"6,table=0305,display=Patient location type is the categorization of the patient’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 – Person Location Type for suggested values."`
-	Building                   IS      `hl7:"7,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor                      IS      `hl7:"8,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus             IS      `hl7:"5,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
+	PatientLocationType        IS      `hl7:"6,table=0305,datatype=IS,display=Patient location type is the categorization of the patient’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 – Person Location Type for suggested values."`
+	Building                   IS      `hl7:"7,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor                      IS      `hl7:"8,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	StreetAddress              ST      `hl7:"9,display=This component specifies the street or mailing address of a person or institution. When referencing an institution- it is used to specify the institution name. When used in connection with a person- it specifies the first line of the address."`
 	OtherDesignation           ST      `hl7:"10,display=This component specifies the second line of an address. In general- it qualifies address. Examples: Suite 555 or Fourth Floor. When referencing an institution- this component specifies the street address."`
 	City                       ST      `hl7:"11,display=This component specifies the city- or district or place where the person or institution is located depending upon the national convention for formatting addresses for postal usage. City should be represented by the official postal service codes for that state."`
 	StateOrProvince            ST      `hl7:"12,display=This component specifies the state or province where the person or institution is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"13,display=This component specifies the zip or postal code where the person or institution is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9 and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"14,len=3,table=0399,display=This component specifies the country where the person or institution is located. ISO 3166 provides a list of country codes that may be used.   HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 – Country Code in section 2.C.2.313 ISO-3166."`
-	AddressType                ID      `hl7:"15,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
+	Country                    ID      `hl7:"14,len=3,table=0399,datatype=ID,display=This component specifies the country where the person or institution is located. ISO 3166 provides a list of country codes that may be used.   HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 – Country Code in section 2.C.2.313 ISO-3166."`
+	AddressType                ID      `hl7:"15,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values."`
 	OtherGeographicDesignation ST      `hl7:"16,display=This component specifies any other geographic designation that may be necessary. It includes county- bioregion- SMSA- etc."`
 }
 
@@ -622,8 +622,8 @@ type LA2 struct {
 // This data type specifies an amount of money and the denomination in which it is expressed.
 type MO struct {
 	HL7          HL7Name `hl7:",name=MO,len=0,type=d"`
-	Quantity     NM      `hl7:"1,display=The first component is a quantity."`
-	Denomination ID      `hl7:"2,len=3,table=0913,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- 'MSH-17-country code'- in Chapter 2- section 2.14.9.17- is used to determine the default.   Example- where USD is the ISO 4217 code for the U.S. American dollar. |99.50^USD|"`
+	Quantity     NM      `hl7:"1,datatype=NM,display=The first component is a quantity."`
+	Denomination ID      `hl7:"2,len=3,table=0913,datatype=ID,display=The second component is the denomination in which the quantity is expressed. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- 'MSH-17-country code'- in Chapter 2- section 2.14.9.17- is used to determine the default.   Example- where USD is the ISO 4217 code for the U.S. American dollar. |99.50^USD|"`
 }
 
 // Money And Code
@@ -648,9 +648,9 @@ type MOC struct {
 // |AT^99.50^USD|
 type MOP struct {
 	HL7                        HL7Name `hl7:",name=MOP,len=0,type=d"`
-	MoneyOrPercentageIndicator ID      `hl7:"1,required,table=0148,display=Specifies whether the amount is currency or a percentage.  Refer to HL7 Table 0148 – Money or Percentage Indicator for valid values."`
-	MoneyOrPercentageQuantity  NM      `hl7:"2,required,display=Specifies the currency or percentage quantity."`
-	MonetaryDenomination       ID      `hl7:"3,len=3,table=0913,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- the context of the message or MSH-17 Country Code- in Chapter 2- section 2.14.9.17- is used to determine the default."`
+	MoneyOrPercentageIndicator ID      `hl7:"1,required,table=0148,datatype=ID,display=Specifies whether the amount is currency or a percentage.  Refer to HL7 Table 0148 – Money or Percentage Indicator for valid values."`
+	MoneyOrPercentageQuantity  NM      `hl7:"2,required,datatype=NM,display=Specifies the currency or percentage quantity."`
+	MonetaryDenomination       ID      `hl7:"3,len=3,table=0913,datatype=ID,display=the denomination in which the quantity is expressed where the amount is currency. The values for the denomination component are the three-character codes specified in ISO-4217. See Externally-defined Table 0913 – Monetary Denomination Code.  If the denomination is not specified- the context of the message or MSH-17 Country Code- in Chapter 2- section 2.14.9.17- is used to determine the default."`
 }
 
 // Message Type
@@ -660,9 +660,9 @@ type MOP struct {
 // Note: Replaces the CM data type used in 2.16.9.9 MSH-9 as of v 2.5.
 type MSG struct {
 	HL7              HL7Name `hl7:",name=MSG,len=0,type=d"`
-	MessageCode      ID      `hl7:"1,required,len=3,table=0076,display=Specifies the message type code. Refer to HL7 Table 0076– Message Type for valid values.  This table contains values such as ACK- ADT- ORM- ORU etc.   See Chapter 2- 'Control'- section 2.5.1- 'Messages' for further discussion."`
-	TriggerEvent     ID      `hl7:"2,required,len=3,table=0003,display=Specifies the trigger event code. Refer to HL7 Table 0003– Event Type for valid values.   This table contains values like A01- O01- R01 etc."`
-	MessageStructure ID      `hl7:"3,required,len=7,table=0354,display=Specifies the abstract message structure code. Refer to HL7 Table 0354 – Message Structure for valid values."`
+	MessageCode      ID      `hl7:"1,required,len=3,table=0076,datatype=ID,display=Specifies the message type code. Refer to HL7 Table 0076– Message Type for valid values.  This table contains values such as ACK- ADT- ORM- ORU etc.   See Chapter 2- 'Control'- section 2.5.1- 'Messages' for further discussion."`
+	TriggerEvent     ID      `hl7:"2,required,len=3,table=0003,datatype=ID,display=Specifies the trigger event code. Refer to HL7 Table 0003– Event Type for valid values.   This table contains values like A01- O01- R01 etc."`
+	MessageStructure ID      `hl7:"3,required,len=7,table=0354,datatype=ID,display=Specifies the abstract message structure code. Refer to HL7 Table 0354 – Message Structure for valid values."`
 }
 
 func (d MSG) MessageStructureID() string {
@@ -696,10 +696,10 @@ func (d MSG) MessageStructureID() string {
 // |^2^3^4~5^^^8~9^10~~17^18^19^20|
 type NA struct {
 	HL7    HL7Name `hl7:",name=NA,len=0,type=d"`
-	Value1 NM      `hl7:"1,display=Value1"`
-	Value2 NM      `hl7:"2,display=Value2"`
-	Value3 NM      `hl7:"3,display=Value3"`
-	Value4 NM      `hl7:"4,display=Value4"`
+	Value1 NM      `hl7:"1,datatype=NM,display=Value1"`
+	Value2 NM      `hl7:"2,datatype=NM,display=Value2"`
+	Value3 NM      `hl7:"3,datatype=NM,display=Value3"`
+	Value4 NM      `hl7:"4,datatype=NM,display=Value4"`
 }
 
 // Name With Date And Location
@@ -717,14 +717,14 @@ type NDL struct {
 	Name                *CNN    `hl7:"1,display=This component specifies the name of the person performing a service. "`
 	StartDateTime       DTM     `hl7:"2,format=YMDHM,display=This component specifies the starting date and time for when the person is performing the service."`
 	EndDateTime         DTM     `hl7:"3,format=YMDHM,display=This component specifies the ending date and time for when the person is performing the service. "`
-	PointOfCare         IS      `hl7:"4,table=0302,display=This component specifies the code for the point where patient care is administered. It is conditional on NDL.9 – Patient Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
-	Room                IS      `hl7:"5,table=0303,display=Patient room. After point of care- it is the most general location designation. Refer to User-defined Table 0303 - Room for suggested values."`
-	Bed                 IS      `hl7:"6,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
+	PointOfCare         IS      `hl7:"4,table=0302,datatype=IS,display=This component specifies the code for the point where patient care is administered. It is conditional on NDL.9 – Patient Location Type (e.g.- nursing unit or department or clinic). After floor- it is the most general patient location designation. Refer to User-defined Table 0302 - Point of care for suggested values."`
+	Room                IS      `hl7:"5,table=0303,datatype=IS,display=Patient room. After point of care- it is the most general location designation. Refer to User-defined Table 0303 - Room for suggested values."`
+	Bed                 IS      `hl7:"6,table=0304,datatype=IS,display=This component specifies the code for the patient's bed. After room- it is the most general location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility            *HD     `hl7:"7,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general location designation. "`
-	LocationStatus      IS      `hl7:"8,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
-	PatientLocationType IS      `hl7:"9,table=0305,display=Location type is the categorization of the location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person Location Type for suggested values."`
-	Building            IS      `hl7:"10,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general location designation. Refer to User-defined Table 0307 - Building for suggested values."`
-	Floor               IS      `hl7:"11,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
+	LocationStatus      IS      `hl7:"8,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
+	PatientLocationType IS      `hl7:"9,table=0305,datatype=IS,display=Location type is the categorization of the location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. Usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person Location Type for suggested values."`
+	Building            IS      `hl7:"10,table=0307,datatype=IS,display=This component specifies the code for the building where the person is located. After facility- it is the most general location designation. Refer to User-defined Table 0307 - Building for suggested values."`
+	Floor               IS      `hl7:"11,table=0308,datatype=IS,display=This component specifies the code for the floor where the person is located. After building- it is the most general location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 }
 
 // Numeric
@@ -763,8 +763,8 @@ type NM = string
 // v 2.5.
 type NR struct {
 	HL7       HL7Name `hl7:",name=NR,len=0,type=d"`
-	LowValue  NM      `hl7:"1,display=The number specifying the lower limit or boundary of the range."`
-	HighValue NM      `hl7:"2,display=The number specifying the high limit or boundary of the range."`
+	LowValue  NM      `hl7:"1,datatype=NM,display=The number specifying the lower limit or boundary of the range."`
+	HighValue NM      `hl7:"2,datatype=NM,display=The number specifying the high limit or boundary of the range."`
 }
 
 // Occurrence Code And Date
@@ -848,8 +848,8 @@ type PL struct {
 	Room                            *HD     `hl7:"2,table=0303,display=This component specifies the code for the patient's room. After point of care- it is the most general person location designation. Refer to User-defined Table 0303 - Room for suggested values."`
 	Bed                             *HD     `hl7:"3,table=0304,display=This component specifies the code for the patient's bed. After room- it is the most general person location designation. Refer to User-defined Table 0304 - Bed for suggested values."`
 	Facility                        *HD     `hl7:"4,display=This component is subject to site interpretation but generally describes the highest level physical designation of an institution- medical center or enterprise. It is the most general person location designation.   See Section 2.A.33- “HD - hierarchic designator” for discussion of data type.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be redefined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
-	LocationStatus                  IS      `hl7:"5,table=0306,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
-	PersonLocationType              IS      `hl7:"6,table=0305,display=Person location type is the categorization of the person’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person location type for suggested values."`
+	LocationStatus                  IS      `hl7:"5,table=0306,datatype=IS,display=This component specifies the code for the status or availability of the location. For example- it may convey bed status. Refer to User-defined Table 0306 - Location Status for suggested values."`
+	PersonLocationType              IS      `hl7:"6,table=0305,datatype=IS,display=Person location type is the categorization of the person’s location defined by facility- building- floor- point of care- room or bed. Although not a required field- when used- it may be the only populated field. It usually includes values such as nursing unit- department- clinic- SNF- physician’s office. Refer to User-defined Table 0305 - Person location type for suggested values."`
 	Building                        *HD     `hl7:"7,table=0307,display=This component specifies the code for the building where the person is located. After facility- it is the most general person location designation. Refer to User-defined Table 0307 - Building for suggested values."`
 	Floor                           *HD     `hl7:"8,table=0308,display=This component specifies the code for the floor where the person is located. After building- it is the most general person location designation. Refer to User-defined Table 0308 - Floor for suggested values."`
 	LocationDescription             ST      `hl7:"9,display=This component describes the location in free text."`
@@ -886,23 +886,23 @@ type PPN struct {
 	Degree                                      ST      `hl7:"7,display=Attention: The PPN.7 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v2.7 See PPN.22 - Professional Suffix."`
 	SourceTable                                 CWE     `hl7:"8,table=0297,display=Attention: Retained for backwards compatibility only as of v2.7. The reader is referred to PPN.9 instead.  User-defined Table 0297 - CN ID Source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,conditional,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. It is a HD data type. User-defined Table 0363 – Assigning Authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.  By site agreement- implementors may continue to use User-defined Table 0300 – Namespace ID for the first sub-component.  Attention: As of v2.7- the Assigning Authority is conditional. It is required if PPN.1 is populated and neither PPN.23 nor PPN.24 are populated. All 3 components may be populated. No assumptions can be safely made based on position or sequence. Best practice is to send an OID in this component when populated.  The reader is referred to the PPN.23 and the PPN.24 if there is a need to transmit values with semantic meaning for an assigning jurisdiction or assigning department or agency in addition to- or instead of- an assigning authority. However- all 3 components may be valued. If- in so doing- it is discovered that the values in PPN.23 and/or PPN.24 conflict with PPN.9- the user would look to the Message Profile or other implementation agreement for a statement as to which takes precedence."`
-	NameTypeCode                                ID      `hl7:"10,len=5,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values (see Section 2.A.89- “XPN - extended person name”)."`
+	NameTypeCode                                ID      `hl7:"10,len=5,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values (see Section 2.A.89- “XPN - extended person name”)."`
 	IdentifierCheckDigit                        ST      `hl7:"11,display=A digit- or digits- exclusive of the identifier in CX.1- calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier."`
-	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,display=Contains the code identifying the check digit scheme employed. Refer to HL7 Table 0061 - Check Digit Scheme for valid values."`
-	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier Type for suggested values."`
+	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed. Refer to HL7 Table 0061 - Check Digit Scheme for valid values."`
+	IdentifierTypeCode                          ID      `hl7:"13,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier Type for suggested values."`
 	AssigningFacility                           *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the patient. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
 	DateTimeActionPerformed                     DTM     `hl7:"15,format=YMDHM,display=This component describes when the activity was performed. Note:If this field is not null- both the performing person and the time stamp must be valued."`
-	NameRepresentationCode                      ID      `hl7:"16,len=1,table=0465,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component.  Note:This new component remains in “alphabetic” representation with each repetition of the field using these data types. That is- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
+	NameRepresentationCode                      ID      `hl7:"16,len=1,table=0465,datatype=ID,display=Different name/address types and representations of the same name/address should be described by repeating of this field- with different values of the Name/Address Type and/or Name/Address Representation component.  Note:This new component remains in “alphabetic” representation with each repetition of the field using these data types. That is- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
 	NameContext                                 *CWE    `hl7:"17,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare: indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to chapter 3- section 3.4.2.6 for more detailed information on how to use this table. Refer to User-defined Table 0448 – Name Context for suggested values."`
 	NameValidityRange                           ST      `hl7:"18,display=Attention: The PPN.18 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v 2.7. Refer to PPN.20 Effective Date and PPN.21 Expiration Date."`
-	NameAssemblyOrder                           ID      `hl7:"19,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 – Name Assembly Order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"19,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 – Name Assembly Order for valid values."`
 	EffectiveDate                               DTM     `hl7:"20,format=YMDHM,display=The first date- if known- on which the person name is valid and active."`
 	ExpirationDate                              DTM     `hl7:"21,format=YMDHM,display=The last date- if known- on which the person name is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"22,display=Used to specify an abbreviation- or a string of abbreviations- denoting qualifications that support the person’s profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- 'Personnel Management'."`
 	AssigningJurisdiction                       *CWE    `hl7:"23,display=The geo-political body that assigned the identifier in component 1.   See section 2.A.14.9- 'Assigning Jurisdiction (CWE)' for further detail."`
 	AssigningAgencyOrDepartment                 *CWE    `hl7:"24,display=The agency or department that assigned the identifier in component 1.  See section 2.A.14.10- 'Assigning Agency or Department (CWE)' for further details."`
 	SecurityCheck                               ST      `hl7:"25,display=This component is used to communicate a version code that may be assigned to the value given in PPN.1 - Person Identifier."`
-	SecurityCheckScheme                         ID      `hl7:"26,len=3,table=0904,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values."`
+	SecurityCheckScheme                         ID      `hl7:"26,len=3,table=0904,datatype=ID,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values."`
 }
 
 // Parent Result Link
@@ -937,8 +937,8 @@ type PRL struct {
 // This data type indicates whether to process a message as defined in HL7 Application (level 7) Processing rules.
 type PT struct {
 	HL7            HL7Name `hl7:",name=PT,len=0,type=d"`
-	ProcessingID   ID      `hl7:"1,required,len=1,table=0103,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
-	ProcessingMode ID      `hl7:"2,len=1,table=0207,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing Mode for valid values."`
+	ProcessingID   ID      `hl7:"1,required,len=1,table=0103,datatype=ID,display=A value that defines whether the message is part of a production- training- or debugging system. Refer to HL7 Table 0103 - Processing ID for valid values."`
+	ProcessingMode ID      `hl7:"2,len=1,table=0207,datatype=ID,display=A value that defines whether the message is part of an archival process or an initial load. Refer to HL7 Table 0207 - Processing Mode for valid values."`
 }
 
 // Policy Type And Amount
@@ -964,8 +964,8 @@ type PTA struct {
 type RCD struct {
 	HL7                HL7Name `hl7:",name=RCD,len=0,type=d"`
 	SegmentFieldName   ST      `hl7:"1,len=12,display=This component contains the segment field name.  Naming conventions: Segment field names are designated by the “@” symbol concatenated with the HL7 segment ID followed by the sequence number for the field separated by a period. See sections 2.5.2- 'Segments and segment groups' and 2.5.3.1- 'Position (sequence within the segment)' for a definition of segment ID and sequence number. If the field is divided into components- the designation may be suffixed with '.nn'- to identify a particular component (a suffix of “.3” indicates the third component of the field); otherwise- the whole field is assumed. If the field is further divided into subcomponents- the designation is suffixed with “.nn.mm'- which identifies the component and subcomponent requested by relative position.  Site-specific segment field names may be used. In this case- the site-specific segment ID (if the field is not being added to an existing HL7 segment) and the sequence number must be defined so that they do not conflict with existing HL7 segment IDs and field sequence numbers. Values for this field are defined in the function-specific chapters of this specification.  Note: If the “@” is being used as one of the delimiter characters defined in MSH-2-encoding characters- it must be “escaped.”  See Section 2.7.1- 'Formatting Codes'."`
-	Hl7DataType        ID      `hl7:"2,len=3,table=0440,display=The two or three character HL7 data type. Refer to HL7 Table 0440 – Data Types for valid values."`
-	MaximumColumnWidth NM      `hl7:"3,len=5,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
+	Hl7DataType        ID      `hl7:"2,len=3,table=0440,datatype=ID,display=The two or three character HL7 data type. Refer to HL7 Table 0440 – Data Types for valid values."`
+	MaximumColumnWidth NM      `hl7:"3,len=5,datatype=NM,display=The maximum width of the column- as dictated by the responding system. This may vary from the HL7-defined maximum field length."`
 }
 
 // Reference Range
@@ -1054,14 +1054,14 @@ type RMC struct {
 type RPT struct {
 	HL7                        HL7Name `hl7:",name=RPT,len=0,type=d"`
 	RepeatPatternCode          CWE     `hl7:"1,required,table=0335,display=A code representing the repeat pattern defined by the other components of this data type. Refer to User-defined Table 0335 – Repeat Pattern for suggested values."`
-	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from “the 5th of every month”). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
-	PhaseRangeBeginValue       NM      `hl7:"3,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week.   If Phase Range Begin Value is populated- but Phase Range End Value is not populated- then this component defines when the period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the earliest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
-	PhaseRangeEndValue         NM      `hl7:"4,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the end of the phase.   If Phase Range End Value is populated- but Phase Range Begin Value is not populated- then this component defines when the timing period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the latest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
-	PeriodQuantity             NM      `hl7:"5,display=A time duration specifying the frequency at which the periodic interval repeats. RPT.6 - Period Units defines the units of time for this component."`
+	CalendarAlignment          ID      `hl7:"2,len=2,table=0527,datatype=ID,display=Specifies an alignment of the repetition to a calendar (e.g.- to distinguish every 30 days from “the 5th of every month”). Refer to HL7 Table 0527 - Calendar Alignment for valid values."`
+	PhaseRangeBeginValue       NM      `hl7:"3,datatype=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the beginning of the phase. If Calendar Alignment is DW (days of week)- then this would be the offset from the beginning of the week.   If Phase Range Begin Value is populated- but Phase Range End Value is not populated- then this component defines when the period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the earliest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
+	PhaseRangeEndValue         NM      `hl7:"4,datatype=NM,display=Used for Calendar aligned repeat patterns to determine the amount of time from the beginning of particular RPT.2 - Calendar Alignment to the end of the phase.   If Phase Range End Value is populated- but Phase Range Begin Value is not populated- then this component defines when the timing period (RPT.5- 6) begins.  If both Phase Range Begin Value and Phase Range End Value are populated- then this component defines the latest point in time at which the period (RPT.5- 6) will begin.  The units of measure for this component are derived from the Calendar Alignment value in RPT.2. See HL7 Table 0527 - Calendar Alignment for the units of measure associated with a particular calendar alignment."`
+	PeriodQuantity             NM      `hl7:"5,datatype=NM,display=A time duration specifying the frequency at which the periodic interval repeats. RPT.6 - Period Units defines the units of time for this component."`
 	PeriodUnits                *CWE    `hl7:"6,conditional,display=Defines the units used for RPT.5 - Period Quantity. Constrained to units of time.  Best practice is to use UCUM. Refer to External table the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM] for valid values.   Condition Rule: This component is required if RPT.5 - Period Quantity is populated."`
-	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish “every 8 hours” from “3 times a day”).  Refer to HL7 Table 0136 - Yes/No Indicator for valid values.   - Y - exact timing up to party executing schedule.   - N - exact timing as specified."`
-	Event                      ID      `hl7:"8,len=3,table=0528,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528 - Event-Related Period for valid values."`
-	EventOffsetQuantity        NM      `hl7:"9,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT.8. A negative numeric value indicates the amount of time prior to the event in RPT.8. RPT.10 - Event Offset Units defines the units of time for this component."`
+	InstitutionSpecifiedTime   ID      `hl7:"7,len=1,table=0136,datatype=ID,display=A code that indicates whether the exact timing is up to the party executing the schedule (e.g.- to distinguish “every 8 hours” from “3 times a day”).  Refer to HL7 Table 0136 - Yes/No Indicator for valid values.   - Y - exact timing up to party executing schedule.   - N - exact timing as specified."`
+	Event                      ID      `hl7:"8,len=3,table=0528,datatype=ID,display=A code for a common (periodical) activity of daily living. Refer to HL7 Table 0528 - Event-Related Period for valid values."`
+	EventOffsetQuantity        NM      `hl7:"9,datatype=NM,display=An interval that marks the offsets for the beginning- width and end of the event-related periodic interval measured from the time each such event actually occurred. A positive numeric value indicates the amount of time after the event in RPT.8. A negative numeric value indicates the amount of time prior to the event in RPT.8. RPT.10 - Event Offset Units defines the units of time for this component."`
 	EventOffsetUnits           *CWE    `hl7:"10,conditional,display=Defines the units used for RPT-9 (Event Offset Quantity). Constrained to units of time. The codes for unit of measure are specified in the Unified Code for Units of Measure (UCUM) [http://aurora.rg.iupui.edu/UCUM].  Condition Rule: This component is required if RPT.9 - Event Offset Quantity is populated."`
 	GeneralTimingSpecification GTS     `hl7:"11,display=The General Timing Specification as defined by the Version 3 Data Types document."`
 }
@@ -1110,9 +1110,9 @@ type SI = string
 type SN struct {
 	HL7             HL7Name `hl7:",name=SN,len=0,type=d"`
 	Comparator      ST      `hl7:"1,len=2,display=Defined as greater than- less than- greater than or equal- less than or equal- equal- and not equal- respectively (:  '>' or '<' or '>:' or '<:' or ':' or '<>'."`
-	Num1            NM      `hl7:"2,display=A number. "`
+	Num1            NM      `hl7:"2,datatype=NM,display=A number. "`
 	SeparatorSuffix ST      `hl7:"3,len=1,display='-' or '+' or '/' or '.' or ':' Examples: Greater than 100 |>^100|  Equal to range of 100 through 200 |^100^-^200|  Ratio of 1 to 128- e.g.- the results of a serological test |^1^:^228|  Categorical response- e.g.- occult blood positivity |^2^+|"`
-	Num2            NM      `hl7:"4,display=A number or null depending on the measurement."`
+	Num2            NM      `hl7:"4,datatype=NM,display=A number or null depending on the measurement."`
 }
 
 // String Of Telephone Number Digits
@@ -1134,7 +1134,7 @@ type SPD struct {
 	HL7                 HL7Name `hl7:",name=SPD,len=0,type=d"`
 	SpecialtyName       ST      `hl7:"1,required,display=Identifies the provider’s specialty."`
 	GoverningBoard      ST      `hl7:"2,display=Identifies the governing body providing for the specialty."`
-	EligibleOrCertified ID      `hl7:"3,len=1,table=0337,display=Specifies the certification status. Refer to HL7 Table 0337 – Certification Status for valid values."`
+	EligibleOrCertified ID      `hl7:"3,len=1,table=0337,datatype=ID,display=Specifies the certification status. Refer to HL7 Table 0337 – Certification Status for valid values."`
 	DateOfCertification DT      `hl7:"4,format=YMD,display=Specifies when certification occurred."`
 }
 
@@ -1151,7 +1151,7 @@ type SPD struct {
 type SRT struct {
 	HL7         HL7Name `hl7:",name=SRT,len=0,type=d"`
 	SortByField ST      `hl7:"1,required,len=12,display=Identifies the field by which the response will be sorted. In a tabular response- this will be the column name to sort by. In the Segment Pattern and the Display Response- this will be the segment field name to sort by. See QIP in Section 2.A.59.1- 'Segment Field Name (ST)' for segment field name definition."`
-	Sequencing  ID      `hl7:"2,len=2,table=0397,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 – Sequencing  for valid values"`
+	Sequencing  ID      `hl7:"2,len=2,table=0397,datatype=ID,display=Identifies how the field or parameter will be sorted; and- if sorted- whether the sort will be case sensitive (the default) or not. Refer to HL7 Table 0397 – Sequencing  for valid values"`
 }
 
 // String Data
@@ -1256,7 +1256,7 @@ type UVC struct {
 	HL7                            HL7Name `hl7:",name=UVC,len=0,type=d"`
 	ValueCode                      CWE     `hl7:"1,required,table=0153,display=Specifies the National Uniform Billing Committee (NUBC) code itself.  Refer to External Table 0153 – Value Code for valid values. In the United States- values for this component need to come from National Uniform Billing Committee (NUBC); no extensions are allowed.    Other realms should determine the precise set appropriate for their realm."`
 	ValueAmount                    *MO     `hl7:"2,display=Specifies the numeric amount when needed to pair with the value when it is monetary."`
-	NonMonetaryValueAmountQuantity NM      `hl7:"3,display=Specifies the numeric amount when needed to pair with the value when it is non-monetary. "`
+	NonMonetaryValueAmountQuantity NM      `hl7:"3,datatype=NM,display=Specifies the numeric amount when needed to pair with the value when it is non-monetary. "`
 	NonMonetaryValueAmountUnits    *CWE    `hl7:"4,display=This component species the units in which the  non-monetary quantity is expressed. Refer to the Unified Code for Units of Measure (UCUM) for valid values [http://aurora.rg.iupui.edu/UCUM]."`
 }
 
@@ -1266,8 +1266,8 @@ type UVC struct {
 // valid values for the first two components.
 type VH struct {
 	HL7            HL7Name `hl7:",name=VH,len=0,type=d"`
-	StartDayRange  ID      `hl7:"1,len=3,table=0267,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the Week for valid values."`
-	EndDayRange    ID      `hl7:"2,len=3,table=0267,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the Week for valid values."`
+	StartDayRange  ID      `hl7:"1,len=3,table=0267,datatype=ID,display=Starting day of visiting hours range. See HL7 Table 0267 - Days of the Week for valid values."`
+	EndDayRange    ID      `hl7:"2,len=3,table=0267,datatype=ID,display=Ending day of visiting hours range. Starting day of visiting hours range. See HL7 Table 0267 - Days of the Week for valid values."`
 	StartHourRange TM      `hl7:"3,format=HM,display=Starting hour on starting day of visiting hours range. See first component- 2.A.81.1- 'Start Day Range (ID)'."`
 	EndHourRange   TM      `hl7:"4,format=HM,display=Ending hour on ending day of visiting hours range.  See second component- 2.A.81.2- 'End Day Range (ID)'."`
 }
@@ -1275,7 +1275,7 @@ type VH struct {
 // Version Identifier
 type VID struct {
 	HL7                      HL7Name `hl7:",name=VID,len=0,type=d"`
-	VersionID                ID      `hl7:"1,required,len=5,table=0104,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID in Chapter 2C for valid values."`
+	VersionID                ID      `hl7:"1,required,len=5,table=0104,datatype=ID,display=Used to identify the HL7 version. Refer to HL7 Table 0104 - Version ID in Chapter 2C for valid values."`
 	InternationalizationCode *CWE    `hl7:"2,table=0399,display=Used to identify the international affiliate country code. The values to be used are those of ISO 3166 -1:1977. The ISO 3166 table has three separate forms of the country code: HL7 specifies that the 3-character (alphabetic) form be used for the country code. Refer to HL7 Table 0399 - Country Code in Chapter 2C for the 3-character codes as defined by ISO 3166 table."`
 	InternationalVersionID   *CWE    `hl7:"3,display=This field component identifies international affiliate’s version; it is especially important when the international affiliate has more than a single local version associated with a single US version."`
 }
@@ -1300,22 +1300,22 @@ type XAD struct {
 	City                       ST      `hl7:"3,table=City,display=This component specifies the city- or district or place where the addressee is located depending upon the national convention for formatting addresses for postal usage."`
 	StateOrProvince            ST      `hl7:"4,table=State,display=This component specifies the state or province where the addressee is located. State or province should be represented by the official postal service codes for that country."`
 	ZipOrPostalCode            ST      `hl7:"5,table=ZipCode,display=This component specifies the zip or postal code where the addressee is located. Zip or postal codes should be represented by the official codes for that country. In the US- the zip code takes the form 99999[-9999]- while the Canadian postal code takes the form A9A9A9- and the Australian Postcode takes the form 9999."`
-	Country                    ID      `hl7:"6,len=3,table=0399,display=This component specifies the country where the addressee is located.  HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 – Country Code for valid values."`
-	AddressType                ID      `hl7:"7,conditional,len=3,table=0190,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values. XAD.7 is required if there are multiple occurrences of XAD in a field."`
+	Country                    ID      `hl7:"6,len=3,table=0399,datatype=ID,display=This component specifies the country where the addressee is located.  HL7 specifies that the 3-character (alphabetic) form of ISO 3166 be used for the country code. Refer to HL7 Table 0399 – Country Code for valid values."`
+	AddressType                ID      `hl7:"7,conditional,len=3,table=0190,datatype=ID,display=This component specifies the kind or type of address. Refer to HL7 Table 0190 - Address Type for valid values. XAD.7 is required if there are multiple occurrences of XAD in a field."`
 	OtherGeographicDesignation ST      `hl7:"8,display=This component specifies any other geographic designation. It includes county- bioregion- SMSA- etc."`
 	CountyParishCode           *CWE    `hl7:"9,table=0289,display=A code that represents the county in which the specified address resides. User-defined Table 0289 - County/parish is used as the HL7 identifier for the user-defined table of values for this component. When this component is used to represent the county (or parish)- component 8 <other geographic designation> should not duplicate it (i.e.- the use of <other geographic designation> to represent the county is allowed only for the purpose of backward compatibility- and should be discouraged in this and future versions of HL7).  Allowable values: codes defined by government."`
 	CensusTract                *CWE    `hl7:"10,table=0288,display=A code that represents the census tract in which the specified address resides. User-defined Table 0288 - Census Tract is used as the HL7 identifier for the user-defined table of values for this component.  Allowable Values: codes defined by government."`
-	AddressRepresentationCode  ID      `hl7:"11,len=1,table=0465,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: Also note that this new component remains in 'alphabetic' representation with each repetition of the fields using these data types. I.e. even though the address may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
+	AddressRepresentationCode  ID      `hl7:"11,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same name/address should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: Also note that this new component remains in 'alphabetic' representation with each repetition of the fields using these data types. I.e. even though the address may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
 	AddressValidityRange       ST      `hl7:"12,display=Attention: The XAD.12 component was retained for backward compatibility as of v2.5 and the detail was withdrawn and removed from the standard as of v2.7. Refer to 2.A.86.13- 'Effective Date (DTM)' and 2.A.86.14- 'Expiration Date (DTM)' components."`
 	EffectiveDate              DTM     `hl7:"13,format=YMDHM,display=The first date- if known- on which the address is valid and active for the ‘owning’ entity."`
 	ExpirationDate             DTM     `hl7:"14,format=YMDHM,display=The last date- if known- on which the address is valid and active for the ‘owning’ entity."`
 	ExpirationReason           *CWE    `hl7:"15,table=0616,display=This identifies why this address was marked as 'ended'.   Refer to User-defined Table 0616 – Address Expiration Reason for valid values."`
-	TemporaryIndicator         ID      `hl7:"16,len=1,table=0136,display=If 'Y' this indicates that the address is considered temporary.  Refer to HL7 Table 0136 – Yes/No Indicator."`
-	BadAddressIndicator        ID      `hl7:"17,len=1,table=0136,display=If 'Y'- this indicates that it is not possible to contact the 'owning' entity at this address. Refer to HL7 Table 0136 – Yes/No Indicator."`
-	AddressUsage               ID      `hl7:"18,len=1,table=0617,display=This indicates how the address is intended to be used.  Refer to User-defined Table 0617 – Address Usage for valid values."`
+	TemporaryIndicator         ID      `hl7:"16,len=1,table=0136,datatype=ID,display=If 'Y' this indicates that the address is considered temporary.  Refer to HL7 Table 0136 – Yes/No Indicator."`
+	BadAddressIndicator        ID      `hl7:"17,len=1,table=0136,datatype=ID,display=If 'Y'- this indicates that it is not possible to contact the 'owning' entity at this address. Refer to HL7 Table 0136 – Yes/No Indicator."`
+	AddressUsage               ID      `hl7:"18,len=1,table=0617,datatype=ID,display=This indicates how the address is intended to be used.  Refer to User-defined Table 0617 – Address Usage for valid values."`
 	Addressee                  ST      `hl7:"19,display=Identifies the name of the person or organization that should appear as part of the address. This is also known as the 'Care of' or 'C/O' line."`
 	Comment                    ST      `hl7:"20,display=Descriptive information about the use of the address.  Example: 'Do not ring bell.'"`
-	PreferenceOrder            NM      `hl7:"21,display=For an entity having multiple addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g.- first try my home address- then my business address). Otherwise- the preference order is assumed to only apply within a specific usage.  Preference order numbers need not be sequential (i.e.- four addresses with the priority orders of 10- 1- 5 and 15 are legitimate). The preference order numbers must be non-negative."`
+	PreferenceOrder            NM      `hl7:"21,datatype=NM,display=For an entity having multiple addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g.- first try my home address- then my business address). Otherwise- the preference order is assumed to only apply within a specific usage.  Preference order numbers need not be sequential (i.e.- four addresses with the priority orders of 10- 1- 5 and 15 are legitimate). The preference order numbers must be non-negative."`
 	ProtectionCode             *CWE    `hl7:"22,table=0618,display=Identifies that an address needs to be treated with special care or sensitivity.  The specific set of codes and appropriate handling of such addresses will vary by jurisdiction and is subject to site-specific negotiation. For example- a provider address marked as 'unlisted' might not be printed in an internal address listing.  Refer to User-defined Table 0618 – Protection Code for valid values."`
 	AddressIdentifier          *EI     `hl7:"23,display=A unique identifier for the address.  This component allows an address to be uniquely identified- and enables the linking of a single address to multiple people and contexts. For example- each member of a family living at the same location would share the same address- having the same identifier. A correction to the address of one family member could be automatically propagated to other members of the family."`
 }
@@ -1354,22 +1354,22 @@ type XCN struct {
 	Degree                                      ST      `hl7:"7,display=Attention: The XCN-7 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v27.. Refer to XCN.21 Professional Suffix."`
 	SourceTable                                 CWE     `hl7:"8,table=0297,display=Attention: Retained for backwards compatibility only as of v2.7. The reader is referred to XCN.9 instead.  User-defined Table 0297 – CN ID source is used as the HL7 identifier for the user-defined table of values for this component. Used to delineate the first component."`
 	AssigningAuthority                          *HD     `hl7:"9,conditional,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency of department) that creates the data. User-defined Table 0363 – Assigning Authority is used as the HL7 identifier for the user-defined table of values for the first sub-component of the HD component- <namespace ID>.  As of v2.7- the Assigning Authority is conditional. It is required if XCN.1 is populated and neither XCN.22 nor XCN.23 are populated. All 3 components may be populated. No assumptions can be safely made based on position or sequence. Best practice is to send an OID in this component when populated.  The reader is referred to XCN.22 and XCN.23 if there is a need to transmit values with semantic meaning for an assigning jurisdiction or assigning department or agency in addition to- or instead of- an assigning authority. However- all 3 components may be valued.  If- in so doing- it is discovered that the values in XCN.22 and/or XCN.23 conflict with XCN.9- the user would look to the Message Profile or other implementation agreement for a statement as to which takes precedence. Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.  By site agreement- implementors may continue to use User-defined Table 0300 – Namespace ID for the first sub-component."`
-	NameTypeCode                                ID      `hl7:"10,conditional,len=5,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values. See Section 2.A.89.7- 'Name Type Code (ID)'.  As of v2.7- XCN.10 is conditional. It is required if XCN.2 is populated."`
+	NameTypeCode                                ID      `hl7:"10,conditional,len=5,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values. See Section 2.A.89.7- 'Name Type Code (ID)'.  As of v2.7- XCN.10 is conditional. It is required if XCN.2 is populated."`
 	IdentifierCheckDigit                        ST      `hl7:"11,len=4,display=This component contains the digit- or digits- exclusive of the identifier in XCN.1- calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier."`
-	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,display=Contains the code identifying the check digit scheme employed.  Refer to HL7 Table 0061 - Check Digit Scheme for valid values."`
-	IdentifierTypeCode                          ID      `hl7:"13,conditional,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier Type for suggested values.  As of v2.7- XCN.13 is conditional. It is required if XCN.1 is populated."`
+	CheckDigitScheme                            ID      `hl7:"12,conditional,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed.  Refer to HL7 Table 0061 - Check Digit Scheme for valid values."`
+	IdentifierTypeCode                          ID      `hl7:"13,conditional,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the <assigning authority> component. Refer to HL7 Table 0203 - Identifier Type for suggested values.  As of v2.7- XCN.13 is conditional. It is required if XCN.1 is populated."`
 	AssigningFacility                           *HD     `hl7:"14,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems.  Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment."`
-	NameRepresentationCode                      ID      `hl7:"15,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in “alphabetic” representation with each repetition of the field using these data types. I.e.- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
+	NameRepresentationCode                      ID      `hl7:"15,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in “alphabetic” representation with each repetition of the field using these data types. I.e.- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
 	NameContext                                 *CWE    `hl7:"16,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare for indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to User-defined Table 0448 – Name Context for suggested values."`
 	NameValidityRange                           ST      `hl7:"17,display=Attention: The XCN.17 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v 2.7. Refer to XCN.19 Effective Date and XCN.20 Expiration Date instead.  This component contains the start and end date/times that define the period during which this name was valid."`
-	NameAssemblyOrder                           ID      `hl7:"18,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name Assembly Order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"18,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 Table 0444 - Name Assembly Order for valid values."`
 	EffectiveDate                               DTM     `hl7:"19,format=YMDHM,display=The first date- if known- on which the person name is valid and active."`
 	ExpirationDate                              DTM     `hl7:"20,format=YMDHM,display=The last date- if known- on which the person name is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"21,display=Used to specify an abbreviation- or a string of abbreviations denoting qualifications that support the person’s profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- 'Personnel Management'."`
 	AssigningJurisdiction                       *CWE    `hl7:"22,conditional,display=The geo-political body that assigned the identifier in XCN.1.   See section- 2.A.14.9- 'Assigning Jurisdiction (CWE)' for further detail.  As of v2.7- the Assigning Jurisdiction is conditional. It is required if XCN.1 is populated and neither XCN.9 nor XCN.23 are populated. All 3 components may be populated. The reader is referred to XCN.9 if there is a need to transmit this information as an OID."`
 	AssigningAgencyOrDepartment                 *CWE    `hl7:"23,conditional,display=The agency or department that assigned the identifier in XCN.1.  See section 2.A.14.10- 'Assigning Agency or Department (CWE)' for further details.  As of v2.7- the Assigning Jurisdiction is conditional. It is required if XCN.1 is populated and neither XCN.9 nor XCN.22 are populated. All 3 components may be populated. The reader is referred to XCN.9 if there is a need to transmit this information as an OID."`
 	SecurityCheck                               ST      `hl7:"24,display=This component is used to communicate a version code that may be assigned to the value given in XCN.1 Person Identifier."`
-	SecurityCheckScheme                         ID      `hl7:"25,len=3,table=0904,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values"`
+	SecurityCheckScheme                         ID      `hl7:"25,len=3,table=0904,datatype=ID,display=This component is used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card.  Refer to HL7 Table 0904 - Security Check Scheme for valid values"`
 }
 
 // Extended Composite Name And Identification Number For Organizations
@@ -1391,12 +1391,12 @@ type XON struct {
 	OrganizationName         ST      `hl7:"1,display=The name of the specified organization."`
 	OrganizationNameTypeCode *CWE    `hl7:"2,table=0204,display=A code that represents the type of name i.e.- legal name- display name. Refer to User-defined Table 0204 - Organizational Name Type for suggested values."`
 	IDNumber                 ST      `hl7:"3,display=Attention: The XON.3 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v 2.7. Refer to XON.10 Organization Identifier."`
-	IdentifierCheckDigit     NM      `hl7:"4,display=This component contains the digit- or digits- exclusive of the identifier in XON.1- usually calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier.  This component may also be used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card."`
-	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,display=Contains the code identifying the check digit scheme employed.  The check digit scheme codes are defined in HL7 Table 0061 - Check Digit Scheme."`
+	IdentifierCheckDigit     NM      `hl7:"4,datatype=NM,display=This component contains the digit- or digits- exclusive of the identifier in XON.1- usually calculated by applying an algorithm to all or some of the digits in the number- which may be used to test validity of the non-check-digit identifier.  This component may also be used to transmit information intended to validate the veracity of the supplied identifier or the presenter of the identifier. For example- this component may be used to ensure that the presenter of a credit card is an authorized user of that card."`
+	CheckDigitScheme         ID      `hl7:"5,len=3,table=0061,datatype=ID,display=Contains the code identifying the check digit scheme employed.  The check digit scheme codes are defined in HL7 Table 0061 - Check Digit Scheme."`
 	AssigningAuthority       *HD     `hl7:"6,table=0363,display=The assigning authority is a unique identifier of the system (or organization or agency or department) that creates the data. Assigning authorities are unique across a given HL7 implementation. Refer to User-defined Table 0363 - Assigning Authority for suggested values.   Note: When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment.  By site agreement- implementers may continue to use User-defined Table 0300 – Namespace ID for the first sub-component."`
-	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier Type for suggested values."`
+	IdentifierTypeCode       ID      `hl7:"7,len=5,table=0203,datatype=ID,display=A code corresponding to the type of identifier. In some cases- this code may be used as a qualifier to the 'Assigning authority' component. Refer to HL7 Table 0203 - Identifier Type for suggested values."`
 	AssigningFacility        *HD     `hl7:"8,display=The place or location identifier where the identifier was first assigned to the person. This component is not an inherent part of the identifier but rather part of the history of the identifier: as part of this data type- its existence is a convenience for certain intercommunicating systems.  Note:When the HD data type is used in a given segment as a component of a field of another data type- User-defined Table 0300 - Namespace ID (referenced by the first sub-component of the HD component) may be re-defined (given a different user-defined table number and name) by the technical committee responsible for that segment"`
-	NameRepresentationCode   ID      `hl7:"9,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in “alphabetic” representation with each repetition of the field using these data types- i.e. even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation Code for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
+	NameRepresentationCode   ID      `hl7:"9,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in “alphabetic” representation with each repetition of the field using these data types- i.e. even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation Code for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
 	OrganizationIdentifier   ST      `hl7:"10,display=This component contains the sequence of characters (the code) that uniquely identifies the item being referenced by XON.1 Organization Name. This component replaces XON.3 ID Number as of v 2.5.  Note: The check digit and code identifying check digit scheme are null if Organization identifier is alphanumeric."`
 }
 
@@ -1420,11 +1420,11 @@ type XPN struct {
 	Suffix                                      ST      `hl7:"4,display=Used to specify a name suffix (e.g.- Jr. or III)."`
 	Prefix                                      ST      `hl7:"5,display=Used to specify a name prefix (e.g.- Dr.)."`
 	Degree                                      ST      `hl7:"6,display=Attention: The XPN-6 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v 2.7. See XPN.14 Professional Suffix."`
-	NameTypeCode                                ID      `hl7:"7,len=5,table=0200,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values.  Note:The content of Legal Name is country specific. In the US the legal name is the same as the current married name."`
-	NameRepresentationCode                      ID      `hl7:"8,len=1,table=0465,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in 'alphabetic' representation with each repetition of the field using these data types- i.e.- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
+	NameTypeCode                                ID      `hl7:"7,len=5,table=0200,datatype=ID,display=A code that represents the type of name. Refer to HL7 Table 0200 - Name Type for valid values.  Note:The content of Legal Name is country specific. In the US the legal name is the same as the current married name."`
+	NameRepresentationCode                      ID      `hl7:"8,len=1,table=0465,datatype=ID,display=Different <name/address types> and representations of the same <name/address> should be described by repeating of this field- with different values of the <name/address type> and/or <name/address representation> component.  Note: This new component remains in 'alphabetic' representation with each repetition of the field using these data types- i.e.- even though the name may be represented in an ideographic character set- this component will remain represented in an alphabetic character set. Refer to HL7 Table 0465 – Name/address Representation for valid values.  In general this component provides an indication of the representation provided by the data item. It does not necessarily specify the character sets used. Thus- even though the representation might provide an indication of what to expect- the sender is still free to encode the contents using whatever character set is desired. This component provides only hints for the receiver- so it can make choices regarding what it has been sent and what it is capable of displaying."`
 	NameContext                                 *CWE    `hl7:"9,table=0448,display=This component is used to designate the context in which a name is used. The main use case is in Australian healthcare for indigenous patients who prefer to use different names when attending different healthcare institutions. Another use case occurs in the US where health practitioners can be licensed under slightly different names and the reporting of the correct name is vital for administrative purposes. Refer to User-defined Table 0448 – Name Context for suggested values."`
 	NameValidityRange                           ST      `hl7:"10,display=Attention: The XPN.10 component was deprecated as of v2.5 and the detail was withdrawn and removed from the standard as of v2.7. Refer to 2.A.89.12- 'Effective date (DTM)' and 2.A.89.13- 'Expiration date (DTM)' components."`
-	NameAssemblyOrder                           ID      `hl7:"11,len=1,table=0444,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 – Name Assembly Order for valid values."`
+	NameAssemblyOrder                           ID      `hl7:"11,len=1,table=0444,datatype=ID,display=A code that represents the preferred display order of the components of this person name. Refer to HL7 0444 – Name Assembly Order for valid values."`
 	EffectiveDate                               DTM     `hl7:"12,format=YMDHM,display=The first date- if known- on which the person name is valid and active."`
 	ExpirationDate                              DTM     `hl7:"13,format=YMDHM,display=The last date- if known- on which the person name is valid and active."`
 	ProfessionalSuffix                          ST      `hl7:"14,display=Used to specify an abbreviation- or a string of abbreviations denoting qualifications that support the person’s profession- (e.g.- licenses- certificates- degrees- affiliations with professional societies- etc.). The Professional Suffix normally follows the Family Name when the Person Name is used for display purposes. Please note that this component is an unformatted string and is used for display purposes only. Detailed information regarding the contents of Professional Suffix is obtained using appropriate segments in Chapter 15- Personnel Management"`
@@ -1445,8 +1445,8 @@ type XPN struct {
 type XTN struct {
 	HL7                               HL7Name `hl7:",name=XTN,len=0,type=d"`
 	TelephoneNumber                   ST      `hl7:"1,table=PhoneNumber,display=Attention: The XTN-1 component was deprecated as of v2.3 and the detail was withdrawn and removed from the standard as of v2.6."`
-	TelecommunicationUseCode          ID      `hl7:"2,len=3,table=0201,display=A code that represents a specific use of a telecommunication number. Refer to HL7 Table 0201 - Telecommunication Use Code for valid values. This component along with XTN.3 describes the nature of the telecommunication data that follows. Best practice requires the population of XTN.2 when XTN.4 or XTN.7 is populated. "`
-	TelecommunicationEquipmentType    ID      `hl7:"3,required,len=8,table=0202,display=A code that represents the type of telecommunication equipment. Refer to HL7 Table 0202 - Telecommunication Equipment Type for valid values. This component along with XTN.2 describes the nature of the telecommunication data that follows and is necessary to accurately interpret it."`
+	TelecommunicationUseCode          ID      `hl7:"2,len=3,table=0201,datatype=ID,display=A code that represents a specific use of a telecommunication number. Refer to HL7 Table 0201 - Telecommunication Use Code for valid values. This component along with XTN.3 describes the nature of the telecommunication data that follows. Best practice requires the population of XTN.2 when XTN.4 or XTN.7 is populated. "`
+	TelecommunicationEquipmentType    ID      `hl7:"3,required,len=8,table=0202,datatype=ID,display=A code that represents the type of telecommunication equipment. Refer to HL7 Table 0202 - Telecommunication Equipment Type for valid values. This component along with XTN.2 describes the nature of the telecommunication data that follows and is necessary to accurately interpret it."`
 	CommunicationAddress              ST      `hl7:"4,conditional,display=This component is required if- and only allowed if- XTN.7 or XTN.12 are not populated.  Note: If any of the HL7 delimiters are found in the Communication Address- such as @- the appropriate HL7 escape sequence SHALL be used when encoding an Internet address (see Chapter 2- 'Control'- section 2.7.1- 'Formatting Codes')."`
 	CountryCode                       SNM     `hl7:"5,display=The numeric code assigned by the International Telecommunication Union in standard E.164 to access telephone services in another country.  For example- '+1' is the country code for the United States- '+49' is the code for Germany."`
 	AreaCityCode                      SNM     `hl7:"6,display=The numeric code to access telephone services in another area/city within a country. This number historically needs not be dialed if the caller is located in the same area/city as the phone number of the called party.  The use and size of area/city codes is however country specific: some countries do not use area/city codes- or the use of area/city codes may be mandatory when dialing- or area/city codes may be used to group phone numbers based on other criteria than geographic location- such as cell-phone area/city-codes- or free information numbers (e.g.- 800 numbers).  Most countries have a prefix for Area/City codes which only has to be dialed if one doesn't use a country code. This prefix (mostly '0'- sometimes '9') should be omitted from the content of this field. If the prefix for the Area/Code is also mandatory if one uses the country code (e.g.- in Italy) then this field should also contain the prefix."`
@@ -1461,7 +1461,7 @@ type XTN struct {
 	ExpirationReason                  *CWE    `hl7:"15,table=0868,display=Indicates why this contact number/e-mail was marked as 'ended'.  Refer to User-defined Table 0868 – Telecommunication Expiration Reason for valid values."`
 	ProtectionCode                    *CWE    `hl7:"16,table=0618,display=Indicates whether this information is considered sensitive or should be protected in some way. Refer to User-defined table 0618 - Protection Code for suggested values.  The specific set of codes and appropriate handling of such telecommunications addresses will vary by jurisdiction and is subject to site-specific negotiation. For example- a provider address marked as 'unlisted' might not be printed in an internal address listing."`
 	SharedTelecommunicationIdentifier *EI     `hl7:"17,display=A unique identifier for the phone number or e-mail address.  This component allows a phone number to be uniquely identified- and enables the linking of a single phone number to multiple people and contexts. For example- each member of a family living at the same location would share the same phone number- having the same identifier. A correction to the phone number of one family member could be automatically propagated to other members of the family.  HL7 does not assume responsibility for the processing rules for this component."`
-	PreferenceOrder                   NM      `hl7:"18,display=For an entity having multiple telecommunication addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g. first try my home number- then my business number). If the preference order is unique across all usages and types- then it indicates the preference across all types of telecommunication addresses.  (E.g.- first try my phone; if that doesn't work- use my pager.) Otherwise- the preference order is assumed to only apply within a specific type and usage.  Preference order numbers need not be sequential (i.e.- three numbers with the priority orders of 0- 5 and 15 are legitimate).  The preference order numbers must be non-negative."`
+	PreferenceOrder                   NM      `hl7:"18,datatype=NM,display=For an entity having multiple telecommunication addresses- indicates which is the 'most preferred' (lowest number) to 'least preferred' (highest number).  If the preference order is unique across all usages for a given type- then it indicates the preference across all usages- (e.g. first try my home number- then my business number). If the preference order is unique across all usages and types- then it indicates the preference across all types of telecommunication addresses.  (E.g.- first try my phone; if that doesn't work- use my pager.) Otherwise- the preference order is assumed to only apply within a specific type and usage.  Preference order numbers need not be sequential (i.e.- three numbers with the priority orders of 0- 5 and 15 are legitimate).  The preference order numbers must be non-negative."`
 }
 
 // Varies
@@ -0,0 +1,17052 @@
+// Code generated by "hl7fetch -pkgdir h271 -root ./genjson -version 2.7.1"; DO NOT EDIT.
+
+package h271
+
+// Each table has an enum type, such as Table0001, with a constant for each value.
+// Declare a field with the enum type to check the value against the table when decoded,
+// as with the hl7.Enum interface.
+
+// Table0001 is a value of table 0001, Administrative Sex.
+type Table0001 string
+
+const (
+	Table0001_A Table0001 = `A` // Ambiguous
+	Table0001_F Table0001 = `F` // Female
+	Table0001_M Table0001 = `M` // Male
+	Table0001_N Table0001 = `N` // Not applicable
+	Table0001_O Table0001 = `O` // Other
+	Table0001_U Table0001 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0001) TableID() string { return `0001` }
+
+// Valid reports if the value is in the table.
+func (v Table0001) Valid() bool { return TableValueLookup[`0001`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0001) Description() string { return tableDescription(`0001`, string(v)) }
+
+// Table0002 is a value of table 0002, Marital Status.
+type Table0002 string
+
+const (
+	Table0002_A Table0002 = `A` // Separated
+	Table0002_B Table0002 = `B` // Unmarried
+	Table0002_C Table0002 = `C` // Common law
+	Table0002_D Table0002 = `D` // Divorced
+	Table0002_E Table0002 = `E` // Legally Separated
+	Table0002_G Table0002 = `G` // Living together
+	Table0002_I Table0002 = `I` // Interlocutory
+	Table0002_M Table0002 = `M` // Married
+	Table0002_N Table0002 = `N` // Annulled
+	Table0002_O Table0002 = `O` // Other
+	Table0002_P Table0002 = `P` // Domestic partner
+	Table0002_R Table0002 = `R` // Registered domestic partner
+	Table0002_S Table0002 = `S` // Single
+	Table0002_T Table0002 = `T` // Unreported
+	Table0002_U Table0002 = `U` // Unknown
+	Table0002_W Table0002 = `W` // Widowed
+)
+
+// TableID returns the table ID.
+func (Table0002) TableID() string { return `0002` }
+
+// Valid reports if the value is in the table.
+func (v Table0002) Valid() bool { return TableValueLookup[`0002`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0002) Description() string { return tableDescription(`0002`, string(v)) }
+
+// Table0003 is a value of table 0003, Event Type.
+type Table0003 string
+
+const (
+	Table0003_A01 Table0003 = `A01` // ADT/ACK - Admit/visit notification
+	Table0003_A02 Table0003 = `A02` // ADT/ACK - Transfer a patient
+	Table0003_A03 Table0003 = `A03` // ADT/ACK - Discharge/end visit
+	Table0003_A04 Table0003 = `A04` // ADT/ACK - Register a patient
+	Table0003_A05 Table0003 = `A05` // ADT/ACK - Pre-admit a patient
+	Table0003_A06 Table0003 = `A06` // ADT/ACK - Change an outpatient to an inpatient
+	Table0003_A07 Table0003 = `A07` // ADT/ACK - Change an inpatient to an outpatient
+	Table0003_A08 Table0003 = `A08` // ADT/ACK - Update patient information
+	Table0003_A09 Table0003 = `A09` // ADT/ACK - Patient departing - tracking
+	Table0003_A10 Table0003 = `A10` // ADT/ACK - Patient arriving - tracking
+	Table0003_A11 Table0003 = `A11` // ADT/ACK - Cancel admit/visit notification
+	Table0003_A12 Table0003 = `A12` // ADT/ACK - Cancel transfer
+	Table0003_A13 Table0003 = `A13` // ADT/ACK - Cancel discharge/end visit
+	Table0003_A14 Table0003 = `A14` // ADT/ACK - Pending admit
+	Table0003_A15 Table0003 = `A15` // ADT/ACK - Pending transfer
+	Table0003_A16 Table0003 = `A16` // ADT/ACK - Pending discharge
+	Table0003_A17 Table0003 = `A17` // ADT/ACK - Swap patients
+	Table0003_A18 Table0003 = `A18` // ADT/ACK - Merge patient information
+	Table0003_A19 Table0003 = `A19` // QRY/ADR - Patient query
+	Table0003_A20 Table0003 = `A20` // ADT/ACK - Bed status update
+	Table0003_A21 Table0003 = `A21` // ADT/ACK - Patient goes on a "leave of absence"
+	Table0003_A22 Table0003 = `A22` // ADT/ACK - Patient returns from a "leave of absence"
+	Table0003_A23 Table0003 = `A23` // ADT/ACK - Delete a patient record
+	Table0003_A24 Table0003 = `A24` // ADT/ACK - Link patient information
+	Table0003_A25 Table0003 = `A25` // ADT/ACK - Cancel pending discharge
+	Table0003_A26 Table0003 = `A26` // ADT/ACK - Cancel pending transfer
+	Table0003_A27 Table0003 = `A27` // ADT/ACK - Cancel pending admit
+	Table0003_A28 Table0003 = `A28` // ADT/ACK - Add person information
+	Table0003_A29 Table0003 = `A29` // ADT/ACK - Delete person information
+	Table0003_A30 Table0003 = `A30` // ADT/ACK - Merge person information
+	Table0003_A31 Table0003 = `A31` // ADT/ACK - Update person information
+	Table0003_A32 Table0003 = `A32` // ADT/ACK - Cancel patient arriving - tracking
+	Table0003_A33 Table0003 = `A33` // ADT/ACK - Cancel patient departing - tracking
+	Table0003_A34 Table0003 = `A34` // ADT/ACK - Merge patient information - patient ID only
+	Table0003_A35 Table0003 = `A35` // ADT/ACK - Merge patient information - account number only
+	Table0003_A36 Table0003 = `A36` // ADT/ACK - Merge patient information - patient ID and account number
+	Table0003_A37 Table0003 = `A37` // ADT/ACK - Unlink patient information
+	Table0003_A38 Table0003 = `A38` // ADT/ACK - Cancel pre-admit
+	Table0003_A39 Table0003 = `A39` // ADT/ACK - Merge person - patient ID
+	Table0003_A40 Table0003 = `A40` // ADT/ACK - Merge patient - patient identifier list
+	Table0003_A41 Table0003 = `A41` // ADT/ACK - Merge account - patient account number
+	Table0003_A42 Table0003 = `A42` // ADT/ACK - Merge visit - visit number
+	Table0003_A43 Table0003 = `A43` // ADT/ACK - Move patient information - patient identifier list
+	Table0003_A44 Table0003 = `A44` // ADT/ACK - Move account information - patient account number
+	Table0003_A45 Table0003 = `A45` // ADT/ACK - Move visit information - visit number
+	Table0003_A46 Table0003 = `A46` // ADT/ACK - Change patient ID
+	Table0003_A47 Table0003 = `A47` // ADT/ACK - Change patient identifier list
+	Table0003_A48 Table0003 = `A48` // ADT/ACK - Change alternate patient ID
+	Table0003_A49 Table0003 = `A49` // ADT/ACK - Change patient account number
+	Table0003_A50 Table0003 = `A50` // ADT/ACK - Change visit number
+	Table0003_A51 Table0003 = `A51` // ADT/ACK - Change alternate visit ID
+	Table0003_A52 Table0003 = `A52` // ADT/ACK - Cancel leave of absence for a patient
+	Table0003_A53 Table0003 = `A53` // ADT/ACK - Cancel patient returns from a leave of absence
+	Table0003_A54 Table0003 = `A54` // ADT/ACK - Change attending doctor
+	Table0003_A55 Table0003 = `A55` // ADT/ACK - Cancel change attending doctor
+	Table0003_A60 Table0003 = `A60` // ADT/ACK - Update allergy information
+	Table0003_A61 Table0003 = `A61` // ADT/ACK - Change consulting doctor
+	Table0003_A62 Table0003 = `A62` // ADT/ACK - Cancel change consulting doctor
+	Table0003_B01 Table0003 = `B01` // PMU/ACK - Add personnel record
+	Table0003_B02 Table0003 = `B02` // PMU/ACK - Update personnel record
+	Table0003_B03 Table0003 = `B03` // PMU/ACK - Delete personnel re cord
+	Table0003_B04 Table0003 = `B04` // PMU/ACK - Active practicing person
+	Table0003_B05 Table0003 = `B05` // PMU/ACK - Deactivate practicing person
+	Table0003_B06 Table0003 = `B06` // PMU/ACK - Terminate practicing person
+	Table0003_B07 Table0003 = `B07` // PMU/ACK - Grant Certificate/Permission
+	Table0003_B08 Table0003 = `B08` // PMU/ACK - Revoke Certificate/Permission
+	Table0003_C01 Table0003 = `C01` // CRM - Register a patient on a clinical trial
+	Table0003_C02 Table0003 = `C02` // CRM - Cancel a patient registration on clinical trial (for clerical mistakes only)
+	Table0003_C03 Table0003 = `C03` // CRM - Correct/update registration information
+	Table0003_C04 Table0003 = `C04` // CRM - Patient has gone off a clinical trial
+	Table0003_C05 Table0003 = `C05` // CRM - Patient enters phase of clinical trial
+	Table0003_C06 Table0003 = `C06` // CRM - Cancel patient entering a phase (clerical mistake)
+	Table0003_C07 Table0003 = `C07` // CRM - Correct/update phase information
+	Table0003_C08 Table0003 = `C08` // CRM - Patient has gone off phase of clinical trial
+	Table0003_C09 Table0003 = `C09` // CSU - Automated time intervals for reporting, like monthly
+	Table0003_C10 Table0003 = `C10` // CSU - Patient completes the clinical trial
+	Table0003_C11 Table0003 = `C11` // CSU - Patient completes a phase of the clinical trial
+	Table0003_C12 Table0003 = `C12` // CSU - Update/correction of patient order/result information
+	Table0003_E01 Table0003 = `E01` // Submit HealthCare Services Invoice
+	Table0003_E02 Table0003 = `E02` // Cancel HealthCare Services Invoice
+	Table0003_E03 Table0003 = `E03` // HealthCare Services Invoice Status
+	Table0003_E04 Table0003 = `E04` // Re-Assess HealthCare Services Invoice Request
+	Table0003_E10 Table0003 = `E10` // Edit/Adjudication Results
+	Table0003_E12 Table0003 = `E12` // Request Additional Information
+	Table0003_E13 Table0003 = `E13` // Additional Information Response
+	Table0003_E15 Table0003 = `E15` // Payment/Remittance Advice
+	Table0003_E20 Table0003 = `E20` // Submit Authorization Request
+	Table0003_E21 Table0003 = `E21` // Cancel Authorization Request
+	Table0003_E22 Table0003 = `E22` // Authorization Request Status
+	Table0003_E24 Table0003 = `E24` // Authorization Response
+	Table0003_E30 Table0003 = `E30` // Submit Health Document related to Authorization Request
+	Table0003_E31 Table0003 = `E31` // Cancel Health Document related to Authorization Request
+	Table0003_I01 Table0003 = `I01` // RQI/RPI - Request for insurance information
+	Table0003_I02 Table0003 = `I02` // RQI/RPL - Request/receipt of patient selection display list
+	Table0003_I03 Table0003 = `I03` // RQI/RPR - Request/receipt of patient selection list
+	Table0003_I04 Table0003 = `I04` // RQD/RPI - Request for patient demographic data
+	Table0003_I05 Table0003 = `I05` // RQC/RCI - Request for patient clinical information
+	Table0003_I06 Table0003 = `I06` // RQC/RCL - Request/receipt of clinical data listing
+	Table0003_I07 Table0003 = `I07` // PIN/ACK - Unsolicited insurance information
+	Table0003_I08 Table0003 = `I08` // RQA/RPA - Request for treatment authorization information
+	Table0003_I09 Table0003 = `I09` // RQA/RPA - Request for modification to an authorization
+	Table0003_I10 Table0003 = `I10` // RQA/RPA - Request for resubmission of an authorization
+	Table0003_I11 Table0003 = `I11` // RQA/RPA - Request for cancellation of an authorization
+	Table0003_I12 Table0003 = `I12` // REF/RRI - Patient referral
+	Table0003_I13 Table0003 = `I13` // REF/RRI - Modify patient referral
+	Table0003_I14 Table0003 = `I14` // REF/RRI - Cancel patient referral
+	Table0003_I15 Table0003 = `I15` // REF/RRI - Request patient referral status
+	Table0003_I16 Table0003 = `I16` // Collaborative Care Referral
+	Table0003_I17 Table0003 = `I17` // Modify Collaborative Care Referral
+	Table0003_I18 Table0003 = `I18` // Cancel Collaborative Care Referral
+	Table0003_I19 Table0003 = `I19` // Collaborative Care Query/Collaborative Care Query Update
+	Table0003_I20 Table0003 = `I20` // Asynchronous Collaborative Care Update
+	Table0003_I21 Table0003 = `I21` // Collaborative Care Message
+	Table0003_I22 Table0003 = `I22` // Collaborative Care Fetch / Collaborative Care Information
+	Table0003_J01 Table0003 = `J01` // QCN/ACK - Cancel query/acknowledge message
+	Table0003_J02 Table0003 = `J02` // QSX/ACK - Cancel subscription/acknowledge message
+	Table0003_K11 Table0003 = `K11` // RSP - Segment pattern response in response to QBP^Q11
+	Table0003_K13 Table0003 = `K13` // RTB - Tabular response in response to QBP^Q13
+	Table0003_K15 Table0003 = `K15` // RDY - Display response in response to QBP^Q15
+	Table0003_K21 Table0003 = `K21` // RSP - Get person demographics response
+	Table0003_K22 Table0003 = `K22` // RSP - Find candidates response
+	Table0003_K23 Table0003 = `K23` // RSP - Get corresponding identifiers response
+	Table0003_K24 Table0003 = `K24` // RSP - Allocate identifiers response
+	Table0003_K25 Table0003 = `K25` // RSP - Personnel Information by Segment Response
+	Table0003_K31 Table0003 = `K31` // RSP -Dispense History Response
+	Table0003_K32 Table0003 = `K32` // Find Candidates including Visit Information Response
+	Table0003_M01 Table0003 = `M01` // MFN/MFK - Master file not otherwise specified
+	Table0003_M02 Table0003 = `M02` // MFN/MFK - Master file - staff practitioner
+	Table0003_M03 Table0003 = `M03` // MFN/MFK - Master file - test/observation
+	Table0003_M04 Table0003 = `M04` // MFN/MFK - Master files charge description
+	Table0003_M05 Table0003 = `M05` // MFN/MFK - Patient location master file
+	Table0003_M06 Table0003 = `M06` // MFN/MFK - Clinical study with phases and schedules master file
+	Table0003_M07 Table0003 = `M07` // MFN/MFK - Clinical study without phases but with schedules master file
+	Table0003_M08 Table0003 = `M08` // MFN/MFK - Test/observation (numeric) master file
+	Table0003_M09 Table0003 = `M09` // MFN/MFK - Test/observation (categorical) master file
+	Table0003_M10 Table0003 = `M10` // MFN/MFK - Test /observation batteries master file
+	Table0003_M11 Table0003 = `M11` // MFN/MFK - Test/calculated observations master file
+	Table0003_M12 Table0003 = `M12` // MFN/MFK - Master file notification message
+	Table0003_M13 Table0003 = `M13` // MFN/MFK - Master file notification - general
+	Table0003_M14 Table0003 = `M14` // MFN/MFK - Master file notification - site defined
+	Table0003_M15 Table0003 = `M15` // MFN/MFK - Inventory item master file notification
+	Table0003_M16 Table0003 = `M16` // MFN/MFK - Master File Notification Inventory Item Enhanced
+	Table0003_M17 Table0003 = `M17` // DRG Master File Message
+	Table0003_N01 Table0003 = `N01` // NMQ/NMR - Application management query message
+	Table0003_N02 Table0003 = `N02` // NMD/ACK - Application management data message (unsolicited)
+	Table0003_O01 Table0003 = `O01` // ORM - Order message (also RDE, RDS, RGV, RAS)
+	Table0003_O02 Table0003 = `O02` // ORR - Order response (also RRE, RRD, RRG, RRA)
+	Table0003_O03 Table0003 = `O03` // OMD - Diet order
+	Table0003_O04 Table0003 = `O04` // ORD - Diet order acknowledgment
+	Table0003_O05 Table0003 = `O05` // OMS - Stock requisition order
+	Table0003_O06 Table0003 = `O06` // ORS - Stock requisition acknowledgment
+	Table0003_O07 Table0003 = `O07` // OMN - Non-stock requisition order
+	Table0003_O08 Table0003 = `O08` // ORN - Non-stock requisition acknowledgment
+	Table0003_O09 Table0003 = `O09` // OMP - Pharmacy/treatment order
+	Table0003_O10 Table0003 = `O10` // ORP - Pharmacy/treatment order acknowledgment
+	Table0003_O11 Table0003 = `O11` // RDE - Pharmacy/treatment encoded order
+	Table0003_O12 Table0003 = `O12` // RRE - Pharmacy/treatment encoded order acknowledgment
+	Table0003_O13 Table0003 = `O13` // RDS - Pharmacy/treatment dispense
+	Table0003_O14 Table0003 = `O14` // RRD - Pharmacy/treatment dispense acknowledgment
+	Table0003_O15 Table0003 = `O15` // RGV - Pharmacy/treatment give
+	Table0003_O16 Table0003 = `O16` // RRG - Pharmacy/treatment give acknowledgment
+	Table0003_O17 Table0003 = `O17` // RAS - Pharmacy/treatment administration
+	Table0003_O18 Table0003 = `O18` // RRA - Pharmacy/treatment administration acknowledgment
+	Table0003_O19 Table0003 = `O19` // OMG - General clinical order
+	Table0003_O20 Table0003 = `O20` // ORG/ORL - General clinical order response
+	Table0003_O21 Table0003 = `O21` // OML - Laboratory order
+	Table0003_O22 Table0003 = `O22` // ORL - General laboratory order response message to any OML
+	Table0003_O23 Table0003 = `O23` // OMI - Imaging order
+	Table0003_O24 Table0003 = `O24` // ORI - Imaging order response message to any OMI
+	Table0003_O25 Table0003 = `O25` // RDE - Pharmacy/treatment refill authorization request
+	Table0003_O26 Table0003 = `O26` // RRE - Pharmacy/Treatment Refill Authorization Acknowledgement
+	Table0003_O27 Table0003 = `O27` // OMB - Blood product order
+	Table0003_O28 Table0003 = `O28` // ORB - Blood product order acknowledgment
+	Table0003_O29 Table0003 = `O29` // BPS - Blood product dispense status
+	Table0003_O30 Table0003 = `O30` // BRP - Blood product dispense status acknowledgment
+	Table0003_O31 Table0003 = `O31` // BTS - Blood product transfusion/disposition
+	Table0003_O32 Table0003 = `O32` // BRT - Blood product transfusion/disposition acknowledgment
+	Table0003_O33 Table0003 = `O33` // OML - Laboratory order for multiple orders related to a single specimen
+	Table0003_O34 Table0003 = `O34` // ORL - Laboratory order response message to a multiple order related to single specimen OML
+	Table0003_O35 Table0003 = `O35` // OML - Laboratory order for multiple orders related to a single container of a specimen
+	Table0003_O36 Table0003 = `O36` // ORL - Laboratory order response message to a single container of a specimen OML
+	Table0003_O37 Table0003 = `O37` // OPL - Population/Location-Based Laboratory Order Message
+	Table0003_O38 Table0003 = `O38` // OPR - Population/Location-Based Laboratory Order Acknowledgment Message
+	Table0003_O39 Table0003 = `O39` // Specimen shipment centric laboratory order
+	Table0003_O40 Table0003 = `O40` // Specimen Shipment Centric Laboratory Order Acknowledgment Message
+	Table0003_P01 Table0003 = `P01` // BAR/ACK - Add patient accounts
+	Table0003_P02 Table0003 = `P02` // BAR/ACK - Purge patient accounts
+	Table0003_P03 Table0003 = `P03` // DFT/ACK - Post detail financial transaction
+	Table0003_P04 Table0003 = `P04` // QRY/DSP - Generate bill and A/R statements
+	Table0003_P05 Table0003 = `P05` // BAR/ACK - Update account
+	Table0003_P06 Table0003 = `P06` // BAR/ACK - End account
+	Table0003_P07 Table0003 = `P07` // PEX - Unsolicited initial individual product experience report
+	Table0003_P08 Table0003 = `P08` // PEX - Unsolicited update individual product experience report
+	Table0003_P09 Table0003 = `P09` // SUR - Summary product experience report
+	Table0003_P10 Table0003 = `P10` // BAR/ACK -Transmit Ambulatory Payment Classification(APC)
+	Table0003_P11 Table0003 = `P11` // DFT/ACK - Post Detail Financial Transactions - New
+	Table0003_P12 Table0003 = `P12` // BAR/ACK - Update Diagnosis/Procedure
+	Table0003_PC1 Table0003 = `PC1` // PPR - PC/ problem add
+	Table0003_PC2 Table0003 = `PC2` // PPR - PC/ problem update
+	Table0003_PC3 Table0003 = `PC3` // PPR - PC/ problem delete
+	Table0003_PC4 Table0003 = `PC4` // QRY - PC/ problem query
+	Table0003_PC5 Table0003 = `PC5` // PRR - PC/ problem response
+	Table0003_PC6 Table0003 = `PC6` // PGL - PC/ goal add
+	Table0003_PC7 Table0003 = `PC7` // PGL - PC/ goal update
+	Table0003_PC8 Table0003 = `PC8` // PGL - PC/ goal delete
+	Table0003_PC9 Table0003 = `PC9` // QRY - PC/ goal query
+	Table0003_PCA Table0003 = `PCA` // PPV - PC/ goal response
+	Table0003_PCB Table0003 = `PCB` // PPP - PC/ pathway (problem-oriented) add
+	Table0003_PCC Table0003 = `PCC` // PPP - PC/ pathway (problem-oriented) update
+	Table0003_PCD Table0003 = `PCD` // PPP - PC/ pathway (problem-oriented) delete
+	Table0003_PCE Table0003 = `PCE` // QRY - PC/ pathway (problem-oriented) query
+	Table0003_PCF Table0003 = `PCF` // PTR - PC/ pathway (problem-oriented) query response
+	Table0003_PCG Table0003 = `PCG` // PPG - PC/ pathway (goal-oriented) add
+	Table0003_PCH Table0003 = `PCH` // PPG - PC/ pathway (goal-oriented) update
+	Table0003_PCJ Table0003 = `PCJ` // PPG - PC/ pathway (goal-oriented) delete
+	Table0003_PCK Table0003 = `PCK` // QRY - PC/ pathway (goal-oriented) query
+	Table0003_PCL Table0003 = `PCL` // PPT - PC/ pathway (goal-oriented) query response
+	Table0003_Q01 Table0003 = `Q01` // QRY/DSR - Query sent for immediate response
+	Table0003_Q02 Table0003 = `Q02` // QRY/QCK - Query sent for deferred response
+	Table0003_Q03 Table0003 = `Q03` // DSR/ACK - Deferred response to a query
+	Table0003_Q05 Table0003 = `Q05` // UDM/ACK - Unsolicited display update message
+	Table0003_Q06 Table0003 = `Q06` // OSQ/OSR - Query for order status
+	Table0003_Q11 Table0003 = `Q11` // QBP - Query by parameter requesting an RSP segment pattern response
+	Table0003_Q13 Table0003 = `Q13` // QBP - Query by parameter requesting an RTB - tabular response
+	Table0003_Q15 Table0003 = `Q15` // QBP - Query by parameter requesting an RDY display response
+	Table0003_Q16 Table0003 = `Q16` // QSB - Create subscription
+	Table0003_Q17 Table0003 = `Q17` // QVR - Query for previous events
+	Table0003_Q21 Table0003 = `Q21` // QBP - Get person demographics
+	Table0003_Q22 Table0003 = `Q22` // QBP - Find candidates
+	Table0003_Q23 Table0003 = `Q23` // QBP - Get corresponding identifiers
+	Table0003_Q24 Table0003 = `Q24` // QBP - Allocate identifiers
+	Table0003_Q25 Table0003 = `Q25` // QBP - Personnel Information by Segment Query
+	Table0003_Q26 Table0003 = `Q26` // ROR - Pharmacy/treatment order response
+	Table0003_Q27 Table0003 = `Q27` // RAR - Pharmacy/treatment administration information
+	Table0003_Q28 Table0003 = `Q28` // RDR - Pharmacy/treatment dispense information
+	Table0003_Q29 Table0003 = `Q29` // RER - Pharmacy/treatment encoded order information
+	Table0003_Q30 Table0003 = `Q30` // RGR - Pharmacy/treatment dose information
+	Table0003_Q31 Table0003 = `Q31` // QBP Query Dispense history
+	Table0003_Q32 Table0003 = `Q32` // Find Candidates including Visit Information
+	Table0003_R01 Table0003 = `R01` // ORU/ACK - Unsolicited transmission of an observation message
+	Table0003_R02 Table0003 = `R02` // QRY - Query for results of observation
+	Table0003_R04 Table0003 = `R04` // ORF - Response to query; transmission of requested observation
+	Table0003_R21 Table0003 = `R21` // OUL - Unsolicited laboratory observation
+	Table0003_R22 Table0003 = `R22` // OUL - Unsolicited Specimen Oriented Observation Message
+	Table0003_R23 Table0003 = `R23` // OUL - Unsolicited Specimen Container Oriented Observation Message
+	Table0003_R24 Table0003 = `R24` // OUL - Unsolicited Order Oriented Observation Message
+	Table0003_R25 Table0003 = `R25` // OPU - Unsolicited Population/Location-Based Laboratory Observation Message
+	Table0003_R26 Table0003 = `R26` // OSM - Unsolicited Specimen Shipment Manifest Message
+	Table0003_R30 Table0003 = `R30` // ORU - Unsolicited Point-Of-Care Observation Message Without Existing Order - Place An Order
+	Table0003_R31 Table0003 = `R31` // ORU - Unsolicited New Point-Of-Care Observation Message - Search For An Order
+	Table0003_R32 Table0003 = `R32` // ORU - Unsolicited Pre-Ordered Point-Of-Care Observation
+	Table0003_R33 Table0003 = `R33` // ORA - Observation Report Acknowledgement
+	Table0003_ROR Table0003 = `ROR` // ROR - Pharmacy prescription order query response
+	Table0003_S01 Table0003 = `S01` // SRM/SRR - Request new appointment booking
+	Table0003_S02 Table0003 = `S02` // SRM/SRR - Request appointment rescheduling
+	Table0003_S03 Table0003 = `S03` // SRM/SRR - Request appointment modification
+	Table0003_S04 Table0003 = `S04` // SRM/SRR - Request appointment cancellation
+	Table0003_S05 Table0003 = `S05` // SRM/SRR - Request appointment discontinuation
+	Table0003_S06 Table0003 = `S06` // SRM/SRR - Request appointment deletion
+	Table0003_S07 Table0003 = `S07` // SRM/SRR - Request addition of service/resource on appointment
+	Table0003_S08 Table0003 = `S08` // SRM/SRR - Request modification of service/resource on appointment
+	Table0003_S09 Table0003 = `S09` // SRM/SRR - Request cancellation of service/resource on appointment
+	Table0003_S10 Table0003 = `S10` // SRM/SRR - Request discontinuation of service/resource on appointment
+	Table0003_S11 Table0003 = `S11` // SRM/SRR - Request deletion of service/resource on appointment
+	Table0003_S12 Table0003 = `S12` // SIU/ACK - Notification of new appointment booking
+	Table0003_S13 Table0003 = `S13` // SIU/ACK - Notification of appointment rescheduling
+	Table0003_S14 Table0003 = `S14` // SIU/ACK - Notification of appointment modification
+	Table0003_S15 Table0003 = `S15` // SIU/ACK - Notification of appointment cancellation
+	Table0003_S16 Table0003 = `S16` // SIU/ACK - Notification of appointment discontinuation
+	Table0003_S17 Table0003 = `S17` // SIU/ACK - Notification of appointment deletion
+	Table0003_S18 Table0003 = `S18` // SIU/ACK - Notification of addition of service/resource on appointment
+	Table0003_S19 Table0003 = `S19` // SIU/ACK - Notification of modification of service/resource on appointment
+	Table0003_S20 Table0003 = `S20` // SIU/ACK - Notification of cancellation of service/resource on appointment
+	Table0003_S21 Table0003 = `S21` // SIU/ACK - Notification of discontinuation of service/resource on appointment
+	Table0003_S22 Table0003 = `S22` // SIU/ACK - Notification of deletion of service/resource on appointment
+	Table0003_S23 Table0003 = `S23` // SIU/ACK - Notification of blocked schedule time slot(s)
+	Table0003_S24 Table0003 = `S24` // SIU/ACK - Notification of opened ("unblocked") schedule time slot(s)
+	Table0003_S25 Table0003 = `S25` // SQM/SQR - Schedule query message and response
+	Table0003_S26 Table0003 = `S26` // SIU/ACK Notification that patient did not show up for schedule appointment
+	Table0003_S27 Table0003 = `S27` // SIU/ACK - Broadcast Notification of Scheduled Appointments
+	Table0003_S28 Table0003 = `S28` // SLR/SLS - Request new sterilization lot
+	Table0003_S29 Table0003 = `S29` // SLR/SLS - Request Sterilization lot deletion
+	Table0003_S30 Table0003 = `S30` // STI/STS - Request item
+	Table0003_S31 Table0003 = `S31` // SDR/SDS - Request anti-microbial device data
+	Table0003_S32 Table0003 = `S32` // SMD/SMS - Request anti-microbial device cycle data
+	Table0003_S33 Table0003 = `S33` // STC/ACK - Notification of sterilization configuration
+	Table0003_S34 Table0003 = `S34` // SLN/ACK - Notification of sterilization lot
+	Table0003_S35 Table0003 = `S35` // SLN/ACK - Notification of sterilization lot deletion
+	Table0003_S36 Table0003 = `S36` // SDN/ACK - Notification of anti-microbial device data
+	Table0003_S37 Table0003 = `S37` // SCN/ACK - Notification of anti-microbial device cycle data
+	Table0003_T01 Table0003 = `T01` // MDM/ACK - Original document notification
+	Table0003_T02 Table0003 = `T02` // MDM/ACK - Original document notification and content
+	Table0003_T03 Table0003 = `T03` // MDM/ACK - Document status change notification
+	Table0003_T04 Table0003 = `T04` // MDM/ACK - Document status change notification and content
+	Table0003_T05 Table0003 = `T05` // MDM/ACK - Document addendum notification
+	Table0003_T06 Table0003 = `T06` // MDM/ACK - Document addendum notification and content
+	Table0003_T07 Table0003 = `T07` // MDM/ACK - Document edit notification
+	Table0003_T08 Table0003 = `T08` // MDM/ACK - Document edit notification and content
+	Table0003_T09 Table0003 = `T09` // MDM/ACK - Document replacement notification
+	Table0003_T10 Table0003 = `T10` // MDM/ACK - Document replacement notification and content
+	Table0003_T11 Table0003 = `T11` // MDM/ACK - Document cancel notification
+	Table0003_T12 Table0003 = `T12` // QRY/DOC - Document query
+	Table0003_U01 Table0003 = `U01` // ESU/ACK - Automated equipment status update
+	Table0003_U02 Table0003 = `U02` // ESR/ACK - Automated equipment status request
+	Table0003_U03 Table0003 = `U03` // SSU/ACK - Specimen status update
+	Table0003_U04 Table0003 = `U04` // SSR/ACK - specimen status request
+	Table0003_U05 Table0003 = `U05` // INU/ACK - Automated equipment inventory update
+	Table0003_U06 Table0003 = `U06` // INR/ACK - Automated equipment inventory request
+	Table0003_U07 Table0003 = `U07` // EAC/ACK - Automated equipment command
+	Table0003_U08 Table0003 = `U08` // EAR/ACK - Automated equipment response
+	Table0003_U09 Table0003 = `U09` // EAN/ACK - Automated equipment notification
+	Table0003_U10 Table0003 = `U10` // TCU/ACK - Automated equipment test code settings update
+	Table0003_U11 Table0003 = `U11` // TCR/ACK - Automated equipment test code settings request
+	Table0003_U12 Table0003 = `U12` // LSU/ACK - Automated equipment log/service update
+	Table0003_U13 Table0003 = `U13` // LSR/ACK - Automated equipment log/service request
+	Table0003_V01 Table0003 = `V01` // VXQ - Query for vaccination record
+	Table0003_V02 Table0003 = `V02` // VXX - Response to vaccination query returning multiple PID matches
+	Table0003_V03 Table0003 = `V03` // VXR - Vaccination record response
+	Table0003_V04 Table0003 = `V04` // VXU - Unsolicited vaccination record update
+	Table0003_W01 Table0003 = `W01` // ORU - Waveform result, unsolicited transmission of requested information
+	Table0003_W02 Table0003 = `W02` // QRF - Waveform result, response to query
+)
+
+// TableID returns the table ID.
+func (Table0003) TableID() string { return `0003` }
+
+// Valid reports if the value is in the table.
+func (v Table0003) Valid() bool { return TableValueLookup[`0003`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0003) Description() string { return tableDescription(`0003`, string(v)) }
+
+// Table0004 is a value of table 0004, Patient Class.
+type Table0004 string
+
+const (
+	Table0004_B Table0004 = `B` // Obstetrics
+	Table0004_C Table0004 = `C` // Commercial Account
+	Table0004_E Table0004 = `E` // Emergency
+	Table0004_I Table0004 = `I` // Inpatient
+	Table0004_N Table0004 = `N` // Not Applicable
+	Table0004_O Table0004 = `O` // Outpatient
+	Table0004_P Table0004 = `P` // Preadmit
+	Table0004_R Table0004 = `R` // Recurring patient
+	Table0004_U Table0004 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0004) TableID() string { return `0004` }
+
+// Valid reports if the value is in the table.
+func (v Table0004) Valid() bool { return TableValueLookup[`0004`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0004) Description() string { return tableDescription(`0004`, string(v)) }
+
+// Table0005 is a value of table 0005, Race.
+type Table0005 string
+
+const (
+	Table0005_1002_5 Table0005 = `1002-5` // American Indian or Alaska Native
+	Table0005_2028_9 Table0005 = `2028-9` // Asian
+	Table0005_2054_5 Table0005 = `2054-5` // Black or African American
+	Table0005_2076_8 Table0005 = `2076-8` // Native Hawaiian or Other Pacific Islander
+	Table0005_2106_3 Table0005 = `2106-3` // White
+	Table0005_2131_1 Table0005 = `2131-1` // Other Race
+)
+
+// TableID returns the table ID.
+func (Table0005) TableID() string { return `0005` }
+
+// Valid reports if the value is in the table.
+func (v Table0005) Valid() bool { return TableValueLookup[`0005`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0005) Description() string { return tableDescription(`0005`, string(v)) }
+
+// Table0006 is a value of table 0006, Religion.
+type Table0006 string
+
+const (
+	Table0006_ABC Table0006 = `ABC` // Christian: American Baptist Church
+	Table0006_AGN Table0006 = `AGN` // Agnostic
+	Table0006_AME Table0006 = `AME` // Christian: African Methodist Episcopal Zion
+	Table0006_AMT Table0006 = `AMT` // Christian: African Methodist Episcopal
+	Table0006_ANG Table0006 = `ANG` // Christian: Anglican
+	Table0006_AOG Table0006 = `AOG` // Christian: Assembly of God
+	Table0006_ATH Table0006 = `ATH` // Atheist
+	Table0006_BAH Table0006 = `BAH` // Baha'i
+	Table0006_BAP Table0006 = `BAP` // Christian: Baptist
+	Table0006_BMA Table0006 = `BMA` // Buddhist: Mahayana
+	Table0006_BOT Table0006 = `BOT` // Buddhist: Other
+	Table0006_BRE Table0006 = `BRE` // Brethren
+	Table0006_BTA Table0006 = `BTA` // Buddhist: Tantrayana
+	Table0006_BTH Table0006 = `BTH` // Buddhist: Theravada
+	Table0006_BUD Table0006 = `BUD` // Buddhist
+	Table0006_CAT Table0006 = `CAT` // Christian: Roman Catholic
+	Table0006_CFR Table0006 = `CFR` // Chinese Folk Religionist
+	Table0006_CHR Table0006 = `CHR` // Christian
+	Table0006_CHS Table0006 = `CHS` // Christian: Christian Science
+	Table0006_CMA Table0006 = `CMA` // Christian: Christian Missionary Alliance
+	Table0006_CNF Table0006 = `CNF` // Confucian
+	Table0006_COC Table0006 = `COC` // Christian: Church of Christ
+	Table0006_COG Table0006 = `COG` // Christian: Church of God
+	Table0006_COI Table0006 = `COI` // Christian: Church of God in Christ
+	Table0006_COL Table0006 = `COL` // Christian: Congregational
+	Table0006_COM Table0006 = `COM` // Christian: Community
+	Table0006_COP Table0006 = `COP` // Christian: Other Pentecostal
+	Table0006_COT Table0006 = `COT` // Christian: Other
+	Table0006_CRR Table0006 = `CRR` // Christian: Christian Reformed
+	Table0006_DOC Table0006 = `DOC` // Disciples of Christ
+	Table0006_EOT Table0006 = `EOT` // Christian: Eastern Orthodox
+	Table0006_EPI Table0006 = `EPI` // Christian: Episcopalian
+	Table0006_ERL Table0006 = `ERL` // Ethnic Religionist
+	Table0006_EVC Table0006 = `EVC` // Christian: Evangelical Church
+	Table0006_FRQ Table0006 = `FRQ` // Christian: Friends
+	Table0006_FUL Table0006 = `FUL` // Christian: Full Gospel
+	Table0006_FWB Table0006 = `FWB` // Christian: Free Will Baptist
+	Table0006_GRE Table0006 = `GRE` // Christian: Greek Orthodox
+	Table0006_HIN Table0006 = `HIN` // Hindu
+	Table0006_HOT Table0006 = `HOT` // Hindu: Other
+	Table0006_HSH Table0006 = `HSH` // Hindu: Shaivites
+	Table0006_HVA Table0006 = `HVA` // Hindu: Vaishnavites
+	Table0006_JAI Table0006 = `JAI` // Jain
+	Table0006_JCO Table0006 = `JCO` // Jewish: Conservative
+	Table0006_JEW Table0006 = `JEW` // Jewish
+	Table0006_JOR Table0006 = `JOR` // Jewish: Orthodox
+	Table0006_JOT Table0006 = `JOT` // Jewish: Other
+	Table0006_JRC Table0006 = `JRC` // Jewish: Reconstructionist
+	Table0006_JRF Table0006 = `JRF` // Jewish: Reform
+	Table0006_JRN Table0006 = `JRN` // Jewish: Renewal
+	Table0006_JWN Table0006 = `JWN` // Christian: Jehovah's Witness
+	Table0006_LMS Table0006 = `LMS` // Christian: Lutheran Missouri Synod
+	Table0006_LUT Table0006 = `LUT` // Christian: Lutheran
+	Table0006_MEN Table0006 = `MEN` // Christian: Mennonite
+	Table0006_MET Table0006 = `MET` // Christian: Methodist
+	Table0006_MOM Table0006 = `MOM` // Christian: Latter-day Saints
+	Table0006_MOS Table0006 = `MOS` // Muslim
+	Table0006_MOT Table0006 = `MOT` // Muslim: Other
+	Table0006_MSH Table0006 = `MSH` // Muslim: Shiite
+	Table0006_MSU Table0006 = `MSU` // Muslim: Sunni
+	Table0006_NAM Table0006 = `NAM` // Native American
+	Table0006_NAZ Table0006 = `NAZ` // Christian: Church of the Nazarene
+	Table0006_NOE Table0006 = `NOE` // Nonreligious
+	Table0006_NRL Table0006 = `NRL` // New Religionist
+	Table0006_ORT Table0006 = `ORT` // Christian: Orthodox
+	Table0006_OTH Table0006 = `OTH` // Other
+	Table0006_PEN Table0006 = `PEN` // Christian: Pentecostal
+	Table0006_PRC Table0006 = `PRC` // Christian: Other Protestant
+	Table0006_PRE Table0006 = `PRE` // Christian: Presbyterian
+	Table0006_PRO Table0006 = `PRO` // Christian: Protestant
+	Table0006_REC Table0006 = `REC` // Christian: Reformed Church
+	Table0006_REO Table0006 = `REO` // Christian: Reorganized Church of Jesus Christ-LDS
+	Table0006_SAA Table0006 = `SAA` // Christian: Salvation Army
+	Table0006_SEV Table0006 = `SEV` // Christian: Seventh Day Adventist
+	Table0006_SHN Table0006 = `SHN` // Shintoist
+	Table0006_SIK Table0006 = `SIK` // Sikh
+	Table0006_SOU Table0006 = `SOU` // Christian: Southern Baptist
+	Table0006_SPI Table0006 = `SPI` // Spiritist
+	Table0006_UCC Table0006 = `UCC` // Christian: United Church of Christ
+	Table0006_UMD Table0006 = `UMD` // Christian: United Methodist
+	Table0006_UNI Table0006 = `UNI` // Christian: Unitarian
+	Table0006_UNU Table0006 = `UNU` // Christian: Unitarian Universalist
+	Table0006_VAR Table0006 = `VAR` // Unknown
+	Table0006_WES Table0006 = `WES` // Christian: Wesleyan
+	Table0006_WMC Table0006 = `WMC` // Christian: Wesleyan Methodist
+)
+
+// TableID returns the table ID.
+func (Table0006) TableID() string { return `0006` }
+
+// Valid reports if the value is in the table.
+func (v Table0006) Valid() bool { return TableValueLookup[`0006`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0006) Description() string { return tableDescription(`0006`, string(v)) }
+
+// Table0007 is a value of table 0007, Admission Type.
+type Table0007 string
+
+const (
+	Table0007_A Table0007 = `A` // Accident
+	Table0007_C Table0007 = `C` // Elective
+	Table0007_E Table0007 = `E` // Emergency
+	Table0007_L Table0007 = `L` // Labor and Delivery
+	Table0007_N Table0007 = `N` // Newborn (Birth in healthcare facility)
+	Table0007_R Table0007 = `R` // Routine
+	Table0007_U Table0007 = `U` // Urgent
+)
+
+// TableID returns the table ID.
+func (Table0007) TableID() string { return `0007` }
+
+// Valid reports if the value is in the table.
+func (v Table0007) Valid() bool { return TableValueLookup[`0007`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0007) Description() string { return tableDescription(`0007`, string(v)) }
+
+// Table0008 is a value of table 0008, Acknowledgment Code.
+type Table0008 string
+
+const (
+	Table0008_AA Table0008 = `AA` // Original mode: Application Accept - Enhanced mode: Application acknowledgment: Accept
+	Table0008_AE Table0008 = `AE` // Original mode: Application Error - Enhanced mode: Application acknowledgment: Error
+	Table0008_AR Table0008 = `AR` // Original mode: Application Reject - Enhanced mode: Application acknowledgment: Reject
+	Table0008_CA Table0008 = `CA` // Enhanced mode: Accept acknowledgment: Commit Accept
+	Table0008_CE Table0008 = `CE` // Enhanced mode: Accept acknowledgment: Commit Error
+	Table0008_CR Table0008 = `CR` // Enhanced mode: Accept acknowledgment: Commit Reject
+)
+
+// TableID returns the table ID.
+func (Table0008) TableID() string { return `0008` }
+
+// Valid reports if the value is in the table.
+func (v Table0008) Valid() bool { return TableValueLookup[`0008`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0008) Description() string { return tableDescription(`0008`, string(v)) }
+
+// Table0009 is a value of table 0009, Ambulatory Status.
+type Table0009 string
+
+const (
+	Table0009_A0 Table0009 = `A0` // No functional limitations
+	Table0009_A1 Table0009 = `A1` // Ambulates with assistive device
+	Table0009_A2 Table0009 = `A2` // Wheelchair/stretcher bound
+	Table0009_A3 Table0009 = `A3` // Comatose; non-responsive
+	Table0009_A4 Table0009 = `A4` // Disoriented
+	Table0009_A5 Table0009 = `A5` // Vision impaired
+	Table0009_A6 Table0009 = `A6` // Hearing impaired
+	Table0009_A7 Table0009 = `A7` // Speech impaired
+	Table0009_A8 Table0009 = `A8` // Non-English speaking
+	Table0009_A9 Table0009 = `A9` // Functional level unknown
+	Table0009_B1 Table0009 = `B1` // Oxygen therapy
+	Table0009_B2 Table0009 = `B2` // Special equipment (tubes, IVs, catheters)
+	Table0009_B3 Table0009 = `B3` // Amputee
+	Table0009_B4 Table0009 = `B4` // Mastectomy
+	Table0009_B5 Table0009 = `B5` // Paraplegic
+	Table0009_B6 Table0009 = `B6` // Pregnant
+)
+
+// TableID returns the table ID.
+func (Table0009) TableID() string { return `0009` }
+
+// Valid reports if the value is in the table.
+func (v Table0009) Valid() bool { return TableValueLookup[`0009`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0009) Description() string { return tableDescription(`0009`, string(v)) }
+
+// Table0010 is a value of table 0010, Physician ID.
+type Table0010 string
+
+// TableID returns the table ID.
+func (Table0010) TableID() string { return `0010` }
+
+// Valid reports if the value is in the table.
+func (v Table0010) Valid() bool { return TableValueLookup[`0010`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0010) Description() string { return tableDescription(`0010`, string(v)) }
+
+// Table0017 is a value of table 0017, Transaction Type.
+type Table0017 string
+
+const (
+	Table0017_AJ Table0017 = `AJ` // Adjustment
+	Table0017_CD Table0017 = `CD` // Credit
+	Table0017_CG Table0017 = `CG` // Charge
+	Table0017_CO Table0017 = `CO` // Co-payment
+	Table0017_PY Table0017 = `PY` // Payment
+)
+
+// TableID returns the table ID.
+func (Table0017) TableID() string { return `0017` }
+
+// Valid reports if the value is in the table.
+func (v Table0017) Valid() bool { return TableValueLookup[`0017`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0017) Description() string { return tableDescription(`0017`, string(v)) }
+
+// Table0018 is a value of table 0018, Patient Type.
+type Table0018 string
+
+// TableID returns the table ID.
+func (Table0018) TableID() string { return `0018` }
+
+// Valid reports if the value is in the table.
+func (v Table0018) Valid() bool { return TableValueLookup[`0018`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0018) Description() string { return tableDescription(`0018`, string(v)) }
+
+// Table0019 is a value of table 0019, Anesthesia Code.
+type Table0019 string
+
+// TableID returns the table ID.
+func (Table0019) TableID() string { return `0019` }
+
+// Valid reports if the value is in the table.
+func (v Table0019) Valid() bool { return TableValueLookup[`0019`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0019) Description() string { return tableDescription(`0019`, string(v)) }
+
+// Table0021 is a value of table 0021, Bad Debt Agency Code.
+type Table0021 string
+
+// TableID returns the table ID.
+func (Table0021) TableID() string { return `0021` }
+
+// Valid reports if the value is in the table.
+func (v Table0021) Valid() bool { return TableValueLookup[`0021`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0021) Description() string { return tableDescription(`0021`, string(v)) }
+
+// Table0022 is a value of table 0022, Billing Status.
+type Table0022 string
+
+// TableID returns the table ID.
+func (Table0022) TableID() string { return `0022` }
+
+// Valid reports if the value is in the table.
+func (v Table0022) Valid() bool { return TableValueLookup[`0022`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0022) Description() string { return tableDescription(`0022`, string(v)) }
+
+// Table0023 is a value of table 0023, Admit Source.
+type Table0023 string
+
+// TableID returns the table ID.
+func (Table0023) TableID() string { return `0023` }
+
+// Valid reports if the value is in the table.
+func (v Table0023) Valid() bool { return TableValueLookup[`0023`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0023) Description() string { return tableDescription(`0023`, string(v)) }
+
+// Table0024 is a value of table 0024, Fee Schedule.
+type Table0024 string
+
+// TableID returns the table ID.
+func (Table0024) TableID() string { return `0024` }
+
+// Valid reports if the value is in the table.
+func (v Table0024) Valid() bool { return TableValueLookup[`0024`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0024) Description() string { return tableDescription(`0024`, string(v)) }
+
+// Table0027 is a value of table 0027, Priority.
+type Table0027 string
+
+const (
+	Table0027_A Table0027 = `A` // As soon as possible (a priority lower than stat)
+	Table0027_P Table0027 = `P` // Preoperative (to be done prior to surgery)
+	Table0027_R Table0027 = `R` // Routine
+	Table0027_S Table0027 = `S` // Stat (do immediately)
+	Table0027_T Table0027 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// TableID returns the table ID.
+func (Table0027) TableID() string { return `0027` }
+
+// Valid reports if the value is in the table.
+func (v Table0027) Valid() bool { return TableValueLookup[`0027`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0027) Description() string { return tableDescription(`0027`, string(v)) }
+
+// Table0032 is a value of table 0032, Charge/Price Indicator.
+type Table0032 string
+
+// TableID returns the table ID.
+func (Table0032) TableID() string { return `0032` }
+
+// Valid reports if the value is in the table.
+func (v Table0032) Valid() bool { return TableValueLookup[`0032`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0032) Description() string { return tableDescription(`0032`, string(v)) }
+
+// Table0038 is a value of table 0038, Order status.
+type Table0038 string
+
+const (
+	Table0038_A  Table0038 = `A`  // Some, but not all, results available
+	Table0038_CA Table0038 = `CA` // Order was canceled
+	Table0038_CM Table0038 = `CM` // Order is completed
+	Table0038_DC Table0038 = `DC` // Order was discontinued
+	Table0038_ER Table0038 = `ER` // Error, order not found
+	Table0038_HD Table0038 = `HD` // Order is on hold
+	Table0038_IP Table0038 = `IP` // In process, unspecified
+	Table0038_RP Table0038 = `RP` // Order has been replaced
+	Table0038_SC Table0038 = `SC` // In process, scheduled
+)
+
+// TableID returns the table ID.
+func (Table0038) TableID() string { return `0038` }
+
+// Valid reports if the value is in the table.
+func (v Table0038) Valid() bool { return TableValueLookup[`0038`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0038) Description() string { return tableDescription(`0038`, string(v)) }
+
+// Table0042 is a value of table 0042, Company Plan Code.
+type Table0042 string
+
+// TableID returns the table ID.
+func (Table0042) TableID() string { return `0042` }
+
+// Valid reports if the value is in the table.
+func (v Table0042) Valid() bool { return TableValueLookup[`0042`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0042) Description() string { return tableDescription(`0042`, string(v)) }
+
+// Table0043 is a value of table 0043, Condition Code.
+type Table0043 string
+
+// TableID returns the table ID.
+func (Table0043) TableID() string { return `0043` }
+
+// Valid reports if the value is in the table.
+func (v Table0043) Valid() bool { return TableValueLookup[`0043`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0043) Description() string { return tableDescription(`0043`, string(v)) }
+
+// Table0044 is a value of table 0044, Contract Code.
+type Table0044 string
+
+// TableID returns the table ID.
+func (Table0044) TableID() string { return `0044` }
+
+// Valid reports if the value is in the table.
+func (v Table0044) Valid() bool { return TableValueLookup[`0044`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0044) Description() string { return tableDescription(`0044`, string(v)) }
+
+// Table0045 is a value of table 0045, Courtesy Code.
+type Table0045 string
+
+// TableID returns the table ID.
+func (Table0045) TableID() string { return `0045` }
+
+// Valid reports if the value is in the table.
+func (v Table0045) Valid() bool { return TableValueLookup[`0045`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0045) Description() string { return tableDescription(`0045`, string(v)) }
+
+// Table0046 is a value of table 0046, Credit Rating.
+type Table0046 string
+
+// TableID returns the table ID.
+func (Table0046) TableID() string { return `0046` }
+
+// Valid reports if the value is in the table.
+func (v Table0046) Valid() bool { return TableValueLookup[`0046`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0046) Description() string { return tableDescription(`0046`, string(v)) }
+
+// Table0049 is a value of table 0049, Department Code.
+type Table0049 string
+
+// TableID returns the table ID.
+func (Table0049) TableID() string { return `0049` }
+
+// Valid reports if the value is in the table.
+func (v Table0049) Valid() bool { return TableValueLookup[`0049`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0049) Description() string { return tableDescription(`0049`, string(v)) }
+
+// Table0050 is a value of table 0050, Accident Code.
+type Table0050 string
+
+// TableID returns the table ID.
+func (Table0050) TableID() string { return `0050` }
+
+// Valid reports if the value is in the table.
+func (v Table0050) Valid() bool { return TableValueLookup[`0050`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0050) Description() string { return tableDescription(`0050`, string(v)) }
+
+// Table0051 is a value of table 0051, Diagnosis Code.
+type Table0051 string
+
+// TableID returns the table ID.
+func (Table0051) TableID() string { return `0051` }
+
+// Valid reports if the value is in the table.
+func (v Table0051) Valid() bool { return TableValueLookup[`0051`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0051) Description() string { return tableDescription(`0051`, string(v)) }
+
+// Table0052 is a value of table 0052, Diagnosis Type.
+type Table0052 string
+
+const (
+	Table0052_A Table0052 = `A` // Admitting
+	Table0052_F Table0052 = `F` // Final
+	Table0052_W Table0052 = `W` // Working
+)
+
+// TableID returns the table ID.
+func (Table0052) TableID() string { return `0052` }
+
+// Valid reports if the value is in the table.
+func (v Table0052) Valid() bool { return TableValueLookup[`0052`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0052) Description() string { return tableDescription(`0052`, string(v)) }
+
+// Table0055 is a value of table 0055, Diagnosis Related Group.
+type Table0055 string
+
+// TableID returns the table ID.
+func (Table0055) TableID() string { return `0055` }
+
+// Valid reports if the value is in the table.
+func (v Table0055) Valid() bool { return TableValueLookup[`0055`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0055) Description() string { return tableDescription(`0055`, string(v)) }
+
+// Table0056 is a value of table 0056, DRG Grouper Review Code.
+type Table0056 string
+
+// TableID returns the table ID.
+func (Table0056) TableID() string { return `0056` }
+
+// Valid reports if the value is in the table.
+func (v Table0056) Valid() bool { return TableValueLookup[`0056`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0056) Description() string { return tableDescription(`0056`, string(v)) }
+
+// Table0059 is a value of table 0059, Consent Code.
+type Table0059 string
+
+// TableID returns the table ID.
+func (Table0059) TableID() string { return `0059` }
+
+// Valid reports if the value is in the table.
+func (v Table0059) Valid() bool { return TableValueLookup[`0059`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0059) Description() string { return tableDescription(`0059`, string(v)) }
+
+// Table0061 is a value of table 0061, Check Digit Scheme.
+type Table0061 string
+
+const (
+	Table0061_BCV Table0061 = `BCV` // Bank Card Validation Number
+	Table0061_ISO Table0061 = `ISO` // ISO 7064: 1983
+	Table0061_M10 Table0061 = `M10` // Mod 10 algorithm
+	Table0061_M11 Table0061 = `M11` // Mod 11 algorithm
+	Table0061_NPI Table0061 = `NPI` // Check digit algorithm in the US National Provider Identifier
+)
+
+// TableID returns the table ID.
+func (Table0061) TableID() string { return `0061` }
+
+// Valid reports if the value is in the table.
+func (v Table0061) Valid() bool { return TableValueLookup[`0061`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0061) Description() string { return tableDescription(`0061`, string(v)) }
+
+// Table0062 is a value of table 0062, Event Reason.
+type Table0062 string
+
+const (
+	Table0062_01 Table0062 = `01` // Patient request
+	Table0062_02 Table0062 = `02` // Physician/health practitioner order
+	Table0062_03 Table0062 = `03` // Census management
+	Table0062_O  Table0062 = `O`  // Other
+	Table0062_U  Table0062 = `U`  // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0062) TableID() string { return `0062` }
+
+// Valid reports if the value is in the table.
+func (v Table0062) Valid() bool { return TableValueLookup[`0062`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0062) Description() string { return tableDescription(`0062`, string(v)) }
+
+// Table0063 is a value of table 0063, Relationship.
+type Table0063 string
+
+const (
+	Table0063_ASC Table0063 = `ASC` // Associate
+	Table0063_BRO Table0063 = `BRO` // Brother
+	Table0063_CGV Table0063 = `CGV` // Care giver
+	Table0063_CHD Table0063 = `CHD` // Child
+	Table0063_DEP Table0063 = `DEP` // Handicapped dependent
+	Table0063_DOM Table0063 = `DOM` // Life partner
+	Table0063_EMC Table0063 = `EMC` // Emergency contact
+	Table0063_EME Table0063 = `EME` // Employee
+	Table0063_EMR Table0063 = `EMR` // Employer
+	Table0063_EXF Table0063 = `EXF` // Extended family
+	Table0063_FCH Table0063 = `FCH` // Foster child
+	Table0063_FND Table0063 = `FND` // Friend
+	Table0063_FTH Table0063 = `FTH` // Father
+	Table0063_GCH Table0063 = `GCH` // Grandchild
+	Table0063_GRD Table0063 = `GRD` // Guardian
+	Table0063_GRP Table0063 = `GRP` // Grandparent
+	Table0063_MGR Table0063 = `MGR` // Manager
+	Table0063_MTH Table0063 = `MTH` // Mother
+	Table0063_NCH Table0063 = `NCH` // Natural child
+	Table0063_NON Table0063 = `NON` // None
+	Table0063_OAD Table0063 = `OAD` // Other adult
+	Table0063_OTH Table0063 = `OTH` // Other
+	Table0063_OWN Table0063 = `OWN` // Owner
+	Table0063_PAR Table0063 = `PAR` // Parent
+	Table0063_SCH Table0063 = `SCH` // Stepchild
+	Table0063_SEL Table0063 = `SEL` // Self
+	Table0063_SIB Table0063 = `SIB` // Sibling
+	Table0063_SIS Table0063 = `SIS` // Sister
+	Table0063_SPO Table0063 = `SPO` // Spouse
+	Table0063_TRA Table0063 = `TRA` // Trainer
+	Table0063_UNK Table0063 = `UNK` // Unknown
+	Table0063_WRD Table0063 = `WRD` // Ward of court
+)
+
+// TableID returns the table ID.
+func (Table0063) TableID() string { return `0063` }
+
+// Valid reports if the value is in the table.
+func (v Table0063) Valid() bool { return TableValueLookup[`0063`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0063) Description() string { return tableDescription(`0063`, string(v)) }
+
+// Table0064 is a value of table 0064, Financial Class.
+type Table0064 string
+
+// TableID returns the table ID.
+func (Table0064) TableID() string { return `0064` }
+
+// Valid reports if the value is in the table.
+func (v Table0064) Valid() bool { return TableValueLookup[`0064`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0064) Description() string { return tableDescription(`0064`, string(v)) }
+
+// Table0065 is a value of table 0065, Specimen Action Code.
+type Table0065 string
+
+const (
+	Table0065_A Table0065 = `A` // Add ordered tests to the existing specimen
+	Table0065_G Table0065 = `G` // Generated order; reflex order
+	Table0065_L Table0065 = `L` // Lab to obtain specimen from patient
+	Table0065_O Table0065 = `O` // Specimen obtained by service other than Lab
+	Table0065_P Table0065 = `P` // Pending specimen; Order sent prior to delivery
+	Table0065_R Table0065 = `R` // Revised order
+	Table0065_S Table0065 = `S` // Schedule the tests specified below
+)
+
+// TableID returns the table ID.
+func (Table0065) TableID() string { return `0065` }
+
+// Valid reports if the value is in the table.
+func (v Table0065) Valid() bool { return TableValueLookup[`0065`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0065) Description() string { return tableDescription(`0065`, string(v)) }
+
+// Table0066 is a value of table 0066, Employment Status.
+type Table0066 string
+
+const (
+	Table0066_1 Table0066 = `1` // Full time employed
+	Table0066_2 Table0066 = `2` // Part time employed
+	Table0066_3 Table0066 = `3` // Unemployed
+	Table0066_4 Table0066 = `4` // Self-employed,
+	Table0066_5 Table0066 = `5` // Retired
+	Table0066_6 Table0066 = `6` // On active military duty
+	Table0066_9 Table0066 = `9` // Unknown
+	Table0066_C Table0066 = `C` // Contract, per diem
+	Table0066_L Table0066 = `L` // Leave of absence (e.g., family leave, sabbatical, etc.)
+	Table0066_O Table0066 = `O` // Other
+	Table0066_T Table0066 = `T` // Temporarily unemployed
+)
+
+// TableID returns the table ID.
+func (Table0066) TableID() string { return `0066` }
+
+// Valid reports if the value is in the table.
+func (v Table0066) Valid() bool { return TableValueLookup[`0066`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0066) Description() string { return tableDescription(`0066`, string(v)) }
+
+// Table0068 is a value of table 0068, Guarantor Type.
+type Table0068 string
+
+// TableID returns the table ID.
+func (Table0068) TableID() string { return `0068` }
+
+// Valid reports if the value is in the table.
+func (v Table0068) Valid() bool { return TableValueLookup[`0068`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0068) Description() string { return tableDescription(`0068`, string(v)) }
+
+// Table0069 is a value of table 0069, Hospital Service.
+type Table0069 string
+
+const (
+	Table0069_CAR Table0069 = `CAR` // Cardiac Service
+	Table0069_MED Table0069 = `MED` // Medical Service
+	Table0069_PUL Table0069 = `PUL` // Pulmonary Service
+	Table0069_SUR Table0069 = `SUR` // Surgical Service
+	Table0069_URO Table0069 = `URO` // Urology Service
+)
+
+// TableID returns the table ID.
+func (Table0069) TableID() string { return `0069` }
+
+// Valid reports if the value is in the table.
+func (v Table0069) Valid() bool { return TableValueLookup[`0069`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0069) Description() string { return tableDescription(`0069`, string(v)) }
+
+// Table0072 is a value of table 0072, Insurance plan ID.
+type Table0072 string
+
+// TableID returns the table ID.
+func (Table0072) TableID() string { return `0072` }
+
+// Valid reports if the value is in the table.
+func (v Table0072) Valid() bool { return TableValueLookup[`0072`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0072) Description() string { return tableDescription(`0072`, string(v)) }
+
+// Table0073 is a value of table 0073, Interest Rate Code.
+type Table0073 string
+
+// TableID returns the table ID.
+func (Table0073) TableID() string { return `0073` }
+
+// Valid reports if the value is in the table.
+func (v Table0073) Valid() bool { return TableValueLookup[`0073`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0073) Description() string { return tableDescription(`0073`, string(v)) }
+
+// Table0074 is a value of table 0074, Diagnostic Service Section ID.
+type Table0074 string
+
+const (
+	Table0074_AU  Table0074 = `AU`  // Audiology
+	Table0074_BG  Table0074 = `BG`  // Blood Gases
+	Table0074_BLB Table0074 = `BLB` // Blood Bank
+	Table0074_CH  Table0074 = `CH`  // Chemistry
+	Table0074_CP  Table0074 = `CP`  // Cytopathology
+	Table0074_CT  Table0074 = `CT`  // CAT Scan
+	Table0074_CTH Table0074 = `CTH` // Cardiac Catheterization
+	Table0074_CUS Table0074 = `CUS` // Cardiac Ultrasound
+	Table0074_EC  Table0074 = `EC`  // Electrocardiac (e.g., EKG, EEC, Holter)
+	Table0074_EN  Table0074 = `EN`  // Electroneuro (EEG, EMG,EP,PSG)
+	Table0074_HM  Table0074 = `HM`  // Hematology
+	Table0074_ICU Table0074 = `ICU` // Bedside ICU Monitoring
+	Table0074_IMM Table0074 = `IMM` // Immunology
+	Table0074_LAB Table0074 = `LAB` // Laboratory
+	Table0074_MB  Table0074 = `MB`  // Microbiology
+	Table0074_MCB Table0074 = `MCB` // Mycobacteriology
+	Table0074_MYC Table0074 = `MYC` // Mycology
+	Table0074_NMR Table0074 = `NMR` // Nuclear Magnetic Resonance
+	Table0074_NMS Table0074 = `NMS` // Nuclear Medicine Scan
+	Table0074_NRS Table0074 = `NRS` // Nursing Service Measures
+	Table0074_OSL Table0074 = `OSL` // Outside Lab
+	Table0074_OT  Table0074 = `OT`  // Occupational Therapy
+	Table0074_OTH Table0074 = `OTH` // Other
+	Table0074_OUS Table0074 = `OUS` // OB Ultrasound
+	Table0074_PF  Table0074 = `PF`  // Pulmonary Function
+	Table0074_PHR Table0074 = `PHR` // Pharmacy
+	Table0074_PHY Table0074 = `PHY` // Physician (Hx. Dx, admission note, etc.)
+	Table0074_PT  Table0074 = `PT`  // Physical Therapy
+	Table0074_RAD Table0074 = `RAD` // Radiology
+	Table0074_RC  Table0074 = `RC`  // Respiratory Care (therapy)
+	Table0074_RT  Table0074 = `RT`  // Radiation Therapy
+	Table0074_RUS Table0074 = `RUS` // Radiology Ultrasound
+	Table0074_RX  Table0074 = `RX`  // Radiograph
+	Table0074_SP  Table0074 = `SP`  // Surgical Pathology
+	Table0074_SR  Table0074 = `SR`  // Serology
+	Table0074_TX  Table0074 = `TX`  // Toxicology
+	Table0074_VR  Table0074 = `VR`  // Virology
+	Table0074_VUS Table0074 = `VUS` // Vascular Ultrasound
+	Table0074_XRC Table0074 = `XRC` // Cineradiograph
+)
+
+// TableID returns the table ID.
+func (Table0074) TableID() string { return `0074` }
+
+// Valid reports if the value is in the table.
+func (v Table0074) Valid() bool { return TableValueLookup[`0074`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0074) Description() string { return tableDescription(`0074`, string(v)) }
+
+// Table0076 is a value of table 0076, Message Type.
+type Table0076 string
+
+const (
+	Table0076_ACK Table0076 = `ACK` // General acknowledgment message
+	Table0076_ADR Table0076 = `ADR` // ADT response
+	Table0076_ADT Table0076 = `ADT` // ADT message
+	Table0076_BAR Table0076 = `BAR` // Add/change billing account
+	Table0076_BPS Table0076 = `BPS` // Blood product dispense status message
+	Table0076_BRP Table0076 = `BRP` // Blood product dispense status acknowledgement message
+	Table0076_BRT Table0076 = `BRT` // Blood product transfusion/disposition acknowledgement message
+	Table0076_BTS Table0076 = `BTS` // Blood product transfusion/disposition message
+	Table0076_CCF Table0076 = `CCF` // Collaborative Care Fetch
+	Table0076_CCI Table0076 = `CCI` // Collaborative Care Information
+	Table0076_CCM Table0076 = `CCM` // Collaborative Care Message
+	Table0076_CCQ Table0076 = `CCQ` // Collaborative Care Referral
+	Table0076_CCU Table0076 = `CCU` // Collaborative Care Referral
+	Table0076_CQU Table0076 = `CQU` // Collaborative Care Referral
+	Table0076_CRM Table0076 = `CRM` // Clinical study registration message
+	Table0076_CSU Table0076 = `CSU` // Unsolicited study data message
+	Table0076_DFT Table0076 = `DFT` // Detail financial transactions
+	Table0076_DOC Table0076 = `DOC` // Document response
+	Table0076_DSR Table0076 = `DSR` // Display response
+	Table0076_EAC Table0076 = `EAC` // Automated equipment command message
+	Table0076_EAN Table0076 = `EAN` // Automated equipment notification message
+	Table0076_EAR Table0076 = `EAR` // Automated equipment response message
+	Table0076_EHC Table0076 = `EHC` // Health Care Invoice
+	Table0076_ESR Table0076 = `ESR` // Automated equipment status update acknowledgment message
+	Table0076_ESU Table0076 = `ESU` // Automated equipment status update message
+	Table0076_INR Table0076 = `INR` // Automated equipment inventory request message
+	Table0076_INU Table0076 = `INU` // Automated equipment inventory update message
+	Table0076_LSR Table0076 = `LSR` // Automated equipment log/service request message
+	Table0076_LSU Table0076 = `LSU` // Automated equipment log/service update message
+	Table0076_MDM Table0076 = `MDM` // Medical document management
+	Table0076_MFD Table0076 = `MFD` // Master files delayed application acknowledgment
+	Table0076_MFK Table0076 = `MFK` // Master files application acknowledgment
+	Table0076_MFN Table0076 = `MFN` // Master files notification
+	Table0076_MFQ Table0076 = `MFQ` // Master files query
+	Table0076_MFR Table0076 = `MFR` // Master files response
+	Table0076_NMD Table0076 = `NMD` // Application management data message
+	Table0076_NMQ Table0076 = `NMQ` // Application management query message
+	Table0076_NMR Table0076 = `NMR` // Application management response message
+	Table0076_OMB Table0076 = `OMB` // Blood product order message
+	Table0076_OMD Table0076 = `OMD` // Dietary order
+	Table0076_OMG Table0076 = `OMG` // General clinical order message
+	Table0076_OMI Table0076 = `OMI` // Imaging order
+	Table0076_OML Table0076 = `OML` // Laboratory order message
+	Table0076_OMN Table0076 = `OMN` // Non-stock requisition order message
+	Table0076_OMP Table0076 = `OMP` // Pharmacy/treatment order message
+	Table0076_OMS Table0076 = `OMS` // Stock requisition order message
+	Table0076_OPL Table0076 = `OPL` // Population/Location-Based Laboratory Order Message
+	Table0076_OPR Table0076 = `OPR` // Population/Location-Based Laboratory Order Acknowledgment Message
+	Table0076_OPU Table0076 = `OPU` // Unsolicited Population/Location-Based Laboratory Observation Message
+	Table0076_ORA Table0076 = `ORA` // Observation Report Acknowledgment
+	Table0076_ORB Table0076 = `ORB` // Blood product order acknowledgement message
+	Table0076_ORD Table0076 = `ORD` // Dietary order acknowledgment message
+	Table0076_ORF Table0076 = `ORF` // Query for results of observation
+	Table0076_ORG Table0076 = `ORG` // General clinical order acknowledgment message
+	Table0076_ORI Table0076 = `ORI` // Imaging order acknowledgement message
+	Table0076_ORL Table0076 = `ORL` // Laboratory acknowledgment message (unsolicited)
+	Table0076_ORM Table0076 = `ORM` // Pharmacy/treatment order message
+	Table0076_ORN Table0076 = `ORN` // Non-stock requisition - General order acknowledgment message
+	Table0076_ORP Table0076 = `ORP` // Pharmacy/treatment order acknowledgment message
+	Table0076_ORR Table0076 = `ORR` // General order response message response to any ORM
+	Table0076_ORS Table0076 = `ORS` // Stock requisition - Order acknowledgment message
+	Table0076_ORU Table0076 = `ORU` // Unsolicited transmission of an observation message
+	Table0076_OSM Table0076 = `OSM` // Specimen Shipment Message
+	Table0076_OSQ Table0076 = `OSQ` // Query response for order status
+	Table0076_OSR Table0076 = `OSR` // Query response for order status
+	Table0076_OUL Table0076 = `OUL` // Unsolicited laboratory observation message
+	Table0076_PEX Table0076 = `PEX` // Product experience message
+	Table0076_PGL Table0076 = `PGL` // Patient goal message
+	Table0076_PIN Table0076 = `PIN` // Patient insurance information
+	Table0076_PMU Table0076 = `PMU` // Add personnel record
+	Table0076_PPG Table0076 = `PPG` // Patient pathway message (goal-oriented)
+	Table0076_PPP Table0076 = `PPP` // Patient pathway message (problem-oriented)
+	Table0076_PPR Table0076 = `PPR` // Patient problem message
+	Table0076_PPT Table0076 = `PPT` // Patient pathway goal-oriented response
+	Table0076_PPV Table0076 = `PPV` // Patient goal response
+	Table0076_PRR Table0076 = `PRR` // Patient problem response
+	Table0076_PTR Table0076 = `PTR` // Patient pathway problem-oriented response
+	Table0076_QBP Table0076 = `QBP` // Query by parameter
+	Table0076_QCK Table0076 = `QCK` // Deferred query
+	Table0076_QCN Table0076 = `QCN` // Cancel query
+	Table0076_QRY Table0076 = `QRY` // Query, original mode
+	Table0076_QSB Table0076 = `QSB` // Create subscription
+	Table0076_QSX Table0076 = `QSX` // Cancel subscription/acknowledge message
+	Table0076_QVR Table0076 = `QVR` // Query for previous events
+	Table0076_RAR Table0076 = `RAR` // Pharmacy/treatment administration information
+	Table0076_RAS Table0076 = `RAS` // Pharmacy/treatment administration message
+	Table0076_RCI Table0076 = `RCI` // Return clinical information
+	Table0076_RCL Table0076 = `RCL` // Return clinical list
+	Table0076_RDE Table0076 = `RDE` // Pharmacy/treatment encoded order message
+	Table0076_RDR Table0076 = `RDR` // Pharmacy/treatment dispense information
+	Table0076_RDS Table0076 = `RDS` // Pharmacy/treatment dispense message
+	Table0076_RDY Table0076 = `RDY` // Display based response
+	Table0076_REF Table0076 = `REF` // Patient referral
+	Table0076_RER Table0076 = `RER` // Pharmacy/treatment encoded order information
+	Table0076_RGR Table0076 = `RGR` // Pharmacy/treatment dose information
+	Table0076_RGV Table0076 = `RGV` // Pharmacy/treatment give message
+	Table0076_ROR Table0076 = `ROR` // Pharmacy/treatment order response
+	Table0076_RPA Table0076 = `RPA` // Return patient authorization
+	Table0076_RPI Table0076 = `RPI` // Return patient information
+	Table0076_RPL Table0076 = `RPL` // Return patient display list
+	Table0076_RPR Table0076 = `RPR` // Return patient list
+	Table0076_RQA Table0076 = `RQA` // Request patient authorization
+	Table0076_RQC Table0076 = `RQC` // Request clinical information
+	Table0076_RQI Table0076 = `RQI` // Request patient information
+	Table0076_RQP Table0076 = `RQP` // Request patient demographics
+	Table0076_RRA Table0076 = `RRA` // Pharmacy/treatment administration acknowledgment message
+	Table0076_RRD Table0076 = `RRD` // Pharmacy/treatment dispense acknowledgment message
+	Table0076_RRE Table0076 = `RRE` // Pharmacy/treatment encoded order acknowledgment message
+	Table0076_RRG Table0076 = `RRG` // Pharmacy/treatment give acknowledgment message
+	Table0076_RRI Table0076 = `RRI` // Return referral information
+	Table0076_RSP Table0076 = `RSP` // Segment pattern response
+	Table0076_RTB Table0076 = `RTB` // Tabular response
+	Table0076_SCN Table0076 = `SCN` // Notification of Anti-Microbial Device Cycle Data
+	Table0076_SDN Table0076 = `SDN` // Notification of Anti-Microbial Device Data
+	Table0076_SDR Table0076 = `SDR` // Sterilization anti-microbial device data request
+	Table0076_SIU Table0076 = `SIU` // Schedule information unsolicited
+	Table0076_SLN Table0076 = `SLN` // Notification of New Sterilization Lot
+	Table0076_SLR Table0076 = `SLR` // Sterilization lot request
+	Table0076_SMD Table0076 = `SMD` // Sterilization anti-microbial device cycle data request
+	Table0076_SQM Table0076 = `SQM` // Schedule query message
+	Table0076_SQR Table0076 = `SQR` // Schedule query response
+	Table0076_SRM Table0076 = `SRM` // Schedule request message
+	Table0076_SRR Table0076 = `SRR` // Scheduled request response
+	Table0076_SSR Table0076 = `SSR` // Specimen status request message
+	Table0076_SSU Table0076 = `SSU` // Specimen status update message
+	Table0076_STC Table0076 = `STC` // Notification of Sterilization Configuration
+	Table0076_STI Table0076 = `STI` // Sterilization item request
+	Table0076_SUR Table0076 = `SUR` // Summary product experience report
+	Table0076_TBR Table0076 = `TBR` // Tabular data response
+	Table0076_TCR Table0076 = `TCR` // Automated equipment test code settings request message
+	Table0076_TCU Table0076 = `TCU` // Automated equipment test code settings update message
+	Table0076_UDM Table0076 = `UDM` // Unsolicited display update message
+	Table0076_VXQ Table0076 = `VXQ` // Query for vaccination record
+	Table0076_VXR Table0076 = `VXR` // Vaccination record response
+	Table0076_VXU Table0076 = `VXU` // Unsolicited vaccination record update
+	Table0076_VXX Table0076 = `VXX` // Response for vaccination query with multiple PID matches
+)
+
+// TableID returns the table ID.
+func (Table0076) TableID() string { return `0076` }
+
+// Valid reports if the value is in the table.
+func (v Table0076) Valid() bool { return TableValueLookup[`0076`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0076) Description() string { return tableDescription(`0076`, string(v)) }
+
+// Table0080 is a value of table 0080, Nature of Abnormal Testing.
+type Table0080 string
+
+const (
+	Table0080_A  Table0080 = `A`  // An age-based population
+	Table0080_B  Table0080 = `B`  // Breed
+	Table0080_N  Table0080 = `N`  // None - generic normal range
+	Table0080_R  Table0080 = `R`  // A race-based population
+	Table0080_S  Table0080 = `S`  // A sex-based population
+	Table0080_SP Table0080 = `SP` // Species
+	Table0080_ST Table0080 = `ST` // Strain
+)
+
+// TableID returns the table ID.
+func (Table0080) TableID() string { return `0080` }
+
+// Valid reports if the value is in the table.
+func (v Table0080) Valid() bool { return TableValueLookup[`0080`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0080) Description() string { return tableDescription(`0080`, string(v)) }
+
+// Table0083 is a value of table 0083, Outlier Type.
+type Table0083 string
+
+const (
+	Table0083_C Table0083 = `C` // Outlier cost
+	Table0083_D Table0083 = `D` // Outlier days
+)
+
+// TableID returns the table ID.
+func (Table0083) TableID() string { return `0083` }
+
+// Valid reports if the value is in the table.
+func (v Table0083) Valid() bool { return TableValueLookup[`0083`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0083) Description() string { return tableDescription(`0083`, string(v)) }
+
+// Table0084 is a value of table 0084, Performed by.
+type Table0084 string
+
+// TableID returns the table ID.
+func (Table0084) TableID() string { return `0084` }
+
+// Valid reports if the value is in the table.
+func (v Table0084) Valid() bool { return TableValueLookup[`0084`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0084) Description() string { return tableDescription(`0084`, string(v)) }
+
+// Table0085 is a value of table 0085, Observation Result Status Codes Interpretation.
+type Table0085 string
+
+const (
+	Table0085_C Table0085 = `C` // Record coming over is a correction and thus replaces a final result
+	Table0085_D Table0085 = `D` // Deletes the OBX record
+	Table0085_F Table0085 = `F` // Final results; Can only be changed with a corrected result.
+	Table0085_I Table0085 = `I` // Specimen in lab; results pending
+	Table0085_N Table0085 = `N` // Not asked; used to affirmatively document that the observation identified in the OBX was not sought when the universal service ID in OBR-4 implies that it would be sought.
+	Table0085_O Table0085 = `O` // Order detail description only (no result)
+	Table0085_P Table0085 = `P` // Preliminary results
+	Table0085_R Table0085 = `R` // Results entered -- not verified
+	Table0085_S Table0085 = `S` // Partial results. Deprecated. Retained only for backward compatibility as of V2.6.
+	Table0085_U Table0085 = `U` // Results status change to final without retransmitting results already sent as 'preliminary.' E.g., radiology changes status from preliminary to final
+	Table0085_W Table0085 = `W` // Post original as wrong, e.g., transmitted for wrong patient
+	Table0085_X Table0085 = `X` // Results cannot be obtained for this observation
+)
+
+// TableID returns the table ID.
+func (Table0085) TableID() string { return `0085` }
+
+// Valid reports if the value is in the table.
+func (v Table0085) Valid() bool { return TableValueLookup[`0085`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0085) Description() string { return tableDescription(`0085`, string(v)) }
+
+// Table0086 is a value of table 0086, Plan ID.
+type Table0086 string
+
+// TableID returns the table ID.
+func (Table0086) TableID() string { return `0086` }
+
+// Valid reports if the value is in the table.
+func (v Table0086) Valid() bool { return TableValueLookup[`0086`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0086) Description() string { return tableDescription(`0086`, string(v)) }
+
+// Table0087 is a value of table 0087, Pre-Admit Test Indicator.
+type Table0087 string
+
+// TableID returns the table ID.
+func (Table0087) TableID() string { return `0087` }
+
+// Valid reports if the value is in the table.
+func (v Table0087) Valid() bool { return TableValueLookup[`0087`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0087) Description() string { return tableDescription(`0087`, string(v)) }
+
+// Table0088 is a value of table 0088, Procedure Code.
+type Table0088 string
+
+// TableID returns the table ID.
+func (Table0088) TableID() string { return `0088` }
+
+// Valid reports if the value is in the table.
+func (v Table0088) Valid() bool { return TableValueLookup[`0088`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0088) Description() string { return tableDescription(`0088`, string(v)) }
+
+// Table0091 is a value of table 0091, Query Priority.
+type Table0091 string
+
+const (
+	Table0091_D Table0091 = `D` // Deferred
+	Table0091_I Table0091 = `I` // Immediate
+)
+
+// TableID returns the table ID.
+func (Table0091) TableID() string { return `0091` }
+
+// Valid reports if the value is in the table.
+func (v Table0091) Valid() bool { return TableValueLookup[`0091`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0091) Description() string { return tableDescription(`0091`, string(v)) }
+
+// Table0092 is a value of table 0092, Re-Admission Indicator.
+type Table0092 string
+
+const (
+	Table0092_R Table0092 = `R` // Re-admission
+)
+
+// TableID returns the table ID.
+func (Table0092) TableID() string { return `0092` }
+
+// Valid reports if the value is in the table.
+func (v Table0092) Valid() bool { return TableValueLookup[`0092`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0092) Description() string { return tableDescription(`0092`, string(v)) }
+
+// Table0093 is a value of table 0093, Release Information.
+type Table0093 string
+
+const (
+	Table0093_N Table0093 = `N` // No
+	Table0093_Y Table0093 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0093) TableID() string { return `0093` }
+
+// Valid reports if the value is in the table.
+func (v Table0093) Valid() bool { return TableValueLookup[`0093`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0093) Description() string { return tableDescription(`0093`, string(v)) }
+
+// Table0098 is a value of table 0098, Type of Agreement.
+type Table0098 string
+
+const (
+	Table0098_M Table0098 = `M` // Maternity
+	Table0098_S Table0098 = `S` // Standard
+	Table0098_U Table0098 = `U` // Unified
+)
+
+// TableID returns the table ID.
+func (Table0098) TableID() string { return `0098` }
+
+// Valid reports if the value is in the table.
+func (v Table0098) Valid() bool { return TableValueLookup[`0098`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0098) Description() string { return tableDescription(`0098`, string(v)) }
+
+// Table0099 is a value of table 0099, VIP Indicator.
+type Table0099 string
+
+// TableID returns the table ID.
+func (Table0099) TableID() string { return `0099` }
+
+// Valid reports if the value is in the table.
+func (v Table0099) Valid() bool { return TableValueLookup[`0099`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0099) Description() string { return tableDescription(`0099`, string(v)) }
+
+// Table0100 is a value of table 0100, Invocation event.
+type Table0100 string
+
+const (
+	Table0100_D Table0100 = `D` // On discharge
+	Table0100_O Table0100 = `O` // On receipt of order
+	Table0100_R Table0100 = `R` // At time service is completed
+	Table0100_S Table0100 = `S` // At time service is started
+	Table0100_T Table0100 = `T` // At a designated date/time
+)
+
+// TableID returns the table ID.
+func (Table0100) TableID() string { return `0100` }
+
+// Valid reports if the value is in the table.
+func (v Table0100) Valid() bool { return TableValueLookup[`0100`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0100) Description() string { return tableDescription(`0100`, string(v)) }
+
+// Table0103 is a value of table 0103, Processing ID.
+type Table0103 string
+
+const (
+	Table0103_D Table0103 = `D` // Debugging
+	Table0103_P Table0103 = `P` // Production
+	Table0103_T Table0103 = `T` // Training
+)
+
+// TableID returns the table ID.
+func (Table0103) TableID() string { return `0103` }
+
+// Valid reports if the value is in the table.
+func (v Table0103) Valid() bool { return TableValueLookup[`0103`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0103) Description() string { return tableDescription(`0103`, string(v)) }
+
+// Table0104 is a value of table 0104, Version ID.
+type Table0104 string
+
+const (
+	Table0104_2_0   Table0104 = `2.0`   // Release 2.0
+	Table0104_2_0D  Table0104 = `2.0D`  // Demo 2.0
+	Table0104_2_1   Table0104 = `2.1`   // Release 2.1
+	Table0104_2_2   Table0104 = `2.2`   // Release 2.2
+	Table0104_2_3   Table0104 = `2.3`   // Release 2.3
+	Table0104_2_3_1 Table0104 = `2.3.1` // Release 2.3.1
+	Table0104_2_4   Table0104 = `2.4`   // Release 2.4
+	Table0104_2_5   Table0104 = `2.5`   // Release 2.5
+	Table0104_2_5_1 Table0104 = `2.5.1` // Release 2.5.1
+	Table0104_2_6   Table0104 = `2.6`   // Release 2.6
+	Table0104_2_7   Table0104 = `2.7`   // Release 2.7
+	Table0104_2_7_1 Table0104 = `2.7.1` // Release 2.7.1
+)
+
+// TableID returns the table ID.
+func (Table0104) TableID() string { return `0104` }
+
+// Valid reports if the value is in the table.
+func (v Table0104) Valid() bool { return TableValueLookup[`0104`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0104) Description() string { return tableDescription(`0104`, string(v)) }
+
+// Table0105 is a value of table 0105, Source of Comment.
+type Table0105 string
+
+const (
+	Table0105_L Table0105 = `L` // Ancillary (filler) department is source of comment
+	Table0105_O Table0105 = `O` // Other system is source of comment
+	Table0105_P Table0105 = `P` // Orderer (placer) is source of comment
+)
+
+// TableID returns the table ID.
+func (Table0105) TableID() string { return `0105` }
+
+// Valid reports if the value is in the table.
+func (v Table0105) Valid() bool { return TableValueLookup[`0105`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0105) Description() string { return tableDescription(`0105`, string(v)) }
+
+// Table0110 is a value of table 0110, Transfer to Bad Debt Code.
+type Table0110 string
+
+// TableID returns the table ID.
+func (Table0110) TableID() string { return `0110` }
+
+// Valid reports if the value is in the table.
+func (v Table0110) Valid() bool { return TableValueLookup[`0110`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0110) Description() string { return tableDescription(`0110`, string(v)) }
+
+// Table0111 is a value of table 0111, Delete Account Code.
+type Table0111 string
+
+// TableID returns the table ID.
+func (Table0111) TableID() string { return `0111` }
+
+// Valid reports if the value is in the table.
+func (v Table0111) Valid() bool { return TableValueLookup[`0111`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0111) Description() string { return tableDescription(`0111`, string(v)) }
+
+// Table0112 is a value of table 0112, Discharge Disposition.
+type Table0112 string
+
+// TableID returns the table ID.
+func (Table0112) TableID() string { return `0112` }
+
+// Valid reports if the value is in the table.
+func (v Table0112) Valid() bool { return TableValueLookup[`0112`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0112) Description() string { return tableDescription(`0112`, string(v)) }
+
+// Table0113 is a value of table 0113, Discharged to Location.
+type Table0113 string
+
+// TableID returns the table ID.
+func (Table0113) TableID() string { return `0113` }
+
+// Valid reports if the value is in the table.
+func (v Table0113) Valid() bool { return TableValueLookup[`0113`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0113) Description() string { return tableDescription(`0113`, string(v)) }
+
+// Table0114 is a value of table 0114, Diet Type.
+type Table0114 string
+
+// TableID returns the table ID.
+func (Table0114) TableID() string { return `0114` }
+
+// Valid reports if the value is in the table.
+func (v Table0114) Valid() bool { return TableValueLookup[`0114`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0114) Description() string { return tableDescription(`0114`, string(v)) }
+
+// Table0115 is a value of table 0115, Servicing Facility.
+type Table0115 string
+
+// TableID returns the table ID.
+func (Table0115) TableID() string { return `0115` }
+
+// Valid reports if the value is in the table.
+func (v Table0115) Valid() bool { return TableValueLookup[`0115`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0115) Description() string { return tableDescription(`0115`, string(v)) }
+
+// Table0116 is a value of table 0116, Bed Status.
+type Table0116 string
+
+const (
+	Table0116_C Table0116 = `C` // Closed
+	Table0116_H Table0116 = `H` // Housekeeping
+	Table0116_I Table0116 = `I` // Isolated
+	Table0116_K Table0116 = `K` // Contaminated
+	Table0116_O Table0116 = `O` // Occupied
+	Table0116_U Table0116 = `U` // Unoccupied
+)
+
+// TableID returns the table ID.
+func (Table0116) TableID() string { return `0116` }
+
+// Valid reports if the value is in the table.
+func (v Table0116) Valid() bool { return TableValueLookup[`0116`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0116) Description() string { return tableDescription(`0116`, string(v)) }
+
+// Table0117 is a value of table 0117, Account Status.
+type Table0117 string
+
+// TableID returns the table ID.
+func (Table0117) TableID() string { return `0117` }
+
+// Valid reports if the value is in the table.
+func (v Table0117) Valid() bool { return TableValueLookup[`0117`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0117) Description() string { return tableDescription(`0117`, string(v)) }
+
+// Table0118 is a value of table 0118, Major Diagnostic Category.
+type Table0118 string
+
+// TableID returns the table ID.
+func (Table0118) TableID() string { return `0118` }
+
+// Valid reports if the value is in the table.
+func (v Table0118) Valid() bool { return TableValueLookup[`0118`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0118) Description() string { return tableDescription(`0118`, string(v)) }
+
+// Table0119 is a value of table 0119, Order Control Codes.
+type Table0119 string
+
+const (
+	Table0119_AF Table0119 = `AF` // Order/service refill request approval
+	Table0119_CA Table0119 = `CA` // Cancel order/service request
+	Table0119_CH Table0119 = `CH` // Child order/service
+	Table0119_CN Table0119 = `CN` // Combined result
+	Table0119_CR Table0119 = `CR` // Canceled as requested
+	Table0119_DC Table0119 = `DC` // Discontinue order/service request
+	Table0119_DE Table0119 = `DE` // Data errors
+	Table0119_DF Table0119 = `DF` // Order/service refill request denied
+	Table0119_DR Table0119 = `DR` // Discontinued as requested
+	Table0119_FU Table0119 = `FU` // Order/service refilled, unsolicited
+	Table0119_HD Table0119 = `HD` // Hold order request
+	Table0119_HR Table0119 = `HR` // On hold as requested
+	Table0119_LI Table0119 = `LI` // Link order/service to patient care problem or goal
+	Table0119_MC Table0119 = `MC` // Miscellaneous Charge - not associated with an order
+	Table0119_NA Table0119 = `NA` // Number assigned
+	Table0119_NW Table0119 = `NW` // New order/service
+	Table0119_OC Table0119 = `OC` // Order/service canceled
+	Table0119_OD Table0119 = `OD` // Order/service discontinued
+	Table0119_OE Table0119 = `OE` // Order/service released
+	Table0119_OF Table0119 = `OF` // Order/service refilled as requested
+	Table0119_OH Table0119 = `OH` // Order/service held
+	Table0119_OK Table0119 = `OK` // Order/service accepted & OK
+	Table0119_OP Table0119 = `OP` // Notification of order for outside dispense
+	Table0119_OR Table0119 = `OR` // Released as requested
+	Table0119_PA Table0119 = `PA` // Parent order/service
+	Table0119_PR Table0119 = `PR` // Previous Results with new order/service
+	Table0119_PY Table0119 = `PY` // Notification of replacement order for outside dispense
+	Table0119_RE Table0119 = `RE` // Observations/Performed Service to follow
+	Table0119_RF Table0119 = `RF` // Refill order/service request
+	Table0119_RL Table0119 = `RL` // Release previous hold
+	Table0119_RO Table0119 = `RO` // Replacement order
+	Table0119_RP Table0119 = `RP` // Order/service replace request
+	Table0119_RQ Table0119 = `RQ` // Replaced as requested
+	Table0119_RR Table0119 = `RR` // Request received
+	Table0119_RU Table0119 = `RU` // Replaced unsolicited
+	Table0119_SC Table0119 = `SC` // Status changed
+	Table0119_SN Table0119 = `SN` // Send order/service number
+	Table0119_SR Table0119 = `SR` // Response to send order/service status request
+	Table0119_SS Table0119 = `SS` // Send order/service status request
+	Table0119_UA Table0119 = `UA` // Unable to accept order/service
+	Table0119_UC Table0119 = `UC` // Unable to cancel
+	Table0119_UD Table0119 = `UD` // Unable to discontinue
+	Table0119_UF Table0119 = `UF` // Unable to refill
+	Table0119_UH Table0119 = `UH` // Unable to put on hold
+	Table0119_UM Table0119 = `UM` // Unable to replace
+	Table0119_UN Table0119 = `UN` // Unlink order/service from patient care problem or goal
+	Table0119_UR Table0119 = `UR` // Unable to release
+	Table0119_UX Table0119 = `UX` // Unable to change
+	Table0119_XO Table0119 = `XO` // Change order/service request
+	Table0119_XR Table0119 = `XR` // Changed as requested
+	Table0119_XX Table0119 = `XX` // Order/service changed, unsol.
+)
+
+// TableID returns the table ID.
+func (Table0119) TableID() string { return `0119` }
+
+// Valid reports if the value is in the table.
+func (v Table0119) Valid() bool { return TableValueLookup[`0119`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0119) Description() string { return tableDescription(`0119`, string(v)) }
+
+// Table0121 is a value of table 0121, Response Flag.
+type Table0121 string
+
+const (
+	Table0121_D Table0121 = `D` // Same as R, also other associated segments
+	Table0121_E Table0121 = `E` // Report exceptions only
+	Table0121_F Table0121 = `F` // Same as D, plus confirmations explicitly
+	Table0121_N Table0121 = `N` // Only the MSA segment is returned
+	Table0121_R Table0121 = `R` // Same as E, also Replacement and Parent-Child
+)
+
+// TableID returns the table ID.
+func (Table0121) TableID() string { return `0121` }
+
+// Valid reports if the value is in the table.
+func (v Table0121) Valid() bool { return TableValueLookup[`0121`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0121) Description() string { return tableDescription(`0121`, string(v)) }
+
+// Table0122 is a value of table 0122, Charge Type.
+type Table0122 string
+
+const (
+	Table0122_CH Table0122 = `CH` // Charge
+	Table0122_CO Table0122 = `CO` // Contract
+	Table0122_CR Table0122 = `CR` // Credit
+	Table0122_DP Table0122 = `DP` // Department
+	Table0122_GR Table0122 = `GR` // Grant
+	Table0122_NC Table0122 = `NC` // No Charge
+	Table0122_PC Table0122 = `PC` // Professional
+	Table0122_RS Table0122 = `RS` // Research
+)
+
+// TableID returns the table ID.
+func (Table0122) TableID() string { return `0122` }
+
+// Valid reports if the value is in the table.
+func (v Table0122) Valid() bool { return TableValueLookup[`0122`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0122) Description() string { return tableDescription(`0122`, string(v)) }
+
+// Table0123 is a value of table 0123, Result Status.
+type Table0123 string
+
+const (
+	Table0123_A Table0123 = `A` // Some, but not all, results available
+	Table0123_C Table0123 = `C` // Correction to results
+	Table0123_F Table0123 = `F` // Final results; results stored and verified. Can only be changed with a corrected result.
+	Table0123_I Table0123 = `I` // No results available; specimen received, procedure incomplete
+	Table0123_O Table0123 = `O` // Order received; specimen not yet received
+	Table0123_P Table0123 = `P` // Preliminary: A verified early result is available, final results not yet obtained
+	Table0123_R Table0123 = `R` // Results stored; not yet verified
+	Table0123_S Table0123 = `S` // No results available; procedure scheduled, but not done
+	Table0123_X Table0123 = `X` // No results available; Order canceled.
+	Table0123_Y Table0123 = `Y` // No order on record for this test. (Used only on queries)
+	Table0123_Z Table0123 = `Z` // No record of this patient. (Used only on queries)
+)
+
+// TableID returns the table ID.
+func (Table0123) TableID() string { return `0123` }
+
+// Valid reports if the value is in the table.
+func (v Table0123) Valid() bool { return TableValueLookup[`0123`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0123) Description() string { return tableDescription(`0123`, string(v)) }
+
+// Table0124 is a value of table 0124, Transportation Mode.
+type Table0124 string
+
+const (
+	Table0124_CART Table0124 = `CART` // Cart - patient travels on cart or gurney
+	Table0124_PORT Table0124 = `PORT` // The examining device goes to patient's location
+	Table0124_WALK Table0124 = `WALK` // Patient walks to diagnostic service
+	Table0124_WHLC Table0124 = `WHLC` // Wheelchair
+)
+
+// TableID returns the table ID.
+func (Table0124) TableID() string { return `0124` }
+
+// Valid reports if the value is in the table.
+func (v Table0124) Valid() bool { return TableValueLookup[`0124`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0124) Description() string { return tableDescription(`0124`, string(v)) }
+
+// Table0125 is a value of table 0125, Value Type.
+type Table0125 string
+
+const (
+	Table0125_AD  Table0125 = `AD`  // Address
+	Table0125_CF  Table0125 = `CF`  // Coded Element With Formatted Values
+	Table0125_CK  Table0125 = `CK`  // Composite ID With Check Digit
+	Table0125_CN  Table0125 = `CN`  // Composite ID And Name
+	Table0125_CNE Table0125 = `CNE` // Coded with No Exceptions
+	Table0125_CP  Table0125 = `CP`  // Composite Price
+	Table0125_CWE Table0125 = `CWE` // Coded Entry
+	Table0125_CX  Table0125 = `CX`  // Extended Composite ID With Check Digit
+	Table0125_DR  Table0125 = `DR`  // Date/Time Range
+	Table0125_DT  Table0125 = `DT`  // Date
+	Table0125_DTM Table0125 = `DTM` // Time Stamp (Date & Time)
+	Table0125_ED  Table0125 = `ED`  // Encapsulated Data
+	Table0125_FT  Table0125 = `FT`  // Formatted Text (Display)
+	Table0125_ID  Table0125 = `ID`  // Coded Value for HL7 Defined Tables
+	Table0125_IS  Table0125 = `IS`  // Coded Value for User-Defined Tables
+	Table0125_MA  Table0125 = `MA`  // Multiplexed Array
+	Table0125_MO  Table0125 = `MO`  // Money
+	Table0125_NA  Table0125 = `NA`  // Numeric Array
+	Table0125_NM  Table0125 = `NM`  // Numeric
+	Table0125_PN  Table0125 = `PN`  // Person Name
+	Table0125_RP  Table0125 = `RP`  // Reference Pointer
+	Table0125_SN  Table0125 = `SN`  // Structured Numeric
+	Table0125_ST  Table0125 = `ST`  // String Data.
+	Table0125_TM  Table0125 = `TM`  // Time
+	Table0125_TN  Table0125 = `TN`  // Telephone Number
+	Table0125_TX  Table0125 = `TX`  // Text Data (Display)
+	Table0125_XAD Table0125 = `XAD` // Extended Address
+	Table0125_XCN Table0125 = `XCN` // Extended Composite Name And Number For Persons
+	Table0125_XON Table0125 = `XON` // Extended Composite Name And Number For Organizations
+	Table0125_XPN Table0125 = `XPN` // Extended Person Name
+	Table0125_XTN Table0125 = `XTN` // Extended Telecommunications Number
+)
+
+// TableID returns the table ID.
+func (Table0125) TableID() string { return `0125` }
+
+// Valid reports if the value is in the table.
+func (v Table0125) Valid() bool { return TableValueLookup[`0125`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0125) Description() string { return tableDescription(`0125`, string(v)) }
+
+// Table0127 is a value of table 0127, Allergen Type.
+type Table0127 string
+
+const (
+	Table0127_AA Table0127 = `AA` // Animal Allergy
+	Table0127_DA Table0127 = `DA` // Drug allergy
+	Table0127_EA Table0127 = `EA` // Environmental Allergy
+	Table0127_FA Table0127 = `FA` // Food allergy
+	Table0127_LA Table0127 = `LA` // Pollen Allergy
+	Table0127_MA Table0127 = `MA` // Miscellaneous allergy
+	Table0127_MC Table0127 = `MC` // Miscellaneous contraindication
+	Table0127_PA Table0127 = `PA` // Plant Allergy
+)
+
+// TableID returns the table ID.
+func (Table0127) TableID() string { return `0127` }
+
+// Valid reports if the value is in the table.
+func (v Table0127) Valid() bool { return TableValueLookup[`0127`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0127) Description() string { return tableDescription(`0127`, string(v)) }
+
+// Table0128 is a value of table 0128, Allergy Severity.
+type Table0128 string
+
+const (
+	Table0128_MI Table0128 = `MI` // Mild
+	Table0128_MO Table0128 = `MO` // Moderate
+	Table0128_SV Table0128 = `SV` // Severe
+	Table0128_U  Table0128 = `U`  // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0128) TableID() string { return `0128` }
+
+// Valid reports if the value is in the table.
+func (v Table0128) Valid() bool { return TableValueLookup[`0128`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0128) Description() string { return tableDescription(`0128`, string(v)) }
+
+// Table0129 is a value of table 0129, Accommodation Code.
+type Table0129 string
+
+// TableID returns the table ID.
+func (Table0129) TableID() string { return `0129` }
+
+// Valid reports if the value is in the table.
+func (v Table0129) Valid() bool { return TableValueLookup[`0129`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0129) Description() string { return tableDescription(`0129`, string(v)) }
+
+// Table0130 is a value of table 0130, Visit User Code.
+type Table0130 string
+
+const (
+	Table0130_HO Table0130 = `HO` // Home
+	Table0130_MO Table0130 = `MO` // Mobile Unit
+	Table0130_PH Table0130 = `PH` // Phone
+	Table0130_TE Table0130 = `TE` // Teaching
+)
+
+// TableID returns the table ID.
+func (Table0130) TableID() string { return `0130` }
+
+// Valid reports if the value is in the table.
+func (v Table0130) Valid() bool { return TableValueLookup[`0130`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0130) Description() string { return tableDescription(`0130`, string(v)) }
+
+// Table0131 is a value of table 0131, Contact Role.
+type Table0131 string
+
+const (
+	Table0131_C Table0131 = `C` // Emergency Contact
+	Table0131_E Table0131 = `E` // Employer
+	Table0131_F Table0131 = `F` // Federal Agency
+	Table0131_I Table0131 = `I` // Insurance Company
+	Table0131_N Table0131 = `N` // Next-of-Kin
+	Table0131_O Table0131 = `O` // Other
+	Table0131_S Table0131 = `S` // State Agency
+	Table0131_U Table0131 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0131) TableID() string { return `0131` }
+
+// Valid reports if the value is in the table.
+func (v Table0131) Valid() bool { return TableValueLookup[`0131`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0131) Description() string { return tableDescription(`0131`, string(v)) }
+
+// Table0132 is a value of table 0132, Transaction Code.
+type Table0132 string
+
+// TableID returns the table ID.
+func (Table0132) TableID() string { return `0132` }
+
+// Valid reports if the value is in the table.
+func (v Table0132) Valid() bool { return TableValueLookup[`0132`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0132) Description() string { return tableDescription(`0132`, string(v)) }
+
+// Table0135 is a value of table 0135, Assignment of Benefits.
+type Table0135 string
+
+const (
+	Table0135_M Table0135 = `M` // Modified assignment
+	Table0135_N Table0135 = `N` // No
+	Table0135_Y Table0135 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0135) TableID() string { return `0135` }
+
+// Valid reports if the value is in the table.
+func (v Table0135) Valid() bool { return TableValueLookup[`0135`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0135) Description() string { return tableDescription(`0135`, string(v)) }
+
+// Table0136 is a value of table 0136, Yes/no Indicator.
+type Table0136 string
+
+const (
+	Table0136_N Table0136 = `N` // No
+	Table0136_Y Table0136 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0136) TableID() string { return `0136` }
+
+// Valid reports if the value is in the table.
+func (v Table0136) Valid() bool { return TableValueLookup[`0136`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0136) Description() string { return tableDescription(`0136`, string(v)) }
+
+// Table0137 is a value of table 0137, Mail Claim Party.
+type Table0137 string
+
+const (
+	Table0137_E Table0137 = `E` // Employer
+	Table0137_G Table0137 = `G` // Guarantor
+	Table0137_I Table0137 = `I` // Insurance company
+	Table0137_O Table0137 = `O` // Other
+	Table0137_P Table0137 = `P` // Patient
+)
+
+// TableID returns the table ID.
+func (Table0137) TableID() string { return `0137` }
+
+// Valid reports if the value is in the table.
+func (v Table0137) Valid() bool { return TableValueLookup[`0137`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0137) Description() string { return tableDescription(`0137`, string(v)) }
+
+// Table0139 is a value of table 0139, Employer Information Data.
+type Table0139 string
+
+// TableID returns the table ID.
+func (Table0139) TableID() string { return `0139` }
+
+// Valid reports if the value is in the table.
+func (v Table0139) Valid() bool { return TableValueLookup[`0139`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0139) Description() string { return tableDescription(`0139`, string(v)) }
+
+// Table0140 is a value of table 0140, Military Service.
+type Table0140 string
+
+const (
+	Table0140_AUSA  Table0140 = `AUSA`  // Australian Army
+	Table0140_AUSAF Table0140 = `AUSAF` // Australian Air Force
+	Table0140_AUSN  Table0140 = `AUSN`  // Australian Navy
+	Table0140_NATO  Table0140 = `NATO`  // North Atlantic Treaty Organization
+	Table0140_NOAA  Table0140 = `NOAA`  // National Oceanic and Atmospheric Administration
+	Table0140_USA   Table0140 = `USA`   // US Army
+	Table0140_USAF  Table0140 = `USAF`  // US Air Force
+	Table0140_USCG  Table0140 = `USCG`  // US Coast Guard
+	Table0140_USMC  Table0140 = `USMC`  // US Marine Corps
+	Table0140_USN   Table0140 = `USN`   // US Navy
+	Table0140_USPHS Table0140 = `USPHS` // US Public Health Service
+)
+
+// TableID returns the table ID.
+func (Table0140) TableID() string { return `0140` }
+
+// Valid reports if the value is in the table.
+func (v Table0140) Valid() bool { return TableValueLookup[`0140`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0140) Description() string { return tableDescription(`0140`, string(v)) }
+
+// Table0141 is a value of table 0141, Military Rank/Grade.
+type Table0141 string
+
+const (
+	Table0141_E1____E9  Table0141 = `E1... E9`  // Enlisted
+	Table0141_O1_____O9 Table0141 = `O1 ... O9` // Officers
+	Table0141_W1_____W4 Table0141 = `W1 ... W4` // Warrant Officers
+)
+
+// TableID returns the table ID.
+func (Table0141) TableID() string { return `0141` }
+
+// Valid reports if the value is in the table.
+func (v Table0141) Valid() bool { return TableValueLookup[`0141`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0141) Description() string { return tableDescription(`0141`, string(v)) }
+
+// Table0142 is a value of table 0142, Military Status.
+type Table0142 string
+
+const (
+	Table0142_ACT Table0142 = `ACT` // Active duty
+	Table0142_DEC Table0142 = `DEC` // Deceased
+	Table0142_RET Table0142 = `RET` // Retired
+)
+
+// TableID returns the table ID.
+func (Table0142) TableID() string { return `0142` }
+
+// Valid reports if the value is in the table.
+func (v Table0142) Valid() bool { return TableValueLookup[`0142`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0142) Description() string { return tableDescription(`0142`, string(v)) }
+
+// Table0143 is a value of table 0143, Non-covered Insurance Code.
+type Table0143 string
+
+// TableID returns the table ID.
+func (Table0143) TableID() string { return `0143` }
+
+// Valid reports if the value is in the table.
+func (v Table0143) Valid() bool { return TableValueLookup[`0143`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0143) Description() string { return tableDescription(`0143`, string(v)) }
+
+// Table0144 is a value of table 0144, Eligibility Source.
+type Table0144 string
+
+const (
+	Table0144_1 Table0144 = `1` // Insurance company
+	Table0144_2 Table0144 = `2` // Employer
+	Table0144_3 Table0144 = `3` // Insured presented policy
+	Table0144_4 Table0144 = `4` // Insured presented card
+	Table0144_5 Table0144 = `5` // Signed statement on file
+	Table0144_6 Table0144 = `6` // Verbal information
+	Table0144_7 Table0144 = `7` // None
+)
+
+// TableID returns the table ID.
+func (Table0144) TableID() string { return `0144` }
+
+// Valid reports if the value is in the table.
+func (v Table0144) Valid() bool { return TableValueLookup[`0144`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0144) Description() string { return tableDescription(`0144`, string(v)) }
+
+// Table0145 is a value of table 0145, Room Type.
+type Table0145 string
+
+const (
+	Table0145_2ICU Table0145 = `2ICU` // Second intensive care unit
+	Table0145_2PRI Table0145 = `2PRI` // Second private room
+	Table0145_2SPR Table0145 = `2SPR` // Second semi-private room
+	Table0145_ICU  Table0145 = `ICU`  // Intensive care unit
+	Table0145_PRI  Table0145 = `PRI`  // Private room
+	Table0145_SPR  Table0145 = `SPR`  // Semi-private room
+)
+
+// TableID returns the table ID.
+func (Table0145) TableID() string { return `0145` }
+
+// Valid reports if the value is in the table.
+func (v Table0145) Valid() bool { return TableValueLookup[`0145`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0145) Description() string { return tableDescription(`0145`, string(v)) }
+
+// Table0146 is a value of table 0146, Amount Type.
+type Table0146 string
+
+const (
+	Table0146_DF Table0146 = `DF` // Differential
+	Table0146_LM Table0146 = `LM` // Limit
+	Table0146_PC Table0146 = `PC` // Percentage
+	Table0146_RT Table0146 = `RT` // Rate
+	Table0146_UL Table0146 = `UL` // Unlimited
+)
+
+// TableID returns the table ID.
+func (Table0146) TableID() string { return `0146` }
+
+// Valid reports if the value is in the table.
+func (v Table0146) Valid() bool { return TableValueLookup[`0146`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0146) Description() string { return tableDescription(`0146`, string(v)) }
+
+// Table0147 is a value of table 0147, Policy Type.
+type Table0147 string
+
+const (
+	Table0147_2ANC Table0147 = `2ANC` // Second ancillary
+	Table0147_2MMD Table0147 = `2MMD` // Second major medical
+	Table0147_3MMD Table0147 = `3MMD` // Third major medical
+	Table0147_ANC  Table0147 = `ANC`  // Ancillary
+	Table0147_MMD  Table0147 = `MMD`  // Major medical
+)
+
+// TableID returns the table ID.
+func (Table0147) TableID() string { return `0147` }
+
+// Valid reports if the value is in the table.
+func (v Table0147) Valid() bool { return TableValueLookup[`0147`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0147) Description() string { return tableDescription(`0147`, string(v)) }
+
+// Table0148 is a value of table 0148, Money or Percentage Indicator.
+type Table0148 string
+
+const (
+	Table0148_AT Table0148 = `AT` // Currency amount
+	Table0148_PC Table0148 = `PC` // Percentage
+)
+
+// TableID returns the table ID.
+func (Table0148) TableID() string { return `0148` }
+
+// Valid reports if the value is in the table.
+func (v Table0148) Valid() bool { return TableValueLookup[`0148`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0148) Description() string { return tableDescription(`0148`, string(v)) }
+
+// Table0149 is a value of table 0149, Day Type.
+type Table0149 string
+
+const (
+	Table0149_AP Table0149 = `AP` // Approved
+	Table0149_DE Table0149 = `DE` // Denied
+	Table0149_PE Table0149 = `PE` // Pending
+)
+
+// TableID returns the table ID.
+func (Table0149) TableID() string { return `0149` }
+
+// Valid reports if the value is in the table.
+func (v Table0149) Valid() bool { return TableValueLookup[`0149`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0149) Description() string { return tableDescription(`0149`, string(v)) }
+
+// Table0150 is a value of table 0150, Certification Patient Type.
+type Table0150 string
+
+const (
+	Table0150_ER  Table0150 = `ER`  // Emergency
+	Table0150_IPE Table0150 = `IPE` // Inpatient elective
+	Table0150_OPE Table0150 = `OPE` // Outpatient elective
+	Table0150_UR  Table0150 = `UR`  // Urgent
+)
+
+// TableID returns the table ID.
+func (Table0150) TableID() string { return `0150` }
+
+// Valid reports if the value is in the table.
+func (v Table0150) Valid() bool { return TableValueLookup[`0150`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0150) Description() string { return tableDescription(`0150`, string(v)) }
+
+// Table0151 is a value of table 0151, Second Opinion Status.
+type Table0151 string
+
+// TableID returns the table ID.
+func (Table0151) TableID() string { return `0151` }
+
+// Valid reports if the value is in the table.
+func (v Table0151) Valid() bool { return TableValueLookup[`0151`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0151) Description() string { return tableDescription(`0151`, string(v)) }
+
+// Table0152 is a value of table 0152, Second Opinion Documentation Received.
+type Table0152 string
+
+// TableID returns the table ID.
+func (Table0152) TableID() string { return `0152` }
+
+// Valid reports if the value is in the table.
+func (v Table0152) Valid() bool { return TableValueLookup[`0152`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0152) Description() string { return tableDescription(`0152`, string(v)) }
+
+// Table0153 is a value of table 0153, Value Code.
+type Table0153 string
+
+// TableID returns the table ID.
+func (Table0153) TableID() string { return `0153` }
+
+// Valid reports if the value is in the table.
+func (v Table0153) Valid() bool { return TableValueLookup[`0153`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0153) Description() string { return tableDescription(`0153`, string(v)) }
+
+// Table0155 is a value of table 0155, Accept/Application Acknowledgment Conditions.
+type Table0155 string
+
+const (
+	Table0155_AL Table0155 = `AL` // Always
+	Table0155_ER Table0155 = `ER` // Error/reject conditions only
+	Table0155_NE Table0155 = `NE` // Never
+	Table0155_SU Table0155 = `SU` // Successful completion only
+)
+
+// TableID returns the table ID.
+func (Table0155) TableID() string { return `0155` }
+
+// Valid reports if the value is in the table.
+func (v Table0155) Valid() bool { return TableValueLookup[`0155`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0155) Description() string { return tableDescription(`0155`, string(v)) }
+
+// Table0159 is a value of table 0159, Diet Code Specification Type.
+type Table0159 string
+
+const (
+	Table0159_D Table0159 = `D` // Diet
+	Table0159_P Table0159 = `P` // Preference
+	Table0159_S Table0159 = `S` // Supplement
+)
+
+// TableID returns the table ID.
+func (Table0159) TableID() string { return `0159` }
+
+// Valid reports if the value is in the table.
+func (v Table0159) Valid() bool { return TableValueLookup[`0159`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0159) Description() string { return tableDescription(`0159`, string(v)) }
+
+// Table0160 is a value of table 0160, Tray Type.
+type Table0160 string
+
+const (
+	Table0160_EARLY Table0160 = `EARLY` // Early tray
+	Table0160_GUEST Table0160 = `GUEST` // Guest tray
+	Table0160_LATE  Table0160 = `LATE`  // Late tray
+	Table0160_MSG   Table0160 = `MSG`   // Tray message only
+	Table0160_NO    Table0160 = `NO`    // No tray
+)
+
+// TableID returns the table ID.
+func (Table0160) TableID() string { return `0160` }
+
+// Valid reports if the value is in the table.
+func (v Table0160) Valid() bool { return TableValueLookup[`0160`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0160) Description() string { return tableDescription(`0160`, string(v)) }
+
+// Table0161 is a value of table 0161, Allow Substitution.
+type Table0161 string
+
+const (
+	Table0161_G Table0161 = `G` // Allow generic substitutions.
+	Table0161_N Table0161 = `N` // Substitutions are NOT authorized. (This is the default - null.)
+	Table0161_T Table0161 = `T` // Allow therapeutic substitutions
+)
+
+// TableID returns the table ID.
+func (Table0161) TableID() string { return `0161` }
+
+// Valid reports if the value is in the table.
+func (v Table0161) Valid() bool { return TableValueLookup[`0161`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0161) Description() string { return tableDescription(`0161`, string(v)) }
+
+// Table0162 is a value of table 0162, Route of Administration.
+type Table0162 string
+
+const (
+	Table0162_AP  Table0162 = `AP`  // Apply Externally
+	Table0162_B   Table0162 = `B`   // Buccal
+	Table0162_DT  Table0162 = `DT`  // Dental
+	Table0162_EP  Table0162 = `EP`  // Epidural
+	Table0162_ET  Table0162 = `ET`  // Endotrachial Tube*
+	Table0162_GTT Table0162 = `GTT` // Gastrostomy Tube
+	Table0162_GU  Table0162 = `GU`  // GU Irrigant
+	Table0162_IA  Table0162 = `IA`  // Intra-arterial
+	Table0162_IB  Table0162 = `IB`  // Intrabursal
+	Table0162_IC  Table0162 = `IC`  // Intracardiac
+	Table0162_ICV Table0162 = `ICV` // Intracervical (uterus)
+	Table0162_ID  Table0162 = `ID`  // Intradermal
+	Table0162_IH  Table0162 = `IH`  // Inhalation
+	Table0162_IHA Table0162 = `IHA` // Intrahepatic Artery
+	Table0162_IM  Table0162 = `IM`  // Intramuscular
+	Table0162_IMR Table0162 = `IMR` // Immerse (Soak) Body Part
+	Table0162_IN  Table0162 = `IN`  // Intranasal
+	Table0162_IO  Table0162 = `IO`  // Intraocular
+	Table0162_IP  Table0162 = `IP`  // Intraperitoneal
+	Table0162_IS  Table0162 = `IS`  // Intrasynovial
+	Table0162_IT  Table0162 = `IT`  // Intrathecal
+	Table0162_IU  Table0162 = `IU`  // Intrauterine
+	Table0162_IV  Table0162 = `IV`  // Intravenous
+	Table0162_MM  Table0162 = `MM`  // Mucous Membrane
+	Table0162_MTH Table0162 = `MTH` // Mouth/Throat
+	Table0162_NG  Table0162 = `NG`  // Nasogastric
+	Table0162_NP  Table0162 = `NP`  // Nasal Prongs*
+	Table0162_NS  Table0162 = `NS`  // Nasal
+	Table0162_NT  Table0162 = `NT`  // Nasotrachial Tube
+	Table0162_OP  Table0162 = `OP`  // Ophthalmic
+	Table0162_OT  Table0162 = `OT`  // Otic
+	Table0162_OTH Table0162 = `OTH` // Other/Miscellaneous
+	Table0162_PF  Table0162 = `PF`  // Perfusion
+	Table0162_PO  Table0162 = `PO`  // Oral
+	Table0162_PR  Table0162 = `PR`  // Rectal
+	Table0162_RM  Table0162 = `RM`  // Rebreather Mask*
+	Table0162_SC  Table0162 = `SC`  // Subcutaneous
+	Table0162_SD  Table0162 = `SD`  // Soaked Dressing
+	Table0162_SL  Table0162 = `SL`  // Sublingual
+	Table0162_TD  Table0162 = `TD`  // Transdermal
+	Table0162_TL  Table0162 = `TL`  // Translingual
+	Table0162_TP  Table0162 = `TP`  // Topical
+	Table0162_TRA Table0162 = `TRA` // Tracheostomy*
+	Table0162_UR  Table0162 = `UR`  // Urethral
+	Table0162_VG  Table0162 = `VG`  // Vaginal
+	Table0162_VM  Table0162 = `VM`  // Ventimask
+	Table0162_WND Table0162 = `WND` // Wound
+)
+
+// TableID returns the table ID.
+func (Table0162) TableID() string { return `0162` }
+
+// Valid reports if the value is in the table.
+func (v Table0162) Valid() bool { return TableValueLookup[`0162`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0162) Description() string { return tableDescription(`0162`, string(v)) }
+
+// Table0163 is a value of table 0163, Body Site.
+type Table0163 string
+
+const (
+	Table0163_BE    Table0163 = `BE`    // Bilateral Ears
+	Table0163_BN    Table0163 = `BN`    // Bilateral Nares
+	Table0163_BU    Table0163 = `BU`    // Buttock
+	Table0163_CT    Table0163 = `CT`    // Chest Tube
+	Table0163_LA    Table0163 = `LA`    // Left Arm
+	Table0163_LAC   Table0163 = `LAC`   // Left Anterior Chest
+	Table0163_LACF  Table0163 = `LACF`  // Left Antecubital Fossa
+	Table0163_LD    Table0163 = `LD`    // Left Deltoid
+	Table0163_LE    Table0163 = `LE`    // Left Ear
+	Table0163_LEJ   Table0163 = `LEJ`   // Left External Jugular
+	Table0163_LF    Table0163 = `LF`    // Left Foot
+	Table0163_LG    Table0163 = `LG`    // Left Gluteus Medius
+	Table0163_LH    Table0163 = `LH`    // Left Hand
+	Table0163_LIJ   Table0163 = `LIJ`   // Left Internal Jugular
+	Table0163_LLAQ  Table0163 = `LLAQ`  // Left Lower Abd Quadrant
+	Table0163_LLFA  Table0163 = `LLFA`  // Left Lower Forearm
+	Table0163_LMFA  Table0163 = `LMFA`  // Left Mid Forearm
+	Table0163_LN    Table0163 = `LN`    // Left Naris
+	Table0163_LPC   Table0163 = `LPC`   // Left Posterior Chest
+	Table0163_LSC   Table0163 = `LSC`   // Left Subclavian
+	Table0163_LT    Table0163 = `LT`    // Left Thigh
+	Table0163_LUA   Table0163 = `LUA`   // Left Upper Arm
+	Table0163_LUAQ  Table0163 = `LUAQ`  // Left Upper Abd Quadrant
+	Table0163_LUFA  Table0163 = `LUFA`  // Left Upper Forearm
+	Table0163_LVG   Table0163 = `LVG`   // Left Ventragluteal
+	Table0163_LVL   Table0163 = `LVL`   // Left Vastus Lateralis
+	Table0163_NB    Table0163 = `NB`    // Nebulized
+	Table0163_OD    Table0163 = `OD`    // Right Eye
+	Table0163_OS    Table0163 = `OS`    // Left Eye
+	Table0163_OU    Table0163 = `OU`    // Bilateral Eyes
+	Table0163_PA    Table0163 = `PA`    // Perianal
+	Table0163_PERIN Table0163 = `PERIN` // Perineal
+	Table0163_RA    Table0163 = `RA`    // Right Arm
+	Table0163_RAC   Table0163 = `RAC`   // Right Anterior Chest
+	Table0163_RACF  Table0163 = `RACF`  // Right Antecubital Fossa
+	Table0163_RD    Table0163 = `RD`    // Right Deltoid
+	Table0163_RE    Table0163 = `RE`    // Right Ear
+	Table0163_REJ   Table0163 = `REJ`   // Right External Jugular
+	Table0163_RF    Table0163 = `RF`    // Right Foot
+	Table0163_RG    Table0163 = `RG`    // Right Gluteus Medius
+	Table0163_RH    Table0163 = `RH`    // Right Hand
+	Table0163_RIJ   Table0163 = `RIJ`   // Right Internal Jugular
+	Table0163_RLAQ  Table0163 = `RLAQ`  // Rt Lower Abd Quadrant
+	Table0163_RLFA  Table0163 = `RLFA`  // Right Lower Forearm
+	Table0163_RMFA  Table0163 = `RMFA`  // Right Mid Forearm
+	Table0163_RN    Table0163 = `RN`    // Right Naris
+	Table0163_RPC   Table0163 = `RPC`   // Right Posterior Chest
+	Table0163_RSC   Table0163 = `RSC`   // Right Subclavian
+	Table0163_RT    Table0163 = `RT`    // Right Thigh
+	Table0163_RUA   Table0163 = `RUA`   // Right Upper Arm
+	Table0163_RUAQ  Table0163 = `RUAQ`  // Right Upper Abd Quadrant
+	Table0163_RUFA  Table0163 = `RUFA`  // Right Upper Forearm
+	Table0163_RVG   Table0163 = `RVG`   // Right Ventragluteal
+	Table0163_RVL   Table0163 = `RVL`   // Right Vastus Lateralis
+)
+
+// TableID returns the table ID.
+func (Table0163) TableID() string { return `0163` }
+
+// Valid reports if the value is in the table.
+func (v Table0163) Valid() bool { return TableValueLookup[`0163`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0163) Description() string { return tableDescription(`0163`, string(v)) }
+
+// Table0164 is a value of table 0164, Administration Device.
+type Table0164 string
+
+const (
+	Table0164_AP   Table0164 = `AP`   // Applicator
+	Table0164_BT   Table0164 = `BT`   // Buretrol
+	Table0164_HL   Table0164 = `HL`   // Heparin Lock
+	Table0164_IPPB Table0164 = `IPPB` // IPPB
+	Table0164_IVP  Table0164 = `IVP`  // IV Pump
+	Table0164_IVS  Table0164 = `IVS`  // IV Soluset
+	Table0164_MI   Table0164 = `MI`   // Metered Inhaler
+	Table0164_NEB  Table0164 = `NEB`  // Nebulizer
+	Table0164_PCA  Table0164 = `PCA`  // PCA Pump
+)
+
+// TableID returns the table ID.
+func (Table0164) TableID() string { return `0164` }
+
+// Valid reports if the value is in the table.
+func (v Table0164) Valid() bool { return TableValueLookup[`0164`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0164) Description() string { return tableDescription(`0164`, string(v)) }
+
+// Table0165 is a value of table 0165, Administration Method.
+type Table0165 string
+
+const (
+	Table0165_CH   Table0165 = `CH`   // Chew
+	Table0165_DI   Table0165 = `DI`   // Dissolve
+	Table0165_DU   Table0165 = `DU`   // Dust
+	Table0165_IF   Table0165 = `IF`   // Infiltrate
+	Table0165_IR   Table0165 = `IR`   // Irrigate
+	Table0165_IS   Table0165 = `IS`   // Insert
+	Table0165_IVP  Table0165 = `IVP`  // IV Push
+	Table0165_IVPB Table0165 = `IVPB` // IV Piggyback
+	Table0165_NB   Table0165 = `NB`   // Nebulized
+	Table0165_PF   Table0165 = `PF`   // Perfuse
+	Table0165_PT   Table0165 = `PT`   // Paint
+	Table0165_SH   Table0165 = `SH`   // Shampoo
+	Table0165_SO   Table0165 = `SO`   // Soak
+	Table0165_WA   Table0165 = `WA`   // Wash
+	Table0165_WI   Table0165 = `WI`   // Wipe
+)
+
+// TableID returns the table ID.
+func (Table0165) TableID() string { return `0165` }
+
+// Valid reports if the value is in the table.
+func (v Table0165) Valid() bool { return TableValueLookup[`0165`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0165) Description() string { return tableDescription(`0165`, string(v)) }
+
+// Table0166 is a value of table 0166, RX Component Type.
+type Table0166 string
+
+const (
+	Table0166_A Table0166 = `A` // Additive
+	Table0166_B Table0166 = `B` // Base
+)
+
+// TableID returns the table ID.
+func (Table0166) TableID() string { return `0166` }
+
+// Valid reports if the value is in the table.
+func (v Table0166) Valid() bool { return TableValueLookup[`0166`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0166) Description() string { return tableDescription(`0166`, string(v)) }
+
+// Table0167 is a value of table 0167, Substitution Status.
+type Table0167 string
+
+const (
+	Table0167_0 Table0167 = `0` // No product selection indicated
+	Table0167_1 Table0167 = `1` // Substitution not allowed by prescriber
+	Table0167_2 Table0167 = `2` // Substitution allowed - patient requested product dispensed
+	Table0167_3 Table0167 = `3` // Substitution allowed - pharmacist selected product dispensed
+	Table0167_4 Table0167 = `4` // Substitution allowed - generic drug not in stock
+	Table0167_5 Table0167 = `5` // Substitution allowed - brand drug dispensed as a generic
+	Table0167_7 Table0167 = `7` // Substitution not allowed - brand drug mandated by law
+	Table0167_8 Table0167 = `8` // Substitution allowed - generic drug not available in marketplace
+	Table0167_G Table0167 = `G` // A generic substitution was dispensed.
+	Table0167_N Table0167 = `N` // No substitute was dispensed. This is equivalent to the default (null) value.
+	Table0167_T Table0167 = `T` // A therapeutic substitution was dispensed.
+)
+
+// TableID returns the table ID.
+func (Table0167) TableID() string { return `0167` }
+
+// Valid reports if the value is in the table.
+func (v Table0167) Valid() bool { return TableValueLookup[`0167`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0167) Description() string { return tableDescription(`0167`, string(v)) }
+
+// Table0168 is a value of table 0168, Processing Priority.
+type Table0168 string
+
+const (
+	Table0168_A Table0168 = `A` // As soon as possible (a priority lower than stat)
+	Table0168_B Table0168 = `B` // Do at bedside or portable (may be used with other codes)
+	Table0168_C Table0168 = `C` // Measure continuously (e.g., arterial line blood pressure)
+	Table0168_P Table0168 = `P` // Preoperative (to be done prior to surgery)
+	Table0168_R Table0168 = `R` // Routine
+	Table0168_S Table0168 = `S` // Stat (do immediately)
+	Table0168_T Table0168 = `T` // Timing critical (do as near as possible to requested time)
+)
+
+// TableID returns the table ID.
+func (Table0168) TableID() string { return `0168` }
+
+// Valid reports if the value is in the table.
+func (v Table0168) Valid() bool { return TableValueLookup[`0168`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0168) Description() string { return tableDescription(`0168`, string(v)) }
+
+// Table0169 is a value of table 0169, Reporting Priority.
+type Table0169 string
+
+const (
+	Table0169_C Table0169 = `C` // Call back results
+	Table0169_R Table0169 = `R` // Rush reporting
+)
+
+// TableID returns the table ID.
+func (Table0169) TableID() string { return `0169` }
+
+// Valid reports if the value is in the table.
+func (v Table0169) Valid() bool { return TableValueLookup[`0169`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0169) Description() string { return tableDescription(`0169`, string(v)) }
+
+// Table0170 is a value of table 0170, Derived Specimen.
+type Table0170 string
+
+const (
+	Table0170_C Table0170 = `C` // Child Observation
+	Table0170_N Table0170 = `N` // Not Applicable
+	Table0170_P Table0170 = `P` // Parent Observation
+)
+
+// TableID returns the table ID.
+func (Table0170) TableID() string { return `0170` }
+
+// Valid reports if the value is in the table.
+func (v Table0170) Valid() bool { return TableValueLookup[`0170`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0170) Description() string { return tableDescription(`0170`, string(v)) }
+
+// Table0171 is a value of table 0171, Citizenship.
+type Table0171 string
+
+// TableID returns the table ID.
+func (Table0171) TableID() string { return `0171` }
+
+// Valid reports if the value is in the table.
+func (v Table0171) Valid() bool { return TableValueLookup[`0171`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0171) Description() string { return tableDescription(`0171`, string(v)) }
+
+// Table0172 is a value of table 0172, Veterans Military Status.
+type Table0172 string
+
+// TableID returns the table ID.
+func (Table0172) TableID() string { return `0172` }
+
+// Valid reports if the value is in the table.
+func (v Table0172) Valid() bool { return TableValueLookup[`0172`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0172) Description() string { return tableDescription(`0172`, string(v)) }
+
+// Table0173 is a value of table 0173, Coordination of Benefits.
+type Table0173 string
+
+const (
+	Table0173_CO Table0173 = `CO` // Coordination
+	Table0173_IN Table0173 = `IN` // Independent
+)
+
+// TableID returns the table ID.
+func (Table0173) TableID() string { return `0173` }
+
+// Valid reports if the value is in the table.
+func (v Table0173) Valid() bool { return TableValueLookup[`0173`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0173) Description() string { return tableDescription(`0173`, string(v)) }
+
+// Table0174 is a value of table 0174, Nature of Service/Test/Observation.
+type Table0174 string
+
+const (
+	Table0174_A Table0174 = `A` // Atomic service/test/observation (test code or treatment code)
+	Table0174_C Table0174 = `C` // Single observation calculated via a rule or formula from other independent observations (e.g., Alveolar-arterial ratio, cardiac output)
+	Table0174_F Table0174 = `F` // Functional procedure that may consist of one or more interrelated measures (e.g., glucose tolerance test, creatinine clearance), usually done at different times and/or on different specimens
+	Table0174_P Table0174 = `P` // Profile or battery consisting of many independent atomic observations (e.g., SMA12, electrolytes), usually done at one instrument on one specimen
+	Table0174_S Table0174 = `S` // Superset-a set of batteries or procedures ordered under a single code unit but processed as separate batteries (e.g., routines = CBC, UA, electrolytes)<p>This set indicates that the code being described is used to order multiple service/test/observation b
+)
+
+// TableID returns the table ID.
+func (Table0174) TableID() string { return `0174` }
+
+// Valid reports if the value is in the table.
+func (v Table0174) Valid() bool { return TableValueLookup[`0174`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0174) Description() string { return tableDescription(`0174`, string(v)) }
+
+// Table0175 is a value of table 0175, Master File Identifier Code.
+type Table0175 string
+
+const (
+	Table0175_CDM Table0175 = `CDM` // Charge description master file
+	Table0175_CLN Table0175 = `CLN` // Clinic master file
+	Table0175_CMA Table0175 = `CMA` // Clinical study with phases and scheduled master file
+	Table0175_CMB Table0175 = `CMB` // Clinical study without phases but with scheduled master file
+	Table0175_INV Table0175 = `INV` // Inventory master file
+	Table0175_LOC Table0175 = `LOC` // Location master file
+	Table0175_OMA Table0175 = `OMA` // Numerical observation master file
+	Table0175_OMB Table0175 = `OMB` // Categorical observation master file
+	Table0175_OMC Table0175 = `OMC` // Observation batteries master file
+	Table0175_OMD Table0175 = `OMD` // Calculated observations master file
+	Table0175_OME Table0175 = `OME` // Other Observation/Service Item master file
+	Table0175_PRA Table0175 = `PRA` // Practitioner master file
+	Table0175_STF Table0175 = `STF` // Staff master file
+)
+
+// TableID returns the table ID.
+func (Table0175) TableID() string { return `0175` }
+
+// Valid reports if the value is in the table.
+func (v Table0175) Valid() bool { return TableValueLookup[`0175`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0175) Description() string { return tableDescription(`0175`, string(v)) }
+
+// Table0177 is a value of table 0177, Confidentiality Code.
+type Table0177 string
+
+const (
+	Table0177_AID Table0177 = `AID` // AIDS patient
+	Table0177_EMP Table0177 = `EMP` // Employee
+	Table0177_ETH Table0177 = `ETH` // Alcohol/drug treatment patient
+	Table0177_HIV Table0177 = `HIV` // HIV(+) patient
+	Table0177_PSY Table0177 = `PSY` // Psychiatric patient
+	Table0177_R   Table0177 = `R`   // Restricted
+	Table0177_U   Table0177 = `U`   // Usual control
+	Table0177_UWM Table0177 = `UWM` // Unwed mother
+	Table0177_V   Table0177 = `V`   // Very restricted
+	Table0177_VIP Table0177 = `VIP` // Very important person or celebrity
+)
+
+// TableID returns the table ID.
+func (Table0177) TableID() string { return `0177` }
+
+// Valid reports if the value is in the table.
+func (v Table0177) Valid() bool { return TableValueLookup[`0177`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0177) Description() string { return tableDescription(`0177`, string(v)) }
+
+// Table0178 is a value of table 0178, File Level Event Code.
+type Table0178 string
+
+const (
+	Table0178_REP Table0178 = `REP` // Replace current version of this master file with the version contained in this message
+	Table0178_UPD Table0178 = `UPD` // Change file records as defined in the record-level event codes for each record that follows
+)
+
+// TableID returns the table ID.
+func (Table0178) TableID() string { return `0178` }
+
+// Valid reports if the value is in the table.
+func (v Table0178) Valid() bool { return TableValueLookup[`0178`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0178) Description() string { return tableDescription(`0178`, string(v)) }
+
+// Table0179 is a value of table 0179, Response Level.
+type Table0179 string
+
+const (
+	Table0179_AL Table0179 = `AL` // Always. All MFA segments (whether denoting errors or not) must be returned via the application-level acknowledgment message
+	Table0179_ER Table0179 = `ER` // Error/Reject conditions only. Only MFA segments denoting errors must be returned via the application-level acknowledgment for this message
+	Table0179_NE Table0179 = `NE` // Never. No application-level response needed
+	Table0179_SU Table0179 = `SU` // Success. Only MFA segments denoting success must be returned via the application-level acknowledgment for this message
+)
+
+// TableID returns the table ID.
+func (Table0179) TableID() string { return `0179` }
+
+// Valid reports if the value is in the table.
+func (v Table0179) Valid() bool { return TableValueLookup[`0179`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0179) Description() string { return tableDescription(`0179`, string(v)) }
+
+// Table0180 is a value of table 0180, Record-level Event Code.
+type Table0180 string
+
+const (
+	Table0180_MAC Table0180 = `MAC` // Reactivate deactivated record
+	Table0180_MAD Table0180 = `MAD` // Add record to master file
+	Table0180_MDC Table0180 = `MDC` // Deactivate: discontinue using record in master file, but do not delete from database
+	Table0180_MDL Table0180 = `MDL` // Delete record from master file
+	Table0180_MUP Table0180 = `MUP` // Update record for master file
+)
+
+// TableID returns the table ID.
+func (Table0180) TableID() string { return `0180` }
+
+// Valid reports if the value is in the table.
+func (v Table0180) Valid() bool { return TableValueLookup[`0180`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0180) Description() string { return tableDescription(`0180`, string(v)) }
+
+// Table0181 is a value of table 0181, MFN Record-level Error Return.
+type Table0181 string
+
+const (
+	Table0181_S Table0181 = `S` // Successful posting of the record defined by the MFE segment
+	Table0181_U Table0181 = `U` // Unsuccessful posting of the record defined by the MFE segment
+)
+
+// TableID returns the table ID.
+func (Table0181) TableID() string { return `0181` }
+
+// Valid reports if the value is in the table.
+func (v Table0181) Valid() bool { return TableValueLookup[`0181`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0181) Description() string { return tableDescription(`0181`, string(v)) }
+
+// Table0182 is a value of table 0182, Staff type.
+type Table0182 string
+
+// TableID returns the table ID.
+func (Table0182) TableID() string { return `0182` }
+
+// Valid reports if the value is in the table.
+func (v Table0182) Valid() bool { return TableValueLookup[`0182`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0182) Description() string { return tableDescription(`0182`, string(v)) }
+
+// Table0183 is a value of table 0183, Active/Inactive.
+type Table0183 string
+
+const (
+	Table0183_A Table0183 = `A` // Active Staff
+	Table0183_I Table0183 = `I` // Inactive Staff
+)
+
+// TableID returns the table ID.
+func (Table0183) TableID() string { return `0183` }
+
+// Valid reports if the value is in the table.
+func (v Table0183) Valid() bool { return TableValueLookup[`0183`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0183) Description() string { return tableDescription(`0183`, string(v)) }
+
+// Table0184 is a value of table 0184, Department.
+type Table0184 string
+
+// TableID returns the table ID.
+func (Table0184) TableID() string { return `0184` }
+
+// Valid reports if the value is in the table.
+func (v Table0184) Valid() bool { return TableValueLookup[`0184`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0184) Description() string { return tableDescription(`0184`, string(v)) }
+
+// Table0185 is a value of table 0185, Preferred Method of Contact.
+type Table0185 string
+
+const (
+	Table0185_B Table0185 = `B` // Beeper Number
+	Table0185_C Table0185 = `C` // Cellular Phone Number
+	Table0185_E Table0185 = `E` // E-Mail Address (for backward compatibility)
+	Table0185_F Table0185 = `F` // FAX Number
+	Table0185_H Table0185 = `H` // Home Phone Number
+	Table0185_O Table0185 = `O` // Office Phone Number
+)
+
+// TableID returns the table ID.
+func (Table0185) TableID() string { return `0185` }
+
+// Valid reports if the value is in the table.
+func (v Table0185) Valid() bool { return TableValueLookup[`0185`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0185) Description() string { return tableDescription(`0185`, string(v)) }
+
+// Table0186 is a value of table 0186, Practitioner Category.
+type Table0186 string
+
+// TableID returns the table ID.
+func (Table0186) TableID() string { return `0186` }
+
+// Valid reports if the value is in the table.
+func (v Table0186) Valid() bool { return TableValueLookup[`0186`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0186) Description() string { return tableDescription(`0186`, string(v)) }
+
+// Table0187 is a value of table 0187, Provider Billing.
+type Table0187 string
+
+const (
+	Table0187_I Table0187 = `I` // Institution bills for provider
+	Table0187_P Table0187 = `P` // Provider does own billing
+)
+
+// TableID returns the table ID.
+func (Table0187) TableID() string { return `0187` }
+
+// Valid reports if the value is in the table.
+func (v Table0187) Valid() bool { return TableValueLookup[`0187`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0187) Description() string { return tableDescription(`0187`, string(v)) }
+
+// Table0188 is a value of table 0188, Operator ID.
+type Table0188 string
+
+// TableID returns the table ID.
+func (Table0188) TableID() string { return `0188` }
+
+// Valid reports if the value is in the table.
+func (v Table0188) Valid() bool { return TableValueLookup[`0188`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0188) Description() string { return tableDescription(`0188`, string(v)) }
+
+// Table0189 is a value of table 0189, Ethnic Group.
+type Table0189 string
+
+const (
+	Table0189_H Table0189 = `H` // Hispanic or Latino
+	Table0189_N Table0189 = `N` // Not Hispanic or Latino
+	Table0189_U Table0189 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0189) TableID() string { return `0189` }
+
+// Valid reports if the value is in the table.
+func (v Table0189) Valid() bool { return TableValueLookup[`0189`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0189) Description() string { return tableDescription(`0189`, string(v)) }
+
+// Table0190 is a value of table 0190, Address Type.
+type Table0190 string
+
+const (
+	Table0190_B   Table0190 = `B`   // Firm/Business
+	Table0190_BA  Table0190 = `BA`  // Bad address
+	Table0190_BDL Table0190 = `BDL` // Birth delivery location (address where birth occurred)
+	Table0190_BI  Table0190 = `BI`  // Billing Address
+	Table0190_BR  Table0190 = `BR`  // Residence at birth (home address at time of birth)
+	Table0190_C   Table0190 = `C`   // Current Or Temporary
+	Table0190_F   Table0190 = `F`   // Country Of Origin
+	Table0190_H   Table0190 = `H`   // Home
+	Table0190_L   Table0190 = `L`   // Legal Address
+	Table0190_M   Table0190 = `M`   // Mailing
+	Table0190_N   Table0190 = `N`   // Birth (nee) (birth address, not otherwise specified)
+	Table0190_O   Table0190 = `O`   // Office/Business
+	Table0190_P   Table0190 = `P`   // Permanent
+	Table0190_RH  Table0190 = `RH`  // Registry home. Refers to the information system, typically managed by a public health agency, that stores patient information such as immunization histories or cancer data, regardless of where the patient obtains services.
+	Table0190_S   Table0190 = `S`   // Service Location
+	Table0190_SH  Table0190 = `SH`  // Shipping Address
+	Table0190_TM  Table0190 = `TM`  // Tube Address
+	Table0190_V   Table0190 = `V`   // Vacation
+)
+
+// TableID returns the table ID.
+func (Table0190) TableID() string { return `0190` }
+
+// Valid reports if the value is in the table.
+func (v Table0190) Valid() bool { return TableValueLookup[`0190`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0190) Description() string { return tableDescription(`0190`, string(v)) }
+
+// Table0191 is a value of table 0191, Type of Referenced Data.
+type Table0191 string
+
+const (
+	Table0191_AP        Table0191 = `AP`        // Other application data, typically uninterpreted binary data (HL7 V2.3 and later)
+	Table0191_AU        Table0191 = `AU`        // Audio data (HL7 V2.3 and later)
+	Table0191_FT        Table0191 = `FT`        // Formatted text (HL7 V2.2 only)
+	Table0191_IM        Table0191 = `IM`        // Image data (HL7 V2.3 and later)
+	Table0191_multipart Table0191 = `multipart` // MIME multipart package
+	Table0191_NS        Table0191 = `NS`        // Non-scanned image (HL7 V2.2 only)
+	Table0191_SD        Table0191 = `SD`        // Scanned document (HL7 V2.2 only)
+	Table0191_SI        Table0191 = `SI`        // Scanned image (HL7 V2.2 only)
+	Table0191_TEXT      Table0191 = `TEXT`      // Machine readable text document (HL7 V2.3.1 and later)
+	Table0191_TX        Table0191 = `TX`        // Machine readable text document (HL7 V2.2 only)
+)
+
+// TableID returns the table ID.
+func (Table0191) TableID() string { return `0191` }
+
+// Valid reports if the value is in the table.
+func (v Table0191) Valid() bool { return TableValueLookup[`0191`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0191) Description() string { return tableDescription(`0191`, string(v)) }
+
+// Table0193 is a value of table 0193, Amount Class.
+type Table0193 string
+
+const (
+	Table0193_AT Table0193 = `AT` // Amount
+	Table0193_LM Table0193 = `LM` // Limit
+	Table0193_PC Table0193 = `PC` // Percentage
+	Table0193_UL Table0193 = `UL` // Unlimited
+)
+
+// TableID returns the table ID.
+func (Table0193) TableID() string { return `0193` }
+
+// Valid reports if the value is in the table.
+func (v Table0193) Valid() bool { return TableValueLookup[`0193`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0193) Description() string { return tableDescription(`0193`, string(v)) }
+
+// Table0200 is a value of table 0200, Name Type.
+type Table0200 string
+
+const (
+	Table0200_A     Table0200 = `A`     // Assigned
+	Table0200_B     Table0200 = `B`     // Birth name
+	Table0200_BAD   Table0200 = `BAD`   // Bad Name
+	Table0200_C     Table0200 = `C`     // Adopted Name
+	Table0200_D     Table0200 = `D`     // Customary Name
+	Table0200_I     Table0200 = `I`     // Licensing Name
+	Table0200_K     Table0200 = `K`     // Business name
+	Table0200_L     Table0200 = `L`     // Official Registry Name
+	Table0200_M     Table0200 = `M`     // Maiden Name
+	Table0200_MSK   Table0200 = `MSK`   // Masked
+	Table0200_N     Table0200 = `N`     // Nickname
+	Table0200_NAV   Table0200 = `NAV`   // Temporarily Unavailable
+	Table0200_NB    Table0200 = `NB`    // Newborn Name
+	Table0200_NOUSE Table0200 = `NOUSE` // No Longer To Be Used
+	Table0200_P     Table0200 = `P`     // Name of Partner/Spouse
+	Table0200_R     Table0200 = `R`     // Registered Name
+	Table0200_REL   Table0200 = `REL`   // Religious
+	Table0200_S     Table0200 = `S`     // Pseudonym
+	Table0200_T     Table0200 = `T`     // Indigenous/Tribal
+	Table0200_TEMP  Table0200 = `TEMP`  // Temporary Name
+	Table0200_U     Table0200 = `U`     // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0200) TableID() string { return `0200` }
+
+// Valid reports if the value is in the table.
+func (v Table0200) Valid() bool { return TableValueLookup[`0200`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0200) Description() string { return tableDescription(`0200`, string(v)) }
+
+// Table0201 is a value of table 0201, Telecommunication Use Code.
+type Table0201 string
+
+const (
+	Table0201_ASN Table0201 = `ASN` // Answering Service Number
+	Table0201_BPN Table0201 = `BPN` // Beeper Number
+	Table0201_EMR Table0201 = `EMR` // Emergency Number
+	Table0201_NET Table0201 = `NET` // Network (email) Address
+	Table0201_ORN Table0201 = `ORN` // Other Residence Number
+	Table0201_PRN Table0201 = `PRN` // Primary Residence Number
+	Table0201_PRS Table0201 = `PRS` // Personal
+	Table0201_VHN Table0201 = `VHN` // Vacation Home Number
+	Table0201_WPN Table0201 = `WPN` // Work Number
+)
+
+// TableID returns the table ID.
+func (Table0201) TableID() string { return `0201` }
+
+// Valid reports if the value is in the table.
+func (v Table0201) Valid() bool { return TableValueLookup[`0201`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0201) Description() string { return tableDescription(`0201`, string(v)) }
+
+// Table0202 is a value of table 0202, Telecommunication Equipment Type.
+type Table0202 string
+
+const (
+	Table0202_BP       Table0202 = `BP`       // Beeper
+	Table0202_CP       Table0202 = `CP`       // Cellular or Mobile Phone
+	Table0202_FX       Table0202 = `FX`       // Fax
+	Table0202_Internet Table0202 = `Internet` // Internet Address
+	Table0202_MD       Table0202 = `MD`       // Modem
+	Table0202_PH       Table0202 = `PH`       // Telephone
+	Table0202_SAT      Table0202 = `SAT`      // Satellite Phone
+	Table0202_TDD      Table0202 = `TDD`      // Telecommunications Device for the Deaf
+	Table0202_TTY      Table0202 = `TTY`      // Teletypewriter
+	Table0202_X_400    Table0202 = `X.400`    // X.400 email address
+)
+
+// TableID returns the table ID.
+func (Table0202) TableID() string { return `0202` }
+
+// Valid reports if the value is in the table.
+func (v Table0202) Valid() bool { return TableValueLookup[`0202`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0202) Description() string { return tableDescription(`0202`, string(v)) }
+
+// Table0203 is a value of table 0203, Identifier Type.
+type Table0203 string
+
+const (
+	Table0203_ACSN                      Table0203 = `ACSN` // Accession ID
+	Table0203_AM                        Table0203 = `AM`   // American Express
+	Table0203_AMA                       Table0203 = `AMA`  // American Medical Association Number
+	Table0203_AN                        Table0203 = `AN`   // Account number
+	Table0203_An_Identifier_for_a_provi Table0203 = `An Identifier for a provi`
+	Table0203_ANC                       Table0203 = `ANC`   // Account number Creditor
+	Table0203_AND                       Table0203 = `AND`   // Account number debitor
+	Table0203_ANON                      Table0203 = `ANON`  // Anonymous identifier
+	Table0203_ANT                       Table0203 = `ANT`   // Temporary Account Number
+	Table0203_APRN                      Table0203 = `APRN`  // Advanced Practice Registered Nurse number
+	Table0203_ASID                      Table0203 = `ASID`  // Ancestor Specimen ID
+	Table0203_BA                        Table0203 = `BA`    // Bank Account Number
+	Table0203_BC                        Table0203 = `BC`    // Bank Card Number
+	Table0203_BCT                       Table0203 = `BCT`   // Birth Certificate
+	Table0203_BR                        Table0203 = `BR`    // Birth registry number
+	Table0203_BRN                       Table0203 = `BRN`   // Breed Registry Number
+	Table0203_BSNR                      Table0203 = `BSNR`  // Primary physician office number
+	Table0203_CC                        Table0203 = `CC`    // Cost Center number
+	Table0203_CONM                      Table0203 = `CONM`  // Change of Name Document
+	Table0203_CY                        Table0203 = `CY`    // County number
+	Table0203_CZ                        Table0203 = `CZ`    // Citizenship Card
+	Table0203_DDS                       Table0203 = `DDS`   // Dentist license number
+	Table0203_DEA                       Table0203 = `DEA`   // Drug Enforcement Administration registration number
+	Table0203_DFN                       Table0203 = `DFN`   // Drug Furnishing or prescriptive authority Number
+	Table0203_DI                        Table0203 = `DI`    // Diner's Club card
+	Table0203_DL                        Table0203 = `DL`    // Driver's license number
+	Table0203_DN                        Table0203 = `DN`    // Doctor number
+	Table0203_DO                        Table0203 = `DO`    // Osteopathic License number
+	Table0203_DP                        Table0203 = `DP`    // Diplomatic Passport
+	Table0203_DPM                       Table0203 = `DPM`   // Podiatrist license number
+	Table0203_DR                        Table0203 = `DR`    // Donor Registration Number
+	Table0203_DS                        Table0203 = `DS`    // Discover Card
+	Table0203_EI                        Table0203 = `EI`    // Employee number
+	Table0203_EN                        Table0203 = `EN`    // Employer number
+	Table0203_ESN                       Table0203 = `ESN`   // Staff Enterprise Number
+	Table0203_FI                        Table0203 = `FI`    // Facility ID
+	Table0203_GI                        Table0203 = `GI`    // Guarantor internal identifier
+	Table0203_GL                        Table0203 = `GL`    // General ledger number
+	Table0203_GN                        Table0203 = `GN`    // Guarantor external identifier
+	Table0203_HC                        Table0203 = `HC`    // Health Card Number
+	Table0203_IND                       Table0203 = `IND`   // Indigenous/Aboriginal
+	Table0203_JHN                       Table0203 = `JHN`   // Jurisdictional health number (Canada)
+	Table0203_LACSN                     Table0203 = `LACSN` // Laboratory Accession ID
+	Table0203_LANR                      Table0203 = `LANR`  // Lifelong physician number
+	Table0203_LI                        Table0203 = `LI`    // Labor and industries number
+	Table0203_LN                        Table0203 = `LN`    // License number
+	Table0203_LR                        Table0203 = `LR`    // Local Registry ID
+	Table0203_MA                        Table0203 = `MA`    // Patient Medicaid number
+	Table0203_MB                        Table0203 = `MB`    // Member Number
+	Table0203_MC                        Table0203 = `MC`    // Patient's Medicare number
+	Table0203_MCD                       Table0203 = `MCD`   // Practitioner Medicaid number
+	Table0203_MCN                       Table0203 = `MCN`   // Microchip Number
+	Table0203_MCR                       Table0203 = `MCR`   // Practitioner Medicare number
+	Table0203_MCT                       Table0203 = `MCT`   // Marriage Certificate
+	Table0203_MD                        Table0203 = `MD`    // Medical License number
+	Table0203_MI                        Table0203 = `MI`    // Military ID number
+	Table0203_MR                        Table0203 = `MR`    // Medical record number
+	Table0203_MRT                       Table0203 = `MRT`   // Temporary Medical Record Number
+	Table0203_MS                        Table0203 = `MS`    // MasterCard
+	Table0203_NBSNR                     Table0203 = `NBSNR` // Secondary physician office number
+	Table0203_NCT                       Table0203 = `NCT`   // Naturalization Certificate
+	Table0203_NE                        Table0203 = `NE`    // National employer identifier
+	Table0203_NH                        Table0203 = `NH`    // National Health Plan Identifier
+	Table0203_NI                        Table0203 = `NI`    // National unique individual identifier
+	Table0203_NII                       Table0203 = `NII`   // National Insurance Organization Identifier
+	Table0203_NIIP                      Table0203 = `NIIP`  // National Insurance Payor Identifier (Payor)
+	Table0203_NNxxx                     Table0203 = `NNxxx` // National Person Identifier where the xxx is the ISO table 3166 3-character (alphabetic) country code
+	Table0203_NP                        Table0203 = `NP`    // Nurse practitioner number
+	Table0203_NPI                       Table0203 = `NPI`   // National provider identifier
+	Table0203_OD                        Table0203 = `OD`    // Optometrist license number
+	Table0203_PA                        Table0203 = `PA`    // Physician Assistant number
+	Table0203_PC                        Table0203 = `PC`    // Parole Card
+	Table0203_PCN                       Table0203 = `PCN`   // Penitentiary/correctional institution Number
+	Table0203_PE                        Table0203 = `PE`    // Living Subject Enterprise Number
+	Table0203_PEN                       Table0203 = `PEN`   // Pension Number
+	Table0203_PI                        Table0203 = `PI`    // Patient internal identifier
+	Table0203_PN                        Table0203 = `PN`    // Person number
+	Table0203_PNT                       Table0203 = `PNT`   // Temporary Living Subject Number
+	Table0203_PPIN                      Table0203 = `PPIN`  // Medicare/CMS Performing Provider Identification Number
+	Table0203_PPN                       Table0203 = `PPN`   // Passport number
+	Table0203_PRC                       Table0203 = `PRC`   // Permanent Resident Card Number
+	Table0203_PRN                       Table0203 = `PRN`   // Provider number
+	Table0203_PT                        Table0203 = `PT`    // Patient external identifier
+	Table0203_QA                        Table0203 = `QA`    // QA number
+	Table0203_RI                        Table0203 = `RI`    // Resource identifier
+	Table0203_RN                        Table0203 = `RN`    // Registered Nurse Number
+	Table0203_RPH                       Table0203 = `RPH`   // Pharmacist license number
+	Table0203_RR                        Table0203 = `RR`    // Railroad Retirement number
+	Table0203_RRI                       Table0203 = `RRI`   // Regional registry ID
+	Table0203_RRP                       Table0203 = `RRP`   // Railroad Retirement Provider
+	Table0203_SID                       Table0203 = `SID`   // Specimen ID
+	Table0203_SL                        Table0203 = `SL`    // State license
+	Table0203_SN                        Table0203 = `SN`    // Subscriber Number
+	Table0203_SP                        Table0203 = `SP`    // Study Permit
+	Table0203_SR                        Table0203 = `SR`    // State registry ID
+	Table0203_SS                        Table0203 = `SS`    // Social Security number
+	Table0203_TAX                       Table0203 = `TAX`   // Tax ID number
+	Table0203_TN                        Table0203 = `TN`    // Treaty Number/ (Canada)
+	Table0203_TPR                       Table0203 = `TPR`   // Temporary Permanent Resident (Canada)
+	Table0203_U                         Table0203 = `U`     // Unspecified identifier
+	Table0203_UPIN                      Table0203 = `UPIN`  // Medicare/CMS (formerly HCFA)'s Universal Physician Identification numbers
+	Table0203_USID                      Table0203 = `USID`  // Unique Specimen ID
+	Table0203_VN                        Table0203 = `VN`    // Visit number
+	Table0203_VP                        Table0203 = `VP`    // Visitor Permit
+	Table0203_VS                        Table0203 = `VS`    // VISA
+	Table0203_WC                        Table0203 = `WC`    // WIC identifier
+	Table0203_WCN                       Table0203 = `WCN`   // Workers' Comp Number
+	Table0203_WP                        Table0203 = `WP`    // Work Permit
+	Table0203_XX                        Table0203 = `XX`    // Organization identifier
+)
+
+// TableID returns the table ID.
+func (Table0203) TableID() string { return `0203` }
+
+// Valid reports if the value is in the table.
+func (v Table0203) Valid() bool { return TableValueLookup[`0203`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0203) Description() string { return tableDescription(`0203`, string(v)) }
+
+// Table0204 is a value of table 0204, Organizational Name Type.
+type Table0204 string
+
+const (
+	Table0204_A  Table0204 = `A`  // Alias name
+	Table0204_D  Table0204 = `D`  // Display name
+	Table0204_L  Table0204 = `L`  // Legal name
+	Table0204_SL Table0204 = `SL` // Stock exchange listing name
+)
+
+// TableID returns the table ID.
+func (Table0204) TableID() string { return `0204` }
+
+// Valid reports if the value is in the table.
+func (v Table0204) Valid() bool { return TableValueLookup[`0204`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0204) Description() string { return tableDescription(`0204`, string(v)) }
+
+// Table0205 is a value of table 0205, Price Type.
+type Table0205 string
+
+const (
+	Table0205_AP Table0205 = `AP` // administrative price or handling fee
+	Table0205_DC Table0205 = `DC` // direct unit cost
+	Table0205_IC Table0205 = `IC` // indirect unit cost
+	Table0205_PF Table0205 = `PF` // professional fee for performing provider
+	Table0205_TF Table0205 = `TF` // technology fee for use of equipment
+	Table0205_TP Table0205 = `TP` // total price
+	Table0205_UP Table0205 = `UP` // unit price, may be based on length of procedure or service
+)
+
+// TableID returns the table ID.
+func (Table0205) TableID() string { return `0205` }
+
+// Valid reports if the value is in the table.
+func (v Table0205) Valid() bool { return TableValueLookup[`0205`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0205) Description() string { return tableDescription(`0205`, string(v)) }
+
+// Table0206 is a value of table 0206, Segment action code.
+type Table0206 string
+
+const (
+	Table0206_A Table0206 = `A` // Add/Insert
+	Table0206_D Table0206 = `D` // Delete
+	Table0206_U Table0206 = `U` // Update
+	Table0206_X Table0206 = `X` // No Change
+)
+
+// TableID returns the table ID.
+func (Table0206) TableID() string { return `0206` }
+
+// Valid reports if the value is in the table.
+func (v Table0206) Valid() bool { return TableValueLookup[`0206`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0206) Description() string { return tableDescription(`0206`, string(v)) }
+
+// Table0207 is a value of table 0207, Processing Mode.
+type Table0207 string
+
+const (
+	Table0207_A           Table0207 = `A`           // Archive
+	Table0207_I           Table0207 = `I`           // Initial load
+	Table0207_Not_present Table0207 = `Not present` // Not present (the default, meaning current processing)
+	Table0207_R           Table0207 = `R`           // Restore from archive
+	Table0207_T           Table0207 = `T`           // Current processing, transmitted at intervals (scheduled or on demand)
+)
+
+// TableID returns the table ID.
+func (Table0207) TableID() string { return `0207` }
+
+// Valid reports if the value is in the table.
+func (v Table0207) Valid() bool { return TableValueLookup[`0207`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0207) Description() string { return tableDescription(`0207`, string(v)) }
+
+// Table0208 is a value of table 0208, Query Response Status.
+type Table0208 string
+
+const (
+	Table0208_AE Table0208 = `AE` // Application error
+	Table0208_AR Table0208 = `AR` // Application reject
+	Table0208_NF Table0208 = `NF` // No data found, no errors
+	Table0208_OK Table0208 = `OK` // Data found, no errors (this is the default)
+)
+
+// TableID returns the table ID.
+func (Table0208) TableID() string { return `0208` }
+
+// Valid reports if the value is in the table.
+func (v Table0208) Valid() bool { return TableValueLookup[`0208`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0208) Description() string { return tableDescription(`0208`, string(v)) }
+
+// Table0211 is a value of table 0211, Alternate Character Sets.
+type Table0211 string
+
+const (
+	Table0211_8859_1         Table0211 = `8859/1`         // The printable characters from the ISO 8859/1 Character set
+	Table0211_8859_15        Table0211 = `8859/15`        // The printable characters from the ISO 8859/15 (Latin-15)
+	Table0211_8859_2         Table0211 = `8859/2`         // The printable characters from the ISO 8859/2 Character set
+	Table0211_8859_3         Table0211 = `8859/3`         // The printable characters from the ISO 8859/3 Character set
+	Table0211_8859_4         Table0211 = `8859/4`         // The printable characters from the ISO 8859/4 Character set
+	Table0211_8859_5         Table0211 = `8859/5`         // The printable characters from the ISO 8859/5 Character set
+	Table0211_8859_6         Table0211 = `8859/6`         // The printable characters from the ISO 8859/6 Character set
+	Table0211_8859_7         Table0211 = `8859/7`         // The printable characters from the ISO 8859/7 Character set
+	Table0211_8859_8         Table0211 = `8859/8`         // The printable characters from the ISO 8859/8 Character set
+	Table0211_8859_9         Table0211 = `8859/9`         // The printable characters from the ISO 8859/9 Character set
+	Table0211_ASCII          Table0211 = `ASCII`          // The printable 7-bit ASCII character set.
+	Table0211_BIG_5          Table0211 = `BIG-5`          // Code for Taiwanese Character Set (BIG-5)
+	Table0211_CNS_11643_1992 Table0211 = `CNS 11643-1992` // Code for Taiwanese Character Set (CNS 11643-1992)
+	Table0211_GB_18030_2000  Table0211 = `GB 18030-2000`  // Code for Chinese Character Set (GB 18030-2000)
+	Table0211_ISO_IR14       Table0211 = `ISO IR14`       // Code for Information Exchange (one byte)(JIS X 0201-1976).
+	Table0211_ISO_IR159      Table0211 = `ISO IR159`      // Code of the supplementary Japanese Graphic Character set for information interchange (JIS X 0212-1990).
+	Table0211_ISO_IR6        Table0211 = `ISO IR6`        // ASCII graphic character set consisting of 94 characters.
+	Table0211_ISO_IR87       Table0211 = `ISO IR87`       // Code for the Japanese Graphic Character set for information interchange (JIS X 0208-1990),
+	Table0211_KS_X_1001      Table0211 = `KS X 1001`      // Code for Korean Character Set (KS X 1001)
+	Table0211_UNICODE        Table0211 = `UNICODE`        // The world wide character standard from ISO/IEC 10646-1-1993
+	Table0211_UNICODE_UTF_16 Table0211 = `UNICODE UTF-16` // UCS Transformation Format, 16-bit form
+	Table0211_UNICODE_UTF_32 Table0211 = `UNICODE UTF-32` // UCS Transformation Format, 32-bit form
+	Table0211_UNICODE_UTF_8  Table0211 = `UNICODE UTF-8`  // UCS Transformation Format, 8-bit form
+)
+
+// TableID returns the table ID.
+func (Table0211) TableID() string { return `0211` }
+
+// Valid reports if the value is in the table.
+func (v Table0211) Valid() bool { return TableValueLookup[`0211`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0211) Description() string { return tableDescription(`0211`, string(v)) }
+
+// Table0212 is a value of table 0212, Nationality.
+type Table0212 string
+
+// TableID returns the table ID.
+func (Table0212) TableID() string { return `0212` }
+
+// Valid reports if the value is in the table.
+func (v Table0212) Valid() bool { return TableValueLookup[`0212`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0212) Description() string { return tableDescription(`0212`, string(v)) }
+
+// Table0213 is a value of table 0213, Purge Status Code.
+type Table0213 string
+
+const (
+	Table0213_D Table0213 = `D` // The visit is marked for deletion and the user cannot enter new data against it.
+	Table0213_I Table0213 = `I` // The visit is marked inactive and the user cannot enter new data against it.
+	Table0213_P Table0213 = `P` // Marked for purge. User is no longer able to update the visit.
+)
+
+// TableID returns the table ID.
+func (Table0213) TableID() string { return `0213` }
+
+// Valid reports if the value is in the table.
+func (v Table0213) Valid() bool { return TableValueLookup[`0213`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0213) Description() string { return tableDescription(`0213`, string(v)) }
+
+// Table0214 is a value of table 0214, Special Program Code.
+type Table0214 string
+
+const (
+	Table0214_CH Table0214 = `CH` // Child Health Assistance
+	Table0214_ES Table0214 = `ES` // Elective Surgery Program
+	Table0214_FP Table0214 = `FP` // Family Planning
+	Table0214_O  Table0214 = `O`  // Other
+	Table0214_U  Table0214 = `U`  // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0214) TableID() string { return `0214` }
+
+// Valid reports if the value is in the table.
+func (v Table0214) Valid() bool { return TableValueLookup[`0214`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0214) Description() string { return tableDescription(`0214`, string(v)) }
+
+// Table0215 is a value of table 0215, Publicity Code.
+type Table0215 string
+
+const (
+	Table0215_F Table0215 = `F` // Family only
+	Table0215_N Table0215 = `N` // No Publicity
+	Table0215_O Table0215 = `O` // Other
+	Table0215_U Table0215 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0215) TableID() string { return `0215` }
+
+// Valid reports if the value is in the table.
+func (v Table0215) Valid() bool { return TableValueLookup[`0215`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0215) Description() string { return tableDescription(`0215`, string(v)) }
+
+// Table0216 is a value of table 0216, Patient Status Code.
+type Table0216 string
+
+const (
+	Table0216_AI Table0216 = `AI` // Active Inpatient
+	Table0216_DI Table0216 = `DI` // Discharged Inpatient
+)
+
+// TableID returns the table ID.
+func (Table0216) TableID() string { return `0216` }
+
+// Valid reports if the value is in the table.
+func (v Table0216) Valid() bool { return TableValueLookup[`0216`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0216) Description() string { return tableDescription(`0216`, string(v)) }
+
+// Table0217 is a value of table 0217, Visit Priority Code.
+type Table0217 string
+
+const (
+	Table0217_1 Table0217 = `1` // Emergency
+	Table0217_2 Table0217 = `2` // Urgent
+	Table0217_3 Table0217 = `3` // Elective
+)
+
+// TableID returns the table ID.
+func (Table0217) TableID() string { return `0217` }
+
+// Valid reports if the value is in the table.
+func (v Table0217) Valid() bool { return TableValueLookup[`0217`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0217) Description() string { return tableDescription(`0217`, string(v)) }
+
+// Table0218 is a value of table 0218, Patient Charge Adjustment.
+type Table0218 string
+
+// TableID returns the table ID.
+func (Table0218) TableID() string { return `0218` }
+
+// Valid reports if the value is in the table.
+func (v Table0218) Valid() bool { return TableValueLookup[`0218`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0218) Description() string { return tableDescription(`0218`, string(v)) }
+
+// Table0219 is a value of table 0219, Recurring Service Code.
+type Table0219 string
+
+// TableID returns the table ID.
+func (Table0219) TableID() string { return `0219` }
+
+// Valid reports if the value is in the table.
+func (v Table0219) Valid() bool { return TableValueLookup[`0219`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0219) Description() string { return tableDescription(`0219`, string(v)) }
+
+// Table0220 is a value of table 0220, Living Arrangement.
+type Table0220 string
+
+const (
+	Table0220_A Table0220 = `A` // Alone
+	Table0220_F Table0220 = `F` // Family
+	Table0220_I Table0220 = `I` // Institution
+	Table0220_R Table0220 = `R` // Relative
+	Table0220_S Table0220 = `S` // Spouse Only
+	Table0220_U Table0220 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0220) TableID() string { return `0220` }
+
+// Valid reports if the value is in the table.
+func (v Table0220) Valid() bool { return TableValueLookup[`0220`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0220) Description() string { return tableDescription(`0220`, string(v)) }
+
+// Table0222 is a value of table 0222, Contact Reason.
+type Table0222 string
+
+// TableID returns the table ID.
+func (Table0222) TableID() string { return `0222` }
+
+// Valid reports if the value is in the table.
+func (v Table0222) Valid() bool { return TableValueLookup[`0222`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0222) Description() string { return tableDescription(`0222`, string(v)) }
+
+// Table0223 is a value of table 0223, Living Dependency.
+type Table0223 string
+
+const (
+	Table0223_C Table0223 = `C` // Small Children Dependent
+	Table0223_M Table0223 = `M` // Medical Supervision Required
+	Table0223_O Table0223 = `O` // Other
+	Table0223_S Table0223 = `S` // Spouse Dependent
+	Table0223_U Table0223 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0223) TableID() string { return `0223` }
+
+// Valid reports if the value is in the table.
+func (v Table0223) Valid() bool { return TableValueLookup[`0223`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0223) Description() string { return tableDescription(`0223`, string(v)) }
+
+// Table0224 is a value of table 0224, Transport Arranged.
+type Table0224 string
+
+const (
+	Table0224_A Table0224 = `A` // Arranged
+	Table0224_N Table0224 = `N` // Not Arranged
+	Table0224_U Table0224 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0224) TableID() string { return `0224` }
+
+// Valid reports if the value is in the table.
+func (v Table0224) Valid() bool { return TableValueLookup[`0224`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0224) Description() string { return tableDescription(`0224`, string(v)) }
+
+// Table0225 is a value of table 0225, Escort Required.
+type Table0225 string
+
+const (
+	Table0225_N Table0225 = `N` // Not Required
+	Table0225_R Table0225 = `R` // Required
+	Table0225_U Table0225 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0225) TableID() string { return `0225` }
+
+// Valid reports if the value is in the table.
+func (v Table0225) Valid() bool { return TableValueLookup[`0225`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0225) Description() string { return tableDescription(`0225`, string(v)) }
+
+// Table0227 is a value of table 0227, Manufacturers of Vaccines.
+type Table0227 string
+
+const (
+	Table0227_AB  Table0227 = `AB`  // Abbott Laboratories
+	Table0227_AD  Table0227 = `AD`  // Adams Laboratories, Inc.
+	Table0227_ALP Table0227 = `ALP` // Alpha Therapeutic Corporation
+	Table0227_AR  Table0227 = `AR`  // Armour
+	Table0227_AVB Table0227 = `AVB` // Aventis Behring L.L.C.
+	Table0227_AVI Table0227 = `AVI` // Aviron
+	Table0227_BA  Table0227 = `BA`  // Baxter Healthcare Corporation
+	Table0227_BAH Table0227 = `BAH` // Baxter Healthcare Corporation
+	Table0227_BAY Table0227 = `BAY` // Bayer Corporation
+	Table0227_BP  Table0227 = `BP`  // Berna Products
+	Table0227_BPC Table0227 = `BPC` // Berna Products Corporation
+	Table0227_CEN Table0227 = `CEN` // Centeon L.L.C.
+	Table0227_CHI Table0227 = `CHI` // Chiron Corporation
+	Table0227_CMP Table0227 = `CMP` // Celltech Medeva Pharmaceuticals
+	Table0227_CNJ Table0227 = `CNJ` // Cangene Corporation
+	Table0227_CON Table0227 = `CON` // Connaught
+	Table0227_DVC Table0227 = `DVC` // DynPort Vaccine Company, LLC
+	Table0227_EVN Table0227 = `EVN` // Evans Medical Limited
+	Table0227_GEO Table0227 = `GEO` // GeoVax Labs, Inc.
+	Table0227_GRE Table0227 = `GRE` // Greer Laboratories, Inc.
+	Table0227_IAG Table0227 = `IAG` // Immuno International AG
+	Table0227_IM  Table0227 = `IM`  // Merieux
+	Table0227_IUS Table0227 = `IUS` // Immuno-U.S., Inc.
+	Table0227_JPN Table0227 = `JPN` // The Research Foundation for Microbial Diseases of Osaka University
+	Table0227_KGC Table0227 = `KGC` // Korea Green Cross Corporation
+	Table0227_LED Table0227 = `LED` // Lederle
+	Table0227_MA  Table0227 = `MA`  // Massachusetts Public Health Biologic Laboratories
+	Table0227_MBL Table0227 = `MBL` // Massachusetts Biologic Laboratories
+	Table0227_MED Table0227 = `MED` // MedImmune, Inc.
+	Table0227_MIL Table0227 = `MIL` // Miles
+	Table0227_MIP Table0227 = `MIP` // Bioport Corporation
+	Table0227_MSD Table0227 = `MSD` // Merck & Co., Inc.
+	Table0227_NAB Table0227 = `NAB` // NABI
+	Table0227_NAV Table0227 = `NAV` // North American Vaccine, Inc.
+	Table0227_NOV Table0227 = `NOV` // Novartis Pharmaceutical Corporation
+	Table0227_NVX Table0227 = `NVX` // Novavax, Inc.
+	Table0227_NYB Table0227 = `NYB` // New York Blood Center
+	Table0227_ORT Table0227 = `ORT` // Ortho-Clinical Diagnostics
+	Table0227_OTC Table0227 = `OTC` // Organon Teknika Corporation
+	Table0227_OTH Table0227 = `OTH` // Other manufacturer
+	Table0227_PD  Table0227 = `PD`  // Parkedale Pharmaceuticals
+	Table0227_PMC Table0227 = `PMC` // sanofi pasteur
+	Table0227_PRX Table0227 = `PRX` // Praxis Biologics
+	Table0227_PWJ Table0227 = `PWJ` // PowderJect Pharmaceuticals
+	Table0227_SCL Table0227 = `SCL` // Sclavo, Inc.
+	Table0227_SI  Table0227 = `SI`  // Swiss Serum and Vaccine Inst.
+	Table0227_SKB Table0227 = `SKB` // GlaxoSmithKline
+	Table0227_SOL Table0227 = `SOL` // Solvay Pharmaceuticals
+	Table0227_TAL Table0227 = `TAL` // Talecris Biotherapeutics
+	Table0227_UNK Table0227 = `UNK` // Unknown manufacturer
+	Table0227_USA Table0227 = `USA` // United States Army Medical Research and Material Command
+	Table0227_VXG Table0227 = `VXG` // VaxGen
+	Table0227_WA  Table0227 = `WA`  // Wyeth-Ayerst
+	Table0227_WAL Table0227 = `WAL` // Wyeth-Ayerst
+	Table0227_ZLB Table0227 = `ZLB` // ZLB Behring
+)
+
+// TableID returns the table ID.
+func (Table0227) TableID() string { return `0227` }
+
+// Valid reports if the value is in the table.
+func (v Table0227) Valid() bool { return TableValueLookup[`0227`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0227) Description() string { return tableDescription(`0227`, string(v)) }
+
+// Table0228 is a value of table 0228, Diagnosis Classification.
+type Table0228 string
+
+const (
+	Table0228_C Table0228 = `C` // Consultation
+	Table0228_D Table0228 = `D` // Diagnosis
+	Table0228_I Table0228 = `I` // Invasive procedure not classified elsewhere (I.V., catheter, etc.)
+	Table0228_M Table0228 = `M` // Medication (antibiotic)
+	Table0228_O Table0228 = `O` // Other
+	Table0228_R Table0228 = `R` // Radiological scheduling (not using ICDA codes)
+	Table0228_S Table0228 = `S` // Sign and symptom
+	Table0228_T Table0228 = `T` // Tissue diagnosis
+)
+
+// TableID returns the table ID.
+func (Table0228) TableID() string { return `0228` }
+
+// Valid reports if the value is in the table.
+func (v Table0228) Valid() bool { return TableValueLookup[`0228`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0228) Description() string { return tableDescription(`0228`, string(v)) }
+
+// Table0229 is a value of table 0229, DRG Payor.
+type Table0229 string
+
+const (
+	Table0229_C Table0229 = `C` // Champus
+	Table0229_G Table0229 = `G` // Managed Care Organization
+	Table0229_M Table0229 = `M` // Medicare
+)
+
+// TableID returns the table ID.
+func (Table0229) TableID() string { return `0229` }
+
+// Valid reports if the value is in the table.
+func (v Table0229) Valid() bool { return TableValueLookup[`0229`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0229) Description() string { return tableDescription(`0229`, string(v)) }
+
+// Table0230 is a value of table 0230, Procedure Functional Type.
+type Table0230 string
+
+const (
+	Table0230_A Table0230 = `A` // Anesthesia
+	Table0230_D Table0230 = `D` // Diagnostic procedure
+	Table0230_I Table0230 = `I` // Invasive procedure not classified elsewhere (e.g., IV, catheter, etc.)
+	Table0230_P Table0230 = `P` // Procedure for treatment (therapeutic, including operations)
+)
+
+// TableID returns the table ID.
+func (Table0230) TableID() string { return `0230` }
+
+// Valid reports if the value is in the table.
+func (v Table0230) Valid() bool { return TableValueLookup[`0230`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0230) Description() string { return tableDescription(`0230`, string(v)) }
+
+// Table0231 is a value of table 0231, Student Status.
+type Table0231 string
+
+const (
+	Table0231_F Table0231 = `F` // Full-time student
+	Table0231_N Table0231 = `N` // Not a student
+	Table0231_P Table0231 = `P` // Part-time student
+)
+
+// TableID returns the table ID.
+func (Table0231) TableID() string { return `0231` }
+
+// Valid reports if the value is in the table.
+func (v Table0231) Valid() bool { return TableValueLookup[`0231`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0231) Description() string { return tableDescription(`0231`, string(v)) }
+
+// Table0232 is a value of table 0232, Insurance Company Contact Reason.
+type Table0232 string
+
+const (
+	Table0232_01 Table0232 = `01` // Medicare claim status
+	Table0232_02 Table0232 = `02` // Medicaid claim status
+	Table0232_03 Table0232 = `03` // Name/address change
+)
+
+// TableID returns the table ID.
+func (Table0232) TableID() string { return `0232` }
+
+// Valid reports if the value is in the table.
+func (v Table0232) Valid() bool { return TableValueLookup[`0232`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0232) Description() string { return tableDescription(`0232`, string(v)) }
+
+// Table0233 is a value of table 0233, Non-Concur Code/Description.
+type Table0233 string
+
+// TableID returns the table ID.
+func (Table0233) TableID() string { return `0233` }
+
+// Valid reports if the value is in the table.
+func (v Table0233) Valid() bool { return TableValueLookup[`0233`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0233) Description() string { return tableDescription(`0233`, string(v)) }
+
+// Table0234 is a value of table 0234, Report Timing.
+type Table0234 string
+
+const (
+	Table0234_10D Table0234 = `10D` // 10 day report
+	Table0234_15D Table0234 = `15D` // 15 day report
+	Table0234_30D Table0234 = `30D` // 30 day report
+	Table0234_3D  Table0234 = `3D`  // 3 day report
+	Table0234_7D  Table0234 = `7D`  // 7 day report
+	Table0234_AD  Table0234 = `AD`  // Additional information
+	Table0234_CO  Table0234 = `CO`  // Correction
+	Table0234_DE  Table0234 = `DE`  // Device evaluation
+	Table0234_PD  Table0234 = `PD`  // Periodic
+	Table0234_RQ  Table0234 = `RQ`  // Requested information
+)
+
+// TableID returns the table ID.
+func (Table0234) TableID() string { return `0234` }
+
+// Valid reports if the value is in the table.
+func (v Table0234) Valid() bool { return TableValueLookup[`0234`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0234) Description() string { return tableDescription(`0234`, string(v)) }
+
+// Table0235 is a value of table 0235, Report Source.
+type Table0235 string
+
+const (
+	Table0235_C Table0235 = `C` // Clinical trial
+	Table0235_D Table0235 = `D` // Database/registry/poison control center
+	Table0235_E Table0235 = `E` // Distributor
+	Table0235_H Table0235 = `H` // Health professional
+	Table0235_L Table0235 = `L` // Literature
+	Table0235_M Table0235 = `M` // Manufacturer/marketing authority holder
+	Table0235_N Table0235 = `N` // Non-healthcare professional
+	Table0235_O Table0235 = `O` // Other
+	Table0235_P Table0235 = `P` // Patient
+	Table0235_R Table0235 = `R` // Regulatory agency
+)
+
+// TableID returns the table ID.
+func (Table0235) TableID() string { return `0235` }
+
+// Valid reports if the value is in the table.
+func (v Table0235) Valid() bool { return TableValueLookup[`0235`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0235) Description() string { return tableDescription(`0235`, string(v)) }
+
+// Table0236 is a value of table 0236, Event Reported To.
+type Table0236 string
+
+const (
+	Table0236_D Table0236 = `D` // Distributor
+	Table0236_L Table0236 = `L` // Local facility/user facility
+	Table0236_M Table0236 = `M` // Manufacturer
+	Table0236_R Table0236 = `R` // Regulatory agency
+)
+
+// TableID returns the table ID.
+func (Table0236) TableID() string { return `0236` }
+
+// Valid reports if the value is in the table.
+func (v Table0236) Valid() bool { return TableValueLookup[`0236`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0236) Description() string { return tableDescription(`0236`, string(v)) }
+
+// Table0237 is a value of table 0237, Event Qualification.
+type Table0237 string
+
+const (
+	Table0237_A Table0237 = `A` // Abuse
+	Table0237_B Table0237 = `B` // Unexpected beneficial effect
+	Table0237_D Table0237 = `D` // Dependency
+	Table0237_I Table0237 = `I` // Interaction
+	Table0237_L Table0237 = `L` // Lack of expect therapeutic effect
+	Table0237_M Table0237 = `M` // Misuse
+	Table0237_O Table0237 = `O` // Overdose
+	Table0237_W Table0237 = `W` // Drug withdrawal
+)
+
+// TableID returns the table ID.
+func (Table0237) TableID() string { return `0237` }
+
+// Valid reports if the value is in the table.
+func (v Table0237) Valid() bool { return TableValueLookup[`0237`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0237) Description() string { return tableDescription(`0237`, string(v)) }
+
+// Table0238 is a value of table 0238, Event Seriousness.
+type Table0238 string
+
+const (
+	Table0238_N Table0238 = `N` // No
+	Table0238_S Table0238 = `S` // Significant
+	Table0238_Y Table0238 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0238) TableID() string { return `0238` }
+
+// Valid reports if the value is in the table.
+func (v Table0238) Valid() bool { return TableValueLookup[`0238`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0238) Description() string { return tableDescription(`0238`, string(v)) }
+
+// Table0239 is a value of table 0239, Event Expected.
+type Table0239 string
+
+const (
+	Table0239_N Table0239 = `N` // No
+	Table0239_U Table0239 = `U` // Unknown
+	Table0239_Y Table0239 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0239) TableID() string { return `0239` }
+
+// Valid reports if the value is in the table.
+func (v Table0239) Valid() bool { return TableValueLookup[`0239`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0239) Description() string { return tableDescription(`0239`, string(v)) }
+
+// Table0240 is a value of table 0240, Event Consequence.
+type Table0240 string
+
+const (
+	Table0240_C Table0240 = `C` // Congenital anomaly/birth defect
+	Table0240_D Table0240 = `D` // Death
+	Table0240_H Table0240 = `H` // Caused hospitalized
+	Table0240_I Table0240 = `I` // Incapacity which is significant, persistent or permanent
+	Table0240_J Table0240 = `J` // Disability which is significant, persistent or permanent
+	Table0240_L Table0240 = `L` // Life threatening
+	Table0240_O Table0240 = `O` // Other
+	Table0240_P Table0240 = `P` // Prolonged hospitalization
+	Table0240_R Table0240 = `R` // Required intervention to prevent permanent impairment/damage
+)
+
+// TableID returns the table ID.
+func (Table0240) TableID() string { return `0240` }
+
+// Valid reports if the value is in the table.
+func (v Table0240) Valid() bool { return TableValueLookup[`0240`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0240) Description() string { return tableDescription(`0240`, string(v)) }
+
+// Table0241 is a value of table 0241, Patient Outcome.
+type Table0241 string
+
+const (
+	Table0241_D Table0241 = `D` // Died
+	Table0241_F Table0241 = `F` // Fully recovered
+	Table0241_N Table0241 = `N` // Not recovering/unchanged
+	Table0241_R Table0241 = `R` // Recovering
+	Table0241_S Table0241 = `S` // Sequelae
+	Table0241_U Table0241 = `U` // Unknown
+	Table0241_W Table0241 = `W` // Worsening
+)
+
+// TableID returns the table ID.
+func (Table0241) TableID() string { return `0241` }
+
+// Valid reports if the value is in the table.
+func (v Table0241) Valid() bool { return TableValueLookup[`0241`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0241) Description() string { return tableDescription(`0241`, string(v)) }
+
+// Table0242 is a value of table 0242, Primary Observer's Qualification.
+type Table0242 string
+
+const (
+	Table0242_C Table0242 = `C` // Health care consumer/patient
+	Table0242_H Table0242 = `H` // Other health professional
+	Table0242_L Table0242 = `L` // Lawyer/attorney
+	Table0242_M Table0242 = `M` // Mid-level professional (nurse, nurse practitioner, physician's assistant)
+	Table0242_O Table0242 = `O` // Other non-health professional
+	Table0242_P Table0242 = `P` // Physician (osteopath, homeopath)
+	Table0242_R Table0242 = `R` // Pharmacist
+)
+
+// TableID returns the table ID.
+func (Table0242) TableID() string { return `0242` }
+
+// Valid reports if the value is in the table.
+func (v Table0242) Valid() bool { return TableValueLookup[`0242`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0242) Description() string { return tableDescription(`0242`, string(v)) }
+
+// Table0243 is a value of table 0243, Identity May Be Divulged.
+type Table0243 string
+
+const (
+	Table0243_N  Table0243 = `N`  // No
+	Table0243_NA Table0243 = `NA` // Not applicable
+	Table0243_Y  Table0243 = `Y`  // Yes
+)
+
+// TableID returns the table ID.
+func (Table0243) TableID() string { return `0243` }
+
+// Valid reports if the value is in the table.
+func (v Table0243) Valid() bool { return TableValueLookup[`0243`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0243) Description() string { return tableDescription(`0243`, string(v)) }
+
+// Table0244 is a value of table 0244, Single Use Device.
+type Table0244 string
+
+// TableID returns the table ID.
+func (Table0244) TableID() string { return `0244` }
+
+// Valid reports if the value is in the table.
+func (v Table0244) Valid() bool { return TableValueLookup[`0244`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0244) Description() string { return tableDescription(`0244`, string(v)) }
+
+// Table0245 is a value of table 0245, Product Problem.
+type Table0245 string
+
+// TableID returns the table ID.
+func (Table0245) TableID() string { return `0245` }
+
+// Valid reports if the value is in the table.
+func (v Table0245) Valid() bool { return TableValueLookup[`0245`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0245) Description() string { return tableDescription(`0245`, string(v)) }
+
+// Table0246 is a value of table 0246, Product Available for Inspection.
+type Table0246 string
+
+// TableID returns the table ID.
+func (Table0246) TableID() string { return `0246` }
+
+// Valid reports if the value is in the table.
+func (v Table0246) Valid() bool { return TableValueLookup[`0246`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0246) Description() string { return tableDescription(`0246`, string(v)) }
+
+// Table0247 is a value of table 0247, Status of Evaluation.
+type Table0247 string
+
+const (
+	Table0247_A Table0247 = `A` // Evaluation anticipated, but not yet begun
+	Table0247_C Table0247 = `C` // Product received in condition which made analysis impossible
+	Table0247_D Table0247 = `D` // Product discarded -- unable to follow up
+	Table0247_I Table0247 = `I` // Product remains implanted -- unable to follow up
+	Table0247_K Table0247 = `K` // Problem already known, no evaluation necessary
+	Table0247_O Table0247 = `O` // Other
+	Table0247_P Table0247 = `P` // Evaluation in progress
+	Table0247_Q Table0247 = `Q` // Product under quarantine -- unable to follow up
+	Table0247_R Table0247 = `R` // Product under recall/corrective action
+	Table0247_U Table0247 = `U` // Product unavailable for follow up investigation
+	Table0247_X Table0247 = `X` // Product not made by company
+	Table0247_Y Table0247 = `Y` // Evaluation completed
+)
+
+// TableID returns the table ID.
+func (Table0247) TableID() string { return `0247` }
+
+// Valid reports if the value is in the table.
+func (v Table0247) Valid() bool { return TableValueLookup[`0247`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0247) Description() string { return tableDescription(`0247`, string(v)) }
+
+// Table0248 is a value of table 0248, Product Source.
+type Table0248 string
+
+const (
+	Table0248_A Table0248 = `A` // Actual product involved in incident was evaluated
+	Table0248_L Table0248 = `L` // A product from the same lot as the actual product involved was evaluated
+	Table0248_N Table0248 = `N` // A product from a controlled/non-related inventory was evaluated
+	Table0248_R Table0248 = `R` // A product from a reserve sample was evaluated
+)
+
+// TableID returns the table ID.
+func (Table0248) TableID() string { return `0248` }
+
+// Valid reports if the value is in the table.
+func (v Table0248) Valid() bool { return TableValueLookup[`0248`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0248) Description() string { return tableDescription(`0248`, string(v)) }
+
+// Table0249 is a value of table 0249, Generic Product.
+type Table0249 string
+
+// TableID returns the table ID.
+func (Table0249) TableID() string { return `0249` }
+
+// Valid reports if the value is in the table.
+func (v Table0249) Valid() bool { return TableValueLookup[`0249`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0249) Description() string { return tableDescription(`0249`, string(v)) }
+
+// Table0250 is a value of table 0250, Relatedness Assessment.
+type Table0250 string
+
+const (
+	Table0250_H Table0250 = `H` // Highly probable
+	Table0250_I Table0250 = `I` // Improbable
+	Table0250_M Table0250 = `M` // Moderately probable
+	Table0250_N Table0250 = `N` // Not related
+	Table0250_S Table0250 = `S` // Somewhat probable
+)
+
+// TableID returns the table ID.
+func (Table0250) TableID() string { return `0250` }
+
+// Valid reports if the value is in the table.
+func (v Table0250) Valid() bool { return TableValueLookup[`0250`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0250) Description() string { return tableDescription(`0250`, string(v)) }
+
+// Table0251 is a value of table 0251, Action Taken in Response to the Event.
+type Table0251 string
+
+const (
+	Table0251_DI Table0251 = `DI` // Product dose or frequency of use increased
+	Table0251_DR Table0251 = `DR` // Product dose or frequency of use reduced
+	Table0251_N  Table0251 = `N`  // None
+	Table0251_OT Table0251 = `OT` // Other
+	Table0251_WP Table0251 = `WP` // Product withdrawn permanently
+	Table0251_WT Table0251 = `WT` // Product withdrawn temporarily
+)
+
+// TableID returns the table ID.
+func (Table0251) TableID() string { return `0251` }
+
+// Valid reports if the value is in the table.
+func (v Table0251) Valid() bool { return TableValueLookup[`0251`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0251) Description() string { return tableDescription(`0251`, string(v)) }
+
+// Table0252 is a value of table 0252, Causality Observations.
+type Table0252 string
+
+const (
+	Table0252_AW Table0252 = `AW` // Abatement of event after product withdrawn
+	Table0252_BE Table0252 = `BE` // Event recurred after product reintroduced
+	Table0252_DR Table0252 = `DR` // Dose response observed
+	Table0252_EX Table0252 = `EX` // Alternative explanations for the event available
+	Table0252_IN Table0252 = `IN` // Event occurred after product introduced
+	Table0252_LI Table0252 = `LI` // Literature reports association of product with event
+	Table0252_OE Table0252 = `OE` // Occurrence of event was confirmed by objective evidence
+	Table0252_OT Table0252 = `OT` // Other
+	Table0252_PL Table0252 = `PL` // Effect observed when patient receives placebo
+	Table0252_SE Table0252 = `SE` // Similar events in past for this patient
+	Table0252_TC Table0252 = `TC` // Toxic levels of product documented in blood or body fluids
+)
+
+// TableID returns the table ID.
+func (Table0252) TableID() string { return `0252` }
+
+// Valid reports if the value is in the table.
+func (v Table0252) Valid() bool { return TableValueLookup[`0252`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0252) Description() string { return tableDescription(`0252`, string(v)) }
+
+// Table0253 is a value of table 0253, Indirect Exposure Mechanism.
+type Table0253 string
+
+const (
+	Table0253_B Table0253 = `B` // Breast milk
+	Table0253_F Table0253 = `F` // Father
+	Table0253_O Table0253 = `O` // Other
+	Table0253_P Table0253 = `P` // Transplacental
+	Table0253_X Table0253 = `X` // Blood product
+)
+
+// TableID returns the table ID.
+func (Table0253) TableID() string { return `0253` }
+
+// Valid reports if the value is in the table.
+func (v Table0253) Valid() bool { return TableValueLookup[`0253`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0253) Description() string { return tableDescription(`0253`, string(v)) }
+
+// Table0254 is a value of table 0254, Kind of Quantity.
+type Table0254 string
+
+const (
+	Table0254_ABS     Table0254 = `ABS`     // Absorbance
+	Table0254_ACNC    Table0254 = `ACNC`    // Concentration, Arbitrary Substance
+	Table0254_ACT     Table0254 = `ACT`     // *Activity
+	Table0254_APER    Table0254 = `APER`    // Appearance
+	Table0254_ARB     Table0254 = `ARB`     // *Arbitrary
+	Table0254_AREA    Table0254 = `AREA`    // Area
+	Table0254_ASPECT  Table0254 = `ASPECT`  // Aspect
+	Table0254_CACT    Table0254 = `CACT`    // *Catalytic Activity
+	Table0254_CCNT    Table0254 = `CCNT`    // *Catalytic Content
+	Table0254_CCRTO   Table0254 = `CCRTO`   // Catalytic Concentration Ratio
+	Table0254_CFR     Table0254 = `CFR`     // *Catalytic Fraction
+	Table0254_CLAS    Table0254 = `CLAS`    // Class
+	Table0254_CNC     Table0254 = `CNC`     // *Catalytic Concentration
+	Table0254_CNST    Table0254 = `CNST`    // *Constant
+	Table0254_COEF    Table0254 = `COEF`    // *Coefficient
+	Table0254_COLOR   Table0254 = `COLOR`   // Color
+	Table0254_CONS    Table0254 = `CONS`    // Consistency
+	Table0254_CRAT    Table0254 = `CRAT`    // *Catalytic Rate
+	Table0254_CRTO    Table0254 = `CRTO`    // Catalytic Ratio
+	Table0254_DEN     Table0254 = `DEN`     // Density
+	Table0254_DEV     Table0254 = `DEV`     // Device
+	Table0254_DIFF    Table0254 = `DIFF`    // *Difference
+	Table0254_ELAS    Table0254 = `ELAS`    // Elasticity
+	Table0254_ELPOT   Table0254 = `ELPOT`   // Electrical Potential (Voltage)
+	Table0254_ELRAT   Table0254 = `ELRAT`   // Electrical current (amperage)
+	Table0254_ELRES   Table0254 = `ELRES`   // Electrical Resistance
+	Table0254_ENGR    Table0254 = `ENGR`    // Energy
+	Table0254_ENT     Table0254 = `ENT`     // *Entitic
+	Table0254_ENTCAT  Table0254 = `ENTCAT`  // *Entitic Catalytic Activity
+	Table0254_ENTNUM  Table0254 = `ENTNUM`  // *Entitic Number
+	Table0254_ENTSUB  Table0254 = `ENTSUB`  // *Entitic Substance of Amount
+	Table0254_ENTVOL  Table0254 = `ENTVOL`  // *Entitic Volume
+	Table0254_EQL     Table0254 = `EQL`     // Equilibrium
+	Table0254_FORCE   Table0254 = `FORCE`   // Mechanical force
+	Table0254_FREQ    Table0254 = `FREQ`    // Frequency
+	Table0254_IMP     Table0254 = `IMP`     // Impression/ interpretation of study
+	Table0254_KINV    Table0254 = `KINV`    // *Kinematic Viscosity
+	Table0254_LEN     Table0254 = `LEN`     // Length
+	Table0254_LINC    Table0254 = `LINC`    // *Length Increment
+	Table0254_LIQ     Table0254 = `LIQ`     // *Liquefaction
+	Table0254_MASS    Table0254 = `MASS`    // *Mass
+	Table0254_MCNC    Table0254 = `MCNC`    // *Mass Concentration
+	Table0254_MCNT    Table0254 = `MCNT`    // Mass Content
+	Table0254_MCRTO   Table0254 = `MCRTO`   // *Mass Concentration Ratio
+	Table0254_MFR     Table0254 = `MFR`     // *Mass Fraction
+	Table0254_MGFLUX  Table0254 = `MGFLUX`  // Magnetic flux
+	Table0254_MINC    Table0254 = `MINC`    // *Mass Increment
+	Table0254_MORPH   Table0254 = `MORPH`   // Morphology
+	Table0254_MOTIL   Table0254 = `MOTIL`   // Motility
+	Table0254_MRAT    Table0254 = `MRAT`    // *Mass Rate
+	Table0254_MRTO    Table0254 = `MRTO`    // *Mass Ratio
+	Table0254_NCNC    Table0254 = `NCNC`    // *Number Concentration
+	Table0254_NCNT    Table0254 = `NCNT`    // *Number Content
+	Table0254_NFR     Table0254 = `NFR`     // *Number Fraction
+	Table0254_NRTO    Table0254 = `NRTO`    // *Number Ratio
+	Table0254_NUM     Table0254 = `NUM`     // *Number
+	Table0254_OD      Table0254 = `OD`      // Optical density
+	Table0254_OSMOL   Table0254 = `OSMOL`   // *Osmolality
+	Table0254_PRES    Table0254 = `PRES`    // *Pressure (Partial)
+	Table0254_PRID    Table0254 = `PRID`    // Presence/Identity/Existence
+	Table0254_PWR     Table0254 = `PWR`     // Power (wattage)
+	Table0254_RANGE   Table0254 = `RANGE`   // *Ranges
+	Table0254_RATIO   Table0254 = `RATIO`   // *Ratios
+	Table0254_RCRLTM  Table0254 = `RCRLTM`  // *Reciprocal Relative Time
+	Table0254_RDEN    Table0254 = `RDEN`    // *Relative Density
+	Table0254_REL     Table0254 = `REL`     // *Relative
+	Table0254_RLMCNC  Table0254 = `RLMCNC`  // *Relative Mass Concentration
+	Table0254_RLSCNC  Table0254 = `RLSCNC`  // *Relative Substance Concentration
+	Table0254_RLTM    Table0254 = `RLTM`    // *Relative Time
+	Table0254_SATFR   Table0254 = `SATFR`   // *Saturation Fraction
+	Table0254_SCNC    Table0254 = `SCNC`    // *Substance Concentration
+	Table0254_SCNCIN  Table0254 = `SCNCIN`  // *Substance Concentration Increment
+	Table0254_SCNT    Table0254 = `SCNT`    // *Substance Content
+	Table0254_SCNTR   Table0254 = `SCNTR`   // *Substance Content Rate
+	Table0254_SCRTO   Table0254 = `SCRTO`   // *Substance Concentration Ratio
+	Table0254_SFR     Table0254 = `SFR`     // *Substance Fraction
+	Table0254_SHAPE   Table0254 = `SHAPE`   // Shape
+	Table0254_SMELL   Table0254 = `SMELL`   // Smell
+	Table0254_SRAT    Table0254 = `SRAT`    // *Substance Rate
+	Table0254_SRTO    Table0254 = `SRTO`    // *Substance Ratio
+	Table0254_SUB     Table0254 = `SUB`     // *Substance Amount
+	Table0254_SUSC    Table0254 = `SUSC`    // *Susceptibility
+	Table0254_TASTE   Table0254 = `TASTE`   // Taste
+	Table0254_TEMP    Table0254 = `TEMP`    // *Temperature
+	Table0254_TEMPDF  Table0254 = `TEMPDF`  // *Temperature Difference
+	Table0254_TEMPIN  Table0254 = `TEMPIN`  // *Temperature Increment
+	Table0254_THRMCNC Table0254 = `THRMCNC` // *Threshold Mass Concentration
+	Table0254_THRSCNC Table0254 = `THRSCNC` // *Threshold Substance Concentration
+	Table0254_TIME    Table0254 = `TIME`    // *Time (e.g. seconds)
+	Table0254_TITR    Table0254 = `TITR`    // *Dilution Factor (Titer)
+	Table0254_TMDF    Table0254 = `TMDF`    // *Time Difference
+	Table0254_TMSTP   Table0254 = `TMSTP`   // *Time Stamp-Date and Time
+	Table0254_TRTO    Table0254 = `TRTO`    // *Time Ratio
+	Table0254_TYPE    Table0254 = `TYPE`    // *Type
+	Table0254_VCNT    Table0254 = `VCNT`    // *Volume Content
+	Table0254_VEL     Table0254 = `VEL`     // *Velocity
+	Table0254_VELRT   Table0254 = `VELRT`   // *Velocity Ratio
+	Table0254_VFR     Table0254 = `VFR`     // *Volume Fraction
+	Table0254_VISC    Table0254 = `VISC`    // *Viscosity
+	Table0254_VOL     Table0254 = `VOL`     // *Volume
+	Table0254_VRAT    Table0254 = `VRAT`    // *Volume Rate
+	Table0254_VRTO    Table0254 = `VRTO`    // *Volume Ratio
+)
+
+// TableID returns the table ID.
+func (Table0254) TableID() string { return `0254` }
+
+// Valid reports if the value is in the table.
+func (v Table0254) Valid() bool { return TableValueLookup[`0254`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0254) Description() string { return tableDescription(`0254`, string(v)) }
+
+// Table0255 is a value of table 0255, Duration Categories.
+type Table0255 string
+
+const (
+	Table0255_12H  Table0255 = `12H`  // 12 hours
+	Table0255_1H   Table0255 = `1H`   // 1 hour
+	Table0255_1L   Table0255 = `1L`   // 1 months (30 days)
+	Table0255_1W   Table0255 = `1W`   // 1 week
+	Table0255_2_5H Table0255 = `2.5H` // 2Â½ hours
+	Table0255_24H  Table0255 = `24H`  // 24 hours
+	Table0255_2D   Table0255 = `2D`   // 2 days
+	Table0255_2H   Table0255 = `2H`   // 2 hours
+	Table0255_2L   Table0255 = `2L`   // 2 months
+	Table0255_2W   Table0255 = `2W`   // 2 weeks
+	Table0255_30M  Table0255 = `30M`  // 30 minutes
+	Table0255_3D   Table0255 = `3D`   // 3 days
+	Table0255_3H   Table0255 = `3H`   // 3 hours
+	Table0255_3L   Table0255 = `3L`   // 3 months
+	Table0255_3W   Table0255 = `3W`   // 3 weeks
+	Table0255_4D   Table0255 = `4D`   // 4 days
+	Table0255_4H   Table0255 = `4H`   // 4 hours
+	Table0255_4W   Table0255 = `4W`   // 4 weeks
+	Table0255_5D   Table0255 = `5D`   // 5 days
+	Table0255_5H   Table0255 = `5H`   // 5 hours
+	Table0255_6D   Table0255 = `6D`   // 6 days
+	Table0255_6H   Table0255 = `6H`   // 6 hours
+	Table0255_7H   Table0255 = `7H`   // 7 hours
+	Table0255_8H   Table0255 = `8H`   // 8 hours
+	Table0255_PT   Table0255 = `PT`   // To identify measures at a point in time. This is a synonym for "spot" or "random" as applied to urine measurements.
+)
+
+// TableID returns the table ID.
+func (Table0255) TableID() string { return `0255` }
+
+// Valid reports if the value is in the table.
+func (v Table0255) Valid() bool { return TableValueLookup[`0255`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0255) Description() string { return tableDescription(`0255`, string(v)) }
+
+// Table0258 is a value of table 0258, Relationship Modifier.
+type Table0258 string
+
+const (
+	Table0258_BPU     Table0258 = `BPU`     // Blood product unit
+	Table0258_CONTROL Table0258 = `CONTROL` // Control
+	Table0258_DONOR   Table0258 = `DONOR`   // Donor
+	Table0258_PATIENT Table0258 = `PATIENT` // Patient
+)
+
+// TableID returns the table ID.
+func (Table0258) TableID() string { return `0258` }
+
+// Valid reports if the value is in the table.
+func (v Table0258) Valid() bool { return TableValueLookup[`0258`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0258) Description() string { return tableDescription(`0258`, string(v)) }
+
+// Table0260 is a value of table 0260, Patient Location Type.
+type Table0260 string
+
+const (
+	Table0260_B Table0260 = `B` // Bed
+	Table0260_C Table0260 = `C` // Clinic
+	Table0260_D Table0260 = `D` // Department
+	Table0260_E Table0260 = `E` // Exam Room
+	Table0260_L Table0260 = `L` // Other Location
+	Table0260_N Table0260 = `N` // Nursing Unit
+	Table0260_O Table0260 = `O` // Operating Room
+	Table0260_R Table0260 = `R` // Room
+)
+
+// TableID returns the table ID.
+func (Table0260) TableID() string { return `0260` }
+
+// Valid reports if the value is in the table.
+func (v Table0260) Valid() bool { return TableValueLookup[`0260`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0260) Description() string { return tableDescription(`0260`, string(v)) }
+
+// Table0261 is a value of table 0261, Location Equipment.
+type Table0261 string
+
+const (
+	Table0261_EEG Table0261 = `EEG` // Electro-Encephalogram
+	Table0261_EKG Table0261 = `EKG` // Electro-Cardiogram
+	Table0261_INF Table0261 = `INF` // Infusion pump
+	Table0261_IVP Table0261 = `IVP` // IV pump
+	Table0261_OXY Table0261 = `OXY` // Oxygen
+	Table0261_SUC Table0261 = `SUC` // Suction
+	Table0261_VEN Table0261 = `VEN` // Ventilator
+	Table0261_VIT Table0261 = `VIT` // Vital signs monitor
+)
+
+// TableID returns the table ID.
+func (Table0261) TableID() string { return `0261` }
+
+// Valid reports if the value is in the table.
+func (v Table0261) Valid() bool { return TableValueLookup[`0261`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0261) Description() string { return tableDescription(`0261`, string(v)) }
+
+// Table0264 is a value of table 0264, Location Department.
+type Table0264 string
+
+// TableID returns the table ID.
+func (Table0264) TableID() string { return `0264` }
+
+// Valid reports if the value is in the table.
+func (v Table0264) Valid() bool { return TableValueLookup[`0264`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0264) Description() string { return tableDescription(`0264`, string(v)) }
+
+// Table0265 is a value of table 0265, Specialty Type.
+type Table0265 string
+
+const (
+	Table0265_ALC Table0265 = `ALC` // Allergy
+	Table0265_AMB Table0265 = `AMB` // Ambulatory
+	Table0265_CAN Table0265 = `CAN` // Cancer
+	Table0265_CAR Table0265 = `CAR` // Coronary/cardiac care
+	Table0265_CCR Table0265 = `CCR` // Critical care
+	Table0265_CHI Table0265 = `CHI` // Chiropractic
+	Table0265_EDI Table0265 = `EDI` // Education
+	Table0265_EMR Table0265 = `EMR` // Emergency
+	Table0265_FPC Table0265 = `FPC` // Family planning
+	Table0265_INT Table0265 = `INT` // Intensive care
+	Table0265_ISO Table0265 = `ISO` // Isolation
+	Table0265_NAT Table0265 = `NAT` // Naturopathic
+	Table0265_NBI Table0265 = `NBI` // Newborn, nursery, infants
+	Table0265_OBG Table0265 = `OBG` // Obstetrics, gynecology
+	Table0265_OBS Table0265 = `OBS` // Observation
+	Table0265_OTH Table0265 = `OTH` // Other specialty
+	Table0265_PED Table0265 = `PED` // Pediatrics
+	Table0265_PHY Table0265 = `PHY` // General/family practice
+	Table0265_PIN Table0265 = `PIN` // Pediatric/neonatal intensive care
+	Table0265_PPS Table0265 = `PPS` // Pediatric psychiatric
+	Table0265_PRE Table0265 = `PRE` // Pediatric rehabilitation
+	Table0265_PSI Table0265 = `PSI` // Psychiatric intensive care
+	Table0265_PSY Table0265 = `PSY` // Psychiatric
+	Table0265_REH Table0265 = `REH` // Rehabilitation
+	Table0265_SUR Table0265 = `SUR` // Surgery
+	Table0265_WIC Table0265 = `WIC` // Walk-in clinic
+)
+
+// TableID returns the table ID.
+func (Table0265) TableID() string { return `0265` }
+
+// Valid reports if the value is in the table.
+func (v Table0265) Valid() bool { return TableValueLookup[`0265`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0265) Description() string { return tableDescription(`0265`, string(v)) }
+
+// Table0267 is a value of table 0267, Days of the Week.
+type Table0267 string
+
+const (
+	Table0267_FRI Table0267 = `FRI` // Friday
+	Table0267_MON Table0267 = `MON` // Monday
+	Table0267_SAT Table0267 = `SAT` // Saturday
+	Table0267_SUN Table0267 = `SUN` // Sunday
+	Table0267_THU Table0267 = `THU` // Thursday
+	Table0267_TUE Table0267 = `TUE` // Tuesday
+	Table0267_WED Table0267 = `WED` // Wednesday
+)
+
+// TableID returns the table ID.
+func (Table0267) TableID() string { return `0267` }
+
+// Valid reports if the value is in the table.
+func (v Table0267) Valid() bool { return TableValueLookup[`0267`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0267) Description() string { return tableDescription(`0267`, string(v)) }
+
+// Table0268 is a value of table 0268, Override.
+type Table0268 string
+
+const (
+	Table0268_A Table0268 = `A` // Override allowed
+	Table0268_R Table0268 = `R` // Override required
+	Table0268_X Table0268 = `X` // Override not allowed
+)
+
+// TableID returns the table ID.
+func (Table0268) TableID() string { return `0268` }
+
+// Valid reports if the value is in the table.
+func (v Table0268) Valid() bool { return TableValueLookup[`0268`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0268) Description() string { return tableDescription(`0268`, string(v)) }
+
+// Table0269 is a value of table 0269, Charge On Indicator.
+type Table0269 string
+
+const (
+	Table0269_O Table0269 = `O` // Charge on Order
+	Table0269_R Table0269 = `R` // Charge on Result
+)
+
+// TableID returns the table ID.
+func (Table0269) TableID() string { return `0269` }
+
+// Valid reports if the value is in the table.
+func (v Table0269) Valid() bool { return TableValueLookup[`0269`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0269) Description() string { return tableDescription(`0269`, string(v)) }
+
+// Table0270 is a value of table 0270, Document Type.
+type Table0270 string
+
+const (
+	Table0270_AR Table0270 = `AR` // Autopsy report
+	Table0270_CD Table0270 = `CD` // Cardiodiagnostics
+	Table0270_CN Table0270 = `CN` // Consultation
+	Table0270_DI Table0270 = `DI` // Diagnostic imaging
+	Table0270_DS Table0270 = `DS` // Discharge summary
+	Table0270_ED Table0270 = `ED` // Emergency department report
+	Table0270_HP Table0270 = `HP` // History and physical examination
+	Table0270_OP Table0270 = `OP` // Operative report
+	Table0270_PC Table0270 = `PC` // Psychiatric consultation
+	Table0270_PH Table0270 = `PH` // Psychiatric history and physical examination
+	Table0270_PN Table0270 = `PN` // Procedure note
+	Table0270_PR Table0270 = `PR` // Progress note
+	Table0270_SP Table0270 = `SP` // Surgical pathology
+	Table0270_TS Table0270 = `TS` // Transfer summary
+)
+
+// TableID returns the table ID.
+func (Table0270) TableID() string { return `0270` }
+
+// Valid reports if the value is in the table.
+func (v Table0270) Valid() bool { return TableValueLookup[`0270`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0270) Description() string { return tableDescription(`0270`, string(v)) }
+
+// Table0271 is a value of table 0271, Document Completion Status.
+type Table0271 string
+
+const (
+	Table0271_AU Table0271 = `AU` // Authenticated
+	Table0271_DI Table0271 = `DI` // Dictated
+	Table0271_DO Table0271 = `DO` // Documented
+	Table0271_IN Table0271 = `IN` // Incomplete
+	Table0271_IP Table0271 = `IP` // In Progress
+	Table0271_LA Table0271 = `LA` // Legally authenticated
+	Table0271_PA Table0271 = `PA` // Pre-authenticated
+)
+
+// TableID returns the table ID.
+func (Table0271) TableID() string { return `0271` }
+
+// Valid reports if the value is in the table.
+func (v Table0271) Valid() bool { return TableValueLookup[`0271`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0271) Description() string { return tableDescription(`0271`, string(v)) }
+
+// Table0272 is a value of table 0272, Document Confidentiality Status.
+type Table0272 string
+
+const (
+	Table0272_R Table0272 = `R` // Restricted
+	Table0272_U Table0272 = `U` // Usual control
+	Table0272_V Table0272 = `V` // Very restricted
+)
+
+// TableID returns the table ID.
+func (Table0272) TableID() string { return `0272` }
+
+// Valid reports if the value is in the table.
+func (v Table0272) Valid() bool { return TableValueLookup[`0272`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0272) Description() string { return tableDescription(`0272`, string(v)) }
+
+// Table0273 is a value of table 0273, Document Availability Status.
+type Table0273 string
+
+const (
+	Table0273_AV Table0273 = `AV` // Available for patient care
+	Table0273_CA Table0273 = `CA` // Deleted
+	Table0273_OB Table0273 = `OB` // Obsolete
+	Table0273_UN Table0273 = `UN` // Unavailable for patient care
+)
+
+// TableID returns the table ID.
+func (Table0273) TableID() string { return `0273` }
+
+// Valid reports if the value is in the table.
+func (v Table0273) Valid() bool { return TableValueLookup[`0273`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0273) Description() string { return tableDescription(`0273`, string(v)) }
+
+// Table0275 is a value of table 0275, Document Storage Status.
+type Table0275 string
+
+const (
+	Table0275_AA Table0275 = `AA` // Active and archived
+	Table0275_AC Table0275 = `AC` // Active
+	Table0275_AR Table0275 = `AR` // Archived (not active)
+	Table0275_PU Table0275 = `PU` // Purged
+)
+
+// TableID returns the table ID.
+func (Table0275) TableID() string { return `0275` }
+
+// Valid reports if the value is in the table.
+func (v Table0275) Valid() bool { return TableValueLookup[`0275`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0275) Description() string { return tableDescription(`0275`, string(v)) }
+
+// Table0276 is a value of table 0276, Appointment reason codes.
+type Table0276 string
+
+const (
+	Table0276_CHECKUP   Table0276 = `CHECKUP`   // A routine check-up, such as an annual physical
+	Table0276_EMERGENCY Table0276 = `EMERGENCY` // Emergency appointment
+	Table0276_FOLLOWUP  Table0276 = `FOLLOWUP`  // A follow up visit from a previous appointment
+	Table0276_ROUTINE   Table0276 = `ROUTINE`   // Routine appointment - default if not valued
+	Table0276_WALKIN    Table0276 = `WALKIN`    // A previously unscheduled walk-in visit
+)
+
+// TableID returns the table ID.
+func (Table0276) TableID() string { return `0276` }
+
+// Valid reports if the value is in the table.
+func (v Table0276) Valid() bool { return TableValueLookup[`0276`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0276) Description() string { return tableDescription(`0276`, string(v)) }
+
+// Table0277 is a value of table 0277, Appointment Type Codes.
+type Table0277 string
+
+const (
+	Table0277_Complete  Table0277 = `Complete`  // A request to add a completed appointment, used to maintain records of completed appointments that did not appear in the schedule (e.g., STAT, walk-in, etc.)
+	Table0277_Normal    Table0277 = `Normal`    // Routine schedule request type - default if not valued
+	Table0277_Tentative Table0277 = `Tentative` // A request for a tentative (e.g., "penciled in") appointment
+)
+
+// TableID returns the table ID.
+func (Table0277) TableID() string { return `0277` }
+
+// Valid reports if the value is in the table.
+func (v Table0277) Valid() bool { return TableValueLookup[`0277`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0277) Description() string { return tableDescription(`0277`, string(v)) }
+
+// Table0278 is a value of table 0278, Filler status codes.
+type Table0278 string
+
+const (
+	Table0278_Blocked   Table0278 = `Blocked`   // The indicated time slot(s) is(are) blocked
+	Table0278_Booked    Table0278 = `Booked`    // The indicated appointment is booked
+	Table0278_Cancelled Table0278 = `Cancelled` // The indicated appointment was stopped from occurring (canceled prior to starting)
+	Table0278_Complete  Table0278 = `Complete`  // The indicated appointment has completed normally (was not discontinued, canceled, or deleted)
+	Table0278_Dc        Table0278 = `Dc`        // The indicated appointment was discontinued (DC'ed while in progress, discontinued parent appointment, or discontinued child appointment)
+	Table0278_Deleted   Table0278 = `Deleted`   // The indicated appointment was deleted from the filler application
+	Table0278_Noshow    Table0278 = `Noshow`    // The patient did not show up for the appointment
+	Table0278_Overbook  Table0278 = `Overbook`  // The appointment has been confirmed; however it is confirmed in an overbooked state
+	Table0278_Pending   Table0278 = `Pending`   // Appointment has not yet been confirmed
+	Table0278_Started   Table0278 = `Started`   // The indicated appointment has begun and is currently in progress
+	Table0278_Waitlist  Table0278 = `Waitlist`  // Appointment has been placed on a waiting list for a particular slot, or set of slots
+)
+
+// TableID returns the table ID.
+func (Table0278) TableID() string { return `0278` }
+
+// Valid reports if the value is in the table.
+func (v Table0278) Valid() bool { return TableValueLookup[`0278`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0278) Description() string { return tableDescription(`0278`, string(v)) }
+
+// Table0279 is a value of table 0279, Allow Substitution Codes.
+type Table0279 string
+
+const (
+	Table0279_Confirm Table0279 = `Confirm` // Contact the Placer Contact Person prior to making any substitutions of this resource
+	Table0279_No      Table0279 = `No`      // Substitution of this resource is not allowed
+	Table0279_Notify  Table0279 = `Notify`  // Notify the Placer Contact Person, through normal institutional procedures, that a substitution of this resource has been made
+	Table0279_Yes     Table0279 = `Yes`     // Substitution of this resource is allowed
+)
+
+// TableID returns the table ID.
+func (Table0279) TableID() string { return `0279` }
+
+// Valid reports if the value is in the table.
+func (v Table0279) Valid() bool { return TableValueLookup[`0279`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0279) Description() string { return tableDescription(`0279`, string(v)) }
+
+// Table0280 is a value of table 0280, Referral Priority.
+type Table0280 string
+
+const (
+	Table0280_A Table0280 = `A` // ASAP
+	Table0280_R Table0280 = `R` // Routine
+	Table0280_S Table0280 = `S` // STAT
+)
+
+// TableID returns the table ID.
+func (Table0280) TableID() string { return `0280` }
+
+// Valid reports if the value is in the table.
+func (v Table0280) Valid() bool { return TableValueLookup[`0280`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0280) Description() string { return tableDescription(`0280`, string(v)) }
+
+// Table0281 is a value of table 0281, Referral Type.
+type Table0281 string
+
+const (
+	Table0281_Hom Table0281 = `Hom` // Home Care
+	Table0281_Lab Table0281 = `Lab` // Laboratory
+	Table0281_Med Table0281 = `Med` // Medical
+	Table0281_Psy Table0281 = `Psy` // Psychiatric
+	Table0281_Rad Table0281 = `Rad` // Radiology
+	Table0281_Skn Table0281 = `Skn` // Skilled Nursing
+)
+
+// TableID returns the table ID.
+func (Table0281) TableID() string { return `0281` }
+
+// Valid reports if the value is in the table.
+func (v Table0281) Valid() bool { return TableValueLookup[`0281`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0281) Description() string { return tableDescription(`0281`, string(v)) }
+
+// Table0282 is a value of table 0282, Referral Disposition.
+type Table0282 string
+
+const (
+	Table0282_AM Table0282 = `AM` // Assume Management
+	Table0282_RP Table0282 = `RP` // Return Patient After Evaluation
+	Table0282_SO Table0282 = `SO` // Second Opinion
+	Table0282_WR Table0282 = `WR` // Send Written Report
+)
+
+// TableID returns the table ID.
+func (Table0282) TableID() string { return `0282` }
+
+// Valid reports if the value is in the table.
+func (v Table0282) Valid() bool { return TableValueLookup[`0282`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0282) Description() string { return tableDescription(`0282`, string(v)) }
+
+// Table0283 is a value of table 0283, Referral Status.
+type Table0283 string
+
+const (
+	Table0283_A Table0283 = `A` // Accepted
+	Table0283_E Table0283 = `E` // Expired
+	Table0283_P Table0283 = `P` // Pending
+	Table0283_R Table0283 = `R` // Rejected
+)
+
+// TableID returns the table ID.
+func (Table0283) TableID() string { return `0283` }
+
+// Valid reports if the value is in the table.
+func (v Table0283) Valid() bool { return TableValueLookup[`0283`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0283) Description() string { return tableDescription(`0283`, string(v)) }
+
+// Table0284 is a value of table 0284, Referral Category.
+type Table0284 string
+
+const (
+	Table0284_A Table0284 = `A` // Ambulatory
+	Table0284_E Table0284 = `E` // Emergency
+	Table0284_I Table0284 = `I` // Inpatient
+	Table0284_O Table0284 = `O` // Outpatient
+)
+
+// TableID returns the table ID.
+func (Table0284) TableID() string { return `0284` }
+
+// Valid reports if the value is in the table.
+func (v Table0284) Valid() bool { return TableValueLookup[`0284`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0284) Description() string { return tableDescription(`0284`, string(v)) }
+
+// Table0285 is a value of table 0285, Insurance Company ID Codes.
+type Table0285 string
+
+// TableID returns the table ID.
+func (Table0285) TableID() string { return `0285` }
+
+// Valid reports if the value is in the table.
+func (v Table0285) Valid() bool { return TableValueLookup[`0285`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0285) Description() string { return tableDescription(`0285`, string(v)) }
+
+// Table0286 is a value of table 0286, Provider Role.
+type Table0286 string
+
+const (
+	Table0286_CP Table0286 = `CP` // Consulting Provider
+	Table0286_PP Table0286 = `PP` // Primary Care Provider
+	Table0286_RP Table0286 = `RP` // Referring Provider
+	Table0286_RT Table0286 = `RT` // Referred to Provider
+)
+
+// TableID returns the table ID.
+func (Table0286) TableID() string { return `0286` }
+
+// Valid reports if the value is in the table.
+func (v Table0286) Valid() bool { return TableValueLookup[`0286`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0286) Description() string { return tableDescription(`0286`, string(v)) }
+
+// Table0287 is a value of table 0287, Problem/Goal Action Code.
+type Table0287 string
+
+const (
+	Table0287_AD Table0287 = `AD` // ADD
+	Table0287_CO Table0287 = `CO` // CORRECT
+	Table0287_DE Table0287 = `DE` // DELETE
+	Table0287_LI Table0287 = `LI` // LINK
+	Table0287_UC Table0287 = `UC` // UNCHANGED *
+	Table0287_UN Table0287 = `UN` // UNLINK
+	Table0287_UP Table0287 = `UP` // UPDATE
+)
+
+// TableID returns the table ID.
+func (Table0287) TableID() string { return `0287` }
+
+// Valid reports if the value is in the table.
+func (v Table0287) Valid() bool { return TableValueLookup[`0287`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0287) Description() string { return tableDescription(`0287`, string(v)) }
+
+// Table0288 is a value of table 0288, Census Tract.
+type Table0288 string
+
+// TableID returns the table ID.
+func (Table0288) TableID() string { return `0288` }
+
+// Valid reports if the value is in the table.
+func (v Table0288) Valid() bool { return TableValueLookup[`0288`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0288) Description() string { return tableDescription(`0288`, string(v)) }
+
+// Table0289 is a value of table 0289, County/Parish.
+type Table0289 string
+
+// TableID returns the table ID.
+func (Table0289) TableID() string { return `0289` }
+
+// Valid reports if the value is in the table.
+func (v Table0289) Valid() bool { return TableValueLookup[`0289`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0289) Description() string { return tableDescription(`0289`, string(v)) }
+
+// Table0291 is a value of table 0291, Subtype of Referenced Data.
+type Table0291 string
+
+const (
+	Table0291_x_hl7_cda_level_one Table0291 = `x-hl7-cda-level-one` // HL7 Clinical Document Architecture Level One document
+)
+
+// TableID returns the table ID.
+func (Table0291) TableID() string { return `0291` }
+
+// Valid reports if the value is in the table.
+func (v Table0291) Valid() bool { return TableValueLookup[`0291`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0291) Description() string { return tableDescription(`0291`, string(v)) }
+
+// Table0292 is a value of table 0292, Vaccines Administered.
+type Table0292 string
+
+const (
+	Table0292_01  Table0292 = `01`  // DTP
+	Table0292_02  Table0292 = `02`  // OPV
+	Table0292_03  Table0292 = `03`  // MMR
+	Table0292_04  Table0292 = `04`  // M/R
+	Table0292_05  Table0292 = `05`  // measles
+	Table0292_06  Table0292 = `06`  // rubella
+	Table0292_07  Table0292 = `07`  // mumps
+	Table0292_08  Table0292 = `08`  // Hep B, adolescent or pediatric
+	Table0292_09  Table0292 = `09`  // Td (adult)
+	Table0292_10  Table0292 = `10`  // IPV
+	Table0292_100 Table0292 = `100` // pneumococcal conjugate
+	Table0292_101 Table0292 = `101` // typhoid, ViCPs
+	Table0292_102 Table0292 = `102` // DTP-Hib-Hep B
+	Table0292_103 Table0292 = `103` // meningococcal C conjugate
+	Table0292_104 Table0292 = `104` // Hep A-Hep B
+	Table0292_105 Table0292 = `105` // vaccinia (smallpox) diluted
+	Table0292_106 Table0292 = `106` // DTaP, 5 pertussis antigens6
+	Table0292_107 Table0292 = `107` // DTaP, NOS
+	Table0292_108 Table0292 = `108` // meningococcal, NOS
+	Table0292_109 Table0292 = `109` // pneumococcal, NOS
+	Table0292_11  Table0292 = `11`  // pertussis
+	Table0292_110 Table0292 = `110` // DTaP-Hep B-IPV
+	Table0292_111 Table0292 = `111` // influenza, live, intranasal
+	Table0292_112 Table0292 = `112` // tetanus toxoid, NOS
+	Table0292_113 Table0292 = `113` // Td (adult)
+	Table0292_114 Table0292 = `114` // meningococcal A,C,Y,W-135 diphtheria conjugate
+	Table0292_115 Table0292 = `115` // Tdap
+	Table0292_116 Table0292 = `116` // rotavirus, pentavalent
+	Table0292_117 Table0292 = `117` // VZIG (IND)
+	Table0292_118 Table0292 = `118` // HPV, bivalent
+	Table0292_119 Table0292 = `119` // rotavirus, monovalent
+	Table0292_12  Table0292 = `12`  // diphtheria antitoxin
+	Table0292_120 Table0292 = `120` // DTaP-Hib-IPV
+	Table0292_121 Table0292 = `121` // zoster
+	Table0292_122 Table0292 = `122` // rotavirus, NOS1
+	Table0292_13  Table0292 = `13`  // TIG
+	Table0292_14  Table0292 = `14`  // IG, NOS
+	Table0292_15  Table0292 = `15`  // influenza, split (incl. purified surface antigen)
+	Table0292_16  Table0292 = `16`  // influenza, whole
+	Table0292_17  Table0292 = `17`  // Hib, NOS
+	Table0292_18  Table0292 = `18`  // rabies, intramuscular injection
+	Table0292_19  Table0292 = `19`  // BCG
+	Table0292_20  Table0292 = `20`  // DTaP
+	Table0292_21  Table0292 = `21`  // varicella
+	Table0292_22  Table0292 = `22`  // DTP-Hib
+	Table0292_23  Table0292 = `23`  // plague
+	Table0292_24  Table0292 = `24`  // anthrax
+	Table0292_25  Table0292 = `25`  // typhoid, oral
+	Table0292_26  Table0292 = `26`  // cholera
+	Table0292_27  Table0292 = `27`  // botulinum antitoxin
+	Table0292_28  Table0292 = `28`  // DT (pediatric)
+	Table0292_29  Table0292 = `29`  // CMVIG
+	Table0292_30  Table0292 = `30`  // HBIG
+	Table0292_31  Table0292 = `31`  // Hep A, pediatric, NOS
+	Table0292_32  Table0292 = `32`  // meningococcal
+	Table0292_33  Table0292 = `33`  // pneumococcal
+	Table0292_34  Table0292 = `34`  // RIG
+	Table0292_35  Table0292 = `35`  // tetanus toxoid
+	Table0292_36  Table0292 = `36`  // VZIG
+	Table0292_37  Table0292 = `37`  // yellow fever
+	Table0292_38  Table0292 = `38`  // rubella/mumps
+	Table0292_39  Table0292 = `39`  // Japanese encephalitis
+	Table0292_40  Table0292 = `40`  // rabies, intradermal injection
+	Table0292_41  Table0292 = `41`  // typhoid, parenteral
+	Table0292_42  Table0292 = `42`  // Hep B, adolescent/high risk infant2
+	Table0292_43  Table0292 = `43`  // Hep B, adult4
+	Table0292_44  Table0292 = `44`  // Hep B, dialysis
+	Table0292_45  Table0292 = `45`  // Hep B, NOS
+	Table0292_46  Table0292 = `46`  // Hib (PRP-D)
+	Table0292_47  Table0292 = `47`  // Hib (HbOC)
+	Table0292_48  Table0292 = `48`  // Hib (PRP-T)
+	Table0292_49  Table0292 = `49`  // Hib (PRP-OMP)
+	Table0292_50  Table0292 = `50`  // DTaP-Hib
+	Table0292_51  Table0292 = `51`  // Hib-Hep B
+	Table0292_52  Table0292 = `52`  // Hep A, adult
+	Table0292_53  Table0292 = `53`  // typhoid, parenteral, AKD (U.S. military)
+	Table0292_54  Table0292 = `54`  // adenovirus, type 4
+	Table0292_55  Table0292 = `55`  // adenovirus, type 7
+	Table0292_56  Table0292 = `56`  // dengue fever
+	Table0292_57  Table0292 = `57`  // hantavirus
+	Table0292_58  Table0292 = `58`  // Hep C
+	Table0292_59  Table0292 = `59`  // Hep E
+	Table0292_60  Table0292 = `60`  // herpes simplex 2
+	Table0292_61  Table0292 = `61`  // HIV
+	Table0292_62  Table0292 = `62`  // HPV, quadrivalent
+	Table0292_63  Table0292 = `63`  // Junin virus
+	Table0292_64  Table0292 = `64`  // leishmaniasis
+	Table0292_65  Table0292 = `65`  // leprosy
+	Table0292_66  Table0292 = `66`  // Lyme disease
+	Table0292_67  Table0292 = `67`  // malaria
+	Table0292_68  Table0292 = `68`  // melanoma
+	Table0292_69  Table0292 = `69`  // parainfluenza-3
+	Table0292_70  Table0292 = `70`  // Q fever
+	Table0292_71  Table0292 = `71`  // RSV-IGIV
+	Table0292_72  Table0292 = `72`  // rheumatic fever
+	Table0292_73  Table0292 = `73`  // Rift Valley fever
+	Table0292_74  Table0292 = `74`  // rotavirus, tetravalent
+	Table0292_75  Table0292 = `75`  // vaccinia (smallpox)
+	Table0292_76  Table0292 = `76`  // Staphylococcus bacterio lysate
+	Table0292_77  Table0292 = `77`  // tick-borne encephalitis
+	Table0292_78  Table0292 = `78`  // tularemia vaccine
+	Table0292_79  Table0292 = `79`  // vaccinia immune globulin
+	Table0292_80  Table0292 = `80`  // VEE, live
+	Table0292_81  Table0292 = `81`  // VEE, inactivated
+	Table0292_82  Table0292 = `82`  // adenovirus, NOS1
+	Table0292_83  Table0292 = `83`  // Hep A, ped/adol, 2 dose
+	Table0292_84  Table0292 = `84`  // Hep A, ped/adol, 3 dose
+	Table0292_85  Table0292 = `85`  // Hep A, NOS
+	Table0292_86  Table0292 = `86`  // IG
+	Table0292_87  Table0292 = `87`  // IGIV
+	Table0292_88  Table0292 = `88`  // influenza, NOS
+	Table0292_89  Table0292 = `89`  // polio, NOS
+	Table0292_90  Table0292 = `90`  // rabies, NOS
+	Table0292_91  Table0292 = `91`  // typhoid, NOS
+	Table0292_92  Table0292 = `92`  // VEE, NOS
+	Table0292_93  Table0292 = `93`  // RSV-MAb
+	Table0292_94  Table0292 = `94`  // MMRV
+	Table0292_95  Table0292 = `95`  // TST-OT tine test
+	Table0292_96  Table0292 = `96`  // TST-PPD intradermal
+	Table0292_97  Table0292 = `97`  // TST-PPD tine test
+	Table0292_98  Table0292 = `98`  // TST, NOS
+	Table0292_99  Table0292 = `99`  // RESERVED - do not use3
+	Table0292_998 Table0292 = `998` // no vaccine administered5
+	Table0292_999 Table0292 = `999` // unknown
+)
+
+// TableID returns the table ID.
+func (Table0292) TableID() string { return `0292` }
+
+// Valid reports if the value is in the table.
+func (v Table0292) Valid() bool { return TableValueLookup[`0292`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0292) Description() string { return tableDescription(`0292`, string(v)) }
+
+// Table0293 is a value of table 0293, Billing Category.
+type Table0293 string
+
+// TableID returns the table ID.
+func (Table0293) TableID() string { return `0293` }
+
+// Valid reports if the value is in the table.
+func (v Table0293) Valid() bool { return TableValueLookup[`0293`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0293) Description() string { return tableDescription(`0293`, string(v)) }
+
+// Table0294 is a value of table 0294, Time Selection Criteria Parameter Class Codes.
+type Table0294 string
+
+const (
+	Table0294_Fri       Table0294 = `Fri`       // An indicator that Friday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Mon       Table0294 = `Mon`       // An indicator that Monday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Prefend   Table0294 = `Prefend`   // An indicator that there is a preferred end time for the appointment request, service or resource.
+	Table0294_Prefstart Table0294 = `Prefstart` // An indicator that there is a preferred start time for the appointment request, service or resource.
+	Table0294_Sat       Table0294 = `Sat`       // An indicator that Saturday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Sun       Table0294 = `Sun`       // An indicator that Sunday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Thu       Table0294 = `Thu`       // An indicator that Thursday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Tue       Table0294 = `Tue`       // An indicator that Tuesday is or is not preferred for the day on which the appointment will occur.
+	Table0294_Wed       Table0294 = `Wed`       // An indicator that Wednesday is or is not preferred for the day on which the appointment will occur.
+)
+
+// TableID returns the table ID.
+func (Table0294) TableID() string { return `0294` }
+
+// Valid reports if the value is in the table.
+func (v Table0294) Valid() bool { return TableValueLookup[`0294`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0294) Description() string { return tableDescription(`0294`, string(v)) }
+
+// Table0295 is a value of table 0295, Handicap.
+type Table0295 string
+
+// TableID returns the table ID.
+func (Table0295) TableID() string { return `0295` }
+
+// Valid reports if the value is in the table.
+func (v Table0295) Valid() bool { return TableValueLookup[`0295`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0295) Description() string { return tableDescription(`0295`, string(v)) }
+
+// Table0296 is a value of table 0296, Primary Language.
+type Table0296 string
+
+// TableID returns the table ID.
+func (Table0296) TableID() string { return `0296` }
+
+// Valid reports if the value is in the table.
+func (v Table0296) Valid() bool { return TableValueLookup[`0296`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0296) Description() string { return tableDescription(`0296`, string(v)) }
+
+// Table0297 is a value of table 0297, CN ID Source.
+type Table0297 string
+
+// TableID returns the table ID.
+func (Table0297) TableID() string { return `0297` }
+
+// Valid reports if the value is in the table.
+func (v Table0297) Valid() bool { return TableValueLookup[`0297`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0297) Description() string { return tableDescription(`0297`, string(v)) }
+
+// Table0298 is a value of table 0298, CP Range Type.
+type Table0298 string
+
+const (
+	Table0298_F Table0298 = `F` // Flat-rate. Apply the entire price to this interval, do not pro-rate the price if the full interval has not occurred/been consumed
+	Table0298_P Table0298 = `P` // Pro-rate. Apply this price to this interval, pro-rated by whatever portion of the interval has occurred/been consumed
+)
+
+// TableID returns the table ID.
+func (Table0298) TableID() string { return `0298` }
+
+// Valid reports if the value is in the table.
+func (v Table0298) Valid() bool { return TableValueLookup[`0298`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0298) Description() string { return tableDescription(`0298`, string(v)) }
+
+// Table0299 is a value of table 0299, Encoding.
+type Table0299 string
+
+const (
+	Table0299_A      Table0299 = `A`      // No encoding - data are displayable ASCII characters.
+	Table0299_Base64 Table0299 = `Base64` // Encoding as defined by MIME (Multipurpose Internet Mail Extensions) standard RFC 1521. Four consecutive ASCII characters represent three consecutive octets of binary data. Base64 utilizes a 65-character subset of US-ASCII, consisting of both the upper and
+	Table0299_Hex    Table0299 = `Hex`    // Hexadecimal encoding - consecutive pairs of hexadecimal digits represent consecutive single octets.
+)
+
+// TableID returns the table ID.
+func (Table0299) TableID() string { return `0299` }
+
+// Valid reports if the value is in the table.
+func (v Table0299) Valid() bool { return TableValueLookup[`0299`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0299) Description() string { return tableDescription(`0299`, string(v)) }
+
+// Table0300 is a value of table 0300, Namespace ID.
+type Table0300 string
+
+// TableID returns the table ID.
+func (Table0300) TableID() string { return `0300` }
+
+// Valid reports if the value is in the table.
+func (v Table0300) Valid() bool { return TableValueLookup[`0300`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0300) Description() string { return tableDescription(`0300`, string(v)) }
+
+// Table0301 is a value of table 0301, Universal ID Type.
+type Table0301 string
+
+const (
+	Table0301_CLIA   Table0301 = `CLIA`   // Clinical Laboratory Improvement Amendments. Allows for the ability to designate organization identifier as a "CLIA" assigned number (for labs)
+	Table0301_CLIP   Table0301 = `CLIP`   // Clinical laboratory Improvement Program. Allows for the ability to designate organization identifier as a "CLIP" assigned number (for labs).Â Used by US Department of Defense.
+	Table0301_DNS    Table0301 = `DNS`    // An Internet host name, in accordance with RFC 1035; or an IP address. Either in ASCII or as integers, with periods between components ("dotted" notation).
+	Table0301_EUI64  Table0301 = `EUI64`  // IEEE 64-bit Extended Unique Identifier is comprised of a 24-bit company identifier and a 40-bit instance identifier. The value shall be formatted as 16 ASCII HEX digits, for example, "AABBCC1122334455". The 24-bit company identifier, formally known as
+	Table0301_GUID   Table0301 = `GUID`   // Same as UUID.
+	Table0301_HCD    Table0301 = `HCD`    // The CEN Healthcare Coding Scheme Designator
+	Table0301_HL7    Table0301 = `HL7`    // HL7 registration schemes
+	Table0301_ISO    Table0301 = `ISO`    // An International Standards Organization Object Identifier (OID), in accordance with ISO/IEC 8824. Formatted as decimal digits separated by periods; recommended limit of 64 characters
+	Table0301_Random Table0301 = `Random` // Usually a base64 encoded string of random bits.<p>Note: Random IDs are typically used for instance identifiers, rather than an identifier of an Assigning Authority that issues instance identifiers
+	Table0301_URI    Table0301 = `URI`    // Uniform Resource Identifier
+	Table0301_UUID   Table0301 = `UUID`   // The DCE Universal Unique Identifier, in accordance with RFC 4122. Recommended format is 32 hexadecimal digits separated by hyphens, in the digit grouping 8-4-4-4-12
+	Table0301_x400   Table0301 = `x400`   // An X.400 MHS identifier. Recommended format is in accordance with RFC 1649
+	Table0301_x500   Table0301 = `x500`   // An X.500 directory name
+)
+
+// TableID returns the table ID.
+func (Table0301) TableID() string { return `0301` }
+
+// Valid reports if the value is in the table.
+func (v Table0301) Valid() bool { return TableValueLookup[`0301`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0301) Description() string { return tableDescription(`0301`, string(v)) }
+
+// Table0302 is a value of table 0302, Point of Care.
+type Table0302 string
+
+// TableID returns the table ID.
+func (Table0302) TableID() string { return `0302` }
+
+// Valid reports if the value is in the table.
+func (v Table0302) Valid() bool { return TableValueLookup[`0302`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0302) Description() string { return tableDescription(`0302`, string(v)) }
+
+// Table0303 is a value of table 0303, Room.
+type Table0303 string
+
+// TableID returns the table ID.
+func (Table0303) TableID() string { return `0303` }
+
+// Valid reports if the value is in the table.
+func (v Table0303) Valid() bool { return TableValueLookup[`0303`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0303) Description() string { return tableDescription(`0303`, string(v)) }
+
+// Table0304 is a value of table 0304, Bed.
+type Table0304 string
+
+// TableID returns the table ID.
+func (Table0304) TableID() string { return `0304` }
+
+// Valid reports if the value is in the table.
+func (v Table0304) Valid() bool { return TableValueLookup[`0304`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0304) Description() string { return tableDescription(`0304`, string(v)) }
+
+// Table0305 is a value of table 0305, Person Location Type.
+type Table0305 string
+
+const (
+	Table0305_C Table0305 = `C` // Clinic
+	Table0305_D Table0305 = `D` // Department
+	Table0305_H Table0305 = `H` // Home
+	Table0305_N Table0305 = `N` // Nursing Unit
+	Table0305_O Table0305 = `O` // Provider's Office
+	Table0305_P Table0305 = `P` // Phone
+	Table0305_S Table0305 = `S` // SNF
+)
+
+// TableID returns the table ID.
+func (Table0305) TableID() string { return `0305` }
+
+// Valid reports if the value is in the table.
+func (v Table0305) Valid() bool { return TableValueLookup[`0305`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0305) Description() string { return tableDescription(`0305`, string(v)) }
+
+// Table0306 is a value of table 0306, Location Status.
+type Table0306 string
+
+// TableID returns the table ID.
+func (Table0306) TableID() string { return `0306` }
+
+// Valid reports if the value is in the table.
+func (v Table0306) Valid() bool { return TableValueLookup[`0306`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0306) Description() string { return tableDescription(`0306`, string(v)) }
+
+// Table0307 is a value of table 0307, Building.
+type Table0307 string
+
+// TableID returns the table ID.
+func (Table0307) TableID() string { return `0307` }
+
+// Valid reports if the value is in the table.
+func (v Table0307) Valid() bool { return TableValueLookup[`0307`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0307) Description() string { return tableDescription(`0307`, string(v)) }
+
+// Table0308 is a value of table 0308, Floor.
+type Table0308 string
+
+// TableID returns the table ID.
+func (Table0308) TableID() string { return `0308` }
+
+// Valid reports if the value is in the table.
+func (v Table0308) Valid() bool { return TableValueLookup[`0308`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0308) Description() string { return tableDescription(`0308`, string(v)) }
+
+// Table0309 is a value of table 0309, Coverage Type.
+type Table0309 string
+
+const (
+	Table0309_B  Table0309 = `B`  // Both hospital and physician
+	Table0309_H  Table0309 = `H`  // Hospital/institutional
+	Table0309_P  Table0309 = `P`  // Physician/professional
+	Table0309_RX Table0309 = `RX` // Pharmacy
+)
+
+// TableID returns the table ID.
+func (Table0309) TableID() string { return `0309` }
+
+// Valid reports if the value is in the table.
+func (v Table0309) Valid() bool { return TableValueLookup[`0309`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0309) Description() string { return tableDescription(`0309`, string(v)) }
+
+// Table0311 is a value of table 0311, Job Status.
+type Table0311 string
+
+const (
+	Table0311_O Table0311 = `O` // Other
+	Table0311_P Table0311 = `P` // Permanent
+	Table0311_T Table0311 = `T` // Temporary
+	Table0311_U Table0311 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0311) TableID() string { return `0311` }
+
+// Valid reports if the value is in the table.
+func (v Table0311) Valid() bool { return TableValueLookup[`0311`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0311) Description() string { return tableDescription(`0311`, string(v)) }
+
+// Table0312 is a value of table 0312, Policy Scope.
+type Table0312 string
+
+// TableID returns the table ID.
+func (Table0312) TableID() string { return `0312` }
+
+// Valid reports if the value is in the table.
+func (v Table0312) Valid() bool { return TableValueLookup[`0312`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0312) Description() string { return tableDescription(`0312`, string(v)) }
+
+// Table0313 is a value of table 0313, Policy Source.
+type Table0313 string
+
+// TableID returns the table ID.
+func (Table0313) TableID() string { return `0313` }
+
+// Valid reports if the value is in the table.
+func (v Table0313) Valid() bool { return TableValueLookup[`0313`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0313) Description() string { return tableDescription(`0313`, string(v)) }
+
+// Table0315 is a value of table 0315, Living Will Code.
+type Table0315 string
+
+const (
+	Table0315_F Table0315 = `F` // Yes, patient has a living will but it is not on file
+	Table0315_I Table0315 = `I` // No, patient does not have a living will but information was provided
+	Table0315_N Table0315 = `N` // No, patient does not have a living will and no information was provided
+	Table0315_U Table0315 = `U` // Unknown
+	Table0315_Y Table0315 = `Y` // Yes, patient has a living will
+)
+
+// TableID returns the table ID.
+func (Table0315) TableID() string { return `0315` }
+
+// Valid reports if the value is in the table.
+func (v Table0315) Valid() bool { return TableValueLookup[`0315`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0315) Description() string { return tableDescription(`0315`, string(v)) }
+
+// Table0316 is a value of table 0316, Organ Donor Code.
+type Table0316 string
+
+const (
+	Table0316_F Table0316 = `F` // Yes, patient is a documented donor, but documentation is not on file
+	Table0316_I Table0316 = `I` // No, patient is not a documented donor, but information was provided
+	Table0316_N Table0316 = `N` // No, patient has not agreed to be a donor
+	Table0316_P Table0316 = `P` // Patient leaves organ donation decision to a specific person
+	Table0316_R Table0316 = `R` // Patient leaves organ donation decision to relatives
+	Table0316_U Table0316 = `U` // Unknown
+	Table0316_Y Table0316 = `Y` // Yes, patient is a documented donor and documentation is on file
+)
+
+// TableID returns the table ID.
+func (Table0316) TableID() string { return `0316` }
+
+// Valid reports if the value is in the table.
+func (v Table0316) Valid() bool { return TableValueLookup[`0316`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0316) Description() string { return tableDescription(`0316`, string(v)) }
+
+// Table0319 is a value of table 0319, Department Cost Center.
+type Table0319 string
+
+// TableID returns the table ID.
+func (Table0319) TableID() string { return `0319` }
+
+// Valid reports if the value is in the table.
+func (v Table0319) Valid() bool { return TableValueLookup[`0319`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0319) Description() string { return tableDescription(`0319`, string(v)) }
+
+// Table0320 is a value of table 0320, Item Natural Account Code.
+type Table0320 string
+
+// TableID returns the table ID.
+func (Table0320) TableID() string { return `0320` }
+
+// Valid reports if the value is in the table.
+func (v Table0320) Valid() bool { return TableValueLookup[`0320`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0320) Description() string { return tableDescription(`0320`, string(v)) }
+
+// Table0321 is a value of table 0321, Dispense Method.
+type Table0321 string
+
+const (
+	Table0321_AD Table0321 = `AD` // Automatic Dispensing
+	Table0321_F  Table0321 = `F`  // Floor Stock
+	Table0321_TR Table0321 = `TR` // Traditional
+	Table0321_UD Table0321 = `UD` // Unit Dose
+)
+
+// TableID returns the table ID.
+func (Table0321) TableID() string { return `0321` }
+
+// Valid reports if the value is in the table.
+func (v Table0321) Valid() bool { return TableValueLookup[`0321`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0321) Description() string { return tableDescription(`0321`, string(v)) }
+
+// Table0322 is a value of table 0322, Completion Status.
+type Table0322 string
+
+const (
+	Table0322_CP Table0322 = `CP` // Complete
+	Table0322_NA Table0322 = `NA` // Not Administered
+	Table0322_PA Table0322 = `PA` // Partially Administered
+	Table0322_RE Table0322 = `RE` // Refused
+)
+
+// TableID returns the table ID.
+func (Table0322) TableID() string { return `0322` }
+
+// Valid reports if the value is in the table.
+func (v Table0322) Valid() bool { return TableValueLookup[`0322`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0322) Description() string { return tableDescription(`0322`, string(v)) }
+
+// Table0324 is a value of table 0324, Location Characteristic ID.
+type Table0324 string
+
+const (
+	Table0324_GEN Table0324 = `GEN` // Gender of patient(s)
+	Table0324_IMP Table0324 = `IMP` // Implant: can be used for radiation implant patients
+	Table0324_INF Table0324 = `INF` // Infectious disease: this location can be used for isolation
+	Table0324_LCR Table0324 = `LCR` // Level of care
+	Table0324_LIC Table0324 = `LIC` // Licensed
+	Table0324_OVR Table0324 = `OVR` // Overflow
+	Table0324_PRL Table0324 = `PRL` // Privacy level: indicating the level of private versus non-private room
+	Table0324_SET Table0324 = `SET` // Bed is set up
+	Table0324_SHA Table0324 = `SHA` // Shadow: a temporary holding location that does not physically exist
+	Table0324_SMK Table0324 = `SMK` // Smoking
+	Table0324_STF Table0324 = `STF` // Bed is staffed
+	Table0324_TEA Table0324 = `TEA` // Teaching location
+)
+
+// TableID returns the table ID.
+func (Table0324) TableID() string { return `0324` }
+
+// Valid reports if the value is in the table.
+func (v Table0324) Valid() bool { return TableValueLookup[`0324`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0324) Description() string { return tableDescription(`0324`, string(v)) }
+
+// Table0325 is a value of table 0325, Location Relationship ID.
+type Table0325 string
+
+const (
+	Table0325_ALI Table0325 = `ALI` // Location Alias(es)
+	Table0325_DTY Table0325 = `DTY` // Nearest dietary location
+	Table0325_LAB Table0325 = `LAB` // Nearest lab
+	Table0325_LB2 Table0325 = `LB2` // Second nearest lab
+	Table0325_PAR Table0325 = `PAR` // Parent location
+	Table0325_RX  Table0325 = `RX`  // Nearest pharmacy
+	Table0325_RX2 Table0325 = `RX2` // Second nearest pharmacy
+)
+
+// TableID returns the table ID.
+func (Table0325) TableID() string { return `0325` }
+
+// Valid reports if the value is in the table.
+func (v Table0325) Valid() bool { return TableValueLookup[`0325`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0325) Description() string { return tableDescription(`0325`, string(v)) }
+
+// Table0326 is a value of table 0326, Visit Indicator.
+type Table0326 string
+
+const (
+	Table0326_A Table0326 = `A` // Account level (default)
+	Table0326_V Table0326 = `V` // Visit level
+)
+
+// TableID returns the table ID.
+func (Table0326) TableID() string { return `0326` }
+
+// Valid reports if the value is in the table.
+func (v Table0326) Valid() bool { return TableValueLookup[`0326`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0326) Description() string { return tableDescription(`0326`, string(v)) }
+
+// Table0327 is a value of table 0327, Job Code.
+type Table0327 string
+
+// TableID returns the table ID.
+func (Table0327) TableID() string { return `0327` }
+
+// Valid reports if the value is in the table.
+func (v Table0327) Valid() bool { return TableValueLookup[`0327`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0327) Description() string { return tableDescription(`0327`, string(v)) }
+
+// Table0328 is a value of table 0328, Employee Classification.
+type Table0328 string
+
+// TableID returns the table ID.
+func (Table0328) TableID() string { return `0328` }
+
+// Valid reports if the value is in the table.
+func (v Table0328) Valid() bool { return TableValueLookup[`0328`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0328) Description() string { return tableDescription(`0328`, string(v)) }
+
+// Table0332 is a value of table 0332, Source Type.
+type Table0332 string
+
+const (
+	Table0332_A Table0332 = `A` // Accept
+	Table0332_I Table0332 = `I` // Initiate
+)
+
+// TableID returns the table ID.
+func (Table0332) TableID() string { return `0332` }
+
+// Valid reports if the value is in the table.
+func (v Table0332) Valid() bool { return TableValueLookup[`0332`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0332) Description() string { return tableDescription(`0332`, string(v)) }
+
+// Table0333 is a value of table 0333, Driver's License Issuing Authority.
+type Table0333 string
+
+// TableID returns the table ID.
+func (Table0333) TableID() string { return `0333` }
+
+// Valid reports if the value is in the table.
+func (v Table0333) Valid() bool { return TableValueLookup[`0333`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0333) Description() string { return tableDescription(`0333`, string(v)) }
+
+// Table0334 is a value of table 0334, Disabled Person Code  .
+type Table0334 string
+
+const (
+	Table0334_AP Table0334 = `AP` // Associated party
+	Table0334_GT Table0334 = `GT` // Guarantor
+	Table0334_IN Table0334 = `IN` // Insured
+	Table0334_PT Table0334 = `PT` // Patient
+)
+
+// TableID returns the table ID.
+func (Table0334) TableID() string { return `0334` }
+
+// Valid reports if the value is in the table.
+func (v Table0334) Valid() bool { return TableValueLookup[`0334`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0334) Description() string { return tableDescription(`0334`, string(v)) }
+
+// Table0335 is a value of table 0335, Repeat Pattern.
+type Table0335 string
+
+const (
+	Table0335_A                    Table0335 = `A`                    // Ante (before)
+	Table0335_BID                  Table0335 = `BID`                  // twice a day at institution-specified times
+	Table0335_C                    Table0335 = `C`                    // service is provided continuously between start time and stop time
+	Table0335_D                    Table0335 = `D`                    // Cibus Diurnus (lunch)
+	Table0335_I                    Table0335 = `I`                    // Inter
+	Table0335_M                    Table0335 = `M`                    // Cibus Matutinus (breakfast)
+	Table0335_Meal_Related_Timings Table0335 = `Meal Related Timings` // <timing>C ("cum")<meal>
+	Table0335_Once                 Table0335 = `Once`                 // one time only.
+	Table0335_P                    Table0335 = `P`                    // Post (after)
+	Table0335_PRN                  Table0335 = `PRN`                  // given as needed
+	Table0335_PRNxxx               Table0335 = `PRNxxx`               // where xxx is some frequency code
+	Table0335_QAM                  Table0335 = `QAM`                  // in the morning at institution-specified time
+	Table0335_QHS                  Table0335 = `QHS`                  // every day before the hour of sleep
+	Table0335_QID                  Table0335 = `QID`                  // four times a day at institution-specified times
+	Table0335_QOD                  Table0335 = `QOD`                  // every other day
+	Table0335_QPM                  Table0335 = `QPM`                  // in the evening at institution-specified time
+	Table0335_QSHIFT               Table0335 = `QSHIFT`               // during each of three eight-hour shifts at institution-specified times
+	Table0335_TID                  Table0335 = `TID`                  // three times a day at institution-specified times
+	Table0335_V                    Table0335 = `V`                    // Cibus Vespertinus (dinner)
+	Table0335_xID                  Table0335 = `xID`                  // "X" times per day at institution-specified times, where X is a numeral 5 or greater.
+)
+
+// TableID returns the table ID.
+func (Table0335) TableID() string { return `0335` }
+
+// Valid reports if the value is in the table.
+func (v Table0335) Valid() bool { return TableValueLookup[`0335`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0335) Description() string { return tableDescription(`0335`, string(v)) }
+
+// Table0336 is a value of table 0336, Referral Reason.
+type Table0336 string
+
+const (
+	Table0336_O Table0336 = `O` // Provider Ordered
+	Table0336_P Table0336 = `P` // Patient Preference
+	Table0336_S Table0336 = `S` // Second Opinion
+	Table0336_W Table0336 = `W` // Work Load
+)
+
+// TableID returns the table ID.
+func (Table0336) TableID() string { return `0336` }
+
+// Valid reports if the value is in the table.
+func (v Table0336) Valid() bool { return TableValueLookup[`0336`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0336) Description() string { return tableDescription(`0336`, string(v)) }
+
+// Table0337 is a value of table 0337, Certification Status.
+type Table0337 string
+
+const (
+	Table0337_C Table0337 = `C` // Certified
+	Table0337_E Table0337 = `E` // Eligible
+)
+
+// TableID returns the table ID.
+func (Table0337) TableID() string { return `0337` }
+
+// Valid reports if the value is in the table.
+func (v Table0337) Valid() bool { return TableValueLookup[`0337`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0337) Description() string { return tableDescription(`0337`, string(v)) }
+
+// Table0338 is a value of table 0338, Practitioner ID Number Type.
+type Table0338 string
+
+const (
+	Table0338_CY   Table0338 = `CY`   // County number
+	Table0338_DEA  Table0338 = `DEA`  // Drug Enforcement Agency no.
+	Table0338_GL   Table0338 = `GL`   // General ledger number
+	Table0338_LI   Table0338 = `LI`   // Labor and industries number
+	Table0338_MCD  Table0338 = `MCD`  // Medicaid number
+	Table0338_MCR  Table0338 = `MCR`  // Medicare number
+	Table0338_QA   Table0338 = `QA`   // QA number
+	Table0338_SL   Table0338 = `SL`   // State license number
+	Table0338_TAX  Table0338 = `TAX`  // Tax ID number
+	Table0338_TRL  Table0338 = `TRL`  // Training license number
+	Table0338_UPIN Table0338 = `UPIN` // Unique physician ID no.
+)
+
+// TableID returns the table ID.
+func (Table0338) TableID() string { return `0338` }
+
+// Valid reports if the value is in the table.
+func (v Table0338) Valid() bool { return TableValueLookup[`0338`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0338) Description() string { return tableDescription(`0338`, string(v)) }
+
+// Table0339 is a value of table 0339, Advanced Beneficiary Notice Code.
+type Table0339 string
+
+const (
+	Table0339_1 Table0339 = `1` // Service is subject to medical necessity procedures
+	Table0339_2 Table0339 = `2` // Patient has been informed of responsibility, and agrees to pay for service
+	Table0339_3 Table0339 = `3` // Patient has been informed of responsibility, and asks that the payer be billed
+	Table0339_4 Table0339 = `4` // Advanced Beneficiary Notice has not been signed
+)
+
+// TableID returns the table ID.
+func (Table0339) TableID() string { return `0339` }
+
+// Valid reports if the value is in the table.
+func (v Table0339) Valid() bool { return TableValueLookup[`0339`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0339) Description() string { return tableDescription(`0339`, string(v)) }
+
+// Table0340 is a value of table 0340, Procedure Code Modifier.
+type Table0340 string
+
+// TableID returns the table ID.
+func (Table0340) TableID() string { return `0340` }
+
+// Valid reports if the value is in the table.
+func (v Table0340) Valid() bool { return TableValueLookup[`0340`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0340) Description() string { return tableDescription(`0340`, string(v)) }
+
+// Table0341 is a value of table 0341, Guarantor Credit Rating Code.
+type Table0341 string
+
+// TableID returns the table ID.
+func (Table0341) TableID() string { return `0341` }
+
+// Valid reports if the value is in the table.
+func (v Table0341) Valid() bool { return TableValueLookup[`0341`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0341) Description() string { return tableDescription(`0341`, string(v)) }
+
+// Table0342 is a value of table 0342, Military Recipient.
+type Table0342 string
+
+// TableID returns the table ID.
+func (Table0342) TableID() string { return `0342` }
+
+// Valid reports if the value is in the table.
+func (v Table0342) Valid() bool { return TableValueLookup[`0342`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0342) Description() string { return tableDescription(`0342`, string(v)) }
+
+// Table0343 is a value of table 0343, Military Handicapped Program Code.
+type Table0343 string
+
+// TableID returns the table ID.
+func (Table0343) TableID() string { return `0343` }
+
+// Valid reports if the value is in the table.
+func (v Table0343) Valid() bool { return TableValueLookup[`0343`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0343) Description() string { return tableDescription(`0343`, string(v)) }
+
+// Table0344 is a value of table 0344, Patient's Relationship to Insured.
+type Table0344 string
+
+const (
+	Table0344_01 Table0344 = `01` // Patient is insured
+	Table0344_02 Table0344 = `02` // Spouse
+	Table0344_03 Table0344 = `03` // Natural child/insured financial responsibility
+	Table0344_04 Table0344 = `04` // Natural child/Insured does not have financial responsibility
+	Table0344_05 Table0344 = `05` // Step child
+	Table0344_06 Table0344 = `06` // Foster child
+	Table0344_07 Table0344 = `07` // Ward of the court
+	Table0344_08 Table0344 = `08` // Employee
+	Table0344_09 Table0344 = `09` // Unknown
+	Table0344_10 Table0344 = `10` // Handicapped dependent
+	Table0344_11 Table0344 = `11` // Organ donor
+	Table0344_12 Table0344 = `12` // Cadaver donor
+	Table0344_13 Table0344 = `13` // Grandchild
+	Table0344_14 Table0344 = `14` // Niece/nephew
+	Table0344_15 Table0344 = `15` // Injured plaintiff
+	Table0344_16 Table0344 = `16` // Sponsored dependent
+	Table0344_17 Table0344 = `17` // Minor dependent of a minor dependent
+	Table0344_18 Table0344 = `18` // Parent
+	Table0344_19 Table0344 = `19` // Grandparent
+)
+
+// TableID returns the table ID.
+func (Table0344) TableID() string { return `0344` }
+
+// Valid reports if the value is in the table.
+func (v Table0344) Valid() bool { return TableValueLookup[`0344`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0344) Description() string { return tableDescription(`0344`, string(v)) }
+
+// Table0345 is a value of table 0345, Appeal Reason.
+type Table0345 string
+
+// TableID returns the table ID.
+func (Table0345) TableID() string { return `0345` }
+
+// Valid reports if the value is in the table.
+func (v Table0345) Valid() bool { return TableValueLookup[`0345`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0345) Description() string { return tableDescription(`0345`, string(v)) }
+
+// Table0346 is a value of table 0346, Certification Agency.
+type Table0346 string
+
+// TableID returns the table ID.
+func (Table0346) TableID() string { return `0346` }
+
+// Valid reports if the value is in the table.
+func (v Table0346) Valid() bool { return TableValueLookup[`0346`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0346) Description() string { return tableDescription(`0346`, string(v)) }
+
+// Table0347 is a value of table 0347, State/Province.
+type Table0347 string
+
+// TableID returns the table ID.
+func (Table0347) TableID() string { return `0347` }
+
+// Valid reports if the value is in the table.
+func (v Table0347) Valid() bool { return TableValueLookup[`0347`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0347) Description() string { return tableDescription(`0347`, string(v)) }
+
+// Table0350 is a value of table 0350, Occurrence Code.
+type Table0350 string
+
+// TableID returns the table ID.
+func (Table0350) TableID() string { return `0350` }
+
+// Valid reports if the value is in the table.
+func (v Table0350) Valid() bool { return TableValueLookup[`0350`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0350) Description() string { return tableDescription(`0350`, string(v)) }
+
+// Table0351 is a value of table 0351, Occurrence Span.
+type Table0351 string
+
+// TableID returns the table ID.
+func (Table0351) TableID() string { return `0351` }
+
+// Valid reports if the value is in the table.
+func (v Table0351) Valid() bool { return TableValueLookup[`0351`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0351) Description() string { return tableDescription(`0351`, string(v)) }
+
+// Table0354 is a value of table 0354, Message Structure.
+type Table0354 string
+
+const (
+	Table0354_ACK     Table0354 = `ACK` // Varies
+	Table0354_ADR_A19 Table0354 = `ADR_A19`
+	Table0354_ADT_A01 Table0354 = `ADT_A01` // A01, A04, A08, A13
+	Table0354_ADT_A02 Table0354 = `ADT_A02` // A02
+	Table0354_ADT_A03 Table0354 = `ADT_A03` // A03
+	Table0354_ADT_A05 Table0354 = `ADT_A05` // A05, A14, A28, A31
+	Table0354_ADT_A06 Table0354 = `ADT_A06` // A06, A07
+	Table0354_ADT_A09 Table0354 = `ADT_A09` // A09, A10, A11
+	Table0354_ADT_A12 Table0354 = `ADT_A12` // A12
+	Table0354_ADT_A15 Table0354 = `ADT_A15` // A15
+	Table0354_ADT_A16 Table0354 = `ADT_A16` // A16
+	Table0354_ADT_A17 Table0354 = `ADT_A17` // A17
+	Table0354_ADT_A18 Table0354 = `ADT_A18`
+	Table0354_ADT_A20 Table0354 = `ADT_A20` // A20
+	Table0354_ADT_A21 Table0354 = `ADT_A21` // A21, A22, A23, A25, A26, A27, A29, A32, A33
+	Table0354_ADT_A24 Table0354 = `ADT_A24` // A24
+	Table0354_ADT_A30 Table0354 = `ADT_A30`
+	Table0354_ADT_A37 Table0354 = `ADT_A37` // A37
+	Table0354_ADT_A38 Table0354 = `ADT_A38` // A38
+	Table0354_ADT_A39 Table0354 = `ADT_A39` // A39, A40, A41, A42
+	Table0354_ADT_A43 Table0354 = `ADT_A43` // A43
+	Table0354_ADT_A44 Table0354 = `ADT_A44` // A44
+	Table0354_ADT_A45 Table0354 = `ADT_A45` // A45
+	Table0354_ADT_A50 Table0354 = `ADT_A50` // A50, A51
+	Table0354_ADT_A52 Table0354 = `ADT_A52` // A52, A53
+	Table0354_ADT_A54 Table0354 = `ADT_A54` // A54, A55
+	Table0354_ADT_A60 Table0354 = `ADT_A60` // A60
+	Table0354_ADT_A61 Table0354 = `ADT_A61` // A61, A62
+	Table0354_BAR_P01 Table0354 = `BAR_P01` // P01
+	Table0354_BAR_P02 Table0354 = `BAR_P02` // P02
+	Table0354_BAR_P05 Table0354 = `BAR_P05` // P05
+	Table0354_BAR_P06 Table0354 = `BAR_P06` // P06
+	Table0354_BAR_P10 Table0354 = `BAR_P10` // P10
+	Table0354_BAR_P12 Table0354 = `BAR_P12` // P12
+	Table0354_BPS_O29 Table0354 = `BPS_O29` // O29
+	Table0354_BRP_O30 Table0354 = `BRP_O30` // O30
+	Table0354_BRT_O32 Table0354 = `BRT_O32` // O32
+	Table0354_BTS_O31 Table0354 = `BTS_O31` // O31
+	Table0354_CCF_I22 Table0354 = `CCF_I22` // I22
+	Table0354_CCI_I22 Table0354 = `CCI_I22` // I22
+	Table0354_CCM_I21 Table0354 = `CCM_I21` // I21
+	Table0354_CCQ_I19 Table0354 = `CCQ_I19` // I19
+	Table0354_CCR_I16 Table0354 = `CCR_I16` // I16, |17, |18
+	Table0354_CCU_I20 Table0354 = `CCU_I20` // I20
+	Table0354_CQU_I19 Table0354 = `CQU_I19` // I19
+	Table0354_CRM_C01 Table0354 = `CRM_C01` // C01, C02, C03, C04, C05, C06, C07, C08
+	Table0354_CSU_C09 Table0354 = `CSU_C09` // C09, C10, C11, C12
+	Table0354_DFT_P03 Table0354 = `DFT_P03` // P03
+	Table0354_DFT_P11 Table0354 = `DFT_P11` // P11
+	Table0354_DOC_T12 Table0354 = `DOC_T12`
+	Table0354_EAC_U07 Table0354 = `EAC_U07` // U07
+	Table0354_EAN_U09 Table0354 = `EAN_U09` // U09
+	Table0354_EAR_U08 Table0354 = `EAR_U08` // U08
+	Table0354_EHC_E01 Table0354 = `EHC_E01` // E01
+	Table0354_EHC_E02 Table0354 = `EHC_E02` // E02
+	Table0354_EHC_E04 Table0354 = `EHC_E04` // E04
+	Table0354_EHC_E10 Table0354 = `EHC_E10` // E10
+	Table0354_EHC_E12 Table0354 = `EHC_E12` // E12
+	Table0354_EHC_E13 Table0354 = `EHC_E13` // E13
+	Table0354_EHC_E15 Table0354 = `EHC_E15` // E15
+	Table0354_EHC_E20 Table0354 = `EHC_E20` // E20
+	Table0354_EHC_E21 Table0354 = `EHC_E21` // E21
+	Table0354_EHC_E24 Table0354 = `EHC_E24` // E24
+	Table0354_ESR_U02 Table0354 = `ESR_U02` // U02
+	Table0354_ESU_U01 Table0354 = `ESU_U01` // U01
+	Table0354_INR_U06 Table0354 = `INR_U06` // U06
+	Table0354_INU_U05 Table0354 = `INU_U05` // U05
+	Table0354_LSU_U12 Table0354 = `LSU_U12` // U12, U13
+	Table0354_MDM_T01 Table0354 = `MDM_T01` // T01, T03, T05, T07, T09, T11
+	Table0354_MDM_T02 Table0354 = `MDM_T02` // T02, T04, T06, T08, T10
+	Table0354_MFK_M01 Table0354 = `MFK_M01` // M01, M02, M03, M04, M05, M06, M07, M08, M09, M10, M11
+	Table0354_MFN_M01 Table0354 = `MFN_M01`
+	Table0354_MFN_M02 Table0354 = `MFN_M02` // M02
+	Table0354_MFN_M03 Table0354 = `MFN_M03` // M03
+	Table0354_MFN_M04 Table0354 = `MFN_M04` // M04
+	Table0354_MFN_M05 Table0354 = `MFN_M05` // M05
+	Table0354_MFN_M06 Table0354 = `MFN_M06` // M06
+	Table0354_MFN_M07 Table0354 = `MFN_M07` // M07
+	Table0354_MFN_M08 Table0354 = `MFN_M08` // M08
+	Table0354_MFN_M09 Table0354 = `MFN_M09` // M09
+	Table0354_MFN_M10 Table0354 = `MFN_M10` // M10
+	Table0354_MFN_M11 Table0354 = `MFN_M11` // M11
+	Table0354_MFN_M12 Table0354 = `MFN_M12` // M12
+	Table0354_MFN_M13 Table0354 = `MFN_M13` // M13
+	Table0354_MFN_M15 Table0354 = `MFN_M15` // M15
+	Table0354_MFN_M16 Table0354 = `MFN_M16` // M16
+	Table0354_MFN_M17 Table0354 = `MFN_M17` // M17
+	Table0354_MFN_Znn Table0354 = `MFN_Znn` // Znn
+	Table0354_MFQ_M01 Table0354 = `MFQ_M01` // M01, M02, M03, M04, M05, M06
+	Table0354_MFR_M01 Table0354 = `MFR_M01` // M01, M02, M03,
+	Table0354_MFR_M04 Table0354 = `MFR_M04` // M04
+	Table0354_MFR_M05 Table0354 = `MFR_M05` // M05
+	Table0354_MFR_M06 Table0354 = `MFR_M06` // M06
+	Table0354_MFR_M07 Table0354 = `MFR_M07` // M07
+	Table0354_NMD_N02 Table0354 = `NMD_N02` // N02
+	Table0354_NMQ_N01 Table0354 = `NMQ_N01` // N01
+	Table0354_NMR_N01 Table0354 = `NMR_N01` // N01
+	Table0354_OMB_O27 Table0354 = `OMB_O27` // O27
+	Table0354_OMD_O03 Table0354 = `OMD_O03` // O03
+	Table0354_OMG_O19 Table0354 = `OMG_O19` // O19
+	Table0354_OMI_O23 Table0354 = `OMI_O23` // O23
+	Table0354_OML_O21 Table0354 = `OML_O21` // O21
+	Table0354_OML_O33 Table0354 = `OML_O33` // O33
+	Table0354_OML_O35 Table0354 = `OML_O35` // O35
+	Table0354_OML_O39 Table0354 = `OML_O39` // O39
+	Table0354_OMN_O07 Table0354 = `OMN_O07` // O07
+	Table0354_OMP_O09 Table0354 = `OMP_O09` // O09
+	Table0354_OMS_O05 Table0354 = `OMS_O05` // O05
+	Table0354_OPL_O37 Table0354 = `OPL_O37` // O37
+	Table0354_OPR_O38 Table0354 = `OPR_O38` // O38
+	Table0354_OPU_R25 Table0354 = `OPU_R25` // R25
+	Table0354_ORA_R33 Table0354 = `ORA_R33` // R33
+	Table0354_ORB_O28 Table0354 = `ORB_O28` // O28
+	Table0354_ORD_O04 Table0354 = `ORD_O04` // O04
+	Table0354_ORF_R04 Table0354 = `ORF_R04` // R04
+	Table0354_ORG_O20 Table0354 = `ORG_O20` // O20
+	Table0354_ORI_O24 Table0354 = `ORI_O24` // O24
+	Table0354_ORL_O22 Table0354 = `ORL_O22` // O22
+	Table0354_ORL_O34 Table0354 = `ORL_O34` // O34
+	Table0354_ORL_O36 Table0354 = `ORL_O36` // O36
+	Table0354_ORL_O40 Table0354 = `ORL_O40` // O40
+	Table0354_ORM_O01 Table0354 = `ORM_O01` // O01
+	Table0354_ORN_O08 Table0354 = `ORN_O08` // O08
+	Table0354_ORP_O10 Table0354 = `ORP_O10` // O10
+	Table0354_ORR_O02 Table0354 = `ORR_O02` // O02
+	Table0354_ORS_O06 Table0354 = `ORS_O06` // O06
+	Table0354_ORU_R01 Table0354 = `ORU_R01` // R01
+	Table0354_ORU_R30 Table0354 = `ORU_R30` // R30
+	Table0354_ORU_W01 Table0354 = `ORU_W01` // W01
+	Table0354_OSM_R26 Table0354 = `OSM_R26` // R26
+	Table0354_OSQ_Q06 Table0354 = `OSQ_Q06` // Q06
+	Table0354_OSR_Q06 Table0354 = `OSR_Q06` // Q06
+	Table0354_OUL_R21 Table0354 = `OUL_R21` // R21
+	Table0354_OUL_R22 Table0354 = `OUL_R22` // R22
+	Table0354_OUL_R23 Table0354 = `OUL_R23` // R23
+	Table0354_OUL_R24 Table0354 = `OUL_R24` // R24
+	Table0354_PEX_P07 Table0354 = `PEX_P07` // P07, P08
+	Table0354_PGL_PC6 Table0354 = `PGL_PC6` // PC6, PC7, PC8
+	Table0354_PMU_B01 Table0354 = `PMU_B01` // B01, B02
+	Table0354_PMU_B03 Table0354 = `PMU_B03` // B03
+	Table0354_PMU_B04 Table0354 = `PMU_B04` // B04, B05, B06
+	Table0354_PMU_B07 Table0354 = `PMU_B07` // B07
+	Table0354_PMU_B08 Table0354 = `PMU_B08` // B08
+	Table0354_PPG_PCG Table0354 = `PPG_PCG` // PCC, PCG, PCH, PCJ
+	Table0354_PPP_PCB Table0354 = `PPP_PCB` // PCB, PCD
+	Table0354_PPR_PC1 Table0354 = `PPR_PC1` // PC1, PC2, PC3
+	Table0354_PPT_PCL Table0354 = `PPT_PCL` // PCL
+	Table0354_PPV_PCA Table0354 = `PPV_PCA` // PCA
+	Table0354_PRR_PC5 Table0354 = `PRR_PC5` // PC5
+	Table0354_PTR_PCF Table0354 = `PTR_PCF` // PCF
+	Table0354_QBP_E03 Table0354 = `QBP_E03` // E03
+	Table0354_QBP_E22 Table0354 = `QBP_E22` // E22
+	Table0354_QBP_Q11 Table0354 = `QBP_Q11` // Q11
+	Table0354_QBP_Q13 Table0354 = `QBP_Q13` // Q13
+	Table0354_QBP_Q15 Table0354 = `QBP_Q15` // Q15
+	Table0354_QBP_Q21 Table0354 = `QBP_Q21` // Q21, Q22, Q23,Q24, Q25
+	Table0354_QBP_Qnn Table0354 = `QBP_Qnn` // Qnn
+	Table0354_QBP_Z73 Table0354 = `QBP_Z73` // Z73
+	Table0354_QCK_Q02 Table0354 = `QCK_Q02` // Q02
+	Table0354_QCN_J01 Table0354 = `QCN_J01` // J01, J02
+	Table0354_QRF_W02 Table0354 = `QRF_W02` // W02
+	Table0354_QRY_A19 Table0354 = `QRY_A19` // A19
+	Table0354_QRY_PC4 Table0354 = `QRY_PC4` // PC4, PC9, PCE, PCK
+	Table0354_QRY_Q01 Table0354 = `QRY_Q01` // Q01, Q26, Q27, Q28, Q29, Q30
+	Table0354_QRY_Q02 Table0354 = `QRY_Q02` // Q02
+	Table0354_QRY_R02 Table0354 = `QRY_R02` // R02
+	Table0354_QRY_T12 Table0354 = `QRY_T12` // T12
+	Table0354_QSB_Q16 Table0354 = `QSB_Q16` // Q16
+	Table0354_QVR_Q17 Table0354 = `QVR_Q17` // Q17
+	Table0354_RAR_RAR Table0354 = `RAR_RAR` // RAR
+	Table0354_RAS_O17 Table0354 = `RAS_O17` // O17
+	Table0354_RCI_I05 Table0354 = `RCI_I05` // I05
+	Table0354_RCL_I06 Table0354 = `RCL_I06` // I06
+	Table0354_RDE_O11 Table0354 = `RDE_O11` // O11, O25
+	Table0354_RDR_RDR Table0354 = `RDR_RDR` // RDR
+	Table0354_RDS_O13 Table0354 = `RDS_O13` // O13
+	Table0354_RDY_K15 Table0354 = `RDY_K15` // K15
+	Table0354_REF_I12 Table0354 = `REF_I12` // I12, I13, I14, I15
+	Table0354_RER_RER Table0354 = `RER_RER` // RER
+	Table0354_RGR_RGR Table0354 = `RGR_RGR` // RGR
+	Table0354_RGV_O15 Table0354 = `RGV_O15` // O15
+	Table0354_ROR_ROR Table0354 = `ROR_ROR` // ROR
+	Table0354_RPA_I08 Table0354 = `RPA_I08` // I08, I09. I10, I11
+	Table0354_RPI_I01 Table0354 = `RPI_I01` // I01, I04
+	Table0354_RPI_I04 Table0354 = `RPI_I04` // I04
+	Table0354_RPL_I02 Table0354 = `RPL_I02` // I02
+	Table0354_RPR_I03 Table0354 = `RPR_I03` // I03
+	Table0354_RQA_I08 Table0354 = `RQA_I08` // I08, I09, I10, I11
+	Table0354_RQC_I05 Table0354 = `RQC_I05` // I05, I06
+	Table0354_RQI_I01 Table0354 = `RQI_I01` // I01, I02, I03, I07
+	Table0354_RQP_I04 Table0354 = `RQP_I04` // I04
+	Table0354_RRA_O18 Table0354 = `RRA_O18` // O18
+	Table0354_RRD_O14 Table0354 = `RRD_O14` // O14
+	Table0354_RRE_O12 Table0354 = `RRE_O12` // O12, O26
+	Table0354_RRG_O16 Table0354 = `RRG_O16` // O16
+	Table0354_RRI_I12 Table0354 = `RRI_I12` // I12, I13, I14, I15
+	Table0354_RSP_E03 Table0354 = `RSP_E03` // E03
+	Table0354_RSP_E22 Table0354 = `RSP_E22` // E22
+	Table0354_RSP_K11 Table0354 = `RSP_K11` // K11
+	Table0354_RSP_K21 Table0354 = `RSP_K21` // K21
+	Table0354_RSP_K22 Table0354 = `RSP_K22` // K22
+	Table0354_RSP_K23 Table0354 = `RSP_K23` // K23, K24
+	Table0354_RSP_K25 Table0354 = `RSP_K25` // K25
+	Table0354_RSP_K31 Table0354 = `RSP_K31` // K31
+	Table0354_RSP_K32 Table0354 = `RSP_K32` // K32
+	Table0354_RSP_Q11 Table0354 = `RSP_Q11` // Q11
+	Table0354_RSP_Z82 Table0354 = `RSP_Z82` // Z82
+	Table0354_RSP_Z86 Table0354 = `RSP_Z86` // Z86
+	Table0354_RSP_Z88 Table0354 = `RSP_Z88` // Z88
+	Table0354_RSP_Z90 Table0354 = `RSP_Z90` // Z90
+	Table0354_RTB_K13 Table0354 = `RTB_K13` // K13
+	Table0354_RTB_Knn Table0354 = `RTB_Knn` // Knn
+	Table0354_RTB_Z74 Table0354 = `RTB_Z74` // Z74
+	Table0354_SDR_S31 Table0354 = `SDR_S31` // S31, S36
+	Table0354_SDR_S32 Table0354 = `SDR_S32` // S32, S37
+	Table0354_SIU_S12 Table0354 = `SIU_S12` // S12, S13, S14, S15, S16, S17, S18, S19, S20, S21, S22, S23, S24, S26
+	Table0354_SLR_S28 Table0354 = `SLR_S28` // S28, S29, S30, S34, S35
+	Table0354_SQM_S25 Table0354 = `SQM_S25` // S25
+	Table0354_SQR_S25 Table0354 = `SQR_S25` // S25
+	Table0354_SRM_S01 Table0354 = `SRM_S01` // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354_SRR_S01 Table0354 = `SRR_S01` // S01, S02, S03, S04, S05, S06, S07, S08, S09, S10, S11
+	Table0354_SSR_U04 Table0354 = `SSR_U04` // U04
+	Table0354_SSU_U03 Table0354 = `SSU_U03` // U03
+	Table0354_STC_S33 Table0354 = `STC_S33` // S33
+	Table0354_SUR_P09 Table0354 = `SUR_P09` // P09
+	Table0354_TCU_U10 Table0354 = `TCU_U10` // U10, U11
+	Table0354_UDM_Q05 Table0354 = `UDM_Q05` // Q05
+	Table0354_VXQ_V01 Table0354 = `VXQ_V01` // V01
+	Table0354_VXR_V03 Table0354 = `VXR_V03` // V03
+	Table0354_VXU_V04 Table0354 = `VXU_V04` // V04
+	Table0354_VXX_V02 Table0354 = `VXX_V02` // V02
+)
+
+// TableID returns the table ID.
+func (Table0354) TableID() string { return `0354` }
+
+// Valid reports if the value is in the table.
+func (v Table0354) Valid() bool { return TableValueLookup[`0354`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0354) Description() string { return tableDescription(`0354`, string(v)) }
+
+// Table0355 is a value of table 0355, Primary Key Value Type.
+type Table0355 string
+
+const (
+	Table0355_CE  Table0355 = `CE`  // Coded element
+	Table0355_CWE Table0355 = `CWE` // Coded with Exceptions
+	Table0355_PL  Table0355 = `PL`  // Person location
+)
+
+// TableID returns the table ID.
+func (Table0355) TableID() string { return `0355` }
+
+// Valid reports if the value is in the table.
+func (v Table0355) Valid() bool { return TableValueLookup[`0355`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0355) Description() string { return tableDescription(`0355`, string(v)) }
+
+// Table0356 is a value of table 0356, Alternate Character Set Handling Scheme.
+type Table0356 string
+
+const (
+	Table0356_2_3           Table0356 = `2.3`           // The character set switching mode specified in HL7 2.5, section 2.7.2 and section 2.A.46, "XPN - extended person name".
+	Table0356_ISO_2022_1994 Table0356 = `ISO 2022-1994` // This standard is titled "Information Technology - Character Code Structure and Extension Technique". .
+)
+
+// TableID returns the table ID.
+func (Table0356) TableID() string { return `0356` }
+
+// Valid reports if the value is in the table.
+func (v Table0356) Valid() bool { return TableValueLookup[`0356`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0356) Description() string { return tableDescription(`0356`, string(v)) }
+
+// Table0357 is a value of table 0357, Message Error Condition Codes.
+type Table0357 string
+
+const (
+	Table0357_0   Table0357 = `0`   // Message accepted
+	Table0357_100 Table0357 = `100` // Segment sequence error
+	Table0357_101 Table0357 = `101` // Required field missing
+	Table0357_102 Table0357 = `102` // Data type error
+	Table0357_103 Table0357 = `103` // Table value not found
+	Table0357_104 Table0357 = `104` // Value too long
+	Table0357_200 Table0357 = `200` // Unsupported message type
+	Table0357_201 Table0357 = `201` // Unsupported event code
+	Table0357_202 Table0357 = `202` // Unsupported processing id
+	Table0357_203 Table0357 = `203` // Unsupported version id
+	Table0357_204 Table0357 = `204` // Unknown key identifier
+	Table0357_205 Table0357 = `205` // Duplicate key identifier
+	Table0357_206 Table0357 = `206` // Application record locked
+	Table0357_207 Table0357 = `207` // Application internal error
+)
+
+// TableID returns the table ID.
+func (Table0357) TableID() string { return `0357` }
+
+// Valid reports if the value is in the table.
+func (v Table0357) Valid() bool { return TableValueLookup[`0357`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0357) Description() string { return tableDescription(`0357`, string(v)) }
+
+// Table0358 is a value of table 0358, Practitioner Group.
+type Table0358 string
+
+// TableID returns the table ID.
+func (Table0358) TableID() string { return `0358` }
+
+// Valid reports if the value is in the table.
+func (v Table0358) Valid() bool { return TableValueLookup[`0358`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0358) Description() string { return tableDescription(`0358`, string(v)) }
+
+// Table0359 is a value of table 0359, Diagnosis Priority.
+type Table0359 string
+
+const (
+	Table0359_0 Table0359 = `0` // Not included in diagnosis ranking
+	Table0359_1 Table0359 = `1` // The primary diagnosis
+	Table0359_2 Table0359 = `2` // For ranked secondary diagnoses
+)
+
+// TableID returns the table ID.
+func (Table0359) TableID() string { return `0359` }
+
+// Valid reports if the value is in the table.
+func (v Table0359) Valid() bool { return TableValueLookup[`0359`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0359) Description() string { return tableDescription(`0359`, string(v)) }
+
+// Table0360 is a value of table 0360, Degree/License/Certificate.
+type Table0360 string
+
+const (
+	Table0360_AA     Table0360 = `AA`     // Associate of Arts
+	Table0360_AAS    Table0360 = `AAS`    // Associate of Applied Science
+	Table0360_ABA    Table0360 = `ABA`    // Associate of Business Administration
+	Table0360_AE     Table0360 = `AE`     // Associate of Engineering
+	Table0360_AS     Table0360 = `AS`     // Associate of Science
+	Table0360_BA     Table0360 = `BA`     // Bachelor of Arts
+	Table0360_BBA    Table0360 = `BBA`    // Bachelor of Business Administration
+	Table0360_BE     Table0360 = `BE`     // Bachelor or Engineering
+	Table0360_BFA    Table0360 = `BFA`    // Bachelor of Fine Arts
+	Table0360_BN     Table0360 = `BN`     // Bachelor of Nursing
+	Table0360_BS     Table0360 = `BS`     // Bachelor of Science
+	Table0360_BSL    Table0360 = `BSL`    // Bachelor of Science - Law
+	Table0360_BSN    Table0360 = `BSN`    // Bachelor on Science - Nursing
+	Table0360_BT     Table0360 = `BT`     // Bachelor of Theology
+	Table0360_CANP   Table0360 = `CANP`   // Certified Adult Nurse Practitioner
+	Table0360_CER    Table0360 = `CER`    // Certificate
+	Table0360_CMA    Table0360 = `CMA`    // Certified Medical Assistant
+	Table0360_CNM    Table0360 = `CNM`    // Certified Nurse Midwife
+	Table0360_CNP    Table0360 = `CNP`    // Certified Nurse Practitioner
+	Table0360_CNS    Table0360 = `CNS`    // Certified Nurse Specialist
+	Table0360_CPNP   Table0360 = `CPNP`   // Certified Pediatric Nurse Practitioner
+	Table0360_CRN    Table0360 = `CRN`    // Certified Registered Nurse
+	Table0360_DBA    Table0360 = `DBA`    // Doctor of Business Administration
+	Table0360_DED    Table0360 = `DED`    // Doctor of Education
+	Table0360_DIP    Table0360 = `DIP`    // Diploma
+	Table0360_DO     Table0360 = `DO`     // Doctor of Osteopathy
+	Table0360_EMT    Table0360 = `EMT`    // Emergency Medical Technician
+	Table0360_EMTP   Table0360 = `EMTP`   // Emergency Medical Technician - Paramedic
+	Table0360_FPNP   Table0360 = `FPNP`   // Family Practice Nurse Practitioner
+	Table0360_HS     Table0360 = `HS`     // High School Graduate
+	Table0360_JD     Table0360 = `JD`     // Juris Doctor
+	Table0360_MA     Table0360 = `MA`     // Master of Arts
+	Table0360_MBA    Table0360 = `MBA`    // Master of Business Administration
+	Table0360_MCE    Table0360 = `MCE`    // Master of Civil Engineering
+	Table0360_MD     Table0360 = `MD`     // Doctor of Medicine
+	Table0360_MDA    Table0360 = `MDA`    // Medical Assistant
+	Table0360_MDI    Table0360 = `MDI`    // Master of Divinity
+	Table0360_ME     Table0360 = `ME`     // Master of Engineering
+	Table0360_MED    Table0360 = `MED`    // Master of Education
+	Table0360_MEE    Table0360 = `MEE`    // Master of Electrical Engineering
+	Table0360_MFA    Table0360 = `MFA`    // Master of Fine Arts
+	Table0360_MME    Table0360 = `MME`    // Master of Mechanical Engineering
+	Table0360_MS     Table0360 = `MS`     // Master of Science
+	Table0360_MSL    Table0360 = `MSL`    // Master of Science - Law
+	Table0360_MSN    Table0360 = `MSN`    // Master of Science - Nursing
+	Table0360_MT     Table0360 = `MT`     // Medical Technician
+	Table0360_MTH    Table0360 = `MTH`    // Master of Theology
+	Table0360_NG     Table0360 = `NG`     // Non-Graduate
+	Table0360_NP     Table0360 = `NP`     // Nurse Practitioner
+	Table0360_PA     Table0360 = `PA`     // Physician Assistant
+	Table0360_PharmD Table0360 = `PharmD` // Doctor of Pharmacy
+	Table0360_PHD    Table0360 = `PHD`    // Doctor of Philosophy
+	Table0360_PHE    Table0360 = `PHE`    // Doctor of Engineering
+	Table0360_PHS    Table0360 = `PHS`    // Doctor of Science
+	Table0360_PN     Table0360 = `PN`     // Advanced Practice Nurse
+	Table0360_RMA    Table0360 = `RMA`    // Registered Medical Assistant
+	Table0360_RN     Table0360 = `RN`     // Registered Nurse
+	Table0360_RPH    Table0360 = `RPH`    // Registered Pharmacist
+	Table0360_SEC    Table0360 = `SEC`    // Secretarial Certificate
+	Table0360_TS     Table0360 = `TS`     // Trade School Graduate
+)
+
+// TableID returns the table ID.
+func (Table0360) TableID() string { return `0360` }
+
+// Valid reports if the value is in the table.
+func (v Table0360) Valid() bool { return TableValueLookup[`0360`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0360) Description() string { return tableDescription(`0360`, string(v)) }
+
+// Table0361 is a value of table 0361, Application.
+type Table0361 string
+
+// TableID returns the table ID.
+func (Table0361) TableID() string { return `0361` }
+
+// Valid reports if the value is in the table.
+func (v Table0361) Valid() bool { return TableValueLookup[`0361`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0361) Description() string { return tableDescription(`0361`, string(v)) }
+
+// Table0362 is a value of table 0362, Facility.
+type Table0362 string
+
+// TableID returns the table ID.
+func (Table0362) TableID() string { return `0362` }
+
+// Valid reports if the value is in the table.
+func (v Table0362) Valid() bool { return TableValueLookup[`0362`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0362) Description() string { return tableDescription(`0362`, string(v)) }
+
+// Table0363 is a value of table 0363, Assigning Authority.
+type Table0363 string
+
+// TableID returns the table ID.
+func (Table0363) TableID() string { return `0363` }
+
+// Valid reports if the value is in the table.
+func (v Table0363) Valid() bool { return TableValueLookup[`0363`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0363) Description() string { return tableDescription(`0363`, string(v)) }
+
+// Table0364 is a value of table 0364, Comment Type.
+type Table0364 string
+
+const (
+	Table0364_1R Table0364 = `1R` // Primary Reason
+	Table0364_2R Table0364 = `2R` // Secondary Reason
+	Table0364_AI Table0364 = `AI` // Ancillary Instructions
+	Table0364_DR Table0364 = `DR` // Duplicate/Interaction Reason
+	Table0364_GI Table0364 = `GI` // General Instructions
+	Table0364_GR Table0364 = `GR` // General Reason
+	Table0364_PI Table0364 = `PI` // Patient Instructions
+	Table0364_RE Table0364 = `RE` // Remark
+)
+
+// TableID returns the table ID.
+func (Table0364) TableID() string { return `0364` }
+
+// Valid reports if the value is in the table.
+func (v Table0364) Valid() bool { return TableValueLookup[`0364`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0364) Description() string { return tableDescription(`0364`, string(v)) }
+
+// Table0365 is a value of table 0365, Equipment State.
+type Table0365 string
+
+const (
+	Table0365_CL Table0365 = `CL` // Clearing
+	Table0365_CO Table0365 = `CO` // Configuring
+	Table0365_ES Table0365 = `ES` // E-stopped
+	Table0365_ID Table0365 = `ID` // Idle
+	Table0365_IN Table0365 = `IN` // Initializing
+	Table0365_OP Table0365 = `OP` // Normal Operation
+	Table0365_PA Table0365 = `PA` // Pausing
+	Table0365_PD Table0365 = `PD` // Paused
+	Table0365_PU Table0365 = `PU` // Powered Up
+)
+
+// TableID returns the table ID.
+func (Table0365) TableID() string { return `0365` }
+
+// Valid reports if the value is in the table.
+func (v Table0365) Valid() bool { return TableValueLookup[`0365`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0365) Description() string { return tableDescription(`0365`, string(v)) }
+
+// Table0366 is a value of table 0366, Local/Remote Control State.
+type Table0366 string
+
+const (
+	Table0366_L Table0366 = `L` // Local
+	Table0366_R Table0366 = `R` // Remote
+)
+
+// TableID returns the table ID.
+func (Table0366) TableID() string { return `0366` }
+
+// Valid reports if the value is in the table.
+func (v Table0366) Valid() bool { return TableValueLookup[`0366`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0366) Description() string { return tableDescription(`0366`, string(v)) }
+
+// Table0367 is a value of table 0367, Alert Level.
+type Table0367 string
+
+const (
+	Table0367_C Table0367 = `C` // Critical
+	Table0367_N Table0367 = `N` // Normal
+	Table0367_S Table0367 = `S` // Serious
+	Table0367_W Table0367 = `W` // Warning
+)
+
+// TableID returns the table ID.
+func (Table0367) TableID() string { return `0367` }
+
+// Valid reports if the value is in the table.
+func (v Table0367) Valid() bool { return TableValueLookup[`0367`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0367) Description() string { return tableDescription(`0367`, string(v)) }
+
+// Table0368 is a value of table 0368, Remote Control Command.
+type Table0368 string
+
+const (
+	Table0368_AB Table0368 = `AB` // Abort
+	Table0368_CL Table0368 = `CL` // Clear
+	Table0368_CN Table0368 = `CN` // Clear Notification
+	Table0368_DI Table0368 = `DI` // Disable Sending Events
+	Table0368_EN Table0368 = `EN` // Enable Sending Events
+	Table0368_ES Table0368 = `ES` // Emergency -stop
+	Table0368_EX Table0368 = `EX` // Execute (command specified in field Parameters (ST) 01394)
+	Table0368_IN Table0368 = `IN` // Initialize/Initiate
+	Table0368_LC Table0368 = `LC` // Local Control Request
+	Table0368_LK Table0368 = `LK` // Lock
+	Table0368_LO Table0368 = `LO` // Load
+	Table0368_PA Table0368 = `PA` // Pause
+	Table0368_RC Table0368 = `RC` // Remote Control Request
+	Table0368_RE Table0368 = `RE` // Resume
+	Table0368_SA Table0368 = `SA` // Sampling
+	Table0368_SU Table0368 = `SU` // Setup
+	Table0368_TT Table0368 = `TT` // Transport To
+	Table0368_UC Table0368 = `UC` // Unlock
+	Table0368_UN Table0368 = `UN` // Unload
+)
+
+// TableID returns the table ID.
+func (Table0368) TableID() string { return `0368` }
+
+// Valid reports if the value is in the table.
+func (v Table0368) Valid() bool { return TableValueLookup[`0368`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0368) Description() string { return tableDescription(`0368`, string(v)) }
+
+// Table0369 is a value of table 0369, Specimen Role.
+type Table0369 string
+
+const (
+	Table0369_B Table0369 = `B` // Blind Sample
+	Table0369_C Table0369 = `C` // Calibrator, used for initial setting of calibration
+	Table0369_E Table0369 = `E` // Electronic QC, used with manufactured reference providing signals that simulate QC results
+	Table0369_F Table0369 = `F` // Specimen used for testing proficiency of the organization performing the testing (Filler)
+	Table0369_G Table0369 = `G` // Group (where a specimen consists of multiple individual elements that are not individually identified)
+	Table0369_L Table0369 = `L` // Pool (aliquots of individual specimens combined to form a single specimen representing all of the components.)
+	Table0369_O Table0369 = `O` // Specimen used for testing Operator Proficiency
+	Table0369_P Table0369 = `P` // Patient (default if blank component value)
+	Table0369_Q Table0369 = `Q` // Control specimen
+	Table0369_R Table0369 = `R` // Replicate (of patient sample as a control)
+	Table0369_V Table0369 = `V` // Verifying Calibrator, used for periodic calibration checks
+)
+
+// TableID returns the table ID.
+func (Table0369) TableID() string { return `0369` }
+
+// Valid reports if the value is in the table.
+func (v Table0369) Valid() bool { return TableValueLookup[`0369`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0369) Description() string { return tableDescription(`0369`, string(v)) }
+
+// Table0370 is a value of table 0370, Container Status.
+type Table0370 string
+
+const (
+	Table0370_I Table0370 = `I` // Identified
+	Table0370_L Table0370 = `L` // Left Equipment
+	Table0370_M Table0370 = `M` // Missing
+	Table0370_O Table0370 = `O` // In Process
+	Table0370_P Table0370 = `P` // In Position
+	Table0370_R Table0370 = `R` // Process Completed
+	Table0370_U Table0370 = `U` // Unknown
+	Table0370_X Table0370 = `X` // Container Unavailable
+)
+
+// TableID returns the table ID.
+func (Table0370) TableID() string { return `0370` }
+
+// Valid reports if the value is in the table.
+func (v Table0370) Valid() bool { return TableValueLookup[`0370`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0370) Description() string { return tableDescription(`0370`, string(v)) }
+
+// Table0371 is a value of table 0371, Additive/Preservative.
+type Table0371 string
+
+const (
+	Table0371_ACDA   Table0371 = `ACDA`   // ACD Solution A
+	Table0371_ACDB   Table0371 = `ACDB`   // ACD Solution B
+	Table0371_ACET   Table0371 = `ACET`   // Acetic Acid
+	Table0371_AMIES  Table0371 = `AMIES`  // Amies transport medium
+	Table0371_BACTM  Table0371 = `BACTM`  // Bacterial Transport medium
+	Table0371_BF10   Table0371 = `BF10`   // Buffered 10% formalin
+	Table0371_BOR    Table0371 = `BOR`    // Borate Boric Acid
+	Table0371_BOUIN  Table0371 = `BOUIN`  // Bouin's solution
+	Table0371_BSKM   Table0371 = `BSKM`   // Buffered skim milk
+	Table0371_C32    Table0371 = `C32`    // 3.2% Citrate
+	Table0371_C38    Table0371 = `C38`    // 3.8% Citrate
+	Table0371_CARS   Table0371 = `CARS`   // Carson's Modified 10% formalin
+	Table0371_CARY   Table0371 = `CARY`   // Cary Blair Medium
+	Table0371_CHLTM  Table0371 = `CHLTM`  // Chlamydia transport medium
+	Table0371_CTAD   Table0371 = `CTAD`   // CTAD (this should be spelled out if not universally understood)
+	Table0371_EDTK   Table0371 = `EDTK`   // Potassium/K EDTA
+	Table0371_EDTK15 Table0371 = `EDTK15` // Potassium/K EDTA 15%
+	Table0371_EDTK75 Table0371 = `EDTK75` // Potassium/K EDTA 7.5%
+	Table0371_EDTN   Table0371 = `EDTN`   // Sodium/Na EDTA
+	Table0371_ENT    Table0371 = `ENT`    // Enteric bacteria transport medium
+	Table0371_F10    Table0371 = `F10`    // 10% Formalin
+	Table0371_FDP    Table0371 = `FDP`    // Thrombin NIH; soybean trypsin inhibitor (Fibrin Degradation Products)
+	Table0371_FL10   Table0371 = `FL10`   // Sodium Fluoride, 10mg
+	Table0371_FL100  Table0371 = `FL100`  // Sodium Fluoride, 100mg
+	Table0371_HCL6   Table0371 = `HCL6`   // 6N HCL
+	Table0371_HEPA   Table0371 = `HEPA`   // Ammonium heparin
+	Table0371_HEPL   Table0371 = `HEPL`   // Lithium/Li Heparin
+	Table0371_HEPN   Table0371 = `HEPN`   // Sodium/Na Heparin
+	Table0371_HNO3   Table0371 = `HNO3`   // Nitric Acid
+	Table0371_JKM    Table0371 = `JKM`    // Jones Kendrick Medium
+	Table0371_KARN   Table0371 = `KARN`   // Karnovsky's fixative
+	Table0371_KOX    Table0371 = `KOX`    // Potassium Oxalate
+	Table0371_LIA    Table0371 = `LIA`    // Lithium iodoacetate
+	Table0371_M4     Table0371 = `M4`     // M4
+	Table0371_M4RT   Table0371 = `M4RT`   // M4-RT
+	Table0371_M5     Table0371 = `M5`     // M5
+	Table0371_MICHTM Table0371 = `MICHTM` // Michel's transport medium
+	Table0371_MMDTM  Table0371 = `MMDTM`  // MMD transport medium
+	Table0371_NAF    Table0371 = `NAF`    // Sodium Fluoride
+	Table0371_NAPS   Table0371 = `NAPS`   // Sodium polyanethol sulfonate 0.35% in 0.85% sodium chloride
+	Table0371_NONE   Table0371 = `NONE`   // None
+	Table0371_PAGE   Table0371 = `PAGE`   // Pages's Saline
+	Table0371_PHENOL Table0371 = `PHENOL` // Phenol
+	Table0371_PVA    Table0371 = `PVA`    // PVA (polyvinylalcohol)
+	Table0371_RLM    Table0371 = `RLM`    // Reagan Lowe Medium
+	Table0371_SILICA Table0371 = `SILICA` // Siliceous earth, 12 mg
+	Table0371_SPS    Table0371 = `SPS`    // SPS(this should be spelled out if not universally understood)
+	Table0371_SST    Table0371 = `SST`    // Serum Separator Tube (Polymer Gel)
+	Table0371_STUTM  Table0371 = `STUTM`  // Stuart transport medium
+	Table0371_THROM  Table0371 = `THROM`  // Thrombin
+	Table0371_THYMOL Table0371 = `THYMOL` // Thymol
+	Table0371_THYO   Table0371 = `THYO`   // Thyoglycollate broth
+	Table0371_TOLU   Table0371 = `TOLU`   // Toluene
+	Table0371_URETM  Table0371 = `URETM`  // Ureaplasma transport medium
+	Table0371_VIRTM  Table0371 = `VIRTM`  // Viral Transport medium
+	Table0371_WEST   Table0371 = `WEST`   // Buffered Citrate (Westergren Sedimentation Rate)
+)
+
+// TableID returns the table ID.
+func (Table0371) TableID() string { return `0371` }
+
+// Valid reports if the value is in the table.
+func (v Table0371) Valid() bool { return TableValueLookup[`0371`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0371) Description() string { return tableDescription(`0371`, string(v)) }
+
+// Table0372 is a value of table 0372, Specimen Component.
+type Table0372 string
+
+const (
+	Table0372_BLD  Table0372 = `BLD`  // Whole blood, homogeneous
+	Table0372_BSEP Table0372 = `BSEP` // Whole blood, separated
+	Table0372_PLAS Table0372 = `PLAS` // Plasma, NOS (not otherwise specified)
+	Table0372_PPP  Table0372 = `PPP`  // Platelet poor plasma
+	Table0372_PRP  Table0372 = `PRP`  // Platelet rich plasma
+	Table0372_SED  Table0372 = `SED`  // Sediment
+	Table0372_SER  Table0372 = `SER`  // Serum, NOS (not otherwise specified)
+	Table0372_SUP  Table0372 = `SUP`  // Supernatant
+)
+
+// TableID returns the table ID.
+func (Table0372) TableID() string { return `0372` }
+
+// Valid reports if the value is in the table.
+func (v Table0372) Valid() bool { return TableValueLookup[`0372`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0372) Description() string { return tableDescription(`0372`, string(v)) }
+
+// Table0373 is a value of table 0373, Treatment.
+type Table0373 string
+
+const (
+	Table0373_ACID Table0373 = `ACID` // Acidification
+	Table0373_ALK  Table0373 = `ALK`  // Alkalization
+	Table0373_DEFB Table0373 = `DEFB` // Defibrination
+	Table0373_FILT Table0373 = `FILT` // Filtration
+	Table0373_LDLP Table0373 = `LDLP` // LDL Precipitation
+	Table0373_NEUT Table0373 = `NEUT` // Neutralization
+	Table0373_RECA Table0373 = `RECA` // Recalification
+	Table0373_UFIL Table0373 = `UFIL` // Ultrafiltration
+)
+
+// TableID returns the table ID.
+func (Table0373) TableID() string { return `0373` }
+
+// Valid reports if the value is in the table.
+func (v Table0373) Valid() bool { return TableValueLookup[`0373`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0373) Description() string { return tableDescription(`0373`, string(v)) }
+
+// Table0374 is a value of table 0374, System Induced Contaminants.
+type Table0374 string
+
+const (
+	Table0374_CNTM Table0374 = `CNTM` // Present, type of contamination unspecified
+)
+
+// TableID returns the table ID.
+func (Table0374) TableID() string { return `0374` }
+
+// Valid reports if the value is in the table.
+func (v Table0374) Valid() bool { return TableValueLookup[`0374`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0374) Description() string { return tableDescription(`0374`, string(v)) }
+
+// Table0375 is a value of table 0375, Artificial Blood.
+type Table0375 string
+
+const (
+	Table0375_FLUR Table0375 = `FLUR` // Fluorocarbons
+	Table0375_SFHB Table0375 = `SFHB` // Stromal free hemoglobin preparations
+)
+
+// TableID returns the table ID.
+func (Table0375) TableID() string { return `0375` }
+
+// Valid reports if the value is in the table.
+func (v Table0375) Valid() bool { return TableValueLookup[`0375`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0375) Description() string { return tableDescription(`0375`, string(v)) }
+
+// Table0376 is a value of table 0376, Special Handling Code.
+type Table0376 string
+
+const (
+	Table0376_AMB  Table0376 = `AMB`  // Ambient temperature
+	Table0376_C37  Table0376 = `C37`  // Body temperature
+	Table0376_CAMB Table0376 = `CAMB` // Critical ambient temperature
+	Table0376_CATM Table0376 = `CATM` // Protect from air
+	Table0376_CFRZ Table0376 = `CFRZ` // Critical frozen temperature
+	Table0376_CREF Table0376 = `CREF` // Critical refrigerated temperature
+	Table0376_DFRZ Table0376 = `DFRZ` // Deep frozen
+	Table0376_DRY  Table0376 = `DRY`  // Dry
+	Table0376_FRZ  Table0376 = `FRZ`  // Frozen temperature
+	Table0376_MTLF Table0376 = `MTLF` // Metal Free
+	Table0376_NTR  Table0376 = `NTR`  // Liquid nitrogen
+	Table0376_PRTL Table0376 = `PRTL` // Protect from light
+	Table0376_PSA  Table0376 = `PSA`  // Do not shake
+	Table0376_PSO  Table0376 = `PSO`  // No shock
+	Table0376_REF  Table0376 = `REF`  // Refrigerated temperature
+	Table0376_UFRZ Table0376 = `UFRZ` // Ultra frozen
+	Table0376_UPR  Table0376 = `UPR`  // Upright
+)
+
+// TableID returns the table ID.
+func (Table0376) TableID() string { return `0376` }
+
+// Valid reports if the value is in the table.
+func (v Table0376) Valid() bool { return TableValueLookup[`0376`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0376) Description() string { return tableDescription(`0376`, string(v)) }
+
+// Table0377 is a value of table 0377, Other Environmental Factors.
+type Table0377 string
+
+const (
+	Table0377_A60 Table0377 = `A60` // Opened container, indoor atmosphere, 60 minutes duration
+	Table0377_ATM Table0377 = `ATM` // Opened container, atmosphere and duration unspecified
+)
+
+// TableID returns the table ID.
+func (Table0377) TableID() string { return `0377` }
+
+// Valid reports if the value is in the table.
+func (v Table0377) Valid() bool { return TableValueLookup[`0377`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0377) Description() string { return tableDescription(`0377`, string(v)) }
+
+// Table0378 is a value of table 0378, Carrier Type.
+type Table0378 string
+
+// TableID returns the table ID.
+func (Table0378) TableID() string { return `0378` }
+
+// Valid reports if the value is in the table.
+func (v Table0378) Valid() bool { return TableValueLookup[`0378`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0378) Description() string { return tableDescription(`0378`, string(v)) }
+
+// Table0379 is a value of table 0379, Tray Type.
+type Table0379 string
+
+// TableID returns the table ID.
+func (Table0379) TableID() string { return `0379` }
+
+// Valid reports if the value is in the table.
+func (v Table0379) Valid() bool { return TableValueLookup[`0379`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0379) Description() string { return tableDescription(`0379`, string(v)) }
+
+// Table0380 is a value of table 0380, Separator Type.
+type Table0380 string
+
+// TableID returns the table ID.
+func (Table0380) TableID() string { return `0380` }
+
+// Valid reports if the value is in the table.
+func (v Table0380) Valid() bool { return TableValueLookup[`0380`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0380) Description() string { return tableDescription(`0380`, string(v)) }
+
+// Table0381 is a value of table 0381, Cap Type.
+type Table0381 string
+
+// TableID returns the table ID.
+func (Table0381) TableID() string { return `0381` }
+
+// Valid reports if the value is in the table.
+func (v Table0381) Valid() bool { return TableValueLookup[`0381`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0381) Description() string { return tableDescription(`0381`, string(v)) }
+
+// Table0382 is a value of table 0382, Drug Interference.
+type Table0382 string
+
+// TableID returns the table ID.
+func (Table0382) TableID() string { return `0382` }
+
+// Valid reports if the value is in the table.
+func (v Table0382) Valid() bool { return TableValueLookup[`0382`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0382) Description() string { return tableDescription(`0382`, string(v)) }
+
+// Table0383 is a value of table 0383, Substance Status.
+type Table0383 string
+
+const (
+	Table0383_CE Table0383 = `CE` // Calibration Error
+	Table0383_CW Table0383 = `CW` // Calibration Warning
+	Table0383_EE Table0383 = `EE` // Expired Error
+	Table0383_EW Table0383 = `EW` // Expired Warning
+	Table0383_NE Table0383 = `NE` // Not Available Error
+	Table0383_NW Table0383 = `NW` // Not Available Warning
+	Table0383_OE Table0383 = `OE` // Other Error
+	Table0383_OK Table0383 = `OK` // OK Status
+	Table0383_OW Table0383 = `OW` // Other Warning
+	Table0383_QE Table0383 = `QE` // QC Error
+	Table0383_QW Table0383 = `QW` // QC Warning
+)
+
+// TableID returns the table ID.
+func (Table0383) TableID() string { return `0383` }
+
+// Valid reports if the value is in the table.
+func (v Table0383) Valid() bool { return TableValueLookup[`0383`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0383) Description() string { return tableDescription(`0383`, string(v)) }
+
+// Table0384 is a value of table 0384, Substance Type.
+type Table0384 string
+
+const (
+	Table0384_CO Table0384 = `CO` // Control
+	Table0384_DI Table0384 = `DI` // Diluent
+	Table0384_LI Table0384 = `LI` // Measurable Liquid Item
+	Table0384_LW Table0384 = `LW` // Liquid Waste
+	Table0384_MR Table0384 = `MR` // Multiple Test Reagent
+	Table0384_OT Table0384 = `OT` // Other
+	Table0384_PT Table0384 = `PT` // Pretreatment
+	Table0384_PW Table0384 = `PW` // Purified Water
+	Table0384_RC Table0384 = `RC` // Reagent Calibrator
+	Table0384_SC Table0384 = `SC` // Countable Solid Item
+	Table0384_SR Table0384 = `SR` // Single Test Reagent
+	Table0384_SW Table0384 = `SW` // Solid Waste
+)
+
+// TableID returns the table ID.
+func (Table0384) TableID() string { return `0384` }
+
+// Valid reports if the value is in the table.
+func (v Table0384) Valid() bool { return TableValueLookup[`0384`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0384) Description() string { return tableDescription(`0384`, string(v)) }
+
+// Table0385 is a value of table 0385, Manufacturer Identifier.
+type Table0385 string
+
+// TableID returns the table ID.
+func (Table0385) TableID() string { return `0385` }
+
+// Valid reports if the value is in the table.
+func (v Table0385) Valid() bool { return TableValueLookup[`0385`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0385) Description() string { return tableDescription(`0385`, string(v)) }
+
+// Table0386 is a value of table 0386, Supplier Identifier.
+type Table0386 string
+
+// TableID returns the table ID.
+func (Table0386) TableID() string { return `0386` }
+
+// Valid reports if the value is in the table.
+func (v Table0386) Valid() bool { return TableValueLookup[`0386`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0386) Description() string { return tableDescription(`0386`, string(v)) }
+
+// Table0387 is a value of table 0387, Command Response.
+type Table0387 string
+
+const (
+	Table0387_ER Table0387 = `ER` // Command cannot be completed because of error condition
+	Table0387_OK Table0387 = `OK` // Command completed successfully
+	Table0387_ST Table0387 = `ST` // Command cannot be completed because of the status of the requested equipment
+	Table0387_TI Table0387 = `TI` // Command cannot be completed within requested completion time
+	Table0387_UN Table0387 = `UN` // Command cannot be completed for unknown reasons
+)
+
+// TableID returns the table ID.
+func (Table0387) TableID() string { return `0387` }
+
+// Valid reports if the value is in the table.
+func (v Table0387) Valid() bool { return TableValueLookup[`0387`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0387) Description() string { return tableDescription(`0387`, string(v)) }
+
+// Table0388 is a value of table 0388, Processing Type.
+type Table0388 string
+
+const (
+	Table0388_E Table0388 = `E` // Evaluation
+	Table0388_P Table0388 = `P` // Regular Production
+)
+
+// TableID returns the table ID.
+func (Table0388) TableID() string { return `0388` }
+
+// Valid reports if the value is in the table.
+func (v Table0388) Valid() bool { return TableValueLookup[`0388`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0388) Description() string { return tableDescription(`0388`, string(v)) }
+
+// Table0389 is a value of table 0389, Analyte Repeat Status.
+type Table0389 string
+
+const (
+	Table0389_D Table0389 = `D` // Repeated with dilution
+	Table0389_F Table0389 = `F` // Reflex test
+	Table0389_O Table0389 = `O` // Original, first run
+	Table0389_R Table0389 = `R` // Repeated without dilution
+)
+
+// TableID returns the table ID.
+func (Table0389) TableID() string { return `0389` }
+
+// Valid reports if the value is in the table.
+func (v Table0389) Valid() bool { return TableValueLookup[`0389`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0389) Description() string { return tableDescription(`0389`, string(v)) }
+
+// Table0391 is a value of table 0391, Segment Group.
+type Table0391 string
+
+const (
+	Table0391_ADMINISTRATION            Table0391 = `ADMINISTRATION`
+	Table0391_ALLERGY                   Table0391 = `ALLERGY`
+	Table0391_APP_STATS                 Table0391 = `APP_STATS`
+	Table0391_APP_STATUS                Table0391 = `APP_STATUS`
+	Table0391_ASSOCIATED_PERSON         Table0391 = `ASSOCIATED_PERSON`
+	Table0391_ASSOCIATED_RX_ADMIN       Table0391 = `ASSOCIATED_RX_ADMIN`
+	Table0391_ASSOCIATED_RX_ORDER       Table0391 = `ASSOCIATED_RX_ORDER`
+	Table0391_AUTHORIZATION             Table0391 = `AUTHORIZATION`
+	Table0391_AUTHORIZATION_CONTACT     Table0391 = `AUTHORIZATION_CONTACT`
+	Table0391_CERTIFICATE               Table0391 = `CERTIFICATE`
+	Table0391_CLOCK                     Table0391 = `CLOCK`
+	Table0391_CLOCK_AND_STATISTICS      Table0391 = `CLOCK_AND_STATISTICS`
+	Table0391_CLOCK_AND_STATS_WITH_NOTE Table0391 = `CLOCK_AND_STATS_WITH_NOTE`
+	Table0391_COMMAND                   Table0391 = `COMMAND`
+	Table0391_COMMAND_RESPONSE          Table0391 = `COMMAND_RESPONSE`
+	Table0391_COMMON_ORDER              Table0391 = `COMMON_ORDER`
+	Table0391_COMPONENT                 Table0391 = `COMPONENT`
+	Table0391_COMPONENTS                Table0391 = `COMPONENTS`
+	Table0391_CONTAINER                 Table0391 = `CONTAINER`
+	Table0391_DEFINITION                Table0391 = `DEFINITION`
+	Table0391_DIET                      Table0391 = `DIET`
+	Table0391_DISPENSE                  Table0391 = `DISPENSE`
+	Table0391_ENCODED_ORDER             Table0391 = `ENCODED_ORDER`
+	Table0391_ENCODING                  Table0391 = `ENCODING`
+	Table0391_EXPERIENCE                Table0391 = `EXPERIENCE`
+	Table0391_FINANCIAL                 Table0391 = `FINANCIAL`
+	Table0391_FINANCIAL_COMMON_ORDER    Table0391 = `FINANCIAL_COMMON_ORDER`
+	Table0391_FINANCIAL_INSURANCE       Table0391 = `FINANCIAL_INSURANCE`
+	Table0391_FINANCIAL_OBSERVATION     Table0391 = `FINANCIAL_OBSERVATION`
+	Table0391_FINANCIAL_ORDER           Table0391 = `FINANCIAL_ORDER`
+	Table0391_FINANCIAL_PROCEDURE       Table0391 = `FINANCIAL_PROCEDURE`
+	Table0391_FINANCIAL_TIMING_QUANTITY Table0391 = `FINANCIAL_TIMING_QUANTITY`
+	Table0391_GENERAL_RESOURCE          Table0391 = `GENERAL_RESOURCE`
+	Table0391_GIVE                      Table0391 = `GIVE`
+	Table0391_GOAL                      Table0391 = `GOAL`
+	Table0391_GOAL_OBSERVATION          Table0391 = `GOAL_OBSERVATION`
+	Table0391_GOAL_PATHWAY              Table0391 = `GOAL_PATHWAY`
+	Table0391_GOAL_ROLE                 Table0391 = `GOAL_ROLE`
+	Table0391_GUARANTOR_INSURANCE       Table0391 = `GUARANTOR_INSURANCE`
+	Table0391_INSURANCE                 Table0391 = `INSURANCE`
+	Table0391_LOCATION_RESOURCE         Table0391 = `LOCATION_RESOURCE`
+	Table0391_MERGE_INFO                Table0391 = `MERGE_INFO`
+	Table0391_MF                        Table0391 = `MF`
+	Table0391_MF_CDM                    Table0391 = `MF_CDM`
+	Table0391_MF_CLIN_STUDY             Table0391 = `MF_CLIN_STUDY`
+	Table0391_MF_CLIN_STUDY_SCHED       Table0391 = `MF_CLIN_STUDY_SCHED`
+	Table0391_MF_INV_ITEM               Table0391 = `MF_INV_ITEM`
+	Table0391_MF_LOC_DEPT               Table0391 = `MF_LOC_DEPT`
+	Table0391_MF_LOCATION               Table0391 = `MF_LOCATION`
+	Table0391_MF_OBS_ATTRIBUTES         Table0391 = `MF_OBS_ATTRIBUTES`
+	Table0391_MF_PHASE_SCHED_DETAIL     Table0391 = `MF_PHASE_SCHED_DETAIL`
+	Table0391_MF_QUERY                  Table0391 = `MF_QUERY`
+	Table0391_MF_SITE_DEFINED           Table0391 = `MF_SITE_DEFINED`
+	Table0391_MF_STAFF                  Table0391 = `MF_STAFF`
+	Table0391_MF_TEST                   Table0391 = `MF_TEST`
+	Table0391_MF_TEST_BATT_DETAIL       Table0391 = `MF_TEST_BATT_DETAIL`
+	Table0391_MF_TEST_BATTERIES         Table0391 = `MF_TEST_BATTERIES`
+	Table0391_MF_TEST_CALC_DETAIL       Table0391 = `MF_TEST_CALC_DETAIL`
+	Table0391_MF_TEST_CALCULATED        Table0391 = `MF_TEST_CALCULATED`
+	Table0391_MF_TEST_CAT_DETAIL        Table0391 = `MF_TEST_CAT_DETAIL`
+	Table0391_MF_TEST_CATEGORICAL       Table0391 = `MF_TEST_CATEGORICAL`
+	Table0391_MF_TEST_NUMERIC           Table0391 = `MF_TEST_NUMERIC`
+	Table0391_NK1_TIMING_QTY            Table0391 = `NK1_TIMING_QTY`
+	Table0391_NOTIFICATION              Table0391 = `NOTIFICATION`
+	Table0391_OBSERVATION               Table0391 = `OBSERVATION`
+	Table0391_OBSERVATION_PRIOR         Table0391 = `OBSERVATION_PRIOR`
+	Table0391_OBSERVATION_REQUEST       Table0391 = `OBSERVATION_REQUEST`
+	Table0391_OMSERVATION               Table0391 = `OMSERVATION`
+	Table0391_ORDER                     Table0391 = `ORDER`
+	Table0391_ORDER_CHOICE              Table0391 = `ORDER_CHOICE`
+	Table0391_ORDER_DETAIL              Table0391 = `ORDER_DETAIL`
+	Table0391_ORDER_DETAIL_SUPPLEMENT   Table0391 = `ORDER_DETAIL_SUPPLEMENT`
+	Table0391_ORDER_DIET                Table0391 = `ORDER_DIET`
+	Table0391_ORDER_ENCODED             Table0391 = `ORDER_ENCODED`
+	Table0391_ORDER_OBSERVATION         Table0391 = `ORDER_OBSERVATION`
+	Table0391_ORDER_PRIOR               Table0391 = `ORDER_PRIOR`
+	Table0391_ORDER_TRAY                Table0391 = `ORDER_TRAY`
+	Table0391_PATHWAY                   Table0391 = `PATHWAY`
+	Table0391_PATHWAY_ROLE              Table0391 = `PATHWAY_ROLE`
+	Table0391_PATIENT                   Table0391 = `PATIENT`
+	Table0391_PATIENT_PRIOR             Table0391 = `PATIENT_PRIOR`
+	Table0391_PATIENT_RESULT            Table0391 = `PATIENT_RESULT`
+	Table0391_PATIENT_VISIT             Table0391 = `PATIENT_VISIT`
+	Table0391_PATIENT_VISIT_PRIOR       Table0391 = `PATIENT_VISIT_PRIOR`
+	Table0391_PERSONNEL_RESOURCE        Table0391 = `PERSONNEL_RESOURCE`
+	Table0391_PEX_CAUSE                 Table0391 = `PEX_CAUSE`
+	Table0391_PEX_OBSERVATION           Table0391 = `PEX_OBSERVATION`
+	Table0391_PRIOR_RESULT              Table0391 = `PRIOR_RESULT`
+	Table0391_PROBLEM                   Table0391 = `PROBLEM`
+	Table0391_PROBLEM_OBSERVATION       Table0391 = `PROBLEM_OBSERVATION`
+	Table0391_PROBLEM_PATHWAY           Table0391 = `PROBLEM_PATHWAY`
+	Table0391_PROBLEM_ROLE              Table0391 = `PROBLEM_ROLE`
+	Table0391_PROCEDURE                 Table0391 = `PROCEDURE`
+	Table0391_PRODUCT                   Table0391 = `PRODUCT`
+	Table0391_PRODUCT_STATUS            Table0391 = `PRODUCT_STATUS`
+	Table0391_PROVIDER                  Table0391 = `PROVIDER`
+	Table0391_PROVIDER_CONTACT          Table0391 = `PROVIDER_CONTACT`
+	Table0391_QBP                       Table0391 = `QBP`
+	Table0391_QRY_WITH_DETAIL           Table0391 = `QRY_WITH_DETAIL`
+	Table0391_QUERY_RESPONSE            Table0391 = `QUERY_RESPONSE`
+	Table0391_QUERY_RESULT_CLUSTER      Table0391 = `QUERY_RESULT_CLUSTER`
+	Table0391_REQUEST                   Table0391 = `REQUEST`
+	Table0391_RESOURCE                  Table0391 = `RESOURCE`
+	Table0391_RESOURCES                 Table0391 = `RESOURCES`
+	Table0391_RESPONSE                  Table0391 = `RESPONSE`
+	Table0391_RESULT                    Table0391 = `RESULT`
+	Table0391_RESULTS                   Table0391 = `RESULTS`
+	Table0391_RESULTS_NOTES             Table0391 = `RESULTS_NOTES`
+	Table0391_ROW_DEFINITION            Table0391 = `ROW_DEFINITION`
+	Table0391_RX_ADMINISTRATION         Table0391 = `RX_ADMINISTRATION`
+	Table0391_RX_ORDER                  Table0391 = `RX_ORDER`
+	Table0391_SCHEDULE                  Table0391 = `SCHEDULE`
+	Table0391_SERVICE                   Table0391 = `SERVICE`
+	Table0391_SPECIMEN                  Table0391 = `SPECIMEN`
+	Table0391_SPECIMEN_CONTAINER        Table0391 = `SPECIMEN_CONTAINER`
+	Table0391_STAFF                     Table0391 = `STAFF`
+	Table0391_STUDY                     Table0391 = `STUDY`
+	Table0391_STUDY_OBSERVATION         Table0391 = `STUDY_OBSERVATION`
+	Table0391_STUDY_PHASE               Table0391 = `STUDY_PHASE`
+	Table0391_STUDY_SCHEDULE            Table0391 = `STUDY_SCHEDULE`
+	Table0391_TEST_CONFIGURATION        Table0391 = `TEST_CONFIGURATION`
+	Table0391_TIMING                    Table0391 = `TIMING`
+	Table0391_TIMING_DIET               Table0391 = `TIMING_DIET`
+	Table0391_TIMING_ENCODED            Table0391 = `TIMING_ENCODED`
+	Table0391_TIMING_GIVE               Table0391 = `TIMING_GIVE`
+	Table0391_TIMING_PRIOR              Table0391 = `TIMING_PRIOR`
+	Table0391_TIMING_QTY                Table0391 = `TIMING_QTY`
+	Table0391_TIMING_QUANTITY           Table0391 = `TIMING_QUANTITY`
+	Table0391_TIMING_TRAY               Table0391 = `TIMING_TRAY`
+	Table0391_TREATMENT                 Table0391 = `TREATMENT`
+	Table0391_VISIT                     Table0391 = `VISIT`
+)
+
+// TableID returns the table ID.
+func (Table0391) TableID() string { return `0391` }
+
+// Valid reports if the value is in the table.
+func (v Table0391) Valid() bool { return TableValueLookup[`0391`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0391) Description() string { return tableDescription(`0391`, string(v)) }
+
+// Table0392 is a value of table 0392, Match Reason.
+type Table0392 string
+
+const (
+	Table0392_DB Table0392 = `DB` // Match on Date of Birth
+	Table0392_NA Table0392 = `NA` // Match on Name (Alpha Match)
+	Table0392_NP Table0392 = `NP` // Match on Name (Phonetic Match)
+	Table0392_SS Table0392 = `SS` // Match on Social Security Number
+)
+
+// TableID returns the table ID.
+func (Table0392) TableID() string { return `0392` }
+
+// Valid reports if the value is in the table.
+func (v Table0392) Valid() bool { return TableValueLookup[`0392`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0392) Description() string { return tableDescription(`0392`, string(v)) }
+
+// Table0393 is a value of table 0393, Match Algorithms.
+type Table0393 string
+
+const (
+	Table0393_LINKSOFT_2_01 Table0393 = `LINKSOFT_2.01` // Proprietary algorithm for LinkSoft v2.01
+	Table0393_MATCHWARE_1_2 Table0393 = `MATCHWARE_1.2` // Proprietary algorithm for MatchWare v1.2
+)
+
+// TableID returns the table ID.
+func (Table0393) TableID() string { return `0393` }
+
+// Valid reports if the value is in the table.
+func (v Table0393) Valid() bool { return TableValueLookup[`0393`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0393) Description() string { return tableDescription(`0393`, string(v)) }
+
+// Table0394 is a value of table 0394, Response Modality.
+type Table0394 string
+
+const (
+	Table0394_B Table0394 = `B` // Batch
+	Table0394_R Table0394 = `R` // Real Time
+	Table0394_T Table0394 = `T` // Bolus (a series of responses sent at the same time without use of batch formatting)
+)
+
+// TableID returns the table ID.
+func (Table0394) TableID() string { return `0394` }
+
+// Valid reports if the value is in the table.
+func (v Table0394) Valid() bool { return TableValueLookup[`0394`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0394) Description() string { return tableDescription(`0394`, string(v)) }
+
+// Table0395 is a value of table 0395, Modify Indicator.
+type Table0395 string
+
+const (
+	Table0395_M Table0395 = `M` // Modified Subscription
+	Table0395_N Table0395 = `N` // New Subscription
+)
+
+// TableID returns the table ID.
+func (Table0395) TableID() string { return `0395` }
+
+// Valid reports if the value is in the table.
+func (v Table0395) Valid() bool { return TableValueLookup[`0395`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0395) Description() string { return tableDescription(`0395`, string(v)) }
+
+// Table0396 is a value of table 0396, Coding System.
+type Table0396 string
+
+const (
+	Table0396_99zzz        Table0396 = `99zzz`        // Local general code where z is an alphanumeric character
+	Table0396_ACR          Table0396 = `ACR`          // American College of Radiology finding codes
+	Table0396_ALPHAID2006  Table0396 = `ALPHAID2006`  // German Alpha-ID v2006
+	Table0396_ALPHAID2007  Table0396 = `ALPHAID2007`  // German Alpha-ID v2007
+	Table0396_ALPHAID2008  Table0396 = `ALPHAID2008`  // German Alpha-ID v2008
+	Table0396_ALPHAID2009  Table0396 = `ALPHAID2009`  // German Alpha-ID v2009
+	Table0396_ALPHAID2010  Table0396 = `ALPHAID2010`  // German Alpha-ID v2010
+	Table0396_ALPHAID2011  Table0396 = `ALPHAID2011`  // German Alpha-ID v2011
+	Table0396_ART          Table0396 = `ART`          // WHO Adverse Reaction Terms
+	Table0396_AS4          Table0396 = `AS4`          // ASTM E1238/ E1467 Universal
+	Table0396_AS4E         Table0396 = `AS4E`         // AS4 Neurophysiology Codes
+	Table0396_ATC          Table0396 = `ATC`          // American Type Culture Collection
+	Table0396_C4           Table0396 = `C4`           // CPT-4
+	Table0396_CAPECC       Table0396 = `CAPECC`       // College of American Pathologists Electronic Cancer Checklist
+	Table0396_CAS          Table0396 = `CAS`          // Chemical abstract codes
+	Table0396_CCC          Table0396 = `CCC`          // Clinical Care Classification system
+	Table0396_CD2          Table0396 = `CD2`          // CDT-2 Codes
+	Table0396_CDCA         Table0396 = `CDCA`         // CDC Analyte Codes
+	Table0396_CDCEDACUITY  Table0396 = `CDCEDACUITY`  // CDC Emergency Department Acuity
+	Table0396_CDCM         Table0396 = `CDCM`         // CDC Methods/Instruments Codes
+	Table0396_CDCOBS       Table0396 = `CDCOBS`       // CDC BioSense RT observations (Census) - CDC
+	Table0396_CDCPHINVS    Table0396 = `CDCPHINVS`    // CDC PHIN Vocabulary Coding System
+	Table0396_CDCREC       Table0396 = `CDCREC`       // Race & Ethnicity - CDC
+	Table0396_CDS          Table0396 = `CDS`          // CDC Surveillance
+	Table0396_CLP          Table0396 = `CLP`          // CLIP
+	Table0396_CPTM         Table0396 = `CPTM`         // CPT Modifier Code
+	Table0396_CST          Table0396 = `CST`          // COSTART
+	Table0396_CVX          Table0396 = `CVX`          // CDC Vaccine Codes
+	Table0396_DCM          Table0396 = `DCM`          // DICOM Controlled Terminology
+	Table0396_E            Table0396 = `E`            // EUCLIDES
+	Table0396_E5           Table0396 = `E5`           // Euclides quantity codes
+	Table0396_E6           Table0396 = `E6`           // Euclides Lab method codes
+	Table0396_E7           Table0396 = `E7`           // Euclides Lab equipment codes
+	Table0396_ENZC         Table0396 = `ENZC`         // Enzyme Codes
+	Table0396_EPASRS       Table0396 = `EPASRS`       // EPA SRS
+	Table0396_FDAUNII      Table0396 = `FDAUNII`      // Unique Ingredient Identifier (UNII)
+	Table0396_FDDC         Table0396 = `FDDC`         // First DataBank Drug Codes
+	Table0396_FDDX         Table0396 = `FDDX`         // First DataBank Diagnostic Codes
+	Table0396_FDK          Table0396 = `FDK`          // FDA K10
+	Table0396_FIPS5_2      Table0396 = `FIPS5_2`      // FIPS 5-2 (State)
+	Table0396_FIPS6_4      Table0396 = `FIPS6_4`      // FIPS 6-4 (County)
+	Table0396_GDRG2004     Table0396 = `GDRG2004`     // G-DRG German DRG Codes v2004
+	Table0396_GDRG2005     Table0396 = `GDRG2005`     // G-DRG German DRG Codes v2005
+	Table0396_GDRG2006     Table0396 = `GDRG2006`     // G-DRG German DRG Codes v2006
+	Table0396_GDRG2007     Table0396 = `GDRG2007`     // G-DRG German DRG Codes v2007
+	Table0396_GDRG2008     Table0396 = `GDRG2008`     // G-DRG German DRG Codes v2008
+	Table0396_GDRG2009     Table0396 = `GDRG2009`     // G-DRG German DRG Codes v2009
+	Table0396_GMDC2004     Table0396 = `GMDC2004`     // German Major Diagnostic Codes v2004
+	Table0396_GMDC2005     Table0396 = `GMDC2005`     // German Major Diagnostic Codes v2005
+	Table0396_GMDC2006     Table0396 = `GMDC2006`     // German Major Diagnostic Codes v2006
+	Table0396_GMDC2007     Table0396 = `GMDC2007`     // German Major Diagnostic Codes v2007
+	Table0396_GMDC2008     Table0396 = `GMDC2008`     // German Major Diagnostic Codes v2008
+	Table0396_GMDC2009     Table0396 = `GMDC2009`     // German Major Diagnostic Codes v2009
+	Table0396_HB           Table0396 = `HB`           // HIBCC
+	Table0396_HCPCS        Table0396 = `HCPCS`        // CMS (formerly HCFA) Common Procedure Coding System
+	Table0396_HCPT         Table0396 = `HCPT`         // Health Care Provider Taxonomy
+	Table0396_HHC          Table0396 = `HHC`          // Home Health Care
+	Table0396_HI           Table0396 = `HI`           // Health Outcomes
+	Table0396_HL7nnnn      Table0396 = `HL7nnnn`      // HL7 Defined Codes where nnnn is the HL7 table number
+	Table0396_HOT          Table0396 = `HOT`          // Japanese Nationwide Medicine Code
+	Table0396_HPC          Table0396 = `HPC`          // CMS (formerly HCFA )Procedure Codes (HCPCS)
+	Table0396_I10          Table0396 = `I10`          // ICD-10
+	Table0396_I10G2004     Table0396 = `I10G2004`     // ICD 10 Germany 2004
+	Table0396_I10G2005     Table0396 = `I10G2005`     // ICD 10 Germany 2005
+	Table0396_I10G2006     Table0396 = `I10G2006`     // ICD 10 Germany 2006
+	Table0396_I10P         Table0396 = `I10P`         // ICD-10 Procedure Codes
+	Table0396_I9           Table0396 = `I9`           // ICD9
+	Table0396_I9C          Table0396 = `I9C`          // ICD-9CM
+	Table0396_I9CDX        Table0396 = `I9CDX`        // ICD-9CM Diagnosis codes
+	Table0396_I9CP         Table0396 = `I9CP`         // ICD-9CM Procedure codes
+	Table0396_IBT          Table0396 = `IBT`          // ISBT
+	Table0396_IBTnnnn      Table0396 = `IBTnnnn`      // ISBT 128 codes where nnnn specifies a specific table within ISBT 128.
+	Table0396_IC2          Table0396 = `IC2`          // ICHPPC-2
+	Table0396_ICD10AM      Table0396 = `ICD10AM`      // ICD-10 Australian modification
+	Table0396_ICD10CA      Table0396 = `ICD10CA`      // ICD-10 Canada
+	Table0396_ICD10GM2007  Table0396 = `ICD10GM2007`  // ICD 10 Germany v2007
+	Table0396_ICD10GM2008  Table0396 = `ICD10GM2008`  // ICD 10 Germany v2008
+	Table0396_ICD10GM2009  Table0396 = `ICD10GM2009`  // ICD 10 Germany v2009
+	Table0396_ICD10GM2010  Table0396 = `ICD10GM2010`  // ICD 10 Germany v2010
+	Table0396_ICD10GM2011  Table0396 = `ICD10GM2011`  // ICD 10 Germany v2011
+	Table0396_ICDO         Table0396 = `ICDO`         // International Classification of Diseases for Oncology
+	Table0396_ICDO2        Table0396 = `ICDO2`        // International Classification of Disease for Oncology Second Edition
+	Table0396_ICDO3        Table0396 = `ICDO3`        // International Classification of Disease for Oncology Third Edition
+	Table0396_ICS          Table0396 = `ICS`          // ICCS
+	Table0396_ICSD         Table0396 = `ICSD`         // International Classification of Sleep Disorders
+	Table0396_ISO          Table0396 = `ISO`          // ISO 2955.83 (units of measure) with HL7 extensions
+	Table0396_ISO3166_1    Table0396 = `ISO3166_1`    // ISO 3166-1 Country Codes
+	Table0396_ISO3166_2    Table0396 = `ISO3166_2`    // ISO 3166-2 Country subdivisions
+	Table0396_ISO4217      Table0396 = `ISO4217`      // ISO4217 Currency Codes
+	Table0396_ISO639       Table0396 = `ISO639`       // ISO 639 Language
+	Table0396_ITIS         Table0396 = `ITIS`         // Integrated Taxonomic Information System
+	Table0396_IUPC         Table0396 = `IUPC`         // IUPAC/IFCC Component Codes
+	Table0396_IUPP         Table0396 = `IUPP`         // IUPAC/IFCC Property Codes
+	Table0396_JC10         Table0396 = `JC10`         // JLAC/JSLM, nationwide laboratory code
+	Table0396_JC8          Table0396 = `JC8`          // Japanese Chemistry
+	Table0396_JJ1017       Table0396 = `JJ1017`       // Japanese Image Examination Cache
+	Table0396_L            Table0396 = `L`            // Local general code
+	Table0396_LB           Table0396 = `LB`           // Local billing code
+	Table0396_LN           Table0396 = `LN`           // Logical Observation Identifier Names and Codes (LOINCÂ®)
+	Table0396_MCD          Table0396 = `MCD`          // Medicaid
+	Table0396_MCR          Table0396 = `MCR`          // Medicare
+	Table0396_MDC          Table0396 = `MDC`          // Medical Device Communication
+	Table0396_MDDX         Table0396 = `MDDX`         // Medispan Diagnostic Codes
+	Table0396_MEDC         Table0396 = `MEDC`         // Medical Economics Drug Codes
+	Table0396_MEDR         Table0396 = `MEDR`         // Medical Dictionary for Drug Regulatory Affairs (MEDDRA)
+	Table0396_MEDX         Table0396 = `MEDX`         // Medical Economics Diagnostic Codes
+	Table0396_MGPI         Table0396 = `MGPI`         // Medispan GPI
+	Table0396_MVX          Table0396 = `MVX`          // CDC Vaccine Manufacturer Codes
+	Table0396_NAICS        Table0396 = `NAICS`        // Industry (NAICS)
+	Table0396_NCPDPnnnnsss Table0396 = `NCPDPnnnnsss` // NCPDP code list for data element nnnn [as used in segment sss]
+	Table0396_NDA          Table0396 = `NDA`          // NANDA
+	Table0396_NDC          Table0396 = `NDC`          // National drug codes
+	Table0396_NDFRT        Table0396 = `NDFRT`        // NDF-RT (Drug Classification)
+	Table0396_NIC          Table0396 = `NIC`          // Nursing Interventions Classification
+	Table0396_NIP001       Table0396 = `NIP001`       // Source of Information (Immunization)
+	Table0396_NIP002       Table0396 = `NIP002`       // Substance refusal reason
+	Table0396_NIP004       Table0396 = `NIP004`       // Vaccination - Contraindications, Precautions, and Immunities
+	Table0396_NIP007       Table0396 = `NIP007`       // Vaccinated at location (facility)
+	Table0396_NIP008       Table0396 = `NIP008`       // Vaccine purchased with (Type of funding)
+	Table0396_NIP009       Table0396 = `NIP009`       // Reported adverse event previously
+	Table0396_NIP010       Table0396 = `NIP010`       // VAERS Report type
+	Table0396_NND          Table0396 = `NND`          // Notifiable Event (Disease/Condition) Code List
+	Table0396_NPI          Table0396 = `NPI`          // National Provider Identifier
+	Table0396_NUBC         Table0396 = `NUBC`         // National Uniform Billing Committee
+	Table0396_NULLFL       Table0396 = `NULLFL`       // Null Flavor
+	Table0396_O301         Table0396 = `O301`         // German Procedure Codes
+	Table0396_O3012004     Table0396 = `O3012004`     // OPS Germany v2004
+	Table0396_O3012005     Table0396 = `O3012005`     // OPS Germany v2005
+	Table0396_O3012006     Table0396 = `O3012006`     // OPS Germany v2006
+	Table0396_OHA          Table0396 = `OHA`          // Omaha System
+	Table0396_OPS2007      Table0396 = `OPS2007`      // OPS Germany v2007
+	Table0396_OPS2008      Table0396 = `OPS2008`      // OPS Germany v2008
+	Table0396_OPS2009      Table0396 = `OPS2009`      // OPS Germany v2009
+	Table0396_OPS2010      Table0396 = `OPS2010`      // OPS Germany v2010
+	Table0396_OPS2011      Table0396 = `OPS2011`      // OPS Germany v2011
+	Table0396_PHINQUESTION Table0396 = `PHINQUESTION` // CDC Public Health Information Network (PHIN) Question
+	Table0396_PLR          Table0396 = `PLR`          // CDC PHLIP Lab result codes that are not covered in SNOMED at the time of this implementation
+	Table0396_PLT          Table0396 = `PLT`          // CDC PHLIP Lab test codes, where LOINC concept is too broad or not yet available, especially as needed for ordering and or lab to lab reporting )
+	Table0396_POS          Table0396 = `POS`          // POS Codes
+	Table0396_RC           Table0396 = `RC`           // Read Classification
+	Table0396_RXNORM       Table0396 = `RXNORM`       // RxNorm
+	Table0396_SCT          Table0396 = `SCT`          // SNOMED Clinical Terms
+	Table0396_SCT2         Table0396 = `SCT2`         // SNOMED Clinical Terms alphanumeric codes
+	Table0396_SDM          Table0396 = `SDM`          // SNOMED- DICOM Microglossary
+	Table0396_SIC          Table0396 = `SIC`          // Industry (SIC)
+	Table0396_SNM          Table0396 = `SNM`          // Systemized Nomenclature of Medicine (SNOMED)
+	Table0396_SNM3         Table0396 = `SNM3`         // SNOMED International
+	Table0396_SNT          Table0396 = `SNT`          // SNOMED topology codes (anatomic sites)
+	Table0396_SOC          Table0396 = `SOC`          // Occupation (SOC 2000)
+	Table0396_UB04FL14     Table0396 = `UB04FL14`     // Priority (Type) of Visit
+	Table0396_UB04FL15     Table0396 = `UB04FL15`     // Point of Origin
+	Table0396_UB04FL17     Table0396 = `UB04FL17`     // Patient Discharge Status
+	Table0396_UB04FL31     Table0396 = `UB04FL31`     // Occurrence Code
+	Table0396_UB04FL35     Table0396 = `UB04FL35`     // Occurrence Span
+	Table0396_UB04FL39     Table0396 = `UB04FL39`     // Value Code
+	Table0396_UC           Table0396 = `UC`           // UCDS
+	Table0396_UCUM         Table0396 = `UCUM`         // UCUM code set for units of measure(from Regenstrief)
+	Table0396_UMD          Table0396 = `UMD`          // MDNS
+	Table0396_UML          Table0396 = `UML`          // Unified Medical Language
+	Table0396_UPC          Table0396 = `UPC`          // Universal Product Code
+	Table0396_UPIN         Table0396 = `UPIN`         // UPIN
+	Table0396_USPS         Table0396 = `USPS`         // United States Postal Service
+	Table0396_W1           Table0396 = `W1`           // WHO record # drug codes (6 digit)
+	Table0396_W2           Table0396 = `W2`           // WHO record # drug codes (8 digit)
+	Table0396_W4           Table0396 = `W4`           // WHO record # code with ASTM extension
+	Table0396_WC           Table0396 = `WC`           // WHO ATC
+	Table0396_X12Dennnn    Table0396 = `X12Dennnn`    // ASC X12 Code List nnnn
+)
+
+// TableID returns the table ID.
+func (Table0396) TableID() string { return `0396` }
+
+// Valid reports if the value is in the table.
+func (v Table0396) Valid() bool { return TableValueLookup[`0396`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0396) Description() string { return tableDescription(`0396`, string(v)) }
+
+// Table0397 is a value of table 0397, Sequencing.
+type Table0397 string
+
+const (
+	Table0397_A  Table0397 = `A`  // Ascending
+	Table0397_AN Table0397 = `AN` // Ascending, case insensitive
+	Table0397_D  Table0397 = `D`  // Descending
+	Table0397_DN Table0397 = `DN` // Descending, case insensitive
+	Table0397_N  Table0397 = `N`  // None
+)
+
+// TableID returns the table ID.
+func (Table0397) TableID() string { return `0397` }
+
+// Valid reports if the value is in the table.
+func (v Table0397) Valid() bool { return TableValueLookup[`0397`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0397) Description() string { return tableDescription(`0397`, string(v)) }
+
+// Table0398 is a value of table 0398, Continuation Style Code.
+type Table0398 string
+
+const (
+	Table0398_F Table0398 = `F` // Fragmentation
+	Table0398_I Table0398 = `I` // Interactive Continuation
+)
+
+// TableID returns the table ID.
+func (Table0398) TableID() string { return `0398` }
+
+// Valid reports if the value is in the table.
+func (v Table0398) Valid() bool { return TableValueLookup[`0398`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0398) Description() string { return tableDescription(`0398`, string(v)) }
+
+// Table0399 is a value of table 0399, Country Code.
+type Table0399 string
+
+// TableID returns the table ID.
+func (Table0399) TableID() string { return `0399` }
+
+// Valid reports if the value is in the table.
+func (v Table0399) Valid() bool { return TableValueLookup[`0399`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0399) Description() string { return tableDescription(`0399`, string(v)) }
+
+// Table0401 is a value of table 0401, Government Reimbursement Program.
+type Table0401 string
+
+const (
+	Table0401_C  Table0401 = `C`  // Medi-Cal
+	Table0401_MM Table0401 = `MM` // Medicare
+)
+
+// TableID returns the table ID.
+func (Table0401) TableID() string { return `0401` }
+
+// Valid reports if the value is in the table.
+func (v Table0401) Valid() bool { return TableValueLookup[`0401`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0401) Description() string { return tableDescription(`0401`, string(v)) }
+
+// Table0402 is a value of table 0402, School Type.
+type Table0402 string
+
+const (
+	Table0402_D Table0402 = `D` // Dental
+	Table0402_G Table0402 = `G` // Graduate
+	Table0402_M Table0402 = `M` // Medical
+	Table0402_U Table0402 = `U` // Undergraduate
+)
+
+// TableID returns the table ID.
+func (Table0402) TableID() string { return `0402` }
+
+// Valid reports if the value is in the table.
+func (v Table0402) Valid() bool { return TableValueLookup[`0402`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0402) Description() string { return tableDescription(`0402`, string(v)) }
+
+// Table0403 is a value of table 0403, Language Ability.
+type Table0403 string
+
+const (
+	Table0403_1 Table0403 = `1` // Read
+	Table0403_2 Table0403 = `2` // Write
+	Table0403_3 Table0403 = `3` // Speak
+	Table0403_4 Table0403 = `4` // Understand
+	Table0403_5 Table0403 = `5` // Sign
+)
+
+// TableID returns the table ID.
+func (Table0403) TableID() string { return `0403` }
+
+// Valid reports if the value is in the table.
+func (v Table0403) Valid() bool { return TableValueLookup[`0403`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0403) Description() string { return tableDescription(`0403`, string(v)) }
+
+// Table0404 is a value of table 0404, Language Proficiency.
+type Table0404 string
+
+const (
+	Table0404_1 Table0404 = `1` // Excellent
+	Table0404_2 Table0404 = `2` // Good
+	Table0404_3 Table0404 = `3` // Fair
+	Table0404_4 Table0404 = `4` // Poor
+	Table0404_5 Table0404 = `5` // Some (level unknown)
+	Table0404_6 Table0404 = `6` // None
+)
+
+// TableID returns the table ID.
+func (Table0404) TableID() string { return `0404` }
+
+// Valid reports if the value is in the table.
+func (v Table0404) Valid() bool { return TableValueLookup[`0404`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0404) Description() string { return tableDescription(`0404`, string(v)) }
+
+// Table0405 is a value of table 0405, Organization Unit.
+type Table0405 string
+
+// TableID returns the table ID.
+func (Table0405) TableID() string { return `0405` }
+
+// Valid reports if the value is in the table.
+func (v Table0405) Valid() bool { return TableValueLookup[`0405`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0405) Description() string { return tableDescription(`0405`, string(v)) }
+
+// Table0406 is a value of table 0406, Organization Unit Type.
+type Table0406 string
+
+const (
+	Table0406_1 Table0406 = `1` // Hospital
+	Table0406_2 Table0406 = `2` // Physician Clinic
+	Table0406_3 Table0406 = `3` // Long Term Care
+	Table0406_4 Table0406 = `4` // Acute Care
+	Table0406_5 Table0406 = `5` // Other
+	Table0406_H Table0406 = `H` // Home
+	Table0406_O Table0406 = `O` // Office
+)
+
+// TableID returns the table ID.
+func (Table0406) TableID() string { return `0406` }
+
+// Valid reports if the value is in the table.
+func (v Table0406) Valid() bool { return TableValueLookup[`0406`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0406) Description() string { return tableDescription(`0406`, string(v)) }
+
+// Table0409 is a value of table 0409, Application Change Type.
+type Table0409 string
+
+const (
+	Table0409_M  Table0409 = `M`  // Migrates to different CPU
+	Table0409_SD Table0409 = `SD` // Shut down
+	Table0409_SU Table0409 = `SU` // Start up
+)
+
+// TableID returns the table ID.
+func (Table0409) TableID() string { return `0409` }
+
+// Valid reports if the value is in the table.
+func (v Table0409) Valid() bool { return TableValueLookup[`0409`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0409) Description() string { return tableDescription(`0409`, string(v)) }
+
+// Table0411 is a value of table 0411, Supplemental Service Information Values.
+type Table0411 string
+
+// TableID returns the table ID.
+func (Table0411) TableID() string { return `0411` }
+
+// Valid reports if the value is in the table.
+func (v Table0411) Valid() bool { return TableValueLookup[`0411`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0411) Description() string { return tableDescription(`0411`, string(v)) }
+
+// Table0412 is a value of table 0412, Category Identifier.
+type Table0412 string
+
+// TableID returns the table ID.
+func (Table0412) TableID() string { return `0412` }
+
+// Valid reports if the value is in the table.
+func (v Table0412) Valid() bool { return TableValueLookup[`0412`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0412) Description() string { return tableDescription(`0412`, string(v)) }
+
+// Table0413 is a value of table 0413, Consent Identifier.
+type Table0413 string
+
+// TableID returns the table ID.
+func (Table0413) TableID() string { return `0413` }
+
+// Valid reports if the value is in the table.
+func (v Table0413) Valid() bool { return TableValueLookup[`0413`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0413) Description() string { return tableDescription(`0413`, string(v)) }
+
+// Table0414 is a value of table 0414, Units of Time.
+type Table0414 string
+
+// TableID returns the table ID.
+func (Table0414) TableID() string { return `0414` }
+
+// Valid reports if the value is in the table.
+func (v Table0414) Valid() bool { return TableValueLookup[`0414`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0414) Description() string { return tableDescription(`0414`, string(v)) }
+
+// Table0415 is a value of table 0415, DRG Transfer Type.
+type Table0415 string
+
+const (
+	Table0415_E Table0415 = `E` // DRG Exempt
+	Table0415_N Table0415 = `N` // DRG Non Exempt
+)
+
+// TableID returns the table ID.
+func (Table0415) TableID() string { return `0415` }
+
+// Valid reports if the value is in the table.
+func (v Table0415) Valid() bool { return TableValueLookup[`0415`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0415) Description() string { return tableDescription(`0415`, string(v)) }
+
+// Table0416 is a value of table 0416, Procedure DRG Type.
+type Table0416 string
+
+const (
+	Table0416_1 Table0416 = `1` // 1st non-Operative
+	Table0416_2 Table0416 = `2` // 2nd non-Operative
+	Table0416_3 Table0416 = `3` // Major Operative
+	Table0416_4 Table0416 = `4` // 2nd Operative
+	Table0416_5 Table0416 = `5` // 3rd Operative
+)
+
+// TableID returns the table ID.
+func (Table0416) TableID() string { return `0416` }
+
+// Valid reports if the value is in the table.
+func (v Table0416) Valid() bool { return TableValueLookup[`0416`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0416) Description() string { return tableDescription(`0416`, string(v)) }
+
+// Table0417 is a value of table 0417, Tissue Type Code.
+type Table0417 string
+
+const (
+	Table0417_0 Table0417 = `0` // No tissue expected
+	Table0417_1 Table0417 = `1` // Insufficient Tissue
+	Table0417_2 Table0417 = `2` // Not abnormal
+	Table0417_3 Table0417 = `3` // Abnormal-not categorized
+	Table0417_4 Table0417 = `4` // Mechanical abnormal
+	Table0417_5 Table0417 = `5` // Growth alteration
+	Table0417_6 Table0417 = `6` // Degeneration & necrosis
+	Table0417_7 Table0417 = `7` // Non-acute inflammation
+	Table0417_8 Table0417 = `8` // Non-malignant neoplasm
+	Table0417_9 Table0417 = `9` // Malignant neoplasm
+	Table0417_B Table0417 = `B` // Basal cell carcinoma
+	Table0417_C Table0417 = `C` // Carcinoma-unspecified type
+	Table0417_G Table0417 = `G` // Additional tissue required
+)
+
+// TableID returns the table ID.
+func (Table0417) TableID() string { return `0417` }
+
+// Valid reports if the value is in the table.
+func (v Table0417) Valid() bool { return TableValueLookup[`0417`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0417) Description() string { return tableDescription(`0417`, string(v)) }
+
+// Table0418 is a value of table 0418, Procedure Priority.
+type Table0418 string
+
+const (
+	Table0418_0 Table0418 = `0` // the admitting procedure
+	Table0418_1 Table0418 = `1` // the primary procedure
+	Table0418_2 Table0418 = `2` // for ranked secondary procedures
+)
+
+// TableID returns the table ID.
+func (Table0418) TableID() string { return `0418` }
+
+// Valid reports if the value is in the table.
+func (v Table0418) Valid() bool { return TableValueLookup[`0418`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0418) Description() string { return tableDescription(`0418`, string(v)) }
+
+// Table0421 is a value of table 0421, Severity of Illness Code.
+type Table0421 string
+
+const (
+	Table0421_MI Table0421 = `MI` // Mild
+	Table0421_MO Table0421 = `MO` // Moderate
+	Table0421_SE Table0421 = `SE` // Severe
+)
+
+// TableID returns the table ID.
+func (Table0421) TableID() string { return `0421` }
+
+// Valid reports if the value is in the table.
+func (v Table0421) Valid() bool { return TableValueLookup[`0421`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0421) Description() string { return tableDescription(`0421`, string(v)) }
+
+// Table0422 is a value of table 0422, Triage Code.
+type Table0422 string
+
+const (
+	Table0422_1  Table0422 = `1`  // Non-acute
+	Table0422_2  Table0422 = `2`  // Acute
+	Table0422_3  Table0422 = `3`  // Urgent
+	Table0422_4  Table0422 = `4`  // Severe
+	Table0422_5  Table0422 = `5`  // Dead on Arrival (DOA)
+	Table0422_99 Table0422 = `99` // Other
+)
+
+// TableID returns the table ID.
+func (Table0422) TableID() string { return `0422` }
+
+// Valid reports if the value is in the table.
+func (v Table0422) Valid() bool { return TableValueLookup[`0422`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0422) Description() string { return tableDescription(`0422`, string(v)) }
+
+// Table0423 is a value of table 0423, Case Category Code.
+type Table0423 string
+
+const (
+	Table0423_D Table0423 = `D` // Doctor's Office Closed
+)
+
+// TableID returns the table ID.
+func (Table0423) TableID() string { return `0423` }
+
+// Valid reports if the value is in the table.
+func (v Table0423) Valid() bool { return TableValueLookup[`0423`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0423) Description() string { return tableDescription(`0423`, string(v)) }
+
+// Table0424 is a value of table 0424, Gestation Category Code.
+type Table0424 string
+
+const (
+	Table0424_1 Table0424 = `1` // Premature / Pre-term
+	Table0424_2 Table0424 = `2` // Full Term
+	Table0424_3 Table0424 = `3` // Overdue / Post-term
+)
+
+// TableID returns the table ID.
+func (Table0424) TableID() string { return `0424` }
+
+// Valid reports if the value is in the table.
+func (v Table0424) Valid() bool { return TableValueLookup[`0424`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0424) Description() string { return tableDescription(`0424`, string(v)) }
+
+// Table0425 is a value of table 0425, Newborn Code.
+type Table0425 string
+
+const (
+	Table0425_1 Table0425 = `1` // Born in facility
+	Table0425_2 Table0425 = `2` // Transfer in
+	Table0425_3 Table0425 = `3` // Born en route
+	Table0425_4 Table0425 = `4` // Other
+	Table0425_5 Table0425 = `5` // Born at home
+)
+
+// TableID returns the table ID.
+func (Table0425) TableID() string { return `0425` }
+
+// Valid reports if the value is in the table.
+func (v Table0425) Valid() bool { return TableValueLookup[`0425`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0425) Description() string { return tableDescription(`0425`, string(v)) }
+
+// Table0426 is a value of table 0426, Blood Product Code.
+type Table0426 string
+
+const (
+	Table0426_CRYO    Table0426 = `CRYO`    // Cryoprecipitated AHF
+	Table0426_CRYOP   Table0426 = `CRYOP`   // Pooled Cryoprecipitate
+	Table0426_FFP     Table0426 = `FFP`     // Fresh Frozen Plasma
+	Table0426_FFPTH   Table0426 = `FFPTH`   // Fresh Frozen Plasma - Thawed
+	Table0426_PC      Table0426 = `PC`      // Packed Cells
+	Table0426_PCA     Table0426 = `PCA`     // Autologous Packed Cells
+	Table0426_PCNEO   Table0426 = `PCNEO`   // Packed Cells - Neonatal
+	Table0426_PCW     Table0426 = `PCW`     // Washed Packed Cells
+	Table0426_PLT     Table0426 = `PLT`     // Platelet Concentrate
+	Table0426_PLTNEO  Table0426 = `PLTNEO`  // Reduced Volume Platelets
+	Table0426_PLTP    Table0426 = `PLTP`    // Pooled Platelets
+	Table0426_PLTPH   Table0426 = `PLTPH`   // Platelet Pheresis
+	Table0426_PLTPHLR Table0426 = `PLTPHLR` // Leukoreduced Platelet Pheresis
+	Table0426_RWB     Table0426 = `RWB`     // Reconstituted Whole Blood
+	Table0426_WBA     Table0426 = `WBA`     // Autologous Whole Blood
+)
+
+// TableID returns the table ID.
+func (Table0426) TableID() string { return `0426` }
+
+// Valid reports if the value is in the table.
+func (v Table0426) Valid() bool { return TableValueLookup[`0426`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0426) Description() string { return tableDescription(`0426`, string(v)) }
+
+// Table0427 is a value of table 0427, Risk Management Incident Code.
+type Table0427 string
+
+const (
+	Table0427_B Table0427 = `B` // Body fluid exposure
+	Table0427_C Table0427 = `C` // Contaminated Substance
+	Table0427_D Table0427 = `D` // Diet Errors
+	Table0427_E Table0427 = `E` // Equipment problem
+	Table0427_F Table0427 = `F` // Patient fell (not from bed)
+	Table0427_H Table0427 = `H` // Patient fell from bed
+	Table0427_I Table0427 = `I` // Infusion error
+	Table0427_J Table0427 = `J` // Foreign object left during surgery
+	Table0427_K Table0427 = `K` // Sterile precaution violated
+	Table0427_O Table0427 = `O` // Other
+	Table0427_P Table0427 = `P` // Procedure error
+	Table0427_R Table0427 = `R` // Pharmaceutical error
+	Table0427_S Table0427 = `S` // Suicide Attempt
+	Table0427_T Table0427 = `T` // Transfusion error
+)
+
+// TableID returns the table ID.
+func (Table0427) TableID() string { return `0427` }
+
+// Valid reports if the value is in the table.
+func (v Table0427) Valid() bool { return TableValueLookup[`0427`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0427) Description() string { return tableDescription(`0427`, string(v)) }
+
+// Table0428 is a value of table 0428, Incident Type Code.
+type Table0428 string
+
+const (
+	Table0428_O Table0428 = `O` // Other
+	Table0428_P Table0428 = `P` // Preventable
+	Table0428_U Table0428 = `U` // User Error
+)
+
+// TableID returns the table ID.
+func (Table0428) TableID() string { return `0428` }
+
+// Valid reports if the value is in the table.
+func (v Table0428) Valid() bool { return TableValueLookup[`0428`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0428) Description() string { return tableDescription(`0428`, string(v)) }
+
+// Table0429 is a value of table 0429, Production Class Code.
+type Table0429 string
+
+const (
+	Table0429_BR Table0429 = `BR` // Breeding/genetic stock
+	Table0429_DA Table0429 = `DA` // Dairy
+	Table0429_DR Table0429 = `DR` // Draft
+	Table0429_DU Table0429 = `DU` // Dual Purpose
+	Table0429_LY Table0429 = `LY` // Layer, Includes Multiplier flocks
+	Table0429_MT Table0429 = `MT` // Meat
+	Table0429_NA Table0429 = `NA` // Not Applicable
+	Table0429_OT Table0429 = `OT` // Other
+	Table0429_PL Table0429 = `PL` // Pleasure
+	Table0429_RA Table0429 = `RA` // Racing
+	Table0429_SH Table0429 = `SH` // Show
+	Table0429_U  Table0429 = `U`  // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0429) TableID() string { return `0429` }
+
+// Valid reports if the value is in the table.
+func (v Table0429) Valid() bool { return TableValueLookup[`0429`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0429) Description() string { return tableDescription(`0429`, string(v)) }
+
+// Table0430 is a value of table 0430, Mode of Arrival Code.
+type Table0430 string
+
+const (
+	Table0430_A Table0430 = `A` // Ambulance
+	Table0430_C Table0430 = `C` // Car
+	Table0430_F Table0430 = `F` // On foot
+	Table0430_H Table0430 = `H` // Helicopter
+	Table0430_O Table0430 = `O` // Other
+	Table0430_P Table0430 = `P` // Public Transport
+	Table0430_U Table0430 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0430) TableID() string { return `0430` }
+
+// Valid reports if the value is in the table.
+func (v Table0430) Valid() bool { return TableValueLookup[`0430`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0430) Description() string { return tableDescription(`0430`, string(v)) }
+
+// Table0431 is a value of table 0431, Recreational Drug Use Code.
+type Table0431 string
+
+const (
+	Table0431_A Table0431 = `A` // Alcohol
+	Table0431_C Table0431 = `C` // Tobacco - chewed
+	Table0431_K Table0431 = `K` // Kava
+	Table0431_M Table0431 = `M` // Marijuana
+	Table0431_O Table0431 = `O` // Other
+	Table0431_T Table0431 = `T` // Tobacco - smoked
+	Table0431_U Table0431 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0431) TableID() string { return `0431` }
+
+// Valid reports if the value is in the table.
+func (v Table0431) Valid() bool { return TableValueLookup[`0431`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0431) Description() string { return tableDescription(`0431`, string(v)) }
+
+// Table0432 is a value of table 0432, Admission Level of Care Code.
+type Table0432 string
+
+const (
+	Table0432_AC Table0432 = `AC` // Acute
+	Table0432_CH Table0432 = `CH` // Chronic
+	Table0432_CO Table0432 = `CO` // Comatose
+	Table0432_CR Table0432 = `CR` // Critical
+	Table0432_IM Table0432 = `IM` // Improved
+	Table0432_MO Table0432 = `MO` // Moribund
+)
+
+// TableID returns the table ID.
+func (Table0432) TableID() string { return `0432` }
+
+// Valid reports if the value is in the table.
+func (v Table0432) Valid() bool { return TableValueLookup[`0432`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0432) Description() string { return tableDescription(`0432`, string(v)) }
+
+// Table0433 is a value of table 0433, Precaution Code.
+type Table0433 string
+
+const (
+	Table0433_A Table0433 = `A` // Aggressive
+	Table0433_B Table0433 = `B` // Blind
+	Table0433_C Table0433 = `C` // Confused
+	Table0433_D Table0433 = `D` // Deaf
+	Table0433_I Table0433 = `I` // On IV
+	Table0433_N Table0433 = `N` // "No-code" (i.e. Do not resuscitate)
+	Table0433_O Table0433 = `O` // Other
+	Table0433_P Table0433 = `P` // Paraplegic
+	Table0433_U Table0433 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0433) TableID() string { return `0433` }
+
+// Valid reports if the value is in the table.
+func (v Table0433) Valid() bool { return TableValueLookup[`0433`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0433) Description() string { return tableDescription(`0433`, string(v)) }
+
+// Table0434 is a value of table 0434, Patient Condition Code.
+type Table0434 string
+
+const (
+	Table0434_A Table0434 = `A` // Satisfactory
+	Table0434_C Table0434 = `C` // Critical
+	Table0434_O Table0434 = `O` // Other
+	Table0434_P Table0434 = `P` // Poor
+	Table0434_S Table0434 = `S` // Stable
+	Table0434_U Table0434 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0434) TableID() string { return `0434` }
+
+// Valid reports if the value is in the table.
+func (v Table0434) Valid() bool { return TableValueLookup[`0434`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0434) Description() string { return tableDescription(`0434`, string(v)) }
+
+// Table0435 is a value of table 0435, Advance Directive Code.
+type Table0435 string
+
+const (
+	Table0435_DNR Table0435 = `DNR` // Do not resuscitate
+	Table0435_N   Table0435 = `N`   // No directive
+)
+
+// TableID returns the table ID.
+func (Table0435) TableID() string { return `0435` }
+
+// Valid reports if the value is in the table.
+func (v Table0435) Valid() bool { return TableValueLookup[`0435`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0435) Description() string { return tableDescription(`0435`, string(v)) }
+
+// Table0436 is a value of table 0436, Sensitivity to Causative Agent Code.
+type Table0436 string
+
+const (
+	Table0436_AD Table0436 = `AD` // Adverse Reaction (Not otherwise classified)
+	Table0436_AL Table0436 = `AL` // Allergy
+	Table0436_CT Table0436 = `CT` // Contraindication
+	Table0436_IN Table0436 = `IN` // Intolerance
+	Table0436_SE Table0436 = `SE` // Side Effect
+)
+
+// TableID returns the table ID.
+func (Table0436) TableID() string { return `0436` }
+
+// Valid reports if the value is in the table.
+func (v Table0436) Valid() bool { return TableValueLookup[`0436`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0436) Description() string { return tableDescription(`0436`, string(v)) }
+
+// Table0437 is a value of table 0437, Alert Device Code.
+type Table0437 string
+
+const (
+	Table0437_B Table0437 = `B` // Bracelet
+	Table0437_N Table0437 = `N` // Necklace
+	Table0437_W Table0437 = `W` // Wallet Card
+)
+
+// TableID returns the table ID.
+func (Table0437) TableID() string { return `0437` }
+
+// Valid reports if the value is in the table.
+func (v Table0437) Valid() bool { return TableValueLookup[`0437`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0437) Description() string { return tableDescription(`0437`, string(v)) }
+
+// Table0438 is a value of table 0438, Allergy Clinical Status.
+type Table0438 string
+
+const (
+	Table0438_C Table0438 = `C` // Confirmed or verified
+	Table0438_D Table0438 = `D` // Doubt raised
+	Table0438_E Table0438 = `E` // Erroneous
+	Table0438_I Table0438 = `I` // Confirmed but inactive
+	Table0438_P Table0438 = `P` // Pending
+	Table0438_S Table0438 = `S` // Suspect
+	Table0438_U Table0438 = `U` // Unconfirmed
+)
+
+// TableID returns the table ID.
+func (Table0438) TableID() string { return `0438` }
+
+// Valid reports if the value is in the table.
+func (v Table0438) Valid() bool { return TableValueLookup[`0438`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0438) Description() string { return tableDescription(`0438`, string(v)) }
+
+// Table0440 is a value of table 0440, Data Types.
+type Table0440 string
+
+const (
+	Table0440_AD  Table0440 = `AD`  // Address
+	Table0440_AUI Table0440 = `AUI` // Authorization information
+	Table0440_CCD Table0440 = `CCD` // Charge code and date
+	Table0440_CCP Table0440 = `CCP` // Channel calibration parameters
+	Table0440_CD  Table0440 = `CD`  // Channel definition
+	Table0440_CE  Table0440 = `CE`  // Coded element
+	Table0440_CF  Table0440 = `CF`  // Coded element with formatted values
+	Table0440_CK  Table0440 = `CK`  // Composite ID with check digit
+	Table0440_CM  Table0440 = `CM`  // Composite
+	Table0440_CN  Table0440 = `CN`  // Composite ID number and name
+	Table0440_CNE Table0440 = `CNE` // Coded with no exceptions
+	Table0440_CNN Table0440 = `CNN` // Composite ID number and name simplified
+	Table0440_CP  Table0440 = `CP`  // Composite price
+	Table0440_CQ  Table0440 = `CQ`  // Composite quantity with units
+	Table0440_CSU Table0440 = `CSU` // Channel sensitivity and units
+	Table0440_CWE Table0440 = `CWE` // Coded with exceptions
+	Table0440_CX  Table0440 = `CX`  // Extended composite ID with check digit
+	Table0440_DDI Table0440 = `DDI` // Daily deductible information
+	Table0440_DIN Table0440 = `DIN` // Date and institution name
+	Table0440_DLD Table0440 = `DLD` // Discharge to location and date
+	Table0440_DLN Table0440 = `DLN` // Driver's license number
+	Table0440_DLT Table0440 = `DLT` // Delta
+	Table0440_DR  Table0440 = `DR`  // Date/time range
+	Table0440_DT  Table0440 = `DT`  // Date
+	Table0440_DTM Table0440 = `DTM` // Date/time
+	Table0440_DTN Table0440 = `DTN` // Day type and number
+	Table0440_ED  Table0440 = `ED`  // Encapsulated data
+	Table0440_EI  Table0440 = `EI`  // Entity identifier
+	Table0440_EIP Table0440 = `EIP` // Entity identifier pair
+	Table0440_ELD Table0440 = `ELD` // Error location and description
+	Table0440_ERL Table0440 = `ERL` // Error location
+	Table0440_FC  Table0440 = `FC`  // Financial class
+	Table0440_FN  Table0440 = `FN`  // Family name
+	Table0440_FT  Table0440 = `FT`  // Formatted text
+	Table0440_GTS Table0440 = `GTS` // General timing specification
+	Table0440_HD  Table0440 = `HD`  // Hierarchic designator
+	Table0440_ICD Table0440 = `ICD` // Insurance certification definition
+	Table0440_ID  Table0440 = `ID`  // Coded values for HL7 tables
+	Table0440_IS  Table0440 = `IS`  // Coded value for user-defined tables
+	Table0440_JCC Table0440 = `JCC` // Job code/class
+	Table0440_LA1 Table0440 = `LA1` // Location with address variation 1
+	Table0440_LA2 Table0440 = `LA2` // Location with address variation 2
+	Table0440_MA  Table0440 = `MA`  // Multiplexed array
+	Table0440_MO  Table0440 = `MO`  // Money
+	Table0440_MOC Table0440 = `MOC` // Money and charge code
+	Table0440_MOP Table0440 = `MOP` // Money or percentage
+	Table0440_MSG Table0440 = `MSG` // Message type
+	Table0440_NA  Table0440 = `NA`  // Numeric array
+	Table0440_NDL Table0440 = `NDL` // Name with date and location
+	Table0440_NM  Table0440 = `NM`  // Numeric
+	Table0440_NR  Table0440 = `NR`  // Numeric range
+	Table0440_OCD Table0440 = `OCD` // Occurrence code and date
+	Table0440_OSD Table0440 = `OSD` // Order sequence definition
+	Table0440_OSP Table0440 = `OSP` // Occurrence span code and date
+	Table0440_PIP Table0440 = `PIP` // Practitioner institutional privileges
+	Table0440_PL  Table0440 = `PL`  // Person location
+	Table0440_PLN Table0440 = `PLN` // Practitioner license or other ID number
+	Table0440_PN  Table0440 = `PN`  // Person name
+	Table0440_PPN Table0440 = `PPN` // Performing person time stamp
+	Table0440_PRL Table0440 = `PRL` // Parent result link
+	Table0440_PT  Table0440 = `PT`  // Processing type
+	Table0440_PTA Table0440 = `PTA` // Policy type and amount
+	Table0440_QIP Table0440 = `QIP` // Query input parameter list
+	Table0440_QSC Table0440 = `QSC` // Query selection criteria
+	Table0440_RCD Table0440 = `RCD` // Row column definition
+	Table0440_RFR Table0440 = `RFR` // Reference range
+	Table0440_RI  Table0440 = `RI`  // Repeat interval
+	Table0440_RMC Table0440 = `RMC` // Room coverage
+	Table0440_RP  Table0440 = `RP`  // Reference pointer
+	Table0440_RPT Table0440 = `RPT` // Repeat pattern
+	Table0440_SAD Table0440 = `SAD` // Street Address
+	Table0440_SCV Table0440 = `SCV` // Scheduling class value pair
+	Table0440_SI  Table0440 = `SI`  // Sequence ID
+	Table0440_SN  Table0440 = `SN`  // Structured numeric
+	Table0440_SNM Table0440 = `SNM` // String of telephone number digits
+	Table0440_SPD Table0440 = `SPD` // Specialty description
+	Table0440_SPS Table0440 = `SPS` // Specimen source
+	Table0440_SRT Table0440 = `SRT` // Sort order
+	Table0440_ST  Table0440 = `ST`  // String data
+	Table0440_TM  Table0440 = `TM`  // Time
+	Table0440_TN  Table0440 = `TN`  // Telephone number
+	Table0440_TQ  Table0440 = `TQ`  // Timing/quantity
+	Table0440_TS  Table0440 = `TS`  // Time stamp
+	Table0440_TX  Table0440 = `TX`  // Text data
+	Table0440_UVC Table0440 = `UVC` // UB value code and amount
+	Table0440_VH  Table0440 = `VH`  // Visiting hours
+	Table0440_VID Table0440 = `VID` // Version identifier
+	Table0440_VR  Table0440 = `VR`  // Value range
+	Table0440_WVI Table0440 = `WVI` // Channel Identifier
+	Table0440_WVS Table0440 = `WVS` // Waveform source
+	Table0440_XAD Table0440 = `XAD` // Extended address
+	Table0440_XCN Table0440 = `XCN` // Extended composite ID number and name for persons
+	Table0440_XON Table0440 = `XON` // Extended composite name and ID number for organizations
+	Table0440_XPN Table0440 = `XPN` // Extended person name
+	Table0440_XTN Table0440 = `XTN` // Extended telecommunications number
+)
+
+// TableID returns the table ID.
+func (Table0440) TableID() string { return `0440` }
+
+// Valid reports if the value is in the table.
+func (v Table0440) Valid() bool { return TableValueLookup[`0440`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0440) Description() string { return tableDescription(`0440`, string(v)) }
+
+// Table0441 is a value of table 0441, Immunization Registry Status.
+type Table0441 string
+
+const (
+	Table0441_A Table0441 = `A` // Active
+	Table0441_I Table0441 = `I` // Inactive
+	Table0441_L Table0441 = `L` // Inactive - Lost to follow-up (cancel contract)
+	Table0441_M Table0441 = `M` // Inactive - Moved or gone elsewhere (cancel contract)
+	Table0441_O Table0441 = `O` // Other
+	Table0441_P Table0441 = `P` // Inactive - Permanently inactive (Do not reactivate or add new entries to the record)
+	Table0441_U Table0441 = `U` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0441) TableID() string { return `0441` }
+
+// Valid reports if the value is in the table.
+func (v Table0441) Valid() bool { return TableValueLookup[`0441`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0441) Description() string { return tableDescription(`0441`, string(v)) }
+
+// Table0442 is a value of table 0442, Location Service Code.
+type Table0442 string
+
+const (
+	Table0442_D Table0442 = `D` // Diagnostic
+	Table0442_E Table0442 = `E` // Emergency Room Casualty
+	Table0442_P Table0442 = `P` // Primary Care
+	Table0442_T Table0442 = `T` // Therapeutic
+)
+
+// TableID returns the table ID.
+func (Table0442) TableID() string { return `0442` }
+
+// Valid reports if the value is in the table.
+func (v Table0442) Valid() bool { return TableValueLookup[`0442`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0442) Description() string { return tableDescription(`0442`, string(v)) }
+
+// Table0443 is a value of table 0443, Provider Role.
+type Table0443 string
+
+const (
+	Table0443_AD   Table0443 = `AD`   // Admitting
+	Table0443_AI   Table0443 = `AI`   // Assistant/Alternate Interpreter
+	Table0443_AP   Table0443 = `AP`   // Administering Provider
+	Table0443_AT   Table0443 = `AT`   // Attending
+	Table0443_CLP  Table0443 = `CLP`  // Collecting Provider
+	Table0443_CP   Table0443 = `CP`   // Consulting Provider
+	Table0443_DP   Table0443 = `DP`   // Dispensing Provider
+	Table0443_EP   Table0443 = `EP`   // Entering Provider (probably not the same as transcriptionist?)
+	Table0443_FHCP Table0443 = `FHCP` // Family Health Care Professional
+	Table0443_IP   Table0443 = `IP`   // Initiating Provider (as in action by)
+	Table0443_MDIR Table0443 = `MDIR` // Medical Director
+	Table0443_OP   Table0443 = `OP`   // Ordering Provider
+	Table0443_PH   Table0443 = `PH`   // Pharmacist (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0443_PI   Table0443 = `PI`   // Primary Interpreter
+	Table0443_PP   Table0443 = `PP`   // Primary Care Provider
+	Table0443_RO   Table0443 = `RO`   // Responsible Observer
+	Table0443_RP   Table0443 = `RP`   // Referring Provider
+	Table0443_RT   Table0443 = `RT`   // Referred to Provider
+	Table0443_TN   Table0443 = `TN`   // Technician
+	Table0443_TR   Table0443 = `TR`   // Transcriptionist
+	Table0443_VP   Table0443 = `VP`   // Verifying Provider
+	Table0443_VPS  Table0443 = `VPS`  // Verifying Pharmaceutical Supplier (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0443_VTS  Table0443 = `VTS`  // Verifying Treatment Supplier (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+)
+
+// TableID returns the table ID.
+func (Table0443) TableID() string { return `0443` }
+
+// Valid reports if the value is in the table.
+func (v Table0443) Valid() bool { return TableValueLookup[`0443`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0443) Description() string { return tableDescription(`0443`, string(v)) }
+
+// Table0444 is a value of table 0444, Name Assembly Order.
+type Table0444 string
+
+const (
+	Table0444_F Table0444 = `F` // Prefix Family Middle Given Suffix
+	Table0444_G Table0444 = `G` // Prefix Given Middle Family Suffix
+)
+
+// TableID returns the table ID.
+func (Table0444) TableID() string { return `0444` }
+
+// Valid reports if the value is in the table.
+func (v Table0444) Valid() bool { return TableValueLookup[`0444`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0444) Description() string { return tableDescription(`0444`, string(v)) }
+
+// Table0445 is a value of table 0445, Identity Reliability Code.
+type Table0445 string
+
+const (
+	Table0445_AL Table0445 = `AL` // Patient/Person Name is an Alias
+	Table0445_UA Table0445 = `UA` // Unknown/Default Address
+	Table0445_UD Table0445 = `UD` // Unknown/Default Date of Birth
+	Table0445_US Table0445 = `US` // Unknown/Default Social Security Number
+)
+
+// TableID returns the table ID.
+func (Table0445) TableID() string { return `0445` }
+
+// Valid reports if the value is in the table.
+func (v Table0445) Valid() bool { return TableValueLookup[`0445`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0445) Description() string { return tableDescription(`0445`, string(v)) }
+
+// Table0446 is a value of table 0446, Species Code.
+type Table0446 string
+
+// TableID returns the table ID.
+func (Table0446) TableID() string { return `0446` }
+
+// Valid reports if the value is in the table.
+func (v Table0446) Valid() bool { return TableValueLookup[`0446`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0446) Description() string { return tableDescription(`0446`, string(v)) }
+
+// Table0447 is a value of table 0447, Breed Code.
+type Table0447 string
+
+// TableID returns the table ID.
+func (Table0447) TableID() string { return `0447` }
+
+// Valid reports if the value is in the table.
+func (v Table0447) Valid() bool { return TableValueLookup[`0447`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0447) Description() string { return tableDescription(`0447`, string(v)) }
+
+// Table0448 is a value of table 0448, Name Context.
+type Table0448 string
+
+// TableID returns the table ID.
+func (Table0448) TableID() string { return `0448` }
+
+// Valid reports if the value is in the table.
+func (v Table0448) Valid() bool { return TableValueLookup[`0448`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0448) Description() string { return tableDescription(`0448`, string(v)) }
+
+// Table0450 is a value of table 0450, Event Type.
+type Table0450 string
+
+const (
+	Table0450_LOG Table0450 = `LOG` // Log Event
+	Table0450_SER Table0450 = `SER` // Service Event
+)
+
+// TableID returns the table ID.
+func (Table0450) TableID() string { return `0450` }
+
+// Valid reports if the value is in the table.
+func (v Table0450) Valid() bool { return TableValueLookup[`0450`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0450) Description() string { return tableDescription(`0450`, string(v)) }
+
+// Table0451 is a value of table 0451, Substance Identifier.
+type Table0451 string
+
+const (
+	Table0451_ALL Table0451 = `ALL` // Used for query of all inventory items
+)
+
+// TableID returns the table ID.
+func (Table0451) TableID() string { return `0451` }
+
+// Valid reports if the value is in the table.
+func (v Table0451) Valid() bool { return TableValueLookup[`0451`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0451) Description() string { return tableDescription(`0451`, string(v)) }
+
+// Table0452 is a value of table 0452, Health Care Provider Type Code.
+type Table0452 string
+
+const (
+	Table0452_SUGGESTION Table0452 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 1 - Type
+)
+
+// TableID returns the table ID.
+func (Table0452) TableID() string { return `0452` }
+
+// Valid reports if the value is in the table.
+func (v Table0452) Valid() bool { return TableValueLookup[`0452`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0452) Description() string { return tableDescription(`0452`, string(v)) }
+
+// Table0453 is a value of table 0453, Health Care Provider Classification.
+type Table0453 string
+
+const (
+	Table0453_SUGGESTION Table0453 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 2 - Classification
+)
+
+// TableID returns the table ID.
+func (Table0453) TableID() string { return `0453` }
+
+// Valid reports if the value is in the table.
+func (v Table0453) Valid() bool { return TableValueLookup[`0453`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0453) Description() string { return tableDescription(`0453`, string(v)) }
+
+// Table0454 is a value of table 0454, Health Care Provider Area of Specialization.
+type Table0454 string
+
+const (
+	Table0454_SUGGESTION Table0454 = `SUGGESTION` // ANSI ASC X12 Health Care Provider Taxonomy, Level 3 - specialization
+)
+
+// TableID returns the table ID.
+func (Table0454) TableID() string { return `0454` }
+
+// Valid reports if the value is in the table.
+func (v Table0454) Valid() bool { return TableValueLookup[`0454`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0454) Description() string { return tableDescription(`0454`, string(v)) }
+
+// Table0455 is a value of table 0455, Type of Bill Code.
+type Table0455 string
+
+// TableID returns the table ID.
+func (Table0455) TableID() string { return `0455` }
+
+// Valid reports if the value is in the table.
+func (v Table0455) Valid() bool { return TableValueLookup[`0455`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0455) Description() string { return tableDescription(`0455`, string(v)) }
+
+// Table0456 is a value of table 0456, Revenue code.
+type Table0456 string
+
+// TableID returns the table ID.
+func (Table0456) TableID() string { return `0456` }
+
+// Valid reports if the value is in the table.
+func (v Table0456) Valid() bool { return TableValueLookup[`0456`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0456) Description() string { return tableDescription(`0456`, string(v)) }
+
+// Table0457 is a value of table 0457, Overall Claim Disposition Code.
+type Table0457 string
+
+const (
+	Table0457_0 Table0457 = `0` // No edits present on claim
+	Table0457_1 Table0457 = `1` // Only edits present are for line item denial or rejection
+	Table0457_2 Table0457 = `2` // Multiple-day claim with one or more days denied or rejected
+	Table0457_3 Table0457 = `3` // Claim denied, rejected, suspended or returned to provider with only post payment edits
+	Table0457_4 Table0457 = `4` // Claim denied, rejected, suspended or returned to provider with only pre payment edits
+)
+
+// TableID returns the table ID.
+func (Table0457) TableID() string { return `0457` }
+
+// Valid reports if the value is in the table.
+func (v Table0457) Valid() bool { return TableValueLookup[`0457`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0457) Description() string { return tableDescription(`0457`, string(v)) }
+
+// Table0458 is a value of table 0458, OCE Edit Code.
+type Table0458 string
+
+const (
+	Table0458_1   Table0458 = `1`   // Invalid diagnosis code
+	Table0458_10  Table0458 = `10`  // Non-covered service submitted for verification of denial (condition code 21 from header information on claim)
+	Table0458_11  Table0458 = `11`  // Non-covered service submitted for FI review (condition code 20 from header information on claim)
+	Table0458_12  Table0458 = `12`  // Questionable covered service
+	Table0458_13  Table0458 = `13`  // Additional payment for service not provided by Medicare
+	Table0458_14  Table0458 = `14`  // Code indicates a site of service not included in OPPS
+	Table0458_15  Table0458 = `15`  // Service unit out of range for procedure
+	Table0458_16  Table0458 = `16`  // Multiple bilateral procedures without modifier 50 (see Appendix A)
+	Table0458_17  Table0458 = `17`  // Multiple bilateral procedures with modifier 50 (see Appendix A)
+	Table0458_18  Table0458 = `18`  // Inpatient procedure
+	Table0458_19  Table0458 = `19`  // Mutually exclusive procedure that is not allowed even if appropriate modifier present
+	Table0458_2   Table0458 = `2`   // Diagnosis and age conflict
+	Table0458_20  Table0458 = `20`  // Component of a comprehensive procedure that is not allowed even if appropriate modifier present
+	Table0458_21  Table0458 = `21`  // Medical visit on same day as a type "T" or "S" procedure without modifier 25 (see Appendix B)
+	Table0458_22  Table0458 = `22`  // Invalid modifier
+	Table0458_23  Table0458 = `23`  // Invalid date
+	Table0458_24  Table0458 = `24`  // Date out of OCE range
+	Table0458_25  Table0458 = `25`  // Invalid age
+	Table0458_26  Table0458 = `26`  // Invalid sex
+	Table0458_27  Table0458 = `27`  // Only incidental services reported
+	Table0458_28  Table0458 = `28`  // Code not recognized by Medicare; alternate code for same service available
+	Table0458_29  Table0458 = `29`  // Partial hospitalization service for non-mental health diagnosis
+	Table0458_3   Table0458 = `3`   // Diagnosis and sex conflict
+	Table0458_30  Table0458 = `30`  // Insufficient services on day of partial hospitalization
+	Table0458_31  Table0458 = `31`  // Partial hospitalization on same day as ECT or type "T" procedure
+	Table0458_32  Table0458 = `32`  // Partial hospitalization claim spans 3 or less days with in-sufficient services, or ECT or significant procedure on at least one of the days
+	Table0458_33  Table0458 = `33`  // Partial hospitalization claim spans more than 3 days with insufficient number of days having mental health services
+	Table0458_34  Table0458 = `34`  // Partial hospitalization claim spans more than 3 days with insufficient number of days meeting partial hospitalization criteria
+	Table0458_35_ Table0458 = `35.` // Only activity therapy and/or occupational therapy services provided
+	Table0458_36_ Table0458 = `36.` // Extensive mental health services provided on day of ECT or significant procedure
+	Table0458_37  Table0458 = `37`  // Terminated bilateral procedure or terminated procedure with units greater than one
+	Table0458_38_ Table0458 = `38.` // Inconsistency between implanted device and implantation procedure
+	Table0458_39_ Table0458 = `39.` // Mutually exclusive procedure that would be allowed if appropriate modifier were present
+	Table0458_4   Table0458 = `4`   // Medicare secondary payer alert
+	Table0458_40_ Table0458 = `40.` // Component of a comprehensive procedure that would be allowed if appropriate modifier were present
+	Table0458_41_ Table0458 = `41.` // Invalid revenue code
+	Table0458_42_ Table0458 = `42.` // Multiple medical visits on same day with same revenue code without condition code G0 (see Appendix B)
+	Table0458_5   Table0458 = `5`   // E-code as reason for visit
+	Table0458_6   Table0458 = `6`   // Invalid procedure code
+	Table0458_7   Table0458 = `7`   // Procedure and age conflict
+	Table0458_8   Table0458 = `8`   // Procedure and sex conflict
+	Table0458_9   Table0458 = `9`   // Nov-covered service
+)
+
+// TableID returns the table ID.
+func (Table0458) TableID() string { return `0458` }
+
+// Valid reports if the value is in the table.
+func (v Table0458) Valid() bool { return TableValueLookup[`0458`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0458) Description() string { return tableDescription(`0458`, string(v)) }
+
+// Table0459 is a value of table 0459, Reimbursement Action Code.
+type Table0459 string
+
+const (
+	Table0459_0 Table0459 = `0` // OCE line item denial or rejection is not ignored
+	Table0459_1 Table0459 = `1` // OCE line item denial or rejection is ignored
+	Table0459_2 Table0459 = `2` // External line item denial. Line item is denied even if no OCE edits
+	Table0459_3 Table0459 = `3` // External line item rejection. Line item is rejected even if no OCE edits
+)
+
+// TableID returns the table ID.
+func (Table0459) TableID() string { return `0459` }
+
+// Valid reports if the value is in the table.
+func (v Table0459) Valid() bool { return TableValueLookup[`0459`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0459) Description() string { return tableDescription(`0459`, string(v)) }
+
+// Table0460 is a value of table 0460, Denial or Rejection Code.
+type Table0460 string
+
+const (
+	Table0460_0 Table0460 = `0` // Line item not denied or rejected
+	Table0460_1 Table0460 = `1` // Line item denied or rejected
+	Table0460_2 Table0460 = `2` // Line item is on a multiple-day claim. The line item is not denied or rejected, but occurs on a day that has been denied or rejected.
+)
+
+// TableID returns the table ID.
+func (Table0460) TableID() string { return `0460` }
+
+// Valid reports if the value is in the table.
+func (v Table0460) Valid() bool { return TableValueLookup[`0460`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0460) Description() string { return tableDescription(`0460`, string(v)) }
+
+// Table0461 is a value of table 0461, License Number.
+type Table0461 string
+
+// TableID returns the table ID.
+func (Table0461) TableID() string { return `0461` }
+
+// Valid reports if the value is in the table.
+func (v Table0461) Valid() bool { return TableValueLookup[`0461`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0461) Description() string { return tableDescription(`0461`, string(v)) }
+
+// Table0462 is a value of table 0462, Location Cost Center.
+type Table0462 string
+
+// TableID returns the table ID.
+func (Table0462) TableID() string { return `0462` }
+
+// Valid reports if the value is in the table.
+func (v Table0462) Valid() bool { return TableValueLookup[`0462`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0462) Description() string { return tableDescription(`0462`, string(v)) }
+
+// Table0463 is a value of table 0463, Inventory Number.
+type Table0463 string
+
+// TableID returns the table ID.
+func (Table0463) TableID() string { return `0463` }
+
+// Valid reports if the value is in the table.
+func (v Table0463) Valid() bool { return TableValueLookup[`0463`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0463) Description() string { return tableDescription(`0463`, string(v)) }
+
+// Table0464 is a value of table 0464, Facility ID.
+type Table0464 string
+
+// TableID returns the table ID.
+func (Table0464) TableID() string { return `0464` }
+
+// Valid reports if the value is in the table.
+func (v Table0464) Valid() bool { return TableValueLookup[`0464`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0464) Description() string { return tableDescription(`0464`, string(v)) }
+
+// Table0465 is a value of table 0465, Name/Address Representation.
+type Table0465 string
+
+const (
+	Table0465_A Table0465 = `A` // Alphabetic (i.e., Default or some single-byte)
+	Table0465_I Table0465 = `I` // Ideographic (i.e., Kanji)
+	Table0465_P Table0465 = `P` // Phonetic (i.e., ASCII, Katakana, Hiragana, etc.)
+)
+
+// TableID returns the table ID.
+func (Table0465) TableID() string { return `0465` }
+
+// Valid reports if the value is in the table.
+func (v Table0465) Valid() bool { return TableValueLookup[`0465`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0465) Description() string { return tableDescription(`0465`, string(v)) }
+
+// Table0466 is a value of table 0466, Ambulatory Payment Classification Code.
+type Table0466 string
+
+const (
+	Table0466_031 Table0466 = `031` // Dental procedures
+	Table0466_163 Table0466 = `163` // Excision/biopsy
+	Table0466_181 Table0466 = `181` // Level 1 skin repair.
+)
+
+// TableID returns the table ID.
+func (Table0466) TableID() string { return `0466` }
+
+// Valid reports if the value is in the table.
+func (v Table0466) Valid() bool { return TableValueLookup[`0466`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0466) Description() string { return tableDescription(`0466`, string(v)) }
+
+// Table0467 is a value of table 0467, Modifier Edit Code.
+type Table0467 string
+
+const (
+	Table0467_0 Table0467 = `0` // Modifier does NOT exist
+	Table0467_1 Table0467 = `1` // Modifier present, no errors
+	Table0467_2 Table0467 = `2` // Modifier invalid
+	Table0467_3 Table0467 = `3` // Modifier NOT approved for ASC/HOPD use
+	Table0467_4 Table0467 = `4` // Modifier approved for ASC/HOPD use, inappropriate for code
+	Table0467_U Table0467 = `U` // Modifier edit code unknown
+)
+
+// TableID returns the table ID.
+func (Table0467) TableID() string { return `0467` }
+
+// Valid reports if the value is in the table.
+func (v Table0467) Valid() bool { return TableValueLookup[`0467`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0467) Description() string { return tableDescription(`0467`, string(v)) }
+
+// Table0468 is a value of table 0468, Payment Adjustment Code.
+type Table0468 string
+
+const (
+	Table0468_1 Table0468 = `1` // No payment adjustment
+	Table0468_2 Table0468 = `2` // Designated current drug or biological payment adjustment applies to APC (status indicator G)
+	Table0468_3 Table0468 = `3` // Designated new device payment adjustment applies to APC (status indicator H)
+	Table0468_4 Table0468 = `4` // Designated new drug or new biological payment adjustment applies to APC (status indicator J)
+	Table0468_5 Table0468 = `5` // Deductible not applicable (specific list of HCPCS codes)
+)
+
+// TableID returns the table ID.
+func (Table0468) TableID() string { return `0468` }
+
+// Valid reports if the value is in the table.
+func (v Table0468) Valid() bool { return TableValueLookup[`0468`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0468) Description() string { return tableDescription(`0468`, string(v)) }
+
+// Table0469 is a value of table 0469, Packaging Status Code.
+type Table0469 string
+
+const (
+	Table0469_0 Table0469 = `0` // Not packaged
+	Table0469_1 Table0469 = `1` // Packaged service (status indicator N, or no HCPCS code and certain revenue codes)
+	Table0469_2 Table0469 = `2` // Packaged as part of partial hospitalization per diem or daily mental health service per diem
+)
+
+// TableID returns the table ID.
+func (Table0469) TableID() string { return `0469` }
+
+// Valid reports if the value is in the table.
+func (v Table0469) Valid() bool { return TableValueLookup[`0469`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0469) Description() string { return tableDescription(`0469`, string(v)) }
+
+// Table0470 is a value of table 0470, Reimbursement Type Code.
+type Table0470 string
+
+const (
+	Table0470_Crnl  Table0470 = `Crnl`  // Corneal Tissue APC
+	Table0470_DME   Table0470 = `DME`   // Durable Medical Equipment
+	Table0470_EPO   Table0470 = `EPO`   // Epotein
+	Table0470_Lab   Table0470 = `Lab`   // Clinical Laboratory APC
+	Table0470_Mamm  Table0470 = `Mamm`  // Screening Mammography APC
+	Table0470_NoPay Table0470 = `NoPay` // This APC is not paid
+	Table0470_OPPS  Table0470 = `OPPS`  // Outpatient Prospective Payment System
+	Table0470_PartH Table0470 = `PartH` // Partial Hospitalization APC
+	Table0470_Pckg  Table0470 = `Pckg`  // Packaged APC
+	Table0470_Thrpy Table0470 = `Thrpy` // Therapy APC
+)
+
+// TableID returns the table ID.
+func (Table0470) TableID() string { return `0470` }
+
+// Valid reports if the value is in the table.
+func (v Table0470) Valid() bool { return TableValueLookup[`0470`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0470) Description() string { return tableDescription(`0470`, string(v)) }
+
+// Table0471 is a value of table 0471, Query Name.
+type Table0471 string
+
+// TableID returns the table ID.
+func (Table0471) TableID() string { return `0471` }
+
+// Valid reports if the value is in the table.
+func (v Table0471) Valid() bool { return TableValueLookup[`0471`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0471) Description() string { return tableDescription(`0471`, string(v)) }
+
+// Table0472 is a value of table 0472, TQ Conjunction ID.
+type Table0472 string
+
+const (
+	Table0472_A Table0472 = `A` // Asynchronous
+	Table0472_C Table0472 = `C` // Actuation Time
+	Table0472_S Table0472 = `S` // Synchronous
+)
+
+// TableID returns the table ID.
+func (Table0472) TableID() string { return `0472` }
+
+// Valid reports if the value is in the table.
+func (v Table0472) Valid() bool { return TableValueLookup[`0472`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0472) Description() string { return tableDescription(`0472`, string(v)) }
+
+// Table0473 is a value of table 0473, Formulary Status.
+type Table0473 string
+
+const (
+	Table0473_G Table0473 = `G` // This observation/service is on the formulary, and has guidelines
+	Table0473_N Table0473 = `N` // This observation/service is not on the formulary
+	Table0473_R Table0473 = `R` // This observation/service is on the formulary, but is restricted
+	Table0473_Y Table0473 = `Y` // This observation/service is on the formulary
+)
+
+// TableID returns the table ID.
+func (Table0473) TableID() string { return `0473` }
+
+// Valid reports if the value is in the table.
+func (v Table0473) Valid() bool { return TableValueLookup[`0473`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0473) Description() string { return tableDescription(`0473`, string(v)) }
+
+// Table0474 is a value of table 0474, Organization Unit Type.
+type Table0474 string
+
+const (
+	Table0474_D Table0474 = `D` // Department
+	Table0474_F Table0474 = `F` // Facility
+	Table0474_S Table0474 = `S` // Subdivision
+	Table0474_U Table0474 = `U` // Subdepartment
+	Table0474_V Table0474 = `V` // Division
+)
+
+// TableID returns the table ID.
+func (Table0474) TableID() string { return `0474` }
+
+// Valid reports if the value is in the table.
+func (v Table0474) Valid() bool { return TableValueLookup[`0474`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0474) Description() string { return tableDescription(`0474`, string(v)) }
+
+// Table0475 is a value of table 0475, Charge Type Reason.
+type Table0475 string
+
+const (
+	Table0475_01 Table0475 = `01` // Allergy
+	Table0475_02 Table0475 = `02` // Intolerance
+	Table0475_03 Table0475 = `03` // Treatment Failure
+	Table0475_04 Table0475 = `04` // Patient Request
+	Table0475_05 Table0475 = `05` // No Exception
+)
+
+// TableID returns the table ID.
+func (Table0475) TableID() string { return `0475` }
+
+// Valid reports if the value is in the table.
+func (v Table0475) Valid() bool { return TableValueLookup[`0475`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0475) Description() string { return tableDescription(`0475`, string(v)) }
+
+// Table0476 is a value of table 0476, Medically Necessary Duplicate Procedure Reason.
+type Table0476 string
+
+// TableID returns the table ID.
+func (Table0476) TableID() string { return `0476` }
+
+// Valid reports if the value is in the table.
+func (v Table0476) Valid() bool { return TableValueLookup[`0476`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0476) Description() string { return tableDescription(`0476`, string(v)) }
+
+// Table0477 is a value of table 0477, Controlled Substance Schedule*.
+type Table0477 string
+
+const (
+	Table0477_I   Table0477 = `I`   // Schedule I
+	Table0477_II  Table0477 = `II`  // Schedule II
+	Table0477_III Table0477 = `III` // Schedule III
+	Table0477_IV  Table0477 = `IV`  // Schedule IV
+	Table0477_V   Table0477 = `V`   // Schedule V
+	Table0477_VI  Table0477 = `VI`  // Schedule VI
+)
+
+// TableID returns the table ID.
+func (Table0477) TableID() string { return `0477` }
+
+// Valid reports if the value is in the table.
+func (v Table0477) Valid() bool { return TableValueLookup[`0477`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0477) Description() string { return tableDescription(`0477`, string(v)) }
+
+// Table0478 is a value of table 0478, Formulary Status.
+type Table0478 string
+
+const (
+	Table0478_G Table0478 = `G` // Pharmaceutical substance is in the formulary, but guidelines apply
+	Table0478_N Table0478 = `N` // Pharmaceutical substance is NOT in the formulary
+	Table0478_R Table0478 = `R` // Pharmaceutical substance is in the formulary, but restrictions apply
+	Table0478_Y Table0478 = `Y` // Pharmaceutical substance is in the formulary
+)
+
+// TableID returns the table ID.
+func (Table0478) TableID() string { return `0478` }
+
+// Valid reports if the value is in the table.
+func (v Table0478) Valid() bool { return TableValueLookup[`0478`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0478) Description() string { return tableDescription(`0478`, string(v)) }
+
+// Table0480 is a value of table 0480, Pharmacy Order Types.
+type Table0480 string
+
+const (
+	Table0480_M Table0480 = `M` // Medication
+	Table0480_O Table0480 = `O` // Other solution as medication orders
+	Table0480_S Table0480 = `S` // IV Large Volume Solutions
+)
+
+// TableID returns the table ID.
+func (Table0480) TableID() string { return `0480` }
+
+// Valid reports if the value is in the table.
+func (v Table0480) Valid() bool { return TableValueLookup[`0480`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0480) Description() string { return tableDescription(`0480`, string(v)) }
+
+// Table0482 is a value of table 0482, Order Type.
+type Table0482 string
+
+const (
+	Table0482_I Table0482 = `I` // Inpatient Order
+	Table0482_O Table0482 = `O` // Outpatient Order
+)
+
+// TableID returns the table ID.
+func (Table0482) TableID() string { return `0482` }
+
+// Valid reports if the value is in the table.
+func (v Table0482) Valid() bool { return TableValueLookup[`0482`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0482) Description() string { return tableDescription(`0482`, string(v)) }
+
+// Table0483 is a value of table 0483, Authorization Mode.
+type Table0483 string
+
+const (
+	Table0483_EL Table0483 = `EL` // Electronic
+	Table0483_EM Table0483 = `EM` // E-mail
+	Table0483_FX Table0483 = `FX` // Fax
+	Table0483_IP Table0483 = `IP` // In Person
+	Table0483_MA Table0483 = `MA` // Mail
+	Table0483_PA Table0483 = `PA` // Paper
+	Table0483_PH Table0483 = `PH` // Phone
+	Table0483_RE Table0483 = `RE` // Reflexive (Automated system)
+	Table0483_VC Table0483 = `VC` // Video-conference
+	Table0483_VO Table0483 = `VO` // Voice
+)
+
+// TableID returns the table ID.
+func (Table0483) TableID() string { return `0483` }
+
+// Valid reports if the value is in the table.
+func (v Table0483) Valid() bool { return TableValueLookup[`0483`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0483) Description() string { return tableDescription(`0483`, string(v)) }
+
+// Table0484 is a value of table 0484, Dispense Type.
+type Table0484 string
+
+const (
+	Table0484_B Table0484 = `B` // Trial Quantity Balance
+	Table0484_C Table0484 = `C` // Compassionate Fill
+	Table0484_N Table0484 = `N` // New/Renew - Full Fill
+	Table0484_P Table0484 = `P` // New/Renew - Part Fill
+	Table0484_Q Table0484 = `Q` // Refill - Part Fill
+	Table0484_R Table0484 = `R` // Refill - Full Fill
+	Table0484_S Table0484 = `S` // Manufacturer Sample
+	Table0484_T Table0484 = `T` // Trial Quantity
+	Table0484_Z Table0484 = `Z` // Non-Prescription Fill
+)
+
+// TableID returns the table ID.
+func (Table0484) TableID() string { return `0484` }
+
+// Valid reports if the value is in the table.
+func (v Table0484) Valid() bool { return TableValueLookup[`0484`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0484) Description() string { return tableDescription(`0484`, string(v)) }
+
+// Table0485 is a value of table 0485, Extended Priority Codes.
+type Table0485 string
+
+const (
+	Table0485_A   Table0485 = `A`   // ASAP
+	Table0485_C   Table0485 = `C`   // Callback
+	Table0485_P   Table0485 = `P`   // Preop
+	Table0485_PRN Table0485 = `PRN` // As needed
+	Table0485_R   Table0485 = `R`   // Routine
+	Table0485_S   Table0485 = `S`   // Stat
+	Table0485_T   Table0485 = `T`   // Timing critical
+)
+
+// TableID returns the table ID.
+func (Table0485) TableID() string { return `0485` }
+
+// Valid reports if the value is in the table.
+func (v Table0485) Valid() bool { return TableValueLookup[`0485`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0485) Description() string { return tableDescription(`0485`, string(v)) }
+
+// Table0487 is a value of table 0487, Specimen Type.
+type Table0487 string
+
+const (
+	Table0487_ABS    Table0487 = `ABS`    // Abscess
+	Table0487_ACNE   Table0487 = `ACNE`   // Tissue, Acne
+	Table0487_ACNFLD Table0487 = `ACNFLD` // Fluid, Acne
+	Table0487_AIRS   Table0487 = `AIRS`   // Air Sample
+	Table0487_ALL    Table0487 = `ALL`    // Allograft
+	Table0487_AMP    Table0487 = `AMP`    // Amputation
+	Table0487_ANGI   Table0487 = `ANGI`   // Catheter Tip, Angio
+	Table0487_ARTC   Table0487 = `ARTC`   // Catheter Tip, Arterial
+	Table0487_ASERU  Table0487 = `ASERU`  // Serum, Acute
+	Table0487_ASP    Table0487 = `ASP`    // Aspirate
+	Table0487_ATTE   Table0487 = `ATTE`   // Environment, Attest
+	Table0487_AUTOA  Table0487 = `AUTOA`  // Environmental, Autoclave Ampule
+	Table0487_AUTOC  Table0487 = `AUTOC`  // Environmental, Autoclave Capsule
+	Table0487_AUTP   Table0487 = `AUTP`   // Autopsy
+	Table0487_BBL    Table0487 = `BBL`    // Blood bag
+	Table0487_BCYST  Table0487 = `BCYST`  // Cyst, Baker's
+	Table0487_BITE   Table0487 = `BITE`   // Bite
+	Table0487_BLEB   Table0487 = `BLEB`   // Bleb
+	Table0487_BLIST  Table0487 = `BLIST`  // Blister
+	Table0487_BOIL   Table0487 = `BOIL`   // Boil
+	Table0487_BON    Table0487 = `BON`    // Bone
+	Table0487_BOWL   Table0487 = `BOWL`   // Bowel contents
+	Table0487_BPU    Table0487 = `BPU`    // Blood product unit
+	Table0487_BRN    Table0487 = `BRN`    // Burn
+	Table0487_BRSH   Table0487 = `BRSH`   // Brush
+	Table0487_BRTH   Table0487 = `BRTH`   // Breath (use EXHLD)
+	Table0487_BRUS   Table0487 = `BRUS`   // Brushing
+	Table0487_BUB    Table0487 = `BUB`    // Bubo
+	Table0487_BULLA  Table0487 = `BULLA`  // Bulla/Bullae
+	Table0487_BX     Table0487 = `BX`     // Biopsy
+	Table0487_CALC   Table0487 = `CALC`   // Calculus (=Stone)
+	Table0487_CARBU  Table0487 = `CARBU`  // Carbuncle
+	Table0487_CAT    Table0487 = `CAT`    // Catheter
+	Table0487_CBITE  Table0487 = `CBITE`  // Bite, Cat
+	Table0487_CLIPP  Table0487 = `CLIPP`  // Clippings
+	Table0487_CNJT   Table0487 = `CNJT`   // Conjunctiva
+	Table0487_COL    Table0487 = `COL`    // Colostrum
+	Table0487_CONE   Table0487 = `CONE`   // Biospy, Cone
+	Table0487_CSCR   Table0487 = `CSCR`   // Scratch, Cat
+	Table0487_CSERU  Table0487 = `CSERU`  // Serum, Convalescent
+	Table0487_CSITE  Table0487 = `CSITE`  // Catheter Insertion Site
+	Table0487_CSMY   Table0487 = `CSMY`   // Fluid, Cystostomy Tube
+	Table0487_CST    Table0487 = `CST`    // Fluid, Cyst
+	Table0487_CSVR   Table0487 = `CSVR`   // Blood, Cell Saver
+	Table0487_CTP    Table0487 = `CTP`    // Catheter tip
+	Table0487_CVPS   Table0487 = `CVPS`   // Site, CVP
+	Table0487_CVPT   Table0487 = `CVPT`   // Catheter Tip, CVP
+	Table0487_CYN    Table0487 = `CYN`    // Nodule, Cystic
+	Table0487_CYST   Table0487 = `CYST`   // Cyst
+	Table0487_DBITE  Table0487 = `DBITE`  // Bite, Dog
+	Table0487_DCS    Table0487 = `DCS`    // Sputum, Deep Cough
+	Table0487_DEC    Table0487 = `DEC`    // Ulcer, Decubitus
+	Table0487_DEION  Table0487 = `DEION`  // Environmental, Water (Deionized)
+	Table0487_DIA    Table0487 = `DIA`    // Dialysate
+	Table0487_DISCHG Table0487 = `DISCHG` // Discharge
+	Table0487_DIV    Table0487 = `DIV`    // Diverticulum
+	Table0487_DRN    Table0487 = `DRN`    // Drain
+	Table0487_DRNG   Table0487 = `DRNG`   // Drainage, Tube
+	Table0487_DRNGP  Table0487 = `DRNGP`  // Drainage, Penrose
+	Table0487_EARW   Table0487 = `EARW`   // Ear wax (cerumen)
+	Table0487_EBRUSH Table0487 = `EBRUSH` // Brush, Esophageal
+	Table0487_EEYE   Table0487 = `EEYE`   // Environmental, Eye Wash
+	Table0487_EFF    Table0487 = `EFF`    // Environmental, Effluent
+	Table0487_EFFUS  Table0487 = `EFFUS`  // Effusion
+	Table0487_EFOD   Table0487 = `EFOD`   // Environmental, Food
+	Table0487_EISO   Table0487 = `EISO`   // Environmental, Isolette
+	Table0487_ELT    Table0487 = `ELT`    // Electrode
+	Table0487_ENVIR  Table0487 = `ENVIR`  // Environmental, Unidentified Substance
+	Table0487_EOTH   Table0487 = `EOTH`   // Environmental, Other Substance
+	Table0487_ESOI   Table0487 = `ESOI`   // Environmental, Soil
+	Table0487_ESOS   Table0487 = `ESOS`   // Environmental, Solution (Sterile)
+	Table0487_ETA    Table0487 = `ETA`    // Aspirate, Endotrach
+	Table0487_ETTP   Table0487 = `ETTP`   // Catheter Tip, Endotracheal
+	Table0487_ETTUB  Table0487 = `ETTUB`  // Tube, Endotracheal
+	Table0487_EWHI   Table0487 = `EWHI`   // Environmental, Whirlpool
+	Table0487_EXG    Table0487 = `EXG`    // Gas, exhaled (=breath)
+	Table0487_EXS    Table0487 = `EXS`    // Shunt, External
+	Table0487_EXUDTE Table0487 = `EXUDTE` // Exudate
+	Table0487_FAW    Table0487 = `FAW`    // Environmental, Water (Well)
+	Table0487_FBLOOD Table0487 = `FBLOOD` // Blood, Fetal
+	Table0487_FGA    Table0487 = `FGA`    // Fluid, Abdomen
+	Table0487_FIST   Table0487 = `FIST`   // Fistula
+	Table0487_FLD    Table0487 = `FLD`    // Fluid, Other
+	Table0487_FLT    Table0487 = `FLT`    // Filter
+	Table0487_FLU    Table0487 = `FLU`    // Fluid, Body unsp
+	Table0487_FLUID  Table0487 = `FLUID`  // Fluid
+	Table0487_FOLEY  Table0487 = `FOLEY`  // Catheter Tip, Foley
+	Table0487_FRS    Table0487 = `FRS`    // Fluid, Respiratory
+	Table0487_FSCLP  Table0487 = `FSCLP`  // Scalp, Fetal
+	Table0487_FUR    Table0487 = `FUR`    // Furuncle
+	Table0487_GAS    Table0487 = `GAS`    // Gas
+	Table0487_GASA   Table0487 = `GASA`   // Aspirate, Gastric
+	Table0487_GASAN  Table0487 = `GASAN`  // Antrum, Gastric
+	Table0487_GASBR  Table0487 = `GASBR`  // Brushing, Gastric
+	Table0487_GASD   Table0487 = `GASD`   // Drainage, Gastric
+	Table0487_GAST   Table0487 = `GAST`   // Fluid/contents, Gastric
+	Table0487_GENV   Table0487 = `GENV`   // Genital vaginal
+	Table0487_GRAFT  Table0487 = `GRAFT`  // Graft
+	Table0487_GRAFTS Table0487 = `GRAFTS` // Graft Site
+	Table0487_GRANU  Table0487 = `GRANU`  // Granuloma
+	Table0487_GROSH  Table0487 = `GROSH`  // Catheter, Groshong
+	Table0487_GSOL   Table0487 = `GSOL`   // Solution, Gastrostomy
+	Table0487_GSPEC  Table0487 = `GSPEC`  // Biopsy, Gastric
+	Table0487_GT     Table0487 = `GT`     // Tube, Gastric
+	Table0487_GTUBE  Table0487 = `GTUBE`  // Drainage Tube, Drainage (Gastrostomy)
+	Table0487_HBITE  Table0487 = `HBITE`  // Bite, Human
+	Table0487_HBLUD  Table0487 = `HBLUD`  // Blood, Autopsy
+	Table0487_HEMAQ  Table0487 = `HEMAQ`  // Catheter Tip, Hemaquit
+	Table0487_HEMO   Table0487 = `HEMO`   // Catheter Tip, Hemovac
+	Table0487_HERNI  Table0487 = `HERNI`  // Tissue, Herniated
+	Table0487_HEV    Table0487 = `HEV`    // Drain, Hemovac
+	Table0487_HIC    Table0487 = `HIC`    // Catheter, Hickman
+	Table0487_HYDC   Table0487 = `HYDC`   // Fluid, Hydrocele
+	Table0487_IBITE  Table0487 = `IBITE`  // Bite, Insect
+	Table0487_ICYST  Table0487 = `ICYST`  // Cyst, Inclusion
+	Table0487_IDC    Table0487 = `IDC`    // Catheter Tip, Indwelling
+	Table0487_IHG    Table0487 = `IHG`    // Gas, Inhaled
+	Table0487_ILEO   Table0487 = `ILEO`   // Drainage, Ileostomy
+	Table0487_ILLEG  Table0487 = `ILLEG`  // Source of Specimen Is Illegible
+	Table0487_IMP    Table0487 = `IMP`    // Implant
+	Table0487_INCI   Table0487 = `INCI`   // Site, Incision/Surgical
+	Table0487_INFIL  Table0487 = `INFIL`  // Infiltrate
+	Table0487_INS    Table0487 = `INS`    // Insect
+	Table0487_INTRD  Table0487 = `INTRD`  // Catheter Tip, Introducer
+	Table0487_IT     Table0487 = `IT`     // Intubation tube
+	Table0487_IUD    Table0487 = `IUD`    // Intrauterine Device
+	Table0487_IVCAT  Table0487 = `IVCAT`  // Catheter Tip, IV
+	Table0487_IVFLD  Table0487 = `IVFLD`  // Fluid, IV
+	Table0487_IVTIP  Table0487 = `IVTIP`  // Tubing Tip, IV
+	Table0487_JEJU   Table0487 = `JEJU`   // Drainage, Jejunal
+	Table0487_JNTFLD Table0487 = `JNTFLD` // Fluid, Joint
+	Table0487_JP     Table0487 = `JP`     // Drainage, Jackson Pratt
+	Table0487_KELOI  Table0487 = `KELOI`  // Lavage
+	Table0487_KIDFLD Table0487 = `KIDFLD` // Fluid, Kidney
+	Table0487_LAVG   Table0487 = `LAVG`   // Lavage, Bronhial
+	Table0487_LAVGG  Table0487 = `LAVGG`  // Lavage, Gastric
+	Table0487_LAVGP  Table0487 = `LAVGP`  // Lavage, Peritoneal
+	Table0487_LAVPG  Table0487 = `LAVPG`  // Lavage, Pre-Bronch
+	Table0487_LENS1  Table0487 = `LENS1`  // Contact Lens
+	Table0487_LENS2  Table0487 = `LENS2`  // Contact Lens Case
+	Table0487_LESN   Table0487 = `LESN`   // Lesion
+	Table0487_LIQ    Table0487 = `LIQ`    // Liquid, Unspecified
+	Table0487_LIQO   Table0487 = `LIQO`   // Liquid, Other
+	Table0487_LSAC   Table0487 = `LSAC`   // Fluid, Lumbar Sac
+	Table0487_MAHUR  Table0487 = `MAHUR`  // Catheter Tip, Makurkour
+	Table0487_MASS   Table0487 = `MASS`   // Mass
+	Table0487_MBLD   Table0487 = `MBLD`   // Blood, Menstrual
+	Table0487_MUCOS  Table0487 = `MUCOS`  // Mucosa
+	Table0487_MUCUS  Table0487 = `MUCUS`  // Mucus
+	Table0487_NASDR  Table0487 = `NASDR`  // Drainage, Nasal
+	Table0487_NEDL   Table0487 = `NEDL`   // Needle
+	Table0487_NEPH   Table0487 = `NEPH`   // Site, Nephrostomy
+	Table0487_NGASP  Table0487 = `NGASP`  // Aspirate, Nasogastric
+	Table0487_NGAST  Table0487 = `NGAST`  // Drainage, Nasogastric
+	Table0487_NGS    Table0487 = `NGS`    // Site, Naso/Gastric
+	Table0487_NODUL  Table0487 = `NODUL`  // Nodule(s)
+	Table0487_NSECR  Table0487 = `NSECR`  // Secretion, Nasal
+	Table0487_ORH    Table0487 = `ORH`    // Other
+	Table0487_ORL    Table0487 = `ORL`    // Lesion, Oral
+	Table0487_OTH    Table0487 = `OTH`    // Source, Other
+	Table0487_PACEM  Table0487 = `PACEM`  // Pacemaker
+	Table0487_PCFL   Table0487 = `PCFL`   // Fluid, Pericardial
+	Table0487_PDSIT  Table0487 = `PDSIT`  // Site, Peritoneal Dialysis
+	Table0487_PDTS   Table0487 = `PDTS`   // Site, Peritoneal Dialysis Tunnel
+	Table0487_PELVA  Table0487 = `PELVA`  // Abscess, Pelvic
+	Table0487_PENIL  Table0487 = `PENIL`  // Lesion, Penile
+	Table0487_PERIA  Table0487 = `PERIA`  // Abscess, Perianal
+	Table0487_PILOC  Table0487 = `PILOC`  // Cyst, Pilonidal
+	Table0487_PINS   Table0487 = `PINS`   // Site, Pin
+	Table0487_PIS    Table0487 = `PIS`    // Site, Pacemaker Insetion
+	Table0487_PLAN   Table0487 = `PLAN`   // Plant Material
+	Table0487_PLAS   Table0487 = `PLAS`   // Plasma
+	Table0487_PLB    Table0487 = `PLB`    // Plasma bag
+	Table0487_PLEVS  Table0487 = `PLEVS`  // Serum, Peak Level
+	Table0487_PND    Table0487 = `PND`    // Drainage, Penile
+	Table0487_POL    Table0487 = `POL`    // Polyps
+	Table0487_POPGS  Table0487 = `POPGS`  // Graft Site, Popliteal
+	Table0487_POPLG  Table0487 = `POPLG`  // Graft, Popliteal
+	Table0487_POPLV  Table0487 = `POPLV`  // Site, Popliteal Vein
+	Table0487_PORTA  Table0487 = `PORTA`  // Catheter, Porta
+	Table0487_PPP    Table0487 = `PPP`    // Plasma, Platelet poor
+	Table0487_PROST  Table0487 = `PROST`  // Prosthetic Device
+	Table0487_PRP    Table0487 = `PRP`    // Plasma, Platelet rich
+	Table0487_PSC    Table0487 = `PSC`    // Pseudocyst
+	Table0487_PUNCT  Table0487 = `PUNCT`  // Wound, Puncture
+	Table0487_PUS    Table0487 = `PUS`    // Pus
+	Table0487_PUSFR  Table0487 = `PUSFR`  // Pustule
+	Table0487_PUST   Table0487 = `PUST`   // Pus
+	Table0487_QC3    Table0487 = `QC3`    // Quality Control
+	Table0487_RANDU  Table0487 = `RANDU`  // Urine, Random
+	Table0487_RBITE  Table0487 = `RBITE`  // Bite, Reptile
+	Table0487_RECT   Table0487 = `RECT`   // Drainage, Rectal
+	Table0487_RECTA  Table0487 = `RECTA`  // Abscess, Rectal
+	Table0487_RENALC Table0487 = `RENALC` // Cyst, Renal
+	Table0487_RENC   Table0487 = `RENC`   // Fluid, Renal Cyst
+	Table0487_RES    Table0487 = `RES`    // Respiratory
+	Table0487_SAL    Table0487 = `SAL`    // Saliva
+	Table0487_SCAR   Table0487 = `SCAR`   // Tissue, Keloid (Scar)
+	Table0487_SCLV   Table0487 = `SCLV`   // Catheter Tip, Subclavian
+	Table0487_SCROA  Table0487 = `SCROA`  // Abscess, Scrotal
+	Table0487_SECRE  Table0487 = `SECRE`  // Secretion(s)
+	Table0487_SER    Table0487 = `SER`    // Serum
+	Table0487_SHU    Table0487 = `SHU`    // Site, Shunt
+	Table0487_SHUNF  Table0487 = `SHUNF`  // Fluid, Shunt
+	Table0487_SHUNT  Table0487 = `SHUNT`  // Shunt
+	Table0487_SITE   Table0487 = `SITE`   // Site
+	Table0487_SKBP   Table0487 = `SKBP`   // Biopsy, Skin
+	Table0487_SKN    Table0487 = `SKN`    // Skin
+	Table0487_SMM    Table0487 = `SMM`    // Mass, Sub-Mandibular
+	Table0487_SNV    Table0487 = `SNV`    // Fluid, synovial (Joint fluid)
+	Table0487_SPRM   Table0487 = `SPRM`   // Spermatozoa
+	Table0487_SPRP   Table0487 = `SPRP`   // Catheter Tip, Suprapubic
+	Table0487_SPRPB  Table0487 = `SPRPB`  // Cathether Tip, Suprapubic
+	Table0487_SPS    Table0487 = `SPS`    // Environmental, Spore Strip
+	Table0487_SPT    Table0487 = `SPT`    // Sputum
+	Table0487_SPTC   Table0487 = `SPTC`   // Sputum - coughed
+	Table0487_SPTT   Table0487 = `SPTT`   // Sputum - tracheal aspirate
+	Table0487_SPUT1  Table0487 = `SPUT1`  // Sputum, Simulated
+	Table0487_SPUTIN Table0487 = `SPUTIN` // Sputum, Inducted
+	Table0487_SPUTSP Table0487 = `SPUTSP` // Sputum, Spontaneous
+	Table0487_STER   Table0487 = `STER`   // Environmental, Sterrad
+	Table0487_STL    Table0487 = `STL`    // Stool = Fecal
+	Table0487_STONE  Table0487 = `STONE`  // Stone, Kidney
+	Table0487_SUBMA  Table0487 = `SUBMA`  // Abscess, Submandibular
+	Table0487_SUBMX  Table0487 = `SUBMX`  // Abscess, Submaxillary
+	Table0487_SUMP   Table0487 = `SUMP`   // Drainage, Sump
+	Table0487_SUP    Table0487 = `SUP`    // Suprapubic Tap
+	Table0487_SUTUR  Table0487 = `SUTUR`  // Suture
+	Table0487_SWGZ   Table0487 = `SWGZ`   // Catheter Tip, Swan Gantz
+	Table0487_TASP   Table0487 = `TASP`   // Aspirate, Tracheal
+	Table0487_TISS   Table0487 = `TISS`   // Tissue
+	Table0487_TISU   Table0487 = `TISU`   // Tissue ulcer
+	Table0487_TLC    Table0487 = `TLC`    // Cathether Tip, Triple Lumen
+	Table0487_TRAC   Table0487 = `TRAC`   // Site, Tracheostomy
+	Table0487_TRANS  Table0487 = `TRANS`  // Transudate
+	Table0487_TSERU  Table0487 = `TSERU`  // Serum, Trough
+	Table0487_TSTES  Table0487 = `TSTES`  // Abscess, Testicular
+	Table0487_TTRA   Table0487 = `TTRA`   // Aspirate, Transtracheal
+	Table0487_TUBES  Table0487 = `TUBES`  // Tubes
+	Table0487_TUMOR  Table0487 = `TUMOR`  // Tumor
+	Table0487_TZANC  Table0487 = `TZANC`  // Smear, Tzanck
+	Table0487_UDENT  Table0487 = `UDENT`  // Source, Unidentified
+	Table0487_UR     Table0487 = `UR`     // Urine
+	Table0487_URC    Table0487 = `URC`    // Urine clean catch
+	Table0487_URINB  Table0487 = `URINB`  // Urine, Bladder Washings
+	Table0487_URINC  Table0487 = `URINC`  // Urine, Catheterized
+	Table0487_URINM  Table0487 = `URINM`  // Urine, Midstream
+	Table0487_URINN  Table0487 = `URINN`  // Urine, Nephrostomy
+	Table0487_URINP  Table0487 = `URINP`  // Urine, Pedibag
+	Table0487_URT    Table0487 = `URT`    // Urine catheter
+	Table0487_USCOP  Table0487 = `USCOP`  // Urine, Cystoscopy
+	Table0487_USPEC  Table0487 = `USPEC`  // Source, Unspecified
+	Table0487_VASTIP Table0487 = `VASTIP` // Catheter Tip, Vas
+	Table0487_VENT   Table0487 = `VENT`   // Catheter Tip, Ventricular
+	Table0487_VITF   Table0487 = `VITF`   // Vitreous Fluid
+	Table0487_VOM    Table0487 = `VOM`    // Vomitus
+	Table0487_WASH   Table0487 = `WASH`   // Wash
+	Table0487_WASI   Table0487 = `WASI`   // Washing, e.g. bronchial washing
+	Table0487_WAT    Table0487 = `WAT`    // Water
+	Table0487_WB     Table0487 = `WB`     // Blood, Whole
+	Table0487_WEN    Table0487 = `WEN`    // Wen
+	Table0487_WICK   Table0487 = `WICK`   // Wick
+	Table0487_WND    Table0487 = `WND`    // Wound
+	Table0487_WNDA   Table0487 = `WNDA`   // Wound abscess
+	Table0487_WNDD   Table0487 = `WNDD`   // Wound drainage
+	Table0487_WNDE   Table0487 = `WNDE`   // Wound exudate
+	Table0487_WORM   Table0487 = `WORM`   // Worm
+	Table0487_WRT    Table0487 = `WRT`    // Wart
+	Table0487_WWA    Table0487 = `WWA`    // Environmental, Water
+	Table0487_WWO    Table0487 = `WWO`    // Environmental, Water (Ocean)
+	Table0487_WWT    Table0487 = `WWT`    // Environmental, Water (Tap)
+)
+
+// TableID returns the table ID.
+func (Table0487) TableID() string { return `0487` }
+
+// Valid reports if the value is in the table.
+func (v Table0487) Valid() bool { return TableValueLookup[`0487`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0487) Description() string { return tableDescription(`0487`, string(v)) }
+
+// Table0488 is a value of table 0488, Specimen Collection Method.
+type Table0488 string
+
+const (
+	Table0488_ANP    Table0488 = `ANP`    // Plates, Anaerobic
+	Table0488_BAP    Table0488 = `BAP`    // Plates, Blood Agar
+	Table0488_BCAE   Table0488 = `BCAE`   // Blood Culture, Aerobic Bottle
+	Table0488_BCAN   Table0488 = `BCAN`   // Blood Culture, Anaerobic Bottle
+	Table0488_BCPD   Table0488 = `BCPD`   // Blood Culture, Pediatric Bottle
+	Table0488_BIO    Table0488 = `BIO`    // Biopsy
+	Table0488_CAP    Table0488 = `CAP`    // Capillary Specimen
+	Table0488_CATH   Table0488 = `CATH`   // Catheterized
+	Table0488_CVP    Table0488 = `CVP`    // Line, CVP
+	Table0488_EPLA   Table0488 = `EPLA`   // Environmental, Plate
+	Table0488_ESWA   Table0488 = `ESWA`   // Environmental, Swab
+	Table0488_FNA    Table0488 = `FNA`    // Aspiration, Fine Needle
+	Table0488_KOFFP  Table0488 = `KOFFP`  // Plate, Cough
+	Table0488_LNA    Table0488 = `LNA`    // Line, Arterial
+	Table0488_LNV    Table0488 = `LNV`    // Line, Venous
+	Table0488_MARTL  Table0488 = `MARTL`  // Martin-Lewis Agar
+	Table0488_ML11   Table0488 = `ML11`   // Mod. Martin-Lewis Agar
+	Table0488_MLP    Table0488 = `MLP`    // Plate, Martin-Lewis
+	Table0488_NYP    Table0488 = `NYP`    // Plate, New York City
+	Table0488_PACE   Table0488 = `PACE`   // Pace, Gen-Probe
+	Table0488_PIN    Table0488 = `PIN`    // Pinworm Prep
+	Table0488_PNA    Table0488 = `PNA`    // Arterial puncture
+	Table0488_PRIME  Table0488 = `PRIME`  // Pump Prime
+	Table0488_PUMP   Table0488 = `PUMP`   // Pump Specimen
+	Table0488_QC5    Table0488 = `QC5`    // Quality Control For Micro
+	Table0488_SCLP   Table0488 = `SCLP`   // Scalp, Fetal Vein
+	Table0488_SCRAPS Table0488 = `SCRAPS` // Scrapings
+	Table0488_SHA    Table0488 = `SHA`    // Shaving
+	Table0488_SWA    Table0488 = `SWA`    // Swab
+	Table0488_SWD    Table0488 = `SWD`    // Swab, Dacron tipped
+	Table0488_TMAN   Table0488 = `TMAN`   // Transport Media, Anaerobic
+	Table0488_TMCH   Table0488 = `TMCH`   // Transport Media, Chalamydia
+	Table0488_TMM4   Table0488 = `TMM4`   // Transport Media, M4
+	Table0488_TMMY   Table0488 = `TMMY`   // Transport Media, Mycoplasma
+	Table0488_TMOT   Table0488 = `TMOT`   // Transport Media,
+	Table0488_TMP    Table0488 = `TMP`    // Plate, Thayer-Martin
+	Table0488_TMPV   Table0488 = `TMPV`   // Transport Media, PVA
+	Table0488_TMSC   Table0488 = `TMSC`   // Transport Media, Stool Culture
+	Table0488_TMUP   Table0488 = `TMUP`   // Transport Media, Ureaplasma
+	Table0488_TMVI   Table0488 = `TMVI`   // Transport Media, Viral
+	Table0488_VENIP  Table0488 = `VENIP`  // Venipuncture
+	Table0488_WOOD   Table0488 = `WOOD`   // Swab, Wooden Shaft
+)
+
+// TableID returns the table ID.
+func (Table0488) TableID() string { return `0488` }
+
+// Valid reports if the value is in the table.
+func (v Table0488) Valid() bool { return TableValueLookup[`0488`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0488) Description() string { return tableDescription(`0488`, string(v)) }
+
+// Table0489 is a value of table 0489, Risk Codes.
+type Table0489 string
+
+const (
+	Table0489_AGG Table0489 = `AGG` // Aggressive
+	Table0489_BHZ Table0489 = `BHZ` // Biohazard
+	Table0489_BIO Table0489 = `BIO` // Biological
+	Table0489_COR Table0489 = `COR` // Corrosive
+	Table0489_ESC Table0489 = `ESC` // Escape Risk
+	Table0489_EXP Table0489 = `EXP` // Explosive
+	Table0489_IFL Table0489 = `IFL` // MaterialDangerInflammable
+	Table0489_INF Table0489 = `INF` // MaterialDangerInfectious
+	Table0489_INJ Table0489 = `INJ` // Injury Hazard
+	Table0489_POI Table0489 = `POI` // Poison
+	Table0489_RAD Table0489 = `RAD` // Radioactive
+)
+
+// TableID returns the table ID.
+func (Table0489) TableID() string { return `0489` }
+
+// Valid reports if the value is in the table.
+func (v Table0489) Valid() bool { return TableValueLookup[`0489`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0489) Description() string { return tableDescription(`0489`, string(v)) }
+
+// Table0490 is a value of table 0490, Specimen Reject Reason.
+type Table0490 string
+
+const (
+	Table0490_EX Table0490 = `EX` // Expired
+	Table0490_QS Table0490 = `QS` // Quantity not sufficient
+	Table0490_RA Table0490 = `RA` // Missing patient ID number
+	Table0490_RB Table0490 = `RB` // Broken container
+	Table0490_RC Table0490 = `RC` // Clotting
+	Table0490_RD Table0490 = `RD` // Missing collection date
+	Table0490_RE Table0490 = `RE` // Missing patient name
+	Table0490_RH Table0490 = `RH` // Hemolysis
+	Table0490_RI Table0490 = `RI` // Identification problem
+	Table0490_RM Table0490 = `RM` // Labeling
+	Table0490_RN Table0490 = `RN` // Contamination
+	Table0490_RP Table0490 = `RP` // Missing phlebotomist ID
+	Table0490_RR Table0490 = `RR` // Improper storage
+	Table0490_RS Table0490 = `RS` // Name misspelling
+)
+
+// TableID returns the table ID.
+func (Table0490) TableID() string { return `0490` }
+
+// Valid reports if the value is in the table.
+func (v Table0490) Valid() bool { return TableValueLookup[`0490`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0490) Description() string { return tableDescription(`0490`, string(v)) }
+
+// Table0491 is a value of table 0491, Specimen Quality.
+type Table0491 string
+
+const (
+	Table0491_E Table0491 = `E` // Excellent
+	Table0491_F Table0491 = `F` // Fair
+	Table0491_G Table0491 = `G` // Good
+	Table0491_P Table0491 = `P` // Poor
+)
+
+// TableID returns the table ID.
+func (Table0491) TableID() string { return `0491` }
+
+// Valid reports if the value is in the table.
+func (v Table0491) Valid() bool { return TableValueLookup[`0491`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0491) Description() string { return tableDescription(`0491`, string(v)) }
+
+// Table0492 is a value of table 0492, Specimen Appropriateness.
+type Table0492 string
+
+const (
+	Table0492_A Table0492 = `A` // Appropriate
+	Table0492_I Table0492 = `I` // Inappropriate
+	Table0492_P Table0492 = `P` // Preferred
+)
+
+// TableID returns the table ID.
+func (Table0492) TableID() string { return `0492` }
+
+// Valid reports if the value is in the table.
+func (v Table0492) Valid() bool { return TableValueLookup[`0492`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0492) Description() string { return tableDescription(`0492`, string(v)) }
+
+// Table0493 is a value of table 0493, Specimen Condition.
+type Table0493 string
+
+const (
+	Table0493_AUT  Table0493 = `AUT`  // Autolyzed
+	Table0493_CLOT Table0493 = `CLOT` // Clotted
+	Table0493_CON  Table0493 = `CON`  // Contaminated
+	Table0493_COOL Table0493 = `COOL` // Cool
+	Table0493_FROZ Table0493 = `FROZ` // Frozen
+	Table0493_HEM  Table0493 = `HEM`  // Hemolyzed
+	Table0493_LIVE Table0493 = `LIVE` // Live
+	Table0493_ROOM Table0493 = `ROOM` // Room temperature
+	Table0493_SNR  Table0493 = `SNR`  // Sample not received
+)
+
+// TableID returns the table ID.
+func (Table0493) TableID() string { return `0493` }
+
+// Valid reports if the value is in the table.
+func (v Table0493) Valid() bool { return TableValueLookup[`0493`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0493) Description() string { return tableDescription(`0493`, string(v)) }
+
+// Table0494 is a value of table 0494, Specimen Child Role.
+type Table0494 string
+
+const (
+	Table0494_A Table0494 = `A` // Aliquot
+	Table0494_C Table0494 = `C` // Component
+	Table0494_M Table0494 = `M` // Modified from original specimen
+)
+
+// TableID returns the table ID.
+func (Table0494) TableID() string { return `0494` }
+
+// Valid reports if the value is in the table.
+func (v Table0494) Valid() bool { return TableValueLookup[`0494`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0494) Description() string { return tableDescription(`0494`, string(v)) }
+
+// Table0495 is a value of table 0495, Body Site Modifier.
+type Table0495 string
+
+const (
+	Table0495_ANT Table0495 = `ANT` // Anterior
+	Table0495_BIL Table0495 = `BIL` // Bilateral
+	Table0495_DIS Table0495 = `DIS` // Distal
+	Table0495_EXT Table0495 = `EXT` // External
+	Table0495_L   Table0495 = `L`   // Left
+	Table0495_LAT Table0495 = `LAT` // Lateral
+	Table0495_LLQ Table0495 = `LLQ` // Quadrant, Left Lower
+	Table0495_LOW Table0495 = `LOW` // Lower
+	Table0495_LUQ Table0495 = `LUQ` // Quadrant, Left Upper
+	Table0495_MED Table0495 = `MED` // Medial
+	Table0495_POS Table0495 = `POS` // Posterior
+	Table0495_PRO Table0495 = `PRO` // Proximal
+	Table0495_R   Table0495 = `R`   // Right
+	Table0495_RLQ Table0495 = `RLQ` // Quadrant, Right Lower
+	Table0495_RUQ Table0495 = `RUQ` // Quadrant, Right Upper
+	Table0495_UPP Table0495 = `UPP` // Upper
+)
+
+// TableID returns the table ID.
+func (Table0495) TableID() string { return `0495` }
+
+// Valid reports if the value is in the table.
+func (v Table0495) Valid() bool { return TableValueLookup[`0495`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0495) Description() string { return tableDescription(`0495`, string(v)) }
+
+// Table0496 is a value of table 0496, Consent Type.
+type Table0496 string
+
+const (
+	Table0496_001  Table0496 = `001`  // Release of Information/MR / Authorization to Disclosure Protected Health Information
+	Table0496_002  Table0496 = `002`  // Medical Procedure (invasive)
+	Table0496_003  Table0496 = `003`  // Acknowledge Receipt of Privacy Notice
+	Table0496_004  Table0496 = `004`  // Abortion
+	Table0496_005  Table0496 = `005`  // Abortion/Laminaria
+	Table0496_006  Table0496 = `006`  // Accutane - Information
+	Table0496_007  Table0496 = `007`  // Accutane - Woman
+	Table0496_008  Table0496 = `008`  // Advanced Beneficiary Notice
+	Table0496_009  Table0496 = `009`  // AFP (Alpha Fetoprotein) Screening
+	Table0496_010  Table0496 = `010`  // Amniocentesis (consent & refusal)
+	Table0496_011  Table0496 = `011`  // Anatomical Gift (organ donation)
+	Table0496_012  Table0496 = `012`  // Anesthesia - Complications
+	Table0496_013  Table0496 = `013`  // Anesthesia - Questionnaire
+	Table0496_014  Table0496 = `014`  // Angiogram
+	Table0496_015  Table0496 = `015`  // Angioplasty
+	Table0496_016  Table0496 = `016`  // Anticancer Drugs
+	Table0496_017  Table0496 = `017`  // Antipsychotic Medications
+	Table0496_018  Table0496 = `018`  // Arthrogram
+	Table0496_019  Table0496 = `019`  // Autopsy
+	Table0496_020  Table0496 = `020`  // AZT Therapy
+	Table0496_021  Table0496 = `021`  // Biliary Drainage
+	Table0496_022  Table0496 = `022`  // Biliary Stone Extraction
+	Table0496_023  Table0496 = `023`  // Biopsy
+	Table0496_024  Table0496 = `024`  // Bleeding Time Test
+	Table0496_025  Table0496 = `025`  // Bronchogram
+	Table0496_026  Table0496 = `026`  // Cardiac Catheterization
+	Table0496_027  Table0496 = `027`  // Coronary Angiography
+	Table0496_028  Table0496 = `028`  // "" "" w/o Surgery Capability
+	Table0496_029  Table0496 = `029`  // Cataract Op/Implant of FDA Aprvd Lens
+	Table0496_030  Table0496 = `030`  // Cataract Op/Implant of Investigational Lens
+	Table0496_031  Table0496 = `031`  // Cataract Surgery
+	Table0496_032  Table0496 = `032`  // Cholera Immunization
+	Table0496_033  Table0496 = `033`  // Cholesterol Screening
+	Table0496_034  Table0496 = `034`  // Circumcision - Newborn
+	Table0496_035  Table0496 = `035`  // Colonoscopy
+	Table0496_036  Table0496 = `036`  // Contact Lenses
+	Table0496_037  Table0496 = `037`  // CT Scan - Cervical & Lumbar
+	Table0496_038  Table0496 = `038`  // CT Scan w/ IV Contrast Media into Vein
+	Table0496_039  Table0496 = `039`  // CVS (Chorionic Villus) Sampling
+	Table0496_040  Table0496 = `040`  // Cystospy
+	Table0496_041  Table0496 = `041`  // Disclosure of Protected Health Information to Family/Friends
+	Table0496_042  Table0496 = `042`  // D & C and Conization
+	Table0496_043  Table0496 = `043`  // Dacryocystogram
+	Table0496_044  Table0496 = `044`  // Diagnostic Isotope
+	Table0496_045  Table0496 = `045`  // Drainage of an Abscess
+	Table0496_046  Table0496 = `046`  // Drug Screening
+	Table0496_047  Table0496 = `047`  // Electronic Monitoring of Labor - Refusal
+	Table0496_048  Table0496 = `048`  // Endometrial Biopsy
+	Table0496_049  Table0496 = `049`  // Endoscopy/Sclerosis of Esophageal Varices
+	Table0496_050  Table0496 = `050`  // ERCP
+	Table0496_051  Table0496 = `051`  // Exposure to reportable Communicable Disease
+	Table0496_052  Table0496 = `052`  // External Version
+	Table0496_053  Table0496 = `053`  // Fluorescein Angioscopy
+	Table0496_054  Table0496 = `054`  // Hepatitis B - Consent/Declination
+	Table0496_055  Table0496 = `055`  // Herniogram
+	Table0496_056  Table0496 = `056`  // HIV Test - Consent Refusal
+	Table0496_057  Table0496 = `057`  // HIV Test - Disclosure
+	Table0496_058  Table0496 = `058`  // HIV Test - Prenatal
+	Table0496_059  Table0496 = `059`  // Home IV Treatment Program
+	Table0496_060  Table0496 = `060`  // Home Parenteral Treatment Program
+	Table0496_061  Table0496 = `061`  // Hysterectomy
+	Table0496_062  Table0496 = `062`  // Hysterosalpingogram
+	Table0496_063  Table0496 = `063`  // Injection Slip/ Consent
+	Table0496_064  Table0496 = `064`  // Intrauterine Device
+	Table0496_065  Table0496 = `065`  // Intrauterine Device/Sterilization
+	Table0496_066  Table0496 = `066`  // Intravascular Infusion of Streptokinase/Urokinase
+	Table0496_067  Table0496 = `067`  // Intravenous Cholangiogram
+	Table0496_068  Table0496 = `068`  // Intravenous Digital Angiography
+	Table0496_069  Table0496 = `069`  // Iodine Administration
+	Table0496_070  Table0496 = `070`  // ISG
+	Table0496_071  Table0496 = `071`  // IVP
+	Table0496_072  Table0496 = `072`  // Laser Photocoagulation
+	Table0496_073  Table0496 = `073`  // Laser treatment
+	Table0496_074  Table0496 = `074`  // Lithium Carbonate
+	Table0496_075  Table0496 = `075`  // Liver Biopsy
+	Table0496_076  Table0496 = `076`  // Lumbar Puncture
+	Table0496_077  Table0496 = `077`  // Lymphangiogram
+	Table0496_078  Table0496 = `078`  // MAO Inhibitors
+	Table0496_079  Table0496 = `079`  // Med, Psych, and/or Drug/Alcohol
+	Table0496_080  Table0496 = `080`  // Medical Treatment - Refusal
+	Table0496_081  Table0496 = `081`  // Morning-after Pill
+	Table0496_082  Table0496 = `082`  // MRI - Adult
+	Table0496_083  Table0496 = `083`  // MRI - Pediatric
+	Table0496_084  Table0496 = `084`  // Myelogram
+	Table0496_085  Table0496 = `085`  // Needle Biopsy
+	Table0496_086  Table0496 = `086`  // Needle Biopsy of Lung
+	Table0496_087  Table0496 = `087`  // Newborn Treatment and Release
+	Table0496_088  Table0496 = `088`  // Norplant Subdermal Birth Control Implant
+	Table0496_089  Table0496 = `089`  // Operations, Anesthesia, Transfusions
+	Table0496_090  Table0496 = `090`  // Oral Contraceptives
+	Table0496_091  Table0496 = `091`  // Organ Donation
+	Table0496_092  Table0496 = `092`  // Patient Permits, Consents
+	Table0496_093  Table0496 = `093`  // Patient Treatment Permit, Release & Admission
+	Table0496_094  Table0496 = `094`  // Penile Injections
+	Table0496_095  Table0496 = `095`  // Percutaneous Nephrostomy
+	Table0496_096  Table0496 = `096`  // Percutaneous Transhepatic Cholangiogram
+	Table0496_097  Table0496 = `097`  // Photographs
+	Table0496_098  Table0496 = `098`  // Photographs - Employee
+	Table0496_099  Table0496 = `099`  // Photographs - Medical Research
+	Table0496_100  Table0496 = `100`  // Photographs - news Media
+	Table0496_101  Table0496 = `101`  // Psychiatric Admission - Next of Kin
+	Table0496_102  Table0496 = `102`  // Psychiatric Information During Hospital Stay
+	Table0496_103  Table0496 = `103`  // Public Release of Information
+	Table0496_104  Table0496 = `104`  // Radiologic Procedure
+	Table0496_105  Table0496 = `105`  // Refusal of Treatment
+	Table0496_106  Table0496 = `106`  // Release of Body
+	Table0496_107  Table0496 = `107`  // Release of Limb
+	Table0496_108  Table0496 = `108`  // Rh Immune Globulin
+	Table0496_109  Table0496 = `109`  // Rights of Medical Research Participants
+	Table0496_110  Table0496 = `110`  // Request to Restrict Access/Disclosure to Medical Record/Protected Health Information
+	Table0496_111  Table0496 = `111`  // Request for Remain Anonymous
+	Table0496_112  Table0496 = `112`  // Seat Belt Exemption
+	Table0496_113  Table0496 = `113`  // Sialogram
+	Table0496_1137 Table0496 = `1137` // Voiding Cystogram
+	Table0496_114  Table0496 = `114`  // Sigmoidoscopy
+	Table0496_115  Table0496 = `115`  // Sterilization - Anesthesia & Medical Services
+	Table0496_116  Table0496 = `116`  // Sterilization -Federally Funded
+	Table0496_117  Table0496 = `117`  // Sterilization - Female
+	Table0496_118  Table0496 = `118`  // Sterilization - Laparoscopy/Pomeroy
+	Table0496_119  Table0496 = `119`  // Sterilization - Non-Federally Funded
+	Table0496_120  Table0496 = `120`  // Sterilization - Secondary
+	Table0496_121  Table0496 = `121`  // Tranquilizers
+	Table0496_122  Table0496 = `122`  // Transfer - Acknowledgement
+	Table0496_123  Table0496 = `123`  // Transfer - Authorization
+	Table0496_124  Table0496 = `124`  // Transfer Certification - Physician
+	Table0496_125  Table0496 = `125`  // Transfer/Discharge Request
+	Table0496_126  Table0496 = `126`  // Transfer for Non-Medical Reasons
+	Table0496_127  Table0496 = `127`  // Transfer - Interfaculty Neonatal
+	Table0496_128  Table0496 = `128`  // Transfer Refusal
+	Table0496_129  Table0496 = `129`  // Transfer Refusal of Further Treatment
+	Table0496_130  Table0496 = `130`  // Treadmill & EKG
+	Table0496_131  Table0496 = `131`  // Treadmill, Thallium-201
+	Table0496_132  Table0496 = `132`  // Typhoid
+	Table0496_133  Table0496 = `133`  // Use of Investigational Device
+	Table0496_134  Table0496 = `134`  // Use of Investigational Drug
+	Table0496_135  Table0496 = `135`  // Venogram
+	Table0496_136  Table0496 = `136`  // Videotape
+)
+
+// TableID returns the table ID.
+func (Table0496) TableID() string { return `0496` }
+
+// Valid reports if the value is in the table.
+func (v Table0496) Valid() bool { return TableValueLookup[`0496`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0496) Description() string { return tableDescription(`0496`, string(v)) }
+
+// Table0497 is a value of table 0497, Consent Mode.
+type Table0497 string
+
+const (
+	Table0497_T Table0497 = `T` // Telephone
+	Table0497_V Table0497 = `V` // Verbal
+	Table0497_W Table0497 = `W` // Written
+)
+
+// TableID returns the table ID.
+func (Table0497) TableID() string { return `0497` }
+
+// Valid reports if the value is in the table.
+func (v Table0497) Valid() bool { return TableValueLookup[`0497`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0497) Description() string { return tableDescription(`0497`, string(v)) }
+
+// Table0498 is a value of table 0498, Consent Status.
+type Table0498 string
+
+const (
+	Table0498_A Table0498 = `A` // Active - Consent has been granted
+	Table0498_B Table0498 = `B` // Bypassed (Consent not sought)
+	Table0498_L Table0498 = `L` // Limited - Consent has been granted with limitations
+	Table0498_P Table0498 = `P` // Pending - Consent has not yet been sought
+	Table0498_R Table0498 = `R` // Refused - Consent has been refused
+	Table0498_X Table0498 = `X` // Rescinded - Consent was initially granted, but was subsequently revoked or ended.
+)
+
+// TableID returns the table ID.
+func (Table0498) TableID() string { return `0498` }
+
+// Valid reports if the value is in the table.
+func (v Table0498) Valid() bool { return TableValueLookup[`0498`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0498) Description() string { return tableDescription(`0498`, string(v)) }
+
+// Table0499 is a value of table 0499, Consent Bypass Reason.
+type Table0499 string
+
+const (
+	Table0499_E  Table0499 = `E`  // Emergency
+	Table0499_PJ Table0499 = `PJ` // Professional Judgment
+)
+
+// TableID returns the table ID.
+func (Table0499) TableID() string { return `0499` }
+
+// Valid reports if the value is in the table.
+func (v Table0499) Valid() bool { return TableValueLookup[`0499`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0499) Description() string { return tableDescription(`0499`, string(v)) }
+
+// Table0500 is a value of table 0500, Consent Disclosure Level.
+type Table0500 string
+
+const (
+	Table0500_F Table0500 = `F` // Full Disclosure
+	Table0500_N Table0500 = `N` // No Disclosure
+	Table0500_P Table0500 = `P` // Partial Disclosure
+)
+
+// TableID returns the table ID.
+func (Table0500) TableID() string { return `0500` }
+
+// Valid reports if the value is in the table.
+func (v Table0500) Valid() bool { return TableValueLookup[`0500`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0500) Description() string { return tableDescription(`0500`, string(v)) }
+
+// Table0501 is a value of table 0501, Consent Non-Disclosure Reason.
+type Table0501 string
+
+const (
+	Table0501_E  Table0501 = `E`  // Emergency
+	Table0501_PR Table0501 = `PR` // Patient Request
+	Table0501_RX Table0501 = `RX` // Rx Private
+)
+
+// TableID returns the table ID.
+func (Table0501) TableID() string { return `0501` }
+
+// Valid reports if the value is in the table.
+func (v Table0501) Valid() bool { return TableValueLookup[`0501`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0501) Description() string { return tableDescription(`0501`, string(v)) }
+
+// Table0502 is a value of table 0502, Non-Subject Consenter Reason.
+type Table0502 string
+
+const (
+	Table0502_LM  Table0502 = `LM`  // Legally mandated
+	Table0502_MIN Table0502 = `MIN` // Subject is a minor
+	Table0502_NC  Table0502 = `NC`  // Subject is not competent to consent
+)
+
+// TableID returns the table ID.
+func (Table0502) TableID() string { return `0502` }
+
+// Valid reports if the value is in the table.
+func (v Table0502) Valid() bool { return TableValueLookup[`0502`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0502) Description() string { return tableDescription(`0502`, string(v)) }
+
+// Table0503 is a value of table 0503, Sequence/Results Flag.
+type Table0503 string
+
+const (
+	Table0503_C Table0503 = `C` // Cyclical
+	Table0503_R Table0503 = `R` // Reserved for future use
+	Table0503_S Table0503 = `S` // Sequential
+)
+
+// TableID returns the table ID.
+func (Table0503) TableID() string { return `0503` }
+
+// Valid reports if the value is in the table.
+func (v Table0503) Valid() bool { return TableValueLookup[`0503`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0503) Description() string { return tableDescription(`0503`, string(v)) }
+
+// Table0504 is a value of table 0504, Sequence Condition Code.
+type Table0504 string
+
+const (
+	Table0504_EE Table0504 = `EE` // End related service request(s), end current service request.
+	Table0504_ES Table0504 = `ES` // End related service request(s), start current service request.
+	Table0504_SE Table0504 = `SE` // Start related service request(s), end current service request.
+	Table0504_SS Table0504 = `SS` // Start related service request(s), start current service request.
+)
+
+// TableID returns the table ID.
+func (Table0504) TableID() string { return `0504` }
+
+// Valid reports if the value is in the table.
+func (v Table0504) Valid() bool { return TableValueLookup[`0504`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0504) Description() string { return tableDescription(`0504`, string(v)) }
+
+// Table0505 is a value of table 0505, Cyclic Entry/Exit Indicator.
+type Table0505 string
+
+// TableID returns the table ID.
+func (Table0505) TableID() string { return `0505` }
+
+// Valid reports if the value is in the table.
+func (v Table0505) Valid() bool { return TableValueLookup[`0505`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0505) Description() string { return tableDescription(`0505`, string(v)) }
+
+// Table0506 is a value of table 0506, Service Request Relationship.
+type Table0506 string
+
+const (
+	Table0506_C Table0506 = `C` // Compound
+	Table0506_E Table0506 = `E` // Exclusive
+	Table0506_N Table0506 = `N` // Nurse prerogative
+	Table0506_S Table0506 = `S` // Simultaneous
+	Table0506_T Table0506 = `T` // Tapering
+)
+
+// TableID returns the table ID.
+func (Table0506) TableID() string { return `0506` }
+
+// Valid reports if the value is in the table.
+func (v Table0506) Valid() bool { return TableValueLookup[`0506`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0506) Description() string { return tableDescription(`0506`, string(v)) }
+
+// Table0507 is a value of table 0507, Observation Result Handling.
+type Table0507 string
+
+const (
+	Table0507_A   Table0507 = `A`   // Alert provider when abnormal
+	Table0507_BCC Table0507 = `BCC` // Blind Copy
+	Table0507_CC  Table0507 = `CC`  // Copies Requested
+	Table0507_F   Table0507 = `F`   // Film-with-patient
+	Table0507_N   Table0507 = `N`   // Notify provider when ready
+)
+
+// TableID returns the table ID.
+func (Table0507) TableID() string { return `0507` }
+
+// Valid reports if the value is in the table.
+func (v Table0507) Valid() bool { return TableValueLookup[`0507`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0507) Description() string { return tableDescription(`0507`, string(v)) }
+
+// Table0508 is a value of table 0508, Blood Product Processing Requirements.
+type Table0508 string
+
+const (
+	Table0508_AU Table0508 = `AU` // Autologous Unit
+	Table0508_CM Table0508 = `CM` // CMV Negative
+	Table0508_CS Table0508 = `CS` // CMV Safe
+	Table0508_DI Table0508 = `DI` // Directed Unit
+	Table0508_FR Table0508 = `FR` // Fresh unit
+	Table0508_HB Table0508 = `HB` // Hemoglobin S Negative
+	Table0508_HL Table0508 = `HL` // HLA Matched
+	Table0508_IG Table0508 = `IG` // IgA Deficient
+	Table0508_IR Table0508 = `IR` // Irradiated
+	Table0508_LR Table0508 = `LR` // Leukoreduced
+	Table0508_WA Table0508 = `WA` // Washed
+)
+
+// TableID returns the table ID.
+func (Table0508) TableID() string { return `0508` }
+
+// Valid reports if the value is in the table.
+func (v Table0508) Valid() bool { return TableValueLookup[`0508`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0508) Description() string { return tableDescription(`0508`, string(v)) }
+
+// Table0509 is a value of table 0509, Indication for Use.
+type Table0509 string
+
+// TableID returns the table ID.
+func (Table0509) TableID() string { return `0509` }
+
+// Valid reports if the value is in the table.
+func (v Table0509) Valid() bool { return TableValueLookup[`0509`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0509) Description() string { return tableDescription(`0509`, string(v)) }
+
+// Table0510 is a value of table 0510, Blood Product Dispense Status.
+type Table0510 string
+
+const (
+	Table0510_CR Table0510 = `CR` // Released into inventory for general availability
+	Table0510_DS Table0510 = `DS` // Dispensed to patient location
+	Table0510_PT Table0510 = `PT` // Presumed transfused (dispensed and not returned)
+	Table0510_RA Table0510 = `RA` // Returned unused/no longer needed
+	Table0510_RD Table0510 = `RD` // Reserved and ready to dispense
+	Table0510_RE Table0510 = `RE` // Released (no longer allocated for the patient)
+	Table0510_RI Table0510 = `RI` // Received into inventory (for specified patient)
+	Table0510_RL Table0510 = `RL` // Returned unused/keep linked to patient for possible use later
+	Table0510_RQ Table0510 = `RQ` // Request to dispense blood product
+	Table0510_RS Table0510 = `RS` // Reserved (ordered and product allocated for the patient)
+	Table0510_WA Table0510 = `WA` // Wasted (product no longer viable)
+)
+
+// TableID returns the table ID.
+func (Table0510) TableID() string { return `0510` }
+
+// Valid reports if the value is in the table.
+func (v Table0510) Valid() bool { return TableValueLookup[`0510`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0510) Description() string { return tableDescription(`0510`, string(v)) }
+
+// Table0511 is a value of table 0511, BP Observation Status Codes Interpretation.
+type Table0511 string
+
+const (
+	Table0511_C Table0511 = `C` // Record coming over is a correction and thus replaces a final status
+	Table0511_D Table0511 = `D` // Deletes the BPX record
+	Table0511_F Table0511 = `F` // Final status; Can only be changed with a corrected status
+	Table0511_O Table0511 = `O` // Order detail description only (no status)
+	Table0511_P Table0511 = `P` // Preliminary status
+	Table0511_W Table0511 = `W` // Post original as wrong, e.g., transmitted for wrong patient
+)
+
+// TableID returns the table ID.
+func (Table0511) TableID() string { return `0511` }
+
+// Valid reports if the value is in the table.
+func (v Table0511) Valid() bool { return TableValueLookup[`0511`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0511) Description() string { return tableDescription(`0511`, string(v)) }
+
+// Table0512 is a value of table 0512, Commercial Product.
+type Table0512 string
+
+// TableID returns the table ID.
+func (Table0512) TableID() string { return `0512` }
+
+// Valid reports if the value is in the table.
+func (v Table0512) Valid() bool { return TableValueLookup[`0512`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0512) Description() string { return tableDescription(`0512`, string(v)) }
+
+// Table0513 is a value of table 0513, Blood Product Transfusion/Disposition Status.
+type Table0513 string
+
+const (
+	Table0513_RA Table0513 = `RA` // Returned unused/no longer needed
+	Table0513_RL Table0513 = `RL` // Returned unused/keep linked to patient for possible use later
+	Table0513_TR Table0513 = `TR` // Transfused with adverse reaction
+	Table0513_TX Table0513 = `TX` // Transfused
+	Table0513_WA Table0513 = `WA` // Wasted (product no longer viable)
+)
+
+// TableID returns the table ID.
+func (Table0513) TableID() string { return `0513` }
+
+// Valid reports if the value is in the table.
+func (v Table0513) Valid() bool { return TableValueLookup[`0513`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0513) Description() string { return tableDescription(`0513`, string(v)) }
+
+// Table0514 is a value of table 0514, Transfusion Adverse Reaction.
+type Table0514 string
+
+const (
+	Table0514_ABOINC     Table0514 = `ABOINC`     // ABO Incompatible Transfusion Reaction
+	Table0514_ACUTHEHTR  Table0514 = `ACUTHEHTR`  // Acute Hemolytic Transfusion Reaction
+	Table0514_ALLERGIC1  Table0514 = `ALLERGIC1`  // Allergic Reaction - First
+	Table0514_ALLERGIC2  Table0514 = `ALLERGIC2`  // Allergic Reaction - Recurrent
+	Table0514_ALLERGICR  Table0514 = `ALLERGICR`  // Allergic Reaction - Repeating
+	Table0514_ANAPHYLAC  Table0514 = `ANAPHYLAC`  // Anaphylactic Reaction
+	Table0514_BACTCONTAM Table0514 = `BACTCONTAM` // Reaction to Bacterial Contamination
+	Table0514_DELAYEDHTR Table0514 = `DELAYEDHTR` // Delayed Hemolytic Transfusion Reaction
+	Table0514_DELAYEDSTR Table0514 = `DELAYEDSTR` // Delayed Serological Transfusion Reaction
+	Table0514_GVHD       Table0514 = `GVHD`       // Graft vs Host Disease - Transfusion - Associated
+	Table0514_HYPOTENS   Table0514 = `HYPOTENS`   // Non-hemolytic Hypotensive Reaction
+	Table0514_NONHTR1    Table0514 = `NONHTR1`    // Non-Hemolytic Fever Chill Transfusion Reaction - First
+	Table0514_NONHTR2    Table0514 = `NONHTR2`    // Non-Hemolytic Fever Chill Transfusion Reaction - Recurrent
+	Table0514_NONHTRREC  Table0514 = `NONHTRREC`  // Non-Hemolytic Fever Chill Transfusion Reaction - Repeating
+	Table0514_NONIMMUNE  Table0514 = `NONIMMUNE`  // Non-Immune Hemolysis
+	Table0514_NONSPEC    Table0514 = `NONSPEC`    // Non-Specific, Non-Hemolytic Transfusion Reaction
+	Table0514_NORXN      Table0514 = `NORXN`      // No Evidence of Transfusion Reaction
+	Table0514_PTP        Table0514 = `PTP`        // Posttransfusion Purpura
+	Table0514_VOLOVER    Table0514 = `VOLOVER`    // Symptoms most likely due to volume overload
+)
+
+// TableID returns the table ID.
+func (Table0514) TableID() string { return `0514` }
+
+// Valid reports if the value is in the table.
+func (v Table0514) Valid() bool { return TableValueLookup[`0514`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0514) Description() string { return tableDescription(`0514`, string(v)) }
+
+// Table0515 is a value of table 0515, Transfusion Interrupted Reason.
+type Table0515 string
+
+// TableID returns the table ID.
+func (Table0515) TableID() string { return `0515` }
+
+// Valid reports if the value is in the table.
+func (v Table0515) Valid() bool { return TableValueLookup[`0515`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0515) Description() string { return tableDescription(`0515`, string(v)) }
+
+// Table0516 is a value of table 0516, Error Severity.
+type Table0516 string
+
+const (
+	Table0516_E Table0516 = `E` // Error
+	Table0516_F Table0516 = `F` // Fatal Error
+	Table0516_I Table0516 = `I` // Information
+	Table0516_W Table0516 = `W` // Warning
+)
+
+// TableID returns the table ID.
+func (Table0516) TableID() string { return `0516` }
+
+// Valid reports if the value is in the table.
+func (v Table0516) Valid() bool { return TableValueLookup[`0516`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0516) Description() string { return tableDescription(`0516`, string(v)) }
+
+// Table0517 is a value of table 0517, Inform Person Code.
+type Table0517 string
+
+const (
+	Table0517_HD   Table0517 = `HD`   // Inform help desk
+	Table0517_NPAT Table0517 = `NPAT` // Do NOT inform patient
+	Table0517_PAT  Table0517 = `PAT`  // Inform patient
+	Table0517_USR  Table0517 = `USR`  // Inform User
+)
+
+// TableID returns the table ID.
+func (Table0517) TableID() string { return `0517` }
+
+// Valid reports if the value is in the table.
+func (v Table0517) Valid() bool { return TableValueLookup[`0517`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0517) Description() string { return tableDescription(`0517`, string(v)) }
+
+// Table0518 is a value of table 0518, Override Type.
+type Table0518 string
+
+const (
+	Table0518_EQV  Table0518 = `EQV`  // Equivalence Override
+	Table0518_EXTN Table0518 = `EXTN` // Extension Override
+	Table0518_INLV Table0518 = `INLV` // Interval Override
+)
+
+// TableID returns the table ID.
+func (Table0518) TableID() string { return `0518` }
+
+// Valid reports if the value is in the table.
+func (v Table0518) Valid() bool { return TableValueLookup[`0518`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0518) Description() string { return tableDescription(`0518`, string(v)) }
+
+// Table0519 is a value of table 0519, Override Reason.
+type Table0519 string
+
+// TableID returns the table ID.
+func (Table0519) TableID() string { return `0519` }
+
+// Valid reports if the value is in the table.
+func (v Table0519) Valid() bool { return TableValueLookup[`0519`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0519) Description() string { return tableDescription(`0519`, string(v)) }
+
+// Table0520 is a value of table 0520, Message Waiting Priority.
+type Table0520 string
+
+const (
+	Table0520_H Table0520 = `H` // High
+	Table0520_L Table0520 = `L` // Low
+	Table0520_M Table0520 = `M` // Medium
+)
+
+// TableID returns the table ID.
+func (Table0520) TableID() string { return `0520` }
+
+// Valid reports if the value is in the table.
+func (v Table0520) Valid() bool { return TableValueLookup[`0520`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0520) Description() string { return tableDescription(`0520`, string(v)) }
+
+// Table0521 is a value of table 0521, Override Code.
+type Table0521 string
+
+// TableID returns the table ID.
+func (Table0521) TableID() string { return `0521` }
+
+// Valid reports if the value is in the table.
+func (v Table0521) Valid() bool { return TableValueLookup[`0521`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0521) Description() string { return tableDescription(`0521`, string(v)) }
+
+// Table0523 is a value of table 0523, Computation Type.
+type Table0523 string
+
+const (
+	Table0523_a Table0523 = `a` // Absolute Change
+)
+
+// TableID returns the table ID.
+func (Table0523) TableID() string { return `0523` }
+
+// Valid reports if the value is in the table.
+func (v Table0523) Valid() bool { return TableValueLookup[`0523`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0523) Description() string { return tableDescription(`0523`, string(v)) }
+
+// Table0525 is a value of table 0525, Privilege.
+type Table0525 string
+
+// TableID returns the table ID.
+func (Table0525) TableID() string { return `0525` }
+
+// Valid reports if the value is in the table.
+func (v Table0525) Valid() bool { return TableValueLookup[`0525`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0525) Description() string { return tableDescription(`0525`, string(v)) }
+
+// Table0526 is a value of table 0526, Privilege Class.
+type Table0526 string
+
+// TableID returns the table ID.
+func (Table0526) TableID() string { return `0526` }
+
+// Valid reports if the value is in the table.
+func (v Table0526) Valid() bool { return TableValueLookup[`0526`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0526) Description() string { return tableDescription(`0526`, string(v)) }
+
+// Table0527 is a value of table 0527, Calendar Alignment.
+type Table0527 string
+
+const (
+	Table0527_DM Table0527 = `DM` // day of the month
+	Table0527_DW Table0527 = `DW` // day of the week (begins with Monday)
+	Table0527_DY Table0527 = `DY` // day of the year
+	Table0527_HD Table0527 = `HD` // hour of the day
+	Table0527_MY Table0527 = `MY` // month of the year
+	Table0527_NH Table0527 = `NH` // minute of the hour
+	Table0527_SN Table0527 = `SN` // second of the minute
+	Table0527_WY Table0527 = `WY` // week of the year
+)
+
+// TableID returns the table ID.
+func (Table0527) TableID() string { return `0527` }
+
+// Valid reports if the value is in the table.
+func (v Table0527) Valid() bool { return TableValueLookup[`0527`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0527) Description() string { return tableDescription(`0527`, string(v)) }
+
+// Table0528 is a value of table 0528, Event Related Period.
+type Table0528 string
+
+const (
+	Table0528_AC  Table0528 = `AC`  // before meal (from lat. ante cibus)
+	Table0528_ACD Table0528 = `ACD` // before lunch (from lat. ante cibus diurnus)
+	Table0528_ACM Table0528 = `ACM` // before breakfast (from lat. ante cibus matutinus)
+	Table0528_ACV Table0528 = `ACV` // before dinner (from lat. ante cibus vespertinus)
+	Table0528_HS  Table0528 = `HS`  // the hour of sleep (e.g., H18-22)
+	Table0528_IC  Table0528 = `IC`  // between meals (from lat. inter cibus)
+	Table0528_ICD Table0528 = `ICD` // between lunch and dinner
+	Table0528_ICM Table0528 = `ICM` // between breakfast and lunch
+	Table0528_ICV Table0528 = `ICV` // between dinner and the hour of sleep
+	Table0528_PC  Table0528 = `PC`  // after meal (from lat. post cibus)
+	Table0528_PCD Table0528 = `PCD` // after lunch (from lat. post cibus diurnus)
+	Table0528_PCM Table0528 = `PCM` // after breakfast (from lat. post cibus matutinus)
+	Table0528_PCV Table0528 = `PCV` // after dinner (from lat. post cibus vespertinus)
+)
+
+// TableID returns the table ID.
+func (Table0528) TableID() string { return `0528` }
+
+// Valid reports if the value is in the table.
+func (v Table0528) Valid() bool { return TableValueLookup[`0528`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0528) Description() string { return tableDescription(`0528`, string(v)) }
+
+// Table0531 is a value of table 0531, Institution.
+type Table0531 string
+
+// TableID returns the table ID.
+func (Table0531) TableID() string { return `0531` }
+
+// Valid reports if the value is in the table.
+func (v Table0531) Valid() bool { return TableValueLookup[`0531`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0531) Description() string { return tableDescription(`0531`, string(v)) }
+
+// Table0532 is a value of table 0532, Expanded Yes/no Indicator.
+type Table0532 string
+
+const (
+	Table0532_ASKU Table0532 = `ASKU` // asked but unknown
+	Table0532_N    Table0532 = `N`    // No
+	Table0532_NA   Table0532 = `NA`   // not applicable
+	Table0532_NASK Table0532 = `NASK` // not asked
+	Table0532_NAV  Table0532 = `NAV`  // temporarily unavailable
+	Table0532_NI   Table0532 = `NI`   // No Information
+	Table0532_NP   Table0532 = `NP`   // not present
+	Table0532_UNK  Table0532 = `UNK`  // unknown
+	Table0532_Y    Table0532 = `Y`    // Yes
+)
+
+// TableID returns the table ID.
+func (Table0532) TableID() string { return `0532` }
+
+// Valid reports if the value is in the table.
+func (v Table0532) Valid() bool { return TableValueLookup[`0532`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0532) Description() string { return tableDescription(`0532`, string(v)) }
+
+// Table0533 is a value of table 0533, Application Error Code.
+type Table0533 string
+
+// TableID returns the table ID.
+func (Table0533) TableID() string { return `0533` }
+
+// Valid reports if the value is in the table.
+func (v Table0533) Valid() bool { return TableValueLookup[`0533`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0533) Description() string { return tableDescription(`0533`, string(v)) }
+
+// Table0534 is a value of table 0534, Notify Clergy Code.
+type Table0534 string
+
+const (
+	Table0534_L Table0534 = `L` // Last Rites only
+	Table0534_N Table0534 = `N` // No
+	Table0534_O Table0534 = `O` // Other
+	Table0534_U Table0534 = `U` // Unknown
+	Table0534_Y Table0534 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0534) TableID() string { return `0534` }
+
+// Valid reports if the value is in the table.
+func (v Table0534) Valid() bool { return TableValueLookup[`0534`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0534) Description() string { return tableDescription(`0534`, string(v)) }
+
+// Table0535 is a value of table 0535, Signature Code.
+type Table0535 string
+
+const (
+	Table0535_C Table0535 = `C` // Signed CMS-1500 claim form on file, e.g., authorization for release of any medical or other information necessary to process this claim and assignment of benefits.
+	Table0535_M Table0535 = `M` // Signed authorization for assignment of benefits on file.
+	Table0535_P Table0535 = `P` // Signature generated by provider because the patient was not physically present for services.
+	Table0535_S Table0535 = `S` // Signed authorization for release of any medical or other information necessary to process this claim on file.
+)
+
+// TableID returns the table ID.
+func (Table0535) TableID() string { return `0535` }
+
+// Valid reports if the value is in the table.
+func (v Table0535) Valid() bool { return TableValueLookup[`0535`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0535) Description() string { return tableDescription(`0535`, string(v)) }
+
+// Table0536 is a value of table 0536, Certificate Status.
+type Table0536 string
+
+const (
+	Table0536_E Table0536 = `E` // Expired
+	Table0536_I Table0536 = `I` // Inactive
+	Table0536_P Table0536 = `P` // Provisional
+	Table0536_R Table0536 = `R` // Revoked
+	Table0536_V Table0536 = `V` // Active/Valid
+)
+
+// TableID returns the table ID.
+func (Table0536) TableID() string { return `0536` }
+
+// Valid reports if the value is in the table.
+func (v Table0536) Valid() bool { return TableValueLookup[`0536`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0536) Description() string { return tableDescription(`0536`, string(v)) }
+
+// Table0537 is a value of table 0537, Institution.
+type Table0537 string
+
+// TableID returns the table ID.
+func (Table0537) TableID() string { return `0537` }
+
+// Valid reports if the value is in the table.
+func (v Table0537) Valid() bool { return TableValueLookup[`0537`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0537) Description() string { return tableDescription(`0537`, string(v)) }
+
+// Table0538 is a value of table 0538, Institution Relationship Type.
+type Table0538 string
+
+const (
+	Table0538_CON Table0538 = `CON` // Contractor
+	Table0538_CST Table0538 = `CST` // Consultant
+	Table0538_EMP Table0538 = `EMP` // Employee
+	Table0538_VOL Table0538 = `VOL` // Volunteer
+)
+
+// TableID returns the table ID.
+func (Table0538) TableID() string { return `0538` }
+
+// Valid reports if the value is in the table.
+func (v Table0538) Valid() bool { return TableValueLookup[`0538`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0538) Description() string { return tableDescription(`0538`, string(v)) }
+
+// Table0539 is a value of table 0539, Cost Center Code.
+type Table0539 string
+
+// TableID returns the table ID.
+func (Table0539) TableID() string { return `0539` }
+
+// Valid reports if the value is in the table.
+func (v Table0539) Valid() bool { return TableValueLookup[`0539`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0539) Description() string { return tableDescription(`0539`, string(v)) }
+
+// Table0540 is a value of table 0540, Inactive Reason Code.
+type Table0540 string
+
+const (
+	Table0540_L Table0540 = `L` // Leave of Absence
+	Table0540_R Table0540 = `R` // Retired
+	Table0540_T Table0540 = `T` // Termination
+)
+
+// TableID returns the table ID.
+func (Table0540) TableID() string { return `0540` }
+
+// Valid reports if the value is in the table.
+func (v Table0540) Valid() bool { return TableValueLookup[`0540`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0540) Description() string { return tableDescription(`0540`, string(v)) }
+
+// Table0541 is a value of table 0541, Specimen Type Modifier.
+type Table0541 string
+
+// TableID returns the table ID.
+func (Table0541) TableID() string { return `0541` }
+
+// Valid reports if the value is in the table.
+func (v Table0541) Valid() bool { return TableValueLookup[`0541`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0541) Description() string { return tableDescription(`0541`, string(v)) }
+
+// Table0542 is a value of table 0542, Specimen Source Type Modifier.
+type Table0542 string
+
+// TableID returns the table ID.
+func (Table0542) TableID() string { return `0542` }
+
+// Valid reports if the value is in the table.
+func (v Table0542) Valid() bool { return TableValueLookup[`0542`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0542) Description() string { return tableDescription(`0542`, string(v)) }
+
+// Table0543 is a value of table 0543, Specimen Collection Site.
+type Table0543 string
+
+// TableID returns the table ID.
+func (Table0543) TableID() string { return `0543` }
+
+// Valid reports if the value is in the table.
+func (v Table0543) Valid() bool { return TableValueLookup[`0543`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0543) Description() string { return tableDescription(`0543`, string(v)) }
+
+// Table0544 is a value of table 0544, Container Condition.
+type Table0544 string
+
+const (
+	Table0544_CC    Table0544 = `CC`    // Container Cracked
+	Table0544_CL    Table0544 = `CL`    // Container Leaking
+	Table0544_CT    Table0544 = `CT`    // Container Torn
+	Table0544_SB    Table0544 = `SB`    // Seal Broken
+	Table0544_XAMB  Table0544 = `XAMB`  // Not Ambient temperature
+	Table0544_XC37  Table0544 = `XC37`  // Not Body temperature
+	Table0544_XCAMB Table0544 = `XCAMB` // Not Critical ambient temperature
+	Table0544_XCATM Table0544 = `XCATM` // Exposed to Air
+	Table0544_XCFRZ Table0544 = `XCFRZ` // Not Critical frozen temperature
+	Table0544_XCREF Table0544 = `XCREF` // Not Critical refrigerated temperature
+	Table0544_XDFRZ Table0544 = `XDFRZ` // Not Deep frozen
+	Table0544_XDRY  Table0544 = `XDRY`  // Not Dry
+	Table0544_XFRZ  Table0544 = `XFRZ`  // Not Frozen temperature
+	Table0544_XMTLF Table0544 = `XMTLF` // Metal Exposed
+	Table0544_XNTR  Table0544 = `XNTR`  // Not Liquid nitrogen
+	Table0544_XPRTL Table0544 = `XPRTL` // Not Protected from light
+	Table0544_XPSA  Table0544 = `XPSA`  // Shaken
+	Table0544_XPSO  Table0544 = `XPSO`  // Exposed to shock
+	Table0544_XREF  Table0544 = `XREF`  // Not Refrigerated temperature
+	Table0544_XUFRZ Table0544 = `XUFRZ` // Not Ultra frozen
+	Table0544_XUPR  Table0544 = `XUPR`  // Not Upright
+)
+
+// TableID returns the table ID.
+func (Table0544) TableID() string { return `0544` }
+
+// Valid reports if the value is in the table.
+func (v Table0544) Valid() bool { return TableValueLookup[`0544`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0544) Description() string { return tableDescription(`0544`, string(v)) }
+
+// Table0547 is a value of table 0547, Jurisdictional Breadth.
+type Table0547 string
+
+const (
+	Table0547_C Table0547 = `C` // County/Parish
+	Table0547_N Table0547 = `N` // Country
+	Table0547_S Table0547 = `S` // State/Province
+)
+
+// TableID returns the table ID.
+func (Table0547) TableID() string { return `0547` }
+
+// Valid reports if the value is in the table.
+func (v Table0547) Valid() bool { return TableValueLookup[`0547`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0547) Description() string { return tableDescription(`0547`, string(v)) }
+
+// Table0548 is a value of table 0548, Signatory's Relationship to Subject.
+type Table0548 string
+
+const (
+	Table0548_1 Table0548 = `1` // Self
+	Table0548_2 Table0548 = `2` // Parent
+	Table0548_3 Table0548 = `3` // Next of Kin
+	Table0548_4 Table0548 = `4` // Durable Power of Attorney in Healthcare Affairs
+	Table0548_5 Table0548 = `5` // Conservator
+	Table0548_6 Table0548 = `6` // Emergent Practitioner (practitioner judging case as emergency requiring care without a consent)
+	Table0548_7 Table0548 = `7` // Non-Emergent Practitioner (i.e. medical ethics committee)
+)
+
+// TableID returns the table ID.
+func (Table0548) TableID() string { return `0548` }
+
+// Valid reports if the value is in the table.
+func (v Table0548) Valid() bool { return TableValueLookup[`0548`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0548) Description() string { return tableDescription(`0548`, string(v)) }
+
+// Table0549 is a value of table 0549, NDC Codes.
+type Table0549 string
+
+// TableID returns the table ID.
+func (Table0549) TableID() string { return `0549` }
+
+// Valid reports if the value is in the table.
+func (v Table0549) Valid() bool { return TableValueLookup[`0549`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0549) Description() string { return tableDescription(`0549`, string(v)) }
+
+// Table0550 is a value of table 0550, Body Parts.
+type Table0550 string
+
+const (
+	Table0550_ACET   Table0550 = `ACET`   // Acetabulum
+	Table0550_ACHIL  Table0550 = `ACHIL`  // Achilles
+	Table0550_ADB    Table0550 = `ADB`    // Abdomen
+	Table0550_ADE    Table0550 = `ADE`    // Adenoids
+	Table0550_ADR    Table0550 = `ADR`    // Adrenal
+	Table0550_AMN    Table0550 = `AMN`    // Amniotic fluid
+	Table0550_AMS    Table0550 = `AMS`    // Amniotic Sac
+	Table0550_ANAL   Table0550 = `ANAL`   // Anal
+	Table0550_ANKL   Table0550 = `ANKL`   // Ankle
+	Table0550_ANTEC  Table0550 = `ANTEC`  // Antecubital
+	Table0550_ANTECF Table0550 = `ANTECF` // Antecubital Fossa
+	Table0550_ANTR   Table0550 = `ANTR`   // Antrum
+	Table0550_ANUS   Table0550 = `ANUS`   // Anus
+	Table0550_AORTA  Table0550 = `AORTA`  // Aorta
+	Table0550_APDX   Table0550 = `APDX`   // Appendix
+	Table0550_AR     Table0550 = `AR`     // Aortic Rim
+	Table0550_AREO   Table0550 = `AREO`   // Areola
+	Table0550_ARM    Table0550 = `ARM`    // Arm
+	Table0550_ARTE   Table0550 = `ARTE`   // Artery
+	Table0550_ASCIT  Table0550 = `ASCIT`  // Ascites
+	Table0550_ASCT   Table0550 = `ASCT`   // Ascitic Fluid
+	Table0550_ATR    Table0550 = `ATR`    // Atrium
+	Table0550_AURI   Table0550 = `AURI`   // Auricular
+	Table0550_AV     Table0550 = `AV`     // Aortic Valve
+	Table0550_AXI    Table0550 = `AXI`    // Axilla
+	Table0550_BACK   Table0550 = `BACK`   // Back
+	Table0550_BARTD  Table0550 = `BARTD`  // Bartholin Duct
+	Table0550_BARTG  Table0550 = `BARTG`  // Bartholin Gland
+	Table0550_BCYS   Table0550 = `BCYS`   // Brain Cyst Fluid
+	Table0550_BDY    Table0550 = `BDY`    // Body, Whole
+	Table0550_BID    Table0550 = `BID`    // Bile Duct
+	Table0550_BIFL   Table0550 = `BIFL`   // Bile fluid
+	Table0550_BLAD   Table0550 = `BLAD`   // Bladder
+	Table0550_BLD    Table0550 = `BLD`    // Blood, Whole
+	Table0550_BLDA   Table0550 = `BLDA`   // Blood, Arterial
+	Table0550_BLDC   Table0550 = `BLDC`   // Blood, Capillary
+	Table0550_BLDV   Table0550 = `BLDV`   // Blood, Venous
+	Table0550_BLOOD  Table0550 = `BLOOD`  // Blood
+	Table0550_BMAR   Table0550 = `BMAR`   // Bone marrow
+	Table0550_BON    Table0550 = `BON`    // Bone
+	Table0550_BOWEL  Table0550 = `BOWEL`  // Bowel
+	Table0550_BOWLA  Table0550 = `BOWLA`  // Bowel, Large
+	Table0550_BOWSM  Table0550 = `BOWSM`  // Bowel, Small
+	Table0550_BPH    Table0550 = `BPH`    // Basophils
+	Table0550_BRA    Table0550 = `BRA`    // Brachial
+	Table0550_BRAIN  Table0550 = `BRAIN`  // Brain
+	Table0550_BRO    Table0550 = `BRO`    // Bronchial
+	Table0550_BROCH  Table0550 = `BROCH`  // Bronchiole/Bronchiolar
+	Table0550_BRONC  Table0550 = `BRONC`  // Bronchus/Bronchial
+	Table0550_BROW   Table0550 = `BROW`   // Eyebrow
+	Table0550_BRST   Table0550 = `BRST`   // Breast
+	Table0550_BRSTFL Table0550 = `BRSTFL` // Breast fluid
+	Table0550_BRTGF  Table0550 = `BRTGF`  // Bartholin Gland Fluid
+	Table0550_BRV    Table0550 = `BRV`    // Broviac
+	Table0550_BUCCA  Table0550 = `BUCCA`  // Buccal
+	Table0550_BURSA  Table0550 = `BURSA`  // Bursa
+	Table0550_BURSF  Table0550 = `BURSF`  // Bursa Fluid
+	Table0550_BUTT   Table0550 = `BUTT`   // Buttocks
+	Table0550_CALF   Table0550 = `CALF`   // Calf
+	Table0550_CANAL  Table0550 = `CANAL`  // Canal
+	Table0550_CANLI  Table0550 = `CANLI`  // Canaliculis
+	Table0550_CANTH  Table0550 = `CANTH`  // Canthus
+	Table0550_CARO   Table0550 = `CARO`   // Carotid
+	Table0550_CARP   Table0550 = `CARP`   // Carpal
+	Table0550_CAVIT  Table0550 = `CAVIT`  // Cavity
+	Table0550_CBLD   Table0550 = `CBLD`   // Blood, Cord
+	Table0550_CDM    Table0550 = `CDM`    // Cardiac Muscle
+	Table0550_CDUCT  Table0550 = `CDUCT`  // Common Duct
+	Table0550_CECUM  Table0550 = `CECUM`  // Cecum/Cecal
+	Table0550_CERVUT Table0550 = `CERVUT` // Cervix/Uterus
+	Table0550_CHE    Table0550 = `CHE`    // Cavity, Chest
+	Table0550_CHEEK  Table0550 = `CHEEK`  // Cheek
+	Table0550_CHES   Table0550 = `CHES`   // Chest
+	Table0550_CHIN   Table0550 = `CHIN`   // Chin
+	Table0550_CIRCU  Table0550 = `CIRCU`  // Circumcision Site
+	Table0550_CLAVI  Table0550 = `CLAVI`  // Clavicle/Clavicular
+	Table0550_CLIT   Table0550 = `CLIT`   // Clitoris
+	Table0550_CLITO  Table0550 = `CLITO`  // Clitoral
+	Table0550_CNL    Table0550 = `CNL`    // Cannula
+	Table0550_COCCG  Table0550 = `COCCG`  // Coccygeal
+	Table0550_COCCY  Table0550 = `COCCY`  // Coccyx
+	Table0550_COLON  Table0550 = `COLON`  // Colon
+	Table0550_COLOS  Table0550 = `COLOS`  // Colostomy
+	Table0550_CONJ   Table0550 = `CONJ`   // Conjunctiva
+	Table0550_COR    Table0550 = `COR`    // Cord
+	Table0550_CORAL  Table0550 = `CORAL`  // Coral
+	Table0550_CORD   Table0550 = `CORD`   // Cord Blood
+	Table0550_CORN   Table0550 = `CORN`   // Cornea
+	Table0550_COS    Table0550 = `COS`    // Colostomy Stoma
+	Table0550_CRANE  Table0550 = `CRANE`  // Cranium, ethmoid
+	Table0550_CRANF  Table0550 = `CRANF`  // Cranium, frontal
+	Table0550_CRANO  Table0550 = `CRANO`  // Cranium, occipital
+	Table0550_CRANP  Table0550 = `CRANP`  // Cranium, parietal
+	Table0550_CRANS  Table0550 = `CRANS`  // Cranium, sphenoid
+	Table0550_CRANT  Table0550 = `CRANT`  // Cranium, temporal
+	Table0550_CSF    Table0550 = `CSF`    // Cerebral Spinal Fluid
+	Table0550_CUBIT  Table0550 = `CUBIT`  // Cubitus
+	Table0550_CUFF   Table0550 = `CUFF`   // Cuff
+	Table0550_CULD   Table0550 = `CULD`   // Cul De Sac
+	Table0550_CULDO  Table0550 = `CULDO`  // Culdocentesis
+	Table0550_CVX    Table0550 = `CVX`    // Cervix
+	Table0550_DELT   Table0550 = `DELT`   // Deltoid
+	Table0550_DEN    Table0550 = `DEN`    // Dental Gingiva
+	Table0550_DENTA  Table0550 = `DENTA`  // Dental
+	Table0550_DIAF   Table0550 = `DIAF`   // Dialysis Fluid
+	Table0550_DIGIT  Table0550 = `DIGIT`  // Digit
+	Table0550_DISC   Table0550 = `DISC`   // Disc
+	Table0550_DORS   Table0550 = `DORS`   // Dorsum/Dorsal
+	Table0550_DPH    Table0550 = `DPH`    // Diaphragm
+	Table0550_DUFL   Table0550 = `DUFL`   // Duodenal Fluid
+	Table0550_DUODE  Table0550 = `DUODE`  // Duodenum/Duodenal
+	Table0550_DUR    Table0550 = `DUR`    // Dura
+	Table0550_EAR    Table0550 = `EAR`    // Ear
+	Table0550_EARBI  Table0550 = `EARBI`  // Ear bone, incus
+	Table0550_EARBM  Table0550 = `EARBM`  // Ear bone, malleus
+	Table0550_EARBS  Table0550 = `EARBS`  // Ear bone,stapes
+	Table0550_EARLO  Table0550 = `EARLO`  // Ear Lobe
+	Table0550_EC     Table0550 = `EC`     // Endocervical
+	Table0550_ELBOW  Table0550 = `ELBOW`  // Elbow
+	Table0550_ELBOWJ Table0550 = `ELBOWJ` // Elbow Joint
+	Table0550_ENDC   Table0550 = `ENDC`   // Endocardium
+	Table0550_ENDM   Table0550 = `ENDM`   // Endometrium
+	Table0550_EOLPH  Table0550 = `EOLPH`  // endolpthamitis
+	Table0550_EOS    Table0550 = `EOS`    // Eosinophils
+	Table0550_EPD    Table0550 = `EPD`    // Epididymis
+	Table0550_EPICA  Table0550 = `EPICA`  // Epicardial
+	Table0550_EPICM  Table0550 = `EPICM`  // Epicardium
+	Table0550_EPIDU  Table0550 = `EPIDU`  // Epidural
+	Table0550_EPIGL  Table0550 = `EPIGL`  // Epiglottis
+	Table0550_ESO    Table0550 = `ESO`    // Esophagus
+	Table0550_ESOPG  Table0550 = `ESOPG`  // Esophageal
+	Table0550_ET     Table0550 = `ET`     // Endotracheal
+	Table0550_ETHMO  Table0550 = `ETHMO`  // Ethmoid
+	Table0550_EUR    Table0550 = `EUR`    // Endourethral
+	Table0550_EYE    Table0550 = `EYE`    // Eye
+	Table0550_EYELI  Table0550 = `EYELI`  // Eyelid
+	Table0550_FACE   Table0550 = `FACE`   // Face
+	Table0550_FALLT  Table0550 = `FALLT`  // Fallopian Tube
+	Table0550_FBINC  Table0550 = `FBINC`  // Facial bone, inferior nasal concha
+	Table0550_FBLAC  Table0550 = `FBLAC`  // Facial bone, lacrimal
+	Table0550_FBMAX  Table0550 = `FBMAX`  // Facial bone, maxilla
+	Table0550_FBNAS  Table0550 = `FBNAS`  // Facial bone, nasal
+	Table0550_FBPAL  Table0550 = `FBPAL`  // Facial bone, palatine
+	Table0550_FBVOM  Table0550 = `FBVOM`  // Facial bone, vomer
+	Table0550_FBZYG  Table0550 = `FBZYG`  // Facial bone, zygomatic
+	Table0550_FEMOR  Table0550 = `FEMOR`  // Femoral
+	Table0550_FEMUR  Table0550 = `FEMUR`  // Femur
+	Table0550_FET    Table0550 = `FET`    // Fetus
+	Table0550_FIBU   Table0550 = `FIBU`   // Fibula
+	Table0550_FING   Table0550 = `FING`   // Finger
+	Table0550_FINGN  Table0550 = `FINGN`  // Finger Nail
+	Table0550_FMH    Table0550 = `FMH`    // Femoral Head
+	Table0550_FOL    Table0550 = `FOL`    // Follicle
+	Table0550_FOOT   Table0550 = `FOOT`   // Foot
+	Table0550_FOREA  Table0550 = `FOREA`  // Forearm
+	Table0550_FOREH  Table0550 = `FOREH`  // Forehead
+	Table0550_FORES  Table0550 = `FORES`  // Foreskin
+	Table0550_FOURC  Table0550 = `FOURC`  // Fourchette
+	Table0550_GB     Table0550 = `GB`     // Gall Bladder
+	Table0550_GEN    Table0550 = `GEN`    // Genital
+	Table0550_GENC   Table0550 = `GENC`   // Genital Cervix
+	Table0550_GENL   Table0550 = `GENL`   // Genital Lochia
+	Table0550_GL     Table0550 = `GL`     // Genital Lesion
+	Table0550_GLAND  Table0550 = `GLAND`  // Gland
+	Table0550_GLANS  Table0550 = `GLANS`  // Glans
+	Table0550_GLUT   Table0550 = `GLUT`   // Gluteus
+	Table0550_GLUTE  Table0550 = `GLUTE`  // Gluteal
+	Table0550_GLUTM  Table0550 = `GLUTM`  // Gluteus Medius
+	Table0550_GROIN  Table0550 = `GROIN`  // Groin
+	Table0550_GUM    Table0550 = `GUM`    // Gum
+	Table0550_GVU    Table0550 = `GVU`    // Genital - Vulva
+	Table0550_HAL    Table0550 = `HAL`    // Hallux
+	Table0550_HAND   Table0550 = `HAND`   // Hand
+	Table0550_HAR    Table0550 = `HAR`    // Hair
+	Table0550_HART   Table0550 = `HART`   // Heart
+	Table0550_HEAD   Table0550 = `HEAD`   // Head
+	Table0550_HEEL   Table0550 = `HEEL`   // Heel
+	Table0550_HEM    Table0550 = `HEM`    // Hemorrhoid
+	Table0550_HIP    Table0550 = `HIP`    // Hip
+	Table0550_HIPJ   Table0550 = `HIPJ`   // Hip Joint
+	Table0550_HUMER  Table0550 = `HUMER`  // Humerus
+	Table0550_HV     Table0550 = `HV`     // Heart Valve
+	Table0550_HVB    Table0550 = `HVB`    // Heart Valve, Bicuspid
+	Table0550_HVT    Table0550 = `HVT`    // Heart Valve, Tricuspid
+	Table0550_HYMEN  Table0550 = `HYMEN`  // Hymen
+	Table0550_ICX    Table0550 = `ICX`    // Intracervical
+	Table0550_ILC    Table0550 = `ILC`    // Ileal Conduit
+	Table0550_ILCON  Table0550 = `ILCON`  // Ilical Conduit
+	Table0550_ILCR   Table0550 = `ILCR`   // Iliac Crest
+	Table0550_ILE    Table0550 = `ILE`    // Ileal Loop
+	Table0550_ILEOS  Table0550 = `ILEOS`  // Ileostomy
+	Table0550_ILEUM  Table0550 = `ILEUM`  // Ileum
+	Table0550_ILIAC  Table0550 = `ILIAC`  // Iliac
+	Table0550_INASA  Table0550 = `INASA`  // Intranasal
+	Table0550_INGUI  Table0550 = `INGUI`  // Inguinal
+	Table0550_INSTL  Table0550 = `INSTL`  // Intestine, Large
+	Table0550_INSTS  Table0550 = `INSTS`  // Intestine, Small
+	Table0550_INT    Table0550 = `INT`    // Intestine
+	Table0550_INTRO  Table0550 = `INTRO`  // Introitus
+	Table0550_INTRU  Table0550 = `INTRU`  // Intrauterine
+	Table0550_ISCHI  Table0550 = `ISCHI`  // Ischium
+	Table0550_ISH    Table0550 = `ISH`    // Loop, Ishial
+	Table0550_JAW    Table0550 = `JAW`    // Jaw
+	Table0550_JUGI   Table0550 = `JUGI`   // Jugular, Internal
+	Table0550_KNEE   Table0550 = `KNEE`   // Knee
+	Table0550_KNEEF  Table0550 = `KNEEF`  // Knee Fluid
+	Table0550_KNEEJ  Table0550 = `KNEEJ`  // Knee Joint
+	Table0550_LABIA  Table0550 = `LABIA`  // Labia
+	Table0550_LABMA  Table0550 = `LABMA`  // Labia Majora
+	Table0550_LABMI  Table0550 = `LABMI`  // Labia Minora
+	Table0550_LACRI  Table0550 = `LACRI`  // Lacrimal
+	Table0550_LAM    Table0550 = `LAM`    // Lamella
+	Table0550_LARYN  Table0550 = `LARYN`  // Larynx
+	Table0550_LEG    Table0550 = `LEG`    // Leg
+	Table0550_LENS   Table0550 = `LENS`   // Lens
+	Table0550_LING   Table0550 = `LING`   // Lingual
+	Table0550_LINGU  Table0550 = `LINGU`  // Lingula
+	Table0550_LIP    Table0550 = `LIP`    // Lip
+	Table0550_LIVER  Table0550 = `LIVER`  // Liver
+	Table0550_LMN    Table0550 = `LMN`    // Lumen
+	Table0550_LN     Table0550 = `LN`     // Lymph Node
+	Table0550_LNG    Table0550 = `LNG`    // Lymph Node, Groin
+	Table0550_LOBE   Table0550 = `LOBE`   // Lobe
+	Table0550_LOCH   Table0550 = `LOCH`   // Lochia
+	Table0550_LUMBA  Table0550 = `LUMBA`  // Lumbar
+	Table0550_LUNG   Table0550 = `LUNG`   // Lung
+	Table0550_LYM    Table0550 = `LYM`    // Lymphocytes
+	Table0550_MAC    Table0550 = `MAC`    // Macrophages
+	Table0550_MALLE  Table0550 = `MALLE`  // Malleolus
+	Table0550_MANDI  Table0550 = `MANDI`  // Mandible/Mandibular
+	Table0550_MAR    Table0550 = `MAR`    // Marrow
+	Table0550_MAST   Table0550 = `MAST`   // Mastoid
+	Table0550_MAXIL  Table0550 = `MAXIL`  // Maxilla/Maxillary
+	Table0550_MAXS   Table0550 = `MAXS`   // Maxillary Sinus
+	Table0550_MEATU  Table0550 = `MEATU`  // Meatus
+	Table0550_MEC    Table0550 = `MEC`    // Meconium
+	Table0550_MEDST  Table0550 = `MEDST`  // Mediastinum
+	Table0550_MEDU   Table0550 = `MEDU`   // Medullary
+	Table0550_METAC  Table0550 = `METAC`  // Metacarpal
+	Table0550_METAT  Table0550 = `METAT`  // Metatarsal
+	Table0550_MILK   Table0550 = `MILK`   // Milk, Breast
+	Table0550_MITRL  Table0550 = `MITRL`  // Mitral Valve
+	Table0550_MOLAR  Table0550 = `MOLAR`  // Molar
+	Table0550_MONSU  Table0550 = `MONSU`  // Mons Ureteris
+	Table0550_MONSV  Table0550 = `MONSV`  // Mons Veneris(Mons Pubis)
+	Table0550_MOU    Table0550 = `MOU`    // Membrane
+	Table0550_MOUTH  Table0550 = `MOUTH`  // Mouth
+	Table0550_MP     Table0550 = `MP`     // Mons Pubis
+	Table0550_MPB    Table0550 = `MPB`    // Meninges
+	Table0550_MRSA2  Table0550 = `MRSA2`  // Mrsa:
+	Table0550_MYO    Table0550 = `MYO`    // Myocardium
+	Table0550_NAIL   Table0550 = `NAIL`   // Nail
+	Table0550_NAILB  Table0550 = `NAILB`  // Nail Bed
+	Table0550_NAILF  Table0550 = `NAILF`  // Nail, Finger
+	Table0550_NAILT  Table0550 = `NAILT`  // Nail, Toe
+	Table0550_NARES  Table0550 = `NARES`  // Nares
+	Table0550_NASL   Table0550 = `NASL`   // Nasal
+	Table0550_NAVEL  Table0550 = `NAVEL`  // Navel
+	Table0550_NECK   Table0550 = `NECK`   // Neck
+	Table0550_NERVE  Table0550 = `NERVE`  // Nerve
+	Table0550_NIPPL  Table0550 = `NIPPL`  // Nipple
+	Table0550_NLACR  Table0550 = `NLACR`  // Nasolacrimal
+	Table0550_NOS    Table0550 = `NOS`    // Nose (Nasal Passage)
+	Table0550_NOSE   Table0550 = `NOSE`   // Nose/Nose(outside)
+	Table0550_NOSTR  Table0550 = `NOSTR`  // Nostril
+	Table0550_NP     Table0550 = `NP`     // Nasopharyngeal/Nasopharynx
+	Table0550_NSS    Table0550 = `NSS`    // Nasal Septum
+	Table0550_NTRAC  Table0550 = `NTRAC`  // Nasotracheal
+	Table0550_OCCIP  Table0550 = `OCCIP`  // Occipital
+	Table0550_OLECR  Table0550 = `OLECR`  // Olecranon
+	Table0550_OMEN   Table0550 = `OMEN`   // Omentum
+	Table0550_ORBIT  Table0550 = `ORBIT`  // Orbit/Orbital
+	Table0550_ORO    Table0550 = `ORO`    // Oropharynx
+	Table0550_OSCOX  Table0550 = `OSCOX`  // Os coxa (pelvic girdle)
+	Table0550_OVARY  Table0550 = `OVARY`  // Ovary
+	Table0550_PAFL   Table0550 = `PAFL`   // Pancreatic Fluid
+	Table0550_PALAT  Table0550 = `PALAT`  // Palate
+	Table0550_PALM   Table0550 = `PALM`   // Palm
+	Table0550_PANAL  Table0550 = `PANAL`  // Perianal/Perirectal
+	Table0550_PANCR  Table0550 = `PANCR`  // Pancreas
+	Table0550_PARAT  Table0550 = `PARAT`  // Paratracheal
+	Table0550_PARIE  Table0550 = `PARIE`  // Parietal
+	Table0550_PARON  Table0550 = `PARON`  // Paronychia
+	Table0550_PAROT  Table0550 = `PAROT`  // Parotid/Parotid Gland
+	Table0550_PAS    Table0550 = `PAS`    // Parasternal
+	Table0550_PATEL  Table0550 = `PATEL`  // Patella
+	Table0550_PCARD  Table0550 = `PCARD`  // Pericardium
+	Table0550_PCLIT  Table0550 = `PCLIT`  // Periclitoral
+	Table0550_PELV   Table0550 = `PELV`   // Pelvis
+	Table0550_PENIS  Table0550 = `PENIS`  // Penis
+	Table0550_PENSH  Table0550 = `PENSH`  // Penile Shaft
+	Table0550_PER    Table0550 = `PER`    // Peritoneal
+	Table0550_PERI   Table0550 = `PERI`   // Pericardial Fluid
+	Table0550_PERIH  Table0550 = `PERIH`  // Perihepatic
+	Table0550_PERIN  Table0550 = `PERIN`  // Perineal Abscess
+	Table0550_PERIS  Table0550 = `PERIS`  // Perisplenic
+	Table0550_PERIT  Table0550 = `PERIT`  // Peritoneum
+	Table0550_PERIU  Table0550 = `PERIU`  // Periurethal
+	Table0550_PERIV  Table0550 = `PERIV`  // Perivesicular
+	Table0550_PERRA  Table0550 = `PERRA`  // Perirectal
+	Table0550_PERT   Table0550 = `PERT`   // Peritoneal Fluid
+	Table0550_PHALA  Table0550 = `PHALA`  // Phalanyx
+	Table0550_PILO   Table0550 = `PILO`   // Pilonidal
+	Table0550_PINNA  Table0550 = `PINNA`  // Pinna
+	Table0550_PLACF  Table0550 = `PLACF`  // Placenta (Fetal Side)
+	Table0550_PLACM  Table0550 = `PLACM`  // Placenta (Maternal Side)
+	Table0550_PLANT  Table0550 = `PLANT`  // Plantar
+	Table0550_PLATH  Table0550 = `PLATH`  // Palate, Hard
+	Table0550_PLATS  Table0550 = `PLATS`  // Palate, Soft
+	Table0550_PLC    Table0550 = `PLC`    // Placenta
+	Table0550_PLEU   Table0550 = `PLEU`   // Pleural Fluid
+	Table0550_PLEUR  Table0550 = `PLEUR`  // Pleura
+	Table0550_PLR    Table0550 = `PLR`    // Pleural Fluid (Thoracentesis Fld)
+	Table0550_PNEAL  Table0550 = `PNEAL`  // Perineal
+	Table0550_PNEPH  Table0550 = `PNEPH`  // Perinephric
+	Table0550_PNM    Table0550 = `PNM`    // Perineum
+	Table0550_POPLI  Table0550 = `POPLI`  // Popliteal
+	Table0550_PORBI  Table0550 = `PORBI`  // Periorbital
+	Table0550_PREAU  Table0550 = `PREAU`  // Preauricular
+	Table0550_PRERE  Table0550 = `PRERE`  // Prerenal
+	Table0550_PROS   Table0550 = `PROS`   // Prostatic Fluid
+	Table0550_PRST   Table0550 = `PRST`   // Prostate Gland
+	Table0550_PTONS  Table0550 = `PTONS`  // Peritonsillar
+	Table0550_PUBIC  Table0550 = `PUBIC`  // Pubic
+	Table0550_PUL    Table0550 = `PUL`    // Pulmonary Artery
+	Table0550_RADI   Table0550 = `RADI`   // Radial
+	Table0550_RADIUS Table0550 = `RADIUS` // Radius
+	Table0550_RBC    Table0550 = `RBC`    // Red Blood Cells
+	Table0550_RECTL  Table0550 = `RECTL`  // Rectal
+	Table0550_RECTU  Table0550 = `RECTU`  // Rectum
+	Table0550_RENL   Table0550 = `RENL`   // Renal
+	Table0550_RIB    Table0550 = `RIB`    // Rib
+	Table0550_RNP    Table0550 = `RNP`    // Renal Pelvis
+	Table0550_RPERI  Table0550 = `RPERI`  // Retroperitoneal
+	Table0550_SAC    Table0550 = `SAC`    // Uterine Cul/De/Sac
+	Table0550_SACIL  Table0550 = `SACIL`  // Sacroiliac
+	Table0550_SACRA  Table0550 = `SACRA`  // Sacral
+	Table0550_SACRO  Table0550 = `SACRO`  // Sacrococcygeal
+	Table0550_SACRU  Table0550 = `SACRU`  // Sacrum
+	Table0550_SALGL  Table0550 = `SALGL`  // Salivary Gland
+	Table0550_SCALP  Table0550 = `SCALP`  // Scalp
+	Table0550_SCAPU  Table0550 = `SCAPU`  // Scapula/Scapular
+	Table0550_SCLAV  Table0550 = `SCLAV`  // Supraclavicle/Supraclavicular
+	Table0550_SCLER  Table0550 = `SCLER`  // Sclera
+	Table0550_SCLV   Table0550 = `SCLV`   // Sub Clavian
+	Table0550_SCROT  Table0550 = `SCROT`  // Scrotum/Scrotal
+	Table0550_SDP    Table0550 = `SDP`    // Subdiaphramatic
+	Table0550_SEM    Table0550 = `SEM`    // Seminal Fluid
+	Table0550_SEMN   Table0550 = `SEMN`   // Semen
+	Table0550_SEPTU  Table0550 = `SEPTU`  // Septum/Septal
+	Table0550_SEROM  Table0550 = `SEROM`  // Seroma
+	Table0550_SGF    Table0550 = `SGF`    // Subgaleal Fluid
+	Table0550_SHIN   Table0550 = `SHIN`   // Shin
+	Table0550_SHOL   Table0550 = `SHOL`   // Shoulder
+	Table0550_SHOLJ  Table0550 = `SHOLJ`  // Sholder Joint
+	Table0550_SIGMO  Table0550 = `SIGMO`  // Sigmoid
+	Table0550_SINUS  Table0550 = `SINUS`  // Sinus
+	Table0550_SKENE  Table0550 = `SKENE`  // Skene's Gland
+	Table0550_SKM    Table0550 = `SKM`    // Skeletal Muscle
+	Table0550_SKULL  Table0550 = `SKULL`  // Skull
+	Table0550_SOLE   Table0550 = `SOLE`   // Sole
+	Table0550_SPCOR  Table0550 = `SPCOR`  // Spinal Cord
+	Table0550_SPHEN  Table0550 = `SPHEN`  // Sphenoid
+	Table0550_SPLN   Table0550 = `SPLN`   // Spleen
+	Table0550_SPRM   Table0550 = `SPRM`   // Spermatozoa
+	Table0550_SPX    Table0550 = `SPX`    // Supra Cervical
+	Table0550_STER   Table0550 = `STER`   // Sternum/Sternal
+	Table0550_STOM   Table0550 = `STOM`   // Stoma
+	Table0550_STOMA  Table0550 = `STOMA`  // Stomach
+	Table0550_STOOLL Table0550 = `STOOLL` // Liquid Stool
+	Table0550_STUMP  Table0550 = `STUMP`  // Stump
+	Table0550_SUB    Table0550 = `SUB`    // Subdural
+	Table0550_SUBD   Table0550 = `SUBD`   // Subdural Fluid
+	Table0550_SUBM   Table0550 = `SUBM`   // Submandibular
+	Table0550_SUBME  Table0550 = `SUBME`  // Submental
+	Table0550_SUBPH  Table0550 = `SUBPH`  // Subphrenic
+	Table0550_SUBX   Table0550 = `SUBX`   // Submaxillary
+	Table0550_SUPB   Table0550 = `SUPB`   // Suprapubic Specimen
+	Table0550_SUPRA  Table0550 = `SUPRA`  // Suprapubic
+	Table0550_SWT    Table0550 = `SWT`    // Sweat
+	Table0550_SWTG   Table0550 = `SWTG`   // Sweat Gland
+	Table0550_SYN    Table0550 = `SYN`    // Synovial Fluid
+	Table0550_SYNOL  Table0550 = `SYNOL`  // Synovial
+	Table0550_SYNOV  Table0550 = `SYNOV`  // Synovium
+	Table0550_TARS   Table0550 = `TARS`   // Tarsal
+	Table0550_TBRON  Table0550 = `TBRON`  // Transbronchial
+	Table0550_TCN    Table0550 = `TCN`    // Transcarina Asp
+	Table0550_TDUCT  Table0550 = `TDUCT`  // Tear Duct
+	Table0550_TEAR   Table0550 = `TEAR`   // Tears
+	Table0550_TEMPL  Table0550 = `TEMPL`  // Temple
+	Table0550_TEMPO  Table0550 = `TEMPO`  // Temporal
+	Table0550_TESTI  Table0550 = `TESTI`  // Testicle(Testis)
+	Table0550_THIGH  Table0550 = `THIGH`  // Thigh
+	Table0550_THM    Table0550 = `THM`    // Thymus
+	Table0550_THORA  Table0550 = `THORA`  // Thorax/Thoracic/Thoracentesis
+	Table0550_THRB   Table0550 = `THRB`   // Throat
+	Table0550_THUMB  Table0550 = `THUMB`  // Thumb
+	Table0550_THYRD  Table0550 = `THYRD`  // Thyroid
+	Table0550_TIBIA  Table0550 = `TIBIA`  // Tibia
+	Table0550_TML    Table0550 = `TML`    // Temporal Lobe
+	Table0550_TNL    Table0550 = `TNL`    // Thumbnail
+	Table0550_TOE    Table0550 = `TOE`    // Toe
+	Table0550_TOEN   Table0550 = `TOEN`   // Toe Nail
+	Table0550_TONG   Table0550 = `TONG`   // Tongue
+	Table0550_TONS   Table0550 = `TONS`   // Tonsil
+	Table0550_TOOTH  Table0550 = `TOOTH`  // Tooth
+	Table0550_TRCHE  Table0550 = `TRCHE`  // Trachea/Tracheal
+	Table0550_TSK    Table0550 = `TSK`    // Tooth Socket
+	Table0550_ULNA   Table0550 = `ULNA`   // Ulna/Ulnar
+	Table0550_UMB    Table0550 = `UMB`    // Umbilical Blood
+	Table0550_UMBL   Table0550 = `UMBL`   // Umbilicus/Umbilical
+	Table0550_URET   Table0550 = `URET`   // Ureter
+	Table0550_URTH   Table0550 = `URTH`   // Urethra
+	Table0550_USTOM  Table0550 = `USTOM`  // Stoma, Urinary
+	Table0550_UTER   Table0550 = `UTER`   // Uterus
+	Table0550_UTERI  Table0550 = `UTERI`  // Uterine
+	Table0550_VAGIN  Table0550 = `VAGIN`  // Vagina/Vaginal
+	Table0550_VAL    Table0550 = `VAL`    // Valve
+	Table0550_VAS    Table0550 = `VAS`    // Vas Deferens
+	Table0550_VASTL  Table0550 = `VASTL`  // Vastus Lateralis
+	Table0550_VAULT  Table0550 = `VAULT`  // Vault
+	Table0550_VCSF   Table0550 = `VCSF`   // Ventricular CSF
+	Table0550_VCUFF  Table0550 = `VCUFF`  // Vaginal Cuff
+	Table0550_VEIN   Table0550 = `VEIN`   // Vein
+	Table0550_VENTG  Table0550 = `VENTG`  // Ventragluteal
+	Table0550_VERMI  Table0550 = `VERMI`  // Vermis Cerebelli
+	Table0550_VERTC  Table0550 = `VERTC`  // Vertebra, cervical
+	Table0550_VERTL  Table0550 = `VERTL`  // Vertebra, lumbar
+	Table0550_VERTT  Table0550 = `VERTT`  // Vertebra, thoracic
+	Table0550_VESCL  Table0550 = `VESCL`  // Vesicular
+	Table0550_VESFLD Table0550 = `VESFLD` // Vesicular Fluid
+	Table0550_VESI   Table0550 = `VESI`   // Vesicle
+	Table0550_VESTI  Table0550 = `VESTI`  // Vestibule(Genital)
+	Table0550_VGV    Table0550 = `VGV`    // Vaginal Vault
+	Table0550_VITR   Table0550 = `VITR`   // Vitreous Fluid
+	Table0550_VOC    Table0550 = `VOC`    // Vocal Cord
+	Table0550_VULVA  Table0550 = `VULVA`  // Vulva
+	Table0550_WBC    Table0550 = `WBC`    // Leukocytes
+	Table0550_WRIST  Table0550 = `WRIST`  // Wrist
+)
+
+// TableID returns the table ID.
+func (Table0550) TableID() string { return `0550` }
+
+// Valid reports if the value is in the table.
+func (v Table0550) Valid() bool { return TableValueLookup[`0550`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0550) Description() string { return tableDescription(`0550`, string(v)) }
+
+// Table0552 is a value of table 0552, Advanced Beneficiary Notice Override Reason.
+type Table0552 string
+
+// TableID returns the table ID.
+func (Table0552) TableID() string { return `0552` }
+
+// Valid reports if the value is in the table.
+func (v Table0552) Valid() bool { return TableValueLookup[`0552`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0552) Description() string { return tableDescription(`0552`, string(v)) }
+
+// Table0553 is a value of table 0553, Invoice Control Code.
+type Table0553 string
+
+const (
+	Table0553_AA Table0553 = `AA` // Authorization request for inpatient admission
+	Table0553_AI Table0553 = `AI` // Combined Authorization and Adjudication request
+	Table0553_CA Table0553 = `CA` // Cancel Authorization request
+	Table0553_CG Table0553 = `CG` // Cancel Invoice Product/Service Group
+	Table0553_CL Table0553 = `CL` // Cancel Invoice Product/Service Line Item
+	Table0553_CN Table0553 = `CN` // Cancel Invoice
+	Table0553_CP Table0553 = `CP` // Copy of Invoice
+	Table0553_CQ Table0553 = `CQ` // Coverage Register Query
+	Table0553_EA Table0553 = `EA` // Authorization request for inpatient stay extension
+	Table0553_OA Table0553 = `OA` // Original Authorization
+	Table0553_OR Table0553 = `OR` // Original Invoice
+	Table0553_PA Table0553 = `PA` // Pre-Authorization
+	Table0553_PD Table0553 = `PD` // Pre-Determination Invoice
+	Table0553_RA Table0553 = `RA` // Re-Assessment
+	Table0553_RC Table0553 = `RC` // Referral Pre-Authorization
+	Table0553_RU Table0553 = `RU` // Referral authorization
+	Table0553_SA Table0553 = `SA` // Special Authorization
+)
+
+// TableID returns the table ID.
+func (Table0553) TableID() string { return `0553` }
+
+// Valid reports if the value is in the table.
+func (v Table0553) Valid() bool { return TableValueLookup[`0553`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0553) Description() string { return tableDescription(`0553`, string(v)) }
+
+// Table0554 is a value of table 0554, Invoice Reason Codes.
+type Table0554 string
+
+const (
+	Table0554_LATE Table0554 = `LATE` // Late Invoice
+	Table0554_NORM Table0554 = `NORM` // Normal submission
+	Table0554_SUB  Table0554 = `SUB`  // Subscriber coverage problem
+)
+
+// TableID returns the table ID.
+func (Table0554) TableID() string { return `0554` }
+
+// Valid reports if the value is in the table.
+func (v Table0554) Valid() bool { return TableValueLookup[`0554`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0554) Description() string { return tableDescription(`0554`, string(v)) }
+
+// Table0555 is a value of table 0555, Invoice Type.
+type Table0555 string
+
+const (
+	Table0555_BK Table0555 = `BK` // Block
+	Table0555_FN Table0555 = `FN` // Final
+	Table0555_FS Table0555 = `FS` // Fee for Service
+	Table0555_GP Table0555 = `GP` // Group
+	Table0555_IN Table0555 = `IN` // Information Only
+	Table0555_NP Table0555 = `NP` // Non Patient
+	Table0555_PA Table0555 = `PA` // Partial
+	Table0555_SL Table0555 = `SL` // Salary
+	Table0555_SS Table0555 = `SS` // By Session
+	Table0555_SU Table0555 = `SU` // Supplemental
+)
+
+// TableID returns the table ID.
+func (Table0555) TableID() string { return `0555` }
+
+// Valid reports if the value is in the table.
+func (v Table0555) Valid() bool { return TableValueLookup[`0555`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0555) Description() string { return tableDescription(`0555`, string(v)) }
+
+// Table0556 is a value of table 0556, Benefit Group.
+type Table0556 string
+
+const (
+	Table0556_AMB  Table0556 = `AMB`  // AMBULATORY CARE
+	Table0556_DENT Table0556 = `DENT` // DENTAL
+)
+
+// TableID returns the table ID.
+func (Table0556) TableID() string { return `0556` }
+
+// Valid reports if the value is in the table.
+func (v Table0556) Valid() bool { return TableValueLookup[`0556`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0556) Description() string { return tableDescription(`0556`, string(v)) }
+
+// Table0557 is a value of table 0557, Payee Type.
+type Table0557 string
+
+const (
+	Table0557_EMPL Table0557 = `EMPL` // Employer
+	Table0557_ORG  Table0557 = `ORG`  // Payee Organization
+	Table0557_PERS Table0557 = `PERS` // Person
+	Table0557_PPER Table0557 = `PPER` // Pay Person
+)
+
+// TableID returns the table ID.
+func (Table0557) TableID() string { return `0557` }
+
+// Valid reports if the value is in the table.
+func (v Table0557) Valid() bool { return TableValueLookup[`0557`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0557) Description() string { return tableDescription(`0557`, string(v)) }
+
+// Table0558 is a value of table 0558, Payee Relationship to Invoice.
+type Table0558 string
+
+const (
+	Table0558_FM Table0558 = `FM` // Family Member
+	Table0558_GT Table0558 = `GT` // Guarantor
+	Table0558_PT Table0558 = `PT` // Patient
+	Table0558_SB Table0558 = `SB` // Subscriber
+)
+
+// TableID returns the table ID.
+func (Table0558) TableID() string { return `0558` }
+
+// Valid reports if the value is in the table.
+func (v Table0558) Valid() bool { return TableValueLookup[`0558`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0558) Description() string { return tableDescription(`0558`, string(v)) }
+
+// Table0559 is a value of table 0559, Product/Service Status.
+type Table0559 string
+
+const (
+	Table0559_D Table0559 = `D` // Denied
+	Table0559_P Table0559 = `P` // Processed
+	Table0559_R Table0559 = `R` // Rejected
+)
+
+// TableID returns the table ID.
+func (Table0559) TableID() string { return `0559` }
+
+// Valid reports if the value is in the table.
+func (v Table0559) Valid() bool { return TableValueLookup[`0559`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0559) Description() string { return tableDescription(`0559`, string(v)) }
+
+// Table0561 is a value of table 0561, Product/Services Clarification Codes.
+type Table0561 string
+
+const (
+	Table0561_CLCTR Table0561 = `CLCTR` // Claim Center
+	Table0561_DGAPP Table0561 = `DGAPP` // Diagnostic Approval Number
+	Table0561_DTCTR Table0561 = `DTCTR` // Data Center Number
+	Table0561_ENC   Table0561 = `ENC`   // Encounter Number
+	Table0561_GFTH  Table0561 = `GFTH`  // Good Faith Indicator
+	Table0561_OOP   Table0561 = `OOP`   // Out of Province Indicator
+	Table0561_SEQ   Table0561 = `SEQ`   // Sequence Number
+)
+
+// TableID returns the table ID.
+func (Table0561) TableID() string { return `0561` }
+
+// Valid reports if the value is in the table.
+func (v Table0561) Valid() bool { return TableValueLookup[`0561`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0561) Description() string { return tableDescription(`0561`, string(v)) }
+
+// Table0562 is a value of table 0562, Processing Consideration Codes.
+type Table0562 string
+
+const (
+	Table0562_DFADJ    Table0562 = `DFADJ`    // Deferred Adjudication Processing
+	Table0562_EFORM    Table0562 = `EFORM`    // Electronic form to follow
+	Table0562_FAX      Table0562 = `FAX`      // Fax to follow
+	Table0562_PAPER    Table0562 = `PAPER`    // Paper documentation to follow
+	Table0562_PYRDELAY Table0562 = `PYRDELAY` // Delayed by a Previous Payer
+	Table0562_RTADJ    Table0562 = `RTADJ`    // Real Time Adjudication Processing
+)
+
+// TableID returns the table ID.
+func (Table0562) TableID() string { return `0562` }
+
+// Valid reports if the value is in the table.
+func (v Table0562) Valid() bool { return TableValueLookup[`0562`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0562) Description() string { return tableDescription(`0562`, string(v)) }
+
+// Table0564 is a value of table 0564, Adjustment Category Code.
+type Table0564 string
+
+const (
+	Table0564_EA Table0564 = `EA` // Edit/Adjudication Response
+	Table0564_IN Table0564 = `IN` // Information
+	Table0564_PA Table0564 = `PA` // Provider Adjustment
+	Table0564_PR Table0564 = `PR` // Processing Result
+)
+
+// TableID returns the table ID.
+func (Table0564) TableID() string { return `0564` }
+
+// Valid reports if the value is in the table.
+func (v Table0564) Valid() bool { return TableValueLookup[`0564`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0564) Description() string { return tableDescription(`0564`, string(v)) }
+
+// Table0565 is a value of table 0565, Provider Adjustment Reason Code.
+type Table0565 string
+
+const (
+	Table0565_DISP Table0565 = `DISP` // Dispensing Fee
+	Table0565_GST  Table0565 = `GST`  // Goods and Services Tax
+	Table0565_HST  Table0565 = `HST`  // Harmonized Sales Tax
+	Table0565_MKUP Table0565 = `MKUP` // Mark up Fee
+	Table0565_PST  Table0565 = `PST`  // Provincial Sales Tax
+)
+
+// TableID returns the table ID.
+func (Table0565) TableID() string { return `0565` }
+
+// Valid reports if the value is in the table.
+func (v Table0565) Valid() bool { return TableValueLookup[`0565`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0565) Description() string { return tableDescription(`0565`, string(v)) }
+
+// Table0569 is a value of table 0569, Adjustment Action.
+type Table0569 string
+
+const (
+	Table0569_EOB Table0569 = `EOB` // Print on EOB
+	Table0569_PAT Table0569 = `PAT` // Inform Patient
+	Table0569_PRO Table0569 = `PRO` // Inform Provider
+)
+
+// TableID returns the table ID.
+func (Table0569) TableID() string { return `0569` }
+
+// Valid reports if the value is in the table.
+func (v Table0569) Valid() bool { return TableValueLookup[`0569`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0569) Description() string { return tableDescription(`0569`, string(v)) }
+
+// Table0570 is a value of table 0570, Payment Method Code.
+type Table0570 string
+
+const (
+	Table0570_CASH Table0570 = `CASH` // Cash
+	Table0570_CCCA Table0570 = `CCCA` // Credit Card
+	Table0570_CCHK Table0570 = `CCHK` // Cashier's Check
+	Table0570_CDAC Table0570 = `CDAC` // Credit/Debit Account
+	Table0570_CHCK Table0570 = `CHCK` // Check
+	Table0570_DDPO Table0570 = `DDPO` // Direct Deposit
+	Table0570_DEBC Table0570 = `DEBC` // Debit Card
+	Table0570_SWFT Table0570 = `SWFT` // Society for Worldwide Interbank Financial Telecommunications (S.W.I.F.T.)
+	Table0570_TRAC Table0570 = `TRAC` // Traveler's Check
+	Table0570_VISN Table0570 = `VISN` // VISA Special Electronic Funds Transfer Network
+)
+
+// TableID returns the table ID.
+func (Table0570) TableID() string { return `0570` }
+
+// Valid reports if the value is in the table.
+func (v Table0570) Valid() bool { return TableValueLookup[`0570`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0570) Description() string { return tableDescription(`0570`, string(v)) }
+
+// Table0571 is a value of table 0571, Invoice Processing Results Status.
+type Table0571 string
+
+const (
+	Table0571_ACK    Table0571 = `ACK`    // Acknowledge
+	Table0571_ADJ    Table0571 = `ADJ`    // Adjudicated with Adjustments
+	Table0571_ADJSUB Table0571 = `ADJSUB` // Adjudicated as Submitted
+	Table0571_ADJZER Table0571 = `ADJZER` // Adjudicated to Zero
+	Table0571_PAID   Table0571 = `PAID`   // Paid
+	Table0571_PEND   Table0571 = `PEND`   // Pending
+	Table0571_PRED   Table0571 = `PRED`   // Pre-Determination
+	Table0571_REJECT Table0571 = `REJECT` // Reject
+)
+
+// TableID returns the table ID.
+func (Table0571) TableID() string { return `0571` }
+
+// Valid reports if the value is in the table.
+func (v Table0571) Valid() bool { return TableValueLookup[`0571`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0571) Description() string { return tableDescription(`0571`, string(v)) }
+
+// Table0572 is a value of table 0572, Tax status.
+type Table0572 string
+
+const (
+	Table0572_RVAT Table0572 = `RVAT` // Registered in VAT register
+	Table0572_UVAT Table0572 = `UVAT` // Unregistered in VAT register
+)
+
+// TableID returns the table ID.
+func (Table0572) TableID() string { return `0572` }
+
+// Valid reports if the value is in the table.
+func (v Table0572) Valid() bool { return TableValueLookup[`0572`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0572) Description() string { return tableDescription(`0572`, string(v)) }
+
+// Table0615 is a value of table 0615, User Authentication Credential Type Code.
+type Table0615 string
+
+const (
+	Table0615_KERB Table0615 = `KERB` // Kerberos Service Ticket
+	Table0615_SAML Table0615 = `SAML` // Authenticated User Identity Assertion
+)
+
+// TableID returns the table ID.
+func (Table0615) TableID() string { return `0615` }
+
+// Valid reports if the value is in the table.
+func (v Table0615) Valid() bool { return TableValueLookup[`0615`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0615) Description() string { return tableDescription(`0615`, string(v)) }
+
+// Table0616 is a value of table 0616, Address Expiration Reason.
+type Table0616 string
+
+const (
+	Table0616_C Table0616 = `C` // Corrected
+	Table0616_E Table0616 = `E` // Added in error
+	Table0616_M Table0616 = `M` // Moved
+	Table0616_R Table0616 = `R` // On request
+)
+
+// TableID returns the table ID.
+func (Table0616) TableID() string { return `0616` }
+
+// Valid reports if the value is in the table.
+func (v Table0616) Valid() bool { return TableValueLookup[`0616`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0616) Description() string { return tableDescription(`0616`, string(v)) }
+
+// Table0617 is a value of table 0617, Address Usage.
+type Table0617 string
+
+const (
+	Table0617_C Table0617 = `C` // Classification
+	Table0617_M Table0617 = `M` // Mailing
+	Table0617_V Table0617 = `V` // Visit
+)
+
+// TableID returns the table ID.
+func (Table0617) TableID() string { return `0617` }
+
+// Valid reports if the value is in the table.
+func (v Table0617) Valid() bool { return TableValueLookup[`0617`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0617) Description() string { return tableDescription(`0617`, string(v)) }
+
+// Table0618 is a value of table 0618, Protection Code.
+type Table0618 string
+
+const (
+	Table0618_LI Table0618 = `LI` // Listed
+	Table0618_UL Table0618 = `UL` // Unlisted (Should not appear in directories)
+	Table0618_UP Table0618 = `UP` // Unpublished
+)
+
+// TableID returns the table ID.
+func (Table0618) TableID() string { return `0618` }
+
+// Valid reports if the value is in the table.
+func (v Table0618) Valid() bool { return TableValueLookup[`0618`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0618) Description() string { return tableDescription(`0618`, string(v)) }
+
+// Table0625 is a value of table 0625, Item Status Codes.
+type Table0625 string
+
+const (
+	Table0625_1 Table0625 = `1` // Active
+	Table0625_2 Table0625 = `2` // Pending Inactive
+	Table0625_3 Table0625 = `3` // Inactive
+)
+
+// TableID returns the table ID.
+func (Table0625) TableID() string { return `0625` }
+
+// Valid reports if the value is in the table.
+func (v Table0625) Valid() bool { return TableValueLookup[`0625`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0625) Description() string { return tableDescription(`0625`, string(v)) }
+
+// Table0634 is a value of table 0634, Item Importance Codes.
+type Table0634 string
+
+const (
+	Table0634_CRT Table0634 = `CRT` // Critical
+)
+
+// TableID returns the table ID.
+func (Table0634) TableID() string { return `0634` }
+
+// Valid reports if the value is in the table.
+func (v Table0634) Valid() bool { return TableValueLookup[`0634`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0634) Description() string { return tableDescription(`0634`, string(v)) }
+
+// Table0642 is a value of table 0642, Reorder Theory Codes.
+type Table0642 string
+
+const (
+	Table0642_D Table0642 = `D` // DOP/DOQ
+	Table0642_M Table0642 = `M` // MIN/MAX
+	Table0642_O Table0642 = `O` // Override
+)
+
+// TableID returns the table ID.
+func (Table0642) TableID() string { return `0642` }
+
+// Valid reports if the value is in the table.
+func (v Table0642) Valid() bool { return TableValueLookup[`0642`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0642) Description() string { return tableDescription(`0642`, string(v)) }
+
+// Table0651 is a value of table 0651, Labor Calculation Type.
+type Table0651 string
+
+const (
+	Table0651_CST Table0651 = `CST` // Cost
+	Table0651_TME Table0651 = `TME` // Time
+)
+
+// TableID returns the table ID.
+func (Table0651) TableID() string { return `0651` }
+
+// Valid reports if the value is in the table.
+func (v Table0651) Valid() bool { return TableValueLookup[`0651`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0651) Description() string { return tableDescription(`0651`, string(v)) }
+
+// Table0653 is a value of table 0653, Date Format.
+type Table0653 string
+
+const (
+	Table0653_1 Table0653 = `1` // mm/dd/yy
+	Table0653_2 Table0653 = `2` // yy.mm.dd
+	Table0653_3 Table0653 = `3` // dd/mm/yy
+	Table0653_4 Table0653 = `4` // dd.mm.yy
+	Table0653_5 Table0653 = `5` // yy/mm/dd
+	Table0653_6 Table0653 = `6` // Yymmdd
+)
+
+// TableID returns the table ID.
+func (Table0653) TableID() string { return `0653` }
+
+// Valid reports if the value is in the table.
+func (v Table0653) Valid() bool { return TableValueLookup[`0653`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0653) Description() string { return tableDescription(`0653`, string(v)) }
+
+// Table0657 is a value of table 0657, Device Type.
+type Table0657 string
+
+const (
+	Table0657_1 Table0657 = `1` // EO Gas Sterilizer
+	Table0657_2 Table0657 = `2` // Steam Sterilizer
+	Table0657_3 Table0657 = `3` // Peracetic Acid
+)
+
+// TableID returns the table ID.
+func (Table0657) TableID() string { return `0657` }
+
+// Valid reports if the value is in the table.
+func (v Table0657) Valid() bool { return TableValueLookup[`0657`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0657) Description() string { return tableDescription(`0657`, string(v)) }
+
+// Table0659 is a value of table 0659, Lot Control.
+type Table0659 string
+
+const (
+	Table0659_1 Table0659 = `1` // OR Mode Without Operator
+	Table0659_2 Table0659 = `2` // OR Mode with Operator
+	Table0659_3 Table0659 = `3` // CPD Mode Without Operator
+	Table0659_4 Table0659 = `4` // CPD Mode With Operator
+	Table0659_5 Table0659 = `5` // Offline Mode
+)
+
+// TableID returns the table ID.
+func (Table0659) TableID() string { return `0659` }
+
+// Valid reports if the value is in the table.
+func (v Table0659) Valid() bool { return TableValueLookup[`0659`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0659) Description() string { return tableDescription(`0659`, string(v)) }
+
+// Table0667 is a value of table 0667, Device Data State -.
+type Table0667 string
+
+const (
+	Table0667_0 Table0667 = `0` // Real Time Values
+	Table0667_1 Table0667 = `1` // Historic Values
+)
+
+// TableID returns the table ID.
+func (Table0667) TableID() string { return `0667` }
+
+// Valid reports if the value is in the table.
+func (v Table0667) Valid() bool { return TableValueLookup[`0667`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0667) Description() string { return tableDescription(`0667`, string(v)) }
+
+// Table0669 is a value of table 0669, Load Status.
+type Table0669 string
+
+const (
+	Table0669_LCC Table0669 = `LCC` // Load is Complete
+	Table0669_LCN Table0669 = `LCN` // Load Canceled
+	Table0669_LCP Table0669 = `LCP` // Load In Process
+	Table0669_LLD Table0669 = `LLD` // Building a Load
+)
+
+// TableID returns the table ID.
+func (Table0669) TableID() string { return `0669` }
+
+// Valid reports if the value is in the table.
+func (v Table0669) Valid() bool { return TableValueLookup[`0669`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0669) Description() string { return tableDescription(`0669`, string(v)) }
+
+// Table0702 is a value of table 0702, Cycle Type-.
+type Table0702 string
+
+const (
+	Table0702_2RS Table0702 = `2RS` // Second Rinse
+	Table0702_ANR Table0702 = `ANR` // Anesthesia/Respiratory
+	Table0702_BDP Table0702 = `BDP` // Bedpans
+	Table0702_BWD Table0702 = `BWD` // Bowie-Dick Test
+	Table0702_CMW Table0702 = `CMW` // Chemical Wash
+	Table0702_COD Table0702 = `COD` // Code
+	Table0702_CRT Table0702 = `CRT` // Cart Wash
+	Table0702_DEC Table0702 = `DEC` // Decontamination
+	Table0702_DRT Table0702 = `DRT` // Dart
+	Table0702_DRW Table0702 = `DRW` // Dart Warm-up Cycle
+	Table0702_EOH Table0702 = `EOH` // EO High Temperature
+	Table0702_EOL Table0702 = `EOL` // EO Low Temperature
+	Table0702_EXP Table0702 = `EXP` // Express
+	Table0702_FLS Table0702 = `FLS` // Flash
+	Table0702_GLS Table0702 = `GLS` // Glassware
+	Table0702_GNP Table0702 = `GNP` // Gen. Purpose
+	Table0702_GRV Table0702 = `GRV` // Gravity
+	Table0702_GTL Table0702 = `GTL` // Gentle
+	Table0702_ISO Table0702 = `ISO` // Isothermal
+	Table0702_IST Table0702 = `IST` // Instrument Wash
+	Table0702_LKT Table0702 = `LKT` // Leak Test
+	Table0702_LQD Table0702 = `LQD` // Liquid
+	Table0702_OPW Table0702 = `OPW` // Optional Wash
+	Table0702_PEA Table0702 = `PEA` // Peracetic Acid
+	Table0702_PLA Table0702 = `PLA` // Plastic Goods Wash
+	Table0702_PRV Table0702 = `PRV` // Prevac
+	Table0702_RNS Table0702 = `RNS` // Rinse
+	Table0702_SFP Table0702 = `SFP` // Steam Flush Pressure Pulse
+	Table0702_THR Table0702 = `THR` // Thermal
+	Table0702_TRB Table0702 = `TRB` // Tray/Basin
+	Table0702_UTL Table0702 = `UTL` // Utensil Wash
+	Table0702_WFP Table0702 = `WFP` // Wrap/Steam Flush Pressure Pulse (Wrap/SFPP)
+)
+
+// TableID returns the table ID.
+func (Table0702) TableID() string { return `0702` }
+
+// Valid reports if the value is in the table.
+func (v Table0702) Valid() bool { return TableValueLookup[`0702`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0702) Description() string { return tableDescription(`0702`, string(v)) }
+
+// Table0717 is a value of table 0717, Access Restriction Value.
+type Table0717 string
+
+const (
+	Table0717_ALL    Table0717 = `ALL`    // All
+	Table0717_DEM    Table0717 = `DEM`    // All demographic data
+	Table0717_DRG    Table0717 = `DRG`    // Drug
+	Table0717_HIV    Table0717 = `HIV`    // HIV status and results
+	Table0717_LOC    Table0717 = `LOC`    // Patient Location
+	Table0717_NO     Table0717 = `NO`     // None
+	Table0717_OI     Table0717 = `OI`     // Opt in all registries (HIPAA)
+	Table0717_OO     Table0717 = `OO`     // Opt out all registries (HIPAA)
+	Table0717_PID_17 Table0717 = `PID-17` // Religion
+	Table0717_PID_7  Table0717 = `PID-7`  // Date of Birth
+	Table0717_PSY    Table0717 = `PSY`    // Psychiatric Mental health
+	Table0717_SMD    Table0717 = `SMD`    // Sensitive medical data
+	Table0717_STD    Table0717 = `STD`    // Sexually transmitted diseases
+)
+
+// TableID returns the table ID.
+func (Table0717) TableID() string { return `0717` }
+
+// Valid reports if the value is in the table.
+func (v Table0717) Valid() bool { return TableValueLookup[`0717`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0717) Description() string { return tableDescription(`0717`, string(v)) }
+
+// Table0719 is a value of table 0719, Access Restriction Reason Code.
+type Table0719 string
+
+const (
+	Table0719_DIA Table0719 = `DIA` // Diagnosis-related
+	Table0719_EMP Table0719 = `EMP` // Employee of this organization
+	Table0719_ORG Table0719 = `ORG` // Organizational policy or requirement
+	Table0719_PAT Table0719 = `PAT` // Patient Request
+	Table0719_PHY Table0719 = `PHY` // Physician Request
+	Table0719_REG Table0719 = `REG` // Regulatory requirement
+	Table0719_VIP Table0719 = `VIP` // Very important person or celebrity
+)
+
+// TableID returns the table ID.
+func (Table0719) TableID() string { return `0719` }
+
+// Valid reports if the value is in the table.
+func (v Table0719) Valid() bool { return TableValueLookup[`0719`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0719) Description() string { return tableDescription(`0719`, string(v)) }
+
+// Table0725 is a value of table 0725, Mood Codes.
+type Table0725 string
+
+const (
+	Table0725_APT     Table0725 = `APT`     // Appointment
+	Table0725_ARQ     Table0725 = `ARQ`     // Appointment Request
+	Table0725_EVN     Table0725 = `EVN`     // Event
+	Table0725_EVN_CRT Table0725 = `EVN.CRT` // Event Criterion
+	Table0725_EXP     Table0725 = `EXP`     // Expectation
+	Table0725_INT     Table0725 = `INT`     // Intent
+	Table0725_PRMS    Table0725 = `PRMS`    // Promise
+	Table0725_PRP     Table0725 = `PRP`     // Proposal
+	Table0725_RQO     Table0725 = `RQO`     // Request-Order
+)
+
+// TableID returns the table ID.
+func (Table0725) TableID() string { return `0725` }
+
+// Valid reports if the value is in the table.
+func (v Table0725) Valid() bool { return TableValueLookup[`0725`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0725) Description() string { return tableDescription(`0725`, string(v)) }
+
+// Table0728 is a value of table 0728, CCL Value.
+type Table0728 string
+
+const (
+	Table0728_0 Table0728 = `0` // Nothing obvious
+	Table0728_1 Table0728 = `1` // Low
+	Table0728_2 Table0728 = `2` // Moderate
+	Table0728_3 Table0728 = `3` // High
+	Table0728_4 Table0728 = `4` // Very high
+)
+
+// TableID returns the table ID.
+func (Table0728) TableID() string { return `0728` }
+
+// Valid reports if the value is in the table.
+func (v Table0728) Valid() bool { return TableValueLookup[`0728`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0728) Description() string { return tableDescription(`0728`, string(v)) }
+
+// Table0731 is a value of table 0731, DRG Diagnosis Determination Status.
+type Table0731 string
+
+const (
+	Table0731_0 Table0731 = `0` // Valid code
+	Table0731_1 Table0731 = `1` // Invalid code
+	Table0731_2 Table0731 = `2` // Two primary diagnosis codes
+	Table0731_3 Table0731 = `3` // Invalid for this gender
+	Table0731_4 Table0731 = `4` // Invalid for this age
+)
+
+// TableID returns the table ID.
+func (Table0731) TableID() string { return `0731` }
+
+// Valid reports if the value is in the table.
+func (v Table0731) Valid() bool { return TableValueLookup[`0731`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0731) Description() string { return tableDescription(`0731`, string(v)) }
+
+// Table0734 is a value of table 0734, Grouper Status.
+type Table0734 string
+
+const (
+	Table0734_0 Table0734 = `0` // Normal grouping
+	Table0734_1 Table0734 = `1` // Invalid or missing primary diagnosis
+	Table0734_2 Table0734 = `2` // Diagnosis is not allowed to be primary
+	Table0734_3 Table0734 = `3` // Data does not fulfill DRG criteria
+	Table0734_4 Table0734 = `4` // Invalid age, admission date, date of birth or discharge date
+	Table0734_5 Table0734 = `5` // Invalid gender
+	Table0734_6 Table0734 = `6` // Invalid discharge status
+	Table0734_7 Table0734 = `7` // Invalid weight ad admission
+	Table0734_8 Table0734 = `8` // Invalid length of stay
+	Table0734_9 Table0734 = `9` // Invalid field "same day"
+)
+
+// TableID returns the table ID.
+func (Table0734) TableID() string { return `0734` }
+
+// Valid reports if the value is in the table.
+func (v Table0734) Valid() bool { return TableValueLookup[`0734`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0734) Description() string { return tableDescription(`0734`, string(v)) }
+
+// Table0739 is a value of table 0739, Status Patient.
+type Table0739 string
+
+const (
+	Table0739_1 Table0739 = `1` // Normal length of stay
+	Table0739_2 Table0739 = `2` // Short length of stay
+	Table0739_3 Table0739 = `3` // Long length of stay
+)
+
+// TableID returns the table ID.
+func (Table0739) TableID() string { return `0739` }
+
+// Valid reports if the value is in the table.
+func (v Table0739) Valid() bool { return TableValueLookup[`0739`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0739) Description() string { return tableDescription(`0739`, string(v)) }
+
+// Table0742 is a value of table 0742, DRG Status Financial Calculation.
+type Table0742 string
+
+const (
+	Table0742_00 Table0742 = `00` // Effective weight calculated
+	Table0742_01 Table0742 = `01` // Hospital specific contract
+	Table0742_03 Table0742 = `03` // Eeffective weight for transfer/referral calculated
+	Table0742_04 Table0742 = `04` // Referral from other hospital based on a cooperation (no DRG reimbursement)
+	Table0742_05 Table0742 = `05` // Invalid length of stay
+	Table0742_10 Table0742 = `10` // No information/entry in cost data for this DRG
+	Table0742_11 Table0742 = `11` // No relative weight found for department (type)
+)
+
+// TableID returns the table ID.
+func (Table0742) TableID() string { return `0742` }
+
+// Valid reports if the value is in the table.
+func (v Table0742) Valid() bool { return TableValueLookup[`0742`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0742) Description() string { return tableDescription(`0742`, string(v)) }
+
+// Table0749 is a value of table 0749, DRG Grouping Status.
+type Table0749 string
+
+const (
+	Table0749_0 Table0749 = `0` // Valid code; not used for grouping
+	Table0749_1 Table0749 = `1` // Valid code; used for grouping
+	Table0749_2 Table0749 = `2` // Invalid code; not used for grouping
+	Table0749_3 Table0749 = `3` // Invalid code; code is relevant for grouping
+)
+
+// TableID returns the table ID.
+func (Table0749) TableID() string { return `0749` }
+
+// Valid reports if the value is in the table.
+func (v Table0749) Valid() bool { return TableValueLookup[`0749`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0749) Description() string { return tableDescription(`0749`, string(v)) }
+
+// Table0755 is a value of table 0755, Status Weight At Birth.
+type Table0755 string
+
+const (
+	Table0755_0 Table0755 = `0` // No weight reported at admission used for grouping
+	Table0755_1 Table0755 = `1` // Weight reported at admission used for grouping
+	Table0755_2 Table0755 = `2` // Default weight (>2499g) used for grouping
+)
+
+// TableID returns the table ID.
+func (Table0755) TableID() string { return `0755` }
+
+// Valid reports if the value is in the table.
+func (v Table0755) Valid() bool { return TableValueLookup[`0755`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0755) Description() string { return tableDescription(`0755`, string(v)) }
+
+// Table0757 is a value of table 0757, Status Respiration Minutes.
+type Table0757 string
+
+const (
+	Table0757_0 Table0757 = `0` // Respiration minutes not used for grouping
+	Table0757_1 Table0757 = `1` // Listed respiration minutes used for grouping
+	Table0757_2 Table0757 = `2` // OPS code value used for grouping
+)
+
+// TableID returns the table ID.
+func (Table0757) TableID() string { return `0757` }
+
+// Valid reports if the value is in the table.
+func (v Table0757) Valid() bool { return TableValueLookup[`0757`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0757) Description() string { return tableDescription(`0757`, string(v)) }
+
+// Table0759 is a value of table 0759, Status Admission.
+type Table0759 string
+
+const (
+	Table0759_0 Table0759 = `0` // Admission status is valid; used for grouping
+	Table0759_1 Table0759 = `1` // Admission status is valid; not used for grouping
+	Table0759_2 Table0759 = `2` // Admission status is invalid; not used for grouping
+	Table0759_3 Table0759 = `3` // Admission status is invalid; default value used for grouping
+)
+
+// TableID returns the table ID.
+func (Table0759) TableID() string { return `0759` }
+
+// Valid reports if the value is in the table.
+func (v Table0759) Valid() bool { return TableValueLookup[`0759`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0759) Description() string { return tableDescription(`0759`, string(v)) }
+
+// Table0761 is a value of table 0761, DRG Procedure Determination Status.
+type Table0761 string
+
+const (
+	Table0761_0 Table0761 = `0` // Valid code
+	Table0761_1 Table0761 = `1` // Invalid code
+	Table0761_2 Table0761 = `2` // Not used
+	Table0761_3 Table0761 = `3` // Invalid for this gender
+	Table0761_4 Table0761 = `4` // Invalid for this age
+)
+
+// TableID returns the table ID.
+func (Table0761) TableID() string { return `0761` }
+
+// Valid reports if the value is in the table.
+func (v Table0761) Valid() bool { return TableValueLookup[`0761`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0761) Description() string { return tableDescription(`0761`, string(v)) }
+
+// Table0763 is a value of table 0763, DRG Procedure Relevance.
+type Table0763 string
+
+const (
+	Table0763_0 Table0763 = `0` // Neither operation relevant nor non-operation relevant procedure
+	Table0763_1 Table0763 = `1` // Operation relevant procedure
+	Table0763_2 Table0763 = `2` // Non-operation relevant procedure
+)
+
+// TableID returns the table ID.
+func (Table0763) TableID() string { return `0763` }
+
+// Valid reports if the value is in the table.
+func (v Table0763) Valid() bool { return TableValueLookup[`0763`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0763) Description() string { return tableDescription(`0763`, string(v)) }
+
+// Table0771 is a value of table 0771, Resource Type or Category.
+type Table0771 string
+
+// TableID returns the table ID.
+func (Table0771) TableID() string { return `0771` }
+
+// Valid reports if the value is in the table.
+func (v Table0771) Valid() bool { return TableValueLookup[`0771`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0771) Description() string { return tableDescription(`0771`, string(v)) }
+
+// Table0776 is a value of table 0776, Item Status.
+type Table0776 string
+
+const (
+	Table0776_A Table0776 = `A` // Active
+	Table0776_I Table0776 = `I` // Inactive
+	Table0776_P Table0776 = `P` // Pending Inactive
+)
+
+// TableID returns the table ID.
+func (Table0776) TableID() string { return `0776` }
+
+// Valid reports if the value is in the table.
+func (v Table0776) Valid() bool { return TableValueLookup[`0776`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0776) Description() string { return tableDescription(`0776`, string(v)) }
+
+// Table0778 is a value of table 0778, Item Type.
+type Table0778 string
+
+const (
+	Table0778_EQP Table0778 = `EQP` // Equipment
+	Table0778_IMP Table0778 = `IMP` // Implant
+	Table0778_MED Table0778 = `MED` // Medication
+	Table0778_SUP Table0778 = `SUP` // Supply
+	Table0778_TDC Table0778 = `TDC` // Tubes, Drains, and Catheters
+)
+
+// TableID returns the table ID.
+func (Table0778) TableID() string { return `0778` }
+
+// Valid reports if the value is in the table.
+func (v Table0778) Valid() bool { return TableValueLookup[`0778`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0778) Description() string { return tableDescription(`0778`, string(v)) }
+
+// Table0790 is a value of table 0790, Approving Regulatory Agency.
+type Table0790 string
+
+const (
+	Table0790_AMA Table0790 = `AMA` // American Medical Association
+	Table0790_FDA Table0790 = `FDA` // Food and Drug Administration
+)
+
+// TableID returns the table ID.
+func (Table0790) TableID() string { return `0790` }
+
+// Valid reports if the value is in the table.
+func (v Table0790) Valid() bool { return TableValueLookup[`0790`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0790) Description() string { return tableDescription(`0790`, string(v)) }
+
+// Table0793 is a value of table 0793, Ruling Act.
+type Table0793 string
+
+const (
+	Table0793_SMDA Table0793 = `SMDA` // Safe Medical Devices Act
+)
+
+// TableID returns the table ID.
+func (Table0793) TableID() string { return `0793` }
+
+// Valid reports if the value is in the table.
+func (v Table0793) Valid() bool { return TableValueLookup[`0793`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0793) Description() string { return tableDescription(`0793`, string(v)) }
+
+// Table0806 is a value of table 0806, Sterilization Type.
+type Table0806 string
+
+const (
+	Table0806_EOG Table0806 = `EOG` // Ethylene Oxide Gas
+	Table0806_PCA Table0806 = `PCA` // Peracetic acid
+	Table0806_STM Table0806 = `STM` // Steam
+)
+
+// TableID returns the table ID.
+func (Table0806) TableID() string { return `0806` }
+
+// Valid reports if the value is in the table.
+func (v Table0806) Valid() bool { return TableValueLookup[`0806`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0806) Description() string { return tableDescription(`0806`, string(v)) }
+
+// Table0809 is a value of table 0809, Maintenance Cycle.
+type Table0809 string
+
+// TableID returns the table ID.
+func (Table0809) TableID() string { return `0809` }
+
+// Valid reports if the value is in the table.
+func (v Table0809) Valid() bool { return TableValueLookup[`0809`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0809) Description() string { return tableDescription(`0809`, string(v)) }
+
+// Table0811 is a value of table 0811, Maintenance Type.
+type Table0811 string
+
+// TableID returns the table ID.
+func (Table0811) TableID() string { return `0811` }
+
+// Valid reports if the value is in the table.
+func (v Table0811) Valid() bool { return TableValueLookup[`0811`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0811) Description() string { return tableDescription(`0811`, string(v)) }
+
+// Table0818 is a value of table 0818, Package.
+type Table0818 string
+
+const (
+	Table0818_BX  Table0818 = `BX`  // Box
+	Table0818_CS  Table0818 = `CS`  // Case
+	Table0818_EA  Table0818 = `EA`  // Each
+	Table0818_SET Table0818 = `SET` // Set
+)
+
+// TableID returns the table ID.
+func (Table0818) TableID() string { return `0818` }
+
+// Valid reports if the value is in the table.
+func (v Table0818) Valid() bool { return TableValueLookup[`0818`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0818) Description() string { return tableDescription(`0818`, string(v)) }
+
+// Table0834 is a value of table 0834, MIME Types.
+type Table0834 string
+
+const (
+	Table0834_application Table0834 = `application` // Application data
+	Table0834_audio       Table0834 = `audio`       // Audio data
+	Table0834_image       Table0834 = `image`       // Image data
+	Table0834_model       Table0834 = `model`       // Model data
+	Table0834_multipart   Table0834 = `multipart`   // MIME multipart package
+	Table0834_text        Table0834 = `text`        // Text data
+	Table0834_video       Table0834 = `video`       // Video data
+)
+
+// TableID returns the table ID.
+func (Table0834) TableID() string { return `0834` }
+
+// Valid reports if the value is in the table.
+func (v Table0834) Valid() bool { return TableValueLookup[`0834`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0834) Description() string { return tableDescription(`0834`, string(v)) }
+
+// Table0836 is a value of table 0836, Problem Severity.
+type Table0836 string
+
+const (
+	Table0836_No_Values_Defined Table0836 = `No Values Defined`
+)
+
+// TableID returns the table ID.
+func (Table0836) TableID() string { return `0836` }
+
+// Valid reports if the value is in the table.
+func (v Table0836) Valid() bool { return TableValueLookup[`0836`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0836) Description() string { return tableDescription(`0836`, string(v)) }
+
+// Table0838 is a value of table 0838, Problem Perspective.
+type Table0838 string
+
+const (
+	Table0838_No_Values_Defined Table0838 = `No Values Defined`
+)
+
+// TableID returns the table ID.
+func (Table0838) TableID() string { return `0838` }
+
+// Valid reports if the value is in the table.
+func (v Table0838) Valid() bool { return TableValueLookup[`0838`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0838) Description() string { return tableDescription(`0838`, string(v)) }
+
+// Table0865 is a value of table 0865, Referral Documentation Completion Status.
+type Table0865 string
+
+// TableID returns the table ID.
+func (Table0865) TableID() string { return `0865` }
+
+// Valid reports if the value is in the table.
+func (v Table0865) Valid() bool { return TableValueLookup[`0865`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0865) Description() string { return tableDescription(`0865`, string(v)) }
+
+// Table0868 is a value of table 0868, Telecommunication Expiration Reason.
+type Table0868 string
+
+const (
+	Table0868_C Table0868 = `C` // Corrected
+	Table0868_E Table0868 = `E` // Added in error
+	Table0868_M Table0868 = `M` // Moved
+	Table0868_N Table0868 = `N` // No longer in service
+	Table0868_R Table0868 = `R` // On request
+)
+
+// TableID returns the table ID.
+func (Table0868) TableID() string { return `0868` }
+
+// Valid reports if the value is in the table.
+func (v Table0868) Valid() bool { return TableValueLookup[`0868`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0868) Description() string { return tableDescription(`0868`, string(v)) }
+
+// Table0871 is a value of table 0871, Supply Risk Codes.
+type Table0871 string
+
+const (
+	Table0871_COR Table0871 = `COR` // Corrosive
+	Table0871_EXP Table0871 = `EXP` // Explosive
+	Table0871_FLA Table0871 = `FLA` // Flammable
+	Table0871_INJ Table0871 = `INJ` // Injury Hazard
+	Table0871_RAD Table0871 = `RAD` // Radioactive
+	Table0871_TOX Table0871 = `TOX` // Toxic
+	Table0871_UNK Table0871 = `UNK` // Unknown
+)
+
+// TableID returns the table ID.
+func (Table0871) TableID() string { return `0871` }
+
+// Valid reports if the value is in the table.
+func (v Table0871) Valid() bool { return TableValueLookup[`0871`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0871) Description() string { return tableDescription(`0871`, string(v)) }
+
+// Table0879 is a value of table 0879, Product/Service Code.
+type Table0879 string
+
+// TableID returns the table ID.
+func (Table0879) TableID() string { return `0879` }
+
+// Valid reports if the value is in the table.
+func (v Table0879) Valid() bool { return TableValueLookup[`0879`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0879) Description() string { return tableDescription(`0879`, string(v)) }
+
+// Table0880 is a value of table 0880, Product/Service Code Modifier.
+type Table0880 string
+
+// TableID returns the table ID.
+func (Table0880) TableID() string { return `0880` }
+
+// Valid reports if the value is in the table.
+func (v Table0880) Valid() bool { return TableValueLookup[`0880`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0880) Description() string { return tableDescription(`0880`, string(v)) }
+
+// Table0881 is a value of table 0881, Role Executing Physician.
+type Table0881 string
+
+const (
+	Table0881_B Table0881 = `B` // Both
+	Table0881_P Table0881 = `P` // Professional Part
+	Table0881_T Table0881 = `T` // Technical Part
+)
+
+// TableID returns the table ID.
+func (Table0881) TableID() string { return `0881` }
+
+// Valid reports if the value is in the table.
+func (v Table0881) Valid() bool { return TableValueLookup[`0881`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0881) Description() string { return tableDescription(`0881`, string(v)) }
+
+// Table0882 is a value of table 0882, Medical Role Executing Physician.
+type Table0882 string
+
+const (
+	Table0882_E  Table0882 = `E`  // Employed
+	Table0882_SE Table0882 = `SE` // Self-employed
+)
+
+// TableID returns the table ID.
+func (Table0882) TableID() string { return `0882` }
+
+// Valid reports if the value is in the table.
+func (v Table0882) Valid() bool { return TableValueLookup[`0882`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0882) Description() string { return tableDescription(`0882`, string(v)) }
+
+// Table0894 is a value of table 0894, Side of body.
+type Table0894 string
+
+const (
+	Table0894_L Table0894 = `L` // Left
+	Table0894_R Table0894 = `R` // Right
+)
+
+// TableID returns the table ID.
+func (Table0894) TableID() string { return `0894` }
+
+// Valid reports if the value is in the table.
+func (v Table0894) Valid() bool { return TableValueLookup[`0894`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0894) Description() string { return tableDescription(`0894`, string(v)) }
+
+// Table0895 is a value of table 0895, Present On Admission (POA) Indicator.
+type Table0895 string
+
+const (
+	Table0895_E Table0895 = `E` // Exempt
+	Table0895_N Table0895 = `N` // No
+	Table0895_U Table0895 = `U` // Unknown
+	Table0895_W Table0895 = `W` // Not applicable
+	Table0895_Y Table0895 = `Y` // Yes
+)
+
+// TableID returns the table ID.
+func (Table0895) TableID() string { return `0895` }
+
+// Valid reports if the value is in the table.
+func (v Table0895) Valid() bool { return TableValueLookup[`0895`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0895) Description() string { return tableDescription(`0895`, string(v)) }
+
+// Table0904 is a value of table 0904, Security Check Scheme.
+type Table0904 string
+
+const (
+	Table0904_BCV Table0904 = `BCV` // Bank Card Validation Number
+	Table0904_CCS Table0904 = `CCS` // Credit Card Security code
+	Table0904_VID Table0904 = `VID` // Version ID
+)
+
+// TableID returns the table ID.
+func (Table0904) TableID() string { return `0904` }
+
+// Valid reports if the value is in the table.
+func (v Table0904) Valid() bool { return TableValueLookup[`0904`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0904) Description() string { return tableDescription(`0904`, string(v)) }
+
+// Table0905 is a value of table 0905, Shipment Status.
+type Table0905 string
+
+const (
+	Table0905_INV Table0905 = `INV` // Inventoried
+	Table0905_ONH Table0905 = `ONH` // On Hold
+	Table0905_PRC Table0905 = `PRC` // Processing
+	Table0905_REJ Table0905 = `REJ` // Rejected
+	Table0905_TRN Table0905 = `TRN` // In Transit
+	Table0905_TTL Table0905 = `TTL` // Triaged to Lab
+)
+
+// TableID returns the table ID.
+func (Table0905) TableID() string { return `0905` }
+
+// Valid reports if the value is in the table.
+func (v Table0905) Valid() bool { return TableValueLookup[`0905`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0905) Description() string { return tableDescription(`0905`, string(v)) }
+
+// Table0906 is a value of table 0906, ActPriority.
+type Table0906 string
+
+const (
+	Table0906_A   Table0906 = `A`   // ASAP - As soon as possible, next highest priority after stat
+	Table0906_CR  Table0906 = `CR`  // Callback results - filler should contact the placer as soon as results are available, even for preliminary results
+	Table0906_CS  Table0906 = `CS`  // Callback for scheduling - Filler should contact the placer (or target) to schedule the service.
+	Table0906_CSP Table0906 = `CSP` // Callback placer for scheduling - filler should contact the placer to schedule the service
+	Table0906_CSR Table0906 = `CSR` // Contact recipient for scheduling - Filler should contact the service recipient (target) to schedule the service
+	Table0906_EL  Table0906 = `EL`  // Elective - Beneficial to the patient but not essential for survival.
+	Table0906_EM  Table0906 = `EM`  // Emergency - An unforeseen combination of circumstances or the resulting state that calls for immediate action
+	Table0906_P   Table0906 = `P`   // Preop - Used to indicate that a service is to be performed prior to a scheduled surgery. When ordering a service and using the pre-op priority, a check is done to see the amount of time that must be allowed for performance of the service. When the order
+	Table0906_PRN Table0906 = `PRN` // As needed - An "as needed" order should be accompanied by a description of what constitutes a need. This description is represented by an observation service predicate as a precondition.
+	Table0906_R   Table0906 = `R`   // Routine - Routine service, do at usual work hours
+	Table0906_RR  Table0906 = `RR`  // Rush reporting - A report should be prepared and sent as quickly as possible
+	Table0906_S   Table0906 = `S`   // Stat - With highest priority (e.g. emergency).
+	Table0906_T   Table0906 = `T`   // Timing critical - It is critical to come as close as possible to the requested time (e.g. for a through antimicrobial level).
+	Table0906_UD  Table0906 = `UD`  // Use as directed - Drug is to be used as directed by the prescriber.
+	Table0906_UR  Table0906 = `UR`  // Urgent - Calls for prompt action
+)
+
+// TableID returns the table ID.
+func (Table0906) TableID() string { return `0906` }
+
+// Valid reports if the value is in the table.
+func (v Table0906) Valid() bool { return TableValueLookup[`0906`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0906) Description() string { return tableDescription(`0906`, string(v)) }
+
+// Table0907 is a value of table 0907, Confidentiality.
+type Table0907 string
+
+const (
+	Table0907_B   Table0907 = `B`   // Business - Since the service class can represent knowledge structures that may be considered a trade or business secret, there is sometimes (though rarely) the need to flag those items as of business level confidentiality. However, no patient related inf
+	Table0907_C   Table0907 = `C`   // Celebrity - Celebrities are people of public interest (VIP) including employees, whose information require special protection.
+	Table0907_D   Table0907 = `D`   // Clinician - Only clinicians may see this item, billing and administration persons can not access this item without special permission.
+	Table0907_ETH Table0907 = `ETH` // Substance abuse related - Alcohol/drug-abuse related item
+	Table0907_HIV Table0907 = `HIV` // HIV Related - HIV and AIDS related item
+	Table0907_I   Table0907 = `I`   // Individual - Access only to individual persons who are mentioned explicitly as actors of this service and whose actor type warrants that access (cf. to actor typed code).
+	Table0907_L   Table0907 = `L`   // Low - No patient record item can be of low confidentiality. However, some service objects are not patient related and therefore may have low confidentiality.
+	Table0907_N   Table0907 = `N`   // Normal - Normal confidentiality rules (according to good health care practice) apply, that is, only authorized individuals with a legitimate medical or business need may access this item.
+	Table0907_PSY Table0907 = `PSY` // Psychiatry related - Psychiatry related item
+	Table0907_R   Table0907 = `R`   // Restricted - Restricted access, e.g. only to providers having a current care relationship to the patient.
+	Table0907_S   Table0907 = `S`   // Sensitive - Information for which the patient seeks heightened confidentiality. Sensitive information is not to be shared with family members. Information reported by the patient about family members is sensitive by default. Flag can be set or cleared
+	Table0907_SDV Table0907 = `SDV` // Sexual and domestic violence related - Sexual assault / domestic violence related item
+	Table0907_T   Table0907 = `T`   // Taboo - Information not to be disclosed or discussed with patient except through physician assigned to patient in this case. This is usually a temporary constraint only; example use is a new fatal diagnosis or finding, such as malignancy or HIV.
+	Table0907_V   Table0907 = `V`   // Very restricted - Very restricted access as declared by the Privacy Officer of the record holder.
+)
+
+// TableID returns the table ID.
+func (Table0907) TableID() string { return `0907` }
+
+// Valid reports if the value is in the table.
+func (v Table0907) Valid() bool { return TableValueLookup[`0907`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0907) Description() string { return tableDescription(`0907`, string(v)) }
+
+// Table0908 is a value of table 0908, Package Type.
+type Table0908 string
+
+// TableID returns the table ID.
+func (Table0908) TableID() string { return `0908` }
+
+// Valid reports if the value is in the table.
+func (v Table0908) Valid() bool { return TableValueLookup[`0908`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0908) Description() string { return tableDescription(`0908`, string(v)) }
+
+// Table0909 is a value of table 0909, Patient Results Release Categorization Scheme.
+type Table0909 string
+
+const (
+	Table0909_SID  Table0909 = `SID`  // Share In1 Day -<p>Share result regardless of reference/therapeutic range after 1 or more business day as agreed to by the systems in play.
+	Table0909_SIDC Table0909 = `SIDC` // Share in 1 Day Conditionally -<p>Share result in reference ranges/therapeutic with patient after 1 or more business day as agreed to by the systems in play.<p>Withhold result out of reference/therapeutic range until physician release
+	Table0909_SIMM Table0909 = `SIMM` // Share Immediately -<p>Share result with patient immediately
+	Table0909_STBD Table0909 = `STBD` // Share To Be Determined -<p>Category to be determined
+	Table0909_SWNL Table0909 = `SWNL` // Share Within Normal Limits -<p>Share result in reference/therapeutic range with patient immediately<p>Share result out of reference/therapeutic ranges with patient after 1 or more business day as agreed to by the systems in play.
+	Table0909_SWTH Table0909 = `SWTH` // Share Withhold -<p>Withhold result regardless of reference/therapeutic ranges
+)
+
+// TableID returns the table ID.
+func (Table0909) TableID() string { return `0909` }
+
+// Valid reports if the value is in the table.
+func (v Table0909) Valid() bool { return TableValueLookup[`0909`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0909) Description() string { return tableDescription(`0909`, string(v)) }
+
+// Table0910 is a value of table 0910, Acquisition Modality.
+type Table0910 string
+
+// TableID returns the table ID.
+func (Table0910) TableID() string { return `0910` }
+
+// Valid reports if the value is in the table.
+func (v Table0910) Valid() bool { return TableValueLookup[`0910`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0910) Description() string { return tableDescription(`0910`, string(v)) }
+
+// Table0912 is a value of table 0912, Participation.
+type Table0912 string
+
+const (
+	Table0912_AD    Table0912 = `AD`    // Admitting Provider
+	Table0912_AI    Table0912 = `AI`    // Assistant/Alternate Interpreter
+	Table0912_AP    Table0912 = `AP`    // Administering Provider
+	Table0912_ARI   Table0912 = `ARI`   // Assistant Result Interpreter
+	Table0912_AT    Table0912 = `AT`    // Attending Provider
+	Table0912_AUT   Table0912 = `AUT`   // AUT Author/Event Initiator
+	Table0912_CP    Table0912 = `CP`    // Consulting Provider
+	Table0912_DP    Table0912 = `DP`    // Dispensing Provider
+	Table0912_EP    Table0912 = `EP`    // Entering Provider (probably not the same as transcriptionist)
+	Table0912_EQUIP Table0912 = `EQUIP` // Equipment
+	Table0912_FHCP  Table0912 = `FHCP`  // Family Health Care Professional
+	Table0912_MDIR  Table0912 = `MDIR`  // Medical Director
+	Table0912_OP    Table0912 = `OP`    // Ordering Provider
+	Table0912_PB    Table0912 = `PB`    // Packed by
+	Table0912_PH    Table0912 = `PH`    // Pharmacist (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912_PI    Table0912 = `PI`    // Primary Interpreter
+	Table0912_PO    Table0912 = `PO`    // Performing Organization
+	Table0912_POMD  Table0912 = `POMD`  // Performing Organization Medical Director
+	Table0912_PP    Table0912 = `PP`    // Primary Care Provider
+	Table0912_PRI   Table0912 = `PRI`   // Principal Result Interpreter
+	Table0912_RCT   Table0912 = `RCT`   // Results Copies To
+	Table0912_RO    Table0912 = `RO`    // Responsible Observer
+	Table0912_RP    Table0912 = `RP`    // Referring Provider
+	Table0912_RT    Table0912 = `RT`    // Referred to Provider
+	Table0912_SB    Table0912 = `SB`    // Send by
+	Table0912_SC    Table0912 = `SC`    // Specimen Collector
+	Table0912_TN    Table0912 = `TN`    // Technician
+	Table0912_TR    Table0912 = `TR`    // Transcriptionist
+	Table0912_VP    Table0912 = `VP`    // Verifying Provider
+	Table0912_VPS   Table0912 = `VPS`   // Verifying Pharmaceutical Supplier (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912_VTS   Table0912 = `VTS`   // Verifying Treatment Supplier (not sure how to dissect Pharmacist/Treatment Supplier's Verifier ID)
+	Table0912_WAY   Table0912 = `WAY`   // Waypoint
+	Table0912_WAYR  Table0912 = `WAYR`  // Waypoint Recipient
+)
+
+// TableID returns the table ID.
+func (Table0912) TableID() string { return `0912` }
+
+// Valid reports if the value is in the table.
+func (v Table0912) Valid() bool { return TableValueLookup[`0912`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0912) Description() string { return tableDescription(`0912`, string(v)) }
+
+// Table0913 is a value of table 0913, Monetary Denomination Code.
+type Table0913 string
+
+const (
+	Table0913_AED Table0913 = `AED` // UAE Dirham
+	Table0913_AFN Table0913 = `AFN` // Afghani
+	Table0913_ALL Table0913 = `ALL` // Lek
+	Table0913_AMD Table0913 = `AMD` // Armenian Dram
+	Table0913_ANG Table0913 = `ANG` // Netherlands Antillean Guilder
+	Table0913_AOA Table0913 = `AOA` // Kwanza
+	Table0913_ARS Table0913 = `ARS` // Argentine Peso
+	Table0913_AUD Table0913 = `AUD` // Australian Dollar
+	Table0913_AWG Table0913 = `AWG` // Aruban Florin
+	Table0913_AZN Table0913 = `AZN` // Azerbaijan Manat
+	Table0913_BAM Table0913 = `BAM` // Convertible Mark
+	Table0913_BBD Table0913 = `BBD` // Barbados Dollar
+	Table0913_BDT Table0913 = `BDT` // Taka
+	Table0913_BGN Table0913 = `BGN` // Bulgarian Lev
+	Table0913_BHD Table0913 = `BHD` // Bahraini Dinar
+	Table0913_BIF Table0913 = `BIF` // Burundi Franc
+	Table0913_BMD Table0913 = `BMD` // Bermudian Dollar
+	Table0913_BND Table0913 = `BND` // Brunei Dollar
+	Table0913_BOB Table0913 = `BOB` // Boliviano
+	Table0913_BOV Table0913 = `BOV` // Mvdol
+	Table0913_BRL Table0913 = `BRL` // Brazilian Real
+	Table0913_BSD Table0913 = `BSD` // Bahamian Dollar
+	Table0913_BTN Table0913 = `BTN` // Ngultrum
+	Table0913_BWP Table0913 = `BWP` // Pula
+	Table0913_BYN Table0913 = `BYN` // Belarusian Ruble
+	Table0913_BZD Table0913 = `BZD` // Belize Dollar
+	Table0913_CAD Table0913 = `CAD` // Canadian Dollar
+	Table0913_CDF Table0913 = `CDF` // Congolese Franc
+	Table0913_CHE Table0913 = `CHE` // WIR Euro
+	Table0913_CHF Table0913 = `CHF` // Swiss Franc
+	Table0913_CHW Table0913 = `CHW` // WIR Franc
+	Table0913_CLF Table0913 = `CLF` // Unidad de Fomento
+	Table0913_CLP Table0913 = `CLP` // Chilean Peso
+	Table0913_CNY Table0913 = `CNY` // Yuan Renminbi
+	Table0913_COP Table0913 = `COP` // Colombian Peso
+	Table0913_COU Table0913 = `COU` // Unidad de Valor Real
+	Table0913_CRC Table0913 = `CRC` // Costa Rican Colon
+	Table0913_CUC Table0913 = `CUC` // Peso Convertible
+	Table0913_CUP Table0913 = `CUP` // Cuban Peso
+	Table0913_CVE Table0913 = `CVE` // Cabo Verde Escudo
+	Table0913_CZK Table0913 = `CZK` // Czech Koruna
+	Table0913_DJF Table0913 = `DJF` // Djibouti Franc
+	Table0913_DKK Table0913 = `DKK` // Danish Krone
+	Table0913_DOP Table0913 = `DOP` // Dominican Peso
+	Table0913_DZD Table0913 = `DZD` // Algerian Dinar
+	Table0913_EGP Table0913 = `EGP` // Egyptian Pound
+	Table0913_ERN Table0913 = `ERN` // Nakfa
+	Table0913_ETB Table0913 = `ETB` // Ethiopian Birr
+	Table0913_EUR Table0913 = `EUR` // Euro
+	Table0913_FJD Table0913 = `FJD` // Fiji Dollar
+	Table0913_FKP Table0913 = `FKP` // Falkland Islands Pound
+	Table0913_GBP Table0913 = `GBP` // Pound Sterling
+	Table0913_GEL Table0913 = `GEL` // Lari
+	Table0913_GHS Table0913 = `GHS` // Ghana Cedi
+	Table0913_GIP Table0913 = `GIP` // Gibraltar Pound
+	Table0913_GMD Table0913 = `GMD` // Dalasi
+	Table0913_GNF Table0913 = `GNF` // Guinean Franc
+	Table0913_GTQ Table0913 = `GTQ` // Quetzal
+	Table0913_GYD Table0913 = `GYD` // Guyana Dollar
+	Table0913_HKD Table0913 = `HKD` // Hong Kong Dollar
+	Table0913_HNL Table0913 = `HNL` // Lempira
+	Table0913_HRK Table0913 = `HRK` // Kuna
+	Table0913_HTG Table0913 = `HTG` // Gourde
+	Table0913_HUF Table0913 = `HUF` // Forint
+	Table0913_IDR Table0913 = `IDR` // Rupiah
+	Table0913_ILS Table0913 = `ILS` // New Israeli Sheqel
+	Table0913_INR Table0913 = `INR` // Indian Rupee
+	Table0913_IQD Table0913 = `IQD` // Iraqi Dinar
+	Table0913_IRR Table0913 = `IRR` // Iranian Rial
+	Table0913_ISK Table0913 = `ISK` // Iceland Krona
+	Table0913_JMD Table0913 = `JMD` // Jamaican Dollar
+	Table0913_JOD Table0913 = `JOD` // Jordanian Dinar
+	Table0913_JPY Table0913 = `JPY` // Yen
+	Table0913_KES Table0913 = `KES` // Kenyan Shilling
+	Table0913_KGS Table0913 = `KGS` // Som
+	Table0913_KHR Table0913 = `KHR` // Riel
+	Table0913_KMF Table0913 = `KMF` // Comorian Franc
+	Table0913_KPW Table0913 = `KPW` // North Korean Won
+	Table0913_KRW Table0913 = `KRW` // Won
+	Table0913_KWD Table0913 = `KWD` // Kuwaiti Dinar
+	Table0913_KYD Table0913 = `KYD` // Cayman Islands Dollar
+	Table0913_KZT Table0913 = `KZT` // Tenge
+	Table0913_LAK Table0913 = `LAK` // Lao Kip
+	Table0913_LBP Table0913 = `LBP` // Lebanese Pound
+	Table0913_LKR Table0913 = `LKR` // Sri Lanka Rupee
+	Table0913_LRD Table0913 = `LRD` // Liberian Dollar
+	Table0913_LSL Table0913 = `LSL` // Loti
+	Table0913_LYD Table0913 = `LYD` // Libyan Dinar
+	Table0913_MAD Table0913 = `MAD` // Moroccan Dirham
+	Table0913_MDL Table0913 = `MDL` // Moldovan Leu
+	Table0913_MGA Table0913 = `MGA` // Malagasy Ariary
+	Table0913_MKD Table0913 = `MKD` // Denar
+	Table0913_MMK Table0913 = `MMK` // Kyat
+	Table0913_MNT Table0913 = `MNT` // Tugrik
+	Table0913_MOP Table0913 = `MOP` // Pataca
+	Table0913_MRU Table0913 = `MRU` // Ouguiya
+	Table0913_MUR Table0913 = `MUR` // Mauritius Rupee
+	Table0913_MVR Table0913 = `MVR` // Rufiyaa
+	Table0913_MWK Table0913 = `MWK` // Malawi Kwacha
+	Table0913_MXN Table0913 = `MXN` // Mexican Peso
+	Table0913_MXV Table0913 = `MXV` // Mexican Unidad de Inversion (UDI)
+	Table0913_MYR Table0913 = `MYR` // Malaysian Ringgit
+	Table0913_MZN Table0913 = `MZN` // Mozambique Metical
+	Table0913_NAD Table0913 = `NAD` // Namibia Dollar
+	Table0913_NGN Table0913 = `NGN` // Naira
+	Table0913_NIO Table0913 = `NIO` // Cordoba Oro
+	Table0913_NOK Table0913 = `NOK` // Norwegian Krone
+	Table0913_NPR Table0913 = `NPR` // Nepalese Rupee
+	Table0913_NZD Table0913 = `NZD` // New Zealand Dollar
+	Table0913_OMR Table0913 = `OMR` // Rial Omani
+	Table0913_PAB Table0913 = `PAB` // Balboa
+	Table0913_PEN Table0913 = `PEN` // Sol
+	Table0913_PGK Table0913 = `PGK` // Kina
+	Table0913_PHP Table0913 = `PHP` // Philippine Peso
+	Table0913_PKR Table0913 = `PKR` // Pakistan Rupee
+	Table0913_PLN Table0913 = `PLN` // Zloty
+	Table0913_PYG Table0913 = `PYG` // Guarani
+	Table0913_QAR Table0913 = `QAR` // Qatari Rial
+	Table0913_RON Table0913 = `RON` // Romanian Leu
+	Table0913_RSD Table0913 = `RSD` // Serbian Dinar
+	Table0913_RUB Table0913 = `RUB` // Russian Ruble
+	Table0913_RWF Table0913 = `RWF` // Rwanda Franc
+	Table0913_SAR Table0913 = `SAR` // Saudi Riyal
+	Table0913_SBD Table0913 = `SBD` // Solomon Islands Dollar
+	Table0913_SCR Table0913 = `SCR` // Seychelles Rupee
+	Table0913_SDG Table0913 = `SDG` // Sudanese Pound
+	Table0913_SEK Table0913 = `SEK` // Swedish Krona
+	Table0913_SGD Table0913 = `SGD` // Singapore Dollar
+	Table0913_SHP Table0913 = `SHP` // Saint Helena Pound
+	Table0913_SLL Table0913 = `SLL` // Leone
+	Table0913_SOS Table0913 = `SOS` // Somali Shilling
+	Table0913_SRD Table0913 = `SRD` // Surinam Dollar
+	Table0913_SSP Table0913 = `SSP` // South Sudanese Pound
+	Table0913_STN Table0913 = `STN` // Dobra
+	Table0913_SVC Table0913 = `SVC` // El Salvador Colon
+	Table0913_SYP Table0913 = `SYP` // Syrian Pound
+	Table0913_SZL Table0913 = `SZL` // Lilangeni
+	Table0913_THB Table0913 = `THB` // Baht
+	Table0913_TJS Table0913 = `TJS` // Somoni
+	Table0913_TMT Table0913 = `TMT` // Turkmenistan New Manat
+	Table0913_TND Table0913 = `TND` // Tunisian Dinar
+	Table0913_TOP Table0913 = `TOP` // Pa’anga
+	Table0913_TRY Table0913 = `TRY` // Turkish Lira
+	Table0913_TTD Table0913 = `TTD` // Trinidad and Tobago Dollar
+	Table0913_TWD Table0913 = `TWD` // New Taiwan Dollar
+	Table0913_TZS Table0913 = `TZS` // Tanzanian Shilling
+	Table0913_UAH Table0913 = `UAH` // Hryvnia
+	Table0913_UGX Table0913 = `UGX` // Uganda Shilling
+	Table0913_USD Table0913 = `USD` // US Dollar
+	Table0913_USN Table0913 = `USN` // US Dollar (Next day)
+	Table0913_UYI Table0913 = `UYI` // Uruguay Peso en Unidades Indexadas (UI)
+	Table0913_UYU Table0913 = `UYU` // Peso Uruguayo
+	Table0913_UYW Table0913 = `UYW` // Unidad Previsional
+	Table0913_UZS Table0913 = `UZS` // Uzbekistan Sum
+	Table0913_VES Table0913 = `VES` // Bolívar Soberano
+	Table0913_VND Table0913 = `VND` // Dong
+	Table0913_VUV Table0913 = `VUV` // Vatu
+	Table0913_WST Table0913 = `WST` // Tala
+	Table0913_XAF Table0913 = `XAF` // CFA Franc BEAC
+	Table0913_XAG Table0913 = `XAG` // Silver
+	Table0913_XAU Table0913 = `XAU` // Gold
+	Table0913_XBA Table0913 = `XBA` // Bond Markets Unit European Composite Unit (EURCO)
+	Table0913_XBB Table0913 = `XBB` // Bond Markets Unit European Monetary Unit (E.M.U.-6)
+	Table0913_XBC Table0913 = `XBC` // Bond Markets Unit European Unit of Account 9 (E.U.A.-9)
+	Table0913_XBD Table0913 = `XBD` // Bond Markets Unit European Unit of Account 17 (E.U.A.-17)
+	Table0913_XCD Table0913 = `XCD` // East Caribbean Dollar
+	Table0913_XDR Table0913 = `XDR` // SDR (Special Drawing Right)
+	Table0913_XOF Table0913 = `XOF` // CFA Franc BCEAO
+	Table0913_XPD Table0913 = `XPD` // Palladium
+	Table0913_XPF Table0913 = `XPF` // CFP Franc
+	Table0913_XPT Table0913 = `XPT` // Platinum
+	Table0913_XSU Table0913 = `XSU` // Sucre
+	Table0913_XTS Table0913 = `XTS` // Codes specifically reserved for testing purposes
+	Table0913_XUA Table0913 = `XUA` // ADB Unit of Account
+	Table0913_XXX Table0913 = `XXX` // The codes assigned for transactions where no currency is involved
+	Table0913_YER Table0913 = `YER` // Yemeni Rial
+	Table0913_ZAR Table0913 = `ZAR` // Rand
+	Table0913_ZMW Table0913 = `ZMW` // Zambian Kwacha
+	Table0913_ZWL Table0913 = `ZWL` // Zimbabwe Dollar
+)
+
+// TableID returns the table ID.
+func (Table0913) TableID() string { return `0913` }
+
+// Valid reports if the value is in the table.
+func (v Table0913) Valid() bool { return TableValueLookup[`0913`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0913) Description() string { return tableDescription(`0913`, string(v)) }
+
+// Table0916 is a value of table 0916, Relevant Clinical Information.
+type Table0916 string
+
+const (
+	Table0916_F   Table0916 = `F`   // Patient was fasting prior to the procedure
+	Table0916_FNA Table0916 = `FNA` // Fasting not asked of the patient at time of procedure
+	Table0916_NF  Table0916 = `NF`  // The patient indicated they did not fast prior to the procedure
+	Table0916_NG  Table0916 = `NG`  // Not Given – Patient was not asked at the time of the procedure
+)
+
+// TableID returns the table ID.
+func (Table0916) TableID() string { return `0916` }
+
+// Valid reports if the value is in the table.
+func (v Table0916) Valid() bool { return TableValueLookup[`0916`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table0916) Description() string { return tableDescription(`0916`, string(v)) }
+
+// Table9999 is a value of table 9999, no table for CE.
+type Table9999 string
+
+// TableID returns the table ID.
+func (Table9999) TableID() string { return `9999` }
+
+// Valid reports if the value is in the table.
+func (v Table9999) Valid() bool { return TableValueLookup[`9999`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v Table9999) Description() string { return tableDescription(`9999`, string(v)) }
+
+// TableCity is a value of table City, City.
+type TableCity string
+
+const (
+	TableCity_Albuquerque                 TableCity = `Albuquerque`
+	TableCity_Arlington                   TableCity = `Arlington`
+	TableCity_Atlanta                     TableCity = `Atlanta`
+	TableCity_Austin                      TableCity = `Austin`
+	TableCity_Baltimore                   TableCity = `Baltimore`
+	TableCity_Boston                      TableCity = `Boston`
+	TableCity_Charlotte                   TableCity = `Charlotte`
+	TableCity_Chicago                     TableCity = `Chicago`
+	TableCity_Cleveland                   TableCity = `Cleveland`
+	TableCity_Colorado_Springs            TableCity = `Colorado Springs`
+	TableCity_Columbus                    TableCity = `Columbus`
+	TableCity_Dallas                      TableCity = `Dallas`
+	TableCity_Denver                      TableCity = `Denver`
+	TableCity_Detroit                     TableCity = `Detroit`
+	TableCity_El_Paso                     TableCity = `El Paso`
+	TableCity_Fort_Worth                  TableCity = `Fort Worth`
+	TableCity_Fresno                      TableCity = `Fresno`
+	TableCity_Honolulu                    TableCity = `Honolulu`
+	TableCity_Houston                     TableCity = `Houston`
+	TableCity_Indianapolis                TableCity = `Indianapolis`
+	TableCity_Jacksonville                TableCity = `Jacksonville`
+	TableCity_Kansas_City                 TableCity = `Kansas City`
+	TableCity_Las_Vegas                   TableCity = `Las Vegas`
+	TableCity_Long_Beach                  TableCity = `Long Beach`
+	TableCity_Los_Angeles                 TableCity = `Los Angeles`
+	TableCity_Louisville_Jefferson_County TableCity = `Louisville-Jefferson County`
+	TableCity_Memphis                     TableCity = `Memphis`
+	TableCity_Mesa                        TableCity = `Mesa`
+	TableCity_Miami                       TableCity = `Miami`
+	TableCity_Milwaukee                   TableCity = `Milwaukee`
+	TableCity_Minneapolis                 TableCity = `Minneapolis`
+	TableCity_Nashville_Davidson          TableCity = `Nashville-Davidson`
+	TableCity_New_York                    TableCity = `New York`
+	TableCity_Oakland                     TableCity = `Oakland`
+	TableCity_Oklahoma_City               TableCity = `Oklahoma City`
+	TableCity_Omaha                       TableCity = `Omaha`
+	TableCity_Philadelphia                TableCity = `Philadelphia`
+	TableCity_Phoenix                     TableCity = `Phoenix`
+	TableCity_Portland                    TableCity = `Portland`
+	TableCity_Raleigh                     TableCity = `Raleigh`
+	TableCity_Sacramento                  TableCity = `Sacramento`
+	TableCity_San_Antonio                 TableCity = `San Antonio`
+	TableCity_San_Diego                   TableCity = `San Diego`
+	TableCity_San_Francisco               TableCity = `San Francisco`
+	TableCity_San_Jose                    TableCity = `San Jose`
+	TableCity_Seattle                     TableCity = `Seattle`
+	TableCity_Tucson                      TableCity = `Tucson`
+	TableCity_Tulsa                       TableCity = `Tulsa`
+	TableCity_Virginia_Beach              TableCity = `Virginia Beach`
+	TableCity_Washington                  TableCity = `Washington`
+)
+
+// TableID returns the table ID.
+func (TableCity) TableID() string { return `City` }
+
+// Valid reports if the value is in the table.
+func (v TableCity) Valid() bool { return TableValueLookup[`City`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableCity) Description() string { return tableDescription(`City`, string(v)) }
+
+// TableFirstName is a value of table FirstName, First Name.
+type TableFirstName string
+
+const (
+	TableFirstName_Aaron       TableFirstName = `Aaron`
+	TableFirstName_Abby        TableFirstName = `Abby`
+	TableFirstName_Abe         TableFirstName = `Abe`
+	TableFirstName_Abel        TableFirstName = `Abel`
+	TableFirstName_Abigail     TableFirstName = `Abigail`
+	TableFirstName_Abraham     TableFirstName = `Abraham`
+	TableFirstName_Ada         TableFirstName = `Ada`
+	TableFirstName_Adam        TableFirstName = `Adam`
+	TableFirstName_Addie       TableFirstName = `Addie`
+	TableFirstName_Adela       TableFirstName = `Adela`
+	TableFirstName_Adele       TableFirstName = `Adele`
+	TableFirstName_Adolfo      TableFirstName = `Adolfo`
+	TableFirstName_Adolph      TableFirstName = `Adolph`
+	TableFirstName_Adolphus    TableFirstName = `Adolphus`
+	TableFirstName_Adrian      TableFirstName = `Adrian`
+	TableFirstName_Adrienne    TableFirstName = `Adrienne`
+	TableFirstName_Agnes       TableFirstName = `Agnes`
+	TableFirstName_Agustin     TableFirstName = `Agustin`
+	TableFirstName_Aida        TableFirstName = `Aida`
+	TableFirstName_Aileen      TableFirstName = `Aileen`
+	TableFirstName_Aimee       TableFirstName = `Aimee`
+	TableFirstName_Al          TableFirstName = `Al`
+	TableFirstName_Alan        TableFirstName = `Alan`
+	TableFirstName_Alana       TableFirstName = `Alana`
+	TableFirstName_Albert      TableFirstName = `Albert`
+	TableFirstName_Alberta     TableFirstName = `Alberta`
+	TableFirstName_Alberto     TableFirstName = `Alberto`
+	TableFirstName_Alden       TableFirstName = `Alden`
+	TableFirstName_Alejandro   TableFirstName = `Alejandro`
+	TableFirstName_Aleta       TableFirstName = `Aleta`
+	TableFirstName_Aletha      TableFirstName = `Aletha`
+	TableFirstName_Alex        TableFirstName = `Alex`
+	TableFirstName_Alexander   TableFirstName = `Alexander`
+	TableFirstName_Alexandra   TableFirstName = `Alexandra`
+	TableFirstName_Alexis      TableFirstName = `Alexis`
+	TableFirstName_Alfonso     TableFirstName = `Alfonso`
+	TableFirstName_Alfonzo     TableFirstName = `Alfonzo`
+	TableFirstName_Alford      TableFirstName = `Alford`
+	TableFirstName_Alfred      TableFirstName = `Alfred`
+	TableFirstName_Alfreda     TableFirstName = `Alfreda`
+	TableFirstName_Alfredo     TableFirstName = `Alfredo`
+	TableFirstName_Alice       TableFirstName = `Alice`
+	TableFirstName_Alicia      TableFirstName = `Alicia`
+	TableFirstName_Alisa       TableFirstName = `Alisa`
+	TableFirstName_Alison      TableFirstName = `Alison`
+	TableFirstName_Allan       TableFirstName = `Allan`
+	TableFirstName_Allen       TableFirstName = `Allen`
+	TableFirstName_Allison     TableFirstName = `Allison`
+	TableFirstName_Allyn       TableFirstName = `Allyn`
+	TableFirstName_Alma        TableFirstName = `Alma`
+	TableFirstName_Alonzo      TableFirstName = `Alonzo`
+	TableFirstName_Alphonse    TableFirstName = `Alphonse`
+	TableFirstName_Alphonso    TableFirstName = `Alphonso`
+	TableFirstName_Alta        TableFirstName = `Alta`
+	TableFirstName_Althea      TableFirstName = `Althea`
+	TableFirstName_Alton       TableFirstName = `Alton`
+	TableFirstName_Alva        TableFirstName = `Alva`
+	TableFirstName_Alvin       TableFirstName = `Alvin`
+	TableFirstName_Alyce       TableFirstName = `Alyce`
+	TableFirstName_Amanda      TableFirstName = `Amanda`
+	TableFirstName_Amber       TableFirstName = `Amber`
+	TableFirstName_Amelia      TableFirstName = `Amelia`
+	TableFirstName_Amos        TableFirstName = `Amos`
+	TableFirstName_Amy         TableFirstName = `Amy`
+	TableFirstName_Ana         TableFirstName = `Ana`
+	TableFirstName_Anderson    TableFirstName = `Anderson`
+	TableFirstName_Andre       TableFirstName = `Andre`
+	TableFirstName_Andrea      TableFirstName = `Andrea`
+	TableFirstName_Andres      TableFirstName = `Andres`
+	TableFirstName_Andrew      TableFirstName = `Andrew`
+	TableFirstName_Andy        TableFirstName = `Andy`
+	TableFirstName_Angel       TableFirstName = `Angel`
+	TableFirstName_Angela      TableFirstName = `Angela`
+	TableFirstName_Angelia     TableFirstName = `Angelia`
+	TableFirstName_Angelina    TableFirstName = `Angelina`
+	TableFirstName_Angeline    TableFirstName = `Angeline`
+	TableFirstName_Angelita    TableFirstName = `Angelita`
+	TableFirstName_Angelo      TableFirstName = `Angelo`
+	TableFirstName_Angie       TableFirstName = `Angie`
+	TableFirstName_Anita       TableFirstName = `Anita`
+	TableFirstName_Ann         TableFirstName = `Ann`
+	TableFirstName_Anna        TableFirstName = `Anna`
+	TableFirstName_Anne        TableFirstName = `Anne`
+	TableFirstName_Annemarie   TableFirstName = `Annemarie`
+	TableFirstName_Annetta     TableFirstName = `Annetta`
+	TableFirstName_Annette     TableFirstName = `Annette`
+	TableFirstName_Annie       TableFirstName = `Annie`
+	TableFirstName_Annmarie    TableFirstName = `Annmarie`
+	TableFirstName_Anthony     TableFirstName = `Anthony`
+	TableFirstName_Antionette  TableFirstName = `Antionette`
+	TableFirstName_Antoinette  TableFirstName = `Antoinette`
+	TableFirstName_Anton       TableFirstName = `Anton`
+	TableFirstName_Antonia     TableFirstName = `Antonia`
+	TableFirstName_Antonio     TableFirstName = `Antonio`
+	TableFirstName_April       TableFirstName = `April`
+	TableFirstName_Archie      TableFirstName = `Archie`
+	TableFirstName_Arden       TableFirstName = `Arden`
+	TableFirstName_Arleen      TableFirstName = `Arleen`
+	TableFirstName_Arlen       TableFirstName = `Arlen`
+	TableFirstName_Arlene      TableFirstName = `Arlene`
+	TableFirstName_Arlie       TableFirstName = `Arlie`
+	TableFirstName_Armand      TableFirstName = `Armand`
+	TableFirstName_Armando     TableFirstName = `Armando`
+	TableFirstName_Arnold      TableFirstName = `Arnold`
+	TableFirstName_Arnulfo     TableFirstName = `Arnulfo`
+	TableFirstName_Art         TableFirstName = `Art`
+	TableFirstName_Arthur      TableFirstName = `Arthur`
+	TableFirstName_Artie       TableFirstName = `Artie`
+	TableFirstName_Artis       TableFirstName = `Artis`
+	TableFirstName_Arturo      TableFirstName = `Arturo`
+	TableFirstName_Ashley      TableFirstName = `Ashley`
+	TableFirstName_Athena      TableFirstName = `Athena`
+	TableFirstName_Aubrey      TableFirstName = `Aubrey`
+	TableFirstName_Audie       TableFirstName = `Audie`
+	TableFirstName_Audrey      TableFirstName = `Audrey`
+	TableFirstName_August      TableFirstName = `August`
+	TableFirstName_Augustine   TableFirstName = `Augustine`
+	TableFirstName_Augustus    TableFirstName = `Augustus`
+	TableFirstName_Aurelio     TableFirstName = `Aurelio`
+	TableFirstName_Aurora      TableFirstName = `Aurora`
+	TableFirstName_Austin      TableFirstName = `Austin`
+	TableFirstName_Ava         TableFirstName = `Ava`
+	TableFirstName_Avery       TableFirstName = `Avery`
+	TableFirstName_Avis        TableFirstName = `Avis`
+	TableFirstName_Bambi       TableFirstName = `Bambi`
+	TableFirstName_Barbara     TableFirstName = `Barbara`
+	TableFirstName_Barbra      TableFirstName = `Barbra`
+	TableFirstName_Barney      TableFirstName = `Barney`
+	TableFirstName_Barrett     TableFirstName = `Barrett`
+	TableFirstName_Barry       TableFirstName = `Barry`
+	TableFirstName_Bart        TableFirstName = `Bart`
+	TableFirstName_Barton      TableFirstName = `Barton`
+	TableFirstName_Basil       TableFirstName = `Basil`
+	TableFirstName_Beatrice    TableFirstName = `Beatrice`
+	TableFirstName_Becky       TableFirstName = `Becky`
+	TableFirstName_Belinda     TableFirstName = `Belinda`
+	TableFirstName_Ben         TableFirstName = `Ben`
+	TableFirstName_Benedict    TableFirstName = `Benedict`
+	TableFirstName_Benita      TableFirstName = `Benita`
+	TableFirstName_Benito      TableFirstName = `Benito`
+	TableFirstName_Benjamin    TableFirstName = `Benjamin`
+	TableFirstName_Bennett     TableFirstName = `Bennett`
+	TableFirstName_Bennie      TableFirstName = `Bennie`
+	TableFirstName_Benny       TableFirstName = `Benny`
+	TableFirstName_Benton      TableFirstName = `Benton`
+	TableFirstName_Bernadette  TableFirstName = `Bernadette`
+	TableFirstName_Bernadine   TableFirstName = `Bernadine`
+	TableFirstName_Bernard     TableFirstName = `Bernard`
+	TableFirstName_Bernice     TableFirstName = `Bernice`
+	TableFirstName_Bernie      TableFirstName = `Bernie`
+	TableFirstName_Berry       TableFirstName = `Berry`
+	TableFirstName_Bert        TableFirstName = `Bert`
+	TableFirstName_Bertha      TableFirstName = `Bertha`
+	TableFirstName_Bertram     TableFirstName = `Bertram`
+	TableFirstName_Beryl       TableFirstName = `Beryl`
+	TableFirstName_Bessie      TableFirstName = `Bessie`
+	TableFirstName_Beth        TableFirstName = `Beth`
+	TableFirstName_Bethany     TableFirstName = `Bethany`
+	TableFirstName_Betsy       TableFirstName = `Betsy`
+	TableFirstName_Bette       TableFirstName = `Bette`
+	TableFirstName_Bettie      TableFirstName = `Bettie`
+	TableFirstName_Betty       TableFirstName = `Betty`
+	TableFirstName_Bettye      TableFirstName = `Bettye`
+	TableFirstName_Beulah      TableFirstName = `Beulah`
+	TableFirstName_Beverley    TableFirstName = `Beverley`
+	TableFirstName_Beverly     TableFirstName = `Beverly`
+	TableFirstName_Bill        TableFirstName = `Bill`
+	TableFirstName_Billie      TableFirstName = `Billie`
+	TableFirstName_Billy       TableFirstName = `Billy`
+	TableFirstName_Blaine      TableFirstName = `Blaine`
+	TableFirstName_Blair       TableFirstName = `Blair`
+	TableFirstName_Blake       TableFirstName = `Blake`
+	TableFirstName_Blanca      TableFirstName = `Blanca`
+	TableFirstName_Blanche     TableFirstName = `Blanche`
+	TableFirstName_Blane       TableFirstName = `Blane`
+	TableFirstName_Bob         TableFirstName = `Bob`
+	TableFirstName_Bobbi       TableFirstName = `Bobbi`
+	TableFirstName_Bobbie      TableFirstName = `Bobbie`
+	TableFirstName_Bobby       TableFirstName = `Bobby`
+	TableFirstName_Bonita      TableFirstName = `Bonita`
+	TableFirstName_Bonnie      TableFirstName = `Bonnie`
+	TableFirstName_Bonny       TableFirstName = `Bonny`
+	TableFirstName_Booker      TableFirstName = `Booker`
+	TableFirstName_Boyce       TableFirstName = `Boyce`
+	TableFirstName_Boyd        TableFirstName = `Boyd`
+	TableFirstName_Brad        TableFirstName = `Brad`
+	TableFirstName_Bradford    TableFirstName = `Bradford`
+	TableFirstName_Bradley     TableFirstName = `Bradley`
+	TableFirstName_Bradly      TableFirstName = `Bradly`
+	TableFirstName_Brady       TableFirstName = `Brady`
+	TableFirstName_Brandon     TableFirstName = `Brandon`
+	TableFirstName_Brant       TableFirstName = `Brant`
+	TableFirstName_Brenda      TableFirstName = `Brenda`
+	TableFirstName_Brendan     TableFirstName = `Brendan`
+	TableFirstName_Brent       TableFirstName = `Brent`
+	TableFirstName_Bret        TableFirstName = `Bret`
+	TableFirstName_Brett       TableFirstName = `Brett`
+	TableFirstName_Brian       TableFirstName = `Brian`
+	TableFirstName_Brice       TableFirstName = `Brice`
+	TableFirstName_Bridget     TableFirstName = `Bridget`
+	TableFirstName_Britt       TableFirstName = `Britt`
+	TableFirstName_Brooks      TableFirstName = `Brooks`
+	TableFirstName_Bruce       TableFirstName = `Bruce`
+	TableFirstName_Bruno       TableFirstName = `Bruno`
+	TableFirstName_Bryan       TableFirstName = `Bryan`
+	TableFirstName_Bryant      TableFirstName = `Bryant`
+	TableFirstName_Bryce       TableFirstName = `Bryce`
+	TableFirstName_Bryon       TableFirstName = `Bryon`
+	TableFirstName_Bud         TableFirstName = `Bud`
+	TableFirstName_Buddy       TableFirstName = `Buddy`
+	TableFirstName_Buford      TableFirstName = `Buford`
+	TableFirstName_Burl        TableFirstName = `Burl`
+	TableFirstName_Burt        TableFirstName = `Burt`
+	TableFirstName_Burton      TableFirstName = `Burton`
+	TableFirstName_Buster      TableFirstName = `Buster`
+	TableFirstName_Butch       TableFirstName = `Butch`
+	TableFirstName_Byron       TableFirstName = `Byron`
+	TableFirstName_Callie      TableFirstName = `Callie`
+	TableFirstName_Calvin      TableFirstName = `Calvin`
+	TableFirstName_Cameron     TableFirstName = `Cameron`
+	TableFirstName_Camilla     TableFirstName = `Camilla`
+	TableFirstName_Camille     TableFirstName = `Camille`
+	TableFirstName_Candace     TableFirstName = `Candace`
+	TableFirstName_Candice     TableFirstName = `Candice`
+	TableFirstName_Candy       TableFirstName = `Candy`
+	TableFirstName_Cara        TableFirstName = `Cara`
+	TableFirstName_Caren       TableFirstName = `Caren`
+	TableFirstName_Carey       TableFirstName = `Carey`
+	TableFirstName_Carl        TableFirstName = `Carl`
+	TableFirstName_Carla       TableFirstName = `Carla`
+	TableFirstName_Carleen     TableFirstName = `Carleen`
+	TableFirstName_Carlene     TableFirstName = `Carlene`
+	TableFirstName_Carleton    TableFirstName = `Carleton`
+	TableFirstName_Carlo       TableFirstName = `Carlo`
+	TableFirstName_Carlos      TableFirstName = `Carlos`
+	TableFirstName_Carlotta    TableFirstName = `Carlotta`
+	TableFirstName_Carlton     TableFirstName = `Carlton`
+	TableFirstName_Carmel      TableFirstName = `Carmel`
+	TableFirstName_Carmela     TableFirstName = `Carmela`
+	TableFirstName_Carmella    TableFirstName = `Carmella`
+	TableFirstName_Carmelo     TableFirstName = `Carmelo`
+	TableFirstName_Carmen      TableFirstName = `Carmen`
+	TableFirstName_Carmine     TableFirstName = `Carmine`
+	TableFirstName_Carnell     TableFirstName = `Carnell`
+	TableFirstName_Carol       TableFirstName = `Carol`
+	TableFirstName_Carole      TableFirstName = `Carole`
+	TableFirstName_Carolina    TableFirstName = `Carolina`
+	TableFirstName_Caroline    TableFirstName = `Caroline`
+	TableFirstName_Carolyn     TableFirstName = `Carolyn`
+	TableFirstName_Caron       TableFirstName = `Caron`
+	TableFirstName_Carrie      TableFirstName = `Carrie`
+	TableFirstName_Carroll     TableFirstName = `Carroll`
+	TableFirstName_Carson      TableFirstName = `Carson`
+	TableFirstName_Carter      TableFirstName = `Carter`
+	TableFirstName_Cary        TableFirstName = `Cary`
+	TableFirstName_Caryl       TableFirstName = `Caryl`
+	TableFirstName_Caryn       TableFirstName = `Caryn`
+	TableFirstName_Casey       TableFirstName = `Casey`
+	TableFirstName_Cassandra   TableFirstName = `Cassandra`
+	TableFirstName_Catharine   TableFirstName = `Catharine`
+	TableFirstName_Catherine   TableFirstName = `Catherine`
+	TableFirstName_Cathey      TableFirstName = `Cathey`
+	TableFirstName_Cathie      TableFirstName = `Cathie`
+	TableFirstName_Cathleen    TableFirstName = `Cathleen`
+	TableFirstName_Cathrine    TableFirstName = `Cathrine`
+	TableFirstName_Cathryn     TableFirstName = `Cathryn`
+	TableFirstName_Cathy       TableFirstName = `Cathy`
+	TableFirstName_Cecelia     TableFirstName = `Cecelia`
+	TableFirstName_Cecil       TableFirstName = `Cecil`
+	TableFirstName_Cecile      TableFirstName = `Cecile`
+	TableFirstName_Cecilia     TableFirstName = `Cecilia`
+	TableFirstName_Cedric      TableFirstName = `Cedric`
+	TableFirstName_Celeste     TableFirstName = `Celeste`
+	TableFirstName_Celestine   TableFirstName = `Celestine`
+	TableFirstName_Celia       TableFirstName = `Celia`
+	TableFirstName_Cesar       TableFirstName = `Cesar`
+	TableFirstName_Chad        TableFirstName = `Chad`
+	TableFirstName_Charla      TableFirstName = `Charla`
+	TableFirstName_Charleen    TableFirstName = `Charleen`
+	TableFirstName_Charlene    TableFirstName = `Charlene`
+	TableFirstName_Charles     TableFirstName = `Charles`
+	TableFirstName_Charley     TableFirstName = `Charley`
+	TableFirstName_Charlie     TableFirstName = `Charlie`
+	TableFirstName_Charlotte   TableFirstName = `Charlotte`
+	TableFirstName_Charmaine   TableFirstName = `Charmaine`
+	TableFirstName_Chauncey    TableFirstName = `Chauncey`
+	TableFirstName_Cheri       TableFirstName = `Cheri`
+	TableFirstName_Cherie      TableFirstName = `Cherie`
+	TableFirstName_Cherri      TableFirstName = `Cherri`
+	TableFirstName_Cherrie     TableFirstName = `Cherrie`
+	TableFirstName_Cherry      TableFirstName = `Cherry`
+	TableFirstName_Cheryl      TableFirstName = `Cheryl`
+	TableFirstName_Cheryle     TableFirstName = `Cheryle`
+	TableFirstName_Cheryll     TableFirstName = `Cheryll`
+	TableFirstName_Chester     TableFirstName = `Chester`
+	TableFirstName_Chip        TableFirstName = `Chip`
+	TableFirstName_Chris       TableFirstName = `Chris`
+	TableFirstName_Christa     TableFirstName = `Christa`
+	TableFirstName_Christi     TableFirstName = `Christi`
+	TableFirstName_Christian   TableFirstName = `Christian`
+	TableFirstName_Christie    TableFirstName = `Christie`
+	TableFirstName_Christina   TableFirstName = `Christina`
+	TableFirstName_Christine   TableFirstName = `Christine`
+	TableFirstName_Christophe  TableFirstName = `Christophe`
+	TableFirstName_Christopher TableFirstName = `Christopher`
+	TableFirstName_Christy     TableFirstName = `Christy`
+	TableFirstName_Chuck       TableFirstName = `Chuck`
+	TableFirstName_Cinda       TableFirstName = `Cinda`
+	TableFirstName_Cindi       TableFirstName = `Cindi`
+	TableFirstName_Cindy       TableFirstName = `Cindy`
+	TableFirstName_Clair       TableFirstName = `Clair`
+	TableFirstName_Claire      TableFirstName = `Claire`
+	TableFirstName_Clara       TableFirstName = `Clara`
+	TableFirstName_Clare       TableFirstName = `Clare`
+	TableFirstName_Clarence    TableFirstName = `Clarence`
+	TableFirstName_Clarice     TableFirstName = `Clarice`
+	TableFirstName_Clarissa    TableFirstName = `Clarissa`
+	TableFirstName_Clark       TableFirstName = `Clark`
+	TableFirstName_Clarke      TableFirstName = `Clarke`
+	TableFirstName_Claud       TableFirstName = `Claud`
+	TableFirstName_Claude      TableFirstName = `Claude`
+	TableFirstName_Claudette   TableFirstName = `Claudette`
+	TableFirstName_Claudia     TableFirstName = `Claudia`
+	TableFirstName_Clay        TableFirstName = `Clay`
+	TableFirstName_Clayton     TableFirstName = `Clayton`
+	TableFirstName_Clement     TableFirstName = `Clement`
+	TableFirstName_Cleo        TableFirstName = `Cleo`
+	TableFirstName_Cletus      TableFirstName = `Cletus`
+	TableFirstName_Cleve       TableFirstName = `Cleve`
+	TableFirstName_Cleveland   TableFirstName = `Cleveland`
+	TableFirstName_Cliff       TableFirstName = `Cliff`
+	TableFirstName_Clifford    TableFirstName = `Clifford`
+	TableFirstName_Clifton     TableFirstName = `Clifton`
+	TableFirstName_Clint       TableFirstName = `Clint`
+	TableFirstName_Clinton     TableFirstName = `Clinton`
+	TableFirstName_Clyde       TableFirstName = `Clyde`
+	TableFirstName_Cody        TableFirstName = `Cody`
+	TableFirstName_Cole        TableFirstName = `Cole`
+	TableFirstName_Coleen      TableFirstName = `Coleen`
+	TableFirstName_Coleman     TableFirstName = `Coleman`
+	TableFirstName_Colette     TableFirstName = `Colette`
+	TableFirstName_Colin       TableFirstName = `Colin`
+	TableFirstName_Colleen     TableFirstName = `Colleen`
+	TableFirstName_Collette    TableFirstName = `Collette`
+	TableFirstName_Columbus    TableFirstName = `Columbus`
+	TableFirstName_Concetta    TableFirstName = `Concetta`
+	TableFirstName_Connie      TableFirstName = `Connie`
+	TableFirstName_Conrad      TableFirstName = `Conrad`
+	TableFirstName_Constance   TableFirstName = `Constance`
+	TableFirstName_Consuelo    TableFirstName = `Consuelo`
+	TableFirstName_Cora        TableFirstName = `Cora`
+	TableFirstName_Cordell     TableFirstName = `Cordell`
+	TableFirstName_Corey       TableFirstName = `Corey`
+	TableFirstName_Corine      TableFirstName = `Corine`
+	TableFirstName_Corinne     TableFirstName = `Corinne`
+	TableFirstName_Corliss     TableFirstName = `Corliss`
+	TableFirstName_Cornelia    TableFirstName = `Cornelia`
+	TableFirstName_Cornelius   TableFirstName = `Cornelius`
+	TableFirstName_Cornell     TableFirstName = `Cornell`
+	TableFirstName_Corrine     TableFirstName = `Corrine`
+	TableFirstName_Cory        TableFirstName = `Cory`
+	TableFirstName_Courtney    TableFirstName = `Courtney`
+	TableFirstName_Coy         TableFirstName = `Coy`
+	TableFirstName_Craig       TableFirstName = `Craig`
+	TableFirstName_Cris        TableFirstName = `Cris`
+	TableFirstName_Cristina    TableFirstName = `Cristina`
+	TableFirstName_Cruz        TableFirstName = `Cruz`
+	TableFirstName_Crystal     TableFirstName = `Crystal`
+	TableFirstName_Curt        TableFirstName = `Curt`
+	TableFirstName_Curtis      TableFirstName = `Curtis`
+	TableFirstName_Curtiss     TableFirstName = `Curtiss`
+	TableFirstName_Cynthia     TableFirstName = `Cynthia`
+	TableFirstName_Cyril       TableFirstName = `Cyril`
+	TableFirstName_Cyrus       TableFirstName = `Cyrus`
+	TableFirstName_Daisy       TableFirstName = `Daisy`
+	TableFirstName_Dale        TableFirstName = `Dale`
+	TableFirstName_Dallas      TableFirstName = `Dallas`
+	TableFirstName_Dalton      TableFirstName = `Dalton`
+	TableFirstName_Damian      TableFirstName = `Damian`
+	TableFirstName_Damon       TableFirstName = `Damon`
+	TableFirstName_Dan         TableFirstName = `Dan`
+	TableFirstName_Dana        TableFirstName = `Dana`
+	TableFirstName_Dane        TableFirstName = `Dane`
+	TableFirstName_Danette     TableFirstName = `Danette`
+	TableFirstName_Danial      TableFirstName = `Danial`
+	TableFirstName_Daniel      TableFirstName = `Daniel`
+	TableFirstName_Danielle    TableFirstName = `Danielle`
+	TableFirstName_Danita      TableFirstName = `Danita`
+	TableFirstName_Dann        TableFirstName = `Dann`
+	TableFirstName_Danna       TableFirstName = `Danna`
+	TableFirstName_Dannie      TableFirstName = `Dannie`
+	TableFirstName_Danny       TableFirstName = `Danny`
+	TableFirstName_Dante       TableFirstName = `Dante`
+	TableFirstName_Daphne      TableFirstName = `Daphne`
+	TableFirstName_Darcy       TableFirstName = `Darcy`
+	TableFirstName_Darell      TableFirstName = `Darell`
+	TableFirstName_Daria       TableFirstName = `Daria`
+	TableFirstName_Darius      TableFirstName = `Darius`
+	TableFirstName_Darla       TableFirstName = `Darla`
+	TableFirstName_Darleen     TableFirstName = `Darleen`
+	TableFirstName_Darlene     TableFirstName = `Darlene`
+	TableFirstName_Darnell     TableFirstName = `Darnell`
+	TableFirstName_Darold      TableFirstName = `Darold`
+	TableFirstName_Darrel      TableFirstName = `Darrel`
+	TableFirstName_Darrell     TableFirstName = `Darrell`
+	TableFirstName_Darryl      TableFirstName = `Darryl`
+	TableFirstName_Darwin      TableFirstName = `Darwin`
+	TableFirstName_Daryl       TableFirstName = `Daryl`
+	TableFirstName_Daryle      TableFirstName = `Daryle`
+	TableFirstName_Dave        TableFirstName = `Dave`
+	TableFirstName_Davey       TableFirstName = `Davey`
+	TableFirstName_David       TableFirstName = `David`
+	TableFirstName_Davie       TableFirstName = `Davie`
+	TableFirstName_Davis       TableFirstName = `Davis`
+	TableFirstName_Davy        TableFirstName = `Davy`
+	TableFirstName_Dawn        TableFirstName = `Dawn`
+	TableFirstName_Dayna       TableFirstName = `Dayna`
+	TableFirstName_Dean        TableFirstName = `Dean`
+	TableFirstName_Deana       TableFirstName = `Deana`
+	TableFirstName_Deann       TableFirstName = `Deann`
+	TableFirstName_Deanna      TableFirstName = `Deanna`
+	TableFirstName_Deanne      TableFirstName = `Deanne`
+	TableFirstName_Debbi       TableFirstName = `Debbi`
+	TableFirstName_Debbie      TableFirstName = `Debbie`
+	TableFirstName_Debbra      TableFirstName = `Debbra`
+	TableFirstName_Debby       TableFirstName = `Debby`
+	TableFirstName_Debi        TableFirstName = `Debi`
+	TableFirstName_Debora      TableFirstName = `Debora`
+	TableFirstName_Deborah     TableFirstName = `Deborah`
+	TableFirstName_Debra       TableFirstName = `Debra`
+	TableFirstName_Debrah      TableFirstName = `Debrah`
+	TableFirstName_Debroah     TableFirstName = `Debroah`
+	TableFirstName_Dee         TableFirstName = `Dee`
+	TableFirstName_Deena       TableFirstName = `Deena`
+	TableFirstName_Deidre      TableFirstName = `Deidre`
+	TableFirstName_Deirdre     TableFirstName = `Deirdre`
+	TableFirstName_Del         TableFirstName = `Del`
+	TableFirstName_Delbert     TableFirstName = `Delbert`
+	TableFirstName_Delia       TableFirstName = `Delia`
+	TableFirstName_Delilah     TableFirstName = `Delilah`
+	TableFirstName_Dell        TableFirstName = `Dell`
+	TableFirstName_Della       TableFirstName = `Della`
+	TableFirstName_Delma       TableFirstName = `Delma`
+	TableFirstName_Delmar      TableFirstName = `Delmar`
+	TableFirstName_Delmer      TableFirstName = `Delmer`
+	TableFirstName_Delois      TableFirstName = `Delois`
+	TableFirstName_Delores     TableFirstName = `Delores`
+	TableFirstName_Deloris     TableFirstName = `Deloris`
+	TableFirstName_Delphine    TableFirstName = `Delphine`
+	TableFirstName_Delton      TableFirstName = `Delton`
+	TableFirstName_Demetrius   TableFirstName = `Demetrius`
+	TableFirstName_Dena        TableFirstName = `Dena`
+	TableFirstName_Denice      TableFirstName = `Denice`
+	TableFirstName_Denis       TableFirstName = `Denis`
+	TableFirstName_Denise      TableFirstName = `Denise`
+	TableFirstName_Dennie      TableFirstName = `Dennie`
+	TableFirstName_Dennis      TableFirstName = `Dennis`
+	TableFirstName_Denny       TableFirstName = `Denny`
+	TableFirstName_Denver      TableFirstName = `Denver`
+	TableFirstName_Derek       TableFirstName = `Derek`
+	TableFirstName_Derrell     TableFirstName = `Derrell`
+	TableFirstName_Derrick     TableFirstName = `Derrick`
+	TableFirstName_Desiree     TableFirstName = `Desiree`
+	TableFirstName_Desmond     TableFirstName = `Desmond`
+	TableFirstName_Dewayne     TableFirstName = `Dewayne`
+	TableFirstName_Dewey       TableFirstName = `Dewey`
+	TableFirstName_Dewitt      TableFirstName = `Dewitt`
+	TableFirstName_Dexter      TableFirstName = `Dexter`
+	TableFirstName_Dian        TableFirstName = `Dian`
+	TableFirstName_Diana       TableFirstName = `Diana`
+	TableFirstName_Diane       TableFirstName = `Diane`
+	TableFirstName_Diann       TableFirstName = `Diann`
+	TableFirstName_Dianna      TableFirstName = `Dianna`
+	TableFirstName_Dianne      TableFirstName = `Dianne`
+	TableFirstName_Dick        TableFirstName = `Dick`
+	TableFirstName_Dickie      TableFirstName = `Dickie`
+	TableFirstName_Dina        TableFirstName = `Dina`
+	TableFirstName_Dinah       TableFirstName = `Dinah`
+	TableFirstName_Dino        TableFirstName = `Dino`
+	TableFirstName_Dirk        TableFirstName = `Dirk`
+	TableFirstName_Dixie       TableFirstName = `Dixie`
+	TableFirstName_Dollie      TableFirstName = `Dollie`
+	TableFirstName_Dolly       TableFirstName = `Dolly`
+	TableFirstName_Dolores     TableFirstName = `Dolores`
+	TableFirstName_Domenic     TableFirstName = `Domenic`
+	TableFirstName_Domingo     TableFirstName = `Domingo`
+	TableFirstName_Dominic     TableFirstName = `Dominic`
+	TableFirstName_Dominick    TableFirstName = `Dominick`
+	TableFirstName_Don         TableFirstName = `Don`
+	TableFirstName_Dona        TableFirstName = `Dona`
+	TableFirstName_Donald      TableFirstName = `Donald`
+	TableFirstName_Donell      TableFirstName = `Donell`
+	TableFirstName_Donita      TableFirstName = `Donita`
+	TableFirstName_Donn        TableFirstName = `Donn`
+	TableFirstName_Donna       TableFirstName = `Donna`
+	TableFirstName_Donnell     TableFirstName = `Donnell`
+	TableFirstName_Donnie      TableFirstName = `Donnie`
+	TableFirstName_Donny       TableFirstName = `Donny`
+	TableFirstName_Donovan     TableFirstName = `Donovan`
+	TableFirstName_Dora        TableFirstName = `Dora`
+	TableFirstName_Dorcas      TableFirstName = `Dorcas`
+	TableFirstName_Doreen      TableFirstName = `Doreen`
+	TableFirstName_Dorene      TableFirstName = `Dorene`
+	TableFirstName_Doretha     TableFirstName = `Doretha`
+	TableFirstName_Dorian      TableFirstName = `Dorian`
+	TableFirstName_Dorinda     TableFirstName = `Dorinda`
+	TableFirstName_Doris       TableFirstName = `Doris`
+	TableFirstName_Dorothea    TableFirstName = `Dorothea`
+	TableFirstName_Dorothy     TableFirstName = `Dorothy`
+	TableFirstName_Dorsey      TableFirstName = `Dorsey`
+	TableFirstName_Dorthy      TableFirstName = `Dorthy`
+	TableFirstName_Dottie      TableFirstName = `Dottie`
+	TableFirstName_Doug        TableFirstName = `Doug`
+	TableFirstName_Douglas     TableFirstName = `Douglas`
+	TableFirstName_Douglass    TableFirstName = `Douglass`
+	TableFirstName_Doyle       TableFirstName = `Doyle`
+	TableFirstName_Drew        TableFirstName = `Drew`
+	TableFirstName_Duane       TableFirstName = `Duane`
+	TableFirstName_Dudley      TableFirstName = `Dudley`
+	TableFirstName_Duke        TableFirstName = `Duke`
+	TableFirstName_Duncan      TableFirstName = `Duncan`
+	TableFirstName_Dusty       TableFirstName = `Dusty`
+	TableFirstName_Duwayne     TableFirstName = `Duwayne`
+	TableFirstName_Dwain       TableFirstName = `Dwain`
+	TableFirstName_Dwaine      TableFirstName = `Dwaine`
+	TableFirstName_Dwayne      TableFirstName = `Dwayne`
+	TableFirstName_Dwight      TableFirstName = `Dwight`
+	TableFirstName_Earl        TableFirstName = `Earl`
+	TableFirstName_Earle       TableFirstName = `Earle`
+	TableFirstName_Earlene     TableFirstName = `Earlene`
+	TableFirstName_Earline     TableFirstName = `Earline`
+	TableFirstName_Earnest     TableFirstName = `Earnest`
+	TableFirstName_Earnestine  TableFirstName = `Earnestine`
+	TableFirstName_Eartha      TableFirstName = `Eartha`
+	TableFirstName_Ed          TableFirstName = `Ed`
+	TableFirstName_Eddie       TableFirstName = `Eddie`
+	TableFirstName_Eddy        TableFirstName = `Eddy`
+	TableFirstName_Edgar       TableFirstName = `Edgar`
+	TableFirstName_Edith       TableFirstName = `Edith`
+	TableFirstName_Edmund      TableFirstName = `Edmund`
+	TableFirstName_Edna        TableFirstName = `Edna`
+	TableFirstName_Eduardo     TableFirstName = `Eduardo`
+	TableFirstName_Edward      TableFirstName = `Edward`
+	TableFirstName_Edwardo     TableFirstName = `Edwardo`
+	TableFirstName_Edwin       TableFirstName = `Edwin`
+	TableFirstName_Edwina      TableFirstName = `Edwina`
+	TableFirstName_Effie       TableFirstName = `Effie`
+	TableFirstName_Efrain      TableFirstName = `Efrain`
+	TableFirstName_Eileen      TableFirstName = `Eileen`
+	TableFirstName_Elaine      TableFirstName = `Elaine`
+	TableFirstName_Elbert      TableFirstName = `Elbert`
+	TableFirstName_Eldon       TableFirstName = `Eldon`
+	TableFirstName_Eldridge    TableFirstName = `Eldridge`
+	TableFirstName_Eleanor     TableFirstName = `Eleanor`
+	TableFirstName_Elena       TableFirstName = `Elena`
+	TableFirstName_Eli         TableFirstName = `Eli`
+	TableFirstName_Elias       TableFirstName = `Elias`
+	TableFirstName_Elijah      TableFirstName = `Elijah`
+	TableFirstName_Elisa       TableFirstName = `Elisa`
+	TableFirstName_Elisabeth   TableFirstName = `Elisabeth`
+	TableFirstName_Elise       TableFirstName = `Elise`
+	TableFirstName_Eliseo      TableFirstName = `Eliseo`
+	TableFirstName_Elissa      TableFirstName = `Elissa`
+	TableFirstName_Eliza       TableFirstName = `Eliza`
+	TableFirstName_Elizabeth   TableFirstName = `Elizabeth`
+	TableFirstName_Ella        TableFirstName = `Ella`
+	TableFirstName_Ellen       TableFirstName = `Ellen`
+	TableFirstName_Elliot      TableFirstName = `Elliot`
+	TableFirstName_Elliott     TableFirstName = `Elliott`
+	TableFirstName_Ellis       TableFirstName = `Ellis`
+	TableFirstName_Elma        TableFirstName = `Elma`
+	TableFirstName_Elmer       TableFirstName = `Elmer`
+	TableFirstName_Elmo        TableFirstName = `Elmo`
+	TableFirstName_Elnora      TableFirstName = `Elnora`
+	TableFirstName_Eloise      TableFirstName = `Eloise`
+	TableFirstName_Eloy        TableFirstName = `Eloy`
+	TableFirstName_Elroy       TableFirstName = `Elroy`
+	TableFirstName_Elsa        TableFirstName = `Elsa`
+	TableFirstName_Elsie       TableFirstName = `Elsie`
+	TableFirstName_Elton       TableFirstName = `Elton`
+	TableFirstName_Elva        TableFirstName = `Elva`
+	TableFirstName_Elvin       TableFirstName = `Elvin`
+	TableFirstName_Elvira      TableFirstName = `Elvira`
+	TableFirstName_Elvis       TableFirstName = `Elvis`
+	TableFirstName_Elwood      TableFirstName = `Elwood`
+	TableFirstName_Elyse       TableFirstName = `Elyse`
+	TableFirstName_Emanuel     TableFirstName = `Emanuel`
+	TableFirstName_Emerson     TableFirstName = `Emerson`
+	TableFirstName_Emery       TableFirstName = `Emery`
+	TableFirstName_Emil        TableFirstName = `Emil`
+	TableFirstName_Emilio      TableFirstName = `Emilio`
+	TableFirstName_Emily       TableFirstName = `Emily`
+	TableFirstName_Emma        TableFirstName = `Emma`
+	TableFirstName_Emmanuel    TableFirstName = `Emmanuel`
+	TableFirstName_Emmett      TableFirstName = `Emmett`
+	TableFirstName_Emmitt      TableFirstName = `Emmitt`
+	TableFirstName_Emory       TableFirstName = `Emory`
+	TableFirstName_Enoch       TableFirstName = `Enoch`
+	TableFirstName_Enrique     TableFirstName = `Enrique`
+	TableFirstName_Eric        TableFirstName = `Eric`
+	TableFirstName_Erica       TableFirstName = `Erica`
+	TableFirstName_Erich       TableFirstName = `Erich`
+	TableFirstName_Erick       TableFirstName = `Erick`
+	TableFirstName_Erik        TableFirstName = `Erik`
+	TableFirstName_Erin        TableFirstName = `Erin`
+	TableFirstName_Erma        TableFirstName = `Erma`
+	TableFirstName_Ernest      TableFirstName = `Ernest`
+	TableFirstName_Ernestine   TableFirstName = `Ernestine`
+	TableFirstName_Ernesto     TableFirstName = `Ernesto`
+	TableFirstName_Ernie       TableFirstName = `Ernie`
+	TableFirstName_Errol       TableFirstName = `Errol`
+	TableFirstName_Ervin       TableFirstName = `Ervin`
+	TableFirstName_Erwin       TableFirstName = `Erwin`
+	TableFirstName_Esmeralda   TableFirstName = `Esmeralda`
+	TableFirstName_Esperanza   TableFirstName = `Esperanza`
+	TableFirstName_Essie       TableFirstName = `Essie`
+	TableFirstName_Esteban     TableFirstName = `Esteban`
+	TableFirstName_Estela      TableFirstName = `Estela`
+	TableFirstName_Estella     TableFirstName = `Estella`
+	TableFirstName_Estelle     TableFirstName = `Estelle`
+	TableFirstName_Ester       TableFirstName = `Ester`
+	TableFirstName_Esther      TableFirstName = `Esther`
+	TableFirstName_Ethel       TableFirstName = `Ethel`
+	TableFirstName_Etta        TableFirstName = `Etta`
+	TableFirstName_Eugene      TableFirstName = `Eugene`
+	TableFirstName_Eugenia     TableFirstName = `Eugenia`
+	TableFirstName_Eula        TableFirstName = `Eula`
+	TableFirstName_Eunice      TableFirstName = `Eunice`
+	TableFirstName_Eva         TableFirstName = `Eva`
+	TableFirstName_Evan        TableFirstName = `Evan`
+	TableFirstName_Evangeline  TableFirstName = `Evangeline`
+	TableFirstName_Eve         TableFirstName = `Eve`
+	TableFirstName_Evelyn      TableFirstName = `Evelyn`
+	TableFirstName_Everett     TableFirstName = `Everett`
+	TableFirstName_Everette    TableFirstName = `Everette`
+	TableFirstName_Ezra        TableFirstName = `Ezra`
+	TableFirstName_Faith       TableFirstName = `Faith`
+	TableFirstName_Fannie      TableFirstName = `Fannie`
+	TableFirstName_Faron       TableFirstName = `Faron`
+	TableFirstName_Farrell     TableFirstName = `Farrell`
+	TableFirstName_Fay         TableFirstName = `Fay`
+	TableFirstName_Faye        TableFirstName = `Faye`
+	TableFirstName_Federico    TableFirstName = `Federico`
+	TableFirstName_Felecia     TableFirstName = `Felecia`
+	TableFirstName_Felicia     TableFirstName = `Felicia`
+	TableFirstName_Felipe      TableFirstName = `Felipe`
+	TableFirstName_Felix       TableFirstName = `Felix`
+	TableFirstName_Felton      TableFirstName = `Felton`
+	TableFirstName_Ferdinand   TableFirstName = `Ferdinand`
+	TableFirstName_Fern        TableFirstName = `Fern`
+	TableFirstName_Fernando    TableFirstName = `Fernando`
+	TableFirstName_Fidel       TableFirstName = `Fidel`
+	TableFirstName_Fletcher    TableFirstName = `Fletcher`
+	TableFirstName_Flora       TableFirstName = `Flora`
+	TableFirstName_Florence    TableFirstName = `Florence`
+	TableFirstName_Florine     TableFirstName = `Florine`
+	TableFirstName_Floyd       TableFirstName = `Floyd`
+	TableFirstName_Forest      TableFirstName = `Forest`
+	TableFirstName_Forrest     TableFirstName = `Forrest`
+	TableFirstName_Foster      TableFirstName = `Foster`
+	TableFirstName_Fran        TableFirstName = `Fran`
+	TableFirstName_Frances     TableFirstName = `Frances`
+	TableFirstName_Francesca   TableFirstName = `Francesca`
+	TableFirstName_Francine    TableFirstName = `Francine`
+	TableFirstName_Francis     TableFirstName = `Francis`
+	TableFirstName_Francisco   TableFirstName = `Francisco`
+	TableFirstName_Frank       TableFirstName = `Frank`
+	TableFirstName_Frankie     TableFirstName = `Frankie`
+	TableFirstName_Franklin    TableFirstName = `Franklin`
+	TableFirstName_Franklyn    TableFirstName = `Franklyn`
+	TableFirstName_Fred        TableFirstName = `Fred`
+	TableFirstName_Freda       TableFirstName = `Freda`
+	TableFirstName_Freddie     TableFirstName = `Freddie`
+	TableFirstName_Freddy      TableFirstName = `Freddy`
+	TableFirstName_Frederic    TableFirstName = `Frederic`
+	TableFirstName_Frederick   TableFirstName = `Frederick`
+	TableFirstName_Fredric     TableFirstName = `Fredric`
+	TableFirstName_Fredrick    TableFirstName = `Fredrick`
+	TableFirstName_Freeman     TableFirstName = `Freeman`
+	TableFirstName_Freida      TableFirstName = `Freida`
+	TableFirstName_Frieda      TableFirstName = `Frieda`
+	TableFirstName_Fritz       TableFirstName = `Fritz`
+	TableFirstName_Gabriel     TableFirstName = `Gabriel`
+	TableFirstName_Gail        TableFirstName = `Gail`
+	TableFirstName_Gale        TableFirstName = `Gale`
+	TableFirstName_Galen       TableFirstName = `Galen`
+	TableFirstName_Garland     TableFirstName = `Garland`
+	TableFirstName_Garold      TableFirstName = `Garold`
+	TableFirstName_Garrett     TableFirstName = `Garrett`
+	TableFirstName_Garry       TableFirstName = `Garry`
+	TableFirstName_Garth       TableFirstName = `Garth`
+	TableFirstName_Gary        TableFirstName = `Gary`
+	TableFirstName_Gavin       TableFirstName = `Gavin`
+	TableFirstName_Gay         TableFirstName = `Gay`
+	TableFirstName_Gaye        TableFirstName = `Gaye`
+	TableFirstName_Gayla       TableFirstName = `Gayla`
+	TableFirstName_Gayle       TableFirstName = `Gayle`
+	TableFirstName_Gaylon      TableFirstName = `Gaylon`
+	TableFirstName_Gaylord     TableFirstName = `Gaylord`
+	TableFirstName_Gearld      TableFirstName = `Gearld`
+	TableFirstName_Geary       TableFirstName = `Geary`
+	TableFirstName_Gena        TableFirstName = `Gena`
+	TableFirstName_Gene        TableFirstName = `Gene`
+	TableFirstName_Geneva      TableFirstName = `Geneva`
+	TableFirstName_Genevieve   TableFirstName = `Genevieve`
+	TableFirstName_Geoffrey    TableFirstName = `Geoffrey`
+	TableFirstName_George      TableFirstName = `George`
+	TableFirstName_Georgette   TableFirstName = `Georgette`
+	TableFirstName_Georgia     TableFirstName = `Georgia`
+	TableFirstName_Georgina    TableFirstName = `Georgina`
+	TableFirstName_Gerald      TableFirstName = `Gerald`
+	TableFirstName_Geraldine   TableFirstName = `Geraldine`
+	TableFirstName_Geralyn     TableFirstName = `Geralyn`
+	TableFirstName_Gerard      TableFirstName = `Gerard`
+	TableFirstName_Gerardo     TableFirstName = `Gerardo`
+	TableFirstName_Geri        TableFirstName = `Geri`
+	TableFirstName_Gerri       TableFirstName = `Gerri`
+	TableFirstName_Gerry       TableFirstName = `Gerry`
+	TableFirstName_Gertrude    TableFirstName = `Gertrude`
+	TableFirstName_Gil         TableFirstName = `Gil`
+	TableFirstName_Gilbert     TableFirstName = `Gilbert`
+	TableFirstName_Gilberto    TableFirstName = `Gilberto`
+	TableFirstName_Gilda       TableFirstName = `Gilda`
+	TableFirstName_Giles       TableFirstName = `Giles`
+	TableFirstName_Gina        TableFirstName = `Gina`
+	TableFirstName_Ginger      TableFirstName = `Ginger`
+	TableFirstName_Gino        TableFirstName = `Gino`
+	TableFirstName_Gisele      TableFirstName = `Gisele`
+	TableFirstName_Gladys      TableFirstName = `Gladys`
+	TableFirstName_Glen        TableFirstName = `Glen`
+	TableFirstName_Glenda      TableFirstName = `Glenda`
+	TableFirstName_Glenn       TableFirstName = `Glenn`
+	TableFirstName_Glenna      TableFirstName = `Glenna`
+	TableFirstName_Glinda      TableFirstName = `Glinda`
+	TableFirstName_Gloria      TableFirstName = `Gloria`
+	TableFirstName_Glynn       TableFirstName = `Glynn`
+	TableFirstName_Goldie      TableFirstName = `Goldie`
+	TableFirstName_Gordon      TableFirstName = `Gordon`
+	TableFirstName_Grace       TableFirstName = `Grace`
+	TableFirstName_Gracie      TableFirstName = `Gracie`
+	TableFirstName_Graciela    TableFirstName = `Graciela`
+	TableFirstName_Grady       TableFirstName = `Grady`
+	TableFirstName_Graham      TableFirstName = `Graham`
+	TableFirstName_Grant       TableFirstName = `Grant`
+	TableFirstName_Greg        TableFirstName = `Greg`
+	TableFirstName_Gregg       TableFirstName = `Gregg`
+	TableFirstName_Greggory    TableFirstName = `Greggory`
+	TableFirstName_Gregorio    TableFirstName = `Gregorio`
+	TableFirstName_Gregory     TableFirstName = `Gregory`
+	TableFirstName_Greta       TableFirstName = `Greta`
+	TableFirstName_Gretchen    TableFirstName = `Gretchen`
+	TableFirstName_Grover      TableFirstName = `Grover`
+	TableFirstName_Guadalupe   TableFirstName = `Guadalupe`
+	TableFirstName_Guillermo   TableFirstName = `Guillermo`
+	TableFirstName_Gus         TableFirstName = `Gus`
+	TableFirstName_Gustavo     TableFirstName = `Gustavo`
+	TableFirstName_Guy         TableFirstName = `Guy`
+	TableFirstName_Gwen        TableFirstName = `Gwen`
+	TableFirstName_Gwendolyn   TableFirstName = `Gwendolyn`
+	TableFirstName_Hal         TableFirstName = `Hal`
+	TableFirstName_Hank        TableFirstName = `Hank`
+	TableFirstName_Hannah      TableFirstName = `Hannah`
+	TableFirstName_Hans        TableFirstName = `Hans`
+	TableFirstName_Harlan      TableFirstName = `Harlan`
+	TableFirstName_Harley      TableFirstName = `Harley`
+	TableFirstName_Harmon      TableFirstName = `Harmon`
+	TableFirstName_Harold      TableFirstName = `Harold`
+	TableFirstName_Harriet     TableFirstName = `Harriet`
+	TableFirstName_Harriett    TableFirstName = `Harriett`
+	TableFirstName_Harris      TableFirstName = `Harris`
+	TableFirstName_Harrison    TableFirstName = `Harrison`
+	TableFirstName_Harry       TableFirstName = `Harry`
+	TableFirstName_Harvey      TableFirstName = `Harvey`
+	TableFirstName_Hattie      TableFirstName = `Hattie`
+	TableFirstName_Hayward     TableFirstName = `Hayward`
+	TableFirstName_Haywood     TableFirstName = `Haywood`
+	TableFirstName_Hazel       TableFirstName = `Hazel`
+	TableFirstName_Heather     TableFirstName = `Heather`
+	TableFirstName_Hector      TableFirstName = `Hector`
+	TableFirstName_Heidi       TableFirstName = `Heidi`
+	TableFirstName_Helen       TableFirstName = `Helen`
+	TableFirstName_Helena      TableFirstName = `Helena`
+	TableFirstName_Helene      TableFirstName = `Helene`
+	TableFirstName_Henrietta   TableFirstName = `Henrietta`
+	TableFirstName_Henry       TableFirstName = `Henry`
+	TableFirstName_Herbert     TableFirstName = `Herbert`
+	TableFirstName_Heriberto   TableFirstName = `Heriberto`
+	TableFirstName_Herman      TableFirstName = `Herman`
+	TableFirstName_Herschel    TableFirstName = `Herschel`
+	TableFirstName_Hershel     TableFirstName = `Hershel`
+	TableFirstName_Hilary      TableFirstName = `Hilary`
+	TableFirstName_Hilda       TableFirstName = `Hilda`
+	TableFirstName_Hilton      TableFirstName = `Hilton`
+	TableFirstName_Hiram       TableFirstName = `Hiram`
+	TableFirstName_Hollis      TableFirstName = `Hollis`
+	TableFirstName_Holly       TableFirstName = `Holly`
+	TableFirstName_Homer       TableFirstName = `Homer`
+	TableFirstName_Hope        TableFirstName = `Hope`
+	TableFirstName_Horace      TableFirstName = `Horace`
+	TableFirstName_Hosea       TableFirstName = `Hosea`
+	TableFirstName_Houston     TableFirstName = `Houston`
+	TableFirstName_Howard      TableFirstName = `Howard`
+	TableFirstName_Hoyt        TableFirstName = `Hoyt`
+	TableFirstName_Hubert      TableFirstName = `Hubert`
+	TableFirstName_Huey        TableFirstName = `Huey`
+	TableFirstName_Hugh        TableFirstName = `Hugh`
+	TableFirstName_Hugo        TableFirstName = `Hugo`
+	TableFirstName_Humberto    TableFirstName = `Humberto`
+	TableFirstName_Ian         TableFirstName = `Ian`
+	TableFirstName_Ida         TableFirstName = `Ida`
+	TableFirstName_Ignacio     TableFirstName = `Ignacio`
+	TableFirstName_Ike         TableFirstName = `Ike`
+	TableFirstName_Ilene       TableFirstName = `Ilene`
+	TableFirstName_Imogene     TableFirstName = `Imogene`
+	TableFirstName_Ina         TableFirstName = `Ina`
+	TableFirstName_Inez        TableFirstName = `Inez`
+	TableFirstName_Ingrid      TableFirstName = `Ingrid`
+	TableFirstName_Ira         TableFirstName = `Ira`
+	TableFirstName_Irene       TableFirstName = `Irene`
+	TableFirstName_Iris        TableFirstName = `Iris`
+	TableFirstName_Irma        TableFirstName = `Irma`
+	TableFirstName_Irvin       TableFirstName = `Irvin`
+	TableFirstName_Irving      TableFirstName = `Irving`
+	TableFirstName_Irwin       TableFirstName = `Irwin`
+	TableFirstName_Isaac       TableFirstName = `Isaac`
+	TableFirstName_Isabel      TableFirstName = `Isabel`
+	TableFirstName_Isaiah      TableFirstName = `Isaiah`
+	TableFirstName_Isiah       TableFirstName = `Isiah`
+	TableFirstName_Ismael      TableFirstName = `Ismael`
+	TableFirstName_Israel      TableFirstName = `Israel`
+	TableFirstName_Issac       TableFirstName = `Issac`
+	TableFirstName_Iva         TableFirstName = `Iva`
+	TableFirstName_Ivan        TableFirstName = `Ivan`
+	TableFirstName_Ivory       TableFirstName = `Ivory`
+	TableFirstName_Ivy         TableFirstName = `Ivy`
+	TableFirstName_Jacalyn     TableFirstName = `Jacalyn`
+	TableFirstName_Jack        TableFirstName = `Jack`
+	TableFirstName_Jackie      TableFirstName = `Jackie`
+	TableFirstName_Jacklyn     TableFirstName = `Jacklyn`
+	TableFirstName_Jackson     TableFirstName = `Jackson`
+	TableFirstName_Jacky       TableFirstName = `Jacky`
+	TableFirstName_Jacob       TableFirstName = `Jacob`
+	TableFirstName_Jacque      TableFirstName = `Jacque`
+	TableFirstName_Jacqueline  TableFirstName = `Jacqueline`
+	TableFirstName_Jacquelyn   TableFirstName = `Jacquelyn`
+	TableFirstName_Jacques     TableFirstName = `Jacques`
+	TableFirstName_Jacquline   TableFirstName = `Jacquline`
+	TableFirstName_Jaime       TableFirstName = `Jaime`
+	TableFirstName_Jake        TableFirstName = `Jake`
+	TableFirstName_Jame        TableFirstName = `Jame`
+	TableFirstName_James       TableFirstName = `James`
+	TableFirstName_Jamie       TableFirstName = `Jamie`
+	TableFirstName_Jan         TableFirstName = `Jan`
+	TableFirstName_Jana        TableFirstName = `Jana`
+	TableFirstName_Jane        TableFirstName = `Jane`
+	TableFirstName_Janeen      TableFirstName = `Janeen`
+	TableFirstName_Janell      TableFirstName = `Janell`
+	TableFirstName_Janelle     TableFirstName = `Janelle`
+	TableFirstName_Janet       TableFirstName = `Janet`
+	TableFirstName_Janette     TableFirstName = `Janette`
+	TableFirstName_Janice      TableFirstName = `Janice`
+	TableFirstName_Janie       TableFirstName = `Janie`
+	TableFirstName_Janine      TableFirstName = `Janine`
+	TableFirstName_Janis       TableFirstName = `Janis`
+	TableFirstName_Jann        TableFirstName = `Jann`
+	TableFirstName_Janna       TableFirstName = `Janna`
+	TableFirstName_Jannette    TableFirstName = `Jannette`
+	TableFirstName_Jannie      TableFirstName = `Jannie`
+	TableFirstName_Jared       TableFirstName = `Jared`
+	TableFirstName_Jarvis      TableFirstName = `Jarvis`
+	TableFirstName_Jason       TableFirstName = `Jason`
+	TableFirstName_Jasper      TableFirstName = `Jasper`
+	TableFirstName_Javier      TableFirstName = `Javier`
+	TableFirstName_Jay         TableFirstName = `Jay`
+	TableFirstName_Jaye        TableFirstName = `Jaye`
+	TableFirstName_Jayne       TableFirstName = `Jayne`
+	TableFirstName_Jean        TableFirstName = `Jean`
+	TableFirstName_Jeanette    TableFirstName = `Jeanette`
+	TableFirstName_Jeanie      TableFirstName = `Jeanie`
+	TableFirstName_Jeanine     TableFirstName = `Jeanine`
+	TableFirstName_Jeanne      TableFirstName = `Jeanne`
+	TableFirstName_Jeannette   TableFirstName = `Jeannette`
+	TableFirstName_Jeannie     TableFirstName = `Jeannie`
+	TableFirstName_Jeannine    TableFirstName = `Jeannine`
+	TableFirstName_Jed         TableFirstName = `Jed`
+	TableFirstName_Jeff        TableFirstName = `Jeff`
+	TableFirstName_Jefferey    TableFirstName = `Jefferey`
+	TableFirstName_Jefferson   TableFirstName = `Jefferson`
+	TableFirstName_Jeffery     TableFirstName = `Jeffery`
+	TableFirstName_Jeffry      TableFirstName = `Jeffry`
+	TableFirstName_Jenifer     TableFirstName = `Jenifer`
+	TableFirstName_Jennie      TableFirstName = `Jennie`
+	TableFirstName_Jennifer    TableFirstName = `Jennifer`
+	TableFirstName_Jenny       TableFirstName = `Jenny`
+	TableFirstName_Jerald      TableFirstName = `Jerald`
+	TableFirstName_Jere        TableFirstName = `Jere`
+	TableFirstName_Jeremiah    TableFirstName = `Jeremiah`
+	TableFirstName_Jeremy      TableFirstName = `Jeremy`
+	TableFirstName_Jeri        TableFirstName = `Jeri`
+	TableFirstName_Jerilyn     TableFirstName = `Jerilyn`
+	TableFirstName_Jerold      TableFirstName = `Jerold`
+	TableFirstName_Jerome      TableFirstName = `Jerome`
+	TableFirstName_Jerri       TableFirstName = `Jerri`
+	TableFirstName_Jerrie      TableFirstName = `Jerrie`
+	TableFirstName_Jerrold     TableFirstName = `Jerrold`
+	TableFirstName_Jerry       TableFirstName = `Jerry`
+	TableFirstName_Jeryl       TableFirstName = `Jeryl`
+	TableFirstName_Jess        TableFirstName = `Jess`
+	TableFirstName_Jesse       TableFirstName = `Jesse`
+	TableFirstName_Jessica     TableFirstName = `Jessica`
+	TableFirstName_Jessie      TableFirstName = `Jessie`
+	TableFirstName_Jesus       TableFirstName = `Jesus`
+	TableFirstName_Jewel       TableFirstName = `Jewel`
+	TableFirstName_Jewell      TableFirstName = `Jewell`
+	TableFirstName_Jill        TableFirstName = `Jill`
+	TableFirstName_Jim         TableFirstName = `Jim`
+	TableFirstName_Jimmie      TableFirstName = `Jimmie`
+	TableFirstName_Jimmy       TableFirstName = `Jimmy`
+	TableFirstName_Jo          TableFirstName = `Jo`
+	TableFirstName_Joan        TableFirstName = `Joan`
+	TableFirstName_Joanie      TableFirstName = `Joanie`
+	TableFirstName_Joann       TableFirstName = `Joann`
+	TableFirstName_Joanna      TableFirstName = `Joanna`
+	TableFirstName_Joanne      TableFirstName = `Joanne`
+	TableFirstName_Joaquin     TableFirstName = `Joaquin`
+	TableFirstName_Jocelyn     TableFirstName = `Jocelyn`
+	TableFirstName_Jodi        TableFirstName = `Jodi`
+	TableFirstName_Jodie       TableFirstName = `Jodie`
+	TableFirstName_Jody        TableFirstName = `Jody`
+	TableFirstName_Joe         TableFirstName = `Joe`
+	TableFirstName_Joel        TableFirstName = `Joel`
+	TableFirstName_Joellen     TableFirstName = `Joellen`
+	TableFirstName_Joesph      TableFirstName = `Joesph`
+	TableFirstName_Joette      TableFirstName = `Joette`
+	TableFirstName_Joey        TableFirstName = `Joey`
+	TableFirstName_Johanna     TableFirstName = `Johanna`
+	TableFirstName_John        TableFirstName = `John`
+	TableFirstName_Johnathan   TableFirstName = `Johnathan`
+	TableFirstName_Johnie      TableFirstName = `Johnie`
+	TableFirstName_Johnnie     TableFirstName = `Johnnie`
+	TableFirstName_Johnny      TableFirstName = `Johnny`
+	TableFirstName_Joleen      TableFirstName = `Joleen`
+	TableFirstName_Jolene      TableFirstName = `Jolene`
+	TableFirstName_Jon         TableFirstName = `Jon`
+	TableFirstName_Jonas       TableFirstName = `Jonas`
+	TableFirstName_Jonathan    TableFirstName = `Jonathan`
+	TableFirstName_Jonathon    TableFirstName = `Jonathon`
+	TableFirstName_Joni        TableFirstName = `Joni`
+	TableFirstName_Jordan      TableFirstName = `Jordan`
+	TableFirstName_Jorge       TableFirstName = `Jorge`
+	TableFirstName_Jose        TableFirstName = `Jose`
+	TableFirstName_Josefina    TableFirstName = `Josefina`
+	TableFirstName_Joseph      TableFirstName = `Joseph`
+	TableFirstName_Josephine   TableFirstName = `Josephine`
+	TableFirstName_Joshua      TableFirstName = `Joshua`
+	TableFirstName_Josie       TableFirstName = `Josie`
+	TableFirstName_Joy         TableFirstName = `Joy`
+	TableFirstName_Joyce       TableFirstName = `Joyce`
+	TableFirstName_Joycelyn    TableFirstName = `Joycelyn`
+	TableFirstName_Juan        TableFirstName = `Juan`
+	TableFirstName_Juana       TableFirstName = `Juana`
+	TableFirstName_Juanita     TableFirstName = `Juanita`
+	TableFirstName_Jude        TableFirstName = `Jude`
+	TableFirstName_Judi        TableFirstName = `Judi`
+	TableFirstName_Judith      TableFirstName = `Judith`
+	TableFirstName_Judson      TableFirstName = `Judson`
+	TableFirstName_Judy        TableFirstName = `Judy`
+	TableFirstName_Jules       TableFirstName = `Jules`
+	TableFirstName_Julia       TableFirstName = `Julia`
+	TableFirstName_Julian      TableFirstName = `Julian`
+	TableFirstName_Juliana     TableFirstName = `Juliana`
+	TableFirstName_Juliann     TableFirstName = `Juliann`
+	TableFirstName_Julianne    TableFirstName = `Julianne`
+	TableFirstName_Julie       TableFirstName = `Julie`
+	TableFirstName_Juliet      TableFirstName = `Juliet`
+	TableFirstName_Juliette    TableFirstName = `Juliette`
+	TableFirstName_Julio       TableFirstName = `Julio`
+	TableFirstName_Julius      TableFirstName = `Julius`
+	TableFirstName_June        TableFirstName = `June`
+	TableFirstName_Junior      TableFirstName = `Junior`
+	TableFirstName_Justin      TableFirstName = `Justin`
+	TableFirstName_Justine     TableFirstName = `Justine`
+	TableFirstName_Kandy       TableFirstName = `Kandy`
+	TableFirstName_Karan       TableFirstName = `Karan`
+	TableFirstName_Karen       TableFirstName = `Karen`
+	TableFirstName_Kari        TableFirstName = `Kari`
+	TableFirstName_Karin       TableFirstName = `Karin`
+	TableFirstName_Karl        TableFirstName = `Karl`
+	TableFirstName_Karla       TableFirstName = `Karla`
+	TableFirstName_Karol       TableFirstName = `Karol`
+	TableFirstName_Karon       TableFirstName = `Karon`
+	TableFirstName_Karyn       TableFirstName = `Karyn`
+	TableFirstName_Kate        TableFirstName = `Kate`
+	TableFirstName_Kathaleen   TableFirstName = `Kathaleen`
+	TableFirstName_Katharine   TableFirstName = `Katharine`
+	TableFirstName_Katherine   TableFirstName = `Katherine`
+	TableFirstName_Katheryn    TableFirstName = `Katheryn`
+	TableFirstName_Kathi       TableFirstName = `Kathi`
+	TableFirstName_Kathie      TableFirstName = `Kathie`
+	TableFirstName_Kathleen    TableFirstName = `Kathleen`
+	TableFirstName_Kathrine    TableFirstName = `Kathrine`
+	TableFirstName_Kathryn     TableFirstName = `Kathryn`
+	TableFirstName_Kathy       TableFirstName = `Kathy`
+	TableFirstName_Katie       TableFirstName = `Katie`
+	TableFirstName_Katrina     TableFirstName = `Katrina`
+	TableFirstName_Kay         TableFirstName = `Kay`
+	TableFirstName_Kaye        TableFirstName = `Kaye`
+	TableFirstName_Keith       TableFirstName = `Keith`
+	TableFirstName_Kelley      TableFirstName = `Kelley`
+	TableFirstName_Kelly       TableFirstName = `Kelly`
+	TableFirstName_Kelvin      TableFirstName = `Kelvin`
+	TableFirstName_Ken         TableFirstName = `Ken`
+	TableFirstName_Kendall     TableFirstName = `Kendall`
+	TableFirstName_Kendra      TableFirstName = `Kendra`
+	TableFirstName_Kenneth     TableFirstName = `Kenneth`
+	TableFirstName_Kennith     TableFirstName = `Kennith`
+	TableFirstName_Kenny       TableFirstName = `Kenny`
+	TableFirstName_Kent        TableFirstName = `Kent`
+	TableFirstName_Kenton      TableFirstName = `Kenton`
+	TableFirstName_Kermit      TableFirstName = `Kermit`
+	TableFirstName_Kerri       TableFirstName = `Kerri`
+	TableFirstName_Kerry       TableFirstName = `Kerry`
+	TableFirstName_Kevan       TableFirstName = `Kevan`
+	TableFirstName_Keven       TableFirstName = `Keven`
+	TableFirstName_Kevin       TableFirstName = `Kevin`
+	TableFirstName_Kim         TableFirstName = `Kim`
+	TableFirstName_Kimberlee   TableFirstName = `Kimberlee`
+	TableFirstName_Kimberley   TableFirstName = `Kimberley`
+	TableFirstName_Kimberly    TableFirstName = `Kimberly`
+	TableFirstName_King        TableFirstName = `King`
+	TableFirstName_Kip         TableFirstName = `Kip`
+	TableFirstName_Kirby       TableFirstName = `Kirby`
+	TableFirstName_Kirk        TableFirstName = `Kirk`
+	TableFirstName_Kirt        TableFirstName = `Kirt`
+	TableFirstName_Kit         TableFirstName = `Kit`
+	TableFirstName_Kitty       TableFirstName = `Kitty`
+	TableFirstName_Kraig       TableFirstName = `Kraig`
+	TableFirstName_Kris        TableFirstName = `Kris`
+	TableFirstName_Krista      TableFirstName = `Krista`
+	TableFirstName_Kristen     TableFirstName = `Kristen`
+	TableFirstName_Kristi      TableFirstName = `Kristi`
+	TableFirstName_Kristie     TableFirstName = `Kristie`
+	TableFirstName_Kristin     TableFirstName = `Kristin`
+	TableFirstName_Kristina    TableFirstName = `Kristina`
+	TableFirstName_Kristine    TableFirstName = `Kristine`
+	TableFirstName_Kristy      TableFirstName = `Kristy`
+	TableFirstName_Kurt        TableFirstName = `Kurt`
+	TableFirstName_Kurtis      TableFirstName = `Kurtis`
+	TableFirstName_Kyle        TableFirstName = `Kyle`
+	TableFirstName_Lacy        TableFirstName = `Lacy`
+	TableFirstName_Ladonna     TableFirstName = `Ladonna`
+	TableFirstName_Lafayette   TableFirstName = `Lafayette`
+	TableFirstName_Lamar       TableFirstName = `Lamar`
+	TableFirstName_Lamont      TableFirstName = `Lamont`
+	TableFirstName_Lana        TableFirstName = `Lana`
+	TableFirstName_Lance       TableFirstName = `Lance`
+	TableFirstName_Lane        TableFirstName = `Lane`
+	TableFirstName_Lanette     TableFirstName = `Lanette`
+	TableFirstName_Lanny       TableFirstName = `Lanny`
+	TableFirstName_Larry       TableFirstName = `Larry`
+	TableFirstName_Laura       TableFirstName = `Laura`
+	TableFirstName_Laureen     TableFirstName = `Laureen`
+	TableFirstName_Laurel      TableFirstName = `Laurel`
+	TableFirstName_Lauren      TableFirstName = `Lauren`
+	TableFirstName_Laurence    TableFirstName = `Laurence`
+	TableFirstName_Laurene     TableFirstName = `Laurene`
+	TableFirstName_Lauretta    TableFirstName = `Lauretta`
+	TableFirstName_Lauri       TableFirstName = `Lauri`
+	TableFirstName_Laurie      TableFirstName = `Laurie`
+	TableFirstName_Lavern      TableFirstName = `Lavern`
+	TableFirstName_Laverne     TableFirstName = `Laverne`
+	TableFirstName_Lavonne     TableFirstName = `Lavonne`
+	TableFirstName_Lawanda     TableFirstName = `Lawanda`
+	TableFirstName_Lawerence   TableFirstName = `Lawerence`
+	TableFirstName_Lawrence    TableFirstName = `Lawrence`
+	TableFirstName_Layne       TableFirstName = `Layne`
+	TableFirstName_Lea         TableFirstName = `Lea`
+	TableFirstName_Leah        TableFirstName = `Leah`
+	TableFirstName_Leander     TableFirstName = `Leander`
+	TableFirstName_Leann       TableFirstName = `Leann`
+	TableFirstName_Leanna      TableFirstName = `Leanna`
+	TableFirstName_Leanne      TableFirstName = `Leanne`
+	TableFirstName_Lee         TableFirstName = `Lee`
+	TableFirstName_Leeann      TableFirstName = `Leeann`
+	TableFirstName_Leigh       TableFirstName = `Leigh`
+	TableFirstName_Leila       TableFirstName = `Leila`
+	TableFirstName_Lela        TableFirstName = `Lela`
+	TableFirstName_Leland      TableFirstName = `Leland`
+	TableFirstName_Lelia       TableFirstName = `Lelia`
+	TableFirstName_Lemuel      TableFirstName = `Lemuel`
+	TableFirstName_Len         TableFirstName = `Len`
+	TableFirstName_Lena        TableFirstName = `Lena`
+	TableFirstName_Lenard      TableFirstName = `Lenard`
+	TableFirstName_Lennie      TableFirstName = `Lennie`
+	TableFirstName_Lenny       TableFirstName = `Lenny`
+	TableFirstName_Lenora      TableFirstName = `Lenora`
+	TableFirstName_Lenore      TableFirstName = `Lenore`
+	TableFirstName_Leo         TableFirstName = `Leo`
+	TableFirstName_Leola       TableFirstName = `Leola`
+	TableFirstName_Leon        TableFirstName = `Leon`
+	TableFirstName_Leona       TableFirstName = `Leona`
+	TableFirstName_Leonard     TableFirstName = `Leonard`
+	TableFirstName_Leonardo    TableFirstName = `Leonardo`
+	TableFirstName_Leroy       TableFirstName = `Leroy`
+	TableFirstName_Les         TableFirstName = `Les`
+	TableFirstName_Lesa        TableFirstName = `Lesa`
+	TableFirstName_Leslee      TableFirstName = `Leslee`
+	TableFirstName_Lesley      TableFirstName = `Lesley`
+	TableFirstName_Leslie      TableFirstName = `Leslie`
+	TableFirstName_Lessie      TableFirstName = `Lessie`
+	TableFirstName_Lester      TableFirstName = `Lester`
+	TableFirstName_Leta        TableFirstName = `Leta`
+	TableFirstName_Letha       TableFirstName = `Letha`
+	TableFirstName_Leticia     TableFirstName = `Leticia`
+	TableFirstName_Letitia     TableFirstName = `Letitia`
+	TableFirstName_Levern      TableFirstName = `Levern`
+	TableFirstName_Levi        TableFirstName = `Levi`
+	TableFirstName_Levon       TableFirstName = `Levon`
+	TableFirstName_Lewis       TableFirstName = `Lewis`
+	TableFirstName_Lex         TableFirstName = `Lex`
+	TableFirstName_Libby       TableFirstName = `Libby`
+	TableFirstName_Lila        TableFirstName = `Lila`
+	TableFirstName_Lillian     TableFirstName = `Lillian`
+	TableFirstName_Lillie      TableFirstName = `Lillie`
+	TableFirstName_Lilly       TableFirstName = `Lilly`
+	TableFirstName_Lily        TableFirstName = `Lily`
+	TableFirstName_Lincoln     TableFirstName = `Lincoln`
+	TableFirstName_Linda       TableFirstName = `Linda`
+	TableFirstName_Lindsay     TableFirstName = `Lindsay`
+	TableFirstName_Lindsey     TableFirstName = `Lindsey`
+	TableFirstName_Lindy       TableFirstName = `Lindy`
+	TableFirstName_Linnea      TableFirstName = `Linnea`
+	TableFirstName_Linwood     TableFirstName = `Linwood`
+	TableFirstName_Lionel      TableFirstName = `Lionel`
+	TableFirstName_Lisa        TableFirstName = `Lisa`
+	TableFirstName_Lise        TableFirstName = `Lise`
+	TableFirstName_Lizabeth    TableFirstName = `Lizabeth`
+	TableFirstName_Lizzie      TableFirstName = `Lizzie`
+	TableFirstName_Lloyd       TableFirstName = `Lloyd`
+	TableFirstName_Logan       TableFirstName = `Logan`
+	TableFirstName_Lois        TableFirstName = `Lois`
+	TableFirstName_Lola        TableFirstName = `Lola`
+	TableFirstName_Lolita      TableFirstName = `Lolita`
+	TableFirstName_Lon         TableFirstName = `Lon`
+	TableFirstName_Lona        TableFirstName = `Lona`
+	TableFirstName_Lonnie      TableFirstName = `Lonnie`
+	TableFirstName_Lonny       TableFirstName = `Lonny`
+	TableFirstName_Lora        TableFirstName = `Lora`
+	TableFirstName_Loraine     TableFirstName = `Loraine`
+	TableFirstName_Lorelei     TableFirstName = `Lorelei`
+	TableFirstName_Loren       TableFirstName = `Loren`
+	TableFirstName_Lorena      TableFirstName = `Lorena`
+	TableFirstName_Lorene      TableFirstName = `Lorene`
+	TableFirstName_Lorenzo     TableFirstName = `Lorenzo`
+	TableFirstName_Loretta     TableFirstName = `Loretta`
+	TableFirstName_Lori        TableFirstName = `Lori`
+	TableFirstName_Lorie       TableFirstName = `Lorie`
+	TableFirstName_Lorin       TableFirstName = `Lorin`
+	TableFirstName_Lorinda     TableFirstName = `Lorinda`
+	TableFirstName_Lorna       TableFirstName = `Lorna`
+	TableFirstName_Lorraine    TableFirstName = `Lorraine`
+	TableFirstName_Lorri       TableFirstName = `Lorri`
+	TableFirstName_Lorrie      TableFirstName = `Lorrie`
+	TableFirstName_Lottie      TableFirstName = `Lottie`
+	TableFirstName_Lou         TableFirstName = `Lou`
+	TableFirstName_Louann      TableFirstName = `Louann`
+	TableFirstName_Louella     TableFirstName = `Louella`
+	TableFirstName_Louie       TableFirstName = `Louie`
+	TableFirstName_Louis       TableFirstName = `Louis`
+	TableFirstName_Louisa      TableFirstName = `Louisa`
+	TableFirstName_Louise      TableFirstName = `Louise`
+	TableFirstName_Lourdes     TableFirstName = `Lourdes`
+	TableFirstName_Lowell      TableFirstName = `Lowell`
+	TableFirstName_Loyd        TableFirstName = `Loyd`
+	TableFirstName_Lu          TableFirstName = `Lu`
+	TableFirstName_Luann       TableFirstName = `Luann`
+	TableFirstName_Luanne      TableFirstName = `Luanne`
+	TableFirstName_Lucia       TableFirstName = `Lucia`
+	TableFirstName_Lucille     TableFirstName = `Lucille`
+	TableFirstName_Lucinda     TableFirstName = `Lucinda`
+	TableFirstName_Lucius      TableFirstName = `Lucius`
+	TableFirstName_Lucretia    TableFirstName = `Lucretia`
+	TableFirstName_Lucy        TableFirstName = `Lucy`
+	TableFirstName_Luella      TableFirstName = `Luella`
+	TableFirstName_Luis        TableFirstName = `Luis`
+	TableFirstName_Luke        TableFirstName = `Luke`
+	TableFirstName_Lula        TableFirstName = `Lula`
+	TableFirstName_Lupe        TableFirstName = `Lupe`
+	TableFirstName_Luther      TableFirstName = `Luther`
+	TableFirstName_Luz         TableFirstName = `Luz`
+	TableFirstName_Lydia       TableFirstName = `Lydia`
+	TableFirstName_Lyle        TableFirstName = `Lyle`
+	TableFirstName_Lyman       TableFirstName = `Lyman`
+	TableFirstName_Lyn         TableFirstName = `Lyn`
+	TableFirstName_Lynda       TableFirstName = `Lynda`
+	TableFirstName_Lyndon      TableFirstName = `Lyndon`
+	TableFirstName_Lynette     TableFirstName = `Lynette`
+	TableFirstName_Lynn        TableFirstName = `Lynn`
+	TableFirstName_Lynne       TableFirstName = `Lynne`
+	TableFirstName_Lynnette    TableFirstName = `Lynnette`
+	TableFirstName_Lynwood     TableFirstName = `Lynwood`
+	TableFirstName_Mabel       TableFirstName = `Mabel`
+	TableFirstName_Mable       TableFirstName = `Mable`
+	TableFirstName_Mac         TableFirstName = `Mac`
+	TableFirstName_Mack        TableFirstName = `Mack`
+	TableFirstName_Madeleine   TableFirstName = `Madeleine`
+	TableFirstName_Madeline    TableFirstName = `Madeline`
+	TableFirstName_Madelyn     TableFirstName = `Madelyn`
+	TableFirstName_Madonna     TableFirstName = `Madonna`
+	TableFirstName_Mae         TableFirstName = `Mae`
+	TableFirstName_Magdalena   TableFirstName = `Magdalena`
+	TableFirstName_Maggie      TableFirstName = `Maggie`
+	TableFirstName_Major       TableFirstName = `Major`
+	TableFirstName_Malcolm     TableFirstName = `Malcolm`
+	TableFirstName_Malinda     TableFirstName = `Malinda`
+	TableFirstName_Mamie       TableFirstName = `Mamie`
+	TableFirstName_Manuel      TableFirstName = `Manuel`
+	TableFirstName_Mara        TableFirstName = `Mara`
+	TableFirstName_Marc        TableFirstName = `Marc`
+	TableFirstName_Marcel      TableFirstName = `Marcel`
+	TableFirstName_Marcella    TableFirstName = `Marcella`
+	TableFirstName_Marci       TableFirstName = `Marci`
+	TableFirstName_Marcia      TableFirstName = `Marcia`
+	TableFirstName_Marcie      TableFirstName = `Marcie`
+	TableFirstName_Marco       TableFirstName = `Marco`
+	TableFirstName_Marcos      TableFirstName = `Marcos`
+	TableFirstName_Marcus      TableFirstName = `Marcus`
+	TableFirstName_Marcy       TableFirstName = `Marcy`
+	TableFirstName_Margaret    TableFirstName = `Margaret`
+	TableFirstName_Margarita   TableFirstName = `Margarita`
+	TableFirstName_Margarito   TableFirstName = `Margarito`
+	TableFirstName_Margery     TableFirstName = `Margery`
+	TableFirstName_Margie      TableFirstName = `Margie`
+	TableFirstName_Margo       TableFirstName = `Margo`
+	TableFirstName_Margot      TableFirstName = `Margot`
+	TableFirstName_Margret     TableFirstName = `Margret`
+	TableFirstName_Marguerite  TableFirstName = `Marguerite`
+	TableFirstName_Mari        TableFirstName = `Mari`
+	TableFirstName_Maria       TableFirstName = `Maria`
+	TableFirstName_Marian      TableFirstName = `Marian`
+	TableFirstName_Mariann     TableFirstName = `Mariann`
+	TableFirstName_Marianna    TableFirstName = `Marianna`
+	TableFirstName_Marianne    TableFirstName = `Marianne`
+	TableFirstName_Maribeth    TableFirstName = `Maribeth`
+	TableFirstName_Marie       TableFirstName = `Marie`
+	TableFirstName_Marietta    TableFirstName = `Marietta`
+	TableFirstName_Marilee     TableFirstName = `Marilee`
+	TableFirstName_Marilyn     TableFirstName = `Marilyn`
+	TableFirstName_Marilynn    TableFirstName = `Marilynn`
+	TableFirstName_Marina      TableFirstName = `Marina`
+	TableFirstName_Mario       TableFirstName = `Mario`
+	TableFirstName_Marion      TableFirstName = `Marion`
+	TableFirstName_Marita      TableFirstName = `Marita`
+	TableFirstName_Marjorie    TableFirstName = `Marjorie`
+	TableFirstName_Mark        TableFirstName = `Mark`
+	TableFirstName_Marla       TableFirstName = `Marla`
+	TableFirstName_Marlene     TableFirstName = `Marlene`
+	TableFirstName_Marlin      TableFirstName = `Marlin`
+	TableFirstName_Marlon      TableFirstName = `Marlon`
+	TableFirstName_Marlys      TableFirstName = `Marlys`
+	TableFirstName_Marsha      TableFirstName = `Marsha`
+	TableFirstName_Marshall    TableFirstName = `Marshall`
+	TableFirstName_Marta       TableFirstName = `Marta`
+	TableFirstName_Martha      TableFirstName = `Martha`
+	TableFirstName_Martin      TableFirstName = `Martin`
+	TableFirstName_Martina     TableFirstName = `Martina`
+	TableFirstName_Marty       TableFirstName = `Marty`
+	TableFirstName_Marva       TableFirstName = `Marva`
+	TableFirstName_Marvin      TableFirstName = `Marvin`
+	TableFirstName_Mary        TableFirstName = `Mary`
+	TableFirstName_Maryann     TableFirstName = `Maryann`
+	TableFirstName_Maryanne    TableFirstName = `Maryanne`
+	TableFirstName_Marybeth    TableFirstName = `Marybeth`
+	TableFirstName_Maryellen   TableFirstName = `Maryellen`
+	TableFirstName_Maryjane    TableFirstName = `Maryjane`
+	TableFirstName_Maryjo      TableFirstName = `Maryjo`
+	TableFirstName_Marylou     TableFirstName = `Marylou`
+	TableFirstName_Mason       TableFirstName = `Mason`
+	TableFirstName_Mathew      TableFirstName = `Mathew`
+	TableFirstName_Matilda     TableFirstName = `Matilda`
+	TableFirstName_Matt        TableFirstName = `Matt`
+	TableFirstName_Matthew     TableFirstName = `Matthew`
+	TableFirstName_Mattie      TableFirstName = `Mattie`
+	TableFirstName_Maura       TableFirstName = `Maura`
+	TableFirstName_Maureen     TableFirstName = `Maureen`
+	TableFirstName_Maurice     TableFirstName = `Maurice`
+	TableFirstName_Mavis       TableFirstName = `Mavis`
+	TableFirstName_Max         TableFirstName = `Max`
+	TableFirstName_Maxine      TableFirstName = `Maxine`
+	TableFirstName_Maxwell     TableFirstName = `Maxwell`
+	TableFirstName_May         TableFirstName = `May`
+	TableFirstName_Maynard     TableFirstName = `Maynard`
+	TableFirstName_Mckinley    TableFirstName = `Mckinley`
+	TableFirstName_Megan       TableFirstName = `Megan`
+	TableFirstName_Mel         TableFirstName = `Mel`
+	TableFirstName_Melanie     TableFirstName = `Melanie`
+	TableFirstName_Melba       TableFirstName = `Melba`
+	TableFirstName_Melinda     TableFirstName = `Melinda`
+	TableFirstName_Melissa     TableFirstName = `Melissa`
+	TableFirstName_Melodee     TableFirstName = `Melodee`
+	TableFirstName_Melodie     TableFirstName = `Melodie`
+	TableFirstName_Melody      TableFirstName = `Melody`
+	TableFirstName_Melva       TableFirstName = `Melva`
+	TableFirstName_Melvin      TableFirstName = `Melvin`
+	TableFirstName_Mercedes    TableFirstName = `Mercedes`
+	TableFirstName_Meredith    TableFirstName = `Meredith`
+	TableFirstName_Merle       TableFirstName = `Merle`
+	TableFirstName_Merlin      TableFirstName = `Merlin`
+	TableFirstName_Merri       TableFirstName = `Merri`
+	TableFirstName_Merrill     TableFirstName = `Merrill`
+	TableFirstName_Merry       TableFirstName = `Merry`
+	TableFirstName_Mervin      TableFirstName = `Mervin`
+	TableFirstName_Meryl       TableFirstName = `Meryl`
+	TableFirstName_Michael     TableFirstName = `Michael`
+	TableFirstName_Michal      TableFirstName = `Michal`
+	TableFirstName_Michale     TableFirstName = `Michale`
+	TableFirstName_Micheal     TableFirstName = `Micheal`
+	TableFirstName_Michel      TableFirstName = `Michel`
+	TableFirstName_Michele     TableFirstName = `Michele`
+	TableFirstName_Michelle    TableFirstName = `Michelle`
+	TableFirstName_Mickey      TableFirstName = `Mickey`
+	TableFirstName_Mickie      TableFirstName = `Mickie`
+	TableFirstName_Micky       TableFirstName = `Micky`
+	TableFirstName_Migdalia    TableFirstName = `Migdalia`
+	TableFirstName_Miguel      TableFirstName = `Miguel`
+	TableFirstName_Mike        TableFirstName = `Mike`
+	TableFirstName_Mikel       TableFirstName = `Mikel`
+	TableFirstName_Milagros    TableFirstName = `Milagros`
+	TableFirstName_Milan       TableFirstName = `Milan`
+	TableFirstName_Mildred     TableFirstName = `Mildred`
+	TableFirstName_Miles       TableFirstName = `Miles`
+	TableFirstName_Milford     TableFirstName = `Milford`
+	TableFirstName_Millard     TableFirstName = `Millard`
+	TableFirstName_Millicent   TableFirstName = `Millicent`
+	TableFirstName_Millie      TableFirstName = `Millie`
+	TableFirstName_Milo        TableFirstName = `Milo`
+	TableFirstName_Milton      TableFirstName = `Milton`
+	TableFirstName_Mimi        TableFirstName = `Mimi`
+	TableFirstName_Mindy       TableFirstName = `Mindy`
+	TableFirstName_Minerva     TableFirstName = `Minerva`
+	TableFirstName_Minnie      TableFirstName = `Minnie`
+	TableFirstName_Miriam      TableFirstName = `Miriam`
+	TableFirstName_Mitch       TableFirstName = `Mitch`
+	TableFirstName_Mitchel     TableFirstName = `Mitchel`
+	TableFirstName_Mitchell    TableFirstName = `Mitchell`
+	TableFirstName_Mitzi       TableFirstName = `Mitzi`
+	TableFirstName_Moira       TableFirstName = `Moira`
+	TableFirstName_Moises      TableFirstName = `Moises`
+	TableFirstName_Mollie      TableFirstName = `Mollie`
+	TableFirstName_Molly       TableFirstName = `Molly`
+	TableFirstName_Mona        TableFirstName = `Mona`
+	TableFirstName_Monica      TableFirstName = `Monica`
+	TableFirstName_Monique     TableFirstName = `Monique`
+	TableFirstName_Monroe      TableFirstName = `Monroe`
+	TableFirstName_Monte       TableFirstName = `Monte`
+	TableFirstName_Monty       TableFirstName = `Monty`
+	TableFirstName_Morgan      TableFirstName = `Morgan`
+	TableFirstName_Morris      TableFirstName = `Morris`
+	TableFirstName_Mose        TableFirstName = `Mose`
+	TableFirstName_Moses       TableFirstName = `Moses`
+	TableFirstName_Muriel      TableFirstName = `Muriel`
+	TableFirstName_Murphy      TableFirstName = `Murphy`
+	TableFirstName_Murray      TableFirstName = `Murray`
+	TableFirstName_Myles       TableFirstName = `Myles`
+	TableFirstName_Myra        TableFirstName = `Myra`
+	TableFirstName_Myrna       TableFirstName = `Myrna`
+	TableFirstName_Myron       TableFirstName = `Myron`
+	TableFirstName_Myrtle      TableFirstName = `Myrtle`
+	TableFirstName_Nadine      TableFirstName = `Nadine`
+	TableFirstName_Nan         TableFirstName = `Nan`
+	TableFirstName_Nanci       TableFirstName = `Nanci`
+	TableFirstName_Nancy       TableFirstName = `Nancy`
+	TableFirstName_Nanette     TableFirstName = `Nanette`
+	TableFirstName_Nannette    TableFirstName = `Nannette`
+	TableFirstName_Naomi       TableFirstName = `Naomi`
+	TableFirstName_Napoleon    TableFirstName = `Napoleon`
+	TableFirstName_Natalie     TableFirstName = `Natalie`
+	TableFirstName_Nathan      TableFirstName = `Nathan`
+	TableFirstName_Nathaniel   TableFirstName = `Nathaniel`
+	TableFirstName_Neal        TableFirstName = `Neal`
+	TableFirstName_Ned         TableFirstName = `Ned`
+	TableFirstName_Nedra       TableFirstName = `Nedra`
+	TableFirstName_Neil        TableFirstName = `Neil`
+	TableFirstName_Nelda       TableFirstName = `Nelda`
+	TableFirstName_Nellie      TableFirstName = `Nellie`
+	TableFirstName_Nelson      TableFirstName = `Nelson`
+	TableFirstName_Nettie      TableFirstName = `Nettie`
+	TableFirstName_Neva        TableFirstName = `Neva`
+	TableFirstName_Newton      TableFirstName = `Newton`
+	TableFirstName_Nicholas    TableFirstName = `Nicholas`
+	TableFirstName_Nick        TableFirstName = `Nick`
+	TableFirstName_Nicki       TableFirstName = `Nicki`
+	TableFirstName_Nickolas    TableFirstName = `Nickolas`
+	TableFirstName_Nicky       TableFirstName = `Nicky`
+	TableFirstName_Nicolas     TableFirstName = `Nicolas`
+	TableFirstName_Nicole      TableFirstName = `Nicole`
+	TableFirstName_Nikki       TableFirstName = `Nikki`
+	TableFirstName_Nina        TableFirstName = `Nina`
+	TableFirstName_Nita        TableFirstName = `Nita`
+	TableFirstName_Noah        TableFirstName = `Noah`
+	TableFirstName_Noe         TableFirstName = `Noe`
+	TableFirstName_Noel        TableFirstName = `Noel`
+	TableFirstName_Noemi       TableFirstName = `Noemi`
+	TableFirstName_Nola        TableFirstName = `Nola`
+	TableFirstName_Nolan       TableFirstName = `Nolan`
+	TableFirstName_Nona        TableFirstName = `Nona`
+	TableFirstName_Nora        TableFirstName = `Nora`
+	TableFirstName_Norbert     TableFirstName = `Norbert`
+	TableFirstName_Noreen      TableFirstName = `Noreen`
+	TableFirstName_Norma       TableFirstName = `Norma`
+	TableFirstName_Norman      TableFirstName = `Norman`
+	TableFirstName_Normand     TableFirstName = `Normand`
+	TableFirstName_Norris      TableFirstName = `Norris`
+	TableFirstName_Odell       TableFirstName = `Odell`
+	TableFirstName_Odessa      TableFirstName = `Odessa`
+	TableFirstName_Odis        TableFirstName = `Odis`
+	TableFirstName_Ofelia      TableFirstName = `Ofelia`
+	TableFirstName_Ola         TableFirstName = `Ola`
+	TableFirstName_Olen        TableFirstName = `Olen`
+	TableFirstName_Olga        TableFirstName = `Olga`
+	TableFirstName_Olin        TableFirstName = `Olin`
+	TableFirstName_Oliver      TableFirstName = `Oliver`
+	TableFirstName_Olivia      TableFirstName = `Olivia`
+	TableFirstName_Ollie       TableFirstName = `Ollie`
+	TableFirstName_Omar        TableFirstName = `Omar`
+	TableFirstName_Opal        TableFirstName = `Opal`
+	TableFirstName_Ophelia     TableFirstName = `Ophelia`
+	TableFirstName_Ora         TableFirstName = `Ora`
+	TableFirstName_Oralia      TableFirstName = `Oralia`
+	TableFirstName_Orlando     TableFirstName = `Orlando`
+	TableFirstName_Orval       TableFirstName = `Orval`
+	TableFirstName_Orville     TableFirstName = `Orville`
+	TableFirstName_Oscar       TableFirstName = `Oscar`
+	TableFirstName_Otha        TableFirstName = `Otha`
+	TableFirstName_Otis        TableFirstName = `Otis`
+	TableFirstName_Otto        TableFirstName = `Otto`
+	TableFirstName_Owen        TableFirstName = `Owen`
+	TableFirstName_Pablo       TableFirstName = `Pablo`
+	TableFirstName_Paige       TableFirstName = `Paige`
+	TableFirstName_Pam         TableFirstName = `Pam`
+	TableFirstName_Pamala      TableFirstName = `Pamala`
+	TableFirstName_Pamela      TableFirstName = `Pamela`
+	TableFirstName_Pamella     TableFirstName = `Pamella`
+	TableFirstName_Pasquale    TableFirstName = `Pasquale`
+	TableFirstName_Pat         TableFirstName = `Pat`
+	TableFirstName_Patrica     TableFirstName = `Patrica`
+	TableFirstName_Patrice     TableFirstName = `Patrice`
+	TableFirstName_Patricia    TableFirstName = `Patricia`
+	TableFirstName_Patrick     TableFirstName = `Patrick`
+	TableFirstName_Patsy       TableFirstName = `Patsy`
+	TableFirstName_Patti       TableFirstName = `Patti`
+	TableFirstName_Pattie      TableFirstName = `Pattie`
+	TableFirstName_Patty       TableFirstName = `Patty`
+	TableFirstName_Paul        TableFirstName = `Paul`
+	TableFirstName_Paula       TableFirstName = `Paula`
+	TableFirstName_Paulette    TableFirstName = `Paulette`
+	TableFirstName_Pauline     TableFirstName = `Pauline`
+	TableFirstName_Pearl       TableFirstName = `Pearl`
+	TableFirstName_Pearlie     TableFirstName = `Pearlie`
+	TableFirstName_Pedro       TableFirstName = `Pedro`
+	TableFirstName_Peggie      TableFirstName = `Peggie`
+	TableFirstName_Peggy       TableFirstName = `Peggy`
+	TableFirstName_Penelope    TableFirstName = `Penelope`
+	TableFirstName_Pennie      TableFirstName = `Pennie`
+	TableFirstName_Penny       TableFirstName = `Penny`
+	TableFirstName_Percy       TableFirstName = `Percy`
+	TableFirstName_Perry       TableFirstName = `Perry`
+	TableFirstName_Pete        TableFirstName = `Pete`
+	TableFirstName_Peter       TableFirstName = `Peter`
+	TableFirstName_Phil        TableFirstName = `Phil`
+	TableFirstName_Philip      TableFirstName = `Philip`
+	TableFirstName_Phoebe      TableFirstName = `Phoebe`
+	TableFirstName_Phyllis     TableFirstName = `Phyllis`
+	TableFirstName_Pierre      TableFirstName = `Pierre`
+	TableFirstName_Polly       TableFirstName = `Polly`
+	TableFirstName_Porter      TableFirstName = `Porter`
+	TableFirstName_Portia      TableFirstName = `Portia`
+	TableFirstName_Preston     TableFirstName = `Preston`
+	TableFirstName_Prince      TableFirstName = `Prince`
+	TableFirstName_Priscilla   TableFirstName = `Priscilla`
+	TableFirstName_Queen       TableFirstName = `Queen`
+	TableFirstName_Quentin     TableFirstName = `Quentin`
+	TableFirstName_Quincy      TableFirstName = `Quincy`
+	TableFirstName_Quinton     TableFirstName = `Quinton`
+	TableFirstName_Rachael     TableFirstName = `Rachael`
+	TableFirstName_Rachel      TableFirstName = `Rachel`
+	TableFirstName_Rachelle    TableFirstName = `Rachelle`
+	TableFirstName_Rae         TableFirstName = `Rae`
+	TableFirstName_Rafael      TableFirstName = `Rafael`
+	TableFirstName_Raleigh     TableFirstName = `Raleigh`
+	TableFirstName_Ralph       TableFirstName = `Ralph`
+	TableFirstName_Ramiro      TableFirstName = `Ramiro`
+	TableFirstName_Ramon       TableFirstName = `Ramon`
+	TableFirstName_Ramona      TableFirstName = `Ramona`
+	TableFirstName_Rand        TableFirstName = `Rand`
+	TableFirstName_Randal      TableFirstName = `Randal`
+	TableFirstName_Randall     TableFirstName = `Randall`
+	TableFirstName_Randel      TableFirstName = `Randel`
+	TableFirstName_Randi       TableFirstName = `Randi`
+	TableFirstName_Randle      TableFirstName = `Randle`
+	TableFirstName_Randolf     TableFirstName = `Randolf`
+	TableFirstName_Randolph    TableFirstName = `Randolph`
+	TableFirstName_Randy       TableFirstName = `Randy`
+	TableFirstName_Raphael     TableFirstName = `Raphael`
+	TableFirstName_Raquel      TableFirstName = `Raquel`
+	TableFirstName_Raul        TableFirstName = `Raul`
+	TableFirstName_Ray         TableFirstName = `Ray`
+	TableFirstName_Rayford     TableFirstName = `Rayford`
+	TableFirstName_Raymon      TableFirstName = `Raymon`
+	TableFirstName_Raymond     TableFirstName = `Raymond`
+	TableFirstName_Raymundo    TableFirstName = `Raymundo`
+	TableFirstName_Reba        TableFirstName = `Reba`
+	TableFirstName_Rebecca     TableFirstName = `Rebecca`
+	TableFirstName_Rebekah     TableFirstName = `Rebekah`
+	TableFirstName_Reed        TableFirstName = `Reed`
+	TableFirstName_Regenia     TableFirstName = `Regenia`
+	TableFirstName_Reggie      TableFirstName = `Reggie`
+	TableFirstName_Regina      TableFirstName = `Regina`
+	TableFirstName_Reginald    TableFirstName = `Reginald`
+	TableFirstName_Regis       TableFirstName = `Regis`
+	TableFirstName_Reid        TableFirstName = `Reid`
+	TableFirstName_Rena        TableFirstName = `Rena`
+	TableFirstName_Renae       TableFirstName = `Renae`
+	TableFirstName_Rene        TableFirstName = `Rene`
+	TableFirstName_Renee       TableFirstName = `Renee`
+	TableFirstName_Renita      TableFirstName = `Renita`
+	TableFirstName_Reta        TableFirstName = `Reta`
+	TableFirstName_Retha       TableFirstName = `Retha`
+	TableFirstName_Reuben      TableFirstName = `Reuben`
+	TableFirstName_Reva        TableFirstName = `Reva`
+	TableFirstName_Rex         TableFirstName = `Rex`
+	TableFirstName_Reynaldo    TableFirstName = `Reynaldo`
+	TableFirstName_Reynold     TableFirstName = `Reynold`
+	TableFirstName_Rhea        TableFirstName = `Rhea`
+	TableFirstName_Rhett       TableFirstName = `Rhett`
+	TableFirstName_Rhoda       TableFirstName = `Rhoda`
+	TableFirstName_Rhonda      TableFirstName = `Rhonda`
+	TableFirstName_Ricardo     TableFirstName = `Ricardo`
+	TableFirstName_Richard     TableFirstName = `Richard`
+	TableFirstName_Rick        TableFirstName = `Rick`
+	TableFirstName_Rickey      TableFirstName = `Rickey`
+	TableFirstName_Ricki       TableFirstName = `Ricki`
+	TableFirstName_Rickie      TableFirstName = `Rickie`
+	TableFirstName_Ricky       TableFirstName = `Ricky`
+	TableFirstName_Riley       TableFirstName = `Riley`
+	TableFirstName_Rita        TableFirstName = `Rita`
+	TableFirstName_Ritchie     TableFirstName = `Ritchie`
+	TableFirstName_Rob         TableFirstName = `Rob`
+	TableFirstName_Robbie      TableFirstName = `Robbie`
+	TableFirstName_Robbin      TableFirstName = `Robbin`
+	TableFirstName_Robby       TableFirstName = `Robby`
+	TableFirstName_Robert      TableFirstName = `Robert`
+	TableFirstName_Roberta     TableFirstName = `Roberta`
+	TableFirstName_Roberto     TableFirstName = `Roberto`
+	TableFirstName_Robin       TableFirstName = `Robin`
+	TableFirstName_Robyn       TableFirstName = `Robyn`
+	TableFirstName_Rocco       TableFirstName = `Rocco`
+	TableFirstName_Rochelle    TableFirstName = `Rochelle`
+	TableFirstName_Rock        TableFirstName = `Rock`
+	TableFirstName_Rocky       TableFirstName = `Rocky`
+	TableFirstName_Rod         TableFirstName = `Rod`
+	TableFirstName_Roderick    TableFirstName = `Roderick`
+	TableFirstName_Rodger      TableFirstName = `Rodger`
+	TableFirstName_Rodney      TableFirstName = `Rodney`
+	TableFirstName_Rodolfo     TableFirstName = `Rodolfo`
+	TableFirstName_Rodrick     TableFirstName = `Rodrick`
+	TableFirstName_Rogelio     TableFirstName = `Rogelio`
+	TableFirstName_Roger       TableFirstName = `Roger`
+	TableFirstName_Rogers      TableFirstName = `Rogers`
+	TableFirstName_Roland      TableFirstName = `Roland`
+	TableFirstName_Rolando     TableFirstName = `Rolando`
+	TableFirstName_Rolf        TableFirstName = `Rolf`
+	TableFirstName_Rolland     TableFirstName = `Rolland`
+	TableFirstName_Roman       TableFirstName = `Roman`
+	TableFirstName_Romona      TableFirstName = `Romona`
+	TableFirstName_Ron         TableFirstName = `Ron`
+	TableFirstName_Rona        TableFirstName = `Rona`
+	TableFirstName_Ronald      TableFirstName = `Ronald`
+	TableFirstName_Ronda       TableFirstName = `Ronda`
+	TableFirstName_Roni        TableFirstName = `Roni`
+	TableFirstName_Ronna       TableFirstName = `Ronna`
+	TableFirstName_Ronnie      TableFirstName = `Ronnie`
+	TableFirstName_Ronny       TableFirstName = `Ronny`
+	TableFirstName_Roosevelt   TableFirstName = `Roosevelt`
+	TableFirstName_Rory        TableFirstName = `Rory`
+	TableFirstName_Rosa        TableFirstName = `Rosa`
+	TableFirstName_Rosalie     TableFirstName = `Rosalie`
+	TableFirstName_Rosalind    TableFirstName = `Rosalind`
+	TableFirstName_Rosalinda   TableFirstName = `Rosalinda`
+	TableFirstName_Rosalyn     TableFirstName = `Rosalyn`
+	TableFirstName_Rosanna     TableFirstName = `Rosanna`
+	TableFirstName_Rosanne     TableFirstName = `Rosanne`
+	TableFirstName_Rosario     TableFirstName = `Rosario`
+	TableFirstName_Roscoe      TableFirstName = `Roscoe`
+	TableFirstName_Rose        TableFirstName = `Rose`
+	TableFirstName_Roseann     TableFirstName = `Roseann`
+	TableFirstName_Roseanne    TableFirstName = `Roseanne`
+	TableFirstName_Rosemarie   TableFirstName = `Rosemarie`
+	TableFirstName_Rosemary    TableFirstName = `Rosemary`
+	TableFirstName_Rosendo     TableFirstName = `Rosendo`
+	TableFirstName_Rosetta     TableFirstName = `Rosetta`
+	TableFirstName_Rosie       TableFirstName = `Rosie`
+	TableFirstName_Rosita      TableFirstName = `Rosita`
+	TableFirstName_Roslyn      TableFirstName = `Roslyn`
+	TableFirstName_Ross        TableFirstName = `Ross`
+	TableFirstName_Rowena      TableFirstName = `Rowena`
+	TableFirstName_Rowland     TableFirstName = `Rowland`
+	TableFirstName_Roxane      TableFirstName = `Roxane`
+	TableFirstName_Roxann      TableFirstName = `Roxann`
+	TableFirstName_Roxanna     TableFirstName = `Roxanna`
+	TableFirstName_Roxanne     TableFirstName = `Roxanne`
+	TableFirstName_Roxie       TableFirstName = `Roxie`
+	TableFirstName_Roy         TableFirstName = `Roy`
+	TableFirstName_Royal       TableFirstName = `Royal`
+	TableFirstName_Royce       TableFirstName = `Royce`
+	TableFirstName_Ruben       TableFirstName = `Ruben`
+	TableFirstName_Rubin       TableFirstName = `Rubin`
+	TableFirstName_Ruby        TableFirstName = `Ruby`
+	TableFirstName_Rudolfo     TableFirstName = `Rudolfo`
+	TableFirstName_Rudolph     TableFirstName = `Rudolph`
+	TableFirstName_Rudy        TableFirstName = `Rudy`
+	TableFirstName_Rufus       TableFirstName = `Rufus`
+	TableFirstName_Russ        TableFirstName = `Russ`
+	TableFirstName_Russel      TableFirstName = `Russel`
+	TableFirstName_Russell     TableFirstName = `Russell`
+	TableFirstName_Rusty       TableFirstName = `Rusty`
+	TableFirstName_Ruth        TableFirstName = `Ruth`
+	TableFirstName_Ruthie      TableFirstName = `Ruthie`
+	TableFirstName_Ryan        TableFirstName = `Ryan`
+	TableFirstName_Sabrina     TableFirstName = `Sabrina`
+	TableFirstName_Sadie       TableFirstName = `Sadie`
+	TableFirstName_Sallie      TableFirstName = `Sallie`
+	TableFirstName_Sally       TableFirstName = `Sally`
+	TableFirstName_Salvador    TableFirstName = `Salvador`
+	TableFirstName_Salvatore   TableFirstName = `Salvatore`
+	TableFirstName_Sam         TableFirstName = `Sam`
+	TableFirstName_Sammie      TableFirstName = `Sammie`
+	TableFirstName_Sammy       TableFirstName = `Sammy`
+	TableFirstName_Samuel      TableFirstName = `Samuel`
+	TableFirstName_Sandi       TableFirstName = `Sandi`
+	TableFirstName_Sandra      TableFirstName = `Sandra`
+	TableFirstName_Sandy       TableFirstName = `Sandy`
+	TableFirstName_Sanford     TableFirstName = `Sanford`
+	TableFirstName_Santiago    TableFirstName = `Santiago`
+	TableFirstName_Santos      TableFirstName = `Santos`
+	TableFirstName_Sara        TableFirstName = `Sara`
+	TableFirstName_Sarah       TableFirstName = `Sarah`
+	TableFirstName_Saul        TableFirstName = `Saul`
+	TableFirstName_Saundra     TableFirstName = `Saundra`
+	TableFirstName_Scot        TableFirstName = `Scot`
+	TableFirstName_Scott       TableFirstName = `Scott`
+	TableFirstName_Scottie     TableFirstName = `Scottie`
+	TableFirstName_Scotty      TableFirstName = `Scotty`
+	TableFirstName_Sean        TableFirstName = `Sean`
+	TableFirstName_Selma       TableFirstName = `Selma`
+	TableFirstName_Serena      TableFirstName = `Serena`
+	TableFirstName_Sergio      TableFirstName = `Sergio`
+	TableFirstName_Seth        TableFirstName = `Seth`
+	TableFirstName_Shane       TableFirstName = `Shane`
+	TableFirstName_Shannon     TableFirstName = `Shannon`
+	TableFirstName_Sharen      TableFirstName = `Sharen`
+	TableFirstName_Shari       TableFirstName = `Shari`
+	TableFirstName_Sharlene    TableFirstName = `Sharlene`
+	TableFirstName_Sharon      TableFirstName = `Sharon`
+	TableFirstName_Sharron     TableFirstName = `Sharron`
+	TableFirstName_Shaun       TableFirstName = `Shaun`
+	TableFirstName_Shauna      TableFirstName = `Shauna`
+	TableFirstName_Shawn       TableFirstName = `Shawn`
+	TableFirstName_Sheila      TableFirstName = `Sheila`
+	TableFirstName_Sheilah     TableFirstName = `Sheilah`
+	TableFirstName_Shelby      TableFirstName = `Shelby`
+	TableFirstName_Sheldon     TableFirstName = `Sheldon`
+	TableFirstName_Shelia      TableFirstName = `Shelia`
+	TableFirstName_Shelley     TableFirstName = `Shelley`
+	TableFirstName_Shelly      TableFirstName = `Shelly`
+	TableFirstName_Shelton     TableFirstName = `Shelton`
+	TableFirstName_Sheree      TableFirstName = `Sheree`
+	TableFirstName_Sheri       TableFirstName = `Sheri`
+	TableFirstName_Sherie      TableFirstName = `Sherie`
+	TableFirstName_Sherman     TableFirstName = `Sherman`
+	TableFirstName_Sheron      TableFirstName = `Sheron`
+	TableFirstName_Sherree     TableFirstName = `Sherree`
+	TableFirstName_Sherri      TableFirstName = `Sherri`
+	TableFirstName_Sherrie     TableFirstName = `Sherrie`
+	TableFirstName_Sherrill    TableFirstName = `Sherrill`
+	TableFirstName_Sherry      TableFirstName = `Sherry`
+	TableFirstName_Sherryl     TableFirstName = `Sherryl`
+	TableFirstName_Sherwood    TableFirstName = `Sherwood`
+	TableFirstName_Sheryl      TableFirstName = `Sheryl`
+	TableFirstName_Sheryll     TableFirstName = `Sheryll`
+	TableFirstName_Shirlene    TableFirstName = `Shirlene`
+	TableFirstName_Shirley     TableFirstName = `Shirley`
+	TableFirstName_Sidney      TableFirstName = `Sidney`
+	TableFirstName_Silas       TableFirstName = `Silas`
+	TableFirstName_Silvia      TableFirstName = `Silvia`
+	TableFirstName_Simon       TableFirstName = `Simon`
+	TableFirstName_Skip        TableFirstName = `Skip`
+	TableFirstName_Solomon     TableFirstName = `Solomon`
+	TableFirstName_Sondra      TableFirstName = `Sondra`
+	TableFirstName_Sonia       TableFirstName = `Sonia`
+	TableFirstName_Sonja       TableFirstName = `Sonja`
+	TableFirstName_Sonny       TableFirstName = `Sonny`
+	TableFirstName_Sonya       TableFirstName = `Sonya`
+	TableFirstName_Sophia      TableFirstName = `Sophia`
+	TableFirstName_Sophie      TableFirstName = `Sophie`
+	TableFirstName_Spencer     TableFirstName = `Spencer`
+	TableFirstName_Stacey      TableFirstName = `Stacey`
+	TableFirstName_Stacy       TableFirstName = `Stacy`
+	TableFirstName_Stan        TableFirstName = `Stan`
+	TableFirstName_Stanford    TableFirstName = `Stanford`
+	TableFirstName_Stanley     TableFirstName = `Stanley`
+	TableFirstName_Stanton     TableFirstName = `Stanton`
+	TableFirstName_Starla      TableFirstName = `Starla`
+	TableFirstName_Stella      TableFirstName = `Stella`
+	TableFirstName_Stephan     TableFirstName = `Stephan`
+	TableFirstName_Stephanie   TableFirstName = `Stephanie`
+	TableFirstName_Stephen     TableFirstName = `Stephen`
+	TableFirstName_Sterling    TableFirstName = `Sterling`
+	TableFirstName_Stevan      TableFirstName = `Stevan`
+	TableFirstName_Steve       TableFirstName = `Steve`
+	TableFirstName_Steven      TableFirstName = `Steven`
+	TableFirstName_Stevie      TableFirstName = `Stevie`
+	TableFirstName_Stewart     TableFirstName = `Stewart`
+	TableFirstName_Stuart      TableFirstName = `Stuart`
+	TableFirstName_Sue         TableFirstName = `Sue`
+	TableFirstName_Suellen     TableFirstName = `Suellen`
+	TableFirstName_Susan       TableFirstName = `Susan`
+	TableFirstName_Susana      TableFirstName = `Susana`
+	TableFirstName_Susanna     TableFirstName = `Susanna`
+	TableFirstName_Susanne     TableFirstName = `Susanne`
+	TableFirstName_Susie       TableFirstName = `Susie`
+	TableFirstName_Suzan       TableFirstName = `Suzan`
+	TableFirstName_Suzann      TableFirstName = `Suzann`
+	TableFirstName_Suzanne     TableFirstName = `Suzanne`
+	TableFirstName_Suzette     TableFirstName = `Suzette`
+	TableFirstName_Sybil       TableFirstName = `Sybil`
+	TableFirstName_Sydney      TableFirstName = `Sydney`
+	TableFirstName_Sylvester   TableFirstName = `Sylvester`
+	TableFirstName_Sylvia      TableFirstName = `Sylvia`
+	TableFirstName_Tad         TableFirstName = `Tad`
+	TableFirstName_Talmadge    TableFirstName = `Talmadge`
+	TableFirstName_Tamara      TableFirstName = `Tamara`
+	TableFirstName_Tami        TableFirstName = `Tami`
+	TableFirstName_Tammy       TableFirstName = `Tammy`
+	TableFirstName_Tana        TableFirstName = `Tana`
+	TableFirstName_Tanya       TableFirstName = `Tanya`
+	TableFirstName_Tara        TableFirstName = `Tara`
+	TableFirstName_Taryn       TableFirstName = `Taryn`
+	TableFirstName_Taylor      TableFirstName = `Taylor`
+	TableFirstName_Ted         TableFirstName = `Ted`
+	TableFirstName_Teddy       TableFirstName = `Teddy`
+	TableFirstName_Teena       TableFirstName = `Teena`
+	TableFirstName_Tena        TableFirstName = `Tena`
+	TableFirstName_Terence     TableFirstName = `Terence`
+	TableFirstName_Teresa      TableFirstName = `Teresa`
+	TableFirstName_Terese      TableFirstName = `Terese`
+	TableFirstName_Teressa     TableFirstName = `Teressa`
+	TableFirstName_Teri        TableFirstName = `Teri`
+	TableFirstName_Terrance    TableFirstName = `Terrance`
+	TableFirstName_Terrell     TableFirstName = `Terrell`
+	TableFirstName_Terrence    TableFirstName = `Terrence`
+	TableFirstName_Terri       TableFirstName = `Terri`
+	TableFirstName_Terrie      TableFirstName = `Terrie`
+	TableFirstName_Terry       TableFirstName = `Terry`
+	TableFirstName_Thad        TableFirstName = `Thad`
+	TableFirstName_Thaddeus    TableFirstName = `Thaddeus`
+	TableFirstName_Thea        TableFirstName = `Thea`
+	TableFirstName_Theadore    TableFirstName = `Theadore`
+	TableFirstName_Thelma      TableFirstName = `Thelma`
+	TableFirstName_Theodis     TableFirstName = `Theodis`
+	TableFirstName_Theodore    TableFirstName = `Theodore`
+	TableFirstName_Theresa     TableFirstName = `Theresa`
+	TableFirstName_Therese     TableFirstName = `Therese`
+	TableFirstName_Theron      TableFirstName = `Theron`
+	TableFirstName_Thomas      TableFirstName = `Thomas`
+	TableFirstName_Thurman     TableFirstName = `Thurman`
+	TableFirstName_Tim         TableFirstName = `Tim`
+	TableFirstName_Timmothy    TableFirstName = `Timmothy`
+	TableFirstName_Timmy       TableFirstName = `Timmy`
+	TableFirstName_Timothy     TableFirstName = `Timothy`
+	TableFirstName_Tina        TableFirstName = `Tina`
+	TableFirstName_Toby        TableFirstName = `Toby`
+	TableFirstName_Tod         TableFirstName = `Tod`
+	TableFirstName_Todd        TableFirstName = `Todd`
+	TableFirstName_Tom         TableFirstName = `Tom`
+	TableFirstName_Tomas       TableFirstName = `Tomas`
+	TableFirstName_Tommie      TableFirstName = `Tommie`
+	TableFirstName_Tommy       TableFirstName = `Tommy`
+	TableFirstName_Toney       TableFirstName = `Toney`
+	TableFirstName_Toni        TableFirstName = `Toni`
+	TableFirstName_Tonia       TableFirstName = `Tonia`
+	TableFirstName_Tony        TableFirstName = `Tony`
+	TableFirstName_Tonya       TableFirstName = `Tonya`
+	TableFirstName_Tracey      TableFirstName = `Tracey`
+	TableFirstName_Tracy       TableFirstName = `Tracy`
+	TableFirstName_Travis      TableFirstName = `Travis`
+	TableFirstName_Trena       TableFirstName = `Trena`
+	TableFirstName_Trent       TableFirstName = `Trent`
+	TableFirstName_Treva       TableFirstName = `Treva`
+	TableFirstName_Tricia      TableFirstName = `Tricia`
+	TableFirstName_Trina       TableFirstName = `Trina`
+	TableFirstName_Troy        TableFirstName = `Troy`
+	TableFirstName_Trudy       TableFirstName = `Trudy`
+	TableFirstName_Truman      TableFirstName = `Truman`
+	TableFirstName_Twila       TableFirstName = `Twila`
+	TableFirstName_Twyla       TableFirstName = `Twyla`
+	TableFirstName_Ty          TableFirstName = `Ty`
+	TableFirstName_Tyler       TableFirstName = `Tyler`
+	TableFirstName_Tyrone      TableFirstName = `Tyrone`
+	TableFirstName_Ulysses     TableFirstName = `Ulysses`
+	TableFirstName_Ursula      TableFirstName = `Ursula`
+	TableFirstName_Val         TableFirstName = `Val`
+	TableFirstName_Valarie     TableFirstName = `Valarie`
+	TableFirstName_Valentine   TableFirstName = `Valentine`
+	TableFirstName_Valeria     TableFirstName = `Valeria`
+	TableFirstName_Valerie     TableFirstName = `Valerie`
+	TableFirstName_Valorie     TableFirstName = `Valorie`
+	TableFirstName_Van         TableFirstName = `Van`
+	TableFirstName_Vance       TableFirstName = `Vance`
+	TableFirstName_Vanessa     TableFirstName = `Vanessa`
+	TableFirstName_Vaughn      TableFirstName = `Vaughn`
+	TableFirstName_Velda       TableFirstName = `Velda`
+	TableFirstName_Velma       TableFirstName = `Velma`
+	TableFirstName_Venita      TableFirstName = `Venita`
+	TableFirstName_Vera        TableFirstName = `Vera`
+	TableFirstName_Vern        TableFirstName = `Vern`
+	TableFirstName_Verna       TableFirstName = `Verna`
+	TableFirstName_Verne       TableFirstName = `Verne`
+	TableFirstName_Vernell     TableFirstName = `Vernell`
+	TableFirstName_Vernita     TableFirstName = `Vernita`
+	TableFirstName_Vernon      TableFirstName = `Vernon`
+	TableFirstName_Veronica    TableFirstName = `Veronica`
+	TableFirstName_Vicente     TableFirstName = `Vicente`
+	TableFirstName_Vickey      TableFirstName = `Vickey`
+	TableFirstName_Vicki       TableFirstName = `Vicki`
+	TableFirstName_Vickie      TableFirstName = `Vickie`
+	TableFirstName_Vicky       TableFirstName = `Vicky`
+	TableFirstName_Victor      TableFirstName = `Victor`
+	TableFirstName_Victoria    TableFirstName = `Victoria`
+	TableFirstName_Vikki       TableFirstName = `Vikki`
+	TableFirstName_Vince       TableFirstName = `Vince`
+	TableFirstName_Vincent     TableFirstName = `Vincent`
+	TableFirstName_Viola       TableFirstName = `Viola`
+	TableFirstName_Violet      TableFirstName = `Violet`
+	TableFirstName_Virgie      TableFirstName = `Virgie`
+	TableFirstName_Virgil      TableFirstName = `Virgil`
+	TableFirstName_Virginia    TableFirstName = `Virginia`
+	TableFirstName_Vito        TableFirstName = `Vito`
+	TableFirstName_Vivian      TableFirstName = `Vivian`
+	TableFirstName_Von         TableFirstName = `Von`
+	TableFirstName_Vonda       TableFirstName = `Vonda`
+	TableFirstName_Wade        TableFirstName = `Wade`
+	TableFirstName_Walker      TableFirstName = `Walker`
+	TableFirstName_Wallace     TableFirstName = `Wallace`
+	TableFirstName_Wally       TableFirstName = `Wally`
+	TableFirstName_Walter      TableFirstName = `Walter`
+	TableFirstName_Wanda       TableFirstName = `Wanda`
+	TableFirstName_Ward        TableFirstName = `Ward`
+	TableFirstName_Wardell     TableFirstName = `Wardell`
+	TableFirstName_Warner      TableFirstName = `Warner`
+	TableFirstName_Warren      TableFirstName = `Warren`
+	TableFirstName_Waymon      TableFirstName = `Waymon`
+	TableFirstName_Wayne       TableFirstName = `Wayne`
+	TableFirstName_Weldon      TableFirstName = `Weldon`
+	TableFirstName_Wendell     TableFirstName = `Wendell`
+	TableFirstName_Wendy       TableFirstName = `Wendy`
+	TableFirstName_Wesley      TableFirstName = `Wesley`
+	TableFirstName_Wilbert     TableFirstName = `Wilbert`
+	TableFirstName_Wilbur      TableFirstName = `Wilbur`
+	TableFirstName_Wilburn     TableFirstName = `Wilburn`
+	TableFirstName_Wiley       TableFirstName = `Wiley`
+	TableFirstName_Wilford     TableFirstName = `Wilford`
+	TableFirstName_Wilfred     TableFirstName = `Wilfred`
+	TableFirstName_Wilfredo    TableFirstName = `Wilfredo`
+	TableFirstName_Will        TableFirstName = `Will`
+	TableFirstName_Willa       TableFirstName = `Willa`
+	TableFirstName_Willard     TableFirstName = `Willard`
+	TableFirstName_William     TableFirstName = `William`
+	TableFirstName_Williams    TableFirstName = `Williams`
+	TableFirstName_Willie      TableFirstName = `Willie`
+	TableFirstName_Willis      TableFirstName = `Willis`
+	TableFirstName_Wilma       TableFirstName = `Wilma`
+	TableFirstName_Wilmer      TableFirstName = `Wilmer`
+	TableFirstName_Wilson      TableFirstName = `Wilson`
+	TableFirstName_Wilton      TableFirstName = `Wilton`
+	TableFirstName_Winford     TableFirstName = `Winford`
+	TableFirstName_Winfred     TableFirstName = `Winfred`
+	TableFirstName_Winifred    TableFirstName = `Winifred`
+	TableFirstName_Winona      TableFirstName = `Winona`
+	TableFirstName_Winston     TableFirstName = `Winston`
+	TableFirstName_Woodrow     TableFirstName = `Woodrow`
+	TableFirstName_Woody       TableFirstName = `Woody`
+	TableFirstName_Wyatt       TableFirstName = `Wyatt`
+	TableFirstName_Xavier      TableFirstName = `Xavier`
+	TableFirstName_Yolanda     TableFirstName = `Yolanda`
+	TableFirstName_Yvette      TableFirstName = `Yvette`
+	TableFirstName_Yvonne      TableFirstName = `Yvonne`
+	TableFirstName_Zachary     TableFirstName = `Zachary`
+	TableFirstName_Zane        TableFirstName = `Zane`
+	TableFirstName_Zelda       TableFirstName = `Zelda`
+	TableFirstName_Zelma       TableFirstName = `Zelma`
+	TableFirstName_Zoe         TableFirstName = `Zoe`
+)
+
+// TableID returns the table ID.
+func (TableFirstName) TableID() string { return `FirstName` }
+
+// Valid reports if the value is in the table.
+func (v TableFirstName) Valid() bool { return TableValueLookup[`FirstName`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableFirstName) Description() string { return tableDescription(`FirstName`, string(v)) }
+
+// TablePhoneNumber is a value of table PhoneNumber, Phone Number.
+type TablePhoneNumber string
+
+// TableID returns the table ID.
+func (TablePhoneNumber) TableID() string { return `PhoneNumber` }
+
+// Valid reports if the value is in the table.
+func (v TablePhoneNumber) Valid() bool { return TableValueLookup[`PhoneNumber`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TablePhoneNumber) Description() string { return tableDescription(`PhoneNumber`, string(v)) }
+
+// TableState is a value of table State, State.
+type TableState string
+
+const (
+	TableState_Alabama        TableState = `Alabama`
+	TableState_Alaska         TableState = `Alaska`
+	TableState_Arizona        TableState = `Arizona`
+	TableState_Arkansas       TableState = `Arkansas`
+	TableState_California     TableState = `California`
+	TableState_Colorado       TableState = `Colorado`
+	TableState_Connecticut    TableState = `Connecticut`
+	TableState_Delaware       TableState = `Delaware`
+	TableState_Florida        TableState = `Florida`
+	TableState_Georgia        TableState = `Georgia`
+	TableState_Hawaii         TableState = `Hawaii`
+	TableState_Idaho          TableState = `Idaho`
+	TableState_Illinois       TableState = `Illinois`
+	TableState_Indiana        TableState = `Indiana`
+	TableState_Iowa           TableState = `Iowa`
+	TableState_Kansas         TableState = `Kansas`
+	TableState_Kentucky       TableState = `Kentucky`
+	TableState_Louisiana      TableState = `Louisiana`
+	TableState_Maine          TableState = `Maine`
+	TableState_Maryland       TableState = `Maryland`
+	TableState_Massachusetts  TableState = `Massachusetts`
+	TableState_Michigan       TableState = `Michigan`
+	TableState_Minnesota      TableState = `Minnesota`
+	TableState_Mississippi    TableState = `Mississippi`
+	TableState_Missouri       TableState = `Missouri`
+	TableState_Montana        TableState = `Montana`
+	TableState_Nebraska       TableState = `Nebraska`
+	TableState_Nevada         TableState = `Nevada`
+	TableState_New_Hampshire  TableState = `New Hampshire`
+	TableState_New_Jersey     TableState = `New Jersey`
+	TableState_New_Mexico     TableState = `New Mexico`
+	TableState_New_York       TableState = `New York  `
+	TableState_North_Carolina TableState = `North Carolina`
+	TableState_North_Dakota   TableState = `North Dakota`
+	TableState_Ohio           TableState = `Ohio`
+	TableState_Oklahoma       TableState = `Oklahoma`
+	TableState_Oregon         TableState = `Oregon`
+	TableState_Pennsylvania   TableState = `Pennsylvania`
+	TableState_Rhode_Island   TableState = `Rhode Island`
+	TableState_South_Carolina TableState = `South Carolina`
+	TableState_South_Dakota   TableState = `South Dakota`
+	TableState_Tennessee      TableState = `Tennessee`
+	TableState_Texas          TableState = `Texas`
+	TableState_Utah           TableState = `Utah`
+	TableState_Vermont        TableState = `Vermont`
+	TableState_Virginia       TableState = `Virginia`
+	TableState_Washington     TableState = `Washington`
+	TableState_West_Virginia  TableState = `West Virginia`
+	TableState_Wisconsin      TableState = `Wisconsin`
+	TableState_Wyoming        TableState = `Wyoming`
+)
+
+// TableID returns the table ID.
+func (TableState) TableID() string { return `State` }
+
+// Valid reports if the value is in the table.
+func (v TableState) Valid() bool { return TableValueLookup[`State`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableState) Description() string { return tableDescription(`State`, string(v)) }
+
+// TableStreet is a value of table Street, Street.
+type TableStreet string
+
+const (
+	TableStreet_11th_St                         TableStreet = `11th St`
+	TableStreet_1st_St                          TableStreet = `1st St`
+	TableStreet_2nd_St                          TableStreet = `2nd St`
+	TableStreet_3rd_St                          TableStreet = `3rd St`
+	TableStreet_41st_St                         TableStreet = `41st St`
+	TableStreet_4th_St                          TableStreet = `4th St`
+	TableStreet_6th_St                          TableStreet = `6th St`
+	TableStreet_7th_St                          TableStreet = `7th St`
+	TableStreet_8th_St                          TableStreet = `8th St`
+	TableStreet_9th_St                          TableStreet = `9th St`
+	TableStreet_Aaron_Ln                        TableStreet = `Aaron Ln`
+	TableStreet_Abbey_Ln                        TableStreet = `Abbey Ln`
+	TableStreet_Abercrombie_St                  TableStreet = `Abercrombie St`
+	TableStreet_Aberdeen_Ct                     TableStreet = `Aberdeen Ct`
+	TableStreet_Abernathy_Rd                    TableStreet = `Abernathy Rd`
+	TableStreet_Abington_Ct                     TableStreet = `Abington Ct`
+	TableStreet_Access_Rd                       TableStreet = `Access Rd`
+	TableStreet_Ackerman_Rd                     TableStreet = `Ackerman Rd`
+	TableStreet_Acorn_Hill_NW                   TableStreet = `Acorn Hill NW`
+	TableStreet_Adair_Dr                        TableStreet = `Adair Dr`
+	TableStreet_Adair_Hollow_Rd                 TableStreet = `Adair Hollow Rd`
+	TableStreet_Adairsville_Pleasant_Valley_Rd  TableStreet = `Adairsville Pleasant Valley Rd`
+	TableStreet_Adams_Chapel_Rd                 TableStreet = `Adams Chapel Rd`
+	TableStreet_Adams_Way                       TableStreet = `Adams Way`
+	TableStreet_Adcock_Loop                     TableStreet = `Adcock Loop`
+	TableStreet_Adcock_Rd                       TableStreet = `Adcock Rd`
+	TableStreet_Aiken_St                        TableStreet = `Aiken St`
+	TableStreet_Akin_Dr                         TableStreet = `Akin Dr`
+	TableStreet_Akin_Rd                         TableStreet = `Akin Rd`
+	TableStreet_Akin_Way                        TableStreet = `Akin Way`
+	TableStreet_Akron_St                        TableStreet = `Akron St`
+	TableStreet_Alex_Dr                         TableStreet = `Alex Dr`
+	TableStreet_Alexander_St                    TableStreet = `Alexander St`
+	TableStreet_Alford_Rd                       TableStreet = `Alford Rd`
+	TableStreet_Alisha_Cir                      TableStreet = `Alisha Cir`
+	TableStreet_All_Metals_Dr                   TableStreet = `All Metals Dr`
+	TableStreet_Allatoona_Dam_Rd                TableStreet = `Allatoona Dam Rd`
+	TableStreet_Allatoona_Dr                    TableStreet = `Allatoona Dr`
+	TableStreet_Allatoona_Estates_Dr            TableStreet = `Allatoona Estates Dr`
+	TableStreet_Allatoona_Landing_Rd            TableStreet = `Allatoona Landing Rd`
+	TableStreet_Allatoona_Pass_Rd               TableStreet = `Allatoona Pass Rd`
+	TableStreet_Allatoona_Trace_Dr              TableStreet = `Allatoona Trace Dr`
+	TableStreet_Allatoona_Woods_Trl             TableStreet = `Allatoona Woods Trl`
+	TableStreet_Allegiance_Ct                   TableStreet = `Allegiance Ct`
+	TableStreet_Allen_Cir                       TableStreet = `Allen Cir`
+	TableStreet_Allen_Rd                        TableStreet = `Allen Rd`
+	TableStreet_Allison_Cir                     TableStreet = `Allison Cir`
+	TableStreet_Allweather_St                   TableStreet = `Allweather St`
+	TableStreet_Alpine_Dr                       TableStreet = `Alpine Dr`
+	TableStreet_Amanda_Ln                       TableStreet = `Amanda Ln`
+	TableStreet_Amandas_Ct                      TableStreet = `Amandas Ct`
+	TableStreet_Amberidge_Dr                    TableStreet = `Amberidge Dr`
+	TableStreet_Amberly_Ln                      TableStreet = `Amberly Ln`
+	TableStreet_Amberwood_Conn                  TableStreet = `Amberwood Conn`
+	TableStreet_Amberwood_Ct                    TableStreet = `Amberwood Ct`
+	TableStreet_Amberwood_Ln                    TableStreet = `Amberwood Ln`
+	TableStreet_Amberwood_Pl                    TableStreet = `Amberwood Pl`
+	TableStreet_Amberwood_Trl                   TableStreet = `Amberwood Trl`
+	TableStreet_Amberwood_Way                   TableStreet = `Amberwood Way`
+	TableStreet_Ampacet_Dr                      TableStreet = `Ampacet Dr`
+	TableStreet_Anchor_Rd                       TableStreet = `Anchor Rd`
+	TableStreet_Anderson_St                     TableStreet = `Anderson St`
+	TableStreet_Andrew_Cole_Trl                 TableStreet = `Andrew Cole Trl`
+	TableStreet_Angus_Trl                       TableStreet = `Angus Trl`
+	TableStreet_Ann_Cir                         TableStreet = `Ann Cir`
+	TableStreet_Ann_Rd                          TableStreet = `Ann Rd`
+	TableStreet_Anna_Pl                         TableStreet = `Anna Pl`
+	TableStreet_Anns_Ct                         TableStreet = `Anns Ct`
+	TableStreet_Anns_Trc                        TableStreet = `Anns Trc`
+	TableStreet_Ansley_Way                      TableStreet = `Ansley Way`
+	TableStreet_Ansubet_Dr                      TableStreet = `Ansubet Dr`
+	TableStreet_Antebellum_Ct                   TableStreet = `Antebellum Ct`
+	TableStreet_Apache_Dr                       TableStreet = `Apache Dr`
+	TableStreet_Apache_Trl                      TableStreet = `Apache Trl`
+	TableStreet_Apex_Dr                         TableStreet = `Apex Dr`
+	TableStreet_Appaloosa_Ct                    TableStreet = `Appaloosa Ct`
+	TableStreet_Apple_Barrel_Way                TableStreet = `Apple Barrel Way`
+	TableStreet_Apple_Ln                        TableStreet = `Apple Ln`
+	TableStreet_Apple_Tree_Ln                   TableStreet = `Apple Tree Ln`
+	TableStreet_Apple_Wood_Ln                   TableStreet = `Apple Wood Ln`
+	TableStreet_Appletree_Ct                    TableStreet = `Appletree Ct`
+	TableStreet_Appling_Way                     TableStreet = `Appling Way`
+	TableStreet_April_Ln                        TableStreet = `April Ln`
+	TableStreet_Aragon_Rd                       TableStreet = `Aragon Rd`
+	TableStreet_Arapaho_Dr                      TableStreet = `Arapaho Dr`
+	TableStreet_Arbor_Ln                        TableStreet = `Arbor Ln`
+	TableStreet_Arbors_Way                      TableStreet = `Arbors Way`
+	TableStreet_Arbutus_Trl                     TableStreet = `Arbutus Trl`
+	TableStreet_Ardmore_Cir                     TableStreet = `Ardmore Cir`
+	TableStreet_Arizona_Ave                     TableStreet = `Arizona Ave`
+	TableStreet_Arlington_Way                   TableStreet = `Arlington Way`
+	TableStreet_Arnold_Rd                       TableStreet = `Arnold Rd`
+	TableStreet_Arnold_Spur                     TableStreet = `Arnold Spur`
+	TableStreet_Arrington_Rd                    TableStreet = `Arrington Rd`
+	TableStreet_Arrow_Mountain_Dr               TableStreet = `Arrow Mountain Dr`
+	TableStreet_Arrowhead_Ct                    TableStreet = `Arrowhead Ct`
+	TableStreet_Arrowhead_Dr                    TableStreet = `Arrowhead Dr`
+	TableStreet_Arrowhead_Ln                    TableStreet = `Arrowhead Ln`
+	TableStreet_Ash_Ct                          TableStreet = `Ash Ct`
+	TableStreet_Ash_Way                         TableStreet = `Ash Way`
+	TableStreet_Ashford_Ct                      TableStreet = `Ashford Ct`
+	TableStreet_Ashford_Pt                      TableStreet = `Ashford Pt`
+	TableStreet_Ashley_Ct                       TableStreet = `Ashley Ct`
+	TableStreet_Ashley_Rd                       TableStreet = `Ashley Rd`
+	TableStreet_Ashwood_Dr                      TableStreet = `Ashwood Dr`
+	TableStreet_Aspen_Dr                        TableStreet = `Aspen Dr`
+	TableStreet_Aspen_Ln                        TableStreet = `Aspen Ln`
+	TableStreet_Assembly_Dr                     TableStreet = `Assembly Dr`
+	TableStreet_Atco_All                        TableStreet = `Atco All`
+	TableStreet_Attaway_Dr                      TableStreet = `Attaway Dr`
+	TableStreet_Atwood_Rd                       TableStreet = `Atwood Rd`
+	TableStreet_Aubrey_Lake_Rd                  TableStreet = `Aubrey Lake Rd`
+	TableStreet_Aubrey_Rd                       TableStreet = `Aubrey Rd`
+	TableStreet_Aubrey_St                       TableStreet = `Aubrey St`
+	TableStreet_Auburn_Dr                       TableStreet = `Auburn Dr`
+	TableStreet_Austin_Rd                       TableStreet = `Austin Rd`
+	TableStreet_Autry_Rd                        TableStreet = `Autry Rd`
+	TableStreet_Autumn_Pl                       TableStreet = `Autumn Pl`
+	TableStreet_Autumn_Ridge_Dr                 TableStreet = `Autumn Ridge Dr`
+	TableStreet_Autumn_Turn                     TableStreet = `Autumn Turn`
+	TableStreet_Autumn_Wood_Dr                  TableStreet = `Autumn Wood Dr`
+	TableStreet_Avenue_Of_Savannah              TableStreet = `Avenue Of Savannah`
+	TableStreet_Avon_Ct                         TableStreet = `Avon Ct`
+	TableStreet_Azalea_Dr                       TableStreet = `Azalea Dr`
+	TableStreet_Aztec_Way                       TableStreet = `Aztec Way`
+	TableStreet_B_D_Trl                         TableStreet = `B D Trl`
+	TableStreet_Backus_Rd                       TableStreet = `Backus Rd`
+	TableStreet_Bagwell_Ln                      TableStreet = `Bagwell Ln`
+	TableStreet_Bailey_Hill_Rd                  TableStreet = `Bailey Hill Rd`
+	TableStreet_Bailey_Rd                       TableStreet = `Bailey Rd`
+	TableStreet_Baker_Rd                        TableStreet = `Baker Rd`
+	TableStreet_Baker_St                        TableStreet = `Baker St`
+	TableStreet_Bald_Eagle_Lndg                 TableStreet = `Bald Eagle Lndg`
+	TableStreet_Baldwell_Ct                     TableStreet = `Baldwell Ct`
+	TableStreet_Balfour_Dr                      TableStreet = `Balfour Dr`
+	TableStreet_Balsam_Dr                       TableStreet = `Balsam Dr`
+	TableStreet_Bandit_Ln                       TableStreet = `Bandit Ln`
+	TableStreet_Bangor_St                       TableStreet = `Bangor St`
+	TableStreet_Baptist_Cir                     TableStreet = `Baptist Cir`
+	TableStreet_Barnsley_Church_Rd              TableStreet = `Barnsley Church Rd`
+	TableStreet_Barnsley_Ct                     TableStreet = `Barnsley Ct`
+	TableStreet_Barnsley_Dr                     TableStreet = `Barnsley Dr`
+	TableStreet_Barnsley_Garden_Rd              TableStreet = `Barnsley Garden Rd`
+	TableStreet_Barnsley_Village_Dr             TableStreet = `Barnsley Village Dr`
+	TableStreet_Barnsley_Village_Trl            TableStreet = `Barnsley Village Trl`
+	TableStreet_Barrett_Ln                      TableStreet = `Barrett Ln`
+	TableStreet_Barrett_Rd                      TableStreet = `Barrett Rd`
+	TableStreet_Barry_Dr                        TableStreet = `Barry Dr`
+	TableStreet_Bartow_Beach_Rd                 TableStreet = `Bartow Beach Rd`
+	TableStreet_Bartow_Carver_Rd                TableStreet = `Bartow Carver Rd`
+	TableStreet_Bartow_St                       TableStreet = `Bartow St`
+	TableStreet_Bates_Rd                        TableStreet = `Bates Rd`
+	TableStreet_Bay_Ct                          TableStreet = `Bay Ct`
+	TableStreet_Bearden_Rd                      TableStreet = `Bearden Rd`
+	TableStreet_Beasley_Rd                      TableStreet = `Beasley Rd`
+	TableStreet_Beaureguard_St                  TableStreet = `Beaureguard St`
+	TableStreet_Beaver_Trl                      TableStreet = `Beaver Trl`
+	TableStreet_Beavers_Dr                      TableStreet = `Beavers Dr`
+	TableStreet_Bedford_Ct                      TableStreet = `Bedford Ct`
+	TableStreet_Bedford_Rdg                     TableStreet = `Bedford Rdg`
+	TableStreet_Bee_Tree_Rd                     TableStreet = `Bee Tree Rd`
+	TableStreet_Beechwood_Dr                    TableStreet = `Beechwood Dr`
+	TableStreet_Beguine_Dr                      TableStreet = `Beguine Dr`
+	TableStreet_Bell_Dr                         TableStreet = `Bell Dr`
+	TableStreet_Bells_Ferry_Rd                  TableStreet = `Bells Ferry Rd`
+	TableStreet_Belmont_Dr                      TableStreet = `Belmont Dr`
+	TableStreet_Belmont_Way                     TableStreet = `Belmont Way`
+	TableStreet_Benfield_Cir                    TableStreet = `Benfield Cir`
+	TableStreet_Benham_Cir                      TableStreet = `Benham Cir`
+	TableStreet_Bennett_Kimbral_Rd              TableStreet = `Bennett Kimbral Rd`
+	TableStreet_Bennett_Way                     TableStreet = `Bennett Way`
+	TableStreet_Bent_Water_Dr                   TableStreet = `Bent Water Dr`
+	TableStreet_Berkeley_Pl                     TableStreet = `Berkeley Pl`
+	TableStreet_Berkshire_Dr                    TableStreet = `Berkshire Dr`
+	TableStreet_Bestway_Dr                      TableStreet = `Bestway Dr`
+	TableStreet_Bestway_Pkwy                    TableStreet = `Bestway Pkwy`
+	TableStreet_Betsy_Locke_Pt                  TableStreet = `Betsy Locke Pt`
+	TableStreet_Beverlie_Dr                     TableStreet = `Beverlie Dr`
+	TableStreet_Bevil_Ridge_Rd                  TableStreet = `Bevil Ridge Rd`
+	TableStreet_Biddy_Rd                        TableStreet = `Biddy Rd`
+	TableStreet_Big_Branch_Ct                   TableStreet = `Big Branch Ct`
+	TableStreet_Big_Ditch_Rd                    TableStreet = `Big Ditch Rd`
+	TableStreet_Big_Oak_Tree_Rd                 TableStreet = `Big Oak Tree Rd`
+	TableStreet_Big_Pond_Rd                     TableStreet = `Big Pond Rd`
+	TableStreet_Bill_Black_Rd                   TableStreet = `Bill Black Rd`
+	TableStreet_Bill_Nelson_Rd                  TableStreet = `Bill Nelson Rd`
+	TableStreet_Billies_Cv                      TableStreet = `Billies Cv`
+	TableStreet_Bingham_Rd                      TableStreet = `Bingham Rd`
+	TableStreet_Birch_Pl                        TableStreet = `Birch Pl`
+	TableStreet_Birch_Way                       TableStreet = `Birch Way`
+	TableStreet_Bishop_Cv                       TableStreet = `Bishop Cv`
+	TableStreet_Bishop_Dr                       TableStreet = `Bishop Dr`
+	TableStreet_Bishop_Loop                     TableStreet = `Bishop Loop`
+	TableStreet_Bishop_Mill_Dr                  TableStreet = `Bishop Mill Dr`
+	TableStreet_Bishop_Rd                       TableStreet = `Bishop Rd`
+	TableStreet_Black_Jack_Mountain_Cir         TableStreet = `Black Jack Mountain Cir`
+	TableStreet_Black_Oak_Rd                    TableStreet = `Black Oak Rd`
+	TableStreet_Black_Rd                        TableStreet = `Black Rd`
+	TableStreet_Black_Water_Dr                  TableStreet = `Black Water Dr`
+	TableStreet_Blackacre_Trl                   TableStreet = `Blackacre Trl`
+	TableStreet_Blackberry_Rdg                  TableStreet = `Blackberry Rdg`
+	TableStreet_Blackfoot_Trl                   TableStreet = `Blackfoot Trl`
+	TableStreet_Blacksmith_Ln                   TableStreet = `Blacksmith Ln`
+	TableStreet_Bloomfield_Ct                   TableStreet = `Bloomfield Ct`
+	TableStreet_Blueberry_Rdg                   TableStreet = `Blueberry Rdg`
+	TableStreet_Bluebird_Cir                    TableStreet = `Bluebird Cir`
+	TableStreet_Bluegrass_Cv                    TableStreet = `Bluegrass Cv`
+	TableStreet_Bluff_Ct                        TableStreet = `Bluff Ct`
+	TableStreet_Boardwalk_Ct                    TableStreet = `Boardwalk Ct`
+	TableStreet_Boatner_Ave                     TableStreet = `Boatner Ave`
+	TableStreet_Bob_O_Link_Dr                   TableStreet = `Bob O Link Dr`
+	TableStreet_Bob_White_Trl                   TableStreet = `Bob White Trl`
+	TableStreet_Bobcat_Ct                       TableStreet = `Bobcat Ct`
+	TableStreet_Boliver_Rd                      TableStreet = `Boliver Rd`
+	TableStreet_Bonair_St                       TableStreet = `Bonair St`
+	TableStreet_Boones_Farm                     TableStreet = `Boones Farm`
+	TableStreet_Boones_Ridge_Dr                 TableStreet = `Boones Ridge Dr`
+	TableStreet_Boones_Ridge_Pkwy               TableStreet = `Boones Ridge Pkwy`
+	TableStreet_Boss_Rd                         TableStreet = `Boss Rd`
+	TableStreet_Boulder_Crest_Rd                TableStreet = `Boulder Crest Rd`
+	TableStreet_Boulder_Rd                      TableStreet = `Boulder Rd`
+	TableStreet_Bowen_Rd                        TableStreet = `Bowen Rd`
+	TableStreet_Bowens_Ct                       TableStreet = `Bowens Ct`
+	TableStreet_Boyd_Morris_Dr                  TableStreet = `Boyd Morris Dr`
+	TableStreet_Boyd_Mountain_Rd                TableStreet = `Boyd Mountain Rd`
+	TableStreet_Boysenberry_Ct                  TableStreet = `Boysenberry Ct`
+	TableStreet_Bozeman_Rd                      TableStreet = `Bozeman Rd`
+	TableStreet_Bradford_Ct                     TableStreet = `Bradford Ct`
+	TableStreet_Bradford_Dr                     TableStreet = `Bradford Dr`
+	TableStreet_Bradley_Ln                      TableStreet = `Bradley Ln`
+	TableStreet_Bradley_Trl                     TableStreet = `Bradley Trl`
+	TableStreet_Bramblewood_Ct                  TableStreet = `Bramblewood Ct`
+	TableStreet_Bramblewood_Dr                  TableStreet = `Bramblewood Dr`
+	TableStreet_Bramblewood_Pl                  TableStreet = `Bramblewood Pl`
+	TableStreet_Bramblewood_Trl                 TableStreet = `Bramblewood Trl`
+	TableStreet_Bramblewood_Way                 TableStreet = `Bramblewood Way`
+	TableStreet_Brandon_Farm_Rd                 TableStreet = `Brandon Farm Rd`
+	TableStreet_Brandon_Rdg                     TableStreet = `Brandon Rdg`
+	TableStreet_Brandy_Ln                       TableStreet = `Brandy Ln`
+	TableStreet_Branson_Crossing_Rd             TableStreet = `Branson Crossing Rd`
+	TableStreet_Branson_Mill_Dr                 TableStreet = `Branson Mill Dr`
+	TableStreet_Branton_Rd                      TableStreet = `Branton Rd`
+	TableStreet_Brent_Cir                       TableStreet = `Brent Cir`
+	TableStreet_Brentwood_Dr                    TableStreet = `Brentwood Dr`
+	TableStreet_Brett_Way                       TableStreet = `Brett Way`
+	TableStreet_Briar_Chase_Ct                  TableStreet = `Briar Chase Ct`
+	TableStreet_Briar_Patch_Ln                  TableStreet = `Briar Patch Ln`
+	TableStreet_Briarcrest_Way                  TableStreet = `Briarcrest Way`
+	TableStreet_Briarwood_Ln                    TableStreet = `Briarwood Ln`
+	TableStreet_Brickshire                      TableStreet = `Brickshire`
+	TableStreet_Brickshire_Dr                   TableStreet = `Brickshire Dr`
+	TableStreet_Bridgestone_Way                 TableStreet = `Bridgestone Way`
+	TableStreet_Bridlewood_Ct                   TableStreet = `Bridlewood Ct`
+	TableStreet_Brighton_Ct                     TableStreet = `Brighton Ct`
+	TableStreet_Brightwell_Pl                   TableStreet = `Brightwell Pl`
+	TableStreet_Bristol_Ct                      TableStreet = `Bristol Ct`
+	TableStreet_Brittani_Ln                     TableStreet = `Brittani Ln`
+	TableStreet_Broadlands_Dr                   TableStreet = `Broadlands Dr`
+	TableStreet_Broken_Arrow_Ct                 TableStreet = `Broken Arrow Ct`
+	TableStreet_Bronco_Ct                       TableStreet = `Bronco Ct`
+	TableStreet_Brook_Dr                        TableStreet = `Brook Dr`
+	TableStreet_Brook_Knoll_Ct                  TableStreet = `Brook Knoll Ct`
+	TableStreet_Brooke_Rd                       TableStreet = `Brooke Rd`
+	TableStreet_Brookhollow_Ln                  TableStreet = `Brookhollow Ln`
+	TableStreet_Brookland_Dr                    TableStreet = `Brookland Dr`
+	TableStreet_Brookshire_Dr                   TableStreet = `Brookshire Dr`
+	TableStreet_Brookside_Ct                    TableStreet = `Brookside Ct`
+	TableStreet_Brookside_Way                   TableStreet = `Brookside Way`
+	TableStreet_Brookwood_Dr                    TableStreet = `Brookwood Dr`
+	TableStreet_Brown_Dr                        TableStreet = `Brown Dr`
+	TableStreet_Brown_Farm_Rd                   TableStreet = `Brown Farm Rd`
+	TableStreet_Brown_Loop                      TableStreet = `Brown Loop`
+	TableStreet_Brown_Loop_Spur                 TableStreet = `Brown Loop Spur`
+	TableStreet_Brown_Rd                        TableStreet = `Brown Rd`
+	TableStreet_Brownwood_Dr                    TableStreet = `Brownwood Dr`
+	TableStreet_Bruce_St                        TableStreet = `Bruce St`
+	TableStreet_Brutis_Rd                       TableStreet = `Brutis Rd`
+	TableStreet_Bryan_Dr                        TableStreet = `Bryan Dr`
+	TableStreet_Bryan_Ln                        TableStreet = `Bryan Ln`
+	TableStreet_Buckhorn_Trl                    TableStreet = `Buckhorn Trl`
+	TableStreet_Buckingham_Ct                   TableStreet = `Buckingham Ct`
+	TableStreet_Buckland_Ct                     TableStreet = `Buckland Ct`
+	TableStreet_Buckskin_Dr                     TableStreet = `Buckskin Dr`
+	TableStreet_Bucky_St                        TableStreet = `Bucky St`
+	TableStreet_Buena_Vista_Cir                 TableStreet = `Buena Vista Cir`
+	TableStreet_Buford_St                       TableStreet = `Buford St`
+	TableStreet_Bunch_Mountain_Rd               TableStreet = `Bunch Mountain Rd`
+	TableStreet_Burch_Ln                        TableStreet = `Burch Ln`
+	TableStreet_Burges_Mill_Rd                  TableStreet = `Burges Mill Rd`
+	TableStreet_Burnt_Hickory_Dr                TableStreet = `Burnt Hickory Dr`
+	TableStreet_Burnt_Hickory_Rd                TableStreet = `Burnt Hickory Rd`
+	TableStreet_Busch_Dr                        TableStreet = `Busch Dr`
+	TableStreet_Bushey_Trl                      TableStreet = `Bushey Trl`
+	TableStreet_Buttonwood_Dr                   TableStreet = `Buttonwood Dr`
+	TableStreet_Buttram_Rd                      TableStreet = `Buttram Rd`
+	TableStreet_Byars_Rd                        TableStreet = `Byars Rd`
+	TableStreet_C_J_Ct                          TableStreet = `C J Ct`
+	TableStreet_C_J_Dr                          TableStreet = `C J Dr`
+	TableStreet_Caboose_Ct                      TableStreet = `Caboose Ct`
+	TableStreet_Cade_Dr                         TableStreet = `Cade Dr`
+	TableStreet_Cagle_Rd                        TableStreet = `Cagle Rd`
+	TableStreet_Cain_Dr                         TableStreet = `Cain Dr`
+	TableStreet_Calico_Valley_Rd                TableStreet = `Calico Valley Rd`
+	TableStreet_Calloway_Ln                     TableStreet = `Calloway Ln`
+	TableStreet_Calvary_Ct                      TableStreet = `Calvary Ct`
+	TableStreet_Cambridge_Ct                    TableStreet = `Cambridge Ct`
+	TableStreet_Cambridge_Way                   TableStreet = `Cambridge Way`
+	TableStreet_Camden_Woods_Dr                 TableStreet = `Camden Woods Dr`
+	TableStreet_Camellia_Ln                     TableStreet = `Camellia Ln`
+	TableStreet_Camelot_Dr                      TableStreet = `Camelot Dr`
+	TableStreet_Camp_Creek_Rd                   TableStreet = `Camp Creek Rd`
+	TableStreet_Camp_Dr                         TableStreet = `Camp Dr`
+	TableStreet_Camp_Pl                         TableStreet = `Camp Pl`
+	TableStreet_Camp_Sunrise_Rd                 TableStreet = `Camp Sunrise Rd`
+	TableStreet_Camp_Trl                        TableStreet = `Camp Trl`
+	TableStreet_Campbell_Dr                     TableStreet = `Campbell Dr`
+	TableStreet_Campfire_Trl                    TableStreet = `Campfire Trl`
+	TableStreet_Candlestick_Comm                TableStreet = `Candlestick Comm`
+	TableStreet_Canefield_Dr                    TableStreet = `Canefield Dr`
+	TableStreet_Cannon_Cir                      TableStreet = `Cannon Cir`
+	TableStreet_Cannon_Ct                       TableStreet = `Cannon Ct`
+	TableStreet_Cannonade_Run                   TableStreet = `Cannonade Run`
+	TableStreet_Canter_Ln                       TableStreet = `Canter Ln`
+	TableStreet_Canterbury_Ct                   TableStreet = `Canterbury Ct`
+	TableStreet_Canterbury_Walk                 TableStreet = `Canterbury Walk`
+	TableStreet_Cantrell_Ln                     TableStreet = `Cantrell Ln`
+	TableStreet_Cantrell_Pt                     TableStreet = `Cantrell Pt`
+	TableStreet_Cantrell_St                     TableStreet = `Cantrell St`
+	TableStreet_Canty_Rd                        TableStreet = `Canty Rd`
+	TableStreet_Canyon_Pkwy                     TableStreet = `Canyon Pkwy`
+	TableStreet_Captains_Turn                   TableStreet = `Captains Turn`
+	TableStreet_Captains_Walk                   TableStreet = `Captains Walk`
+	TableStreet_Cardinal_Ct                     TableStreet = `Cardinal Ct`
+	TableStreet_Cardinal_Rd                     TableStreet = `Cardinal Rd`
+	TableStreet_Carl_Duvall_Dr                  TableStreet = `Carl Duvall Dr`
+	TableStreet_Carlie_Pass                     TableStreet = `Carlie Pass`
+	TableStreet_Carnes_Dr                       TableStreet = `Carnes Dr`
+	TableStreet_Carnes_Rd                       TableStreet = `Carnes Rd`
+	TableStreet_Carolyn_Ct                      TableStreet = `Carolyn Ct`
+	TableStreet_Carr_Rd                         TableStreet = `Carr Rd`
+	TableStreet_Carriage_Ln                     TableStreet = `Carriage Ln`
+	TableStreet_Carriage_Trc                    TableStreet = `Carriage Trc`
+	TableStreet_Carrington_Dr                   TableStreet = `Carrington Dr`
+	TableStreet_Carrington_Trc                  TableStreet = `Carrington Trc`
+	TableStreet_Carrol_Slough_Rd                TableStreet = `Carrol Slough Rd`
+	TableStreet_Carson_Ct                       TableStreet = `Carson Ct`
+	TableStreet_Carson_Loop                     TableStreet = `Carson Loop`
+	TableStreet_Carson_Rd                       TableStreet = `Carson Rd`
+	TableStreet_Carter_Grove_Blvd               TableStreet = `Carter Grove Blvd`
+	TableStreet_Carter_Ln                       TableStreet = `Carter Ln`
+	TableStreet_Carter_Rd                       TableStreet = `Carter Rd`
+	TableStreet_Carver_Landing_Rd               TableStreet = `Carver Landing Rd`
+	TableStreet_Casey_Dr                        TableStreet = `Casey Dr`
+	TableStreet_Casey_Ln                        TableStreet = `Casey Ln`
+	TableStreet_Casey_St                        TableStreet = `Casey St`
+	TableStreet_Cass_Dr                         TableStreet = `Cass Dr`
+	TableStreet_Cass_Station_Dr                 TableStreet = `Cass Station Dr`
+	TableStreet_Cass_Station_Pass               TableStreet = `Cass Station Pass`
+	TableStreet_Cassandra_View                  TableStreet = `Cassandra View`
+	TableStreet_Cassville_Pine_Log_Rd           TableStreet = `Cassville Pine Log Rd`
+	TableStreet_Cassville_Rd                    TableStreet = `Cassville Rd`
+	TableStreet_Cassville_White_Rd              TableStreet = `Cassville White Rd`
+	TableStreet_Catalpa_Ct                      TableStreet = `Catalpa Ct`
+	TableStreet_Cathedral_Hght                  TableStreet = `Cathedral Hght`
+	TableStreet_Catherine_Cir                   TableStreet = `Catherine Cir`
+	TableStreet_Catherine_Way                   TableStreet = `Catherine Way`
+	TableStreet_Cedar_Creek_Church_Rd           TableStreet = `Cedar Creek Church Rd`
+	TableStreet_Cedar_Creek_Rd                  TableStreet = `Cedar Creek Rd`
+	TableStreet_Cedar_Gate_Ln                   TableStreet = `Cedar Gate Ln`
+	TableStreet_Cedar_Ln                        TableStreet = `Cedar Ln`
+	TableStreet_Cedar_Rd                        TableStreet = `Cedar Rd`
+	TableStreet_Cedar_Rdg                       TableStreet = `Cedar Rdg`
+	TableStreet_Cedar_Springs_Dr                TableStreet = `Cedar Springs Dr`
+	TableStreet_Cedar_St                        TableStreet = `Cedar St`
+	TableStreet_Cedar_Way                       TableStreet = `Cedar Way`
+	TableStreet_Cemetary_Rd                     TableStreet = `Cemetary Rd`
+	TableStreet_Center_Bluff                    TableStreet = `Center Bluff`
+	TableStreet_Center_Rd                       TableStreet = `Center Rd`
+	TableStreet_Center_St                       TableStreet = `Center St`
+	TableStreet_Centerport_Dr                   TableStreet = `Centerport Dr`
+	TableStreet_Central_Ave                     TableStreet = `Central Ave`
+	TableStreet_Chance_Cir                      TableStreet = `Chance Cir`
+	TableStreet_Chandler_Ln                     TableStreet = `Chandler Ln`
+	TableStreet_Chapel_Way                      TableStreet = `Chapel Way`
+	TableStreet_Charismatic_Rd                  TableStreet = `Charismatic Rd`
+	TableStreet_Charles_Ct                      TableStreet = `Charles Ct`
+	TableStreet_Charles_St                      TableStreet = `Charles St`
+	TableStreet_Charlotte_Dr                    TableStreet = `Charlotte Dr`
+	TableStreet_Chase_Pl                        TableStreet = `Chase Pl`
+	TableStreet_Chateau_Dr                      TableStreet = `Chateau Dr`
+	TableStreet_Cherokee_Cir                    TableStreet = `Cherokee Cir`
+	TableStreet_Cherokee_Dr                     TableStreet = `Cherokee Dr`
+	TableStreet_Cherokee_Hght                   TableStreet = `Cherokee Hght`
+	TableStreet_Cherokee_Hills_Dr               TableStreet = `Cherokee Hills Dr`
+	TableStreet_Cherokee_Pl                     TableStreet = `Cherokee Pl`
+	TableStreet_Cherokee_St                     TableStreet = `Cherokee St`
+	TableStreet_Cherry_St                       TableStreet = `Cherry St`
+	TableStreet_Cherrywood_Ln                   TableStreet = `Cherrywood Ln`
+	TableStreet_Cherrywood_Way                  TableStreet = `Cherrywood Way`
+	TableStreet_Cheryl_Dr                       TableStreet = `Cheryl Dr`
+	TableStreet_Chestnut_Ridge_Ct               TableStreet = `Chestnut Ridge Ct`
+	TableStreet_Chestnut_Ridge_Dr               TableStreet = `Chestnut Ridge Dr`
+	TableStreet_Chestnut_St                     TableStreet = `Chestnut St`
+	TableStreet_Cheyenne_Way                    TableStreet = `Cheyenne Way`
+	TableStreet_Chickasaw_Trl                   TableStreet = `Chickasaw Trl`
+	TableStreet_Chickasaw_Way                   TableStreet = `Chickasaw Way`
+	TableStreet_Chimney_Ln                      TableStreet = `Chimney Ln`
+	TableStreet_Chimney_Springs_Dr              TableStreet = `Chimney Springs Dr`
+	TableStreet_Chippewa_Dr                     TableStreet = `Chippewa Dr`
+	TableStreet_Chippewa_Way                    TableStreet = `Chippewa Way`
+	TableStreet_Chitwood_Cemetary_Rd            TableStreet = `Chitwood Cemetary Rd`
+	TableStreet_Choctaw_Ct                      TableStreet = `Choctaw Ct`
+	TableStreet_Christon_Dr                     TableStreet = `Christon Dr`
+	TableStreet_Christopher_Pl                  TableStreet = `Christopher Pl`
+	TableStreet_Christopher_Rdg                 TableStreet = `Christopher Rdg`
+	TableStreet_Chulio_Dr                       TableStreet = `Chulio Dr`
+	TableStreet_Chunn_Dr                        TableStreet = `Chunn Dr`
+	TableStreet_Chunn_Facin_Rd                  TableStreet = `Chunn Facin Rd`
+	TableStreet_Church_St                       TableStreet = `Church St`
+	TableStreet_Churchill_Down                  TableStreet = `Churchill Down`
+	TableStreet_Churchill_Downs_Down            TableStreet = `Churchill Downs Down`
+	TableStreet_Cindy_Ln                        TableStreet = `Cindy Ln`
+	TableStreet_Citation_Pte                    TableStreet = `Citation Pte`
+	TableStreet_Claire_Cv                       TableStreet = `Claire Cv`
+	TableStreet_Clark_Cir                       TableStreet = `Clark Cir`
+	TableStreet_Clark_Way                       TableStreet = `Clark Way`
+	TableStreet_Clear_Creek_Rd                  TableStreet = `Clear Creek Rd`
+	TableStreet_Clear_Lake_Dr                   TableStreet = `Clear Lake Dr`
+	TableStreet_Clear_Pass                      TableStreet = `Clear Pass`
+	TableStreet_Clearview_Dr                    TableStreet = `Clearview Dr`
+	TableStreet_Clearwater_St                   TableStreet = `Clearwater St`
+	TableStreet_Cliff_Nelson_Rd                 TableStreet = `Cliff Nelson Rd`
+	TableStreet_Cliffhanger_Pte                 TableStreet = `Cliffhanger Pte`
+	TableStreet_Cline_Dr                        TableStreet = `Cline Dr`
+	TableStreet_Cline_Smith_Rd                  TableStreet = `Cline Smith Rd`
+	TableStreet_Cline_St                        TableStreet = `Cline St`
+	TableStreet_Clover_Dr                       TableStreet = `Clover Dr`
+	TableStreet_Clover_Ln                       TableStreet = `Clover Ln`
+	TableStreet_Clover_Pass                     TableStreet = `Clover Pass`
+	TableStreet_Clubhouse_Ct                    TableStreet = `Clubhouse Ct`
+	TableStreet_Clubview_Dr                     TableStreet = `Clubview Dr`
+	TableStreet_Clydesdale_Trl                  TableStreet = `Clydesdale Trl`
+	TableStreet_Cobblestone_Dr                  TableStreet = `Cobblestone Dr`
+	TableStreet_Cochrans_Way                    TableStreet = `Cochrans Way`
+	TableStreet_Coffee_St                       TableStreet = `Coffee St`
+	TableStreet_Cole_Ct                         TableStreet = `Cole Ct`
+	TableStreet_Cole_Dr                         TableStreet = `Cole Dr`
+	TableStreet_Coleman_St                      TableStreet = `Coleman St`
+	TableStreet_Colleen_and_Karen_Rd            TableStreet = `Colleen and Karen Rd`
+	TableStreet_College_St                      TableStreet = `College St`
+	TableStreet_Collins_Dr                      TableStreet = `Collins Dr`
+	TableStreet_Collins_Pl                      TableStreet = `Collins Pl`
+	TableStreet_Collins_St                      TableStreet = `Collins St`
+	TableStreet_Collum_Rd                       TableStreet = `Collum Rd`
+	TableStreet_Colonial_Cir                    TableStreet = `Colonial Cir`
+	TableStreet_Colonial_Club_Dr                TableStreet = `Colonial Club Dr`
+	TableStreet_Colony_Ct                       TableStreet = `Colony Ct`
+	TableStreet_Colony_Dr                       TableStreet = `Colony Dr`
+	TableStreet_Colt_Ct                         TableStreet = `Colt Ct`
+	TableStreet_Colt_Way                        TableStreet = `Colt Way`
+	TableStreet_Columbia_St                     TableStreet = `Columbia St`
+	TableStreet_Comedy_Ln                       TableStreet = `Comedy Ln`
+	TableStreet_Commanche_Dr                    TableStreet = `Commanche Dr`
+	TableStreet_Commanche_Trl                   TableStreet = `Commanche Trl`
+	TableStreet_Commerce_Dr                     TableStreet = `Commerce Dr`
+	TableStreet_Commerce_Row                    TableStreet = `Commerce Row`
+	TableStreet_Commerce_Way                    TableStreet = `Commerce Way`
+	TableStreet_Cone_Rd                         TableStreet = `Cone Rd`
+	TableStreet_Confederate_St                  TableStreet = `Confederate St`
+	TableStreet_Connesena_Rd                    TableStreet = `Connesena Rd`
+	TableStreet_Connie_St                       TableStreet = `Connie St`
+	TableStreet_Conyers_Industrial_Dr           TableStreet = `Conyers Industrial Dr`
+	TableStreet_Cook_St                         TableStreet = `Cook St`
+	TableStreet_Cooper_Mill_Rd                  TableStreet = `Cooper Mill Rd`
+	TableStreet_Copeland_Rd                     TableStreet = `Copeland Rd`
+	TableStreet_Coperite_Pl                     TableStreet = `Coperite Pl`
+	TableStreet_Copperhead_Rd                   TableStreet = `Copperhead Rd`
+	TableStreet_Corbitt_Dr                      TableStreet = `Corbitt Dr`
+	TableStreet_Corinth_Rd                      TableStreet = `Corinth Rd`
+	TableStreet_Corson_Trl                      TableStreet = `Corson Trl`
+	TableStreet_Cottage_Dr                      TableStreet = `Cottage Dr`
+	TableStreet_Cottage_Trc                     TableStreet = `Cottage Trc`
+	TableStreet_Cottage_Walk                    TableStreet = `Cottage Walk`
+	TableStreet_Cotton_Bend                     TableStreet = `Cotton Bend`
+	TableStreet_Cotton_Dr                       TableStreet = `Cotton Dr`
+	TableStreet_Cotton_St                       TableStreet = `Cotton St`
+	TableStreet_Cottonwood_Ct                   TableStreet = `Cottonwood Ct`
+	TableStreet_Country_Club_Dr                 TableStreet = `Country Club Dr`
+	TableStreet_Country_Cove_Ln                 TableStreet = `Country Cove Ln`
+	TableStreet_Country_Creek_Rd                TableStreet = `Country Creek Rd`
+	TableStreet_Country_Dr                      TableStreet = `Country Dr`
+	TableStreet_Country_Ln                      TableStreet = `Country Ln`
+	TableStreet_Country_Meadow_Way              TableStreet = `Country Meadow Way`
+	TableStreet_Country_Walk                    TableStreet = `Country Walk`
+	TableStreet_County_Line_Loop_No_1           TableStreet = `County Line Loop No 1`
+	TableStreet_County_Line_Rd                  TableStreet = `County Line Rd`
+	TableStreet_County_Line_Road_No_2           TableStreet = `County Line Road No 2`
+	TableStreet_Courrant_St                     TableStreet = `Courrant St`
+	TableStreet_Court_Xing                      TableStreet = `Court Xing`
+	TableStreet_Courtyard_Dr                    TableStreet = `Courtyard Dr`
+	TableStreet_Courtyard_Ln                    TableStreet = `Courtyard Ln`
+	TableStreet_Covered_Bridge_Rd               TableStreet = `Covered Bridge Rd`
+	TableStreet_Cowan_Dr                        TableStreet = `Cowan Dr`
+	TableStreet_Cox_Farm_Rd                     TableStreet = `Cox Farm Rd`
+	TableStreet_Cox_Farm_Spur                   TableStreet = `Cox Farm Spur`
+	TableStreet_Cox_Rd                          TableStreet = `Cox Rd`
+	TableStreet_Cox_St                          TableStreet = `Cox St`
+	TableStreet_Cozy_Ln                         TableStreet = `Cozy Ln`
+	TableStreet_Craigs_Rd                       TableStreet = `Craigs Rd`
+	TableStreet_Crane_Cir                       TableStreet = `Crane Cir`
+	TableStreet_Creed_Dr                        TableStreet = `Creed Dr`
+	TableStreet_Creek_Bend_Ct                   TableStreet = `Creek Bend Ct`
+	TableStreet_Creek_Dr                        TableStreet = `Creek Dr`
+	TableStreet_Creek_Trl                       TableStreet = `Creek Trl`
+	TableStreet_Creekbend_Dr                    TableStreet = `Creekbend Dr`
+	TableStreet_Creekside_Dr                    TableStreet = `Creekside Dr`
+	TableStreet_Creekside_Ln                    TableStreet = `Creekside Ln`
+	TableStreet_Creekstone_Ct                   TableStreet = `Creekstone Ct`
+	TableStreet_Creekview_Dr                    TableStreet = `Creekview Dr`
+	TableStreet_Crescent_Dr                     TableStreet = `Crescent Dr`
+	TableStreet_Crestbrook_Dr                   TableStreet = `Crestbrook Dr`
+	TableStreet_Crestview_Ln                    TableStreet = `Crestview Ln`
+	TableStreet_Crestwood_Ct                    TableStreet = `Crestwood Ct`
+	TableStreet_Crestwood_Rd                    TableStreet = `Crestwood Rd`
+	TableStreet_Crimson_Hill_Dr                 TableStreet = `Crimson Hill Dr`
+	TableStreet_Criss_Black_Rd                  TableStreet = `Criss Black Rd`
+	TableStreet_Crolley_Ln                      TableStreet = `Crolley Ln`
+	TableStreet_Cromwell_Ct                     TableStreet = `Cromwell Ct`
+	TableStreet_Cross_Creek_Dr                  TableStreet = `Cross Creek Dr`
+	TableStreet_Cross_St                        TableStreet = `Cross St`
+	TableStreet_Cross_Tie_Ct                    TableStreet = `Cross Tie Ct`
+	TableStreet_Crossbridge_CT                  TableStreet = `Crossbridge CT`
+	TableStreet_Crossfield_Cir                  TableStreet = `Crossfield Cir`
+	TableStreet_Crowe_Dr                        TableStreet = `Crowe Dr`
+	TableStreet_Crowe_Springs_Rd                TableStreet = `Crowe Springs Rd`
+	TableStreet_Crowe_Springs_Spur              TableStreet = `Crowe Springs Spur`
+	TableStreet_Crump_Rd                        TableStreet = `Crump Rd`
+	TableStreet_Crystal_Ln                      TableStreet = `Crystal Ln`
+	TableStreet_Crystal_Mountain_Rd             TableStreet = `Crystal Mountain Rd`
+	TableStreet_Culver_Rd                       TableStreet = `Culver Rd`
+	TableStreet_Cumberland_Gap                  TableStreet = `Cumberland Gap`
+	TableStreet_Cumberland_Rd                   TableStreet = `Cumberland Rd`
+	TableStreet_Cummings_Rd                     TableStreet = `Cummings Rd`
+	TableStreet_Curtis_Ct                       TableStreet = `Curtis Ct`
+	TableStreet_Cut_Off_Rd                      TableStreet = `Cut Off Rd`
+	TableStreet_Cypress_Way                     TableStreet = `Cypress Way`
+	TableStreet_Dabbs_Dr                        TableStreet = `Dabbs Dr`
+	TableStreet_Dan_Dr                          TableStreet = `Dan Dr`
+	TableStreet_Dana_Way                        TableStreet = `Dana Way`
+	TableStreet_Danby_Ct                        TableStreet = `Danby Ct`
+	TableStreet_Darnell_Rd                      TableStreet = `Darnell Rd`
+	TableStreet_David_Rd                        TableStreet = `David Rd`
+	TableStreet_David_St                        TableStreet = `David St`
+	TableStreet_Davids_Way                      TableStreet = `Davids Way`
+	TableStreet_Davis_Dr                        TableStreet = `Davis Dr`
+	TableStreet_Davis_Loop                      TableStreet = `Davis Loop`
+	TableStreet_Davis_St                        TableStreet = `Davis St`
+	TableStreet_Davistown_Rd                    TableStreet = `Davistown Rd`
+	TableStreet_Dawn_Dr                         TableStreet = `Dawn Dr`
+	TableStreet_Dawns_Way                       TableStreet = `Dawns Way`
+	TableStreet_Dawson_St                       TableStreet = `Dawson St`
+	TableStreet_Dean_Dr                         TableStreet = `Dean Dr`
+	TableStreet_Dean_Rd                         TableStreet = `Dean Rd`
+	TableStreet_Dean_St                         TableStreet = `Dean St`
+	TableStreet_Debra_Cir                       TableStreet = `Debra Cir`
+	TableStreet_Deens_Rd                        TableStreet = `Deens Rd`
+	TableStreet_Deer_Lodge_Rd                   TableStreet = `Deer Lodge Rd`
+	TableStreet_Deer_Run_Dr                     TableStreet = `Deer Run Dr`
+	TableStreet_Deer_Run_Ln                     TableStreet = `Deer Run Ln`
+	TableStreet_Deering_Way                     TableStreet = `Deering Way`
+	TableStreet_Deerview_Ct                     TableStreet = `Deerview Ct`
+	TableStreet_Defender_St                     TableStreet = `Defender St`
+	TableStreet_Dempsey_Loop                    TableStreet = `Dempsey Loop`
+	TableStreet_Dempsey_Rd                      TableStreet = `Dempsey Rd`
+	TableStreet_Dent_Dr                         TableStreet = `Dent Dr`
+	TableStreet_Denver_Dr                       TableStreet = `Denver Dr`
+	TableStreet_Derby_Way                       TableStreet = `Derby Way`
+	TableStreet_Derrick_Ln                      TableStreet = `Derrick Ln`
+	TableStreet_Devin_Ln                        TableStreet = `Devin Ln`
+	TableStreet_Devon_Ct                        TableStreet = `Devon Ct`
+	TableStreet_Dewberry_Ln                     TableStreet = `Dewberry Ln`
+	TableStreet_Dewey_Dr                        TableStreet = `Dewey Dr`
+	TableStreet_Dianne_Dr                       TableStreet = `Dianne Dr`
+	TableStreet_Dixie_Dr                        TableStreet = `Dixie Dr`
+	TableStreet_Dobson_Dr                       TableStreet = `Dobson Dr`
+	TableStreet_Dodd_Rd                         TableStreet = `Dodd Rd`
+	TableStreet_Dodson_Rd                       TableStreet = `Dodson Rd`
+	TableStreet_Doe_Ct                          TableStreet = `Doe Ct`
+	TableStreet_Dog_Ln                          TableStreet = `Dog Ln`
+	TableStreet_Dogwood_Cir                     TableStreet = `Dogwood Cir`
+	TableStreet_Dogwood_Dr                      TableStreet = `Dogwood Dr`
+	TableStreet_Dogwood_Ln                      TableStreet = `Dogwood Ln`
+	TableStreet_Dogwood_Pl                      TableStreet = `Dogwood Pl`
+	TableStreet_Dogwood_Trl                     TableStreet = `Dogwood Trl`
+	TableStreet_Dolphin_Dr                      TableStreet = `Dolphin Dr`
+	TableStreet_Donn_Dr                         TableStreet = `Donn Dr`
+	TableStreet_Dooley_Springs_Rd               TableStreet = `Dooley Springs Rd`
+	TableStreet_Doris_Rd                        TableStreet = `Doris Rd`
+	TableStreet_Doss_Dr                         TableStreet = `Doss Dr`
+	TableStreet_Douglas_St                      TableStreet = `Douglas St`
+	TableStreet_Douthit_Bridge_Rd               TableStreet = `Douthit Bridge Rd`
+	TableStreet_Douthit_Ferry_Rd                TableStreet = `Douthit Ferry Rd`
+	TableStreet_Dove_Ct                         TableStreet = `Dove Ct`
+	TableStreet_Dove_Dr                         TableStreet = `Dove Dr`
+	TableStreet_Dove_Trl                        TableStreet = `Dove Trl`
+	TableStreet_Dover_Rd                        TableStreet = `Dover Rd`
+	TableStreet_Downing_Way                     TableStreet = `Downing Way`
+	TableStreet_Dripping_Rock_Trl               TableStreet = `Dripping Rock Trl`
+	TableStreet_Drury_Ln                        TableStreet = `Drury Ln`
+	TableStreet_Dry_Creek_Rd                    TableStreet = `Dry Creek Rd`
+	TableStreet_Duncan_Dr                       TableStreet = `Duncan Dr`
+	TableStreet_Dunhill_Ct                      TableStreet = `Dunhill Ct`
+	TableStreet_Durey_Ct                        TableStreet = `Durey Ct`
+	TableStreet_Dyar_St                         TableStreet = `Dyar St`
+	TableStreet_Dysart_Rd                       TableStreet = `Dysart Rd`
+	TableStreet_E_Carter_St                     TableStreet = `E Carter St`
+	TableStreet_E_Cherokee_Ave                  TableStreet = `E Cherokee Ave`
+	TableStreet_E_Church_St                     TableStreet = `E Church St`
+	TableStreet_E_Felton_Rd                     TableStreet = `E Felton Rd`
+	TableStreet_E_George_St                     TableStreet = `E George St`
+	TableStreet_E_Greenridge_Rd                 TableStreet = `E Greenridge Rd`
+	TableStreet_E_Holiday_Ct                    TableStreet = `E Holiday Ct`
+	TableStreet_E_Howard_St                     TableStreet = `E Howard St`
+	TableStreet_E_Indiana_Ave                   TableStreet = `E Indiana Ave`
+	TableStreet_E_Lee_St                        TableStreet = `E Lee St`
+	TableStreet_E_Main_St                       TableStreet = `E Main St`
+	TableStreet_E_Mitchell_Rd                   TableStreet = `E Mitchell Rd`
+	TableStreet_E_Pleasant_Valley_Rd            TableStreet = `E Pleasant Valley Rd`
+	TableStreet_E_Porter_St                     TableStreet = `E Porter St`
+	TableStreet_E_Railroad_St                   TableStreet = `E Railroad St`
+	TableStreet_E_Rocky_St                      TableStreet = `E Rocky St`
+	TableStreet_Eagle_Dr                        TableStreet = `Eagle Dr`
+	TableStreet_Eagle_Glen_Dr                   TableStreet = `Eagle Glen Dr`
+	TableStreet_Eagle_Ln                        TableStreet = `Eagle Ln`
+	TableStreet_Eagle_Mountain_Trl              TableStreet = `Eagle Mountain Trl`
+	TableStreet_Eagle_Pkwy                      TableStreet = `Eagle Pkwy`
+	TableStreet_Eagles_Ct                       TableStreet = `Eagles Ct`
+	TableStreet_Eagles_Nest_Cv                  TableStreet = `Eagles Nest Cv`
+	TableStreet_Eagles_View_Dr                  TableStreet = `Eagles View Dr`
+	TableStreet_Earle_Dr                        TableStreet = `Earle Dr`
+	TableStreet_East_Boxwood_Dr                 TableStreet = `East Boxwood Dr`
+	TableStreet_East_Heritage_Dr                TableStreet = `East Heritage Dr`
+	TableStreet_East_Valley_Rd                  TableStreet = `East Valley Rd`
+	TableStreet_Easton_Trc                      TableStreet = `Easton Trc`
+	TableStreet_Eastview_Ct                     TableStreet = `Eastview Ct`
+	TableStreet_Eastview_Ter                    TableStreet = `Eastview Ter`
+	TableStreet_Eastwood_Dr                     TableStreet = `Eastwood Dr`
+	TableStreet_Echota_Rd                       TableStreet = `Echota Rd`
+	TableStreet_Edgewater_Dr                    TableStreet = `Edgewater Dr`
+	TableStreet_Edgewood_Rd                     TableStreet = `Edgewood Rd`
+	TableStreet_Edsel_Dr                        TableStreet = `Edsel Dr`
+	TableStreet_Eidson_St                       TableStreet = `Eidson St`
+	TableStreet_Elizabeth_Rd                    TableStreet = `Elizabeth Rd`
+	TableStreet_Elizabeth_St                    TableStreet = `Elizabeth St`
+	TableStreet_Elkhorn_Ct                      TableStreet = `Elkhorn Ct`
+	TableStreet_Elliott_St                      TableStreet = `Elliott St`
+	TableStreet_Ellis_Rd                        TableStreet = `Ellis Rd`
+	TableStreet_Elm_Dr                          TableStreet = `Elm Dr`
+	TableStreet_Elm_St                          TableStreet = `Elm St`
+	TableStreet_Elmwood_Pl                      TableStreet = `Elmwood Pl`
+	TableStreet_Elrod_Rdg                       TableStreet = `Elrod Rdg`
+	TableStreet_Elrod_St                        TableStreet = `Elrod St`
+	TableStreet_Ember_Way                       TableStreet = `Ember Way`
+	TableStreet_Emerald_Pass                    TableStreet = `Emerald Pass`
+	TableStreet_Emerald_Run                     TableStreet = `Emerald Run`
+	TableStreet_Emerald_Springs_Ct              TableStreet = `Emerald Springs Ct`
+	TableStreet_Emerald_Springs_Dr              TableStreet = `Emerald Springs Dr`
+	TableStreet_Emerald_Springs_Way             TableStreet = `Emerald Springs Way`
+	TableStreet_Emerald_Trl                     TableStreet = `Emerald Trl`
+	TableStreet_Engineer_Ln                     TableStreet = `Engineer Ln`
+	TableStreet_English_Turn                    TableStreet = `English Turn`
+	TableStreet_Enon_Ridge_Rd                   TableStreet = `Enon Ridge Rd`
+	TableStreet_Enterprise_Dr                   TableStreet = `Enterprise Dr`
+	TableStreet_Equestrian_Way                  TableStreet = `Equestrian Way`
+	TableStreet_Estate_Dr                       TableStreet = `Estate Dr`
+	TableStreet_Estates_Rdg                     TableStreet = `Estates Rdg`
+	TableStreet_Etowah_Ct                       TableStreet = `Etowah Ct`
+	TableStreet_Etowah_Dr                       TableStreet = `Etowah Dr`
+	TableStreet_Etowah_Ln                       TableStreet = `Etowah Ln`
+	TableStreet_Etowah_Ridge_Dr                 TableStreet = `Etowah Ridge Dr`
+	TableStreet_Etowah_Ridge_Ovlk               TableStreet = `Etowah Ridge Ovlk`
+	TableStreet_Etowah_Ridge_Trl                TableStreet = `Etowah Ridge Trl`
+	TableStreet_Euharlee_Five_Forks_Rd          TableStreet = `Euharlee Five Forks Rd`
+	TableStreet_Euharlee_Rd                     TableStreet = `Euharlee Rd`
+	TableStreet_Euharlee_St                     TableStreet = `Euharlee St`
+	TableStreet_Evans_Hightower_Rd              TableStreet = `Evans Hightower Rd`
+	TableStreet_Evans_Rd                        TableStreet = `Evans Rd`
+	TableStreet_Evening_Trl                     TableStreet = `Evening Trl`
+	TableStreet_Everest_Dr                      TableStreet = `Everest Dr`
+	TableStreet_Everett_Cir                     TableStreet = `Everett Cir`
+	TableStreet_Evergreen_Trl                   TableStreet = `Evergreen Trl`
+	TableStreet_Ezell_Rd                        TableStreet = `Ezell Rd`
+	TableStreet_Fairfield_Ct                    TableStreet = `Fairfield Ct`
+	TableStreet_Fairfield_Dr                    TableStreet = `Fairfield Dr`
+	TableStreet_Fairfield_Ln                    TableStreet = `Fairfield Ln`
+	TableStreet_Fairfield_Way                   TableStreet = `Fairfield Way`
+	TableStreet_Fairmount_Rd                    TableStreet = `Fairmount Rd`
+	TableStreet_Fairview_Church_Rd              TableStreet = `Fairview Church Rd`
+	TableStreet_Fairview_Cir                    TableStreet = `Fairview Cir`
+	TableStreet_Fairview_Dr                     TableStreet = `Fairview Dr`
+	TableStreet_Fairview_St                     TableStreet = `Fairview St`
+	TableStreet_Faith_Ln                        TableStreet = `Faith Ln`
+	TableStreet_Falcon_Cir                      TableStreet = `Falcon Cir`
+	TableStreet_Fall_Dr                         TableStreet = `Fall Dr`
+	TableStreet_Falling_Springs_Rd              TableStreet = `Falling Springs Rd`
+	TableStreet_Farm_Rd                         TableStreet = `Farm Rd`
+	TableStreet_Farmbrook_Dr                    TableStreet = `Farmbrook Dr`
+	TableStreet_Farmer_Rd                       TableStreet = `Farmer Rd`
+	TableStreet_Farmstead_Ct                    TableStreet = `Farmstead Ct`
+	TableStreet_Farr_Cir                        TableStreet = `Farr Cir`
+	TableStreet_Fawn_Lake_Trl                   TableStreet = `Fawn Lake Trl`
+	TableStreet_Fawn_Ridge_Dr                   TableStreet = `Fawn Ridge Dr`
+	TableStreet_Fawn_View                       TableStreet = `Fawn View`
+	TableStreet_Felton_Pl                       TableStreet = `Felton Pl`
+	TableStreet_Felton_St                       TableStreet = `Felton St`
+	TableStreet_Ferguson_Dr                     TableStreet = `Ferguson Dr`
+	TableStreet_Ferry_Views                     TableStreet = `Ferry Views`
+	TableStreet_Fields_Rd                       TableStreet = `Fields Rd`
+	TableStreet_Fieldstone_Ct                   TableStreet = `Fieldstone Ct`
+	TableStreet_Fieldstone_Path                 TableStreet = `Fieldstone Path`
+	TableStreet_Fieldwood_Dr                    TableStreet = `Fieldwood Dr`
+	TableStreet_Finch_Ct                        TableStreet = `Finch Ct`
+	TableStreet_Findley_Rd                      TableStreet = `Findley Rd`
+	TableStreet_Finley_St                       TableStreet = `Finley St`
+	TableStreet_Fire_Tower_Rd                   TableStreet = `Fire Tower Rd`
+	TableStreet_Fireside_Ct                     TableStreet = `Fireside Ct`
+	TableStreet_Fite_St                         TableStreet = `Fite St`
+	TableStreet_Five_Forks_Rd                   TableStreet = `Five Forks Rd`
+	TableStreet_Flagstone_Ct                    TableStreet = `Flagstone Ct`
+	TableStreet_Flint_Hill_Rd                   TableStreet = `Flint Hill Rd`
+	TableStreet_Floral_Dr                       TableStreet = `Floral Dr`
+	TableStreet_Florence_Dr                     TableStreet = `Florence Dr`
+	TableStreet_Florida_Ave                     TableStreet = `Florida Ave`
+	TableStreet_Floyd_Creek_Church_Rd           TableStreet = `Floyd Creek Church Rd`
+	TableStreet_Floyd_Rd                        TableStreet = `Floyd Rd`
+	TableStreet_Folsom_Glade_Rd                 TableStreet = `Folsom Glade Rd`
+	TableStreet_Folsom_Rd                       TableStreet = `Folsom Rd`
+	TableStreet_Fools_Gold_Rd                   TableStreet = `Fools Gold Rd`
+	TableStreet_Foothills_Pkwy                  TableStreet = `Foothills Pkwy`
+	TableStreet_Ford_St                         TableStreet = `Ford St`
+	TableStreet_Forest_Hill_Dr                  TableStreet = `Forest Hill Dr`
+	TableStreet_Forest_Trl                      TableStreet = `Forest Trl`
+	TableStreet_Forrest_Ave                     TableStreet = `Forrest Ave`
+	TableStreet_Forrest_Hill_Dr                 TableStreet = `Forrest Hill Dr`
+	TableStreet_Forrest_Ln                      TableStreet = `Forrest Ln`
+	TableStreet_Fossetts_Cv                     TableStreet = `Fossetts Cv`
+	TableStreet_Fouche_Ct                       TableStreet = `Fouche Ct`
+	TableStreet_Fouche_Dr                       TableStreet = `Fouche Dr`
+	TableStreet_Four_Feathers_Ln                TableStreet = `Four Feathers Ln`
+	TableStreet_Fowler_Dr                       TableStreet = `Fowler Dr`
+	TableStreet_Fowler_Rd                       TableStreet = `Fowler Rd`
+	TableStreet_Fox_Chas                        TableStreet = `Fox Chas`
+	TableStreet_Fox_Hill_Dr                     TableStreet = `Fox Hill Dr`
+	TableStreet_Fox_Meadow_Ct                   TableStreet = `Fox Meadow Ct`
+	TableStreet_Foxfire_Ln                      TableStreet = `Foxfire Ln`
+	TableStreet_Foxhound_Way                    TableStreet = `Foxhound Way`
+	TableStreet_Foxrun_Ct                       TableStreet = `Foxrun Ct`
+	TableStreet_Frances_Way                     TableStreet = `Frances Way`
+	TableStreet_Franklin_Dr                     TableStreet = `Franklin Dr`
+	TableStreet_Franklin_Johnson_Dr             TableStreet = `Franklin Johnson Dr`
+	TableStreet_Franklin_Loop                   TableStreet = `Franklin Loop`
+	TableStreet_Fredda_Ln                       TableStreet = `Fredda Ln`
+	TableStreet_Freedom_Dr                      TableStreet = `Freedom Dr`
+	TableStreet_Freeman_St                      TableStreet = `Freeman St`
+	TableStreet_Friction_Dr                     TableStreet = `Friction Dr`
+	TableStreet_Friendly_Ln                     TableStreet = `Friendly Ln`
+	TableStreet_Friendship_Rd                   TableStreet = `Friendship Rd`
+	TableStreet_Fuger_Ln                        TableStreet = `Fuger Ln`
+	TableStreet_Fun_Dr                          TableStreet = `Fun Dr`
+	TableStreet_Funkhouser_Industrial_Access_Rd TableStreet = `Funkhouser Industrial Access Rd`
+	TableStreet_Gaddis_Rd                       TableStreet = `Gaddis Rd`
+	TableStreet_Gail_St                         TableStreet = `Gail St`
+	TableStreet_Gaines_Rd                       TableStreet = `Gaines Rd`
+	TableStreet_Gaither_Boggs_Rd                TableStreet = `Gaither Boggs Rd`
+	TableStreet_Galt_St                         TableStreet = `Galt St`
+	TableStreet_Galway_Dr                       TableStreet = `Galway Dr`
+	TableStreet_Garden_Gate                     TableStreet = `Garden Gate`
+	TableStreet_Garland_No_1_Rd                 TableStreet = `Garland No 1 Rd`
+	TableStreet_Garland_No_2_Rd                 TableStreet = `Garland No 2 Rd`
+	TableStreet_Garrison_Dr                     TableStreet = `Garrison Dr`
+	TableStreet_Gaston_Westbrook_Ave            TableStreet = `Gaston Westbrook Ave`
+	TableStreet_Gatepost_Ln                     TableStreet = `Gatepost Ln`
+	TableStreet_Gatlin_Rd                       TableStreet = `Gatlin Rd`
+	TableStreet_Gayle_Dr                        TableStreet = `Gayle Dr`
+	TableStreet_Geneva_Ln                       TableStreet = `Geneva Ln`
+	TableStreet_Gentilly_Blvd                   TableStreet = `Gentilly Blvd`
+	TableStreet_Gentry_Dr                       TableStreet = `Gentry Dr`
+	TableStreet_George_St                       TableStreet = `George St`
+	TableStreet_Georgia_Ave                     TableStreet = `Georgia Ave`
+	TableStreet_Georgia_Blvd                    TableStreet = `Georgia Blvd`
+	TableStreet_Georgia_North_Cir               TableStreet = `Georgia North Cir`
+	TableStreet_Georgia_North_Industrial_Rd     TableStreet = `Georgia North Industrial Rd`
+	TableStreet_Gertrude_Ln                     TableStreet = `Gertrude Ln`
+	TableStreet_Gibbons_Rd                      TableStreet = `Gibbons Rd`
+	TableStreet_Gill_Rd                         TableStreet = `Gill Rd`
+	TableStreet_Gilliams_Xing                   TableStreet = `Gilliams Xing`
+	TableStreet_Gilmer_St                       TableStreet = `Gilmer St`
+	TableStreet_Gilreath_Rd                     TableStreet = `Gilreath Rd`
+	TableStreet_Gilreath_Trl                    TableStreet = `Gilreath Trl`
+	TableStreet_Glade_Rd                        TableStreet = `Glade Rd`
+	TableStreet_Glen_Cove_Dr                    TableStreet = `Glen Cove Dr`
+	TableStreet_Glenabby_Dr                     TableStreet = `Glenabby Dr`
+	TableStreet_Glenmar_Ave                     TableStreet = `Glenmar Ave`
+	TableStreet_Glenmaura_Way                   TableStreet = `Glenmaura Way`
+	TableStreet_Glenmore_Dr                     TableStreet = `Glenmore Dr`
+	TableStreet_Glenn_Dr                        TableStreet = `Glenn Dr`
+	TableStreet_Glory_Ln                        TableStreet = `Glory Ln`
+	TableStreet_Gold_Creek_Trl                  TableStreet = `Gold Creek Trl`
+	TableStreet_Gold_Hill_Dr                    TableStreet = `Gold Hill Dr`
+	TableStreet_Golden_Eagle_Dr                 TableStreet = `Golden Eagle Dr`
+	TableStreet_Goode_Dr                        TableStreet = `Goode Dr`
+	TableStreet_Goodson_Cemetary_Rd             TableStreet = `Goodson Cemetary Rd`
+	TableStreet_Goodwin_St                      TableStreet = `Goodwin St`
+	TableStreet_Goodyear_Ave                    TableStreet = `Goodyear Ave`
+	TableStreet_Gordon_McCormick_Rd             TableStreet = `Gordon McCormick Rd`
+	TableStreet_Gordon_Rd                       TableStreet = `Gordon Rd`
+	TableStreet_Gore_Rd                         TableStreet = `Gore Rd`
+	TableStreet_Gore_Springs_Rd                 TableStreet = `Gore Springs Rd`
+	TableStreet_Goss_St                         TableStreet = `Goss St`
+	TableStreet_Governors_Ct                    TableStreet = `Governors Ct`
+	TableStreet_Grace_Park_Dr                   TableStreet = `Grace Park Dr`
+	TableStreet_Grace_St                        TableStreet = `Grace St`
+	TableStreet_Grand_Main_St                   TableStreet = `Grand Main St`
+	TableStreet_Grandview_Ct                    TableStreet = `Grandview Ct`
+	TableStreet_Grandview_Dr                    TableStreet = `Grandview Dr`
+	TableStreet_Granger_Dr                      TableStreet = `Granger Dr`
+	TableStreet_Grant_Dr                        TableStreet = `Grant Dr`
+	TableStreet_Grapevine_Way                   TableStreet = `Grapevine Way`
+	TableStreet_Grassdale_Rd                    TableStreet = `Grassdale Rd`
+	TableStreet_Gray_Rd                         TableStreet = `Gray Rd`
+	TableStreet_Gray_St                         TableStreet = `Gray St`
+	TableStreet_Graysburg_Dr                    TableStreet = `Graysburg Dr`
+	TableStreet_Graystone_Dr                    TableStreet = `Graystone Dr`
+	TableStreet_Greatwood_Dr                    TableStreet = `Greatwood Dr`
+	TableStreet_Green_Acre_Ln                   TableStreet = `Green Acre Ln`
+	TableStreet_Green_Apple_Ct                  TableStreet = `Green Apple Ct`
+	TableStreet_Green_Gable_Pt                  TableStreet = `Green Gable Pt`
+	TableStreet_Green_Ives_Ln                   TableStreet = `Green Ives Ln`
+	TableStreet_Green_Loop_Rd                   TableStreet = `Green Loop Rd`
+	TableStreet_Green_Rd                        TableStreet = `Green Rd`
+	TableStreet_Green_St                        TableStreet = `Green St`
+	TableStreet_Green_Valley_Trl                TableStreet = `Green Valley Trl`
+	TableStreet_Greenbriar_Ave                  TableStreet = `Greenbriar Ave`
+	TableStreet_Greencliff_Way                  TableStreet = `Greencliff Way`
+	TableStreet_Greenhouse_Dr                   TableStreet = `Greenhouse Dr`
+	TableStreet_Greenmont_Ct                    TableStreet = `Greenmont Ct`
+	TableStreet_Greenridge_Rd                   TableStreet = `Greenridge Rd`
+	TableStreet_Greenridge_Trl                  TableStreet = `Greenridge Trl`
+	TableStreet_Greenway_Ln                     TableStreet = `Greenway Ln`
+	TableStreet_Greenwood_Dr                    TableStreet = `Greenwood Dr`
+	TableStreet_Greyrock                        TableStreet = `Greyrock`
+	TableStreet_Greystone_Ln                    TableStreet = `Greystone Ln`
+	TableStreet_Greystone_Way                   TableStreet = `Greystone Way`
+	TableStreet_Greywood_Ln                     TableStreet = `Greywood Ln`
+	TableStreet_Griffin_Mill_Dr                 TableStreet = `Griffin Mill Dr`
+	TableStreet_Griffin_Rd                      TableStreet = `Griffin Rd`
+	TableStreet_Grimshaw_Rd                     TableStreet = `Grimshaw Rd`
+	TableStreet_Grist_Mill_Ln                   TableStreet = `Grist Mill Ln`
+	TableStreet_Gristmill_Parish                TableStreet = `Gristmill Parish`
+	TableStreet_Grogan_Rd                       TableStreet = `Grogan Rd`
+	TableStreet_Groovers_Landing_Rd             TableStreet = `Groovers Landing Rd`
+	TableStreet_Grove_Cir                       TableStreet = `Grove Cir`
+	TableStreet_Grove_Park_Cir                  TableStreet = `Grove Park Cir`
+	TableStreet_Grove_Pointe_Way                TableStreet = `Grove Pointe Way`
+	TableStreet_Grove_Springs_Ct                TableStreet = `Grove Springs Ct`
+	TableStreet_Grove_Way                       TableStreet = `Grove Way`
+	TableStreet_Grover_Rd                       TableStreet = `Grover Rd`
+	TableStreet_Guest_Holl                      TableStreet = `Guest Holl`
+	TableStreet_Gum_Dr                          TableStreet = `Gum Dr`
+	TableStreet_Gum_Springs_Ln                  TableStreet = `Gum Springs Ln`
+	TableStreet_Gunston_Hall                    TableStreet = `Gunston Hall`
+	TableStreet_Guyton_Industrial_Dr            TableStreet = `Guyton Industrial Dr`
+	TableStreet_Guyton_St                       TableStreet = `Guyton St`
+	TableStreet_Habersham_Cir                   TableStreet = `Habersham Cir`
+	TableStreet_Haley_Pl                        TableStreet = `Haley Pl`
+	TableStreet_Hall_St                         TableStreet = `Hall St`
+	TableStreet_Hall_Station_Rd                 TableStreet = `Hall Station Rd`
+	TableStreet_Hames_Pte                       TableStreet = `Hames Pte`
+	TableStreet_Hamil_Ct                        TableStreet = `Hamil Ct`
+	TableStreet_Hamilton_Blvd                   TableStreet = `Hamilton Blvd`
+	TableStreet_Hamilton_Crossing_Rd            TableStreet = `Hamilton Crossing Rd`
+	TableStreet_Hampton_Dr                      TableStreet = `Hampton Dr`
+	TableStreet_Hampton_Ln                      TableStreet = `Hampton Ln`
+	TableStreet_Haney_Hollow_Way                TableStreet = `Haney Hollow Way`
+	TableStreet_Hannon_Way                      TableStreet = `Hannon Way`
+	TableStreet_Happy_Hollow_Rd                 TableStreet = `Happy Hollow Rd`
+	TableStreet_Happy_Valley_Ln                 TableStreet = `Happy Valley Ln`
+	TableStreet_Harbor_Dr                       TableStreet = `Harbor Dr`
+	TableStreet_Harbor_Ln                       TableStreet = `Harbor Ln`
+	TableStreet_Hardin_Bridge_Rd                TableStreet = `Hardin Bridge Rd`
+	TableStreet_Hardin_Rd                       TableStreet = `Hardin Rd`
+	TableStreet_Hardwood_Ct                     TableStreet = `Hardwood Ct`
+	TableStreet_Hardwood_Ridge_Dr               TableStreet = `Hardwood Ridge Dr`
+	TableStreet_Hardwood_Ridge_Pkwy             TableStreet = `Hardwood Ridge Pkwy`
+	TableStreet_Hardy_Rd                        TableStreet = `Hardy Rd`
+	TableStreet_Hargis_Way                      TableStreet = `Hargis Way`
+	TableStreet_Harris_Rd                       TableStreet = `Harris Rd`
+	TableStreet_Harris_St                       TableStreet = `Harris St`
+	TableStreet_Harrison_Rd                     TableStreet = `Harrison Rd`
+	TableStreet_Harvest_Way                     TableStreet = `Harvest Way`
+	TableStreet_Harvey_Knight_Rd                TableStreet = `Harvey Knight Rd`
+	TableStreet_Hastings_Dr                     TableStreet = `Hastings Dr`
+	TableStreet_Hatfield_Rd                     TableStreet = `Hatfield Rd`
+	TableStreet_Hattie_St                       TableStreet = `Hattie St`
+	TableStreet_Hawk_Rd                         TableStreet = `Hawk Rd`
+	TableStreet_Hawkins_Rd                      TableStreet = `Hawkins Rd`
+	TableStreet_Hawks_Branch_Ln                 TableStreet = `Hawks Branch Ln`
+	TableStreet_Hawks_Farm_Rd                   TableStreet = `Hawks Farm Rd`
+	TableStreet_Hawkstone_Ct                    TableStreet = `Hawkstone Ct`
+	TableStreet_Hawthorne_Dr                    TableStreet = `Hawthorne Dr`
+	TableStreet_Hayes_Cemetary_Loop             TableStreet = `Hayes Cemetary Loop`
+	TableStreet_Hazelwood_Rd                    TableStreet = `Hazelwood Rd`
+	TableStreet_Headden_Rdg                     TableStreet = `Headden Rdg`
+	TableStreet_Heartwood_Dr                    TableStreet = `Heartwood Dr`
+	TableStreet_Heatco_Ct                       TableStreet = `Heatco Ct`
+	TableStreet_Hedgerow_Ct                     TableStreet = `Hedgerow Ct`
+	TableStreet_Heidelberg_Ln                   TableStreet = `Heidelberg Ln`
+	TableStreet_Helen_Ln                        TableStreet = `Helen Ln`
+	TableStreet_Hemlock_Pl                      TableStreet = `Hemlock Pl`
+	TableStreet_Henderson_Dr                    TableStreet = `Henderson Dr`
+	TableStreet_Henderson_Rd                    TableStreet = `Henderson Rd`
+	TableStreet_Hendricks_Rd                    TableStreet = `Hendricks Rd`
+	TableStreet_Hendricks_St                    TableStreet = `Hendricks St`
+	TableStreet_Henry_Mack_Hill_Rd              TableStreet = `Henry Mack Hill Rd`
+	TableStreet_Hepworth_Ln                     TableStreet = `Hepworth Ln`
+	TableStreet_Heritage_Ave                    TableStreet = `Heritage Ave`
+	TableStreet_Heritage_Cv                     TableStreet = `Heritage Cv`
+	TableStreet_Heritage_Dr                     TableStreet = `Heritage Dr`
+	TableStreet_Heritage_Way                    TableStreet = `Heritage Way`
+	TableStreet_Herring_St                      TableStreet = `Herring St`
+	TableStreet_Hickory_Forest_Ln               TableStreet = `Hickory Forest Ln`
+	TableStreet_Hickory_Hill_Dr                 TableStreet = `Hickory Hill Dr`
+	TableStreet_Hickory_Holl                    TableStreet = `Hickory Holl`
+	TableStreet_Hickory_Ln                      TableStreet = `Hickory Ln`
+	TableStreet_Hidden_Valley_Ln                TableStreet = `Hidden Valley Ln`
+	TableStreet_High_Ct                         TableStreet = `High Ct`
+	TableStreet_High_Moon_St                    TableStreet = `High Moon St`
+	TableStreet_High_Point_Ct                   TableStreet = `High Point Ct`
+	TableStreet_High_Pointe_Dr                  TableStreet = `High Pointe Dr`
+	TableStreet_Highland_Cir                    TableStreet = `Highland Cir`
+	TableStreet_Highland_Crest_Dr               TableStreet = `Highland Crest Dr`
+	TableStreet_Highland_Dr                     TableStreet = `Highland Dr`
+	TableStreet_Highland_Ln                     TableStreet = `Highland Ln`
+	TableStreet_Highland_Trl                    TableStreet = `Highland Trl`
+	TableStreet_Highland_Way                    TableStreet = `Highland Way`
+	TableStreet_Highpine_Dr                     TableStreet = `Highpine Dr`
+	TableStreet_Hill_St                         TableStreet = `Hill St`
+	TableStreet_Hillside_Dr                     TableStreet = `Hillside Dr`
+	TableStreet_Hillstone_Way                   TableStreet = `Hillstone Way`
+	TableStreet_Hilltop_Ct                      TableStreet = `Hilltop Ct`
+	TableStreet_Hilltop_Dr                      TableStreet = `Hilltop Dr`
+	TableStreet_History_St                      TableStreet = `History St`
+	TableStreet_Hitchcock_Sta                   TableStreet = `Hitchcock Sta`
+	TableStreet_Hobgood_Rd                      TableStreet = `Hobgood Rd`
+	TableStreet_Hodges_Mine_Rd                  TableStreet = `Hodges Mine Rd`
+	TableStreet_Holcomb_Rd                      TableStreet = `Holcomb Rd`
+	TableStreet_Holcomb_Spur                    TableStreet = `Holcomb Spur`
+	TableStreet_Holcomb_Trl                     TableStreet = `Holcomb Trl`
+	TableStreet_Holloway_Rd                     TableStreet = `Holloway Rd`
+	TableStreet_Hollows_Dr                      TableStreet = `Hollows Dr`
+	TableStreet_Holly_Ann_St                    TableStreet = `Holly Ann St`
+	TableStreet_Holly_Dr                        TableStreet = `Holly Dr`
+	TableStreet_Holly_Springs_Rd                TableStreet = `Holly Springs Rd`
+	TableStreet_Hollyhock_Ln                    TableStreet = `Hollyhock Ln`
+	TableStreet_Holt_Rd                         TableStreet = `Holt Rd`
+	TableStreet_Home_Place_Dr                   TableStreet = `Home Place Dr`
+	TableStreet_Home_Place_Rd                   TableStreet = `Home Place Rd`
+	TableStreet_Homestead_Dr                    TableStreet = `Homestead Dr`
+	TableStreet_Hometown_Ct                     TableStreet = `Hometown Ct`
+	TableStreet_Honey_Vine_Trl                  TableStreet = `Honey Vine Trl`
+	TableStreet_Honeylocust_Ct                  TableStreet = `Honeylocust Ct`
+	TableStreet_Honeysuckle_Dr                  TableStreet = `Honeysuckle Dr`
+	TableStreet_Hopkins_Breeze                  TableStreet = `Hopkins Breeze`
+	TableStreet_Hopkins_Farm_Dr                 TableStreet = `Hopkins Farm Dr`
+	TableStreet_Hopkins_Rd                      TableStreet = `Hopkins Rd`
+	TableStreet_Hopson_Rd                       TableStreet = `Hopson Rd`
+	TableStreet_Horizon_Trl                     TableStreet = `Horizon Trl`
+	TableStreet_Horseshoe_Ct                    TableStreet = `Horseshoe Ct`
+	TableStreet_Horseshoe_Loop                  TableStreet = `Horseshoe Loop`
+	TableStreet_Horseshow_Rd                    TableStreet = `Horseshow Rd`
+	TableStreet_Hotel_St                        TableStreet = `Hotel St`
+	TableStreet_Howard_Ave                      TableStreet = `Howard Ave`
+	TableStreet_Howard_Dr                       TableStreet = `Howard Dr`
+	TableStreet_Howard_Heights_St               TableStreet = `Howard Heights St`
+	TableStreet_Howard_St                       TableStreet = `Howard St`
+	TableStreet_Howell_Bend_Rd                  TableStreet = `Howell Bend Rd`
+	TableStreet_Howell_Rd                       TableStreet = `Howell Rd`
+	TableStreet_Howell_St                       TableStreet = `Howell St`
+	TableStreet_Hughs_Pl                        TableStreet = `Hughs Pl`
+	TableStreet_Hunt_Club_Ln                    TableStreet = `Hunt Club Ln`
+	TableStreet_Huntcliff_Dr                    TableStreet = `Huntcliff Dr`
+	TableStreet_Hunters_Cv                      TableStreet = `Hunters Cv`
+	TableStreet_Hunters_Rdg                     TableStreet = `Hunters Rdg`
+	TableStreet_Hunters_View                    TableStreet = `Hunters View`
+	TableStreet_Huntington_Ct                   TableStreet = `Huntington Ct`
+	TableStreet_Hwy_293                         TableStreet = `Hwy 293`
+	TableStreet_Hwy_411                         TableStreet = `Hwy 411`
+	TableStreet_Hyatt_Ct                        TableStreet = `Hyatt Ct`
+	TableStreet_I_75                            TableStreet = `I-75`
+	TableStreet_Idlewood_Dr                     TableStreet = `Idlewood Dr`
+	TableStreet_Imperial_Ct                     TableStreet = `Imperial Ct`
+	TableStreet_Independence_Way                TableStreet = `Independence Way`
+	TableStreet_Indian_Hills_Dr                 TableStreet = `Indian Hills Dr`
+	TableStreet_Indian_Hills_Hght               TableStreet = `Indian Hills Hght`
+	TableStreet_Indian_Mounds_Rd                TableStreet = `Indian Mounds Rd`
+	TableStreet_Indian_Ridge_Ct                 TableStreet = `Indian Ridge Ct`
+	TableStreet_Indian_Springs_Dr               TableStreet = `Indian Springs Dr`
+	TableStreet_Indian_Trl                      TableStreet = `Indian Trl`
+	TableStreet_Indian_Valley_Way               TableStreet = `Indian Valley Way`
+	TableStreet_Indian_Woods_Dr                 TableStreet = `Indian Woods Dr`
+	TableStreet_Industrial_Dr                   TableStreet = `Industrial Dr`
+	TableStreet_Industrial_Park_Rd              TableStreet = `Industrial Park Rd`
+	TableStreet_Ingram_Rd                       TableStreet = `Ingram Rd`
+	TableStreet_Iron_Belt_Ct                    TableStreet = `Iron Belt Ct`
+	TableStreet_Iron_Belt_Rd                    TableStreet = `Iron Belt Rd`
+	TableStreet_Iron_Hill_Cv                    TableStreet = `Iron Hill Cv`
+	TableStreet_Iron_Hill_Rd                    TableStreet = `Iron Hill Rd`
+	TableStreet_Iron_Mountain_Rd                TableStreet = `Iron Mountain Rd`
+	TableStreet_Irwin_St                        TableStreet = `Irwin St`
+	TableStreet_Isabella_Ct                     TableStreet = `Isabella Ct`
+	TableStreet_Island_Ford_Rd                  TableStreet = `Island Ford Rd`
+	TableStreet_Island_Mill_Rd                  TableStreet = `Island Mill Rd`
+	TableStreet_Ivan_Evans_Rd                   TableStreet = `Ivan Evans Rd`
+	TableStreet_Ivanhoe_Pl                      TableStreet = `Ivanhoe Pl`
+	TableStreet_Ivy_Chase_Way                   TableStreet = `Ivy Chase Way`
+	TableStreet_Ivy_Ln                          TableStreet = `Ivy Ln`
+	TableStreet_Ivy_Stone_Ct                    TableStreet = `Ivy Stone Ct`
+	TableStreet_J_R_Rd                          TableStreet = `J R Rd`
+	TableStreet_Jackson_Dr                      TableStreet = `Jackson Dr`
+	TableStreet_Jackson_Pl                      TableStreet = `Jackson Pl`
+	TableStreet_Jackson_Rd                      TableStreet = `Jackson Rd`
+	TableStreet_Jackson_Row                     TableStreet = `Jackson Row`
+	TableStreet_Jackson_St                      TableStreet = `Jackson St`
+	TableStreet_Jacob_Dr                        TableStreet = `Jacob Dr`
+	TableStreet_Jacobs_Rd                       TableStreet = `Jacobs Rd`
+	TableStreet_James_Ave                       TableStreet = `James Ave`
+	TableStreet_James_Place                     TableStreet = `James Place`
+	TableStreet_James_Rd                        TableStreet = `James Rd`
+	TableStreet_James_St                        TableStreet = `James St`
+	TableStreet_Jamesport_Ln                    TableStreet = `Jamesport Ln`
+	TableStreet_Janice_Dr                       TableStreet = `Janice Dr`
+	TableStreet_Janice_Ln                       TableStreet = `Janice Ln`
+	TableStreet_Jarrett_Loop                    TableStreet = `Jarrett Loop`
+	TableStreet_Jasmine_Ln                      TableStreet = `Jasmine Ln`
+	TableStreet_Jazmin_Sq                       TableStreet = `Jazmin Sq`
+	TableStreet_Jeffery_Ln                      TableStreet = `Jeffery Ln`
+	TableStreet_Jeffery_Rd                      TableStreet = `Jeffery Rd`
+	TableStreet_Jenkins_Dr                      TableStreet = `Jenkins Dr`
+	TableStreet_Jennifer_Ln                     TableStreet = `Jennifer Ln`
+	TableStreet_Jenny_Ln                        TableStreet = `Jenny Ln`
+	TableStreet_Jewell_Rd                       TableStreet = `Jewell Rd`
+	TableStreet_Jill_Ln                         TableStreet = `Jill Ln`
+	TableStreet_Jim_Dr                          TableStreet = `Jim Dr`
+	TableStreet_Jim_Knight_Rd                   TableStreet = `Jim Knight Rd`
+	TableStreet_Jim_Ln                          TableStreet = `Jim Ln`
+	TableStreet_Jimmie_Nelson_Rd                TableStreet = `Jimmie Nelson Rd`
+	TableStreet_Jockey_Way                      TableStreet = `Jockey Way`
+	TableStreet_Joe_Frank_Harris_Pkwy           TableStreet = `Joe Frank Harris Pkwy`
+	TableStreet_Joe_Lee_Rd                      TableStreet = `Joe Lee Rd`
+	TableStreet_Joe_Stella_Dr                   TableStreet = `Joe Stella Dr`
+	TableStreet_John_Huskins_St                 TableStreet = `John Huskins St`
+	TableStreet_John_Kay_Rd                     TableStreet = `John Kay Rd`
+	TableStreet_Johnson_Cove                    TableStreet = `Johnson Cove`
+	TableStreet_Johnson_Dr                      TableStreet = `Johnson Dr`
+	TableStreet_Johnson_Ln                      TableStreet = `Johnson Ln`
+	TableStreet_Johnson_Mountain_Rd             TableStreet = `Johnson Mountain Rd`
+	TableStreet_Johnson_St                      TableStreet = `Johnson St`
+	TableStreet_Johnston_Row                    TableStreet = `Johnston Row`
+	TableStreet_Jolly_Cir                       TableStreet = `Jolly Cir`
+	TableStreet_Jones_Ct                        TableStreet = `Jones Ct`
+	TableStreet_Jones_Mill_Pl                   TableStreet = `Jones Mill Pl`
+	TableStreet_Jones_Mill_Rd                   TableStreet = `Jones Mill Rd`
+	TableStreet_Jones_Rd                        TableStreet = `Jones Rd`
+	TableStreet_Jones_Slough_Rd                 TableStreet = `Jones Slough Rd`
+	TableStreet_Jones_St                        TableStreet = `Jones St`
+	TableStreet_Jordan_Rd                       TableStreet = `Jordan Rd`
+	TableStreet_Jordan_St                       TableStreet = `Jordan St`
+	TableStreet_Joree_Rd                        TableStreet = `Joree Rd`
+	TableStreet_Josephine_Ave                   TableStreet = `Josephine Ave`
+	TableStreet_Jude_Dr                         TableStreet = `Jude Dr`
+	TableStreet_Judith_Ave                      TableStreet = `Judith Ave`
+	TableStreet_Juliana_Way                     TableStreet = `Juliana Way`
+	TableStreet_Juniper_Ln                      TableStreet = `Juniper Ln`
+	TableStreet_Justine_Rd                      TableStreet = `Justine Rd`
+	TableStreet_Kakki_Ct                        TableStreet = `Kakki Ct`
+	TableStreet_Kaleigh_Ct                      TableStreet = `Kaleigh Ct`
+	TableStreet_Katie_Dr                        TableStreet = `Katie Dr`
+	TableStreet_Katie_Rdg                       TableStreet = `Katie Rdg`
+	TableStreet_Katrina_Dr                      TableStreet = `Katrina Dr`
+	TableStreet_Kay_Rd                          TableStreet = `Kay Rd`
+	TableStreet_Kayla_Ct                        TableStreet = `Kayla Ct`
+	TableStreet_Keeling_Lake_Rd                 TableStreet = `Keeling Lake Rd`
+	TableStreet_Keeling_Mountain_Rd             TableStreet = `Keeling Mountain Rd`
+	TableStreet_Keith_Rd                        TableStreet = `Keith Rd`
+	TableStreet_Kelley_Trl                      TableStreet = `Kelley Trl`
+	TableStreet_Kelli_Clark_Ct                  TableStreet = `Kelli Clark Ct`
+	TableStreet_Kellogg_Cir                     TableStreet = `Kellogg Cir`
+	TableStreet_Kelly_Dr                        TableStreet = `Kelly Dr`
+	TableStreet_Ken_St                          TableStreet = `Ken St`
+	TableStreet_Kent_Dr                         TableStreet = `Kent Dr`
+	TableStreet_Kentucky_Ave                    TableStreet = `Kentucky Ave`
+	TableStreet_Kentucky_Walk                   TableStreet = `Kentucky Walk`
+	TableStreet_Kenwood_Ln                      TableStreet = `Kenwood Ln`
+	TableStreet_Kerry_St                        TableStreet = `Kerry St`
+	TableStreet_Kimberly_Dr                     TableStreet = `Kimberly Dr`
+	TableStreet_Kimsey_Cir                      TableStreet = `Kimsey Cir`
+	TableStreet_Kincannon_Rd                    TableStreet = `Kincannon Rd`
+	TableStreet_King_Rd                         TableStreet = `King Rd`
+	TableStreet_King_St                         TableStreet = `King St`
+	TableStreet_Kings_Camp_Rd                   TableStreet = `Kings Camp Rd`
+	TableStreet_Kings_Dr                        TableStreet = `Kings Dr`
+	TableStreet_Kingston_Hwy                    TableStreet = `Kingston Hwy`
+	TableStreet_Kingston_Pointe_Dr              TableStreet = `Kingston Pointe Dr`
+	TableStreet_Kiowa_Ct                        TableStreet = `Kiowa Ct`
+	TableStreet_Kirby_Ct                        TableStreet = `Kirby Ct`
+	TableStreet_Kirby_Dr                        TableStreet = `Kirby Dr`
+	TableStreet_Kirk_Rd                         TableStreet = `Kirk Rd`
+	TableStreet_Kitchen_Mountain_Rd             TableStreet = `Kitchen Mountain Rd`
+	TableStreet_Kitchens_All                    TableStreet = `Kitchens All`
+	TableStreet_Knight_Dr                       TableStreet = `Knight Dr`
+	TableStreet_Knight_St                       TableStreet = `Knight St`
+	TableStreet_Knight_Way                      TableStreet = `Knight Way`
+	TableStreet_Knollwood_Way                   TableStreet = `Knollwood Way`
+	TableStreet_Knucklesville_Rd                TableStreet = `Knucklesville Rd`
+	TableStreet_Kooweskoowe_Blvd                TableStreet = `Kooweskoowe Blvd`
+	TableStreet_Kortlyn_Pl                      TableStreet = `Kortlyn Pl`
+	TableStreet_Kovells_Dr                      TableStreet = `Kovells Dr`
+	TableStreet_Kristen_Ct                      TableStreet = `Kristen Ct`
+	TableStreet_Kristen_Ln                      TableStreet = `Kristen Ln`
+	TableStreet_Kuhlman_St                      TableStreet = `Kuhlman St`
+	TableStreet_Lacey_Rd                        TableStreet = `Lacey Rd`
+	TableStreet_Ladds_Mountain_Rd               TableStreet = `Ladds Mountain Rd`
+	TableStreet_Lake_Ct                         TableStreet = `Lake Ct`
+	TableStreet_Lake_Dr                         TableStreet = `Lake Dr`
+	TableStreet_Lake_Drive_No_1                 TableStreet = `Lake Drive No 1`
+	TableStreet_Lake_Eagle_Ct                   TableStreet = `Lake Eagle Ct`
+	TableStreet_Lake_Haven_Dr                   TableStreet = `Lake Haven Dr`
+	TableStreet_Lake_Marguerite_Rd              TableStreet = `Lake Marguerite Rd`
+	TableStreet_Lake_Overlook_Dr                TableStreet = `Lake Overlook Dr`
+	TableStreet_Lake_Point_Dr                   TableStreet = `Lake Point Dr`
+	TableStreet_Lake_Top_Dr                     TableStreet = `Lake Top Dr`
+	TableStreet_Lakeport                        TableStreet = `Lakeport`
+	TableStreet_Lakeshore_Cir                   TableStreet = `Lakeshore Cir`
+	TableStreet_Lakeside_Trl                    TableStreet = `Lakeside Trl`
+	TableStreet_Lakeside_Way                    TableStreet = `Lakeside Way`
+	TableStreet_Lakeview_Ct                     TableStreet = `Lakeview Ct`
+	TableStreet_Lakeview_Drive_No_1             TableStreet = `Lakeview Drive No 1`
+	TableStreet_Lakeview_Drive_No_2             TableStreet = `Lakeview Drive No 2`
+	TableStreet_Lakeview_Drive_No_3             TableStreet = `Lakeview Drive No 3`
+	TableStreet_Lakewood_Ct                     TableStreet = `Lakewood Ct`
+	TableStreet_Lamplighter_Cv                  TableStreet = `Lamplighter Cv`
+	TableStreet_Lancelot_Ct                     TableStreet = `Lancelot Ct`
+	TableStreet_Lancer_Ct                       TableStreet = `Lancer Ct`
+	TableStreet_Landers_Dr                      TableStreet = `Landers Dr`
+	TableStreet_Landers_Rd                      TableStreet = `Landers Rd`
+	TableStreet_Lanham_Field_Rd                 TableStreet = `Lanham Field Rd`
+	TableStreet_Lanham_Rd                       TableStreet = `Lanham Rd`
+	TableStreet_Lanier_Dr                       TableStreet = `Lanier Dr`
+	TableStreet_Lantern_Cir                     TableStreet = `Lantern Cir`
+	TableStreet_Lantern_Light_Trl               TableStreet = `Lantern Light Trl`
+	TableStreet_Lark_Cir                        TableStreet = `Lark Cir`
+	TableStreet_Larkspur_Ln                     TableStreet = `Larkspur Ln`
+	TableStreet_Larkspur_Rd                     TableStreet = `Larkspur Rd`
+	TableStreet_Larkwood_Cir                    TableStreet = `Larkwood Cir`
+	TableStreet_Larsen_Rdg                      TableStreet = `Larsen Rdg`
+	TableStreet_Latimer_Ln                      TableStreet = `Latimer Ln`
+	TableStreet_Latimer_Rd                      TableStreet = `Latimer Rd`
+	TableStreet_Laura_Dr                        TableStreet = `Laura Dr`
+	TableStreet_Laurel_Cv                       TableStreet = `Laurel Cv`
+	TableStreet_Laurel_Dr                       TableStreet = `Laurel Dr`
+	TableStreet_Laurel_Trc                      TableStreet = `Laurel Trc`
+	TableStreet_Laurel_Way                      TableStreet = `Laurel Way`
+	TableStreet_Laurelwood_Ln                   TableStreet = `Laurelwood Ln`
+	TableStreet_Lauren_Ln                       TableStreet = `Lauren Ln`
+	TableStreet_Lavanes_Way                     TableStreet = `Lavanes Way`
+	TableStreet_Law_Rd                          TableStreet = `Law Rd`
+	TableStreet_Lawrence_St                     TableStreet = `Lawrence St`
+	TableStreet_Lazy_Water_Dr                   TableStreet = `Lazy Water Dr`
+	TableStreet_Lead_Mountain_Rd                TableStreet = `Lead Mountain Rd`
+	TableStreet_Leake_St                        TableStreet = `Leake St`
+	TableStreet_Ledford_Ln                      TableStreet = `Ledford Ln`
+	TableStreet_Lee_Ln                          TableStreet = `Lee Ln`
+	TableStreet_Lee_Rd                          TableStreet = `Lee Rd`
+	TableStreet_Lee_St                          TableStreet = `Lee St`
+	TableStreet_Legacy_Way                      TableStreet = `Legacy Way`
+	TableStreet_Legion_St                       TableStreet = `Legion St`
+	TableStreet_Leila_Cv                        TableStreet = `Leila Cv`
+	TableStreet_Lennox_Park_Ave                 TableStreet = `Lennox Park Ave`
+	TableStreet_Lenox_Dr                        TableStreet = `Lenox Dr`
+	TableStreet_Leonard_Dr                      TableStreet = `Leonard Dr`
+	TableStreet_Leslie_Cv                       TableStreet = `Leslie Cv`
+	TableStreet_Lewis_Dr                        TableStreet = `Lewis Dr`
+	TableStreet_Lewis_Farm_Rd                   TableStreet = `Lewis Farm Rd`
+	TableStreet_Lewis_Rd                        TableStreet = `Lewis Rd`
+	TableStreet_Lewis_Way                       TableStreet = `Lewis Way`
+	TableStreet_Lexington_Ct                    TableStreet = `Lexington Ct`
+	TableStreet_Lexy_Way                        TableStreet = `Lexy Way`
+	TableStreet_Liberty_Crossing_Dr             TableStreet = `Liberty Crossing Dr`
+	TableStreet_Liberty_Dr                      TableStreet = `Liberty Dr`
+	TableStreet_Liberty_Square_Dr               TableStreet = `Liberty Square Dr`
+	TableStreet_Lily_Way                        TableStreet = `Lily Way`
+	TableStreet_Limerick_Ct                     TableStreet = `Limerick Ct`
+	TableStreet_Linda_Rd                        TableStreet = `Linda Rd`
+	TableStreet_Lindsey_Dr                      TableStreet = `Lindsey Dr`
+	TableStreet_Lingerfelt_Ln                   TableStreet = `Lingerfelt Ln`
+	TableStreet_Linwood_Rd                      TableStreet = `Linwood Rd`
+	TableStreet_Lipscomb_Cir                    TableStreet = `Lipscomb Cir`
+	TableStreet_Litchfield_St                   TableStreet = `Litchfield St`
+	TableStreet_Little_Gate_Way                 TableStreet = `Little Gate Way`
+	TableStreet_Little_John_Ln                  TableStreet = `Little John Ln`
+	TableStreet_Little_John_Trl                 TableStreet = `Little John Trl`
+	TableStreet_Little_St                       TableStreet = `Little St`
+	TableStreet_Little_Valley_Rd                TableStreet = `Little Valley Rd`
+	TableStreet_Littlefield_Rd                  TableStreet = `Littlefield Rd`
+	TableStreet_Live_Oak_Run                    TableStreet = `Live Oak Run`
+	TableStreet_Livsey_Rd                       TableStreet = `Livsey Rd`
+	TableStreet_Lodge_Rd                        TableStreet = `Lodge Rd`
+	TableStreet_Lois_Ln                         TableStreet = `Lois Ln`
+	TableStreet_Lois_Rd                         TableStreet = `Lois Rd`
+	TableStreet_London_Ct                       TableStreet = `London Ct`
+	TableStreet_Londonderry_Way                 TableStreet = `Londonderry Way`
+	TableStreet_Long_Branch_Ct                  TableStreet = `Long Branch Ct`
+	TableStreet_Long_Rd                         TableStreet = `Long Rd`
+	TableStreet_Long_Trl                        TableStreet = `Long Trl`
+	TableStreet_Longview_Pte                    TableStreet = `Longview Pte`
+	TableStreet_Lovell_St                       TableStreet = `Lovell St`
+	TableStreet_Low_Ct                          TableStreet = `Low Ct`
+	TableStreet_Lowery_Rd                       TableStreet = `Lowery Rd`
+	TableStreet_Lowry_Way                       TableStreet = `Lowry Way`
+	TableStreet_Lucas_Ln                        TableStreet = `Lucas Ln`
+	TableStreet_Lucas_Rd                        TableStreet = `Lucas Rd`
+	TableStreet_Lucille_Rd                      TableStreet = `Lucille Rd`
+	TableStreet_Luckie_St                       TableStreet = `Luckie St`
+	TableStreet_Luke_Ln                         TableStreet = `Luke Ln`
+	TableStreet_Lumpkin_Dr                      TableStreet = `Lumpkin Dr`
+	TableStreet_Lusk_Ave                        TableStreet = `Lusk Ave`
+	TableStreet_Luther_Knight_Rd                TableStreet = `Luther Knight Rd`
+	TableStreet_Luwanda_Trl                     TableStreet = `Luwanda Trl`
+	TableStreet_Lynch_St                        TableStreet = `Lynch St`
+	TableStreet_Mac_Johnson_Rd                  TableStreet = `Mac Johnson Rd`
+	TableStreet_Macedonia_Cemetary_Rd           TableStreet = `Macedonia Cemetary Rd`
+	TableStreet_Macedonia_Rd                    TableStreet = `Macedonia Rd`
+	TableStreet_Macra_Dr                        TableStreet = `Macra Dr`
+	TableStreet_Madden_Rd                       TableStreet = `Madden Rd`
+	TableStreet_Madden_St                       TableStreet = `Madden St`
+	TableStreet_Maddox_Rd                       TableStreet = `Maddox Rd`
+	TableStreet_Madison_Cir                     TableStreet = `Madison Cir`
+	TableStreet_Madison_Ct                      TableStreet = `Madison Ct`
+	TableStreet_Madison_Ln                      TableStreet = `Madison Ln`
+	TableStreet_Madison_Pl                      TableStreet = `Madison Pl`
+	TableStreet_Madison_Way                     TableStreet = `Madison Way`
+	TableStreet_Magnolia_Ct                     TableStreet = `Magnolia Ct`
+	TableStreet_Magnolia_Dr                     TableStreet = `Magnolia Dr`
+	TableStreet_Magnolia_Farm_Rd                TableStreet = `Magnolia Farm Rd`
+	TableStreet_Mahan_Ln                        TableStreet = `Mahan Ln`
+	TableStreet_Mahan_Rd                        TableStreet = `Mahan Rd`
+	TableStreet_Main_Lake_Dr                    TableStreet = `Main Lake Dr`
+	TableStreet_Main_St                         TableStreet = `Main St`
+	TableStreet_Mallet_Pte                      TableStreet = `Mallet Pte`
+	TableStreet_Mallory_Ct                      TableStreet = `Mallory Ct`
+	TableStreet_Mallory_Dr                      TableStreet = `Mallory Dr`
+	TableStreet_Manning_Mill_Rd                 TableStreet = `Manning Mill Rd`
+	TableStreet_Manning_Mill_Way                TableStreet = `Manning Mill Way`
+	TableStreet_Manning_Rd                      TableStreet = `Manning Rd`
+	TableStreet_Manor_House_Ln                  TableStreet = `Manor House Ln`
+	TableStreet_Manor_Way                       TableStreet = `Manor Way`
+	TableStreet_Mansfield_Rd                    TableStreet = `Mansfield Rd`
+	TableStreet_Maple_Ave                       TableStreet = `Maple Ave`
+	TableStreet_Maple_Dr                        TableStreet = `Maple Dr`
+	TableStreet_Maple_Grove_Dr                  TableStreet = `Maple Grove Dr`
+	TableStreet_Maple_Ln                        TableStreet = `Maple Ln`
+	TableStreet_Maple_Ridge_Dr                  TableStreet = `Maple Ridge Dr`
+	TableStreet_Maple_St                        TableStreet = `Maple St`
+	TableStreet_Maplewood_Pl                    TableStreet = `Maplewood Pl`
+	TableStreet_Maplewood_Trl                   TableStreet = `Maplewood Trl`
+	TableStreet_Marguerite_Dr                   TableStreet = `Marguerite Dr`
+	TableStreet_Marina_Rd                       TableStreet = `Marina Rd`
+	TableStreet_Mariner_Way                     TableStreet = `Mariner Way`
+	TableStreet_Mark_Trl                        TableStreet = `Mark Trl`
+	TableStreet_Market_Place_Blvd               TableStreet = `Market Place Blvd`
+	TableStreet_Marlin_Ln                       TableStreet = `Marlin Ln`
+	TableStreet_Marr_Rd                         TableStreet = `Marr Rd`
+	TableStreet_Marshwood_Glen                  TableStreet = `Marshwood Glen`
+	TableStreet_Martha_Ct                       TableStreet = `Martha Ct`
+	TableStreet_Marthas_Pl                      TableStreet = `Marthas Pl`
+	TableStreet_Marthas_Walk                    TableStreet = `Marthas Walk`
+	TableStreet_Martin_Cir                      TableStreet = `Martin Cir`
+	TableStreet_Martin_Loop                     TableStreet = `Martin Loop`
+	TableStreet_Martin_Luther_King_Jr_Cir       TableStreet = `Martin Luther King Jr Cir`
+	TableStreet_Martin_Luther_King_Jr_Dr        TableStreet = `Martin Luther King Jr Dr`
+	TableStreet_Martin_Rd                       TableStreet = `Martin Rd`
+	TableStreet_Mary_Grace_Ln                   TableStreet = `Mary Grace Ln`
+	TableStreet_Mary_Ln                         TableStreet = `Mary Ln`
+	TableStreet_Mary_St                         TableStreet = `Mary St`
+	TableStreet_Massell_Dr                      TableStreet = `Massell Dr`
+	TableStreet_Massingale_Rd                   TableStreet = `Massingale Rd`
+	TableStreet_Mathews_Rd                      TableStreet = `Mathews Rd`
+	TableStreet_Maude_White_Rd                  TableStreet = `Maude White Rd`
+	TableStreet_Mauldin_Cir                     TableStreet = `Mauldin Cir`
+	TableStreet_Maxwell_Rd                      TableStreet = `Maxwell Rd`
+	TableStreet_May_St                          TableStreet = `May St`
+	TableStreet_Maybelle_St                     TableStreet = `Maybelle St`
+	TableStreet_Mayfield_Rd                     TableStreet = `Mayfield Rd`
+	TableStreet_Mayflower_Cir                   TableStreet = `Mayflower Cir`
+	TableStreet_Mayflower_St                    TableStreet = `Mayflower St`
+	TableStreet_Mayflower_Way                   TableStreet = `Mayflower Way`
+	TableStreet_Mays_Rd                         TableStreet = `Mays Rd`
+	TableStreet_McCanless_St                    TableStreet = `McCanless St`
+	TableStreet_McClure_Dr                      TableStreet = `McClure Dr`
+	TableStreet_McCormick_Rd                    TableStreet = `McCormick Rd`
+	TableStreet_McCoy_Rd                        TableStreet = `McCoy Rd`
+	TableStreet_McElreath_St                    TableStreet = `McElreath St`
+	TableStreet_McEver_St                       TableStreet = `McEver St`
+	TableStreet_McKaskey_Creek_Rd               TableStreet = `McKaskey Creek Rd`
+	TableStreet_McKay_Dr                        TableStreet = `McKay Dr`
+	TableStreet_McKelvey_Ct                     TableStreet = `McKelvey Ct`
+	TableStreet_McKenna_Rd                      TableStreet = `McKenna Rd`
+	TableStreet_McKenzie_Cir                    TableStreet = `McKenzie Cir`
+	TableStreet_McKenzie_St                     TableStreet = `McKenzie St`
+	TableStreet_McKinley_Ct                     TableStreet = `McKinley Ct`
+	TableStreet_McKinney_Campground_Rd          TableStreet = `McKinney Campground Rd`
+	TableStreet_McMillan_Rd                     TableStreet = `McMillan Rd`
+	TableStreet_McStotts_Rd                     TableStreet = `McStotts Rd`
+	TableStreet_McTier_Cir                      TableStreet = `McTier Cir`
+	TableStreet_Meadow_Ln                       TableStreet = `Meadow Ln`
+	TableStreet_Meadowbridge_Dr                 TableStreet = `Meadowbridge Dr`
+	TableStreet_Meadowview_Cir                  TableStreet = `Meadowview Cir`
+	TableStreet_Meadowview_Rd                   TableStreet = `Meadowview Rd`
+	TableStreet_Medical_Dr                      TableStreet = `Medical Dr`
+	TableStreet_Melhana_Dr                      TableStreet = `Melhana Dr`
+	TableStreet_Melone_Dr                       TableStreet = `Melone Dr`
+	TableStreet_Mercer_Dr                       TableStreet = `Mercer Dr`
+	TableStreet_Mercer_Ln                       TableStreet = `Mercer Ln`
+	TableStreet_Merchants_Square_Dr             TableStreet = `Merchants Square Dr`
+	TableStreet_Michael_Ln                      TableStreet = `Michael Ln`
+	TableStreet_Michigan_Ave                    TableStreet = `Michigan Ave`
+	TableStreet_Middle_Ct                       TableStreet = `Middle Ct`
+	TableStreet_Middlebrook_Dr                  TableStreet = `Middlebrook Dr`
+	TableStreet_Middleton_Ct                    TableStreet = `Middleton Ct`
+	TableStreet_Milam_Bridge_Rd                 TableStreet = `Milam Bridge Rd`
+	TableStreet_Milam_Cir                       TableStreet = `Milam Cir`
+	TableStreet_Milam_St                        TableStreet = `Milam St`
+	TableStreet_Miles_Dr                        TableStreet = `Miles Dr`
+	TableStreet_Mill_Creek_Rd                   TableStreet = `Mill Creek Rd`
+	TableStreet_Mill_Rock_Dr                    TableStreet = `Mill Rock Dr`
+	TableStreet_Mill_View_Ct                    TableStreet = `Mill View Ct`
+	TableStreet_Miller_Farm_Rd                  TableStreet = `Miller Farm Rd`
+	TableStreet_Millers_Way                     TableStreet = `Millers Way`
+	TableStreet_Millstone_Pt                    TableStreet = `Millstone Pt`
+	TableStreet_Millstream_Ct                   TableStreet = `Millstream Ct`
+	TableStreet_Milner_Rd                       TableStreet = `Milner Rd`
+	TableStreet_Milner_St                       TableStreet = `Milner St`
+	TableStreet_Miltons_Walk                    TableStreet = `Miltons Walk`
+	TableStreet_Mimosa_Ln                       TableStreet = `Mimosa Ln`
+	TableStreet_Mimosa_Ter                      TableStreet = `Mimosa Ter`
+	TableStreet_Mineral_Museum_Dr               TableStreet = `Mineral Museum Dr`
+	TableStreet_Miners_Pt                       TableStreet = `Miners Pt`
+	TableStreet_Mint_Rd                         TableStreet = `Mint Rd`
+	TableStreet_Mirror_Lake_Dr                  TableStreet = `Mirror Lake Dr`
+	TableStreet_Mission_Hills_Dr                TableStreet = `Mission Hills Dr`
+	TableStreet_Mission_Mountain_Rd             TableStreet = `Mission Mountain Rd`
+	TableStreet_Mission_Rd                      TableStreet = `Mission Rd`
+	TableStreet_Mission_Ridge_Dr                TableStreet = `Mission Ridge Dr`
+	TableStreet_Misty_Hollow_Ct                 TableStreet = `Misty Hollow Ct`
+	TableStreet_Misty_Ridge_Dr                  TableStreet = `Misty Ridge Dr`
+	TableStreet_Misty_Valley_Dr                 TableStreet = `Misty Valley Dr`
+	TableStreet_Mitchell_Ave                    TableStreet = `Mitchell Ave`
+	TableStreet_Mitchell_Rd                     TableStreet = `Mitchell Rd`
+	TableStreet_Mockingbird_Dr                  TableStreet = `Mockingbird Dr`
+	TableStreet_Mohawk_Dr                       TableStreet = `Mohawk Dr`
+	TableStreet_Monroe_Xing                     TableStreet = `Monroe Xing`
+	TableStreet_Montclair_Ct                    TableStreet = `Montclair Ct`
+	TableStreet_Montgomery_St                   TableStreet = `Montgomery St`
+	TableStreet_Montview_Cir                    TableStreet = `Montview Cir`
+	TableStreet_Moody_St                        TableStreet = `Moody St`
+	TableStreet_Moonlight_Dr                    TableStreet = `Moonlight Dr`
+	TableStreet_Moore_Rd                        TableStreet = `Moore Rd`
+	TableStreet_Moore_St                        TableStreet = `Moore St`
+	TableStreet_Moores_Spring_Rd                TableStreet = `Moores Spring Rd`
+	TableStreet_Morgan_Ave                      TableStreet = `Morgan Ave`
+	TableStreet_Morgan_Dr                       TableStreet = `Morgan Dr`
+	TableStreet_Moriah_Way                      TableStreet = `Moriah Way`
+	TableStreet_Morris_Dr                       TableStreet = `Morris Dr`
+	TableStreet_Morris_Rd                       TableStreet = `Morris Rd`
+	TableStreet_Mosley_Dr                       TableStreet = `Mosley Dr`
+	TableStreet_Moss_Landing_Rd                 TableStreet = `Moss Landing Rd`
+	TableStreet_Moss_Ln                         TableStreet = `Moss Ln`
+	TableStreet_Moss_Way                        TableStreet = `Moss Way`
+	TableStreet_Mossy_Rock_Ln                   TableStreet = `Mossy Rock Ln`
+	TableStreet_Mostellers_Mill_Rd              TableStreet = `Mostellers Mill Rd`
+	TableStreet_Motorsports_Dr                  TableStreet = `Motorsports Dr`
+	TableStreet_Mount_Olive_St                  TableStreet = `Mount Olive St`
+	TableStreet_Mount_Pleasant_Rd               TableStreet = `Mount Pleasant Rd`
+	TableStreet_Mountain_Breeze_Rd              TableStreet = `Mountain Breeze Rd`
+	TableStreet_Mountain_Chase_Dr               TableStreet = `Mountain Chase Dr`
+	TableStreet_Mountain_Creek_Trl              TableStreet = `Mountain Creek Trl`
+	TableStreet_Mountain_Ridge_Dr               TableStreet = `Mountain Ridge Dr`
+	TableStreet_Mountain_Ridge_Rd               TableStreet = `Mountain Ridge Rd`
+	TableStreet_Mountain_Trail_Ct               TableStreet = `Mountain Trail Ct`
+	TableStreet_Mountain_View_Ct                TableStreet = `Mountain View Ct`
+	TableStreet_Mountain_View_Dr                TableStreet = `Mountain View Dr`
+	TableStreet_Mountain_View_Rd                TableStreet = `Mountain View Rd`
+	TableStreet_Mountainbrook_Dr                TableStreet = `Mountainbrook Dr`
+	TableStreet_Muirfield_Walk                  TableStreet = `Muirfield Walk`
+	TableStreet_Mulberry_Ln                     TableStreet = `Mulberry Ln`
+	TableStreet_Mulberry_Way                    TableStreet = `Mulberry Way`
+	TableStreet_Mulinix_Rd                      TableStreet = `Mulinix Rd`
+	TableStreet_Mull_St                         TableStreet = `Mull St`
+	TableStreet_Mullinax_Rd                     TableStreet = `Mullinax Rd`
+	TableStreet_Mundy_Rd                        TableStreet = `Mundy Rd`
+	TableStreet_Mundy_St                        TableStreet = `Mundy St`
+	TableStreet_Murphy_Ln                       TableStreet = `Murphy Ln`
+	TableStreet_Murray_Ave                      TableStreet = `Murray Ave`
+	TableStreet_N_Bartow_St                     TableStreet = `N Bartow St`
+	TableStreet_N_Cass_St                       TableStreet = `N Cass St`
+	TableStreet_N_Central_Ave                   TableStreet = `N Central Ave`
+	TableStreet_N_Dixie_Ave                     TableStreet = `N Dixie Ave`
+	TableStreet_N_Erwin_St                      TableStreet = `N Erwin St`
+	TableStreet_N_Franklin_St                   TableStreet = `N Franklin St`
+	TableStreet_N_Gilmer_St                     TableStreet = `N Gilmer St`
+	TableStreet_N_Main_St                       TableStreet = `N Main St`
+	TableStreet_N_Morningside_Dr                TableStreet = `N Morningside Dr`
+	TableStreet_N_Museum_Dr                     TableStreet = `N Museum Dr`
+	TableStreet_N_Public_Sq                     TableStreet = `N Public Sq`
+	TableStreet_N_Railroad_St                   TableStreet = `N Railroad St`
+	TableStreet_N_Tennessee_St                  TableStreet = `N Tennessee St`
+	TableStreet_N_Wall_St                       TableStreet = `N Wall St`
+	TableStreet_Nally_Rd                        TableStreet = `Nally Rd`
+	TableStreet_Natalie_Dr                      TableStreet = `Natalie Dr`
+	TableStreet_Natchi_Trl                      TableStreet = `Natchi Trl`
+	TableStreet_Navajo_Ln                       TableStreet = `Navajo Ln`
+	TableStreet_Navajo_Trl                      TableStreet = `Navajo Trl`
+	TableStreet_Navigation_Pte                  TableStreet = `Navigation Pte`
+	TableStreet_Needle_Point_Rdg                TableStreet = `Needle Point Rdg`
+	TableStreet_Neel_St                         TableStreet = `Neel St`
+	TableStreet_Nelson_St                       TableStreet = `Nelson St`
+	TableStreet_New_Hope_Church_Rd              TableStreet = `New Hope Church Rd`
+	TableStreet_Nicholas_Ln                     TableStreet = `Nicholas Ln`
+	TableStreet_Noble_Hill                      TableStreet = `Noble Hill`
+	TableStreet_Noble_St                        TableStreet = `Noble St`
+	TableStreet_Noland_St                       TableStreet = `Noland St`
+	TableStreet_Norland_Ct                      TableStreet = `Norland Ct`
+	TableStreet_North_Ave                       TableStreet = `North Ave`
+	TableStreet_North_Dr                        TableStreet = `North Dr`
+	TableStreet_North_Hampton_Dr                TableStreet = `North Hampton Dr`
+	TableStreet_North_Oaks_Cir                  TableStreet = `North Oaks Cir`
+	TableStreet_North_Point_Dr                  TableStreet = `North Point Dr`
+	TableStreet_North_Ridge_Cir                 TableStreet = `North Ridge Cir`
+	TableStreet_North_Ridge_Ct                  TableStreet = `North Ridge Ct`
+	TableStreet_North_Ridge_Dr                  TableStreet = `North Ridge Dr`
+	TableStreet_North_Ridge_Pt                  TableStreet = `North Ridge Pt`
+	TableStreet_North_Valley_Rd                 TableStreet = `North Valley Rd`
+	TableStreet_North_Village_Cir               TableStreet = `North Village Cir`
+	TableStreet_North_Wesley_Rdg                TableStreet = `North Wesley Rdg`
+	TableStreet_North_Woods_Dr                  TableStreet = `North Woods Dr`
+	TableStreet_Northpoint_Pkwy                 TableStreet = `Northpoint Pkwy`
+	TableStreet_Northside_Pkwy                  TableStreet = `Northside Pkwy`
+	TableStreet_Norton_Rd                       TableStreet = `Norton Rd`
+	TableStreet_Nottingham_Dr                   TableStreet = `Nottingham Dr`
+	TableStreet_Nottingham_Way                  TableStreet = `Nottingham Way`
+	TableStreet_November_Ln                     TableStreet = `November Ln`
+	TableStreet_Oak_Beach_Dr                    TableStreet = `Oak Beach Dr`
+	TableStreet_Oak_Ct                          TableStreet = `Oak Ct`
+	TableStreet_Oak_Dr                          TableStreet = `Oak Dr`
+	TableStreet_Oak_Farm_Dr                     TableStreet = `Oak Farm Dr`
+	TableStreet_Oak_Grove_Ct                    TableStreet = `Oak Grove Ct`
+	TableStreet_Oak_Grove_Ln                    TableStreet = `Oak Grove Ln`
+	TableStreet_Oak_Grove_Rd                    TableStreet = `Oak Grove Rd`
+	TableStreet_Oak_Hill_Cir                    TableStreet = `Oak Hill Cir`
+	TableStreet_Oak_Hill_Dr                     TableStreet = `Oak Hill Dr`
+	TableStreet_Oak_Hill_Ln                     TableStreet = `Oak Hill Ln`
+	TableStreet_Oak_Hill_Way                    TableStreet = `Oak Hill Way`
+	TableStreet_Oak_Hollow_Rd                   TableStreet = `Oak Hollow Rd`
+	TableStreet_Oak_Leaf_Dr                     TableStreet = `Oak Leaf Dr`
+	TableStreet_Oak_Ln                          TableStreet = `Oak Ln`
+	TableStreet_Oak_St                          TableStreet = `Oak St`
+	TableStreet_Oak_Street_No_1                 TableStreet = `Oak Street No 1`
+	TableStreet_Oak_Street_No_2                 TableStreet = `Oak Street No 2`
+	TableStreet_Oak_Way                         TableStreet = `Oak Way`
+	TableStreet_Oakbrook_Dr                     TableStreet = `Oakbrook Dr`
+	TableStreet_Oakdale_Dr                      TableStreet = `Oakdale Dr`
+	TableStreet_Oakland_St                      TableStreet = `Oakland St`
+	TableStreet_Oakridge_Dr                     TableStreet = `Oakridge Dr`
+	TableStreet_Oakside_Ct                      TableStreet = `Oakside Ct`
+	TableStreet_Oakside_Trl                     TableStreet = `Oakside Trl`
+	TableStreet_Oakwood_Way                     TableStreet = `Oakwood Way`
+	TableStreet_Ohio_Ave                        TableStreet = `Ohio Ave`
+	TableStreet_Ohio_St                         TableStreet = `Ohio St`
+	TableStreet_Old_140_Hwy                     TableStreet = `Old 140 Hwy`
+	TableStreet_Old_41_Hwy                      TableStreet = `Old 41 Hwy`
+	TableStreet_Old_Alabama_Rd                  TableStreet = `Old Alabama Rd`
+	TableStreet_Old_Alabama_Spur                TableStreet = `Old Alabama Spur`
+	TableStreet_Old_Allatoona_Rd                TableStreet = `Old Allatoona Rd`
+	TableStreet_Old_Barn_Way                    TableStreet = `Old Barn Way`
+	TableStreet_Old_Bells_Ferry_Rd              TableStreet = `Old Bells Ferry Rd`
+	TableStreet_Old_C_C_C_Rd                    TableStreet = `Old C C C Rd`
+	TableStreet_Old_Canton_Rd                   TableStreet = `Old Canton Rd`
+	TableStreet_Old_Cassville_White_Rd          TableStreet = `Old Cassville White Rd`
+	TableStreet_Old_Cline_Smith_Rd              TableStreet = `Old Cline Smith Rd`
+	TableStreet_Old_Dallas_Hwy                  TableStreet = `Old Dallas Hwy`
+	TableStreet_Old_Dallas_Rd                   TableStreet = `Old Dallas Rd`
+	TableStreet_Old_Dixie_Hwy                   TableStreet = `Old Dixie Hwy`
+	TableStreet_Old_Farm_Rd                     TableStreet = `Old Farm Rd`
+	TableStreet_Old_Field_Rd                    TableStreet = `Old Field Rd`
+	TableStreet_Old_Furnace_Rd                  TableStreet = `Old Furnace Rd`
+	TableStreet_Old_Gilliam_Springs_Rd          TableStreet = `Old Gilliam Springs Rd`
+	TableStreet_Old_Goodie_Mountain_Rd          TableStreet = `Old Goodie Mountain Rd`
+	TableStreet_Old_Grassdale_Rd                TableStreet = `Old Grassdale Rd`
+	TableStreet_Old_Hall_Station_Rd             TableStreet = `Old Hall Station Rd`
+	TableStreet_Old_Hardin_Bridge_Rd            TableStreet = `Old Hardin Bridge Rd`
+	TableStreet_Old_Hemlock_Cv                  TableStreet = `Old Hemlock Cv`
+	TableStreet_Old_Home_Place_Rd               TableStreet = `Old Home Place Rd`
+	TableStreet_Old_Jones_Mill_Rd               TableStreet = `Old Jones Mill Rd`
+	TableStreet_Old_Macedonia_Campground_Rd     TableStreet = `Old Macedonia Campground Rd`
+	TableStreet_Old_Martin_Rd                   TableStreet = `Old Martin Rd`
+	TableStreet_Old_McKaskey_Creek_Rd           TableStreet = `Old McKaskey Creek Rd`
+	TableStreet_Old_Mill_Farm_Rd                TableStreet = `Old Mill Farm Rd`
+	TableStreet_Old_Mill_Rd                     TableStreet = `Old Mill Rd`
+	TableStreet_Old_Old_Alabama_Rd              TableStreet = `Old Old Alabama Rd`
+	TableStreet_Old_Post_Rd                     TableStreet = `Old Post Rd`
+	TableStreet_Old_River_Rd                    TableStreet = `Old River Rd`
+	TableStreet_Old_Rome_Rd                     TableStreet = `Old Rome Rd`
+	TableStreet_Old_Roving_Rd                   TableStreet = `Old Roving Rd`
+	TableStreet_Old_Rudy_York_Rd                TableStreet = `Old Rudy York Rd`
+	TableStreet_Old_Sandtown_Rd                 TableStreet = `Old Sandtown Rd`
+	TableStreet_Old_Spring_Place_Rd             TableStreet = `Old Spring Place Rd`
+	TableStreet_Old_Stilesboro_Rd               TableStreet = `Old Stilesboro Rd`
+	TableStreet_Old_Tennessee_Hwy               TableStreet = `Old Tennessee Hwy`
+	TableStreet_Old_Tennessee_Rd                TableStreet = `Old Tennessee Rd`
+	TableStreet_Old_Wade_Rd                     TableStreet = `Old Wade Rd`
+	TableStreet_Olive_Vine_Church_Rd            TableStreet = `Olive Vine Church Rd`
+	TableStreet_Opal_St                         TableStreet = `Opal St`
+	TableStreet_Orchard_Rd                      TableStreet = `Orchard Rd`
+	TableStreet_Ore_Mine_Rd                     TableStreet = `Ore Mine Rd`
+	TableStreet_Oriole_Dr                       TableStreet = `Oriole Dr`
+	TableStreet_Otting_Dr                       TableStreet = `Otting Dr`
+	TableStreet_Overlook_Cir                    TableStreet = `Overlook Cir`
+	TableStreet_Overlook_Way                    TableStreet = `Overlook Way`
+	TableStreet_Owensby_Ln                      TableStreet = `Owensby Ln`
+	TableStreet_Oxford_Ct                       TableStreet = `Oxford Ct`
+	TableStreet_Oxford_Dr                       TableStreet = `Oxford Dr`
+	TableStreet_Oxford_Ln                       TableStreet = `Oxford Ln`
+	TableStreet_Oxford_Mill_Way                 TableStreet = `Oxford Mill Way`
+	TableStreet_P_M_B_Young_Rd                  TableStreet = `P M B Young Rd`
+	TableStreet_Paddock_Way                     TableStreet = `Paddock Way`
+	TableStreet_Padgett_Rd                      TableStreet = `Padgett Rd`
+	TableStreet_Paga_Mine_Rd                    TableStreet = `Paga Mine Rd`
+	TableStreet_Paige_Dr                        TableStreet = `Paige Dr`
+	TableStreet_Paige_St                        TableStreet = `Paige St`
+	TableStreet_Palmer_Rd                       TableStreet = `Palmer Rd`
+	TableStreet_Pam_Cir                         TableStreet = `Pam Cir`
+	TableStreet_Park_Ct                         TableStreet = `Park Ct`
+	TableStreet_Park_Marina_Rd                  TableStreet = `Park Marina Rd`
+	TableStreet_Park_Place_Dr                   TableStreet = `Park Place Dr`
+	TableStreet_Park_St                         TableStreet = `Park St`
+	TableStreet_Parker_Ave                      TableStreet = `Parker Ave`
+	TableStreet_Parker_Wood_Rd                  TableStreet = `Parker Wood Rd`
+	TableStreet_Parkside_View                   TableStreet = `Parkside View`
+	TableStreet_Parkview_Dr                     TableStreet = `Parkview Dr`
+	TableStreet_Parmenter_St                    TableStreet = `Parmenter St`
+	TableStreet_Parr_Wade_Rd                    TableStreet = `Parr Wade Rd`
+	TableStreet_Pasley_Rd                       TableStreet = `Pasley Rd`
+	TableStreet_Pathfinder_St                   TableStreet = `Pathfinder St`
+	TableStreet_Patricia_Dr                     TableStreet = `Patricia Dr`
+	TableStreet_Patterson_Dr                    TableStreet = `Patterson Dr`
+	TableStreet_Patterson_Ln                    TableStreet = `Patterson Ln`
+	TableStreet_Patton_Ln                       TableStreet = `Patton Ln`
+	TableStreet_Patton_St                       TableStreet = `Patton St`
+	TableStreet_Pawnee_Trl                      TableStreet = `Pawnee Trl`
+	TableStreet_Peace_Tree_Ln                   TableStreet = `Peace Tree Ln`
+	TableStreet_Peach_Blossom_Cir               TableStreet = `Peach Blossom Cir`
+	TableStreet_Peachtree_St                    TableStreet = `Peachtree St`
+	TableStreet_Pearl_Ln                        TableStreet = `Pearl Ln`
+	TableStreet_Pearl_St                        TableStreet = `Pearl St`
+	TableStreet_Pearson_Rd                      TableStreet = `Pearson Rd`
+	TableStreet_Pearson_St                      TableStreet = `Pearson St`
+	TableStreet_Pebble_Brook_Ct                 TableStreet = `Pebble Brook Ct`
+	TableStreet_Pebble_Hill_Ct                  TableStreet = `Pebble Hill Ct`
+	TableStreet_Pecan_Ln                        TableStreet = `Pecan Ln`
+	TableStreet_Peddlers_Way                    TableStreet = `Peddlers Way`
+	TableStreet_Peeples_Valley_Rd               TableStreet = `Peeples Valley Rd`
+	TableStreet_Pembroke_Ln                     TableStreet = `Pembroke Ln`
+	TableStreet_Pendley_Trl                     TableStreet = `Pendley Trl`
+	TableStreet_Penelope_Ln                     TableStreet = `Penelope Ln`
+	TableStreet_Penfield_Dr                     TableStreet = `Penfield Dr`
+	TableStreet_Penny_Ct                        TableStreet = `Penny Ct`
+	TableStreet_Penny_Ln                        TableStreet = `Penny Ln`
+	TableStreet_Peppermill_Dr                   TableStreet = `Peppermill Dr`
+	TableStreet_Percheron_Trl                   TableStreet = `Percheron Trl`
+	TableStreet_Perkins_Dr                      TableStreet = `Perkins Dr`
+	TableStreet_Perkins_Mountain_Rd             TableStreet = `Perkins Mountain Rd`
+	TableStreet_Perry_Rd                        TableStreet = `Perry Rd`
+	TableStreet_Pettit_Cir                      TableStreet = `Pettit Cir`
+	TableStreet_Pettit_Rd                       TableStreet = `Pettit Rd`
+	TableStreet_Phillips_Dr                     TableStreet = `Phillips Dr`
+	TableStreet_Phoenix_Air_Dr                  TableStreet = `Phoenix Air Dr`
+	TableStreet_Pickers_Row                     TableStreet = `Pickers Row`
+	TableStreet_Picklesimer_Rd                  TableStreet = `Picklesimer Rd`
+	TableStreet_Pickys_Holl                     TableStreet = `Pickys Holl`
+	TableStreet_Piedmont_Ave                    TableStreet = `Piedmont Ave`
+	TableStreet_Piedmont_Ln                     TableStreet = `Piedmont Ln`
+	TableStreet_Pilgrim_St                      TableStreet = `Pilgrim St`
+	TableStreet_Pine_Bow_Rd                     TableStreet = `Pine Bow Rd`
+	TableStreet_Pine_Cir                        TableStreet = `Pine Cir`
+	TableStreet_Pine_Dr                         TableStreet = `Pine Dr`
+	TableStreet_Pine_Forrest_Rd                 TableStreet = `Pine Forrest Rd`
+	TableStreet_Pine_Grove_Church_Rd            TableStreet = `Pine Grove Church Rd`
+	TableStreet_Pine_Grove_Cir                  TableStreet = `Pine Grove Cir`
+	TableStreet_Pine_Grove_Rd                   TableStreet = `Pine Grove Rd`
+	TableStreet_Pine_Log_Rd                     TableStreet = `Pine Log Rd`
+	TableStreet_Pine_Loop                       TableStreet = `Pine Loop`
+	TableStreet_Pine_Needle_Trl                 TableStreet = `Pine Needle Trl`
+	TableStreet_Pine_Oak_Ct                     TableStreet = `Pine Oak Ct`
+	TableStreet_Pine_Ridge_Ct                   TableStreet = `Pine Ridge Ct`
+	TableStreet_Pine_Ridge_Dr                   TableStreet = `Pine Ridge Dr`
+	TableStreet_Pine_Ridge_Ln                   TableStreet = `Pine Ridge Ln`
+	TableStreet_Pine_Ridge_Rd                   TableStreet = `Pine Ridge Rd`
+	TableStreet_Pine_St                         TableStreet = `Pine St`
+	TableStreet_Pine_Street_No_1                TableStreet = `Pine Street No 1`
+	TableStreet_Pine_Street_No_2                TableStreet = `Pine Street No 2`
+	TableStreet_Pine_Valley_Cir                 TableStreet = `Pine Valley Cir`
+	TableStreet_Pine_Valley_Dr                  TableStreet = `Pine Valley Dr`
+	TableStreet_Pine_Vista_Cir                  TableStreet = `Pine Vista Cir`
+	TableStreet_Pine_Water_Ct                   TableStreet = `Pine Water Ct`
+	TableStreet_Pine_Wood_Rd                    TableStreet = `Pine Wood Rd`
+	TableStreet_Pinecrest_Dr                    TableStreet = `Pinecrest Dr`
+	TableStreet_Pinehill_Dr                     TableStreet = `Pinehill Dr`
+	TableStreet_Pinery_Dr                       TableStreet = `Pinery Dr`
+	TableStreet_Piney_Woods_Rd                  TableStreet = `Piney Woods Rd`
+	TableStreet_Pinson_Dr                       TableStreet = `Pinson Dr`
+	TableStreet_Pioneer_Trl                     TableStreet = `Pioneer Trl`
+	TableStreet_Pittman_St                      TableStreet = `Pittman St`
+	TableStreet_Piute_Pl                        TableStreet = `Piute Pl`
+	TableStreet_Plainview_Dr                    TableStreet = `Plainview Dr`
+	TableStreet_Plantation_Rd                   TableStreet = `Plantation Rd`
+	TableStreet_Plantation_Ridge_Dr             TableStreet = `Plantation Ridge Dr`
+	TableStreet_Planters_Dr                     TableStreet = `Planters Dr`
+	TableStreet_Pleasant_Grove_Church_Rd        TableStreet = `Pleasant Grove Church Rd`
+	TableStreet_Pleasant_Ln                     TableStreet = `Pleasant Ln`
+	TableStreet_Pleasant_Run_Dr                 TableStreet = `Pleasant Run Dr`
+	TableStreet_Pleasant_Valley_Rd              TableStreet = `Pleasant Valley Rd`
+	TableStreet_Plymouth_Ct                     TableStreet = `Plymouth Ct`
+	TableStreet_Plymouth_Dr                     TableStreet = `Plymouth Dr`
+	TableStreet_Point_Dr                        TableStreet = `Point Dr`
+	TableStreet_Point_Place_Dr                  TableStreet = `Point Place Dr`
+	TableStreet_Pointe_North_Dr                 TableStreet = `Pointe North Dr`
+	TableStreet_Pointe_Way                      TableStreet = `Pointe Way`
+	TableStreet_Polo_Flds                       TableStreet = `Polo Flds`
+	TableStreet_Pompano_Ln                      TableStreet = `Pompano Ln`
+	TableStreet_Ponders_Rd                      TableStreet = `Ponders Rd`
+	TableStreet_Popham_Rd                       TableStreet = `Popham Rd`
+	TableStreet_Poplar_Springs_Rd               TableStreet = `Poplar Springs Rd`
+	TableStreet_Poplar_St                       TableStreet = `Poplar St`
+	TableStreet_Popular_Dr                      TableStreet = `Popular Dr`
+	TableStreet_Post_Office_Cir                 TableStreet = `Post Office Cir`
+	TableStreet_Postelle_St                     TableStreet = `Postelle St`
+	TableStreet_Powell_Rd                       TableStreet = `Powell Rd`
+	TableStreet_Powers_Ct                       TableStreet = `Powers Ct`
+	TableStreet_Powersports_Cir                 TableStreet = `Powersports Cir`
+	TableStreet_Prather_St                      TableStreet = `Prather St`
+	TableStreet_Pratt_Rd                        TableStreet = `Pratt Rd`
+	TableStreet_Prayer_Trl                      TableStreet = `Prayer Trl`
+	TableStreet_Prestwick_Loop                  TableStreet = `Prestwick Loop`
+	TableStreet_Princeton_Ave                   TableStreet = `Princeton Ave`
+	TableStreet_Princeton_Blvd                  TableStreet = `Princeton Blvd`
+	TableStreet_Princeton_Glen_Ct               TableStreet = `Princeton Glen Ct`
+	TableStreet_Princeton_Pl                    TableStreet = `Princeton Pl`
+	TableStreet_Princeton_Place_Dr              TableStreet = `Princeton Place Dr`
+	TableStreet_Princeton_Walk                  TableStreet = `Princeton Walk`
+	TableStreet_Priory_Club_Dr                  TableStreet = `Priory Club Dr`
+	TableStreet_Public_Sq                       TableStreet = `Public Sq`
+	TableStreet_Puckett_Rd                      TableStreet = `Puckett Rd`
+	TableStreet_Puckett_St                      TableStreet = `Puckett St`
+	TableStreet_Pumpkinvine_Trl                 TableStreet = `Pumpkinvine Trl`
+	TableStreet_Puritan_St                      TableStreet = `Puritan St`
+	TableStreet_Pyron_Ct                        TableStreet = `Pyron Ct`
+	TableStreet_Quail_Ct                        TableStreet = `Quail Ct`
+	TableStreet_Quail_Hollow_Dr                 TableStreet = `Quail Hollow Dr`
+	TableStreet_Quail_Ridge_Dr                  TableStreet = `Quail Ridge Dr`
+	TableStreet_Quail_Ridge_Rd                  TableStreet = `Quail Ridge Rd`
+	TableStreet_Quail_Run                       TableStreet = `Quail Run`
+	TableStreet_Quality_Dr                      TableStreet = `Quality Dr`
+	TableStreet_R_E_Fields_Rd                   TableStreet = `R E Fields Rd`
+	TableStreet_Rail_Dr                         TableStreet = `Rail Dr`
+	TableStreet_Rail_Ovlk                       TableStreet = `Rail Ovlk`
+	TableStreet_Railroad_Ave                    TableStreet = `Railroad Ave`
+	TableStreet_Railroad_Pass                   TableStreet = `Railroad Pass`
+	TableStreet_Railroad_St                     TableStreet = `Railroad St`
+	TableStreet_Raindrop_Ln                     TableStreet = `Raindrop Ln`
+	TableStreet_Randolph_Rd                     TableStreet = `Randolph Rd`
+	TableStreet_Randy_Way                       TableStreet = `Randy Way`
+	TableStreet_Ranell_Pl                       TableStreet = `Ranell Pl`
+	TableStreet_Ranger_Rd                       TableStreet = `Ranger Rd`
+	TableStreet_Rattler_Rd                      TableStreet = `Rattler Rd`
+	TableStreet_Ravenfield_Rd                   TableStreet = `Ravenfield Rd`
+	TableStreet_Raymond_Jenkins_Rd              TableStreet = `Raymond Jenkins Rd`
+	TableStreet_Recess_Rd                       TableStreet = `Recess Rd`
+	TableStreet_Red_Apple_Ter                   TableStreet = `Red Apple Ter`
+	TableStreet_Red_Barn_Rd                     TableStreet = `Red Barn Rd`
+	TableStreet_Red_Bug_Pt                      TableStreet = `Red Bug Pt`
+	TableStreet_Red_Fox_Trl                     TableStreet = `Red Fox Trl`
+	TableStreet_Red_Oak_Dr                      TableStreet = `Red Oak Dr`
+	TableStreet_Red_Tip_Dr                      TableStreet = `Red Tip Dr`
+	TableStreet_Red_Top_Beach_Rd                TableStreet = `Red Top Beach Rd`
+	TableStreet_Red_Top_Cir                     TableStreet = `Red Top Cir`
+	TableStreet_Red_Top_Dr                      TableStreet = `Red Top Dr`
+	TableStreet_Red_Top_Mountain_Rd             TableStreet = `Red Top Mountain Rd`
+	TableStreet_Redcomb_Dr                      TableStreet = `Redcomb Dr`
+	TableStreet_Redd_Rd                         TableStreet = `Redd Rd`
+	TableStreet_Redding_Rd                      TableStreet = `Redding Rd`
+	TableStreet_Redfield_Ct                     TableStreet = `Redfield Ct`
+	TableStreet_Rediger_Ct                      TableStreet = `Rediger Ct`
+	TableStreet_Redwood_Dr                      TableStreet = `Redwood Dr`
+	TableStreet_Reject_Rd                       TableStreet = `Reject Rd`
+	TableStreet_Remington_Ct                    TableStreet = `Remington Ct`
+	TableStreet_Remington_Dr                    TableStreet = `Remington Dr`
+	TableStreet_Remington_Ln                    TableStreet = `Remington Ln`
+	TableStreet_Retreat_Rdg                     TableStreet = `Retreat Rdg`
+	TableStreet_Rex_Ln                          TableStreet = `Rex Ln`
+	TableStreet_Reynolds_Bend_Rd                TableStreet = `Reynolds Bend Rd`
+	TableStreet_Reynolds_Bridge_Rd              TableStreet = `Reynolds Bridge Rd`
+	TableStreet_Reynolds_Ln                     TableStreet = `Reynolds Ln`
+	TableStreet_Rhonda_Ln                       TableStreet = `Rhonda Ln`
+	TableStreet_Rice_Dr                         TableStreet = `Rice Dr`
+	TableStreet_Richards_Rd                     TableStreet = `Richards Rd`
+	TableStreet_Richland_Dr                     TableStreet = `Richland Dr`
+	TableStreet_Riddle_Mill_Rd                  TableStreet = `Riddle Mill Rd`
+	TableStreet_Ridge_Cross_Rd                  TableStreet = `Ridge Cross Rd`
+	TableStreet_Ridge_Rd                        TableStreet = `Ridge Rd`
+	TableStreet_Ridge_Row_Dr                    TableStreet = `Ridge Row Dr`
+	TableStreet_Ridge_View_Dr                   TableStreet = `Ridge View Dr`
+	TableStreet_Ridge_Way                       TableStreet = `Ridge Way`
+	TableStreet_Ridgedale_Rd                    TableStreet = `Ridgedale Rd`
+	TableStreet_Ridgeline_Way                   TableStreet = `Ridgeline Way`
+	TableStreet_Ridgemont_Way                   TableStreet = `Ridgemont Way`
+	TableStreet_Ridgeview_Ct                    TableStreet = `Ridgeview Ct`
+	TableStreet_Ridgeview_Dr                    TableStreet = `Ridgeview Dr`
+	TableStreet_Ridgeview_Trl                   TableStreet = `Ridgeview Trl`
+	TableStreet_Ridgewater_Dr                   TableStreet = `Ridgewater Dr`
+	TableStreet_Ridgeway_Ct                     TableStreet = `Ridgeway Ct`
+	TableStreet_Ridgewood_Dr                    TableStreet = `Ridgewood Dr`
+	TableStreet_Ringers_Rd                      TableStreet = `Ringers Rd`
+	TableStreet_Rip_Rd                          TableStreet = `Rip Rd`
+	TableStreet_Ripley_Ave                      TableStreet = `Ripley Ave`
+	TableStreet_River_Birch_Cir                 TableStreet = `River Birch Cir`
+	TableStreet_River_Birch_Ct                  TableStreet = `River Birch Ct`
+	TableStreet_River_Birch_Dr                  TableStreet = `River Birch Dr`
+	TableStreet_River_Birch_Rd                  TableStreet = `River Birch Rd`
+	TableStreet_River_Ct                        TableStreet = `River Ct`
+	TableStreet_River_Dr                        TableStreet = `River Dr`
+	TableStreet_River_Oaks_Ct                   TableStreet = `River Oaks Ct`
+	TableStreet_River_Oaks_Dr                   TableStreet = `River Oaks Dr`
+	TableStreet_River_Shoals_Dr                 TableStreet = `River Shoals Dr`
+	TableStreet_River_Walk_Pkwy                 TableStreet = `River Walk Pkwy`
+	TableStreet_Riverbend_Rd                    TableStreet = `Riverbend Rd`
+	TableStreet_Rivercreek_Xing                 TableStreet = `Rivercreek Xing`
+	TableStreet_Riverside_Ct                    TableStreet = `Riverside Ct`
+	TableStreet_Riverside_Dr                    TableStreet = `Riverside Dr`
+	TableStreet_Riverview_Ct                    TableStreet = `Riverview Ct`
+	TableStreet_Riverview_Trl                   TableStreet = `Riverview Trl`
+	TableStreet_Riverwood_Cv                    TableStreet = `Riverwood Cv`
+	TableStreet_Roach_Dr                        TableStreet = `Roach Dr`
+	TableStreet_Road_No_1_South                 TableStreet = `Road No 1 South`
+	TableStreet_Road_No_2_South                 TableStreet = `Road No 2 South`
+	TableStreet_Road_No_3_South                 TableStreet = `Road No 3 South`
+	TableStreet_Roberson_Dr                     TableStreet = `Roberson Dr`
+	TableStreet_Roberson_Rd                     TableStreet = `Roberson Rd`
+	TableStreet_Robert_St                       TableStreet = `Robert St`
+	TableStreet_Roberts_Dr                      TableStreet = `Roberts Dr`
+	TableStreet_Roberts_Way                     TableStreet = `Roberts Way`
+	TableStreet_Robin_Dr                        TableStreet = `Robin Dr`
+	TableStreet_Robin_Hood_Dr                   TableStreet = `Robin Hood Dr`
+	TableStreet_Robinson_Loop                   TableStreet = `Robinson Loop`
+	TableStreet_Rock_Fence_Cir                  TableStreet = `Rock Fence Cir`
+	TableStreet_Rock_Fence_Rd                   TableStreet = `Rock Fence Rd`
+	TableStreet_Rock_Fence_Road_No_2            TableStreet = `Rock Fence Road No 2`
+	TableStreet_Rock_Ridge_Ct                   TableStreet = `Rock Ridge Ct`
+	TableStreet_Rock_Ridge_Rd                   TableStreet = `Rock Ridge Rd`
+	TableStreet_Rockaway_Ct                     TableStreet = `Rockaway Ct`
+	TableStreet_Rockcrest_Cir                   TableStreet = `Rockcrest Cir`
+	TableStreet_Rockpoint                       TableStreet = `Rockpoint`
+	TableStreet_Rockridge_Dr                    TableStreet = `Rockridge Dr`
+	TableStreet_Rocky_Ave                       TableStreet = `Rocky Ave`
+	TableStreet_Rocky_Cir                       TableStreet = `Rocky Cir`
+	TableStreet_Rocky_Mountain_Pass             TableStreet = `Rocky Mountain Pass`
+	TableStreet_Rocky_Rd                        TableStreet = `Rocky Rd`
+	TableStreet_Rocky_St                        TableStreet = `Rocky St`
+	TableStreet_Rogers_Cut_Off_Rd               TableStreet = `Rogers Cut Off Rd`
+	TableStreet_Rogers_Rd                       TableStreet = `Rogers Rd`
+	TableStreet_Rogers_St                       TableStreet = `Rogers St`
+	TableStreet_Rolling_Hills_Dr                TableStreet = `Rolling Hills Dr`
+	TableStreet_Ron_St                          TableStreet = `Ron St`
+	TableStreet_Ronson_St                       TableStreet = `Ronson St`
+	TableStreet_Roosevelt_St                    TableStreet = `Roosevelt St`
+	TableStreet_Rose_Brook_Cir                  TableStreet = `Rose Brook Cir`
+	TableStreet_Rose_Trl                        TableStreet = `Rose Trl`
+	TableStreet_Rose_Way                        TableStreet = `Rose Way`
+	TableStreet_Rosebury_Ct                     TableStreet = `Rosebury Ct`
+	TableStreet_Rosen_St                        TableStreet = `Rosen St`
+	TableStreet_Rosewood_Ln                     TableStreet = `Rosewood Ln`
+	TableStreet_Ross_Rd                         TableStreet = `Ross Rd`
+	TableStreet_Roth_Ln                         TableStreet = `Roth Ln`
+	TableStreet_Roundtable_Ct                   TableStreet = `Roundtable Ct`
+	TableStreet_Roving_Hills_Cir                TableStreet = `Roving Hills Cir`
+	TableStreet_Roving_Rd                       TableStreet = `Roving Rd`
+	TableStreet_Rowland_Springs_Rd              TableStreet = `Rowland Springs Rd`
+	TableStreet_Roxburgh_Trl                    TableStreet = `Roxburgh Trl`
+	TableStreet_Roxy_Pl                         TableStreet = `Roxy Pl`
+	TableStreet_Royal_Lake_Ct                   TableStreet = `Royal Lake Ct`
+	TableStreet_Royal_Lake_Cv                   TableStreet = `Royal Lake Cv`
+	TableStreet_Royal_Lake_Dr                   TableStreet = `Royal Lake Dr`
+	TableStreet_Royco_Dr                        TableStreet = `Royco Dr`
+	TableStreet_Rubie_Ln                        TableStreet = `Rubie Ln`
+	TableStreet_Ruby_Dabbs_Ln                   TableStreet = `Ruby Dabbs Ln`
+	TableStreet_Ruby_St                         TableStreet = `Ruby St`
+	TableStreet_Rudy_York_Rd                    TableStreet = `Rudy York Rd`
+	TableStreet_Ruff_Dr                         TableStreet = `Ruff Dr`
+	TableStreet_Running_Deer_Trl                TableStreet = `Running Deer Trl`
+	TableStreet_Running_Terrace_Way             TableStreet = `Running Terrace Way`
+	TableStreet_Russell_Dr                      TableStreet = `Russell Dr`
+	TableStreet_Russell_Rdg                     TableStreet = `Russell Rdg`
+	TableStreet_Russler_Way                     TableStreet = `Russler Way`
+	TableStreet_Ryan_Boulevard                  TableStreet = `Ryan Boulevard`
+	TableStreet_Ryan_Rd                         TableStreet = `Ryan Rd`
+	TableStreet_Ryles_Rd                        TableStreet = `Ryles Rd`
+	TableStreet_S_Bartow_St                     TableStreet = `S Bartow St`
+	TableStreet_S_Cass_St                       TableStreet = `S Cass St`
+	TableStreet_S_Central_Ave                   TableStreet = `S Central Ave`
+	TableStreet_S_Dixie_Ave                     TableStreet = `S Dixie Ave`
+	TableStreet_S_Erwin_St                      TableStreet = `S Erwin St`
+	TableStreet_S_Franklin_St                   TableStreet = `S Franklin St`
+	TableStreet_S_Gilmer_St                     TableStreet = `S Gilmer St`
+	TableStreet_S_Main_St                       TableStreet = `S Main St`
+	TableStreet_S_Morningside_Dr                TableStreet = `S Morningside Dr`
+	TableStreet_S_Museum_Dr                     TableStreet = `S Museum Dr`
+	TableStreet_S_Public_Sq                     TableStreet = `S Public Sq`
+	TableStreet_S_Railroad_St                   TableStreet = `S Railroad St`
+	TableStreet_S_Tennessee_St                  TableStreet = `S Tennessee St`
+	TableStreet_S_Wall_St                       TableStreet = `S Wall St`
+	TableStreet_Saddle_Club_Dr                  TableStreet = `Saddle Club Dr`
+	TableStreet_Saddle_Field_Cir                TableStreet = `Saddle Field Cir`
+	TableStreet_Saddle_Ln                       TableStreet = `Saddle Ln`
+	TableStreet_Saddle_Ridge_Dr                 TableStreet = `Saddle Ridge Dr`
+	TableStreet_Saddlebrook_Dr                  TableStreet = `Saddlebrook Dr`
+	TableStreet_Saddleman_Ct                    TableStreet = `Saddleman Ct`
+	TableStreet_Sage_Way                        TableStreet = `Sage Way`
+	TableStreet_Saggus_Rd                       TableStreet = `Saggus Rd`
+	TableStreet_Sailors_Cv                      TableStreet = `Sailors Cv`
+	TableStreet_Saint_Andrews_Dr                TableStreet = `Saint Andrews Dr`
+	TableStreet_Saint_Elmo_Cir                  TableStreet = `Saint Elmo Cir`
+	TableStreet_Saint_Francis_Pl                TableStreet = `Saint Francis Pl`
+	TableStreet_Saint_Ives_Way                  TableStreet = `Saint Ives Way`
+	TableStreet_Salacoa_Creek_Rd                TableStreet = `Salacoa Creek Rd`
+	TableStreet_Salacoa_Rd                      TableStreet = `Salacoa Rd`
+	TableStreet_Sam_Sutton_Rd                   TableStreet = `Sam Sutton Rd`
+	TableStreet_Samuel_Way                      TableStreet = `Samuel Way`
+	TableStreet_Sandcliffe_Ln                   TableStreet = `Sandcliffe Ln`
+	TableStreet_Sandtown_Rd                     TableStreet = `Sandtown Rd`
+	TableStreet_Saratoga_Dr                     TableStreet = `Saratoga Dr`
+	TableStreet_Sassafras_Trl                   TableStreet = `Sassafras Trl`
+	TableStreet_Sassy_Ln                        TableStreet = `Sassy Ln`
+	TableStreet_Satcher_Rd                      TableStreet = `Satcher Rd`
+	TableStreet_Scale_House_Dr                  TableStreet = `Scale House Dr`
+	TableStreet_Scarlett_Oak_Dr                 TableStreet = `Scarlett Oak Dr`
+	TableStreet_Scenic_Mountain_Dr              TableStreet = `Scenic Mountain Dr`
+	TableStreet_School_St                       TableStreet = `School St`
+	TableStreet_Scott_Dr                        TableStreet = `Scott Dr`
+	TableStreet_Screaming_Eagle_Ct              TableStreet = `Screaming Eagle Ct`
+	TableStreet_Sea_Horse_Cir                   TableStreet = `Sea Horse Cir`
+	TableStreet_Secretariat_Ct                  TableStreet = `Secretariat Ct`
+	TableStreet_Secretariat_Rd                  TableStreet = `Secretariat Rd`
+	TableStreet_Seminole_Rd                     TableStreet = `Seminole Rd`
+	TableStreet_Seminole_Trl                    TableStreet = `Seminole Trl`
+	TableStreet_Seneca_Ln                       TableStreet = `Seneca Ln`
+	TableStreet_Sentry_Dr                       TableStreet = `Sentry Dr`
+	TableStreet_Sequoia_Pl                      TableStreet = `Sequoia Pl`
+	TableStreet_Sequoyah_Cir                    TableStreet = `Sequoyah Cir`
+	TableStreet_Sequoyah_Trl                    TableStreet = `Sequoyah Trl`
+	TableStreet_Serena_St                       TableStreet = `Serena St`
+	TableStreet_Service_Road_No_3               TableStreet = `Service Road No 3`
+	TableStreet_Setters_Pte                     TableStreet = `Setters Pte`
+	TableStreet_Settlers_Cv                     TableStreet = `Settlers Cv`
+	TableStreet_Sewell_Rd                       TableStreet = `Sewell Rd`
+	TableStreet_Shadow_Ln                       TableStreet = `Shadow Ln`
+	TableStreet_Shady_Oak_Ln                    TableStreet = `Shady Oak Ln`
+	TableStreet_Shady_Valley_Dr                 TableStreet = `Shady Valley Dr`
+	TableStreet_Shagbark_Dr                     TableStreet = `Shagbark Dr`
+	TableStreet_Shake_Rag_Cir                   TableStreet = `Shake Rag Cir`
+	TableStreet_Shake_Rag_Rd                    TableStreet = `Shake Rag Rd`
+	TableStreet_Shaker_Ct                       TableStreet = `Shaker Ct`
+	TableStreet_Shallowood_Pl                   TableStreet = `Shallowood Pl`
+	TableStreet_Sharp_Way                       TableStreet = `Sharp Way`
+	TableStreet_Shaw_Blvd                       TableStreet = `Shaw Blvd`
+	TableStreet_Shaw_St                         TableStreet = `Shaw St`
+	TableStreet_Sheffield_Pl                    TableStreet = `Sheffield Pl`
+	TableStreet_Sheila_Ln                       TableStreet = `Sheila Ln`
+	TableStreet_Sheila_Rdg                      TableStreet = `Sheila Rdg`
+	TableStreet_Shenandoah_Pkwy                 TableStreet = `Shenandoah Pkwy`
+	TableStreet_Sherman_Ln                      TableStreet = `Sherman Ln`
+	TableStreet_Sherwood_Dr                     TableStreet = `Sherwood Dr`
+	TableStreet_Sherwood_Ln                     TableStreet = `Sherwood Ln`
+	TableStreet_Sherwood_Way                    TableStreet = `Sherwood Way`
+	TableStreet_Shiloh_Church_Rd                TableStreet = `Shiloh Church Rd`
+	TableStreet_Shinall_Gaines_Rd               TableStreet = `Shinall Gaines Rd`
+	TableStreet_Shinall_Rd                      TableStreet = `Shinall Rd`
+	TableStreet_Shirley_Ln                      TableStreet = `Shirley Ln`
+	TableStreet_Shotgun_Rd                      TableStreet = `Shotgun Rd`
+	TableStreet_Shropshire_Ln                   TableStreet = `Shropshire Ln`
+	TableStreet_Signal_Mountain_Cir             TableStreet = `Signal Mountain Cir`
+	TableStreet_Signal_Mountain_Dr              TableStreet = `Signal Mountain Dr`
+	TableStreet_Silversmith_Trl                 TableStreet = `Silversmith Trl`
+	TableStreet_Simmons_Dr                      TableStreet = `Simmons Dr`
+	TableStreet_Simpson_Cir                     TableStreet = `Simpson Cir`
+	TableStreet_Simpson_Rd                      TableStreet = `Simpson Rd`
+	TableStreet_Simpson_Trl                     TableStreet = `Simpson Trl`
+	TableStreet_Singletree_Rdg                  TableStreet = `Singletree Rdg`
+	TableStreet_Siniard_Dr                      TableStreet = `Siniard Dr`
+	TableStreet_Siniard_Rd                      TableStreet = `Siniard Rd`
+	TableStreet_Sioux_Rd                        TableStreet = `Sioux Rd`
+	TableStreet_Skinner_St                      TableStreet = `Skinner St`
+	TableStreet_Skyline_Dr                      TableStreet = `Skyline Dr`
+	TableStreet_Skyview_Dr                      TableStreet = `Skyview Dr`
+	TableStreet_Skyview_Pte                     TableStreet = `Skyview Pte`
+	TableStreet_Slopes_Dr                       TableStreet = `Slopes Dr`
+	TableStreet_Smiley_Ingram_Rd                TableStreet = `Smiley Ingram Rd`
+	TableStreet_Smith_Cir                       TableStreet = `Smith Cir`
+	TableStreet_Smith_Dr                        TableStreet = `Smith Dr`
+	TableStreet_Smith_Rd                        TableStreet = `Smith Rd`
+	TableStreet_Smyrna_St                       TableStreet = `Smyrna St`
+	TableStreet_Snapper_Ln                      TableStreet = `Snapper Ln`
+	TableStreet_Snow_Springs_Church_Rd          TableStreet = `Snow Springs Church Rd`
+	TableStreet_Snow_Springs_Rd                 TableStreet = `Snow Springs Rd`
+	TableStreet_Snug_Harbor_Dr                  TableStreet = `Snug Harbor Dr`
+	TableStreet_Soaring_Heights_Dr              TableStreet = `Soaring Heights Dr`
+	TableStreet_Soho_Dr                         TableStreet = `Soho Dr`
+	TableStreet_Somerset_Club_Dr                TableStreet = `Somerset Club Dr`
+	TableStreet_Somerset_Ln                     TableStreet = `Somerset Ln`
+	TableStreet_Sonya_Pl                        TableStreet = `Sonya Pl`
+	TableStreet_South_Ave                       TableStreet = `South Ave`
+	TableStreet_South_Bridge_Dr                 TableStreet = `South Bridge Dr`
+	TableStreet_South_Dr                        TableStreet = `South Dr`
+	TableStreet_South_Oaks_Dr                   TableStreet = `South Oaks Dr`
+	TableStreet_South_Valley_Rd                 TableStreet = `South Valley Rd`
+	TableStreet_Southern_Rd                     TableStreet = `Southern Rd`
+	TableStreet_Southridge_Trl                  TableStreet = `Southridge Trl`
+	TableStreet_Southview_Dr                    TableStreet = `Southview Dr`
+	TableStreet_Southwell_Ave                   TableStreet = `Southwell Ave`
+	TableStreet_Southwood_Dr                    TableStreet = `Southwood Dr`
+	TableStreet_Sparks_Rd                       TableStreet = `Sparks Rd`
+	TableStreet_Sparks_St                       TableStreet = `Sparks St`
+	TableStreet_Sparky_Trl                      TableStreet = `Sparky Trl`
+	TableStreet_Sparrow_Ct                      TableStreet = `Sparrow Ct`
+	TableStreet_Spigs_St                        TableStreet = `Spigs St`
+	TableStreet_Split_Oak_Dr                    TableStreet = `Split Oak Dr`
+	TableStreet_Split_Rail_Ct                   TableStreet = `Split Rail Ct`
+	TableStreet_Spring_Creek_Cir                TableStreet = `Spring Creek Cir`
+	TableStreet_Spring_Folly                    TableStreet = `Spring Folly`
+	TableStreet_Spring_Lake_Trl                 TableStreet = `Spring Lake Trl`
+	TableStreet_Spring_Meadows_Ln               TableStreet = `Spring Meadows Ln`
+	TableStreet_Spring_Place_Rd                 TableStreet = `Spring Place Rd`
+	TableStreet_Spring_St                       TableStreet = `Spring St`
+	TableStreet_Springhouse_Ct                  TableStreet = `Springhouse Ct`
+	TableStreet_Springmont_Dr                   TableStreet = `Springmont Dr`
+	TableStreet_Springwell_Ln                   TableStreet = `Springwell Ln`
+	TableStreet_Spruce_Ln                       TableStreet = `Spruce Ln`
+	TableStreet_Spruce_St                       TableStreet = `Spruce St`
+	TableStreet_Squires_Ct                      TableStreet = `Squires Ct`
+	TableStreet_SR_113                          TableStreet = `SR 113`
+	TableStreet_SR_140                          TableStreet = `SR 140`
+	TableStreet_SR_20                           TableStreet = `SR 20`
+	TableStreet_SR_294                          TableStreet = `SR 294`
+	TableStreet_SR_61                           TableStreet = `SR 61`
+	TableStreet_Stamp_Creek_Rd                  TableStreet = `Stamp Creek Rd`
+	TableStreet_Stampede_Pass                   TableStreet = `Stampede Pass`
+	TableStreet_Standard_Ct                     TableStreet = `Standard Ct`
+	TableStreet_Star_Dust_Trl                   TableStreet = `Star Dust Trl`
+	TableStreet_Starlight_Dr                    TableStreet = `Starlight Dr`
+	TableStreet_Starnes_Rd                      TableStreet = `Starnes Rd`
+	TableStreet_Starting_Gate_Dr                TableStreet = `Starting Gate Dr`
+	TableStreet_Stately_Oaks_Dr                 TableStreet = `Stately Oaks Dr`
+	TableStreet_Station_Ct                      TableStreet = `Station Ct`
+	TableStreet_Station_Rail_Dr                 TableStreet = `Station Rail Dr`
+	TableStreet_Station_Way                     TableStreet = `Station Way`
+	TableStreet_Staton_Pl                       TableStreet = `Staton Pl`
+	TableStreet_Steeplechase_Ln                 TableStreet = `Steeplechase Ln`
+	TableStreet_Stephen_Way                     TableStreet = `Stephen Way`
+	TableStreet_Stephens_Rd                     TableStreet = `Stephens Rd`
+	TableStreet_Stephens_St                     TableStreet = `Stephens St`
+	TableStreet_Sterling_Ct                     TableStreet = `Sterling Ct`
+	TableStreet_Stewart_Dr                      TableStreet = `Stewart Dr`
+	TableStreet_Stewart_Ln                      TableStreet = `Stewart Ln`
+	TableStreet_Stiles_Ct                       TableStreet = `Stiles Ct`
+	TableStreet_Stiles_Fairway                  TableStreet = `Stiles Fairway`
+	TableStreet_Stiles_Rd                       TableStreet = `Stiles Rd`
+	TableStreet_Stillmont_Way                   TableStreet = `Stillmont Way`
+	TableStreet_Stillwater_Ct                   TableStreet = `Stillwater Ct`
+	TableStreet_Stoker_Rd                       TableStreet = `Stoker Rd`
+	TableStreet_Stokley_St                      TableStreet = `Stokley St`
+	TableStreet_Stone_Gate_Dr                   TableStreet = `Stone Gate Dr`
+	TableStreet_Stone_Mill_Dr                   TableStreet = `Stone Mill Dr`
+	TableStreet_Stonebridge_Ct                  TableStreet = `Stonebridge Ct`
+	TableStreet_Stonebrook_Dr                   TableStreet = `Stonebrook Dr`
+	TableStreet_Stonecreek_Dr                   TableStreet = `Stonecreek Dr`
+	TableStreet_Stonehaven_Cir                  TableStreet = `Stonehaven Cir`
+	TableStreet_Stonehenge_Ct                   TableStreet = `Stonehenge Ct`
+	TableStreet_Stoner_Rd                       TableStreet = `Stoner Rd`
+	TableStreet_Stoneridge_Pl                   TableStreet = `Stoneridge Pl`
+	TableStreet_Stoners_Chapel_Rd               TableStreet = `Stoners Chapel Rd`
+	TableStreet_Stonewall_Ln                    TableStreet = `Stonewall Ln`
+	TableStreet_Stonewall_St                    TableStreet = `Stonewall St`
+	TableStreet_Stoneybrook_Ct                  TableStreet = `Stoneybrook Ct`
+	TableStreet_Stratford_Ln                    TableStreet = `Stratford Ln`
+	TableStreet_Stratford_Way                   TableStreet = `Stratford Way`
+	TableStreet_Striplin_Cv                     TableStreet = `Striplin Cv`
+	TableStreet_Sue_U_Path                      TableStreet = `Sue U Path`
+	TableStreet_Sugar_Hill_Rd                   TableStreet = `Sugar Hill Rd`
+	TableStreet_Sugar_Mill_Dr                   TableStreet = `Sugar Mill Dr`
+	TableStreet_Sugar_Valley_Rd                 TableStreet = `Sugar Valley Rd`
+	TableStreet_Sugarberry_Pl                   TableStreet = `Sugarberry Pl`
+	TableStreet_Sukkau_Dr                       TableStreet = `Sukkau Dr`
+	TableStreet_Sullins_Rd                      TableStreet = `Sullins Rd`
+	TableStreet_Summer_Dr                       TableStreet = `Summer Dr`
+	TableStreet_Summer_Pl                       TableStreet = `Summer Pl`
+	TableStreet_Summer_St                       TableStreet = `Summer St`
+	TableStreet_Summerset_Ct                    TableStreet = `Summerset Ct`
+	TableStreet_Summit_Ridge_Cir                TableStreet = `Summit Ridge Cir`
+	TableStreet_Summit_Ridge_Dr                 TableStreet = `Summit Ridge Dr`
+	TableStreet_Summit_St                       TableStreet = `Summit St`
+	TableStreet_Sumner_Ln                       TableStreet = `Sumner Ln`
+	TableStreet_Sunrise_Dr                      TableStreet = `Sunrise Dr`
+	TableStreet_Sunset_Cir                      TableStreet = `Sunset Cir`
+	TableStreet_Sunset_Dr                       TableStreet = `Sunset Dr`
+	TableStreet_Sunset_Rd                       TableStreet = `Sunset Rd`
+	TableStreet_Sunset_Ter                      TableStreet = `Sunset Ter`
+	TableStreet_Surrey_Ln                       TableStreet = `Surrey Ln`
+	TableStreet_Sutton_Rd                       TableStreet = `Sutton Rd`
+	TableStreet_Sutton_Trl                      TableStreet = `Sutton Trl`
+	TableStreet_Swafford_Rd                     TableStreet = `Swafford Rd`
+	TableStreet_Swallow_Dr                      TableStreet = `Swallow Dr`
+	TableStreet_Swan_Cir                        TableStreet = `Swan Cir`
+	TableStreet_Sweet_Eloise_Ln                 TableStreet = `Sweet Eloise Ln`
+	TableStreet_Sweet_Gracie_Holl               TableStreet = `Sweet Gracie Holl`
+	TableStreet_Sweet_Gum_Ln                    TableStreet = `Sweet Gum Ln`
+	TableStreet_Sweet_Water_Ct                  TableStreet = `Sweet Water Ct`
+	TableStreet_Sweetbriar_Cir                  TableStreet = `Sweetbriar Cir`
+	TableStreet_Swisher_Dr                      TableStreet = `Swisher Dr`
+	TableStreet_Syble_Cv                        TableStreet = `Syble Cv`
+	TableStreet_Tabernacle_St                   TableStreet = `Tabernacle St`
+	TableStreet_Taff_Rd                         TableStreet = `Taff Rd`
+	TableStreet_Talisman_Dr                     TableStreet = `Talisman Dr`
+	TableStreet_Tall_Oaks_Ln                    TableStreet = `Tall Oaks Ln`
+	TableStreet_Tanager_Ln                      TableStreet = `Tanager Ln`
+	TableStreet_Tanchez_Dr                      TableStreet = `Tanchez Dr`
+	TableStreet_Tanglewood_Dr                   TableStreet = `Tanglewood Dr`
+	TableStreet_Tank_Hill_St                    TableStreet = `Tank Hill St`
+	TableStreet_Tant_Rd                         TableStreet = `Tant Rd`
+	TableStreet_Tanyard_Creek_Rd                TableStreet = `Tanyard Creek Rd`
+	TableStreet_Tanyard_Rd                      TableStreet = `Tanyard Rd`
+	TableStreet_Tara_Way                        TableStreet = `Tara Way`
+	TableStreet_Tarpon_Trl                      TableStreet = `Tarpon Trl`
+	TableStreet_Tasha_Trl                       TableStreet = `Tasha Trl`
+	TableStreet_Tatum_Ridge_Rd                  TableStreet = `Tatum Ridge Rd`
+	TableStreet_Taylor_Dr                       TableStreet = `Taylor Dr`
+	TableStreet_Taylor_Ln                       TableStreet = `Taylor Ln`
+	TableStreet_Taylorsville_Macedonia_Rd       TableStreet = `Taylorsville Macedonia Rd`
+	TableStreet_Taylorsville_Rd                 TableStreet = `Taylorsville Rd`
+	TableStreet_Teague_Rd                       TableStreet = `Teague Rd`
+	TableStreet_Teal_Ct                         TableStreet = `Teal Ct`
+	TableStreet_Tellus_Dr                       TableStreet = `Tellus Dr`
+	TableStreet_Tennessee_Ave                   TableStreet = `Tennessee Ave`
+	TableStreet_Terrell_Dr                      TableStreet = `Terrell Dr`
+	TableStreet_Terry_Ln                        TableStreet = `Terry Ln`
+	TableStreet_Thatch_Ct                       TableStreet = `Thatch Ct`
+	TableStreet_Theater_Ave                     TableStreet = `Theater Ave`
+	TableStreet_Third_Army_Rd                   TableStreet = `Third Army Rd`
+	TableStreet_Thistle_Stop                    TableStreet = `Thistle Stop`
+	TableStreet_Thomas_Ct                       TableStreet = `Thomas Ct`
+	TableStreet_Thompson_St                     TableStreet = `Thompson St`
+	TableStreet_Thornwood_Dr                    TableStreet = `Thornwood Dr`
+	TableStreet_Thoroughbred_Ln                 TableStreet = `Thoroughbred Ln`
+	TableStreet_Thrasher_Ct                     TableStreet = `Thrasher Ct`
+	TableStreet_Thrasher_Rd                     TableStreet = `Thrasher Rd`
+	TableStreet_Thunderhawk_Ln                  TableStreet = `Thunderhawk Ln`
+	TableStreet_Tidwell_Rd                      TableStreet = `Tidwell Rd`
+	TableStreet_Tillberry_Ct                    TableStreet = `Tillberry Ct`
+	TableStreet_Tillton_Trl                     TableStreet = `Tillton Trl`
+	TableStreet_Timber_Ridge_Dr                 TableStreet = `Timber Ridge Dr`
+	TableStreet_Timber_Trl                      TableStreet = `Timber Trl`
+	TableStreet_Timberlake_Cv                   TableStreet = `Timberlake Cv`
+	TableStreet_Timberlake_Pte                  TableStreet = `Timberlake Pte`
+	TableStreet_Timberland_Cir                  TableStreet = `Timberland Cir`
+	TableStreet_Timberland_Ct                   TableStreet = `Timberland Ct`
+	TableStreet_Timberwalk_Ct                   TableStreet = `Timberwalk Ct`
+	TableStreet_Timberwood_Knol                 TableStreet = `Timberwood Knol`
+	TableStreet_Timberwood_Rd                   TableStreet = `Timberwood Rd`
+	TableStreet_Tinsley_Dr                      TableStreet = `Tinsley Dr`
+	TableStreet_Todd_Rd                         TableStreet = `Todd Rd`
+	TableStreet_Tom_Jones_Rd                    TableStreet = `Tom Jones Rd`
+	TableStreet_Tomahawk_Dr                     TableStreet = `Tomahawk Dr`
+	TableStreet_Top_Ridge_Ct                    TableStreet = `Top Ridge Ct`
+	TableStreet_Topridge_Dr                     TableStreet = `Topridge Dr`
+	TableStreet_Tori_Ln                         TableStreet = `Tori Ln`
+	TableStreet_Towe_Chapel_Rd                  TableStreet = `Towe Chapel Rd`
+	TableStreet_Tower_Dr                        TableStreet = `Tower Dr`
+	TableStreet_Tower_Ridge_Rd                  TableStreet = `Tower Ridge Rd`
+	TableStreet_Tower_St                        TableStreet = `Tower St`
+	TableStreet_Town_And_Country_Dr             TableStreet = `Town And Country Dr`
+	TableStreet_Townsend_Dr                     TableStreet = `Townsend Dr`
+	TableStreet_Townsend_Teague_Rd              TableStreet = `Townsend Teague Rd`
+	TableStreet_Townsley_Dr                     TableStreet = `Townsley Dr`
+	TableStreet_Tracy_Ln                        TableStreet = `Tracy Ln`
+	TableStreet_Tramore_Ct                      TableStreet = `Tramore Ct`
+	TableStreet_Trappers_Cv                     TableStreet = `Trappers Cv`
+	TableStreet_Travelers_Path                  TableStreet = `Travelers Path`
+	TableStreet_Treemont_Dr                     TableStreet = `Treemont Dr`
+	TableStreet_Triangle_Ln                     TableStreet = `Triangle Ln`
+	TableStreet_Trimble_Hollow_Rd               TableStreet = `Trimble Hollow Rd`
+	TableStreet_Trotters_Walk                   TableStreet = `Trotters Walk`
+	TableStreet_Tuba_Dr                         TableStreet = `Tuba Dr`
+	TableStreet_Tumlin_St                       TableStreet = `Tumlin St`
+	TableStreet_Tupelo_Dr                       TableStreet = `Tupelo Dr`
+	TableStreet_Turnberry_Ct                    TableStreet = `Turnberry Ct`
+	TableStreet_Turner_Dr                       TableStreet = `Turner Dr`
+	TableStreet_Turner_St                       TableStreet = `Turner St`
+	TableStreet_Turtle_Rock_Ct                  TableStreet = `Turtle Rock Ct`
+	TableStreet_Twelve_Oaks_Dr                  TableStreet = `Twelve Oaks Dr`
+	TableStreet_Twin_Branches_Ln                TableStreet = `Twin Branches Ln`
+	TableStreet_Twin_Bridges_Rd                 TableStreet = `Twin Bridges Rd`
+	TableStreet_Twin_Oaks_Ln                    TableStreet = `Twin Oaks Ln`
+	TableStreet_Twin_Pines_Rd                   TableStreet = `Twin Pines Rd`
+	TableStreet_Twinleaf_Ct                     TableStreet = `Twinleaf Ct`
+	TableStreet_Two_Gun_Bailey_Rd               TableStreet = `Two Gun Bailey Rd`
+	TableStreet_Two_Run_Creek_Rd                TableStreet = `Two Run Creek Rd`
+	TableStreet_Two_Run_Creek_Trl               TableStreet = `Two Run Creek Trl`
+	TableStreet_Two_Run_Trc                     TableStreet = `Two Run Trc`
+	TableStreet_Two_Run_Xing                    TableStreet = `Two Run Xing`
+	TableStreet_Unbridled_Rd                    TableStreet = `Unbridled Rd`
+	TableStreet_Val_Hollow_Ridge_Rd             TableStreet = `Val Hollow Ridge Rd`
+	TableStreet_Valley_Creek_Dr                 TableStreet = `Valley Creek Dr`
+	TableStreet_Valley_Dale_Dr                  TableStreet = `Valley Dale Dr`
+	TableStreet_Valley_Dr                       TableStreet = `Valley Dr`
+	TableStreet_Valley_Trl                      TableStreet = `Valley Trl`
+	TableStreet_Valley_View_Ct                  TableStreet = `Valley View Ct`
+	TableStreet_Valley_View_Dr                  TableStreet = `Valley View Dr`
+	TableStreet_Valley_View_Farm_Rd             TableStreet = `Valley View Farm Rd`
+	TableStreet_Vaughan_Ct                      TableStreet = `Vaughan Ct`
+	TableStreet_Vaughan_Dr                      TableStreet = `Vaughan Dr`
+	TableStreet_Vaughn_Dairy_Rd                 TableStreet = `Vaughn Dairy Rd`
+	TableStreet_Vaughn_Rd                       TableStreet = `Vaughn Rd`
+	TableStreet_Vaughn_Spur                     TableStreet = `Vaughn Spur`
+	TableStreet_Veach_Loop                      TableStreet = `Veach Loop`
+	TableStreet_Vermont_Ave                     TableStreet = `Vermont Ave`
+	TableStreet_Victoria_Dr                     TableStreet = `Victoria Dr`
+	TableStreet_Vigilant_St                     TableStreet = `Vigilant St`
+	TableStreet_Village_Dr                      TableStreet = `Village Dr`
+	TableStreet_Village_Trc                     TableStreet = `Village Trc`
+	TableStreet_Vineyard_Mountain_Rd            TableStreet = `Vineyard Mountain Rd`
+	TableStreet_Vineyard_Rd                     TableStreet = `Vineyard Rd`
+	TableStreet_Vineyard_Way                    TableStreet = `Vineyard Way`
+	TableStreet_Vinnings_Ln                     TableStreet = `Vinnings Ln`
+	TableStreet_Vintage_Ct                      TableStreet = `Vintage Ct`
+	TableStreet_Vintagewood_Cv                  TableStreet = `Vintagewood Cv`
+	TableStreet_Virginia_Ave                    TableStreet = `Virginia Ave`
+	TableStreet_Vista_Ct                        TableStreet = `Vista Ct`
+	TableStreet_Vista_Woods_Dr                  TableStreet = `Vista Woods Dr`
+	TableStreet_Vulcan_Quarry_Rd                TableStreet = `Vulcan Quarry Rd`
+	TableStreet_W_Carter_St                     TableStreet = `W Carter St`
+	TableStreet_W_Cherokee_Ave                  TableStreet = `W Cherokee Ave`
+	TableStreet_W_Church_St                     TableStreet = `W Church St`
+	TableStreet_W_Felton_Rd                     TableStreet = `W Felton Rd`
+	TableStreet_W_George_St                     TableStreet = `W George St`
+	TableStreet_W_Georgia_Ave                   TableStreet = `W Georgia Ave`
+	TableStreet_W_Holiday_Ct                    TableStreet = `W Holiday Ct`
+	TableStreet_W_Howard_St                     TableStreet = `W Howard St`
+	TableStreet_W_Indiana_Ave                   TableStreet = `W Indiana Ave`
+	TableStreet_W_Iron_Belt_Rd                  TableStreet = `W Iron Belt Rd`
+	TableStreet_W_Lee_St                        TableStreet = `W Lee St`
+	TableStreet_W_Main_St                       TableStreet = `W Main St`
+	TableStreet_W_Oak_Grove_Rd                  TableStreet = `W Oak Grove Rd`
+	TableStreet_W_Porter_St                     TableStreet = `W Porter St`
+	TableStreet_W_Railroad_St                   TableStreet = `W Railroad St`
+	TableStreet_W_Rocky_St                      TableStreet = `W Rocky St`
+	TableStreet_Wade_Chapel_Rd                  TableStreet = `Wade Chapel Rd`
+	TableStreet_Wade_Rd                         TableStreet = `Wade Rd`
+	TableStreet_Wagonwheel_Dr                   TableStreet = `Wagonwheel Dr`
+	TableStreet_Walden_Xing                     TableStreet = `Walden Xing`
+	TableStreet_Walker_Hills_Cir                TableStreet = `Walker Hills Cir`
+	TableStreet_Walker_Rd                       TableStreet = `Walker Rd`
+	TableStreet_Walker_St                       TableStreet = `Walker St`
+	TableStreet_Walnut_Dr                       TableStreet = `Walnut Dr`
+	TableStreet_Walnut_Grove_Rd                 TableStreet = `Walnut Grove Rd`
+	TableStreet_Walnut_Ln                       TableStreet = `Walnut Ln`
+	TableStreet_Walnut_Trl                      TableStreet = `Walnut Trl`
+	TableStreet_Walt_Way                        TableStreet = `Walt Way`
+	TableStreet_Walton_St                       TableStreet = `Walton St`
+	TableStreet_Wanda_Dr                        TableStreet = `Wanda Dr`
+	TableStreet_Wansley_Dr                      TableStreet = `Wansley Dr`
+	TableStreet_Ward_Cir                        TableStreet = `Ward Cir`
+	TableStreet_Ward_Mountain_Rd                TableStreet = `Ward Mountain Rd`
+	TableStreet_Ward_Mountain_Trl               TableStreet = `Ward Mountain Trl`
+	TableStreet_Warren_Cv                       TableStreet = `Warren Cv`
+	TableStreet_Washakie_Ln                     TableStreet = `Washakie Ln`
+	TableStreet_Water_Tower_Rd                  TableStreet = `Water Tower Rd`
+	TableStreet_Waterford_Dr                    TableStreet = `Waterford Dr`
+	TableStreet_Waterfront_Dr                   TableStreet = `Waterfront Dr`
+	TableStreet_Waters_Edge_Dr                  TableStreet = `Waters Edge Dr`
+	TableStreet_Waters_View                     TableStreet = `Waters View`
+	TableStreet_Waterside_Dr                    TableStreet = `Waterside Dr`
+	TableStreet_Waterstone_Ct                   TableStreet = `Waterstone Ct`
+	TableStreet_Waterstone_Dr                   TableStreet = `Waterstone Dr`
+	TableStreet_Watters_Rd                      TableStreet = `Watters Rd`
+	TableStreet_Wayland_Cir                     TableStreet = `Wayland Cir`
+	TableStreet_Wayne_Ave                       TableStreet = `Wayne Ave`
+	TableStreet_Wayne_Dr                        TableStreet = `Wayne Dr`
+	TableStreet_Wayside_Dr                      TableStreet = `Wayside Dr`
+	TableStreet_Wayside_Rd                      TableStreet = `Wayside Rd`
+	TableStreet_Weaver_St                       TableStreet = `Weaver St`
+	TableStreet_Webb_Dr                         TableStreet = `Webb Dr`
+	TableStreet_Websters_Ferry_Lndg             TableStreet = `Websters Ferry Lndg`
+	TableStreet_Websters_Overlook_Dr            TableStreet = `Websters Overlook Dr`
+	TableStreet_Websters_Overlook_Lndg          TableStreet = `Websters Overlook Lndg`
+	TableStreet_Weems_Dr                        TableStreet = `Weems Dr`
+	TableStreet_Weems_Rd                        TableStreet = `Weems Rd`
+	TableStreet_Weems_Spur                      TableStreet = `Weems Spur`
+	TableStreet_Weeping_Willow_Ln               TableStreet = `Weeping Willow Ln`
+	TableStreet_Weissinger_Rd                   TableStreet = `Weissinger Rd`
+	TableStreet_Wellington_Dr                   TableStreet = `Wellington Dr`
+	TableStreet_Wellons_Rd                      TableStreet = `Wellons Rd`
+	TableStreet_Wells_Dr                        TableStreet = `Wells Dr`
+	TableStreet_Wells_St                        TableStreet = `Wells St`
+	TableStreet_Wentercress_Dr                  TableStreet = `Wentercress Dr`
+	TableStreet_Wesley_Drew_Ln                  TableStreet = `Wesley Drew Ln`
+	TableStreet_Wesley_Mill_Dr                  TableStreet = `Wesley Mill Dr`
+	TableStreet_Wesley_Rd                       TableStreet = `Wesley Rd`
+	TableStreet_Wesley_Trc                      TableStreet = `Wesley Trc`
+	TableStreet_Wessington_Pl                   TableStreet = `Wessington Pl`
+	TableStreet_West_Ave                        TableStreet = `West Ave`
+	TableStreet_West_Dr                         TableStreet = `West Dr`
+	TableStreet_West_Ln                         TableStreet = `West Ln`
+	TableStreet_West_Oak_Dr                     TableStreet = `West Oak Dr`
+	TableStreet_West_Ridge_Ct                   TableStreet = `West Ridge Ct`
+	TableStreet_West_Ridge_Dr                   TableStreet = `West Ridge Dr`
+	TableStreet_Westchester_Dr                  TableStreet = `Westchester Dr`
+	TableStreet_Westfield_Park                  TableStreet = `Westfield Park`
+	TableStreet_Westgate_Dr                     TableStreet = `Westgate Dr`
+	TableStreet_Westminster_Dr                  TableStreet = `Westminster Dr`
+	TableStreet_Westover_Dr                     TableStreet = `Westover Dr`
+	TableStreet_Westover_Rdg                    TableStreet = `Westover Rdg`
+	TableStreet_Westside_Chas                   TableStreet = `Westside Chas`
+	TableStreet_Westview_Dr                     TableStreet = `Westview Dr`
+	TableStreet_Westwood_Cir                    TableStreet = `Westwood Cir`
+	TableStreet_Westwood_Dr                     TableStreet = `Westwood Dr`
+	TableStreet_Westwood_Ln                     TableStreet = `Westwood Ln`
+	TableStreet_Wetlands_Rd                     TableStreet = `Wetlands Rd`
+	TableStreet_Wexford_Cir                     TableStreet = `Wexford Cir`
+	TableStreet_Wey_Bridge_Ct                   TableStreet = `Wey Bridge Ct`
+	TableStreet_Wheeler_Rd                      TableStreet = `Wheeler Rd`
+	TableStreet_Whipporwill_Ct                  TableStreet = `Whipporwill Ct`
+	TableStreet_Whipporwill_Ln                  TableStreet = `Whipporwill Ln`
+	TableStreet_Whispering_Pine_Cir             TableStreet = `Whispering Pine Cir`
+	TableStreet_Whispering_Pine_Ln              TableStreet = `Whispering Pine Ln`
+	TableStreet_Whistle_Stop_Dr                 TableStreet = `Whistle Stop Dr`
+	TableStreet_White_Oak_Dr                    TableStreet = `White Oak Dr`
+	TableStreet_White_Rd                        TableStreet = `White Rd`
+	TableStreet_Widgeon_Way                     TableStreet = `Widgeon Way`
+	TableStreet_Wild_Flower_Trl                 TableStreet = `Wild Flower Trl`
+	TableStreet_Wildberry_Path                  TableStreet = `Wildberry Path`
+	TableStreet_Wilderness_Camp_Rd              TableStreet = `Wilderness Camp Rd`
+	TableStreet_Wildwood_Dr                     TableStreet = `Wildwood Dr`
+	TableStreet_Wilkey_St                       TableStreet = `Wilkey St`
+	TableStreet_Wilkins_Rd                      TableStreet = `Wilkins Rd`
+	TableStreet_William_Dr                      TableStreet = `William Dr`
+	TableStreet_Williams_Creek_Trl              TableStreet = `Williams Creek Trl`
+	TableStreet_Williams_Rd                     TableStreet = `Williams Rd`
+	TableStreet_Williams_Spur_Rd                TableStreet = `Williams Spur Rd`
+	TableStreet_Williams_St                     TableStreet = `Williams St`
+	TableStreet_Willis_Rd                       TableStreet = `Willis Rd`
+	TableStreet_Willow_Bend_Dr                  TableStreet = `Willow Bend Dr`
+	TableStreet_Willow_Cir                      TableStreet = `Willow Cir`
+	TableStreet_Willow_Ct                       TableStreet = `Willow Ct`
+	TableStreet_Willow_Ln                       TableStreet = `Willow Ln`
+	TableStreet_Willow_Trc                      TableStreet = `Willow Trc`
+	TableStreet_Willow_Wood_Ln                  TableStreet = `Willow Wood Ln`
+	TableStreet_Willowbrook_Ct                  TableStreet = `Willowbrook Ct`
+	TableStreet_Wilmer_Way                      TableStreet = `Wilmer Way`
+	TableStreet_Wilson_St                       TableStreet = `Wilson St`
+	TableStreet_Winchester_Ave                  TableStreet = `Winchester Ave`
+	TableStreet_Winchester_Dr                   TableStreet = `Winchester Dr`
+	TableStreet_Windcliff_Ct                    TableStreet = `Windcliff Ct`
+	TableStreet_Windermere_Bend                 TableStreet = `Windermere Bend`
+	TableStreet_Windfield_Dr                    TableStreet = `Windfield Dr`
+	TableStreet_Winding_Branch_Trc              TableStreet = `Winding Branch Trc`
+	TableStreet_Winding_Water_Cv                TableStreet = `Winding Water Cv`
+	TableStreet_Windrush_Dr                     TableStreet = `Windrush Dr`
+	TableStreet_Windsor_Ct                      TableStreet = `Windsor Ct`
+	TableStreet_Windsor_Trc                     TableStreet = `Windsor Trc`
+	TableStreet_Windy_Hill_Rd                   TableStreet = `Windy Hill Rd`
+	TableStreet_Wingfoot_Ct                     TableStreet = `Wingfoot Ct`
+	TableStreet_Wingfoot_Trl                    TableStreet = `Wingfoot Trl`
+	TableStreet_Winnie_Frank_Rd                 TableStreet = `Winnie Frank Rd`
+	TableStreet_Winter_Wood_Ct                  TableStreet = `Winter Wood Ct`
+	TableStreet_Winter_Wood_Cv                  TableStreet = `Winter Wood Cv`
+	TableStreet_Winter_Wood_Dr                  TableStreet = `Winter Wood Dr`
+	TableStreet_Winter_Wood_Trc                 TableStreet = `Winter Wood Trc`
+	TableStreet_Winter_Wood_Trl                 TableStreet = `Winter Wood Trl`
+	TableStreet_Winter_Wood_Way                 TableStreet = `Winter Wood Way`
+	TableStreet_Winterset_Dr                    TableStreet = `Winterset Dr`
+	TableStreet_Wiseman_Rd                      TableStreet = `Wiseman Rd`
+	TableStreet_Wisteria_Trl                    TableStreet = `Wisteria Trl`
+	TableStreet_Wofford_St                      TableStreet = `Wofford St`
+	TableStreet_Wolfcliff_Rd                    TableStreet = `Wolfcliff Rd`
+	TableStreet_Wolfpen_Pass                    TableStreet = `Wolfpen Pass`
+	TableStreet_Wolfridge_Trl                   TableStreet = `Wolfridge Trl`
+	TableStreet_Womack_Dr                       TableStreet = `Womack Dr`
+	TableStreet_Wood_Cir                        TableStreet = `Wood Cir`
+	TableStreet_Wood_Forest_Dr                  TableStreet = `Wood Forest Dr`
+	TableStreet_Wood_Ln                         TableStreet = `Wood Ln`
+	TableStreet_Wood_Rd                         TableStreet = `Wood Rd`
+	TableStreet_Wood_St                         TableStreet = `Wood St`
+	TableStreet_Woodall_Rd                      TableStreet = `Woodall Rd`
+	TableStreet_Woodbine_Dr                     TableStreet = `Woodbine Dr`
+	TableStreet_Woodbridge_Dr                   TableStreet = `Woodbridge Dr`
+	TableStreet_Woodcrest_Dr                    TableStreet = `Woodcrest Dr`
+	TableStreet_Woodcrest_Rd                    TableStreet = `Woodcrest Rd`
+	TableStreet_Wooddale_Dr                     TableStreet = `Wooddale Dr`
+	TableStreet_Woodhaven_Ct                    TableStreet = `Woodhaven Ct`
+	TableStreet_Woodhaven_Dr                    TableStreet = `Woodhaven Dr`
+	TableStreet_Woodland_Bridge_Rd              TableStreet = `Woodland Bridge Rd`
+	TableStreet_Woodland_Dr                     TableStreet = `Woodland Dr`
+	TableStreet_Woodland_Rd                     TableStreet = `Woodland Rd`
+	TableStreet_Woodland_Way                    TableStreet = `Woodland Way`
+	TableStreet_Woodlands_Way                   TableStreet = `Woodlands Way`
+	TableStreet_Woodsong_Ct                     TableStreet = `Woodsong Ct`
+	TableStreet_Woodview_Dr                     TableStreet = `Woodview Dr`
+	TableStreet_Woodvine_Ct                     TableStreet = `Woodvine Ct`
+	TableStreet_Woodvine_Dr                     TableStreet = `Woodvine Dr`
+	TableStreet_Woody_Rd                        TableStreet = `Woody Rd`
+	TableStreet_Wooleys_Rd                      TableStreet = `Wooleys Rd`
+	TableStreet_Worthington_Rd                  TableStreet = `Worthington Rd`
+	TableStreet_Wren_Ct                         TableStreet = `Wren Ct`
+	TableStreet_Wykle_St                        TableStreet = `Wykle St`
+	TableStreet_Wynn_Loop                       TableStreet = `Wynn Loop`
+	TableStreet_Wynn_Rd                         TableStreet = `Wynn Rd`
+	TableStreet_Yacht_Club_Rd                   TableStreet = `Yacht Club Rd`
+	TableStreet_Yellow_Brick_Rd                 TableStreet = `Yellow Brick Rd`
+	TableStreet_Yellow_Hammer_Dr                TableStreet = `Yellow Hammer Dr`
+	TableStreet_Yonah_Ct                        TableStreet = `Yonah Ct`
+	TableStreet_York_Trl                        TableStreet = `York Trl`
+	TableStreet_Young_Deer_Trl                  TableStreet = `Young Deer Trl`
+	TableStreet_Young_Loop                      TableStreet = `Young Loop`
+	TableStreet_Young_Rd                        TableStreet = `Young Rd`
+	TableStreet_Young_St                        TableStreet = `Young St`
+	TableStreet_Youngs_Mill_Rd                  TableStreet = `Youngs Mill Rd`
+	TableStreet_Zena_Dr                         TableStreet = `Zena Dr`
+	TableStreet_Zion_Rd                         TableStreet = `Zion Rd`
+)
+
+// TableID returns the table ID.
+func (TableStreet) TableID() string { return `Street` }
+
+// Valid reports if the value is in the table.
+func (v TableStreet) Valid() bool { return TableValueLookup[`Street`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableStreet) Description() string { return tableDescription(`Street`, string(v)) }
+
+// TableZipCode is a value of table ZipCode, Zip Code.
+type TableZipCode string
+
+const (
+	TableZipCode_01770 TableZipCode = `01770`
+	TableZipCode_02030 TableZipCode = `02030`
+	TableZipCode_02108 TableZipCode = `02108`
+	TableZipCode_02109 TableZipCode = `02109`
+	TableZipCode_02110 TableZipCode = `02110`
+	TableZipCode_02199 TableZipCode = `02199`
+	TableZipCode_02481 TableZipCode = `02481`
+	TableZipCode_02493 TableZipCode = `02493`
+	TableZipCode_06820 TableZipCode = `06820`
+	TableZipCode_06830 TableZipCode = `06830`
+	TableZipCode_06831 TableZipCode = `06831`
+	TableZipCode_06840 TableZipCode = `06840`
+	TableZipCode_06853 TableZipCode = `06853`
+	TableZipCode_06870 TableZipCode = `06870`
+	TableZipCode_06878 TableZipCode = `06878`
+	TableZipCode_06880 TableZipCode = `06880`
+	TableZipCode_06883 TableZipCode = `06883`
+	TableZipCode_07021 TableZipCode = `07021`
+	TableZipCode_07046 TableZipCode = `07046`
+	TableZipCode_07078 TableZipCode = `07078`
+	TableZipCode_07417 TableZipCode = `07417`
+	TableZipCode_07458 TableZipCode = `07458`
+	TableZipCode_07620 TableZipCode = `07620`
+	TableZipCode_07760 TableZipCode = `07760`
+	TableZipCode_07924 TableZipCode = `07924`
+	TableZipCode_07931 TableZipCode = `07931`
+	TableZipCode_07945 TableZipCode = `07945`
+	TableZipCode_10004 TableZipCode = `10004`
+	TableZipCode_10017 TableZipCode = `10017`
+	TableZipCode_10018 TableZipCode = `10018`
+	TableZipCode_10021 TableZipCode = `10021`
+	TableZipCode_10022 TableZipCode = `10022`
+	TableZipCode_10028 TableZipCode = `10028`
+	TableZipCode_10069 TableZipCode = `10069`
+	TableZipCode_10504 TableZipCode = `10504`
+	TableZipCode_10506 TableZipCode = `10506`
+	TableZipCode_10514 TableZipCode = `10514`
+	TableZipCode_10538 TableZipCode = `10538`
+	TableZipCode_10576 TableZipCode = `10576`
+	TableZipCode_10577 TableZipCode = `10577`
+	TableZipCode_10580 TableZipCode = `10580`
+	TableZipCode_10583 TableZipCode = `10583`
+	TableZipCode_11024 TableZipCode = `11024`
+	TableZipCode_11030 TableZipCode = `11030`
+	TableZipCode_11568 TableZipCode = `11568`
+	TableZipCode_11724 TableZipCode = `11724`
+	TableZipCode_19035 TableZipCode = `19035`
+	TableZipCode_19041 TableZipCode = `19041`
+	TableZipCode_19085 TableZipCode = `19085`
+	TableZipCode_19807 TableZipCode = `19807`
+	TableZipCode_20198 TableZipCode = `20198`
+	TableZipCode_20854 TableZipCode = `20854`
+	TableZipCode_22066 TableZipCode = `22066`
+	TableZipCode_23219 TableZipCode = `23219`
+	TableZipCode_28207 TableZipCode = `28207`
+	TableZipCode_30326 TableZipCode = `30326`
+	TableZipCode_32963 TableZipCode = `32963`
+	TableZipCode_33480 TableZipCode = `33480`
+	TableZipCode_33786 TableZipCode = `33786`
+	TableZipCode_33921 TableZipCode = `33921`
+	TableZipCode_34102 TableZipCode = `34102`
+	TableZipCode_34108 TableZipCode = `34108`
+	TableZipCode_34228 TableZipCode = `34228`
+	TableZipCode_60022 TableZipCode = `60022`
+	TableZipCode_60043 TableZipCode = `60043`
+	TableZipCode_60045 TableZipCode = `60045`
+	TableZipCode_60093 TableZipCode = `60093`
+	TableZipCode_60521 TableZipCode = `60521`
+	TableZipCode_60606 TableZipCode = `60606`
+	TableZipCode_60611 TableZipCode = `60611`
+	TableZipCode_63124 TableZipCode = `63124`
+	TableZipCode_74103 TableZipCode = `74103`
+	TableZipCode_75205 TableZipCode = `75205`
+	TableZipCode_75225 TableZipCode = `75225`
+	TableZipCode_76102 TableZipCode = `76102`
+	TableZipCode_77002 TableZipCode = `77002`
+	TableZipCode_77024 TableZipCode = `77024`
+	TableZipCode_78257 TableZipCode = `78257`
+	TableZipCode_83014 TableZipCode = `83014`
+	TableZipCode_85253 TableZipCode = `85253`
+	TableZipCode_89451 TableZipCode = `89451`
+	TableZipCode_90067 TableZipCode = `90067`
+	TableZipCode_90077 TableZipCode = `90077`
+	TableZipCode_90210 TableZipCode = `90210`
+	TableZipCode_90212 TableZipCode = `90212`
+	TableZipCode_90272 TableZipCode = `90272`
+	TableZipCode_90402 TableZipCode = `90402`
+	TableZipCode_91436 TableZipCode = `91436`
+	TableZipCode_92067 TableZipCode = `92067`
+	TableZipCode_92091 TableZipCode = `92091`
+	TableZipCode_92657 TableZipCode = `92657`
+	TableZipCode_93108 TableZipCode = `93108`
+	TableZipCode_94022 TableZipCode = `94022`
+	TableZipCode_94027 TableZipCode = `94027`
+	TableZipCode_94028 TableZipCode = `94028`
+	TableZipCode_94062 TableZipCode = `94062`
+	TableZipCode_94111 TableZipCode = `94111`
+	TableZipCode_94301 TableZipCode = `94301`
+	TableZipCode_94304 TableZipCode = `94304`
+	TableZipCode_94920 TableZipCode = `94920`
+	TableZipCode_98039 TableZipCode = `98039`
+)
+
+// TableID returns the table ID.
+func (TableZipCode) TableID() string { return `ZipCode` }
+
+// Valid reports if the value is in the table.
+func (v TableZipCode) Valid() bool { return TableValueLookup[`ZipCode`][string(v)] }
+
+// Description returns the description of the value, or empty if the value is not in the table.
+func (v TableZipCode) Description() string { return tableDescription(`ZipCode`, string(v)) }
+
+func tableDescription(table, value string) string {
+	for _, row := range TableLookup[table].Row {
+		if row.ID == value {
+			return row.Description
+		}
+	}
+	return ""
+}
@@ -22,11 +22,11 @@ type ABS struct {
 	AbstractCompletionDateTime DTM     `hl7:"7,format=YMDHM,display=Abstract Completion Date/Time"`
 	AbstractedBy               *XCN    `hl7:"8,display=Abstracted By"`
 	CaseCategoryCode           *CWE    `hl7:"9,table=0423,display=Case Category Code"`
-	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,display=Caesarian Section Indicator"`
+	CaesarianSectionIndicator  ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Caesarian Section Indicator"`
 	GestationCategoryCode      *CWE    `hl7:"11,table=0424,display=Gestation Category Code"`
-	GestationPeriodWeeks       NM      `hl7:"12,display=Gestation Period - Weeks"`
+	GestationPeriodWeeks       NM      `hl7:"12,datatype=NM,display=Gestation Period - Weeks"`
 	NewbornCode                *CWE    `hl7:"13,table=0425,display=Newborn Code"`
-	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,display=Stillborn Indicator"`
+	StillbornIndicator         ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Stillborn Indicator"`
 }
 
 // Accident
@@ -38,14 +38,14 @@ type ACC struct {
 	AccidentCode                *CWE    `hl7:"2,table=0050,display=Accident Code"`
 	AccidentLocation            ST      `hl7:"3,display=Accident Location"`
 	AutoAccidentState           CWE     `hl7:"4,table=0347,display=Auto Accident State"`
-	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,display=Accident Job Related Indicator"`
-	AccidentDeathIndicator      ID      `hl7:"6,len=1,table=0136,display=Accident Death Indicator"`
+	AccidentJobRelatedIndicator ID      `hl7:"5,len=1,table=0136,datatype=ID,display=Accident Job Related Indicator"`
+	AccidentDeathIndicator      ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Accident Death Indicator"`
 	EnteredBy                   *XCN    `hl7:"7,display=Entered By"`
 	AccidentDescription         ST      `hl7:"8,display=Accident Description"`
 	BroughtInBy                 ST      `hl7:"9,display=Brought In By"`
-	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,display=Police Notified Indicator"`
+	PoliceNotifiedIndicator     ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Police Notified Indicator"`
 	AccidentAddress             *XAD    `hl7:"11,display=Accident Address"`
-	DegreeOfPatientLiability    NM      `hl7:"12,display=Degree Of Patient Liability"`
+	DegreeOfPatientLiability    NM      `hl7:"12,datatype=NM,display=Degree Of Patient Liability"`
 }
 
 // Addendum
@@ -67,14 +67,14 @@ type ADJ struct {
 	HL7                                                HL7Name `hl7:",name=ADJ,type=s"`
 	ProviderAdjustmentNumber                           EI      `hl7:"1,required,display=Provider Adjustment Number"`
 	PayerAdjustmentNumber                              EI      `hl7:"2,required,display=Payer Adjustment Number"`
-	AdjustmentSequenceNumber                           SI      `hl7:"3,required,len=4,display=Adjustment Sequence Number"`
+	AdjustmentSequenceNumber                           SI      `hl7:"3,required,len=4,datatype=SI,display=Adjustment Sequence Number"`
 	AdjustmentCategory                                 CWE     `hl7:"4,required,table=0564,display=Adjustment Category"`
 	AdjustmentAmount                                   *CP     `hl7:"5,display=Adjustment Amount"`
 	AdjustmentQuantity                                 *CQ     `hl7:"6,display=Adjustment Quantity"`
 	AdjustmentReasonPa                                 *CWE    `hl7:"7,conditional,table=0565,display=Adjustment Reason Pa"`
 	AdjustmentDescription                              ST      `hl7:"8,display=Adjustment Description"`
-	OriginalValue                                      NM      `hl7:"9,display=Original Value"`
-	SubstituteValue                                    NM      `hl7:"10,display=Substitute Value"`
+	OriginalValue                                      NM      `hl7:"9,datatype=NM,display=Original Value"`
+	SubstituteValue                                    NM      `hl7:"10,datatype=NM,display=Substitute Value"`
 	AdjustmentAction                                   *CWE    `hl7:"11,table=0569,display=Adjustment Action"`
 	ProviderAdjustmentNumberCrossReference             *EI     `hl7:"12,display=Provider Adjustment Number Cross Reference"`
 	ProviderProductServiceLineItemNumberCrossReference *EI     `hl7:"13,display=Provider Product/Service Line Item Number Cross Reference"`
@@ -88,7 +88,7 @@ type ADJ struct {
 // by the STF segment is/was associated.
 type AFF struct {
 	HL7                                          HL7Name `hl7:",name=AFF,type=s"`
-	SetID                                        SI      `hl7:"1,seq,required,len=4,display=Set Id - Aff"`
+	SetID                                        SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Aff"`
 	ProfessionalOrganization                     XON     `hl7:"2,required,display=Professional Organization"`
 	ProfessionalOrganizationAddress              *XAD    `hl7:"3,display=Professional Organization Address"`
 	ProfessionalOrganizationAffiliationDateRange []DR    `hl7:"4,display=Professional Organization Affiliation Date Range"`
@@ -104,17 +104,17 @@ type AFF struct {
 // are identified with a simple identification code.
 type AIG struct {
 	HL7                      HL7Name `hl7:",name=AIG,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set Id - Aig"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Aig"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceID               *CWE    `hl7:"3,conditional,display=Resource Id"`
 	ResourceType             CWE     `hl7:"4,required,display=Resource Type"`
 	ResourceGroup            []CWE   `hl7:"5,display=Resource Group"`
-	ResourceQuantity         NM      `hl7:"6,display=Resource Quantity"`
+	ResourceQuantity         NM      `hl7:"6,datatype=NM,display=Resource Quantity"`
 	ResourceQuantityUnits    *CNE    `hl7:"7,display=Resource Quantity Units"`
 	StartDateTime            DTM     `hl7:"8,conditional,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"9,conditional,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"9,conditional,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"10,conditional,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"11,display=Duration"`
+	Duration                 NM      `hl7:"11,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"12,display=Duration Units"`
 	AllowSubstitutionCode    *CWE    `hl7:"13,conditional,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"14,conditional,table=0278,display=Filler Status Code"`
@@ -129,15 +129,15 @@ type AIG struct {
 // of locations used by the HL7 specification.
 type AIL struct {
 	HL7                      HL7Name `hl7:",name=AIL,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set Id - Ail"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Ail"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	LocationResourceID       []PL    `hl7:"3,conditional,display=Location Resource Id"`
 	LocationType             *CWE    `hl7:"4,conditional,table=0305,display=Location Type - Ail"`
 	LocationGroup            *CWE    `hl7:"5,display=Location Group"`
 	StartDateTime            DTM     `hl7:"6,conditional,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"8,conditional,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,display=Duration"`
+	Duration                 NM      `hl7:"9,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"10,display=Duration Units"`
 	AllowSubstitutionCode    *CWE    `hl7:"11,conditional,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"12,conditional,table=0278,display=Filler Status Code"`
@@ -152,15 +152,15 @@ type AIL struct {
 // nurses, surgeons, anesthesiologists, or CRNAs).
 type AIP struct {
 	HL7                      HL7Name `hl7:",name=AIP,type=s"`
-	SetID                    SI      `hl7:"1,seq,required,len=4,display=Set Id - Aip"`
-	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Aip"`
+	SegmentActionCode        ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	PersonnelResourceID      []XCN   `hl7:"3,conditional,display=Personnel Resource Id"`
 	ResourceType             *CWE    `hl7:"4,conditional,table=0182,display=Resource Type"`
 	ResourceGroup            *CWE    `hl7:"5,display=Resource Group"`
 	StartDateTime            DTM     `hl7:"6,conditional,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset      NM      `hl7:"7,conditional,display=Start Date/Time Offset"`
+	StartDateTimeOffset      NM      `hl7:"7,conditional,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits *CNE    `hl7:"8,conditional,display=Start Date/Time Offset Units"`
-	Duration                 NM      `hl7:"9,display=Duration"`
+	Duration                 NM      `hl7:"9,datatype=NM,display=Duration"`
 	DurationUnits            *CNE    `hl7:"10,display=Duration Units"`
 	AllowSubstitutionCode    *CWE    `hl7:"11,conditional,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode         *CWE    `hl7:"12,conditional,table=0278,display=Filler Status Code"`
@@ -173,13 +173,13 @@ type AIP struct {
 // by a schedule are not identified on a schedule request using this segment.
 type AIS struct {
 	HL7                                  HL7Name `hl7:",name=AIS,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set Id - Ais"`
-	SegmentActionCode                    ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Ais"`
+	SegmentActionCode                    ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	UniversalServiceIdentifier           CWE     `hl7:"3,required,display=Universal Service Identifier"`
 	StartDateTime                        DTM     `hl7:"4,conditional,format=YMDHM,display=Start Date/Time"`
-	StartDateTimeOffset                  NM      `hl7:"5,conditional,display=Start Date/Time Offset"`
+	StartDateTimeOffset                  NM      `hl7:"5,conditional,datatype=NM,display=Start Date/Time Offset"`
 	StartDateTimeOffsetUnits             *CNE    `hl7:"6,conditional,display=Start Date/Time Offset Units"`
-	Duration                             NM      `hl7:"7,display=Duration"`
+	Duration                             NM      `hl7:"7,datatype=NM,display=Duration"`
 	DurationUnits                        *CNE    `hl7:"8,display=Duration Units"`
 	AllowSubstitutionCode                *CWE    `hl7:"9,conditional,table=0279,display=Allow Substitution Code"`
 	FillerStatusCode                     *CWE    `hl7:"10,conditional,table=0278,display=Filler Status Code"`
@@ -193,7 +193,7 @@ type AIS struct {
 // tables. Each AL1 segment describes a single patient allergy.
 type AL1 struct {
 	HL7                             HL7Name `hl7:",name=AL1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set Id - Al1"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Al1"`
 	AllergenTypeCode                *CWE    `hl7:"2,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription CWE     `hl7:"3,required,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode             *CWE    `hl7:"4,table=0128,display=Allergy Severity Code"`
@@ -215,7 +215,7 @@ type APR struct {
 	TimeSelectionCriteria     []SCV   `hl7:"1,display=Time Selection Criteria"`
 	ResourceSelectionCriteria []SCV   `hl7:"2,display=Resource Selection Criteria"`
 	LocationSelectionCriteria []SCV   `hl7:"3,display=Location Selection Criteria"`
-	SlotSpacingCriteria       NM      `hl7:"4,display=Slot Spacing Criteria"`
+	SlotSpacingCriteria       NM      `hl7:"4,datatype=NM,display=Slot Spacing Criteria"`
 	FillerOverrideCriteria    []SCV   `hl7:"5,display=Filler Override Criteria"`
 }
 
@@ -227,13 +227,13 @@ type ARQ struct {
 	HL7                         HL7Name `hl7:",name=ARQ,type=s"`
 	PlacerAppointmentID         EI      `hl7:"1,required,display=Placer Appointment Id"`
 	FillerAppointmentID         *EI     `hl7:"2,conditional,display=Filler Appointment Id"`
-	OccurrenceNumber            NM      `hl7:"3,conditional,display=Occurrence Number"`
+	OccurrenceNumber            NM      `hl7:"3,conditional,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber           *EI     `hl7:"4,display=Placer Group Number"`
 	ScheduleID                  *CWE    `hl7:"5,display=Schedule Id"`
 	RequestEventReason          *CWE    `hl7:"6,display=Request Event Reason"`
 	AppointmentReason           *CWE    `hl7:"7,table=0276,display=Appointment Reason"`
 	AppointmentType             *CWE    `hl7:"8,table=0277,display=Appointment Type"`
-	AppointmentDuration         NM      `hl7:"9,display=Appointment Duration"`
+	AppointmentDuration         NM      `hl7:"9,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits    *CNE    `hl7:"10,display=Appointment Duration Units"`
 	RequestedStartDateTimeRange []DR    `hl7:"11,display=Requested Start Date/Time Range"`
 	Priority                    ST      `hl7:"12,display=Priority-arq"`
@@ -289,7 +289,7 @@ type ARQ struct {
 // information.
 type ARV struct {
 	HL7                                  HL7Name `hl7:",name=ARV,type=s"`
-	SetID                                SI      `hl7:"1,seq,len=4,display=Set Id"`
+	SetID                                SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id"`
 	AccessRestrictionActionCode          CNE     `hl7:"2,required,table=0206,display=Access Restriction Action Code"`
 	AccessRestrictionValue               CWE     `hl7:"3,required,table=0717,display=Access Restriction Value"`
 	AccessRestrictionReason              []CWE   `hl7:"4,table=0719,display=Access Restriction Reason"`
@@ -322,8 +322,8 @@ type AUT struct {
 // The BHS segment defines the start of a batch.
 type BHS struct {
 	HL7                          HL7Name `hl7:",name=BHS,type=s"`
-	BatchFieldSeparator          ST      `hl7:"1,required,len=1,display=Batch Field Separator"`
-	BatchEncodingCharacters      ST      `hl7:"2,required,len=5,display=Batch Encoding Characters"`
+	BatchFieldSeparator          ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=Batch Field Separator"`
+	BatchEncodingCharacters      ST      `hl7:"2,noescape,fieldchars,required,len=5,display=Batch Encoding Characters"`
 	BatchSendingApplication      *HD     `hl7:"3,display=Batch Sending Application"`
 	BatchSendingFacility         *HD     `hl7:"4,display=Batch Sending Facility"`
 	BatchReceivingApplication    *HD     `hl7:"5,display=Batch Receiving Application"`
@@ -354,7 +354,7 @@ type BLC struct {
 type BLG struct {
 	HL7              HL7Name `hl7:",name=BLG,type=s"`
 	WhenToCharge     *CCD    `hl7:"1,display=When To Charge"`
-	ChargeType       ID      `hl7:"2,len=2,table=0122,display=Charge Type"`
+	ChargeType       ID      `hl7:"2,len=2,table=0122,datatype=ID,display=Charge Type"`
 	AccountID        *CX     `hl7:"3,display=Account Id"`
 	ChargeTypeReason *CWE    `hl7:"4,table=0475,display=Charge Type Reason"`
 }
@@ -366,11 +366,11 @@ type BLG struct {
 // processing requirements (e.g., irradiation and leukoreduction) and the amount of the blood product to be administered.
 type BPO struct {
 	HL7                            HL7Name `hl7:",name=BPO,type=s"`
-	SetID                          SI      `hl7:"1,seq,required,len=4,display=Set Id - Bpo"`
+	SetID                          SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Bpo"`
 	BpUniversalServiceIdentifier   CWE     `hl7:"2,required,table=9999,display=Bp Universal Service Identifier"`
 	BpProcessingRequirements       []CWE   `hl7:"3,table=0508,display=Bp  Processing Requirements"`
-	BpQuantity                     NM      `hl7:"4,required,display=Bp Quantity"`
-	BpAmount                       NM      `hl7:"5,display=Bp Amount"`
+	BpQuantity                     NM      `hl7:"4,required,datatype=NM,display=Bp Quantity"`
+	BpAmount                       NM      `hl7:"5,datatype=NM,display=Bp Amount"`
 	BpUnits                        *CWE    `hl7:"6,table=9999,display=Bp Units"`
 	BpIntendedUseDateTime          DTM     `hl7:"7,format=YMDHM,display=Bp Intended Use Date/Time"`
 	BpIntendedDispenseFromLocation *PL     `hl7:"8,display=Bp Intended Dispense From Location"`
@@ -379,7 +379,7 @@ type BPO struct {
 	BpRequestedDispenseToLocation  *PL     `hl7:"11,display=Bp Requested Dispense To Location"`
 	BpRequestedDispenseToAddress   *XAD    `hl7:"12,display=Bp Requested Dispense To Address"`
 	BpIndicationForUse             []CWE   `hl7:"13,table=0509,display=Bp Indication For Use"`
-	BpInformedConsentIndicator     ID      `hl7:"14,len=1,table=0136,display=Bp Informed Consent Indicator"`
+	BpInformedConsentIndicator     ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Bp Informed Consent Indicator"`
 }
 
 // Blood Product Dispense Status
@@ -405,9 +405,9 @@ type BPO struct {
 // assigned a unique donation number.
 type BPX struct {
 	HL7                         HL7Name `hl7:",name=BPX,type=s"`
-	SetID                       SI      `hl7:"1,seq,required,len=4,display=Set Id - Bpx"`
+	SetID                       SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Bpx"`
 	BpDispenseStatus            CWE     `hl7:"2,required,table=0510,display=Bp Dispense Status"`
-	BpStatus                    ID      `hl7:"3,required,len=1,table=0511,display=Bp Status"`
+	BpStatus                    ID      `hl7:"3,required,len=1,table=0511,datatype=ID,display=Bp Status"`
 	BpDateTimeOfStatus          DTM     `hl7:"4,required,format=YMDHM,display=Bp Date/Time Of Status"`
 	BcDonationID                *EI     `hl7:"5,conditional,display=Bc Donation Id"`
 	BcComponent                 *CNE    `hl7:"6,conditional,table=9999,display=Bc Component"`
@@ -418,8 +418,8 @@ type BPX struct {
 	BpBloodGroup                *CNE    `hl7:"11,table=9999,display=Bp Blood Group"`
 	BcSpecialTesting            []CNE   `hl7:"12,table=9999,display=Bc Special Testing"`
 	BpExpirationDateTime        DTM     `hl7:"13,format=YMDHM,display=Bp Expiration Date/Time"`
-	BpQuantity                  NM      `hl7:"14,required,display=Bp Quantity"`
-	BpAmount                    NM      `hl7:"15,display=Bp Amount"`
+	BpQuantity                  NM      `hl7:"14,required,datatype=NM,display=Bp Quantity"`
+	BpAmount                    NM      `hl7:"15,datatype=NM,display=Bp Amount"`
 	BpUnits                     *CWE    `hl7:"16,table=9999,display=Bp Units"`
 	BpUniqueID                  *EI     `hl7:"17,display=Bp Unique Id"`
 	BpActualDispensedToLocation *PL     `hl7:"18,display=Bp Actual Dispensed To Location"`
@@ -435,7 +435,7 @@ type BTS struct {
 	HL7               HL7Name `hl7:",name=BTS,type=s"`
 	BatchMessageCount ST      `hl7:"1,display=Batch Message Count"`
 	BatchComment      ST      `hl7:"2,display=Batch Comment"`
-	BatchTotals       []NM    `hl7:"3,display=Batch Totals"`
+	BatchTotals       []NM    `hl7:"3,datatype=NM,display=Batch Totals"`
 }
 
 // Blood Product Transfusion/disposition
@@ -456,18 +456,18 @@ type BTS struct {
 // assigned a unique donation number.
 type BTX struct {
 	HL7                                HL7Name `hl7:",name=BTX,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set Id - Btx"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Btx"`
 	BcDonationID                       *EI     `hl7:"2,conditional,display=Bc Donation Id"`
 	BcComponent                        *CNE    `hl7:"3,conditional,table=9999,display=Bc Component"`
 	BcBloodGroup                       *CNE    `hl7:"4,conditional,table=9999,display=Bc Blood Group"`
 	CpCommercialProduct                *CWE    `hl7:"5,conditional,table=0512,display=Cp Commercial Product"`
 	CpManufacturer                     *XON    `hl7:"6,conditional,display=Cp Manufacturer"`
 	CpLotNumber                        *EI     `hl7:"7,conditional,display=Cp Lot Number"`
-	BpQuantity                         NM      `hl7:"8,required,display=Bp Quantity"`
-	BpAmount                           NM      `hl7:"9,display=Bp Amount"`
+	BpQuantity                         NM      `hl7:"8,required,datatype=NM,display=Bp Quantity"`
+	BpAmount                           NM      `hl7:"9,datatype=NM,display=Bp Amount"`
 	BpUnits                            *CWE    `hl7:"10,table=9999,display=Bp Units"`
 	BpTransfusionDispositionStatus     CWE     `hl7:"11,required,table=0513,display=Bp Transfusion/Disposition Status"`
-	BpMessageStatus                    ID      `hl7:"12,required,len=1,table=0511,display=Bp Message Status"`
+	BpMessageStatus                    ID      `hl7:"12,required,len=1,table=0511,datatype=ID,display=Bp Message Status"`
 	BpDateTimeOfStatus                 DTM     `hl7:"13,required,format=YMDHM,display=Bp Date/Time Of Status"`
 	BpTransfusionAdministrator         *XCN    `hl7:"14,display=Bp Transfusion Administrator"`
 	BpTransfusionVerifier              *XCN    `hl7:"15,display=Bp Transfusion Verifier"`
@@ -500,12 +500,12 @@ type CDM struct {
 	DescriptionOverrideIndicator *CWE    `hl7:"5,table=0268,display=Description Override Indicator"`
 	ExplodingCharges             []CWE   `hl7:"6,table=0132,display=Exploding Charges"`
 	ProcedureCode                []CNE   `hl7:"7,table=0088,display=Procedure Code"`
-	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag           ID      `hl7:"8,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	InventoryNumber              []CWE   `hl7:"9,table=0463,display=Inventory Number"`
-	ResourceLoad                 NM      `hl7:"10,display=Resource Load"`
+	ResourceLoad                 NM      `hl7:"10,datatype=NM,display=Resource Load"`
 	ContractNumber               []CX    `hl7:"11,display=Contract Number"`
 	ContractOrganization         []XON   `hl7:"12,display=Contract Organization"`
-	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,display=Room Fee Indicator"`
+	RoomFeeIndicator             ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Room Fee Indicator"`
 }
 
 // Certificate Detail
@@ -514,13 +514,13 @@ type CDM struct {
 // held by the health professional identified by the STF segment.
 type CER struct {
 	HL7                                HL7Name `hl7:",name=CER,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set Id - Cer"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Cer"`
 	SerialNumber                       ST      `hl7:"2,display=Serial Number"`
 	Version                            ST      `hl7:"3,display=Version"`
 	GrantingAuthority                  *XON    `hl7:"4,display=Granting Authority"`
 	IssuingAuthority                   *XCN    `hl7:"5,display=Issuing Authority"`
 	Signature                          *ED     `hl7:"6,display=Signature"`
-	GrantingCountry                    ID      `hl7:"7,len=3,table=0399,display=Granting Country"`
+	GrantingCountry                    ID      `hl7:"7,len=3,table=0399,datatype=ID,display=Granting Country"`
 	GrantingStateProvince              *CWE    `hl7:"8,table=0347,display=Granting State/Province"`
 	GrantingCountyParish               *CWE    `hl7:"9,table=0289,display=Granting County/Parish"`
 	CertificateType                    *CWE    `hl7:"10,display=Certificate Type"`
@@ -530,9 +530,9 @@ type CER struct {
 	SubjectDirectoryAttributeExtension []CWE   `hl7:"14,display=Subject Directory Attribute Extension"`
 	SubjectPublicKeyInfo               *CWE    `hl7:"15,display=Subject Public Key Info"`
 	AuthorityKeyIdentifier             *CWE    `hl7:"16,display=Authority Key Identifier"`
-	BasicConstraint                    ID      `hl7:"17,len=1,table=0136,display=Basic Constraint"`
+	BasicConstraint                    ID      `hl7:"17,len=1,table=0136,datatype=ID,display=Basic Constraint"`
 	CrlDistributionPoint               []CWE   `hl7:"18,display=Crl Distribution Point"`
-	JurisdictionCountry                ID      `hl7:"19,len=3,table=0399,display=Jurisdiction Country"`
+	JurisdictionCountry                ID      `hl7:"19,len=3,table=0399,datatype=ID,display=Jurisdiction Country"`
 	JurisdictionStateProvince          *CWE    `hl7:"20,table=0347,display=Jurisdiction State/Province"`
 	JurisdictionCountyParish           *CWE    `hl7:"21,table=0289,display=Jurisdiction County/Parish"`
 	JurisdictionBreadth                []CWE   `hl7:"22,table=0547,display=Jurisdiction Breadth"`
@@ -555,13 +555,13 @@ type CER struct {
 // unless otherwise agreed upon.
 type CM0 struct {
 	HL7                     HL7Name `hl7:",name=CM0,type=s"`
-	SetID                   SI      `hl7:"1,seq,len=4,display=Set Id - Cm0"`
+	SetID                   SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Cm0"`
 	SponsorStudyID          EI      `hl7:"2,required,display=Sponsor Study Id"`
 	AlternateStudyID        []EI    `hl7:"3,max=3,display=Alternate Study Id"`
 	TitleOfStudy            ST      `hl7:"4,required,display=Title Of Study"`
 	ChairmanOfStudy         []XCN   `hl7:"5,display=Chairman Of Study"`
 	LastIrbApprovalDate     DT      `hl7:"6,format=YMD,display=Last Irb Approval Date"`
-	TotalAccrualToDate      NM      `hl7:"7,display=Total Accrual To Date"`
+	TotalAccrualToDate      NM      `hl7:"7,datatype=NM,display=Total Accrual To Date"`
 	LastAccrualDate         DT      `hl7:"8,format=YMD,display=Last Accrual Date"`
 	ContactForStudy         []XCN   `hl7:"9,display=Contact For Study"`
 	ContactsTelephoneNumber *XTN    `hl7:"10,display=Contact's Telephone Number"`
@@ -576,7 +576,7 @@ type CM0 struct {
 // for the receiving system.
 type CM1 struct {
 	HL7                     HL7Name `hl7:",name=CM1,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set Id - Cm1"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Cm1"`
 	StudyPhaseIdentifier    CWE     `hl7:"2,required,display=Study Phase Identifier"`
 	DescriptionOfStudyPhase ST      `hl7:"3,required,display=Description Of Study Phase"`
 }
@@ -588,7 +588,7 @@ type CM1 struct {
 // sequence 2.  The CM2 segment describes the scheduled time points in general.
 type CM2 struct {
 	HL7                          HL7Name `hl7:",name=CM2,type=s"`
-	SetID                        SI      `hl7:"1,seq,len=4,display=Set Id- Cm2"`
+	SetID                        SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id- Cm2"`
 	ScheduledTimePoint           CWE     `hl7:"2,required,display=Scheduled Time Point"`
 	DescriptionOfTimePoint       ST      `hl7:"3,display=Description Of Time Point"`
 	EventsScheduledThisTimePoint []CWE   `hl7:"4,required,max=200,display=Events Scheduled This Time Point"`
@@ -600,8 +600,8 @@ type CM2 struct {
 // notifications.
 type CNS struct {
 	HL7                                 HL7Name `hl7:",name=CNS,type=s"`
-	StartingNotificationReferenceNumber NM      `hl7:"1,display=Starting Notification Reference Number"`
-	EndingNotificationReferenceNumber   NM      `hl7:"2,display=Ending Notification Reference Number"`
+	StartingNotificationReferenceNumber NM      `hl7:"1,datatype=NM,display=Starting Notification Reference Number"`
+	EndingNotificationReferenceNumber   NM      `hl7:"2,datatype=NM,display=Ending Notification Reference Number"`
 	StartingNotificationDateTime        DTM     `hl7:"3,format=YMDHM,display=Starting Notification Date/Time"`
 	EndingNotificationDateTime          DTM     `hl7:"4,format=YMDHM,display=Ending Notification Date/Time"`
 	StartingNotificationCode            *CWE    `hl7:"5,table=9999,display=Starting Notification Code"`
@@ -620,7 +620,7 @@ type CNS struct {
 // the patient), translators, consulting providers, etc., and the specific procedures being proposed (PR1).
 type CON struct {
 	HL7                                    HL7Name `hl7:",name=CON,type=s"`
-	SetID                                  SI      `hl7:"1,seq,required,len=4,display=Set Id - Con"`
+	SetID                                  SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Con"`
 	ConsentType                            *CWE    `hl7:"2,table=0496,display=Consent Type"`
 	ConsentFormIDAndVersion                ST      `hl7:"3,display=Consent Form Id And Version"`
 	ConsentFormNumber                      *EI     `hl7:"4,display=Consent Form Number"`
@@ -635,12 +635,12 @@ type CON struct {
 	ConsentDecisionDateTime                DTM     `hl7:"13,format=YMDHM,display=Consent Decision Date/Time"`
 	ConsentEffectiveDateTime               DTM     `hl7:"14,format=YMDHM,display=Consent Effective Date/Time"`
 	ConsentEndDateTime                     DTM     `hl7:"15,format=YMDHM,display=Consent End Date/Time"`
-	SubjectCompetenceIndicator             ID      `hl7:"16,len=1,table=0136,display=Subject Competence Indicator"`
-	TranslatorAssistanceIndicator          ID      `hl7:"17,len=1,table=0136,display=Translator Assistance Indicator"`
+	SubjectCompetenceIndicator             ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Subject Competence Indicator"`
+	TranslatorAssistanceIndicator          ID      `hl7:"17,len=1,table=0136,datatype=ID,display=Translator Assistance Indicator"`
 	LanguageTranslatedTo                   *CWE    `hl7:"18,table=0296,display=Language Translated To"`
-	InformationalMaterialSuppliedIndicator ID      `hl7:"19,len=1,table=0136,display=Informational Material Supplied Indicator"`
+	InformationalMaterialSuppliedIndicator ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Informational Material Supplied Indicator"`
 	ConsentBypassReason                    *CWE    `hl7:"20,table=0499,display=Consent Bypass Reason"`
-	ConsentDisclosureLevel                 ID      `hl7:"21,len=1,table=0500,display=Consent Disclosure Level"`
+	ConsentDisclosureLevel                 ID      `hl7:"21,len=1,table=0500,datatype=ID,display=Consent Disclosure Level"`
 	ConsentNonDisclosureReason             *CWE    `hl7:"22,table=0501,display=Consent Non-disclosure Reason"`
 	NonSubjectConsenterReason              *CWE    `hl7:"23,table=0502,display=Non-subject Consenter Reason"`
 	ConsenterID                            []XPN   `hl7:"24,required,display=Consenter Id"`
@@ -744,10 +744,10 @@ type CTI struct {
 // The disabled person code and identifier allow for the association of the disability information to the person.
 type DB1 struct {
 	HL7                        HL7Name `hl7:",name=DB1,type=s"`
-	SetID                      SI      `hl7:"1,seq,required,len=4,display=Set Id - Db1"`
+	SetID                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Db1"`
 	DisabledPersonCode         *CWE    `hl7:"2,table=0334,display=Disabled Person Code"`
 	DisabledPersonIdentifier   []CX    `hl7:"3,display=Disabled Person Identifier"`
-	DisabilityIndicator        ID      `hl7:"4,len=1,table=0136,display=Disability Indicator"`
+	DisabilityIndicator        ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Disability Indicator"`
 	DisabilityStartDate        DT      `hl7:"5,format=YMD,display=Disability Start Date"`
 	DisabilityEndDate          DT      `hl7:"6,format=YMD,display=Disability End Date"`
 	DisabilityReturnToWorkDate DT      `hl7:"7,format=YMD,display=Disability Return To Work Date"`
@@ -763,30 +763,30 @@ type DB1 struct {
 // are also defined.
 type DG1 struct {
 	HL7                             HL7Name `hl7:",name=DG1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set Id - Dg1"`
-	DiagnosisCodingMethod           ID      `hl7:"2,display=Diagnosis Coding Method"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Dg1"`
+	DiagnosisCodingMethod           ID      `hl7:"2,datatype=ID,display=Diagnosis Coding Method"`
 	DiagnosisCode                   CWE     `hl7:"3,required,table=0051,display=Diagnosis Code - Dg1"`
 	DiagnosisDescription            ST      `hl7:"4,display=Diagnosis Description"`
 	DiagnosisDateTime               DTM     `hl7:"5,format=YMDHM,display=Diagnosis Date/Time"`
 	DiagnosisType                   CWE     `hl7:"6,required,table=0052,display=Diagnosis Type"`
 	MajorDiagnosticCategory         CNE     `hl7:"7,table=0118,display=Major Diagnostic Category"`
 	DiagnosticRelatedGroup          CNE     `hl7:"8,table=0055,display=Diagnostic Related Group"`
-	DrgApprovalIndicator            ID      `hl7:"9,len=1,table=0136,display=Drg Approval Indicator"`
+	DrgApprovalIndicator            ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Drg Approval Indicator"`
 	DrgGrouperReviewCode            CWE     `hl7:"10,table=0056,display=Drg Grouper Review Code"`
 	OutlierType                     CWE     `hl7:"11,table=0083,display=Outlier Type"`
-	OutlierDays                     NM      `hl7:"12,display=Outlier Days"`
+	OutlierDays                     NM      `hl7:"12,datatype=NM,display=Outlier Days"`
 	OutlierCost                     CP      `hl7:"13,display=Outlier Cost"`
 	GrouperVersionAndType           ST      `hl7:"14,display=Grouper Version And Type"`
-	DiagnosisPriority               NM      `hl7:"15,table=0359,display=Diagnosis Priority"`
+	DiagnosisPriority               NM      `hl7:"15,table=0359,datatype=NM,display=Diagnosis Priority"`
 	DiagnosingClinician             []XCN   `hl7:"16,display=Diagnosing Clinician"`
 	DiagnosisClassification         *CWE    `hl7:"17,table=0228,display=Diagnosis Classification"`
-	ConfidentialIndicator           ID      `hl7:"18,len=1,table=0136,display=Confidential Indicator"`
+	ConfidentialIndicator           ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 	AttestationDateTime             DTM     `hl7:"19,format=YMDHM,display=Attestation Date/Time"`
 	DiagnosisIdentifier             *EI     `hl7:"20,conditional,display=Diagnosis Identifier"`
-	DiagnosisActionCode             ID      `hl7:"21,conditional,len=1,table=0206,display=Diagnosis Action Code"`
+	DiagnosisActionCode             ID      `hl7:"21,conditional,len=1,table=0206,datatype=ID,display=Diagnosis Action Code"`
 	ParentDiagnosis                 *EI     `hl7:"22,conditional,display=Parent Diagnosis"`
 	DrgCclValueCode                 *CWE    `hl7:"23,table=0728,display=Drg Ccl Value Code"`
-	DrgGroupingUsage                ID      `hl7:"24,len=1,table=0136,display=Drg Grouping Usage"`
+	DrgGroupingUsage                ID      `hl7:"24,len=1,table=0136,datatype=ID,display=Drg Grouping Usage"`
 	DrgDiagnosisDeterminationStatus *CWE    `hl7:"25,table=0731,display=Drg Diagnosis Determination Status"`
 	PresentOnAdmissionPoaIndicator  *CWE    `hl7:"26,table=0895,display=Present On Admission (poa) Indicator"`
 }
@@ -800,8 +800,8 @@ type DMI struct {
 	DiagnosticRelatedGroup  *CNE    `hl7:"1,table=0055,display=Diagnostic Related Group"`
 	MajorDiagnosticCategory *CNE    `hl7:"2,conditional,table=0118,display=Major Diagnostic Category"`
 	LowerAndUpperTrimPoints *NR     `hl7:"3,conditional,display=Lower And Upper Trim Points"`
-	AverageLengthOfStay     NM      `hl7:"4,conditional,display=Average Length Of Stay"`
-	RelativeWeight          NM      `hl7:"5,conditional,display=Relative Weight"`
+	AverageLengthOfStay     NM      `hl7:"4,conditional,datatype=NM,display=Average Length Of Stay"`
+	RelativeWeight          NM      `hl7:"5,conditional,datatype=NM,display=Relative Weight"`
 }
 
 // Diagnosis Related Group
@@ -812,19 +812,19 @@ type DRG struct {
 	HL7                        HL7Name `hl7:",name=DRG,type=s"`
 	DiagnosticRelatedGroup     *CNE    `hl7:"1,table=0055,display=Diagnostic Related Group"`
 	AssignedDateTime           DTM     `hl7:"2,format=YMDHM,display=Drg Assigned Date/Time"`
-	ApprovalIndicator          ID      `hl7:"3,len=1,table=0136,display=Drg Approval Indicator"`
+	ApprovalIndicator          ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Drg Approval Indicator"`
 	GrouperReviewCode          *CWE    `hl7:"4,table=0056,display=Drg Grouper Review Code"`
 	OutlierType                *CWE    `hl7:"5,table=0083,display=Outlier Type"`
-	OutlierDays                NM      `hl7:"6,display=Outlier Days"`
+	OutlierDays                NM      `hl7:"6,datatype=NM,display=Outlier Days"`
 	OutlierCost                *CP     `hl7:"7,display=Outlier Cost"`
 	Payor                      *CWE    `hl7:"8,table=0229,display=Drg Payor"`
 	OutlierReimbursement       *CP     `hl7:"9,display=Outlier Reimbursement"`
-	ConfidentialIndicator      ID      `hl7:"10,len=1,table=0136,display=Confidential Indicator"`
+	ConfidentialIndicator      ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Confidential Indicator"`
 	TransferType               *CWE    `hl7:"11,table=0415,display=Drg Transfer Type"`
 	NameOfCoder                *XPN    `hl7:"12,display=Name Of Coder"`
 	GrouperStatus              *CWE    `hl7:"13,table=0734,display=Grouper Status"`
 	PcclValueCode              *CWE    `hl7:"14,table=0728,display=Pccl Value Code"`
-	EffectiveWeight            NM      `hl7:"15,display=Effective Weight"`
+	EffectiveWeight            NM      `hl7:"15,datatype=NM,display=Effective Weight"`
 	MonetaryAmount             *MO     `hl7:"16,display=Monetary Amount"`
 	StatusPatient              *CWE    `hl7:"17,table=0739,display=Status Patient"`
 	GrouperSoftwareName        ST      `hl7:"18,display=Grouper Software Name"`
@@ -834,7 +834,7 @@ type DRG struct {
 	BasicCharge                *MO     `hl7:"22,display=Basic Charge"`
 	TotalCharge                *MO     `hl7:"23,display=Total Charge"`
 	DiscountSurcharge          *MO     `hl7:"24,display=Discount/Surcharge"`
-	CalculatedDays             NM      `hl7:"25,display=Calculated Days"`
+	CalculatedDays             NM      `hl7:"25,datatype=NM,display=Calculated Days"`
 	StatusGender               *CWE    `hl7:"26,table=0749,display=Status Gender"`
 	StatusAge                  *CWE    `hl7:"27,table=0749,display=Status Age"`
 	StatusLengthOfStay         *CWE    `hl7:"28,table=0749,display=Status Length Of Stay"`
@@ -851,7 +851,7 @@ type DRG struct {
 type DSC struct {
 	HL7                 HL7Name `hl7:",name=DSC,type=s"`
 	ContinuationPointer ST      `hl7:"1,display=Continuation Pointer"`
-	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,display=Continuation Style"`
+	ContinuationStyle   ID      `hl7:"2,len=1,table=0398,datatype=ID,display=Continuation Style"`
 }
 
 // Display Data
@@ -860,8 +860,8 @@ type DSC struct {
 // data is lost; the data is simply treated as lines of text.
 type DSP struct {
 	HL7               HL7Name `hl7:",name=DSP,type=s"`
-	SetID             SI      `hl7:"1,seq,len=4,display=Set Id - Dsp"`
-	DisplayLevel      SI      `hl7:"2,len=4,display=Display Level"`
+	SetID             SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Dsp"`
+	DisplayLevel      SI      `hl7:"2,len=4,datatype=SI,display=Display Level"`
 	DataLine          TX      `hl7:"3,required,display=Data Line"`
 	LogicalBreakPoint ST      `hl7:"4,display=Logical Break Point"`
 	ResultID          TX      `hl7:"5,display=Result Id"`
@@ -872,9 +872,9 @@ type DSP struct {
 // The equipment command segment contains the information required to notify the receiving component what is to happen.
 type ECD struct {
 	HL7                     HL7Name `hl7:",name=ECD,type=s"`
-	ReferenceCommandNumber  NM      `hl7:"1,required,display=Reference Command Number"`
+	ReferenceCommandNumber  NM      `hl7:"1,required,datatype=NM,display=Reference Command Number"`
 	RemoteControlCommand    CWE     `hl7:"2,required,table=0368,display=Remote Control Command"`
-	ResponseRequired        ID      `hl7:"3,len=1,table=0136,display=Response Required"`
+	ResponseRequired        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Response Required"`
 	RequestedCompletionTime ST      `hl7:"4,display=Requested Completion Time"`
 	Parameters              []TX    `hl7:"5,display=Parameters"`
 }
@@ -895,7 +895,7 @@ type ECR struct {
 // may optionally follow an STF segment.  An EDU segment must always have been preceded by a corresponding STF segment.
 type EDU struct {
 	HL7                                         HL7Name `hl7:",name=EDU,type=s"`
-	SetID                                       SI      `hl7:"1,seq,required,len=4,display=Set Id - Edu"`
+	SetID                                       SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Edu"`
 	AcademicDegree                              *CWE    `hl7:"2,table=0360,display=Academic Degree"`
 	AcademicDegreeProgramDateRange              *DR     `hl7:"3,display=Academic Degree Program Date Range"`
 	AcademicDegreeProgramParticipationDateRange *DR     `hl7:"4,display=Academic Degree Program Participation Date Range"`
@@ -992,7 +992,7 @@ type ERR struct {
 	ErrorCodeAndLocation      ST      `hl7:"1,display=Error Code And Location"`
 	ErrorLocation             []ERL   `hl7:"2,display=Error Location"`
 	Hl7ErrorCode              CWE     `hl7:"3,required,table=0357,display=Hl7 Error Code"`
-	Severity                  ID      `hl7:"4,required,len=1,table=0516,display=Severity"`
+	Severity                  ID      `hl7:"4,required,len=1,table=0516,datatype=ID,display=Severity"`
 	ApplicationErrorCode      *CWE    `hl7:"5,table=0533,display=Application Error Code"`
 	ApplicationErrorParameter []ST    `hl7:"6,max=10,display=Application Error Parameter"`
 	DiagnosticInformation     TX      `hl7:"7,display=Diagnostic Information"`
@@ -1023,8 +1023,8 @@ type EVN struct {
 // The FHS segment is used to head a file (group of batches) as defined in Section 2.10.3, "HL7 batch protocol".
 type FHS struct {
 	HL7                         HL7Name `hl7:",name=FHS,type=s"`
-	FileFieldSeparator          ST      `hl7:"1,required,len=1,display=File Field Separator"`
-	FileEncodingCharacters      ST      `hl7:"2,required,len=5,display=File Encoding Characters"`
+	FileFieldSeparator          ST      `hl7:"1,noescape,fieldsep,omit,required,len=1,display=File Field Separator"`
+	FileEncodingCharacters      ST      `hl7:"2,noescape,fieldchars,required,len=5,display=File Encoding Characters"`
 	FileSendingApplication      *HD     `hl7:"3,display=File Sending Application"`
 	FileSendingFacility         *HD     `hl7:"4,display=File Sending Facility"`
 	FileReceivingApplication    *HD     `hl7:"5,display=File Receiving Application"`
@@ -1045,7 +1045,7 @@ type FHS struct {
 // records.
 type FT1 struct {
 	HL7                                        HL7Name `hl7:",name=FT1,type=s"`
-	SetID                                      SI      `hl7:"1,seq,len=4,display=Set Id - Ft1"`
+	SetID                                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Ft1"`
 	TransactionID                              ST      `hl7:"2,len=12,display=Transaction Id"`
 	TransactionBatchID                         ST      `hl7:"3,len=10,display=Transaction Batch Id"`
 	TransactionDate                            DR      `hl7:"4,required,display=Transaction Date"`
@@ -1054,7 +1054,7 @@ type FT1 struct {
 	TransactionCode                            CWE     `hl7:"7,required,table=0132,display=Transaction Code"`
 	TransactionDescription                     ST      `hl7:"8,display=Transaction Description"`
 	TransactionDescriptionAlt                  ST      `hl7:"9,display=Transaction Description - Alt"`
-	TransactionQuantity                        NM      `hl7:"10,display=Transaction Quantity"`
+	TransactionQuantity                        NM      `hl7:"10,datatype=NM,display=Transaction Quantity"`
 	TransactionAmountExtended                  *CP     `hl7:"11,display=Transaction Amount - Extended"`
 	TransactionAmountUnit                      *CP     `hl7:"12,display=Transaction Amount - Unit"`
 	DepartmentCode                             *CWE    `hl7:"13,table=0049,display=Department Code"`
@@ -1075,7 +1075,7 @@ type FT1 struct {
 	MedicallyNecessaryDuplicateProcedureReason *CWE    `hl7:"28,table=0476,display=Medically Necessary Duplicate Procedure Reason"`
 	NdcCode                                    *CWE    `hl7:"29,table=0549,display=Ndc Code"`
 	PaymentReferenceID                         *CX     `hl7:"30,display=Payment Reference Id"`
-	TransactionReferenceKey                    []SI    `hl7:"31,len=4,display=Transaction Reference Key"`
+	TransactionReferenceKey                    []SI    `hl7:"31,len=4,datatype=SI,display=Transaction Reference Key"`
 	PerformingFacility                         []XON   `hl7:"32,display=Performing Facility"`
 	OrderingFacility                           *XON    `hl7:"33,display=Ordering Facility"`
 	ItemNumber                                 *CWE    `hl7:"34,display=Item Number"`
@@ -1095,7 +1095,7 @@ type FT1 struct {
 // The FTS segment defines the end of a file.
 type FTS struct {
 	HL7                HL7Name `hl7:",name=FTS,type=s"`
-	FileBatchCount     NM      `hl7:"1,display=File Batch Count"`
+	FileBatchCount     NM      `hl7:"1,datatype=NM,display=File Batch Count"`
 	FileTrailerComment ST      `hl7:"2,display=File Trailer Comment"`
 }
 
@@ -1108,12 +1108,12 @@ type FTS struct {
 // version of that data.
 type GOL struct {
 	HL7                         HL7Name `hl7:",name=GOL,type=s"`
-	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                  ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime              DTM     `hl7:"2,required,format=YMDHM,display=Action Date/Time"`
 	GoalID                      CWE     `hl7:"3,required,display=Goal Id"`
 	GoalInstanceID              EI      `hl7:"4,required,display=Goal Instance Id"`
 	EpisodeOfCareID             *EI     `hl7:"5,display=Episode Of Care Id"`
-	GoalListPriority            NM      `hl7:"6,display=Goal List Priority"`
+	GoalListPriority            NM      `hl7:"6,datatype=NM,display=Goal List Priority"`
 	GoalEstablishedDateTime     DTM     `hl7:"7,format=YMDHM,display=Goal Established Date/Time"`
 	ExpectedGoalAchieveDateTime DTM     `hl7:"8,format=YMDHM,display=Expected Goal Achieve Date/Time"`
 	GoalClassification          *CWE    `hl7:"9,display=Goal Classification"`
@@ -1153,7 +1153,7 @@ type GP1 struct {
 type GP2 struct {
 	HL7                                 HL7Name `hl7:",name=GP2,type=s"`
 	RevenueCode                         *CWE    `hl7:"1,table=0456,display=Revenue Code"`
-	NumberOfServiceUnits                NM      `hl7:"2,display=Number Of Service Units"`
+	NumberOfServiceUnits                NM      `hl7:"2,datatype=NM,display=Number Of Service Units"`
 	Charge                              *CP     `hl7:"3,display=Charge"`
 	ReimbursementActionCode             *CWE    `hl7:"4,table=0459,display=Reimbursement Action Code"`
 	DenialOrRejectionCode               *CWE    `hl7:"5,table=0460,display=Denial Or Rejection Code"`
@@ -1165,7 +1165,7 @@ type GP2 struct {
 	ExpectedCmsPaymentAmount            *CP     `hl7:"11,display=Expected Cms Payment Amount"`
 	ReimbursementTypeCode               *CWE    `hl7:"12,table=0470,display=Reimbursement Type Code"`
 	CoPayAmount                         *CP     `hl7:"13,display=Co-pay Amount"`
-	PayRatePerServiceUnit               NM      `hl7:"14,display=Pay Rate Per Service Unit"`
+	PayRatePerServiceUnit               NM      `hl7:"14,datatype=NM,display=Pay Rate Per Service Unit"`
 }
 
 // Guarantor
@@ -1174,7 +1174,7 @@ type GP2 struct {
 // a patient account) data for patient and insurance billing applications.
 type GT1 struct {
 	HL7                                HL7Name `hl7:",name=GT1,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set Id - Gt1"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Gt1"`
 	GuarantorNumber                    []CX    `hl7:"2,display=Guarantor Number"`
 	GuarantorName                      []XPN   `hl7:"3,required,display=Guarantor Name"`
 	GuarantorSpouseName                []XPN   `hl7:"4,display=Guarantor Spouse Name"`
@@ -1188,20 +1188,20 @@ type GT1 struct {
 	GuarantorSsn                       ST      `hl7:"12,display=Guarantor Ssn"`
 	GuarantorDateBegin                 DT      `hl7:"13,format=YMD,display=Guarantor Date - Begin"`
 	GuarantorDateEnd                   DT      `hl7:"14,format=YMD,display=Guarantor Date - End"`
-	GuarantorPriority                  NM      `hl7:"15,len=2,display=Guarantor Priority"`
+	GuarantorPriority                  NM      `hl7:"15,len=2,datatype=NM,display=Guarantor Priority"`
 	GuarantorEmployerName              []XPN   `hl7:"16,display=Guarantor Employer Name"`
 	GuarantorEmployerAddress           []XAD   `hl7:"17,display=Guarantor Employer Address"`
 	GuarantorEmployerPhoneNumber       []XTN   `hl7:"18,display=Guarantor Employer Phone Number"`
 	GuarantorEmployeeIDNumber          []CX    `hl7:"19,display=Guarantor Employee Id Number"`
 	GuarantorEmploymentStatus          *CWE    `hl7:"20,table=0066,display=Guarantor Employment Status"`
 	GuarantorOrganizationName          []XON   `hl7:"21,display=Guarantor Organization Name"`
-	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,display=Guarantor Billing Hold Flag"`
+	GuarantorBillingHoldFlag           ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Guarantor Billing Hold Flag"`
 	GuarantorCreditRatingCode          *CWE    `hl7:"23,table=0341,display=Guarantor Credit Rating Code"`
 	GuarantorDeathDateAndTime          DTM     `hl7:"24,format=YMDHM,display=Guarantor Death Date And Time"`
-	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,display=Guarantor Death Flag"`
+	GuarantorDeathFlag                 ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Guarantor Death Flag"`
 	GuarantorChargeAdjustmentCode      *CWE    `hl7:"26,table=0218,display=Guarantor Charge Adjustment Code"`
 	GuarantorHouseholdAnnualIncome     *CP     `hl7:"27,display=Guarantor Household Annual Income"`
-	GuarantorHouseholdSize             NM      `hl7:"28,display=Guarantor Household Size"`
+	GuarantorHouseholdSize             NM      `hl7:"28,datatype=NM,display=Guarantor Household Size"`
 	GuarantorEmployerIDNumber          []CX    `hl7:"29,display=Guarantor Employer Id Number"`
 	GuarantorMaritalStatusCode         *CWE    `hl7:"30,table=0002,display=Guarantor Marital Status Code"`
 	GuarantorHireEffectiveDate         DT      `hl7:"31,format=YMD,display=Guarantor Hire Effective Date"`
@@ -1212,7 +1212,7 @@ type GT1 struct {
 	PrimaryLanguage                    *CWE    `hl7:"36,table=0296,display=Primary Language"`
 	LivingArrangement                  *CWE    `hl7:"37,table=0220,display=Living Arrangement"`
 	PublicityCode                      *CWE    `hl7:"38,table=0215,display=Publicity Code"`
-	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                ID      `hl7:"39,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	StudentIndicator                   *CWE    `hl7:"40,table=0231,display=Student Indicator"`
 	Religion                           *CWE    `hl7:"41,table=0006,display=Religion"`
 	MothersMaidenName                  []XPN   `hl7:"42,display=Mother's Maiden Name"`
@@ -1249,7 +1249,7 @@ type Hxx struct {
 // 2.10.4.1.
 type IAM struct {
 	HL7                                  HL7Name `hl7:",name=IAM,type=s"`
-	SetID                                SI      `hl7:"1,seq,required,len=4,display=Set Id - Iam"`
+	SetID                                SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Iam"`
 	AllergenTypeCode                     *CWE    `hl7:"2,table=0127,display=Allergen Type Code"`
 	AllergenCodeMnemonicDescription      CWE     `hl7:"3,required,display=Allergen Code/Mnemonic/Description"`
 	AllergySeverityCode                  *CWE    `hl7:"4,table=0128,display=Allergy Severity Code"`
@@ -1308,11 +1308,11 @@ type IIM struct {
 	InventoryManufacturerName     *CWE    `hl7:"5,display=Inventory Manufacturer Name"`
 	InventoryLocation             *CWE    `hl7:"6,display=Inventory Location"`
 	InventoryReceivedDate         DTM     `hl7:"7,format=YMDHM,display=Inventory Received Date"`
-	InventoryReceivedQuantity     NM      `hl7:"8,display=Inventory Received Quantity"`
+	InventoryReceivedQuantity     NM      `hl7:"8,datatype=NM,display=Inventory Received Quantity"`
 	InventoryReceivedQuantityUnit *CWE    `hl7:"9,table=0818,display=Inventory Received Quantity Unit"`
 	InventoryReceivedItemCost     *MO     `hl7:"10,display=Inventory Received Item Cost"`
 	InventoryOnHandDate           DTM     `hl7:"11,format=YMDHM,display=Inventory On Hand Date"`
-	InventoryOnHandQuantity       NM      `hl7:"12,display=Inventory On Hand Quantity"`
+	InventoryOnHandQuantity       NM      `hl7:"12,datatype=NM,display=Inventory On Hand Quantity"`
 	InventoryOnHandQuantityUnit   *CWE    `hl7:"13,table=0818,display=Inventory On Hand Quantity Unit"`
 	ProcedureCode                 *CNE    `hl7:"14,table=0088,display=Procedure Code"`
 	ProcedureCodeModifier         []CNE   `hl7:"15,table=0340,display=Procedure Code Modifier"`
@@ -1327,15 +1327,15 @@ type IIM struct {
 // inventory.
 type ILT struct {
 	HL7                           HL7Name `hl7:",name=ILT,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set Id - Ilt"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Ilt"`
 	InventoryLotNumber            ST      `hl7:"2,required,display=Inventory Lot Number"`
 	InventoryExpirationDate       DTM     `hl7:"3,format=YMDHM,display=Inventory Expiration Date"`
 	InventoryReceivedDate         DTM     `hl7:"4,format=YMDHM,display=Inventory Received Date"`
-	InventoryReceivedQuantity     NM      `hl7:"5,display=Inventory Received Quantity"`
+	InventoryReceivedQuantity     NM      `hl7:"5,datatype=NM,display=Inventory Received Quantity"`
 	InventoryReceivedQuantityUnit *CWE    `hl7:"6,table=0818,display=Inventory Received Quantity Unit"`
 	InventoryReceivedItemCost     *MO     `hl7:"7,display=Inventory Received Item Cost"`
 	InventoryOnHandDate           DTM     `hl7:"8,format=YMDHM,display=Inventory On Hand Date"`
-	InventoryOnHandQuantity       NM      `hl7:"9,display=Inventory On Hand Quantity"`
+	InventoryOnHandQuantity       NM      `hl7:"9,datatype=NM,display=Inventory On Hand Quantity"`
 	InventoryOnHandQuantityUnit   *CWE    `hl7:"10,table=0818,display=Inventory On Hand Quantity Unit"`
 }
 
@@ -1345,7 +1345,7 @@ type ILT struct {
 // and insurance bills.
 type IN1 struct {
 	HL7                           HL7Name `hl7:",name=IN1,type=s"`
-	SetID                         SI      `hl7:"1,seq,required,len=4,display=Set Id - In1"`
+	SetID                         SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - In1"`
 	HealthPlanID                  CWE     `hl7:"2,required,table=0072,display=Health Plan Id"`
 	InsuranceCompanyID            []CX    `hl7:"3,required,display=Insurance Company Id"`
 	InsuranceCompanyName          []XON   `hl7:"4,display=Insurance Company Name"`
@@ -1367,9 +1367,9 @@ type IN1 struct {
 	AssignmentOfBenefits          *CWE    `hl7:"20,table=0135,display=Assignment Of Benefits"`
 	CoordinationOfBenefits        *CWE    `hl7:"21,table=0173,display=Coordination Of Benefits"`
 	CoordOfBenPriority            ST      `hl7:"22,display=Coord Of Ben. Priority"`
-	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,display=Notice Of Admission Flag"`
+	NoticeOfAdmissionFlag         ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Notice Of Admission Flag"`
 	NoticeOfAdmissionDate         DT      `hl7:"24,format=YMD,display=Notice Of Admission Date"`
-	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,display=Report Of Eligibility Flag"`
+	ReportOfEligibilityFlag       ID      `hl7:"25,len=1,table=0136,datatype=ID,display=Report Of Eligibility Flag"`
 	ReportOfEligibilityDate       DT      `hl7:"26,format=YMD,display=Report Of Eligibility Date"`
 	ReleaseInformationCode        *CWE    `hl7:"27,table=0093,display=Release Information Code"`
 	PreAdmitCertPac               ST      `hl7:"28,display=Pre-admit Cert (pac)"`
@@ -1377,13 +1377,13 @@ type IN1 struct {
 	VerificationBy                []XCN   `hl7:"30,display=Verification By"`
 	TypeOfAgreementCode           *CWE    `hl7:"31,table=0098,display=Type Of Agreement Code"`
 	BillingStatus                 *CWE    `hl7:"32,table=0022,display=Billing Status"`
-	LifetimeReserveDays           NM      `hl7:"33,display=Lifetime Reserve Days"`
-	DelayBeforeLRDay              NM      `hl7:"34,display=Delay Before L.r. Day"`
+	LifetimeReserveDays           NM      `hl7:"33,datatype=NM,display=Lifetime Reserve Days"`
+	DelayBeforeLRDay              NM      `hl7:"34,datatype=NM,display=Delay Before L.r. Day"`
 	CompanyPlanCode               *CWE    `hl7:"35,table=0042,display=Company Plan Code"`
 	PolicyNumber                  ST      `hl7:"36,display=Policy Number"`
 	PolicyDeductible              *CP     `hl7:"37,display=Policy Deductible"`
 	PolicyLimitAmount             ST      `hl7:"38,display=Policy Limit - Amount"`
-	PolicyLimitDays               NM      `hl7:"39,display=Policy Limit - Days"`
+	PolicyLimitDays               NM      `hl7:"39,datatype=NM,display=Policy Limit - Days"`
 	RoomRateSemiPrivate           ST      `hl7:"40,display=Room Rate - Semi-private"`
 	RoomRatePrivate               ST      `hl7:"41,display=Room Rate - Private"`
 	InsuredsEmploymentStatus      *CWE    `hl7:"42,table=0066,display=Insured's Employment Status"`
@@ -1424,9 +1424,9 @@ type IN2 struct {
 	MilitaryRankGrade                    *CWE    `hl7:"15,table=0141,display=Military Rank/Grade"`
 	MilitaryStatus                       *CWE    `hl7:"16,table=0142,display=Military Status"`
 	MilitaryRetireDate                   DT      `hl7:"17,format=YMD,display=Military Retire Date"`
-	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,display=Military Non-avail Cert On File"`
-	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,display=Baby Coverage"`
-	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,display=Combine Baby Bill"`
+	MilitaryNonAvailCertOnFile           ID      `hl7:"18,len=1,table=0136,datatype=ID,display=Military Non-avail Cert On File"`
+	BabyCoverage                         ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Baby Coverage"`
+	CombineBabyBill                      ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Combine Baby Bill"`
 	BloodDeductible                      ST      `hl7:"21,display=Blood Deductible"`
 	SpecialCoverageApprovalName          []XPN   `hl7:"22,display=Special Coverage Approval Name"`
 	SpecialCoverageApprovalTitle         ST      `hl7:"23,display=Special Coverage Approval Title"`
@@ -1443,7 +1443,7 @@ type IN2 struct {
 	PrimaryLanguage                      *CWE    `hl7:"34,table=0296,display=Primary Language"`
 	LivingArrangement                    *CWE    `hl7:"35,table=0220,display=Living Arrangement"`
 	PublicityCode                        *CWE    `hl7:"36,table=0215,display=Publicity Code"`
-	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                  ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	StudentIndicator                     *CWE    `hl7:"38,table=0231,display=Student Indicator"`
 	Religion                             *CWE    `hl7:"39,table=0006,display=Religion"`
 	MothersMaidenName                    []XPN   `hl7:"40,display=Mother's Maiden Name"`
@@ -1472,9 +1472,9 @@ type IN2 struct {
 	InsuredsPhoneNumberHome              []XTN   `hl7:"63,display=Insured's Phone Number - Home"`
 	InsuredsEmployerPhoneNumber          []XTN   `hl7:"64,display=Insured's Employer Phone Number"`
 	MilitaryHandicappedProgram           *CWE    `hl7:"65,table=0343,display=Military Handicapped Program"`
-	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,display=Suspend Flag"`
-	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,display=Copay Limit Flag"`
-	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,display=Stoploss Limit Flag"`
+	SuspendFlag                          ID      `hl7:"66,len=1,table=0136,datatype=ID,display=Suspend Flag"`
+	CopayLimitFlag                       ID      `hl7:"67,len=1,table=0136,datatype=ID,display=Copay Limit Flag"`
+	StoplossLimitFlag                    ID      `hl7:"68,len=1,table=0136,datatype=ID,display=Stoploss Limit Flag"`
 	InsuredOrganizationNameAndID         []XON   `hl7:"69,display=Insured Organization Name And Id"`
 	InsuredEmployerOrganizationNameAndID []XON   `hl7:"70,display=Insured Employer Organization Name And Id"`
 	Race                                 []CWE   `hl7:"71,table=0005,display=Race"`
@@ -1487,10 +1487,10 @@ type IN2 struct {
 // segment are defined by CMS, or other regulatory agencies.
 type IN3 struct {
 	HL7                                HL7Name `hl7:",name=IN3,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set Id - In3"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - In3"`
 	CertificationNumber                *CX     `hl7:"2,display=Certification Number"`
 	CertifiedBy                        []XCN   `hl7:"3,display=Certified By"`
-	CertificationRequired              ID      `hl7:"4,len=1,table=0136,display=Certification Required"`
+	CertificationRequired              ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Certification Required"`
 	Penalty                            *MOP    `hl7:"5,display=Penalty"`
 	CertificationDateTime              DTM     `hl7:"6,format=YMDHM,display=Certification Date/Time"`
 	CertificationModifyDateTime        DTM     `hl7:"7,format=YMDHM,display=Certification Modify Date/Time"`
@@ -1526,10 +1526,10 @@ type INV struct {
 	InventoryContainerIdentifier *CWE    `hl7:"4,table=9999,display=Inventory Container Identifier"`
 	ContainerCarrierIdentifier   *CWE    `hl7:"5,table=9999,display=Container Carrier Identifier"`
 	PositionOnCarrier            *CWE    `hl7:"6,table=9999,display=Position On Carrier"`
-	InitialQuantity              NM      `hl7:"7,display=Initial Quantity"`
-	CurrentQuantity              NM      `hl7:"8,display=Current Quantity"`
-	AvailableQuantity            NM      `hl7:"9,display=Available Quantity"`
-	ConsumptionQuantity          NM      `hl7:"10,display=Consumption Quantity"`
+	InitialQuantity              NM      `hl7:"7,datatype=NM,display=Initial Quantity"`
+	CurrentQuantity              NM      `hl7:"8,datatype=NM,display=Current Quantity"`
+	AvailableQuantity            NM      `hl7:"9,datatype=NM,display=Available Quantity"`
+	ConsumptionQuantity          NM      `hl7:"10,datatype=NM,display=Consumption Quantity"`
 	QuantityUnits                *CWE    `hl7:"11,table=9999,display=Quantity Units"`
 	ExpirationDateTime           DTM     `hl7:"12,format=YMDHM,display=Expiration Date/Time"`
 	FirstUsedDateTime            DTM     `hl7:"13,format=YMDHM,display=First Used Date/Time"`
@@ -1586,7 +1586,7 @@ type IPR struct {
 // section Glossary) on the specific equipment.
 type ISD struct {
 	HL7                        HL7Name `hl7:",name=ISD,type=s"`
-	ReferenceInteractionNumber NM      `hl7:"1,required,display=Reference Interaction Number"`
+	ReferenceInteractionNumber NM      `hl7:"1,required,datatype=NM,display=Reference Interaction Number"`
 	InteractionTypeIdentifier  *CWE    `hl7:"2,table=0368,display=Interaction Type Identifier"`
 	InteractionActiveState     CWE     `hl7:"3,required,table=0387,display=Interaction Active State"`
 }
@@ -1615,7 +1615,7 @@ type ITM struct {
 	LatexIndicator                        *CNE    `hl7:"17,table=0532,display=Latex Indicator"`
 	RulingAct                             []CWE   `hl7:"18,table=0793,display=Ruling Act"`
 	ItemNaturalAccountCode                *CWE    `hl7:"19,table=0320,display=Item Natural Account Code"`
-	ApprovedToBuyQuantity                 NM      `hl7:"20,display=Approved To Buy Quantity"`
+	ApprovedToBuyQuantity                 NM      `hl7:"20,datatype=NM,display=Approved To Buy Quantity"`
 	ApprovedToBuyPrice                    *MO     `hl7:"21,display=Approved To Buy Price"`
 	TaxableItemIndicator                  *CNE    `hl7:"22,table=0532,display=Taxable Item Indicator"`
 	FreightChargeIndicator                *CNE    `hl7:"23,table=0532,display=Freight Charge Indicator"`
@@ -1645,7 +1645,7 @@ type IVC struct {
 	ProviderOrganization                   XON     `hl7:"10,required,display=Provider Organization"`
 	PayerOrganization                      XON     `hl7:"11,required,display=Payer Organization"`
 	Attention                              *XCN    `hl7:"12,display=Attention"`
-	LastInvoiceIndicator                   ID      `hl7:"13,len=1,table=0136,display=Last Invoice Indicator"`
+	LastInvoiceIndicator                   ID      `hl7:"13,len=1,table=0136,datatype=ID,display=Last Invoice Indicator"`
 	InvoiceBookingPeriod                   DTM     `hl7:"14,format=YMDHM,display=Invoice Booking Period"`
 	Origin                                 ST      `hl7:"15,display=Origin"`
 	InvoiceFixedAmount                     *CP     `hl7:"16,display=Invoice Fixed Amount"`
@@ -1656,7 +1656,7 @@ type IVC struct {
 	InvoicePrepaidAmount                   *CP     `hl7:"21,display=Invoice Prepaid Amount"`
 	TotalInvoiceAmountWithoutPrepaidAmount *CP     `hl7:"22,display=Total Invoice Amount Without Prepaid Amount"`
 	TotalAmountOfVat                       *CP     `hl7:"23,conditional,display=Total-amount Of Vat"`
-	VatRatesApplied                        []NM    `hl7:"24,len=5,display=Vat-rates Applied"`
+	VatRatesApplied                        []NM    `hl7:"24,len=5,datatype=NM,display=Vat-rates Applied"`
 	BenefitGroup                           CWE     `hl7:"25,required,table=0556,display=Benefit Group"`
 	ProviderTaxID                          ST      `hl7:"26,display=Provider Tax Id"`
 	PayerTaxID                             ST      `hl7:"27,display=Payer Tax Id"`
@@ -1671,7 +1671,7 @@ type IVC struct {
 // item in the Material Item (ITM) segment.
 type IVT struct {
 	HL7                               HL7Name `hl7:",name=IVT,type=s"`
-	SetID                             SI      `hl7:"1,seq,required,len=4,display=Set Id - Ivt"`
+	SetID                             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Ivt"`
 	InventoryLocationIdentifier       EI      `hl7:"2,required,display=Inventory Location Identifier"`
 	InventoryLocationName             ST      `hl7:"3,display=Inventory Location Name"`
 	SourceLocationIdentifier          *EI     `hl7:"4,display=Source Location Identifier"`
@@ -1692,10 +1692,10 @@ type IVT struct {
 	SubstituteItemIdentifier          []EI    `hl7:"19,display=Substitute Item Identifier"`
 	LatexFreeSubstituteItemIdentifier *EI     `hl7:"20,display=Latex-free Substitute Item Identifier"`
 	RecommendedReorderTheory          *CWE    `hl7:"21,table=0642,display=Recommended Reorder Theory"`
-	RecommendedSafetyStockDays        NM      `hl7:"22,display=Recommended Safety Stock Days"`
-	RecommendedMaximumDaysInventory   NM      `hl7:"23,display=Recommended Maximum Days Inventory"`
-	RecommendedOrderPoint             NM      `hl7:"24,display=Recommended Order Point"`
-	RecommendedOrderAmount            NM      `hl7:"25,display=Recommended Order Amount"`
+	RecommendedSafetyStockDays        NM      `hl7:"22,datatype=NM,display=Recommended Safety Stock Days"`
+	RecommendedMaximumDaysInventory   NM      `hl7:"23,datatype=NM,display=Recommended Maximum Days Inventory"`
+	RecommendedOrderPoint             NM      `hl7:"24,datatype=NM,display=Recommended Order Point"`
+	RecommendedOrderAmount            NM      `hl7:"25,datatype=NM,display=Recommended Order Amount"`
 	OperatingRoomParLevelIndicator    *CNE    `hl7:"26,table=0532,display=Operating Room Par Level Indicator"`
 }
 
@@ -1705,7 +1705,7 @@ type IVT struct {
 // optionally follow an STF segment.  An LAN segment must always have been preceded by a corresponding STF segment.
 type LAN struct {
 	HL7                     HL7Name `hl7:",name=LAN,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set Id - Lan"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Lan"`
 	LanguageCode            CWE     `hl7:"2,required,table=0296,display=Language Code"`
 	LanguageAbilityCode     []CWE   `hl7:"3,table=0403,display=Language Ability Code"`
 	LanguageProficiencyCode *CWE    `hl7:"4,table=0404,display=Language Proficiency Code"`
@@ -1737,7 +1737,7 @@ type LCC struct {
 type LCH struct {
 	HL7                         HL7Name `hl7:",name=LCH,type=s"`
 	PrimaryKeyValue             PL      `hl7:"1,required,display=Primary Key Value - Lch"`
-	SegmentActionCode           ID      `hl7:"2,len=1,table=0206,display=Segment Action Code"`
+	SegmentActionCode           ID      `hl7:"2,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey            *EI     `hl7:"3,display=Segment Unique Key"`
 	LocationCharacteristicID    CWE     `hl7:"4,required,table=0324,display=Location Characteristic Id"`
 	LocationCharacteristicValue CWE     `hl7:"5,required,table=0136,display=Location Characteristic Value - Lch"`
@@ -1755,7 +1755,7 @@ type LDP struct {
 	LocationService     []CWE   `hl7:"3,table=0069,display=Location Service"`
 	SpecialtyType       []CWE   `hl7:"4,table=0265,display=Specialty Type"`
 	ValidPatientClasses []CWE   `hl7:"5,table=0004,display=Valid Patient Classes"`
-	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag  ID      `hl7:"6,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	ActivationDate      DTM     `hl7:"7,format=YMDHM,display=Activation Date - Ldp"`
 	InactivationDate    DTM     `hl7:"8,format=YMDHM,display=Inactivation Date - Ldp"`
 	InactivatedReason   ST      `hl7:"9,display=Inactivated Reason"`
@@ -1789,7 +1789,7 @@ type LOC struct {
 type LRL struct {
 	HL7                                     HL7Name `hl7:",name=LRL,type=s"`
 	PrimaryKeyValue                         PL      `hl7:"1,required,display=Primary Key Value - Lrl"`
-	SegmentActionCode                       ID      `hl7:"2,len=1,table=0206,display=Segment Action Code"`
+	SegmentActionCode                       ID      `hl7:"2,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	SegmentUniqueKey                        *EI     `hl7:"3,display=Segment Unique Key"`
 	LocationRelationshipID                  CWE     `hl7:"4,required,table=0325,display=Location Relationship Id"`
 	OrganizationalLocationRelationshipValue []XON   `hl7:"5,conditional,display=Organizational Location Relationship Value"`
@@ -1801,22 +1801,22 @@ type LRL struct {
 // The MFA segment contains the following fields as defined in HL7 Attribute Table - MFA - Master File Acknowledgment
 type MFA struct {
 	HL7                       HL7Name  `hl7:",name=MFA,type=s"`
-	RecordLevelEventCode      ID       `hl7:"1,required,len=3,table=0180,display=Record-level Event Code"`
+	RecordLevelEventCode      ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-level Event Code"`
 	MfnControlID              ST       `hl7:"2,conditional,display=Mfn Control Id"`
 	EventCompletionDateTime   DTM      `hl7:"3,format=YMDHM,display=Event Completion Date/Time"`
 	MfnRecordLevelErrorReturn CWE      `hl7:"4,required,table=0181,display=Mfn Record Level Error Return"`
 	PrimaryKeyValue           []varies `hl7:"5,required,display=Primary Key Value - Mfa"`
-	PrimaryKeyValueType       []ID     `hl7:"6,required,len=3,table=0355,display=Primary Key Value Type - Mfa"`
+	PrimaryKeyValueType       []ID     `hl7:"6,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type - Mfa"`
 }
 
 // Master File Entry
 type MFE struct {
 	HL7                  HL7Name  `hl7:",name=MFE,type=s"`
-	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,display=Record-level Event Code"`
+	RecordLevelEventCode ID       `hl7:"1,required,len=3,table=0180,datatype=ID,display=Record-level Event Code"`
 	MfnControlID         ST       `hl7:"2,conditional,display=Mfn Control Id"`
 	EffectiveDateTime    DTM      `hl7:"3,format=YMDHM,display=Effective Date/Time"`
 	PrimaryKeyValue      []varies `hl7:"4,required,display=Primary Key Value - Mfe"`
-	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,display=Primary Key Value Type"`
+	PrimaryKeyValueType  []ID     `hl7:"5,required,len=3,table=0355,datatype=ID,display=Primary Key Value Type"`
 	EnteredDateTime      DTM      `hl7:"6,format=YMDHM,display=Entered Date/Time"`
 	EnteredBy            *XCN     `hl7:"7,display=Entered By"`
 }
@@ -1828,10 +1828,10 @@ type MFI struct {
 	HL7                             HL7Name `hl7:",name=MFI,type=s"`
 	MasterFileIdentifier            CWE     `hl7:"1,required,table=0175,display=Master File Identifier"`
 	MasterFileApplicationIdentifier []HD    `hl7:"2,table=0361,display=Master File Application Identifier"`
-	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,display=File-level Event Code"`
+	FileLevelEventCode              ID      `hl7:"3,required,len=3,table=0178,datatype=ID,display=File-level Event Code"`
 	EnteredDateTime                 DTM     `hl7:"4,format=YMDHM,display=Entered Date/Time"`
 	EffectiveDateTime               DTM     `hl7:"5,format=YMDHM,display=Effective Date/Time"`
-	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,display=Response Level Code"`
+	ResponseLevelCode               ID      `hl7:"6,required,len=2,table=0179,datatype=ID,display=Response Level Code"`
 }
 
 // Merge Patient Information
@@ -1865,14 +1865,14 @@ type MRG struct {
 // The MSA segment contains information sent while acknowledging another message.
 type MSA struct {
 	HL7                       HL7Name `hl7:",name=MSA,type=s"`
-	AcknowledgmentCode        ID      `hl7:"1,required,len=2,table=0008,display=Acknowledgment Code"`
+	AcknowledgmentCode        ID      `hl7:"1,required,len=2,table=0008,datatype=ID,display=Acknowledgment Code"`
 	MessageControlID          ST      `hl7:"2,required,len=199,display=Message Control Id"`
 	TextMessage               ST      `hl7:"3,display=Text Message"`
-	ExpectedSequenceNumber    NM      `hl7:"4,display=Expected Sequence Number"`
+	ExpectedSequenceNumber    NM      `hl7:"4,datatype=NM,display=Expected Sequence Number"`
 	DelayedAcknowledgmentType ST      `hl7:"5,display=Delayed Acknowledgment Type"`
 	ErrorCondition            ST      `hl7:"6,display=Error Condition"`
-	MessageWaitingNumber      NM      `hl7:"7,display=Message Waiting Number"`
-	MessageWaitingPriority    ID      `hl7:"8,len=1,table=0520,display=Message Waiting Priority"`
+	MessageWaitingNumber      NM      `hl7:"7,datatype=NM,display=Message Waiting Number"`
+	MessageWaitingPriority    ID      `hl7:"8,len=1,table=0520,datatype=ID,display=Message Waiting Priority"`
 }
 
 // Message Header
@@ -1892,14 +1892,14 @@ type MSH struct {
 	MessageControlID                    ST      `hl7:"10,required,len=199,display=Message Control Id"`
 	ProcessingID                        PT      `hl7:"11,required,display=Processing Id"`
 	VersionID                           VID     `hl7:"12,required,display=Version Id"`
-	SequenceNumber                      NM      `hl7:"13,display=Sequence Number"`
+	SequenceNumber                      NM      `hl7:"13,datatype=NM,display=Sequence Number"`
 	ContinuationPointer                 ST      `hl7:"14,display=Continuation Pointer"`
-	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,display=Accept Acknowledgment Type"`
-	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,display=Application Acknowledgment Type"`
-	CountryCode                         ID      `hl7:"17,len=3,table=0399,display=Country Code"`
-	CharacterSet                        []ID    `hl7:"18,len=15,table=0211,display=Character Set"`
+	AcceptAcknowledgmentType            ID      `hl7:"15,len=2,table=0155,datatype=ID,display=Accept Acknowledgment Type"`
+	ApplicationAcknowledgmentType       ID      `hl7:"16,len=2,table=0155,datatype=ID,display=Application Acknowledgment Type"`
+	CountryCode                         ID      `hl7:"17,len=3,table=0399,datatype=ID,display=Country Code"`
+	CharacterSet                        []ID    `hl7:"18,len=15,table=0211,datatype=ID,display=Character Set"`
 	PrincipalLanguageOfMessage          *CWE    `hl7:"19,display=Principal Language Of Message"`
-	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=13,table=0356,display=Alternate Character Set Handling Scheme"`
+	AlternateCharacterSetHandlingScheme ID      `hl7:"20,len=13,table=0356,datatype=ID,display=Alternate Character Set Handling Scheme"`
 	MessageProfileIdentifier            []EI    `hl7:"21,display=Message Profile Identifier"`
 	SendingResponsibleOrganization      *XON    `hl7:"22,display=Sending Responsible Organization"`
 	ReceivingResponsibleOrganization    *XON    `hl7:"23,display=Receiving Responsible Organization"`
@@ -1936,7 +1936,7 @@ type NCK struct {
 // of events, (e.g., alarms that have occurred on a particular piece of equipment.
 type NDS struct {
 	HL7                         HL7Name `hl7:",name=NDS,type=s"`
-	NotificationReferenceNumber NM      `hl7:"1,required,display=Notification Reference Number"`
+	NotificationReferenceNumber NM      `hl7:"1,required,datatype=NM,display=Notification Reference Number"`
 	NotificationDateTime        DTM     `hl7:"2,required,format=YMDHM,display=Notification Date/Time"`
 	NotificationAlertSeverity   CWE     `hl7:"3,required,table=0367,display=Notification Alert Severity"`
 	NotificationCode            CWE     `hl7:"4,required,table=9999,display=Notification Code"`
@@ -1951,7 +1951,7 @@ type NDS struct {
 // kin, it is recommended to send a NK1 segment for each contact role (field 7).
 type NK1 struct {
 	HL7                                        HL7Name `hl7:",name=NK1,type=s"`
-	SetID                                      SI      `hl7:"1,seq,required,len=4,display=Set Id - Nk1"`
+	SetID                                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Nk1"`
 	Name                                       []XPN   `hl7:"2,display=Name"`
 	Relationship                               *CWE    `hl7:"3,table=0063,display=Relationship"`
 	Address                                    []XAD   `hl7:"4,display=Address"`
@@ -1973,7 +1973,7 @@ type NK1 struct {
 	PrimaryLanguage                            *CWE    `hl7:"20,table=0296,display=Primary Language"`
 	LivingArrangement                          *CWE    `hl7:"21,table=0220,display=Living Arrangement"`
 	PublicityCode                              *CWE    `hl7:"22,table=0215,display=Publicity Code"`
-	ProtectionIndicator                        ID      `hl7:"23,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                        ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	StudentIndicator                           *CWE    `hl7:"24,table=0231,display=Student Indicator"`
 	Religion                                   *CWE    `hl7:"25,table=0006,display=Religion"`
 	MothersMaidenName                          []XPN   `hl7:"26,display=Mother's Maiden Name"`
@@ -2040,21 +2040,21 @@ type NSC struct {
 // port, and some values pertain to the lower level protocol.
 type NST struct {
 	HL7                           HL7Name `hl7:",name=NST,type=s"`
-	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,display=Statistics Available"`
+	StatisticsAvailable           ID      `hl7:"1,required,len=1,table=0136,datatype=ID,display=Statistics Available"`
 	SourceIdentifier              ST      `hl7:"2,display=Source Identifier"`
-	SourceType                    ID      `hl7:"3,table=0332,display=Source Type"`
+	SourceType                    ID      `hl7:"3,table=0332,datatype=ID,display=Source Type"`
 	StatisticsStart               DTM     `hl7:"4,format=YMDHM,display=Statistics Start"`
 	StatisticsEnd                 DTM     `hl7:"5,format=YMDHM,display=Statistics End"`
-	ReceiveCharacterCount         NM      `hl7:"6,display=Receive Character Count"`
-	SendCharacterCount            NM      `hl7:"7,display=Send Character Count"`
-	MessagesReceived              NM      `hl7:"8,display=Messages Received"`
-	MessagesSent                  NM      `hl7:"9,display=Messages Sent"`
-	ChecksumErrorsReceived        NM      `hl7:"10,display=Checksum Errors Received"`
-	LengthErrorsReceived          NM      `hl7:"11,display=Length Errors Received"`
-	OtherErrorsReceived           NM      `hl7:"12,display=Other Errors Received"`
-	ConnectTimeouts               NM      `hl7:"13,display=Connect Timeouts"`
-	ReceiveTimeouts               NM      `hl7:"14,display=Receive Timeouts"`
-	ApplicationControlLevelErrors NM      `hl7:"15,display=Application Control-level Errors"`
+	ReceiveCharacterCount         NM      `hl7:"6,datatype=NM,display=Receive Character Count"`
+	SendCharacterCount            NM      `hl7:"7,datatype=NM,display=Send Character Count"`
+	MessagesReceived              NM      `hl7:"8,datatype=NM,display=Messages Received"`
+	MessagesSent                  NM      `hl7:"9,datatype=NM,display=Messages Sent"`
+	ChecksumErrorsReceived        NM      `hl7:"10,datatype=NM,display=Checksum Errors Received"`
+	LengthErrorsReceived          NM      `hl7:"11,datatype=NM,display=Length Errors Received"`
+	OtherErrorsReceived           NM      `hl7:"12,datatype=NM,display=Other Errors Received"`
+	ConnectTimeouts               NM      `hl7:"13,datatype=NM,display=Connect Timeouts"`
+	ReceiveTimeouts               NM      `hl7:"14,datatype=NM,display=Receive Timeouts"`
+	ApplicationControlLevelErrors NM      `hl7:"15,datatype=NM,display=Application Control-level Errors"`
 }
 
 // Notes And Comments
@@ -2067,8 +2067,8 @@ type NST struct {
 // NTE, for example "Notes and Comments for the PID".
 type NTE struct {
 	HL7                HL7Name `hl7:",name=NTE,type=s"`
-	SetID              SI      `hl7:"1,seq,display=Set Id - Nte"`
-	SourceOfComment    ID      `hl7:"2,len=1,table=0105,display=Source Of Comment"`
+	SetID              SI      `hl7:"1,seq,datatype=SI,display=Set Id - Nte"`
+	SourceOfComment    ID      `hl7:"2,len=1,table=0105,datatype=ID,display=Source Of Comment"`
 	Comment            []FT    `hl7:"3,display=Comment"`
 	CommentType        *CWE    `hl7:"4,table=0364,display=Comment Type"`
 	EnteredBy          *XCN    `hl7:"5,display=Entered By"`
@@ -2131,7 +2131,7 @@ type NTE struct {
 // field.
 type OBR struct {
 	HL7                                        HL7Name `hl7:",name=OBR,type=s"`
-	SetID                                      SI      `hl7:"1,seq,len=4,display=Set Id - Obr"`
+	SetID                                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Obr"`
 	PlacerOrderNumber                          *EI     `hl7:"2,conditional,display=Placer Order Number"`
 	FillerOrderNumber                          *EI     `hl7:"3,conditional,display=Filler Order Number"`
 	UniversalServiceIdentifier                 CWE     `hl7:"4,required,display=Universal Service Identifier"`
@@ -2141,7 +2141,7 @@ type OBR struct {
 	ObservationEndDateTime                     DTM     `hl7:"8,format=YMDHM,display=Observation End Date/Time #"`
 	CollectionVolume                           *CQ     `hl7:"9,display=Collection Volume *"`
 	CollectorIdentifier                        []XCN   `hl7:"10,display=Collector Identifier *"`
-	SpecimenActionCode                         ID      `hl7:"11,len=1,table=0065,display=Specimen Action Code *"`
+	SpecimenActionCode                         ID      `hl7:"11,len=1,table=0065,datatype=ID,display=Specimen Action Code *"`
 	DangerCode                                 *CWE    `hl7:"12,table=9999,display=Danger Code"`
 	RelevantClinicalInformation                []CWE   `hl7:"13,table=0916,display=Relevant Clinical Information"`
 	SpecimenReceivedDateTime                   ST      `hl7:"14,display=Specimen Received Date/Time"`
@@ -2154,25 +2154,25 @@ type OBR struct {
 	FillerField2                               ST      `hl7:"21,display=Filler Field 2 +"`
 	ResultsRptStatusChngDateTime               DTM     `hl7:"22,conditional,format=YMDHM,display=Results Rpt/Status Chng - Date/Time +"`
 	ChargeToPractice                           *MOC    `hl7:"23,display=Charge To Practice +"`
-	DiagnosticServSectID                       ID      `hl7:"24,len=3,table=0074,display=Diagnostic Serv Sect Id"`
-	ResultStatus                               ID      `hl7:"25,conditional,len=1,table=0123,display=Result Status +"`
+	DiagnosticServSectID                       ID      `hl7:"24,len=3,table=0074,datatype=ID,display=Diagnostic Serv Sect Id"`
+	ResultStatus                               ID      `hl7:"25,conditional,len=1,table=0123,datatype=ID,display=Result Status +"`
 	ParentResult                               *PRL    `hl7:"26,display=Parent Result +"`
 	QuantityTiming                             []ST    `hl7:"27,display=Quantity/Timing"`
 	ResultCopiesTo                             []XCN   `hl7:"28,display=Result Copies To"`
 	Parent                                     *EIP    `hl7:"29,display=Parent"`
-	TransportationMode                         ID      `hl7:"30,len=4,table=0124,display=Transportation Mode"`
+	TransportationMode                         ID      `hl7:"30,len=4,table=0124,datatype=ID,display=Transportation Mode"`
 	ReasonForStudy                             []CWE   `hl7:"31,table=9999,display=Reason For Study"`
 	PrincipalResultInterpreter                 NDL     `hl7:"32,display=Principal Result Interpreter +"`
 	AssistantResultInterpreter                 []NDL   `hl7:"33,display=Assistant Result Interpreter +"`
 	Technician                                 []NDL   `hl7:"34,display=Technician +"`
 	Transcriptionist                           []NDL   `hl7:"35,display=Transcriptionist +"`
 	ScheduledDateTime                          DTM     `hl7:"36,format=YMDHM,display=Scheduled Date/Time +"`
-	NumberOfSampleContainers                   NM      `hl7:"37,display=Number Of Sample Containers *"`
+	NumberOfSampleContainers                   NM      `hl7:"37,datatype=NM,display=Number Of Sample Containers *"`
 	TransportLogisticsOfCollectedSample        []CWE   `hl7:"38,table=9999,display=Transport Logistics Of Collected Sample *"`
 	CollectorsComment                          []CWE   `hl7:"39,table=9999,display=Collector's Comment *"`
 	TransportArrangementResponsibility         *CWE    `hl7:"40,table=9999,display=Transport Arrangement Responsibility"`
-	TransportArranged                          ID      `hl7:"41,len=1,table=0224,display=Transport Arranged"`
-	EscortRequired                             ID      `hl7:"42,len=1,table=0225,display=Escort Required"`
+	TransportArranged                          ID      `hl7:"41,len=1,table=0224,datatype=ID,display=Transport Arranged"`
+	EscortRequired                             ID      `hl7:"42,len=1,table=0225,datatype=ID,display=Escort Required"`
 	PlannedPatientTransportComment             []CWE   `hl7:"43,table=9999,display=Planned Patient Transport Comment"`
 	ProcedureCode                              *CNE    `hl7:"44,table=0088,display=Procedure Code"`
 	ProcedureCodeModifier                      []CNE   `hl7:"45,table=0340,display=Procedure Code Modifier"`
@@ -2217,17 +2217,17 @@ type OBR struct {
 // as well as the fields that should not be valued.
 type OBX struct {
 	HL7                                   HL7Name  `hl7:",name=OBX,type=s"`
-	SetID                                 SI       `hl7:"1,seq,len=4,display=Set Id - Obx"`
-	ValueType                             ID       `hl7:"2,conditional,len=3,table=0125,display=Value Type"`
+	SetID                                 SI       `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Obx"`
+	ValueType                             ID       `hl7:"2,conditional,len=3,table=0125,datatype=ID,display=Value Type"`
 	ObservationIdentifier                 CWE      `hl7:"3,required,table=9999,display=Observation Identifier"`
 	ObservationSubID                      ST       `hl7:"4,conditional,display=Observation Sub-id"`
 	ObservationValue                      []varies `hl7:"5,conditional,display=Observation Value"`
 	Units                                 *CWE     `hl7:"6,table=9999,display=Units"`
 	ReferencesRange                       ST       `hl7:"7,display=References Range"`
 	InterpretationCodes                   []CWE    `hl7:"8,display=Interpretation Codes"`
-	Probability                           NM       `hl7:"9,display=Probability"`
-	NatureOfAbnormalTest                  []ID     `hl7:"10,len=2,table=0080,display=Nature Of Abnormal Test"`
-	ObservationResultStatus               ID       `hl7:"11,required,len=1,table=0085,display=Observation Result Status"`
+	Probability                           NM       `hl7:"9,datatype=NM,display=Probability"`
+	NatureOfAbnormalTest                  []ID     `hl7:"10,len=2,table=0080,datatype=ID,display=Nature Of Abnormal Test"`
+	ObservationResultStatus               ID       `hl7:"11,required,len=1,table=0085,datatype=ID,display=Observation Result Status"`
 	EffectiveDateOfReferenceRange         DTM      `hl7:"12,format=YMDHM,display=Effective Date Of Reference Range"`
 	UserDefinedAccessChecks               ST       `hl7:"13,display=User Defined Access Checks"`
 	DateTimeOfTheObservation              DTM      `hl7:"14,format=YMDHM,display=Date/Time Of The Observation"`
@@ -2242,7 +2242,7 @@ type OBX struct {
 	PerformingOrganizationName            XON      `hl7:"23,display=Performing Organization Name"`
 	PerformingOrganizationAddress         XAD      `hl7:"24,display=Performing Organization Address"`
 	PerformingOrganizationMedicalDirector XCN      `hl7:"25,display=Performing Organization Medical Director"`
-	PatientResultsReleaseCategory         ID       `hl7:"26,len=10,table=0909,display=Patient Results Release Category"`
+	PatientResultsReleaseCategory         ID       `hl7:"26,len=10,table=0909,datatype=ID,display=Patient Results Release Category"`
 }
 
 func (v OBX) ChildVaries(dtReg map[string]any) (reflect.Value, error) {
@@ -2269,7 +2269,7 @@ func (v OBX) ChildVaries(dtReg map[string]any) (reflect.Value, error) {
 // |1^QPM^^20010415|.
 type ODS struct {
 	HL7                            HL7Name `hl7:",name=ODS,type=s"`
-	Type                           ID      `hl7:"1,required,len=1,table=0159,display=Type"`
+	Type                           ID      `hl7:"1,required,len=1,table=0159,datatype=ID,display=Type"`
 	ServicePeriod                  []CWE   `hl7:"2,max=10,table=9999,display=Service Period"`
 	DietSupplementOrPreferenceCode []CWE   `hl7:"3,required,max=20,table=9999,display=Diet- Supplement- Or Preference Code"`
 	TextInstruction                []ST    `hl7:"4,max=2,display=Text Instruction"`
@@ -2292,10 +2292,10 @@ type ODT struct {
 // the field attributes that specify what additional segments might also be defined for this observation.
 type OM1 struct {
 	HL7                                                    HL7Name `hl7:",name=OM1,type=s"`
-	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile                NM      `hl7:"1,required,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	ProducersServiceTestObservationID                      CWE     `hl7:"2,required,table=9999,display=Producer's Service/Test/Observation Id"`
-	PermittedDataTypes                                     []ID    `hl7:"3,len=3,table=0125,display=Permitted Data Types"`
-	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,display=Specimen Required"`
+	PermittedDataTypes                                     []ID    `hl7:"3,len=3,table=0125,datatype=ID,display=Permitted Data Types"`
+	SpecimenRequired                                       ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Specimen Required"`
 	ProducerID                                             CWE     `hl7:"5,required,table=9999,display=Producer Id"`
 	ObservationDescription                                 TX      `hl7:"6,display=Observation Description"`
 	OtherServiceTestObservationIdsForTheObservation        *CWE    `hl7:"7,table=9999,display=Other Service/Test/Observation Ids For The Observation"`
@@ -2303,10 +2303,10 @@ type OM1 struct {
 	PreferredReportNameForTheObservation                   ST      `hl7:"9,display=Preferred Report Name For The Observation"`
 	PreferredShortNameOrMnemonicForTheObservation          ST      `hl7:"10,len=8,display=Preferred Short Name Or Mnemonic For The Observation"`
 	PreferredLongNameForTheObservation                     ST      `hl7:"11,display=Preferred Long Name For The Observation"`
-	Orderability                                           ID      `hl7:"12,len=1,table=0136,display=Orderability"`
+	Orderability                                           ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Orderability"`
 	IdentityOfInstrumentUsedToPerformThisStudy             []CWE   `hl7:"13,table=9999,display=Identity Of Instrument Used To Perform This Study"`
 	CodedRepresentationOfMethod                            []CWE   `hl7:"14,table=9999,display=Coded Representation Of Method"`
-	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,display=Portable Device Indicator"`
+	PortableDeviceIndicator                                ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Portable Device Indicator"`
 	ObservationProducingDepartmentSection                  []CWE   `hl7:"16,table=9999,display=Observation Producing Department/Section"`
 	TelephoneNumberOfSection                               *XTN    `hl7:"17,display=Telephone Number Of Section"`
 	NatureOfServiceTestObservation                         CWE     `hl7:"18,required,len=1,table=0174,display=Nature Of Service/Test/Observation"`
@@ -2314,10 +2314,10 @@ type OM1 struct {
 	ReportDisplayOrder                                     ST      `hl7:"20,display=Report Display Order"`
 	DateTimeStampForAnyChangeInDefinitionForTheObservation DTM     `hl7:"21,format=YMDHM,display=Date/Time Stamp For Any Change In Definition For The Observation"`
 	EffectiveDateTimeOfChange                              DTM     `hl7:"22,format=YMDHM,display=Effective Date/Time Of Change"`
-	TypicalTurnAroundTime                                  NM      `hl7:"23,display=Typical Turn-around Time"`
-	ProcessingTime                                         NM      `hl7:"24,display=Processing Time"`
-	ProcessingPriority                                     []ID    `hl7:"25,len=1,table=0168,display=Processing Priority"`
-	ReportingPriority                                      ID      `hl7:"26,len=1,table=0169,display=Reporting Priority"`
+	TypicalTurnAroundTime                                  NM      `hl7:"23,datatype=NM,display=Typical Turn-around Time"`
+	ProcessingTime                                         NM      `hl7:"24,datatype=NM,display=Processing Time"`
+	ProcessingPriority                                     []ID    `hl7:"25,len=1,table=0168,datatype=ID,display=Processing Priority"`
+	ReportingPriority                                      ID      `hl7:"26,len=1,table=0169,datatype=ID,display=Reporting Priority"`
 	OutsideSiteSWhereObservationMayBePerformed             []CWE   `hl7:"27,table=9999,display=Outside Site(s) Where Observation May Be Performed"`
 	AddressOfOutsideSiteS                                  []XAD   `hl7:"28,display=Address Of Outside Site(s)"`
 	PhoneNumberOfOutsideSite                               *XTN    `hl7:"29,display=Phone Number Of Outside Site"`
@@ -2347,16 +2347,16 @@ type OM1 struct {
 // date, or time stamp).  It can be applied to observation batteries of type A and C (see OM1-18 - Nature of Service/Test/Observation).
 type OM2 struct {
 	HL7                                                     HL7Name `hl7:",name=OM2,type=s"`
-	SequenceNumberTestObservationMasterFile                 NM      `hl7:"1,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile                 NM      `hl7:"1,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	UnitsOfMeasure                                          *CWE    `hl7:"2,table=9999,display=Units Of Measure"`
-	RangeOfDecimalPrecision                                 []NM    `hl7:"3,display=Range Of Decimal Precision"`
+	RangeOfDecimalPrecision                                 []NM    `hl7:"3,datatype=NM,display=Range Of Decimal Precision"`
 	CorrespondingSiUnitsOfMeasure                           *CWE    `hl7:"4,table=9999,display=Corresponding Si Units Of Measure"`
 	SiConversionFactor                                      TX      `hl7:"5,display=Si Conversion Factor"`
 	ReferenceNormalRangeForOrdinalAndContinuousObservations []RFR   `hl7:"6,display=Reference (normal) Range For Ordinal And Continuous Observations"`
 	CriticalRangeForOrdinalAndContinuousObservations        []RFR   `hl7:"7,display=Critical Range For Ordinal And Continuous Observations"`
 	AbsoluteRangeForOrdinalAndContinuousObservations        *RFR    `hl7:"8,display=Absolute Range For Ordinal And Continuous Observations"`
 	DeltaCheckCriteria                                      []DLT   `hl7:"9,display=Delta Check Criteria"`
-	MinimumMeaningfulIncrements                             NM      `hl7:"10,display=Minimum Meaningful Increments"`
+	MinimumMeaningfulIncrements                             NM      `hl7:"10,datatype=NM,display=Minimum Meaningful Increments"`
 }
 
 // Categorical Service/test/observation
@@ -2364,13 +2364,13 @@ type OM2 struct {
 // This segment applies to free text and other non-numeric data types.
 type OM3 struct {
 	HL7                                         HL7Name `hl7:",name=OM3,type=s"`
-	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile     NM      `hl7:"1,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	PreferredCodingSystem                       *CWE    `hl7:"2,table=9999,display=Preferred Coding System"`
 	ValidCodedAnswers                           []CWE   `hl7:"3,table=9999,display=Valid Coded 'answers'"`
 	NormalTextCodesForCategoricalObservations   []CWE   `hl7:"4,table=9999,display=Normal Text/Codes For Categorical Observations"`
 	AbnormalTextCodesForCategoricalObservations []CWE   `hl7:"5,table=9999,display=Abnormal Text/Codes For Categorical Observations"`
 	CriticalTextCodesForCategoricalObservations []CWE   `hl7:"6,table=9999,display=Critical Text/Codes For Categorical Observations"`
-	ValueType                                   ID      `hl7:"7,len=3,table=0125,display=Value Type"`
+	ValueType                                   ID      `hl7:"7,len=3,table=0125,datatype=ID,display=Value Type"`
 }
 
 // Observations That Require Specimens
@@ -2380,10 +2380,10 @@ type OM3 struct {
 // and a serum specimen), multiple segments may be included, one for each specimen type.
 type OM4 struct {
 	HL7                                     HL7Name `hl7:",name=OM4,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,display=Sequence Number - Test/Observation Master File"`
-	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,display=Derived Specimen"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,datatype=NM,display=Sequence Number - Test/Observation Master File"`
+	DerivedSpecimen                         ID      `hl7:"2,len=1,table=0170,datatype=ID,display=Derived Specimen"`
 	ContainerDescription                    TX      `hl7:"3,len=60,display=Container Description"`
-	ContainerVolume                         NM      `hl7:"4,display=Container Volume"`
+	ContainerVolume                         NM      `hl7:"4,datatype=NM,display=Container Volume"`
 	ContainerUnits                          *CWE    `hl7:"5,table=9999,display=Container Units"`
 	Specimen                                *CWE    `hl7:"6,table=9999,display=Specimen"`
 	Additive                                *CWE    `hl7:"7,table=0371,display=Additive"`
@@ -2392,7 +2392,7 @@ type OM4 struct {
 	NormalCollectionVolume                  *CQ     `hl7:"10,display=Normal Collection Volume"`
 	MinimumCollectionVolume                 *CQ     `hl7:"11,display=Minimum Collection Volume"`
 	SpecimenRequirements                    TX      `hl7:"12,display=Specimen Requirements"`
-	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,display=Specimen Priorities"`
+	SpecimenPriorities                      []ID    `hl7:"13,len=1,table=0027,datatype=ID,display=Specimen Priorities"`
 	SpecimenRetentionTime                   *CQ     `hl7:"14,display=Specimen Retention Time"`
 }
 
@@ -2402,7 +2402,7 @@ type OM4 struct {
 // Nature of Service/Test/Observation).
 type OM5 struct {
 	HL7                                                HL7Name `hl7:",name=OM5,type=s"`
-	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile            NM      `hl7:"1,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	TestObservationsIncludedWithinAnOrderedTestBattery []CWE   `hl7:"2,table=9999,display=Test/Observations Included Within An Ordered Test Battery"`
 	ObservationIDSuffixes                              ST      `hl7:"3,display=Observation Id Suffixes"`
 }
@@ -2413,7 +2413,7 @@ type OM5 struct {
 // by mathematical or logical means.
 type OM6 struct {
 	HL7                                     HL7Name `hl7:",name=OM6,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	DerivationRule                          TX      `hl7:"2,display=Derivation Rule"`
 }
 
@@ -2422,29 +2422,29 @@ type OM6 struct {
 // The OM7 segment contains additional basic attributes that apply to the definition of most observations/services.
 type OM7 struct {
 	HL7                                     HL7Name `hl7:",name=OM7,type=s"`
-	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,display=Sequence Number - Test/Observation Master File"`
+	SequenceNumberTestObservationMasterFile NM      `hl7:"1,required,datatype=NM,display=Sequence Number - Test/Observation Master File"`
 	UniversalServiceIdentifier              CWE     `hl7:"2,required,display=Universal Service Identifier"`
 	CategoryIdentifier                      []CWE   `hl7:"3,table=0412,display=Category Identifier"`
 	CategoryDescription                     TX      `hl7:"4,display=Category Description"`
 	CategorySynonym                         []ST    `hl7:"5,display=Category Synonym"`
 	EffectiveTestServiceStartDateTime       DTM     `hl7:"6,format=YMDHM,display=Effective Test/Service Start Date/Time"`
 	EffectiveTestServiceEndDateTime         DTM     `hl7:"7,format=YMDHM,display=Effective Test/Service End Date/Time"`
-	TestServiceDefaultDurationQuantity      NM      `hl7:"8,display=Test/Service Default Duration Quantity"`
+	TestServiceDefaultDurationQuantity      NM      `hl7:"8,datatype=NM,display=Test/Service Default Duration Quantity"`
 	TestServiceDefaultDurationUnits         *CWE    `hl7:"9,table=9999,display=Test/Service Default Duration Units"`
 	TestServiceDefaultFrequency             *CWE    `hl7:"10,display=Test/Service Default Frequency"`
-	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,display=Consent Indicator"`
+	ConsentIndicator                        ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Consent Indicator"`
 	ConsentIdentifier                       *CWE    `hl7:"12,table=0413,display=Consent Identifier"`
 	ConsentEffectiveStartDateTime           DTM     `hl7:"13,format=YMDHM,display=Consent Effective Start Date/Time"`
 	ConsentEffectiveEndDateTime             DTM     `hl7:"14,format=YMDHM,display=Consent Effective End Date/Time"`
-	ConsentIntervalQuantity                 NM      `hl7:"15,display=Consent Interval Quantity"`
+	ConsentIntervalQuantity                 NM      `hl7:"15,datatype=NM,display=Consent Interval Quantity"`
 	ConsentIntervalUnits                    *CWE    `hl7:"16,conditional,table=0414,display=Consent Interval Units"`
-	ConsentWaitingPeriodQuantity            NM      `hl7:"17,display=Consent Waiting Period Quantity"`
+	ConsentWaitingPeriodQuantity            NM      `hl7:"17,datatype=NM,display=Consent Waiting Period Quantity"`
 	ConsentWaitingPeriodUnits               *CWE    `hl7:"18,conditional,table=0414,display=Consent Waiting Period Units"`
 	EffectiveDateTimeOfChange               DTM     `hl7:"19,format=YMDHM,display=Effective Date/Time Of Change"`
 	EnteredBy                               *XCN    `hl7:"20,display=Entered By"`
 	OrderableAtLocation                     []PL    `hl7:"21,display=Orderable-at Location"`
 	FormularyStatus                         *CWE    `hl7:"22,table=0473,display=Formulary Status"`
-	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,display=Special Order Indicator"`
+	SpecialOrderIndicator                   ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Special Order Indicator"`
 	PrimaryKeyValueCdm                      []CWE   `hl7:"24,table=0132,display=Primary Key Value - Cdm"`
 }
 
@@ -2482,12 +2482,12 @@ type OM7 struct {
 // 4) The remaining, unperformed, children are canceled as a result of the request.
 type ORC struct {
 	HL7                                     HL7Name `hl7:",name=ORC,type=s"`
-	OrderControl                            ID      `hl7:"1,required,len=2,table=0119,display=Order Control"`
+	OrderControl                            ID      `hl7:"1,required,len=2,table=0119,datatype=ID,display=Order Control"`
 	PlacerOrderNumber                       *EI     `hl7:"2,conditional,display=Placer Order Number"`
 	FillerOrderNumber                       *EI     `hl7:"3,conditional,display=Filler Order Number"`
 	PlacerGroupNumber                       *EI     `hl7:"4,display=Placer Group Number"`
-	OrderStatus                             ID      `hl7:"5,len=2,table=0038,display=Order Status"`
-	ResponseFlag                            ID      `hl7:"6,len=1,table=0121,display=Response Flag"`
+	OrderStatus                             ID      `hl7:"5,len=2,table=0038,datatype=ID,display=Order Status"`
+	ResponseFlag                            ID      `hl7:"6,len=1,table=0121,datatype=ID,display=Response Flag"`
 	QuantityTiming                          []ST    `hl7:"7,display=Quantity/Timing"`
 	Parent                                  *EIP    `hl7:"8,display=Parent"`
 	DateTimeOfTransaction                   DTM     `hl7:"9,format=YMDHM,display=Date/Time Of Transaction"`
@@ -2527,18 +2527,18 @@ type ORC struct {
 // Category.
 type ORG struct {
 	HL7                                        HL7Name `hl7:",name=ORG,type=s"`
-	SetID                                      SI      `hl7:"1,seq,required,len=4,display=Set Id - Org"`
+	SetID                                      SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Org"`
 	OrganizationUnitCode                       *CWE    `hl7:"2,table=0405,display=Organization Unit Code"`
 	OrganizationUnitTypeCode                   *CWE    `hl7:"3,table=0474,display=Organization Unit Type Code"`
-	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,display=Primary Org Unit Indicator"`
+	PrimaryUnitIndicator                       ID      `hl7:"4,len=1,table=0136,datatype=ID,display=Primary Org Unit Indicator"`
 	PractitionerUnitIdentifier                 *CX     `hl7:"5,display=Practitioner Org Unit Identifier"`
 	HealthCareProviderTypeCode                 *CWE    `hl7:"6,table=0452,display=Health Care Provider Type Code"`
 	HealthCareProviderClassificationCode       *CWE    `hl7:"7,table=0453,display=Health Care Provider Classification Code"`
 	HealthCareProviderAreaOfSpecializationCode *CWE    `hl7:"8,table=0454,display=Health Care Provider Area Of Specialization Code"`
 	EffectiveDateRange                         *DR     `hl7:"9,display=Effective Date Range"`
 	EmploymentStatusCode                       *CWE    `hl7:"10,table=0066,display=Employment Status Code"`
-	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,display=Board Approval Indicator"`
-	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,display=Primary Care Physician Indicator"`
+	BoardApprovalIndicator                     ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Board Approval Indicator"`
+	PrimaryCarePhysicianIndicator              ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Primary Care Physician Indicator"`
 	CostCenterCode                             []CWE   `hl7:"13,table=0539,display=Cost Center Code"`
 }
 
@@ -2588,7 +2588,7 @@ type OVR struct {
 // The intent of this segment is to describe the information associated with the shipping package specimens are sent in.
 type PAC struct {
 	HL7                     HL7Name `hl7:",name=PAC,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set Id - Pac"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Pac"`
 	PackageID               *EI     `hl7:"2,conditional,display=Package Id"`
 	ParentPackageID         *EI     `hl7:"3,display=Parent Package Id"`
 	PositionInParentPackage *NA     `hl7:"4,display=Position In Parent Package"`
@@ -2605,7 +2605,7 @@ type PAC struct {
 // Center accounts, Patient Charge Identifier, and Patient Price is unique.
 type PCE struct {
 	HL7                     HL7Name `hl7:",name=PCE,type=s"`
-	SetID                   SI      `hl7:"1,seq,required,len=4,display=Set Id - Pce"`
+	SetID                   SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Pce"`
 	CostCenterAccountNumber *CX     `hl7:"2,table=0319,display=Cost Center Account Number"`
 	TransactionCode         *CWE    `hl7:"3,table=0132,display=Transaction Code"`
 	TransactionAmountUnit   *CP     `hl7:"4,display=Transaction Amount - Unit"`
@@ -2621,7 +2621,7 @@ type PCE struct {
 type PCR struct {
 	HL7                               HL7Name `hl7:",name=PCR,type=s"`
 	ImplicatedProduct                 CWE     `hl7:"1,required,table=9999,display=Implicated Product"`
-	GenericProduct                    IS      `hl7:"2,table=0249,display=Generic Product"`
+	GenericProduct                    IS      `hl7:"2,table=0249,datatype=IS,display=Generic Product"`
 	ProductClass                      *CWE    `hl7:"3,table=9999,display=Product Class"`
 	TotalDurationOfTherapy            *CQ     `hl7:"4,display=Total Duration Of Therapy"`
 	ProductManufactureDate            DTM     `hl7:"5,format=YMDHM,display=Product Manufacture Date"`
@@ -2636,13 +2636,13 @@ type PCR struct {
 	ProductEvaluationPerformed        *CWE    `hl7:"14,table=9999,display=Product Evaluation Performed"`
 	ProductEvaluationStatus           *CWE    `hl7:"15,table=0247,display=Product Evaluation Status"`
 	ProductEvaluationResults          *CWE    `hl7:"16,table=9999,display=Product Evaluation Results"`
-	EvaluatedProductSource            ID      `hl7:"17,len=1,table=0248,display=Evaluated Product Source"`
+	EvaluatedProductSource            ID      `hl7:"17,len=1,table=0248,datatype=ID,display=Evaluated Product Source"`
 	DateProductReturnedToManufacturer DTM     `hl7:"18,format=YMDHM,display=Date Product Returned To Manufacturer"`
-	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,display=Device Operator Qualifications"`
-	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,display=Relatedness Assessment"`
-	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,display=Action Taken In Response To The Event"`
-	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,display=Event Causality Observations"`
-	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,display=Indirect Exposure Mechanism"`
+	DeviceOperatorQualifications      ID      `hl7:"19,len=1,table=0242,datatype=ID,display=Device Operator Qualifications"`
+	RelatednessAssessment             ID      `hl7:"20,len=1,table=0250,datatype=ID,display=Relatedness Assessment"`
+	ActionTakenInResponseToTheEvent   []ID    `hl7:"21,max=6,len=2,table=0251,datatype=ID,display=Action Taken In Response To The Event"`
+	EventCausalityObservations        []ID    `hl7:"22,max=6,len=2,table=0252,datatype=ID,display=Event Causality Observations"`
+	IndirectExposureMechanism         []ID    `hl7:"23,max=3,len=1,table=0253,datatype=ID,display=Indirect Exposure Mechanism"`
 }
 
 // Patient Additional Demographic
@@ -2658,10 +2658,10 @@ type PD1 struct {
 	Handicap                                *CWE    `hl7:"6,table=0295,display=Handicap"`
 	LivingWillCode                          *CWE    `hl7:"7,table=0315,display=Living Will Code"`
 	OrganDonorCode                          *CWE    `hl7:"8,table=0316,display=Organ Donor Code"`
-	SeparateBill                            ID      `hl7:"9,len=1,table=0136,display=Separate Bill"`
+	SeparateBill                            ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Separate Bill"`
 	DuplicatePatient                        []CX    `hl7:"10,display=Duplicate Patient"`
 	PublicityCode                           *CWE    `hl7:"11,table=0215,display=Publicity Code"`
-	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,display=Protection Indicator"`
+	ProtectionIndicator                     ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Protection Indicator"`
 	ProtectionIndicatorEffectiveDate        DT      `hl7:"13,format=YMD,display=Protection Indicator Effective Date"`
 	PlaceOfWorship                          []XON   `hl7:"14,display=Place Of Worship"`
 	AdvanceDirectiveCode                    []CWE   `hl7:"15,conditional,table=0435,display=Advance Directive Code"`
@@ -2681,13 +2681,13 @@ type PDA struct {
 	HL7                            HL7Name `hl7:",name=PDA,type=s"`
 	DeathCauseCode                 []CWE   `hl7:"1,display=Death Cause Code"`
 	DeathLocation                  *PL     `hl7:"2,display=Death Location"`
-	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,display=Death Certified Indicator"`
+	DeathCertifiedIndicator        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Death Certified Indicator"`
 	DeathCertificateSignedDateTime DTM     `hl7:"4,format=YMDHM,display=Death Certificate Signed Date/Time"`
 	DeathCertifiedBy               *XCN    `hl7:"5,display=Death Certified By"`
-	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,display=Autopsy Indicator"`
+	AutopsyIndicator               ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Autopsy Indicator"`
 	AutopsyStartAndEndDateTime     *DR     `hl7:"7,display=Autopsy Start And End Date/Time"`
 	AutopsyPerformedBy             *XCN    `hl7:"8,display=Autopsy Performed By"`
-	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,display=Coroner Indicator"`
+	CoronerIndicator               ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Coroner Indicator"`
 }
 
 // Product Experience Observation
@@ -2709,11 +2709,11 @@ type PEO struct {
 	EventImprovedDateTime                 DTM     `hl7:"5,format=YMDHM,display=Event Improved Date/Time"`
 	EventEndedDataTime                    DTM     `hl7:"6,format=YMDHM,display=Event Ended Data/Time"`
 	EventLocationOccurredAddress          []XAD   `hl7:"7,display=Event Location Occurred Address"`
-	EventQualification                    []ID    `hl7:"8,len=1,table=0237,display=Event Qualification"`
-	EventSerious                          ID      `hl7:"9,len=1,table=0238,display=Event Serious"`
-	EventExpected                         ID      `hl7:"10,len=1,table=0239,display=Event Expected"`
-	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,display=Event Outcome"`
-	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,display=Patient Outcome"`
+	EventQualification                    []ID    `hl7:"8,len=1,table=0237,datatype=ID,display=Event Qualification"`
+	EventSerious                          ID      `hl7:"9,len=1,table=0238,datatype=ID,display=Event Serious"`
+	EventExpected                         ID      `hl7:"10,len=1,table=0239,datatype=ID,display=Event Expected"`
+	EventOutcome                          []ID    `hl7:"11,len=1,table=0240,datatype=ID,display=Event Outcome"`
+	PatientOutcome                        ID      `hl7:"12,len=1,table=0241,datatype=ID,display=Patient Outcome"`
 	EventDescriptionFromOthers            []FT    `hl7:"13,display=Event Description From Others"`
 	EventDescriptionFromOriginalReporter  []FT    `hl7:"14,display=Event Description From Original Reporter"`
 	EventDescriptionFromPatient           []FT    `hl7:"15,display=Event Description From Patient"`
@@ -2723,10 +2723,10 @@ type PEO struct {
 	PrimaryObserverName                   []XPN   `hl7:"19,display=Primary Observer Name"`
 	PrimaryObserverAddress                []XAD   `hl7:"20,display=Primary Observer Address"`
 	PrimaryObserverTelephone              []XTN   `hl7:"21,display=Primary Observer Telephone"`
-	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,display=Primary Observer's Qualification"`
-	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,display=Confirmation Provided By"`
+	PrimaryObserversQualification         ID      `hl7:"22,len=1,table=0242,datatype=ID,display=Primary Observer's Qualification"`
+	ConfirmationProvidedBy                ID      `hl7:"23,len=1,table=0242,datatype=ID,display=Confirmation Provided By"`
 	PrimaryObserverAwareDateTime          DTM     `hl7:"24,format=YMDHM,display=Primary Observer Aware Date/Time"`
-	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=2,table=0243,display=Primary Observer's Identity May Be Divulged"`
+	PrimaryObserversIdentityMayBeDivulged ID      `hl7:"25,len=2,table=0243,datatype=ID,display=Primary Observer's Identity May Be Divulged"`
 }
 
 // Product Experience Sender
@@ -2737,14 +2737,14 @@ type PES struct {
 	SenderAddress          []XAD   `hl7:"3,display=Sender Address"`
 	SenderTelephone        []XTN   `hl7:"4,display=Sender Telephone"`
 	SenderEventIdentifier  *EI     `hl7:"5,display=Sender Event Identifier"`
-	SenderSequenceNumber   NM      `hl7:"6,display=Sender Sequence Number"`
+	SenderSequenceNumber   NM      `hl7:"6,datatype=NM,display=Sender Sequence Number"`
 	SenderEventDescription []FT    `hl7:"7,display=Sender Event Description"`
 	SenderComment          FT      `hl7:"8,display=Sender Comment"`
 	SenderAwareDateTime    DTM     `hl7:"9,format=YMDHM,display=Sender Aware Date/Time"`
 	EventReportDate        DTM     `hl7:"10,required,format=YMDHM,display=Event Report Date"`
-	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,display=Event Report Timing/Type"`
-	EventReportSource      ID      `hl7:"12,len=1,table=0235,display=Event Report Source"`
-	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,display=Event Reported To"`
+	EventReportTimingType  []ID    `hl7:"11,max=2,len=3,table=0234,datatype=ID,display=Event Report Timing/Type"`
+	EventReportSource      ID      `hl7:"12,len=1,table=0235,datatype=ID,display=Event Report Source"`
+	EventReportedTo        []ID    `hl7:"13,len=1,table=0236,datatype=ID,display=Event Reported To"`
 }
 
 // Patient Identification
@@ -2775,7 +2775,7 @@ type PES struct {
 // facility (HD) as the sixth component.
 type PID struct {
 	HL7                                 HL7Name `hl7:",name=PID,type=s"`
-	SetID                               SI      `hl7:"1,seq,len=4,display=Set Id - Pid"`
+	SetID                               SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Pid"`
 	PatientID                           ST      `hl7:"2,display=Patient Id"`
 	PatientIdentifierList               []CX    `hl7:"3,required,display=Patient Identifier List"`
 	AlternatePatientID                  ST      `hl7:"4,display=Alternate Patient Id - Pid"`
@@ -2798,14 +2798,14 @@ type PID struct {
 	MothersIdentifier                   []CX    `hl7:"21,display=Mother's Identifier"`
 	EthnicGroup                         []CWE   `hl7:"22,table=0189,display=Ethnic Group"`
 	BirthPlace                          ST      `hl7:"23,display=Birth Place"`
-	MultipleBirthIndicator              ID      `hl7:"24,len=1,table=0136,display=Multiple Birth Indicator"`
-	BirthOrder                          NM      `hl7:"25,display=Birth Order"`
+	MultipleBirthIndicator              ID      `hl7:"24,len=1,table=0136,datatype=ID,display=Multiple Birth Indicator"`
+	BirthOrder                          NM      `hl7:"25,datatype=NM,display=Birth Order"`
 	Citizenship                         []CWE   `hl7:"26,table=0171,display=Citizenship"`
 	VeteransMilitaryStatus              *CWE    `hl7:"27,table=0172,display=Veterans Military Status"`
 	Nationality                         CWE     `hl7:"28,table=0212,display=Nationality"`
 	PatientDeathDateAndTime             DTM     `hl7:"29,format=YMDHM,display=Patient Death Date And Time"`
-	PatientDeathIndicator               ID      `hl7:"30,len=1,table=0136,display=Patient Death Indicator"`
-	IdentityUnknownIndicator            ID      `hl7:"31,len=1,table=0136,display=Identity Unknown Indicator"`
+	PatientDeathIndicator               ID      `hl7:"30,len=1,table=0136,datatype=ID,display=Patient Death Indicator"`
+	IdentityUnknownIndicator            ID      `hl7:"31,len=1,table=0136,datatype=ID,display=Identity Unknown Indicator"`
 	IdentityReliabilityCode             []CWE   `hl7:"32,table=0445,display=Identity Reliability Code"`
 	LastUpdateDateTime                  DTM     `hl7:"33,format=YMDHM,display=Last Update Date/Time"`
 	LastUpdateFacility                  *HD     `hl7:"34,display=Last Update Facility"`
@@ -2824,10 +2824,10 @@ type PID struct {
 // unit of measure order corresponding with the ascending Set ID.
 type PKG struct {
 	HL7                                HL7Name `hl7:",name=PKG,type=s"`
-	SetID                              SI      `hl7:"1,seq,required,len=4,display=Set Id - Pkg"`
+	SetID                              SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Pkg"`
 	PackagingUnits                     *CWE    `hl7:"2,table=0818,display=Packaging Units"`
 	DefaultOrderUnitOfMeasureIndicator *CNE    `hl7:"3,table=0532,display=Default Order Unit Of Measure Indicator"`
-	PackageQuantity                    NM      `hl7:"4,display=Package Quantity"`
+	PackageQuantity                    NM      `hl7:"4,datatype=NM,display=Package Quantity"`
 	Price                              *CP     `hl7:"5,display=Price"`
 	FutureItemPrice                    *CP     `hl7:"6,display=Future Item Price"`
 	FutureItemPriceEffectiveDate       DTM     `hl7:"7,format=YMDHM,display=Future Item Price Effective Date"`
@@ -2859,30 +2859,30 @@ type PMT struct {
 // The PR1 segment is used to send multiple procedures, for example, for medical records encoding or for billing systems.
 type PR1 struct {
 	HL7                             HL7Name `hl7:",name=PR1,type=s"`
-	SetID                           SI      `hl7:"1,seq,required,len=4,display=Set Id - Pr1"`
+	SetID                           SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Pr1"`
 	ProcedureCodingMethod           ST      `hl7:"2,display=Procedure Coding Method"`
 	ProcedureCode                   CNE     `hl7:"3,required,table=0088,display=Procedure Code"`
 	ProcedureDescription            ST      `hl7:"4,display=Procedure Description"`
 	ProcedureDateTime               DTM     `hl7:"5,required,format=YMDHM,display=Procedure Date/Time"`
 	ProcedureFunctionalType         *CWE    `hl7:"6,table=0230,display=Procedure Functional Type"`
-	ProcedureMinutes                NM      `hl7:"7,display=Procedure Minutes"`
+	ProcedureMinutes                NM      `hl7:"7,datatype=NM,display=Procedure Minutes"`
 	Anesthesiologist                ST      `hl7:"8,display=Anesthesiologist"`
 	AnesthesiaCode                  *CWE    `hl7:"9,table=0019,display=Anesthesia Code"`
-	AnesthesiaMinutes               NM      `hl7:"10,display=Anesthesia Minutes"`
+	AnesthesiaMinutes               NM      `hl7:"10,datatype=NM,display=Anesthesia Minutes"`
 	Surgeon                         ST      `hl7:"11,display=Surgeon"`
 	ProcedurePractitioner           ST      `hl7:"12,display=Procedure Practitioner"`
 	ConsentCode                     *CWE    `hl7:"13,table=0059,display=Consent Code"`
-	ProcedurePriority               NM      `hl7:"14,len=2,table=0418,display=Procedure Priority"`
+	ProcedurePriority               NM      `hl7:"14,len=2,table=0418,datatype=NM,display=Procedure Priority"`
 	AssociatedDiagnosisCode         *CWE    `hl7:"15,table=0051,display=Associated Diagnosis Code"`
 	ProcedureCodeModifier           []CNE   `hl7:"16,table=0340,display=Procedure Code Modifier"`
 	ProcedureDrgType                *CWE    `hl7:"17,table=0416,display=Procedure Drg Type"`
 	TissueTypeCode                  []CWE   `hl7:"18,table=0417,display=Tissue Type Code"`
 	ProcedureIdentifier             *EI     `hl7:"19,conditional,display=Procedure Identifier"`
-	ProcedureActionCode             ID      `hl7:"20,conditional,len=1,table=0206,display=Procedure Action Code"`
+	ProcedureActionCode             ID      `hl7:"20,conditional,len=1,table=0206,datatype=ID,display=Procedure Action Code"`
 	DrgProcedureDeterminationStatus *CWE    `hl7:"21,table=0761,display=Drg Procedure Determination Status"`
 	DrgProcedureRelevance           *CWE    `hl7:"22,table=0763,display=Drg Procedure Relevance"`
 	TreatingOrganizationalUnit      []PL    `hl7:"23,display=Treating Organizational Unit"`
-	RespiratoryWithinSurgery        ID      `hl7:"24,len=1,table=0136,display=Respiratory Within Surgery"`
+	RespiratoryWithinSurgery        ID      `hl7:"24,len=1,table=0136,datatype=ID,display=Respiratory Within Surgery"`
 	ParentProcedureID               *EI     `hl7:"25,display=Parent Procedure Id"`
 }
 
@@ -2899,7 +2899,7 @@ type PRA struct {
 	PrimaryKeyValue                           *CWE    `hl7:"1,conditional,table=9999,display=Primary Key Value - Pra"`
 	PractitionerGroup                         []CWE   `hl7:"2,table=0358,display=Practitioner Group"`
 	PractitionerCategory                      []CWE   `hl7:"3,table=0186,display=Practitioner Category"`
-	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,display=Provider Billing"`
+	ProviderBilling                           ID      `hl7:"4,len=1,table=0187,datatype=ID,display=Provider Billing"`
 	Specialty                                 []SPD   `hl7:"5,display=Specialty"`
 	PractitionerIDNumbers                     []PLN   `hl7:"6,display=Practitioner Id Numbers"`
 	Privileges                                []PIP   `hl7:"7,display=Privileges"`
@@ -2907,7 +2907,7 @@ type PRA struct {
 	Institution                               *CWE    `hl7:"9,table=0537,display=Institution"`
 	DateLeftPractice                          DT      `hl7:"10,format=YMD,display=Date Left Practice"`
 	GovernmentReimbursementBillingEligibility []CWE   `hl7:"11,table=0401,display=Government Reimbursement Billing Eligibility"`
-	SetID                                     SI      `hl7:"12,seq,conditional,len=4,display=Set Id - Pra"`
+	SetID                                     SI      `hl7:"12,seq,conditional,len=4,datatype=SI,display=Set Id - Pra"`
 }
 
 // Problem Details
@@ -2919,12 +2919,12 @@ type PRA struct {
 // version of that data.
 type PRB struct {
 	HL7                                               HL7Name `hl7:",name=PRB,type=s"`
-	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                                        ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionDateTime                                    DTM     `hl7:"2,required,format=YMDHM,display=Action Date/Time"`
 	ProblemID                                         CWE     `hl7:"3,required,display=Problem Id"`
 	ProblemInstanceID                                 EI      `hl7:"4,required,display=Problem Instance Id"`
 	EpisodeOfCareID                                   *EI     `hl7:"5,display=Episode Of Care Id"`
-	ProblemListPriority                               NM      `hl7:"6,display=Problem List Priority"`
+	ProblemListPriority                               NM      `hl7:"6,datatype=NM,display=Problem List Priority"`
 	ProblemEstablishedDateTime                        DTM     `hl7:"7,format=YMDHM,display=Problem Established Date/Time"`
 	AnticipatedProblemResolutionDateTime              DTM     `hl7:"8,format=YMDHM,display=Anticipated Problem Resolution Date/Time"`
 	ActualProblemResolutionDateTime                   DTM     `hl7:"9,format=YMDHM,display=Actual Problem Resolution Date/Time"`
@@ -2938,7 +2938,7 @@ type PRB struct {
 	ProblemOnsetText                                  ST      `hl7:"17,display=Problem Onset Text"`
 	ProblemRanking                                    *CWE    `hl7:"18,display=Problem Ranking"`
 	CertaintyOfProblem                                *CWE    `hl7:"19,display=Certainty Of Problem"`
-	ProbabilityOfProblem01                            NM      `hl7:"20,display=Probability Of Problem (0-1)"`
+	ProbabilityOfProblem01                            NM      `hl7:"20,datatype=NM,display=Probability Of Problem (0-1)"`
 	IndividualAwarenessOfProblem                      *CWE    `hl7:"21,display=Individual Awareness Of Problem"`
 	ProblemPrognosis                                  *CWE    `hl7:"22,display=Problem Prognosis"`
 	IndividualAwarenessOfPrognosis                    *CWE    `hl7:"23,display=Individual Awareness Of Prognosis"`
@@ -2963,16 +2963,16 @@ type PRC struct {
 	ValidPatientClasses []CWE   `hl7:"4,table=0004,display=Valid Patient Classes"`
 	Price               []CP    `hl7:"5,conditional,display=Price"`
 	Formula             []ST    `hl7:"6,display=Formula"`
-	MinimumQuantity     NM      `hl7:"7,display=Minimum Quantity"`
-	MaximumQuantity     NM      `hl7:"8,display=Maximum Quantity"`
+	MinimumQuantity     NM      `hl7:"7,datatype=NM,display=Minimum Quantity"`
+	MaximumQuantity     NM      `hl7:"8,datatype=NM,display=Maximum Quantity"`
 	MinimumPrice        *MO     `hl7:"9,display=Minimum Price"`
 	MaximumPrice        *MO     `hl7:"10,display=Maximum Price"`
 	EffectiveStartDate  DTM     `hl7:"11,format=YMDHM,display=Effective Start Date"`
 	EffectiveEndDate    DTM     `hl7:"12,format=YMDHM,display=Effective End Date"`
 	PriceOverrideFlag   *CWE    `hl7:"13,table=0268,display=Price Override Flag"`
 	BillingCategory     []CWE   `hl7:"14,table=0293,display=Billing Category"`
-	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,display=Chargeable Flag"`
-	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,display=Active/Inactive Flag"`
+	ChargeableFlag      ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Chargeable Flag"`
+	ActiveInactiveFlag  ID      `hl7:"16,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Cost                *MO     `hl7:"17,display=Cost"`
 	ChargeOnIndicator   *CWE    `hl7:"18,table=0269,display=Charge On Indicator"`
 }
@@ -3019,7 +3019,7 @@ type PRD struct {
 type PRT struct {
 	HL7                                   HL7Name `hl7:",name=PRT,type=s"`
 	ParticipationInstanceID               *EI     `hl7:"1,conditional,len=4,display=Participation Instance Id"`
-	ActionCode                            ID      `hl7:"2,required,len=2,table=0287,display=Action Code"`
+	ActionCode                            ID      `hl7:"2,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	ActionReason                          *CWE    `hl7:"3,display=Action Reason"`
 	Participation                         CWE     `hl7:"4,required,table=0912,display=Participation"`
 	ParticipationPerson                   []XCN   `hl7:"5,conditional,display=Participation Person"`
@@ -3044,8 +3044,8 @@ type PSG struct {
 	HL7                               HL7Name `hl7:",name=PSG,type=s"`
 	ProviderProductServiceGroupNumber EI      `hl7:"1,required,display=Provider Product/Service Group Number"`
 	PayerProductServiceGroupNumber    *EI     `hl7:"2,display=Payer Product/Service Group Number"`
-	ProductServiceGroupSequenceNumber SI      `hl7:"3,required,len=4,display=Product/Service Group Sequence Number"`
-	AdjudicateAsGroup                 ID      `hl7:"4,required,len=1,table=0136,display=Adjudicate As Group"`
+	ProductServiceGroupSequenceNumber SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Group Sequence Number"`
+	AdjudicateAsGroup                 ID      `hl7:"4,required,len=1,table=0136,datatype=ID,display=Adjudicate As Group"`
 	ProductServiceGroupBilledAmount   CP      `hl7:"5,required,display=Product/Service Group Billed Amount"`
 	ProductServiceGroupDescription    ST      `hl7:"6,required,display=Product/Service Group Description"`
 }
@@ -3058,7 +3058,7 @@ type PSL struct {
 	HL7                                      HL7Name `hl7:",name=PSL,type=s"`
 	ProviderProductServiceLineItemNumber     EI      `hl7:"1,required,display=Provider Product/Service Line Item Number"`
 	PayerProductServiceLineItemNumber        *EI     `hl7:"2,display=Payer Product/Service Line Item Number"`
-	ProductServiceLineItemSequenceNumber     SI      `hl7:"3,required,len=4,display=Product/Service Line Item Sequence Number"`
+	ProductServiceLineItemSequenceNumber     SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Line Item Sequence Number"`
 	ProviderTrackingID                       *EI     `hl7:"4,display=Provider Tracking Id"`
 	PayerTrackingID                          *EI     `hl7:"5,display=Payer Tracking Id"`
 	ProductServiceLineItemStatus             CWE     `hl7:"6,required,table=0559,display=Product/Service Line Item Status"`
@@ -3069,40 +3069,40 @@ type PSL struct {
 	ProductServiceExpirationDate             DTM     `hl7:"11,format=YMDHM,display=Product/Service Expiration Date"`
 	ProductServiceQuantity                   *CQ     `hl7:"12,conditional,display=Product/Service Quantity"`
 	ProductServiceUnitCost                   *CP     `hl7:"13,conditional,display=Product/Service Unit Cost"`
-	NumberOfItemsPerUnit                     NM      `hl7:"14,conditional,display=Number Of Items Per Unit"`
+	NumberOfItemsPerUnit                     NM      `hl7:"14,conditional,datatype=NM,display=Number Of Items Per Unit"`
 	ProductServiceGrossAmount                *CP     `hl7:"15,conditional,display=Product/Service Gross Amount"`
 	ProductServiceBilledAmount               *CP     `hl7:"16,conditional,display=Product/Service Billed Amount"`
 	ProductServiceClarificationCodeType      *CWE    `hl7:"17,table=0561,display=Product/Service Clarification Code Type"`
 	ProductServiceClarificationCodeValue     ST      `hl7:"18,display=Product/Service Clarification Code Value"`
 	HealthDocumentReferenceIdentifier        *EI     `hl7:"19,display=Health Document Reference Identifier"`
 	ProcessingConsiderationCode              *CWE    `hl7:"20,table=0562,display=Processing Consideration Code"`
-	RestrictedDisclosureIndicator            ID      `hl7:"21,required,len=4,table=0532,display=Restricted Disclosure Indicator"`
+	RestrictedDisclosureIndicator            ID      `hl7:"21,required,len=4,table=0532,datatype=ID,display=Restricted Disclosure Indicator"`
 	RelatedProductServiceCodeIndicator       *CWE    `hl7:"22,table=0879,display=Related Product/Service Code Indicator"`
 	ProductServiceAmountForPhysician         *CP     `hl7:"23,display=Product/Service Amount For Physician"`
-	ProductServiceCostFactor                 NM      `hl7:"24,display=Product/Service Cost Factor"`
+	ProductServiceCostFactor                 NM      `hl7:"24,datatype=NM,display=Product/Service Cost Factor"`
 	CostCenter                               *CX     `hl7:"25,display=Cost Center"`
 	BillingPeriod                            *DR     `hl7:"26,display=Billing Period"`
-	DaysWithoutBilling                       NM      `hl7:"27,display=Days Without Billing"`
-	SessionNo                                NM      `hl7:"28,len=4,display=Session-no"`
+	DaysWithoutBilling                       NM      `hl7:"27,datatype=NM,display=Days Without Billing"`
+	SessionNo                                NM      `hl7:"28,len=4,datatype=NM,display=Session-no"`
 	ExecutingPhysicianID                     *XCN    `hl7:"29,display=Executing Physician Id"`
 	ResponsiblePhysicianID                   *XCN    `hl7:"30,display=Responsible Physician Id"`
 	RoleExecutingPhysician                   *CWE    `hl7:"31,table=0881,display=Role Executing Physician"`
 	MedicalRoleExecutingPhysician            *CWE    `hl7:"32,table=0882,display=Medical Role Executing Physician"`
 	SideOfBody                               *CWE    `hl7:"33,table=0894,display=Side Of Body"`
-	NumberOfTpsPp                            NM      `hl7:"34,display=Number Of Tp's Pp"`
+	NumberOfTpsPp                            NM      `hl7:"34,datatype=NM,display=Number Of Tp's Pp"`
 	TpValuePp                                *CP     `hl7:"35,display=Tp-value Pp"`
-	InternalScalingFactorPp                  NM      `hl7:"36,display=Internal Scaling Factor Pp"`
-	ExternalScalingFactorPp                  NM      `hl7:"37,display=External Scaling Factor Pp"`
+	InternalScalingFactorPp                  NM      `hl7:"36,datatype=NM,display=Internal Scaling Factor Pp"`
+	ExternalScalingFactorPp                  NM      `hl7:"37,datatype=NM,display=External Scaling Factor Pp"`
 	AmountPp                                 *CP     `hl7:"38,display=Amount Pp"`
-	NumberOfTpsTechnicalPart                 NM      `hl7:"39,display=Number Of Tp's Technical Part"`
+	NumberOfTpsTechnicalPart                 NM      `hl7:"39,datatype=NM,display=Number Of Tp's Technical Part"`
 	TpValueTechnicalPart                     *CP     `hl7:"40,display=Tp-value Technical Part"`
-	InternalScalingFactorTechnicalPart       NM      `hl7:"41,display=Internal Scaling Factor Technical Part"`
-	ExternalScalingFactorTechnicalPart       NM      `hl7:"42,display=External Scaling Factor Technical Part"`
+	InternalScalingFactorTechnicalPart       NM      `hl7:"41,datatype=NM,display=Internal Scaling Factor Technical Part"`
+	ExternalScalingFactorTechnicalPart       NM      `hl7:"42,datatype=NM,display=External Scaling Factor Technical Part"`
 	AmountTechnicalPart                      *CP     `hl7:"43,display=Amount Technical Part"`
 	TotalAmountProfessionalPartTechnicalPart *CP     `hl7:"44,display=Total Amount Professional Part + Technical Part"`
-	VatRate                                  NM      `hl7:"45,display=Vat-rate"`
-	MainService                              ID      `hl7:"46,len=20,display=Main-service"`
-	Validation                               ID      `hl7:"47,len=1,table=0136,display=Validation"`
+	VatRate                                  NM      `hl7:"45,datatype=NM,display=Vat-rate"`
+	MainService                              ID      `hl7:"46,len=20,datatype=ID,display=Main-service"`
+	Validation                               ID      `hl7:"47,len=1,table=0136,datatype=ID,display=Validation"`
 	Comment                                  ST      `hl7:"48,display=Comment"`
 }
 
@@ -3114,7 +3114,7 @@ type PSS struct {
 	HL7                                 HL7Name `hl7:",name=PSS,type=s"`
 	ProviderProductServiceSectionNumber EI      `hl7:"1,required,display=Provider Product/Service Section Number"`
 	PayerProductServiceSectionNumber    *EI     `hl7:"2,display=Payer Product/Service Section Number"`
-	ProductServiceSectionSequenceNumber SI      `hl7:"3,required,len=4,display=Product/Service Section Sequence Number"`
+	ProductServiceSectionSequenceNumber SI      `hl7:"3,required,len=4,datatype=SI,display=Product/Service Section Sequence Number"`
 	BilledAmount                        CP      `hl7:"4,required,display=Billed Amount"`
 	SectionDescriptionOrHeading         ST      `hl7:"5,required,display=Section Description Or Heading"`
 }
@@ -3125,7 +3125,7 @@ type PSS struct {
 // to address an individual's health care.
 type PTH struct {
 	HL7                                  HL7Name `hl7:",name=PTH,type=s"`
-	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,display=Action Code"`
+	ActionCode                           ID      `hl7:"1,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	PathwayID                            CWE     `hl7:"2,required,display=Pathway Id"`
 	PathwayInstanceID                    EI      `hl7:"3,required,display=Pathway Instance Id"`
 	PathwayEstablishedDateTime           DTM     `hl7:"4,required,format=YMDHM,display=Pathway Established Date/Time"`
@@ -3151,7 +3151,7 @@ type PTH struct {
 // <point of care> ^ <room> ^ <bed> combination may exist at more than one facility.
 type PV1 struct {
 	HL7                       HL7Name `hl7:",name=PV1,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set Id - Pv1"`
+	SetID                     SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Pv1"`
 	PatientClass              CWE     `hl7:"2,required,table=0004,display=Patient Class"`
 	AssignedPatientLocation   *PL     `hl7:"3,display=Assigned Patient Location"`
 	AdmissionType             *CWE    `hl7:"4,table=0007,display=Admission Type"`
@@ -3176,14 +3176,14 @@ type PV1 struct {
 	CreditRating              *CWE    `hl7:"23,table=0046,display=Credit Rating"`
 	ContractCode              []CWE   `hl7:"24,table=0044,display=Contract Code"`
 	ContractEffectiveDate     []DT    `hl7:"25,format=YMD,display=Contract Effective Date"`
-	ContractAmount            []NM    `hl7:"26,display=Contract Amount"`
-	ContractPeriod            []NM    `hl7:"27,display=Contract Period"`
+	ContractAmount            []NM    `hl7:"26,datatype=NM,display=Contract Amount"`
+	ContractPeriod            []NM    `hl7:"27,datatype=NM,display=Contract Period"`
 	InterestCode              *CWE    `hl7:"28,table=0073,display=Interest Code"`
 	TransferToBadDebtCode     *CWE    `hl7:"29,table=0110,display=Transfer To Bad Debt Code"`
 	TransferToBadDebtDate     DT      `hl7:"30,format=YMD,display=Transfer To Bad Debt Date"`
 	BadDebtAgencyCode         *CWE    `hl7:"31,table=0021,display=Bad Debt Agency Code"`
-	BadDebtTransferAmount     NM      `hl7:"32,display=Bad Debt Transfer Amount"`
-	BadDebtRecoveryAmount     NM      `hl7:"33,display=Bad Debt Recovery Amount"`
+	BadDebtTransferAmount     NM      `hl7:"32,datatype=NM,display=Bad Debt Transfer Amount"`
+	BadDebtRecoveryAmount     NM      `hl7:"33,datatype=NM,display=Bad Debt Recovery Amount"`
 	DeleteAccountIndicator    *CWE    `hl7:"34,table=0111,display=Delete Account Indicator"`
 	DeleteAccountDate         DT      `hl7:"35,format=YMD,display=Delete Account Date"`
 	DischargeDisposition      *CWE    `hl7:"36,table=0112,display=Discharge Disposition"`
@@ -3196,10 +3196,10 @@ type PV1 struct {
 	PriorTemporaryLocation    *PL     `hl7:"43,display=Prior Temporary Location"`
 	AdmitDateTime             DTM     `hl7:"44,format=YMDHM,display=Admit Date/Time"`
 	DischargeDateTime         DTM     `hl7:"45,format=YMDHM,display=Discharge Date/Time"`
-	CurrentPatientBalance     NM      `hl7:"46,display=Current Patient Balance"`
-	TotalCharges              NM      `hl7:"47,display=Total Charges"`
-	TotalAdjustments          NM      `hl7:"48,display=Total Adjustments"`
-	TotalPayments             NM      `hl7:"49,display=Total Payments"`
+	CurrentPatientBalance     NM      `hl7:"46,datatype=NM,display=Current Patient Balance"`
+	TotalCharges              NM      `hl7:"47,datatype=NM,display=Total Charges"`
+	TotalAdjustments          NM      `hl7:"48,datatype=NM,display=Total Adjustments"`
+	TotalPayments             NM      `hl7:"49,datatype=NM,display=Total Payments"`
 	AlternateVisitID          *CX     `hl7:"50,display=Alternate Visit Id"`
 	VisitIndicator            *CWE    `hl7:"51,table=0326,display=Visit Indicator"`
 	OtherHealthcareProvider   ST      `hl7:"52,display=Other Healthcare Provider"`
@@ -3221,19 +3221,19 @@ type PV2 struct {
 	VisitUserCode                       []CWE   `hl7:"7,table=0130,display=Visit User Code"`
 	ExpectedAdmitDateTime               DTM     `hl7:"8,format=YMDHM,display=Expected Admit Date/Time"`
 	ExpectedDischargeDateTime           DTM     `hl7:"9,format=YMDHM,display=Expected Discharge Date/Time"`
-	EstimatedLengthOfInpatientStay      NM      `hl7:"10,display=Estimated Length Of Inpatient Stay"`
-	ActualLengthOfInpatientStay         NM      `hl7:"11,display=Actual Length Of Inpatient Stay"`
+	EstimatedLengthOfInpatientStay      NM      `hl7:"10,datatype=NM,display=Estimated Length Of Inpatient Stay"`
+	ActualLengthOfInpatientStay         NM      `hl7:"11,datatype=NM,display=Actual Length Of Inpatient Stay"`
 	VisitDescription                    ST      `hl7:"12,display=Visit Description"`
 	ReferralSourceCode                  []XCN   `hl7:"13,display=Referral Source Code"`
 	PreviousServiceDate                 DT      `hl7:"14,format=YMD,display=Previous Service Date"`
-	EmploymentIllnessRelatedIndicator   ID      `hl7:"15,len=1,table=0136,display=Employment Illness Related Indicator"`
+	EmploymentIllnessRelatedIndicator   ID      `hl7:"15,len=1,table=0136,datatype=ID,display=Employment Illness Related Indicator"`
 	PurgeStatusCode                     *CWE    `hl7:"16,table=0213,display=Purge Status Code"`
 	PurgeStatusDate                     DT      `hl7:"17,format=YMD,display=Purge Status Date"`
 	SpecialProgramCode                  *CWE    `hl7:"18,table=0214,display=Special Program Code"`
-	RetentionIndicator                  ID      `hl7:"19,len=1,table=0136,display=Retention Indicator"`
-	ExpectedNumberOfInsurancePlans      NM      `hl7:"20,display=Expected Number Of Insurance Plans"`
+	RetentionIndicator                  ID      `hl7:"19,len=1,table=0136,datatype=ID,display=Retention Indicator"`
+	ExpectedNumberOfInsurancePlans      NM      `hl7:"20,datatype=NM,display=Expected Number Of Insurance Plans"`
 	VisitPublicityCode                  *CWE    `hl7:"21,table=0215,display=Visit Publicity Code"`
-	VisitProtectionIndicator            ID      `hl7:"22,len=1,table=0136,display=Visit Protection Indicator"`
+	VisitProtectionIndicator            ID      `hl7:"22,len=1,table=0136,datatype=ID,display=Visit Protection Indicator"`
 	ClinicOrganizationName              []XON   `hl7:"23,display=Clinic Organization Name"`
 	PatientStatusCode                   *CWE    `hl7:"24,table=0216,display=Patient Status Code"`
 	VisitPriorityCode                   *CWE    `hl7:"25,table=0217,display=Visit Priority Code"`
@@ -3243,12 +3243,12 @@ type PV2 struct {
 	FirstSimilarIllnessDate             DT      `hl7:"29,format=YMD,display=First Similar Illness Date"`
 	PatientChargeAdjustmentCode         *CWE    `hl7:"30,table=0218,display=Patient Charge Adjustment Code"`
 	RecurringServiceCode                *CWE    `hl7:"31,table=0219,display=Recurring Service Code"`
-	BillingMediaCode                    ID      `hl7:"32,len=1,table=0136,display=Billing Media Code"`
+	BillingMediaCode                    ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Billing Media Code"`
 	ExpectedSurgeryDateAndTime          DTM     `hl7:"33,format=YMDHM,display=Expected Surgery Date And Time"`
-	MilitaryPartnershipCode             ID      `hl7:"34,len=1,table=0136,display=Military Partnership Code"`
-	MilitaryNonAvailabilityCode         ID      `hl7:"35,len=1,table=0136,display=Military Non-availability Code"`
-	NewbornBabyIndicator                ID      `hl7:"36,len=1,table=0136,display=Newborn Baby Indicator"`
-	BabyDetainedIndicator               ID      `hl7:"37,len=1,table=0136,display=Baby Detained Indicator"`
+	MilitaryPartnershipCode             ID      `hl7:"34,len=1,table=0136,datatype=ID,display=Military Partnership Code"`
+	MilitaryNonAvailabilityCode         ID      `hl7:"35,len=1,table=0136,datatype=ID,display=Military Non-availability Code"`
+	NewbornBabyIndicator                ID      `hl7:"36,len=1,table=0136,datatype=ID,display=Newborn Baby Indicator"`
+	BabyDetainedIndicator               ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Baby Detained Indicator"`
 	ModeOfArrivalCode                   *CWE    `hl7:"38,table=0430,display=Mode Of Arrival Code"`
 	RecreationalDrugUseCode             []CWE   `hl7:"39,table=0431,display=Recreational Drug Use Code"`
 	AdmissionLevelOfCareCode            *CWE    `hl7:"40,table=0432,display=Admission Level Of Care Code"`
@@ -3269,7 +3269,7 @@ type PV2 struct {
 // This segment is used to define payee information.
 type PYE struct {
 	HL7                               HL7Name `hl7:",name=PYE,type=s"`
-	SetID                             SI      `hl7:"1,seq,required,len=4,display=Set Id - Pye"`
+	SetID                             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Pye"`
 	PayeeType                         CWE     `hl7:"2,required,table=0557,display=Payee Type"`
 	PayeeRelationshipToInvoicePatient *CWE    `hl7:"3,conditional,table=0558,display=Payee Relationship To Invoice (patient)"`
 	PayeeIdentificationList           *XON    `hl7:"4,conditional,display=Payee Identification List"`
@@ -3285,11 +3285,11 @@ type PYE struct {
 type QAK struct {
 	HL7                 HL7Name `hl7:",name=QAK,type=s"`
 	QueryTag            ST      `hl7:"1,conditional,display=Query Tag"`
-	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,display=Query Response Status"`
+	QueryResponseStatus ID      `hl7:"2,len=2,table=0208,datatype=ID,display=Query Response Status"`
 	MessageQueryName    *CWE    `hl7:"3,table=0471,display=Message Query Name"`
-	HitCountTotal       NM      `hl7:"4,display=Hit Count Total"`
-	ThisPayload         NM      `hl7:"5,display=This Payload"`
-	HitsRemaining       NM      `hl7:"6,display=Hits Remaining"`
+	HitCountTotal       NM      `hl7:"4,datatype=NM,display=Hit Count Total"`
+	ThisPayload         NM      `hl7:"5,datatype=NM,display=This Payload"`
+	HitsRemaining       NM      `hl7:"6,datatype=NM,display=Hits Remaining"`
 }
 
 // Query Identification
@@ -3333,7 +3333,7 @@ type QRF struct {
 // Response."
 type QRI struct {
 	HL7                 HL7Name `hl7:",name=QRI,type=s"`
-	CandidateConfidence NM      `hl7:"1,display=Candidate Confidence"`
+	CandidateConfidence NM      `hl7:"1,datatype=NM,display=Candidate Confidence"`
 	MatchReasonCode     []CWE   `hl7:"2,len=2,table=0392,display=Match Reason Code"`
 	AlgorithmDescriptor *CWE    `hl7:"3,table=0393,display=Algorithm Descriptor"`
 }
@@ -3343,13 +3343,13 @@ type QRI struct {
 // The RCP segment is used to restrict the amount of data that should be returned in response to query.
 type RCP struct {
 	HL7                      HL7Name `hl7:",name=RCP,type=s"`
-	QueryPriority            ID      `hl7:"1,len=1,table=0091,display=Query Priority"`
+	QueryPriority            ID      `hl7:"1,len=1,table=0091,datatype=ID,display=Query Priority"`
 	QuantityLimitedRequest   *CQ     `hl7:"2,display=Quantity Limited Request"`
 	ResponseModality         *CNE    `hl7:"3,table=0394,display=Response Modality"`
 	ExecutionAndDeliveryTime DTM     `hl7:"4,conditional,format=YMDHM,display=Execution And Delivery Time"`
-	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,display=Modify Indicator"`
+	ModifyIndicator          ID      `hl7:"5,len=1,table=0395,datatype=ID,display=Modify Indicator"`
 	SortByField              []SRT   `hl7:"6,display=Sort-by Field"`
-	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,display=Segment Group Inclusion"`
+	SegmentGroupInclusion    []ID    `hl7:"7,len=256,table=0391,datatype=ID,display=Segment Group Inclusion"`
 }
 
 // Table Row Definition
@@ -3362,7 +3362,7 @@ type RCP struct {
 // data (RDT) segments that follows. It is not necessarily an echo back of the segment as it appeared in the query.
 type RDF struct {
 	HL7                   HL7Name `hl7:",name=RDF,type=s"`
-	NumberOfColumnsPerRow NM      `hl7:"1,required,display=Number Of Columns Per Row"`
+	NumberOfColumnsPerRow NM      `hl7:"1,required,datatype=NM,display=Number Of Columns Per Row"`
 	ColumnDescription     []RCD   `hl7:"2,required,display=Column Description"`
 }
 
@@ -3391,7 +3391,7 @@ type RDT struct {
 // - Complication is a consequence of a procedure.
 type REL struct {
 	HL7                                          HL7Name `hl7:",name=REL,type=s"`
-	SetID                                        SI      `hl7:"1,seq,conditional,len=4,display=Set Id -rel"`
+	SetID                                        SI      `hl7:"1,seq,conditional,len=4,datatype=SI,display=Set Id -rel"`
 	RelationshipType                             CWE     `hl7:"2,required,display=Relationship Type"`
 	ThisRelationshipInstanceIdentifier           EI      `hl7:"3,required,display=This Relationship Instance Identifier"`
 	SourceInformationInstanceIdentifier          EI      `hl7:"4,required,display=Source Information Instance Identifier"`
@@ -3402,11 +3402,11 @@ type REL struct {
 	AssertorAddress                              *XAD    `hl7:"9,display=Assertor Address"`
 	AssertorContact                              *XTN    `hl7:"10,display=Assertor Contact"`
 	AssertionDateRange                           *DR     `hl7:"11,display=Assertion Date Range"`
-	NegationIndicator                            ID      `hl7:"12,len=1,table=0136,display=Negation Indicator"`
+	NegationIndicator                            ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Negation Indicator"`
 	CertaintyOfRelationship                      *CWE    `hl7:"13,display=Certainty Of Relationship"`
-	PriorityNo                                   NM      `hl7:"14,display=Priority No"`
-	PrioritySequenceNoPreferenceForConsideration NM      `hl7:"15,display=Priority  Sequence No (rel Preference For Consideration)"`
-	SeparabilityIndicator                        ID      `hl7:"16,len=1,table=0136,display=Separability Indicator"`
+	PriorityNo                                   NM      `hl7:"14,datatype=NM,display=Priority No"`
+	PrioritySequenceNoPreferenceForConsideration NM      `hl7:"15,datatype=NM,display=Priority  Sequence No (rel Preference For Consideration)"`
+	SeparabilityIndicator                        ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Separability Indicator"`
 }
 
 // Referral Information
@@ -3434,7 +3434,7 @@ type RFI struct {
 	HL7                                   HL7Name `hl7:",name=RFI,type=s"`
 	RequestDate                           DTM     `hl7:"1,required,format=YMDHM,display=Request Date"`
 	ResponseDueDate                       DTM     `hl7:"2,required,format=YMDHM,display=Response Due Date"`
-	PatientConsent                        ID      `hl7:"3,len=1,table=0136,display=Patient Consent"`
+	PatientConsent                        ID      `hl7:"3,len=1,table=0136,datatype=ID,display=Patient Consent"`
 	DateAdditionalInformationWasSubmitted DTM     `hl7:"4,format=YMDHM,display=Date Additional Information Was Submitted"`
 }
 
@@ -3451,8 +3451,8 @@ type RFI struct {
 // if no grouping of resources is required – to allow parsers to properly understand the message.)
 type RGS struct {
 	HL7               HL7Name `hl7:",name=RGS,type=s"`
-	SetID             SI      `hl7:"1,seq,required,len=4,display=Set Id - Rgs"`
-	SegmentActionCode ID      `hl7:"2,conditional,len=1,table=0206,display=Segment Action Code"`
+	SetID             SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Rgs"`
+	SegmentActionCode ID      `hl7:"2,conditional,len=1,table=0206,datatype=ID,display=Segment Action Code"`
 	ResourceGroupID   *CWE    `hl7:"3,display=Resource Group Id"`
 }
 
@@ -3483,7 +3483,7 @@ type RMI struct {
 type ROL struct {
 	HL7                         HL7Name `hl7:",name=ROL,type=s"`
 	RoleInstanceID              *EI     `hl7:"1,conditional,display=Role Instance Id"`
-	ActionCode                  ID      `hl7:"2,required,len=2,table=0287,display=Action Code"`
+	ActionCode                  ID      `hl7:"2,required,len=2,table=0287,datatype=ID,display=Action Code"`
 	Role                        CWE     `hl7:"3,required,table=0443,display=Role-rol"`
 	RolePerson                  []XCN   `hl7:"4,required,display=Role Person"`
 	RoleBeginDateTime           DTM     `hl7:"5,format=YMDHM,display=Role Begin Date/Time"`
@@ -3509,8 +3509,8 @@ type RQ1 struct {
 	ManufacturersCatalog   ST      `hl7:"3,conditional,display=Manufacturer's Catalog"`
 	VendorID               *CWE    `hl7:"4,conditional,table=9999,display=Vendor Id"`
 	VendorCatalog          ST      `hl7:"5,conditional,display=Vendor Catalog"`
-	Taxable                ID      `hl7:"6,len=1,table=0136,display=Taxable"`
-	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,display=Substitute Allowed"`
+	Taxable                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Taxable"`
+	SubstituteAllowed      ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Substitute Allowed"`
 }
 
 // Requisition Detail
@@ -3518,11 +3518,11 @@ type RQ1 struct {
 // RQD contains the detail for each requisitioned item.
 type RQD struct {
 	HL7                      HL7Name `hl7:",name=RQD,type=s"`
-	RequisitionLineNumber    SI      `hl7:"1,len=4,display=Requisition Line Number"`
+	RequisitionLineNumber    SI      `hl7:"1,len=4,datatype=SI,display=Requisition Line Number"`
 	ItemCodeInternal         *CWE    `hl7:"2,conditional,table=9999,display=Item Code - Internal"`
 	ItemCodeExternal         *CWE    `hl7:"3,conditional,table=9999,display=Item Code - External"`
 	HospitalItemCode         *CWE    `hl7:"4,conditional,table=9999,display=Hospital Item Code"`
-	RequisitionQuantity      NM      `hl7:"5,display=Requisition Quantity"`
+	RequisitionQuantity      NM      `hl7:"5,datatype=NM,display=Requisition Quantity"`
 	RequisitionUnitOfMeasure *CWE    `hl7:"6,table=9999,display=Requisition Unit Of Measure"`
 	CostCenterAccountNumber  *CX     `hl7:"7,table=0319,display=Cost Center Account Number"`
 	ItemNaturalAccountCode   *CWE    `hl7:"8,table=0320,display=Item Natural Account Code"`
@@ -3537,32 +3537,32 @@ type RQD struct {
 // the administration data.
 type RXA struct {
 	HL7                                 HL7Name `hl7:",name=RXA,type=s"`
-	GiveSubIDCounter                    NM      `hl7:"1,required,display=Give Sub-id Counter"`
-	AdministrationSubIDCounter          NM      `hl7:"2,required,display=Administration Sub-id Counter"`
+	GiveSubIDCounter                    NM      `hl7:"1,required,datatype=NM,display=Give Sub-id Counter"`
+	AdministrationSubIDCounter          NM      `hl7:"2,required,datatype=NM,display=Administration Sub-id Counter"`
 	DateTimeStartOfAdministration       DTM     `hl7:"3,required,format=YMDHM,display=Date/Time Start Of Administration"`
 	DateTimeEndOfAdministration         DTM     `hl7:"4,required,format=YMDHM,display=Date/Time End Of Administration"`
 	AdministeredCode                    CWE     `hl7:"5,required,table=0292,display=Administered Code"`
-	AdministeredAmount                  NM      `hl7:"6,required,display=Administered Amount"`
+	AdministeredAmount                  NM      `hl7:"6,required,datatype=NM,display=Administered Amount"`
 	AdministeredUnits                   *CWE    `hl7:"7,conditional,table=9999,display=Administered Units"`
 	AdministeredDosageForm              *CWE    `hl7:"8,table=9999,display=Administered Dosage Form"`
 	AdministrationNotes                 []CWE   `hl7:"9,table=9999,display=Administration Notes"`
 	AdministeringProvider               []XCN   `hl7:"10,display=Administering Provider"`
 	AdministeredAtLocation              LA2     `hl7:"11,display=Administered-at Location"`
 	AdministeredPerTimeUnit             ST      `hl7:"12,conditional,display=Administered Per (time Unit)"`
-	AdministeredStrength                NM      `hl7:"13,display=Administered Strength"`
+	AdministeredStrength                NM      `hl7:"13,datatype=NM,display=Administered Strength"`
 	AdministeredStrengthUnits           *CWE    `hl7:"14,table=9999,display=Administered Strength Units"`
 	SubstanceLotNumber                  []ST    `hl7:"15,display=Substance Lot Number"`
 	SubstanceExpirationDate             []DTM   `hl7:"16,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName           []CWE   `hl7:"17,table=0227,display=Substance Manufacturer Name"`
 	SubstanceTreatmentRefusalReason     []CWE   `hl7:"18,table=9999,display=Substance/Treatment Refusal Reason"`
 	Indication                          []CWE   `hl7:"19,table=9999,display=Indication"`
-	CompletionStatus                    ID      `hl7:"20,len=2,table=0322,display=Completion Status"`
-	ActionCode                          ID      `hl7:"21,len=1,table=0206,display=Action Code - Rxa"`
+	CompletionStatus                    ID      `hl7:"20,len=2,table=0322,datatype=ID,display=Completion Status"`
+	ActionCode                          ID      `hl7:"21,len=1,table=0206,datatype=ID,display=Action Code - Rxa"`
 	SystemEntryDateTime                 DTM     `hl7:"22,format=YMDHM,display=System Entry Date/Time"`
-	AdministeredDrugStrengthVolume      NM      `hl7:"23,display=Administered Drug Strength Volume"`
+	AdministeredDrugStrengthVolume      NM      `hl7:"23,datatype=NM,display=Administered Drug Strength Volume"`
 	AdministeredDrugStrengthVolumeUnits *CWE    `hl7:"24,table=9999,display=Administered Drug Strength Volume Units"`
 	AdministeredBarcodeIdentifier       *CWE    `hl7:"25,table=9999,display=Administered Barcode Identifier"`
-	PharmacyOrderType                   ID      `hl7:"26,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                   ID      `hl7:"26,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	AdministerAt                        *PL     `hl7:"27,display=Administer-at"`
 	AdministeredAtAddress               *XAD    `hl7:"28,display=Administered-at Address"`
 }
@@ -3575,52 +3575,52 @@ type RXA struct {
 // at the RXC level is identical to that for the RXO level.
 type RXC struct {
 	HL7                              HL7Name `hl7:",name=RXC,type=s"`
-	RxComponentType                  ID      `hl7:"1,required,len=1,table=0166,display=Rx Component Type"`
+	RxComponentType                  ID      `hl7:"1,required,len=1,table=0166,datatype=ID,display=Rx Component Type"`
 	ComponentCode                    CWE     `hl7:"2,required,table=9999,display=Component Code"`
-	ComponentAmount                  NM      `hl7:"3,required,display=Component Amount"`
+	ComponentAmount                  NM      `hl7:"3,required,datatype=NM,display=Component Amount"`
 	ComponentUnits                   CWE     `hl7:"4,required,table=9999,display=Component Units"`
-	ComponentStrength                NM      `hl7:"5,display=Component Strength"`
+	ComponentStrength                NM      `hl7:"5,datatype=NM,display=Component Strength"`
 	ComponentStrengthUnits           *CWE    `hl7:"6,table=9999,display=Component Strength Units"`
 	SupplementaryCode                []CWE   `hl7:"7,table=9999,display=Supplementary Code"`
-	ComponentDrugStrengthVolume      NM      `hl7:"8,display=Component Drug Strength Volume"`
+	ComponentDrugStrengthVolume      NM      `hl7:"8,datatype=NM,display=Component Drug Strength Volume"`
 	ComponentDrugStrengthVolumeUnits *CWE    `hl7:"9,table=9999,display=Component Drug Strength Volume Units"`
 }
 
 // Pharmacy/treatment Dispense
 type RXD struct {
 	HL7                                                     HL7Name `hl7:",name=RXD,type=s"`
-	DispenseSubIDCounter                                    NM      `hl7:"1,required,display=Dispense Sub-id Counter"`
+	DispenseSubIDCounter                                    NM      `hl7:"1,required,datatype=NM,display=Dispense Sub-id Counter"`
 	DispenseGiveCode                                        CWE     `hl7:"2,required,table=0292,display=Dispense/Give Code"`
 	DateTimeDispensed                                       DTM     `hl7:"3,required,format=YMDHM,display=Date/Time Dispensed"`
-	ActualDispenseAmount                                    NM      `hl7:"4,required,display=Actual Dispense Amount"`
+	ActualDispenseAmount                                    NM      `hl7:"4,required,datatype=NM,display=Actual Dispense Amount"`
 	ActualDispenseUnits                                     *CWE    `hl7:"5,conditional,table=9999,display=Actual Dispense Units"`
 	ActualDosageForm                                        *CWE    `hl7:"6,table=9999,display=Actual Dosage Form"`
 	PrescriptionNumber                                      ST      `hl7:"7,required,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,display=Number Of Refills Remaining"`
+	NumberOfRefillsRemaining                                NM      `hl7:"8,conditional,datatype=NM,display=Number Of Refills Remaining"`
 	DispenseNotes                                           []ST    `hl7:"9,display=Dispense Notes"`
 	DispensingProvider                                      []XCN   `hl7:"10,display=Dispensing Provider"`
-	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                      ID      `hl7:"11,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	TotalDailyDose                                          *CQ     `hl7:"12,display=Total Daily Dose"`
 	DispenseToLocation                                      LA2     `hl7:"13,display=Dispense-to Location"`
-	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"14,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CWE   `hl7:"15,table=9999,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
-	ActualStrength                                          NM      `hl7:"16,display=Actual Strength"`
+	ActualStrength                                          NM      `hl7:"16,datatype=NM,display=Actual Strength"`
 	ActualStrengthUnit                                      *CWE    `hl7:"17,table=9999,display=Actual Strength Unit"`
 	SubstanceLotNumber                                      []ST    `hl7:"18,display=Substance Lot Number"`
 	SubstanceExpirationDate                                 []DTM   `hl7:"19,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName                               []CWE   `hl7:"20,table=0227,display=Substance Manufacturer Name"`
 	Indication                                              []CWE   `hl7:"21,table=9999,display=Indication"`
-	DispensePackageSize                                     NM      `hl7:"22,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"22,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CWE    `hl7:"23,table=9999,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"24,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CWE   `hl7:"25,table=9999,display=Supplementary Code"`
 	InitiatingLocation                                      *CWE    `hl7:"26,table=9999,display=Initiating Location"`
 	PackagingAssemblyLocation                               *CWE    `hl7:"27,table=9999,display=Packaging/Assembly Location"`
-	ActualDrugStrengthVolume                                NM      `hl7:"28,display=Actual Drug Strength Volume"`
+	ActualDrugStrengthVolume                                NM      `hl7:"28,datatype=NM,display=Actual Drug Strength Volume"`
 	ActualDrugStrengthVolumeUnits                           *CWE    `hl7:"29,table=9999,display=Actual Drug Strength Volume Units"`
 	DispenseToPharmacy                                      CWE     `hl7:"30,table=9999,display=Dispense To Pharmacy"`
 	DispenseToPharmacyAddress                               XAD     `hl7:"31,display=Dispense To Pharmacy Address"`
-	PharmacyOrderType                                       ID      `hl7:"32,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                       ID      `hl7:"32,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	DispenseType                                            *CWE    `hl7:"33,table=0484,display=Dispense Type"`
 	PharmacyPhoneNumber                                     []XTN   `hl7:"34,display=Pharmacy Phone Number"`
 }
@@ -3640,80 +3640,80 @@ type RXE struct {
 	HL7                                                     HL7Name `hl7:",name=RXE,type=s"`
 	QuantityTiming                                          ST      `hl7:"1,display=Quantity/Timing"`
 	GiveCode                                                CWE     `hl7:"2,required,table=0292,display=Give Code"`
-	GiveAmountMinimum                                       NM      `hl7:"3,required,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                       NM      `hl7:"4,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                       NM      `hl7:"3,required,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                       NM      `hl7:"4,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                               CWE     `hl7:"5,required,table=9999,display=Give Units"`
 	GiveDosageForm                                          *CWE    `hl7:"6,table=9999,display=Give Dosage Form"`
 	ProvidersAdministrationInstructions                     []CWE   `hl7:"7,table=9999,display=Provider's Administration Instructions"`
 	DeliverToLocation                                       LA1     `hl7:"8,display=Deliver-to Location"`
-	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,display=Substitution Status"`
-	DispenseAmount                                          NM      `hl7:"10,conditional,display=Dispense Amount"`
+	SubstitutionStatus                                      ID      `hl7:"9,len=1,table=0167,datatype=ID,display=Substitution Status"`
+	DispenseAmount                                          NM      `hl7:"10,conditional,datatype=NM,display=Dispense Amount"`
 	DispenseUnits                                           *CWE    `hl7:"11,conditional,table=9999,display=Dispense Units"`
-	NumberOfRefills                                         NM      `hl7:"12,display=Number Of Refills"`
+	NumberOfRefills                                         NM      `hl7:"12,datatype=NM,display=Number Of Refills"`
 	OrderingProvidersDeaNumber                              []XCN   `hl7:"13,display=Ordering Provider's Dea Number"`
 	PharmacistTreatmentSuppliersVerifierID                  []XCN   `hl7:"14,display=Pharmacist/Treatment Supplier's Verifier Id"`
 	PrescriptionNumber                                      ST      `hl7:"15,conditional,display=Prescription Number"`
-	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,display=Number Of Refills Remaining"`
-	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,display=Number Of Refills/Doses Dispensed"`
+	NumberOfRefillsRemaining                                NM      `hl7:"16,conditional,datatype=NM,display=Number Of Refills Remaining"`
+	NumberOfRefillsDosesDispensed                           NM      `hl7:"17,conditional,datatype=NM,display=Number Of Refills/Doses Dispensed"`
 	DTOfMostRecentRefillOrDoseDispensed                     DTM     `hl7:"18,conditional,format=YMDHM,display=D/T Of Most Recent Refill Or Dose Dispensed"`
 	TotalDailyDose                                          *CQ     `hl7:"19,conditional,display=Total Daily Dose"`
-	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                        ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialDispensingInstructions []CWE   `hl7:"21,table=9999,display=Pharmacy/Treatment Supplier's Special Dispensing Instructions"`
 	GivePerTimeUnit                                         ST      `hl7:"22,conditional,display=Give Per (time Unit)"`
 	GiveRateAmount                                          ST      `hl7:"23,display=Give Rate Amount"`
 	GiveRateUnits                                           *CWE    `hl7:"24,table=9999,display=Give Rate Units"`
-	GiveStrength                                            NM      `hl7:"25,display=Give Strength"`
+	GiveStrength                                            NM      `hl7:"25,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                       *CWE    `hl7:"26,table=9999,display=Give Strength Units"`
 	GiveIndication                                          []CWE   `hl7:"27,table=9999,display=Give Indication"`
-	DispensePackageSize                                     NM      `hl7:"28,display=Dispense Package Size"`
+	DispensePackageSize                                     NM      `hl7:"28,datatype=NM,display=Dispense Package Size"`
 	DispensePackageSizeUnit                                 *CWE    `hl7:"29,table=9999,display=Dispense Package Size Unit"`
-	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,display=Dispense Package Method"`
+	DispensePackageMethod                                   ID      `hl7:"30,len=2,table=0321,datatype=ID,display=Dispense Package Method"`
 	SupplementaryCode                                       []CWE   `hl7:"31,table=9999,display=Supplementary Code"`
 	OriginalOrderDateTime                                   DTM     `hl7:"32,format=YMDHM,display=Original Order Date/Time"`
-	GiveDrugStrengthVolume                                  NM      `hl7:"33,display=Give Drug Strength Volume"`
+	GiveDrugStrengthVolume                                  NM      `hl7:"33,datatype=NM,display=Give Drug Strength Volume"`
 	GiveDrugStrengthVolumeUnits                             *CWE    `hl7:"34,table=9999,display=Give Drug Strength Volume Units"`
 	ControlledSubstanceSchedule                             *CWE    `hl7:"35,table=0477,display=Controlled Substance Schedule"`
-	FormularyStatus                                         ID      `hl7:"36,len=1,table=0478,display=Formulary Status"`
+	FormularyStatus                                         ID      `hl7:"36,len=1,table=0478,datatype=ID,display=Formulary Status"`
 	PharmaceuticalSubstanceAlternative                      []CWE   `hl7:"37,table=9999,display=Pharmaceutical Substance Alternative"`
 	PharmacyOfMostRecentFill                                *CWE    `hl7:"38,table=9999,display=Pharmacy Of Most Recent Fill"`
-	InitialDispenseAmount                                   NM      `hl7:"39,display=Initial Dispense Amount"`
+	InitialDispenseAmount                                   NM      `hl7:"39,datatype=NM,display=Initial Dispense Amount"`
 	DispensingPharmacy                                      CWE     `hl7:"40,table=9999,display=Dispensing Pharmacy"`
 	DispensingPharmacyAddress                               XAD     `hl7:"41,display=Dispensing Pharmacy Address"`
 	DeliverToPatientLocation                                *PL     `hl7:"42,display=Deliver-to Patient Location"`
 	DeliverToAddress                                        *XAD    `hl7:"43,display=Deliver-to Address"`
-	PharmacyOrderType                                       ID      `hl7:"44,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                       ID      `hl7:"44,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	PharmacyPhoneNumber                                     []XTN   `hl7:"45,display=Pharmacy Phone Number"`
 }
 
 // Pharmacy/treatment Give
 type RXG struct {
 	HL7                                                         HL7Name `hl7:",name=RXG,type=s"`
-	GiveSubIDCounter                                            NM      `hl7:"1,required,display=Give Sub-id Counter"`
-	DispenseSubIDCounter                                        NM      `hl7:"2,display=Dispense Sub-id Counter"`
+	GiveSubIDCounter                                            NM      `hl7:"1,required,datatype=NM,display=Give Sub-id Counter"`
+	DispenseSubIDCounter                                        NM      `hl7:"2,datatype=NM,display=Dispense Sub-id Counter"`
 	QuantityTiming                                              ST      `hl7:"3,display=Quantity/Timing"`
 	GiveCode                                                    CWE     `hl7:"4,required,table=0292,display=Give Code"`
-	GiveAmountMinimum                                           NM      `hl7:"5,required,display=Give Amount - Minimum"`
-	GiveAmountMaximum                                           NM      `hl7:"6,display=Give Amount - Maximum"`
+	GiveAmountMinimum                                           NM      `hl7:"5,required,datatype=NM,display=Give Amount - Minimum"`
+	GiveAmountMaximum                                           NM      `hl7:"6,datatype=NM,display=Give Amount - Maximum"`
 	GiveUnits                                                   CWE     `hl7:"7,required,table=9999,display=Give Units"`
 	GiveDosageForm                                              *CWE    `hl7:"8,table=9999,display=Give Dosage Form"`
 	AdministrationNotes                                         []CWE   `hl7:"9,table=9999,display=Administration Notes"`
-	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,display=Substitution Status"`
+	SubstitutionStatus                                          ID      `hl7:"10,len=1,table=0167,datatype=ID,display=Substitution Status"`
 	DispenseToLocation                                          LA2     `hl7:"11,display=Dispense-to Location"`
-	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                                            ID      `hl7:"12,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	PharmacyTreatmentSuppliersSpecialAdministrationInstructions []CWE   `hl7:"13,len=250,table=9999,display=Pharmacy/Treatment Supplier's Special Administration Instructions"`
 	GivePerTimeUnit                                             ST      `hl7:"14,conditional,display=Give Per (time Unit)"`
 	GiveRateAmount                                              ST      `hl7:"15,display=Give Rate Amount"`
 	GiveRateUnits                                               *CWE    `hl7:"16,table=9999,display=Give Rate Units"`
-	GiveStrength                                                NM      `hl7:"17,display=Give Strength"`
+	GiveStrength                                                NM      `hl7:"17,datatype=NM,display=Give Strength"`
 	GiveStrengthUnits                                           *CWE    `hl7:"18,table=9999,display=Give Strength Units"`
 	SubstanceLotNumber                                          []ST    `hl7:"19,display=Substance Lot Number"`
 	SubstanceExpirationDate                                     []DTM   `hl7:"20,format=YMDHM,display=Substance Expiration Date"`
 	SubstanceManufacturerName                                   []CWE   `hl7:"21,table=0227,display=Substance Manufacturer Name"`
 	Indication                                                  []CWE   `hl7:"22,table=9999,display=Indication"`
-	GiveDrugStrengthVolume                                      NM      `hl7:"23,display=Give Drug Strength Volume"`
+	GiveDrugStrengthVolume                                      NM      `hl7:"23,datatype=NM,display=Give Drug Strength Volume"`
 	GiveDrugStrengthVolumeUnits                                 *CWE    `hl7:"24,table=9999,display=Give Drug Strength Volume Units"`
 	GiveBarcodeIdentifier                                       *CWE    `hl7:"25,table=9999,display=Give Barcode Identifier"`
-	PharmacyOrderType                                           ID      `hl7:"26,len=1,table=0480,display=Pharmacy Order Type"`
+	PharmacyOrderType                                           ID      `hl7:"26,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
 	DispenseToPharmacy                                          CWE     `hl7:"27,table=9999,display=Dispense To Pharmacy"`
 	DispenseToPharmacyAddress                                   XAD     `hl7:"28,display=Dispense To Pharmacy Address"`
 	DeliverToPatientLocation                                    *PL     `hl7:"29,display=Deliver-to Patient Location"`
@@ -3737,33 +3737,33 @@ type RXG struct {
 type RXO struct {
 	HL7                                    HL7Name `hl7:",name=RXO,type=s"`
 	RequestedGiveCode                      *CWE    `hl7:"1,conditional,table=9999,display=Requested Give Code"`
-	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,display=Requested Give Amount - Minimum"`
-	RequestedGiveAmountMaximum             NM      `hl7:"3,display=Requested Give Amount - Maximum"`
+	RequestedGiveAmountMinimum             NM      `hl7:"2,conditional,datatype=NM,display=Requested Give Amount - Minimum"`
+	RequestedGiveAmountMaximum             NM      `hl7:"3,datatype=NM,display=Requested Give Amount - Maximum"`
 	RequestedGiveUnits                     *CWE    `hl7:"4,conditional,table=9999,display=Requested Give Units"`
 	RequestedDosageForm                    *CWE    `hl7:"5,conditional,table=9999,display=Requested Dosage Form"`
 	ProvidersPharmacyTreatmentInstructions []CWE   `hl7:"6,table=9999,display=Provider's Pharmacy/Treatment Instructions"`
 	ProvidersAdministrationInstructions    []CWE   `hl7:"7,table=9999,display=Provider's Administration Instructions"`
 	DeliverToLocation                      LA1     `hl7:"8,display=Deliver-to Location"`
-	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,display=Allow Substitutions"`
+	AllowSubstitutions                     ID      `hl7:"9,len=1,table=0161,datatype=ID,display=Allow Substitutions"`
 	RequestedDispenseCode                  *CWE    `hl7:"10,table=9999,display=Requested Dispense Code"`
-	RequestedDispenseAmount                NM      `hl7:"11,display=Requested Dispense Amount"`
+	RequestedDispenseAmount                NM      `hl7:"11,datatype=NM,display=Requested Dispense Amount"`
 	RequestedDispenseUnits                 *CWE    `hl7:"12,table=9999,display=Requested Dispense Units"`
-	NumberOfRefills                        NM      `hl7:"13,display=Number Of Refills"`
+	NumberOfRefills                        NM      `hl7:"13,datatype=NM,display=Number Of Refills"`
 	OrderingProvidersDeaNumber             []XCN   `hl7:"14,display=Ordering Provider's Dea Number"`
 	PharmacistTreatmentSuppliersVerifierID []XCN   `hl7:"15,conditional,display=Pharmacist/Treatment Supplier's Verifier Id"`
-	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,display=Needs Human Review"`
+	NeedsHumanReview                       ID      `hl7:"16,len=1,table=0136,datatype=ID,display=Needs Human Review"`
 	RequestedGivePerTimeUnit               ST      `hl7:"17,conditional,display=Requested Give Per (time Unit)"`
-	RequestedGiveStrength                  NM      `hl7:"18,display=Requested Give Strength"`
+	RequestedGiveStrength                  NM      `hl7:"18,datatype=NM,display=Requested Give Strength"`
 	RequestedGiveStrengthUnits             *CWE    `hl7:"19,table=9999,display=Requested Give Strength Units"`
 	Indication                             []CWE   `hl7:"20,table=9999,display=Indication"`
 	RequestedGiveRateAmount                ST      `hl7:"21,display=Requested Give Rate Amount"`
 	RequestedGiveRateUnits                 *CWE    `hl7:"22,table=9999,display=Requested Give Rate Units"`
 	TotalDailyDose                         *CQ     `hl7:"23,display=Total Daily Dose"`
 	SupplementaryCode                      []CWE   `hl7:"24,table=9999,display=Supplementary Code"`
-	RequestedDrugStrengthVolume            NM      `hl7:"25,display=Requested Drug Strength Volume"`
+	RequestedDrugStrengthVolume            NM      `hl7:"25,datatype=NM,display=Requested Drug Strength Volume"`
 	RequestedDrugStrengthVolumeUnits       *CWE    `hl7:"26,table=9999,display=Requested Drug Strength Volume Units"`
-	PharmacyOrderType                      ID      `hl7:"27,len=1,table=0480,display=Pharmacy Order Type"`
-	DispensingInterval                     NM      `hl7:"28,display=Dispensing Interval"`
+	PharmacyOrderType                      ID      `hl7:"27,len=1,table=0480,datatype=ID,display=Pharmacy Order Type"`
+	DispensingInterval                     NM      `hl7:"28,datatype=NM,display=Dispensing Interval"`
 	MedicationInstanceIdentifier           *EI     `hl7:"29,display=Medication Instance Identifier"`
 	SegmentInstanceIdentifier              *EI     `hl7:"30,display=Segment Instance Identifier"`
 	MoodCode                               *CNE    `hl7:"31,conditional,table=0725,display=Mood Code"`
@@ -3815,14 +3815,14 @@ type SAC struct {
 	TrayIdentifier                    *EI     `hl7:"13,display=Tray Identifier"`
 	PositionInTray                    *NA     `hl7:"14,display=Position In Tray"`
 	Location                          []CWE   `hl7:"15,table=9999,display=Location"`
-	ContainerHeight                   NM      `hl7:"16,display=Container Height"`
-	ContainerDiameter                 NM      `hl7:"17,display=Container Diameter"`
-	BarrierDelta                      NM      `hl7:"18,display=Barrier Delta"`
-	BottomDelta                       NM      `hl7:"19,display=Bottom Delta"`
+	ContainerHeight                   NM      `hl7:"16,datatype=NM,display=Container Height"`
+	ContainerDiameter                 NM      `hl7:"17,datatype=NM,display=Container Diameter"`
+	BarrierDelta                      NM      `hl7:"18,datatype=NM,display=Barrier Delta"`
+	BottomDelta                       NM      `hl7:"19,datatype=NM,display=Bottom Delta"`
 	ContainerHeightDiameterDeltaUnits *CWE    `hl7:"20,table=9999,display=Container Height/Diameter/Delta Units"`
-	ContainerVolume                   NM      `hl7:"21,display=Container Volume"`
-	AvailableSpecimenVolume           NM      `hl7:"22,display=Available Specimen Volume"`
-	InitialSpecimenVolume             NM      `hl7:"23,display=Initial Specimen Volume"`
+	ContainerVolume                   NM      `hl7:"21,datatype=NM,display=Container Volume"`
+	AvailableSpecimenVolume           NM      `hl7:"22,datatype=NM,display=Available Specimen Volume"`
+	InitialSpecimenVolume             NM      `hl7:"23,datatype=NM,display=Initial Specimen Volume"`
 	VolumeUnits                       *CWE    `hl7:"24,table=9999,display=Volume Units"`
 	SeparatorType                     *CWE    `hl7:"25,table=0380,display=Separator Type"`
 	CapType                           *CWE    `hl7:"26,table=0381,display=Cap Type"`
@@ -3831,13 +3831,13 @@ type SAC struct {
 	DilutionFactor                    *SN     `hl7:"29,display=Dilution Factor"`
 	Treatment                         *CWE    `hl7:"30,table=0373,display=Treatment"`
 	Temperature                       *SN     `hl7:"31,display=Temperature"`
-	HemolysisIndex                    NM      `hl7:"32,display=Hemolysis Index"`
+	HemolysisIndex                    NM      `hl7:"32,datatype=NM,display=Hemolysis Index"`
 	HemolysisIndexUnits               *CWE    `hl7:"33,table=9999,display=Hemolysis Index Units"`
-	LipemiaIndex                      NM      `hl7:"34,display=Lipemia Index"`
+	LipemiaIndex                      NM      `hl7:"34,datatype=NM,display=Lipemia Index"`
 	LipemiaIndexUnits                 *CWE    `hl7:"35,table=9999,display=Lipemia Index Units"`
-	IcterusIndex                      NM      `hl7:"36,display=Icterus Index"`
+	IcterusIndex                      NM      `hl7:"36,datatype=NM,display=Icterus Index"`
 	IcterusIndexUnits                 *CWE    `hl7:"37,table=9999,display=Icterus Index Units"`
-	FibrinIndex                       NM      `hl7:"38,display=Fibrin Index"`
+	FibrinIndex                       NM      `hl7:"38,datatype=NM,display=Fibrin Index"`
 	FibrinIndexUnits                  *CWE    `hl7:"39,table=9999,display=Fibrin Index Units"`
 	SystemInducedContaminants         []CWE   `hl7:"40,table=0374,display=System Induced Contaminants"`
 	DrugInterference                  []CWE   `hl7:"41,table=0382,display=Drug Interference"`
@@ -3853,13 +3853,13 @@ type SCH struct {
 	HL7                       HL7Name `hl7:",name=SCH,type=s"`
 	PlacerAppointmentID       *EI     `hl7:"1,conditional,display=Placer Appointment Id"`
 	FillerAppointmentID       *EI     `hl7:"2,conditional,display=Filler Appointment Id"`
-	OccurrenceNumber          NM      `hl7:"3,conditional,display=Occurrence Number"`
+	OccurrenceNumber          NM      `hl7:"3,conditional,datatype=NM,display=Occurrence Number"`
 	PlacerGroupNumber         *EI     `hl7:"4,display=Placer Group Number"`
 	ScheduleID                *CWE    `hl7:"5,display=Schedule Id"`
 	EventReason               CWE     `hl7:"6,required,display=Event Reason"`
 	AppointmentReason         *CWE    `hl7:"7,table=0276,display=Appointment Reason"`
 	AppointmentType           *CWE    `hl7:"8,table=0277,display=Appointment Type"`
-	AppointmentDuration       NM      `hl7:"9,display=Appointment Duration"`
+	AppointmentDuration       NM      `hl7:"9,datatype=NM,display=Appointment Duration"`
 	AppointmentDurationUnits  CNE     `hl7:"10,display=Appointment Duration Units"`
 	AppointmentTimingQuantity ST      `hl7:"11,display=Appointment Timing Quantity"`
 	PlacerContactPerson       []XCN   `hl7:"12,display=Placer Contact Person"`
@@ -3886,7 +3886,7 @@ type SCH struct {
 // processing sterilization or decontamination loads.
 type SCP struct {
 	HL7                                         HL7Name `hl7:",name=SCP,type=s"`
-	NumberOfDecontaminationSterilizationDevices NM      `hl7:"1,display=Number Of Decontamination/Sterilization Devices"`
+	NumberOfDecontaminationSterilizationDevices NM      `hl7:"1,datatype=NM,display=Number Of Decontamination/Sterilization Devices"`
 	LaborCalculationType                        *CWE    `hl7:"2,table=0651,display=Labor Calculation Type"`
 	DateFormat                                  *CWE    `hl7:"3,table=0653,display=Date Format"`
 	DeviceNumber                                *EI     `hl7:"4,display=Device Number"`
@@ -3907,7 +3907,7 @@ type SDD struct {
 	DeviceName      ST      `hl7:"3,display=Device Name"`
 	DeviceDataState *CWE    `hl7:"4,table=0667,display=Device Data State"`
 	LoadStatus      *CWE    `hl7:"5,table=0669,display=Load Status"`
-	ControlCode     NM      `hl7:"6,display=Control Code"`
+	ControlCode     NM      `hl7:"6,datatype=NM,display=Control Code"`
 	OperatorName    ST      `hl7:"7,display=Operator Name"`
 }
 
@@ -3962,7 +3962,7 @@ type SHP struct {
 	ShipmentStatusReason       TX      `hl7:"5,display=Shipment Status Reason"`
 	ShipmentPriority           *CWE    `hl7:"6,table=0906,display=Shipment Priority"`
 	ShipmentConfidentiality    []CWE   `hl7:"7,table=0907,display=Shipment Confidentiality"`
-	NumberOfPackagesInShipment NM      `hl7:"8,display=Number Of Packages In Shipment"`
+	NumberOfPackagesInShipment NM      `hl7:"8,datatype=NM,display=Number Of Packages In Shipment"`
 	ShipmentCondition          []CWE   `hl7:"9,table=0544,display=Shipment Condition"`
 	ShipmentHandlingCode       []CWE   `hl7:"10,table=0376,display=Shipment Handling Code"`
 	ShipmentRiskCode           []CWE   `hl7:"11,table=0489,display=Shipment Risk Code"`
@@ -4020,7 +4020,7 @@ type SLT struct {
 // In summary, SPM represents the attributes specific and unique to a specimen.
 type SPM struct {
 	HL7                        HL7Name `hl7:",name=SPM,type=s"`
-	SetID                      SI      `hl7:"1,seq,len=4,display=Set Id - Spm"`
+	SetID                      SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Spm"`
 	SpecimenID                 *EIP    `hl7:"2,display=Specimen Id"`
 	SpecimenParentIds          []EIP   `hl7:"3,display=Specimen Parent Ids"`
 	SpecimenType               CWE     `hl7:"4,required,table=0487,display=Specimen Type"`
@@ -4032,20 +4032,20 @@ type SPM struct {
 	SpecimenCollectionSite     *CWE    `hl7:"10,table=0543,display=Specimen Collection Site"`
 	SpecimenRole               []CWE   `hl7:"11,table=0369,display=Specimen Role"`
 	SpecimenCollectionAmount   *CQ     `hl7:"12,display=Specimen Collection Amount"`
-	GroupedSpecimenCount       NM      `hl7:"13,conditional,display=Grouped Specimen Count"`
+	GroupedSpecimenCount       NM      `hl7:"13,conditional,datatype=NM,display=Grouped Specimen Count"`
 	SpecimenDescription        []ST    `hl7:"14,display=Specimen Description"`
 	SpecimenHandlingCode       []CWE   `hl7:"15,table=0376,display=Specimen Handling Code"`
 	SpecimenRiskCode           []CWE   `hl7:"16,table=0489,display=Specimen Risk Code"`
 	SpecimenCollectionDateTime *DR     `hl7:"17,display=Specimen Collection Date/Time"`
 	SpecimenReceivedDateTime   DTM     `hl7:"18,format=YMDHM,display=Specimen Received Date/Time"`
 	SpecimenExpirationDateTime DTM     `hl7:"19,format=YMDHM,display=Specimen Expiration Date/Time"`
-	SpecimenAvailability       ID      `hl7:"20,len=1,table=0136,display=Specimen Availability"`
+	SpecimenAvailability       ID      `hl7:"20,len=1,table=0136,datatype=ID,display=Specimen Availability"`
 	SpecimenRejectReason       []CWE   `hl7:"21,table=0490,display=Specimen Reject Reason"`
 	SpecimenQuality            *CWE    `hl7:"22,table=0491,display=Specimen Quality"`
 	SpecimenAppropriateness    *CWE    `hl7:"23,table=0492,display=Specimen Appropriateness"`
 	SpecimenCondition          []CWE   `hl7:"24,table=0493,display=Specimen Condition"`
 	SpecimenCurrentQuantity    *CQ     `hl7:"25,display=Specimen Current Quantity"`
-	NumberOfSpecimenContainers NM      `hl7:"26,display=Number Of Specimen Containers"`
+	NumberOfSpecimenContainers NM      `hl7:"26,datatype=NM,display=Number Of Specimen Containers"`
 	ContainerType              *CWE    `hl7:"27,table=9999,display=Container Type"`
 	ContainerCondition         *CWE    `hl7:"28,table=0544,display=Container Condition"`
 	SpecimenChildRole          *CWE    `hl7:"29,table=0494,display=Specimen Child Role"`
@@ -4075,7 +4075,7 @@ type STF struct {
 	StaffType                       []CWE   `hl7:"4,table=0182,display=Staff Type"`
 	AdministrativeSex               *CWE    `hl7:"5,table=0001,display=Administrative Sex"`
 	DateTimeOfBirth                 DTM     `hl7:"6,format=YMDHM,display=Date/Time Of Birth"`
-	ActiveInactiveFlag              ID      `hl7:"7,len=1,table=0183,display=Active/Inactive Flag"`
+	ActiveInactiveFlag              ID      `hl7:"7,len=1,table=0183,datatype=ID,display=Active/Inactive Flag"`
 	Department                      []CWE   `hl7:"8,table=0184,display=Department"`
 	HospitalService                 []CWE   `hl7:"9,table=0069,display=Hospital Service - Stf"`
 	Phone                           []XTN   `hl7:"10,display=Phone"`
@@ -4089,23 +4089,23 @@ type STF struct {
 	JobTitle                        ST      `hl7:"18,display=Job Title"`
 	JobCodeClass                    *JCC    `hl7:"19,display=Job Code/Class"`
 	EmploymentStatusCode            *CWE    `hl7:"20,table=0066,display=Employment Status Code"`
-	AdditionalInsuredOnAuto         ID      `hl7:"21,len=1,table=0136,display=Additional Insured On Auto"`
+	AdditionalInsuredOnAuto         ID      `hl7:"21,len=1,table=0136,datatype=ID,display=Additional Insured On Auto"`
 	DriversLicenseNumberStaff       *DLN    `hl7:"22,display=Driver's License Number - Staff"`
-	CopyAutoIns                     ID      `hl7:"23,len=1,table=0136,display=Copy Auto Ins"`
+	CopyAutoIns                     ID      `hl7:"23,len=1,table=0136,datatype=ID,display=Copy Auto Ins"`
 	AutoInsExpires                  DT      `hl7:"24,format=YMD,display=Auto Ins Expires"`
 	DateLastDmvReview               DT      `hl7:"25,format=YMD,display=Date Last Dmv Review"`
 	DateNextDmvReview               DT      `hl7:"26,format=YMD,display=Date Next Dmv Review"`
 	Race                            *CWE    `hl7:"27,table=0005,display=Race"`
 	EthnicGroup                     *CWE    `hl7:"28,table=0189,display=Ethnic Group"`
-	ReActivationApprovalIndicator   ID      `hl7:"29,len=1,table=0136,display=Re-activation Approval Indicator"`
+	ReActivationApprovalIndicator   ID      `hl7:"29,len=1,table=0136,datatype=ID,display=Re-activation Approval Indicator"`
 	Citizenship                     []CWE   `hl7:"30,table=0171,display=Citizenship"`
 	DateTimeOfDeath                 DTM     `hl7:"31,format=YMDHM,display=Date/Time Of Death"`
-	DeathIndicator                  ID      `hl7:"32,len=1,table=0136,display=Death Indicator"`
+	DeathIndicator                  ID      `hl7:"32,len=1,table=0136,datatype=ID,display=Death Indicator"`
 	InstitutionRelationshipTypeCode *CWE    `hl7:"33,table=0538,display=Institution Relationship Type Code"`
 	InstitutionRelationshipPeriod   *DR     `hl7:"34,display=Institution Relationship Period"`
 	ExpectedReturnDate              DT      `hl7:"35,format=YMD,display=Expected Return Date"`
 	CostCenterCode                  []CWE   `hl7:"36,table=0539,display=Cost Center Code"`
-	GenericClassificationIndicator  ID      `hl7:"37,len=1,table=0136,display=Generic Classification Indicator"`
+	GenericClassificationIndicator  ID      `hl7:"37,len=1,table=0136,datatype=ID,display=Generic Classification Indicator"`
 	InactiveReasonCode              *CWE    `hl7:"38,table=0540,display=Inactive Reason Code"`
 	GenericResourceTypeOrCategory   []CWE   `hl7:"39,table=0771,display=Generic Resource Type Or Category"`
 	Religion                        *CWE    `hl7:"40,table=0006,display=Religion"`
@@ -4136,10 +4136,10 @@ type TCC struct {
 	RerunDilutionFactorDefault            *SN     `hl7:"5,display=Rerun Dilution Factor Default"`
 	PreDilutionFactorDefault              *SN     `hl7:"6,display=Pre-dilution Factor Default"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"7,display=Endogenous Content Of Pre-dilution Diluent"`
-	InventoryLimitsWarningLevel           NM      `hl7:"8,display=Inventory Limits Warning Level"`
-	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,display=Automatic Rerun Allowed"`
-	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,display=Automatic Repeat Allowed"`
-	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,display=Automatic Reflex Allowed"`
+	InventoryLimitsWarningLevel           NM      `hl7:"8,datatype=NM,display=Inventory Limits Warning Level"`
+	AutomaticRerunAllowed                 ID      `hl7:"9,len=1,table=0136,datatype=ID,display=Automatic Rerun Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"10,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	AutomaticReflexAllowed                ID      `hl7:"11,len=1,table=0136,datatype=ID,display=Automatic Reflex Allowed"`
 	EquipmentDynamicRange                 *SN     `hl7:"12,display=Equipment Dynamic Range"`
 	Units                                 *CWE    `hl7:"13,table=9999,display=Units"`
 	ProcessingType                        *CWE    `hl7:"14,table=0388,display=Processing Type"`
@@ -4157,8 +4157,8 @@ type TCD struct {
 	RerunDilutionFactor                   *SN     `hl7:"3,display=Rerun Dilution Factor"`
 	PreDilutionFactor                     *SN     `hl7:"4,display=Pre-dilution Factor"`
 	EndogenousContentOfPreDilutionDiluent *SN     `hl7:"5,display=Endogenous Content Of Pre-dilution Diluent"`
-	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,display=Automatic Repeat Allowed"`
-	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,display=Reflex Allowed"`
+	AutomaticRepeatAllowed                ID      `hl7:"6,len=1,table=0136,datatype=ID,display=Automatic Repeat Allowed"`
+	ReflexAllowed                         ID      `hl7:"7,len=1,table=0136,datatype=ID,display=Reflex Allowed"`
 	AnalyteRepeatStatus                   *CWE    `hl7:"8,table=0389,display=Analyte Repeat Status"`
 }
 
@@ -4186,7 +4186,7 @@ type TCD struct {
 // = P1H^Preop, 1 Hour before Surgery^99LocalCode, Relative time would be empty and priority would be P (preop).
 type TQ1 struct {
 	HL7                  HL7Name `hl7:",name=TQ1,type=s"`
-	SetID                SI      `hl7:"1,seq,len=4,display=Set Id - Tq1"`
+	SetID                SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Tq1"`
 	Quantity             *CQ     `hl7:"2,display=Quantity"`
 	RepeatPattern        []RPT   `hl7:"3,display=Repeat Pattern"`
 	ExplicitTime         []TM    `hl7:"4,format=HM,display=Explicit Time"`
@@ -4197,9 +4197,9 @@ type TQ1 struct {
 	Priority             []CWE   `hl7:"9,table=0485,display=Priority"`
 	ConditionText        TX      `hl7:"10,display=Condition Text"`
 	TextInstruction      TX      `hl7:"11,display=Text Instruction"`
-	Conjunction          ID      `hl7:"12,conditional,len=1,table=0472,display=Conjunction"`
+	Conjunction          ID      `hl7:"12,conditional,len=1,table=0472,datatype=ID,display=Conjunction"`
 	OccurrenceDuration   *CQ     `hl7:"13,display=Occurrence Duration"`
-	TotalOccurrences     NM      `hl7:"14,display=Total Occurrences"`
+	TotalOccurrences     NM      `hl7:"14,datatype=NM,display=Total Occurrences"`
 }
 
 // Timing/quantity Relationship
@@ -4246,16 +4246,16 @@ type TQ1 struct {
 // (which can then be executed according to the specification in the TQ2 segment).
 type TQ2 struct {
 	HL7                               HL7Name `hl7:",name=TQ2,type=s"`
-	SetID                             SI      `hl7:"1,seq,len=4,display=Set Id - Tq2"`
-	SequenceResultsFlag               ID      `hl7:"2,len=1,table=0503,display=Sequence/Results Flag"`
+	SetID                             SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Tq2"`
+	SequenceResultsFlag               ID      `hl7:"2,len=1,table=0503,datatype=ID,display=Sequence/Results Flag"`
 	RelatedPlacerNumber               []EI    `hl7:"3,conditional,display=Related Placer Number"`
 	RelatedFillerNumber               []EI    `hl7:"4,conditional,display=Related Filler Number"`
 	RelatedPlacerGroupNumber          []EI    `hl7:"5,conditional,display=Related Placer Group Number"`
-	SequenceConditionCode             ID      `hl7:"6,conditional,table=0504,display=Sequence Condition Code"`
-	CyclicEntryExitIndicator          ID      `hl7:"7,conditional,len=1,table=0505,display=Cyclic Entry/Exit Indicator"`
+	SequenceConditionCode             ID      `hl7:"6,conditional,table=0504,datatype=ID,display=Sequence Condition Code"`
+	CyclicEntryExitIndicator          ID      `hl7:"7,conditional,len=1,table=0505,datatype=ID,display=Cyclic Entry/Exit Indicator"`
 	SequenceConditionTimeInterval     *CQ     `hl7:"8,display=Sequence Condition Time Interval"`
-	CyclicGroupMaximumNumberOfRepeats NM      `hl7:"9,display=Cyclic Group Maximum Number Of Repeats"`
-	SpecialServiceRequestRelationship ID      `hl7:"10,conditional,len=1,table=0506,display=Special Service Request Relationship"`
+	CyclicGroupMaximumNumberOfRepeats NM      `hl7:"9,datatype=NM,display=Cyclic Group Maximum Number Of Repeats"`
+	SpecialServiceRequestRelationship ID      `hl7:"10,conditional,len=1,table=0506,datatype=ID,display=Special Service Request Relationship"`
 }
 
 // Transcription Document Header
@@ -4267,9 +4267,9 @@ type TQ2 struct {
 // document text.
 type TXA struct {
 	HL7                                      HL7Name `hl7:",name=TXA,type=s"`
-	SetID                                    SI      `hl7:"1,seq,required,len=4,display=Set Id- Txa"`
+	SetID                                    SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id- Txa"`
 	DocumentType                             CWE     `hl7:"2,required,table=0270,display=Document Type"`
-	DocumentContentPresentation              ID      `hl7:"3,conditional,table=0191,display=Document Content Presentation"`
+	DocumentContentPresentation              ID      `hl7:"3,conditional,table=0191,datatype=ID,display=Document Content Presentation"`
 	ActivityDateTime                         DTM     `hl7:"4,format=YMDHM,display=Activity Date/Time"`
 	PrimaryActivityProviderCodeName          []XCN   `hl7:"5,conditional,display=Primary Activity Provider Code/Name"`
 	OriginationDateTime                      DTM     `hl7:"6,format=YMDHM,display=Origination Date/Time"`
@@ -4283,10 +4283,10 @@ type TXA struct {
 	PlacerOrderNumber                        []EI    `hl7:"14,display=Placer Order Number"`
 	FillerOrderNumber                        *EI     `hl7:"15,display=Filler Order Number"`
 	UniqueDocumentFileName                   ST      `hl7:"16,display=Unique Document File Name"`
-	DocumentCompletionStatus                 ID      `hl7:"17,required,len=2,table=0271,display=Document Completion Status"`
-	DocumentConfidentialityStatus            ID      `hl7:"18,len=1,table=0272,display=Document Confidentiality Status"`
-	DocumentAvailabilityStatus               ID      `hl7:"19,len=2,table=0273,display=Document Availability Status"`
-	DocumentStorageStatus                    ID      `hl7:"20,len=2,table=0275,display=Document Storage Status"`
+	DocumentCompletionStatus                 ID      `hl7:"17,required,len=2,table=0271,datatype=ID,display=Document Completion Status"`
+	DocumentConfidentialityStatus            ID      `hl7:"18,len=1,table=0272,datatype=ID,display=Document Confidentiality Status"`
+	DocumentAvailabilityStatus               ID      `hl7:"19,len=2,table=0273,datatype=ID,display=Document Availability Status"`
+	DocumentStorageStatus                    ID      `hl7:"20,len=2,table=0275,datatype=ID,display=Document Storage Status"`
 	DocumentChangeReason                     ST      `hl7:"21,display=Document Change Reason"`
 	AuthenticationPersonTimeStampSet         []PPN   `hl7:"22,conditional,display=Authentication Person- Time Stamp (set)"`
 	DistributedCopiesCodeAndNameOfRecipientS []XCN   `hl7:"23,display=Distributed Copies (code And Name Of Recipient(s) )"`
@@ -4343,7 +4343,7 @@ type UAC struct {
 // Attention: UB1-2 was deprecated as of v2.3 and the detail was withdrawn and removed from the standard as of v 2.6.
 type UB1 struct {
 	HL7                     HL7Name `hl7:",name=UB1,type=s"`
-	SetID                   SI      `hl7:"1,seq,display=Set Id - Ub1"`
+	SetID                   SI      `hl7:"1,seq,datatype=SI,display=Set Id - Ub1"`
 	BloodDeductible         ST      `hl7:"2,display=Blood Deductible"`
 	BloodFurnishedPints     ST      `hl7:"3,display=Blood Furnished-pints"`
 	BloodReplacedPints      ST      `hl7:"4,display=Blood Replaced-pints"`
@@ -4377,7 +4377,7 @@ type UB1 struct {
 // or current list; refer to a UB specification for additional information.
 type UB2 struct {
 	HL7                       HL7Name `hl7:",name=UB2,type=s"`
-	SetID                     SI      `hl7:"1,seq,len=4,display=Set Id - Ub2"`
+	SetID                     SI      `hl7:"1,seq,len=4,datatype=SI,display=Set Id - Ub2"`
 	CoInsuranceDays9          ST      `hl7:"2,len=3,display=Co-insurance Days (9)"`
 	ConditionCode2430         []CWE   `hl7:"3,max=7,table=0043,display=Condition Code (24-30)"`
 	CoveredDays7              ST      `hl7:"4,len=3,display=Covered Days (7)"`
@@ -4393,7 +4393,7 @@ type UB2 struct {
 	UB92Locator56State        []ST    `hl7:"14,max=5,len=14,display=UB92 Locator 56 (state)"`
 	UB92Locator57National     ST      `hl7:"15,len=27,display=UB92 Locator 57 (national)"`
 	UB92Locator78State        []ST    `hl7:"16,max=2,len=2,display=UB92 Locator 78 (state)"`
-	SpecialVisitCount         NM      `hl7:"17,len=3,display=Special Visit Count"`
+	SpecialVisitCount         NM      `hl7:"17,len=3,datatype=NM,display=Special Visit Count"`
 }
 
 // Withdrawn
@@ -4427,7 +4427,7 @@ type VAR struct {
 // This segment contains purchasing vendors that supply the inventory supply item specified in the ITM segment.
 type VND struct {
 	HL7                    HL7Name `hl7:",name=VND,type=s"`
-	SetID                  SI      `hl7:"1,seq,required,len=4,display=Set Id - Vnd"`
+	SetID                  SI      `hl7:"1,seq,required,len=4,datatype=SI,display=Set Id - Vnd"`
 	VendorIdentifier       EI      `hl7:"2,required,display=Vendor Identifier"`
 	VendorName             ST      `hl7:"3,display=Vendor Name"`
 	VendorCatalogNumber    *EI     `hl7:"4,display=Vendor Catalog Number"`
@@ -520,9 +520,9 @@ func run(ctx context.Context) error {
 			buf.WriteString(pos)
 
 			switch f.ID {
-			case "FieldSeparator":
+			case "FieldSeparator", "BatchFieldSeparator", "FileFieldSeparator":
 				buf.WriteString(",noescape,fieldsep,omit")
-			case "EncodingCharacters":
+			case "EncodingCharacters", "BatchEncodingCharacters", "FileEncodingCharacters":
 				buf.WriteString(",noescape,fieldchars")
 			case "SetID":
 				buf.WriteString(",seq")
@@ -554,6 +554,9 @@ func run(ctx context.Context) error {
 				buf.WriteString(",format=YMDHM")
 			case "TS":
 				buf.WriteString(",format=YMDHMS")
+			case "ID", "IS", "NM", "SI":
+				buf.WriteString(",datatype=")
+				buf.WriteString(f.DataType)
 			}
 			desc := f.Description
 			if len(desc) == 0 {
@@ -602,6 +605,31 @@ func run(ctx context.Context) error {
 
 			return buf.String()
 		},
+		"enumType": func(id string) string {
+			return "Table" + strings.Map(idMap, id)
+		},
+		"enums": func(list []TableRow) []enumValue {
+			ret := make([]enumValue, 0, len(list))
+			seen := map[string]bool{}
+			for _, row := range list {
+				name := strings.Map(func(r rune) rune {
+					switch {
+					case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+						return r
+					case r == ' ', r == '-', r == '.', r == '_', r == '/':
+						return '_'
+					}
+					return -1
+				}, strings.TrimSpace(row.Value))
+				if len(name) == 0 || len(name) != len(strings.TrimSpace(row.Value)) || seen[name] {
+					continue
+				}
+				seen[name] = true
+				desc := strings.Join(strings.Fields(strings.Map(rawMap, row.Description)), " ")
+				ret = append(ret, enumValue{Name: name, Value: row.Value, Description: desc})
+			}
+			return ret
+		},
 		"unique": func(list []TableRow) []TableRow {
 			ret := make([]TableRow, 0, len(list))
 			seen := map[string]bool{}
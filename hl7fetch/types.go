@@ -67,6 +67,13 @@ type TableRow struct {
 	Comment     string `json:"comment"`
 }
 
+// enumValue is a table value constant of the enum type of a table.
+type enumValue struct {
+	Name        string
+	Value       string
+	Description string
+}
+
 // https://hl7-definition.caristix.com/v2-api/1/HL7v2.5/TriggerEvents/ORU_R30
 type TriggerType struct {
 	ID          string           `json:"id"`
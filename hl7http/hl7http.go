@@ -0,0 +1,211 @@
+// Package hl7http sends and receives HL7 v2 messages over HTTP, for integrations that cannot use MLLP over TCP,
+// such as those hosted behind an HTTPS load balancer. Each message is the body of a POST request with
+// the content type x-application/hl7-v2+er7, and the response, such as an ACK, is the body of the response.
+package hl7http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/kardianos/hl7"
+)
+
+// ContentType is the content type of a message.
+const ContentType = "x-application/hl7-v2+er7"
+
+// Client posts messages to a URL.
+type Client struct {
+	// URL of the receiver, such as "https://host/hl7".
+	URL string
+
+	// HTTPClient sends the requests. If nil, http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Header is added to each request, such as an Authorization header. May be nil.
+	Header http.Header
+
+	// Trace is called as each message is posted and its response is read. If nil, sending is not traced.
+	Trace *hl7.Trace
+
+	// Metrics receives a MetricSend as each message is posted, and a MetricAck with the round trip
+	// from posting the message to reading its response. May be nil.
+	Metrics hl7.Metrics
+}
+
+// Send posts the message and returns the response body, such as an ACK.
+// A 204 No Content response has no body. A response status other than 200 OK or 204 No Content is an *HTTPError.
+func (c *Client) Send(msg []byte) ([]byte, error) {
+	return c.SendContext(context.Background(), msg)
+}
+
+// SendContext posts the message and returns the response body as Send does, with the context of the request.
+func (c *Client) SendContext(ctx context.Context, msg []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(msg))
+	if err != nil {
+		return nil, fmt.Errorf("hl7http: %w", err)
+	}
+	for key, values := range c.Header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", ContentType)
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	hl7.ObserveSent(c.Trace, c.Metrics, msg, start, err)
+	if err != nil {
+		return nil, fmt.Errorf("hl7http: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := response(resp)
+	hl7.ObserveAck(c.Trace, c.Metrics, msg, start, err)
+	return body, err
+}
+
+// response returns the body of a response with a 200 OK or 204 No Content status.
+func response(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("hl7http: read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: body}
+	}
+	return body, nil
+}
+
+// HTTPError is a response status other than 200 OK or 204 No Content.
+type HTTPError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	const max = 200
+	body := bytes.TrimSpace(e.Body)
+	if len(body) > max {
+		body = body[:max]
+	}
+	if len(body) == 0 {
+		return fmt.Sprintf("hl7http: %s", http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("hl7http: %s: %s", http.StatusText(e.StatusCode), body)
+}
+
+// HandlerOption sets how a Handler reads requests and writes responses.
+type HandlerOption struct {
+	// Encode is the options used to encode each response of the handler. May be nil.
+	// Each response is encoded with a new Encoder, as requests are handled concurrently.
+	Encode *hl7.EncodeOption
+
+	// MaxSize is the maximum message length in bytes. If zero, 10 MiB.
+	MaxSize int64
+
+	// Trace is called as each response is written. If nil, responses are not traced.
+	Trace *hl7.Trace
+
+	// Metrics receives a MetricSend as each response is written, and a MetricAck with the time from reading
+	// the message to writing its response. May be nil.
+	Metrics hl7.Metrics
+}
+
+// NewHandler returns an http.Handler that decodes each posted message with the decoder and passes it to h,
+// with the context of the request if h is an hl7.ContextHandler.
+// The response of h is written as the response body; it is encoded with the Encode options unless it is a []byte.
+// If h returns no response, the status is 204 No Content.
+// A message that cannot be decoded is a 400 Bad Request, and an error from h is a 500 Internal Server Error.
+// Option may be nil.
+func NewHandler(d *hl7.Decoder, h hl7.Handler, opt *HandlerOption) http.Handler {
+	s := &handler{d: d, h: h}
+	if opt != nil {
+		s.opt = *opt
+	}
+	if s.opt.MaxSize <= 0 {
+		s.opt.MaxSize = 10 << 20
+	}
+	return s
+}
+
+type handler struct {
+	d   *hl7.Decoder
+	h   hl7.Handler
+	opt HandlerOption
+}
+
+func (s *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !isContentType(r.Header.Get("Content-Type")) {
+		http.Error(w, "content type must be "+ContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+	// Read one byte past the MaxSize, so a body over it is told from other read errors.
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.opt.MaxSize+1))
+	received := time.Now()
+	if int64(len(data)) > s.opt.MaxSize {
+		http.Error(w, "message too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	message, err := s.d.Decode(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := hl7.ServeContext(r.Context(), s.h, message)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	body, err := encode(s.opt.Encode, resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if body == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	start := time.Now()
+	_, err = w.Write(body)
+	hl7.ObserveSent(s.opt.Trace, s.opt.Metrics, body, start, err)
+	hl7.ObserveAck(s.opt.Trace, s.opt.Metrics, data, received, err)
+}
+
+// isContentType reports if the content type is of a message, allowing the application/hl7-v2 type of some senders.
+func isContentType(v string) bool {
+	mt, _, err := mime.ParseMediaType(v)
+	if err != nil {
+		return false
+	}
+	return mt == ContentType || mt == "application/hl7-v2"
+}
+
+// encode returns the response of a handler, or nil if there is none.
+func encode(opt *hl7.EncodeOption, resp any) ([]byte, error) {
+	switch resp := resp.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return resp, nil
+	}
+	body, err := hl7.NewEncoder(opt).Encode(resp)
+	if err != nil {
+		return nil, fmt.Errorf("encode response: %w", err)
+	}
+	return body, nil
+}
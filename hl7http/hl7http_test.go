@@ -0,0 +1,176 @@
+package hl7http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kardianos/hl7"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestHandler(t *testing.T) {
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		adt, ok := message.(v251.ADT_A01)
+		if !ok {
+			return nil, errors.New("not an ADT_A01")
+		}
+		switch adt.MSH.MessageControlID {
+		case "none":
+			return nil, nil
+		case "fail":
+			return nil, errors.New("failed")
+		}
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R1|P|2.5.1\rMSA|AA|" + adt.MSH.MessageControlID), nil
+	})
+	srv := httptest.NewServer(NewHandler(hl7.NewDecoder(v251.Registry, nil), h, nil))
+	defer srv.Close()
+
+	c := &Client{URL: srv.URL, Header: http.Header{"X-Test": {"1"}}}
+	msg := func(id string) []byte {
+		return []byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|" + id + "|P|2.5.1\rPID|1||123||Doe\rPV1|1|I")
+	}
+	ack, err := c.Send(msg("1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(ack), "MSA|AA|1") {
+		t.Errorf("got %q", ack)
+	}
+	if ack, err := c.Send(msg("none")); err != nil || len(ack) != 0 {
+		t.Errorf("got %q, %v", ack, err)
+	}
+	for body, status := range map[string]int{
+		string(msg("fail")): http.StatusInternalServerError,
+		"MSH|bad":           http.StatusBadRequest,
+	} {
+		_, err := c.Send([]byte(body))
+		var he *HTTPError
+		if !errors.As(err, &he) || he.StatusCode != status {
+			t.Errorf("%q: got %v", body, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.SendContext(ctx, msg("1")); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v", err)
+	}
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("got GET status %d", resp.StatusCode)
+	}
+	resp, err = http.Post(srv.URL, "text/plain", strings.NewReader(string(msg("1"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("got text/plain status %d", resp.StatusCode)
+	}
+}
+
+func TestHandlerParallel(t *testing.T) {
+	// The handler returns a struct, so each response is encoded.
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		return message, nil
+	})
+	srv := httptest.NewServer(NewHandler(hl7.NewDecoder(v251.Registry, nil), h, &HandlerOption{Encode: &hl7.EncodeOption{TrimTrailingSeparator: true}}))
+	defer srv.Close()
+	c := &Client{URL: srv.URL}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				resp, err := c.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|" + id + "|P|2.5.1\rPID|1||123||Doe\rPV1|1|I"))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if !strings.Contains(string(resp), "|ADT^A01^ADT_A01|"+id+"|") {
+					t.Errorf("got %q", resp)
+					return
+				}
+			}
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+}
+
+func TestMetrics(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		got []string
+	)
+	metrics := hl7.MetricsFunc(func(m hl7.Metric) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, m.Step.String()+":"+m.MessageType+"^"+m.TriggerEvent)
+	})
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R1|P|2.5.1\rMSA|AA|1"), nil
+	})
+	var sent []string
+	trace := &hl7.Trace{Sent: func(info hl7.TraceInfo, err error) {
+		mu.Lock()
+		sent = append(sent, info.ControlID)
+		mu.Unlock()
+	}}
+	srv := httptest.NewServer(NewHandler(hl7.NewDecoder(v251.Registry, nil), h, &HandlerOption{Metrics: metrics, Trace: trace}))
+	defer srv.Close()
+
+	c := &Client{URL: srv.URL, Metrics: metrics, Trace: trace}
+	if _, err := c.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|1|P|2.5.1\rPID|1||123||Doe\rPV1|1|I")); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	// The handler writes the response before the client reads it.
+	if g := strings.Join(got, " "); g != "send:ACK^A01 ack:ADT^A01 send:ADT^A01 ack:ADT^A01" {
+		t.Errorf("got %s", g)
+	}
+	if g := strings.Join(sent, " "); g != "R1 1" {
+		t.Errorf("got sent %s", g)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestHandlerSize(t *testing.T) {
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R1|P|2.5.1\rMSA|AA|1"), nil
+	})
+	msg := "MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|1|P|2.5.1\rPID|1||123||Doe\rPV1|1|I"
+	s := NewHandler(hl7.NewDecoder(v251.Registry, nil), h, &HandlerOption{MaxSize: int64(len(msg))})
+	for _, tc := range []struct {
+		body   io.Reader
+		status int
+	}{
+		{strings.NewReader(msg), http.StatusOK},
+		{strings.NewReader(msg + "\r"), http.StatusRequestEntityTooLarge},
+		{strings.NewReader(msg + "\rNTE|1"), http.StatusRequestEntityTooLarge},
+		{errReader{}, http.StatusBadRequest},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/", tc.body)
+		r.Header.Set("Content-Type", ContentType)
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, r)
+		if w.Code != tc.status {
+			t.Errorf("got status %d, want %d: %s", w.Code, tc.status, w.Body)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package hl7
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// LengthPolicy selects how primitive values longer than the len tag option are handled.
+type LengthPolicy byte
+
+const (
+	LengthIgnore   LengthPolicy = iota // Do not check value lengths.
+	LengthWarn                         // Report long values to the Warn function and keep them.
+	LengthError                        // Return an error for long values.
+	LengthTruncate                     // Truncate long values when encoding. Same as LengthWarn when decoding.
+)
+
+// errLength returns the error for a value that exceeds the maximum length.
+func errLength(v string, t tag) error {
+	return fmt.Errorf("value %q length %d exceeds maximum length %d", v, utf8.RuneCountInString(v), t.Len)
+}
+
+func overLength(v string, t tag) bool {
+	return t.Len > 0 && len(v) > t.Len && utf8.RuneCountInString(v) > t.Len
+}
+
+// checkLength applies the decode length policy to the value.
+func (d *lineDecoder) checkLength(v string, t tag) error {
+	if d.opt.Length == LengthIgnore || !overLength(v, t) {
+		return nil
+	}
+	err := errLength(v, t)
+	if d.opt.Length == LengthError {
+		return err
+	}
+	d.warn(err)
+	return nil
+}
+
+// warn reports a problem that is not treated as an error to the Warn option.
+func (d *lineDecoder) warn(err error) {
+	if d.opt.Warn == nil {
+		return
+	}
+	d.opt.Warn(fmt.Errorf("line %d, %s: %w", d.line, d.path, err))
+}
+
+// truncate applies the encode length policy and returns the value to write.
+// If truncated is true, the truncation character (if any) should follow the value.
+func (e *Encoder) truncate(v string, t tag) (_ string, truncated bool, _ error) {
+	if e.opt.Length == LengthIgnore || !overLength(v, t) {
+		return v, false, nil
+	}
+	err := errLength(v, t)
+	switch e.opt.Length {
+	default:
+		if e.opt.Warn != nil {
+			e.opt.Warn(fmt.Errorf("%s: %w", e.path, err))
+		}
+		return v, false, nil
+	case LengthError:
+		return v, false, err
+	case LengthTruncate:
+		n := t.Len
+		if e.truncateChar != 0 {
+			// Leave room for the truncation character.
+			n--
+		}
+		i := 0
+		for pos := range v {
+			if i == n {
+				return v[:pos], true, nil
+			}
+			i++
+		}
+		return v, true, nil
+	}
+}
@@ -0,0 +1,82 @@
+package hl7
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestDecodeLength(t *testing.T) {
+	long := strings.Repeat("X", 25)
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|" + long + "|P|2.5.1|")
+
+	d := NewDecoder(v251.Registry, nil)
+	if _, err := d.DecodeList(raw); err != nil {
+		t.Fatal("default policy should ignore length", err)
+	}
+
+	d = NewDecoder(v251.Registry, &DecodeOption{Length: LengthError})
+	_, err := d.DecodeList(raw)
+	if err == nil {
+		t.Fatal("expected length error")
+	}
+	if !strings.Contains(err.Error(), "MSH.MessageControlID") {
+		t.Fatalf("error missing field: %v", err)
+	}
+
+	var warnings []error
+	d = NewDecoder(v251.Registry, &DecodeOption{
+		Length: LengthWarn,
+		Warn: func(err error) {
+			warnings = append(warnings, err)
+		},
+	})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning, got %v", warnings)
+	}
+	if got := list[0].(*v251.MSH).MessageControlID; got != long {
+		t.Fatalf("value should be kept on warning, got %q", got)
+	}
+}
+
+func TestEncodeLength(t *testing.T) {
+	long := strings.Repeat("X", 25)
+	tests := []struct {
+		name    string
+		chars   string
+		policy  LengthPolicy
+		want    string
+		wantErr bool
+	}{
+		{"ignore", `^~\&`, LengthIgnore, "|" + long + "|", false},
+		{"error", `^~\&`, LengthError, "", true},
+		{"truncate", `^~\&`, LengthTruncate, "|" + long[:20] + "|", false},
+		{"truncate char", `^~\&#`, LengthTruncate, "|" + long[:19] + "#|", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msh := v251.MSH{
+				FieldSeparator:     "|",
+				EncodingCharacters: tt.chars,
+				MessageControlID:   long,
+			}
+			e := NewEncoder(&EncodeOption{Length: tt.policy})
+			bb, err := e.Encode(msh)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Encode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Contains(bb, []byte(tt.want)) {
+				t.Fatalf("got %q, want to contain %q", bb, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Person location types of a location (PL-6), from HL7 table 0305.
+const (
+	LocationClinic         = "C" // Clinic.
+	LocationDepartment     = "D" // Department.
+	LocationHome           = "H" // Home.
+	LocationNursingUnit    = "N" // Nursing unit.
+	LocationProviderOffice = "O" // Provider's office.
+	LocationPhone          = "P" // Phone.
+	LocationSNF            = "S" // Skilled nursing facility.
+)
+
+// Location is the person location data type (PL) of any version, such as PV1-3 assigned patient location.
+// From v2.7 the point of care, room, bed, building, and floor are HD values; the namespace ID is used.
+type Location struct {
+	PointOfCare string // PL-1, such as a nursing unit, department, or clinic.
+	Room        string // PL-2.
+	Bed         string // PL-3.
+	Facility    string // PL-4 namespace ID.
+	Status      string // PL-5 location status, such as the bed status.
+	Type        string // PL-6 person location type, such as LocationNursingUnit.
+	Building    string // PL-7.
+	Floor       string // PL-8.
+	Description string // PL-9 free text description, from v2.3.
+}
+
+// ParseLocation returns the location of a PL value of any version, such as PV1-3 or PV1-6.
+// A location sent as a string is the point of care. A nil pointer, such as an unset PV1-6, is the zero location.
+func ParseLocation(pl any) (Location, error) {
+	rv := reflect.ValueOf(pl)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return Location{}, nil
+	}
+	rv = reflect.Indirect(rv)
+	switch rv.Kind() {
+	default:
+		return Location{}, fmt.Errorf("%T is not a location", pl)
+	case reflect.String:
+		return Location{PointOfCare: rv.String()}, nil
+	case reflect.Struct:
+	}
+	return Location{
+		PointOfCare: stringComponent(rv, 1),
+		Room:        stringComponent(rv, 2),
+		Bed:         stringComponent(rv, 3),
+		Facility:    stringComponent(rv, 4),
+		Status:      stringComponent(rv, 5),
+		Type:        stringComponent(rv, 6),
+		Building:    stringComponent(rv, 7),
+		Floor:       stringComponent(rv, 8),
+		Description: stringComponent(rv, 9),
+	}, nil
+}
+
+// IsZero reports if no part of the location is set.
+func (l Location) IsZero() bool {
+	return l == Location{}
+}
+
+// String returns the point of care, room, and bed separated by dashes, such as "4E-401-B",
+// leaving out empty parts. If none is set, the description is returned.
+func (l Location) String() string {
+	var parts []string
+	for _, s := range []string{l.PointOfCare, l.Room, l.Bed} {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) == 0 {
+		return l.Description
+	}
+	return strings.Join(parts, "-")
+}
+
+// Set sets the components of a PL value of any version, which must be a pointer.
+// The facility is set in the namespace ID of the HD component.
+// Components the version does not define must be empty.
+func (l Location) Set(pl any) error {
+	rv := reflect.ValueOf(pl)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to a location", pl)
+	}
+	return setComponents(rv.Elem(), []string{l.PointOfCare, l.Room, l.Bed, l.Facility, l.Status, l.Type, l.Building, l.Floor, l.Description})
+}
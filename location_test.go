@@ -0,0 +1,53 @@
+package hl7
+
+import (
+	"testing"
+
+	v23 "github.com/kardianos/hl7/h230"
+	v251 "github.com/kardianos/hl7/h251"
+	v28 "github.com/kardianos/hl7/h280"
+)
+
+func TestLocation(t *testing.T) {
+	pv1 := &v251.PV1{AssignedPatientLocation: &v251.PL{
+		PointOfCare: "4E", Room: "401", Bed: "B", Facility: &v251.HD{NamespaceID: "HOSP"}, PersonLocationType: LocationNursingUnit,
+	}}
+	l, err := ParseLocation(pv1.AssignedPatientLocation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != (Location{PointOfCare: "4E", Room: "401", Bed: "B", Facility: "HOSP", Type: LocationNursingUnit}) {
+		t.Errorf("got %+v", l)
+	}
+	if got, want := l.String(), "4E-401-B"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if prior, err := ParseLocation(pv1.PriorPatientLocation); err != nil || !prior.IsZero() {
+		t.Errorf("got %+v, %v", prior, err)
+	}
+	if got := (Location{Description: "Home"}).String(); got != "Home" {
+		t.Errorf("got %q", got)
+	}
+
+	// From v2.7 the components are HD values.
+	var pl v28.PL
+	err = l.Set(&pl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pl.Room == nil || pl.Room.NamespaceID != "401" || pl.Facility.NamespaceID != "HOSP" || pl.Building != nil {
+		t.Errorf("got %+v", pl)
+	}
+	if got, err := ParseLocation(&pl); err != nil || got != l {
+		t.Errorf("got %+v, %v", got, err)
+	}
+
+	var old v23.PL
+	err = Location{PointOfCare: "ER", Description: "Emergency"}.Set(&old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.PointOfCare != "ER" || old.LocationType != "Emergency" {
+		t.Errorf("got %+v", old)
+	}
+}
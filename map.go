@@ -0,0 +1,135 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToMap returns the values of a trigger, segment list, or segment as maps keyed by name, for formatting
+// with text/template or html/template, such as {{with index .PID.PatientName 0}}{{.FamilyName}}{{end}}.
+//
+// A trigger or group has a key for each of its segments and groups by field name, such as PID, ROL2, or Insurance,
+// and a segment or data type has a key for each field or component by field name, such as PatientName.
+// A segment list, such as from DecodeList, has a key for each segment name, with a []any of its segments.
+//
+// A value is a map, a []any for a repeated field, segment, or group, or the Go value of a primitive,
+// so templates may call its methods, such as {{.PID.DateTimeOfBirth.Format "January 2, 2006"}}.
+// Values that are not sent, including explicit nulls, have no key.
+func ToMap(message any) (map[string]any, error) {
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	switch {
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Interface:
+		m := map[string]any{}
+		for i := 0; i < rv.Len(); i++ {
+			sv := rv.Index(i)
+			for sv.Kind() == reflect.Pointer || sv.Kind() == reflect.Interface {
+				sv = sv.Elem()
+			}
+			meta, err := (&Encoder{}).meta(sv.Type())
+			if err != nil {
+				return nil, err
+			}
+			if meta.Type != structSegment {
+				return nil, fmt.Errorf("segment list item %d: %v is not a segment", i, sv.Type())
+			}
+			seg, err := toMapStruct(sv)
+			if err != nil {
+				return nil, err
+			}
+			list, _ := m[meta.Name].([]any)
+			m[meta.Name] = append(list, seg)
+		}
+		return m, nil
+	case rv.Kind() == reflect.Struct:
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil {
+			return nil, err
+		}
+		if !meta.Meta {
+			return nil, fmt.Errorf("%v has no %s meta field", rv.Type(), hl7MetaName)
+		}
+		return toMapStruct(rv)
+	}
+	return nil, fmt.Errorf("unable to convert %T, expected a trigger, segment list, or segment", message)
+}
+
+// toMapStruct returns the map of a trigger, group, segment, or data type.
+func toMapStruct(rv reflect.Value) (map[string]any, error) {
+	rt := rv.Type()
+	m := map[string]any{}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", rt, err)
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		v, ok, err := toMapValue(rv.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("%v.%s: %w", rt, ft.Name, err)
+		}
+		if ok {
+			m[ft.Name] = v
+		}
+	}
+	return m, nil
+}
+
+// toMapValue returns the template value of a field, and false if it is not sent.
+func toMapValue(rv reflect.Value) (any, bool, error) {
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil, false, nil
+		}
+		return toMapValue(rv.Elem())
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, false, nil
+		}
+		// A pointer to a number is sent even if it is 0.
+		if isNumber(rv.Elem().Kind()) {
+			return rv.Elem().Interface(), true, nil
+		}
+		return toMapValue(rv.Elem())
+	case reflect.Slice:
+		if rv.Type() == byteSliceType {
+			return string(rv.Bytes()), rv.Len() > 0, nil
+		}
+		var list []any
+		for i := 0; i < rv.Len(); i++ {
+			v, ok, err := toMapValue(rv.Index(i))
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				list = append(list, v)
+			}
+		}
+		return list, len(list) > 0, nil
+	case reflect.Struct:
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil {
+			return nil, false, err
+		}
+		if meta.Meta {
+			m, err := toMapStruct(rv)
+			return m, len(m) > 0, err
+		}
+	}
+	if n, ok := rv.Interface().(nullable); ok && n.IsNull() {
+		return nil, false, nil
+	}
+	if rv.Kind() == reflect.String && rv.String() == nullValue {
+		return nil, false, nil
+	}
+	if rv.IsZero() {
+		return nil, false, nil
+	}
+	return rv.Interface(), true, nil
+}
@@ -0,0 +1,62 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestToMap(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|||123^^^MRN^MR||Doe^Jane||19800101|F|||""`,
+		`PV1||I|WARD^201^A`,
+		`IN1|1|PPO|BCBS`,
+		`IN1|2|HMO|AETNA`,
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ToMap(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := template.Must(template.New("letter").Option("missingkey=error").Parse(
+		`{{with index .PID.PatientName 0}}{{.GivenName}} {{.FamilyName}}{{end}}, ` +
+			`born {{.PID.DateTimeOfBirth.Format "January 2, 2006"}}, ` +
+			`{{range .Insurance}}{{with index .IN1.InsuranceCompanyID 0}}[{{.IDNumber}}]{{end}}{{end}}`))
+	buf := &strings.Builder{}
+	if err := tmpl.Execute(buf, m); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Jane Doe, born January 1, 1980, [BCBS][AETNA]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	pid := m["PID"].(map[string]any)
+	if _, ok := pid["PatientAddress"]; ok {
+		t.Errorf("explicit null has a key: %v", pid["PatientAddress"])
+	}
+	if _, ok := pid["MothersMaidenName"]; ok {
+		t.Errorf("empty field has a key")
+	}
+
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lm, err := ToMap(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in1 := lm["IN1"].([]any); len(in1) != 2 {
+		t.Errorf("got IN1 %v", in1)
+	}
+	if _, err := ToMap("PID"); err == nil {
+		t.Errorf("expected an error for a string")
+	}
+}
@@ -0,0 +1,75 @@
+package hl7
+
+import "time"
+
+// MetricStep is the step of handling a message a Metric measures.
+type MetricStep byte
+
+const (
+	MetricDecode   MetricStep = iota + 1 // A message decoded by Decode.
+	MetricValidate                       // A message checked by Validate.
+	MetricEncode                         // A message encoded by Encode.
+	MetricAck                            // The response to a message returned by a Router handler, or read or written by a transport.
+	MetricSend                           // A message or response written by a transport.
+)
+
+var metricStepName = [...]string{
+	MetricDecode:   "decode",
+	MetricValidate: "validate",
+	MetricEncode:   "encode",
+	MetricAck:      "ack",
+	MetricSend:     "send",
+}
+
+// String returns the name of the step, such as decode.
+func (s MetricStep) String() string {
+	if int(s) < len(metricStepName) {
+		return metricStepName[s]
+	}
+	return ""
+}
+
+// Metric is a measurement of a step of handling a message. The values are suitable as metric labels:
+// they do not include the message control ID.
+type Metric struct {
+	Step         MetricStep
+	MessageType  string        // MSH-9.1, such as ADT.
+	TriggerEvent string        // MSH-9.2, such as A01.
+	Duration     time.Duration // Duration of the step. For MetricAck, the latency of the response, such as the round trip of a sent message.
+	Segment      string        // Segment type being decoded when a MetricDecode error occurred, such as PID, if known.
+	Err          error         // Error of the step, if any.
+}
+
+// Metrics receives a Metric for each step of handling a message, such as to update Prometheus
+// counters and histograms. Observe should return quickly, as it is called in line.
+type Metrics interface {
+	Observe(m Metric)
+}
+
+// MetricsFunc is a function that implements Metrics.
+type MetricsFunc func(m Metric)
+
+func (f MetricsFunc) Observe(m Metric) {
+	f(m)
+}
+
+// done reports the end of a step since the start to the trace callback and metrics, if set.
+func (info TraceInfo) done(f func(info TraceInfo, err error), m Metrics, step MetricStep, segment string, err error) {
+	if f == nil && m == nil {
+		return
+	}
+	info.Duration = time.Since(info.Start)
+	if f != nil {
+		f(info, err)
+	}
+	if m != nil {
+		m.Observe(Metric{
+			Step:         step,
+			MessageType:  info.MessageType,
+			TriggerEvent: info.TriggerEvent,
+			Duration:     info.Duration,
+			Segment:      segment,
+			Err:          err,
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestMetrics(t *testing.T) {
+	var got []string
+	m := MetricsFunc(func(m Metric) {
+		if m.Duration < 0 {
+			t.Errorf("%v: negative duration", m.Step)
+		}
+		got = append(got, fmt.Sprintf("%v %s^%s %q %v", m.Step, m.MessageType, m.TriggerEvent, m.Segment, m.Err != nil))
+	})
+	d := NewDecoder(v251.Registry, &DecodeOption{Metrics: m})
+	raw := strings.Join([]string{
+		`MSH|^~\&|A|B|||20240102||ADT^A01^ADT_A01|MSG1|P|2.5.1`,
+		`EVN||20240102`,
+		`PID|||123`,
+		`PV1||I`,
+	}, "\r")
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.Decode([]byte(strings.Replace(raw, "PID|||123", "PID|||123||||bad-date", 1))); err == nil {
+		t.Fatal("expected a decode error")
+	}
+	_ = Validate(msg, &ValidateOption{Metrics: m})
+	if _, err := NewEncoder(&EncodeOption{Metrics: m}).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRouter()
+	r.Metrics = m
+	r.Default = HandlerFunc(func(message any) (any, error) {
+		return "ACK", nil
+	})
+	if _, err := r.ServeHL7(msg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Unmarshal([]byte("MSH|^~\\&|A\rQQQ|1"), v251.Registry, &DecodeOption{Metrics: m}); err == nil {
+		t.Fatal("expected an unknown segment error")
+	}
+
+	want := strings.Join([]string{
+		`decode ADT^A01 "" false`,
+		`decode ADT^A01 "PID" true`,
+		`validate ADT^A01 "" true`,
+		`encode ADT^A01 "" false`,
+		`ack ADT^A01 "" false`,
+		`decode ^ "QQQ" true`,
+	}, "\n")
+	if s := strings.Join(got, "\n"); s != want {
+		t.Errorf("got:\n%s\nwant:\n%s", s, want)
+	}
+	if MetricStep(0).String() != "" || MetricSend.String() != "send" {
+		t.Errorf("unexpected step names")
+	}
+}
@@ -0,0 +1,45 @@
+package hl7
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Middleware wraps a Handler with behavior common to many handlers, such as logging, authorization,
+// rate limiting, or panic recovery, calling the next handler to continue.
+// Return a ContextHandlerFunc that calls the next handler with ServeContext, so the context of each message,
+// such as the deadline and cancellation of an mllp.Server, reaches the next handler;
+// a HandlerFunc calling next.ServeHL7 gives the next handler context.Background instead.
+type Middleware func(next Handler) Handler
+
+// Chain returns the handler wrapped by the middleware. The first middleware is the outermost,
+// so Chain(h, a, b) handles a message with a, then b, then h.
+func Chain(h Handler, middleware ...Middleware) Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return h
+}
+
+// PanicError is returned by a handler wrapped with Recover that panics.
+type PanicError struct {
+	Value any    // Value passed to panic.
+	Stack []byte // Stack of the goroutine that panicked.
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("handler panic: %v", err.Value)
+}
+
+// Recover is a Middleware that returns a PanicError if the next handler panics, rather than stopping the program.
+func Recover(next Handler) Handler {
+	return ContextHandlerFunc(func(ctx context.Context, message any) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				resp, err = nil, &PanicError{Value: p, Stack: debug.Stack()}
+			}
+		}()
+		return ServeContext(ctx, next, message)
+	})
+}
@@ -0,0 +1,59 @@
+package hl7
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+				calls = append(calls, name)
+				return ServeContext(ctx, next, message)
+			})
+		}
+	}
+	deny := func(next Handler) Handler {
+		return ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+			if message == "denied" {
+				return nil, errors.New("not authorized")
+			}
+			return ServeContext(ctx, next, message)
+		})
+	}
+	h := Chain(HandlerFunc(func(message any) (any, error) {
+		calls = append(calls, "handler")
+		if message == "panic" {
+			panic("bad message")
+		}
+		return "ok", nil
+	}), Recover, trace("a"), deny, trace("b"))
+
+	resp, err := h.ServeHL7("msg")
+	if err != nil || resp != "ok" {
+		t.Fatalf("got %v, %v", resp, err)
+	}
+	if got := strings.Join(calls, ","); got != "a,b,handler" {
+		t.Errorf("got calls %s", got)
+	}
+
+	calls = nil
+	if _, err := h.ServeHL7("denied"); err == nil || len(calls) != 1 {
+		t.Errorf("got %v, calls %v", err, calls)
+	}
+
+	_, err = h.ServeHL7("panic")
+	var pe *PanicError
+	if !errors.As(err, &pe) || pe.Value != "bad message" || len(pe.Stack) == 0 {
+		t.Errorf("got %v", err)
+	}
+
+	r := NewRouter()
+	if Chain(r) != Handler(r) {
+		t.Errorf("chain without middleware changed the handler")
+	}
+}
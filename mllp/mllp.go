@@ -0,0 +1,214 @@
+// Package mllp sends and receives HL7 v2 messages over the Minimal Lower Layer Protocol,
+// which frames each message with a start block (0x0B) and an end block (0x1C 0x0D) on a stream connection.
+package mllp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// Frame bytes.
+const (
+	StartBlock = 0x0b
+	EndBlock   = 0x1c
+	EndData    = 0x0d
+)
+
+// ErrTooLarge is returned by Reader.ReadMessage for a message longer than the maximum size.
+var ErrTooLarge = errors.New("mllp: message too large")
+
+// Reader reads framed messages from a stream.
+type Reader struct {
+	// MaxSize is the maximum message length in bytes. Zero is no limit.
+	MaxSize int
+
+	r *bufio.Reader
+}
+
+// NewReader returns a Reader of the stream.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// ReadMessage returns the next message, without its frame. Bytes before a start block are skipped.
+// The stream ending before the end of a message returns io.ErrUnexpectedEOF.
+func (r *Reader) ReadMessage() ([]byte, error) {
+	for {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == StartBlock {
+			break
+		}
+	}
+	var msg []byte
+	for {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if b == EndBlock {
+			next, err := r.r.ReadByte()
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+			if next == EndData {
+				return msg, nil
+			}
+			// A lone end block is not the end of the frame.
+			msg = append(msg, b)
+			b = next
+		}
+		if r.MaxSize > 0 && len(msg) >= r.MaxSize {
+			return nil, ErrTooLarge
+		}
+		msg = append(msg, b)
+	}
+}
+
+// WriteMessage writes the message to the stream in a frame, in a single write.
+func WriteMessage(w io.Writer, msg []byte) error {
+	buf := make([]byte, 0, len(msg)+3)
+	buf = append(buf, StartBlock)
+	buf = append(buf, msg...)
+	buf = append(buf, EndBlock, EndData)
+	_, err := w.Write(buf)
+	return err
+}
+
+// Client sends messages on a connection and reads the responses.
+// A Client may be used from more than one goroutine; each exchange holds the connection until it is done.
+type Client struct {
+	// Timeout is how long an exchange may take, from writing the message to reading the response.
+	// Zero is no limit.
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *Reader
+}
+
+// Dial connects to the address on the network, such as "tcp" and "host:2575", or "unix" and a socket path.
+func Dial(network, address string) (*Client, error) {
+	return DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to the address on the network as Dial does, until the context is done.
+func DialContext(ctx context.Context, network, address string) (*Client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("mllp: %w", err)
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient returns a Client of a connection.
+func NewClient(conn net.Conn) *Client {
+	return &Client{conn: conn, r: NewReader(conn)}
+}
+
+// Send writes the message and returns the response, such as an ACK.
+func (c *Client) Send(msg []byte) ([]byte, error) {
+	return c.SendContext(context.Background(), msg)
+}
+
+// SendContext writes the message and returns the response as Send does, until the context is done.
+func (c *Client) SendContext(ctx context.Context, msg []byte) ([]byte, error) {
+	var resp []byte
+	err := c.ExchangeContext(ctx, msg, func(read func() ([]byte, error)) error {
+		var err error
+		resp, err = read()
+		return err
+	})
+	return resp, err
+}
+
+// Exchange writes the message, then calls f to read responses, such as to skip responses to earlier messages,
+// within the Timeout.
+func (c *Client) Exchange(msg []byte, f func(read func() ([]byte, error)) error) error {
+	return c.ExchangeContext(context.Background(), msg, f)
+}
+
+// ExchangeContext writes the message and calls f as Exchange does, until the context is done or its deadline,
+// if it is before the Timeout. An exchange stopped by the context returns the error of the context;
+// the connection should then be closed, as a response may still be sent on it.
+func (c *Client) ExchangeContext(ctx context.Context, msg []byte, f func(read func() ([]byte, error)) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("mllp: %w", err)
+	}
+	var deadline time.Time
+	if c.Timeout > 0 {
+		deadline = time.Now().Add(c.Timeout)
+	}
+	ctxDeadline := false
+	if d, ok := ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+		deadline, ctxDeadline = d, true
+	}
+	if !deadline.IsZero() {
+		if err := c.conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("mllp: %w", err)
+		}
+		defer c.conn.SetDeadline(time.Time{})
+	}
+	if ctx.Done() != nil {
+		// Stop the exchange when the context is done by moving the deadline to now.
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-ctx.Done():
+				c.conn.SetDeadline(time.Now())
+			case <-stop:
+			}
+		}()
+		defer wg.Wait()
+		defer close(stop)
+	}
+	err := c.exchange(msg, f)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("mllp: %w", ctx.Err())
+		}
+		// The connection deadline may pass just before the context's.
+		if ctxDeadline && !time.Now().Before(deadline) {
+			return fmt.Errorf("mllp: %w", context.DeadlineExceeded)
+		}
+	}
+	return err
+}
+
+func (c *Client) exchange(msg []byte, f func(read func() ([]byte, error)) error) error {
+	if err := WriteMessage(c.conn, msg); err != nil {
+		return fmt.Errorf("mllp: write: %w", err)
+	}
+	return f(func() ([]byte, error) {
+		resp, err := c.r.ReadMessage()
+		if err != nil {
+			return nil, fmt.Errorf("mllp: read: %w", err)
+		}
+		return resp, nil
+	})
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
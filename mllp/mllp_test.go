@@ -0,0 +1,101 @@
+package mllp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestReader(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("noise")
+	for _, msg := range []string{"MSH|1\rPID|1", "A\x1cB", ""} {
+		if err := WriteMessage(&buf, []byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf.WriteString("\x0bpartial")
+	r := NewReader(&buf)
+	for _, want := range []string{"MSH|1\rPID|1", "A\x1cB", ""} {
+		got, err := r.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+	if _, err := r.ReadMessage(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("got %v", err)
+	}
+	if _, err := r.ReadMessage(); err != io.EOF {
+		t.Errorf("got %v", err)
+	}
+
+	buf.Reset()
+	WriteMessage(&buf, []byte("0123456789"))
+	r = NewReader(&buf)
+	r.MaxSize = 5
+	if _, err := r.ReadMessage(); err != ErrTooLarge {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestClient(t *testing.T) {
+	a, b := net.Pipe()
+	go func() {
+		r := NewReader(b)
+		for {
+			msg, err := r.ReadMessage()
+			if err != nil {
+				b.Close()
+				return
+			}
+			WriteMessage(b, append([]byte("ACK:"), msg...))
+		}
+	}()
+	c := NewClient(a)
+	defer c.Close()
+	for _, msg := range []string{"one", "two"} {
+		resp, err := c.Send([]byte(msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(resp) != "ACK:"+msg {
+			t.Errorf("got %q", resp)
+		}
+	}
+}
+
+func TestClientContext(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+	go func() {
+		// Read messages without responding.
+		r := NewReader(b)
+		for {
+			if _, err := r.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	c := NewClient(a)
+	defer c.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := c.SendContext(ctx, []byte("one")); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.SendContext(ctx, []byte("two")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v", err)
+	}
+	if _, err := c.SendContext(ctx, []byte("three")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v", err)
+	}
+}
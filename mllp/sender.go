@@ -0,0 +1,417 @@
+package mllp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/kardianos/hl7"
+)
+
+// ErrClosed is returned by Sender.Send after the Sender is closed.
+var ErrClosed = errors.New("mllp: sender closed")
+
+// AckError is a negative ACK of a message.
+type AckError struct {
+	Code string // Acknowledgment code (MSA-1), such as AE or CR.
+	Text string // Text message (MSA-3), if sent.
+}
+
+func (e *AckError) Error() string {
+	if len(e.Text) == 0 {
+		return fmt.Sprintf("mllp: negative ACK %s", e.Code)
+	}
+	return fmt.Sprintf("mllp: negative ACK %s: %s", e.Code, e.Text)
+}
+
+// Temporary reports if the message may be accepted when sent again, for a commit error (CE) or
+// commit reject (CR) of enhanced mode, when the receiver could not store the message.
+func (e *AckError) Temporary() bool {
+	return e.Code == "CE" || e.Code == "CR"
+}
+
+// SenderOption sets how a Sender connects and retries.
+type SenderOption struct {
+	// Network and Address are the receiver to connect to, such as "tcp" and "host:2575", or "unix" and a socket path.
+	// If Network is empty, "tcp".
+	Network string
+	Address string
+
+	// Dial connects to the receiver, such as with TLS or through a proxy. If nil, Network and Address are dialed.
+	Dial func() (net.Conn, error)
+
+	// Timeout is how long to wait for the ACK of a message. If zero, 30 seconds.
+	Timeout time.Duration
+
+	// MaxAttempts is how many times a message is sent before it fails. If zero, 5.
+	MaxAttempts int
+
+	// Backoff is the wait before sending a message again, doubled after each attempt up to MaxBackoff.
+	// If zero, 1 second and 1 minute.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Queue is how many messages Send queues before it blocks. If zero, 100.
+	Queue int
+
+	// KeepAlive is the period of TCP keep-alive probes on the connection, when Dial is nil.
+	// If zero, the default of the net package; if negative, probes are not sent.
+	KeepAlive time.Duration
+
+	// IdleTimeout closes the connection after no message has been sent for this long, so the next message
+	// is sent on a new connection rather than one a firewall may have dropped. If zero, the connection is kept open.
+	IdleTimeout time.Duration
+
+	// Heartbeat sends HeartbeatMessage after the connection has been idle for this long, and closes the connection
+	// if no response is read within the Timeout, so a dropped connection is found before a message is sent on it.
+	// The receiver must answer the heartbeat, as Server does. If zero, no heartbeat is sent.
+	Heartbeat time.Duration
+
+	// HeartbeatMessage is sent as the heartbeat; any response to it is accepted. If nil, an empty message.
+	HeartbeatMessage []byte
+
+	// Delivered is called with each message and its positive ACK, if not nil.
+	Delivered func(msg, ack []byte)
+
+	// Failed is called with each message that could not be delivered and the last error, if not nil.
+	// The error is an *AckError if the receiver rejected the message.
+	Failed func(msg []byte, err error)
+
+	// Trace is called as each attempt of a message is written and its ACK is read. If nil, sending is not traced.
+	Trace *hl7.Trace
+
+	// Metrics receives a MetricSend as each attempt of a message is written, and a MetricAck with the round trip
+	// from writing the message to reading its ACK. May be nil.
+	Metrics hl7.Metrics
+}
+
+// Sender queues messages and sends them in order on one connection, waiting for the ACK of each before
+// sending the next. The ACK of a message is the response with the control ID (MSH-10) of the message
+// in MSA-2; other responses, such as late ACKs of earlier attempts, are skipped.
+// A message is sent again after a timeout, a connection error, or a commit error or reject, with
+// exponential backoff, and fails after MaxAttempts or an application error or reject (AE, AR).
+// The connection is made when the first message is sent, and made again after an error.
+type Sender struct {
+	opt    SenderOption
+	queue  chan []byte
+	done   chan struct{}
+	ctx    context.Context // Canceled to abandon the queued messages.
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	closed  bool
+	closing chan struct{}  // Closed by Shutdown to stop waiting sends.
+	sends   sync.WaitGroup // Sends waiting to queue a message.
+
+	client   *Client
+	lastSend time.Time // Time the last message was sent on the client.
+	lastUse  time.Time // Time the last message or heartbeat was sent on the client.
+}
+
+// NewSender returns a Sender and starts sending queued messages.
+func NewSender(opt *SenderOption) *Sender {
+	s := &Sender{}
+	if opt != nil {
+		s.opt = *opt
+	}
+	if len(s.opt.Network) == 0 {
+		s.opt.Network = "tcp"
+	}
+	if s.opt.Timeout <= 0 {
+		s.opt.Timeout = 30 * time.Second
+	}
+	if s.opt.MaxAttempts <= 0 {
+		s.opt.MaxAttempts = 5
+	}
+	if s.opt.Backoff <= 0 {
+		s.opt.Backoff = time.Second
+	}
+	if s.opt.MaxBackoff <= 0 {
+		s.opt.MaxBackoff = time.Minute
+	}
+	if s.opt.Queue <= 0 {
+		s.opt.Queue = 100
+	}
+	s.queue = make(chan []byte, s.opt.Queue)
+	s.done = make(chan struct{})
+	s.closing = make(chan struct{})
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run()
+	return s
+}
+
+// Send queues the message to be sent. It blocks while the queue is full.
+// The result of sending is reported to Delivered or Failed.
+func (s *Sender) Send(msg []byte) error {
+	return s.SendContext(context.Background(), msg)
+}
+
+// SendContext queues the message as Send does, returning the error of the context if it is done
+// while the queue is full, or ErrClosed if the Sender is shut down. The context does not apply to
+// sending the message once it is queued.
+func (s *Sender) SendContext(ctx context.Context, msg []byte) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrClosed
+	}
+	s.sends.Add(1)
+	s.mu.Unlock()
+	defer s.sends.Done()
+	select {
+	case s.queue <- msg:
+		return nil
+	case <-s.closing:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops queuing messages and waits for the queued messages to be sent, then closes the connection.
+func (s *Sender) Close() error {
+	return s.Shutdown(context.Background())
+}
+
+// Shutdown stops queuing messages and waits for the queued messages to be sent, as Close does, until the context
+// is done. Then the message being sent and those still queued are abandoned and reported to Failed with
+// context.Canceled, and the error of the context is returned.
+func (s *Sender) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.closing)
+		s.mu.Unlock()
+		// Waiting sends return as closing is closed, so the queue may then be closed.
+		s.sends.Wait()
+		close(s.queue)
+	} else {
+		s.mu.Unlock()
+	}
+	select {
+	case <-s.done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-s.done
+		return ctx.Err()
+	}
+}
+
+func (s *Sender) run() {
+	defer close(s.done)
+	defer s.closeClient()
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	for {
+		var idle <-chan time.Time
+		if at, ok := s.idleAt(); ok {
+			timer.Reset(time.Until(at))
+			idle = timer.C
+		}
+		select {
+		case msg, ok := <-s.queue:
+			if !timer.Stop() && idle != nil {
+				<-timer.C
+			}
+			if !ok {
+				return
+			}
+			ack, err := s.deliver(msg)
+			if err != nil {
+				if s.opt.Failed != nil {
+					s.opt.Failed(msg, err)
+				}
+				continue
+			}
+			if s.opt.Delivered != nil {
+				s.opt.Delivered(msg, ack)
+			}
+		case now := <-idle:
+			s.idle(now)
+		}
+	}
+}
+
+// idleAt returns when the connection is next closed for the IdleTimeout or sent a heartbeat, and false if never.
+func (s *Sender) idleAt() (time.Time, bool) {
+	if s.client == nil {
+		return time.Time{}, false
+	}
+	var at time.Time
+	if s.opt.IdleTimeout > 0 {
+		at = s.lastSend.Add(s.opt.IdleTimeout)
+	}
+	if s.opt.Heartbeat > 0 {
+		if hb := s.lastUse.Add(s.opt.Heartbeat); at.IsZero() || hb.Before(at) {
+			at = hb
+		}
+	}
+	return at, !at.IsZero()
+}
+
+// idle closes the connection if it is past the IdleTimeout, or checks it with a heartbeat.
+func (s *Sender) idle(now time.Time) {
+	if s.opt.IdleTimeout > 0 && !now.Before(s.lastSend.Add(s.opt.IdleTimeout)) {
+		s.closeClient()
+		return
+	}
+	if s.opt.Heartbeat <= 0 || now.Before(s.lastUse.Add(s.opt.Heartbeat)) {
+		return
+	}
+	s.lastUse = now
+	err := s.client.ExchangeContext(s.ctx, s.opt.HeartbeatMessage, func(read func() ([]byte, error)) error {
+		_, err := read()
+		return err
+	})
+	if err != nil {
+		s.closeClient()
+	}
+}
+
+func (s *Sender) closeClient() {
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+}
+
+// deliver sends the message until it is acknowledged or fails.
+func (s *Sender) deliver(msg []byte) ([]byte, error) {
+	id, err := controlID(msg)
+	if err != nil {
+		return nil, err
+	}
+	wait := s.opt.Backoff
+	for attempt := 1; ; attempt++ {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+		ack, err := s.attempt(msg, id)
+		if err == nil {
+			return ack, nil
+		}
+		var ae *AckError
+		if errors.As(err, &ae) && !ae.Temporary() {
+			return nil, err
+		}
+		if s.ctx.Err() != nil {
+			return nil, s.ctx.Err()
+		}
+		if attempt >= s.opt.MaxAttempts {
+			return nil, fmt.Errorf("after %d attempts: %w", attempt, err)
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-s.ctx.Done():
+			t.Stop()
+			return nil, s.ctx.Err()
+		}
+		wait *= 2
+		if wait > s.opt.MaxBackoff {
+			wait = s.opt.MaxBackoff
+		}
+	}
+}
+
+// attempt sends the message once and waits for its ACK. The connection is closed after any error but a negative ACK.
+func (s *Sender) attempt(msg []byte, id string) ([]byte, error) {
+	if s.client == nil {
+		conn, err := s.dial()
+		if err != nil {
+			return nil, fmt.Errorf("mllp: %w", err)
+		}
+		s.client = NewClient(conn)
+		s.client.Timeout = s.opt.Timeout
+	}
+	s.lastSend = time.Now()
+	s.lastUse = s.lastSend
+	start := s.lastSend
+	written := false
+	var ack []byte
+	var ackErr error
+	err := s.client.ExchangeContext(s.ctx, msg, func(read func() ([]byte, error)) error {
+		// The message is written before its responses are read.
+		written = true
+		hl7.ObserveSent(s.opt.Trace, s.opt.Metrics, msg, start, nil)
+		for {
+			resp, err := read()
+			if err != nil {
+				return err
+			}
+			if len(resp) == 0 {
+				// The response to a heartbeat.
+				continue
+			}
+			code, ackID, text, err := parseAck(resp)
+			if err != nil {
+				return err
+			}
+			if ackID != id {
+				continue
+			}
+			switch code {
+			case "AA", "CA":
+				ack = resp
+			default:
+				ackErr = &AckError{Code: code, Text: text}
+			}
+			return nil
+		}
+	})
+	if !written {
+		hl7.ObserveSent(s.opt.Trace, s.opt.Metrics, msg, start, err)
+	}
+	if err != nil {
+		if written {
+			hl7.ObserveAck(s.opt.Trace, s.opt.Metrics, msg, start, err)
+		}
+		s.closeClient()
+		return nil, err
+	}
+	hl7.ObserveAck(s.opt.Trace, s.opt.Metrics, msg, start, ackErr)
+	return ack, ackErr
+}
+
+func (s *Sender) dial() (net.Conn, error) {
+	if s.opt.Dial != nil {
+		return s.opt.Dial()
+	}
+	d := net.Dialer{Timeout: s.opt.Timeout, KeepAlive: s.opt.KeepAlive}
+	return d.DialContext(s.ctx, s.opt.Network, s.opt.Address)
+}
+
+// controlID returns the control ID (MSH-10) of a message.
+func controlID(msg []byte) (string, error) {
+	segs, err := hl7.UnmarshalGeneric(msg, nil)
+	if err != nil {
+		return "", fmt.Errorf("mllp: %w", err)
+	}
+	if len(segs) == 0 || segs[0].Name != "MSH" {
+		return "", fmt.Errorf("mllp: missing MSH segment")
+	}
+	id := segs[0].Value(10, 1, 1, 1)
+	if len(id) == 0 {
+		return "", fmt.Errorf("mllp: missing MSH-10 control ID")
+	}
+	return id, nil
+}
+
+// parseAck returns the acknowledgment code (MSA-1), control ID (MSA-2), and text message (MSA-3) of an ACK.
+func parseAck(ack []byte) (code, id, text string, err error) {
+	segs, err := hl7.UnmarshalGeneric(ack, nil)
+	if err != nil {
+		return "", "", "", fmt.Errorf("mllp: ACK: %w", err)
+	}
+	for _, seg := range segs {
+		if seg.Name == "MSA" {
+			return seg.Value(1, 1, 1, 1), seg.Value(2, 1, 1, 1), seg.Value(3, 1, 1, 1), nil
+		}
+	}
+	return "", "", "", fmt.Errorf("mllp: ACK: missing MSA segment")
+}
@@ -0,0 +1,252 @@
+package mllp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSender(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts = map[string]int{}
+		got      []string
+		dials    int
+	)
+	ack := func(code, id string) []byte {
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R" + id + "|P|2.5.1\rMSA|" + code + "|" + id + "|text")
+	}
+	serve := func(conn net.Conn) {
+		defer conn.Close()
+		r := NewReader(conn)
+		for {
+			msg, err := r.ReadMessage()
+			if err != nil {
+				return
+			}
+			id, err := controlID(msg)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			attempts[id]++
+			n := attempts[id]
+			mu.Unlock()
+			switch id {
+			case "1": // A commit error, then accepted.
+				if n == 1 {
+					WriteMessage(conn, ack("CE", id))
+				} else {
+					WriteMessage(conn, ack("CA", id))
+				}
+			case "2": // Rejected.
+				WriteMessage(conn, ack("AR", id))
+			case "3": // A late ACK of another message first.
+				WriteMessage(conn, ack("AA", "0"))
+				WriteMessage(conn, ack("AA", id))
+			case "4": // Never acknowledged.
+			}
+		}
+	}
+	s := NewSender(&SenderOption{
+		Dial: func() (net.Conn, error) {
+			mu.Lock()
+			dials++
+			mu.Unlock()
+			a, b := net.Pipe()
+			go serve(b)
+			return a, nil
+		},
+		Timeout:     50 * time.Millisecond,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		Queue:       1,
+		Delivered: func(msg, ack []byte) {
+			id, _ := controlID(msg)
+			code, ackID, _, _ := parseAck(ack)
+			got = append(got, id+":delivered:"+code+":"+ackID)
+		},
+		Failed: func(msg []byte, err error) {
+			id, _ := controlID(msg)
+			var ae *AckError
+			if errors.As(err, &ae) {
+				got = append(got, id+":failed:"+ae.Code)
+				return
+			}
+			got = append(got, id+":failed")
+		},
+	})
+	for _, id := range []string{"1", "2", "3", "4", ""} {
+		if err := s.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|" + id + "|P|2.5.1")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.Close()
+	if err := s.Send(nil); err != ErrClosed {
+		t.Errorf("got %v", err)
+	}
+
+	want := "1:delivered:CA:1 2:failed:AR 3:delivered:AA:3 4:failed :failed"
+	if g := strings.Join(got, " "); g != want {
+		t.Errorf("got:\n%s\nwant:\n%s", g, want)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	var counts []string
+	for id, n := range attempts {
+		counts = append(counts, id+"="+string(rune('0'+n)))
+	}
+	sort.Strings(counts)
+	if g := strings.Join(counts, " "); g != "1=2 2=1 3=1 4=3" {
+		t.Errorf("got attempts %s", g)
+	}
+	// The connection is dialed again after each timeout of message 4.
+	if dials != 3 {
+		t.Errorf("got %d dials", dials)
+	}
+}
+
+func TestSenderIdle(t *testing.T) {
+	run := func(opt SenderOption, wait func(heartbeats func() int)) (dials, heartbeats int) {
+		var mu sync.Mutex
+		opt.Dial = func() (net.Conn, error) {
+			mu.Lock()
+			dials++
+			mu.Unlock()
+			a, b := net.Pipe()
+			go func() {
+				defer b.Close()
+				r := NewReader(b)
+				for {
+					msg, err := r.ReadMessage()
+					if err != nil {
+						return
+					}
+					if len(msg) == 0 {
+						mu.Lock()
+						heartbeats++
+						mu.Unlock()
+						WriteMessage(b, nil)
+						continue
+					}
+					id, _ := controlID(msg)
+					WriteMessage(b, []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R"+id+"|P|2.5.1\rMSA|AA|"+id))
+				}
+			}()
+			return a, nil
+		}
+		var failed []error
+		opt.Failed = func(msg []byte, err error) { failed = append(failed, err) }
+		s := NewSender(&opt)
+		s.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|1|P|2.5.1"))
+		wait(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return heartbeats
+		})
+		s.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|2|P|2.5.1"))
+		s.Close()
+		if len(failed) != 0 {
+			t.Errorf("got %v", failed)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return dials, heartbeats
+	}
+
+	dials, heartbeats := run(SenderOption{Heartbeat: 5 * time.Millisecond}, func(heartbeats func() int) {
+		for start := time.Now(); heartbeats() < 2 && time.Since(start) < 5*time.Second; {
+			time.Sleep(time.Millisecond)
+		}
+	})
+	if dials != 1 || heartbeats < 2 {
+		t.Errorf("heartbeat: got %d dials, %d heartbeats", dials, heartbeats)
+	}
+
+	dials, heartbeats = run(SenderOption{IdleTimeout: 5 * time.Millisecond}, func(func() int) {
+		time.Sleep(50 * time.Millisecond)
+	})
+	if dials != 2 || heartbeats != 0 {
+		t.Errorf("idle timeout: got %d dials, %d heartbeats", dials, heartbeats)
+	}
+}
+
+func TestSenderShutdown(t *testing.T) {
+	var failed []error
+	s := NewSender(&SenderOption{
+		Dial: func() (net.Conn, error) {
+			a, b := net.Pipe()
+			go func() {
+				// Read messages without acknowledging them.
+				defer b.Close()
+				r := NewReader(b)
+				for {
+					if _, err := r.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}()
+			return a, nil
+		},
+		Timeout: time.Hour,
+		Failed:  func(msg []byte, err error) { failed = append(failed, err) },
+	})
+	for _, id := range []string{"1", "2"} {
+		if err := s.Send([]byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|" + id + "|P|2.5.1")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v", err)
+	}
+	if len(failed) != 2 || failed[0] != context.Canceled || failed[1] != context.Canceled {
+		t.Errorf("got %v", failed)
+	}
+	if err := s.SendContext(context.Background(), nil); err != ErrClosed {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestSenderShutdownBlocked(t *testing.T) {
+	// The receiver is down, so the queue fills and a Send waits.
+	var failed []error
+	s := NewSender(&SenderOption{
+		Dial:    func() (net.Conn, error) { return nil, errors.New("connection refused") },
+		Backoff: time.Hour,
+		Queue:   1,
+		Failed:  func(msg []byte, err error) { failed = append(failed, err) },
+	})
+	msg := []byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|1|P|2.5.1")
+	for i := 0; i < 2; i++ {
+		if err := s.Send(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	blocked := make(chan error, 1)
+	go func() { blocked <- s.Send(msg) }()
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := s.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("shutdown took %v", d)
+	}
+	if err := <-blocked; err != ErrClosed {
+		t.Errorf("blocked send: got %v", err)
+	}
+	if len(failed) != 2 {
+		t.Errorf("got %v", failed)
+	}
+}
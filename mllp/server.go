@@ -0,0 +1,316 @@
+package mllp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/kardianos/hl7"
+)
+
+// ErrServerClosed is returned by Server.Serve after the Server is closed.
+var ErrServerClosed = errors.New("mllp: server closed")
+
+// ServerOption sets how a Server reads messages and writes responses.
+type ServerOption struct {
+	// Encode is the options used to encode each response of the handler. May be nil.
+	// Each response is encoded with a new Encoder, as connections are handled concurrently.
+	Encode *hl7.EncodeOption
+
+	// MaxSize is the maximum message length in bytes. If zero, 10 MiB.
+	MaxSize int
+
+	// KeepAlive is the period of TCP keep-alive probes on TCP connections.
+	// If zero, the default of the listener; if negative, probes are not sent.
+	KeepAlive time.Duration
+
+	// IdleTimeout closes a connection no message or heartbeat is received on for this long. If zero, connections are kept open.
+	IdleTimeout time.Duration
+
+	// MessageTimeout is the deadline of the context of each message, passed to the handler if it is an hl7.ContextHandler.
+	// If zero, the context has no deadline. The context is also canceled when the Server is closed.
+	MessageTimeout time.Duration
+
+	// Error is called with each message that could not be decoded, handled, or answered, if not nil.
+	// A message that could not be decoded is answered with a negative ACK with the code AR, and one the handler
+	// returned an error for, or whose response could not be encoded, with the code AE; the error is the text message (MSA-3).
+	// No response is sent if not even the MSH segment of the message decodes.
+	Error func(msg []byte, err error)
+
+	// Trace is called as each response is written. If nil, responses are not traced.
+	Trace *hl7.Trace
+
+	// Metrics receives a MetricSend as each response is written, and a MetricAck with the time from reading
+	// the message to writing its response. May be nil.
+	Metrics hl7.Metrics
+}
+
+// Server receives messages on connections, decodes each with the decoder, and passes it to a handler.
+// The response of the handler, such as an ACK, is written to the connection; it is encoded with the Encode options
+// unless it is a []byte. Messages on a connection are handled in order; connections are handled concurrently.
+// An empty message is a heartbeat and is answered with an empty message, without calling the handler.
+// A Server may serve any net.Listener or net.Conn, such as a Unix domain socket for a sidecar, or a net.Pipe in tests.
+type Server struct {
+	d   *hl7.Decoder
+	h   hl7.Handler
+	opt ServerOption
+
+	ctx    context.Context // Canceled by Close, and by Shutdown when its context is done.
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	closed  bool
+	closers map[io.Closer]struct{} // Listeners and connections being served.
+	wg      sync.WaitGroup
+}
+
+// NewServer returns a Server. Option may be nil.
+func NewServer(d *hl7.Decoder, h hl7.Handler, opt *ServerOption) *Server {
+	s := &Server{
+		d:       d,
+		h:       h,
+		closers: map[io.Closer]struct{}{},
+	}
+	if opt != nil {
+		s.opt = *opt
+	}
+	if s.opt.MaxSize <= 0 {
+		s.opt.MaxSize = 10 << 20
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	return s
+}
+
+// ListenAndServe listens on the network and address, such as "tcp" and ":2575", or "unix" and a socket path,
+// and calls Serve.
+func (s *Server) ListenAndServe(network, address string) error {
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return fmt.Errorf("mllp: %w", err)
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections on the listener and serves each in a new goroutine. The listener is closed
+// when Serve returns. After Close, Serve returns ErrServerClosed.
+func (s *Server) Serve(l net.Listener) error {
+	defer l.Close()
+	if !s.track(l) {
+		return ErrServerClosed
+	}
+	defer s.untrack(l)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			return fmt.Errorf("mllp: accept: %w", err)
+		}
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves messages on the connection until it is closed, and closes it.
+// It returns nil when the peer closes the connection or it is idle for the IdleTimeout.
+func (s *Server) ServeConn(conn net.Conn) error {
+	defer conn.Close()
+	if !s.track(conn) {
+		return ErrServerClosed
+	}
+	defer s.untrack(conn)
+	if tc, ok := conn.(*net.TCPConn); ok && s.opt.KeepAlive != 0 {
+		tc.SetKeepAlive(s.opt.KeepAlive > 0)
+		if s.opt.KeepAlive > 0 {
+			tc.SetKeepAlivePeriod(s.opt.KeepAlive)
+		}
+	}
+	r := NewReader(conn)
+	r.MaxSize = s.opt.MaxSize
+	for {
+		ok, err := s.readDeadline(conn)
+		if err != nil {
+			return fmt.Errorf("mllp: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+		msg, err := r.ReadMessage()
+		received := time.Now()
+		if err != nil {
+			var ne net.Error
+			if err == io.EOF || s.isClosed() || (errors.As(err, &ne) && ne.Timeout()) {
+				return nil
+			}
+			return fmt.Errorf("mllp: read: %w", err)
+		}
+		if len(msg) == 0 {
+			if err := WriteMessage(conn, nil); err != nil {
+				return fmt.Errorf("mllp: write: %w", err)
+			}
+			continue
+		}
+		message, resp, err := s.serve(msg)
+		if err != nil {
+			if s.opt.Error != nil {
+				s.opt.Error(msg, err)
+			}
+			resp = s.nack(msg, message, err)
+		}
+		if resp == nil {
+			continue
+		}
+		start := time.Now()
+		err = WriteMessage(conn, resp)
+		hl7.ObserveSent(s.opt.Trace, s.opt.Metrics, resp, start, err)
+		hl7.ObserveAck(s.opt.Trace, s.opt.Metrics, msg, received, err)
+		if err != nil {
+			return fmt.Errorf("mllp: write: %w", err)
+		}
+	}
+}
+
+// serve returns the decoded message and the encoded response of the handler to it, or nil if there is none.
+// The message is nil if it could not be decoded.
+func (s *Server) serve(msg []byte) (any, []byte, error) {
+	message, err := s.d.Decode(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := s.ctx
+	if s.opt.MessageTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.opt.MessageTimeout)
+		defer cancel()
+	}
+	resp, err := hl7.ServeContext(ctx, s.h, message)
+	if err != nil {
+		return message, nil, err
+	}
+	switch resp := resp.(type) {
+	case nil:
+		return message, nil, nil
+	case []byte:
+		return message, resp, nil
+	}
+	data, err := hl7.NewEncoder(s.opt.Encode).Encode(resp)
+	if err != nil {
+		return message, nil, fmt.Errorf("encode response: %w", err)
+	}
+	return message, data, nil
+}
+
+// nack returns the encoded negative ACK of a message that could not be decoded (AR), or handled (AE),
+// with the error as the text message. It returns nil if not even the MSH segment of the message decodes.
+func (s *Server) nack(msg []byte, message any, failed error) []byte {
+	code := "AE"
+	if message == nil {
+		code = "AR"
+		header := msg
+		if i := bytes.IndexAny(msg, "\r\n"); i >= 0 {
+			header = msg[:i]
+		}
+		list, err := s.d.DecodeList(header)
+		if err != nil {
+			return nil
+		}
+		message = list
+	}
+	ack, err := hl7.ResponseACK(s.d.Registry(), message, code, failed.Error(), nil)
+	if err != nil {
+		return nil
+	}
+	data, err := hl7.NewEncoder(s.opt.Encode).Encode(ack)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// Close closes the listeners and connections of the Server and waits for the messages being handled.
+// A response to a message being handled is not sent, as its connection is closed.
+func (s *Server) Close() error {
+	s.cancel()
+	s.mu.Lock()
+	s.closed = true
+	for c := range s.closers {
+		c.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	return nil
+}
+
+// Shutdown closes the listeners of the Server, and each connection when it is not handling a message, so
+// the responses to messages being handled are sent. If the context is done first, Shutdown cancels the
+// contexts of the messages being handled, closes the Server as Close does, and returns the error of the context.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	for c := range s.closers {
+		if conn, ok := c.(net.Conn); ok {
+			// Stop reading the next message; the response to a message being handled is still written.
+			conn.SetReadDeadline(time.Now())
+			continue
+		}
+		c.Close()
+	}
+	s.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.Close()
+		return ctx.Err()
+	}
+}
+
+// readDeadline sets the read deadline of a connection for the IdleTimeout before it reads the next message,
+// and reports false if the Server is closed.
+func (s *Server) readDeadline(conn net.Conn) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false, nil
+	}
+	if s.opt.IdleTimeout > 0 {
+		return true, conn.SetReadDeadline(time.Now().Add(s.opt.IdleTimeout))
+	}
+	return true, nil
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// track adds a listener or connection to be closed by Close, and reports false if the Server is closed.
+func (s *Server) track(c io.Closer) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.closers[c] = struct{}{}
+	s.wg.Add(1)
+	return true
+}
+
+func (s *Server) untrack(c io.Closer) {
+	s.mu.Lock()
+	delete(s.closers, c)
+	s.mu.Unlock()
+	s.wg.Done()
+}
@@ -0,0 +1,349 @@
+package mllp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kardianos/hl7"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func testServer(errs *[]string, mu *sync.Mutex) *Server {
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		id := message.(v251.ADT_A01).MSH.MessageControlID
+		switch id {
+		case "none":
+			return nil, nil
+		case "fail":
+			return nil, errors.New("failed")
+		}
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R" + id + "|P|2.5.1\rMSA|AA|" + id), nil
+	})
+	return NewServer(hl7.NewDecoder(v251.Registry, nil), h, &ServerOption{
+		Error: func(msg []byte, err error) {
+			mu.Lock()
+			*errs = append(*errs, err.Error())
+			mu.Unlock()
+		},
+	})
+}
+
+func testADT(id string) []byte {
+	return []byte("MSH|^~\\&|A|A|B|B|20240102||ADT^A01^ADT_A01|" + id + "|P|2.5.1\rPID|1||123||Doe\rPV1|1|I")
+}
+
+func TestServerUnix(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []string
+	)
+	s := testServer(&errs, &mu)
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "mllp.sock"))
+	if err != nil {
+		t.Skip(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- s.Serve(l) }()
+
+	c, err := Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	// Messages without a response are skipped, so the next response is of message fail.
+	for _, msg := range [][]byte{testADT("none"), []byte("MSH|bad")} {
+		if err := WriteMessage(c.conn, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A handler error is answered with AE, and a message that does not decode with AR.
+	for _, tc := range []struct {
+		msg  []byte
+		want string
+	}{
+		{testADT("fail"), "MSA|AE|fail|failed"},
+		{append(testADT("3"), "\rPID|2||456||Doe||notadate"...), "MSA|AR|3|"},
+		{testADT("2"), "MSA|AA|2"},
+	} {
+		ack, err := c.Send(tc.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(ack), tc.want) {
+			t.Errorf("got %q, want %q", ack, tc.want)
+		}
+	}
+	mu.Lock()
+	if len(errs) != 3 || errs[1] != "failed" {
+		t.Errorf("got errors %q", errs)
+	}
+	mu.Unlock()
+
+	s.Close()
+	if err := <-served; err != ErrServerClosed {
+		t.Errorf("got %v", err)
+	}
+	if err := s.Serve(l); err != ErrServerClosed {
+		t.Errorf("got %v after close", err)
+	}
+}
+
+func TestServerConn(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []string
+	)
+	s := testServer(&errs, &mu)
+	a, b := net.Pipe()
+	served := make(chan error, 1)
+	go func() { served <- s.ServeConn(b) }()
+	c := NewClient(a)
+	ack, err := c.Send(testADT("1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(ack), "MSA|AA|1") {
+		t.Errorf("got %q", ack)
+	}
+	c.Close()
+	if err := <-served; err != nil {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestServerIdle(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []string
+	)
+	s := testServer(&errs, &mu)
+	s.opt.IdleTimeout = 20 * time.Millisecond
+	a, b := net.Pipe()
+	served := make(chan error, 1)
+	go func() { served <- s.ServeConn(b) }()
+	defer a.Close()
+
+	// A heartbeat is answered, and keeps the connection open.
+	c := NewClient(a)
+	for i := 0; i < 3; i++ {
+		resp, err := c.Send(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp) != 0 {
+			t.Errorf("got heartbeat response %q", resp)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle connection was not closed")
+	}
+	if _, err := c.Send(testADT("1")); err == nil {
+		t.Errorf("expected an error on a closed connection")
+	}
+}
+
+func TestServerShutdown(t *testing.T) {
+	started := make(chan string, 1)
+	h := hl7.ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+		id := message.(v251.ADT_A01).MSH.MessageControlID
+		started <- id
+		if id == "wait" {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		time.Sleep(10 * time.Millisecond)
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R" + id + "|P|2.5.1\rMSA|AA|" + id), nil
+	})
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	serve := func() (*Server, *Client, chan error) {
+		s := NewServer(hl7.NewDecoder(v251.Registry, nil), h, &ServerOption{
+			IdleTimeout: time.Hour,
+			Error: func(msg []byte, err error) {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			},
+		})
+		a, b := net.Pipe()
+		served := make(chan error, 1)
+		go func() { served <- s.ServeConn(b) }()
+		return s, NewClient(a), served
+	}
+
+	// The response to a message being handled is sent before the connection is closed.
+	s, c, served := serve()
+	acked := make(chan error, 1)
+	go func() {
+		_, err := c.Send(testADT("1"))
+		acked <- err
+	}()
+	<-started
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := <-acked; err != nil {
+		t.Errorf("got %v", err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("got %v", err)
+	}
+	c.Close()
+
+	// A handler that does not return in time has its context canceled.
+	s, c, served = serve()
+	defer c.Close()
+	go c.Send(testADT("wait"))
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v", err)
+	}
+	<-served
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) != 1 || errs[0] != context.Canceled {
+		t.Errorf("got %v", errs)
+	}
+}
+
+func TestServerConcurrent(t *testing.T) {
+	// The handler returns a struct, so each response is encoded.
+	h := hl7.HandlerFunc(func(message any) (any, error) {
+		return message, nil
+	})
+	s := NewServer(hl7.NewDecoder(v251.Registry, nil), h, &ServerOption{Encode: &hl7.EncodeOption{TrimTrailingSeparator: true}})
+	defer s.Close()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		a, b := net.Pipe()
+		go s.ServeConn(b)
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			c := NewClient(a)
+			defer c.Close()
+			for j := 0; j < 20; j++ {
+				resp, err := c.Send(testADT(id))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if !strings.Contains(string(resp), "|ADT^A01^ADT_A01|"+id+"|") {
+					t.Errorf("got %q", resp)
+					return
+				}
+			}
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+}
+
+func TestMetrics(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []string
+		got  = map[string][]string{}
+	)
+	metrics := func(name string) hl7.Metrics {
+		return hl7.MetricsFunc(func(m hl7.Metric) {
+			mu.Lock()
+			defer mu.Unlock()
+			got[name] = append(got[name], m.Step.String()+":"+m.MessageType+"^"+m.TriggerEvent)
+			if m.Err != nil {
+				t.Errorf("%s %s: %v", name, m.Step, m.Err)
+			}
+		})
+	}
+	var sent []string
+	trace := &hl7.Trace{Sent: func(info hl7.TraceInfo, err error) {
+		mu.Lock()
+		sent = append(sent, info.ControlID)
+		mu.Unlock()
+	}}
+	s := testServer(&errs, &mu)
+	s.opt.Metrics = metrics("server")
+	s.opt.Trace = trace
+	sender := NewSender(&SenderOption{
+		Dial: func() (net.Conn, error) {
+			a, b := net.Pipe()
+			go s.ServeConn(b)
+			return a, nil
+		},
+		Timeout: time.Second,
+		Metrics: metrics("sender"),
+		Trace:   trace,
+	})
+	if err := sender.Send(testADT("1")); err != nil {
+		t.Fatal(err)
+	}
+	sender.Close()
+	s.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := map[string][]string{
+		"sender": {"send:ADT^A01", "ack:ADT^A01"},
+		"server": {"send:ACK^A01", "ack:ADT^A01"},
+	}
+	for name, w := range want {
+		if g := strings.Join(got[name], " "); g != strings.Join(w, " ") {
+			t.Errorf("%s got %s, want %s", name, g, strings.Join(w, " "))
+		}
+	}
+	sort.Strings(sent)
+	if g := strings.Join(sent, " "); g != "1 R1" {
+		t.Errorf("got sent %s", g)
+	}
+}
+
+func TestServerMiddlewareContext(t *testing.T) {
+	logged := 0
+	logging := func(next hl7.Handler) hl7.Handler {
+		return hl7.ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+			logged++
+			return hl7.ServeContext(ctx, next, message)
+		})
+	}
+	h := hl7.ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, errors.New("no deadline")
+		}
+		id := message.(v251.ADT_A01).MSH.MessageControlID
+		return []byte("MSH|^~\\&|B|B|A|A|20240102||ACK^A01^ACK|R" + id + "|P|2.5.1\rMSA|AA|" + id), nil
+	})
+	var errs []string
+	s := NewServer(hl7.NewDecoder(v251.Registry, nil), hl7.Chain(h, logging), &ServerOption{
+		MessageTimeout: time.Minute,
+		Error:          func(msg []byte, err error) { errs = append(errs, err.Error()) },
+	})
+	a, b := net.Pipe()
+	go s.ServeConn(b)
+	c := NewClient(a)
+	c.Timeout = time.Second
+	ack, err := c.Send(testADT("1"))
+	if err != nil {
+		t.Fatalf("got %v, errors %q", err, errs)
+	}
+	if !strings.HasSuffix(string(ack), "MSA|AA|1") || logged != 1 {
+		t.Errorf("got %q, logged %d", ack, logged)
+	}
+	c.Close()
+	s.Close()
+}
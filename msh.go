@@ -0,0 +1,245 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// MSHOption sets the values of a header segment from NewMSH.
+type MSHOption struct {
+	SendingApplication   string // MSH-3, the namespace ID.
+	SendingFacility      string // MSH-4, the namespace ID.
+	ReceivingApplication string // MSH-5, the namespace ID.
+	ReceivingFacility    string // MSH-6, the namespace ID.
+
+	MessageType      string // MSH-9.1, such as ORU.
+	TriggerEvent     string // MSH-9.2, such as R01.
+	MessageStructure string // MSH-9.3, such as ORU_R01.
+
+	// ProcessingID is MSH-11, such as P for production, D for debugging, or T for training. If empty, P.
+	ProcessingID string
+
+	// ControlID generates MSH-10. If nil, a SequenceControlID shared by all calls is used.
+	ControlID ControlIDGenerator
+
+	// Now returns the time of the message, MSH-7. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+var defaultControlID = &SequenceControlID{}
+
+// NewMSH returns a new registry MSH segment, such as *h251.MSH, for an outbound message: MSH-1 and MSH-2 have
+// the standard delimiters, MSH-7 the current time, MSH-10 a new control ID, MSH-11 the processing ID,
+// and MSH-12 the registry version. Option may be nil.
+// In versions where MSH-9 is a single value, it is set to the message type.
+func NewMSH(registry Registry, opt *MSHOption) (any, error) {
+	seg, ok := registry.Segment()["MSH"]
+	if !ok {
+		return nil, fmt.Errorf("MSH segment not found in registry")
+	}
+	var o MSHOption
+	if opt != nil {
+		o = *opt
+	}
+	if len(o.ProcessingID) == 0 {
+		o.ProcessingID = "P"
+	}
+	if o.ControlID == nil {
+		o.ControlID = defaultControlID
+	}
+	now := time.Now
+	if o.Now != nil {
+		now = o.Now
+	}
+	id, err := o.ControlID.ControlID()
+	if err != nil {
+		return nil, fmt.Errorf("MSH: %w", err)
+	}
+
+	rv := reflect.New(reflect.TypeOf(seg))
+	rt := rv.Elem().Type()
+	for i := 0; i < rt.NumField(); i++ {
+		t, err := parseTag(rt.Field(i).Name, rt.Field(i).Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+		fv := rv.Elem().Field(i)
+		switch {
+		case t.FieldSep && fv.Kind() == reflect.String:
+			fv.SetString(defaultSep)
+		case t.FieldChars && fv.Kind() == reflect.String:
+			fv.SetString(defaultChars)
+		}
+	}
+	for _, f := range []struct {
+		order  int
+		values []string
+	}{
+		{3, []string{o.SendingApplication}},
+		{4, []string{o.SendingFacility}},
+		{5, []string{o.ReceivingApplication}},
+		{6, []string{o.ReceivingFacility}},
+		{9, []string{o.MessageType, o.TriggerEvent, o.MessageStructure}},
+		{10, []string{id}},
+		{11, []string{o.ProcessingID}},
+		{12, []string{registry.Version()}},
+	} {
+		if err := setField(rv.Elem(), f.order, f.values); err != nil {
+			return nil, fmt.Errorf("MSH-%d: %w", f.order, err)
+		}
+	}
+	_, fv, _, ok := fieldByOrder(rv.Elem(), 7)
+	if ok && fv.Type() == timeType {
+		fv.Set(reflect.ValueOf(now()))
+	}
+	return rv.Interface(), nil
+}
+
+// ResponseOption sets the values of a header segment from ResponseMSH.
+type ResponseOption struct {
+	MessageType      string // MSH-9.1. If empty, ACK.
+	TriggerEvent     string // MSH-9.2. If empty, the trigger event of the request.
+	MessageStructure string // MSH-9.3. If empty, ACK for an ACK message.
+
+	// ControlID generates MSH-10. If nil, a SequenceControlID shared by all calls is used.
+	ControlID ControlIDGenerator
+
+	// Now returns the time of the message, MSH-7. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// ResponseMSH returns a new MSH segment of the same version as the MSH of the request message, for an ACK or query response:
+// the sending application and facility (MSH-3 and MSH-4) are the receiving application and facility of the request
+// (MSH-5 and MSH-6), and the reverse; MSH-7 is the current time and MSH-10 a new control ID; and the processing ID,
+// version, and character sets (MSH-11, MSH-12, and MSH-18) are copied from the request. Option may be nil.
+func ResponseMSH(request any, opt *ResponseOption) (any, error) {
+	req, ok := findSegment(request, "MSH")
+	if !ok {
+		return nil, fmt.Errorf("missing MSH segment")
+	}
+	var o ResponseOption
+	if opt != nil {
+		o = *opt
+	}
+	if len(o.MessageType) == 0 {
+		o.MessageType = "ACK"
+	}
+	if len(o.TriggerEvent) == 0 {
+		_, o.TriggerEvent, _ = messageEvent(request)
+	}
+	if len(o.MessageStructure) == 0 && o.MessageType == "ACK" {
+		o.MessageStructure = "ACK"
+	}
+	if o.ControlID == nil {
+		o.ControlID = defaultControlID
+	}
+	now := time.Now
+	if o.Now != nil {
+		now = o.Now
+	}
+	id, err := o.ControlID.ControlID()
+	if err != nil {
+		return nil, fmt.Errorf("MSH: %w", err)
+	}
+
+	rv := reflect.New(req.Type())
+	rt := req.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		t, err := parseTag(rt.Field(i).Name, rt.Field(i).Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+		if t.FieldSep || t.FieldChars {
+			rv.Elem().Field(i).Set(req.Field(i))
+		}
+	}
+	for to, from := range map[int]int{3: 5, 4: 6, 5: 3, 6: 4, 11: 11, 12: 12, 18: 18} {
+		_, fv, _, ok := fieldByOrder(req, from)
+		if !ok {
+			continue
+		}
+		_, tv, _, ok := fieldByOrder(rv.Elem(), to)
+		if !ok || tv.Type() != fv.Type() {
+			continue
+		}
+		tv.Set(reflect.ValueOf(Clone(fv.Interface())))
+	}
+	for _, f := range []struct {
+		order  int
+		values []string
+	}{
+		{9, []string{o.MessageType, o.TriggerEvent, o.MessageStructure}},
+		{10, []string{id}},
+	} {
+		if err := setField(rv.Elem(), f.order, f.values); err != nil {
+			return nil, fmt.Errorf("MSH-%d: %w", f.order, err)
+		}
+	}
+	_, fv, _, ok := fieldByOrder(rv.Elem(), 7)
+	if ok && fv.Type() == timeType {
+		fv.Set(reflect.ValueOf(now()))
+	}
+	return rv.Interface(), nil
+}
+
+// ResponseACK returns the segments of a general acknowledgment of the request message: an MSH from ResponseMSH,
+// and a registry MSA segment with the acknowledgment code (MSA-1), such as AA, AE, or AR, the control ID of the
+// request (MSA-2), and the text message (MSA-3). The list may be encoded or passed to DecodeGroup. Option may be nil.
+func ResponseACK(registry Registry, request any, code, text string, opt *ResponseOption) ([]any, error) {
+	msh, err := ResponseMSH(request, opt)
+	if err != nil {
+		return nil, err
+	}
+	seg, ok := registry.Segment()["MSA"]
+	if !ok {
+		return nil, fmt.Errorf("MSA segment not found in registry")
+	}
+	req, _ := findSegment(request, "MSH")
+	msa := reflect.New(reflect.TypeOf(seg))
+	for _, f := range []struct {
+		order int
+		value string
+	}{
+		{1, code},
+		{2, stringField(req, 10)},
+		{3, text},
+	} {
+		if err := setField(msa.Elem(), f.order, []string{f.value}); err != nil {
+			return nil, fmt.Errorf("MSA-%d: %w", f.order, err)
+		}
+	}
+	return []any{msh, msa.Interface()}, nil
+}
+
+// setField sets the field of a segment by order to the string components.
+// A field that is a single string is set to the first component.
+func setField(rv reflect.Value, order int, values []string) error {
+	_, fv, _, ok := fieldByOrder(rv, order)
+	if !ok {
+		return fmt.Errorf("%v has no field %d", rv.Type(), order)
+	}
+	empty := true
+	for _, v := range values {
+		if len(v) > 0 {
+			empty = false
+		}
+	}
+	if empty {
+		return nil
+	}
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(values[0])
+		return nil
+	case reflect.Struct:
+		return setComponents(fv, values)
+	}
+	return fmt.Errorf("%v is not a string or data type", fv.Type())
+}
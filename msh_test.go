@@ -0,0 +1,123 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v210 "github.com/kardianos/hl7/h210"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestNewMSH(t *testing.T) {
+	opt := &MSHOption{
+		SendingApplication: "LAB",
+		SendingFacility:    "HOSP",
+		ReceivingFacility:  "CLINIC",
+		MessageType:        "ORU",
+		TriggerEvent:       "R01",
+		MessageStructure:   "ORU_R01",
+		ControlID:          &SequenceControlID{Now: func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }},
+		Now:                func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+	seg, err := NewMSH(v251.Registry, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msh := seg.(*v251.MSH)
+	if msh.SendingApplication.NamespaceID != "LAB" || msh.ReceivingApplication != nil || msh.ProcessingID.ProcessingID != "P" {
+		t.Errorf("got %+v", msh)
+	}
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode([]any{msh})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|LAB|HOSP||CLINIC|20240102030405||ORU^R01^ORU_R01|20240102030405000000|P|2.5.1"
+	if string(got) != want {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+
+	seg, err = NewMSH(v210.Registry, &MSHOption{MessageType: "ADT", TriggerEvent: "A01", ProcessingID: "T"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := seg.(*v210.MSH)
+	if old.MessageType != "ADT" || old.ProcessingID != "T" || old.VersionID != "2.1" || len(old.MessageControlID) != 20 || old.DateTimeOfMessage.IsZero() {
+		t.Errorf("got %+v", old)
+	}
+
+	seg, err = NewMSH(v251.Registry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := NewMSH(v251.Registry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, b := seg.(*v251.MSH).MessageControlID, next.(*v251.MSH).MessageControlID
+	if a == b || !strings.HasPrefix(a, time.Now().UTC().Format("2006")) {
+		t.Errorf("got control IDs %s and %s", a, b)
+	}
+}
+
+func TestResponseMSH(t *testing.T) {
+	raw := "MSH|^~\\&|LAB|HOSP^1.2.3^ISO|EHR|CLINIC|20240102030405||ORU^R01^ORU_R01|MSG1|T|2.5.1||||||UNICODE UTF-8\rPID|||123"
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := func() time.Time { return time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC) }
+	seg, err := ResponseMSH(list, &ResponseOption{
+		ControlID: ControlIDFunc(func() (string, error) { return "ACK1", nil }),
+		Now:       now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode([]any{seg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|EHR|CLINIC|LAB|HOSP^1.2.3^ISO|20240203040506||ACK^R01^ACK|ACK1|T|2.5.1||||||UNICODE UTF-8"
+	if string(got) != want {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+	// The response does not share values with the request.
+	seg.(*v251.MSH).ReceivingFacility.UniversalID = "x"
+	if list[0].(*v251.MSH).SendingFacility.UniversalID != "1.2.3" {
+		t.Errorf("request changed")
+	}
+
+	seg, err = ResponseMSH(list, &ResponseOption{MessageType: "RSP", TriggerEvent: "K22", MessageStructure: "RSP_K21"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mt := seg.(*v251.MSH).MessageType; mt.MessageCode != "RSP" || mt.TriggerEvent != "K22" || mt.MessageStructure != "RSP_K21" {
+		t.Errorf("got %+v", mt)
+	}
+	if _, err := ResponseMSH([]any{}, nil); err == nil {
+		t.Errorf("expected an error without MSH")
+	}
+}
+
+func TestResponseACK(t *testing.T) {
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte("MSH|^~\\&|LAB|HOSP|EHR|CLINIC|20240102030405||ADT^A01^ADT_A01|MSG1|P|2.5.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack, err := ResponseACK(v251.Registry, list, "AE", "bad|value", &ResponseOption{
+		ControlID: ControlIDFunc(func() (string, error) { return "ACK1", nil }),
+		Now:       func() time.Time { return time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(ack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|EHR|CLINIC|LAB|HOSP|20240203040506||ACK^A01^ACK|ACK1|P|2.5.1\rMSA|AE|MSG1|bad\\F\\value"
+	if string(got) != want {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
@@ -0,0 +1,161 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Normalize rewrites a message, or a batch or file of messages, in a canonical form for hashing and comparison:
+// the standard delimiters |^~\&, with # as the truncation character if one is set; no trailing empty fields,
+// repetitions, components, or subcomponents; each segment ending in a CR; and values escaped the same way.
+//
+// Escape sequences are kept as sent, except that delimiters are escaped only where they are delimiters
+// in the standard set, so A^B sent with # as the component separator is written as A\S\B.
+func Normalize(data []byte) ([]byte, error) {
+	lines := bytes.FieldsFunc(data, func(r rune) bool {
+		return r == '\r' || r == '\n'
+	})
+	n := &normalizer{buf: &bytes.Buffer{}}
+	n.buf.Grow(len(data))
+	for index, line := range lines {
+		lineNumber := index + 1
+		if err := n.segment(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+	return n.buf.Bytes(), nil
+}
+
+type normalizer struct {
+	buf *bytes.Buffer
+	src Delimiters // Delimiters of the last header segment.
+	set bool
+}
+
+// normalTruncation is the truncation character written if the message sets one.
+const normalTruncation = '#'
+
+func (n *normalizer) segment(line []byte) error {
+	if len(line) < 3 {
+		return fmt.Errorf("missing segment type")
+	}
+	name := string(line[:3])
+	remain := line[3:]
+	switch name {
+	case "MSH", "BHS", "FHS":
+		dl, size, err := parseInitDelimiters(remain)
+		if err != nil {
+			return err
+		}
+		n.src = dl
+		n.set = true
+		remain = remain[size:]
+	}
+	if !n.set {
+		return fmt.Errorf("missing sep prior to field")
+	}
+	if len(remain) > 0 && remain[0] != n.src.Field {
+		return fmt.Errorf("missing field separator after %s", name)
+	}
+
+	var fields [][]byte
+	if len(remain) > 0 {
+		parts := bytes.Split(remain[1:], []byte{n.src.Field})
+		for _, p := range parts {
+			fields = append(fields, n.field(p))
+		}
+	}
+	for len(fields) > 0 && len(fields[len(fields)-1]) == 0 {
+		fields = fields[:len(fields)-1]
+	}
+
+	n.buf.WriteString(name)
+	switch name {
+	case "MSH", "BHS", "FHS":
+		n.buf.WriteString(defaultSep + defaultChars)
+		if n.src.Truncation != 0 {
+			n.buf.WriteByte(normalTruncation)
+		}
+	}
+	for _, f := range fields {
+		n.buf.WriteString(defaultSep)
+		n.buf.Write(f)
+	}
+	n.buf.WriteByte(nextLine)
+	return nil
+}
+
+// field returns the normalized field, without trailing empty repetitions, components, or subcomponents.
+func (n *normalizer) field(data []byte) []byte {
+	reps := bytes.Split(data, []byte{n.src.Repeat})
+	out := make([][]byte, 0, len(reps))
+	for _, rep := range reps {
+		comps := bytes.Split(rep, []byte{n.src.Component})
+		cout := make([][]byte, 0, len(comps))
+		for _, comp := range comps {
+			subs := bytes.Split(comp, []byte{n.src.Subcomponent})
+			sout := make([][]byte, 0, len(subs))
+			for _, sub := range subs {
+				sout = append(sout, n.value(sub))
+			}
+			cout = append(cout, joinTrimmed(sout, DefaultDelimiters.Subcomponent))
+		}
+		out = append(out, joinTrimmed(cout, DefaultDelimiters.Component))
+	}
+	return joinTrimmed(out, DefaultDelimiters.Repeat)
+}
+
+// joinTrimmed joins the parts without the trailing empty parts.
+func joinTrimmed(parts [][]byte, sep byte) []byte {
+	for len(parts) > 0 && len(parts[len(parts)-1]) == 0 {
+		parts = parts[:len(parts)-1]
+	}
+	return bytes.Join(parts, []byte{sep})
+}
+
+// value returns the value escaped for the standard delimiters.
+func (n *normalizer) value(v []byte) []byte {
+	esc := n.src.Escape
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if esc != 0 && c == esc {
+			end := bytes.IndexByte(v[i+1:], esc)
+			if end >= 0 {
+				// An escape sequence is kept as is.
+				out = append(out, DefaultDelimiters.Escape)
+				out = append(out, v[i+1:i+1+end]...)
+				out = append(out, DefaultDelimiters.Escape)
+				i += end + 1
+				continue
+			}
+		}
+		if n.src.Truncation != 0 && c == n.src.Truncation {
+			out = append(out, normalTruncation)
+			continue
+		}
+		var code byte
+		switch c {
+		case DefaultDelimiters.Field:
+			code = 'F'
+		case DefaultDelimiters.Component:
+			code = 'S'
+		case DefaultDelimiters.Repeat:
+			code = 'R'
+		case DefaultDelimiters.Escape:
+			code = 'E'
+		case DefaultDelimiters.Subcomponent:
+			code = 'T'
+		case normalTruncation:
+			if n.src.Truncation != 0 {
+				code = 'P'
+			}
+		}
+		if code == 0 {
+			out = append(out, c)
+			continue
+		}
+		out = append(out, DefaultDelimiters.Escape, code, DefaultDelimiters.Escape)
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	list := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "trailing",
+			in:   "MSH|^~\\&|A|B|||||ADT^A01^|1|P|2.5.1|||\nPID|||123^^^MRN&&~~||Doe^^||||\r\n\r\nPV1||I\n",
+			want: "MSH|^~\\&|A|B|||||ADT^A01|1|P|2.5.1\rPID|||123^^^MRN||Doe\rPV1||I\r",
+		},
+		{
+			name: "delimiters",
+			in:   "MSH*#~!@*A*B\rPID***1#2~3**A^B|C!S!D\\E!F!@x",
+			want: "MSH|^~\\&|A|B\rPID|||1^2~3||A\\S\\B\\F\\C\\S\\D\\E\\E\\F\\&x\r",
+		},
+		{
+			name: "truncation",
+			in:   "MSH|^~\\&@|A\rNTE|||long@ #1 \\P\\",
+			want: "MSH|^~\\&#|A\rNTE|||long# \\P\\1 \\P\\\r",
+		},
+		{
+			name: "null",
+			in:   "MSH|^~\\&|A\rPID|||\"\"|",
+			want: "MSH|^~\\&|A\rPID|||\"\"\r",
+		},
+	}
+	for _, item := range list {
+		t.Run(item.name, func(t *testing.T) {
+			got, err := Normalize([]byte(item.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != item.want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, item.want)
+			}
+			again, err := Normalize(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("not stable:\n%q", again)
+			}
+		})
+	}
+
+	for _, bad := range []string{
+		"PID|1",
+		"MSH|^~",
+		"MSH|^~\\&|A\rPID1",
+	} {
+		if _, err := Normalize([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %q", strings.ReplaceAll(bad, "\r", "\\r"))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package hl7
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// isNumber reports if the kind is decoded and encoded as an NM value.
+// As with other empty values, a numeric field or component that is 0 is not sent;
+// declare it as a pointer, such as *int, to send a 0. A 0 in a repetition is always sent.
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// checkNumber returns an error if v is not an NM value: [+|-]digits[.digits].
+func checkNumber(v string) error {
+	s := v
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	if len(whole)+len(frac) == 0 || !isDigits(whole) || !isDigits(frac) {
+		return fmt.Errorf("invalid number %q", v)
+	}
+	return nil
+}
+
+// setNumber parses the NM value v into the numeric value rv.
+func setNumber(rv reflect.Value, v string) error {
+	v = strings.TrimSpace(v)
+	if len(v) == 0 || v == nullValue {
+		return nil
+	}
+	if err := checkNumber(v); err != nil {
+		return err
+	}
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(v, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q: %w", v, err)
+		}
+		rv.SetFloat(f)
+		return nil
+	}
+
+	// An integer may have a decimal point if the fraction is zero: 5.00.
+	v = strings.TrimPrefix(v, "+")
+	if whole, frac, ok := strings.Cut(v, "."); ok {
+		if strings.Trim(frac, "0") != "" {
+			return fmt.Errorf("invalid integer %q: has a fraction", v)
+		}
+		v = whole
+		if v == "" || v == "-" {
+			v += "0"
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(v, 10, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q: %w", v, err)
+		}
+		rv.SetUint(u)
+	default:
+		i, err := strconv.ParseInt(v, 10, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q: %w", v, err)
+		}
+		rv.SetInt(i)
+	}
+	return nil
+}
+
+// formatNumber formats the numeric value rv as an NM value.
+func formatNumber(rv reflect.Value) (string, error) {
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", fmt.Errorf("invalid number %v", f)
+		}
+		return strconv.FormatFloat(f, 'f', -1, rv.Type().Bits()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	default:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	}
+}
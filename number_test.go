@@ -0,0 +1,154 @@
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetNumber(t *testing.T) {
+	tests := []struct {
+		in      string
+		into    any
+		want    any
+		wantErr bool
+	}{
+		{"42", new(int), 42, false},
+		{"-42", new(int64), int64(-42), false},
+		{"+7", new(int32), int32(7), false},
+		{"5.00", new(int), 5, false},
+		{"5.5", new(int), 0, true},
+		{"300", new(int8), int8(0), true},
+		{"-1", new(uint), uint(0), true},
+		{"3.25", new(float64), 3.25, false},
+		{".5", new(float64), 0.5, false},
+		{"-0.125", new(float32), float32(-0.125), false},
+		{" 12 ", new(int), 12, false},
+		{"1e5", new(float64), 0.0, true},
+		{"NaN", new(float64), 0.0, true},
+		{"12a", new(int), 0, true},
+		{"-", new(int), 0, true},
+		{".", new(float64), 0.0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			rv := reflect.ValueOf(tt.into).Elem()
+			err := setNumber(rv, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setNumber(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if err == nil && rv.Interface() != tt.want {
+				t.Fatalf("setNumber(%q) got %v, want %v", tt.in, rv.Interface(), tt.want)
+			}
+		})
+	}
+}
+
+type testZNM struct {
+	HL7      struct{}  `hl7:",name=ZNM,type=s"`
+	Count    int       `hl7:"1"`
+	Value    float64   `hl7:"2"`
+	Zero     *int      `hl7:"3"`
+	Repeat   []int64   `hl7:"4"`
+	Optional *float64  `hl7:"5"`
+	Ratio    []float32 `hl7:"6"`
+}
+
+func TestNumberCodec(t *testing.T) {
+	reg := newTestRegistry(testZNM{})
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZNM|3|98.60|0|1~2~-3||0.5")
+
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZNM)
+	if z.Count != 3 || z.Value != 98.6 || z.Zero == nil || *z.Zero != 0 || z.Optional != nil {
+		t.Fatalf("got %+v", z)
+	}
+	if !reflect.DeepEqual(z.Repeat, []int64{1, 2, -3}) {
+		t.Fatalf("got repeat %v", z.Repeat)
+	}
+
+	bb, err := NewEncoder(nil).Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "ZNM|3|98.6|0|1~2~-3||0.5"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	raw = []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZNM|three")
+	if _, err := d.DecodeList(raw); err == nil || !strings.Contains(err.Error(), `invalid number "three"`) {
+		t.Fatalf("expected number error, got %v", err)
+	}
+}
+
+func TestNumberZero(t *testing.T) {
+	zero := 0
+	fzero := 0.0
+	tests := []struct {
+		name string
+		in   testZNM
+		want string
+	}{
+		{"int and float64 zero are not sent", testZNM{Count: 0, Value: 0, Ratio: []float32{1}}, "ZNM||||||1"},
+		{"pointer zero is sent", testZNM{Zero: &zero, Optional: &fzero}, "ZNM|||0||0|"},
+		{"zero in a repetition is sent", testZNM{Count: 1, Repeat: []int64{0, 2, 0}, Ratio: []float32{0}}, "ZNM|1|||0~2~0||0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bb, err := NewEncoder(nil).Encode(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.TrimRight(string(bb), "\r"); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// A 0 received in a non-pointer field decodes as 0, the same as an empty value.
+	reg := newTestRegistry(testZNM{})
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList([]byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZNM|0|0.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z := list[1].(*testZNM); z.Count != 0 || z.Value != 0 {
+		t.Fatalf("got %+v", z)
+	}
+}
+
+type testZSI struct {
+	HL7   struct{} `hl7:",name=ZSI,type=s"`
+	SetID int      `hl7:"1,seq"`
+	Text  string   `hl7:"2"`
+}
+
+func TestSetIDInt(t *testing.T) {
+	bb, err := NewEncoder(nil).Encode([]testZSI{{Text: "a"}, {SetID: 7, Text: "b"}, {Text: "c"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "ZSI|1|a\rZSI|7|b\rZSI|3|c"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	reg := newTestRegistry(testZSI{})
+	d := NewDecoder(reg, &DecodeOption{ErrorZSegment: true})
+	list, err := d.DecodeList([]byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZSI|2|b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := list[1].(*testZSI).SetID; got != 2 {
+		t.Fatalf("got set ID %d", got)
+	}
+	_, err = d.DecodeList([]byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZSI|-2|b"))
+	if err == nil || !strings.Contains(err.Error(), "invalid set ID") {
+		t.Fatalf("expected set ID error, got %v", err)
+	}
+}
@@ -0,0 +1,301 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Abnormal flags of an observation (OBX-8), from HL7 table 0078.
+const (
+	FlagLow              = "L"  // Below low normal.
+	FlagHigh             = "H"  // Above high normal.
+	FlagCriticalLow      = "LL" // Below lower panic limits.
+	FlagCriticalHigh     = "HH" // Above upper panic limits.
+	FlagBelowScale       = "<"  // Below absolute low, off low scale on instrument.
+	FlagAboveScale       = ">"  // Above absolute high, off high scale on instrument.
+	FlagNormal           = "N"  // Normal, applies to non-numeric results.
+	FlagAbnormal         = "A"  // Abnormal, applies to non-numeric results.
+	FlagCriticalAbnormal = "AA" // Very abnormal, applies to non-numeric results.
+	FlagSignificantUp    = "U"  // Significant change up.
+	FlagSignificantDown  = "D"  // Significant change down.
+	FlagBetter           = "B"  // Better, use when direction is not relevant.
+	FlagWorse            = "W"  // Worse, use when direction is not relevant.
+	FlagSusceptible      = "S"  // Susceptible, for microbiology susceptibilities only.
+	FlagResistant        = "R"  // Resistant.
+	FlagIntermediate     = "I"  // Intermediate.
+	FlagModerately       = "MS" // Moderately susceptible.
+	FlagVerySusceptible  = "VS" // Very susceptible.
+)
+
+// Units is the units of an observation (OBX-6).
+type Units struct {
+	Identifier   string // Such as mg/dL.
+	Text         string
+	CodingSystem string // Such as UCUM, or ISO+ for ISO 2955 units.
+}
+
+// ReferenceRange is a numeric reference range of an observation (OBX-7).
+// A range of the form "low-high" includes both limits, while "> low" and "< high" exclude the limit.
+type ReferenceRange struct {
+	Low           Decimal // Lower limit, not set if the range has none.
+	High          Decimal // Upper limit, not set if the range has none.
+	LowExclusive  bool    // The value must be above, not equal to, the lower limit.
+	HighExclusive bool    // The value must be below, not equal to, the upper limit.
+}
+
+// ParseReferenceRange parses a numeric reference range, such as "3.5-5.0", "-2 - 2", "> 60", "<=10", or "<0.5".
+// An empty value and text ranges, such as "negative", return an error.
+func ParseReferenceRange(v string) (ReferenceRange, error) {
+	var r ReferenceRange
+	s := strings.TrimSpace(v)
+	if len(s) == 0 {
+		return r, fmt.Errorf("empty reference range")
+	}
+	var err error
+	switch {
+	case strings.HasPrefix(s, ">="), strings.HasPrefix(s, "<="):
+		r.Low, err = ParseDecimal(strings.TrimSpace(s[2:]))
+		if s[0] == '<' {
+			r.High, r.Low = r.Low, Decimal{}
+		}
+	case s[0] == '>' || s[0] == '<':
+		r.Low, err = ParseDecimal(strings.TrimSpace(s[1:]))
+		r.LowExclusive = true
+		if s[0] == '<' {
+			r.High, r.Low = r.Low, Decimal{}
+			r.HighExclusive, r.LowExclusive = true, false
+		}
+	default:
+		// Find the dash between the limits, after a leading sign of the lower limit.
+		i := strings.Index(s[1:], "-")
+		if i < 0 {
+			return r, fmt.Errorf("reference range %q is not numeric", v)
+		}
+		i++
+		r.Low, err = ParseDecimal(strings.TrimSpace(s[:i]))
+		if err == nil {
+			r.High, err = ParseDecimal(strings.TrimSpace(s[i+1:]))
+		}
+		if err == nil && r.Low.Cmp(r.High) > 0 {
+			err = fmt.Errorf("lower limit above upper limit")
+		}
+	}
+	if err != nil {
+		return ReferenceRange{}, fmt.Errorf("reference range %q: %w", v, err)
+	}
+	return r, nil
+}
+
+// Compare returns -1 if the value is below the range, +1 if above, and 0 if within it.
+func (r ReferenceRange) Compare(v Decimal) int {
+	if !r.Low.IsZero() {
+		c := v.Cmp(r.Low)
+		if c < 0 || c == 0 && r.LowExclusive {
+			return -1
+		}
+	}
+	if !r.High.IsZero() {
+		c := v.Cmp(r.High)
+		if c > 0 || c == 0 && r.HighExclusive {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Flag returns the abnormal flag for the value: FlagLow, FlagHigh, or FlagNormal.
+func (r ReferenceRange) Flag(v Decimal) string {
+	switch r.Compare(v) {
+	case -1:
+		return FlagLow
+	case 1:
+		return FlagHigh
+	}
+	return FlagNormal
+}
+
+// String returns the range in the OBX-7 form.
+func (r ReferenceRange) String() string {
+	switch {
+	case r.High.IsZero():
+		if r.LowExclusive {
+			return ">" + r.Low.String()
+		}
+		return ">=" + r.Low.String()
+	case r.Low.IsZero():
+		if r.HighExclusive {
+			return "<" + r.High.String()
+		}
+		return "<=" + r.High.String()
+	}
+	return r.Low.String() + "-" + r.High.String()
+}
+
+// ObservationUnits returns the units (OBX-6) of the OBX segment. Versions before 2.3 send only the identifier.
+func ObservationUnits(obx any) (Units, error) {
+	rv, err := obxValue(obx)
+	if err != nil {
+		return Units{}, err
+	}
+	_, fv, _, ok := fieldByOrder(rv, 6)
+	if !ok {
+		return Units{}, nil
+	}
+	return unitsValue(fv), nil
+}
+
+func unitsValue(fv reflect.Value) Units {
+	fv = reflect.Indirect(fv)
+	switch fv.Kind() {
+	case reflect.String:
+		return Units{Identifier: fv.String()}
+	case reflect.Struct:
+		return Units{Identifier: stringField(fv, 1), Text: stringField(fv, 2), CodingSystem: stringField(fv, 3)}
+	}
+	return Units{}
+}
+
+// UnitChecker checks observation units, such as against UCUM for electronic lab reporting.
+// The checker is supplied by the user, as this package does not include a unit database.
+type UnitChecker interface {
+	// CheckUnits returns the normalized unit identifier, or an error if the units are not valid.
+	// The checker decides which coding systems to check; units of other systems may be returned as is.
+	CheckUnits(u Units) (string, error)
+}
+
+// UnitCheckerFunc is a function that implements UnitChecker.
+type UnitCheckerFunc func(u Units) (string, error)
+
+func (f UnitCheckerFunc) CheckUnits(u Units) (string, error) {
+	return f(u)
+}
+
+// NormalizeUnits sets the units identifier (OBX-6.1) of each OBX segment in the message to the value
+// normalized by the checker. The message may be a trigger, a segment list, or a pointer to an OBX segment.
+// Invalid units are returned as a ValidationError and left unchanged.
+func NormalizeUnits(message any, uc UnitChecker) error {
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+	var ve ValidationError
+	for _, rv := range m.segs {
+		if segmentName(rv) != "OBX" {
+			continue
+		}
+		ft, fv, _, ok := fieldByOrder(rv, 6)
+		if !ok {
+			continue
+		}
+		u := unitsValue(fv)
+		if len(u.Identifier) == 0 {
+			continue
+		}
+		normal, err := uc.CheckUnits(u)
+		if err != nil {
+			ve = append(ve, &FieldError{Segment: "OBX", Position: []int{6}, Name: ft.Name, Err: fmt.Errorf("%w %q: %v", ErrUnits, u.Identifier, err)})
+			continue
+		}
+		if normal == u.Identifier {
+			continue
+		}
+		fv = reflect.Indirect(fv)
+		if fv.Kind() == reflect.Struct {
+			_, fv, _, _ = fieldByOrder(fv, 1)
+		}
+		if !fv.CanSet() {
+			return fmt.Errorf("OBX-6 of %v cannot be set, pass pointers to the segments", rv.Type())
+		}
+		fv.SetString(normal)
+	}
+	if len(ve) > 0 {
+		return ve
+	}
+	return nil
+}
+
+// ObservationRange returns the numeric reference range (OBX-7) of the OBX segment.
+func ObservationRange(obx any) (ReferenceRange, error) {
+	rv, err := obxValue(obx)
+	if err != nil {
+		return ReferenceRange{}, err
+	}
+	return ParseReferenceRange(stringField(rv, 7))
+}
+
+// AbnormalFlags returns the abnormal flags (OBX-8) of the OBX segment, such as FlagHigh.
+func AbnormalFlags(obx any) ([]string, error) {
+	rv, err := obxValue(obx)
+	if err != nil {
+		return nil, err
+	}
+	_, fv, _, ok := fieldByOrder(rv, 8)
+	if !ok {
+		return nil, nil
+	}
+	values := []reflect.Value{fv}
+	if fv.Kind() == reflect.Slice {
+		values = values[:0]
+		for i := 0; i < fv.Len(); i++ {
+			values = append(values, fv.Index(i))
+		}
+	}
+	var list []string
+	for _, v := range values {
+		v = reflect.Indirect(v)
+		var flag string
+		switch v.Kind() {
+		case reflect.String:
+			flag = v.String()
+		case reflect.Struct:
+			flag = stringField(v, 1)
+		}
+		if len(flag) > 0 {
+			list = append(list, flag)
+		}
+	}
+	return list, nil
+}
+
+// NumericValue returns the first observation value (OBX-5) of the OBX segment as a number,
+// such as for an NM value type.
+func NumericValue(obx any) (Decimal, error) {
+	rv, err := obxValue(obx)
+	if err != nil {
+		return Decimal{}, err
+	}
+	_, fv, _, ok := fieldByOrder(rv, 5)
+	if !ok {
+		return Decimal{}, fmt.Errorf("OBX-5 not found in %v", rv.Type())
+	}
+	if fv.Kind() == reflect.Slice {
+		if fv.Len() == 0 {
+			return Decimal{}, fmt.Errorf("OBX-5 is empty")
+		}
+		fv = fv.Index(0)
+	}
+	for fv.Kind() == reflect.Interface || fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return Decimal{}, fmt.Errorf("OBX-5 is empty")
+		}
+		fv = fv.Elem()
+	}
+	if d, ok := fv.Interface().(Decimal); ok {
+		return d, nil
+	}
+	if fv.Kind() != reflect.String {
+		return Decimal{}, fmt.Errorf("OBX-5 %v is not a number", fv.Type())
+	}
+	d, err := ParseDecimal(strings.TrimSpace(fv.String()))
+	if err != nil {
+		return Decimal{}, fmt.Errorf("OBX-5: %w", err)
+	}
+	return d, nil
+}
+
+func obxValue(obx any) (reflect.Value, error) {
+	rv := reflect.Indirect(reflect.ValueOf(obx))
+	if rv.Kind() != reflect.Struct || segmentName(rv) != "OBX" {
+		return reflect.Value{}, fmt.Errorf("%T is not an OBX segment", obx)
+	}
+	return rv, nil
+}
@@ -0,0 +1,128 @@
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	v210 "github.com/kardianos/hl7/h210"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestReferenceRange(t *testing.T) {
+	list := []struct {
+		v      string
+		str    string
+		values map[string]int
+	}{
+		{"3.5-5.0", "3.5-5.0", map[string]int{"3.4": -1, "3.5": 0, "5.0": 0, "5.01": 1}},
+		{" -2 - 2 ", "-2-2", map[string]int{"-2.1": -1, "-2": 0, "0": 0, "3": 1}},
+		{"-5--1", "-5--1", map[string]int{"-6": -1, "-1": 0, "0": 1}},
+		{"> 60", ">60", map[string]int{"60": -1, "60.1": 0}},
+		{">=60", ">=60", map[string]int{"59": -1, "60": 0}},
+		{"<0.5", "<0.5", map[string]int{"0.49": 0, "0.5": 1}},
+		{"<=10", "<=10", map[string]int{"10": 0, "11": 1}},
+	}
+	for _, item := range list {
+		r, err := ParseReferenceRange(item.v)
+		if err != nil {
+			t.Fatalf("%q: %v", item.v, err)
+		}
+		if got := r.String(); got != item.str {
+			t.Errorf("%q: got %q, want %q", item.v, got, item.str)
+		}
+		for v, want := range item.values {
+			if got := r.Compare(MustDecimal(v)); got != want {
+				t.Errorf("%q: compare %s: got %d, want %d", item.v, v, got, want)
+			}
+		}
+	}
+	for _, v := range []string{"", "negative", "5-3", "1-", "> x"} {
+		if _, err := ParseReferenceRange(v); err == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+func TestObservationInterpretation(t *testing.T) {
+	obx := &v251.OBX{
+		ValueType:        "NM",
+		ObservationValue: []v251.VARIES{v251.NM("11.0")},
+		Units:            &v251.CE{Identifier: "g/dL", Text: "grams per deciliter", NameOfCodingSystem: "UCUM"},
+		ReferencesRange:  "12.0-16.0",
+		AbnormalFlags:    []v251.IS{FlagLow},
+	}
+	units, err := ObservationUnits(obx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if units != (Units{Identifier: "g/dL", Text: "grams per deciliter", CodingSystem: "UCUM"}) {
+		t.Errorf("got %+v", units)
+	}
+	r, err := ObservationRange(obx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NumericValue(obx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flags, err := AbnormalFlags(obx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Flag(v); len(flags) != 1 || got != flags[0] {
+		t.Errorf("got flag %q, sent %q", got, flags)
+	}
+
+	old := v210.OBX{Units: "mg", AbnormalFlags: []v210.ST{FlagHigh, FlagSignificantUp}}
+	if units, _ := ObservationUnits(old); units.Identifier != "mg" {
+		t.Errorf("got %+v", units)
+	}
+	if flags, _ := AbnormalFlags(old); len(flags) != 2 || flags[1] != FlagSignificantUp {
+		t.Errorf("got %q", flags)
+	}
+	if _, err := NumericValue(&v251.OBX{ObservationValue: []v251.VARIES{v251.ST("high")}}); err == nil {
+		t.Error("expected not a number error")
+	}
+	if _, err := ObservationUnits(&v251.OBR{}); err == nil {
+		t.Error("expected not an OBX segment error")
+	}
+}
+
+func TestUnitChecker(t *testing.T) {
+	// A stand in for a UCUM unit database.
+	ucum := UnitCheckerFunc(func(u Units) (string, error) {
+		if u.CodingSystem != "UCUM" {
+			return u.Identifier, nil
+		}
+		for _, unit := range []string{"mg/dL", "g/dL", "10*3/uL"} {
+			if strings.EqualFold(unit, u.Identifier) {
+				return unit, nil
+			}
+		}
+		return "", fmt.Errorf("not a UCUM unit")
+	})
+	list := []any{
+		&v251.OBX{SetID: "1", ObservationIdentifier: v251.CE{Identifier: "GLU"}, ObservationResultStatus: "F", Units: &v251.CE{Identifier: "MG/DL", NameOfCodingSystem: "UCUM"}},
+		&v251.OBX{SetID: "2", ObservationIdentifier: v251.CE{Identifier: "HGB"}, ObservationResultStatus: "F", Units: &v251.CE{Identifier: "gm/dl", NameOfCodingSystem: "UCUM"}},
+		&v251.OBX{SetID: "3", ObservationIdentifier: v251.CE{Identifier: "WBC"}, ObservationResultStatus: "F", Units: &v251.CE{Identifier: "K/uL", NameOfCodingSystem: "L"}},
+		&v251.OBX{SetID: "4", ObservationIdentifier: v251.CE{Identifier: "NOTE"}, ObservationResultStatus: "F"},
+	}
+	check := func(err error) {
+		t.Helper()
+		var ve ValidationError
+		if !errors.As(err, &ve) || len(ve) != 1 || !errors.Is(ve[0], ErrUnits) || ve[0].Location() != "OBX-6" {
+			t.Fatalf("got %v", err)
+		}
+	}
+	check(Validate(list, &ValidateOption{Units: ucum}))
+	check(NormalizeUnits(list, ucum))
+	if got := list[0].(*v251.OBX).Units.Identifier; got != "mg/dL" {
+		t.Errorf("got %q", got)
+	}
+	if got := list[1].(*v251.OBX).Units.Identifier; got != "gm/dl" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,328 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EncodeJSON encodes the message as a JSON object keyed by the HL7 path of each value, such as
+// {"PID-5.1": "Doe", "PID-5.2": "Jane"}, for indexing and logging pipelines. Keys are in message order.
+//
+// A field with more than one repetition has an array with a value for each repetition, such as
+// {"PID-3.1": ["123", "456"]}. A segment after the first with the same name is numbered, such as OBX[2]-5,
+// and a segment without values is written by name alone, such as {"EVN": ""}.
+// The message is a trigger or a segment list, such as from DecodeList.
+func (e *Encoder) EncodeJSON(message any) ([]byte, error) {
+	root, err := e.tree(message)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	for i, pv := range treePaths(root) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		err := enc.Encode(pv.path)
+		if err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1) // Encode ends each value with a new line.
+		buf.WriteByte(':')
+		var value any = pv.values
+		if len(pv.values) == 1 {
+			value = pv.values[0]
+		}
+		err = enc.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// pathValue is the value of each repetition at an HL7 path.
+type pathValue struct {
+	path   string
+	values []string
+}
+
+// treePaths returns the values of the segments of the message tree by path, in message order.
+func treePaths(root node) []pathValue {
+	var list []pathValue
+	count := map[string]int{}
+	var walk func(nodes []node)
+	walk = func(nodes []node) {
+		for _, n := range nodes {
+			name := n.name()
+			if strings.Contains(name, ".") {
+				walk(n.Nodes)
+				continue
+			}
+			count[name]++
+			if c := count[name]; c > 1 {
+				name += "[" + strconv.Itoa(c) + "]"
+			}
+			paths := segmentPaths(name, n.Nodes)
+			if len(paths) == 0 {
+				// An empty segment is kept by its name alone.
+				paths = []pathValue{{path: name, values: []string{""}}}
+			}
+			list = append(list, paths...)
+		}
+	}
+	walk(root.Nodes)
+	return list
+}
+
+// segmentPaths returns the values of the field nodes of a segment by path. The repetitions of a field are consecutive nodes.
+func segmentPaths(segment string, fields []node) []pathValue {
+	var list []pathValue
+	for start := 0; start < len(fields); {
+		end := start + 1
+		for end < len(fields) && fields[end].name() == fields[start].name() {
+			end++
+		}
+		reps := fields[start:end]
+		field := segment + "-" + fields[start].name()[strings.LastIndexByte(fields[start].name(), '.')+1:]
+
+		// Each component path has a value for each repetition.
+		index := map[string]int{}
+		for i, rep := range reps {
+			for _, l := range leafPaths("", rep) {
+				at, ok := index[l.path]
+				if !ok {
+					at = len(list)
+					index[l.path] = at
+					list = append(list, pathValue{path: field + l.path, values: make([]string, len(reps))})
+				}
+				list[at].values[i] = l.values[0]
+			}
+		}
+		start = end
+	}
+	return list
+}
+
+// leafPaths returns the text of each leaf under the node, with the path of its component and subcomponent, such as .4.1.
+func leafPaths(prefix string, n node) []pathValue {
+	if len(n.Nodes) == 0 {
+		return []pathValue{{path: prefix, values: []string{n.Text}}}
+	}
+	var list []pathValue
+	for _, c := range n.Nodes {
+		name := c.name()
+		list = append(list, leafPaths(prefix+"."+name[strings.LastIndexByte(name, '.')+1:], c)...)
+	}
+	return list
+}
+
+// DecodeJSON decodes a JSON object keyed by HL7 path, as written by EncodeJSON, and returns a final trigger
+// with all segments grouped. A value is a string, a number, or an array with a value for each repetition,
+// such as {"PID-3.1": ["123", "456"]}. Segments are in the order their first path appears.
+func (d *Decoder) DecodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("json: expected an object of paths")
+	}
+	b := &pathBuilder{index: map[string]*pathSegment{}}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("json: %w", err)
+		}
+		key := tok.(string)
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return nil, fmt.Errorf("json: %s: %w", key, err)
+		}
+		if err := b.set(key, v); err != nil {
+			return nil, fmt.Errorf("json: %w", err)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	lines, err := treeSegments(b.nodes())
+	if err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	return d.Decode(lines)
+}
+
+// pathBuilder collects the values of paths into segments.
+type pathBuilder struct {
+	list  []*pathSegment
+	index map[string]*pathSegment // By segment name and number, such as OBX[2].
+}
+
+type pathSegment struct {
+	name   string
+	values map[[3]int][]string // By field, component, and subcomponent.
+}
+
+// parsePath returns the segment, with its number if after the first, and the positions of a path such as OBX[2]-5.1.
+// A path of a segment alone, such as EVN, has no positions.
+func parsePath(path string) (string, [3]int, error) {
+	var pos [3]int
+	seg, rest, ok := strings.Cut(path, "-")
+	if len(seg) == 0 || (ok && len(rest) == 0) {
+		return "", pos, fmt.Errorf("path %q: missing segment or field", path)
+	}
+	name, num, numbered := strings.Cut(seg, "[")
+	if numbered {
+		n, err := strconv.Atoi(strings.TrimSuffix(num, "]"))
+		if err != nil || n < 1 || !strings.HasSuffix(num, "]") {
+			return "", pos, fmt.Errorf("path %q: invalid segment number", path)
+		}
+		seg = name
+		if n > 1 {
+			seg = name + "[" + strconv.Itoa(n) + "]"
+		}
+	}
+	if len(name) != 3 {
+		return "", pos, fmt.Errorf("path %q: invalid segment name", path)
+	}
+	if !ok {
+		return seg, pos, nil
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) > len(pos) {
+		return "", pos, fmt.Errorf("path %q: too many positions", path)
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 {
+			return "", pos, fmt.Errorf("path %q: invalid position %q", path, p)
+		}
+		pos[i] = n
+	}
+	return seg, pos, nil
+}
+
+func (b *pathBuilder) set(path string, v any) error {
+	seg, pos, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	var values []string
+	switch v := v.(type) {
+	default:
+		return fmt.Errorf("path %q: value must be a string, number, or array", path)
+	case nil:
+	case string:
+		values = []string{v}
+	case json.Number:
+		values = []string{v.String()}
+	case []any:
+		for _, rep := range v {
+			switch rep := rep.(type) {
+			default:
+				return fmt.Errorf("path %q: repetition must be a string or number", path)
+			case nil:
+				values = append(values, "")
+			case string:
+				values = append(values, rep)
+			case json.Number:
+				values = append(values, rep.String())
+			}
+		}
+	}
+	s := b.index[seg]
+	if s == nil {
+		name, _, _ := strings.Cut(seg, "[")
+		s = &pathSegment{name: name, values: map[[3]int][]string{}}
+		b.index[seg] = s
+		b.list = append(b.list, s)
+	}
+	if pos[0] == 0 {
+		return nil
+	}
+	// A field value is the first component, and a component value the first subcomponent,
+	// except for the field separator and encoding characters of a header segment.
+	header := s.name == "MSH" || s.name == "BHS" || s.name == "FHS"
+	if !header || pos[0] > 2 {
+		for i := range pos {
+			if pos[i] == 0 {
+				pos[i] = 1
+			}
+		}
+	}
+	s.values[pos] = values
+	return nil
+}
+
+// nodes returns the segment nodes of the collected paths.
+func (b *pathBuilder) nodes() []node {
+	nodes := make([]node, len(b.list))
+	for i, s := range b.list {
+		keys := make([][3]int, 0, len(s.values))
+		for k := range s.values {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			a, b := keys[i], keys[j]
+			for x := range a {
+				if a[x] != b[x] {
+					return a[x] < b[x]
+				}
+			}
+			return false
+		})
+		seg := newNode(s.name, "")
+		for start := 0; start < len(keys); {
+			field := keys[start][0]
+			end := start
+			reps := 0
+			for end < len(keys) && keys[end][0] == field {
+				if n := len(s.values[keys[end]]); n > reps {
+					reps = n
+				}
+				end++
+			}
+			name := s.name + "." + strconv.Itoa(field)
+			for r := 0; r < reps; r++ {
+				seg.Nodes = append(seg.Nodes, fieldNode(name, keys[start:end], s.values, r))
+			}
+			start = end
+		}
+		nodes[i] = seg
+	}
+	return nodes
+}
+
+// fieldNode returns the node of a field repetition from the values of its sorted component keys.
+func fieldNode(name string, keys [][3]int, values map[[3]int][]string, rep int) node {
+	text := func(k [3]int) string {
+		if v := values[k]; rep < len(v) {
+			return v[rep]
+		}
+		return ""
+	}
+	if keys[0][1] == 0 {
+		return newNode(name, text(keys[0]))
+	}
+	n := newNode(name, "")
+	for _, k := range keys {
+		c := strconv.Itoa(k[1])
+		if l := len(n.Nodes); l == 0 || n.Nodes[l-1].name() != "."+c {
+			n.Nodes = append(n.Nodes, newNode("."+c, ""))
+		}
+		comp := &n.Nodes[len(n.Nodes)-1]
+		comp.Nodes = append(comp.Nodes, newNode("."+strconv.Itoa(k[2]), text(k)))
+	}
+	return n
+}
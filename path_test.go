@@ -0,0 +1,147 @@
+package hl7
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||123^^^MRN&1.2.3&ISO^MR~456||Doe^Jane||19800101|F`,
+		`OBR|1|A1||CBC^Complete blood count^L`,
+		`OBX|1|NM|WBC^White cells^L||7.2|10*3/uL^^UCUM|||||F`,
+		`OBX|2|ST|NOTE^Note^L||Smith \T\ Sons||||||F`,
+	}, "\r")
+	msg, err := NewDecoder(v251.Registry, nil).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bb, err := NewEncoder(nil).EncodeJSON(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`{"MSH-1":"|","MSH-2":"^~\\&","MSH-3.1":"LAB","MSH-4.1":"HOSP","MSH-7":"20240102030405"`,
+		`"MSH-9.1":"ORU","MSH-9.2":"R01","MSH-9.3":"ORU_R01","MSH-10":"1","MSH-11.1":"P","MSH-12.1":"2.5.1"`,
+		`"PID-1":"1","PID-3.1":["123","456"],"PID-3.4.1":["MRN",""],"PID-3.4.2":["1.2.3",""],"PID-3.4.3":["ISO",""],"PID-3.5":["MR",""]`,
+		`"PID-5.1":"Doe","PID-5.2":"Jane","PID-7":"19800101000000","PID-8":"F"`,
+		`"OBR-1":"1","OBR-2.1":"A1","OBR-4.1":"CBC","OBR-4.2":"Complete blood count","OBR-4.3":"L"`,
+		`"OBX-1":"1","OBX-2":"NM","OBX-3.1":"WBC","OBX-3.2":"White cells","OBX-3.3":"L","OBX-5":"7.2","OBX-6.1":"10*3/uL","OBX-6.3":"UCUM","OBX-11":"F"`,
+		`"OBX[2]-1":"2","OBX[2]-2":"ST","OBX[2]-3.1":"NOTE","OBX[2]-3.2":"Note","OBX[2]-3.3":"L","OBX[2]-5":"Smith & Sons","OBX[2]-11":"F"}`,
+	}, ",")
+	if string(bb) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", bb, want)
+	}
+	if !json.Valid(bb) {
+		t.Error("invalid JSON")
+	}
+
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := NewEncoder(nil).EncodeJSON(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(lb) != want {
+		t.Errorf("got segment list:\n%s", lb)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		t.Run(name, func(t *testing.T) {
+			bb, err := os.ReadFile(filepath.Join(fsDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The encoded bytes are reused by the next Encode call.
+			want, err := NewEncoder(nil).Encode(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			js, err := e.EncodeJSON(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			back, err := d.DecodeJSON(js)
+			if err != nil {
+				t.Fatalf("%v\n%s", err, js)
+			}
+			got, err := e.Encode(back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Fatalf("got:\n%s\njson:\n%s", lineDiff(got, want), js)
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	js := `{
+		"MSH-9.1": "ADT", "MSH-9.2": "A01", "MSH-9.3": "ADT_A01", "MSH-10": 42, "MSH-12": "2.5.1",
+		"EVN-1": "A01",
+		"PID-3.1": ["123", "456"], "PID-3.4.1": ["MRN", null], "PID-3.5": "MR",
+		"PID-5": "Smith & Sons",
+		"PV1-2": "I"
+	}`
+	msg, err := NewDecoder(v251.Registry, nil).DecodeJSON([]byte(js))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`MSH|^~\&|||||||ADT^A01^ADT_A01|42||2.5.1`,
+		`EVN|A01`,
+		`PID|1||123^^^MRN^MR~456||Smith \T\ Sons`,
+		`PV1|1|I`,
+	}, "\r")
+	if string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	for _, bad := range []string{
+		`[]`,
+		`{"-1": "1"}`,
+		`{"PID-": "1"}`,
+		`{"PIDX-1": "1"}`,
+		`{"PID[0]-1": "1"}`,
+		`{"PID-1.0": "1"}`,
+		`{"PID-1.1.1.1": "1"}`,
+		`{"PID-1": {"a": 1}}`,
+		`{"PID-1": [["a"]]}`,
+		`{"MSH-2": "^~"}`,
+		`{"MSH-9": "ADT"`,
+	} {
+		if _, err := NewDecoder(v251.Registry, nil).DecodeJSON([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+}
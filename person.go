@@ -0,0 +1,236 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Name type codes of a person name (XPN-7), from HL7 table 0200.
+const (
+	NameAlias        = "A" // Alias name.
+	NameBirth        = "B" // Name at birth.
+	NameAdopted      = "C" // Adopted name.
+	NameDisplay      = "D" // Display name.
+	NameLicensing    = "I" // Licensing name.
+	NameLegal        = "L" // Legal name.
+	NameMaiden       = "M" // Maiden name.
+	NameNickname     = "N" // Nickname, "call me" name, or street name.
+	NamePartner      = "P" // Name of partner or spouse.
+	NameRegistered   = "R" // Registered name, of animals.
+	NamePseudonym    = "S" // Coded pseudo-name to ensure anonymity.
+	NameIndigenous   = "T" // Indigenous or tribal community name.
+	NameUnspecified  = "U" // Unspecified.
+	NameArtist       = "K" // Artist or stage name, from v2.7.
+	NameBad          = "BAD"
+	NameNotAvailable = "NAV" // Temporarily unavailable, from v2.7.
+	NameMasked       = "MSK" // Masked, from v2.7.
+)
+
+// PersonName is the person name data type (XPN) of any version, such as a PID-5 repetition.
+type PersonName struct {
+	Family string // XPN-1, the surname.
+	Given  string // XPN-2, the first name.
+	Middle string // XPN-3, second and further given names or initials, separated by spaces.
+	Suffix string // XPN-4, such as Jr. or III.
+	Prefix string // XPN-5, such as Dr.
+	Degree string // XPN-6, such as MD.
+	Type   string // XPN-7 name type code, such as NameLegal.
+}
+
+// ParsePersonName returns the person name of an XPN value of any version, or of a PN value
+// of versions before 2.3. A name sent as a string is the family name.
+func ParsePersonName(xpn any) (PersonName, error) {
+	rv := reflect.Indirect(reflect.ValueOf(xpn))
+	switch rv.Kind() {
+	default:
+		return PersonName{}, fmt.Errorf("%T is not a person name", xpn)
+	case reflect.String:
+		return PersonName{Family: rv.String()}, nil
+	case reflect.Struct:
+	}
+	return PersonName{
+		Family: stringComponent(rv, 1),
+		Given:  stringComponent(rv, 2),
+		Middle: stringComponent(rv, 3),
+		Suffix: stringComponent(rv, 4),
+		Prefix: stringComponent(rv, 5),
+		Degree: stringComponent(rv, 6),
+		Type:   stringComponent(rv, 7),
+	}, nil
+}
+
+// PersonNames returns the person names of each repetition of an XPN field, such as PID-5.
+func PersonNames(field any) ([]PersonName, error) {
+	var list []PersonName
+	err := repetitions(reflect.ValueOf(field), func(rv reflect.Value) error {
+		n, err := ParsePersonName(rv.Interface())
+		if err != nil {
+			return err
+		}
+		list = append(list, n)
+		return nil
+	})
+	return list, err
+}
+
+// LegalName returns the first name of type NameLegal. If no name has a type, the first name is returned,
+// as senders that do not send name types send the legal name first.
+func LegalName(names []PersonName) (PersonName, bool) {
+	if n, ok := NameOfType(names, NameLegal); ok {
+		return n, true
+	}
+	for _, n := range names {
+		if len(n.Type) > 0 {
+			return PersonName{}, false
+		}
+	}
+	if len(names) == 0 {
+		return PersonName{}, false
+	}
+	return names[0], true
+}
+
+// NameOfType returns the first name with the name type code, such as NameAlias.
+func NameOfType(names []PersonName, nameType string) (PersonName, bool) {
+	for _, n := range names {
+		if n.Type == nameType {
+			return n, true
+		}
+	}
+	return PersonName{}, false
+}
+
+// nameSuffixes are recognized by ParseDisplayName after the family name.
+var nameSuffixes = map[string]bool{
+	"JR": true, "JR.": true, "SR": true, "SR.": true,
+	"II": true, "III": true, "IV": true, "V": true,
+}
+
+// DisplayName returns the name in the form "Family Suffix, Given Middle", leaving out empty parts.
+func (n PersonName) DisplayName() string {
+	last := strings.TrimSpace(n.Family + " " + n.Suffix)
+	first := strings.TrimSpace(n.Given + " " + n.Middle)
+	switch {
+	case len(first) == 0:
+		return last
+	case len(last) == 0:
+		return first
+	}
+	return last + ", " + first
+}
+
+// ParseDisplayName parses a name in the form returned by DisplayName.
+// A last word of the family part is taken as the suffix if it is a common suffix, such as Jr. or III.
+// A name without a comma is taken as "Given Middle Family".
+func ParseDisplayName(s string) PersonName {
+	var n PersonName
+	last, first, ok := strings.Cut(s, ",")
+	if !ok {
+		words := strings.Fields(s)
+		switch len(words) {
+		case 0:
+			return n
+		case 1:
+			n.Family = words[0]
+			return n
+		}
+		n.Given = words[0]
+		n.Middle = strings.Join(words[1:len(words)-1], " ")
+		n.Family = words[len(words)-1]
+		return n
+	}
+	words := strings.Fields(last)
+	if len(words) > 1 && nameSuffixes[strings.ToUpper(words[len(words)-1])] {
+		n.Suffix = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	n.Family = strings.Join(words, " ")
+	words = strings.Fields(first)
+	if len(words) > 0 {
+		n.Given = words[0]
+		n.Middle = strings.Join(words[1:], " ")
+	}
+	return n
+}
+
+// Set sets the components of an XPN value of any version, which must be a pointer.
+// Components the version does not define must be empty.
+func (n PersonName) Set(xpn any) error {
+	rv := reflect.ValueOf(xpn)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to a person name", xpn)
+	}
+	return setComponents(rv.Elem(), []string{n.Family, n.Given, n.Middle, n.Suffix, n.Prefix, n.Degree, n.Type})
+}
+
+// stringComponent returns the string value of a component, or of the first subcomponent
+// when the component is a data type, such as the FN surname.
+func stringComponent(rv reflect.Value, order int) string {
+	_, fv, _, ok := fieldByOrder(rv, order)
+	if !ok {
+		return ""
+	}
+	fv = reflect.Indirect(fv)
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String()
+	case reflect.Struct:
+		return stringField(fv, 1)
+	}
+	return ""
+}
+
+// setComponents sets the string components of the data type struct, starting at component 1.
+// Empty values of components the type does not define are skipped.
+func setComponents(rv reflect.Value, values []string) error {
+	for i, v := range values {
+		_, fv, _, ok := fieldByOrder(rv, i+1)
+		if !ok {
+			if len(v) == 0 {
+				continue
+			}
+			return fmt.Errorf("%v has no component %d", rv.Type(), i+1)
+		}
+		if fv.Kind() == reflect.Pointer {
+			if len(v) == 0 {
+				continue
+			}
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Struct {
+			_, fv, _, ok = fieldByOrder(fv, 1)
+			if !ok {
+				return fmt.Errorf("%v component %d has no subcomponent 1", rv.Type(), i+1)
+			}
+		}
+		if fv.Kind() != reflect.String {
+			return fmt.Errorf("%v component %d is not a string", rv.Type(), i+1)
+		}
+		fv.SetString(v)
+	}
+	return nil
+}
+
+// repetitions calls fn with each repetition of a field value, or the value itself if it does not repeat.
+func repetitions(rv reflect.Value, fn func(rv reflect.Value) error) error {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice {
+		return fn(rv)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		err := fn(rv.Index(i))
+		if err != nil {
+			return fmt.Errorf("repetition %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package hl7
+
+import (
+	"testing"
+
+	v210 "github.com/kardianos/hl7/h210"
+	v231 "github.com/kardianos/hl7/h231"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestPersonName(t *testing.T) {
+	pid := &v251.PID{PatientName: []v251.XPN{
+		{FamilyName: "Smith", GivenName: "Jan", NameTypeCode: NameAlias},
+		{FamilyName: "Doe", GivenName: "Jane", SecondAndFurtherGivenNamesOrInitialsThereof: "Q", Suffix: "Jr.", NameTypeCode: NameLegal},
+	}}
+	names, err := PersonNames(pid.PatientName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legal, ok := LegalName(names)
+	if !ok {
+		t.Fatal("legal name not found")
+	}
+	if got, want := legal.DisplayName(), "Doe Jr., Jane Q"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := ParseDisplayName(legal.DisplayName()); got != (PersonName{Family: "Doe", Given: "Jane", Middle: "Q", Suffix: "Jr."}) {
+		t.Errorf("got %+v", got)
+	}
+	if alias, ok := NameOfType(names, NameAlias); !ok || alias.DisplayName() != "Smith, Jan" {
+		t.Errorf("got %+v", alias)
+	}
+
+	// Without name types the first name is the legal name.
+	if n, ok := LegalName([]PersonName{{Family: "A"}, {Family: "B"}}); !ok || n.Family != "A" {
+		t.Errorf("got %+v", n)
+	}
+	if _, ok := LegalName([]PersonName{{Family: "A", Type: NameAlias}}); ok {
+		t.Error("expected no legal name")
+	}
+
+	for display, want := range map[string]PersonName{
+		"Van Der Berg, Anna Maria": {Family: "Van Der Berg", Given: "Anna", Middle: "Maria"},
+		"Anna M Berg":              {Family: "Berg", Given: "Anna", Middle: "M"},
+		"Cher":                     {Family: "Cher"},
+		"King III, Henry":          {Family: "King", Given: "Henry", Suffix: "III"},
+	} {
+		if got := ParseDisplayName(display); got != want {
+			t.Errorf("%q: got %+v", display, got)
+		}
+	}
+
+	// Construct names of other versions.
+	var xpn v231.XPN
+	err = PersonName{Family: "Doe", Given: "John", Type: NameLegal}.Set(&xpn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xpn.FamilyNameLastNamePrefix != "Doe" || xpn.GivenName != "John" || xpn.NameTypeCode != NameLegal {
+		t.Errorf("got %+v", xpn)
+	}
+	if n, err := ParsePersonName((&v210.PID{PatientName: "DOE"}).PatientName); err != nil || n.Family != "DOE" {
+		t.Errorf("got %+v, %v", n, err)
+	}
+}
@@ -0,0 +1,138 @@
+package hl7
+
+// PHICatalogVersion is the version of the PHI catalog, changed when fields are added or removed.
+const PHICatalogVersion = "1"
+
+// PHICategory is a kind of identifier of the HIPAA Safe Harbor method.
+type PHICategory string
+
+const (
+	PHIName          PHICategory = "name"
+	PHIAddress       PHICategory = "address" // Geographic subdivisions smaller than a state, such as a street address or county.
+	PHIDate          PHICategory = "date"    // Dates, other than the year, directly related to the person.
+	PHITelecom       PHICategory = "telecom" // Telephone and fax numbers and email addresses.
+	PHISSN           PHICategory = "ssn"
+	PHIMedicalRecord PHICategory = "medical record"
+	PHIHealthPlan    PHICategory = "health plan"
+	PHIAccount       PHICategory = "account"
+	PHILicense       PHICategory = "license"
+	PHIIdentifier    PHICategory = "identifier" // Other identifying numbers.
+)
+
+// PHIField is a standard field that holds protected health information of a patient or a related person.
+type PHIField struct {
+	Path     string // Such as PID-5.
+	Name     string // Such as Patient Name.
+	Category PHICategory
+}
+
+// phiCatalog lists the PHI fields by segment and field position.
+var phiCatalog = []PHIField{
+	{"PID-2", "Patient ID", PHIMedicalRecord},
+	{"PID-3", "Patient Identifier List", PHIMedicalRecord},
+	{"PID-4", "Alternate Patient ID", PHIMedicalRecord},
+	{"PID-5", "Patient Name", PHIName},
+	{"PID-6", "Mother's Maiden Name", PHIName},
+	{"PID-7", "Date/Time of Birth", PHIDate},
+	{"PID-9", "Patient Alias", PHIName},
+	{"PID-11", "Patient Address", PHIAddress},
+	{"PID-12", "County Code", PHIAddress},
+	{"PID-13", "Phone Number - Home", PHITelecom},
+	{"PID-14", "Phone Number - Business", PHITelecom},
+	{"PID-18", "Patient Account Number", PHIAccount},
+	{"PID-19", "SSN Number - Patient", PHISSN},
+	{"PID-20", "Driver's License Number - Patient", PHILicense},
+	{"PID-21", "Mother's Identifier", PHIIdentifier},
+	{"PID-23", "Birth Place", PHIAddress},
+	{"PID-29", "Patient Death Date and Time", PHIDate},
+	{"PID-40", "Patient Telecommunication Information", PHITelecom},
+	{"MRG-1", "Prior Patient Identifier List", PHIMedicalRecord},
+	{"MRG-3", "Prior Patient Account Number", PHIAccount},
+	{"MRG-5", "Prior Visit Number", PHIAccount},
+	{"MRG-7", "Prior Patient Name", PHIName},
+	{"NK1-2", "Name", PHIName},
+	{"NK1-4", "Address", PHIAddress},
+	{"NK1-5", "Phone Number", PHITelecom},
+	{"NK1-6", "Business Phone Number", PHITelecom},
+	{"NK1-16", "Date/Time of Birth", PHIDate},
+	{"NK1-30", "Contact Person's Name", PHIName},
+	{"NK1-31", "Contact Person's Telephone Number", PHITelecom},
+	{"NK1-32", "Contact Person's Address", PHIAddress},
+	{"NK1-33", "Next of Kin/Associated Party's Identifiers", PHIIdentifier},
+	{"NK1-37", "Contact Person Social Security Number", PHISSN},
+	{"EVN-6", "Event Occurred", PHIDate},
+	{"PV1-19", "Visit Number", PHIAccount},
+	{"PV1-44", "Admit Date/Time", PHIDate},
+	{"PV1-45", "Discharge Date/Time", PHIDate},
+	{"PV1-50", "Alternate Visit ID", PHIAccount},
+	{"ACC-1", "Accident Date/Time", PHIDate},
+	{"GT1-2", "Guarantor Number", PHIIdentifier},
+	{"GT1-3", "Guarantor Name", PHIName},
+	{"GT1-5", "Guarantor Address", PHIAddress},
+	{"GT1-6", "Guarantor Ph Num - Home", PHITelecom},
+	{"GT1-7", "Guarantor Ph Num - Business", PHITelecom},
+	{"GT1-8", "Guarantor Date/Time of Birth", PHIDate},
+	{"GT1-12", "Guarantor SSN", PHISSN},
+	{"IN1-16", "Name of Insured", PHIName},
+	{"IN1-18", "Insured's Date of Birth", PHIDate},
+	{"IN1-19", "Insured's Address", PHIAddress},
+	{"IN1-36", "Policy Number", PHIHealthPlan},
+	{"IN1-49", "Insured's ID Number", PHIHealthPlan},
+	{"IN2-1", "Insured's Employee ID", PHIIdentifier},
+	{"IN2-2", "Insured's Social Security Number", PHISSN},
+	{"IN2-6", "Medicare Health Ins Card Number", PHIHealthPlan},
+	{"IN2-8", "Medicaid Case Number", PHIHealthPlan},
+	{"IN2-61", "Patient Member Number", PHIHealthPlan},
+	{"IN2-63", "Insured's Phone Number - Home", PHITelecom},
+	{"OBR-7", "Observation Date/Time", PHIDate},
+	{"OBR-8", "Observation End Date/Time", PHIDate},
+	{"OBR-14", "Specimen Received Date/Time", PHIDate},
+	{"OBX-14", "Date/Time of the Observation", PHIDate},
+	{"SPM-17", "Specimen Collection Date/Time", PHIDate},
+}
+
+// PHIFields returns the standard fields that hold protected health information, oriented to the HIPAA
+// Safe Harbor identifiers, in catalog order. If the registry is set, only the fields of its segments are returned;
+// if nil, all fields are returned. Free text, such as NTE-3 or OBX-5, may also hold PHI and is not listed.
+func PHIFields(r Registry) []PHIField {
+	list := make([]PHIField, 0, len(phiCatalog))
+	for _, f := range phiCatalog {
+		if r != nil && !registryHasField(r, f.Path) {
+			continue
+		}
+		list = append(list, f)
+	}
+	return list
+}
+
+// PHIPaths returns the paths of PHIFields, for Redact.
+func PHIPaths(r Registry) []string {
+	fields := PHIFields(r)
+	paths := make([]string, len(fields))
+	for i, f := range fields {
+		paths[i] = f.Path
+	}
+	return paths
+}
+
+// registryHasField reports if a segment of the registry has the field of the path.
+func registryHasField(r Registry, path string) bool {
+	seg, pos, err := parsePath(path)
+	if err != nil {
+		return false
+	}
+	v, ok := r.Segment()[seg]
+	if !ok {
+		return false
+	}
+	info, err := Describe(v)
+	if err != nil {
+		return false
+	}
+	for _, f := range info.Field {
+		if f.Position == pos[0] {
+			return true
+		}
+	}
+	return false
+}
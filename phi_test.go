@@ -0,0 +1,39 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+	v270 "github.com/kardianos/hl7/h270"
+)
+
+func TestPHIFields(t *testing.T) {
+	all := PHIFields(nil)
+	seen := map[string]bool{}
+	for _, f := range all {
+		if seen[f.Path] {
+			t.Errorf("duplicate path %s", f.Path)
+		}
+		seen[f.Path] = true
+		if _, _, err := parsePath(f.Path); err != nil {
+			t.Error(err)
+		}
+	}
+	if got := len(PHIFields(v270.Registry)); got != len(all) {
+		t.Errorf("got %d fields for 2.7, want %d", got, len(all))
+	}
+	paths := strings.Join(PHIPaths(v251.Registry), " ")
+	if strings.Contains(paths, "PID-40") || !strings.Contains(paths, "PID-19") {
+		t.Errorf("got 2.5.1 paths %s", paths)
+	}
+
+	raw := "MSH|^~\\&|A|B|||||ADT^A01|1|P|2.5.1\rPID|1||123^^^MRN||Doe^Jane||19800101|F|||1 Main St||555-1234|||||ACC1|111-22-3333"
+	got, err := Redact(raw, nil, RedactBlank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "MSH|^~\\&|A|B|||||ADT^A01|1|P|2.5.1\rPID|1||^^^||^|||F|||||||||||"; got != want {
+		t.Errorf("got:\n%q\nwant:\n%q", got, want)
+	}
+}
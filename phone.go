@@ -0,0 +1,178 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Telecommunication use codes of a telephone number (XTN-2), from HL7 table 0201.
+const (
+	UsePrimaryResidence = "PRN" // Primary residence number.
+	UseOtherResidence   = "ORN" // Other residence number.
+	UseWork             = "WPN" // Work number.
+	UseVacationHome     = "VHN" // Vacation home number.
+	UseAnsweringService = "ASN" // Answering service number.
+	UseEmergency        = "EMR" // Emergency number.
+	UseNetwork          = "NET" // Network (email) address.
+	UseBeeper           = "BPN" // Beeper number.
+	UsePersonal         = "PRS" // Personal, from v2.5.
+)
+
+// Telecommunication equipment types of a telephone number (XTN-3), from HL7 table 0202.
+const (
+	EquipmentPhone    = "PH"       // Telephone.
+	EquipmentFax      = "FX"       // Fax.
+	EquipmentModem    = "MD"       // Modem.
+	EquipmentCellular = "CP"       // Cellular or mobile phone.
+	EquipmentBeeper   = "BP"       // Beeper.
+	EquipmentInternet = "Internet" // Internet address, for email.
+	EquipmentX400     = "X.400"    // X.400 email address.
+	EquipmentTDD      = "TDD"      // Telecommunications device for the deaf.
+	EquipmentTTY      = "TTY"      // Teletypewriter.
+)
+
+// Telephone is the extended telecommunication number data type (XTN) of any version, such as a PID-13 repetition.
+type Telephone struct {
+	Formatted   string // XTN-1 number in the deprecated [NN] [(999)]999-9999[X99999][B99999][C any text] form.
+	Use         string // XTN-2 use code, such as UsePrimaryResidence.
+	Equipment   string // XTN-3 equipment type, such as EquipmentCellular.
+	Email       string // XTN-4.
+	CountryCode string // XTN-5.
+	AreaCode    string // XTN-6 area or city code.
+	LocalNumber string // XTN-7.
+	Extension   string // XTN-8.
+	Text        string // XTN-9 any text.
+	Unformatted string // XTN-12 unformatted telephone number, from v2.5.
+}
+
+// ParseTelephone returns the telephone number of an XTN value of any version, or of a TN value,
+// which is the formatted number.
+func ParseTelephone(xtn any) (Telephone, error) {
+	rv := reflect.Indirect(reflect.ValueOf(xtn))
+	switch rv.Kind() {
+	default:
+		return Telephone{}, fmt.Errorf("%T is not a telephone number", xtn)
+	case reflect.String:
+		return Telephone{Formatted: rv.String()}, nil
+	case reflect.Struct:
+	}
+	return Telephone{
+		Formatted:   stringComponent(rv, 1),
+		Use:         stringComponent(rv, 2),
+		Equipment:   stringComponent(rv, 3),
+		Email:       stringComponent(rv, 4),
+		CountryCode: stringComponent(rv, 5),
+		AreaCode:    stringComponent(rv, 6),
+		LocalNumber: stringComponent(rv, 7),
+		Extension:   stringComponent(rv, 8),
+		Text:        stringComponent(rv, 9),
+		Unformatted: stringComponent(rv, 12),
+	}, nil
+}
+
+// Telephones returns the telephone numbers of each repetition of an XTN field, such as PID-13.
+func Telephones(field any) ([]Telephone, error) {
+	var list []Telephone
+	err := repetitions(reflect.ValueOf(field), func(rv reflect.Value) error {
+		t, err := ParseTelephone(rv.Interface())
+		if err != nil {
+			return err
+		}
+		list = append(list, t)
+		return nil
+	})
+	return list, err
+}
+
+// formatted returns the number and extension of the formatted number, without the beeper code and text.
+func (t Telephone) formatted() (number, extension string) {
+	number = t.Formatted
+	for i := 0; i < len(number); i++ {
+		switch number[i] {
+		case 'X', 'x':
+			extension = digits(number[i+1:])
+			return number[:i], extension
+		case 'B', 'b', 'C', 'c':
+			return number[:i], ""
+		}
+	}
+	return number, ""
+}
+
+// Ext returns the extension of the number, from the structured components or the formatted number.
+func (t Telephone) Ext() string {
+	if len(t.Extension) > 0 {
+		return t.Extension
+	}
+	_, ext := t.formatted()
+	return ext
+}
+
+// E164 returns the number in the E.164 form, a plus sign followed by the country code and digits,
+// such as +15555551234. The structured components (XTN-5 to XTN-7) are used when the local number is set,
+// else the formatted number (XTN-1), else the unformatted number (XTN-12).
+// The country code is used for numbers without one; a formatted or unformatted number has one
+// if it starts with a plus sign or, for country code 1, has 11 digits starting with 1.
+// If the number has too few or too many digits or no known country code, false is returned.
+// The extension is not included; see Ext.
+func (t Telephone) E164(countryCode string) (string, bool) {
+	countryCode = digits(countryCode)
+	var number string
+	switch {
+	case len(t.LocalNumber) > 0:
+		cc := digits(t.CountryCode)
+		if len(cc) == 0 {
+			cc = countryCode
+		}
+		if len(cc) == 0 {
+			return "", false
+		}
+		number = "+" + cc + digits(t.AreaCode) + digits(t.LocalNumber)
+	default:
+		f, _ := t.formatted()
+		if len(digits(f)) == 0 {
+			f = t.Unformatted
+		}
+		d := digits(f)
+		switch {
+		case strings.HasPrefix(strings.TrimSpace(f), "+"):
+			number = "+" + d
+		case countryCode == "1" && len(d) == 11 && d[0] == '1':
+			number = "+" + d
+		case len(countryCode) > 0:
+			number = "+" + countryCode + strings.TrimLeft(d, "0")
+		default:
+			return "", false
+		}
+	}
+	// E.164 numbers have at most 15 digits, and at least 7 with the country code is a reasonable minimum.
+	// North American numbers have a 3 digit area code and a 7 digit local number.
+	if n := len(number) - 1; n < 7 || n > 15 || number[1] == '1' && n != 11 {
+		return "", false
+	}
+	return number, true
+}
+
+// Set sets the components of an XTN value of any version, which must be a pointer.
+// Components the version does not define must be empty.
+func (t Telephone) Set(xtn any) error {
+	rv := reflect.ValueOf(xtn)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to a telephone number", xtn)
+	}
+	return setComponents(rv.Elem(), []string{
+		t.Formatted, t.Use, t.Equipment, t.Email, t.CountryCode, t.AreaCode, t.LocalNumber, t.Extension, t.Text,
+		"", "", t.Unformatted,
+	})
+}
+
+// digits returns the decimal digits of s.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
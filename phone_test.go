@@ -0,0 +1,69 @@
+package hl7
+
+import (
+	"testing"
+
+	v210 "github.com/kardianos/hl7/h210"
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestTelephone(t *testing.T) {
+	pid := &v251.PID{PhoneNumberHome: []v251.XTN{
+		{TelephoneNumber: "(555)555-1234X89", TelecommunicationUseCode: UsePrimaryResidence, TelecommunicationEquipmentType: EquipmentPhone},
+		{TelecommunicationUseCode: UsePrimaryResidence, TelecommunicationEquipmentType: EquipmentCellular, AreaCityCode: "555", LocalNumber: "5559876", Extension: "12"},
+		{TelecommunicationUseCode: UseNetwork, TelecommunicationEquipmentType: EquipmentInternet, EmailAddress: "jane@example.com"},
+	}}
+	list, err := Telephones(pid.PhoneNumberHome)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("got %d numbers", len(list))
+	}
+	for i, want := range []struct {
+		e164 string
+		ext  string
+	}{{"+15555551234", "89"}, {"+15555559876", "12"}, {"", ""}} {
+		got, ok := list[i].E164("1")
+		if got != want.e164 || ok != (len(want.e164) > 0) {
+			t.Errorf("%d: got %q, %t, want %q", i+1, got, ok, want.e164)
+		}
+		if got := list[i].Ext(); got != want.ext {
+			t.Errorf("%d: extension got %q, want %q", i+1, got, want.ext)
+		}
+	}
+	if list[2].Email != "jane@example.com" {
+		t.Errorf("got %+v", list[2])
+	}
+
+	for formatted, want := range map[string]string{
+		"1 (555)555-1234":   "+15555551234",
+		"+44 20 7946 0958":  "+442079460958",
+		"555-1234B123C Day": "",
+	} {
+		got, _ := Telephone{Formatted: formatted}.E164("1")
+		if got != want {
+			t.Errorf("%q: got %q, want %q", formatted, got, want)
+		}
+	}
+	if got, _ := (Telephone{Formatted: "020 7946 0958"}).E164("44"); got != "+442079460958" {
+		t.Errorf("got %q", got)
+	}
+	if _, ok := (Telephone{Formatted: "(555)555-1234"}).E164(""); ok {
+		t.Error("expected no country code")
+	}
+
+	tn, err := ParseTelephone((&v210.PID{PhoneNumberHome: []v210.TN{"(555)555-1234"}}).PhoneNumberHome[0])
+	if err != nil || tn.Formatted != "(555)555-1234" {
+		t.Errorf("got %+v, %v", tn, err)
+	}
+
+	var xtn v251.XTN
+	err = Telephone{Use: UseWork, Equipment: EquipmentFax, CountryCode: "1", AreaCode: "555", LocalNumber: "5550000"}.Set(&xtn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xtn.TelecommunicationUseCode != UseWork || xtn.LocalNumber != "5550000" || xtn.CountryCode != "1" {
+		t.Errorf("got %+v", xtn)
+	}
+}
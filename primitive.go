@@ -0,0 +1,30 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// checkPrimitive returns an error if v is not in the form of the primitive data type of the tag.
+func checkPrimitive(v string, t tag) error {
+	if len(v) == 0 || v == nullValue {
+		return nil
+	}
+	switch t.DataType {
+	case "ID", "IS":
+		if strings.TrimSpace(v) != v {
+			return fmt.Errorf("invalid %s %q: leading or trailing space", t.DataType, v)
+		}
+		if strings.IndexFunc(v, unicode.IsControl) >= 0 {
+			return fmt.Errorf("invalid %s %q: contains a control character", t.DataType, v)
+		}
+	case "NM":
+		return checkNumber(v)
+	case "SI":
+		if !isDigits(v) || strings.Trim(v, "0") == "" {
+			return fmt.Errorf("invalid SI %q: must be a positive integer", v)
+		}
+	}
+	return nil
+}
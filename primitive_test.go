@@ -0,0 +1,46 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestCheckPrimitive(t *testing.T) {
+	tests := []struct {
+		dataType string
+		in       string
+		wantErr  bool
+	}{
+		{"ID", "F", false},
+		{"ID", " F", true},
+		{"IS", "A\x01", true},
+		{"NM", "-12.5", false},
+		{"NM", "12,5", true},
+		{"SI", "3", false},
+		{"SI", "0", true},
+		{"SI", "-1", true},
+		{"SI", `""`, false},
+		{"ST", " anything ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dataType+" "+tt.in, func(t *testing.T) {
+			err := checkPrimitive(tt.in, tag{DataType: tt.dataType})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkPrimitive(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeCheckPrimitive(t *testing.T) {
+	raw := []byte("MSH|^~\\&|||||20240131||ADT^A01^ADT_A01|1|P|2.5.1\rOBX|x|NM")
+	if _, err := NewDecoder(v251.Registry, nil).DecodeList(raw); err != nil {
+		t.Fatalf("unchecked decode failed: %v", err)
+	}
+	_, err := NewDecoder(v251.Registry, &DecodeOption{CheckPrimitive: true}).DecodeList(raw)
+	if err == nil || !strings.Contains(err.Error(), `invalid SI "x"`) {
+		t.Fatalf("expected SI error, got %v", err)
+	}
+}
@@ -0,0 +1,371 @@
+package hl7
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrCardinality is the FieldError reason for a segment, group, or field that occurs too few or too many times.
+	ErrCardinality = errors.New("cardinality")
+
+	// ErrUnexpectedSegment is the FieldError reason for a segment not in the message profile.
+	ErrUnexpectedSegment = errors.New("unexpected segment")
+
+	// ErrSegmentOrder is the FieldError reason for a segment in the message structure, but not in order.
+	ErrSegmentOrder = errors.New("segment out of order")
+)
+
+// Profile is an HL7 v2 conformance profile in the XML message profile format,
+// as published by implementation guides.
+type Profile struct {
+	HL7Version  string           `xml:"HL7Version,attr"`
+	ProfileType string           `xml:"ProfileType,attr"`
+	MetaData    ProfileMetaData  `xml:"MetaData"`
+	Message     []ProfileMessage `xml:"HL7v2xStaticDef"`
+}
+
+// ProfileMetaData identifies a conformance profile.
+type ProfileMetaData struct {
+	Name    string `xml:"Name,attr"`
+	OrgName string `xml:"OrgName,attr"`
+	Version string `xml:"Version,attr"`
+}
+
+// ProfileMessage is the static definition of a message structure.
+type ProfileMessage struct {
+	MsgType     string           `xml:"MsgType,attr"`
+	EventType   string           `xml:"EventType,attr"`
+	MsgStructID string           `xml:"MsgStructID,attr"`
+	Element     []ProfileElement `xml:",any"`
+}
+
+// ProfileElement is a segment or segment group of a message profile.
+type ProfileElement struct {
+	XMLName  xml.Name
+	Name     string           `xml:"Name,attr"`
+	LongName string           `xml:"LongName,attr"`
+	Usage    string           `xml:"Usage,attr"`
+	Min      int              `xml:"Min,attr"`
+	Max      string           `xml:"Max,attr"`
+	Field    []ProfileField   `xml:"Field"`
+	Element  []ProfileElement `xml:",any"`
+}
+
+// ProfileField is a field, component, or subcomponent of a message profile.
+type ProfileField struct {
+	Name         string         `xml:"Name,attr"`
+	Usage        string         `xml:"Usage,attr"`
+	Min          int            `xml:"Min,attr"`
+	Max          string         `xml:"Max,attr"`
+	Datatype     string         `xml:"Datatype,attr"`
+	Length       string         `xml:"Length,attr"`
+	Table        string         `xml:"Table,attr"`
+	Component    []ProfileField `xml:"Component"`
+	SubComponent []ProfileField `xml:"SubComponent"`
+}
+
+// LoadProfile reads an XML conformance profile.
+func LoadProfile(r io.Reader) (*Profile, error) {
+	p := &Profile{}
+	err := xml.NewDecoder(r).Decode(p)
+	if err != nil {
+		return nil, fmt.Errorf("conformance profile: %w", err)
+	}
+	if len(p.Message) == 0 {
+		return nil, fmt.Errorf("conformance profile: missing HL7v2xStaticDef")
+	}
+	return p, nil
+}
+
+func (e ProfileElement) isSegment() bool { return e.XMLName.Local == "Segment" }
+func (e ProfileElement) isGroup() bool   { return e.XMLName.Local == "SegGroup" }
+
+// profileMax returns the maximum count, or -1 if unbounded.
+func profileMax(max string) int {
+	if len(max) == 0 || max == "*" {
+		return -1
+	}
+	n, err := strconv.Atoi(max)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// profileRequired reports if the usage or minimum require a value.
+func profileRequired(usage string, min int) bool {
+	return usage == "R" || min > 0
+}
+
+// length returns the maximum length, which may be given as a range (1..20).
+func (f ProfileField) length() int {
+	v := f.Length
+	if _, after, ok := strings.Cut(v, ".."); ok {
+		v = after
+	}
+	n, _ := strconv.Atoi(v)
+	return n
+}
+
+// Validate checks a message against the profile: segment and group usage and cardinality,
+// and field, component, and subcomponent usage, repetitions, length, and table values.
+// The message may be a trigger structure or the list of segments returned by DecodeList.
+// The message structure with the MSH message structure ID is used, or the first if there is no match.
+// If there are problems, the error is a ValidationError. Option is optional.
+func (p *Profile) Validate(message any, opt *ValidateOption) error {
+	if len(p.Message) == 0 {
+		return fmt.Errorf("conformance profile: missing HL7v2xStaticDef")
+	}
+	v := &validator{}
+	if opt != nil {
+		v.opt = *opt
+	}
+	m := &profileMatcher{v: v}
+	m.flatten(reflect.ValueOf(message))
+
+	pm := p.Message[0]
+	if len(m.segs) > 0 {
+		if ms, ok := m.segs[0].Interface().(messageStructure); ok {
+			for _, x := range p.Message {
+				if x.MsgStructID == ms.MessageStructureID() {
+					pm = x
+					break
+				}
+			}
+		}
+	}
+	root := pm.MsgStructID
+	if len(root) == 0 {
+		root = pm.MsgType + "_" + pm.EventType
+	}
+	m.elements(root, pm.Element, false)
+	for ; m.pos < len(m.segs); m.pos++ {
+		name := segmentName(m.segs[m.pos])
+		v.errs = append(v.errs, &FieldError{Segment: name, Name: root, Err: ErrUnexpectedSegment})
+	}
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// structureElements returns the segments and groups of a trigger or group struct as profile elements.
+func structureElements(rt reflect.Type) []ProfileElement {
+	var list []ProfileElement
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta {
+			continue
+		}
+		e := ProfileElement{Max: "1"}
+		if t.Usage == usageRequired {
+			e.Usage, e.Min = "R", 1
+		}
+		et := ft.Type
+		if et.Kind() == reflect.Slice {
+			e.Max = "*"
+			et = et.Elem()
+		}
+		if et.Kind() == reflect.Pointer {
+			et = et.Elem()
+		}
+		meta, err := (&Encoder{}).meta(et)
+		if err != nil {
+			continue
+		}
+		switch meta.Type {
+		default:
+			continue
+		case structSegment:
+			e.XMLName.Local = "Segment"
+			e.Name = meta.Name
+		case structTriggerGroup:
+			e.XMLName.Local = "SegGroup"
+			e.Name = ft.Name
+			e.Element = structureElements(et)
+		}
+		list = append(list, e)
+	}
+	return list
+}
+
+type profileMatcher struct {
+	v    *validator
+	segs []reflect.Value
+	pos  int
+}
+
+// flatten appends each segment in the message in order.
+func (m *profileMatcher) flatten(rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !rv.IsNil() {
+			m.flatten(rv.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			m.flatten(rv.Index(i))
+		}
+	case reflect.Struct:
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil || !meta.Present {
+			return
+		}
+		switch meta.Type {
+		case structTrigger, structTriggerGroup:
+			for i := 0; i < rv.NumField(); i++ {
+				m.flatten(rv.Field(i))
+			}
+		case structSegment:
+			m.segs = append(m.segs, rv)
+		}
+	}
+}
+
+func segmentName(rv reflect.Value) string {
+	meta, _ := (&Encoder{}).meta(rv.Type())
+	return meta.Name
+}
+
+func (m *profileMatcher) fail(segment, name string, err error) {
+	m.v.errs = append(m.v.errs, &FieldError{Segment: segment, Name: name, Err: err})
+}
+
+// elements matches the segments and groups in order from the current position.
+// In a repeating group, a segment past its maximum starts the next repetition of the group.
+func (m *profileMatcher) elements(path string, list []ProfileElement, repeating bool) {
+	for _, e := range list {
+		name := path + "." + e.Name
+		max := profileMax(e.Max)
+		count := 0
+		switch {
+		default:
+			continue
+		case e.isSegment():
+			for m.pos < len(m.segs) && segmentName(m.segs[m.pos]) == e.Name {
+				if repeating && max >= 0 && count == max {
+					break
+				}
+				count++
+				if max >= 0 && count == max+1 {
+					m.fail(e.Name, name, fmt.Errorf("%w: more than %d", ErrCardinality, max))
+				}
+				m.fields(e, m.segs[m.pos])
+				m.pos++
+			}
+		case e.isGroup():
+			for max < 0 || count < max {
+				start, errCount := m.pos, len(m.v.errs)
+				m.elements(name, e.Element, repeating || max != 1)
+				if m.pos == start {
+					// The group is not present, so its missing segments are not problems.
+					m.v.errs = m.v.errs[:errCount]
+					break
+				}
+				count++
+			}
+		}
+		switch {
+		case count == 0 && profileRequired(e.Usage, e.Min):
+			m.fail(e.Name, name, ErrRequired)
+		case count > 0 && count < e.Min:
+			m.fail(e.Name, name, fmt.Errorf("%w: %d less than %d", ErrCardinality, count, e.Min))
+		case count > 0 && e.Usage == "X":
+			m.fail(e.Name, name, ErrNotSupported)
+		}
+	}
+}
+
+// fields checks the fields of the segment value.
+func (m *profileMatcher) fields(e ProfileElement, seg reflect.Value) {
+	for i, f := range e.Field {
+		m.value(e.Name, []int{i + 1}, e.Name, f, seg)
+	}
+}
+
+// value checks the field or component at the last position of pos in the struct rv.
+func (m *profileMatcher) value(segment string, pos []int, name string, f ProfileField, rv reflect.Value) {
+	sf, fv, t, ok := fieldByOrder(rv, pos[len(pos)-1])
+	if !ok {
+		return
+	}
+	name += "." + sf.Name
+	fe := func(err error) {
+		m.v.errs = append(m.v.errs, &FieldError{Segment: segment, Position: pos, Name: name, Err: err})
+	}
+	if fv.IsZero() || (fv.Kind() == reflect.Slice && fv.Len() == 0) {
+		if profileRequired(f.Usage, f.Min) && !t.Sequence && !t.FieldSep && !t.FieldChars {
+			fe(ErrRequired)
+		}
+		return
+	}
+	if f.Usage == "X" {
+		fe(ErrNotSupported)
+		return
+	}
+	values := []reflect.Value{fv}
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+		if max := profileMax(f.Max); max >= 0 && fv.Len() > max {
+			fe(fmt.Errorf("%w: %d repetitions more than %d", ErrCardinality, fv.Len(), max))
+		}
+		values = values[:0]
+		for i := 0; i < fv.Len(); i++ {
+			values = append(values, fv.Index(i))
+		}
+	}
+	children := f.Component
+	if len(children) == 0 {
+		children = f.SubComponent
+	}
+	for _, x := range values {
+		for x.Kind() == reflect.Pointer && !x.IsNil() {
+			x = x.Elem()
+		}
+		switch x.Kind() {
+		case reflect.String:
+			s := x.String()
+			if s == nullValue {
+				continue
+			}
+			if n := f.length(); n > 0 && overLength(s, tag{Len: n}) {
+				fe(errLength(s, tag{Len: n}))
+			}
+			if len(f.Table) > 0 && m.v.opt.Tables != nil {
+				m.v.table(f.Table, &FieldError{Segment: segment, Position: pos, Name: name}, x)
+			}
+		case reflect.Struct:
+			if x.IsZero() {
+				continue
+			}
+			meta, _ := (&Encoder{}).meta(x.Type())
+			if meta.Type != structDataType {
+				continue
+			}
+			for i, c := range children {
+				cpos := append(pos[:len(pos):len(pos)], i+1)
+				m.value(segment, cpos, name, c, x)
+			}
+		}
+	}
+}
+
+// fieldByOrder returns the struct field of rv with the tag order.
+func fieldByOrder(rv reflect.Value, order int) (reflect.StructField, reflect.Value, tag, bool) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta || t.Omit || int(t.Order) != order {
+			continue
+		}
+		return ft, rv.Field(i), t, true
+	}
+	return reflect.StructField{}, reflect.Value{}, tag{}, false
+}
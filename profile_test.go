@@ -0,0 +1,106 @@
+package hl7
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestProfile(t *testing.T) {
+	f, err := os.Open("testdata/profile/adt_a01.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	p, err := LoadProfile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MetaData.Name != "Test ADT" || p.Message[0].MsgStructID != "ADT_A01" {
+		t.Fatalf("got %+v", p)
+	}
+
+	d := NewDecoder(v251.Registry, nil)
+	opt := &ValidateOption{Tables: TableValues(v251.TableValueLookup)}
+
+	valid := strings.Join([]string{
+		`MSH|^~\&|APP||||20240131||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01|20240131`,
+		`PID|1||123||Doe^Ann|||F`,
+		`PV1|1|I`,
+		`IN1|1`,
+		`IN2|`,
+		`IN1|2`,
+	}, "\r")
+	list, err := d.DecodeList([]byte(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Validate(list, opt); err != nil {
+		t.Fatalf("expected valid, got %v", err)
+	}
+	msg, err := d.Decode([]byte(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Validate(msg, opt); err != nil {
+		t.Fatalf("expected valid trigger, got %v", err)
+	}
+
+	invalid := strings.Join([]string{
+		`MSH|^~\&|||||20240131||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`PID|1|9|123456~2~3||Doe^Ann|||Q`,
+		`PV1|1|I`,
+		`OBX|1`,
+		`OBX|2`,
+		`OBX|3`,
+		`IN2|`,
+		`NTE|1`,
+	}, "\r")
+	list, err = d.DecodeList([]byte(invalid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Validate(list, opt)
+	var ve ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	var got []string
+	for _, fe := range ve {
+		reason := "other"
+		for _, r := range []error{ErrRequired, ErrNotSupported, ErrCardinality, ErrUnexpectedSegment, ErrTableValue} {
+			if errors.Is(fe, r) {
+				reason = r.Error()
+			}
+		}
+		got = append(got, fe.Location()+" "+reason)
+	}
+	sort.Strings(got)
+	want := []string{
+		"EVN required value missing",
+		"IN1 required value missing",
+		"MSH-3 required value missing",
+		"NTE unexpected segment",
+		"OBX cardinality",
+		"PID-2 not supported value present",
+		"PID-3 cardinality",
+		"PID-3.1 other",
+		"PID-8 value not in table",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestProfileNoMessage(t *testing.T) {
+	p := &Profile{}
+	err := p.Validate([]any{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "missing HL7v2xStaticDef") {
+		t.Fatalf("expected missing message error, got %v", err)
+	}
+}
@@ -0,0 +1,593 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ProtoOption sets the .proto file written by GenerateProto.
+type ProtoOption struct {
+	// Package is the protobuf package, such as hl7.v251.
+	// If empty, it is hl7.v followed by the digits of the registry version.
+	Package string
+
+	// GoPackage is written as the go_package option, if set.
+	GoPackage string
+}
+
+// protoVaries is the message of a value whose data type is not known from its struct, such as OBX-5.
+const protoVaries = "Varies"
+
+var rawType = reflect.TypeOf(Raw(""))
+
+// GenerateProto returns a proto3 file with a message for each trigger, group, segment, and composite data type
+// of the registry, for sending decoded messages as protocol buffers with EncodeProto and DecodeProto.
+// Option may be nil.
+//
+// The fields of a segment or data type are numbered by their HL7 position, such as 5 for PID-5,
+// and the segments and groups of a trigger or group are numbered in struct order. Primitive values are
+// strings with the text the Encoder would write, such as 20240102 for a date. A value whose data type
+// is not known from the message structure, such as OBX-5, is a Varies message.
+func GenerateProto(r Registry, opt *ProtoOption) ([]byte, error) {
+	var o ProtoOption
+	if opt != nil {
+		o = *opt
+	}
+	if len(o.Package) == 0 {
+		o.Package = "hl7.v" + strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, r.Version())
+	}
+	g := &protoGenerator{types: map[string]reflect.Type{}}
+	for _, lookup := range []RegistryLookup{r.ControlSegment(), r.Segment(), r.Trigger(), r.DataType()} {
+		for _, k := range sortedKeys(lookup) {
+			rt := reflect.TypeOf(lookup[k])
+			if rt == nil || rt.Kind() != reflect.Struct {
+				continue
+			}
+			if meta, err := (&Encoder{}).meta(rt); err != nil || !meta.Meta {
+				continue
+			}
+			if err := g.add(rt); err != nil {
+				return nil, fmt.Errorf("%q: %w", k, err)
+			}
+		}
+	}
+	if _, ok := g.types[protoVaries]; ok {
+		return nil, fmt.Errorf("message %s is reserved", protoVaries)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by GenerateProto from the HL7 %s registry. DO NOT EDIT.\n\n", r.Version())
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(buf, "package %s;\n\n", o.Package)
+	if len(o.GoPackage) > 0 {
+		fmt.Fprintf(buf, "option go_package = %q;\n\n", o.GoPackage)
+	}
+	buf.WriteString(`// Varies is a value whose data type is not known from the message structure, such as OBX-5:
+// the data type if known, and the text of a primitive or the components of a composite, starting at component 1.
+message Varies {
+  string type = 1;
+  string value = 2;
+  repeated Varies components = 3;
+}
+`)
+	names := make([]string, 0, len(g.types))
+	for name := range g.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fields, err := protoFields(g.types[name])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(buf, "\nmessage %s {\n", name)
+		for _, f := range fields {
+			buf.WriteString("  ")
+			if f.repeated {
+				buf.WriteString("repeated ")
+			}
+			typ := f.message
+			if len(typ) == 0 {
+				typ = "string"
+			}
+			fmt.Fprintf(buf, "%s %s = %d;\n", typ, f.name, f.num)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes(), nil
+}
+
+type protoGenerator struct {
+	types map[string]reflect.Type // By message name.
+}
+
+// add adds the message of the struct and of each struct it contains.
+func (g *protoGenerator) add(rt reflect.Type) error {
+	name := protoMessageName(rt)
+	if prev, ok := g.types[name]; ok {
+		if prev != rt {
+			return fmt.Errorf("message %s is both %v and %v", name, prev, rt)
+		}
+		return nil
+	}
+	g.types[name] = rt
+	fields, err := protoFields(rt)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.elem != nil {
+			if err := g.add(f.elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// protoField is a field of a message.
+type protoField struct {
+	num      int
+	name     string // Field name, such as patient_name.
+	index    int    // Index of the struct field.
+	repeated bool
+	message  string       // Message name, or empty for a string.
+	elem     reflect.Type // Struct of a message other than Varies.
+}
+
+// protoFields returns the fields of the message of a trigger, group, segment, or data type struct.
+func protoFields(rt reflect.Type) ([]protoField, error) {
+	meta, err := (&Encoder{}).meta(rt)
+	if err != nil {
+		return nil, err
+	}
+	group := meta.Type == structTrigger || meta.Type == structTriggerGroup
+	var list []protoField
+	seen := map[int]bool{}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", rt, err)
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		f := protoField{num: int(t.Order), name: protoName(ft.Name), index: i}
+		if group {
+			f.num = len(list) + 1
+		}
+		if f.num <= 0 {
+			continue
+		}
+		if seen[f.num] {
+			return nil, fmt.Errorf("%v.%s: duplicate order %d", rt, ft.Name, f.num)
+		}
+		seen[f.num] = true
+		et := ft.Type
+		if et.Kind() == reflect.Slice && et != byteSliceType {
+			f.repeated = true
+			et = et.Elem()
+		}
+		for et.Kind() == reflect.Pointer {
+			et = et.Elem()
+		}
+		switch {
+		case et == rawType || et.Kind() == reflect.Interface:
+			f.message = protoVaries
+		case et.Kind() == reflect.Struct:
+			if m, err := (&Encoder{}).meta(et); err == nil && m.Meta {
+				f.message = protoMessageName(et)
+				f.elem = et
+			}
+		}
+		list = append(list, f)
+	}
+	return list, nil
+}
+
+// protoMessageName returns the HL7 name of a struct, such as PID or ADT_A01_Procedure.
+func protoMessageName(rt reflect.Type) string {
+	if meta, err := (&Encoder{}).meta(rt); err == nil && len(meta.Name) > 0 {
+		return meta.Name
+	}
+	return rt.Name()
+}
+
+// protoName returns the field name of a Go field name, such as patient_id for PatientID.
+func protoName(name string) string {
+	rs := []rune(name)
+	buf := &strings.Builder{}
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rs[i-1]
+			acronymEnd := unicode.IsUpper(prev) && i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || acronymEnd {
+				buf.WriteByte('_')
+			}
+		}
+		buf.WriteRune(unicode.ToLower(r))
+	}
+	return buf.String()
+}
+
+// EncodeProto encodes the trigger as a protocol buffer message of the schema from GenerateProto.
+func (e *Encoder) EncodeProto(message any) ([]byte, error) {
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unable to encode %T, expected a trigger", message)
+	}
+	meta, err := e.meta(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	if meta.Type != structTrigger {
+		return nil, fmt.Errorf("unable to encode %T, expected a trigger", message)
+	}
+	b := &treeBuilder{
+		e:    e,
+		ld:   &lineDecoder{},
+		root: meta.Name,
+	}
+	b.ld.setDelimiters(DefaultDelimiters)
+	return protoGroup(b, 1, rv)
+}
+
+// protoGroup returns the message of a trigger or group, numbering sequence fields like Encoder.walk.
+func protoGroup(b *treeBuilder, seq int, rv reflect.Value) ([]byte, error) {
+	fields, err := protoFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	var buf []byte
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		list := []reflect.Value{fv}
+		if fv.Kind() == reflect.Slice {
+			list = list[:0]
+			for i := 0; i < fv.Len(); i++ {
+				list = append(list, fv.Index(i))
+			}
+		}
+		for i, v := range list {
+			n := seq
+			if fv.Kind() == reflect.Slice {
+				n = i + 1
+			}
+			for (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+				v = v.Elem()
+			}
+			if v.Kind() != reflect.Struct {
+				continue
+			}
+			meta, err := b.e.meta(v.Type())
+			if err != nil {
+				return nil, err
+			}
+			if !meta.Present {
+				continue
+			}
+			var msg []byte
+			switch meta.Type {
+			default:
+				continue
+			case structTriggerGroup:
+				msg, err = protoGroup(b, n, v)
+			case structSegment:
+				var nodes []node
+				nodes, err = b.segment(n, meta.Name, v)
+				if err == nil {
+					msg, err = protoMessage(v.Type(), newNode(meta.Name, "", nodes...))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			buf = appendProto(buf, f.num, msg)
+		}
+	}
+	return buf, nil
+}
+
+// protoMessage returns the message of a segment or data type node.
+func protoMessage(rt reflect.Type, n node) ([]byte, error) {
+	fields, err := protoFields(rt)
+	if err != nil {
+		return nil, err
+	}
+	if len(n.Nodes) == 0 && len(n.Text) > 0 {
+		// A composite with a text value, such as an explicit null, has it as its first component.
+		n = newNode(n.name(), "", newNode(".1", n.Text))
+	}
+	var buf []byte
+	for _, c := range n.Nodes {
+		pos, err := nodePosition("", c.name())
+		if err != nil {
+			return nil, err
+		}
+		var f *protoField
+		for i := range fields {
+			if fields[i].num == pos {
+				f = &fields[i]
+				break
+			}
+		}
+		if f == nil {
+			return nil, fmt.Errorf("%s: no field %d in %v", n.name(), pos, rt)
+		}
+		var v []byte
+		switch f.message {
+		case "":
+			if len(c.Nodes) > 0 {
+				return nil, fmt.Errorf("%s: components of a primitive value", c.name())
+			}
+			v = []byte(c.Text)
+		case protoVaries:
+			v, err = protoVariesMessage(c)
+		default:
+			v, err = protoMessage(f.elem, c)
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf = appendProto(buf, f.num, v)
+	}
+	return buf, nil
+}
+
+// protoVariesMessage returns the Varies message of a node. The data type is known from the component names, such as CWE.1.
+func protoVariesMessage(n node) ([]byte, error) {
+	var buf []byte
+	if len(n.Nodes) == 0 {
+		if len(n.Text) > 0 {
+			buf = appendProto(buf, 2, []byte(n.Text))
+		}
+		return buf, nil
+	}
+	if typ, _, _ := strings.Cut(n.Nodes[0].name(), "."); len(typ) > 0 && typ != "varies" {
+		buf = appendProto(buf, 1, []byte(typ))
+	}
+	next := 1
+	for _, c := range n.Nodes {
+		pos, err := nodePosition("", c.name())
+		if err != nil {
+			return nil, err
+		}
+		// Components not sent are empty, so each has its position.
+		for ; next < pos; next++ {
+			buf = appendProto(buf, 3, nil)
+		}
+		v, err := protoVariesMessage(c)
+		if err != nil {
+			return nil, err
+		}
+		buf = appendProto(buf, 3, v)
+		next++
+	}
+	return buf, nil
+}
+
+// appendProto appends a length delimited field: a string or message.
+func appendProto(buf []byte, num int, v []byte) []byte {
+	var b [2 * binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], uint64(num)<<3|2)
+	n += binary.PutUvarint(b[n:], uint64(len(v)))
+	buf = append(buf, b[:n]...)
+	return append(buf, v...)
+}
+
+// DecodeProto decodes a protocol buffer message of the schema from GenerateProto, as written by EncodeProto,
+// and returns a final trigger with all segments grouped. The trigger is set by the header, field 1 of each trigger.
+func (d *Decoder) DecodeProto(data []byte) (any, error) {
+	msh, ok := d.registry.Segment()["MSH"]
+	if !ok {
+		return nil, fmt.Errorf("proto: registry has no MSH segment")
+	}
+	var header []node
+	err := protoRange(data, func(num int, v []byte) error {
+		if num != 1 || header != nil {
+			return nil
+		}
+		n, err := protoNode("MSH", reflect.TypeOf(msh), v)
+		header = []node{n}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("proto: %w", err)
+	}
+	if header == nil {
+		return nil, fmt.Errorf("proto: missing message header")
+	}
+	line, err := treeSegments(header)
+	if err != nil {
+		return nil, fmt.Errorf("proto: %w", err)
+	}
+	list, err := d.DecodeList(line)
+	if err != nil {
+		return nil, fmt.Errorf("proto: %w", err)
+	}
+	ms, ok := list[0].(messageStructure)
+	if !ok || len(ms.MessageStructureID()) == 0 {
+		return nil, fmt.Errorf("proto: missing message structure")
+	}
+	code := ms.MessageStructureID()
+	tr, ok := d.registry.Trigger()[code]
+	if !ok {
+		return nil, fmt.Errorf("proto: message structure code not found %q", code)
+	}
+	nodes, err := protoGroupNodes(&treeBuilder{root: code}, reflect.TypeOf(tr), data)
+	if err != nil {
+		return nil, fmt.Errorf("proto: %w", err)
+	}
+	lines, err := treeSegments(nodes)
+	if err != nil {
+		return nil, fmt.Errorf("proto: %w", err)
+	}
+	return d.Decode(lines)
+}
+
+// protoGroupNodes returns the group and segment nodes of a trigger or group message.
+func protoGroupNodes(b *treeBuilder, rt reflect.Type, data []byte) ([]node, error) {
+	fields, err := protoFields(rt)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []node
+	err = protoRange(data, func(num int, v []byte) error {
+		for _, f := range fields {
+			if f.num != num || f.elem == nil {
+				continue
+			}
+			meta, err := (&Encoder{}).meta(f.elem)
+			if err != nil {
+				return err
+			}
+			switch meta.Type {
+			case structTriggerGroup:
+				list, err := protoGroupNodes(b, f.elem, v)
+				if err != nil {
+					return err
+				}
+				nodes = append(nodes, newNode(b.groupName(meta.Name), "", list...))
+			case structSegment:
+				n, err := protoNode(meta.Name, f.elem, v)
+				if err != nil {
+					return err
+				}
+				nodes = append(nodes, n)
+			}
+		}
+		return nil
+	})
+	return nodes, err
+}
+
+// protoNode returns the node of a segment or data type message, with a child for each field named
+// after the struct, such as PID.5 or XPN.1.
+func protoNode(name string, rt reflect.Type, data []byte) (node, error) {
+	fields, err := protoFields(rt)
+	if err != nil {
+		return node{}, err
+	}
+	prefix := protoMessageName(rt)
+	n := newNode(name, "")
+	err = protoRange(data, func(num int, v []byte) error {
+		for _, f := range fields {
+			if f.num != num {
+				continue
+			}
+			cname := prefix + "." + strconv.Itoa(num)
+			var c node
+			var err error
+			switch f.message {
+			case "":
+				// An empty repetition keeps the position of those after it.
+				if len(v) == 0 && !f.repeated {
+					return nil
+				}
+				c = newNode(cname, string(v))
+			case protoVaries:
+				c, err = protoVariesNode(cname, v)
+			default:
+				c, err = protoNode(cname, f.elem, v)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", cname, err)
+			}
+			n.Nodes = append(n.Nodes, c)
+		}
+		return nil
+	})
+	return n, err
+}
+
+// protoVariesNode returns the node of a Varies message, whose components are named after its data type, such as CWE.1.
+func protoVariesNode(name string, data []byte) (node, error) {
+	n := newNode(name, "")
+	typ := "varies"
+	var comps [][]byte
+	err := protoRange(data, func(num int, v []byte) error {
+		switch num {
+		case 1:
+			typ = string(v)
+		case 2:
+			n.Text = string(v)
+		case 3:
+			comps = append(comps, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return node{}, err
+	}
+	for i, c := range comps {
+		if len(c) == 0 {
+			continue
+		}
+		cn, err := protoVariesNode(typ+"."+strconv.Itoa(i+1), c)
+		if err != nil {
+			return node{}, err
+		}
+		n.Nodes = append(n.Nodes, cn)
+	}
+	return n, nil
+}
+
+// protoRange calls fn with the number and value of each length delimited field of a message, in order.
+// Fields of other wire types are skipped.
+func protoRange(data []byte, fn func(num int, v []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("invalid field key")
+		}
+		data = data[n:]
+		num := key >> 3
+		if num == 0 || num > 1<<29-1 {
+			return fmt.Errorf("invalid field number %d", num)
+		}
+		switch key & 7 {
+		default:
+			return fmt.Errorf("field %d: unsupported wire type %d", num, key&7)
+		case 0:
+			if _, n = binary.Uvarint(data); n <= 0 {
+				return fmt.Errorf("field %d: invalid varint", num)
+			}
+			data = data[n:]
+		case 1, 5:
+			size := 8
+			if key&7 == 5 {
+				size = 4
+			}
+			if len(data) < size {
+				return fmt.Errorf("field %d: unexpected end of data", num)
+			}
+			data = data[size:]
+		case 2:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || size > uint64(len(data)-n) {
+				return fmt.Errorf("field %d: invalid length", num)
+			}
+			v := data[n : n+int(size)]
+			data = data[n+int(size):]
+			if err := fn(int(num), v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
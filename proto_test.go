@@ -0,0 +1,152 @@
+package hl7
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestGenerateProto(t *testing.T) {
+	schema, err := GenerateProto(v251.Registry, &ProtoOption{GoPackage: "example.com/hl7pb"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"syntax = \"proto3\";\n\npackage hl7.v251;\n\noption go_package = \"example.com/hl7pb\";\n",
+		"\nmessage Varies {\n",
+		"\nmessage ADT_A01 {\n  MSH msh = 1;\n  repeated SFT sft = 2;\n",
+		"  repeated ADT_A01_Procedure procedure = 16;\n  repeated GT1 gt1 = 17;\n",
+		"\nmessage ADT_A01_Procedure {\n  PR1 pr1 = 1;\n  repeated ROL rol = 2;\n}\n",
+		"  repeated XPN patient_name = 5;\n",
+		"  repeated Varies observation_value = 5;\n",
+		"\nmessage XPN {\n  string family_name = 1;\n",
+		"  string set_id = 1;\n",
+	} {
+		if !strings.Contains(string(schema), want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	if strings.Contains(string(schema), "\nmessage ST {") {
+		t.Errorf("primitive data type has a message")
+	}
+}
+
+func TestProtoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"PatientName": "patient_name",
+		"SetID":       "set_id",
+		"IDNumber":    "id_number",
+		"ROL2":        "rol2",
+		"MSH":         "msh",
+		"Procedure":   "procedure",
+	} {
+		if got := protoName(name); got != want {
+			t.Errorf("%s: got %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		t.Run(name, func(t *testing.T) {
+			bb, err := os.ReadFile(filepath.Join(fsDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The encoded bytes are reused by the next Encode call.
+			want, err := NewEncoder(nil).Encode(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pb, err := e.EncodeProto(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			back, err := d.DecodeProto(pb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := e.Encode(back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Fatalf("got:\n%s", lineDiff(got, want))
+			}
+		})
+	}
+}
+
+func TestEncodeProto(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||123^^^MRN^MR~456||Doe^Jane`,
+		`OBR|1||X|CBC`,
+		`OBX|1|CWE|WBC||A^^LN|||H`,
+		`OBX|2|ST|RBC||text \T\ more`,
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb, err := NewEncoder(nil).EncodeProto(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The header is field 1, a length delimited message.
+	if len(pb) == 0 || pb[0] != 1<<3|2 {
+		t.Fatalf("got first key %x", pb[:1])
+	}
+	back, err := d.DecodeProto(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oru := back.(v251.ORU_R01)
+	pr := oru.PatientResult[0]
+	if ids := pr.Patient.PID.PatientIdentifierList; len(ids) != 2 || ids[1].IDNumber != "456" {
+		t.Errorf("got identifiers %+v", ids)
+	}
+	obs := pr.OrderObservation[0].Observation
+	if len(obs) != 2 {
+		t.Fatalf("got %d observations", len(obs))
+	}
+	if cwe, ok := obs[0].OBX.ObservationValue[0].(v251.CWE); !ok || cwe.Identifier != "A" || cwe.NameOfCodingSystem != "LN" {
+		t.Errorf("got value %#v", obs[0].OBX.ObservationValue)
+	}
+	if v := obs[1].OBX.ObservationValue; len(v) != 1 || v[0] != v251.ST("text & more") {
+		t.Errorf("got value %#v", v)
+	}
+
+	if _, err := NewEncoder(nil).EncodeProto([]any{oru.MSH}); err == nil {
+		t.Errorf("expected an error for a segment list")
+	}
+	for _, bad := range [][]byte{
+		nil,
+		{0x12, 0x00},
+		{0x0a, 0x05, 0x01},
+		{0x0b},
+	} {
+		if _, err := d.DecodeProto(bad); err == nil {
+			t.Errorf("expected an error for %x", bad)
+		}
+	}
+}
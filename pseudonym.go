@@ -0,0 +1,68 @@
+package hl7
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// PseudonymOption sets the surrogates of a Pseudonymizer.
+type PseudonymOption struct {
+	// Prefix is written before each surrogate, such as P to mark it as not a real identifier.
+	Prefix string
+
+	// Length is the number of characters of each surrogate after the prefix, 16 if zero.
+	// It is at most 64, or at most 32 for Digits.
+	Length int
+
+	// Digits writes surrogates of decimal digits rather than hex, for systems that expect numeric identifiers.
+	Digits bool
+}
+
+// Pseudonymizer is a Redactor that replaces each value with a surrogate from a keyed hash (HMAC-SHA256) of the value,
+// so the same identifier, such as an MRN, always has the same surrogate across a message stream while the key is kept.
+// The surrogate does not depend on the path, so an identifier sent in both PID-3 and MRG-1 stays linked.
+// Without the key, the surrogates cannot be reversed by hashing possible values.
+// A Pseudonymizer may be used by multiple goroutines.
+type Pseudonymizer struct {
+	key []byte
+	opt PseudonymOption
+}
+
+var _ Redactor = &Pseudonymizer{}
+
+// NewPseudonymizer returns a Pseudonymizer with the secret key, which should be at least 32 random bytes.
+// Option may be nil.
+func NewPseudonymizer(key []byte, opt *PseudonymOption) *Pseudonymizer {
+	p := &Pseudonymizer{key: append([]byte{}, key...)}
+	if opt != nil {
+		p.opt = *opt
+	}
+	max := 2 * sha256.Size
+	if p.opt.Digits {
+		max = sha256.Size
+	}
+	if p.opt.Length <= 0 {
+		p.opt.Length = 16
+	}
+	if p.opt.Length > max {
+		p.opt.Length = max
+	}
+	return p
+}
+
+// Redact returns the surrogate of the value.
+func (p *Pseudonymizer) Redact(path, value string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(value))
+	sum := mac.Sum(nil)
+	if !p.opt.Digits {
+		return p.opt.Prefix + hex.EncodeToString(sum)[:p.opt.Length]
+	}
+	buf := make([]byte, 0, len(p.opt.Prefix)+p.opt.Length)
+	buf = append(buf, p.opt.Prefix...)
+	for _, b := range sum[:p.opt.Length] {
+		buf = append(buf, '0'+b%10)
+	}
+	return string(buf)
+}
@@ -0,0 +1,45 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPseudonymizer(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	p := NewPseudonymizer(key, nil)
+	a := p.Redact("PID-3.1", "123")
+	if len(a) != 16 || a == "123" {
+		t.Errorf("got %q", a)
+	}
+	if b := p.Redact("MRG-1.1", "123"); b != a {
+		t.Errorf("same value has surrogates %q and %q", a, b)
+	}
+	if b := p.Redact("PID-3.1", "124"); b == a {
+		t.Errorf("different values have the surrogate %q", a)
+	}
+	if b := NewPseudonymizer([]byte("other key"), nil).Redact("PID-3.1", "123"); b == a {
+		t.Errorf("different keys have the surrogate %q", a)
+	}
+
+	d := NewPseudonymizer(key, &PseudonymOption{Prefix: "P", Length: 8, Digits: true})
+	v := d.Redact("PID-3.1", "123")
+	if len(v) != 9 || v[0] != 'P' || strings.Trim(v[1:], "0123456789") != "" {
+		t.Errorf("got %q", v)
+	}
+
+	// The same MRN in two messages has the same surrogate.
+	m1 := "MSH|^~\\&|A|B|||||ADT^A01|1|P|2.5.1\rPID|||123^^^MRN||Doe"
+	m2 := "MSH|^~\\&|A|B|||||ADT^A08|2|P|2.5.1\rPID|||123^^^MRN||Doe^Jane"
+	r1, err := Redact(m1, []string{"PID-3.1"}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := Redact(m2, []string{"PID-3.1"}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(r1.(string), "|||"+a+"^^^MRN") || !strings.Contains(r2.(string), "|||"+a+"^^^MRN") {
+		t.Errorf("got:\n%s\n%s", r1, r2)
+	}
+}
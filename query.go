@@ -0,0 +1,349 @@
+package hl7
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// A query by parameter message, such as QBP^Q22, sends the query parameters in QPD-3
+// and the following fields, which the registry QPD segments declare as a single VARIES field.
+// The registry QPD segment decodes QPD-3 and the following fields as a single Raw value;
+// use SetQueryParameters and QueryParameters to set and read them as typed values.
+// Alternatively, declare a QPD segment for the query with each parameter field,
+// replace the registry QPD segment with it using MapRegistry.AddSegment, and encode and decode
+// the query as a segment list, as the registry trigger structures contain the registry QPD segment.
+
+// qpdParameters is the field number of the first query parameter of a QPD segment.
+const qpdParameters = 3
+
+// SetQueryParameters sets the QPD-3 and following fields of the registry QPD segment of a query to the parameters,
+// encoded with the default delimiters. Each parameter is a field value as declared on a segment field,
+// such as a string, a number, a data type such as QIP, or a slice of repetitions; a nil parameter is an empty field.
+// The query may be a QPD segment, a trigger, or a segment list, and must be passed by pointer.
+func SetQueryParameters(query any, params ...any) error {
+	fv, err := queryParameters(query)
+	if err != nil {
+		return err
+	}
+	if !fv.CanSet() {
+		return fmt.Errorf("QPD segment must be passed by pointer")
+	}
+	e := NewEncoder(nil)
+	e.init("", "")
+	for i, p := range params {
+		if i != 0 {
+			e.writeSep(0, 0, true)
+		}
+		order := qpdParameters + i
+		e.path = "QPD." + strconv.Itoa(order)
+		err := e.encodeDataType(tag{Present: true, Order: int32(order)}, p, 0)
+		if err != nil {
+			return fmt.Errorf("QPD-%d: %w", order, err)
+		}
+	}
+	if fv.Kind() == reflect.Pointer {
+		fv.Set(reflect.New(fv.Type().Elem()))
+		fv = fv.Elem()
+	}
+	fv.Set(reflect.ValueOf(Raw(e.buf.String())))
+	return nil
+}
+
+// QueryParameters decodes the QPD-3 and following fields of the registry QPD segment of a query,
+// as received with the default delimiters, into the parameters. Each parameter is a pointer to a field value
+// as given to SetQueryParameters; a nil parameter skips the field. The query may be a QPD segment, a trigger,
+// or a segment list.
+func QueryParameters(query any, params ...any) error {
+	fv, err := queryParameters(query)
+	if err != nil {
+		return err
+	}
+	fv = reflect.Indirect(fv)
+	if !fv.IsValid() || fv.IsNil() {
+		return nil
+	}
+	raw, ok := fv.Elem().Interface().(Raw)
+	if !ok {
+		return fmt.Errorf("QPD-%d: got %v, want Raw", qpdParameters, fv.Elem().Type())
+	}
+	ld := &lineDecoder{}
+	ld.setDelimiters(DefaultDelimiters)
+	parts := bytes.Split([]byte(raw), []byte{ld.sep})
+	for i, p := range params {
+		if p == nil || i >= len(parts) {
+			continue
+		}
+		order := qpdParameters + i
+		rv := reflect.ValueOf(p)
+		if rv.Kind() != reflect.Pointer || rv.IsNil() {
+			return fmt.Errorf("QPD-%d: parameter must be a non-nil pointer, got %T", order, p)
+		}
+		ld.path = "QPD." + strconv.Itoa(order)
+		err := ld.decodeSegmentList(parts[i], tag{Present: true, Order: int32(order)}, rv.Elem(), nil)
+		if err != nil {
+			return fmt.Errorf("QPD-%d: %w", order, err)
+		}
+	}
+	return nil
+}
+
+// queryParameters returns the QPD-3 VARIES field of the QPD segment of the query.
+func queryParameters(query any) (reflect.Value, error) {
+	rv, ok := findSegment(query, "QPD")
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("QPD segment not found")
+	}
+	_, fv, _, ok := fieldByOrder(rv, qpdParameters)
+	if !ok || fv.Type().Kind() != reflect.Interface && (fv.Kind() != reflect.Pointer || fv.Type().Elem().Kind() != reflect.Interface) {
+		return reflect.Value{}, fmt.Errorf("QPD-%d must be a VARIES field", qpdParameters)
+	}
+	return fv, nil
+}
+
+// QueryResponse is a query response message, such as RSP^K22, split into its parts.
+// The segments are of the registry version, or user defined segments such as a QPD segment with typed parameters.
+type QueryResponse struct {
+	MSH    any
+	MSA    any
+	ERR    []any
+	QAK    any
+	QPD    any
+	Result []any // Segments after the QPD segment in the order received, such as PID.
+	DSC    any   // Continuation pointer segment, nil if not present.
+
+	Tag           string // QAK-1 query tag.
+	Status        string // QAK-2 query response status, such as OK or NF.
+	HitCount      int    // QAK-4 total number of matches, or -1 if not sent.
+	ThisPayload   int    // QAK-5 number of matches in this response, or -1 if not sent.
+	HitsRemaining int    // QAK-6 number of matches not yet sent, or -1 if not sent.
+}
+
+// ParseQueryResponse splits a query response into its parts. The message may be a trigger or a segment list.
+func ParseQueryResponse(message any) (*QueryResponse, error) {
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+
+	r := &QueryResponse{HitCount: -1, ThisPayload: -1, HitsRemaining: -1}
+	for _, rv := range m.segs {
+		seg := rv.Interface()
+		if rv.CanAddr() {
+			seg = rv.Addr().Interface()
+		}
+		name := segmentName(rv)
+		switch {
+		case r.QPD != nil:
+			if name == "DSC" {
+				r.DSC = seg
+				continue
+			}
+			r.Result = append(r.Result, seg)
+		case name == "MSH":
+			r.MSH = seg
+		case name == "MSA":
+			r.MSA = seg
+		case name == "ERR":
+			r.ERR = append(r.ERR, seg)
+		case name == "QAK":
+			r.QAK = seg
+			err := r.status(rv)
+			if err != nil {
+				return nil, err
+			}
+		case name == "QPD":
+			r.QPD = seg
+		}
+	}
+	if r.MSH == nil || r.QAK == nil || r.QPD == nil {
+		return nil, fmt.Errorf("query response must contain MSH, QAK, and QPD segments")
+	}
+	return r, nil
+}
+
+// status reads the query status from the QAK segment.
+func (r *QueryResponse) status(rv reflect.Value) error {
+	r.Tag = stringField(rv, 1)
+	r.Status = stringField(rv, 2)
+	for order, p := range map[int]*int{4: &r.HitCount, 5: &r.ThisPayload, 6: &r.HitsRemaining} {
+		_, fv, _, ok := fieldByOrder(rv, order)
+		if !ok {
+			continue
+		}
+		n, set, err := intValue(fv)
+		if err != nil {
+			return fmt.Errorf("QAK-%d: %w", order, err)
+		}
+		if set {
+			*p = n
+		}
+	}
+	return nil
+}
+
+// stringField returns the value of a string field of the segment by field number, or empty.
+func stringField(rv reflect.Value, order int) string {
+	_, fv, _, ok := fieldByOrder(rv, order)
+	if !ok || fv.Kind() != reflect.String {
+		return ""
+	}
+	return fv.String()
+}
+
+// Continuation returns the DSC-1 continuation pointer of the response, or empty if this is the last page.
+func (r *QueryResponse) Continuation() string {
+	return QueryContinuation(r.DSC)
+}
+
+// QueryContinuation returns the DSC-1 continuation pointer of a query or response, or empty if none.
+// The message may be a trigger, a segment list, or a DSC segment.
+func QueryContinuation(message any) string {
+	rv, ok := findSegment(message, "DSC")
+	if !ok {
+		return ""
+	}
+	return stringField(rv, dscContinuationPointer)
+}
+
+// QueryLimit returns the RCP-2 quantity limit of a query, or zero if the query is not limited.
+// The quantity is taken as a number of records, the usual RD unit.
+// The query may be a trigger or a segment list.
+func QueryLimit(query any) (int, error) {
+	rv, ok := findSegment(query, "RCP")
+	if !ok {
+		return 0, nil
+	}
+	_, fv, _, ok := fieldByOrder(rv, 2)
+	if !ok {
+		return 0, nil
+	}
+	fv = reflect.Indirect(fv)
+	if fv.Kind() == reflect.Struct {
+		_, fv, _, ok = fieldByOrder(fv, 1)
+		if !ok {
+			return 0, nil
+		}
+	}
+	n, _, err := intValue(fv)
+	if err != nil {
+		return 0, fmt.Errorf("RCP-2: %w", err)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("RCP-2: negative quantity %d", n)
+	}
+	return n, nil
+}
+
+// QueryPage is the part of the query results to send in one response.
+type QueryPage struct {
+	Start int // Index of the first result to send.
+	End   int // Index after the last result to send.
+
+	HitCount      int // QAK-4 total number of results.
+	ThisPayload   int // QAK-5 number of results in this response.
+	HitsRemaining int // QAK-6 number of results after this response.
+
+	Continuation string // DSC-1 continuation pointer to send, empty if this is the last page.
+}
+
+// PageOption represents options for PageQuery.
+type PageOption struct {
+	// Pointer returns the continuation pointer for the results starting at the offset.
+	// If nil, the pointer is the decimal offset.
+	Pointer func(offset int) string
+
+	// Offset returns the offset of the results for a continuation pointer from Pointer.
+	// If nil, the pointer is parsed as a decimal offset.
+	Offset func(pointer string) (int, error)
+}
+
+// PageQuery returns the page of total results to send in response to the query, honoring the RCP-2 quantity limit
+// and starting at the DSC-1 continuation pointer of the query, if any. Option may be nil.
+func PageQuery(query any, total int, opt *PageOption) (QueryPage, error) {
+	if opt == nil {
+		opt = &PageOption{}
+	}
+	limit, err := QueryLimit(query)
+	if err != nil {
+		return QueryPage{}, err
+	}
+	start := 0
+	if ptr := QueryContinuation(query); len(ptr) > 0 {
+		if opt.Offset != nil {
+			start, err = opt.Offset(ptr)
+		} else {
+			start, err = strconv.Atoi(ptr)
+		}
+		if err != nil {
+			return QueryPage{}, fmt.Errorf("continuation pointer %q: %w", ptr, err)
+		}
+		if start < 0 || start > total {
+			return QueryPage{}, fmt.Errorf("continuation pointer %q: offset %d out of range", ptr, start)
+		}
+	}
+	end := total
+	if limit > 0 && start+limit < total {
+		end = start + limit
+	}
+	p := QueryPage{
+		Start:         start,
+		End:           end,
+		HitCount:      total,
+		ThisPayload:   end - start,
+		HitsRemaining: total - end,
+	}
+	if end < total {
+		if opt.Pointer != nil {
+			p.Continuation = opt.Pointer(end)
+		} else {
+			p.Continuation = strconv.Itoa(end)
+		}
+	}
+	return p, nil
+}
+
+// ContinueQuery returns a copy of the query segment list to request the next page of results
+// at the continuation pointer of the previous response, with a DSC segment from the registry.
+func ContinueQuery(query []any, registry Registry, pointer string) ([]any, error) {
+	dsc, err := ContinuationSegment(registry, pointer)
+	if err != nil {
+		return nil, err
+	}
+	next := make([]any, 0, len(query)+1)
+	for _, seg := range query {
+		if segmentName(reflect.ValueOf(seg)) == "DSC" {
+			continue
+		}
+		next = append(next, seg)
+	}
+	return append(next, dsc), nil
+}
+
+// ContinuationSegment returns a new registry DSC segment with the continuation pointer
+// and the interactive continuation style, to end a page of query results or to request the next page.
+func ContinuationSegment(registry Registry, pointer string) (any, error) {
+	seg, ok := registry.Segment()["DSC"]
+	if !ok {
+		return nil, fmt.Errorf("DSC segment not found in registry")
+	}
+	rv := reflect.New(reflect.TypeOf(seg))
+	for order, v := range map[int]string{dscContinuationPointer: pointer, 2: "I"} {
+		_, fv, _, ok := fieldByOrder(rv.Elem(), order)
+		if ok && fv.Kind() == reflect.String {
+			fv.SetString(v)
+		}
+	}
+	return rv.Interface(), nil
+}
+
+// findSegment returns the first segment with the name in the message.
+func findSegment(message any, name string) (reflect.Value, bool) {
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+	for _, rv := range m.segs {
+		if segmentName(rv) == name {
+			return rv, true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,234 @@
+package hl7
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+// testQPDQ22 is the QPD segment of the find candidates query with typed parameters.
+type testQPDQ22 struct {
+	HL7                       struct{}   `hl7:",name=QPD,type=s"`
+	MessageQueryName          v251.CE    `hl7:"1,required"`
+	QueryTag                  string     `hl7:"2"`
+	DemographicsFields        []v251.QIP `hl7:"3"`
+	SearchConfidenceThreshold string     `hl7:"4"`
+	AlgorithmName             string     `hl7:"5"`
+}
+
+func TestQuery(t *testing.T) {
+	reg := CloneRegistry(v251.Registry)
+	if err := reg.AddSegment(MergeReplace, testQPDQ22{}); err != nil {
+		t.Fatal(err)
+	}
+
+	query := []any{
+		&v251.MSH{
+			MessageType:      v251.MSG{MessageCode: "QBP", TriggerEvent: "Q22", MessageStructure: "QBP_Q21"},
+			MessageControlID: "Q1",
+			ProcessingID:     v251.PT{ProcessingID: "P"},
+			VersionID:        v251.VID{VersionID: "2.5.1"},
+		},
+		&testQPDQ22{
+			MessageQueryName: v251.CE{Identifier: "Q22", Text: "Find Candidates", NameOfCodingSystem: "HL7"},
+			QueryTag:         "T1",
+			DemographicsFields: []v251.QIP{
+				{SegmentFieldName: "@PID.5.1", Values: "DOE"},
+				{SegmentFieldName: "@PID.7", Values: "19700101"},
+			},
+			SearchConfidenceThreshold: "80",
+		},
+		&v251.RCP{QueryPriority: "I", QuantityLimitedRequest: &v251.CQ{Quantity: "10", Units: &v251.CE{Identifier: "RD"}}},
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|||||||QBP^Q22^QBP_Q21|Q1|P|2.5.1\r" +
+		"QPD|Q22^Find Candidates^HL7|T1|@PID.5.1^DOE~@PID.7^19700101|80\r" +
+		"RCP|I|10^RD"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q", got)
+	}
+
+	rsp := "MSH|^~\\&|||||||RSP^K22^RSP_K21|R1|P|2.5.1\r" +
+		"MSA|AA|Q1\r" +
+		"QAK|T1|OK|Q22^Find Candidates^HL7|2|1|1\r" +
+		"QPD|Q22^Find Candidates^HL7|T1|@PID.5.1^DOE~@PID.7^19700101|80\r" +
+		"PID|1||123^^^HOSP||DOE^JOHN\r" +
+		"DSC|P2|I\r"
+	list, err := NewDecoder(reg, nil).DecodeList([]byte(rsp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ParseQueryResponse(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Tag != "T1" || r.Status != "OK" || r.HitCount != 2 || r.ThisPayload != 1 || r.HitsRemaining != 1 {
+		t.Fatalf("got status %+v", r)
+	}
+	qpd := r.QPD.(*testQPDQ22)
+	if len(qpd.DemographicsFields) != 2 || qpd.DemographicsFields[1].Values != "19700101" || qpd.SearchConfidenceThreshold != "80" {
+		t.Fatalf("got QPD %+v", qpd)
+	}
+	if len(r.Result) != 1 || r.DSC.(*v251.DSC).ContinuationPointer != "P2" {
+		t.Fatalf("got result %v, DSC %v", r.Result, r.DSC)
+	}
+}
+
+func TestQueryResponseRegistryQPD(t *testing.T) {
+	rsp := "MSH|^~\\&|||||||RSP^K22^RSP_K21|R1|P|2.5.1\r" +
+		"MSA|AA|Q1\r" +
+		"QAK|T1|NF\r" +
+		"QPD|Q22^Find Candidates^HL7|T1|@PID.5.1^DOE\r"
+	msg, err := NewDecoder(v251.Registry, nil).Decode([]byte(rsp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ParseQueryResponse(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Status != "NF" || r.HitCount != -1 || len(r.Result) != 0 {
+		t.Fatalf("got %+v", r)
+	}
+	qpd := r.QPD.(*v251.QPD)
+	if got := *qpd.UserParametersInSuccessiveFields; got != Raw("@PID.5.1^DOE") {
+		t.Fatalf("got raw parameter %#v", got)
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(bb), "\r"); got != strings.TrimRight(rsp, "\r") {
+		t.Fatalf("got %q", got)
+	}
+
+	if _, err := ParseQueryResponse([]any{}); err == nil {
+		t.Fatal("expected error for empty response")
+	}
+}
+
+func TestQueryParameters(t *testing.T) {
+	qpd := &v251.QPD{MessageQueryName: v251.CE{Identifier: "Q22", Text: "Find Candidates", NameOfCodingSystem: "HL7"}, QueryTag: "T1"}
+	demographics := []v251.QIP{
+		{SegmentFieldName: "@PID.5.1", Values: "DOE"},
+		{SegmentFieldName: "@PID.7", Values: "19700101"},
+	}
+	threshold := 80
+	if err := SetQueryParameters(qpd, demographics, &threshold, nil, "A|B"); err != nil {
+		t.Fatal(err)
+	}
+	query := []any{
+		&v251.MSH{
+			MessageType:      v251.MSG{MessageCode: "QBP", TriggerEvent: "Q22", MessageStructure: "QBP_Q21"},
+			MessageControlID: "Q1",
+			ProcessingID:     v251.PT{ProcessingID: "P"},
+			VersionID:        v251.VID{VersionID: "2.5.1"},
+		},
+		qpd,
+		&v251.RCP{QueryPriority: "I"},
+	}
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MSH|^~\\&|||||||QBP^Q22^QBP_Q21|Q1|P|2.5.1\r" +
+		"QPD|Q22^Find Candidates^HL7|T1|@PID.5.1^DOE~@PID.7^19700101|80||A\\F\\B\r" +
+		"RCP|I"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q", got)
+	}
+
+	msg, err := NewDecoder(v251.Registry, nil).Decode(bb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		gotDemographics []v251.QIP
+		gotThreshold    int
+		gotAlgorithm    string
+		gotName         string
+	)
+	if err := QueryParameters(msg, &gotDemographics, &gotThreshold, &gotAlgorithm, &gotName); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotDemographics, demographics) || gotThreshold != 80 || gotAlgorithm != "" || gotName != "A|B" {
+		t.Fatalf("got %v, %d, %q, %q", gotDemographics, gotThreshold, gotAlgorithm, gotName)
+	}
+	// The decoded message encodes the parameter fields as received.
+	bb, err = NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q", got)
+	}
+
+	if err := SetQueryParameters(v251.QPD{}, "x"); err == nil {
+		t.Fatal("expected error for a QPD segment not passed by pointer")
+	}
+	if err := QueryParameters(&testQPDQ22{}, &gotName); err == nil || !strings.Contains(err.Error(), "VARIES") {
+		t.Fatalf("expected VARIES error, got %v", err)
+	}
+}
+
+func TestQueryPaging(t *testing.T) {
+	query := []any{
+		&v251.MSH{MessageType: v251.MSG{MessageCode: "QBP", TriggerEvent: "Q22", MessageStructure: "QBP_Q21"}},
+		&v251.QPD{MessageQueryName: v251.CE{Identifier: "Q22"}, QueryTag: "T1"},
+		&v251.RCP{QueryPriority: "I", QuantityLimitedRequest: &v251.CQ{Quantity: "2", Units: &v251.CE{Identifier: "RD"}}},
+	}
+	if n, err := QueryLimit(query); err != nil || n != 2 {
+		t.Fatalf("got limit %d, %v", n, err)
+	}
+
+	// Server side, first page of five results.
+	p, err := PageQuery(query, 5, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != (QueryPage{Start: 0, End: 2, HitCount: 5, ThisPayload: 2, HitsRemaining: 3, Continuation: "2"}) {
+		t.Fatalf("got page %+v", p)
+	}
+	dsc, err := ContinuationSegment(v251.Registry, p.Continuation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp := []any{&v251.MSH{}, &v251.QAK{QueryTag: "T1", QueryResponseStatus: "OK"}, &v251.QPD{}, &v251.PID{}, &v251.PID{}, dsc}
+	r, err := ParseQueryResponse(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Client side, request the following pages until there is no continuation pointer.
+	var pages []QueryPage
+	for ptr := r.Continuation(); len(ptr) > 0; ptr = p.Continuation {
+		query, err = ContinueQuery(query, v251.Registry, ptr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(query) != 4 || QueryContinuation(query) != ptr {
+			t.Fatalf("got query %v", query)
+		}
+		p, err = PageQuery(query, 5, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, p)
+	}
+	if len(pages) != 2 || pages[0].Start != 2 || pages[0].End != 4 || pages[1].Start != 4 || pages[1].End != 5 || pages[1].HitsRemaining != 0 {
+		t.Fatalf("got pages %+v", pages)
+	}
+
+	bad, _ := ContinueQuery(query, v251.Registry, "9")
+	if _, err := PageQuery(bad, 5, nil); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("expected range error, got %v", err)
+	}
+	if p, err := PageQuery(query[:2], 5, nil); err != nil || p.End != 5 || len(p.Continuation) != 0 {
+		t.Fatalf("unlimited query got %+v, %v", p, err)
+	}
+}
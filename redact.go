@@ -0,0 +1,356 @@
+package hl7
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Redactor returns the replacement of a value at an HL7 path, such as PID-5.1, for Redact.
+type Redactor interface {
+	Redact(path, value string) string
+}
+
+// RedactorFunc is a function that implements Redactor.
+type RedactorFunc func(path, value string) string
+
+func (f RedactorFunc) Redact(path, value string) string {
+	return f(path, value)
+}
+
+// RedactBlank removes each value.
+var RedactBlank Redactor = RedactorFunc(func(path, value string) string {
+	return ""
+})
+
+// RedactReplace replaces each value with the text, such as XXX.
+func RedactReplace(text string) Redactor {
+	return RedactorFunc(func(path, value string) string {
+		return text
+	})
+}
+
+// RedactHash replaces each value with the first 16 hex digits of its SHA-256 hash, so equal values stay equal.
+// As the hash is not keyed, a short value, such as a date, may be found by hashing each possible value;
+// use a Pseudonymizer where that matters.
+var RedactHash Redactor = RedactorFunc(func(path, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:8])
+})
+
+// Redact returns the message with each value at the paths replaced by the Redactor, for producing shareable test data.
+// The message is raw HL7 as a []byte or string, returned as the same type, or a decoded trigger or segment list,
+// returned as a redacted Clone of the message.
+//
+// Paths are written as for DiffOption.Ignore: a path such as PID-5 matches each repetition, component,
+// and subcomponent within it, in each PID segment; OBX[2]-5 matches the second OBX only; and a segment name
+// alone matches each of its fields. The Redactor is called with the path and text of each value sent,
+// except for explicit nulls. In a decoded message, a value that is not text, such as a date, is cleared
+// unless the Redactor returns it unchanged. If paths is nil, the paths of all PHIFields are redacted.
+func Redact(message any, paths []string, r Redactor) (any, error) {
+	if paths == nil {
+		paths = PHIPaths(nil)
+	}
+	patterns, err := parsePathPatterns(paths)
+	if err != nil {
+		return nil, fmt.Errorf("redact: %w", err)
+	}
+	switch m := message.(type) {
+	case []byte:
+		return redactRaw(m, patterns, r)
+	case string:
+		b, err := redactRaw([]byte(m), patterns, r)
+		return string(b), err
+	}
+	if message == nil {
+		return nil, fmt.Errorf("redact: no message")
+	}
+	// The copy is addressable so the fields of its segments may be set.
+	rv := reflect.New(reflect.TypeOf(message)).Elem()
+	rv.Set(reflect.ValueOf(Clone(message)))
+	pm := &profileMatcher{}
+	pm.flatten(rv)
+	rd := &redactor{
+		r:        r,
+		patterns: patterns,
+		b:        &treeBuilder{e: NewEncoder(nil)},
+		count:    map[string]int{},
+	}
+	for _, seg := range pm.segs {
+		if err := rd.segment(seg); err != nil {
+			return nil, fmt.Errorf("redact: %w", err)
+		}
+	}
+	return rv.Interface(), nil
+}
+
+type redactor struct {
+	r        Redactor
+	patterns []pathPattern
+	b        *treeBuilder // Formats values that are not text.
+	count    map[string]int
+}
+
+func (rd *redactor) segment(rv reflect.Value) error {
+	name := segmentName(rv)
+	rd.count[name]++
+	seg := name
+	if c := rd.count[name]; c > 1 {
+		seg += "[" + strconv.Itoa(c) + "]"
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			return err
+		}
+		if !t.Present || t.Meta || t.Order <= 0 || t.FieldSep || t.FieldChars {
+			continue
+		}
+		f := int(t.Order)
+		if !(diffKey{seg: seg, pos: [3]int{f}}).matchesWithin(rd.patterns) {
+			continue
+		}
+		fv := rv.Field(i)
+		if !fv.CanSet() {
+			return fmt.Errorf("%s-%d of %v cannot be set, pass pointers to the segments", name, f, rt)
+		}
+		if err := rd.value(seg+"-"+strconv.Itoa(f), t, fv, [3]int{f}, 1); err != nil {
+			return fmt.Errorf("%s-%d: %w", name, f, err)
+		}
+	}
+	return nil
+}
+
+// value redacts a field, component, or subcomponent at the level, 1 for a component.
+func (rd *redactor) value(path string, t tag, rv reflect.Value, pos [3]int, level int) error {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return rd.value(path, t, rv.Elem(), pos, level)
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		e := reflect.New(rv.Elem().Type()).Elem()
+		e.Set(rv.Elem())
+		if err := rd.value(path, t, e, pos, level); err != nil {
+			return err
+		}
+		rv.Set(e)
+		return nil
+	case reflect.Slice:
+		if rv.Type() == byteSliceType {
+			break
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := rd.value(path, t, rv.Index(i), pos, level); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil {
+			return err
+		}
+		if !meta.Meta {
+			break
+		}
+		if level >= len(pos) {
+			return fmt.Errorf("%s is nested too deep", path)
+		}
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil {
+				return err
+			}
+			if !ct.Present || ct.Meta || ct.Order <= 0 {
+				continue
+			}
+			cpos := pos
+			cpos[level] = int(ct.Order)
+			if err := rd.value(path+"."+strconv.Itoa(int(ct.Order)), ct, rv.Field(i), cpos, level+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// A value starts at component and subcomponent 1.
+	for l := level; l < len(pos); l++ {
+		pos[l] = 1
+	}
+	if !(diffKey{seg: segmentOf(path), pos: pos}).matches(rd.patterns) {
+		return nil
+	}
+	var text string
+	if rv.Kind() == reflect.String {
+		text = rv.String()
+	} else {
+		nodes, err := rd.b.value(path, t, rv.Interface())
+		if err != nil {
+			return err
+		}
+		if len(nodes) > 0 {
+			text = nodes[0].Text
+		}
+	}
+	if len(text) == 0 || text == nullValue {
+		return nil
+	}
+	v := rd.r.Redact(path, text)
+	switch {
+	case v == text:
+	case rv.Kind() == reflect.String:
+		rv.SetString(v)
+	default:
+		rv.Set(reflect.Zero(rv.Type()))
+	}
+	return nil
+}
+
+// segmentOf returns the segment of a path, such as OBX[2] for OBX[2]-5.1.
+func segmentOf(path string) string {
+	if i := strings.IndexByte(path, '-'); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+// matchesWithin reports if a pattern may match a value within the field, component, or subcomponent of the key.
+func (k diffKey) matchesWithin(list []pathPattern) bool {
+	for _, p := range list {
+		within := p
+		for i, v := range k.pos {
+			if v == 0 {
+				within.pos[i] = 0
+			}
+		}
+		if k.matches([]pathPattern{within}) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactRaw redacts the values of a raw message, leaving the rest of the message as sent.
+func redactRaw(data []byte, patterns []pathPattern, r Redactor) ([]byte, error) {
+	ld := &lineDecoder{}
+	e := NewEncoder(nil)
+	count := map[string]int{}
+	out := &bytes.Buffer{}
+	out.Grow(len(data))
+	lineNumber := 0
+	for len(data) > 0 {
+		end := bytes.IndexAny(data, "\r\n")
+		if end < 0 {
+			end = len(data)
+		}
+		line := data[:end]
+		var term []byte
+		if end < len(data) {
+			term = data[end : end+1]
+		}
+		data = data[len(line)+len(term):]
+		if len(line) == 0 {
+			out.Write(term)
+			continue
+		}
+		lineNumber++
+		v, err := redactLine(line, ld, e, count, patterns, r)
+		if err != nil {
+			return nil, fmt.Errorf("redact: line %d: %w", lineNumber, err)
+		}
+		out.Write(v)
+		out.Write(term)
+	}
+	return out.Bytes(), nil
+}
+
+func redactLine(line []byte, ld *lineDecoder, e *Encoder, count map[string]int, patterns []pathPattern, r Redactor) ([]byte, error) {
+	name, n := ld.getID(line)
+	if len(name) == 0 {
+		return nil, fmt.Errorf("missing segment type")
+	}
+	prefix := line[:n]
+	remain := line[n:]
+	first := 1
+	switch name {
+	case "MSH", "BHS", "FHS":
+		dl, size, err := parseInitDelimiters(remain)
+		if err != nil {
+			return nil, err
+		}
+		ld.setDelimiters(dl)
+		e.init(string(ld.sep), ld.encodingChars())
+		prefix = line[:n+size]
+		remain = remain[size:]
+		first = 3
+	}
+	if ld.sep == 0 {
+		return nil, fmt.Errorf("missing sep prior to field")
+	}
+	count[name]++
+	seg := name
+	if c := count[name]; c > 1 {
+		seg += "[" + strconv.Itoa(c) + "]"
+	}
+	if len(remain) == 0 {
+		return line, nil
+	}
+	out := append([]byte{}, prefix...)
+	// The segment ID or encoding characters are followed by a field separator.
+	for i, field := range bytes.Split(remain[1:], []byte{ld.sep}) {
+		f := first + i
+		out = append(out, ld.sep)
+		if !(diffKey{seg: seg, pos: [3]int{f}}).matchesWithin(patterns) {
+			out = append(out, field...)
+			continue
+		}
+		reps := bytes.Split(field, []byte{ld.repeat})
+		for ri, rep := range reps {
+			comps := bytes.Split(rep, []byte{ld.dividers[1]})
+			for ci, comp := range comps {
+				subs := bytes.Split(comp, []byte{ld.dividers[2]})
+				for si, sub := range subs {
+					k := diffKey{seg: seg, pos: [3]int{f, ci + 1, si + 1}}
+					if len(sub) == 0 || string(sub) == nullValue || !k.matches(patterns) {
+						continue
+					}
+					text, err := ld.unescape(sub)
+					if err != nil {
+						return nil, err
+					}
+					path := seg + "-" + strconv.Itoa(f)
+					if len(comps) > 1 || len(subs) > 1 {
+						path += "." + strconv.Itoa(ci+1)
+					}
+					if len(subs) > 1 {
+						path += "." + strconv.Itoa(si+1)
+					}
+					v := r.Redact(path, text)
+					if v == text {
+						continue
+					}
+					e.buf.Reset()
+					e.write(v, 0, false)
+					subs[si] = append([]byte{}, e.buf.Bytes()...)
+				}
+				comps[ci] = bytes.Join(subs, []byte{ld.dividers[2]})
+			}
+			reps[ri] = bytes.Join(comps, []byte{ld.dividers[1]})
+		}
+		out = append(out, bytes.Join(reps, []byte{ld.repeat})...)
+	}
+	return out, nil
+}
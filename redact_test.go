@@ -0,0 +1,104 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestRedactRaw(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|1||123^^^MRN&1.2&ISO^MR~456||Doe^Jane~Roe^J||19800101|F|||1 Main St^^City||""`,
+		`PID|2||789||Smith`,
+		``,
+	}, "\r")
+	got, err := Redact([]byte(raw), []string{"PID-3.1", "PID-3.4.2", "PID[2]-5", "PID-11", "PID-13"}, RedactReplace("X|Y"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|1||X\F\Y^^^MRN&X\F\Y&ISO^MR~X\F\Y||Doe^Jane~Roe^J||19800101|F|||X\F\Y^^X\F\Y||""`,
+		`PID|2||X\F\Y||X\F\Y`,
+		``,
+	}, "\r")
+	if string(got.([]byte)) != want {
+		t.Errorf("got:\n%s", lineDiff(got.([]byte), []byte(want)))
+	}
+
+	var paths []string
+	_, err = Redact(raw, []string{"PID-5"}, RedactorFunc(func(path, value string) string {
+		paths = append(paths, path+"="+value)
+		return value
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(paths, " "), "PID-5.1=Doe PID-5.2=Jane PID-5.1=Roe PID-5.2=J PID[2]-5=Smith"; got != want {
+		t.Errorf("got paths %s", got)
+	}
+
+	s, err := Redact(raw, []string{"PID-5"}, RedactHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(s.(string), "Doe") || !strings.Contains(s.(string), "|1||123") {
+		t.Errorf("got %s", s)
+	}
+
+	if _, err := Redact(raw, []string{"PID-X"}, RedactBlank); err == nil {
+		t.Errorf("expected an error for an invalid path")
+	}
+}
+
+func TestRedactDecoded(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||123^^^MRN^MR~456||Doe^Jane||19800101|F`,
+		`OBR|1||X|CBC`,
+		`OBX|1|ST|NAME||Jane Doe`,
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Redact(msg, []string{"PID-3.1", "PID-5", "PID-7", "OBX-5"}, RedactReplace("XXX"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oru := got.(v251.ORU_R01)
+	pid := oru.PatientResult[0].Patient.PID
+	if ids := pid.PatientIdentifierList; ids[0].IDNumber != "XXX" || ids[1].IDNumber != "XXX" || ids[0].AssigningAuthority.NamespaceID != "MRN" {
+		t.Errorf("got identifiers %+v", ids)
+	}
+	if n := pid.PatientName[0]; n.FamilyName != "XXX" || n.GivenName != "XXX" {
+		t.Errorf("got name %+v", n)
+	}
+	if !pid.DateTimeOfBirth.IsZero() {
+		t.Errorf("got birth date %v", pid.DateTimeOfBirth)
+	}
+	if v := oru.PatientResult[0].OrderObservation[0].Observation[0].OBX.ObservationValue; v[0] != v251.ST("XXX") {
+		t.Errorf("got value %#v", v)
+	}
+	// The original is not changed.
+	if orig := msg.(v251.ORU_R01).PatientResult[0].Patient.PID; orig.PatientName[0].FamilyName != "Doe" || orig.DateTimeOfBirth.IsZero() {
+		t.Errorf("original changed: %+v", orig.PatientName)
+	}
+
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lr, err := Redact(list, []string{"PID"}, RedactBlank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := lr.([]any)[1].(*v251.PID); len(p.PatientName) != 1 || p.PatientName[0].FamilyName != "" || p.AdministrativeSex != "" {
+		t.Errorf("got %+v", p)
+	}
+}
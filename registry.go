@@ -0,0 +1,403 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RegistryError lists each problem found in a registry.
+type RegistryError []error
+
+func (re RegistryError) Error() string {
+	ss := make([]string, len(re))
+	for i, e := range re {
+		ss[i] = e.Error()
+	}
+	return strings.Join(ss, "; ")
+}
+
+// ValidateRegistry checks the structs of a registry for problems that would otherwise
+// appear as decode errors: invalid tags, duplicate orders, orders exceeding the declared size,
+// meta names that do not match the registry key, and unsupported field types.
+// If there are problems, the error is a RegistryError.
+func ValidateRegistry(r Registry) error {
+	c := &registryChecker{seen: map[reflect.Type]bool{}}
+	c.lookup("control segment", r.ControlSegment(), structSegment)
+	c.lookup("segment", r.Segment(), structSegment)
+	c.lookup("trigger", r.Trigger(), structTrigger)
+	c.lookup("data type", r.DataType(), structDataType)
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return c.errs
+}
+
+type registryChecker struct {
+	seen map[reflect.Type]bool
+	errs RegistryError
+}
+
+func (c *registryChecker) fail(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf(format, args...))
+}
+
+// lookup checks each registry entry in key order.
+// Data types may also be primitive types, and may be registered under an alias such as VARIES.
+func (c *registryChecker) lookup(kind string, lookup RegistryLookup, want structType) {
+	for _, k := range sortedKeys(lookup) {
+		rt := reflect.TypeOf(lookup[k])
+		if want == structDataType {
+			if rt != nil {
+				c.valueField(rt, k, rt)
+			}
+			continue
+		}
+		if rt == nil || rt.Kind() != reflect.Struct {
+			c.fail("%s %q: %v is not a struct", kind, k, rt)
+			continue
+		}
+		meta, err := (&Encoder{}).meta(rt)
+		if err != nil {
+			c.fail("%s %q: %w", kind, k, err)
+			continue
+		}
+		if !meta.Meta {
+			c.fail("%s %q: %v has no %s meta field", kind, k, rt, hl7MetaName)
+			continue
+		}
+		if meta.Name != k {
+			c.fail("%s %q: meta name %q does not match the registry key", kind, k, meta.Name)
+		}
+		if meta.Type != want {
+			c.fail("%s %q: meta type does not match the registry", kind, k)
+		}
+		c.check(rt)
+	}
+}
+
+// check checks the fields of a segment, data type, trigger, or group struct.
+func (c *registryChecker) check(rt reflect.Type) {
+	if c.seen[rt] {
+		return
+	}
+	c.seen[rt] = true
+
+	meta, _ := (&Encoder{}).meta(rt)
+	orders := map[int32]string{}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil {
+			c.fail("%v: %w", rt, err)
+			continue
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		if t.Order > 0 {
+			if other, ok := orders[t.Order]; ok && meta.Type != structTrigger && meta.Type != structTriggerGroup {
+				c.fail("%v: fields %s and %s have the same order %d", rt, other, ft.Name, t.Order)
+			}
+			orders[t.Order] = ft.Name
+			if meta.Order > 0 && t.Order > meta.Order {
+				c.fail("%v.%s: order %d exceeds the size %d", rt, ft.Name, t.Order, meta.Order)
+			}
+		}
+		switch meta.Type {
+		case structTrigger, structTriggerGroup:
+			c.triggerField(rt, ft)
+		default:
+			c.valueField(rt, ft.Name, ft.Type)
+		}
+	}
+}
+
+// triggerField checks a field of a trigger or group is a segment or group.
+func (c *registryChecker) triggerField(parent reflect.Type, ft reflect.StructField) {
+	et := ft.Type
+	if et.Kind() == reflect.Slice {
+		et = et.Elem()
+	}
+	if et.Kind() == reflect.Pointer {
+		et = et.Elem()
+	}
+	meta, err := (&Encoder{}).meta(et)
+	if err != nil || !meta.Meta || (meta.Type != structSegment && meta.Type != structTriggerGroup) {
+		c.fail("%v.%s: %v is not a segment or group", parent, ft.Name, ft.Type)
+		return
+	}
+	c.check(et)
+}
+
+var byteSliceType = reflect.TypeOf([]byte(nil))
+
+// valueField checks a field of a segment or data type is a supported type.
+func (c *registryChecker) valueField(parent reflect.Type, name string, ft reflect.Type) {
+	if ft == byteSliceType || ft == timeType {
+		return
+	}
+	if reflect.PointerTo(ft).Implements(reflect.TypeOf((*valueParser)(nil)).Elem()) {
+		return
+	}
+	switch k := ft.Kind(); {
+	case k == reflect.String, k == reflect.Interface, isNumber(k):
+		return
+	case k == reflect.Pointer, k == reflect.Slice:
+		c.valueField(parent, name, ft.Elem())
+		return
+	case k == reflect.Struct:
+		meta, err := (&Encoder{}).meta(ft)
+		if err == nil && meta.Meta && meta.Type == structDataType {
+			c.check(ft)
+			return
+		}
+	}
+	c.fail("%v.%s: unsupported field type %v", parent, name, ft)
+}
+
+// MapRegistry is a Registry with lookups that may be changed, such as a clone of a version
+// registry with site specific Z segments added.
+type MapRegistry struct {
+	HL7Version      string
+	ControlSegments RegistryLookup
+	Segments        RegistryLookup
+	Triggers        RegistryLookup
+	DataTypes       RegistryLookup
+}
+
+var _ Registry = &MapRegistry{}
+
+func (r *MapRegistry) Version() string                { return r.HL7Version }
+func (r *MapRegistry) ControlSegment() RegistryLookup { return r.ControlSegments }
+func (r *MapRegistry) Segment() RegistryLookup        { return r.Segments }
+func (r *MapRegistry) Trigger() RegistryLookup        { return r.Triggers }
+func (r *MapRegistry) DataType() RegistryLookup       { return r.DataTypes }
+
+// CloneRegistry returns a copy of the registry lookups, which may be changed without changing r.
+func CloneRegistry(r Registry) *MapRegistry {
+	return &MapRegistry{
+		HL7Version:      r.Version(),
+		ControlSegments: cloneLookup(r.ControlSegment()),
+		Segments:        cloneLookup(r.Segment()),
+		Triggers:        cloneLookup(r.Trigger()),
+		DataTypes:       cloneLookup(r.DataType()),
+	}
+}
+
+func cloneLookup(lookup RegistryLookup) RegistryLookup {
+	c := make(RegistryLookup, len(lookup))
+	for k, v := range lookup {
+		c[k] = v
+	}
+	return c
+}
+
+// MergePolicy selects how a merge handles a key registered in both registries with different types.
+type MergePolicy byte
+
+const (
+	MergeError   MergePolicy = iota // Return an error and do not change the registry.
+	MergeKeep                       // Keep the existing entry.
+	MergeReplace                    // Replace the existing entry.
+)
+
+// Merge adds the entries of other to r. The version of r is unchanged.
+func (r *MapRegistry) Merge(other Registry, policy MergePolicy) error {
+	for _, m := range []*RegistryLookup{&r.ControlSegments, &r.Segments, &r.Triggers, &r.DataTypes} {
+		if *m == nil {
+			*m = RegistryLookup{}
+		}
+	}
+	pairs := []struct {
+		kind     string
+		dst, src RegistryLookup
+	}{
+		{"control segment", r.ControlSegments, other.ControlSegment()},
+		{"segment", r.Segments, other.Segment()},
+		{"trigger", r.Triggers, other.Trigger()},
+		{"data type", r.DataTypes, other.DataType()},
+	}
+	if policy == MergeError {
+		var errs RegistryError
+		for _, p := range pairs {
+			for _, k := range sortedKeys(p.src) {
+				if v, ok := p.dst[k]; ok && reflect.TypeOf(v) != reflect.TypeOf(p.src[k]) {
+					errs = append(errs, fmt.Errorf("%s %q: %T conflicts with %T", p.kind, k, p.src[k], v))
+				}
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+	for _, p := range pairs {
+		for k, v := range p.src {
+			if _, ok := p.dst[k]; ok && policy == MergeKeep {
+				continue
+			}
+			p.dst[k] = v
+		}
+	}
+	return nil
+}
+
+// AddSegment registers each segment under its meta name.
+func (r *MapRegistry) AddSegment(policy MergePolicy, segments ...any) error {
+	add := &MapRegistry{Segments: RegistryLookup{}}
+	for _, s := range segments {
+		meta, err := (&Encoder{}).meta(reflect.TypeOf(s))
+		if err != nil {
+			return err
+		}
+		if !meta.Meta || meta.Type != structSegment {
+			return fmt.Errorf("%T is not a segment", s)
+		}
+		add.Segments[meta.Name] = s
+	}
+	return r.Merge(add, policy)
+}
+
+// AddDataType adds the composite data types to the registry under their HL7 names, resolving conflicts with policy.
+// An interface field with a datatype tag, such as datatype=CWE, is decoded as the registered type,
+// so a data type may be replaced for a version or site without redefining each segment that uses it.
+func (r *MapRegistry) AddDataType(policy MergePolicy, dataTypes ...any) error {
+	add := &MapRegistry{DataTypes: RegistryLookup{}}
+	for _, dt := range dataTypes {
+		meta, err := (&Encoder{}).meta(reflect.TypeOf(dt))
+		if err != nil {
+			return err
+		}
+		if !meta.Meta || meta.Type != structDataType {
+			return fmt.Errorf("%T is not a data type", dt)
+		}
+		add.DataTypes[meta.Name] = dt
+	}
+	return r.Merge(add, policy)
+}
+
+func sortedKeys(lookup RegistryLookup) []string {
+	keys := make([]string, 0, len(lookup))
+	for k := range lookup {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// StructInfo describes a segment, data type, trigger, or group struct.
+type StructInfo struct {
+	Name  string // HL7 name, such as PID or CX.
+	Type  reflect.Type
+	Size  int // Declared number of fields, or zero.
+	Field []FieldInfo
+}
+
+// FieldInfo describes a field of a struct from its Go type and tag options.
+type FieldInfo struct {
+	Position int    // Field or component number, or zero for a group.
+	Name     string // Go field name.
+	Display  string // Descriptive name.
+	Type     reflect.Type
+	DataType string // HL7 data type, if known from the type or tag.
+	Repeat   bool   // A slice of values.
+	Usage    string // R, RE, O, C, or X.
+	Len      int    // Maximum length, or zero.
+	Table    string // HL7 table ID, or empty.
+	Format   string // Time format, or empty.
+	Tag      string // The full tag.
+}
+
+// Describe returns the fields of the segment, data type, trigger, or group struct v.
+func Describe(v any) (StructInfo, error) {
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return StructInfo{}, fmt.Errorf("%T is not a struct", v)
+	}
+	meta, err := (&Encoder{}).meta(rt)
+	if err != nil {
+		return StructInfo{}, err
+	}
+	if !meta.Meta {
+		return StructInfo{}, fmt.Errorf("%v has no %s meta field", rt, hl7MetaName)
+	}
+	info := StructInfo{
+		Name: meta.Name,
+		Type: rt,
+		Size: int(meta.Order),
+	}
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		raw := ft.Tag.Get(tagName)
+		t, err := parseTag(ft.Name, raw)
+		if err != nil {
+			return info, fmt.Errorf("%v: %w", rt, err)
+		}
+		if !t.Present || t.Meta {
+			continue
+		}
+		f := FieldInfo{
+			Position: int(t.Order),
+			Name:     ft.Name,
+			Display:  t.Display,
+			Type:     ft.Type,
+			DataType: t.DataType,
+			Usage:    t.Usage.String(),
+			Len:      t.Len,
+			Table:    t.Table,
+			Format:   t.Format,
+			Tag:      raw,
+		}
+		et := ft.Type
+		if et.Kind() == reflect.Slice && et != byteSliceType {
+			f.Repeat = true
+			et = et.Elem()
+		}
+		if et.Kind() == reflect.Pointer {
+			et = et.Elem()
+		}
+		if m, err := (&Encoder{}).meta(et); err == nil && m.Meta && len(f.DataType) == 0 {
+			f.DataType = m.Name
+		}
+		info.Field = append(info.Field, f)
+	}
+	return info, nil
+}
+
+// DescribeSegments returns the description of each segment in the registry, ordered by name.
+func DescribeSegments(r Registry) ([]StructInfo, error) {
+	return describeLookup(r.Segment())
+}
+
+// DescribeDataTypes returns the description of each composite data type in the registry, ordered by name.
+// Primitive data types are not included.
+func DescribeDataTypes(r Registry) ([]StructInfo, error) {
+	lookup := RegistryLookup{}
+	for k, v := range r.DataType() {
+		rt := reflect.TypeOf(v)
+		if rt == nil || rt.Kind() != reflect.Struct {
+			continue
+		}
+		if meta, err := (&Encoder{}).meta(rt); err != nil || !meta.Meta {
+			continue
+		}
+		lookup[k] = v
+	}
+	return describeLookup(lookup)
+}
+
+func describeLookup(lookup RegistryLookup) ([]StructInfo, error) {
+	list := make([]StructInfo, 0, len(lookup))
+	for _, k := range sortedKeys(lookup) {
+		info, err := Describe(lookup[k])
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", k, err)
+		}
+		list = append(list, info)
+	}
+	return list, nil
+}
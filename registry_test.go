@@ -0,0 +1,251 @@
+package hl7
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v21 "github.com/kardianos/hl7/h210"
+	v22 "github.com/kardianos/hl7/h220"
+	v23 "github.com/kardianos/hl7/h230"
+	v231 "github.com/kardianos/hl7/h231"
+	v24 "github.com/kardianos/hl7/h240"
+	v25 "github.com/kardianos/hl7/h250"
+	v251 "github.com/kardianos/hl7/h251"
+	v26 "github.com/kardianos/hl7/h260"
+	v27 "github.com/kardianos/hl7/h270"
+	v271 "github.com/kardianos/hl7/h271"
+	v28 "github.com/kardianos/hl7/h280"
+)
+
+func TestValidateRegistryGenerated(t *testing.T) {
+	for _, r := range []Registry{v21.Registry, v22.Registry, v23.Registry, v231.Registry, v24.Registry, v25.Registry, v251.Registry, v26.Registry, v27.Registry, v271.Registry, v28.Registry} {
+		if err := ValidateRegistry(r); err != nil {
+			t.Errorf("%s: %v", r.Version(), err)
+		}
+	}
+}
+
+type testZBD struct {
+	HL7    struct{}       `hl7:"3,name=ZBD,type=s"`
+	A      string         `hl7:"1"`
+	B      string         `hl7:"1"`
+	C      string         `hl7:"4"`
+	D      map[string]int `hl7:"2"`
+	Broken string         `hl7:"x"`
+}
+
+func TestValidateRegistry(t *testing.T) {
+	reg := newTestRegistry(testZBD{})
+	reg.segment["ZXX"] = testZBD{}
+	err := ValidateRegistry(reg)
+	var re RegistryError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected RegistryError, got %v", err)
+	}
+	msg := err.Error()
+	for _, want := range []string{
+		"unable to parse tag position",
+		"fields A and B have the same order 1",
+		"order 4 exceeds the size 3",
+		"unsupported field type map[string]int",
+		`segment "ZXX": meta name "ZBD" does not match the registry key`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("missing %q in %v", want, msg)
+		}
+	}
+}
+
+type testPIDSite struct {
+	HL7   struct{} `hl7:",name=PID,type=s"`
+	SetID string   `hl7:"1"`
+}
+
+func TestMapRegistry(t *testing.T) {
+	reg := CloneRegistry(v251.Registry)
+	if reg.Version() != "2.5.1" || len(reg.Segment()) != len(v251.SegmentRegistry) {
+		t.Fatalf("unexpected clone %s %d", reg.Version(), len(reg.Segment()))
+	}
+	if err := reg.AddSegment(MergeError, testZDT{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v251.SegmentRegistry["ZDT"]; ok {
+		t.Fatal("clone changed the original registry")
+	}
+	if _, ok := reg.Segment()["ZDT"]; !ok {
+		t.Fatal("segment not added")
+	}
+
+	err := reg.AddSegment(MergeError, testPIDSite{})
+	var re RegistryError
+	if !errors.As(err, &re) || !strings.Contains(err.Error(), `segment "PID"`) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if err := reg.AddSegment(MergeKeep, testPIDSite{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := reg.Segment()["PID"].(v251.PID); !ok {
+		t.Fatal("keep replaced PID")
+	}
+	if err := reg.AddSegment(MergeReplace, testPIDSite{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := reg.Segment()["PID"].(testPIDSite); !ok {
+		t.Fatal("replace kept PID")
+	}
+
+	site := &MapRegistry{}
+	if err := site.Merge(reg, MergeError); err != nil {
+		t.Fatal(err)
+	}
+	if len(site.Trigger()) != len(v251.TriggerRegistry) {
+		t.Fatal("merge did not add triggers")
+	}
+	if err := reg.AddSegment(MergeError, v251.CX{}); err == nil {
+		t.Fatal("expected error adding a data type as a segment")
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	info, err := Describe(&v251.EVN{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "EVN" || len(info.Field) != 7 {
+		t.Fatalf("got %s with %d fields", info.Name, len(info.Field))
+	}
+	code := info.Field[0]
+	if code.Position != 1 || code.Name != "EventTypeCode" || code.Display != "Event Type Code" || code.DataType != "ID" || code.Table != "0003" || code.Len != 3 || code.Usage != "O" {
+		t.Errorf("field 1: %+v", code)
+	}
+	if f := info.Field[1]; f.Usage != "R" || f.Format != "YMDHMS" {
+		t.Errorf("field 2: %+v", f)
+	}
+	if f := info.Field[4]; !f.Repeat || f.DataType != "XCN" {
+		t.Errorf("field 5: %+v", f)
+	}
+	if f := info.Field[6]; f.Repeat || f.DataType != "HD" {
+		t.Errorf("field 7: %+v", f)
+	}
+	if _, err := Describe("PID"); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+func TestDescribeRegistry(t *testing.T) {
+	segs, err := DescribeSegments(v251.Registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segs) != len(v251.SegmentRegistry) {
+		t.Fatalf("got %d segments, want %d", len(segs), len(v251.SegmentRegistry))
+	}
+	for i := 1; i < len(segs); i++ {
+		if segs[i-1].Name > segs[i].Name {
+			t.Fatalf("segments not sorted: %s before %s", segs[i-1].Name, segs[i].Name)
+		}
+	}
+	dts, err := DescribeDataTypes(v251.Registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dt := range dts {
+		if dt.Name == "CX" {
+			return
+		}
+	}
+	t.Error("CX data type not described")
+}
+
+type testZCW struct {
+	HL7  struct{} `hl7:",name=ZCW,type=s"`
+	Code any      `hl7:"1,datatype=CE"`
+	List []any    `hl7:"2,datatype=CE"`
+}
+
+type testCESite struct {
+	HL7        struct{} `hl7:",name=CE,type=d"`
+	Identifier string   `hl7:"1"`
+	Text       string   `hl7:"2"`
+	Local      string   `hl7:"4"`
+}
+
+func TestNamedDataType(t *testing.T) {
+	reg := CloneRegistry(v251.Registry)
+	if err := reg.AddSegment(MergeError, testZCW{}); err != nil {
+		t.Fatal(err)
+	}
+	raw := []byte("MSH|^~\\&|||||||ADT^A01^ADT_A01|1|P|2.5.1|\rZCW|A^Alpha^L^X|B~C^Charlie")
+
+	list, err := NewDecoder(reg, nil).DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := list[1].(*testZCW)
+	if ce, ok := z.Code.(v251.CE); !ok || ce.Identifier != "A" || ce.AlternateIdentifier != "X" {
+		t.Fatalf("got %#v", z.Code)
+	}
+	if len(z.List) != 2 {
+		t.Fatalf("got list %#v", z.List)
+	}
+
+	if err := reg.AddDataType(MergeError, testCESite{}); err == nil {
+		t.Fatal("expected conflict with CE")
+	}
+	if err := reg.AddDataType(MergeReplace, testCESite{}); err != nil {
+		t.Fatal(err)
+	}
+	list, err = NewDecoder(reg, nil).DecodeList(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z = list[1].(*testZCW)
+	if ce, ok := z.Code.(testCESite); !ok || ce.Text != "Alpha" || ce.Local != "X" {
+		t.Fatalf("got %#v", z.Code)
+	}
+	bb, err := NewEncoder(nil).Encode(*z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "ZCW|A^Alpha^^X|B~C^Charlie"
+	if got := strings.TrimRight(string(bb), "\r"); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	delete(reg.DataTypes, "CE")
+	if _, err := NewDecoder(reg, nil).DecodeList(raw); err == nil || !strings.Contains(err.Error(), `data type "CE" not found`) {
+		t.Fatalf("expected missing data type error, got %v", err)
+	}
+	if err := reg.AddDataType(MergeError, testZCW{}); err == nil {
+		t.Fatal("expected error adding a segment as a data type")
+	}
+}
+
+func TestRegistryADT(t *testing.T) {
+	// Version 2.1 has no message structure in MSH-9 to group by.
+	for _, r := range []Registry{v22.Registry, v23.Registry, v231.Registry, v24.Registry, v25.Registry, v251.Registry, v26.Registry, v27.Registry, v271.Registry, v28.Registry} {
+		count := 0
+		for code := range r.Trigger() {
+			if !strings.HasPrefix(code, "ADT_A") {
+				continue
+			}
+			count++
+			event := strings.TrimPrefix(code, "ADT_")
+			raw := "MSH|^~\\&|||||||ADT^" + event + "^" + code + "|1|P|" + r.Version() + "\rEVN|" + event
+			switch event {
+			default:
+				raw += "\rPID|1||123"
+			case "A20":
+				// A bed status update has no patient.
+				raw += "\rNPU|ICU^101^1|O"
+			}
+			if _, err := NewDecoder(r, nil).Decode([]byte(raw)); err != nil {
+				t.Errorf("%s %s: %v", r.Version(), code, err)
+			}
+		}
+		if count == 0 {
+			t.Errorf("%s: no ADT structures", r.Version())
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Order is an order of an observation result message, such as ORU^R01, with its observations and notes.
+// The segments are of the registry version, such as pointers to h251.OBR and h251.OBX.
+type Order struct {
+	Patient     any   // PID segment of the patient the order is for, nil if not sent.
+	ORC         any   // Common order segment, nil if not sent.
+	OBR         any   // Observation request segment.
+	Notes       []any // NTE segments that follow the OBR segment.
+	Observation []Observation
+
+	// Segment is each segment of the order from the ORC or OBR segment, in the order received,
+	// including segments not otherwise grouped, such as TQ1, SPM, and the OBX segments of a specimen.
+	Segment []any
+}
+
+// Observation is an OBX segment of an order with the NTE segments that follow it.
+type Observation struct {
+	OBX   any
+	Notes []any
+}
+
+// GroupResults groups the segments of an observation result message into its orders, in the order received.
+// The message may be a trigger, such as h251.ORU_R01, or a segment list. The segments of a trigger are taken
+// in the order of its groups, so pass the segment list from DecodeList to group them as received.
+// OBX segments that follow an SPM segment describe the specimen and are only added to the order Segment list.
+func GroupResults(message any) ([]Order, error) {
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+
+	var (
+		list    []Order
+		patient any
+		orc     any
+		order   *Order
+		parent  string // Name of the last segment that NTE segments attach to.
+	)
+	for i, rv := range m.segs {
+		seg := rv.Interface()
+		if rv.CanAddr() {
+			seg = rv.Addr().Interface()
+		}
+		name := segmentName(rv)
+		switch name {
+		case "MSH", "PID":
+			if orc != nil {
+				return nil, fmt.Errorf("segment %d: ORC segment without an OBR segment", i+1)
+			}
+			if name == "PID" {
+				patient = seg
+			}
+			order = nil
+			parent = name
+			continue
+		case "ORC":
+			if orc != nil {
+				return nil, fmt.Errorf("segment %d: ORC segment without an OBR segment", i+1)
+			}
+			orc = seg
+			order = nil
+			parent = name
+			continue
+		case "OBR":
+			list = append(list, Order{Patient: patient, ORC: orc, OBR: seg})
+			order = &list[len(list)-1]
+			if orc != nil {
+				order.Segment = append(order.Segment, orc)
+			}
+			orc = nil
+			parent = name
+		}
+		if order == nil {
+			if name == "OBX" {
+				return nil, fmt.Errorf("segment %d: OBX segment before an OBR segment", i+1)
+			}
+			continue
+		}
+		order.Segment = append(order.Segment, seg)
+		switch name {
+		default:
+			parent = name
+		case "OBX":
+			if parent == "SPM" {
+				continue
+			}
+			order.Observation = append(order.Observation, Observation{OBX: seg})
+			parent = name
+		case "NTE":
+			switch parent {
+			case "OBR":
+				order.Notes = append(order.Notes, seg)
+			case "OBX":
+				obs := &order.Observation[len(order.Observation)-1]
+				obs.Notes = append(obs.Notes, seg)
+			}
+		}
+	}
+	if orc != nil {
+		return nil, fmt.Errorf("ORC segment without an OBR segment")
+	}
+	return list, nil
+}
+
+// Notes is a segment with the NTE segments that directly follow it.
+type Notes struct {
+	Segment any // Nil for NTE segments that do not follow a parent segment.
+	Notes   []any
+}
+
+// AttachNotes returns each parent segment of the message, in the order received, with the NTE segments
+// that directly follow it. If no parents are given, the parents are PID, OBR, and OBX.
+// NTE segments that follow any other segment are returned with a nil Segment rather than
+// attached to an earlier parent, as they comment on that other segment.
+// The message may be a trigger or a segment list; see GroupResults.
+func AttachNotes(message any, parents ...string) []Notes {
+	if len(parents) == 0 {
+		parents = []string{"PID", "OBR", "OBX"}
+	}
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+
+	var list []Notes
+	var cur *Notes
+	for _, rv := range m.segs {
+		seg := rv.Interface()
+		if rv.CanAddr() {
+			seg = rv.Addr().Interface()
+		}
+		name := segmentName(rv)
+		if name == "NTE" {
+			if cur == nil {
+				list = append(list, Notes{})
+				cur = &list[len(list)-1]
+			}
+			cur.Notes = append(cur.Notes, seg)
+			continue
+		}
+		cur = nil
+		for _, p := range parents {
+			if p == name {
+				list = append(list, Notes{Segment: seg})
+				cur = &list[len(list)-1]
+				break
+			}
+		}
+	}
+	return list
+}
@@ -0,0 +1,109 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+var testORU = strings.Join([]string{
+	`MSH|^~\&|LAB||EHR||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+	`PID|1||123^^^MRN||Doe^Jane`,
+	`ORC|RE|A1`,
+	`OBR|1|A1||CBC`,
+	`NTE|1||order note`,
+	`OBX|1|NM|WBC||7.2|10*3/uL|4.0-11.0|N|||F`,
+	`NTE|1||wbc note`,
+	`OBX|2|NM|HGB||11.0|g/dL|12.0-16.0|L|||F`,
+	`SPM|1|S1`,
+	`OBX|1|ST|SPECTYPE||Blood||||||F`,
+	`OBR|2|A2||BMP`,
+	`OBX|1|NM|NA||140|mmol/L|||||F`,
+	`NTE|1||sodium note`,
+	`NTE|2||second sodium note`,
+}, "\r") + "\r"
+
+func TestGroupResults(t *testing.T) {
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(testORU))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The trigger groups an OBX segment after an OBX and NTE segment as an observation of the specimen,
+	// so leave out the specimen.
+	v, err := NewDecoder(v251.Registry, nil).Decode([]byte(strings.Replace(testORU, "SPM|1|S1\rOBX|1|ST|SPECTYPE||Blood||||||F\r", "", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, message := range map[string]any{"trigger": v, "list": list} {
+		segments := map[string]int{"trigger": 6, "list": 8}[name]
+		orders, err := GroupResults(message)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(orders) != 2 {
+			t.Fatalf("%s: got %d orders", name, len(orders))
+		}
+		o := orders[0]
+		if o.Patient.(*v251.PID).PatientName[0].GivenName != "Jane" || o.ORC == nil || o.OBR.(*v251.OBR).PlacerOrderNumber.EntityIdentifier != "A1" {
+			t.Errorf("%s: order 1: got %+v", name, o)
+		}
+		if len(o.Notes) != 1 || o.Notes[0].(*v251.NTE).Comment[0] != "order note" {
+			t.Errorf("%s: order 1 notes: got %v", name, o.Notes)
+		}
+		if len(o.Observation) != 2 || len(o.Observation[0].Notes) != 1 || len(o.Observation[1].Notes) != 0 {
+			t.Fatalf("%s: order 1 observations: got %+v", name, o.Observation)
+		}
+		if got := o.Observation[1].OBX.(*v251.OBX).ObservationIdentifier.Identifier; got != "HGB" {
+			t.Errorf("%s: got %q", name, got)
+		}
+		if len(o.Segment) != segments {
+			t.Errorf("%s: order 1: got %d segments", name, len(o.Segment))
+		}
+
+		o = orders[1]
+		if o.ORC != nil || len(o.Notes) != 0 || len(o.Observation) != 1 || len(o.Observation[0].Notes) != 2 {
+			t.Errorf("%s: order 2: got %+v", name, o)
+		}
+		if got := o.Observation[0].Notes[1].(*v251.NTE).Comment[0]; got != "second sodium note" {
+			t.Errorf("%s: got %q", name, got)
+		}
+	}
+
+	_, err = GroupResults([]any{&v251.MSH{}, &v251.OBX{}})
+	if err == nil {
+		t.Fatal("expected OBX before OBR error")
+	}
+}
+
+func TestAttachNotes(t *testing.T) {
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte(testORU))
+	if err != nil {
+		t.Fatal(err)
+	}
+	describe := func(notes []Notes) string {
+		var ss []string
+		for _, n := range notes {
+			name := "-"
+			if n.Segment != nil {
+				name = segmentName(reflect.ValueOf(n.Segment).Elem())
+			}
+			ss = append(ss, fmt.Sprintf("%s:%d", name, len(n.Notes)))
+		}
+		return strings.Join(ss, " ")
+	}
+	notes := AttachNotes(list)
+	if got, want := describe(notes), "PID:0 OBR:1 OBX:1 OBX:0 OBX:0 OBR:0 OBX:2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got := notes[6].Notes[0].(*v251.NTE).Comment[0]; got != "sodium note" {
+		t.Errorf("got %q", got)
+	}
+
+	// The order note follows the OBR segment, so it is not attached to the patient.
+	if got, want := describe(AttachNotes(list, "PID", "OBX")), "PID:0 -:1 OBX:1 OBX:0 OBX:0 OBX:2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,209 @@
+package hl7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoRoute is returned by a Router without a default handler for a message no pattern matches.
+var ErrNoRoute = errors.New("no handler for message type")
+
+// Handler handles a decoded message, returning the response to send, if any, such as an ACK.
+type Handler interface {
+	ServeHL7(message any) (any, error)
+}
+
+// HandlerFunc is a function that implements Handler.
+type HandlerFunc func(message any) (any, error)
+
+func (f HandlerFunc) ServeHL7(message any) (any, error) {
+	return f(message)
+}
+
+// ContextHandler is a Handler that takes the context of a message, such as one a server cancels when
+// it shuts down or that has the deadline of the message. Use ServeContext to call a handler with a context.
+type ContextHandler interface {
+	Handler
+	ServeHL7Context(ctx context.Context, message any) (any, error)
+}
+
+// ContextHandlerFunc is a function that implements ContextHandler. ServeHL7 calls it with context.Background.
+type ContextHandlerFunc func(ctx context.Context, message any) (any, error)
+
+func (f ContextHandlerFunc) ServeHL7(message any) (any, error) {
+	return f(context.Background(), message)
+}
+
+func (f ContextHandlerFunc) ServeHL7Context(ctx context.Context, message any) (any, error) {
+	return f(ctx, message)
+}
+
+// ServeContext returns the response of h to the message. If h is a ContextHandler, it is passed the context;
+// otherwise, h is not called if the context is already done.
+func ServeContext(ctx context.Context, h Handler, message any) (any, error) {
+	if ch, ok := h.(ContextHandler); ok {
+		return ch.ServeHL7Context(ctx, message)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return h.ServeHL7(message)
+}
+
+// Router dispatches decoded messages to handlers by the message type and trigger event of MSH-9.
+// A Router is itself a Handler and may be used from more than one goroutine.
+type Router struct {
+	// Default handles messages no pattern matches. If nil, such messages return ErrNoRoute.
+	Default Handler
+
+	// Trace is called as handlers return the response to each message. If nil, handling is not traced.
+	Trace *Trace
+
+	// Metrics receives a MetricAck as handlers return the response to each message. May be nil.
+	Metrics Metrics
+
+	mu     sync.RWMutex
+	routes []route
+}
+
+type route struct {
+	pattern string
+	h       Handler
+}
+
+// NewRouter returns an empty Router.
+func NewRouter() *Router {
+	return &Router{}
+}
+
+// Handle registers the handler for the pattern, written as message type and trigger event, such as "ORU^R01".
+// A pattern may use the wildcards of path.Match, such as "ADT^A0*" or "*^R01", and a message type alone,
+// such as "ADT", matches each of its trigger events. A message is dispatched to the pattern without wildcards
+// that equals it, or else to the longest matching pattern, then to the first registered.
+func (r *Router) Handle(pattern string, h Handler) error {
+	if h == nil {
+		return fmt.Errorf("route %q: nil handler", pattern)
+	}
+	p, err := routePattern(pattern)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rt := range r.routes {
+		if rt.pattern == p {
+			return fmt.Errorf("route %q: already registered", pattern)
+		}
+	}
+	r.routes = append(r.routes, route{pattern: p, h: h})
+	return nil
+}
+
+// HandleFunc registers the handler function for the pattern.
+func (r *Router) HandleFunc(pattern string, f func(message any) (any, error)) error {
+	return r.Handle(pattern, HandlerFunc(f))
+}
+
+// routePattern returns the pattern with a trigger event, checking it is valid.
+func routePattern(pattern string) (string, error) {
+	if len(pattern) == 0 {
+		return "", fmt.Errorf("route: empty pattern")
+	}
+	parts := strings.Split(pattern, "^")
+	switch len(parts) {
+	case 1:
+		pattern += "^*"
+	case 2:
+	default:
+		return "", fmt.Errorf("route %q: want type^trigger", pattern)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", fmt.Errorf("route %q: %w", pattern, err)
+	}
+	return pattern, nil
+}
+
+// Handler returns the handler for the message type and trigger event, such as "ADT" and "A01",
+// and the pattern it was registered with. If no pattern matches, the Default handler and
+// an empty pattern are returned.
+func (r *Router) Handler(messageType, triggerEvent string) (h Handler, pattern string) {
+	name := messageType + "^" + triggerEvent
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var best *route
+	for i := range r.routes {
+		rt := &r.routes[i]
+		if rt.pattern == name {
+			return rt.h, rt.pattern
+		}
+		if ok, _ := path.Match(rt.pattern, name); !ok {
+			continue
+		}
+		if best == nil || len(rt.pattern) > len(best.pattern) {
+			best = rt
+		}
+	}
+	if best != nil {
+		return best.h, best.pattern
+	}
+	return r.Default, ""
+}
+
+// ServeHL7 dispatches the message, a decoded trigger or segment list, to the handler for its MSH-9.
+func (r *Router) ServeHL7(message any) (any, error) {
+	return r.ServeHL7Context(context.Background(), message)
+}
+
+// ServeHL7Context dispatches the message as ServeHL7 does, passing the context to the handler with ServeContext.
+func (r *Router) ServeHL7Context(ctx context.Context, message any) (any, error) {
+	messageType, triggerEvent, err := messageEvent(message)
+	if err != nil {
+		return nil, fmt.Errorf("route: %w", err)
+	}
+	h, _ := r.Handler(messageType, triggerEvent)
+	if h == nil {
+		return nil, fmt.Errorf("route %s^%s: %w", messageType, triggerEvent, ErrNoRoute)
+	}
+	var acked func(info TraceInfo, err error)
+	if r.Trace != nil {
+		acked = r.Trace.Acked
+	}
+	if acked == nil && r.Metrics == nil {
+		return ServeContext(ctx, h, message)
+	}
+	info := traceMessage(message, time.Now())
+	resp, err := ServeContext(ctx, h, message)
+	info.done(acked, r.Metrics, MetricAck, "", err)
+	return resp, err
+}
+
+// messageEvent returns the message type and trigger event of MSH-9 of the message.
+// Versions where MSH-9 is a single value have no trigger event.
+func messageEvent(message any) (messageType, triggerEvent string, err error) {
+	msh, ok := findSegment(message, "MSH")
+	if !ok {
+		return "", "", fmt.Errorf("missing MSH segment")
+	}
+	_, fv, _, ok := fieldByOrder(msh, 9)
+	if !ok {
+		return "", "", fmt.Errorf("%v has no MSH-9", msh.Type())
+	}
+	fv = reflect.Indirect(fv)
+	switch fv.Kind() {
+	case reflect.String:
+		messageType = fv.String()
+	case reflect.Struct:
+		messageType = stringComponent(fv, 1)
+		triggerEvent = stringComponent(fv, 2)
+	}
+	if len(messageType) == 0 {
+		return "", "", fmt.Errorf("missing message type in MSH-9")
+	}
+	return messageType, triggerEvent, nil
+}
@@ -0,0 +1,125 @@
+package hl7
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestRouter(t *testing.T) {
+	r := NewRouter()
+	var got []string
+	handler := func(name string) Handler {
+		return HandlerFunc(func(message any) (any, error) {
+			got = append(got, name)
+			return name, nil
+		})
+	}
+	for _, item := range []struct{ pattern, name string }{
+		{"ADT^A0*", "admit"},
+		{"ADT^A01", "a01"},
+		{"ADT", "adt"},
+		{"*^R01", "r01"},
+	} {
+		if err := r.Handle(item.pattern, handler(item.name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, bad := range []string{"", "ADT^A01^ADT_A01", "ADT^[", "ADT^A01"} {
+		if err := r.Handle(bad, handler("bad")); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+
+	for _, item := range []struct{ typ, trigger, want string }{
+		{"ADT", "A01", "a01"},
+		{"ADT", "A04", "admit"},
+		{"ADT", "A31", "adt"},
+		{"ORU", "R01", "r01"},
+	} {
+		h, _ := r.Handler(item.typ, item.trigger)
+		if h == nil {
+			t.Errorf("%s^%s: no handler", item.typ, item.trigger)
+			continue
+		}
+		if resp, _ := h.ServeHL7(nil); resp != item.want {
+			t.Errorf("%s^%s: got %v, want %s", item.typ, item.trigger, resp, item.want)
+		}
+	}
+
+	d := NewDecoder(v251.Registry, nil)
+	decode := func(msh9 string) any {
+		raw := strings.Join([]string{
+			`MSH|^~\&|A|B|||20240102||` + msh9 + `|1|P|2.5.1`,
+			`EVN||20240102`,
+			`PID|||123`,
+			`PV1||I`,
+		}, "\r")
+		msg, err := d.Decode([]byte(raw))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+	got = nil
+	if _, err := r.ServeHL7(decode("ADT^A04^ADT_A01")); err != nil {
+		t.Fatal(err)
+	}
+	list, err := d.DecodeList([]byte("MSH|^~\\&|A|B|||20240102||ADT^A01|1|P|2.5.1\rPID|||123"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.ServeHL7(list); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "admit,a01" {
+		t.Errorf("got %v", got)
+	}
+
+	empty := NewRouter()
+	if _, err := empty.ServeHL7(list); !errors.Is(err, ErrNoRoute) {
+		t.Errorf("got %v, want ErrNoRoute", err)
+	}
+	empty.Default = handler("default")
+	if resp, err := empty.ServeHL7(list); err != nil || resp != "default" {
+		t.Errorf("got %v, %v", resp, err)
+	}
+	if _, err := empty.ServeHL7([]any{}); err == nil {
+		t.Errorf("expected an error without MSH")
+	}
+}
+
+func TestServeContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	r := NewRouter()
+	r.Handle("ADT", ContextHandlerFunc(func(ctx context.Context, message any) (any, error) {
+		return ctx.Value(key{}), nil
+	}))
+	list, err := NewDecoder(v251.Registry, nil).DecodeList([]byte("MSH|^~\\&|A|B|||20240102||ADT^A01|1|P|2.5.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The context passes through the middleware and router to the handler.
+	h := Chain(r, Recover, NewDedup(nil).Middleware)
+	if resp, err := ServeContext(ctx, h, list); err != nil || resp != "value" {
+		t.Errorf("got %v, %v", resp, err)
+	}
+	if resp, err := r.ServeHL7(list); err != nil || resp != nil {
+		t.Errorf("got %v, %v without a context", resp, err)
+	}
+
+	called := false
+	plain := HandlerFunc(func(message any) (any, error) {
+		called = true
+		return nil, nil
+	})
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := ServeContext(canceled, plain, list); err != context.Canceled || called {
+		t.Errorf("got %v, called %t", err, called)
+	}
+}
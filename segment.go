@@ -0,0 +1,59 @@
+package hl7
+
+import (
+	"reflect"
+)
+
+// Segments returns the segments of a message in order, as pointers such as *h251.PID.
+// The message may be a trigger or group, whose segments are taken in the order of its groups,
+// or a segment list from DecodeList, whose segments are taken as received.
+func Segments(message any) []any {
+	m := &profileMatcher{}
+	m.flatten(reflect.ValueOf(message))
+	list := make([]any, len(m.segs))
+	for i, rv := range m.segs {
+		seg := rv.Interface()
+		if rv.CanAddr() {
+			seg = rv.Addr().Interface()
+		}
+		list[i] = seg
+	}
+	return list
+}
+
+// SegmentName returns the name of a segment, such as "PID", or empty if the value is not a segment.
+func SegmentName(segment any) string {
+	rv := reflect.Indirect(reflect.ValueOf(segment))
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	meta, err := (&Encoder{}).meta(rv.Type())
+	if err != nil || !meta.Present || meta.Type != structSegment {
+		return ""
+	}
+	return meta.Name
+}
+
+// Field returns the field of a segment, or the component of a data type, at the order starting at 1,
+// such as Field(pid, 5) for PID-5 of any version. A repeating field is returned as a slice.
+// If the value does not define the order or the field is a nil pointer, nil is returned.
+func Field(v any, order int) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	_, fv, _, ok := fieldByOrder(rv, order)
+	if !ok {
+		return nil
+	}
+	if fv.Kind() == reflect.Pointer && fv.IsNil() {
+		return nil
+	}
+	return fv.Interface()
+}
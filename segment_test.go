@@ -0,0 +1,48 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestSegments(t *testing.T) {
+	data := strings.Join([]string{
+		`MSH|^~\&|||||||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|1||123||Doe^Jane~Smith^Jan||19800101|F`,
+		`PV1|1|I`,
+	}, "\r")
+	v, err := NewDecoder(v251.Registry, nil).Decode([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	segs := Segments(v)
+	var names []string
+	for _, s := range segs {
+		names = append(names, SegmentName(s))
+	}
+	if got, want := strings.Join(names, " "), "MSH EVN PID PV1"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	pid, ok := segs[2].(*v251.PID)
+	if !ok {
+		t.Fatalf("got %T", segs[2])
+	}
+	if names, ok := Field(pid, 5).([]v251.XPN); !ok || len(names) != 2 {
+		t.Errorf("got %#v", Field(pid, 5))
+	}
+	if got := Field(Field(segs[0], 9), 2); got != "A01" {
+		t.Errorf("got %#v", got)
+	}
+	if got := Field(pid, 99); got != nil {
+		t.Errorf("got %#v", got)
+	}
+	if got := Field(segs[3], 3); got != nil {
+		t.Errorf("got nil pointer %#v", got)
+	}
+	if SegmentName(v) != "" || SegmentName("PID") != "" {
+		t.Error("expected no segment name")
+	}
+}
@@ -0,0 +1,181 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SequenceStatus is the result of checking the sequence number (MSH-13) of a received message.
+type SequenceStatus byte
+
+const (
+	SequenceNone      SequenceStatus = iota // The message has no sequence number, so the protocol is not in use.
+	SequenceOK                              // The message has the expected sequence number, or sets it.
+	SequenceDuplicate                       // The sequence number is before the expected one: the message was already received.
+	SequenceGap                             // The sequence number is after the expected one: messages are missing.
+	SequenceQuery                           // The sequence number is -1: the sender asks for the expected sequence number.
+	SequenceReset                           // The sequence number is 0: the next message sets the expected sequence number.
+)
+
+var sequenceStatusName = [...]string{
+	SequenceNone:      "none",
+	SequenceOK:        "ok",
+	SequenceDuplicate: "duplicate",
+	SequenceGap:       "gap",
+	SequenceQuery:     "query",
+	SequenceReset:     "reset",
+}
+
+func (s SequenceStatus) String() string {
+	if int(s) < len(sequenceStatusName) {
+		return sequenceStatusName[s]
+	}
+	return ""
+}
+
+// SequenceResult is the result of SequenceReceiver.Check.
+type SequenceResult struct {
+	Status   SequenceStatus
+	Number   int64 // Sequence number of the message.
+	Expected int64 // Expected sequence number of the next message, for MSA-4 of the ACK; 0 if not known.
+}
+
+// SequenceReceiver checks the sequence numbers (MSH-13) of messages received on a link that uses the
+// HL7 sequence number protocol. A message with the expected number, or the first message, is accepted and
+// the expected number advanced; a duplicate should be acknowledged again without processing it, and a gap
+// rejected with the expected number in MSA-4 so the sender resends from there.
+// A SequenceReceiver may be used from more than one goroutine.
+type SequenceReceiver struct {
+	mu       sync.Mutex
+	expected int64 // 0 if not known.
+}
+
+// NewSequenceReceiver returns a SequenceReceiver that expects the sequence number next, such as one
+// stored when the receiver last stopped, or 0 to accept the number of the first message.
+func NewSequenceReceiver(next int64) *SequenceReceiver {
+	if next < 0 {
+		next = 0
+	}
+	return &SequenceReceiver{expected: next}
+}
+
+// Expected returns the expected sequence number of the next message, or 0 if not known.
+func (r *SequenceReceiver) Expected() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.expected
+}
+
+// Check checks the sequence number of the message, advancing the expected number if it is accepted.
+func (r *SequenceReceiver) Check(message any) (SequenceResult, error) {
+	n, ok, err := sequenceNumber(message)
+	if err != nil || !ok {
+		return SequenceResult{Expected: r.Expected()}, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := SequenceResult{Number: n}
+	switch {
+	case n == -1:
+		res.Status = SequenceQuery
+	case n == 0:
+		res.Status = SequenceReset
+		r.expected = 0
+	case n < -1:
+		return SequenceResult{Expected: r.expected}, fmt.Errorf("MSH-13: invalid sequence number %d", n)
+	case r.expected == 0 || n == r.expected:
+		res.Status = SequenceOK
+		r.expected = n + 1
+	case n < r.expected:
+		res.Status = SequenceDuplicate
+	default:
+		res.Status = SequenceGap
+	}
+	res.Expected = r.expected
+	return res, nil
+}
+
+// SequenceSender sets the sequence numbers (MSH-13) of messages sent on a link that uses the HL7 sequence number protocol.
+// A SequenceSender may be used from more than one goroutine.
+type SequenceSender struct {
+	mu   sync.Mutex
+	next int64
+}
+
+// NewSequenceSender returns a SequenceSender whose next sequence number is next, or 1 if next is less than 1.
+func NewSequenceSender(next int64) *SequenceSender {
+	if next < 1 {
+		next = 1
+	}
+	return &SequenceSender{next: next}
+}
+
+// Next returns the sequence number of the next message.
+func (s *SequenceSender) Next() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.next
+}
+
+// Set sets MSH-13 of the message to the next sequence number and advances it. The MSH segment must be a pointer,
+// as in a decoded message or one from NewMSH.
+func (s *SequenceSender) Set(message any) (int64, error) {
+	msh, ok := findSegment(message, "MSH")
+	if !ok {
+		return 0, fmt.Errorf("missing MSH segment")
+	}
+	_, fv, _, ok := fieldByOrder(msh, 13)
+	if !ok || fv.Kind() != reflect.String {
+		return 0, fmt.Errorf("%v has no MSH-13 sequence number", msh.Type())
+	}
+	if !fv.CanSet() {
+		return 0, fmt.Errorf("MSH-13 of %v cannot be set, pass a pointer to the MSH segment", msh.Type())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := s.next
+	fv.SetString(strconv.FormatInt(n, 10))
+	s.next++
+	return n, nil
+}
+
+// Sync sets the next sequence number to the expected sequence number (MSA-4) of an ACK, if it has one,
+// such as after a gap or a query with sequence number -1.
+func (s *SequenceSender) Sync(ack any) error {
+	msa, ok := findSegment(ack, "MSA")
+	if !ok {
+		return fmt.Errorf("missing MSA segment")
+	}
+	v := strings.TrimSpace(stringField(msa, 4))
+	if len(v) == 0 {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 1 {
+		return fmt.Errorf("MSA-4: invalid expected sequence number %q", v)
+	}
+	s.mu.Lock()
+	s.next = n
+	s.mu.Unlock()
+	return nil
+}
+
+// sequenceNumber returns the MSH-13 sequence number of the message, and false if it has none.
+func sequenceNumber(message any) (int64, bool, error) {
+	msh, ok := findSegment(message, "MSH")
+	if !ok {
+		return 0, false, fmt.Errorf("missing MSH segment")
+	}
+	v := strings.TrimSpace(stringField(msh, 13))
+	if len(v) == 0 {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("MSH-13: invalid sequence number %q", v)
+	}
+	return n, true, nil
+}
@@ -0,0 +1,80 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestSequenceReceiver(t *testing.T) {
+	d := NewDecoder(v251.Registry, nil)
+	msg := func(seq string) any {
+		list, err := d.DecodeList([]byte("MSH|^~\\&|A|B|||20240102||ADT^A01|1|P|2.5.1|" + seq))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+	r := NewSequenceReceiver(0)
+	var got []string
+	for _, seq := range []string{"", "5", "6", "6", "9", "-1", "7", "0", "3", "4"} {
+		res, err := r.Check(msg(seq))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%s:%v:%d", seq, res.Status, res.Expected))
+	}
+	want := ":none:0 5:ok:6 6:ok:7 6:duplicate:7 9:gap:7 -1:query:7 7:ok:8 0:reset:0 3:ok:4 4:ok:5"
+	if s := strings.Join(got, " "); s != want {
+		t.Errorf("got:\n%s\nwant:\n%s", s, want)
+	}
+	if r.Expected() != 5 || NewSequenceReceiver(10).Expected() != 10 {
+		t.Errorf("unexpected expected number")
+	}
+	for _, bad := range []string{"x", "-2"} {
+		if _, err := r.Check(msg(bad)); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+}
+
+func TestSequenceSender(t *testing.T) {
+	s := NewSequenceSender(0)
+	msh, err := NewMSH(v251.Registry, &MSHOption{MessageType: "ADT", TriggerEvent: "A01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for want := int64(1); want <= 2; want++ {
+		n, err := s.Set([]any{msh})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != want || msh.(*v251.MSH).SequenceNumber != fmt.Sprint(want) {
+			t.Errorf("got %d, MSH-13 %q", n, msh.(*v251.MSH).SequenceNumber)
+		}
+	}
+	if _, err := s.Set([]any{*msh.(*v251.MSH)}); err == nil {
+		t.Errorf("expected an error for an MSH value")
+	}
+
+	ack, err := NewDecoder(v251.Registry, nil).DecodeList([]byte("MSH|^~\\&|B|A|||20240102||ACK^A01^ACK|2|P|2.5.1\rMSA|AR|1||7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Sync(ack); err != nil {
+		t.Fatal(err)
+	}
+	if s.Next() != 7 {
+		t.Errorf("got next %d", s.Next())
+	}
+	ack[1].(*v251.MSA).ExpectedSequenceNumber = ""
+	if err := s.Sync(ack); err != nil || s.Next() != 7 {
+		t.Errorf("got %v, next %d", err, s.Next())
+	}
+	ack[1].(*v251.MSA).ExpectedSequenceNumber = "-1"
+	if err := s.Sync(ack); err == nil {
+		t.Errorf("expected an error for an invalid MSA-4")
+	}
+}
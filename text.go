@@ -0,0 +1,151 @@
+package hl7
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextOption represents options for SplitText.
+type TextOption struct {
+	// MaxLength is the maximum length in bytes of each value once escaped.
+	MaxLength int
+
+	// ValueType is the OBX-2 value type. If empty, FT is used.
+	// FT values send line breaks as the \.br\ formatting sequence.
+	// TX values may not contain line breaks, so each line starts a new value.
+	ValueType string
+
+	// Repeat sends the values as repetitions of OBX-5 in one OBX segment,
+	// rather than as one OBX segment each.
+	Repeat bool
+
+	// Delimiters of the message the values are escaped for. If nil, DefaultDelimiters are used.
+	Delimiters *Delimiters
+}
+
+// SplitText returns OBX segments with the text in the observation value (OBX-5), split into values
+// no longer than the maximum length once escaped. Text is split after a line break or space when possible.
+// Each segment is a copy of the obx template, a pointer to an OBX segment, with the set ID (OBX-1),
+// value type (OBX-2), and value set. The values are escaped Raw values, so they are encoded as is.
+func SplitText(obx any, text string, opt *TextOption) ([]any, error) {
+	if opt == nil || opt.MaxLength <= 0 {
+		return nil, fmt.Errorf("text max length must be set")
+	}
+	rv := reflect.ValueOf(obx)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct || segmentName(rv.Elem()) != "OBX" {
+		return nil, fmt.Errorf("%T is not a pointer to an OBX segment", obx)
+	}
+	vt := opt.ValueType
+	if len(vt) == 0 {
+		vt = "FT"
+	}
+	dl := DefaultDelimiters
+	if opt.Delimiters != nil {
+		dl = *opt.Delimiters
+	}
+
+	var values []Raw
+	lines := []string{text}
+	if vt == "TX" {
+		lines = strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	}
+	for _, line := range lines {
+		parts, err := splitEscaped(escapeText(line, dl), opt.MaxLength, lineBreak(dl))
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) == 0 && len(lines) > 1 {
+			parts = []Raw{""} // Keep the empty line.
+		}
+		values = append(values, parts...)
+	}
+
+	var list []any
+	add := func(vv []Raw) error {
+		seg := reflect.New(rv.Elem().Type())
+		seg.Elem().Set(rv.Elem())
+		err := setStringField(seg.Elem(), 1, strconv.Itoa(len(list)+1))
+		if err != nil {
+			return fmt.Errorf("OBX-1: %w", err)
+		}
+		err = setStringField(seg.Elem(), 2, vt)
+		if err != nil {
+			return fmt.Errorf("OBX-2: %w", err)
+		}
+		_, fv, _, ok := fieldByOrder(seg.Elem(), 5)
+		if !ok || fv.Kind() != reflect.Slice || fv.Type().Elem().Kind() != reflect.Interface {
+			return fmt.Errorf("OBX-5 of %v must be a list of VARIES", seg.Elem().Type())
+		}
+		fv.Set(reflect.MakeSlice(fv.Type(), 0, len(vv)))
+		for _, v := range vv {
+			fv.Set(reflect.Append(fv, reflect.ValueOf(v)))
+		}
+		list = append(list, seg.Interface())
+		return nil
+	}
+	if opt.Repeat {
+		return list, add(values)
+	}
+	for _, v := range values {
+		err := add([]Raw{v})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// escapeText returns each character of the text escaped for the delimiters as the Encoder escapes it,
+// with line breaks as the \.br\ formatting sequence. Each element must not be split.
+func escapeText(text string, dl Delimiters) []string {
+	e := NewEncoder(nil)
+	e.init(string(dl.Field), dl.EncodingCharacters())
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	var list []string
+	for len(text) > 0 {
+		_, n := utf8.DecodeRuneInString(text)
+		if c := text[0]; c == '\n' || c == '\r' {
+			list = append(list, lineBreak(dl))
+		} else {
+			e.buf.Reset()
+			e.write(text[:n], 0, false)
+			list = append(list, e.buf.String())
+		}
+		text = text[n:]
+	}
+	return list
+}
+
+func lineBreak(dl Delimiters) string {
+	return string(dl.Escape) + ".br" + string(dl.Escape)
+}
+
+// splitEscaped joins the escaped characters into values no longer than max,
+// preferring to split after a line break or space.
+func splitEscaped(chars []string, max int, br string) ([]Raw, error) {
+	var values []Raw
+	for len(chars) > 0 {
+		size := 0
+		end := 0  // Characters that fit.
+		brk := -1 // Characters up to the last line break or space that fit.
+		for end < len(chars) && size+len(chars[end]) <= max {
+			size += len(chars[end])
+			end++
+			if c := chars[end-1]; c == " " || c == br {
+				brk = end
+			}
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("text max length %d is shorter than the escaped character %q", max, chars[0])
+		}
+		if end < len(chars) && brk > 0 {
+			end = brk
+		}
+		values = append(values, Raw(strings.Join(chars[:end], "")))
+		chars = chars[end:]
+	}
+	return values, nil
+}
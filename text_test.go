@@ -0,0 +1,104 @@
+package hl7
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestSplitText(t *testing.T) {
+	text := "Impression: no acute findings.\nHeart size normal & lungs clear|stable."
+	template := &v251.OBX{ObservationIdentifier: v251.CE{Identifier: "IMP"}, ObservationResultStatus: "F"}
+	list, err := SplitText(template, text, &TextOption{MaxLength: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for i, seg := range list {
+		obx := seg.(*v251.OBX)
+		if obx.SetID != v251.SI(strconv.Itoa(i+1)) || obx.ValueType != "FT" || obx.ObservationResultStatus != "F" {
+			t.Fatalf("segment %d: got %+v", i+1, obx)
+		}
+		v := string(obx.ObservationValue[0].(Raw))
+		if len(v) > 24 {
+			t.Errorf("value %q longer than 24", v)
+		}
+		got = append(got, v)
+	}
+	want := []string{"Impression: no acute ", "findings.\\.br\\Heart ", "size normal \\T\\ lungs ", "clear\\F\\stable."}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("got %q", got)
+	}
+	if template.SetID != "" || len(template.ObservationValue) != 0 {
+		t.Fatal("template changed")
+	}
+
+	bb, err := NewEncoder(&EncodeOption{TrimTrailingSeparator: true}).Encode(list[1:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(bb), "\r"); got != "OBX|2|FT|IMP||findings.\\.br\\Heart ||||||F" {
+		t.Fatalf("got %q", got)
+	}
+
+	// Decode the values back to the text.
+	msg := []byte("MSH|^~\\&|||||||ORU^R01^ORU_R01|1|P|2.5.1\r")
+	for _, seg := range list {
+		b, err := NewEncoder(nil).Encode([]any{seg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg = append(append(msg, b...), '\r')
+	}
+	segs, err := NewDecoder(v251.Registry, &DecodeOption{FormatText: FormatTextPlain}).DecodeList(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var joined string
+	for _, seg := range segs[1:] {
+		joined += string(seg.(*v251.OBX).ObservationValue[0].(v251.FT))
+	}
+	if joined != text {
+		t.Fatalf("got %q", joined)
+	}
+}
+
+func TestSplitTextTX(t *testing.T) {
+	list, err := SplitText(&v251.OBX{}, "line one\n\nline two is longer", &TextOption{MaxLength: 12, ValueType: "TX", Repeat: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("got %d segments", len(list))
+	}
+	obx := list[0].(*v251.OBX)
+	var got []string
+	for _, v := range obx.ObservationValue {
+		got = append(got, string(v.(Raw)))
+	}
+	if want := "line one||line two is |longer"; strings.Join(got, "|") != want {
+		t.Fatalf("got %q", got)
+	}
+	if obx.SetID != "1" || obx.ValueType != "TX" {
+		t.Fatalf("got %+v", obx)
+	}
+
+	if _, err := SplitText(&v251.OBX{}, "|", &TextOption{MaxLength: 2}); err == nil {
+		t.Fatal("expected max length error")
+	}
+}
+
+func TestSplitTextDelimiters(t *testing.T) {
+	// The truncation character and control characters are escaped as the Encoder escapes them.
+	dl := DefaultDelimiters
+	dl.Truncation = '#'
+	list, err := SplitText(&v251.OBX{}, "a#b\x01c|d", &TextOption{MaxLength: 80, Delimiters: &dl})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(list[0].(*v251.OBX).ObservationValue[0].(Raw)); got != "a\\P\\b\\X01\\c\\F\\d" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,99 @@
+package hl7
+
+import (
+	"bytes"
+	"time"
+)
+
+// Trace has optional callbacks for the steps of handling a message, such as to record tracing spans.
+// Each is called with the TraceInfo of the message, with its start time and duration, and the error of the step, if any.
+// A nil callback is not called. Callbacks should return quickly, as they are called in line.
+type Trace struct {
+	Received  func(info TraceInfo)            // Called by Decode before a raw message is decoded.
+	Decoded   func(info TraceInfo, err error) // Called by Decode after a message is decoded.
+	Validated func(info TraceInfo, err error) // Called by Validate after a message is checked.
+	Encoded   func(info TraceInfo, err error) // Called by Encode after a message is encoded.
+	Acked     func(info TraceInfo, err error) // Called by Router after a handler returns a response, and by transports after a response is read or written.
+	Sent      func(info TraceInfo, err error) // Called by transports after a message or response is written.
+}
+
+// TraceInfo identifies a message for a Trace callback.
+type TraceInfo struct {
+	ControlID    string // MSH-10 message control ID, for correlating the steps of a message.
+	MessageType  string // MSH-9.1, such as ADT.
+	TriggerEvent string // MSH-9.2, such as A01.
+
+	Start    time.Time     // Start of the step.
+	Duration time.Duration // Duration of the step, zero for Received.
+}
+
+// ObserveSent reports a message or response written by a transport, such as mllp.Sender, to the Sent callback
+// of the trace and as a MetricSend to the metrics; either may be nil. Start is when the write began.
+func ObserveSent(t *Trace, m Metrics, msg []byte, start time.Time, err error) {
+	var sent func(info TraceInfo, err error)
+	if t != nil {
+		sent = t.Sent
+	}
+	if sent == nil && m == nil {
+		return
+	}
+	traceRaw(msg, start).done(sent, m, MetricSend, "", err)
+}
+
+// ObserveAck reports the round trip of a message by a transport to the Acked callback of the trace and as
+// a MetricAck to the metrics; either may be nil. Start is when the message was written, for a sender,
+// or read, for a receiver, and the round trip ends when its response is read or written.
+func ObserveAck(t *Trace, m Metrics, msg []byte, start time.Time, err error) {
+	var acked func(info TraceInfo, err error)
+	if t != nil {
+		acked = t.Acked
+	}
+	if acked == nil && m == nil {
+		return
+	}
+	traceRaw(msg, start).done(acked, m, MetricAck, "", err)
+}
+
+// traceRaw returns the TraceInfo of the first MSH segment of a raw message.
+// Values that cannot be read are left empty.
+func traceRaw(data []byte, start time.Time) TraceInfo {
+	info := TraceInfo{Start: start}
+	i := bytes.Index(data, []byte("MSH"))
+	if i < 0 {
+		return info
+	}
+	line := data[i+3:]
+	if end := bytes.IndexAny(line, "\r\n"); end >= 0 {
+		line = line[:end]
+	}
+	dl, _, err := parseInitDelimiters(line)
+	if err != nil {
+		return info
+	}
+	// The first part is the encoding characters, MSH-2.
+	fields := bytes.Split(line[1:], []byte{dl.Field})
+	field := func(n int) []byte {
+		if n-2 < len(fields) {
+			return fields[n-2]
+		}
+		return nil
+	}
+	msh9 := bytes.Split(field(9), []byte{dl.Component})
+	info.MessageType = string(msh9[0])
+	if len(msh9) > 1 {
+		info.TriggerEvent = string(msh9[1])
+	}
+	info.ControlID = string(bytes.Split(field(10), []byte{dl.Component})[0])
+	return info
+}
+
+// traceMessage returns the TraceInfo of a decoded message.
+// Values that cannot be read are left empty.
+func traceMessage(message any, start time.Time) TraceInfo {
+	info := TraceInfo{Start: start}
+	info.MessageType, info.TriggerEvent, _ = messageEvent(message)
+	if msh, ok := findSegment(message, "MSH"); ok {
+		info.ControlID = stringField(msh, 10)
+	}
+	return info
+}
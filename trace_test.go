@@ -0,0 +1,64 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestTrace(t *testing.T) {
+	var got []string
+	record := func(step string) func(info TraceInfo, err error) {
+		return func(info TraceInfo, err error) {
+			if info.Start.IsZero() || info.Duration < 0 {
+				t.Errorf("%s: missing start time", step)
+			}
+			got = append(got, fmt.Sprintf("%s %s %s^%s %v", step, info.ControlID, info.MessageType, info.TriggerEvent, err != nil))
+		}
+	}
+	tr := &Trace{
+		Received: func(info TraceInfo) {
+			got = append(got, fmt.Sprintf("received %s %s^%s", info.ControlID, info.MessageType, info.TriggerEvent))
+		},
+		Decoded:   record("decoded"),
+		Validated: record("validated"),
+		Encoded:   record("encoded"),
+		Acked:     record("acked"),
+	}
+	raw := strings.Join([]string{
+		`MSH|^~\&|A|B|||20240102||ADT^A01^ADT_A01|MSG1|P|2.5.1`,
+		`EVN||20240102`,
+		`PID|||123`,
+		`PV1||I`,
+	}, "\r")
+	msg, err := NewDecoder(v251.Registry, &DecodeOption{Trace: tr}).Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = Validate(msg, &ValidateOption{Trace: tr})
+	if _, err := NewEncoder(&EncodeOption{Trace: tr}).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRouter()
+	r.Trace = tr
+	r.Default = HandlerFunc(func(message any) (any, error) {
+		return nil, fmt.Errorf("rejected")
+	})
+	_, _ = r.ServeHL7(msg)
+	_, _ = NewDecoder(v251.Registry, &DecodeOption{Trace: tr}).Decode([]byte("MSH*^~\\&*A*B*****ORU^R01*MSG2\rQQQ*1"))
+
+	want := strings.Join([]string{
+		"received MSG1 ADT^A01",
+		"decoded MSG1 ADT^A01 false",
+		"validated MSG1 ADT^A01 true",
+		"encoded MSG1 ADT^A01 false",
+		"acked MSG1 ADT^A01 true",
+		"received MSG2 ORU^R01",
+		"decoded MSG2 ORU^R01 true",
+	}, "\n")
+	if s := strings.Join(got, "\n"); s != want {
+		t.Errorf("got:\n%s\nwant:\n%s", s, want)
+	}
+}
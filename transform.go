@@ -0,0 +1,489 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Transform is a declarative mapping of values between HL7 paths, for converting messages between site dialects.
+// A Transform is usually loaded from JSON with ParseTransform, such as:
+//
+//	{
+//		"tables": {"sex": {"M": "M", "F": "F", "1": "M", "2": "F"}},
+//		"rules": [
+//			{"from": "PID-2", "to": "PID-3"},
+//			{"from": "PID-8", "to": "PID-8", "steps": [{"lookup": "sex", "default": "U"}]},
+//			{"from": "PID-7", "to": "PID-7", "steps": [{"date": {"from": "01/02/2006", "to": "YMD"}}]},
+//			{"from": "PID-5.1", "to": "PID-5.1", "steps": [{"substring": [0, 20]}]},
+//			{"to": "MSH-4", "value": "EAST"},
+//			{"to": "ZPI"}
+//		]
+//	}
+type Transform struct {
+	// Tables are lookup tables by name, each mapping a value to its replacement.
+	Tables map[string]map[string]string `json:"tables,omitempty"`
+
+	// Rules are applied in order.
+	Rules []TransformRule `json:"rules"`
+
+	// Funcs are the functions named by TransformStep.Func. They are not loaded from JSON.
+	Funcs map[string]TransformFunc `json:"-"`
+}
+
+// TransformFunc converts a value for a TransformStep.
+type TransformFunc func(value string) (string, error)
+
+// TransformRule sets the value at the path To, such as PID-3 or OBX-5.1, to the value at From after each of its Steps.
+//
+// Paths are written as for DiffOption.Ignore. A path without a segment number, such as OBX-5, names each OBX segment:
+// if From and To are in segments of the same name, the rule copies within each segment; otherwise the value of the
+// first From segment is written to each To segment, and a missing To segment is added at the end of the message.
+//
+// A field path copies each repetition with all its components, a component path the component of each repetition,
+// and a subcomponent path the subcomponent of each repetition. A value written to a component or subcomponent keeps
+// only its first component or subcomponent. The To value is cleared if the From value is empty.
+type TransformRule struct {
+	// From is the path of the source value. If empty, the value is Value.
+	From string `json:"from,omitempty"`
+
+	// To is the path of the destination value. A segment alone, such as ZPI, with no From or Value removes those segments.
+	To string `json:"to"`
+
+	// Value is a constant value, used if From is empty. If both are empty, the To value is cleared.
+	Value string `json:"value,omitempty"`
+
+	// Steps convert each value in order.
+	Steps []TransformStep `json:"steps,omitempty"`
+}
+
+// TransformStep converts a value. Exactly one of Substring, Lookup, Date, or Func is set.
+// Steps are applied to each non-empty subcomponent of a value, and not to explicit nulls.
+type TransformStep struct {
+	// Substring keeps the characters from the start, counted from 0, for the length. A length of 0 keeps the rest.
+	Substring *[2]int `json:"substring,omitempty"`
+
+	// Lookup replaces the value with its replacement in the named table.
+	Lookup string `json:"lookup,omitempty"`
+
+	// Default is the replacement of a value not in the Lookup table. If nil, the value is kept.
+	Default *string `json:"default,omitempty"`
+
+	// Date reformats a date or time.
+	Date *TransformDate `json:"date,omitempty"`
+
+	// Func names a function of Transform.Funcs.
+	Func string `json:"func,omitempty"`
+}
+
+// TransformDate reformats a value with time formats as for the format tag: a named precision such as YMD,
+// or a Go time layout such as 01/02/2006.
+type TransformDate struct {
+	// From is the format of the value. If empty, the value is an HL7 DTM.
+	From string `json:"from,omitempty"`
+
+	// To is the format written. If empty, an HL7 DTM is written with the precision of From,
+	// or to the second if From is a Go time layout.
+	To string `json:"to,omitempty"`
+}
+
+// ParseTransform returns the Transform of a JSON document, checking each rule.
+func ParseTransform(data []byte) (*Transform, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	t := &Transform{}
+	if err := dec.Decode(t); err != nil {
+		return nil, fmt.Errorf("transform: %w", err)
+	}
+	if _, err := t.compile(false); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+type transformRule struct {
+	from, to pathPattern
+	TransformRule
+}
+
+// compile checks and parses the rules. Functions are checked if funcs is set,
+// as they are not known when the rules are loaded.
+func (t *Transform) compile(funcs bool) ([]transformRule, error) {
+	list := make([]transformRule, len(t.Rules))
+	for i, r := range t.Rules {
+		errorf := func(format string, args ...any) error {
+			return fmt.Errorf("transform: rule %d: %s", i+1, fmt.Sprintf(format, args...))
+		}
+		tr := transformRule{TransformRule: r}
+		var err error
+		tr.to, err = parsePathPattern(r.To)
+		if err != nil {
+			return nil, errorf("%v", err)
+		}
+		switch f := tr.to.pos[0]; {
+		case f == 0 && (len(r.From) > 0 || len(r.Value) > 0 || len(r.Steps) > 0):
+			return nil, errorf("to %q: missing field", r.To)
+		case f > 0 && f <= 2 && isHeaderName(tr.to.name):
+			return nil, errorf("to %q: delimiters cannot be set", r.To)
+		}
+		if len(r.From) > 0 {
+			tr.from, err = parsePathPattern(r.From)
+			if err != nil {
+				return nil, errorf("%v", err)
+			}
+			if tr.from.pos[0] == 0 {
+				return nil, errorf("from %q: missing field", r.From)
+			}
+		}
+		for si, s := range r.Steps {
+			set := 0
+			for _, ok := range []bool{s.Substring != nil, len(s.Lookup) > 0, s.Date != nil, len(s.Func) > 0} {
+				if ok {
+					set++
+				}
+			}
+			switch {
+			case set != 1:
+				return nil, errorf("step %d: set one of substring, lookup, date, or func", si+1)
+			case s.Substring != nil && (s.Substring[0] < 0 || s.Substring[1] < 0):
+				return nil, errorf("step %d: negative substring", si+1)
+			case len(s.Lookup) > 0 && t.Tables[s.Lookup] == nil:
+				return nil, errorf("step %d: unknown table %q", si+1, s.Lookup)
+			case s.Default != nil && len(s.Lookup) == 0:
+				return nil, errorf("step %d: default without lookup", si+1)
+			case funcs && len(s.Func) > 0 && t.Funcs[s.Func] == nil:
+				return nil, errorf("step %d: unknown func %q", si+1, s.Func)
+			}
+			if d := s.Date; d != nil {
+				for _, f := range []string{d.From, d.To} {
+					if len(f) == 0 {
+						continue
+					}
+					if _, _, err := formatLayout(f); err != nil {
+						return nil, errorf("step %d: %v", si+1, err)
+					}
+				}
+			}
+		}
+		list[i] = tr
+	}
+	return list, nil
+}
+
+// Apply returns the message transformed by the rules, written with the standard delimiters.
+// The message is raw HL7 as a []byte or string, or a decoded trigger or segment list.
+// Each rule reads the message as received, so rules may swap values, and rules writing the same value
+// are applied in order. Decode the result with the Decoder of the destination system.
+func (t *Transform) Apply(message any) ([]byte, error) {
+	rules, err := t.compile(true)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	switch m := message.(type) {
+	case []byte:
+		data = m
+	case string:
+		data = []byte(m)
+	default:
+		data, err = NewEncoder(nil).Encode(message)
+		if err != nil {
+			return nil, fmt.Errorf("transform: %w", err)
+		}
+	}
+	src, err := UnmarshalGeneric(data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("transform: %w", err)
+	}
+	out := Clone(src)
+	for i, r := range rules {
+		out, err = t.apply(r, src, out)
+		if err != nil {
+			return nil, fmt.Errorf("transform: rule %d: %w", i+1, err)
+		}
+	}
+	return marshalGeneric(out), nil
+}
+
+// apply applies the rule, reading from src and writing to out.
+func (t *Transform) apply(r transformRule, src, out []GenericSegment) ([]GenericSegment, error) {
+	if r.to.pos[0] == 0 {
+		kept := out[:0]
+		for i, s := range out {
+			if s.Name != r.to.name || (r.to.num != 0 && r.to.num != segmentNumber(out, i)) {
+				kept = append(kept, s)
+			}
+		}
+		return kept, nil
+	}
+	var targets []int
+	for i, s := range out {
+		if s.Name == r.to.name && (r.to.num == 0 || r.to.num == segmentNumber(out, i)) {
+			targets = append(targets, i)
+		}
+	}
+	if len(targets) == 0 && r.to.num <= 1 {
+		out = append(out, GenericSegment{Name: r.to.name})
+		targets = []int{len(out) - 1}
+	}
+
+	// Within each segment, the source is the segment received with the same number as the target.
+	within := len(r.From) > 0 && r.from.name == r.to.name && r.from.num == 0 && r.to.num == 0
+	var values [][][]string
+	if len(r.From) == 0 {
+		if len(r.Value) > 0 {
+			values = [][][]string{{{r.Value}}}
+		}
+	} else if !within {
+		if s, ok := findGeneric(src, r.from); ok {
+			values = genericValues(s, r.from.pos)
+		}
+	}
+	for _, i := range targets {
+		v := values
+		if within {
+			v = nil
+			n := segmentNumber(out, i)
+			if s, ok := findGeneric(src, pathPattern{name: r.from.name, num: n}); ok {
+				v = genericValues(s, r.from.pos)
+			}
+		}
+		v, err := t.steps(r.Steps, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.To, err)
+		}
+		setGenericValues(&out[i], r.to.pos, v)
+	}
+	return out, nil
+}
+
+func isHeaderName(name string) bool {
+	return name == "MSH" || name == "BHS" || name == "FHS"
+}
+
+// segmentNumber returns the number of the segment at the index among the segments with its name, from 1.
+func segmentNumber(segs []GenericSegment, index int) int {
+	n := 0
+	for _, s := range segs[:index+1] {
+		if s.Name == segs[index].Name {
+			n++
+		}
+	}
+	return n
+}
+
+// findGeneric returns the segment of the pattern, or the first with its name if it has no number.
+func findGeneric(segs []GenericSegment, p pathPattern) (GenericSegment, bool) {
+	n := 0
+	for _, s := range segs {
+		if s.Name != p.name {
+			continue
+		}
+		n++
+		if p.num == 0 || p.num == n {
+			return s, true
+		}
+	}
+	return GenericSegment{}, false
+}
+
+// genericValues returns a copy of the components of each repetition at the position.
+// A component or subcomponent position has a single component or subcomponent.
+func genericValues(s GenericSegment, pos [3]int) [][][]string {
+	if pos[0] > len(s.Fields) {
+		return nil
+	}
+	reps := Clone(s.Fields[pos[0]-1])
+	if pos[1] == 0 {
+		return reps
+	}
+	for i, comps := range reps {
+		var subs []string
+		if pos[1] <= len(comps) {
+			subs = comps[pos[1]-1]
+		}
+		if pos[2] > 0 {
+			sub := ""
+			if pos[2] <= len(subs) {
+				sub = subs[pos[2]-1]
+			}
+			subs = []string{sub}
+		}
+		reps[i] = [][]string{subs}
+	}
+	return reps
+}
+
+// setGenericValues sets the value at the position to the components of each repetition.
+func setGenericValues(s *GenericSegment, pos [3]int, values [][][]string) {
+	for len(s.Fields) < pos[0] {
+		s.Fields = append(s.Fields, nil)
+	}
+	f := &s.Fields[pos[0]-1]
+	if pos[1] == 0 {
+		*f = values
+		return
+	}
+	for len(*f) < len(values) {
+		*f = append(*f, nil)
+	}
+	for i := range *f {
+		var v [][]string
+		if i < len(values) {
+			v = values[i]
+		}
+		comps := &(*f)[i]
+		for len(*comps) < pos[1] {
+			*comps = append(*comps, nil)
+		}
+		c := &(*comps)[pos[1]-1]
+		var subs []string
+		if len(v) > 0 {
+			subs = v[0]
+		}
+		if pos[2] == 0 {
+			*c = subs
+			continue
+		}
+		for len(*c) < pos[2] {
+			*c = append(*c, "")
+		}
+		sub := ""
+		if len(subs) > 0 {
+			sub = subs[0]
+		}
+		(*c)[pos[2]-1] = sub
+	}
+}
+
+// steps applies the steps to each non-empty subcomponent of the values.
+func (t *Transform) steps(steps []TransformStep, values [][][]string) ([][][]string, error) {
+	if len(steps) == 0 {
+		return values, nil
+	}
+	for _, comps := range values {
+		for _, subs := range comps {
+			for i, v := range subs {
+				if len(v) == 0 || v == nullValue {
+					continue
+				}
+				for _, s := range steps {
+					var err error
+					v, err = t.step(s, v)
+					if err != nil {
+						return nil, err
+					}
+				}
+				subs[i] = v
+			}
+		}
+	}
+	return values, nil
+}
+
+func (t *Transform) step(s TransformStep, v string) (string, error) {
+	switch {
+	case s.Substring != nil:
+		r := []rune(v)
+		start, length := s.Substring[0], s.Substring[1]
+		if start >= len(r) {
+			return "", nil
+		}
+		end := len(r)
+		if length > 0 && start+length < end {
+			end = start + length
+		}
+		return string(r[start:end]), nil
+	case len(s.Lookup) > 0:
+		if to, ok := t.Tables[s.Lookup][v]; ok {
+			return to, nil
+		}
+		if s.Default != nil {
+			return *s.Default, nil
+		}
+		return v, nil
+	case s.Date != nil:
+		return s.Date.reformat(v)
+	default:
+		return t.Funcs[s.Func](v)
+	}
+}
+
+func (d *TransformDate) reformat(v string) (string, error) {
+	var dt DateTime
+	if len(d.From) == 0 {
+		var err error
+		dt, err = ParseDateTime(v)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		layout, _, _ := formatLayout(d.From)
+		tm, err := time.Parse(layout, v)
+		if err != nil {
+			return "", fmt.Errorf("value %q does not match format %q", v, d.From)
+		}
+		dt.Time = tm
+		dt.Precision = PrecisionSecond
+		if f, ok := formatNames[d.From]; ok && f.precision != PrecisionDefault {
+			dt.Precision = f.precision
+		}
+	}
+	if len(d.To) == 0 {
+		return dt.String(), nil
+	}
+	layout, _, _ := formatLayout(d.To)
+	return dt.Format(layout), nil
+}
+
+// marshalGeneric writes the segments with the standard delimiters, without trailing empty values.
+func marshalGeneric(segs []GenericSegment) []byte {
+	e := NewEncoder(nil)
+	e.init(defaultSep, defaultChars)
+	escape := func(v string) []byte {
+		e.buf.Reset()
+		e.write(v, 0, false)
+		return append([]byte{}, e.buf.Bytes()...)
+	}
+	buf := &bytes.Buffer{}
+	for _, s := range segs {
+		buf.WriteString(s.Name)
+		fields := make([][]byte, len(s.Fields))
+		for f, reps := range s.Fields {
+			if f < 2 && isHeaderName(s.Name) {
+				continue
+			}
+			rout := make([][]byte, len(reps))
+			for r, comps := range reps {
+				cout := make([][]byte, len(comps))
+				for c, subs := range comps {
+					sout := make([][]byte, len(subs))
+					for i, v := range subs {
+						sout[i] = escape(v)
+					}
+					cout[c] = joinTrimmed(sout, DefaultDelimiters.Subcomponent)
+				}
+				rout[r] = joinTrimmed(cout, DefaultDelimiters.Component)
+			}
+			fields[f] = joinTrimmed(rout, DefaultDelimiters.Repeat)
+		}
+		if isHeaderName(s.Name) {
+			buf.WriteString(defaultSep + defaultChars)
+			if len(fields) >= 2 {
+				fields = fields[2:]
+			} else {
+				fields = nil
+			}
+		}
+		for len(fields) > 0 && len(fields[len(fields)-1]) == 0 {
+			fields = fields[:len(fields)-1]
+		}
+		for _, f := range fields {
+			buf.WriteString(defaultSep)
+			buf.Write(f)
+		}
+		buf.WriteByte(nextLine)
+	}
+	return buf.Bytes()
+}
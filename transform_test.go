@@ -0,0 +1,98 @@
+package hl7
+
+import (
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestTransform(t *testing.T) {
+	config := `{
+		"tables": {"sex": {"1": "M", "2": "F"}},
+		"rules": [
+			{"from": "PID-3", "to": "PID-4"},
+			{"from": "PID-4", "to": "PID-3"},
+			{"from": "PID-8", "to": "PID-8", "steps": [{"lookup": "sex", "default": "U"}]},
+			{"from": "PID-7", "to": "PID-7", "steps": [{"date": {"from": "01/02/2006", "to": "YMD"}}]},
+			{"from": "PID-5.1", "to": "PID-5.1", "steps": [{"substring": [0, 3]}, {"func": "upper"}]},
+			{"from": "OBX-3.1", "to": "OBX-4"},
+			{"from": "MSH-4", "to": "OBX-15.1"},
+			{"from": "MSH-7", "to": "ZTS-1", "steps": [{"date": {"to": "YMD"}}]},
+			{"to": "MSH-3", "value": "EAST"},
+			{"to": "PID-9"},
+			{"to": "ZPI"}
+		]
+	}`
+	tr, err := ParseTransform([]byte(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.Funcs = map[string]TransformFunc{
+		"upper": func(v string) (string, error) { return strings.ToUpper(v), nil },
+	}
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||A1^^^X~A2|B1^^^Y|Doe \T\ Sons^Jane||03/04/1980|2|Alias`,
+		`ZPI|1`,
+		`OBR|1||X|CBC`,
+		`OBX|1|ST|WBC^White^LN||5`,
+		`OBX|2|ST|RBC^Red^LN||4`,
+	}, "\r")
+	want := strings.Join([]string{
+		`MSH|^~\&|EAST|HOSP|||20240102030405||ORU^R01^ORU_R01|1|P|2.5.1`,
+		`PID|||B1^^^Y|A1^^^X~A2|DOE^Jane||19800304|F`,
+		`OBR|1||X|CBC`,
+		`OBX|1|ST|WBC^White^LN|WBC|5||||||||||HOSP`,
+		`OBX|2|ST|RBC^Red^LN|RBC|4||||||||||HOSP`,
+		`ZTS|20240102`,
+	}, "\r") + "\r"
+
+	got, err := tr.Apply(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("got:\n%s", lineDiff(got, []byte(want)))
+	}
+
+	// The result decodes for the destination, and a decoded message may be transformed.
+	msg, err := NewDecoder(v251.Registry, nil).Decode(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back := &Transform{
+		Tables: map[string]map[string]string{"sex": {"M": "1", "F": "2"}},
+		Rules: []TransformRule{
+			{From: "PID-8", To: "PID-8", Steps: []TransformStep{{Lookup: "sex"}}},
+			{From: "PID-7", To: "PID-7", Steps: []TransformStep{{Date: &TransformDate{To: "01/02/2006"}}}},
+			{From: "PID-5.1", To: "PID-5.1"},
+		},
+	}
+	got, err = back.Apply(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(got), "\rPID|1||B1^^^Y|A1^^^X~A2|DOE^Jane||03/04/1980|2\r") {
+		t.Errorf("got:\n%s", strings.ReplaceAll(string(got), "\r", "\n"))
+	}
+
+	for _, bad := range []string{
+		`{"rules": [{"to": "PID"}], "extra": 1}`,
+		`{"rules": [{"to": "MSH-2", "value": "x"}]}`,
+		`{"rules": [{"from": "PID", "to": "PID-3"}]}`,
+		`{"rules": [{"to": "ZPI", "value": "x"}]}`,
+		`{"rules": [{"from": "PID-3", "to": "PID-2", "steps": [{}]}]}`,
+		`{"rules": [{"from": "PID-3", "to": "PID-2", "steps": [{"lookup": "none"}]}]}`,
+		`{"rules": [{"from": "PID-3", "to": "PID-2", "steps": [{"date": {"to": "XYZ"}}]}]}`,
+		`{"rules": [{"from": "PID-3", "to": "PID-2", "steps": [{"substring": [-1, 2]}]}]}`,
+	} {
+		if _, err := ParseTransform([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+	tr.Funcs = nil
+	if _, err := tr.Apply(raw); err == nil {
+		t.Errorf("expected an error for an unknown func")
+	}
+}
@@ -0,0 +1,487 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// node is an element of a message tree, named as in the v2.xml encoding: a message, group, segment,
+// field repetition, component, or subcomponent. A node has either a text value or child nodes.
+type node struct {
+	XMLName xml.Name
+	Text    string `xml:",chardata"`
+	Nodes   []node `xml:",any"`
+}
+
+func newNode(name, text string, nodes ...node) node {
+	return node{XMLName: xml.Name{Local: name}, Text: text, Nodes: nodes}
+}
+
+func (n node) name() string {
+	return n.XMLName.Local
+}
+
+// tree returns the tree of the message, with the values the Encoder would write.
+// The message is a trigger or a segment list, such as from DecodeList, which has no groups.
+func (e *Encoder) tree(message any) (node, error) {
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	b := &treeBuilder{
+		e:  e,
+		ld: &lineDecoder{},
+	}
+	b.ld.setDelimiters(DefaultDelimiters)
+
+	var nodes []node
+	var err error
+	switch {
+	default:
+		return node{}, fmt.Errorf("unable to encode %T, expected a trigger or segment list", message)
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Interface:
+		if rv.Len() == 0 {
+			return node{}, fmt.Errorf("segment list is empty")
+		}
+		ms, ok := rv.Index(0).Interface().(messageStructure)
+		if !ok || len(ms.MessageStructureID()) == 0 {
+			return node{}, fmt.Errorf("first segment must have a message structure, %T does not", rv.Index(0).Interface())
+		}
+		b.root = ms.MessageStructureID()
+		nodes, err = b.walk(1, rv)
+	case rv.Kind() == reflect.Struct:
+		meta, err := e.meta(rv.Type())
+		if err != nil {
+			return node{}, err
+		}
+		if meta.Type != structTrigger {
+			return node{}, fmt.Errorf("unable to encode %T, expected a trigger or segment list", message)
+		}
+		b.root = meta.Name
+		nodes, err = b.fields(1, rv)
+	}
+	if err != nil {
+		return node{}, err
+	}
+	return newNode(b.root, "", nodes...), nil
+}
+
+// treeBuilder builds the node tree of a message, numbering sequence fields like Encoder.walk.
+type treeBuilder struct {
+	e    *Encoder
+	ld   *lineDecoder // Splits and unescapes raw values with the delimiters of the last header segment.
+	root string       // Message structure, such as ADT_A01.
+}
+
+// fields returns the nodes of the fields of a trigger or group.
+func (b *treeBuilder) fields(seq int, rv reflect.Value) ([]node, error) {
+	var nodes []node
+	for i := 0; i < rv.NumField(); i++ {
+		list, err := b.walk(seq, rv.Field(i))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, list...)
+	}
+	return nodes, nil
+}
+
+// walk returns the nodes of the groups and segments of the value.
+func (b *treeBuilder) walk(seq int, wv reflect.Value) ([]node, error) {
+	switch wv.Kind() {
+	default:
+		return nil, nil
+	case reflect.Interface, reflect.Pointer:
+		if wv.IsNil() {
+			return nil, nil
+		}
+		return b.walk(seq, wv.Elem())
+	case reflect.Slice:
+		var nodes []node
+		for i := 0; i < wv.Len(); i++ {
+			// Segments of a segment list are each numbered as the first.
+			n := i + 1
+			if wv.Type().Elem().Kind() == reflect.Interface {
+				n = seq
+			}
+			list, err := b.walk(n, wv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, list...)
+		}
+		return nodes, nil
+	case reflect.Struct:
+	}
+	meta, err := b.e.meta(wv.Type())
+	if err != nil {
+		return nil, err
+	}
+	if !meta.Present {
+		return nil, nil
+	}
+	switch meta.Type {
+	default:
+		return nil, nil
+	case structTriggerGroup:
+		nodes, err := b.fields(seq, wv)
+		if err != nil {
+			return nil, err
+		}
+		return []node{newNode(b.groupName(meta.Name), "", nodes...)}, nil
+	case structSegment:
+		nodes, err := b.segment(seq, meta.Name, wv)
+		if err != nil {
+			return nil, err
+		}
+		return []node{newNode(meta.Name, "", nodes...)}, nil
+	}
+}
+
+// groupName returns the v2.xml name of a group, such as ADT_A01.INSURANCE for ADT_A01_Insurance.
+func (b *treeBuilder) groupName(name string) string {
+	name = strings.TrimPrefix(name, b.root+"_")
+	buf := &strings.Builder{}
+	buf.WriteString(b.root)
+	buf.WriteByte('.')
+	prev := rune(0)
+	for _, r := range name {
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			buf.WriteByte('_')
+		}
+		buf.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return buf.String()
+}
+
+func (b *treeBuilder) segment(seq int, name string, st reflect.Value) ([]node, error) {
+	stt := st.Type()
+	sep := defaultSep
+	var nodes []node
+	for i := 0; i < st.NumField(); i++ {
+		fld := stt.Field(i)
+		t, err := parseTag(fld.Name, fld.Tag.Get(tagName))
+		if err != nil {
+			return nil, err
+		}
+		if !t.Present || t.Meta || t.Order <= 0 {
+			continue
+		}
+		v := st.Field(i).Interface()
+		switch {
+		case t.FieldSep:
+			if s, ok := v.(string); ok && len(s) == 0 {
+				v = defaultSep
+			}
+			sep = v.(string)
+		case t.FieldChars:
+			if s, ok := v.(string); ok && len(s) == 0 {
+				v = defaultChars
+			}
+			dl, err := ParseDelimiters(sep, v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			b.ld.setDelimiters(dl)
+		case t.Sequence:
+			if s, ok := v.(string); ok && len(s) == 0 {
+				v = strconv.FormatInt(int64(seq), 10)
+			}
+			if rv := reflect.ValueOf(v); isNumber(rv.Kind()) && rv.IsZero() {
+				v = seq
+			}
+		}
+		list, err := b.value(name+"."+strconv.FormatInt(int64(t.Order), 10), t, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, fld.Name, err)
+		}
+		nodes = append(nodes, list...)
+	}
+	return nodes, nil
+}
+
+// value returns the node of a field or component value, with a node for each repetition.
+func (b *treeBuilder) value(name string, t tag, o any) ([]node, error) {
+	if o == nil {
+		return nil, nil
+	}
+	text := func(v string) ([]node, error) {
+		if len(v) == 0 {
+			return nil, nil
+		}
+		return []node{newNode(name, v)}, nil
+	}
+	rv := reflect.ValueOf(o)
+	isPointer := false
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		// A pointer to a number is sent even if it is 0.
+		rv = rv.Elem()
+		isPointer = true
+	}
+	o = rv.Interface()
+
+	switch v := o.(type) {
+	case []byte:
+		return text(string(v))
+	case string:
+		return text(v)
+	case Raw:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		n, err := b.raw(name, []byte(v), 1)
+		if err != nil {
+			return nil, err
+		}
+		return []node{n}, nil
+	case valueFormatter:
+		if n, ok := v.(nullable); ok && n.IsNull() {
+			return text(nullValue)
+		}
+		if v.IsZero() {
+			return nil, nil
+		}
+		return text(v.formatHL7(t, &b.e.opt))
+	case time.Time:
+		if v.IsZero() {
+			return nil, nil
+		}
+		return text(formatDTM(v, PrecisionDefault, 0, false, t, &b.e.opt))
+	}
+	if isNumber(rv.Kind()) {
+		if rv.IsZero() && !isPointer {
+			return nil, nil
+		}
+		sv, err := formatNumber(rv)
+		if err != nil {
+			return nil, err
+		}
+		return text(sv)
+	}
+	switch rv.Kind() {
+	default:
+		return nil, fmt.Errorf("unknown value kind: %v", rv.Kind())
+	case reflect.String:
+		return text(rv.String())
+	case reflect.Slice:
+		var nodes []node
+		for i := 0; i < rv.Len(); i++ {
+			x := rv.Index(i)
+			value := x.Interface()
+			// A repetition keeps its position, so a 0 in it is sent.
+			if isNumber(x.Kind()) {
+				value = x.Addr().Interface()
+			}
+			list, err := b.value(name, t, value)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, list...)
+		}
+		return nodes, nil
+	case reflect.Struct:
+		if rv.IsZero() {
+			return nil, nil
+		}
+		rt := rv.Type()
+		meta, err := b.e.meta(rt)
+		if err != nil {
+			return nil, err
+		}
+		typeName := meta.Name
+		if len(typeName) == 0 {
+			typeName = rt.Name()
+		}
+		var nodes []node
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			ct, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+			if err != nil {
+				return nil, err
+			}
+			if !ct.Present || ct.Meta || ct.Order <= 0 {
+				continue
+			}
+			cname := typeName + "." + strconv.FormatInt(int64(ct.Order), 10)
+			list, err := b.value(cname, ct, rv.Field(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", cname, err)
+			}
+			nodes = append(nodes, list...)
+		}
+		return []node{newNode(name, "", nodes...)}, nil
+	}
+}
+
+// raw returns the node of a raw value, whose data type is not known, with a node named varies.n for each
+// component and subcomponent.
+func (b *treeBuilder) raw(name string, v []byte, level int) (node, error) {
+	parts := [][]byte{v}
+	for l := level; l < len(b.ld.dividers); l++ {
+		if bytes.IndexByte(v, b.ld.dividers[l]) >= 0 {
+			level = l
+			parts = bytes.Split(v, []byte{b.ld.dividers[l]})
+			break
+		}
+	}
+	if len(parts) == 1 {
+		s, err := b.ld.unescape(v)
+		if err != nil {
+			return node{}, err
+		}
+		return newNode(name, s), nil
+	}
+	n := newNode(name, "")
+	for i, p := range parts {
+		if len(p) == 0 {
+			continue
+		}
+		c, err := b.raw("varies."+strconv.Itoa(i+1), p, level+1)
+		if err != nil {
+			return node{}, err
+		}
+		n.Nodes = append(n.Nodes, c)
+	}
+	return n, nil
+}
+
+// treeSegments converts the nodes of a message tree to segment lines, so they may be decoded as any other message.
+// Group nodes, whose names contain a period, are not required.
+func treeSegments(nodes []node) ([]byte, error) {
+	c := &treeConverter{e: NewEncoder(nil), buf: &bytes.Buffer{}}
+	c.e.init(defaultSep, defaultChars)
+	if err := c.nodes(nodes); err != nil {
+		return nil, err
+	}
+	return c.buf.Bytes(), nil
+}
+
+type treeConverter struct {
+	e   *Encoder // Escapes values with the delimiters of the last header segment.
+	buf *bytes.Buffer
+}
+
+// nodes writes the segments of a message or group node.
+func (c *treeConverter) nodes(list []node) error {
+	for _, n := range list {
+		var err error
+		if strings.Contains(n.name(), ".") {
+			err = c.nodes(n.Nodes)
+		} else {
+			err = c.segment(n)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *treeConverter) segment(n node) error {
+	name := n.name()
+	var fields [][]string
+	header := false
+	switch name {
+	case "MSH", "BHS", "FHS":
+		header = true
+		sep, chars := defaultSep, defaultChars
+		for _, f := range n.Nodes {
+			switch f.name() {
+			case name + ".1":
+				sep = f.Text
+			case name + ".2":
+				chars = f.Text
+			}
+		}
+		if _, err := ParseDelimiters(sep, chars); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		c.e.init(sep, chars)
+		fields = make([][]string, 2)
+	}
+	for _, f := range n.Nodes {
+		order, err := nodePosition(name, f.name())
+		if err != nil {
+			return err
+		}
+		for len(fields) < order {
+			fields = append(fields, nil)
+		}
+		v, err := c.field(f, 0)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.name(), err)
+		}
+		fields[order-1] = append(fields[order-1], v)
+	}
+	sep := c.e.initSep
+	c.buf.WriteString(name)
+	for i, reps := range fields {
+		if header && i < 2 {
+			// The field separator and encoding characters are written as is.
+			if i == 1 {
+				c.buf.WriteString(sep)
+				c.buf.WriteString(c.e.initChars)
+			}
+			continue
+		}
+		c.buf.WriteString(sep)
+		c.buf.WriteString(strings.Join(reps, string(c.e.repeat)))
+	}
+	c.buf.WriteByte(nextLine)
+	return nil
+}
+
+// field returns the escaped value of a field, component, or subcomponent node.
+func (c *treeConverter) field(n node, level int) (string, error) {
+	if len(n.Nodes) == 0 {
+		if n.Text == nullValue {
+			return nullValue, nil
+		}
+		c.e.buf.Reset()
+		c.e.write(n.Text, 0, false)
+		return c.e.buf.String(), nil
+	}
+	if level >= 2 {
+		return "", fmt.Errorf("%s is nested too deep", n.Nodes[0].name())
+	}
+	var parts []string
+	for _, cn := range n.Nodes {
+		order, err := nodePosition("", cn.name())
+		if err != nil {
+			return "", err
+		}
+		for len(parts) < order {
+			parts = append(parts, "")
+		}
+		v, err := c.field(cn, level+1)
+		if err != nil {
+			return "", err
+		}
+		parts[order-1] = v
+	}
+	return strings.Join(parts, string(c.e.dividers[level+1])), nil
+}
+
+// nodePosition returns the position of a field or component node, such as 5 for PID.5.
+// If prefix is set, the node must be named after it.
+func nodePosition(prefix, name string) (int, error) {
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 || (len(prefix) > 0 && name[:i] != prefix) {
+		return 0, fmt.Errorf("unexpected element %s", name)
+	}
+	n, err := strconv.Atoi(name[i+1:])
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("element %s: invalid position", name)
+	}
+	return n, nil
+}
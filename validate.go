@@ -0,0 +1,467 @@
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrRequired is the FieldError reason for a missing required value.
+	ErrRequired = errors.New("required value missing")
+
+	// ErrNotSupported is the FieldError reason for a value that must not be sent.
+	ErrNotSupported = errors.New("not supported value present")
+
+	// ErrTableValue is the FieldError reason for a value that is not in the field table.
+	ErrTableValue = errors.New("value not in table")
+
+	// ErrUnits is the FieldError reason for observation units the UnitChecker rejects.
+	ErrUnits = errors.New("invalid units")
+)
+
+// ValidateOption represents options for Validate.
+type ValidateOption struct {
+	// Tables checks values of fields with a table tag option. If nil, values are not checked.
+	Tables TableProvider
+
+	// TableValue is how values not in the table are reported. The default returns them in the ValidationError.
+	TableValue TablePolicy
+
+	// Units checks the units (OBX-6) of each OBX segment. If nil, units are not checked.
+	Units UnitChecker
+
+	// Codes checks coded values of fields with a table tag option, such as vaccine codes in RXA-5.
+	// The TableValue option is how rejected codes are reported. If nil, coded values are not checked.
+	Codes CodeChecker
+
+	// Warn is called with problems that are not treated as errors.
+	// If nil, warnings are ignored.
+	Warn func(err error)
+
+	// Trace is called as Validate checks each message. If nil, validation is not traced.
+	Trace *Trace
+
+	// Metrics receives a MetricValidate for each message checked. May be nil.
+	Metrics Metrics
+}
+
+// TablePolicy is how Validate reports a value not in the table.
+type TablePolicy byte
+
+const (
+	TableDefault TablePolicy = iota // Error when validating, ignore when decoding.
+	TableError                      // Return the value in the ValidationError, or the error from decoding.
+	TableWarn                       // Call the Warn option with the FieldError.
+	TableIgnore                     // Do not report values not in the table.
+)
+
+// FieldError is a problem with a field, component, or subcomponent of a segment.
+type FieldError struct {
+	Segment  string // Segment name, such as PID.
+	Position []int  // Field, component, and subcomponent numbers.
+	Name     string // Go field names, separated by a period.
+	Err      error
+}
+
+func (e *FieldError) Error() string {
+	return e.Location() + " " + e.Name + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Location returns the HL7 position, such as PID-3.1.
+func (e *FieldError) Location() string {
+	b := &strings.Builder{}
+	b.WriteString(e.Segment)
+	for i, p := range e.Position {
+		if i == 0 {
+			b.WriteByte('-')
+		} else {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(p))
+	}
+	return b.String()
+}
+
+// ValidationError lists each problem found in a message.
+type ValidationError []*FieldError
+
+func (ve ValidationError) Error() string {
+	ss := make([]string, len(ve))
+	for i, e := range ve {
+		ss[i] = e.Error()
+	}
+	return strings.Join(ss, "; ")
+}
+
+// Validate checks the usage of each field in a decoded message, or in a message before it is encoded.
+// Required (R) fields must have a value and not supported (X) fields must not.
+// Components and subcomponents are checked when the data type has a value.
+// RE, O, and C usage are not checked.
+//
+// The message may be a trigger structure, a segment, or the list of segments returned by DecodeList.
+// A trigger structure, as returned by Decode, is also checked for the segments and groups
+// the message structure requires, in each group that is present.
+// If there are problems, the error is a ValidationError.
+// Option is optional.
+func Validate(message any, opt *ValidateOption) error {
+	v := &validator{}
+	if opt != nil {
+		v.opt = *opt
+	}
+	var validated func(info TraceInfo, err error)
+	if t := v.opt.Trace; t != nil {
+		validated = t.Validated
+	}
+	var info TraceInfo
+	if validated != nil || v.opt.Metrics != nil {
+		info = traceMessage(message, time.Now())
+	}
+	v.walk(reflect.ValueOf(message), "")
+	var err error
+	if len(v.errs) > 0 {
+		err = v.errs
+	}
+	info.done(validated, v.opt.Metrics, MetricValidate, "", err)
+	return err
+}
+
+type validator struct {
+	opt  ValidateOption
+	errs ValidationError
+}
+
+func (v *validator) walk(rv reflect.Value, name string) {
+	switch rv.Kind() {
+	default:
+		return
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return
+		}
+		v.walk(rv.Elem(), name)
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			v.walk(rv.Index(i), name)
+		}
+	case reflect.Struct:
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil || !meta.Present {
+			return
+		}
+		switch meta.Type {
+		case structTrigger, structTriggerGroup:
+			if len(name) == 0 {
+				name = meta.Name
+			}
+			v.group(name, rv)
+		case structSegment:
+			v.fields(meta.Name, nil, "", rv)
+		}
+	}
+}
+
+// group checks the required segments and groups of a trigger or group struct, then walks each one present.
+func (v *validator) group(name string, rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta {
+			continue
+		}
+		fname := name + "." + ft.Name
+		fv := rv.Field(i)
+		if fv.IsZero() || (fv.Kind() == reflect.Slice && fv.Len() == 0) {
+			if t.Usage == usageRequired {
+				meta, _ := (&Encoder{}).meta(ft.Type)
+				v.errs = append(v.errs, &FieldError{Segment: meta.Name, Name: fname, Err: ErrRequired})
+			}
+			continue
+		}
+		v.walk(fv, fname)
+	}
+}
+
+// fields checks the fields or components of the segment or data type struct rv.
+func (v *validator) fields(segment string, pos []int, name string, rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		t, err := parseTag(ft.Name, ft.Tag.Get(tagName))
+		if err != nil || !t.Present || t.Meta || t.Omit {
+			continue
+		}
+		fpos := append(pos[:len(pos):len(pos)], int(t.Order))
+		fname := ft.Name
+		if len(name) > 0 {
+			fname = name + "." + ft.Name
+		}
+		fv := rv.Field(i)
+		if fv.IsZero() || (fv.Kind() == reflect.Slice && fv.Len() == 0) {
+			// Set IDs and the MSH delimiters are written by the encoder.
+			if t.Usage == usageRequired && !t.Sequence && !t.FieldSep && !t.FieldChars {
+				v.errs = append(v.errs, &FieldError{Segment: segment, Position: fpos, Name: fname, Err: ErrRequired})
+			}
+			continue
+		}
+		if t.Usage == usageNotSupported {
+			v.errs = append(v.errs, &FieldError{Segment: segment, Position: fpos, Name: fname, Err: ErrNotSupported})
+			continue
+		}
+		if len(t.Table) > 0 && v.opt.Tables != nil {
+			v.table(t.Table, &FieldError{Segment: segment, Position: fpos, Name: fname}, fv)
+		} else {
+			v.enum(&FieldError{Segment: segment, Position: fpos, Name: fname}, fv)
+		}
+		if len(t.Table) > 0 && v.opt.Codes != nil {
+			v.code(t.Table, &FieldError{Segment: segment, Position: fpos, Name: fname}, fv)
+		}
+		if segment == "OBX" && len(pos) == 0 && t.Order == 6 && v.opt.Units != nil {
+			v.units(&FieldError{Segment: segment, Position: fpos, Name: fname}, fv)
+		}
+		v.dataType(segment, fpos, fname, fv)
+	}
+}
+
+// units checks the observation units with the UnitChecker.
+func (v *validator) units(fe *FieldError, rv reflect.Value) {
+	u := unitsValue(rv)
+	if len(u.Identifier) == 0 {
+		return
+	}
+	if _, err := v.opt.Units.CheckUnits(u); err != nil {
+		fe.Err = fmt.Errorf("%w %q: %v", ErrUnits, u.Identifier, err)
+		v.errs = append(v.errs, fe)
+	}
+}
+
+// dataType checks the components of each value of a data type field.
+func (v *validator) dataType(segment string, pos []int, name string, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() || rv.Elem().IsZero() {
+			return // A value not sent has no components.
+		}
+		v.dataType(segment, pos, name, rv.Elem())
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			v.dataType(segment, pos, name, rv.Index(i))
+		}
+	case reflect.Struct:
+		if rv.IsZero() {
+			return
+		}
+		meta, err := (&Encoder{}).meta(rv.Type())
+		if err != nil || meta.Type != structDataType {
+			return
+		}
+		v.fields(segment, pos, name, rv)
+	}
+}
+
+// table checks each string value of rv is in the table.
+// Data type values are not checked, as the coding system may not be the HL7 table.
+func (v *validator) table(table string, fe *FieldError, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			v.table(table, fe, rv.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			v.table(table, fe, rv.Index(i))
+		}
+	case reflect.String:
+		value := rv.String()
+		if len(value) == 0 || value == nullValue {
+			return
+		}
+		valid, known := v.opt.Tables.TableValue(table, value)
+		if valid || !known {
+			return
+		}
+		v.tableError(fe, fmt.Errorf("%w %s: %q", ErrTableValue, table, value))
+	}
+}
+
+// Enum is a string type of the values of a table, such as the table enum types of the version packages.
+// The values of fields declared with an Enum type are checked against the table when decoded
+// and by Validate, without a TableProvider.
+type Enum interface {
+	TableID() string
+	Valid() bool // Reports if the value is in the table.
+}
+
+// checkEnum returns an error if the value of rv is an Enum value not in the table.
+func checkEnum(rv reflect.Value) error {
+	e, ok := rv.Interface().(Enum)
+	if !ok {
+		return nil
+	}
+	value := rv.String()
+	if len(value) == 0 || value == nullValue || e.Valid() {
+		return nil
+	}
+	return fmt.Errorf("%w %s: %q", ErrTableValue, e.TableID(), value)
+}
+
+// enum checks each Enum value of rv is in its table.
+func (v *validator) enum(fe *FieldError, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			v.enum(fe, rv.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			v.enum(fe, rv.Index(i))
+		}
+	case reflect.String:
+		if err := checkEnum(rv); err != nil {
+			v.tableError(fe, err)
+		}
+	}
+}
+
+// code checks each coded data type value of rv with the CodeChecker.
+func (v *validator) code(table string, fe *FieldError, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			v.code(table, fe, rv.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			v.code(table, fe, rv.Index(i))
+		}
+	case reflect.Struct:
+		c := Code{Identifier: stringField(rv, 1), Text: stringField(rv, 2), CodingSystem: stringField(rv, 3)}
+		if len(c.Identifier) == 0 {
+			return
+		}
+		if err := v.opt.Codes.CheckCode(table, c); err != nil {
+			v.tableError(fe, fmt.Errorf("%w %s: %q: %v", ErrTableValue, table, c.Identifier, err))
+		}
+	}
+}
+
+// tableError reports a value not in the table with the table value policy.
+func (v *validator) tableError(fe *FieldError, err error) {
+	e := *fe
+	e.Err = err
+	switch v.opt.TableValue {
+	default:
+		v.errs = append(v.errs, &e)
+	case TableWarn:
+		if v.opt.Warn != nil {
+			v.opt.Warn(&e)
+		}
+	case TableIgnore:
+	}
+}
+
+// Tables of code sets maintained outside of HL7, which a CodeChecker may check.
+const (
+	TableCVX = "0292" // CDC vaccines administered (CVX).
+	TableMVX = "0227" // CDC manufacturers of vaccines (MVX).
+)
+
+// Code is a coded value, the first identifier, text, and coding system of a CE or CWE value.
+type Code struct {
+	Identifier   string
+	Text         string
+	CodingSystem string // Such as CVX.
+}
+
+// CodeChecker checks coded values against code sets, such as the current CVX and MVX codes
+// for immunization reporting. The checker is supplied by the user.
+type CodeChecker interface {
+	// CheckCode returns an error if the code is not valid for the table of the field.
+	// The checker decides which tables and coding systems to check.
+	CheckCode(table string, code Code) error
+}
+
+// CodeCheckerFunc is a function that implements CodeChecker.
+type CodeCheckerFunc func(table string, code Code) error
+
+func (f CodeCheckerFunc) CheckCode(table string, code Code) error {
+	return f(table, code)
+}
+
+// TableProvider reports if values are valid for HL7 tables.
+type TableProvider interface {
+	// TableValue reports if value is in the table. If the table is not known, known is false.
+	TableValue(table, value string) (valid, known bool)
+}
+
+// TableValues is a TableProvider with the set of valid values of each table,
+// such as the TableValueLookup of a version package.
+// A table without values is not known.
+type TableValues map[string]map[string]bool
+
+func (tv TableValues) TableValue(table, value string) (valid, known bool) {
+	values := tv[table]
+	if len(values) == 0 {
+		return false, false
+	}
+	return values[value], true
+}
+
+// ValidateOrder checks the order of the segments returned by DecodeList against the message structure
+// of the registry trigger for the MSH message structure ID, such as an OBX following an OBR in ORU_R01.
+// Required segments and groups that are missing are also reported.
+// Checking stops at the first segment that is out of order or not in the message structure.
+// If there are problems, the error is a ValidationError.
+func ValidateOrder(list []any, registry Registry) error {
+	if len(list) == 0 {
+		return fmt.Errorf("list is empty")
+	}
+	ms, ok := list[0].(messageStructure)
+	if !ok {
+		return fmt.Errorf("first segment must implement MessageStructure, %T does not", list[0])
+	}
+	code := ms.MessageStructureID()
+	trigger, ok := registry.Trigger()[code]
+	if !ok {
+		return fmt.Errorf("message structure code not found %q", code)
+	}
+	rt := reflect.TypeOf(trigger)
+	elements := structureElements(rt)
+
+	m := &profileMatcher{v: &validator{}}
+	m.flatten(reflect.ValueOf(list))
+	m.elements(code, elements, false)
+	if m.pos < len(m.segs) {
+		name := segmentName(m.segs[m.pos])
+		err := ErrUnexpectedSegment
+		if containsSegment(elements, name) {
+			err = ErrSegmentOrder
+		}
+		m.v.errs = append(m.v.errs, &FieldError{Segment: name, Name: code, Err: fmt.Errorf("%w: segment %d", err, m.pos+1)})
+	}
+	if len(m.v.errs) == 0 {
+		return nil
+	}
+	return m.v.errs
+}
+
+// containsSegment reports if the segment is anywhere in the elements.
+func containsSegment(list []ProfileElement, name string) bool {
+	for _, e := range list {
+		if e.isSegment() && e.Name == name {
+			return true
+		}
+		if e.isGroup() && containsSegment(e.Element, name) {
+			return true
+		}
+	}
+	return false
+}
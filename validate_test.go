@@ -0,0 +1,219 @@
+package hl7
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+type testZUS struct {
+	HL7      struct{} `hl7:",name=ZUS,type=s"`
+	Required string   `hl7:"1,usage=R"`
+	Never    string   `hl7:"2,usage=X"`
+	Maybe    string   `hl7:"3,usage=RE"`
+}
+
+func TestValidate(t *testing.T) {
+	pid := &v251.PID{
+		PatientIdentifierList: []v251.CX{{CheckDigit: "1"}},
+	}
+	err := Validate(pid, nil)
+	var ve ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	var got []string
+	for _, fe := range ve {
+		got = append(got, fe.Location())
+		if !errors.Is(fe, ErrRequired) {
+			t.Errorf("%s: got %v, want ErrRequired", fe.Location(), fe.Err)
+		}
+	}
+	want := []string{"PID-3.1", "PID-5"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if s := ve[0].Error(); s != "PID-3.1 PatientIdentifierList.IDNumber: required value missing" {
+		t.Fatalf("got message %q", s)
+	}
+
+	pid.PatientIdentifierList[0].IDNumber = "123"
+	pid.PatientName = []v251.XPN{{GivenName: "Ann"}}
+	if err := Validate([]any{pid}, nil); err != nil {
+		t.Fatalf("expected valid, got %v", err)
+	}
+
+	err = Validate(testZUS{Never: "x"}, nil)
+	if !errors.As(err, &ve) || len(ve) != 2 || !errors.Is(ve[0], ErrRequired) || !errors.Is(ve[1], ErrNotSupported) {
+		t.Fatalf("got %v", err)
+	}
+	if ve[1].Location() != "ZUS-2" {
+		t.Fatalf("got location %q", ve[1].Location())
+	}
+}
+
+func TestValidateStructure(t *testing.T) {
+	msg := &v251.ADT_A01{
+		MSH: &v251.MSH{},
+		PID: &v251.PID{
+			PatientIdentifierList: []v251.CX{{IDNumber: "123"}},
+			PatientName:           []v251.XPN{{GivenName: "Ann"}},
+		},
+		Insurance: []v251.ADT_A01_Insurance{{}},
+	}
+	err := Validate(msg, nil)
+	var ve ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	got := map[string]bool{}
+	for _, fe := range ve {
+		got[fe.Name] = true
+	}
+	for _, name := range []string{"ADT_A01.EVN", "ADT_A01.PV1", "ADT_A01.Insurance.IN1"} {
+		if !got[name] {
+			t.Errorf("missing %s in %v", name, err)
+		}
+	}
+	if got["ADT_A01.PD1"] {
+		t.Errorf("optional segment reported: %v", err)
+	}
+}
+
+func TestValidateTable(t *testing.T) {
+	pid := &v251.PID{
+		PatientIdentifierList: []v251.CX{{IDNumber: "123"}},
+		PatientName:           []v251.XPN{{GivenName: "Ann"}},
+		AdministrativeSex:     "Q",
+	}
+	opt := &ValidateOption{Tables: TableValues(v251.TableValueLookup)}
+	err := Validate(pid, opt)
+	var ve ValidationError
+	if !errors.As(err, &ve) || len(ve) != 1 || !errors.Is(ve[0], ErrTableValue) || ve[0].Location() != "PID-8" {
+		t.Fatalf("got %v", err)
+	}
+
+	var warned []error
+	opt.TableValue = TableWarn
+	opt.Warn = func(err error) { warned = append(warned, err) }
+	if err := Validate(pid, opt); err != nil {
+		t.Fatalf("expected warning only, got %v", err)
+	}
+	if len(warned) != 1 {
+		t.Fatalf("got warnings %v", warned)
+	}
+
+	pid.AdministrativeSex = "F"
+	if err := Validate(pid, &ValidateOption{Tables: TableValues(v251.TableValueLookup)}); err != nil {
+		t.Fatalf("expected valid, got %v", err)
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	d := NewDecoder(v251.Registry, nil)
+	decode := func(lines ...string) []any {
+		list, err := d.DecodeList([]byte(strings.Join(lines, "\r")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+	msh := `MSH|^~\&|||||20240131||ORU^R01^ORU_R01|1|P|2.5.1`
+
+	list := decode(msh, `PID|1`, `OBR|1`, `OBX|1`, `OBX|2`, `OBR|2`, `NTE|1`, `OBX|3`)
+	if err := ValidateOrder(list, v251.Registry); err != nil {
+		t.Fatalf("expected valid, got %v", err)
+	}
+
+	list = decode(msh, `PID|1`, `OBR|1`, `OBX|1`, `DSC|1`, `OBX|2`)
+	err := ValidateOrder(list, v251.Registry)
+	var ve ValidationError
+	if !errors.As(err, &ve) || len(ve) != 1 || !errors.Is(ve[0], ErrSegmentOrder) || ve[0].Segment != "OBX" {
+		t.Fatalf("got %v", err)
+	}
+
+	list = decode(msh, `PID|1`, `OBR|1`, `OBX|1`, `SFT|x`)
+	err = ValidateOrder(list, v251.Registry)
+	if !errors.As(err, &ve) || len(ve) != 1 || !errors.Is(ve[0], ErrSegmentOrder) || ve[0].Segment != "SFT" {
+		t.Fatalf("got %v", err)
+	}
+}
+
+type testZEN struct {
+	HL7   struct{}         `hl7:",name=ZEN,type=s"`
+	Sex   v251.Table0001   `hl7:"1,table=0001"`
+	Class []v251.Table0004 `hl7:"2,table=0004"`
+}
+
+func TestEnum(t *testing.T) {
+	registry := newTestRegistry(testZEN{})
+	data := []byte("MSH|^~\\&|||||||ADT^A01|1|P|2.5.1\rZEN|F|I~Q\r")
+
+	// A local code decodes by default.
+	if _, err := NewDecoder(registry, nil).DecodeList(data); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewDecoder(registry, &DecodeOption{TableValue: TableError}).DecodeList(data)
+	if !errors.Is(err, ErrTableValue) {
+		t.Fatalf("got %v", err)
+	}
+
+	var warn []error
+	list, err := NewDecoder(registry, &DecodeOption{TableValue: TableWarn, Warn: func(err error) { warn = append(warn, err) }}).DecodeList(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warn) != 1 || !strings.Contains(warn[0].Error(), `0004: "Q"`) {
+		t.Fatalf("got %q", warn)
+	}
+	zen := list[1].(*testZEN)
+	if zen.Sex != v251.Table0001_F || zen.Sex.Description() != "Female" || zen.Class[0] != v251.Table0004_I {
+		t.Fatalf("got %+v", zen)
+	}
+
+	err = Validate(zen, nil)
+	var ve ValidationError
+	if !errors.As(err, &ve) || len(ve) != 1 || ve[0].Location() != "ZEN-2" {
+		t.Fatalf("got %v", err)
+	}
+	if err := Validate(zen, &ValidateOption{TableValue: TableIgnore}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCodeChecker(t *testing.T) {
+	// A stand in for the current CVX and MVX code sets.
+	codes := CodeCheckerFunc(func(table string, c Code) error {
+		valid := map[string]map[string]bool{
+			TableCVX: {"08": true, "141": true},
+			TableMVX: {"MSD": true, "SKB": true},
+		}[table]
+		if valid == nil || c.CodingSystem != "CVX" && c.CodingSystem != "MVX" {
+			return nil
+		}
+		if !valid[c.Identifier] {
+			return errors.New("unknown code")
+		}
+		return nil
+	})
+	rxa := &v251.RXA{
+		AdministeredCode:          v251.CE{Identifier: "999", Text: "Not a vaccine", NameOfCodingSystem: "CVX"},
+		SubstanceManufacturerName: []v251.CE{{Identifier: "MSD", NameOfCodingSystem: "MVX"}, {Identifier: "ZZZ", NameOfCodingSystem: "MVX"}},
+	}
+	var ve ValidationError
+	err := Validate(rxa, &ValidateOption{Codes: codes, TableValue: TableError})
+	if !errors.As(err, &ve) {
+		t.Fatalf("got %v", err)
+	}
+	var got []string
+	for _, fe := range ve {
+		if errors.Is(fe, ErrTableValue) {
+			got = append(got, fe.Location())
+		}
+	}
+	if strings.Join(got, " ") != "RXA-5 RXA-17" {
+		t.Fatalf("got %v", ve)
+	}
+}
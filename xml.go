@@ -0,0 +1,71 @@
+package hl7
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// XMLNamespace is the namespace of the HL7 v2 XML encoding.
+const XMLNamespace = "urn:hl7-org:v2xml"
+
+// EncodeXML encodes the message in the HL7 v2 XML encoding (v2.xml).
+//
+// The root element is named after the message structure, such as ADT_A01, and each group after the
+// message structure and the group, such as ADT_A01.INSURANCE. Fields are named after the segment and
+// components after the data type, such as <PID.5><XPN.1><FN.1>Doe</FN.1></XPN.1></PID.5>.
+// A segment list, such as from DecodeList, is written without groups.
+func (e *Encoder) EncodeXML(message any) ([]byte, error) {
+	root, err := e.tree(message)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString(xml.Header)
+	x := xml.NewEncoder(buf)
+	x.Indent("", "  ")
+	start := xml.StartElement{
+		Name: root.XMLName,
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: XMLNamespace}},
+	}
+	if err := writeXML(x, start, root); err != nil {
+		return nil, err
+	}
+	if err := x.Flush(); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// writeXML writes the element of the node and its children.
+func writeXML(x *xml.Encoder, start xml.StartElement, n node) error {
+	if err := x.EncodeToken(start); err != nil {
+		return err
+	}
+	if len(n.Nodes) == 0 {
+		if err := x.EncodeToken(xml.CharData(n.Text)); err != nil {
+			return err
+		}
+	}
+	for _, c := range n.Nodes {
+		if err := writeXML(x, xml.StartElement{Name: c.XMLName}, c); err != nil {
+			return err
+		}
+	}
+	return x.EncodeToken(start.End())
+}
+
+// DecodeXML decodes a message in the HL7 v2 XML encoding (v2.xml) and returns a final trigger with all segments grouped.
+// Group elements are not required; segments are grouped by the registry as for Decode.
+func (d *Decoder) DecodeXML(data []byte) (any, error) {
+	root := node{}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("xml: %w", err)
+	}
+	lines, err := treeSegments(root.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("xml: %w", err)
+	}
+	return d.Decode(lines)
+}
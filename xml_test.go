@@ -0,0 +1,148 @@
+package hl7
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v251 "github.com/kardianos/hl7/h251"
+)
+
+func TestXMLRoundTrip(t *testing.T) {
+	fsDir := filepath.Join("testdata", "roundtrip")
+	dirList, err := os.ReadDir(fsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(v251.Registry, nil)
+	e := NewEncoder(nil)
+	for _, f := range dirList {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		t.Run(name, func(t *testing.T) {
+			bb, err := os.ReadFile(filepath.Join(fsDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := d.Decode(bb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The encoded bytes are reused by the next Encode call.
+			want, err := NewEncoder(nil).Encode(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x, err := e.EncodeXML(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			back, err := d.DecodeXML(x)
+			if err != nil {
+				t.Fatalf("%v\n%s", err, x)
+			}
+			got, err := e.Encode(back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Fatalf("got:\n%s\nwant:\n%s", lineDiff(got, want), x)
+			}
+		})
+	}
+}
+
+func TestEncodeXML(t *testing.T) {
+	raw := strings.Join([]string{
+		`MSH|^~\&|LAB|HOSP|||20240102030405||ADT^A01^ADT_A01|1|P|2.5.1`,
+		`EVN|A01`,
+		`PID|||123^^^MRN^MR||Doe^Jane||19800101|F|||Smith \T\ Sons^^City`,
+		`PV1||I|WARD^201^A|||||||||||||||||""`,
+		`IN1|1|PPO|BCBS`,
+	}, "\r")
+	d := NewDecoder(v251.Registry, nil)
+	msg, err := d.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := NewEncoder(nil).EncodeXML(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<ADT_A01 xmlns="urn:hl7-org:v2xml">`,
+		`<MSH.1>|</MSH.1>`,
+		`<MSH.2>^~\&amp;</MSH.2>`,
+		`<MSG.3>ADT_A01</MSG.3>`,
+		`<PID.1>1</PID.1>`,
+		`<XPN.1>Doe</XPN.1>`,
+		`<SAD.1>Smith &amp; Sons</SAD.1>`,
+		`<FC.1>&#34;&#34;</FC.1>`,
+		`<ADT_A01.INSURANCE>`,
+	} {
+		if !strings.Contains(string(x), want) {
+			t.Errorf("missing %s in:\n%s", want, x)
+		}
+	}
+
+	list, err := d.DecodeList([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lx, err := NewEncoder(nil).EncodeXML(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(lx), `<ADT_A01 xmlns="urn:hl7-org:v2xml">`) || strings.Contains(string(lx), `<ADT_A01.INSURANCE>`) {
+		t.Errorf("got segment list:\n%s", lx)
+	}
+
+	back, err := d.DecodeXML(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adt := back.(v251.ADT_A01)
+	if got := adt.PID.PatientAddress[0].StreetAddress.StreetOrMailingAddress; got != "Smith & Sons" {
+		t.Errorf("got street %q", got)
+	}
+	if len(adt.Insurance) != 1 || adt.Insurance[0].IN1.InsuranceCompanyID[0].IDNumber != "BCBS" {
+		t.Errorf("got insurance %+v", adt.Insurance)
+	}
+}
+
+func TestDecodeXML(t *testing.T) {
+	// Groups are optional and the delimiters of MSH-2 are used to escape values.
+	x := `<ORU_R01 xmlns="urn:hl7-org:v2xml">
+	<MSH><MSH.1>|</MSH.1><MSH.2>^~\&amp;#</MSH.2><MSH.9><MSG.1>ORU</MSG.1><MSG.2>R01</MSG.2><MSG.3>ORU_R01</MSG.3></MSH.9><MSH.12><VID.1>2.5.1</VID.1></MSH.12></MSH>
+	<PID><PID.3><CX.1>1</CX.1></PID.3><PID.3><CX.1>2</CX.1></PID.3></PID>
+	<OBR><OBR.4><CE.1>CBC</CE.1></OBR.4></OBR>
+	<OBX><OBX.2>ST</OBX.2><OBX.5>A|B^C#</OBX.5></OBX>
+</ORU_R01>`
+	msg, err := NewDecoder(v251.Registry, nil).DecodeXML([]byte(x))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oru := msg.(v251.ORU_R01)
+	pr := oru.PatientResult[0]
+	if len(pr.Patient.PID.PatientIdentifierList) != 2 {
+		t.Errorf("got identifiers %+v", pr.Patient.PID.PatientIdentifierList)
+	}
+	obx := pr.OrderObservation[0].Observation[0].OBX
+	if len(obx.ObservationValue) != 1 || obx.ObservationValue[0] != v251.ST("A|B^C#") {
+		t.Errorf("got value %#v", obx.ObservationValue)
+	}
+
+	for _, bad := range []string{
+		`<ADT_A01><MSH><MSH.1>|</MSH.1><MSH.2>^~</MSH.2></MSH></ADT_A01>`,
+		`<ADT_A01><MSH><MSH.X>1</MSH.X></MSH></ADT_A01>`,
+		`<ADT_A01><PID><PV1.1>1</PV1.1></PID></ADT_A01>`,
+		`<ADT_A01>`,
+	} {
+		if _, err := NewDecoder(v251.Registry, nil).DecodeXML([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+}